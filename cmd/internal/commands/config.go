@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"gopkg.in/yaml.v2"
 )
 
@@ -136,5 +138,77 @@ func Config(mgr *config.Manager) *cobra.Command {
 	}
 	cmd.Flags().Bool("env", false, "print as environment")
 	cmd.Flags().Bool("yml", false, "print as yml")
+	cmd.AddCommand(configValidate(mgr), configExplain(mgr))
 	return cmd
 }
+
+var errInvalidConfig = errors.DefineInvalidArgument("invalid_config", "configuration contains `{count}` unknown keys")
+
+func configValidate(mgr *config.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the configuration files",
+		Long: `Validate the configuration files.
+
+Keys in configuration files that are not known to the stack are ignored when
+the configuration is loaded, so misspelled keys silently fall back to their
+defaults. This command reports those keys, with a suggestion where possible.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unknown, err := mgr.ValidateConfigFiles()
+			if err != nil {
+				return err
+			}
+			for _, key := range unknown {
+				if key.Suggestion != "" {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: unknown key \"%s\" (did you mean \"%s\"?)\n", key.File, key.Key, key.Suggestion)
+				} else {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s: unknown key \"%s\"\n", key.File, key.Key)
+				}
+			}
+			if len(unknown) > 0 {
+				return errInvalidConfig.WithAttributes("count", len(unknown))
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Configuration is valid")
+			return nil
+		},
+	}
+}
+
+var errUnknownConfigKey = errors.DefineNotFound("unknown_config_key", "unknown configuration key `{key}`")
+
+func configExplain(mgr *config.Manager) *cobra.Command {
+	return &cobra.Command{
+		Use:   "explain [key]",
+		Short: "Explain a configuration key",
+		Long: `Explain a configuration key.
+
+The type, default value, description and environment variable of the key are
+printed. If the key is a section, all keys in that section are explained.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			infos := mgr.Explain(args[0])
+			if len(infos) == 0 {
+				return errUnknownConfigKey.WithAttributes("key", args[0])
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+			for i, info := range infos {
+				if i > 0 {
+					fmt.Fprintln(w)
+				}
+				fmt.Fprintf(w, "Key:\t%s\n", info.Key)
+				fmt.Fprintf(w, "Type:\t%s\n", info.Type)
+				fmt.Fprintf(w, "Default:\t%q\n", info.Default)
+				if info.Description != "" {
+					fmt.Fprintf(w, "Description:\t%s\n", info.Description)
+				}
+				if info.FileOnly {
+					fmt.Fprintf(w, "File only:\t%t\n", info.FileOnly)
+				} else {
+					fmt.Fprintf(w, "Flag:\t--%s\n", info.Key)
+					fmt.Fprintf(w, "Environment:\t%s\n", mgr.EnvironmentForKey(info.Key))
+				}
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/mutewindow"
 )
 
 // DefaultApplicationServerConfig is the default configuration for the Application Server.
@@ -33,9 +34,57 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
 	},
 	Webhooks: applicationserver.WebhooksConfig{
-		Target:    "direct",
-		Timeout:   5 * time.Second,
-		QueueSize: 16,
-		Workers:   16,
+		Target:        "direct",
+		Timeout:       5 * time.Second,
+		QueueSize:     16,
+		Workers:       16,
+		MaxDeliveries: 20,
+	},
+	WebSocket: applicationserver.WebSocketConfig{
+		Enabled: true,
+	},
+	MQTTWebSocket: applicationserver.MQTTWebSocketConfig{
+		Enabled: true,
+	},
+	MQTTRateLimit: applicationserver.MQTTRateLimitConfig{
+		Default: applicationserver.MQTTRateLimits{
+			PublishRate:    50,
+			PublishBurst:   500,
+			SubscribeRate:  5,
+			SubscribeBurst: 50,
+		},
+	},
+	PubSub: applicationserver.PubSubConfig{
+		PauseBufferSize: 1024,
+	},
+	DownlinkTracking: applicationserver.DownlinkTrackingConfig{
+		Size:           10,
+		TimeoutUplinks: 3,
+		TTL:            7 * 24 * time.Hour,
+	},
+	Suspension: applicationserver.SuspensionConfig{
+		CacheTTL: time.Minute,
+	},
+	DownlinkSchedules: applicationserver.DownlinkSchedulesConfig{
+		History: 10,
+	},
+	GRPCFormatters: applicationserver.GRPCFormattersConfig{
+		Timeout: 5 * time.Second,
+	},
+	JavaScriptFormatters: applicationserver.JavaScriptFormattersConfig{
+		Timeout:         100 * time.Millisecond,
+		StackDepthLimit: 32,
+		CacheSize:       1024,
+	},
+	DeviceRepository: applicationserver.DeviceRepositoryConfig{
+		CacheTTL: 10 * time.Minute,
+	},
+	FormattersHistory: applicationserver.FormattersHistoryConfig{
+		Size: 10,
+	},
+	MuteWindows: applicationserver.MuteWindowsConfig{
+		Enable:     true,
+		CacheTTL:   mutewindow.DefaultCacheTTL,
+		BufferSize: 100,
 	},
 }
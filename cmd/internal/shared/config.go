@@ -29,7 +29,18 @@ var DefaultBaseConfig = config.Base{
 
 // DefaultLogConfig is the default log configuration.
 var DefaultLogConfig = config.Log{
-	Level: log.InfoLevel,
+	Level:  log.InfoLevel,
+	Format: "console",
+	File: config.LogFile{
+		Level:      log.InfoLevel,
+		MaxSize:    100,
+		MaxBackups: 5,
+	},
+	Syslog: config.LogSyslog{
+		Network: "udp",
+		Tag:     "ttn-lw-stack",
+		Level:   log.InfoLevel,
+	},
 }
 
 // DefaultTLSConfig is the default TLS config.
@@ -84,6 +95,9 @@ var DefaultRedisConfig = config.Redis{
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = config.Events{
 	Backend: "internal",
+	Correlation: config.EventsCorrelation{
+		Limit: 100,
+	},
 }
 
 // DefaultBlobConfig is the default config for the blob store.
@@ -32,10 +32,11 @@ func InitializeEvents(ctx context.Context, config config.ServiceBase) (err error
 	case "internal":
 		return nil // this is the default.
 	case "redis":
+		correlation := redis.WithCorrelationStore(config.Events.Correlation.TTL, config.Events.Correlation.Limit)
 		if !config.Events.Redis.IsZero() {
-			events.SetDefaultPubSub(redis.NewPubSub(config.Events.Redis))
+			events.SetDefaultPubSub(redis.NewPubSub(config.Events.Redis, correlation))
 		} else {
-			events.SetDefaultPubSub(redis.NewPubSub(config.Redis))
+			events.SetDefaultPubSub(redis.NewPubSub(config.Redis, correlation))
 		}
 		return nil
 	case "cloud":
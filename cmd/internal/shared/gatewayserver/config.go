@@ -16,6 +16,7 @@ package shared
 
 import (
 	"fmt"
+	"time"
 
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -26,6 +27,9 @@ import (
 // DefaultGatewayServerConfig is the default configuration for the GatewayServer.
 var DefaultGatewayServerConfig = gatewayserver.Config{
 	RequireRegisteredGateways: false,
+	Startup: gatewayserver.StartupConfig{
+		CheckInterval: time.Second,
+	},
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
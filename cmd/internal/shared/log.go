@@ -0,0 +1,78 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"io"
+
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/log/handler/multi"
+)
+
+var (
+	errLogFormat         = errors.DefineInvalidArgument("log_format", "invalid log format `{format}`")
+	errLogNamespaceLevel = errors.DefineInvalidArgument("log_namespace_level", "invalid log level `{level}` for namespace `{namespace}`")
+	errLogFile           = errors.Define("log_file", "could not open log file `{path}`")
+)
+
+// NewLogger returns a new logger based on the log configuration.
+// Log messages are written to w in the configured format, and to the file and syslog server if configured.
+func NewLogger(conf config.Log, w io.Writer) (*log.Logger, error) {
+	var handlers []log.Handler
+	switch conf.Format {
+	case "", "console":
+		handlers = append(handlers, log.NewCLI(w))
+	case "json":
+		handlers = append(handlers, log.NewJSON(w))
+	default:
+		return nil, errLogFormat.WithAttributes("format", conf.Format)
+	}
+	if conf.File.Path != "" {
+		f, err := log.OpenRotatingFile(conf.File.Path, int64(conf.File.MaxSize)<<20, conf.File.MaxBackups)
+		if err != nil {
+			return nil, errLogFile.WithCause(err).WithAttributes("path", conf.File.Path)
+		}
+		handlers = append(handlers, &log.LevelHandler{
+			Level: conf.File.Level,
+			Next:  log.NewJSON(f),
+		})
+	}
+	if conf.Syslog.Address != "" {
+		handlers = append(handlers, &log.LevelHandler{
+			Level: conf.Syslog.Level,
+			Next:  log.NewSyslog(conf.Syslog.Network, conf.Syslog.Address, conf.Syslog.Tag),
+		})
+	}
+
+	levels := make(map[string]log.Level, len(conf.Namespaces))
+	for namespace, str := range conf.Namespaces {
+		level, err := log.ParseLevel(str)
+		if err != nil {
+			return nil, errLogNamespaceLevel.WithAttributes("namespace", namespace, "level", str)
+		}
+		levels[namespace] = level
+	}
+
+	handler := handlers[0]
+	if len(handlers) > 1 {
+		handler = multi.New(handlers...)
+	}
+	return log.NewLogger(
+		log.WithHandler(handler),
+		log.WithNamespaceLevels(log.NewNamespaceLevels(conf.Level, levels)),
+	)
+}
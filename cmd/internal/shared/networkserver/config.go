@@ -17,6 +17,7 @@ package networkserver
 import (
 	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/mutewindow"
 	"go.thethings.network/lorawan-stack/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -25,6 +26,8 @@ import (
 var DefaultNetworkServerConfig = networkserver.Config{
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
+	RecentUplinkCount:   networkserver.DefaultRecentUplinkCount,
+	RecentDownlinkCount: networkserver.DefaultRecentDownlinkCount,
 	DownlinkPriorities: networkserver.DownlinkPriorityConfig{
 		JoinAccept:             "highest",
 		MACCommands:            "highest",
@@ -38,4 +41,11 @@ var DefaultNetworkServerConfig = networkserver.Config{
 		StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(networkserver.DefaultStatusTimePeriodicity),
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(networkserver.DefaultStatusCountPeriodicity),
 	},
+	FairUse: networkserver.FairUseConfig{
+		Window: 24 * time.Hour,
+	},
+	MuteWindows: networkserver.MuteWindowsConfig{
+		Enable:   true,
+		CacheTTL: mutewindow.DefaultCacheTTL,
+	},
 }
@@ -56,6 +56,14 @@ func getApplicationID(flagSet *pflag.FlagSet, args []string) *ttnpb.ApplicationI
 	return &ttnpb.ApplicationIdentifiers{ApplicationID: applicationID}
 }
 
+func getApplicationIDs(args []string) []*ttnpb.ApplicationIdentifiers {
+	ids := make([]*ttnpb.ApplicationIdentifiers, 0, len(args))
+	for _, applicationID := range args {
+		ids = append(ids, &ttnpb.ApplicationIdentifiers{ApplicationID: applicationID})
+	}
+	return ids
+}
+
 var (
 	applicationsCommand = &cobra.Command{
 		Use:     "applications",
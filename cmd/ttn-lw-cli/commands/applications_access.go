@@ -125,6 +125,41 @@ var (
 			return nil
 		},
 	}
+	applicationCollaboratorsSetBulk = &cobra.Command{
+		Use:   "set-bulk [application-id]...",
+		Short: "Set a collaborator of multiple applications",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appIDs := getApplicationIDs(args)
+			if len(appIDs) == 0 {
+				return errNoApplicationID
+			}
+			collaborator := getCollaborator(cmd.Flags())
+			if collaborator == nil {
+				return errNoCollaborator
+			}
+			rights := getRights(cmd.Flags())
+			if len(rights) == 0 {
+				return errNoCollaboratorRights
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationAccessClient(is).SetCollaboratorBulk(ctx, &ttnpb.SetApplicationCollaboratorBulkRequest{
+				ApplicationIDs: appIDs,
+				Collaborator: ttnpb.Collaborator{
+					OrganizationOrUserIdentifiers: *collaborator,
+					Rights:                        rights,
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Results)
+		},
+	}
 	applicationCollaboratorsDelete = &cobra.Command{
 		Use:     "delete",
 		Aliases: []string{"remove"},
@@ -217,6 +252,40 @@ var (
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	applicationAPIKeysCreateBulk = &cobra.Command{
+		Use:   "create-bulk [application-id]...",
+		Short: "Create an API key for multiple applications",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appIDs := getApplicationIDs(args)
+			if len(appIDs) == 0 {
+				return errNoApplicationID
+			}
+			name, _ := cmd.Flags().GetString("name")
+
+			rights := getRights(cmd.Flags())
+			if len(rights) == 0 {
+				return errNoAPIKeyRights
+			}
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationAccessClient(is).CreateAPIKeyBulk(ctx, &ttnpb.CreateApplicationAPIKeyBulkRequest{
+				ApplicationIDs: appIDs,
+				Name:           name,
+				Rights:         rights,
+			})
+			if err != nil {
+				return err
+			}
+
+			logger.Warn("The API key values will never be shown again")
+			logger.Warn("Make sure to copy them to a safe place")
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Results)
+		},
+	}
 	applicationAPIKeysUpdate = &cobra.Command{
 		Use:     "update [application-id] [api-key-id]",
 		Aliases: []string{"set"},
@@ -303,6 +372,9 @@ func init() {
 	applicationCollaboratorsSet.Flags().AddFlagSet(collaboratorFlags())
 	applicationCollaboratorsSet.Flags().AddFlagSet(applicationRightsFlags)
 	applicationCollaborators.AddCommand(applicationCollaboratorsSet)
+	applicationCollaboratorsSetBulk.Flags().AddFlagSet(collaboratorFlags())
+	applicationCollaboratorsSetBulk.Flags().AddFlagSet(applicationRightsFlags)
+	applicationCollaborators.AddCommand(applicationCollaboratorsSetBulk)
 	applicationCollaboratorsDelete.Flags().AddFlagSet(collaboratorFlags())
 	applicationCollaborators.AddCommand(applicationCollaboratorsDelete)
 	applicationCollaborators.PersistentFlags().AddFlagSet(applicationIDFlags())
@@ -313,6 +385,9 @@ func init() {
 	applicationAPIKeysCreate.Flags().String("name", "", "")
 	applicationAPIKeysCreate.Flags().AddFlagSet(applicationRightsFlags)
 	applicationAPIKeys.AddCommand(applicationAPIKeysCreate)
+	applicationAPIKeysCreateBulk.Flags().String("name", "", "")
+	applicationAPIKeysCreateBulk.Flags().AddFlagSet(applicationRightsFlags)
+	applicationAPIKeys.AddCommand(applicationAPIKeysCreateBulk)
 	applicationAPIKeysUpdate.Flags().String("api-key-id", "", "")
 	applicationAPIKeysUpdate.Flags().String("name", "", "")
 	applicationAPIKeysUpdate.Flags().AddFlagSet(applicationRightsFlags)
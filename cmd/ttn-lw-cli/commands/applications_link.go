@@ -128,6 +128,28 @@ var (
 			return nil
 		},
 	}
+	applicationsLinkTrafficStatsCommand = &cobra.Command{
+		Use:     "traffic-stats [application-id]",
+		Aliases: []string{"traffic"},
+		Short:   "Get the traffic statistics of an application link",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsClient(as).GetTrafficStats(ctx, appID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -139,5 +161,7 @@ func init() {
 	applicationsLinkCommand.AddCommand(applicationsLinkSetCommand)
 	applicationsLinkDeleteCommand.Flags().AddFlagSet(applicationIDFlags())
 	applicationsLinkCommand.AddCommand(applicationsLinkDeleteCommand)
+	applicationsLinkTrafficStatsCommand.Flags().AddFlagSet(applicationIDFlags())
+	applicationsLinkCommand.AddCommand(applicationsLinkTrafficStatsCommand)
 	applicationsCommand.AddCommand(applicationsLinkCommand)
 }
@@ -30,10 +30,15 @@ import (
 )
 
 var (
-	selectApplicationPubSubFlags       = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
-	setApplicationPubSubFlags          = util.FieldFlags(&ttnpb.ApplicationPubSub{})
-	natsProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
-	mqttProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	selectApplicationPubSubFlags         = util.FieldMaskFlags(&ttnpb.ApplicationPubSub{})
+	setApplicationPubSubFlags            = util.FieldFlags(&ttnpb.ApplicationPubSub{})
+	natsProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_NATSProvider{}, "nats")
+	mqttProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_MQTTProvider{}, "mqtt")
+	kafkaProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_KafkaProvider{}, "kafka")
+	amqpProviderApplicationPubSubFlags   = util.FieldFlags(&ttnpb.ApplicationPubSub_AMQPProvider{}, "amqp")
+	awsIoTProviderApplicationPubSubFlags = util.FieldFlags(&ttnpb.ApplicationPubSub_AWSIoTProvider{}, "aws_iot")
+	azureProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_AzureProvider{}, "azure")
+	redisProviderApplicationPubSubFlags  = util.FieldFlags(&ttnpb.ApplicationPubSub_RedisProvider{}, "redis")
 )
 
 func applicationPubSubIDFlags() *pflag.FlagSet {
@@ -52,6 +57,25 @@ func applicationPubSubProviderFlags() *pflag.FlagSet {
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-ca", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-cert", ""))
 	flagSet.AddFlagSet(dataFlags("mqtt.tls-client-key", ""))
+	flagSet.Bool("kafka", false, "use the Kafka provider")
+	flagSet.AddFlagSet(kafkaProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("kafka.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("kafka.tls-client-key", ""))
+	flagSet.Bool("amqp", false, "use the AMQP provider")
+	flagSet.AddFlagSet(amqpProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("amqp.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("amqp.tls-client-key", ""))
+	flagSet.Bool("aws-iot", false, "use the AWS IoT Core provider")
+	flagSet.AddFlagSet(awsIoTProviderApplicationPubSubFlags)
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-ca", ""))
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-cert", ""))
+	flagSet.AddFlagSet(dataFlags("aws-iot.tls-client-key", ""))
+	flagSet.Bool("azure", false, "use the Azure provider")
+	flagSet.AddFlagSet(azureProviderApplicationPubSubFlags)
+	flagSet.Bool("redis", false, "use the Redis provider")
+	flagSet.AddFlagSet(redisProviderApplicationPubSubFlags)
 	addDeprecatedProviderFlags(flagSet)
 	return flagSet
 }
@@ -234,6 +258,87 @@ var (
 						return nil
 					},
 				},
+				"kafka": {
+					provider: &ttnpb.ApplicationPubSub_Kafka{},
+					flags:    kafkaProviderApplicationPubSubFlags,
+					loadData: func() error {
+						if useTLS, _ := cmd.Flags().GetBool("kafka.use-tls"); useTLS {
+							for _, name := range []string{
+								"kafka.tls-ca",
+								"kafka.tls-client-cert",
+								"kafka.tls-client-key",
+							} {
+								data, err := getDataBytes(name, cmd.Flags())
+								if err != nil {
+									return err
+								}
+								err = cmd.Flags().Set(name, hex.EncodeToString(data))
+								if err != nil {
+									return err
+								}
+							}
+						}
+						return nil
+					},
+				},
+				"amqp": {
+					provider: &ttnpb.ApplicationPubSub_AMQP{},
+					flags:    amqpProviderApplicationPubSubFlags,
+					loadData: func() error {
+						if useTLS, _ := cmd.Flags().GetBool("amqp.use-tls"); useTLS {
+							for _, name := range []string{
+								"amqp.tls-ca",
+								"amqp.tls-client-cert",
+								"amqp.tls-client-key",
+							} {
+								data, err := getDataBytes(name, cmd.Flags())
+								if err != nil {
+									return err
+								}
+								err = cmd.Flags().Set(name, hex.EncodeToString(data))
+								if err != nil {
+									return err
+								}
+							}
+						}
+						return nil
+					},
+				},
+				"aws-iot": {
+					provider: &ttnpb.ApplicationPubSub_AWSIoT{},
+					flags:    awsIoTProviderApplicationPubSubFlags,
+					loadData: func() error {
+						for _, name := range []string{
+							"aws-iot.tls-ca",
+							"aws-iot.tls-client-cert",
+							"aws-iot.tls-client-key",
+						} {
+							// The CA is optional and the client certificate is only used for X509 authentication.
+							if file, _ := cmd.Flags().GetString(name + "-local-file"); file == "" {
+								continue
+							}
+							data, err := getDataBytes(name, cmd.Flags())
+							if err != nil {
+								return err
+							}
+							err = cmd.Flags().Set(name, hex.EncodeToString(data))
+							if err != nil {
+								return err
+							}
+						}
+						return nil
+					},
+				},
+				"azure": {
+					provider: &ttnpb.ApplicationPubSub_Azure{},
+					flags:    azureProviderApplicationPubSubFlags,
+					loadData: func() error { return nil },
+				},
+				"redis": {
+					provider: &ttnpb.ApplicationPubSub_Redis{},
+					flags:    redisProviderApplicationPubSubFlags,
+					loadData: func() error { return nil },
+				},
 			} {
 				if enabled, _ := cmd.Flags().GetBool(name); enabled {
 					pubsub.Provider = p.provider
@@ -281,6 +386,69 @@ var (
 				return err
 			}
 
+			return nil
+		},
+	}
+	applicationsPubSubsStatusCommand = &cobra.Command{
+		Use:   "status [application-id] [pubsub-id]",
+		Short: "Get the health status of an application pubsub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubsubID, err := getApplicationPubSubID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationPubSubRegistryClient(as).GetPubSubStatus(ctx, pubsubID)
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	applicationsPubSubsPauseCommand = &cobra.Command{
+		Use:   "pause [application-id] [pubsub-id]",
+		Short: "Pause an application pubsub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubsubID, err := getApplicationPubSubID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationPubSubRegistryClient(as).Pause(ctx, pubsubID)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+	applicationsPubSubsResumeCommand = &cobra.Command{
+		Use:   "resume [application-id] [pubsub-id]",
+		Short: "Resume a paused application pubsub",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pubsubID, err := getApplicationPubSubID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationPubSubRegistryClient(as).Resume(ctx, pubsubID)
+			if err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -300,5 +468,11 @@ func init() {
 	applicationsPubSubsCommand.AddCommand(applicationsPubSubsSetCommand)
 	applicationsPubSubsDeleteCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
 	applicationsPubSubsCommand.AddCommand(applicationsPubSubsDeleteCommand)
+	applicationsPubSubsStatusCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
+	applicationsPubSubsCommand.AddCommand(applicationsPubSubsStatusCommand)
+	applicationsPubSubsPauseCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
+	applicationsPubSubsCommand.AddCommand(applicationsPubSubsPauseCommand)
+	applicationsPubSubsResumeCommand.Flags().AddFlagSet(applicationPubSubIDFlags())
+	applicationsPubSubsCommand.AddCommand(applicationsPubSubsResumeCommand)
 	applicationsCommand.AddCommand(applicationsPubSubsCommand)
 }
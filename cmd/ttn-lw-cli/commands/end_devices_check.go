@@ -0,0 +1,330 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Registries of end devices, as reported by the check command.
+const (
+	identityServerRegistry    = "is"
+	networkServerRegistry     = "ns"
+	applicationServerRegistry = "as"
+	joinServerRegistry        = "js"
+)
+
+// endDeviceCheckPageSize is the number of end devices that are listed from the Identity Server at once.
+const endDeviceCheckPageSize = 100
+
+// endDeviceInconsistency is an end device that is registered in some, but not all of its registries.
+type endDeviceInconsistency struct {
+	IDs       ttnpb.EndDeviceIdentifiers `json:"ids"`
+	PresentIn []string                   `json:"present_in"`
+	MissingIn []string                   `json:"missing_in"`
+	Repaired  bool                       `json:"repaired,omitempty"`
+	Cleaned   bool                       `json:"cleaned,omitempty"`
+}
+
+// checkEndDeviceRegistry returns whether the end device exists in the Network Server, Application Server or Join
+// Server registry.
+func checkEndDeviceRegistry(registry string, ids ttnpb.EndDeviceIdentifiers) (bool, error) {
+	req := &ttnpb.GetEndDeviceRequest{EndDeviceIdentifiers: ids}
+	var err error
+	switch registry {
+	case networkServerRegistry:
+		ns, dialErr := api.Dial(ctx, config.NetworkServerGRPCAddress)
+		if dialErr != nil {
+			return false, dialErr
+		}
+		_, err = ttnpb.NewNsEndDeviceRegistryClient(ns).Get(ctx, req)
+	case applicationServerRegistry:
+		as, dialErr := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if dialErr != nil {
+			return false, dialErr
+		}
+		_, err = ttnpb.NewAsEndDeviceRegistryClient(as).Get(ctx, req)
+	case joinServerRegistry:
+		js, dialErr := api.Dial(ctx, config.JoinServerGRPCAddress)
+		if dialErr != nil {
+			return false, dialErr
+		}
+		_, err = ttnpb.NewJsEndDeviceRegistryClient(js).Get(ctx, req)
+	}
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deleteEndDeviceFromRegistry deletes the end device from the Network Server, Application Server or Join Server
+// registry.
+func deleteEndDeviceFromRegistry(registry string, ids *ttnpb.EndDeviceIdentifiers) error {
+	var err error
+	switch registry {
+	case networkServerRegistry:
+		ns, dialErr := api.Dial(ctx, config.NetworkServerGRPCAddress)
+		if dialErr != nil {
+			return dialErr
+		}
+		_, err = ttnpb.NewNsEndDeviceRegistryClient(ns).Delete(ctx, ids)
+	case applicationServerRegistry:
+		as, dialErr := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if dialErr != nil {
+			return dialErr
+		}
+		_, err = ttnpb.NewAsEndDeviceRegistryClient(as).Delete(ctx, ids)
+	case joinServerRegistry:
+		js, dialErr := api.Dial(ctx, config.JoinServerGRPCAddress)
+		if dialErr != nil {
+			return dialErr
+		}
+		_, err = ttnpb.NewJsEndDeviceRegistryClient(js).Delete(ctx, ids)
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// expectedEndDeviceRegistries returns the registries other than the Identity Server in which the end device is
+// expected to be registered, according to the server addresses registered in the Identity Server.
+func expectedEndDeviceRegistries(dev *ttnpb.EndDevice) []string {
+	nsMismatch, asMismatch, jsMismatch := compareServerAddressesEndDevice(dev, config)
+	var registries []string
+	if config.NetworkServerEnabled && dev.NetworkServerAddress != "" && !nsMismatch {
+		registries = append(registries, networkServerRegistry)
+	}
+	if config.ApplicationServerEnabled && dev.ApplicationServerAddress != "" && !asMismatch {
+		registries = append(registries, applicationServerRegistry)
+	}
+	if config.JoinServerEnabled && dev.JoinServerAddress != "" && !jsMismatch &&
+		dev.JoinEUI != nil && dev.DevEUI != nil {
+		registries = append(registries, joinServerRegistry)
+	}
+	return registries
+}
+
+// checkEndDevice checks the end device that is registered in the Identity Server.
+// It returns nil if the end device is registered in all expected registries.
+func checkEndDevice(dev *ttnpb.EndDevice, repair, clean bool) (*endDeviceInconsistency, error) {
+	res := &endDeviceInconsistency{
+		IDs:       dev.EndDeviceIdentifiers,
+		PresentIn: []string{identityServerRegistry},
+	}
+	for _, registry := range expectedEndDeviceRegistries(dev) {
+		ok, err := checkEndDeviceRegistry(registry, dev.EndDeviceIdentifiers)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res.PresentIn = append(res.PresentIn, registry)
+		} else {
+			res.MissingIn = append(res.MissingIn, registry)
+		}
+	}
+	if len(res.MissingIn) == 0 {
+		return nil, nil
+	}
+	logger := logger.WithFields(log.Fields(
+		"device_id", dev.DeviceID,
+		"missing_in", res.MissingIn,
+	))
+	switch {
+	case repair && len(res.MissingIn) == 1 && res.MissingIn[0] == applicationServerRegistry:
+		// The Application Server does not store state that is required to create the end device, so it can be
+		// registered again. The state in the Network Server and Join Server can not be recovered.
+		as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Register end device on Application Server")
+		if _, err := ttnpb.NewAsEndDeviceRegistryClient(as).Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{EndDeviceIdentifiers: dev.EndDeviceIdentifiers},
+		}); err != nil {
+			return nil, err
+		}
+		res.Repaired = true
+	case clean:
+		logger.Info("Delete incomplete end device")
+		if err := deleteEndDevice(ctx, &dev.EndDeviceIdentifiers); err != nil {
+			return nil, err
+		}
+		res.Cleaned = true
+	}
+	return res, nil
+}
+
+// checkOrphanedEndDevice checks the end device that is not registered in the Identity Server.
+// It returns nil if the end device is not registered in any other registry either.
+func checkOrphanedEndDevice(ids ttnpb.EndDeviceIdentifiers, clean bool) (*endDeviceInconsistency, error) {
+	res := &endDeviceInconsistency{
+		IDs:       ids,
+		MissingIn: []string{identityServerRegistry},
+	}
+	var registries []string
+	if config.NetworkServerEnabled {
+		registries = append(registries, networkServerRegistry)
+	}
+	if config.ApplicationServerEnabled {
+		registries = append(registries, applicationServerRegistry)
+	}
+	if config.JoinServerEnabled && ids.JoinEUI != nil && ids.DevEUI != nil {
+		registries = append(registries, joinServerRegistry)
+	}
+	for _, registry := range registries {
+		ok, err := checkEndDeviceRegistry(registry, ids)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res.PresentIn = append(res.PresentIn, registry)
+		}
+	}
+	if len(res.PresentIn) == 0 {
+		return nil, nil
+	}
+	if !clean {
+		return res, nil
+	}
+	for _, registry := range res.PresentIn {
+		logger.WithField("registry", registry).Info("Delete orphaned end device")
+		if err := deleteEndDeviceFromRegistry(registry, &ids); err != nil {
+			return nil, err
+		}
+	}
+	res.Cleaned = true
+	return res, nil
+}
+
+var endDevicesCheckCommand = &cobra.Command{
+	Use:   "check [application-id] [device-id]",
+	Short: "Check the consistency of end devices across registries",
+	Long: `Check the consistency of end devices across registries
+
+If a device ID is given, the end device is checked in all registries. The
+JoinEUI and DevEUI are needed to check an end device that is not registered
+in the Identity Server in the Join Server.
+
+Otherwise, all end devices of the application in the Identity Server are
+checked in the Network Server, Application Server and Join Server that are
+registered for them.
+
+With --repair, end devices that are only missing in the Application Server are
+registered there again. With --clean, end devices that are missing in any
+registry are deleted from all registries, so that they can be created again.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		forwardDeprecatedDeviceFlags(cmd.Flags())
+
+		repair, _ := cmd.Flags().GetBool("repair")
+		clean, _ := cmd.Flags().GetBool("clean")
+
+		var devID *ttnpb.EndDeviceIdentifiers
+		if len(args) == 1 {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID
+			}
+			devID = &ttnpb.EndDeviceIdentifiers{ApplicationIdentifiers: *appID}
+		} else {
+			var err error
+			devID, err = getEndDeviceID(cmd.Flags(), args, false)
+			if err != nil {
+				return err
+			}
+			if devID.ApplicationID == "" {
+				return errNoApplicationID
+			}
+		}
+
+		is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		paths := []string{
+			"network_server_address",
+			"application_server_address",
+			"join_server_address",
+		}
+
+		res := []*endDeviceInconsistency{}
+		if devID.DeviceID != "" {
+			dev, err := ttnpb.NewEndDeviceRegistryClient(is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+				EndDeviceIdentifiers: *devID,
+				FieldMask:            pbtypes.FieldMask{Paths: paths},
+			})
+			var inconsistency *endDeviceInconsistency
+			switch {
+			case errors.IsNotFound(err):
+				inconsistency, err = checkOrphanedEndDevice(*devID, clean)
+			case err == nil:
+				inconsistency, err = checkEndDevice(dev, repair, clean)
+			}
+			if err != nil {
+				return err
+			}
+			if inconsistency != nil {
+				res = append(res, inconsistency)
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		}
+
+		var devs []*ttnpb.EndDevice
+		for page := uint32(1); ; page++ {
+			list, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+				ApplicationIdentifiers: devID.ApplicationIdentifiers,
+				FieldMask:              pbtypes.FieldMask{Paths: paths},
+				Limit:                  endDeviceCheckPageSize,
+				Page:                   page,
+			})
+			if err != nil {
+				return err
+			}
+			devs = append(devs, list.EndDevices...)
+			if len(list.EndDevices) < endDeviceCheckPageSize {
+				break
+			}
+		}
+		logger.WithField("count", len(devs)).Info("Check end devices")
+		for _, dev := range devs {
+			inconsistency, err := checkEndDevice(dev, repair, clean)
+			if err != nil {
+				return err
+			}
+			if inconsistency != nil {
+				res = append(res, inconsistency)
+			}
+		}
+		return io.Write(os.Stdout, config.OutputFormat, res)
+	},
+}
+
+func init() {
+	endDevicesCheckCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	endDevicesCheckCommand.Flags().Bool("repair", false, "register end devices that are only missing in the Application Server")
+	endDevicesCheckCommand.Flags().Bool("clean", false, "delete end devices that are missing in any registry")
+	endDevicesCommand.AddCommand(endDevicesCheckCommand)
+}
@@ -25,6 +25,8 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
+var errNoCorrelationID = errors.DefineInvalidArgument("no_correlation_id", "no correlation ID set")
+
 var eventsCommand = &cobra.Command{
 	Use:     "events",
 	Aliases: []string{"event", "evt", "e"},
@@ -96,8 +98,32 @@ var eventsCommand = &cobra.Command{
 	},
 }
 
+var eventsFindRelatedCommand = &cobra.Command{
+	Use:   "find-related",
+	Short: "Find events related to a message by correlation ID",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		correlationID, _ := cmd.Flags().GetString("correlation-id")
+		if correlationID == "" {
+			return errNoCorrelationID
+		}
+		conn, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		res, err := ttnpb.NewEventsClient(conn).FindRelated(ctx, &ttnpb.FindRelatedEventsRequest{
+			CorrelationID: correlationID,
+		})
+		if err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, res.Events)
+	},
+}
+
 func init() {
 	eventsCommand.Flags().AddFlagSet(combinedIdentifiersFlags())
 	eventsCommand.Flags().Uint32("tail", 0, "")
+	eventsFindRelatedCommand.Flags().String("correlation-id", "", "")
+	eventsCommand.AddCommand(eventsFindRelatedCommand)
 	Root.AddCommand(eventsCommand)
 }
@@ -0,0 +1,393 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/cmd/ttn-lw-cli/internal/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errNoCampaignFile = errors.DefineInvalidArgument("no_campaign_file", "no campaign file set")
+	errCampaignExists = errors.DefineAlreadyExists("campaign_exists", "campaign file `{file}` already exists")
+	errReadCampaign   = errors.Define("read_campaign", "read campaign file `{file}`")
+	errWriteCampaign  = errors.Define("write_campaign", "write campaign file `{file}`")
+)
+
+// The states of gateways in an API key rotation campaign.
+const (
+	rotationPending    = "pending"
+	rotationRotated    = "rotated"
+	rotationFinished   = "finished"
+	rotationRolledBack = "rolled_back"
+	rotationFailed     = "failed"
+)
+
+// apiKeyRotationCampaign is the progress of rotating the API keys of gateways. It is stored in the campaign file after
+// every change, so that campaigns can be continued in batches and rolled back.
+//
+// The values of the new API keys are never stored in the campaign file.
+type apiKeyRotationCampaign struct {
+	Name      string                `json:"name"`
+	Rights    []ttnpb.Right         `json:"rights,omitempty"`
+	CreatedAt time.Time             `json:"created_at"`
+	Gateways  []*apiKeyRotationItem `json:"gateways"`
+
+	file string
+}
+
+type apiKeyRotationItem struct {
+	GatewayID    string    `json:"gateway_id"`
+	State        string    `json:"state"`
+	OldAPIKeyIDs []string  `json:"old_api_key_ids,omitempty"`
+	NewAPIKeyID  string    `json:"new_api_key_id,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// apiKeyRotationResult is the result of rotating the API key of a gateway.
+type apiKeyRotationResult struct {
+	GatewayID string `json:"gateway_id"`
+	APIKeyID  string `json:"api_key_id"`
+	APIKey    string `json:"api_key"`
+}
+
+// apiKeyRotationStatus is the number of gateways by state of a campaign.
+type apiKeyRotationStatus struct {
+	Name   string         `json:"name"`
+	States map[string]int `json:"states"`
+}
+
+func readAPIKeyRotationCampaign(file string) (*apiKeyRotationCampaign, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, errReadCampaign.WithCause(err).WithAttributes("file", file)
+	}
+	campaign := &apiKeyRotationCampaign{file: file}
+	if err := json.Unmarshal(b, campaign); err != nil {
+		return nil, errReadCampaign.WithCause(err).WithAttributes("file", file)
+	}
+	return campaign, nil
+}
+
+// save writes the campaign to a temporary file and renames it, so that the campaign file is never partially written.
+func (c *apiKeyRotationCampaign) save() error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return errWriteCampaign.WithCause(err).WithAttributes("file", c.file)
+	}
+	tmp := c.file + ".tmp"
+	if err := ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return errWriteCampaign.WithCause(err).WithAttributes("file", c.file)
+	}
+	if err := os.Rename(tmp, c.file); err != nil {
+		return errWriteCampaign.WithCause(err).WithAttributes("file", c.file)
+	}
+	return nil
+}
+
+func (c *apiKeyRotationCampaign) status() apiKeyRotationStatus {
+	status := apiKeyRotationStatus{
+		Name:   c.Name,
+		States: make(map[string]int),
+	}
+	for _, item := range c.Gateways {
+		status.States[item.State]++
+	}
+	return status
+}
+
+func (c *apiKeyRotationCampaign) update(item *apiKeyRotationItem, state string, err error) error {
+	item.State = state
+	item.Error = ""
+	if err != nil {
+		item.Error = err.Error()
+	}
+	item.UpdatedAt = time.Now().UTC()
+	return c.save()
+}
+
+// rotate creates a new API key for at most batchSize pending gateways. The existing API keys with the name of the
+// campaign (or all existing API keys if the campaign has no name) are recorded as old API keys, and are only deleted
+// when the campaign is finished.
+func (c *apiKeyRotationCampaign) rotate(cl ttnpb.GatewayAccessClient, batchSize int) ([]apiKeyRotationResult, error) {
+	var results []apiKeyRotationResult
+	for _, item := range c.Gateways {
+		if batchSize > 0 && len(results) == batchSize {
+			break
+		}
+		if item.State != rotationPending && item.State != rotationFailed {
+			continue
+		}
+		ids := ttnpb.GatewayIdentifiers{GatewayID: item.GatewayID}
+		keys, err := cl.ListAPIKeys(ctx, &ttnpb.ListGatewayAPIKeysRequest{GatewayIdentifiers: ids})
+		if err != nil {
+			logger.WithError(err).WithField("gateway_id", item.GatewayID).Warn("Failed to list API keys")
+			if err := c.update(item, rotationFailed, err); err != nil {
+				return results, err
+			}
+			continue
+		}
+		item.OldAPIKeyIDs = item.OldAPIKeyIDs[:0]
+		rights := ttnpb.RightsFrom(c.Rights...)
+		for _, key := range keys.APIKeys {
+			if c.Name != "" && key.Name != c.Name {
+				continue
+			}
+			item.OldAPIKeyIDs = append(item.OldAPIKeyIDs, key.ID)
+			if len(c.Rights) == 0 {
+				rights = rights.Union(ttnpb.RightsFrom(key.Rights...))
+			}
+		}
+		if len(rights.Rights) == 0 {
+			logger.WithField("gateway_id", item.GatewayID).Warn("No API key rights to rotate")
+			if err := c.update(item, rotationFailed, errNoAPIKeyRights); err != nil {
+				return results, err
+			}
+			continue
+		}
+		key, err := cl.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{
+			GatewayIdentifiers: ids,
+			Name:               c.Name,
+			Rights:             rights.Sorted().Rights,
+		})
+		if err != nil {
+			logger.WithError(err).WithField("gateway_id", item.GatewayID).Warn("Failed to create API key")
+			if err := c.update(item, rotationFailed, err); err != nil {
+				return results, err
+			}
+			continue
+		}
+		item.NewAPIKeyID = key.ID
+		if err := c.update(item, rotationRotated, nil); err != nil {
+			return results, err
+		}
+		results = append(results, apiKeyRotationResult{
+			GatewayID: item.GatewayID,
+			APIKeyID:  key.ID,
+			APIKey:    key.Key,
+		})
+	}
+	return results, nil
+}
+
+// deleteAPIKeys deletes the API keys returned by keyIDs of the rotated gateways, and moves them to the given state.
+func (c *apiKeyRotationCampaign) deleteAPIKeys(cl ttnpb.GatewayAccessClient, state string, keyIDs func(*apiKeyRotationItem) []string) error {
+	for _, item := range c.Gateways {
+		if item.State != rotationRotated {
+			continue
+		}
+		var err error
+		for _, id := range keyIDs(item) {
+			if _, err = cl.UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: item.GatewayID},
+				APIKey:             ttnpb.APIKey{ID: id},
+			}); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			logger.WithError(err).WithField("gateway_id", item.GatewayID).Warn("Failed to delete API key")
+			item.Error = err.Error()
+			item.UpdatedAt = time.Now().UTC()
+			if err := c.save(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.update(item, state, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getCampaignFile(flagSet *pflag.FlagSet) (string, error) {
+	file, _ := flagSet.GetString("campaign-file")
+	if file == "" {
+		return "", errNoCampaignFile
+	}
+	return file, nil
+}
+
+func rotateGatewayAPIKeys(campaign *apiKeyRotationCampaign, flagSet *pflag.FlagSet) error {
+	batchSize, _ := flagSet.GetInt("batch-size")
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	results, err := campaign.rotate(ttnpb.NewGatewayAccessClient(is), batchSize)
+	if len(results) > 0 {
+		logger.Warn("The API key values will never be shown again")
+		logger.Warn("Make sure to copy them to a safe place")
+		if err := io.Write(os.Stdout, config.OutputFormat, results); err != nil {
+			return err
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return io.Write(os.Stdout, config.OutputFormat, campaign.status())
+}
+
+var (
+	gatewayAPIKeysRotation = &cobra.Command{
+		Use:   "rotation",
+		Short: "Rotate API keys of gateways in batches",
+		Long: `Rotate API keys of gateways in batches
+
+A rotation campaign creates a new API key for each gateway, and keeps the old
+API keys until the campaign is finished. The progress is stored in the campaign
+file, so that the campaign can be continued in batches and rolled back.`,
+	}
+	gatewayAPIKeysRotationStart = &cobra.Command{
+		Use:   "start [gateway-id]...",
+		Short: "Start rotating API keys of gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return errNoGatewayID
+			}
+			file, err := getCampaignFile(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(file); err == nil {
+				return errCampaignExists.WithAttributes("file", file)
+			}
+			name, _ := cmd.Flags().GetString("name")
+			campaign := &apiKeyRotationCampaign{
+				Name:      name,
+				Rights:    getRights(cmd.Flags()),
+				CreatedAt: time.Now().UTC(),
+				file:      file,
+			}
+			for _, gtwID := range args {
+				campaign.Gateways = append(campaign.Gateways, &apiKeyRotationItem{
+					GatewayID: gtwID,
+					State:     rotationPending,
+					UpdatedAt: campaign.CreatedAt,
+				})
+			}
+			if err := campaign.save(); err != nil {
+				return err
+			}
+			return rotateGatewayAPIKeys(campaign, cmd.Flags())
+		},
+	}
+	gatewayAPIKeysRotationContinue = &cobra.Command{
+		Use:   "continue",
+		Short: "Rotate API keys of the next batch of gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := getCampaignFile(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			campaign, err := readAPIKeyRotationCampaign(file)
+			if err != nil {
+				return err
+			}
+			return rotateGatewayAPIKeys(campaign, cmd.Flags())
+		},
+	}
+	gatewayAPIKeysRotationStatus = &cobra.Command{
+		Use:   "status",
+		Short: "Show the progress of rotating API keys of gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := getCampaignFile(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			campaign, err := readAPIKeyRotationCampaign(file)
+			if err != nil {
+				return err
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, campaign.Gateways); err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, campaign.status())
+		},
+	}
+	gatewayAPIKeysRotationFinish = &cobra.Command{
+		Use:   "finish",
+		Short: "Delete the old API keys of rotated gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := getCampaignFile(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			campaign, err := readAPIKeyRotationCampaign(file)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			if err := campaign.deleteAPIKeys(ttnpb.NewGatewayAccessClient(is), rotationFinished, func(item *apiKeyRotationItem) []string {
+				return item.OldAPIKeyIDs
+			}); err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, campaign.status())
+		},
+	}
+	gatewayAPIKeysRotationRollback = &cobra.Command{
+		Use:   "rollback",
+		Short: "Delete the new API keys of rotated gateways",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := getCampaignFile(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			campaign, err := readAPIKeyRotationCampaign(file)
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			if err := campaign.deleteAPIKeys(ttnpb.NewGatewayAccessClient(is), rotationRolledBack, func(item *apiKeyRotationItem) []string {
+				return []string{item.NewAPIKeyID}
+			}); err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, campaign.status())
+		},
+	}
+)
+
+func init() {
+	gatewayAPIKeysRotationStart.Flags().String("name", "", "name of the API keys to rotate")
+	gatewayAPIKeysRotationStart.Flags().Int("batch-size", 0, "number of gateways to rotate (0 is all)")
+	gatewayAPIKeysRotationStart.Flags().AddFlagSet(gatewayRightsFlags)
+	gatewayAPIKeysRotation.AddCommand(gatewayAPIKeysRotationStart)
+	gatewayAPIKeysRotationContinue.Flags().Int("batch-size", 0, "number of gateways to rotate (0 is all)")
+	gatewayAPIKeysRotation.AddCommand(gatewayAPIKeysRotationContinue)
+	gatewayAPIKeysRotation.AddCommand(gatewayAPIKeysRotationStatus)
+	gatewayAPIKeysRotation.AddCommand(gatewayAPIKeysRotationFinish)
+	gatewayAPIKeysRotation.AddCommand(gatewayAPIKeysRotationRollback)
+	gatewayAPIKeysRotation.PersistentFlags().String("campaign-file", "", "file to store the progress of the campaign")
+	gatewayAPIKeys.AddCommand(gatewayAPIKeysRotation)
+}
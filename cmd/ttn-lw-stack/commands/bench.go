@@ -0,0 +1,706 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// benchCorrelationIDPrefix is the prefix of the correlation IDs of the messages sent by the benchmark.
+const benchCorrelationIDPrefix = "bench:"
+
+var (
+	errNoFrequencyPlans = errors.DefineFailedPrecondition("no_frequency_plans", "no frequency plans configured")
+	errNoBenchDevices   = errors.DefineInvalidArgument("no_bench_devices", "number of end devices must be positive")
+)
+
+// benchRunner drives the benchmark.
+type benchRunner struct {
+	appIDs  ttnpb.ApplicationIdentifiers
+	gtwIDs  ttnpb.GatewayIdentifiers
+	fpID    string
+	fp      *frequencyplans.FrequencyPlan
+	phy     band.Band
+	devices []*benchDevice
+	stats   *benchStats
+
+	fPort       uint32
+	payloadSize int
+
+	defaultAddress string
+	conns          map[string]*grpc.ClientConn
+	tlsConfig      *tls.Config
+	auth           grpc.CallOption
+	gatewayAuth    grpc.CallOption
+
+	linkMu sync.Mutex
+	link   ttnpb.GtwGs_LinkGatewayClient
+}
+
+// address returns the given cluster address, or the default address if it is empty.
+func (r *benchRunner) address(clusterAddress string) string {
+	if clusterAddress != "" {
+		return clusterAddress
+	}
+	return r.defaultAddress
+}
+
+// dial returns a connection to the given address. Connections are shared between components on the same address.
+func (r *benchRunner) dial(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	if conn, ok := r.conns[address]; ok {
+		return conn, nil
+	}
+	opts := rpcclient.DefaultDialOptions(ctx)
+	if r.tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(r.tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.DialContext(ctx, address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	r.conns[address] = conn
+	return conn, nil
+}
+
+func (r *benchRunner) close() {
+	for _, conn := range r.conns {
+		conn.Close()
+	}
+}
+
+func randomIntn(n int) int {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(err)
+	}
+	return int(i.Int64())
+}
+
+func randomBytes(n int) []byte {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// newBenchDevices returns the given number of end devices with random root keys and DevEUIs derived from the prefix.
+func newBenchDevices(appIDs ttnpb.ApplicationIdentifiers, joinEUI, devEUIPrefix types.EUI64, count int) []*benchDevice {
+	devices := make([]*benchDevice, count)
+	for i := range devices {
+		joinEUI, devEUI := joinEUI, devEUIPrefix
+		for j := 0; j < 4; j++ {
+			devEUI[7-j] |= byte(i >> (8 * uint(j)))
+		}
+		dev := &benchDevice{
+			ids: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appIDs,
+				DeviceID:               fmt.Sprintf("bench-%s", strings.ToLower(devEUI.String())),
+				JoinEUI:                &joinEUI,
+				DevEUI:                 &devEUI,
+			},
+			devNonce: uint16(randomIntn(1 << 16)),
+		}
+		copy(dev.appKey[:], randomBytes(16))
+		devices[i] = dev
+	}
+	return devices
+}
+
+// provision creates the end device in the Identity Server and sets it in the Join Server, Network Server and
+// Application Server.
+func (r *benchRunner) provision(ctx context.Context, dev *benchDevice) error {
+	isConn, err := r.dial(ctx, r.address(config.Cluster.IdentityServer))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewEndDeviceRegistryClient(isConn).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers: dev.ids,
+		},
+	}, r.auth); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	jsConn, err := r.dial(ctx, r.address(config.Cluster.JoinServer))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewJsEndDeviceRegistryClient(jsConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers: dev.ids,
+			RootKeys: &ttnpb.RootKeys{
+				AppKey: &ttnpb.KeyEnvelope{Key: &dev.appKey},
+			},
+		},
+		FieldMask: pbtypes.FieldMask{Paths: []string{"root_keys.app_key.key"}},
+	}, r.auth); err != nil {
+		return err
+	}
+	nsConn, err := r.dial(ctx, r.address(config.Cluster.NetworkServer))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewNsEndDeviceRegistryClient(nsConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers: dev.ids,
+			FrequencyPlanID:      r.fpID,
+			LoRaWANVersion:       ttnpb.MAC_V1_0_3,
+			LoRaWANPHYVersion:    ttnpb.PHY_V1_0_3_REV_A,
+			SupportsJoin:         true,
+		},
+		FieldMask: pbtypes.FieldMask{Paths: []string{
+			"frequency_plan_id",
+			"lorawan_phy_version",
+			"lorawan_version",
+			"supports_join",
+		}},
+	}, r.auth); err != nil {
+		return err
+	}
+	asConn, err := r.dial(ctx, r.address(config.Cluster.ApplicationServer))
+	if err != nil {
+		return err
+	}
+	if _, err := ttnpb.NewAsEndDeviceRegistryClient(asConn).Set(ctx, &ttnpb.SetEndDeviceRequest{
+		EndDevice: ttnpb.EndDevice{
+			EndDeviceIdentifiers: dev.ids,
+		},
+	}, r.auth); err != nil {
+		return err
+	}
+	return nil
+}
+
+// deprovision deletes the end device from all components.
+func (r *benchRunner) deprovision(ctx context.Context, dev *benchDevice) error {
+	for _, del := range []struct {
+		address string
+		delete  func(*grpc.ClientConn) error
+	}{
+		{
+			address: config.Cluster.ApplicationServer,
+			delete: func(conn *grpc.ClientConn) error {
+				_, err := ttnpb.NewAsEndDeviceRegistryClient(conn).Delete(ctx, &dev.ids, r.auth)
+				return err
+			},
+		},
+		{
+			address: config.Cluster.NetworkServer,
+			delete: func(conn *grpc.ClientConn) error {
+				_, err := ttnpb.NewNsEndDeviceRegistryClient(conn).Delete(ctx, &dev.ids, r.auth)
+				return err
+			},
+		},
+		{
+			address: config.Cluster.JoinServer,
+			delete: func(conn *grpc.ClientConn) error {
+				_, err := ttnpb.NewJsEndDeviceRegistryClient(conn).Delete(ctx, &dev.ids, r.auth)
+				return err
+			},
+		},
+		{
+			address: config.Cluster.IdentityServer,
+			delete: func(conn *grpc.ClientConn) error {
+				_, err := ttnpb.NewEndDeviceRegistryClient(conn).Delete(ctx, &dev.ids, r.auth)
+				return err
+			},
+		},
+	} {
+		conn, err := r.dial(ctx, r.address(del.address))
+		if err != nil {
+			return err
+		}
+		if err := del.delete(conn); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamEvents records the hops of the operations in flight from the events of the application and the gateway.
+func (r *benchRunner) streamEvents(ctx context.Context) error {
+	conn, err := r.dial(ctx, r.address(config.Cluster.IdentityServer))
+	if err != nil {
+		return err
+	}
+	stream, err := ttnpb.NewEventsClient(conn).Stream(ctx, &ttnpb.StreamEventsRequest{
+		Identifiers: []*ttnpb.EntityIdentifiers{
+			r.appIDs.EntityIdentifiers(),
+			r.gtwIDs.EntityIdentifiers(),
+		},
+	}, r.auth)
+	if err != nil {
+		return err
+	}
+	for {
+		evt, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		for _, cid := range evt.CorrelationIDs {
+			if strings.HasPrefix(cid, benchCorrelationIDPrefix) {
+				r.stats.Hop(cid, evt.Name, evt.Time)
+			}
+		}
+	}
+}
+
+// linkGateway links the simulated gateway to the Gateway Server and handles the downlink messages.
+func (r *benchRunner) linkGateway(ctx context.Context) (<-chan error, error) {
+	conn, err := r.dial(ctx, r.address(config.Cluster.GatewayServer))
+	if err != nil {
+		return nil, err
+	}
+	linkCtx := rpcmetadata.MD{ID: r.gtwIDs.GatewayID}.ToOutgoingContext(ctx)
+	link, err := ttnpb.NewGtwGsClient(conn).LinkGateway(linkCtx, r.gatewayAuth)
+	if err != nil {
+		return nil, err
+	}
+	// Send an empty message to start the stream.
+	if err := link.Send(&ttnpb.GatewayUp{}); err != nil {
+		return nil, err
+	}
+	r.link = link
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			down, err := link.Recv()
+			if err != nil {
+				errCh <- err
+				return
+			}
+			r.handleDownlink(ctx, down.DownlinkMessage, time.Now())
+		}
+	}()
+	return errCh, nil
+}
+
+func (r *benchRunner) handleDownlink(ctx context.Context, down *ttnpb.DownlinkMessage, at time.Time) {
+	if down == nil {
+		return
+	}
+	for _, cid := range down.CorrelationIDs {
+		if !strings.HasPrefix(cid, benchCorrelationIDPrefix+benchJoin.name+":") {
+			continue
+		}
+		dev, ok := r.stats.Device(cid)
+		if !ok {
+			continue
+		}
+		if err := dev.HandleJoinAccept(down.RawPayload); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_id", dev.ids.DeviceID).Warn("Failed to handle join-accept")
+			r.stats.Fail(cid)
+			continue
+		}
+		r.stats.Hop(cid, benchGatewayHop, at)
+	}
+}
+
+// sendUplink sends the given uplink message through the simulated gateway, on a random uplink channel of the
+// frequency plan at its highest data rate.
+func (r *benchRunner) sendUplink(op *benchOperation, dev *benchDevice, rawPayload []byte) error {
+	ch := r.fp.UplinkChannels[randomIntn(len(r.fp.UplinkChannels))]
+	now := time.Now()
+	timestamp := uint32(now.UnixNano() / int64(time.Microsecond))
+	cid := fmt.Sprintf("%s%s:%s", benchCorrelationIDPrefix, op.name, events.NewCorrelationID())
+	up := &ttnpb.UplinkMessage{
+		RawPayload: rawPayload,
+		Settings: ttnpb.TxSettings{
+			DataRate:      r.phy.DataRates[ch.MaxDataRate].Rate,
+			DataRateIndex: ttnpb.DataRateIndex(ch.MaxDataRate),
+			CodingRate:    "4/5",
+			Frequency:     ch.Frequency,
+			Timestamp:     timestamp,
+			Time:          &now,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: r.gtwIDs,
+				Time:               &now,
+				Timestamp:          timestamp,
+				RSSI:               -42,
+				SNR:                5,
+			},
+		},
+		CorrelationIDs: []string{cid},
+	}
+	r.stats.Start(cid, op, dev)
+	r.linkMu.Lock()
+	err := r.link.Send(&ttnpb.GatewayUp{UplinkMessages: []*ttnpb.UplinkMessage{up}})
+	r.linkMu.Unlock()
+	if err != nil {
+		r.stats.Fail(cid)
+		return err
+	}
+	return nil
+}
+
+// join sends a join-request of the given end device.
+func (r *benchRunner) join(dev *benchDevice) error {
+	rawPayload, err := dev.JoinRequest()
+	if err != nil {
+		return err
+	}
+	return r.sendUplink(benchJoin, dev, rawPayload)
+}
+
+// uplink sends a data uplink of the given end device with a random application payload.
+func (r *benchRunner) uplink(dev *benchDevice) error {
+	rawPayload, err := dev.DataUplink(r.fPort, randomBytes(r.payloadSize))
+	if err != nil {
+		return err
+	}
+	return r.sendUplink(benchUplink, dev, rawPayload)
+}
+
+// downlink pushes a downlink message with a random application payload to the queue of the given end device.
+func (r *benchRunner) downlink(ctx context.Context, dev *benchDevice) error {
+	conn, err := r.dial(ctx, r.address(config.Cluster.ApplicationServer))
+	if err != nil {
+		return err
+	}
+	cid := fmt.Sprintf("%s%s:%s", benchCorrelationIDPrefix, benchDownlink.name, events.NewCorrelationID())
+	r.stats.Start(cid, benchDownlink, dev)
+	if _, err := ttnpb.NewAppAsClient(conn).DownlinkQueuePush(ctx, &ttnpb.DownlinkQueueRequest{
+		EndDeviceIdentifiers: dev.ids,
+		Downlinks: []*ttnpb.ApplicationDownlink{
+			{
+				FPort:          r.fPort,
+				FRMPayload:     randomBytes(r.payloadSize),
+				CorrelationIDs: []string{cid},
+			},
+		},
+	}, r.auth); err != nil {
+		r.stats.Fail(cid)
+		return err
+	}
+	r.stats.Hop(cid, benchRPCHop, time.Now())
+	return nil
+}
+
+// randomJoinedDevice returns a random end device that is joined.
+func (r *benchRunner) randomJoinedDevice() (*benchDevice, bool) {
+	joined := make([]*benchDevice, 0, len(r.devices))
+	for _, dev := range r.devices {
+		if dev.isJoined() {
+			joined = append(joined, dev)
+		}
+	}
+	if len(joined) == 0 {
+		return nil, false
+	}
+	return joined[randomIntn(len(joined))], true
+}
+
+// drive calls f at the given rate per second until the context is done.
+func drive(ctx context.Context, rate float64, f func() error) {
+	if rate <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			go func() {
+				if err := f(); err != nil {
+					log.FromContext(ctx).WithError(err).Debug("Operation failed")
+				}
+			}()
+		}
+	}
+}
+
+func benchTLSConfig() (*tls.Config, error) {
+	res := &tls.Config{
+		InsecureSkipVerify: config.TLS.InsecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+	if config.TLS.RootCA != "" {
+		pem, err := ioutil.ReadFile(config.TLS.RootCA)
+		if err != nil {
+			return nil, err
+		}
+		res.RootCAs = x509.NewCertPool()
+		res.RootCAs.AppendCertsFromPEM(pem)
+	}
+	return res, nil
+}
+
+var (
+	benchCommand = &cobra.Command{
+		Use:   "bench",
+		Short: "Benchmark a cluster with simulated end devices (EXPERIMENTAL)",
+		Long: `Benchmark a cluster with simulated end devices (EXPERIMENTAL)
+
+The benchmark provisions LoRaWAN 1.0.3 end devices in the given application and
+drives joins and data uplinks through the given gateway, and pushes downlink
+messages to the queues of joined end devices, at the configured rates. When the
+benchmark completes, the error rate of each operation and the latency per hop
+are reported. Latencies per hop are derived from the events of the application
+and the gateway; the first and last hop are measured with the local clock.
+
+The API key needs rights to link the gateway, to manage the end devices of the
+application and to read the events and traffic of the application and gateway.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID, _ := cmd.Flags().GetString("application-id")
+			if appID == "" {
+				return errMissingFlag.WithAttributes("flag", "application-id")
+			}
+			gtwID, _ := cmd.Flags().GetString("gateway-id")
+			if gtwID == "" {
+				return errMissingFlag.WithAttributes("flag", "gateway-id")
+			}
+			apiKey, _ := cmd.Flags().GetString("api-key")
+			if apiKey == "" {
+				return errMissingFlag.WithAttributes("flag", "api-key")
+			}
+			gatewayAPIKey, _ := cmd.Flags().GetString("gateway-api-key")
+			if gatewayAPIKey == "" {
+				gatewayAPIKey = apiKey
+			}
+			var joinEUI, devEUIPrefix types.EUI64
+			s, _ := cmd.Flags().GetString("join-eui")
+			if err := joinEUI.UnmarshalText([]byte(s)); err != nil {
+				return err
+			}
+			s, _ = cmd.Flags().GetString("dev-eui-prefix")
+			if err := devEUIPrefix.UnmarshalText([]byte(s)); err != nil {
+				return err
+			}
+			deviceCount, _ := cmd.Flags().GetInt("devices")
+			if deviceCount <= 0 {
+				return errNoBenchDevices
+			}
+			duration, _ := cmd.Flags().GetDuration("duration")
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+			joinRate, _ := cmd.Flags().GetFloat64("join-rate")
+			uplinkRate, _ := cmd.Flags().GetFloat64("uplink-rate")
+			downlinkRate, _ := cmd.Flags().GetFloat64("downlink-rate")
+			fPort, _ := cmd.Flags().GetUint32("f-port")
+			payloadSize, _ := cmd.Flags().GetInt("payload-size")
+			address, _ := cmd.Flags().GetString("address")
+			useTLS, _ := cmd.Flags().GetBool("tls")
+			cleanup, _ := cmd.Flags().GetBool("cleanup")
+
+			fpID, _ := cmd.Flags().GetString("frequency-plan-id")
+			fetcher, err := config.FrequencyPlansFetcher(ctx)
+			if err != nil {
+				return err
+			}
+			if fetcher == nil {
+				return errNoFrequencyPlans
+			}
+			fp, err := frequencyplans.NewStore(fetcher).GetByID(fpID)
+			if err != nil {
+				return err
+			}
+			phy, err := band.GetByID(fp.BandID)
+			if err != nil {
+				return err
+			}
+
+			appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: appID}
+			r := &benchRunner{
+				appIDs:         appIDs,
+				gtwIDs:         ttnpb.GatewayIdentifiers{GatewayID: gtwID},
+				fpID:           fpID,
+				fp:             fp,
+				phy:            phy,
+				devices:        newBenchDevices(appIDs, joinEUI, devEUIPrefix, deviceCount),
+				stats:          newBenchStats(),
+				fPort:          fPort,
+				payloadSize:    payloadSize,
+				defaultAddress: address,
+				conns:          make(map[string]*grpc.ClientConn),
+				auth: grpc.PerRPCCredentials(rpcmetadata.MD{
+					AuthType:      "Bearer",
+					AuthValue:     apiKey,
+					AllowInsecure: !useTLS,
+				}),
+				gatewayAuth: grpc.PerRPCCredentials(rpcmetadata.MD{
+					AuthType:      "Bearer",
+					AuthValue:     gatewayAPIKey,
+					AllowInsecure: !useTLS,
+				}),
+			}
+			if useTLS {
+				if r.tlsConfig, err = benchTLSConfig(); err != nil {
+					return err
+				}
+			}
+			defer r.close()
+
+			logger.WithField("count", len(r.devices)).Info("Provisioning end devices...")
+			for _, dev := range r.devices {
+				if err := r.provision(ctx, dev); err != nil {
+					return err
+				}
+			}
+			if cleanup {
+				defer func() {
+					logger.Info("Deleting end devices...")
+					for _, dev := range r.devices {
+						if err := r.deprovision(ctx, dev); err != nil {
+							logger.WithError(err).WithField("device_id", dev.ids.DeviceID).Warn("Failed to delete end device")
+						}
+					}
+				}()
+			}
+
+			benchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			go func() {
+				if err := r.streamEvents(benchCtx); err != nil && benchCtx.Err() == nil {
+					logger.WithError(err).Warn("Failed to stream events, latencies per hop are not available")
+				}
+			}()
+			linkErrCh, err := r.linkGateway(benchCtx)
+			if err != nil {
+				return err
+			}
+
+			logger.WithFields(log.Fields(
+				"duration", duration,
+				"join_rate", joinRate,
+				"uplink_rate", uplinkRate,
+				"downlink_rate", downlinkRate,
+			)).Info("Running benchmark...")
+			driveCtx, stopDrive := context.WithTimeout(benchCtx, duration)
+			defer stopDrive()
+			var nextJoin int
+			var nextJoinMu sync.Mutex
+			var wg sync.WaitGroup
+			for _, d := range []struct {
+				rate float64
+				f    func() error
+			}{
+				{
+					rate: joinRate,
+					f: func() error {
+						// Join the end devices in turn, so that all end devices join before they rejoin.
+						nextJoinMu.Lock()
+						dev := r.devices[nextJoin%len(r.devices)]
+						nextJoin++
+						nextJoinMu.Unlock()
+						return r.join(dev)
+					},
+				},
+				{
+					rate: uplinkRate,
+					f: func() error {
+						dev, ok := r.randomJoinedDevice()
+						if !ok {
+							return nil
+						}
+						return r.uplink(dev)
+					},
+				},
+				{
+					rate: downlinkRate,
+					f: func() error {
+						dev, ok := r.randomJoinedDevice()
+						if !ok {
+							return nil
+						}
+						return r.downlink(benchCtx, dev)
+					},
+				},
+			} {
+				d := d
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					drive(driveCtx, d.rate, d.f)
+				}()
+			}
+
+			ticker := time.NewTicker(5 * time.Second)
+			defer ticker.Stop()
+		loop:
+			for {
+				select {
+				case <-driveCtx.Done():
+					break loop
+				case err := <-linkErrCh:
+					return err
+				case <-ticker.C:
+					r.stats.Expire(timeout)
+					logger.Infof("Completed %s", r.stats)
+				}
+			}
+			wg.Wait()
+
+			logger.WithField("timeout", timeout).Info("Waiting for operations in flight...")
+			select {
+			case <-time.After(timeout):
+			case err := <-linkErrCh:
+				return err
+			}
+			r.stats.Expire(0)
+			return r.stats.Report(os.Stdout)
+		},
+	}
+)
+
+func init() {
+	benchCommand.Flags().String("address", "localhost:1884", "gRPC address of the components that have no cluster address configured")
+	benchCommand.Flags().Bool("tls", false, "Use TLS for the gRPC connections")
+	benchCommand.Flags().String("application-id", "", "Application ID of the end devices")
+	benchCommand.Flags().String("gateway-id", "", "Gateway ID of the simulated gateway")
+	benchCommand.Flags().String("api-key", "", "API key with rights on the application and the gateway")
+	benchCommand.Flags().String("gateway-api-key", "", "API key to link the gateway (default api-key)")
+	benchCommand.Flags().String("frequency-plan-id", "EU_863_870", "Frequency plan ID of the end devices")
+	benchCommand.Flags().String("join-eui", "0000000000000000", "JoinEUI of the end devices")
+	benchCommand.Flags().String("dev-eui-prefix", "70B3D57ED0000000", "DevEUI prefix of the end devices; the last 32 bits are combined with the end device index")
+	benchCommand.Flags().Int("devices", 10, "Number of end devices")
+	benchCommand.Flags().Duration("duration", time.Minute, "Duration of the benchmark")
+	benchCommand.Flags().Duration("timeout", 10*time.Second, "Time after which operations in flight are counted as timed out")
+	benchCommand.Flags().Float64("join-rate", 1, "Join-requests per second")
+	benchCommand.Flags().Float64("uplink-rate", 10, "Data uplinks per second")
+	benchCommand.Flags().Float64("downlink-rate", 1, "Downlink queue pushes per second")
+	benchCommand.Flags().Uint32("f-port", 1, "FPort of the data uplink and downlink messages")
+	benchCommand.Flags().Int("payload-size", 8, "Size of the application payload of the data uplink and downlink messages")
+	benchCommand.Flags().Bool("cleanup", true, "Delete the end devices when the benchmark completes")
+	Root.AddCommand(benchCommand)
+}
@@ -0,0 +1,160 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/crypto"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errNotJoinAccept = errors.DefineInvalidArgument("not_join_accept", "downlink message is not a join-accept")
+	errJoinAcceptMIC = errors.DefineInvalidArgument("join_accept_mic", "join-accept MIC mismatch")
+	errNotJoined     = errors.DefineFailedPrecondition("not_joined", "end device `{device_id}` is not joined")
+)
+
+// benchDevice is a simulated LoRaWAN 1.0.x end device.
+type benchDevice struct {
+	ids    ttnpb.EndDeviceIdentifiers
+	appKey types.AES128Key
+
+	mu       sync.Mutex
+	devNonce uint16
+	joined   bool
+	devAddr  types.DevAddr
+	nwkSKey  types.AES128Key
+	appSKey  types.AES128Key
+	fCnt     uint32
+}
+
+func (d *benchDevice) isJoined() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.joined
+}
+
+func (d *benchDevice) lastDevNonce() types.DevNonce {
+	return types.DevNonce{byte(d.devNonce >> 8), byte(d.devNonce)}
+}
+
+// JoinRequest returns the raw payload of a new join-request with the next DevNonce.
+func (d *benchDevice) JoinRequest() ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.devNonce++
+	msg := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEUI:  *d.ids.JoinEUI,
+				DevEUI:   *d.ids.DevEUI,
+				DevNonce: d.lastDevNonce(),
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(d.appKey, buf)
+	if err != nil {
+		return nil, err
+	}
+	return append(buf, mic[:]...), nil
+}
+
+// HandleJoinAccept decrypts the given join-accept and derives the session keys.
+func (d *benchDevice) HandleJoinAccept(rawPayload []byte) error {
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); err != nil {
+		return err
+	}
+	joinAccept := msg.GetJoinAcceptPayload()
+	if joinAccept == nil {
+		return errNotJoinAccept
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	payload, err := crypto.DecryptJoinAccept(d.appKey, joinAccept.Encrypted)
+	if err != nil {
+		return err
+	}
+	joinAcceptBytes, joinAcceptMIC := payload[:len(payload)-4], payload[len(payload)-4:]
+	expectedMIC, err := crypto.ComputeLegacyJoinAcceptMIC(d.appKey, append([]byte{rawPayload[0]}, joinAcceptBytes...))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(joinAcceptMIC, expectedMIC[:]) {
+		return errJoinAcceptMIC
+	}
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, joinAccept); err != nil {
+		return err
+	}
+	devNonce := d.lastDevNonce()
+	d.devAddr = joinAccept.DevAddr
+	d.nwkSKey = crypto.DeriveLegacyNwkSKey(d.appKey, joinAccept.JoinNonce, joinAccept.NetID, devNonce)
+	d.appSKey = crypto.DeriveLegacyAppSKey(d.appKey, joinAccept.JoinNonce, joinAccept.NetID, devNonce)
+	d.fCnt = 0
+	d.joined = true
+	return nil
+}
+
+// DataUplink returns the raw payload of a new unconfirmed data uplink with the given application payload.
+func (d *benchDevice) DataUplink(fPort uint32, frmPayload []byte) ([]byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.joined {
+		return nil, errNotJoined.WithAttributes("device_id", d.ids.DeviceID)
+	}
+	encrypted, err := crypto.EncryptUplink(d.appSKey, d.devAddr, d.fCnt, frmPayload)
+	if err != nil {
+		return nil, err
+	}
+	msg := ttnpb.Message{
+		MHDR: ttnpb.MHDR{
+			MType: ttnpb.MType_UNCONFIRMED_UP,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MACPayload{
+			MACPayload: &ttnpb.MACPayload{
+				FHDR: ttnpb.FHDR{
+					DevAddr: d.devAddr,
+					FCnt:    d.fCnt,
+				},
+				FPort:      fPort,
+				FRMPayload: encrypted,
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	mic, err := crypto.ComputeLegacyUplinkMIC(d.nwkSKey, d.devAddr, d.fCnt, buf)
+	if err != nil {
+		return nil, err
+	}
+	d.fCnt++
+	return append(buf, mic[:]...), nil
+}
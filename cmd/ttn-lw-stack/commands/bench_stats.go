@@ -0,0 +1,303 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// benchGatewayHop is the hop that completes when the downlink message is received by the simulated gateway.
+	benchGatewayHop = "gateway"
+	// benchRPCHop is the hop that completes when the RPC returns.
+	benchRPCHop = "rpc"
+)
+
+// benchOperation is an operation driven by the benchmark. Each hop is identified by the name of the event that is
+// published when the message passes it, or by one of the local hops.
+type benchOperation struct {
+	name     string
+	hops     []string
+	failures []string
+}
+
+var (
+	benchJoin = &benchOperation{
+		name: "join",
+		hops: []string{
+			"gs.up.receive",
+			"js.join.accept",
+			"ns.up.join.forward",
+			"as.up.join.forward",
+			benchGatewayHop,
+		},
+		failures: []string{
+			"gs.up.drop",
+			"gs.up.fail",
+			"js.join.reject",
+			"ns.up.join.drop",
+			"as.up.join.drop",
+		},
+	}
+	benchUplink = &benchOperation{
+		name: "uplink",
+		hops: []string{
+			"gs.up.receive",
+			"ns.up.data.forward",
+			"as.up.data.forward",
+		},
+		failures: []string{
+			"gs.up.drop",
+			"gs.up.fail",
+			"ns.up.data.drop",
+			"as.up.data.drop",
+		},
+	}
+	benchDownlink = &benchOperation{
+		name: "downlink",
+		hops: []string{
+			"as.down.data.receive",
+			"as.down.data.forward",
+			benchRPCHop,
+		},
+		failures: []string{
+			"as.down.data.drop",
+		},
+	}
+	benchOperations = []*benchOperation{benchJoin, benchUplink, benchDownlink}
+)
+
+func (op *benchOperation) hasHop(name string) bool {
+	for _, hop := range op.hops {
+		if hop == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (op *benchOperation) isFailure(name string) bool {
+	for _, failure := range op.failures {
+		if failure == name {
+			return true
+		}
+	}
+	return false
+}
+
+// benchPending is an operation that is in flight.
+type benchPending struct {
+	op     *benchOperation
+	dev    *benchDevice
+	sentAt time.Time
+	hops   map[string]time.Time
+}
+
+// benchOperationStats contains the results of an operation.
+type benchOperationStats struct {
+	sent     int
+	failed   int
+	timedOut int
+	hops     map[string][]time.Duration
+}
+
+// benchStats keeps track of the operations that are in flight and collects the results of completed operations.
+type benchStats struct {
+	mu      sync.Mutex
+	pending map[string]*benchPending
+	results map[*benchOperation]*benchOperationStats
+}
+
+func newBenchStats() *benchStats {
+	s := &benchStats{
+		pending: make(map[string]*benchPending),
+		results: make(map[*benchOperation]*benchOperationStats),
+	}
+	for _, op := range benchOperations {
+		s.results[op] = &benchOperationStats{
+			hops: make(map[string][]time.Duration),
+		}
+	}
+	return s
+}
+
+// Start starts tracking an operation identified by the given correlation ID.
+func (s *benchStats) Start(cid string, op *benchOperation, dev *benchDevice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[cid] = &benchPending{
+		op:     op,
+		dev:    dev,
+		sentAt: time.Now(),
+		hops:   make(map[string]time.Time),
+	}
+	s.results[op].sent++
+}
+
+// Device returns the end device of the operation identified by the given correlation ID, if it is in flight.
+func (s *benchStats) Device(cid string) (*benchDevice, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[cid]
+	if !ok {
+		return nil, false
+	}
+	return p.dev, true
+}
+
+// Fail marks the operation identified by the given correlation ID as failed.
+func (s *benchStats) Fail(cid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[cid]
+	if !ok {
+		return
+	}
+	delete(s.pending, cid)
+	s.results[p.op].failed++
+}
+
+// Hop records that the operation identified by the given correlation ID passed the given hop at the given time.
+// Failure events fail the operation. The operation completes when it passed all hops.
+func (s *benchStats) Hop(cid, name string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[cid]
+	if !ok {
+		return
+	}
+	if p.op.isFailure(name) {
+		delete(s.pending, cid)
+		s.results[p.op].failed++
+		return
+	}
+	if !p.op.hasHop(name) {
+		return
+	}
+	if _, ok := p.hops[name]; !ok {
+		p.hops[name] = at
+	}
+	if len(p.hops) < len(p.op.hops) {
+		return
+	}
+	delete(s.pending, cid)
+	s.complete(p)
+}
+
+// complete adds the latencies of the given operation to the results. The latency of a hop is the time since the
+// previous hop that the operation passed, or since the operation started.
+func (s *benchStats) complete(p *benchPending) {
+	type hopTime struct {
+		name string
+		at   time.Time
+	}
+	times := make([]hopTime, 0, len(p.hops))
+	for name, at := range p.hops {
+		times = append(times, hopTime{name: name, at: at})
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].at.Before(times[j].at)
+	})
+	res := s.results[p.op]
+	prev := p.sentAt
+	for _, t := range times {
+		d := t.at.Sub(prev)
+		if d < 0 {
+			d = 0
+		}
+		res.hops[t.name] = append(res.hops[t.name], d)
+		prev = t.at
+	}
+	res.hops["total"] = append(res.hops["total"], prev.Sub(p.sentAt))
+}
+
+// Expire ends the operations that are in flight for at least the given timeout. Operations that passed their last
+// hop are completed, as events of intermediate hops may be missed. Other operations are counted as timed out.
+func (s *benchStats) Expire(timeout time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for cid, p := range s.pending {
+		if time.Since(p.sentAt) < timeout {
+			continue
+		}
+		delete(s.pending, cid)
+		if _, ok := p.hops[p.op.hops[len(p.op.hops)-1]]; ok {
+			s.complete(p)
+		} else {
+			s.results[p.op].timedOut++
+		}
+	}
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(p * float64(len(sorted)-1))
+	return sorted[i]
+}
+
+// Report writes the results to the given writer.
+func (s *benchStats) Report(w io.Writer) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "OPERATION\tSENT\tFAILED\tTIMED OUT\tERROR RATE")
+	for _, op := range benchOperations {
+		res := s.results[op]
+		var rate float64
+		if res.sent > 0 {
+			rate = float64(res.failed+res.timedOut) / float64(res.sent)
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.2f%%\n", op.name, res.sent, res.failed, res.timedOut, rate*100)
+	}
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "OPERATION\tHOP\tCOUNT\tMIN\tP50\tP95\tP99\tMAX")
+	for _, op := range benchOperations {
+		res := s.results[op]
+		for _, hop := range append(op.hops, "total") {
+			durations := res.hops[hop]
+			if len(durations) == 0 {
+				continue
+			}
+			sorted := make([]time.Duration, len(durations))
+			copy(sorted, durations)
+			sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n",
+				op.name, hop, len(sorted),
+				sorted[0], percentile(sorted, 0.5), percentile(sorted, 0.95), percentile(sorted, 0.99), sorted[len(sorted)-1],
+			)
+		}
+	}
+	return tw.Flush()
+}
+
+// String returns a one line summary of the results.
+func (s *benchStats) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parts := make([]string, 0, len(benchOperations))
+	for _, op := range benchOperations {
+		res := s.results[op]
+		parts = append(parts, fmt.Sprintf("%s: %d/%d", op.name, len(res.hops["total"]), res.sent))
+	}
+	return strings.Join(parts, ", ")
+}
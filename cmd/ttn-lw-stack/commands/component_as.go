@@ -0,0 +1,98 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_as
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_applicationserver "go.thethings.network/lorawan-stack/cmd/internal/shared/applicationserver"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver"
+	asioapredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/redis"
+	asiopsredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/redis"
+	asiowebredis "go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	asredis "go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
+)
+
+// asConfig is the configuration of the Application Server.
+type asConfig = applicationserver.Config
+
+var defaultASConfig = shared_applicationserver.DefaultApplicationServerConfig
+
+func init() {
+	registerComponent("as", stackComponent{
+		StartByDefault: true,
+		Implies:        []string{"dtc", "qrg"},
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Application Server")
+			config.AS.Links = &asredis.LinkRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "links"},
+			})}
+			config.AS.Devices = &asredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "devices"},
+			})}
+			config.AS.DeviceStates = &asredis.DeviceStateRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "devicestates"},
+			})}
+			config.AS.RetainedUplinks = &asredis.RetainedUplinkRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "retaineduplinks"},
+			})}
+			config.AS.FormattersHistory.Registry = &asredis.PayloadFormattersHistoryRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "formattershistory"},
+			})}
+			config.AS.DownlinkTracking.Registry = &asredis.DownlinkStatusRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "downlinkstatuses"},
+			})}
+			config.AS.PubSub.Registry = &asiopsredis.PubSubRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "io", "pubsub"},
+			})}
+			config.AS.ApplicationPackages.Registry = &asioapredis.ApplicationPackagesRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"as", "io", "applicationpackages"},
+			})}
+			if config.AS.Webhooks.Target != "" {
+				config.AS.Webhooks.Registry = &asiowebredis.WebhookRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "io", "webhooks"},
+				})}
+				config.AS.Webhooks.Deliveries = &asiowebredis.DeliveryRegistry{Redis: redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "io", "webhookdeliveries"},
+				})}
+				config.AS.Webhooks.Idempotency = idempotency.NewStore(redis.New(&redis.Config{
+					Redis:     config.Redis,
+					Namespace: []string{"as", "io", "webhooks", "idempotency"},
+				}), idempotency.DefaultTTL)
+			}
+			as, err := applicationserver.New(c, &config.AS)
+			if err != nil {
+				return shared.ErrInitializeApplicationServer.WithCause(err)
+			}
+			_ = as
+			c.RegisterReadinessCheck(component.ReadinessCheckName("applicationserver", "redis"), redisReadinessCheck(config.Redis))
+			return nil
+		},
+	})
+}
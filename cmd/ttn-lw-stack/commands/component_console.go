@@ -0,0 +1,50 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_console
+
+package commands
+
+import (
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_console "go.thethings.network/lorawan-stack/cmd/internal/shared/console"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/console"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// consoleConfig is the configuration of the Console.
+type consoleConfig = console.Config
+
+var defaultConsoleConfig = shared_console.DefaultConsoleConfig
+
+func init() {
+	registerComponent("console", stackComponent{
+		StartByDefault: true,
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Console")
+			console, err := console.New(c, config.Console)
+			if err != nil {
+				return shared.ErrInitializeConsole.WithCause(err)
+			}
+			_ = console
+			if consoleMount := config.Console.UI.MountPath(); consoleMount != "/" {
+				setup.rootRedirect = web.Redirect("/", http.StatusFound, consoleMount)
+			}
+			return nil
+		},
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_dtc
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
+)
+
+// dtcConfig is the configuration of the Device Template Converter.
+type dtcConfig = devicetemplateconverter.Config
+
+var defaultDTCConfig dtcConfig
+
+func init() {
+	registerComponent("dtc", stackComponent{
+		StartByDefault: true,
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Device Template Converter")
+			dtc, err := devicetemplateconverter.New(c, &config.DTC)
+			if err != nil {
+				return shared.ErrInitializeDeviceTemplateConverter.WithCause(err)
+			}
+			_ = dtc
+			return nil
+		},
+	})
+}
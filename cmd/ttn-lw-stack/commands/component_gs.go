@@ -0,0 +1,44 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_gs
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_gatewayserver "go.thethings.network/lorawan-stack/cmd/internal/shared/gatewayserver"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
+)
+
+// gsConfig is the configuration of the Gateway Server.
+type gsConfig = gatewayserver.Config
+
+var defaultGSConfig = shared_gatewayserver.DefaultGatewayServerConfig
+
+func init() {
+	registerComponent("gs", stackComponent{
+		StartByDefault: true,
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Gateway Server")
+			gs, err := gatewayserver.New(c, &config.GS)
+			if err != nil {
+				return shared.ErrInitializeGatewayServer.WithCause(err)
+			}
+			_ = gs
+			return nil
+		},
+	})
+}
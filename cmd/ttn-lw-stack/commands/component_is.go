@@ -0,0 +1,63 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_is
+
+package commands
+
+import (
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_identityserver "go.thethings.network/lorawan-stack/cmd/internal/shared/identityserver"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/identityserver"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// isConfig is the configuration of the Identity Server.
+type isConfig = identityserver.Config
+
+var defaultISConfig = shared_identityserver.DefaultIdentityServerConfig
+
+func init() {
+	registerComponent("is", stackComponent{
+		StartByDefault: true,
+		Implies:        []string{"dtc", "qrg"},
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Identity Server")
+			config.IS.Idempotency = idempotency.NewStore(redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"is", "idempotency"},
+			}), idempotency.DefaultTTL)
+			is, err := identityserver.New(c, &config.IS)
+			if err != nil {
+				return shared.ErrInitializeIdentityServer.WithCause(err)
+			}
+			if config.Cache.Service == "redis" {
+				is.SetRedisCache(redis.New(&redis.Config{
+					Redis:     config.Cache.Redis,
+					Namespace: []string{"is", "cache"},
+				}))
+				c.RegisterReadinessCheck(component.ReadinessCheckName("identityserver", "redis"), redisReadinessCheck(config.Cache.Redis))
+			}
+			if oauthMount := config.IS.OAuth.UI.MountPath(); oauthMount != "/" {
+				setup.rootRedirect = web.Redirect("/", http.StatusFound, oauthMount)
+			}
+			return nil
+		},
+	})
+}
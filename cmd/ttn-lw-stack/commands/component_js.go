@@ -0,0 +1,56 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_js
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_joinserver "go.thethings.network/lorawan-stack/cmd/internal/shared/joinserver"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/joinserver"
+	jsredis "go.thethings.network/lorawan-stack/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+// jsConfig is the configuration of the Join Server.
+type jsConfig = joinserver.Config
+
+var defaultJSConfig = shared_joinserver.DefaultJoinServerConfig
+
+func init() {
+	registerComponent("js", stackComponent{
+		StartByDefault: true,
+		Implies:        []string{"dtc", "qrg"},
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up Join Server")
+			config.JS.Devices = &jsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"js", "devices"},
+			})}
+			config.JS.Keys = &jsredis.KeyRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"js", "keys"},
+			})}
+			js, err := joinserver.New(c, &config.JS)
+			if err != nil {
+				return shared.ErrInitializeJoinServer.WithCause(err)
+			}
+			_ = js
+			c.RegisterReadinessCheck(component.ReadinessCheckName("joinserver", "redis"), redisReadinessCheck(config.Redis))
+			return nil
+		},
+	})
+}
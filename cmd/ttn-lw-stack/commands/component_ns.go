@@ -0,0 +1,74 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_ns
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	shared_networkserver "go.thethings.network/lorawan-stack/cmd/internal/shared/networkserver"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/networkserver"
+	nsredis "go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/redis"
+)
+
+// nsConfig is the configuration of the Network Server.
+type nsConfig = networkserver.Config
+
+var defaultNSConfig = shared_networkserver.DefaultNetworkServerConfig
+
+func init() {
+	registerComponent("ns", stackComponent{
+		StartByDefault: true,
+		Implies:        []string{"dtc", "qrg"},
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			redisConsumerGroup := "ns"
+
+			logger.Info("Setting up Network Server")
+			config.NS.ApplicationUplinks = nsredis.NewApplicationUplinkQueue(redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "application-uplinks"},
+			}), 100, redisConsumerGroup, setup.redisConsumerID)
+			config.NS.Devices = &nsredis.DeviceRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "devices"},
+			})}
+			config.NS.EmergencyBroadcasts = &nsredis.EmergencyBroadcastRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "emergency-broadcasts"},
+			})}
+			config.NS.FairUse.Registry = &nsredis.FairUseRegistry{Redis: redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "fair-use"},
+			})}
+			nsDownlinkTasks := nsredis.NewDownlinkTaskQueue(redis.New(&redis.Config{
+				Redis:     config.Redis,
+				Namespace: []string{"ns", "tasks"},
+			}), 100000, redisConsumerGroup, setup.redisConsumerID)
+			if err := nsDownlinkTasks.Init(); err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			config.NS.DownlinkTasks = nsDownlinkTasks
+			ns, err := networkserver.New(c, &config.NS)
+			if err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			ns.Component.RegisterTask(ns.Context(), "queue_downlink", nsDownlinkTasks.Run, component.TaskRestartOnFailure)
+			c.RegisterReadinessCheck(component.ReadinessCheckName("networkserver", "redis"), redisReadinessCheck(config.Redis))
+			return nil
+		},
+	})
+}
@@ -0,0 +1,43 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build !without_qrg
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/qrcodegenerator"
+)
+
+// qrgConfig is the configuration of the QR Code Generator.
+type qrgConfig = qrcodegenerator.Config
+
+var defaultQRGConfig qrgConfig
+
+func init() {
+	registerComponent("qrg", stackComponent{
+		StartByDefault: true,
+		Setup: func(c *component.Component, setup *componentSetup) error {
+			logger.Info("Setting up QR Code Generator")
+			qrg, err := qrcodegenerator.New(c, &config.QRG)
+			if err != nil {
+				return shared.ErrInitializeQRCodeGenerator.WithCause(err)
+			}
+			_ = qrg
+			return nil
+		},
+	})
+}
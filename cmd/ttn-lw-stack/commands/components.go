@@ -0,0 +1,73 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/component"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// componentSetup is the state that is shared by the components when they are set up.
+type componentSetup struct {
+	// redisConsumerID is the ID of this process as Redis stream consumer.
+	redisConsumerID string
+	// rootRedirect is the redirect of the root path of the web server. Components that serve a web UI set it.
+	rootRedirect web.Registerer
+}
+
+// stackComponent is a component that can be started with the start command.
+// Components are included in the binary unless they are excluded with their build tag, for example without_is.
+type stackComponent struct {
+	// StartByDefault is whether the component is started when no components are given.
+	StartByDefault bool
+	// Implies are the names of the components that are started with the component, if they are included.
+	Implies []string
+	// Setup sets up the component.
+	Setup func(c *component.Component, setup *componentSetup) error
+}
+
+// componentNames are the names and aliases of all components in the order in which they are set up, including the
+// components that are excluded from the binary.
+var componentNames = [][]string{
+	{"is", "identityserver"},
+	{"gs", "gatewayserver"},
+	{"ns", "networkserver"},
+	{"as", "applicationserver"},
+	{"js", "joinserver"},
+	{"console"},
+	{"gcs"},
+	{"dtc"},
+	{"qrg"},
+}
+
+// stackComponents are the components that are included in the binary, by name.
+var stackComponents = make(map[string]stackComponent)
+
+// registerComponent includes the component with the given name in the binary.
+func registerComponent(name string, sc stackComponent) {
+	stackComponents[name] = sc
+}
+
+// lookupComponent returns the name of the component with the given name or alias, and whether it is known.
+func lookupComponent(arg string) (string, bool) {
+	for _, names := range componentNames {
+		for _, name := range names {
+			if name == arg {
+				return names[0], true
+			}
+		}
+	}
+	return "", false
+}
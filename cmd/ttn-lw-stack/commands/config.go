@@ -17,49 +17,36 @@ package commands
 import (
 	"go.thethings.network/lorawan-stack/cmd/internal/commands"
 	"go.thethings.network/lorawan-stack/cmd/internal/shared"
-	shared_applicationserver "go.thethings.network/lorawan-stack/cmd/internal/shared/applicationserver"
-	shared_console "go.thethings.network/lorawan-stack/cmd/internal/shared/console"
-	shared_gatewayconfigurationserver "go.thethings.network/lorawan-stack/cmd/internal/shared/gatewayconfigurationserver"
-	shared_gatewayserver "go.thethings.network/lorawan-stack/cmd/internal/shared/gatewayserver"
-	shared_identityserver "go.thethings.network/lorawan-stack/cmd/internal/shared/identityserver"
-	shared_joinserver "go.thethings.network/lorawan-stack/cmd/internal/shared/joinserver"
-	shared_networkserver "go.thethings.network/lorawan-stack/cmd/internal/shared/networkserver"
-	"go.thethings.network/lorawan-stack/pkg/applicationserver"
 	conf "go.thethings.network/lorawan-stack/pkg/config"
-	"go.thethings.network/lorawan-stack/pkg/console"
-	"go.thethings.network/lorawan-stack/pkg/devicetemplateconverter"
-	"go.thethings.network/lorawan-stack/pkg/gatewayconfigurationserver"
-	"go.thethings.network/lorawan-stack/pkg/gatewayserver"
-	"go.thethings.network/lorawan-stack/pkg/identityserver"
-	"go.thethings.network/lorawan-stack/pkg/joinserver"
-	"go.thethings.network/lorawan-stack/pkg/networkserver"
-	"go.thethings.network/lorawan-stack/pkg/qrcodegenerator"
 )
 
 // Config for the ttn-lw-stack binary.
+// The configuration of components that are excluded from the binary with build tags is empty.
 type Config struct {
 	conf.ServiceBase `name:",squash"`
-	IS               identityserver.Config             `name:"is"`
-	GS               gatewayserver.Config              `name:"gs"`
-	NS               networkserver.Config              `name:"ns"`
-	AS               applicationserver.Config          `name:"as"`
-	JS               joinserver.Config                 `name:"js"`
-	Console          console.Config                    `name:"console"`
-	GCS              gatewayconfigurationserver.Config `name:"gcs"`
-	DTC              devicetemplateconverter.Config    `name:"dtc"`
-	QRG              qrcodegenerator.Config            `name:"qrg"`
+	IS               isConfig      `name:"is"`
+	GS               gsConfig      `name:"gs"`
+	NS               nsConfig      `name:"ns"`
+	AS               asConfig      `name:"as"`
+	JS               jsConfig      `name:"js"`
+	Console          consoleConfig `name:"console"`
+	GCS              gcsConfig     `name:"gcs"`
+	DTC              dtcConfig     `name:"dtc"`
+	QRG              qrgConfig     `name:"qrg"`
 }
 
 // DefaultConfig contains the default config for the ttn-lw-stack binary.
 var DefaultConfig = Config{
 	ServiceBase: shared.DefaultServiceBase,
-	IS:          shared_identityserver.DefaultIdentityServerConfig,
-	GS:          shared_gatewayserver.DefaultGatewayServerConfig,
-	NS:          shared_networkserver.DefaultNetworkServerConfig,
-	AS:          shared_applicationserver.DefaultApplicationServerConfig,
-	JS:          shared_joinserver.DefaultJoinServerConfig,
-	Console:     shared_console.DefaultConsoleConfig,
-	GCS:         shared_gatewayconfigurationserver.DefaultGatewayConfigurationServerConfig,
+	IS:          defaultISConfig,
+	GS:          defaultGSConfig,
+	NS:          defaultNSConfig,
+	AS:          defaultASConfig,
+	JS:          defaultJSConfig,
+	Console:     defaultConsoleConfig,
+	GCS:         defaultGCSConfig,
+	DTC:         defaultDTCConfig,
+	QRG:         defaultQRGConfig,
 }
 
 func init() {
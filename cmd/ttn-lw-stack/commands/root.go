@@ -58,10 +58,10 @@ var (
 			}
 
 			// create logger
-			logger, err = log.NewLogger(
-				log.WithLevel(config.Base.Log.Level),
-				log.WithHandler(log.NewCLI(os.Stdout)),
-			)
+			logger, err = shared.NewLogger(config.Base.Log, os.Stdout)
+			if err != nil {
+				return err
+			}
 
 			ctx = log.NewContext(ctx, logger)
 
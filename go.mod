@@ -27,14 +27,18 @@ require (
 	cloud.google.com/go/storage v1.3.0 // indirect
 	code.gitea.io/sdk/gitea v0.0.0-20191106151626-e4082d89cc3b // indirect
 	contrib.go.opencensus.io/exporter/prometheus v0.1.0
+	github.com/Azure/azure-amqp-common-go/v3 v3.0.0
+	github.com/Azure/azure-event-hubs-go/v3 v3.1.0
 	github.com/Azure/azure-pipeline-go v0.2.2 // indirect
-	github.com/Azure/azure-sdk-for-go v36.1.0+incompatible // indirect
-	github.com/Azure/go-autorest/autorest/adal v0.8.0 // indirect
-	github.com/Azure/go-autorest/autorest/azure/auth v0.4.0 // indirect
+	github.com/Azure/azure-sdk-for-go v37.1.0+incompatible // indirect
+	github.com/Azure/azure-service-bus-go v0.10.0
+	github.com/Azure/go-autorest/autorest/adal v0.8.1
+	github.com/Azure/go-autorest/autorest/azure/auth v0.4.2 // indirect
 	github.com/Azure/go-autorest/autorest/to v0.3.0 // indirect
 	github.com/Azure/go-autorest/autorest/validation v0.2.0 // indirect
 	github.com/Masterminds/semver/v3 v3.0.2 // indirect
 	github.com/PuerkitoBio/purell v1.1.1
+	github.com/Shopify/sarama v1.23.1
 	github.com/TheThingsIndustries/magepkg v0.0.0-20190214092847-6c0299b7c3ed
 	github.com/TheThingsIndustries/mystique v0.0.0-20190516134627-66efd81c68ea
 	github.com/TheThingsNetwork/go-cayenne-lib v1.0.0
@@ -47,10 +51,12 @@ require (
 	github.com/chrj/smtpd v0.1.2
 	github.com/client9/misspell v0.3.4
 	github.com/disintegration/imaging v1.6.1
+	github.com/eclipse/paho.golang v0.10.0
 	github.com/eclipse/paho.mqtt.golang v1.2.0
 	github.com/envoyproxy/protoc-gen-validate v0.2.0-java
 	github.com/fatih/structtag v1.1.0 // indirect
 	github.com/fsnotify/fsnotify v1.4.7
+	github.com/fxamacker/cbor/v2 v2.2.0
 	github.com/getsentry/raven-go v0.2.0
 	github.com/go-redis/redis v6.15.6+incompatible
 	github.com/gobuffalo/envy v1.7.1 // indirect
@@ -62,7 +68,7 @@ require (
 	github.com/golang/protobuf v1.3.2
 	github.com/goreleaser/goreleaser v0.121.0
 	github.com/gorilla/securecookie v1.1.1
-	github.com/gorilla/websocket v1.4.1
+	github.com/gorilla/websocket v1.4.2
 	github.com/gotnospirit/makeplural v0.0.0-20180622080156-a5f48d94d976 // indirect
 	github.com/gotnospirit/messageformat v0.0.0-20190719172517-c1d0bdacdea2
 	github.com/gregjones/httpcache v0.0.0-20190611155906-901d90724c79
@@ -125,20 +131,23 @@ require (
 	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.5.0
 	github.com/ssor/bom v0.0.0-20170718123548-6386211fdfcf // indirect
+	github.com/streadway/amqp v1.0.0
 	github.com/valyala/fasttemplate v1.1.0 // indirect
 	github.com/xanzy/go-gitlab v0.22.0 // indirect
+	github.com/xdg/scram v0.0.0-20180814205039-7eeb5667e42c
 	go.opencensus.io v0.22.2
 	go.thethings.network/lorawan-stack-legacy v0.0.0-20190118141410-68812c833a78
 	gocloud.dev v0.18.0
+	gocloud.dev/pubsub/kafkapubsub v0.18.0
 	gocloud.dev/pubsub/natspubsub v0.18.0
-	golang.org/x/crypto v0.0.0-20191112222119-e1110fd1c708
+	golang.org/x/crypto v0.0.0-20191206172530-e9b2fee46413
 	golang.org/x/image v0.0.0-20191009234506-e7c1f5e7dbb8 // indirect
 	golang.org/x/net v0.0.0-20191112182307-2180aed22343
 	golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45
-	golang.org/x/sync v0.0.0-20190911185100-cd5d95a43a6e
+	golang.org/x/sync v0.0.0-20201207232520-09787c993a3a
 	golang.org/x/sys v0.0.0-20191113165036-4c7a9d0fe056 // indirect
 	golang.org/x/tools v0.0.0-20191114200427-caa0b0f7d508
-	golang.org/x/xerrors v0.0.0-20191011141410-1b5146add898
+	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543
 	google.golang.org/api v0.13.0
 	google.golang.org/genproto v0.0.0-20191114150713-6bbd007550de
 	google.golang.org/grpc v1.25.1
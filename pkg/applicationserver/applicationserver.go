@@ -27,13 +27,23 @@ import (
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
 	iogrpc "go.thethings.network/lorawan-stack/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt/topics"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages/fmp" // The Firmware Management Protocol package
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
-	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt" // The MQTT integration provider
-	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats" // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/amqp"   // The AMQP integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/awsiot" // The AWS IoT Core integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/azure"  // The Azure integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/kafka"  // The Kafka integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt"   // The MQTT integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/nats"   // The NATS integration provider
+	_ "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/redis"  // The Redis integration provider
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/schedules"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/ws"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -46,7 +56,13 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cbor"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/senml"
+	"go.thethings.network/lorawan-stack/pkg/mutewindow"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/unique"
 	"google.golang.org/grpc"
@@ -61,14 +77,21 @@ type ApplicationServer struct {
 
 	config *Config
 
-	linkMode         LinkMode
-	linkRegistry     LinkRegistry
-	deviceRegistry   DeviceRegistry
-	formatter        payloadFormatter
-	webhooks         web.Webhooks
-	webhookTemplates *web.TemplateStore
-	pubsub           *pubsub.PubSub
-	appPackages      packages.Server
+	linkMode            LinkMode
+	linkRegistry        LinkRegistry
+	deviceRegistry      DeviceRegistry
+	deviceStateRegistry DeviceStateRegistry
+	retainedUplinks     RetainedUplinkRegistry
+	formatter           payloadFormatter
+	webhooks            web.Webhooks
+	webhookTemplates    *web.TemplateStore
+	webhookDeliveries   web.DeliveryRegistry
+	pubsub              *pubsub.PubSub
+	appPackages         packages.Server
+	downlinkTracker     *downlinkTracker
+	formattersHistory   PayloadFormattersHistoryRegistry
+	suspensions         *suspensionCache
+	muteWindows         *mutewindow.Cache
 
 	links              sync.Map
 	linkErrors         sync.Map
@@ -125,28 +148,84 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		return nil, err
 	}
 
+	jsFormatter := javascript.New(conf.JavaScriptFormatters.Options())
 	as = &ApplicationServer{
-		Component:      c,
-		ctx:            ctx,
-		config:         conf,
-		linkMode:       linkMode,
-		linkRegistry:   conf.Links,
-		deviceRegistry: conf.Devices,
+		Component:           c,
+		ctx:                 ctx,
+		config:              conf,
+		linkMode:            linkMode,
+		linkRegistry:        conf.Links,
+		deviceRegistry:      conf.Devices,
+		deviceStateRegistry: conf.DeviceStates,
 		formatter: payloadFormatter{
 			repository: &devicerepository.Client{
 				Fetcher: drFetcher,
 			},
+			repositoryCache: newRepositoryFormatterCache(conf.DeviceRepository.CacheTTL),
 			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
-				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: jsFormatter,
 				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_CBOR:       cbor.New(),
+				ttnpb.PayloadFormatter_FORMATTER_SENML:      senml.New(),
 			},
 			downFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder{
-				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(),
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: jsFormatter,
 				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+				ttnpb.PayloadFormatter_FORMATTER_CBOR:       cbor.New(),
+				ttnpb.PayloadFormatter_FORMATTER_SENML:      senml.New(),
 			},
 		},
-		interopClient: interopCl,
-		interopID:     conf.Interop.ID,
+		interopClient:   interopCl,
+		interopID:       conf.Interop.ID,
+		downlinkTracker: newDownlinkTracker(conf.DownlinkTracking.Registry, conf.DownlinkTracking.Size, conf.DownlinkTracking.TimeoutUplinks, conf.DownlinkTracking.TTL),
+	}
+	if conf.MQTTRetainUplinks {
+		as.retainedUplinks = conf.RetainedUplinks
+	}
+	if conf.FormattersHistory.Size > 0 {
+		as.formattersHistory = conf.FormattersHistory.Registry
+	}
+	if len(conf.GRPCFormatters.Services) > 0 {
+		tlsConfig, err := c.GetTLSClientConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		grpcFormatter, err := grpcservice.New(ctx, conf.GRPCFormatters.Services, conf.GRPCFormatters.Timeout, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		as.formatter.upFormatters[ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE] = grpcFormatter
+		as.formatter.downFormatters[ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE] = grpcFormatter
+	}
+
+	as.suspensions = newSuspensionCache(conf.Suspension.CacheTTL, as.fetchApplicationSuspended)
+	suspensionHandler := events.HandlerFunc(as.suspensions.HandleEvent)
+	for _, name := range suspensionEvents {
+		if err := events.Subscribe(name, suspensionHandler); err != nil {
+			return nil, err
+		}
+	}
+	go func() {
+		<-as.Context().Done()
+		for _, name := range suspensionEvents {
+			events.Unsubscribe(name, suspensionHandler)
+		}
+	}()
+
+	if conf.MuteWindows.Enable {
+		as.muteWindows = mutewindow.NewCache(mutewindow.NewRegistryFetcher(c), conf.MuteWindows.CacheTTL)
+		muteWindowHandler := events.HandlerFunc(as.muteWindows.HandleEvent)
+		for _, name := range mutewindow.UpdateEvents {
+			if err := events.Subscribe(name, muteWindowHandler); err != nil {
+				return nil, err
+			}
+		}
+		go func() {
+			<-as.Context().Done()
+			for _, name := range mutewindow.UpdateEvents {
+				events.Unsubscribe(name, muteWindowHandler)
+			}
+		}()
 	}
 
 	as.grpc.asDevices = asEndDeviceRegistryServer{
@@ -162,12 +241,38 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}()
 
+	mqttRateLimits := func(limits MQTTRateLimits) mqtt.RateLimits {
+		return mqtt.RateLimits{
+			PublishRate:    limits.PublishRate,
+			PublishBurst:   limits.PublishBurst,
+			SubscribeRate:  limits.SubscribeRate,
+			SubscribeBurst: limits.SubscribeBurst,
+		}
+	}
+	mqttRateLimit := mqtt.WithRateLimit(mqtt.RateLimitConfig{
+		Default:        mqttRateLimits(conf.MQTTRateLimit.Default),
+		Trusted:        mqttRateLimits(conf.MQTTRateLimit.Trusted),
+		TrustedAPIKeys: conf.MQTTRateLimit.TrustedAPIKeys,
+		MaxThrottled:   conf.MQTTRateLimit.MaxThrottled,
+	})
+	mqttOpts := []mqtt.Option{mqttRateLimit}
+	if conf.MQTTRetainUplinks {
+		mqttOpts = append(mqttOpts, mqtt.WithRetainedUplinks())
+	}
+	mqttFormat := mqtt.JSON
+	if conf.MQTTTopicTemplate != "" {
+		layout, err := topics.NewTemplate(conf.MQTTTopicTemplate)
+		if err != nil {
+			return nil, err
+		}
+		mqttFormat = mqtt.NewFormat(layout, formatters.JSON)
+	}
 	for _, version := range []struct {
 		Format mqtt.Format
 		Config config.MQTT
 	}{
 		{
-			Format: mqtt.JSON,
+			Format: mqttFormat,
 			Config: conf.MQTT,
 		},
 	} {
@@ -189,16 +294,31 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 					"protocol", endpoint.Protocol(),
 				)
 			}
-			mqtt.Start(ctx, as, lis, version.Format, endpoint.Protocol())
+			mqtt.Start(ctx, as, lis, version.Format, endpoint.Protocol(), mqttOpts...)
 		}
 	}
+	if conf.MQTTWebSocket.Enabled {
+		c.RegisterWeb(mqtt.StartWebSocket(ctx, as, mqttFormat, mqtt.WebSocketConfig{
+			AllowedOrigins: conf.MQTTWebSocket.AllowedOrigins,
+		}, mqttOpts...))
+	}
 
 	if webhooks, err := conf.Webhooks.NewWebhooks(ctx, as); err != nil {
 		return nil, err
 	} else if webhooks != nil {
 		as.webhooks = webhooks
+		as.webhookDeliveries = conf.Webhooks.Deliveries
 		as.defaultSubscribers = append(as.defaultSubscribers, webhooks.NewSubscription())
 		c.RegisterWeb(webhooks)
+		if conf.Webhooks.Idempotency != nil {
+			hooks.RegisterUnaryHook("/ttn.lorawan.v3.ApplicationWebhookRegistry/Set", idempotency.HookName, conf.Webhooks.Idempotency.UnaryHook())
+		}
+	}
+
+	if conf.WebSocket.Enabled {
+		c.RegisterWeb(ws.New(ctx, as, ws.Config{
+			AllowedOrigins: conf.WebSocket.AllowedOrigins,
+		}))
 	}
 
 	if as.webhookTemplates, err = conf.Webhooks.Templates.NewTemplateStore(); err != nil {
@@ -216,6 +336,15 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		c.RegisterGRPC(as.appPackages)
 	}
 
+	if len(conf.DownlinkSchedules.Schedules) > 0 {
+		scheduler, err := schedules.New(ctx, as, timeZoneFetcher{as}, conf.DownlinkSchedules.Schedules, conf.DownlinkSchedules.History)
+		if err != nil {
+			return nil, err
+		}
+		c.RegisterWeb(scheduler)
+		c.RegisterTask(as.Context(), "downlink_schedules", scheduler.Run, component.TaskRestartOnFailure)
+	}
+
 	c.RegisterGRPC(as)
 	if as.linkMode == LinkAll {
 		c.RegisterTask(as.Context(), "link_all", as.linkAll, component.TaskRestartOnFailure)
@@ -229,7 +358,7 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if as.webhooks != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, web.NewWebhookRegistryRPC(as.webhooks.Registry(), as.webhookTemplates, as.webhookDeliveries))
 	}
 	if as.pubsub != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, as.pubsub)
@@ -289,6 +418,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("as:downlink:%s", events.NewCorrelationID()))
 	for _, item := range items {
 		item.CorrelationIDs = append(item.CorrelationIDs, events.CorrelationIDsFromContext(ctx)...)
+		as.downlinkTracker.Tag(item)
 	}
 	logger := log.FromContext(ctx)
 	link, err := as.getLink(ctx, ids.ApplicationIdentifiers)
@@ -299,6 +429,10 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 	for _, item := range items {
 		registerReceiveDownlink(ctx, ids, item)
 	}
+	var (
+		versionIDs *ttnpb.EndDeviceVersionIdentifiers
+		forwarded  []*ttnpb.ApplicationDownlink
+	)
 	_, err = as.deviceRegistry.Set(ctx, ids,
 		[]string{
 			"formatters",
@@ -346,6 +480,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 			if err != nil {
 				return nil, nil, err
 			}
+			versionIDs, forwarded = dev.VersionIDs, encryptedItems
 			return dev, mask, nil
 		},
 	)
@@ -369,11 +504,13 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 				},
 			}
 			registerDropDownlink(ctx, ids, item, err)
+			as.trackDownlink(ctx, ids, item, ttnpb.ApplicationDownlinkStatus_FAILED)
 		}
 		return err
 	}
 	atomic.AddUint64(&link.downlinks, uint64(len(items)))
 	atomic.StoreInt64(&link.lastDownlinkTime, time.Now().UnixNano())
+	link.traffic.AddDownlinks(versionIDs, forwarded...)
 	for _, item := range items {
 		link.upCh <- &io.ContextualApplicationUp{
 			Context: ctx,
@@ -386,6 +523,7 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 			},
 		}
 		registerForwardDownlink(ctx, ids, item, link.connName)
+		as.trackDownlink(ctx, ids, item, ttnpb.ApplicationDownlinkStatus_QUEUED)
 	}
 	return nil
 }
@@ -393,12 +531,18 @@ func (as *ApplicationServer) downlinkQueueOp(ctx context.Context, ids ttnpb.EndD
 // DownlinkQueuePush pushes the given downlink messages to the end device's application downlink queue.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	if err := as.requireNotSuspended(ctx, ids.ApplicationIdentifiers); err != nil {
+		return err
+	}
 	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueuePush)
 }
 
 // DownlinkQueueReplace replaces the end device's application downlink queue with the given downlink messages.
 // This operation changes FRMPayload in the given items.
 func (as *ApplicationServer) DownlinkQueueReplace(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	if err := as.requireNotSuspended(ctx, ids.ApplicationIdentifiers); err != nil {
+		return err
+	}
 	return as.downlinkQueueOp(ctx, ids, io.CleanDownlinks(items), ttnpb.AsNsClient.DownlinkQueueReplace)
 }
 
@@ -449,6 +593,11 @@ func (as *ApplicationServer) DownlinkQueueList(ctx context.Context, ids ttnpb.En
 	return res.Downlinks, nil
 }
 
+// DownlinkStatusList lists the delivery status of the tracked confirmed downlink messages of the given end device.
+func (as *ApplicationServer) DownlinkStatusList(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	return as.downlinkTracker.List(ctx, ids)
+}
+
 var errJSUnavailable = errors.DefineUnavailable("join_server_unavailable", "Join Server unavailable for JoinEUI `{join_eui}`")
 
 func (as *ApplicationServer) fetchAppSKey(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, sessionKeyID []byte) (ttnpb.KeyEnvelope, error) {
@@ -487,24 +636,62 @@ func (as *ApplicationServer) handleUp(ctx context.Context, up *ttnpb.Application
 	case *ttnpb.ApplicationUp_JoinAccept:
 		return as.handleJoinAccept(ctx, up.EndDeviceIdentifiers, p.JoinAccept, link)
 	case *ttnpb.ApplicationUp_UplinkMessage:
-		return as.handleUplink(ctx, up.EndDeviceIdentifiers, p.UplinkMessage, link)
+		if err := as.handleUplink(ctx, up.EndDeviceIdentifiers, p.UplinkMessage, link); err != nil {
+			return err
+		}
+		as.updateDeviceState(ctx, up)
+		as.retainUplink(ctx, up)
+		return nil
+	case *ttnpb.ApplicationUp_LocationSolved:
+		as.updateDeviceState(ctx, up)
+		return nil
 	case *ttnpb.ApplicationUp_DownlinkQueueInvalidated:
 		return as.handleDownlinkQueueInvalidated(ctx, up.EndDeviceIdentifiers, p.DownlinkQueueInvalidated, link)
 	case *ttnpb.ApplicationUp_DownlinkQueued:
 		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkQueued)
 	case *ttnpb.ApplicationUp_DownlinkSent:
+		as.updateDownlinkStatus(ctx, up.EndDeviceIdentifiers, p.DownlinkSent, ttnpb.ApplicationDownlinkStatus_SENT)
 		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkSent)
 	case *ttnpb.ApplicationUp_DownlinkFailed:
+		as.updateDownlinkStatus(ctx, up.EndDeviceIdentifiers, &p.DownlinkFailed.ApplicationDownlink, ttnpb.ApplicationDownlinkStatus_FAILED)
 		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, &p.DownlinkFailed.ApplicationDownlink)
 	case *ttnpb.ApplicationUp_DownlinkAck:
+		as.updateDownlinkStatus(ctx, up.EndDeviceIdentifiers, p.DownlinkAck, ttnpb.ApplicationDownlinkStatus_ACKNOWLEDGED)
 		return as.decryptDownlinkMessage(ctx, up.EndDeviceIdentifiers, p.DownlinkAck)
 	case *ttnpb.ApplicationUp_DownlinkNack:
+		// Nacked downlink messages are inserted in the downlink queue again.
+		as.updateDownlinkStatus(ctx, up.EndDeviceIdentifiers, p.DownlinkNack, ttnpb.ApplicationDownlinkStatus_QUEUED)
 		return as.handleDownlinkNack(ctx, up.EndDeviceIdentifiers, p.DownlinkNack, link)
 	default:
 		return nil
 	}
 }
 
+// trackDownlink starts tracking the delivery status of the given downlink message if it is tagged, and publishes the
+// status. Failing to track the message does not fail the handling of the downlink message.
+func (as *ApplicationServer) trackDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, state ttnpb.ApplicationDownlinkStatus_State) {
+	status, err := as.downlinkTracker.Add(ctx, ids, msg, state)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to track downlink status")
+		return
+	}
+	if status != nil {
+		registerDownlinkStatus(ctx, ids, status)
+	}
+}
+
+// updateDownlinkStatus sets the delivery status of the given downlink message if it is tracked, and publishes the update.
+func (as *ApplicationServer) updateDownlinkStatus(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, state ttnpb.ApplicationDownlinkStatus_State) {
+	status, err := as.downlinkTracker.Update(ctx, ids, msg, state)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update downlink status")
+		return
+	}
+	if status != nil {
+		registerDownlinkStatus(ctx, ids, status)
+	}
+}
+
 var errFetchAppSKey = errors.Define("app_s_key", "failed to get AppSKey")
 
 func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, joinAccept *ttnpb.ApplicationJoinAccept, link *link) error {
@@ -513,16 +700,20 @@ func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.End
 		"dev_eui", ids.DevEUI,
 		"session_key_id", joinAccept.SessionKeyID,
 	))
+	var inferred *ttnpb.EndDevice
 	_, err := as.deviceRegistry.Set(ctx, ids,
 		[]string{
+			"formatters",
 			"pending_session",
 			"session",
+			"version_ids",
 		},
 		func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			var mask []string
 			if dev == nil {
 				return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
 			}
+			inferred = nil
 			var appSKey ttnpb.KeyEnvelope
 			if joinAccept.AppSKey != nil {
 				logger.Debug("Received AppSKey from Network Server")
@@ -558,12 +749,24 @@ func (as *ApplicationServer) handleJoinAccept(ctx context.Context, ids ttnpb.End
 					mask = append(mask, "session")
 				}
 			}
+			if paths := as.inferFormatters(ctx, dev, link.DefaultFormatters); len(paths) > 0 {
+				logger.WithField("version_ids", dev.VersionIDs).Debug("Inferred payload formatters from Device Repository")
+				inferred = &ttnpb.EndDevice{
+					EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+					VersionIDs:           dev.VersionIDs,
+					Formatters:           dev.Formatters,
+				}
+				mask = append(mask, paths...)
+			}
 			return dev, mask, nil
 		},
 	)
 	if err != nil {
 		return err
 	}
+	if inferred != nil {
+		events.Publish(evtInferFormattersJoinAccept(ctx, ids, inferred))
+	}
 	return nil
 }
 
@@ -638,6 +841,14 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, ids ttnpb.EndDevi
 	if err != nil {
 		return err
 	}
+	timedOut, err := as.downlinkTracker.HandleUplink(ctx, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update downlink statuses")
+	}
+	for _, status := range timedOut {
+		registerDownlinkStatus(ctx, ids, status)
+	}
+	link.traffic.AddUplink(dev.VersionIDs, uplink)
 	if err := as.decryptAndDecode(ctx, dev, uplink, link.DefaultFormatters); err != nil {
 		return err
 	}
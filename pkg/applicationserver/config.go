@@ -22,11 +22,15 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/schedules"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
+	"go.thethings.network/lorawan-stack/pkg/scripting"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
@@ -53,15 +57,97 @@ type InteropConfig struct {
 
 // Config represents the ApplicationServer configuration.
 type Config struct {
-	LinkMode            string                    `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
-	Devices             DeviceRegistry            `name:"-"`
-	Links               LinkRegistry              `name:"-"`
-	MQTT                config.MQTT               `name:"mqtt" description:"MQTT configuration"`
-	Webhooks            WebhooksConfig            `name:"webhooks" description:"Webhooks configuration"`
-	PubSub              PubSubConfig              `name:"pubsub" description:"Pub/sub messaging configuration"`
-	ApplicationPackages ApplicationPackagesConfig `name:"application-packages" description:"Application packages configuration"`
-	Interop             InteropConfig             `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel      string                    `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	LinkMode             string                     `name:"link-mode" description:"Mode to link applications to their Network Server (all, explicit)"`
+	Devices              DeviceRegistry             `name:"-"`
+	Links                LinkRegistry               `name:"-"`
+	DeviceStates         DeviceStateRegistry        `name:"-"`
+	RetainedUplinks      RetainedUplinkRegistry     `name:"-"`
+	MQTT                 config.MQTT                `name:"mqtt" description:"MQTT configuration"`
+	Webhooks             WebhooksConfig             `name:"webhooks" description:"Webhooks configuration"`
+	WebSocket            WebSocketConfig            `name:"websocket" description:"WebSocket frontend configuration"`
+	MQTTWebSocket        MQTTWebSocketConfig        `name:"mqtt-websocket" description:"MQTT over WebSocket configuration"`
+	MQTTRateLimit        MQTTRateLimitConfig        `name:"mqtt-rate-limit" description:"MQTT connection rate limit configuration"`
+	MQTTTopicTemplate    string                     `name:"mqtt-topic-template" description:"Template of MQTT topics, for example v3/{application_id}/devices/{device_id}/{type} (empty uses the v3 layout)"`
+	MQTTRetainUplinks    bool                       `name:"mqtt-retain-uplinks" description:"Publish the last uplink message of each end device as retained message to new MQTT subscriptions"`
+	PubSub               PubSubConfig               `name:"pubsub" description:"Pub/sub messaging configuration"`
+	ApplicationPackages  ApplicationPackagesConfig  `name:"application-packages" description:"Application packages configuration"`
+	Interop              InteropConfig              `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel       string                     `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	DownlinkTracking     DownlinkTrackingConfig     `name:"downlink-tracking" description:"Confirmed downlink delivery status tracking configuration"`
+	Suspension           SuspensionConfig           `name:"suspension" description:"Application suspension configuration"`
+	DownlinkSchedules    DownlinkSchedulesConfig    `name:"downlink-schedules" description:"Scheduled downlink messages configuration"`
+	GRPCFormatters       GRPCFormattersConfig       `name:"grpc-formatters" description:"Payload formatters that call gRPC services configuration"`
+	JavaScriptFormatters JavaScriptFormattersConfig `name:"javascript-formatters" description:"JavaScript payload formatters configuration"`
+	MuteWindows          MuteWindowsConfig          `name:"mute-windows" description:"Mute windows of end devices and applications configuration"`
+	DeviceRepository     DeviceRepositoryConfig     `name:"device-repository" description:"Device Repository payload formatters configuration"`
+	FormattersHistory    FormattersHistoryConfig    `name:"formatters-history" description:"Payload formatters version history configuration"`
+}
+
+// FormattersHistoryConfig defines the configuration of the version history of payload formatters.
+type FormattersHistoryConfig struct {
+	Registry PayloadFormattersHistoryRegistry `name:"-"`
+	Size     int                              `name:"size" description:"Number of payload formatters versions to keep per end device and application link (0 is disabled)"`
+}
+
+// DeviceRepositoryConfig defines the configuration of the payload formatters of the Device Repository.
+type DeviceRepositoryConfig struct {
+	CacheTTL time.Duration `name:"cache-ttl" description:"Time to cache the payload formatters of the Device Repository"`
+}
+
+// DownlinkTrackingConfig defines the configuration of the delivery status tracking of confirmed downlink messages.
+type DownlinkTrackingConfig struct {
+	Registry       DownlinkStatusRegistry `name:"-"`
+	Size           int                    `name:"size" description:"Number of recent confirmed downlink messages to track per end device (0 is disabled)"`
+	TimeoutUplinks uint32                 `name:"timeout-uplinks" description:"Number of uplink messages after which an unacknowledged confirmed downlink message times out"`
+	TTL            time.Duration          `name:"ttl" description:"Time after which the tracked downlink messages of an end device without traffic are deleted"`
+}
+
+// DownlinkSchedulesConfig defines the configuration of the scheduled downlink messages.
+type DownlinkSchedulesConfig struct {
+	Schedules map[string]schedules.Schedule `name:"schedules" description:"Downlink schedules by schedule ID" file-only:"true"`
+	History   int                           `name:"history" description:"Number of recent runs to keep per schedule"`
+}
+
+// GRPCFormattersConfig defines the configuration of the payload formatters that call gRPC services.
+type GRPCFormattersConfig struct {
+	Services map[string]grpcservice.Service `name:"services" description:"Payload formatter services by name" file-only:"true"`
+	Timeout  time.Duration                  `name:"timeout" description:"Timeout of calls to payload formatter services"`
+}
+
+// JavaScriptFormattersConfig defines the configuration of the JavaScript payload formatters.
+type JavaScriptFormattersConfig struct {
+	Timeout         time.Duration `name:"timeout" description:"Maximum run time of a JavaScript payload formatter"`
+	StackDepthLimit int           `name:"stack-depth-limit" description:"Maximum call stack depth of a JavaScript payload formatter"`
+	CacheSize       int           `name:"cache-size" description:"Number of compiled JavaScript payload formatters to cache"`
+}
+
+// Options returns the scripting options of the configuration. Zero values are replaced by the default options.
+func (c JavaScriptFormattersConfig) Options() scripting.Options {
+	opts := scripting.DefaultOptions
+	if c.Timeout > 0 {
+		opts.Timeout = c.Timeout
+	}
+	if c.StackDepthLimit > 0 {
+		opts.StackDepthLimit = c.StackDepthLimit
+	}
+	if c.CacheSize > 0 {
+		opts.CacheSize = c.CacheSize
+	}
+	return opts
+}
+
+// SuspensionConfig defines the configuration of the caching of the application suspension state.
+type SuspensionConfig struct {
+	CacheTTL time.Duration `name:"cache-ttl" description:"Time to cache whether an application is suspended"`
+}
+
+// MuteWindowsConfig defines the configuration of the handling of upstream messages during mute windows of end devices
+// and applications. Upstream messages are held in memory until the mute window ends, or dropped, depending on the
+// policy of the mute window.
+type MuteWindowsConfig struct {
+	Enable     bool          `name:"enable" description:"Hold or drop upstream messages during mute windows"`
+	CacheTTL   time.Duration `name:"cache-ttl" description:"Time to cache the mute windows of end devices and applications"`
+	BufferSize int           `name:"buffer-size" description:"Number of upstream messages per application to hold during mute windows"`
 }
 
 var errLinkMode = errors.DefineInvalidArgument("link_mode", "invalid link mode `{value}`")
@@ -85,17 +171,51 @@ var (
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry  web.WebhookRegistry `name:"-"`
-	Target    string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout   time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers   int                 `name:"workers" description:"Number of workers to process requests"`
-	Templates web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
+	Registry      web.WebhookRegistry        `name:"-"`
+	Deliveries    web.DeliveryRegistry       `name:"-"`
+	Idempotency   *idempotency.Store         `name:"-"`
+	Target        string                     `name:"target" description:"Target of the integration (direct)"`
+	Timeout       time.Duration              `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize     int                        `name:"queue-size" description:"Number of requests to queue"`
+	Workers       int                        `name:"workers" description:"Number of workers to process requests"`
+	Templates     web.TemplatesConfig        `name:"templates" description:"The store of the webhook templates"`
+	Client        web.HTTPClientConfig       `name:"client" description:"HTTP client configuration of the webhooks"`
+	Hosts         []web.HostHTTPClientConfig `name:"hosts" description:"HTTP client configuration per webhook host" file-only:"true"`
+	MaxDeliveries int                        `name:"max-deliveries" description:"Number of recent delivery attempts to keep per webhook (0 is disabled)"`
+}
+
+// WebSocketConfig defines the configuration of the WebSocket frontend.
+type WebSocketConfig struct {
+	Enabled        bool     `name:"enabled" description:"Enable the WebSocket frontend"`
+	AllowedOrigins []string `name:"allowed-origins" description:"Origins that are allowed to connect from browsers (* allows any origin)"`
+}
+
+// MQTTWebSocketConfig defines the configuration of the MQTT frontend over WebSocket.
+type MQTTWebSocketConfig struct {
+	Enabled        bool     `name:"enabled" description:"Serve the MQTT frontend over WebSocket on the HTTP listener"`
+	AllowedOrigins []string `name:"allowed-origins" description:"Origins that are allowed to connect from browsers (* allows any origin)"`
+}
+
+// MQTTRateLimits defines the rate limits of the packets that are received on an MQTT connection.
+type MQTTRateLimits struct {
+	PublishRate    float64 `name:"publish-rate" description:"Number of PUBLISH packets per second (0 is unlimited)"`
+	PublishBurst   int     `name:"publish-burst" description:"Number of PUBLISH packets that can be received at once"`
+	SubscribeRate  float64 `name:"subscribe-rate" description:"Number of SUBSCRIBE packets per second (0 is unlimited)"`
+	SubscribeBurst int     `name:"subscribe-burst" description:"Number of SUBSCRIBE packets that can be received at once"`
+}
+
+// MQTTRateLimitConfig defines the rate limits of MQTT connections.
+type MQTTRateLimitConfig struct {
+	Default        MQTTRateLimits `name:"default" description:"Rate limits of connections"`
+	Trusted        MQTTRateLimits `name:"trusted" description:"Rate limits of connections with trusted API keys"`
+	TrustedAPIKeys []string       `name:"trusted-api-keys" description:"IDs of API keys that use the trusted rate limits"`
+	MaxThrottled   int            `name:"max-throttled" description:"Number of throttled packets after which the connection is closed (0 is unlimited)"`
 }
 
 // PubSubConfig contains go-cloud PubSub configuration of the Application Server.
 type PubSubConfig struct {
-	Registry pubsub.Registry `name:"-"`
+	Registry        pubsub.Registry `name:"-"`
+	PauseBufferSize int             `name:"pause-buffer-size" description:"Number of upstream messages to buffer per paused integration"`
 }
 
 // ApplicationPackagesConfig contains application packages associations configuration.
@@ -111,10 +231,19 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	case "":
 		return nil, nil
 	case "direct":
+		client, err := c.Client.NewClient(c.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		hostClients, err := web.HostClients(c.Hosts, c.Timeout)
+		if err != nil {
+			return nil, err
+		}
 		target = &web.HTTPClientSink{
-			Client: &http.Client{
-				Timeout: c.Timeout,
-			},
+			Client:        client,
+			HostClients:   hostClients,
+			Deliveries:    c.Deliveries,
+			MaxDeliveries: c.MaxDeliveries,
 		}
 	default:
 		return nil, errWebhooksTarget.WithAttributes("target", c.Target)
@@ -145,7 +274,7 @@ func (c PubSubConfig) NewPubSub(comp *component.Component, server io.Server) (*p
 	if c.Registry == nil {
 		return nil, nil
 	}
-	return pubsub.New(comp, server, c.Registry)
+	return pubsub.New(comp, server, c.Registry, pubsub.WithPauseBufferSize(c.PauseBufferSize))
 }
 
 // NewApplicationPackages returns a new applications packages frontend based on the configuration.
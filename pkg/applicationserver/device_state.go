@@ -0,0 +1,103 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// batteryField is the field of decoded payloads that contains the battery level.
+const batteryField = "battery"
+
+var errNoDeviceStateRegistry = errors.DefineUnimplemented("no_device_state_registry", "no end device state registry available")
+
+// GetEndDeviceState returns the current state of the given end device.
+func (as *ApplicationServer) GetEndDeviceState(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceState, error) {
+	if as.deviceStateRegistry == nil {
+		return nil, errNoDeviceStateRegistry
+	}
+	return as.deviceStateRegistry.Get(ctx, ids)
+}
+
+// updateDeviceState updates the current state of the end device with the given upstream message.
+// Failing to update the state does not fail the handling of the upstream message.
+func (as *ApplicationServer) updateDeviceState(ctx context.Context, up *ttnpb.ApplicationUp) {
+	if as.deviceStateRegistry == nil {
+		return
+	}
+	_, err := as.deviceStateRegistry.Set(ctx, up.EndDeviceIdentifiers, func(state *ttnpb.EndDeviceState) (*ttnpb.EndDeviceState, error) {
+		if state == nil {
+			state = &ttnpb.EndDeviceState{
+				EndDeviceIdentifiers: up.EndDeviceIdentifiers,
+			}
+		}
+		applyDeviceState(state, up)
+		return state, nil
+	})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to update end device state")
+	}
+}
+
+// deleteDeviceState deletes the current state of the end device.
+func (as *ApplicationServer) deleteDeviceState(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	if as.deviceStateRegistry == nil {
+		return nil
+	}
+	_, err := as.deviceStateRegistry.Set(ctx, ids, func(*ttnpb.EndDeviceState) (*ttnpb.EndDeviceState, error) {
+		return nil, nil
+	})
+	return err
+}
+
+// applyDeviceState applies the upstream message to the end device state.
+// Uplink messages set when the end device was last seen and, if the message is decoded, the latest decoded payload and
+// battery level. Solved locations set the last location.
+func applyDeviceState(state *ttnpb.EndDeviceState, up *ttnpb.ApplicationUp) {
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		state.LastSeenAt = up.ReceivedAt
+		msg := p.UplinkMessage
+		if msg.DecodedPayload == nil {
+			return
+		}
+		state.LastDecodedPayload = msg.DecodedPayload
+		state.LastDecodedPayloadReceivedAt = up.ReceivedAt
+		state.LastDecodedPayloadFPort = msg.FPort
+		if battery, ok := batteryLevel(msg.DecodedPayload); ok {
+			state.Battery = &pbtypes.FloatValue{Value: battery}
+		}
+	case *ttnpb.ApplicationUp_LocationSolved:
+		state.LastLocation = p.LocationSolved
+	}
+}
+
+// batteryLevel returns the numeric battery level of the decoded payload, if any.
+func batteryLevel(payload *pbtypes.Struct) (float32, bool) {
+	v, ok := payload.Fields[batteryField]
+	if !ok {
+		return 0, false
+	}
+	n, ok := v.Kind.(*pbtypes.Value_NumberValue)
+	if !ok {
+		return 0, false
+	}
+	return float32(n.NumberValue), true
+}
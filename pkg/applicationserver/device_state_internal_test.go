@@ -0,0 +1,153 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestApplyDeviceState(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	earlier := time.Unix(1577836800, 0).UTC()
+	now := earlier.Add(time.Hour)
+	payload := func(fields map[string]*pbtypes.Value) *pbtypes.Struct {
+		return &pbtypes.Struct{Fields: fields}
+	}
+	number := func(v float64) *pbtypes.Value {
+		return &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: v}}
+	}
+	location := &ttnpb.ApplicationLocation{
+		Service: "test",
+		Location: ttnpb.Location{
+			Latitude:  52.3,
+			Longitude: 4.9,
+		},
+	}
+	previous := func() *ttnpb.EndDeviceState {
+		return &ttnpb.EndDeviceState{
+			EndDeviceIdentifiers:         ids,
+			LastSeenAt:                   &earlier,
+			LastDecodedPayload:           payload(map[string]*pbtypes.Value{"battery": number(3.2)}),
+			LastDecodedPayloadReceivedAt: &earlier,
+			LastDecodedPayloadFPort:      1,
+			Battery:                      &pbtypes.FloatValue{Value: 3.2},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Up       *ttnpb.ApplicationUp
+		Expected *ttnpb.EndDeviceState
+	}{
+		{
+			Name: "DecodedUplink",
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				ReceivedAt:           &now,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:          2,
+						DecodedPayload: payload(map[string]*pbtypes.Value{"battery": number(3.1), "temperature": number(21.5)}),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDeviceState{
+				EndDeviceIdentifiers:         ids,
+				LastSeenAt:                   &now,
+				LastDecodedPayload:           payload(map[string]*pbtypes.Value{"battery": number(3.1), "temperature": number(21.5)}),
+				LastDecodedPayloadReceivedAt: &now,
+				LastDecodedPayloadFPort:      2,
+				Battery:                      &pbtypes.FloatValue{Value: 3.1},
+			},
+		},
+		{
+			Name: "DecodedUplinkWithoutBattery",
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				ReceivedAt:           &now,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:          2,
+						DecodedPayload: payload(map[string]*pbtypes.Value{"temperature": number(21.5)}),
+					},
+				},
+			},
+			Expected: &ttnpb.EndDeviceState{
+				EndDeviceIdentifiers:         ids,
+				LastSeenAt:                   &now,
+				LastDecodedPayload:           payload(map[string]*pbtypes.Value{"temperature": number(21.5)}),
+				LastDecodedPayloadReceivedAt: &now,
+				LastDecodedPayloadFPort:      2,
+				Battery:                      &pbtypes.FloatValue{Value: 3.2},
+			},
+		},
+		{
+			Name: "UndecodedUplink",
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				ReceivedAt:           &now,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      2,
+						FRMPayload: []byte{0x01, 0x02},
+					},
+				},
+			},
+			Expected: &ttnpb.EndDeviceState{
+				EndDeviceIdentifiers:         ids,
+				LastSeenAt:                   &now,
+				LastDecodedPayload:           payload(map[string]*pbtypes.Value{"battery": number(3.2)}),
+				LastDecodedPayloadReceivedAt: &earlier,
+				LastDecodedPayloadFPort:      1,
+				Battery:                      &pbtypes.FloatValue{Value: 3.2},
+			},
+		},
+		{
+			Name: "LocationSolved",
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				ReceivedAt:           &now,
+				Up: &ttnpb.ApplicationUp_LocationSolved{
+					LocationSolved: location,
+				},
+			},
+			Expected: &ttnpb.EndDeviceState{
+				EndDeviceIdentifiers:         ids,
+				LastSeenAt:                   &earlier,
+				LastDecodedPayload:           payload(map[string]*pbtypes.Value{"battery": number(3.2)}),
+				LastDecodedPayloadReceivedAt: &earlier,
+				LastDecodedPayloadFPort:      1,
+				LastLocation:                 location,
+				Battery:                      &pbtypes.FloatValue{Value: 3.2},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			state := previous()
+			applyDeviceState(state, tc.Up)
+			a.So(state, should.Resemble, tc.Expected)
+		})
+	}
+}
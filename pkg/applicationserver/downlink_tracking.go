@@ -0,0 +1,206 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// trackingCorrelationIDPrefix is the prefix of the correlation ID that identifies a tracked confirmed downlink message.
+const trackingCorrelationIDPrefix = "as:downlink:track:"
+
+// downlinkTracker keeps the delivery status of the most recent confirmed downlink messages per end device in the
+// registry, so that it is shared by all Application Server instances.
+type downlinkTracker struct {
+	registry       DownlinkStatusRegistry
+	size           int
+	timeoutUplinks uint32
+	ttl            time.Duration
+}
+
+// newDownlinkTracker returns a new downlinkTracker that keeps the status of size confirmed downlink messages per end
+// device in the given registry. Sent messages time out when timeoutUplinks uplink messages are received without
+// acknowledgment. The statuses of an end device expire when no downlink or uplink message of the end device is handled
+// within the TTL.
+// If size is not positive or if there is no registry, this function returns nil, which disables tracking.
+func newDownlinkTracker(registry DownlinkStatusRegistry, size int, timeoutUplinks uint32, ttl time.Duration) *downlinkTracker {
+	if size <= 0 || registry == nil {
+		return nil
+	}
+	return &downlinkTracker{
+		registry:       registry,
+		size:           size,
+		timeoutUplinks: timeoutUplinks,
+		ttl:            ttl,
+	}
+}
+
+// trackingID returns the tracking correlation ID of the given downlink message, if any.
+func trackingID(msg *ttnpb.ApplicationDownlink) (string, bool) {
+	for _, cid := range msg.CorrelationIDs {
+		if strings.HasPrefix(cid, trackingCorrelationIDPrefix) {
+			return cid, true
+		}
+	}
+	return "", false
+}
+
+// Tag adds a tracking correlation ID to the given downlink message if it is confirmed.
+func (t *downlinkTracker) Tag(msg *ttnpb.ApplicationDownlink) {
+	if t == nil || !msg.Confirmed {
+		return
+	}
+	if _, ok := trackingID(msg); ok {
+		return
+	}
+	msg.CorrelationIDs = append(msg.CorrelationIDs, fmt.Sprintf("%s%s", trackingCorrelationIDPrefix, events.NewCorrelationID()))
+}
+
+// Add starts tracking the given downlink message with the given state, replacing the oldest tracked message of the end
+// device if the tracker is full. Messages that are not tagged are ignored, and nil is returned.
+func (t *downlinkTracker) Add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, state ttnpb.ApplicationDownlinkStatus_State) (*ttnpb.ApplicationDownlinkStatus, error) {
+	if t == nil {
+		return nil, nil
+	}
+	if _, ok := trackingID(msg); !ok {
+		return nil, nil
+	}
+	status := &ttnpb.ApplicationDownlinkStatus{
+		Downlink:  *msg,
+		State:     state,
+		UpdatedAt: time.Now().UTC(),
+	}
+	_, err := t.registry.Set(ctx, ids, t.ttl, func(stored *ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		if stored == nil {
+			stored = &ttnpb.ApplicationDownlinkStatuses{}
+		}
+		stored.Statuses = append(stored.Statuses, status)
+		if len(stored.Statuses) > t.size {
+			stored.Statuses = stored.Statuses[len(stored.Statuses)-t.size:]
+		}
+		return stored, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// Update sets the state of the given tracked downlink message. The frame counter and session key ID are taken from the
+// given message, as they change when the downlink queue is recalculated. Messages that are not tracked are ignored,
+// and nil is returned.
+func (t *downlinkTracker) Update(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink, state ttnpb.ApplicationDownlinkStatus_State) (*ttnpb.ApplicationDownlinkStatus, error) {
+	if t == nil {
+		return nil, nil
+	}
+	id, ok := trackingID(msg)
+	if !ok {
+		return nil, nil
+	}
+	var updated *ttnpb.ApplicationDownlinkStatus
+	_, err := t.registry.Set(ctx, ids, t.ttl, func(stored *ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		updated = nil
+		if stored == nil {
+			return nil, nil
+		}
+		for _, status := range stored.Statuses {
+			if trackedID, _ := trackingID(&status.Downlink); trackedID != id {
+				continue
+			}
+			status.Downlink.SessionKeyID = msg.SessionKeyID
+			status.Downlink.FCnt = msg.FCnt
+			if state == ttnpb.ApplicationDownlinkStatus_SENT && status.State != state {
+				status.UplinksSinceSent = 0
+			}
+			status.State = state
+			status.UpdatedAt = time.Now().UTC()
+			updated = status
+			break
+		}
+		return stored, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// HandleUplink counts an uplink message of the end device for the sent downlink messages, and returns the statuses of
+// the messages that timed out.
+func (t *downlinkTracker) HandleUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	if t == nil {
+		return nil, nil
+	}
+	var timedOut []*ttnpb.ApplicationDownlinkStatus
+	_, err := t.registry.Set(ctx, ids, t.ttl, func(stored *ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		timedOut = nil
+		if stored == nil {
+			return nil, nil
+		}
+		for _, status := range stored.Statuses {
+			if status.State != ttnpb.ApplicationDownlinkStatus_SENT {
+				continue
+			}
+			status.UplinksSinceSent++
+			if t.timeoutUplinks == 0 || status.UplinksSinceSent < t.timeoutUplinks {
+				continue
+			}
+			status.State = ttnpb.ApplicationDownlinkStatus_TIMED_OUT
+			status.UpdatedAt = time.Now().UTC()
+			timedOut = append(timedOut, status)
+		}
+		return stored, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return timedOut, nil
+}
+
+// List returns the statuses of the tracked downlink messages of the end device, most recent first.
+func (t *downlinkTracker) List(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	if t == nil {
+		return nil, nil
+	}
+	stored, err := t.registry.Get(ctx, ids)
+	if errors.IsNotFound(err) {
+		return []*ttnpb.ApplicationDownlinkStatus{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	res := make([]*ttnpb.ApplicationDownlinkStatus, 0, len(stored.Statuses))
+	for i := len(stored.Statuses) - 1; i >= 0; i-- {
+		res = append(res, stored.Statuses[i])
+	}
+	return res, nil
+}
+
+// Delete deletes the statuses of the tracked downlink messages of the end device.
+func (t *downlinkTracker) Delete(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	if t == nil {
+		return nil
+	}
+	_, err := t.registry.Set(ctx, ids, t.ttl, func(*ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		return nil, nil
+	})
+	return err
+}
@@ -0,0 +1,143 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkTracker(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	registry := &redis.DownlinkStatusRegistry{Redis: cl}
+
+	a.So(newDownlinkTracker(registry, 0, 3, time.Hour), should.BeNil)
+	a.So(newDownlinkTracker(nil, 2, 3, time.Hour), should.BeNil)
+
+	tracker := newDownlinkTracker(registry, 2, 2, time.Hour)
+
+	unconfirmed := &ttnpb.ApplicationDownlink{FPort: 1}
+	tracker.Tag(unconfirmed)
+	a.So(unconfirmed.CorrelationIDs, should.BeEmpty)
+	status, err := tracker.Add(ctx, ids, unconfirmed, ttnpb.ApplicationDownlinkStatus_QUEUED)
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+
+	var msgs []*ttnpb.ApplicationDownlink
+	for i := 0; i < 3; i++ {
+		msg := &ttnpb.ApplicationDownlink{
+			FPort:     uint32(i + 1),
+			FCnt:      uint32(i + 1),
+			Confirmed: true,
+		}
+		tracker.Tag(msg)
+		if !a.So(msg.CorrelationIDs, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		status, err := tracker.Add(ctx, ids, msg, ttnpb.ApplicationDownlinkStatus_QUEUED)
+		if !a.So(err, should.BeNil) || !a.So(status, should.NotBeNil) {
+			t.FailNow()
+		}
+		a.So(status.State, should.Equal, ttnpb.ApplicationDownlinkStatus_QUEUED)
+		msgs = append(msgs, msg)
+	}
+
+	// The oldest message is evicted.
+	statuses, err := tracker.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(statuses, should.HaveLength, 2) {
+		a.So(statuses[0].Downlink.FPort, should.Equal, 3)
+		a.So(statuses[1].Downlink.FPort, should.Equal, 2)
+	}
+	status, err = tracker.Update(ctx, ids, msgs[0], ttnpb.ApplicationDownlinkStatus_SENT)
+	a.So(err, should.BeNil)
+	a.So(status, should.BeNil)
+
+	sent := *msgs[1]
+	sent.FCnt = 42
+	status, err = tracker.Update(ctx, ids, &sent, ttnpb.ApplicationDownlinkStatus_SENT)
+	a.So(err, should.BeNil)
+	if a.So(status, should.NotBeNil) {
+		a.So(status.State, should.Equal, ttnpb.ApplicationDownlinkStatus_SENT)
+		a.So(status.Downlink.FCnt, should.Equal, 42)
+	}
+
+	status, err = tracker.Update(ctx, ids, msgs[2], ttnpb.ApplicationDownlinkStatus_SENT)
+	a.So(err, should.BeNil)
+	a.So(status, should.NotBeNil)
+
+	timedOut, err := tracker.HandleUplink(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(timedOut, should.BeEmpty)
+	status, err = tracker.Update(ctx, ids, msgs[2], ttnpb.ApplicationDownlinkStatus_ACKNOWLEDGED)
+	a.So(err, should.BeNil)
+	a.So(status, should.NotBeNil)
+
+	timedOut, err = tracker.HandleUplink(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(timedOut, should.HaveLength, 1) {
+		a.So(timedOut[0].Downlink.FPort, should.Equal, 2)
+		a.So(timedOut[0].State, should.Equal, ttnpb.ApplicationDownlinkStatus_TIMED_OUT)
+		a.So(timedOut[0].UplinksSinceSent, should.Equal, 2)
+	}
+
+	// The statuses are shared by the trackers of all instances.
+	otherTracker := newDownlinkTracker(registry, 2, 2, time.Hour)
+	statuses, err = otherTracker.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(statuses, should.HaveLength, 2) {
+		a.So(statuses[0].State, should.Equal, ttnpb.ApplicationDownlinkStatus_ACKNOWLEDGED)
+		a.So(statuses[0].UplinksSinceSent, should.Equal, 1)
+		a.So(statuses[1].State, should.Equal, ttnpb.ApplicationDownlinkStatus_TIMED_OUT)
+	}
+
+	otherIDs := ids
+	otherIDs.DeviceID = "bar-device"
+	statuses, err = tracker.List(ctx, otherIDs)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.BeEmpty)
+
+	// The statuses are deleted with the end device.
+	a.So(tracker.Delete(ctx, ids), should.BeNil)
+	statuses, err = tracker.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.BeEmpty)
+
+	var nilTracker *downlinkTracker
+	statuses, err = nilTracker.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.BeNil)
+	a.So(nilTracker.Delete(ctx, ids), should.BeNil)
+}
@@ -0,0 +1,192 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var (
+	evtUpdateLinkFormatters = events.Define(
+		"as.link.formatters.update", "update default payload formatters",
+		ttnpb.RIGHT_APPLICATION_LINK,
+	)
+	evtRollbackLinkFormatters = events.Define(
+		"as.link.formatters.rollback", "roll back default payload formatters",
+		ttnpb.RIGHT_APPLICATION_LINK,
+	)
+	evtUpdateEndDeviceFormatters = events.Define(
+		"as.end_device.formatters.update", "update end device payload formatters",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+	evtRollbackEndDeviceFormatters = events.Define(
+		"as.end_device.formatters.rollback", "roll back end device payload formatters",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
+)
+
+var (
+	errNoFormattersHistory       = errors.DefineUnimplemented("no_formatters_history", "no payload formatters history available")
+	errFormattersVersionNotFound = errors.DefineNotFound("formatters_version_not_found", "payload formatters version `{version}` not found")
+	errLinkNotFound              = errors.DefineNotFound("link_not_found", "link of `{application_uid}` not found")
+)
+
+// endDeviceFormattersPaths are the field paths of the payload formatters of end devices.
+var endDeviceFormattersPaths = []string{
+	"formatters",
+	"formatters.down_formatter",
+	"formatters.down_formatter_parameter",
+	"formatters.f_port_formatters",
+	"formatters.up_formatter",
+	"formatters.up_formatter_parameter",
+}
+
+// recordFormatters stores the payload formatters of the end device, or the default payload formatters of the
+// application link if the device ID is empty, as new version and publishes the change.
+// Failing to store the version does not fail the change of the payload formatters.
+func (as *ApplicationServer) recordFormatters(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, formatters *ttnpb.MessagePayloadFormatters, rollback bool) *ttnpb.PayloadFormattersVersion {
+	if formatters == nil {
+		formatters = &ttnpb.MessagePayloadFormatters{}
+	}
+	version := &ttnpb.PayloadFormattersVersion{
+		Formatters: *formatters,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if as.formattersHistory != nil {
+		stored, err := as.formattersHistory.Add(ctx, ids, deviceID, formatters, as.config.FormattersHistory.Size)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to store payload formatters version")
+		} else {
+			version = stored
+		}
+	}
+	if deviceID == "" {
+		evt := evtUpdateLinkFormatters
+		if rollback {
+			evt = evtRollbackLinkFormatters
+		}
+		events.Publish(evt(ctx, ids, version))
+	} else {
+		evt := evtUpdateEndDeviceFormatters
+		if rollback {
+			evt = evtRollbackEndDeviceFormatters
+		}
+		events.Publish(evt(ctx, ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ids,
+			DeviceID:               deviceID,
+		}, version))
+	}
+	return version
+}
+
+// ListPayloadFormattersVersions implements ttnpb.AsServer.
+func (as *ApplicationServer) ListPayloadFormattersVersions(ctx context.Context, req *ttnpb.ListPayloadFormattersVersionsRequest) (*ttnpb.PayloadFormattersVersions, error) {
+	right := ttnpb.RIGHT_APPLICATION_LINK
+	if req.DeviceID != "" {
+		right = ttnpb.RIGHT_APPLICATION_DEVICES_READ
+	}
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, right); err != nil {
+		return nil, err
+	}
+	if as.formattersHistory == nil {
+		return nil, errNoFormattersHistory
+	}
+	versions, err := as.formattersHistory.List(ctx, req.ApplicationIdentifiers, req.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.PayloadFormattersVersions{
+		Versions: versions,
+	}, nil
+}
+
+// RollbackPayloadFormatters implements ttnpb.AsServer.
+func (as *ApplicationServer) RollbackPayloadFormatters(ctx context.Context, req *ttnpb.RollbackPayloadFormattersRequest) (*ttnpb.PayloadFormattersVersion, error) {
+	right := ttnpb.RIGHT_APPLICATION_LINK
+	if req.DeviceID != "" {
+		right = ttnpb.RIGHT_APPLICATION_DEVICES_WRITE
+	}
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, right); err != nil {
+		return nil, err
+	}
+	if as.formattersHistory == nil {
+		return nil, errNoFormattersHistory
+	}
+	versions, err := as.formattersHistory.List(ctx, req.ApplicationIdentifiers, req.DeviceID)
+	if err != nil {
+		return nil, err
+	}
+	var formatters *ttnpb.MessagePayloadFormatters
+	for _, v := range versions {
+		if v.Version == req.Version {
+			formatters = &v.Formatters
+			break
+		}
+	}
+	if formatters == nil {
+		return nil, errFormattersVersionNotFound.WithAttributes("version", req.Version)
+	}
+
+	if req.DeviceID == "" {
+		_, err := as.linkRegistry.Set(ctx, req.ApplicationIdentifiers, []string{"default_formatters"},
+			func(link *ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
+				if link == nil {
+					return nil, nil, errLinkNotFound.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIdentifiers))
+				}
+				link.DefaultFormatters = formatters
+				return link, []string{"default_formatters"}, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+		as.restartLink(ctx, req.ApplicationIdentifiers)
+	} else {
+		ids := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: req.ApplicationIdentifiers,
+			DeviceID:               req.DeviceID,
+		}
+		_, err := as.deviceRegistry.Set(ctx, ids, []string{"formatters"},
+			func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+				if dev == nil {
+					return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
+				}
+				dev.Formatters = formatters
+				return dev, []string{"formatters"}, nil
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return as.recordFormatters(ctx, req.ApplicationIdentifiers, req.DeviceID, formatters, true), nil
+}
+
+// deleteFormattersHistory deletes the stored versions of the payload formatters of the end device, or of the default
+// payload formatters of the application link if the device ID is empty.
+func (as *ApplicationServer) deleteFormattersHistory(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) error {
+	if as.formattersHistory == nil {
+		return nil
+	}
+	return as.formattersHistory.Delete(ctx, ids, deviceID)
+}
@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
@@ -38,18 +39,20 @@ func (as *ApplicationServer) SetLink(ctx context.Context, req *ttnpb.SetApplicat
 		return nil, err
 	}
 	// Get all the fields here for starting the link task.
+	var formatters *ttnpb.MessagePayloadFormatters
 	link, err := as.linkRegistry.Set(ctx, req.ApplicationIdentifiers, ttnpb.ApplicationLinkFieldPathsTopLevel,
 		func(link *ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error) {
+			formatters = link.GetDefaultFormatters()
 			return &req.ApplicationLink, req.FieldMask.Paths, nil
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
-	if err := as.cancelLink(ctx, req.ApplicationIdentifiers); err != nil && !errors.IsNotFound(err) {
-		log.FromContext(ctx).WithError(err).Warn("Failed to cancel link")
+	if !reflect.DeepEqual(link.DefaultFormatters, formatters) {
+		as.recordFormatters(ctx, req.ApplicationIdentifiers, "", link.DefaultFormatters, false)
 	}
-	as.startLinkTask(as.Context(), req.ApplicationIdentifiers)
+	as.restartLink(ctx, req.ApplicationIdentifiers)
 
 	res := &ttnpb.ApplicationLink{}
 	if err := res.SetFields(link, req.FieldMask.Paths...); err != nil {
@@ -70,9 +73,21 @@ func (as *ApplicationServer) DeleteLink(ctx context.Context, ids *ttnpb.Applicat
 	if err != nil {
 		return nil, err
 	}
+	if err := as.deleteFormattersHistory(ctx, *ids, ""); err != nil {
+		return nil, err
+	}
 	return ttnpb.Empty, nil
 }
 
+// restartLink cancels the link of the application, if any, and starts a new link task that uses the current link
+// configuration.
+func (as *ApplicationServer) restartLink(ctx context.Context, ids ttnpb.ApplicationIdentifiers) {
+	if err := as.cancelLink(ctx, ids); err != nil && !errors.IsNotFound(err) {
+		log.FromContext(ctx).WithError(err).Warn("Failed to cancel link")
+	}
+	as.startLinkTask(as.Context(), ids)
+}
+
 // GetLinkStats implements ttnpb.AsServer.
 func (as *ApplicationServer) GetLinkStats(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.ApplicationLinkStats, error) {
 	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
@@ -99,3 +114,22 @@ func (as *ApplicationServer) GetLinkStats(ctx context.Context, ids *ttnpb.Applic
 	}
 	return stats, nil
 }
+
+// GetTrafficStats implements ttnpb.AsServer.
+func (as *ApplicationServer) GetTrafficStats(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*ttnpb.ApplicationTrafficStats, error) {
+	if err := rights.RequireApplication(ctx, *ids, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
+		return nil, err
+	}
+
+	link, err := as.getLink(ctx, *ids)
+	if err != nil {
+		return nil, err
+	}
+	<-link.connReady
+
+	stats := &ttnpb.ApplicationTrafficStats{
+		StartedAt: link.GetLinkTime(),
+	}
+	stats.Uplink, stats.Downlink, stats.DeviceProfiles = link.traffic.Stats()
+	return stats, nil
+}
@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"reflect"
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
@@ -149,8 +150,15 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		)
 	}
 
+	// Get the payload formatters to record the changes of the payload formatters.
+	gets := ttnpb.AddFields(append(req.FieldMask.Paths[:0:0], req.FieldMask.Paths...),
+		"formatters",
+	)
+
 	var evt events.Event
-	dev, err := r.AS.deviceRegistry.Set(ctx, req.EndDevice.EndDeviceIdentifiers, req.FieldMask.Paths, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+	var formatters *ttnpb.MessagePayloadFormatters
+	dev, err := r.AS.deviceRegistry.Set(ctx, req.EndDevice.EndDeviceIdentifiers, gets, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+		formatters = dev.GetFormatters()
 		if dev != nil {
 			evt = evtUpdateEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, req.FieldMask.Paths)
 			if err := ttnpb.ProhibitFields(sets,
@@ -183,6 +191,12 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 				"ids.dev_eui",
 			)
 		}
+		if !ttnpb.HasAnyField(sets, endDeviceFormattersPaths...) && ttnpb.HasAnyField(sets, "version_ids") {
+			req.EndDevice.Formatters = nil
+			if paths := r.AS.assignRepositoryFormatters(ctx, &req.EndDevice); len(paths) > 0 {
+				sets = ttnpb.AddFields(sets, paths...)
+			}
+		}
 		return &req.EndDevice, sets, nil
 	})
 	if err != nil {
@@ -191,6 +205,9 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 	if evt != nil {
 		events.Publish(evt)
 	}
+	if !reflect.DeepEqual(dev.Formatters, formatters) {
+		r.AS.recordFormatters(ctx, dev.ApplicationIdentifiers, dev.DeviceID, dev.Formatters, false)
+	}
 	return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
 }
 
@@ -209,6 +226,18 @@ func (r asEndDeviceRegistryServer) Delete(ctx context.Context, ids *ttnpb.EndDev
 	if err != nil {
 		return nil, err
 	}
+	if err := r.AS.deleteDeviceState(ctx, *ids); err != nil {
+		return nil, err
+	}
+	if err := r.AS.deleteRetainedUplink(ctx, *ids); err != nil {
+		return nil, err
+	}
+	if err := r.AS.deleteFormattersHistory(ctx, ids.ApplicationIdentifiers, ids.DeviceID); err != nil {
+		return nil, err
+	}
+	if err := r.AS.downlinkTracker.Delete(ctx, *ids); err != nil {
+		return nil, err
+	}
 	if evt != nil {
 		events.Publish(evt)
 	}
@@ -0,0 +1,141 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"strconv"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// UpFPort returns the FPort of the upstream message, if the message has an FPort.
+func UpFPort(up *ttnpb.ApplicationUp) (uint32, bool) {
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return p.UplinkMessage.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return p.DownlinkAck.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return p.DownlinkNack.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return p.DownlinkSent.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return p.DownlinkFailed.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return p.DownlinkQueued.FPort, true
+	}
+	return 0, false
+}
+
+// MatchesUpFilter returns whether the upstream message passes the filter. All messages pass a nil filter.
+func MatchesUpFilter(filter *ttnpb.ApplicationUpFilter, up *ttnpb.ApplicationUp) bool {
+	if filter == nil {
+		return true
+	}
+	if fPort, ok := UpFPort(up); ok && len(filter.FPortRanges) > 0 {
+		var match bool
+		for _, r := range filter.FPortRanges {
+			if fPort >= r.Min && fPort <= r.Max {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+	if uplink := up.GetUplinkMessage(); uplink != nil && len(filter.DecodedPayloadConditions) > 0 {
+		if uplink.DecodedPayload == nil {
+			return false
+		}
+		for _, cond := range filter.DecodedPayloadConditions {
+			if !matchesDecodedPayloadCondition(cond, uplink.DecodedPayload) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// decodedPayloadField returns the value of the field of the decoded payload by its path.
+func decodedPayloadField(payload *pbtypes.Struct, path string) (*pbtypes.Value, bool) {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		v, ok := payload.GetFields()[part]
+		if !ok {
+			return nil, false
+		}
+		if i == len(parts)-1 {
+			return v, true
+		}
+		if payload = v.GetStructValue(); payload == nil {
+			return nil, false
+		}
+	}
+	return nil, false
+}
+
+// matchesDecodedPayloadCondition returns whether the decoded payload matches the condition.
+// Conditions on fields that are not in the decoded payload never match, except for the EXISTS operator.
+func matchesDecodedPayloadCondition(cond *ttnpb.DecodedPayloadCondition, payload *pbtypes.Struct) bool {
+	v, ok := decodedPayloadField(payload, cond.Field)
+	if cond.Operator == ttnpb.DecodedPayloadCondition_EXISTS || !ok {
+		return ok
+	}
+	switch kind := v.Kind.(type) {
+	case *pbtypes.Value_NumberValue:
+		n, err := strconv.ParseFloat(cond.Value, 64)
+		if err != nil {
+			return false
+		}
+		switch cond.Operator {
+		case ttnpb.DecodedPayloadCondition_EQUAL:
+			return kind.NumberValue == n
+		case ttnpb.DecodedPayloadCondition_NOT_EQUAL:
+			return kind.NumberValue != n
+		case ttnpb.DecodedPayloadCondition_GREATER_THAN:
+			return kind.NumberValue > n
+		case ttnpb.DecodedPayloadCondition_GREATER_THAN_OR_EQUAL:
+			return kind.NumberValue >= n
+		case ttnpb.DecodedPayloadCondition_LESS_THAN:
+			return kind.NumberValue < n
+		case ttnpb.DecodedPayloadCondition_LESS_THAN_OR_EQUAL:
+			return kind.NumberValue <= n
+		}
+	case *pbtypes.Value_BoolValue:
+		b, err := strconv.ParseBool(cond.Value)
+		if err != nil {
+			return false
+		}
+		return matchesEquality(cond.Operator, kind.BoolValue == b)
+	case *pbtypes.Value_StringValue:
+		return matchesEquality(cond.Operator, kind.StringValue == cond.Value)
+	}
+	return false
+}
+
+// matchesEquality returns whether the equality matches the EQUAL or NOT_EQUAL operator.
+// Other operators never match.
+func matchesEquality(op ttnpb.DecodedPayloadCondition_Operator, equal bool) bool {
+	switch op {
+	case ttnpb.DecodedPayloadCondition_EQUAL:
+		return equal
+	case ttnpb.DecodedPayloadCondition_NOT_EQUAL:
+		return !equal
+	}
+	return false
+}
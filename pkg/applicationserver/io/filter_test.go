@@ -0,0 +1,149 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMatchesUpFilter(t *testing.T) {
+	uplink := func(fPort uint32, payload map[string]*pbtypes.Value) *ttnpb.ApplicationUp {
+		up := &ttnpb.ApplicationUplink{FPort: fPort}
+		if payload != nil {
+			up.DecodedPayload = &pbtypes.Struct{Fields: payload}
+		}
+		return &ttnpb.ApplicationUp{
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: up},
+		}
+	}
+	number := func(v float64) *pbtypes.Value {
+		return &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: v}}
+	}
+	str := func(v string) *pbtypes.Value {
+		return &pbtypes.Value{Kind: &pbtypes.Value_StringValue{StringValue: v}}
+	}
+	payload := map[string]*pbtypes.Value{
+		"temperature": number(31.5),
+		"status":      str("alarm"),
+		"sensor": {Kind: &pbtypes.Value_StructValue{StructValue: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{"battery": number(20)},
+		}}},
+	}
+	condition := func(field string, op ttnpb.DecodedPayloadCondition_Operator, value string) *ttnpb.ApplicationUpFilter {
+		return &ttnpb.ApplicationUpFilter{
+			DecodedPayloadConditions: []*ttnpb.DecodedPayloadCondition{
+				{Field: field, Operator: op, Value: value},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		Name    string
+		Filter  *ttnpb.ApplicationUpFilter
+		Up      *ttnpb.ApplicationUp
+		Matches bool
+	}{
+		{
+			Name:    "NoFilter",
+			Up:      uplink(1, nil),
+			Matches: true,
+		},
+		{
+			Name: "FPortInRange",
+			Filter: &ttnpb.ApplicationUpFilter{
+				FPortRanges: []*ttnpb.FPortRange{{Min: 1, Max: 9}, {Min: 20, Max: 29}},
+			},
+			Up:      uplink(25, nil),
+			Matches: true,
+		},
+		{
+			Name: "FPortOutOfRange",
+			Filter: &ttnpb.ApplicationUpFilter{
+				FPortRanges: []*ttnpb.FPortRange{{Min: 1, Max: 9}, {Min: 20, Max: 29}},
+			},
+			Up:      uplink(15, nil),
+			Matches: false,
+		},
+		{
+			Name: "FPortRangeWithoutFPort",
+			Filter: &ttnpb.ApplicationUpFilter{
+				FPortRanges: []*ttnpb.FPortRange{{Min: 1, Max: 9}},
+			},
+			Up: &ttnpb.ApplicationUp{
+				Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{}},
+			},
+			Matches: true,
+		},
+		{
+			Name:    "GreaterThan",
+			Filter:  condition("temperature", ttnpb.DecodedPayloadCondition_GREATER_THAN, "30"),
+			Up:      uplink(1, payload),
+			Matches: true,
+		},
+		{
+			Name:    "NotGreaterThan",
+			Filter:  condition("temperature", ttnpb.DecodedPayloadCondition_GREATER_THAN, "35"),
+			Up:      uplink(1, payload),
+			Matches: false,
+		},
+		{
+			Name:    "NestedLessThanOrEqual",
+			Filter:  condition("sensor.battery", ttnpb.DecodedPayloadCondition_LESS_THAN_OR_EQUAL, "20"),
+			Up:      uplink(1, payload),
+			Matches: true,
+		},
+		{
+			Name:    "StringEqual",
+			Filter:  condition("status", ttnpb.DecodedPayloadCondition_EQUAL, "alarm"),
+			Up:      uplink(1, payload),
+			Matches: true,
+		},
+		{
+			Name:    "StringGreaterThan",
+			Filter:  condition("status", ttnpb.DecodedPayloadCondition_GREATER_THAN, "a"),
+			Up:      uplink(1, payload),
+			Matches: false,
+		},
+		{
+			Name:    "Exists",
+			Filter:  condition("status", ttnpb.DecodedPayloadCondition_EXISTS, ""),
+			Up:      uplink(1, payload),
+			Matches: true,
+		},
+		{
+			Name:    "MissingField",
+			Filter:  condition("humidity", ttnpb.DecodedPayloadCondition_NOT_EQUAL, "0"),
+			Up:      uplink(1, payload),
+			Matches: false,
+		},
+		{
+			Name:    "NoDecodedPayload",
+			Filter:  condition("temperature", ttnpb.DecodedPayloadCondition_GREATER_THAN, "30"),
+			Up:      uplink(1, nil),
+			Matches: false,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(MatchesUpFilter(tc.Filter, tc.Up), should.Equal, tc.Matches)
+		})
+	}
+}
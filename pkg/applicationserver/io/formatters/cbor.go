@@ -0,0 +1,126 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	encjson "encoding/json"
+	"fmt"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// cbor is a formatter that uses CBOR marshaling.
+// The structure of the messages is the same as the structure of their JSON representation.
+type cbor struct {
+}
+
+func (cbor) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
+	buf, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	dec := encjson.NewDecoder(bytes.NewReader(buf))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return fxcbor.Marshal(fromJSONValue(v))
+}
+
+func (cbor) ToDownlinks(data []byte) (*ttnpb.ApplicationDownlinks, error) {
+	buf, err := cborToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ApplicationDownlinks{}
+	if err := jsonpb.TTN().Unmarshal(buf, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (cbor) ToDownlinkQueueRequest(data []byte) (*ttnpb.DownlinkQueueRequest, error) {
+	buf, err := cborToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.DownlinkQueueRequest{}
+	if err := jsonpb.TTN().Unmarshal(buf, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// cborToJSON returns the JSON representation of the given CBOR data item.
+func cborToJSON(data []byte) ([]byte, error) {
+	var v interface{}
+	if err := fxcbor.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return encjson.Marshal(toJSONValue(v))
+}
+
+// fromJSONValue replaces the numbers in the given JSON value by integers where possible, so that they are encoded as
+// CBOR integers instead of floating point numbers.
+func fromJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = fromJSONValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = fromJSONValue(e)
+		}
+	case encjson.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	}
+	return v
+}
+
+// toJSONValue replaces the maps with non-string keys in the given CBOR value by maps with string keys, so that the
+// value can be marshaled to JSON. Byte strings are marshaled as base64 encoded strings, as in the JSON representation.
+func toJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = toJSONValue(e)
+		}
+		return m
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = toJSONValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = toJSONValue(e)
+		}
+	}
+	return v
+}
+
+// CBOR is a formatter that uses CBOR marshaling.
+var CBOR Formatter = &cbor{}
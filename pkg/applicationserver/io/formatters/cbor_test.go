@@ -0,0 +1,180 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"strconv"
+	"testing"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func mustMarshalCBOR(t *testing.T, v interface{}) []byte {
+	buf, err := fxcbor.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal CBOR: %v", err)
+	}
+	return buf
+}
+
+func TestCBORUpstream(t *testing.T) {
+	a := assertions.New(t)
+	formatter := formatters.CBOR
+
+	buf, err := formatter.FromUp(&ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "foo-app",
+			},
+			DeviceID: "foo-device",
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      42,
+				FCnt:       42,
+				FRMPayload: []byte{0x1, 0x2, 0x3},
+				DecodedPayload: &pbtypes.Struct{
+					Fields: map[string]*pbtypes.Value{
+						"test_key": {
+							Kind: &pbtypes.Value_NumberValue{
+								NumberValue: 4.2,
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var res struct {
+		EndDeviceIDs struct {
+			DeviceID string `cbor:"device_id"`
+		} `cbor:"end_device_ids"`
+		UplinkMessage struct {
+			FPort          uint64             `cbor:"f_port"`
+			FCnt           uint64             `cbor:"f_cnt"`
+			FRMPayload     string             `cbor:"frm_payload"`
+			DecodedPayload map[string]float64 `cbor:"decoded_payload"`
+		} `cbor:"uplink_message"`
+	}
+	if !a.So(fxcbor.Unmarshal(buf, &res), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.EndDeviceIDs.DeviceID, should.Equal, "foo-device")
+	a.So(res.UplinkMessage.FPort, should.Equal, 42)
+	a.So(res.UplinkMessage.FCnt, should.Equal, 42)
+	a.So(res.UplinkMessage.FRMPayload, should.Equal, "AQID")
+	a.So(res.UplinkMessage.DecodedPayload, should.Resemble, map[string]float64{"test_key": 4.2})
+}
+
+func TestCBORDownstream(t *testing.T) {
+	formatter := formatters.CBOR
+
+	t.Run("Downlinks", func(t *testing.T) {
+		for i, tc := range []struct {
+			Input          []byte
+			Items          *ttnpb.ApplicationDownlinks
+			ErrorAssertion func(*testing.T, error) bool
+		}{
+			{
+				Input: []byte{0xff},
+				ErrorAssertion: func(t *testing.T, err error) bool {
+					return assertions.New(t).So(err, should.NotBeNil)
+				},
+			},
+			{
+				Input: mustMarshalCBOR(t, map[string]interface{}{
+					"downlinks": []interface{}{
+						map[string]interface{}{
+							"f_port":      42,
+							"frm_payload": "AQEB",
+							"confirmed":   true,
+						},
+						map[string]interface{}{
+							"f_port":      42,
+							"frm_payload": []byte{0x2, 0x2, 0x2},
+							"confirmed":   true,
+						},
+					},
+				}),
+				Items: &ttnpb.ApplicationDownlinks{
+					Downlinks: []*ttnpb.ApplicationDownlink{
+						{
+							FPort:      42,
+							FRMPayload: []byte{0x1, 0x1, 0x1},
+							Confirmed:  true,
+						},
+						{
+							FPort:      42,
+							FRMPayload: []byte{0x2, 0x2, 0x2},
+							Confirmed:  true,
+						},
+					},
+				},
+			},
+		} {
+			t.Run(strconv.Itoa(i), func(t *testing.T) {
+				a := assertions.New(t)
+				res, err := formatter.ToDownlinks(tc.Input)
+				if tc.ErrorAssertion != nil && !tc.ErrorAssertion(t, err) || tc.ErrorAssertion == nil && !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(res, should.Resemble, tc.Items)
+			})
+		}
+	})
+
+	t.Run("DownlinkQueueRequest", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := formatter.ToDownlinkQueueRequest(mustMarshalCBOR(t, map[string]interface{}{
+			"end_device_ids": map[string]interface{}{
+				"application_ids": map[string]interface{}{
+					"application_id": "foo-app",
+				},
+				"device_id": "foo-device",
+			},
+			"downlinks": []interface{}{
+				map[string]interface{}{
+					"f_port":      42,
+					"frm_payload": "AQEB",
+				},
+			},
+		}))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res, should.Resemble, &ttnpb.DownlinkQueueRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+					ApplicationID: "foo-app",
+				},
+				DeviceID: "foo-device",
+			},
+			Downlinks: []*ttnpb.ApplicationDownlink{
+				{
+					FPort:      42,
+					FRMPayload: []byte{0x1, 0x1, 0x1},
+				},
+			},
+		})
+	})
+}
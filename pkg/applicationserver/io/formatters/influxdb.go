@@ -0,0 +1,145 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// InfluxDBMeasurement is the measurement of the points written by the InfluxDB formatter.
+const InfluxDBMeasurement = "uplink_message"
+
+var (
+	errInfluxDBMessageType = errors.DefineInvalidArgument("influxdb_message_type", "message type `{type}` is not supported by the InfluxDB format, only uplink messages are")
+	errInfluxDBNoFields    = errors.DefineInvalidArgument("influxdb_no_fields", "no decoded payload fields to write to InfluxDB")
+	errInfluxDBDownlink    = errors.DefineUnimplemented("influxdb_downlink", "downlink messages are not supported by the InfluxDB format")
+)
+
+// influxDB is a formatter that encodes the decoded payload of uplink messages as a point in InfluxDB line protocol.
+// The identifiers of the end device and the FPort are the tags of the point, the decoded payload values are the fields.
+// Nested objects and lists are flattened; their keys are joined with underscores.
+type influxDB struct {
+}
+
+var (
+	influxDBKeyReplacer    = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `, "\n", `\n`)
+	influxDBStringReplacer = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// influxDBFields adds the line protocol field values of v to fields, with key as key or key prefix.
+func influxDBFields(fields map[string]string, key string, v *pbtypes.Value) {
+	switch v := v.GetKind().(type) {
+	case *pbtypes.Value_NumberValue:
+		fields[key] = strconv.FormatFloat(v.NumberValue, 'g', -1, 64)
+	case *pbtypes.Value_StringValue:
+		fields[key] = `"` + influxDBStringReplacer.Replace(v.StringValue) + `"`
+	case *pbtypes.Value_BoolValue:
+		fields[key] = strconv.FormatBool(v.BoolValue)
+	case *pbtypes.Value_StructValue:
+		for k, e := range v.StructValue.GetFields() {
+			if key != "" {
+				k = key + "_" + k
+			}
+			influxDBFields(fields, k, e)
+		}
+	case *pbtypes.Value_ListValue:
+		for i, e := range v.ListValue.GetValues() {
+			k := strconv.Itoa(i)
+			if key != "" {
+				k = key + "_" + k
+			}
+			influxDBFields(fields, k, e)
+		}
+	}
+}
+
+// writeInfluxDBPairs writes the given key-value pairs sorted by key to buf.
+func writeInfluxDBPairs(buf *bytes.Buffer, pairs map[string]string, escapeValue bool) {
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		v := pairs[k]
+		if escapeValue {
+			v = influxDBKeyReplacer.Replace(v)
+		}
+		fmt.Fprintf(buf, "%s=%s", influxDBKeyReplacer.Replace(k), v)
+	}
+}
+
+func (influxDB) FromUp(msg *ttnpb.ApplicationUp) ([]byte, error) {
+	up := msg.GetUplinkMessage()
+	if up == nil {
+		return nil, errInfluxDBMessageType.WithAttributes("type", fmt.Sprintf("%T", msg.Up))
+	}
+	fields := make(map[string]string)
+	influxDBFields(fields, "", &pbtypes.Value{
+		Kind: &pbtypes.Value_StructValue{StructValue: up.DecodedPayload},
+	})
+	if len(fields) == 0 {
+		return nil, errInfluxDBNoFields
+	}
+
+	tags := map[string]string{
+		"application_id": msg.ApplicationID,
+		"device_id":      msg.DeviceID,
+		"f_port":         strconv.FormatUint(uint64(up.FPort), 10),
+	}
+	if msg.DevEUI != nil && !msg.DevEUI.IsZero() {
+		tags["dev_eui"] = msg.DevEUI.String()
+	}
+	if msg.JoinEUI != nil && !msg.JoinEUI.IsZero() {
+		tags["join_eui"] = msg.JoinEUI.String()
+	}
+	if msg.DevAddr != nil && !msg.DevAddr.IsZero() {
+		tags["dev_addr"] = msg.DevAddr.String()
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(InfluxDBMeasurement)
+	buf.WriteByte(',')
+	writeInfluxDBPairs(&buf, tags, true)
+	buf.WriteByte(' ')
+	writeInfluxDBPairs(&buf, fields, false)
+	if !up.ReceivedAt.IsZero() {
+		fmt.Fprintf(&buf, " %d", up.ReceivedAt.UnixNano())
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+func (influxDB) ToDownlinks([]byte) (*ttnpb.ApplicationDownlinks, error) {
+	return nil, errInfluxDBDownlink
+}
+
+func (influxDB) ToDownlinkQueueRequest([]byte) (*ttnpb.DownlinkQueueRequest, error) {
+	return nil, errInfluxDBDownlink
+}
+
+// InfluxDB is a formatter that encodes the decoded payload of uplink messages in InfluxDB line protocol.
+var InfluxDB Formatter = &influxDB{}
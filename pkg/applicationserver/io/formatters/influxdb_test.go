@@ -0,0 +1,142 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters_test
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestInfluxDBUpstream(t *testing.T) {
+	formatter := formatters.InfluxDB
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+		DevEUI:   &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+		DevAddr:  &types.DevAddr{0x42, 0xff, 0xff, 0xff},
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Message        *ttnpb.ApplicationUp
+		Result         string
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name: "Uplink",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      42,
+						FCnt:       42,
+						FRMPayload: []byte{0x1, 0x2, 0x3},
+						DecodedPayload: &pbtypes.Struct{
+							Fields: map[string]*pbtypes.Value{
+								"temperature": {
+									Kind: &pbtypes.Value_NumberValue{NumberValue: 21.5},
+								},
+								"battery low": {
+									Kind: &pbtypes.Value_BoolValue{BoolValue: true},
+								},
+								"status": {
+									Kind: &pbtypes.Value_StringValue{StringValue: `say "hi"`},
+								},
+								"accelerometer": {
+									Kind: &pbtypes.Value_StructValue{StructValue: &pbtypes.Struct{
+										Fields: map[string]*pbtypes.Value{
+											"x": {Kind: &pbtypes.Value_NumberValue{NumberValue: 1}},
+											"y": {Kind: &pbtypes.Value_NumberValue{NumberValue: -1}},
+										},
+									}},
+								},
+								"levels": {
+									Kind: &pbtypes.Value_ListValue{ListValue: &pbtypes.ListValue{
+										Values: []*pbtypes.Value{
+											{Kind: &pbtypes.Value_NumberValue{NumberValue: 3}},
+											{Kind: &pbtypes.Value_NullValue{}},
+										},
+									}},
+								},
+							},
+						},
+						ReceivedAt: time.Unix(1577836800, 42).UTC(),
+					},
+				},
+			},
+			Result: `uplink_message,application_id=foo-app,dev_addr=42FFFFFF,dev_eui=4242424242424242,device_id=foo-device,f_port=42 accelerometer_x=1,accelerometer_y=-1,battery\ low=true,levels_0=3,status="say \"hi\"",temperature=21.5 1577836800000000042` + "\n",
+		},
+		{
+			Name: "NoDecodedPayload",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{
+						FPort:      42,
+						FRMPayload: []byte{0x1, 0x2, 0x3},
+					},
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "JoinAccept",
+			Message: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{
+						SessionKeyID: []byte{0x11, 0x22, 0x33, 0x44},
+					},
+				},
+			},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			buf, err := formatter.FromUp(tc.Message)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(string(buf), should.Equal, tc.Result)
+		})
+	}
+}
+
+func TestInfluxDBDownstream(t *testing.T) {
+	a := assertions.New(t)
+	formatter := formatters.InfluxDB
+
+	_, err := formatter.ToDownlinks([]byte("uplink_message value=1"))
+	a.So(err, should.NotBeNil)
+
+	_, err = formatter.ToDownlinkQueueRequest([]byte("uplink_message value=1"))
+	a.So(err, should.NotBeNil)
+}
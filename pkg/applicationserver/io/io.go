@@ -43,6 +43,17 @@ type Server interface {
 	DownlinkQueueReplace(context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
 	// DownlinkQueueList lists the application downlink queue of the given end device.
 	DownlinkQueueList(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error)
+	// DownlinkStatusList lists the delivery status of the tracked confirmed downlink messages of the given end device.
+	DownlinkStatusList(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error)
+	// GetEndDeviceState returns the current state of the given end device.
+	GetEndDeviceState(context.Context, ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceState, error)
+	// DecodeUplink decodes the given uplink message of the end device, without processing the uplink message.
+	DecodeUplink(context.Context, *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error)
+	// EncodeDownlink encodes the given downlink message of the end device, without scheduling the downlink message.
+	EncodeDownlink(context.Context, *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error)
+	// RangeRetainedUplinks ranges the last uplink messages of the end devices of the given application and calls the
+	// callback function, until false is returned.
+	RangeRetainedUplinks(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.ApplicationUp) bool) error
 }
 
 // ContextualApplicationUp represents an ttnpb.ApplicationUp with its context.
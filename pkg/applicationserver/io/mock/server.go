@@ -108,6 +108,37 @@ func (s *server) DownlinkQueueList(ctx context.Context, ids ttnpb.EndDeviceIdent
 	return queue, nil
 }
 
+// DownlinkStatusList implements io.Server.
+func (s *server) DownlinkStatusList(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlinkStatus, error) {
+	return nil, nil
+}
+
+// GetEndDeviceState implements io.Server.
+func (s *server) GetEndDeviceState(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceState, error) {
+	return &ttnpb.EndDeviceState{
+		EndDeviceIdentifiers: ids,
+	}, nil
+}
+
+// DecodeUplink implements io.Server.
+func (s *server) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+	return &ttnpb.DecodeUplinkResponse{
+		Uplink: req.Uplink,
+	}, nil
+}
+
+// EncodeDownlink implements io.Server.
+func (s *server) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error) {
+	return &ttnpb.EncodeDownlinkResponse{
+		Downlink: req.Downlink,
+	}, nil
+}
+
+// RangeRetainedUplinks implements io.Server.
+func (s *server) RangeRetainedUplinks(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.ApplicationUp) bool) error {
+	return nil
+}
+
 func (s *server) Subscriptions() <-chan *io.Subscription {
 	return s.subscriptionsCh
 }
@@ -24,3 +24,27 @@ type Format interface {
 	topics.Layout
 	formatters.Formatter
 }
+
+type format struct {
+	topics.Layout
+	formatters.Formatter
+}
+
+type upstreamFormat struct {
+	topics.UpstreamLayout
+	formatters.Formatter
+}
+
+// NewFormat returns a format that uses the topic layout and message formatter.
+func NewFormat(layout topics.Layout, formatter formatters.Formatter) Format {
+	if upstream, ok := layout.(topics.UpstreamLayout); ok {
+		return &upstreamFormat{
+			UpstreamLayout: upstream,
+			Formatter:      formatter,
+		}
+	}
+	return &format{
+		Layout:    layout,
+		Formatter: formatter,
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	stdio "io"
 	"net"
+	"sync"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttlog "github.com/TheThingsIndustries/mystique/pkg/log"
@@ -28,6 +29,8 @@ import (
 	"github.com/TheThingsIndustries/mystique/pkg/session"
 	"github.com/TheThingsIndustries/mystique/pkg/topic"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt/topics"
+	ttnauth "go.thethings.network/lorawan-stack/pkg/auth"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/log"
@@ -40,17 +43,29 @@ import (
 const qosUpstream byte = 0
 
 type srv struct {
-	ctx    context.Context
-	server io.Server
-	format Format
-	lis    mqttnet.Listener
+	ctx           context.Context
+	server        io.Server
+	format        Format
+	lis           mqttnet.Listener
+	shared        *sharedGroups
+	rateLimit     RateLimitConfig
+	retainUplinks bool
 }
 
 // Start starts the MQTT frontend.
-func Start(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) {
+func Start(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option) {
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	ctx = mqttlog.NewContext(ctx, mqtt.Logger(log.FromContext(ctx)))
-	s := &srv{ctx, server, format, mqttnet.NewListener(listener, protocol)}
+	s := &srv{
+		ctx:    ctx,
+		server: server,
+		format: format,
+		lis:    mqttnet.NewListener(listener, protocol),
+		shared: &sharedGroups{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
 	go s.accept()
 	go func() {
 		<-ctx.Done()
@@ -70,7 +85,14 @@ func (s *srv) accept() {
 
 		go func() {
 			ctx := log.NewContextWithFields(s.ctx, log.Fields("remote_addr", mqttConn.RemoteAddr().String()))
-			conn := &connection{server: s.server, mqtt: mqttConn, format: s.format}
+			conn := &connection{
+				server:        s.server,
+				mqtt:          mqttConn,
+				format:        s.format,
+				shared:        s.shared,
+				rateLimit:     s.rateLimit,
+				retainUplinks: s.retainUplinks,
+			}
 			if err := conn.setup(ctx); err != nil {
 				log.FromContext(ctx).WithError(err).Warn("Failed to setup connection")
 				mqttConn.Close()
@@ -86,11 +108,23 @@ type connection struct {
 	mqtt    mqttnet.Conn
 	session session.Session
 	io      *io.Subscription
+	appUID  string
+	shared  *sharedGroups
+
+	rateLimit RateLimitConfig
+	limits    RateLimits
+
+	subscriptionsMu sync.RWMutex
+	subscriptions   map[string]subscription
+
+	retainUplinks bool
+	retainedMu    sync.Mutex
+	retained      [][]string
 }
 
 func (c *connection) setup(ctx context.Context) error {
 	ctx = auth.NewContextWithInterface(ctx, c)
-	c.session = session.New(ctx, c.mqtt, c.deliver)
+	c.session = session.New(ctx, &rateLimitConn{Conn: &unsubscribeConn{Conn: c.mqtt, c: c}, c: c}, c.deliver)
 	if err := c.session.ReadConnect(); err != nil {
 		return err
 	}
@@ -126,27 +160,10 @@ func (c *connection) setup(ctx context.Context) error {
 				logger.WithError(c.io.Context().Err()).Debug("Done sending upstream messages")
 				return
 			case up := <-c.io.Up():
-				logger := logger.WithField("device_uid", unique.ID(up.Context, up.EndDeviceIdentifiers))
-				var topicParts []string
-				switch up.Up.(type) {
-				case *ttnpb.ApplicationUp_UplinkMessage:
-					topicParts = c.format.UplinkTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_JoinAccept:
-					topicParts = c.format.JoinAcceptTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkAck:
-					topicParts = c.format.DownlinkAckTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkNack:
-					topicParts = c.format.DownlinkNackTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkSent:
-					topicParts = c.format.DownlinkSentTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkFailed:
-					topicParts = c.format.DownlinkFailedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_DownlinkQueued:
-					topicParts = c.format.DownlinkQueuedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				case *ttnpb.ApplicationUp_LocationSolved:
-					topicParts = c.format.LocationSolvedTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.DeviceID)
-				}
-				if topicParts == nil {
+				deviceUID := unique.ID(up.Context, up.EndDeviceIdentifiers)
+				logger := logger.WithField("device_uid", deviceUID)
+				topicParts := c.upstreamTopic(unique.ID(up.Context, c.io.ApplicationIDs()), up.ApplicationUp)
+				if topicParts == nil || !c.shouldPublish(topicParts, deviceUID) {
 					continue
 				}
 				buf, err := c.format.FromUp(up.ApplicationUp)
@@ -177,6 +194,9 @@ func (c *connection) setup(ctx context.Context) error {
 					continue
 				}
 				err = c.mqtt.Send(pkt)
+				if _, ok := pkt.(*packet.SubackPacket); ok && err == nil {
+					go c.publishRetained(ctx)
+				}
 			case pkt, ok := <-c.session.PublishChan():
 				if !ok {
 					return
@@ -190,6 +210,7 @@ func (c *connection) setup(ctx context.Context) error {
 				} else {
 					logger.Info("Disconnected")
 				}
+				c.removeSubscriptions()
 				c.session.Close()
 				c.io.Disconnect(err)
 				return
@@ -201,6 +222,32 @@ func (c *connection) setup(ctx context.Context) error {
 	return nil
 }
 
+// upstreamTopic returns the topic of the upstream message, or nil if the message is not published.
+func (c *connection) upstreamTopic(appUID string, up *ttnpb.ApplicationUp) []string {
+	if layout, ok := c.format.(topics.UpstreamLayout); ok {
+		return layout.UpstreamTopic(appUID, up)
+	}
+	switch up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return c.format.UplinkTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return c.format.JoinAcceptTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return c.format.DownlinkAckTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return c.format.DownlinkNackTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return c.format.DownlinkSentTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return c.format.DownlinkFailedTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return c.format.DownlinkQueuedTopic(appUID, up.DeviceID)
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return c.format.LocationSolvedTopic(appUID, up.DeviceID)
+	}
+	return nil
+}
+
 type topicAccess struct {
 	appUID string
 	reads  [][]string
@@ -234,6 +281,12 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 	ctx = c.io.Context()
+	c.appUID = uid
+	if _, apiKeyID, _, err := ttnauth.SplitToken(string(info.Password)); err == nil {
+		c.limits = c.rateLimit.limits(apiKeyID)
+	} else {
+		c.limits = c.rateLimit.Default
+	}
 	access := topicAccess{
 		appUID: uid,
 	}
@@ -264,10 +317,15 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
-	accepted, ok := c.format.AcceptedTopic(access.appUID, topic.Split(requestedTopic))
+	group, filter := parseSharedTopic(requestedTopic)
+	accepted, ok := c.format.AcceptedTopic(access.appUID, topic.Split(filter))
 	if !ok {
 		return "", 0, errNotAuthorized
 	}
+	c.addSubscription(requestedTopic, group, accepted)
+	if group == "" {
+		c.addRetained(accepted)
+	}
 	acceptedTopic = topic.Join(accepted)
 	acceptedQoS = requestedQoS
 	return
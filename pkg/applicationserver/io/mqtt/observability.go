@@ -0,0 +1,96 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	evtThrottle = events.Define(
+		"as.mqtt.throttle", "throttle MQTT connection",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtAbuse = events.Define(
+		"as.mqtt.abuse", "close MQTT connection for exceeding rate limit",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+)
+
+const (
+	subsystem     = "as_mqtt"
+	applicationID = "application_id"
+	packetType    = "packet_type"
+)
+
+var mqttMetrics = &connectionMetrics{
+	packetsThrottled: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "packets_throttled_total",
+			Help:      "Number of throttled packets",
+		},
+		[]string{applicationID, packetType},
+	),
+	connectionsAbused: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "connections_abused_total",
+			Help:      "Number of connections closed for exceeding rate limits",
+		},
+		[]string{applicationID, packetType},
+	),
+}
+
+func init() {
+	metrics.MustRegister(mqttMetrics)
+}
+
+type connectionMetrics struct {
+	packetsThrottled  *metrics.ContextualCounterVec
+	connectionsAbused *metrics.ContextualCounterVec
+}
+
+func (m connectionMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.packetsThrottled.Describe(ch)
+	m.connectionsAbused.Describe(ch)
+}
+
+func (m connectionMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.packetsThrottled.Collect(ch)
+	m.connectionsAbused.Collect(ch)
+}
+
+// registerThrottle registers a throttled packet. The event is only published for the first throttled packet of the
+// connection.
+func registerThrottle(c *connection, pktType string, first bool) {
+	ctx, ids := c.io.Context(), *c.io.ApplicationIDs()
+	if first {
+		log.FromContext(ctx).WithField("packet_type", pktType).Warn("Throttle connection")
+		events.Publish(evtThrottle(ctx, ids, nil))
+	}
+	mqttMetrics.packetsThrottled.WithLabelValues(ctx, ids.ApplicationID, pktType).Inc()
+}
+
+func registerAbuse(c *connection, pktType string, err error) {
+	ctx, ids := c.io.Context(), *c.io.ApplicationIDs()
+	log.FromContext(ctx).WithError(err).Warn("Close connection for exceeding rate limit")
+	events.Publish(evtAbuse(ctx, ids, err))
+	mqttMetrics.connectionsAbused.WithLabelValues(ctx, ids.ApplicationID, pktType).Inc()
+}
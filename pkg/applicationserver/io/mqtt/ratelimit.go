@@ -0,0 +1,153 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"math"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// RateLimits are the rate limits of the packets that are received on a connection.
+// A rate of zero does not limit the packets.
+type RateLimits struct {
+	// PublishRate is the number of PUBLISH packets per second.
+	PublishRate float64
+	// PublishBurst is the number of PUBLISH packets that can be received at once.
+	PublishBurst int
+	// SubscribeRate is the number of SUBSCRIBE packets per second.
+	SubscribeRate float64
+	// SubscribeBurst is the number of SUBSCRIBE packets that can be received at once.
+	SubscribeBurst int
+}
+
+// RateLimitConfig is the configuration of the rate limits of connections.
+// Connections that authenticate with one of the trusted API keys use the trusted rate limits.
+type RateLimitConfig struct {
+	Default        RateLimits
+	Trusted        RateLimits
+	TrustedAPIKeys []string
+	// MaxThrottled is the number of throttled packets after which the connection is closed.
+	// If zero, connections are not closed.
+	MaxThrottled int
+}
+
+// limits returns the rate limits of the API key.
+func (c RateLimitConfig) limits(apiKeyID string) RateLimits {
+	for _, id := range c.TrustedAPIKeys {
+		if id == apiKeyID {
+			return c.Trusted
+		}
+	}
+	return c.Default
+}
+
+// Option is an option for the MQTT frontend.
+type Option func(*srv)
+
+// WithRateLimit configures the rate limits of connections.
+func WithRateLimit(conf RateLimitConfig) Option {
+	return func(s *srv) {
+		s.rateLimit = conf
+	}
+}
+
+// tokenBucket is a token bucket rate limiter. It is not safe for concurrent use.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   now,
+	}
+}
+
+// take takes a token from the bucket, and returns the duration to wait until the token is available.
+func (b *tokenBucket) take(now time.Time) time.Duration {
+	if b.rate <= 0 {
+		return 0
+	}
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+var errRateLimitExceeded = errors.DefineResourceExhausted("rate_limit_exceeded", "rate limit of `{packet_type}` packets exceeded")
+
+// rateLimitConn is a mqttnet.Conn that throttles the received PUBLISH and SUBSCRIBE packets.
+// The connection fails when too many packets are throttled.
+type rateLimitConn struct {
+	mqttnet.Conn
+	c *connection
+
+	publish   *tokenBucket
+	subscribe *tokenBucket
+	throttled int
+}
+
+// Receive implements mqttnet.Conn.
+func (r *rateLimitConn) Receive() (packet.ControlPacket, error) {
+	pkt, err := r.Conn.Receive()
+	if err != nil {
+		return nil, err
+	}
+	switch pkt.(type) {
+	case *packet.PublishPacket, *packet.SubscribePacket:
+	default:
+		return pkt, nil
+	}
+	if r.publish == nil {
+		// The connection is authenticated before any PUBLISH or SUBSCRIBE packet is received, so the rate limits
+		// of the API key are known here.
+		now := time.Now()
+		r.publish = newTokenBucket(r.c.limits.PublishRate, r.c.limits.PublishBurst, now)
+		r.subscribe = newTokenBucket(r.c.limits.SubscribeRate, r.c.limits.SubscribeBurst, now)
+	}
+	bucket := r.publish
+	if _, ok := pkt.(*packet.SubscribePacket); ok {
+		bucket = r.subscribe
+	}
+	wait := bucket.take(time.Now())
+	if wait == 0 {
+		return pkt, nil
+	}
+	r.throttled++
+	pktType := packet.Name[pkt.PacketType()]
+	if max := r.c.rateLimit.MaxThrottled; max > 0 && r.throttled > max {
+		err := errRateLimitExceeded.WithAttributes("packet_type", pktType)
+		registerAbuse(r.c, pktType, err)
+		return nil, err
+	}
+	registerThrottle(r.c, pktType, r.throttled == 1)
+	time.Sleep(wait)
+	return pkt, nil
+}
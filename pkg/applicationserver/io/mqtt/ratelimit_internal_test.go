@@ -0,0 +1,58 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTokenBucket(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Unix(0, 0)
+
+	b := newTokenBucket(2, 3, now)
+	for i := 0; i < 3; i++ {
+		a.So(b.take(now), should.Equal, 0)
+	}
+	a.So(b.take(now), should.Equal, 500*time.Millisecond)
+
+	// The bucket is refilled with 2 tokens per second, up to the burst.
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 3; i++ {
+		a.So(b.take(now), should.Equal, 0)
+	}
+	a.So(b.take(now), should.Equal, 500*time.Millisecond)
+
+	// A zero rate does not limit.
+	b = newTokenBucket(0, 0, now)
+	for i := 0; i < 100; i++ {
+		a.So(b.take(now), should.Equal, 0)
+	}
+}
+
+func TestRateLimitConfig(t *testing.T) {
+	a := assertions.New(t)
+	conf := RateLimitConfig{
+		Default:        RateLimits{PublishRate: 1},
+		Trusted:        RateLimits{PublishRate: 100},
+		TrustedAPIKeys: []string{"TRUSTED"},
+	}
+	a.So(conf.limits("TRUSTED"), should.Resemble, conf.Trusted)
+	a.So(conf.limits("OTHER"), should.Resemble, conf.Default)
+}
@@ -0,0 +1,96 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// WithRetainedUplinks publishes the last uplink message of each end device as retained message to new subscriptions.
+func WithRetainedUplinks() Option {
+	return func(s *srv) {
+		s.retainUplinks = true
+	}
+}
+
+// addRetained adds the accepted topic filter of a new subscription, to which the retained uplink messages are
+// published once the subscription is acknowledged.
+// Shared subscriptions do not receive retained messages.
+func (c *connection) addRetained(filter []string) {
+	if !c.retainUplinks {
+		return
+	}
+	c.retainedMu.Lock()
+	c.retained = append(c.retained, filter)
+	c.retainedMu.Unlock()
+}
+
+// takeRetained returns and clears the topic filters that have been added since the last call.
+func (c *connection) takeRetained() [][]string {
+	c.retainedMu.Lock()
+	defer c.retainedMu.Unlock()
+	filters := c.retained
+	c.retained = nil
+	return filters
+}
+
+// matchAny returns whether the topic matches any of the topic filters.
+func matchAny(topicParts []string, filters [][]string) bool {
+	for _, filter := range filters {
+		if topic.MatchPath(topicParts, filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// publishRetained publishes the retained uplink messages that match the topic filters of the new subscriptions.
+func (c *connection) publishRetained(ctx context.Context) {
+	filters := c.takeRetained()
+	if len(filters) == 0 {
+		return
+	}
+	logger := log.FromContext(ctx)
+	err := c.server.RangeRetainedUplinks(ctx, *c.io.ApplicationIDs(), func(up *ttnpb.ApplicationUp) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		topicParts := c.upstreamTopic(c.appUID, up)
+		if topicParts == nil || !matchAny(topicParts, filters) {
+			return true
+		}
+		buf, err := c.format.FromUp(up)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to marshal retained uplink message")
+			return true
+		}
+		c.session.Publish(&packet.PublishPacket{
+			TopicName:  topic.Join(topicParts),
+			TopicParts: topicParts,
+			QoS:        qosUpstream,
+			Retain:     true,
+			Message:    buf,
+		})
+		return true
+	})
+	if err != nil {
+		logger.WithError(err).Warn("Failed to publish retained uplink messages")
+	}
+}
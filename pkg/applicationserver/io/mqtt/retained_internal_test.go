@@ -0,0 +1,42 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRetainedFilters(t *testing.T) {
+	a := assertions.New(t)
+
+	c := &connection{}
+	c.addRetained(topic.Split("v3/foo-app/devices/+/up"))
+	a.So(c.takeRetained(), should.BeEmpty)
+
+	c.retainUplinks = true
+	c.addRetained(topic.Split("v3/foo-app/devices/+/up"))
+	c.addRetained(topic.Split("v3/foo-app/devices/bar-device/#"))
+	filters := c.takeRetained()
+	a.So(filters, should.HaveLength, 2)
+	a.So(c.takeRetained(), should.BeEmpty)
+
+	a.So(matchAny(topic.Split("v3/foo-app/devices/foo-device/up"), filters), should.BeTrue)
+	a.So(matchAny(topic.Split("v3/foo-app/devices/bar-device/up"), filters), should.BeTrue)
+	a.So(matchAny(topic.Split("v3/foo-app/devices/foo-device/join"), filters), should.BeFalse)
+}
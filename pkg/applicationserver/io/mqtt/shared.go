@@ -0,0 +1,201 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"hash/fnv"
+	"strings"
+	"sync"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+)
+
+// sharedSubscriptionPrefix is the first topic level of shared subscriptions: $share/<group>/<filter>.
+const sharedSubscriptionPrefix = "$share"
+
+// parseSharedTopic returns the group and the topic filter of the shared subscription.
+// If the topic is not a valid shared subscription, the group is empty and the filter is the topic itself.
+func parseSharedTopic(t string) (group, filter string) {
+	parts := strings.SplitN(t, "/", 3)
+	if len(parts) != 3 || parts[0] != sharedSubscriptionPrefix {
+		return "", t
+	}
+	group, filter = parts[1], parts[2]
+	if group == "" || filter == "" || strings.ContainsAny(group, "+#") {
+		return "", t
+	}
+	return group, filter
+}
+
+// sharedGroups are the members of the shared subscription groups.
+// Upstream messages of an end device are delivered to one member of the group, which is selected by the end device.
+// This way, the upstream messages are balanced over the members while the messages of an end device stay in order.
+type sharedGroups struct {
+	mu     sync.RWMutex
+	groups map[string][]*connection
+}
+
+func (g *sharedGroups) join(key string, c *connection) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, member := range g.groups[key] {
+		if member == c {
+			return
+		}
+	}
+	if g.groups == nil {
+		g.groups = make(map[string][]*connection)
+	}
+	g.groups[key] = append(g.groups[key], c)
+}
+
+func (g *sharedGroups) leave(key string, c *connection) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	members := g.groups[key]
+	for i, member := range members {
+		if member != c {
+			continue
+		}
+		members = append(members[:i:i], members[i+1:]...)
+		if len(members) == 0 {
+			delete(g.groups, key)
+		} else {
+			g.groups[key] = members
+		}
+		return
+	}
+}
+
+// owner returns the member of the group that receives the upstream messages of the end device.
+func (g *sharedGroups) owner(key, deviceUID string) *connection {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	members := g.groups[key]
+	if len(members) == 0 {
+		return nil
+	}
+	h := fnv.New32a()
+	h.Write([]byte(deviceUID))
+	return members[h.Sum32()%uint32(len(members))]
+}
+
+// subscription is a topic subscription of a connection.
+type subscription struct {
+	group  string
+	filter []string
+}
+
+func (c *connection) sharedGroupKey(group string) string {
+	return c.appUID + "/" + group
+}
+
+// addSubscription adds the subscription with the accepted topic filter, and joins the shared subscription group.
+func (c *connection) addSubscription(requestedTopic, group string, filter []string) {
+	c.subscriptionsMu.Lock()
+	defer c.subscriptionsMu.Unlock()
+	if c.subscriptions == nil {
+		c.subscriptions = make(map[string]subscription)
+	}
+	c.subscriptions[requestedTopic] = subscription{group: group, filter: filter}
+	if group != "" {
+		c.shared.join(c.sharedGroupKey(group), c)
+	}
+}
+
+// removeSubscription removes the subscription, and leaves the shared subscription group if there are no more
+// subscriptions in that group. It returns the topic that the session should unsubscribe from.
+func (c *connection) removeSubscription(requestedTopic string) string {
+	c.subscriptionsMu.Lock()
+	defer c.subscriptionsMu.Unlock()
+	sub, ok := c.subscriptions[requestedTopic]
+	if !ok {
+		return requestedTopic
+	}
+	delete(c.subscriptions, requestedTopic)
+	filter, inGroup, inUse := topic.Join(sub.filter), false, false
+	for _, other := range c.subscriptions {
+		if sub.group != "" && other.group == sub.group {
+			inGroup = true
+		}
+		if topic.Join(other.filter) == filter {
+			inUse = true
+		}
+	}
+	if sub.group != "" && !inGroup {
+		c.shared.leave(c.sharedGroupKey(sub.group), c)
+	}
+	if !inUse {
+		return filter
+	}
+	// The topic filter is still used by another subscription. The session never subscribes to shared topics, so
+	// unsubscribing from a shared topic keeps the topic filter in the session.
+	if sub.group != "" {
+		return requestedTopic
+	}
+	return sharedSubscriptionPrefix + "/" + requestedTopic
+}
+
+// removeSubscriptions removes all subscriptions and leaves the shared subscription groups.
+func (c *connection) removeSubscriptions() {
+	c.subscriptionsMu.Lock()
+	defer c.subscriptionsMu.Unlock()
+	for _, sub := range c.subscriptions {
+		if sub.group != "" {
+			c.shared.leave(c.sharedGroupKey(sub.group), c)
+		}
+	}
+	c.subscriptions = nil
+}
+
+// shouldPublish returns whether the upstream message of the end device on the topic is published to this connection.
+// Messages on a topic that is only subscribed to in shared subscription groups are only published to the owner
+// of the end device in one of these groups.
+func (c *connection) shouldPublish(topicParts []string, deviceUID string) bool {
+	c.subscriptionsMu.RLock()
+	defer c.subscriptionsMu.RUnlock()
+	for _, sub := range c.subscriptions {
+		if !topic.MatchPath(topicParts, sub.filter) {
+			continue
+		}
+		if sub.group == "" || c.shared.owner(c.sharedGroupKey(sub.group), deviceUID) == c {
+			return true
+		}
+	}
+	return false
+}
+
+// unsubscribeConn is a mqttnet.Conn that maps the topics of received UNSUBSCRIBE packets to the accepted topic filters,
+// so that the session unsubscribes from the topic filter that it subscribed to.
+type unsubscribeConn struct {
+	mqttnet.Conn
+	c *connection
+}
+
+// Receive implements mqttnet.Conn.
+func (u *unsubscribeConn) Receive() (packet.ControlPacket, error) {
+	pkt, err := u.Conn.Receive()
+	if err != nil {
+		return nil, err
+	}
+	if unsub, ok := pkt.(*packet.UnsubscribePacket); ok {
+		for i, t := range unsub.Topics {
+			unsub.Topics[i] = u.c.removeSubscription(t)
+		}
+	}
+	return pkt, nil
+}
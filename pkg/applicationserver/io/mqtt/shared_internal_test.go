@@ -0,0 +1,117 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestParseSharedTopic(t *testing.T) {
+	for _, tc := range []struct {
+		Topic  string
+		Group  string
+		Filter string
+	}{
+		{
+			Topic:  "v3/app1/devices/+/up",
+			Filter: "v3/app1/devices/+/up",
+		},
+		{
+			Topic:  "$share/group1/v3/app1/devices/+/up",
+			Group:  "group1",
+			Filter: "v3/app1/devices/+/up",
+		},
+		{
+			Topic:  "$share/group1/#",
+			Group:  "group1",
+			Filter: "#",
+		},
+		{
+			Topic:  "$share/group1",
+			Filter: "$share/group1",
+		},
+		{
+			Topic:  "$share//v3/app1/devices/+/up",
+			Filter: "$share//v3/app1/devices/+/up",
+		},
+		{
+			Topic:  "$share/+/v3/app1/devices/+/up",
+			Filter: "$share/+/v3/app1/devices/+/up",
+		},
+	} {
+		t.Run(tc.Topic, func(t *testing.T) {
+			a := assertions.New(t)
+			group, filter := parseSharedTopic(tc.Topic)
+			a.So(group, should.Equal, tc.Group)
+			a.So(filter, should.Equal, tc.Filter)
+		})
+	}
+}
+
+func TestSharedSubscriptions(t *testing.T) {
+	a := assertions.New(t)
+
+	shared := &sharedGroups{}
+	newConn := func() *connection {
+		return &connection{appUID: "app1", shared: shared}
+	}
+	c1, c2, c3 := newConn(), newConn(), newConn()
+	filter := topic.Split("v3/app1/devices/+/up")
+	c1.addSubscription("$share/group1/v3/app1/devices/+/up", "group1", filter)
+	c2.addSubscription("$share/group1/v3/app1/devices/+/up", "group1", filter)
+	c3.addSubscription("v3/app1/devices/+/up", "", filter)
+
+	// Every upstream message is published to one member of the group, and to the connection that is not in the group.
+	counts := make(map[*connection]int)
+	for i := 0; i < 100; i++ {
+		deviceUID := fmt.Sprintf("app1.dev%d", i)
+		topicParts := topic.Split(fmt.Sprintf("v3/app1/devices/dev%d/up", i))
+		a.So(c1.shouldPublish(topicParts, deviceUID), should.NotEqual, c2.shouldPublish(topicParts, deviceUID))
+		a.So(c3.shouldPublish(topicParts, deviceUID), should.BeTrue)
+		for _, c := range []*connection{c1, c2} {
+			if c.shouldPublish(topicParts, deviceUID) {
+				counts[c]++
+			}
+		}
+	}
+	a.So(counts[c1], should.BeGreaterThan, 0)
+	a.So(counts[c2], should.BeGreaterThan, 0)
+
+	// Topics that are not subscribed to are not published.
+	a.So(c1.shouldPublish(topic.Split("v3/app1/devices/dev1/join"), "app1.dev1"), should.BeFalse)
+
+	// Upstream messages are published to the remaining member when a member unsubscribes.
+	a.So(c2.removeSubscription("$share/group1/v3/app1/devices/+/up"), should.Equal, "v3/app1/devices/+/up")
+	for i := 0; i < 100; i++ {
+		deviceUID := fmt.Sprintf("app1.dev%d", i)
+		topicParts := topic.Split(fmt.Sprintf("v3/app1/devices/dev%d/up", i))
+		a.So(c1.shouldPublish(topicParts, deviceUID), should.BeTrue)
+		a.So(c2.shouldPublish(topicParts, deviceUID), should.BeFalse)
+	}
+
+	// The topic filter stays subscribed in the session while it is used by another subscription.
+	c3.addSubscription("$share/group2/v3/app1/devices/+/up", "group2", filter)
+	a.So(c3.removeSubscription("v3/app1/devices/+/up"), should.Equal, "$share/v3/app1/devices/+/up")
+	a.So(c3.shouldPublish(topic.Split("v3/app1/devices/dev1/up"), "app1.dev1"), should.BeTrue)
+
+	c1.removeSubscriptions()
+	c3.removeSubscriptions()
+	a.So(shared.groups, should.BeEmpty)
+}
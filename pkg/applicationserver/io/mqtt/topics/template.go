@@ -0,0 +1,292 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+// Variables of topic templates.
+const (
+	// VariableApplicationID is the unique application ID.
+	VariableApplicationID = "{application_id}"
+	// VariableDeviceID is the end device ID.
+	VariableDeviceID = "{device_id}"
+	// VariableDevEUI is the DevEUI of the end device.
+	VariableDevEUI = "{dev_eui}"
+	// VariableJoinEUI is the JoinEUI of the end device.
+	VariableJoinEUI = "{join_eui}"
+	// VariableFPort is the FPort of the message, or 0 if the message has no FPort.
+	VariableFPort = "{f_port}"
+	// VariableType is the message type, for example up, join or down/push.
+	VariableType = "{type}"
+)
+
+var templateVariables = map[string]bool{
+	VariableApplicationID: true,
+	VariableDeviceID:      true,
+	VariableDevEUI:        true,
+	VariableJoinEUI:       true,
+	VariableFPort:         true,
+	VariableType:          true,
+}
+
+// unknownEUI is the value of EUI variables of end devices that have no such EUI.
+const unknownEUI = "unknown"
+
+var (
+	errTemplateLevel           = errors.DefineInvalidArgument("template_level", "invalid topic template level `{level}`")
+	errTemplateVariable        = errors.DefineInvalidArgument("template_variable", "unknown topic template variable `{variable}`")
+	errTemplateDuplicate       = errors.DefineInvalidArgument("template_duplicate", "duplicate topic template variable `{variable}`")
+	errTemplateMissingVariable = errors.DefineInvalidArgument("template_missing_variable", "missing topic template variable `{variable}`")
+	errTemplateType            = errors.DefineInvalidArgument("template_type", "the type variable must be the last level of the topic template")
+)
+
+// UpstreamLayout is a Layout that uses the upstream message to build its topic.
+type UpstreamLayout interface {
+	Layout
+	// UpstreamTopic returns the topic of the upstream message, or nil if the message is not published.
+	UpstreamTopic(applicationUID string, up *ttnpb.ApplicationUp) []string
+}
+
+type template struct {
+	// levels are the levels of the template before the type.
+	levels []string
+	// applicationLevel is the index of the application ID level.
+	applicationLevel int
+}
+
+// NewTemplate returns a topic layout that builds topics from the template.
+// The template is a topic of which each level is either a literal or one of the variables.
+// The template must contain the application ID and the end device ID, and must end with the message type,
+// for example v3/{application_id}/devices/{device_id}/{type}, which is equivalent to the default layout.
+// Topic levels of variables that are not known when subscribing or publishing, for example the DevEUI of
+// downlink messages, match any value.
+func NewTemplate(tmpl string) (UpstreamLayout, error) {
+	levels := topic.Split(tmpl)
+	t := &template{
+		applicationLevel: -1,
+	}
+	seen := make(map[string]bool)
+	for i, level := range levels {
+		if !strings.ContainsAny(level, "{}") {
+			if level == "" || strings.ContainsAny(level, topic.Wildcard+topic.PartWildcard) {
+				return nil, errTemplateLevel.WithAttributes("level", level)
+			}
+			t.levels = append(t.levels, level)
+			continue
+		}
+		if !templateVariables[level] {
+			return nil, errTemplateVariable.WithAttributes("variable", level)
+		}
+		if seen[level] {
+			return nil, errTemplateDuplicate.WithAttributes("variable", level)
+		}
+		seen[level] = true
+		switch level {
+		case VariableType:
+			if i != len(levels)-1 {
+				return nil, errTemplateType
+			}
+			continue
+		case VariableApplicationID:
+			t.applicationLevel = i
+		}
+		t.levels = append(t.levels, level)
+	}
+	for _, variable := range []string{VariableApplicationID, VariableDeviceID, VariableType} {
+		if !seen[variable] {
+			return nil, errTemplateMissingVariable.WithAttributes("variable", variable)
+		}
+	}
+	return t, nil
+}
+
+func (t *template) topic(values map[string]string, typ ...string) []string {
+	parts := make([]string, 0, len(t.levels)+len(typ))
+	for _, level := range t.levels {
+		switch value, ok := values[level]; {
+		case ok:
+			parts = append(parts, value)
+		case templateVariables[level]:
+			parts = append(parts, topic.PartWildcard)
+		default:
+			parts = append(parts, level)
+		}
+	}
+	return append(parts, typ...)
+}
+
+func (t *template) match(parts []string, typ ...string) (deviceID string, ok bool) {
+	if len(parts) != len(t.levels)+len(typ) {
+		return "", false
+	}
+	for i, level := range t.levels {
+		switch {
+		case level == VariableDeviceID:
+			deviceID = parts[i]
+		case templateVariables[level]:
+		case parts[i] != level:
+			return "", false
+		}
+	}
+	for i, part := range typ {
+		if parts[len(t.levels)+i] != part {
+			return "", false
+		}
+	}
+	return deviceID, true
+}
+
+func (t *template) deviceTopic(applicationUID, deviceID string, typ ...string) []string {
+	return t.topic(map[string]string{
+		VariableApplicationID: applicationUID,
+		VariableDeviceID:      deviceID,
+	}, typ...)
+}
+
+func (t *template) AcceptedTopic(applicationUID string, requested []string) ([]string, bool) {
+	if len(requested) <= t.applicationLevel {
+		if requested[len(requested)-1] != topic.Wildcard {
+			return nil, false
+		}
+		// Rewrite # to the levels up to and including the application ID, followed by #.
+		accepted := append([]string(nil), requested[:len(requested)-1]...)
+		for _, level := range t.levels[len(accepted):t.applicationLevel] {
+			if templateVariables[level] {
+				level = topic.PartWildcard
+			}
+			accepted = append(accepted, level)
+		}
+		return append(accepted, applicationUID, topic.Wildcard), true
+	}
+	switch requested[t.applicationLevel] {
+	case topic.Wildcard:
+		accepted := append([]string(nil), requested[:t.applicationLevel]...)
+		return append(accepted, applicationUID, topic.Wildcard), true
+	case topic.PartWildcard:
+		requested[t.applicationLevel] = applicationUID
+		return requested, true
+	case applicationUID:
+		return requested, true
+	}
+	return nil, false
+}
+
+func (t *template) UpstreamTopic(applicationUID string, up *ttnpb.ApplicationUp) []string {
+	var (
+		fPort uint32
+		typ   []string
+	)
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		fPort, typ = p.UplinkMessage.FPort, []string{"up"}
+	case *ttnpb.ApplicationUp_JoinAccept:
+		typ = []string{"join"}
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		fPort, typ = p.DownlinkAck.FPort, []string{"down", "ack"}
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		fPort, typ = p.DownlinkNack.FPort, []string{"down", "nack"}
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		fPort, typ = p.DownlinkSent.FPort, []string{"down", "sent"}
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		fPort, typ = p.DownlinkFailed.FPort, []string{"down", "failed"}
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		fPort, typ = p.DownlinkQueued.FPort, []string{"down", "queued"}
+	case *ttnpb.ApplicationUp_LocationSolved:
+		typ = []string{"location", "solved"}
+	default:
+		return nil
+	}
+	euiValue := func(eui *types.EUI64) string {
+		if eui == nil || eui.IsZero() {
+			return unknownEUI
+		}
+		return eui.String()
+	}
+	return t.topic(map[string]string{
+		VariableApplicationID: applicationUID,
+		VariableDeviceID:      up.DeviceID,
+		VariableDevEUI:        euiValue(up.DevEUI),
+		VariableJoinEUI:       euiValue(up.JoinEUI),
+		VariableFPort:         strconv.FormatUint(uint64(fPort), 10),
+	}, typ...)
+}
+
+func (t *template) UplinkTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "up")
+}
+
+func (t *template) JoinAcceptTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "join")
+}
+
+func (t *template) DownlinkAckTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "ack")
+}
+
+func (t *template) DownlinkNackTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "nack")
+}
+
+func (t *template) DownlinkSentTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "sent")
+}
+
+func (t *template) DownlinkFailedTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "failed")
+}
+
+func (t *template) DownlinkQueuedTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "queued")
+}
+
+func (t *template) LocationSolvedTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "location", "solved")
+}
+
+func (t *template) DownlinkPushTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "push")
+}
+
+func (t *template) IsDownlinkPushTopic(parts []string) bool {
+	_, ok := t.match(parts, "down", "push")
+	return ok
+}
+
+func (t *template) ParseDownlinkPushTopic(parts []string) (deviceID string) {
+	deviceID, _ = t.match(parts, "down", "push")
+	return deviceID
+}
+
+func (t *template) DownlinkReplaceTopic(applicationUID, deviceID string) []string {
+	return t.deviceTopic(applicationUID, deviceID, "down", "replace")
+}
+
+func (t *template) IsDownlinkReplaceTopic(parts []string) bool {
+	_, ok := t.match(parts, "down", "replace")
+	return ok
+}
+
+func (t *template) ParseDownlinkReplaceTopic(parts []string) (deviceID string) {
+	deviceID, _ = t.match(parts, "down", "replace")
+	return deviceID
+}
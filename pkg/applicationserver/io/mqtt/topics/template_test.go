@@ -0,0 +1,204 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package topics_test
+
+import (
+	"testing"
+
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt/topics"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNewTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		Template string
+		OK       bool
+	}{
+		{
+			Template: "v3/{application_id}/devices/{device_id}/{type}",
+			OK:       true,
+		},
+		{
+			Template: "application/{application_id}/device/{dev_eui}/{device_id}/{f_port}/{type}",
+			OK:       true,
+		},
+		{
+			Template: "v3/{application_id}/devices/{device_id}",
+		},
+		{
+			Template: "v3/{application_id}/devices/{type}",
+		},
+		{
+			Template: "v3/{application_id}/{type}/{device_id}",
+		},
+		{
+			Template: "v3/{application_id}/{device_id}/{device_id}/{type}",
+		},
+		{
+			Template: "v3/{application_id}/{unknown}/{device_id}/{type}",
+		},
+		{
+			Template: "+/{application_id}/{device_id}/{type}",
+		},
+		{
+			Template: "v3//{application_id}/{device_id}/{type}",
+		},
+	} {
+		t.Run(tc.Template, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := topics.NewTemplate(tc.Template)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestTemplateAcceptedTopic(t *testing.T) {
+	layout, err := topics.NewTemplate("application/{application_id}/device/{device_id}/{type}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		Requested,
+		Accepted string
+		OK bool
+	}{
+		{
+			Requested: "application",
+		},
+		{
+			Requested: "application/other-app/#",
+		},
+		{
+			Requested: "#",
+			Accepted:  "application/foo-app/#",
+			OK:        true,
+		},
+		{
+			Requested: "application/#",
+			Accepted:  "application/foo-app/#",
+			OK:        true,
+		},
+		{
+			Requested: "+/#",
+			Accepted:  "+/foo-app/#",
+			OK:        true,
+		},
+		{
+			Requested: "application/+/device/+/up",
+			Accepted:  "application/foo-app/device/+/up",
+			OK:        true,
+		},
+		{
+			Requested: "application/foo-app/device/foo-device/up",
+			Accepted:  "application/foo-app/device/foo-device/up",
+			OK:        true,
+		},
+	} {
+		t.Run(tc.Requested, func(t *testing.T) {
+			a := assertions.New(t)
+			actual, ok := layout.AcceptedTopic("foo-app", topic.Split(tc.Requested))
+			if !a.So(ok, should.Equal, tc.OK) {
+				t.FailNow()
+			}
+			a.So(topic.Join(actual), should.Equal, tc.Accepted)
+		})
+	}
+}
+
+func TestTemplateTopics(t *testing.T) {
+	a := assertions.New(t)
+	layout, err := topics.NewTemplate("application/{application_id}/device/{dev_eui}/{device_id}/{f_port}/{type}")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+		DevEUI:                 &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	}
+	for _, tc := range []struct {
+		Up       *ttnpb.ApplicationUp
+		Expected string
+	}{
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_UplinkMessage{
+					UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42},
+				},
+			},
+			Expected: "application/foo-app/device/4242424242424242/foo-device/42/up",
+		},
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ids.ApplicationIdentifiers,
+					DeviceID:               ids.DeviceID,
+				},
+				Up: &ttnpb.ApplicationUp_JoinAccept{
+					JoinAccept: &ttnpb.ApplicationJoinAccept{},
+				},
+			},
+			Expected: "application/foo-app/device/unknown/foo-device/0/join",
+		},
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_DownlinkFailed{
+					DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+						ApplicationDownlink: ttnpb.ApplicationDownlink{FPort: 1},
+					},
+				},
+			},
+			Expected: "application/foo-app/device/4242424242424242/foo-device/1/down/failed",
+		},
+		{
+			Up: &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: ids,
+				Up: &ttnpb.ApplicationUp_LocationSolved{
+					LocationSolved: &ttnpb.ApplicationLocation{},
+				},
+			},
+			Expected: "application/foo-app/device/4242424242424242/foo-device/0/location/solved",
+		},
+	} {
+		a.So(topic.Join(layout.UpstreamTopic("foo-app", tc.Up)), should.Equal, tc.Expected)
+	}
+
+	// Levels of variables that are unknown in the topics of the layout match any value.
+	a.So(topic.Join(layout.UplinkTopic("foo-app", topic.PartWildcard)), should.Equal, "application/foo-app/device/+/+/+/up")
+	a.So(topic.Join(layout.DownlinkPushTopic("foo-app", "foo-device")), should.Equal, "application/foo-app/device/+/foo-device/+/down/push")
+
+	push := topic.Split("application/foo-app/device/4242424242424242/foo-device/1/down/push")
+	a.So(layout.IsDownlinkPushTopic(push), should.BeTrue)
+	a.So(layout.ParseDownlinkPushTopic(push), should.Equal, "foo-device")
+	a.So(layout.IsDownlinkReplaceTopic(push), should.BeFalse)
+
+	replace := topic.Split("application/foo-app/device/unknown/foo-device/0/down/replace")
+	a.So(layout.IsDownlinkReplaceTopic(replace), should.BeTrue)
+	a.So(layout.ParseDownlinkReplaceTopic(replace), should.Equal, "foo-device")
+
+	a.So(layout.IsDownlinkPushTopic(topic.Split("v3/foo-app/devices/foo-device/down/push")), should.BeFalse)
+}
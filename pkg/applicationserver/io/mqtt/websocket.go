@@ -0,0 +1,176 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	stdio "io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/ws"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+// webSocketSubprotocol is the WebSocket subprotocol of MQTT over WebSocket.
+const webSocketSubprotocol = "mqtt"
+
+// WebSocketConfig is the configuration of the MQTT over WebSocket frontend.
+type WebSocketConfig struct {
+	// AllowedOrigins are the origins that are allowed to connect from browsers. The wildcard * allows any origin.
+	// If empty, only same origin connections are allowed.
+	AllowedOrigins []string
+}
+
+var (
+	errListenerClosed   = errors.DefineUnavailable("listener_closed", "listener closed")
+	errWebSocketMessage = errors.DefineInvalidArgument("websocket_message", "WebSocket message is not binary")
+)
+
+type webSocketSrv struct {
+	ctx      context.Context
+	lis      *webSocketListener
+	upgrader *websocket.Upgrader
+}
+
+// StartWebSocket starts the MQTT frontend on WebSocket connections, and returns the web.Registerer that upgrades the
+// HTTP requests to WebSocket connections.
+func StartWebSocket(ctx context.Context, server io.Server, format Format, conf WebSocketConfig, opts ...Option) web.Registerer {
+	lis := &webSocketListener{
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+	Start(ctx, server, lis, format, "ws", opts...)
+	return &webSocketSrv{
+		ctx: log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt"),
+		lis: lis,
+		upgrader: &websocket.Upgrader{
+			Subprotocols: []string{webSocketSubprotocol},
+			CheckOrigin:  ws.CheckOrigin(conf.AllowedOrigins),
+		},
+	}
+}
+
+// RegisterRoutes implements web.Registerer.
+func (s *webSocketSrv) RegisterRoutes(server *web.Server) {
+	server.GET(ttnpb.HTTPAPIPrefix+"/as/mqtt", s.handleConnect)
+}
+
+func (s *webSocketSrv) handleConnect(c echo.Context) error {
+	conn, err := s.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		log.FromContext(s.ctx).WithError(err).WithField("remote_addr", c.RealIP()).Debug("Failed to upgrade request to websocket connection")
+		return nil
+	}
+	select {
+	case s.lis.conns <- &webSocketConn{Conn: conn}:
+	case <-s.lis.closed:
+		conn.Close()
+	}
+	return nil
+}
+
+// webSocketListener is a net.Listener that accepts the upgraded WebSocket connections.
+type webSocketListener struct {
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Accept implements net.Listener.
+func (l *webSocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.closed:
+		return nil, errListenerClosed
+	}
+}
+
+// Close implements net.Listener.
+func (l *webSocketListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+// Addr implements net.Listener.
+func (l *webSocketListener) Addr() net.Addr {
+	return webSocketAddr{}
+}
+
+type webSocketAddr struct{}
+
+func (webSocketAddr) Network() string { return "ws" }
+func (webSocketAddr) String() string  { return ttnpb.HTTPAPIPrefix + "/as/mqtt" }
+
+// webSocketConn is a net.Conn that reads from and writes to binary WebSocket messages.
+// MQTT packets are not aligned with WebSocket messages; a message may contain multiple or partial packets.
+type webSocketConn struct {
+	*websocket.Conn
+	reader  stdio.Reader
+	writeMu sync.Mutex
+}
+
+// Read implements net.Conn.
+func (c *webSocketConn) Read(b []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			typ, r, err := c.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			if typ != websocket.BinaryMessage {
+				return 0, errWebSocketMessage
+			}
+			c.reader = r
+		}
+		n, err := c.reader.Read(b)
+		if err == stdio.EOF {
+			c.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+// Write implements net.Conn.
+func (c *webSocketConn) Write(b []byte) (int, error) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+// SetDeadline implements net.Conn.
+func (c *webSocketConn) SetDeadline(t time.Time) error {
+	if err := c.SetReadDeadline(t); err != nil {
+		return err
+	}
+	return c.SetWriteDeadline(t)
+}
@@ -0,0 +1,125 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/mock"
+	. "go.thethings.network/lorawan-stack/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/pkg/component/test"
+	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"go.thethings.network/lorawan-stack/pkg/web"
+)
+
+func TestWebSocket(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr := startMockIS(ctx)
+	is.add(ctx, registeredApplicationID, registeredApplicationKey)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: config.Cluster{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	as := mock.NewServer(c)
+	webServer, err := web.New(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	StartWebSocket(c.Context(), as, JSON, WebSocketConfig{}).RegisterRoutes(webServer)
+	srv := httptest.NewServer(webServer)
+	defer srv.Close()
+
+	clientOpts := mqtt.NewClientOptions()
+	clientOpts.AddBroker(fmt.Sprintf("ws://%s/api/v3/as/mqtt", strings.TrimPrefix(srv.URL, "http://")))
+	clientOpts.SetUsername(registeredApplicationUID)
+	clientOpts.SetPassword(registeredApplicationKey)
+	client := mqtt.NewClient(clientOpts)
+	if token := client.Connect(); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+		t.FailNow()
+	} else if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+	defer client.Disconnect(100)
+
+	var sub *io.Subscription
+	select {
+	case sub = <-as.Subscriptions():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	upCh := make(chan *ttnpb.ApplicationUp)
+	topic := fmt.Sprintf("v3/%v/devices/%v/up", unique.ID(ctx, registeredDeviceID.ApplicationIdentifiers), registeredDeviceID.DeviceID)
+	handler := func(_ mqtt.Client, msg mqtt.Message) {
+		up := &ttnpb.ApplicationUp{}
+		err := jsonpb.TTN().Unmarshal(msg.Payload(), up)
+		a.So(err, should.BeNil)
+		upCh <- up
+	}
+	if token := client.Subscribe(topic, 1, handler); !a.So(token.WaitTimeout(timeout), should.BeTrue) {
+		t.FailNow()
+	} else if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FRMPayload: []byte{0x1, 0x1, 0x1}},
+		},
+	}
+	if err := sub.SendUp(ctx, up); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case actual := <-upCh:
+		a.So(actual, should.Resemble, up)
+	case <-time.After(timeout):
+		t.Fatal("Receive expected upstream timeout")
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fmp implements the LoRaWAN Firmware Management Protocol (TS006) application package.
+//
+// Commands are sent to end devices with the ApplicationFirmwareManagement service. The answers of the end devices
+// are stored in the data of the application package association, so that the firmware version, the scheduled reboot
+// and the status of the upgrade image of the end device can be retrieved from the application package registry.
+package fmp
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+const (
+	// PackageName is the name of the Firmware Management Protocol package.
+	PackageName = "fmp"
+	// DefaultFPort is the default FPort of the Firmware Management Protocol package.
+	DefaultFPort = 203
+)
+
+var errPackageName = errors.DefineFailedPrecondition("package_name", "association is of package `{name}`, not of package `fmp`")
+
+type fmpPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// New returns a new Firmware Management Protocol package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &fmpPackage{
+		server:   server,
+		registry: registry,
+	}
+}
+
+// RegisterServices implements packages.ApplicationPackageHandler.
+func (p *fmpPackage) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterApplicationFirmwareManagementServer(s, p)
+}
+
+// RegisterHandlers implements packages.ApplicationPackageHandler.
+func (p *fmpPackage) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterApplicationFirmwareManagementHandler(context.Background(), s, conn)
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *fmpPackage) HandleUp(ctx context.Context, assoc *ttnpb.ApplicationPackageAssociation, up *ttnpb.ApplicationUp) error {
+	msg := up.GetUplinkMessage()
+	if msg == nil || len(msg.FRMPayload) == 0 {
+		return nil
+	}
+	fields, err := decodeAnswers(msg.FRMPayload)
+	if err != nil {
+		return err
+	}
+	_, err = p.registry.Set(ctx, assoc.ApplicationPackageAssociationIdentifiers, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			if assoc == nil {
+				return nil, nil, nil
+			}
+			if assoc.Data == nil {
+				assoc.Data = &pbtypes.Struct{}
+			}
+			if assoc.Data.Fields == nil {
+				assoc.Data.Fields = make(map[string]*pbtypes.Value)
+			}
+			for k, v := range fields {
+				if v == nil {
+					delete(assoc.Data.Fields, k)
+				} else {
+					assoc.Data.Fields[k] = v
+				}
+			}
+			return assoc, []string{"data"}, nil
+		},
+	)
+	return err
+}
+
+// SendCommands implements ttnpb.ApplicationFirmwareManagementServer.
+func (p *fmpPackage) SendCommands(ctx context.Context, req *ttnpb.FirmwareManagementCommands) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	assoc, err := p.registry.Get(ctx, req.ApplicationPackageAssociationIdentifiers, []string{"package_name"})
+	if err != nil {
+		return nil, err
+	}
+	if assoc.PackageName != PackageName {
+		return nil, errPackageName.WithAttributes("name", assoc.PackageName)
+	}
+	pld, err := encodeCommands(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.server.DownlinkQueuePush(ctx, req.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink{
+		{
+			FPort:      req.FPort,
+			FRMPayload: pld,
+		},
+	}); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("f_port", req.FPort).Debug("Sent Firmware Management Protocol commands")
+	return ttnpb.Empty, nil
+}
+
+func init() {
+	if err := packages.RegisterPackage(ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: DefaultFPort,
+	}, New); err != nil {
+		panic(err)
+	}
+}
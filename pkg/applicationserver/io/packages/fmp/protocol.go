@@ -0,0 +1,181 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fmp
+
+import (
+	"encoding/binary"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Command identifiers of the Firmware Management Protocol.
+const (
+	cidPackageVersion     byte = 0x00
+	cidDevVersion         byte = 0x01
+	cidDevRebootTime      byte = 0x02
+	cidDevRebootCountdown byte = 0x03
+	cidDevUpgradeImage    byte = 0x04
+	cidDevDeleteImage     byte = 0x05
+)
+
+const (
+	// rebootTimeCancel is the reboot time that cancels the scheduled reboot.
+	rebootTimeCancel = 0xffffffff
+	// rebootCountdownCancel is the reboot countdown that cancels the scheduled reboot.
+	rebootCountdownCancel = 0xffffff
+
+	upgradeImageStatusValid = 3
+
+	deleteImageErrNoValidImage   = 1 << 0
+	deleteImageErrInvalidVersion = 1 << 1
+)
+
+// upgradeImageStatuses are the names of the upgrade image statuses in DevUpgradeImageAns.
+var upgradeImageStatuses = [...]string{"NO_IMAGE", "CORRUPT", "INCOMPATIBLE_HARDWARE", "VALID"}
+
+var (
+	errNoCommands    = errors.DefineInvalidArgument("no_commands", "no commands")
+	errUnknownAnswer = errors.DefineInvalidArgument("unknown_answer", "unknown answer with CID `{cid}`")
+	errAnswerLength  = errors.DefineInvalidArgument("answer_length", "answer with CID `{cid}` is too short")
+)
+
+// encodeCommands encodes the commands to the FRMPayload of a downlink message.
+func encodeCommands(cmds *ttnpb.FirmwareManagementCommands) ([]byte, error) {
+	var b []byte
+	if cmds.PackageVersion {
+		b = append(b, cidPackageVersion)
+	}
+	if cmds.DevVersion {
+		b = append(b, cidDevVersion)
+	}
+	if cmds.CancelReboot {
+		b = append(b, cidDevRebootTime)
+		b = appendUint32(b, rebootTimeCancel)
+	} else if cmds.RebootTime != nil {
+		b = append(b, cidDevRebootTime)
+		b = appendUint32(b, uint32(gpstime.ToGPS(*cmds.RebootTime)))
+	}
+	if cmds.RebootCountdown > 0 && !cmds.CancelReboot {
+		c := cmds.RebootCountdown
+		b = append(b, cidDevRebootCountdown, byte(c), byte(c>>8), byte(c>>16))
+	}
+	if cmds.UpgradeImage {
+		b = append(b, cidDevUpgradeImage)
+	}
+	if cmds.DeleteImage {
+		b = append(b, cidDevDeleteImage)
+		b = appendUint32(b, cmds.DeleteImageVersion)
+	}
+	if len(b) == 0 {
+		return nil, errNoCommands
+	}
+	return b, nil
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	return append(b, buf[:]...)
+}
+
+func numberValue(v uint32) *pbtypes.Value {
+	return &pbtypes.Value{Kind: &pbtypes.Value_NumberValue{NumberValue: float64(v)}}
+}
+
+func stringValue(v string) *pbtypes.Value {
+	return &pbtypes.Value{Kind: &pbtypes.Value_StringValue{StringValue: v}}
+}
+
+// decodeAnswers decodes the answers in the FRMPayload of an uplink message to the fields of the association data.
+// A nil value means that the field is removed from the association data.
+func decodeAnswers(b []byte) (map[string]*pbtypes.Value, error) {
+	fields := make(map[string]*pbtypes.Value)
+	for len(b) > 0 {
+		cid := b[0]
+		b = b[1:]
+		var n int
+		switch cid {
+		case cidPackageVersion:
+			n = 2
+		case cidDevVersion:
+			n = 8
+		case cidDevRebootTime:
+			n = 4
+		case cidDevRebootCountdown:
+			n = 3
+		case cidDevUpgradeImage:
+			n = 1
+			if len(b) > 0 && b[0]&0x3 == upgradeImageStatusValid {
+				n = 5
+			}
+		case cidDevDeleteImage:
+			n = 1
+		default:
+			return nil, errUnknownAnswer.WithAttributes("cid", cid)
+		}
+		if len(b) < n {
+			return nil, errAnswerLength.WithAttributes("cid", cid)
+		}
+		pld := b[:n]
+		b = b[n:]
+
+		switch cid {
+		case cidPackageVersion:
+			fields["package_identifier"] = numberValue(uint32(pld[0]))
+			fields["package_version"] = numberValue(uint32(pld[1]))
+		case cidDevVersion:
+			fields["firmware_version"] = numberValue(binary.LittleEndian.Uint32(pld[0:4]))
+			fields["hardware_version"] = numberValue(binary.LittleEndian.Uint32(pld[4:8]))
+		case cidDevRebootTime:
+			switch t := binary.LittleEndian.Uint32(pld); t {
+			case 0, rebootTimeCancel:
+				fields["reboot_time"] = nil
+			default:
+				fields["reboot_time"] = stringValue(gpstime.Parse(int64(t)).UTC().Format(time.RFC3339))
+			}
+		case cidDevRebootCountdown:
+			switch c := uint32(pld[0]) | uint32(pld[1])<<8 | uint32(pld[2])<<16; c {
+			case 0, rebootCountdownCancel:
+				fields["reboot_countdown"] = nil
+			default:
+				fields["reboot_countdown"] = numberValue(c)
+			}
+		case cidDevUpgradeImage:
+			status := pld[0] & 0x3
+			fields["upgrade_image_status"] = stringValue(upgradeImageStatuses[status])
+			if status == upgradeImageStatusValid {
+				fields["next_firmware_version"] = numberValue(binary.LittleEndian.Uint32(pld[1:5]))
+			} else {
+				fields["next_firmware_version"] = nil
+			}
+		case cidDevDeleteImage:
+			switch {
+			case pld[0]&deleteImageErrNoValidImage != 0:
+				fields["delete_image_error"] = stringValue("NO_VALID_IMAGE")
+			case pld[0]&deleteImageErrInvalidVersion != 0:
+				fields["delete_image_error"] = stringValue("INVALID_VERSION")
+			default:
+				fields["delete_image_error"] = nil
+				fields["upgrade_image_status"] = stringValue(upgradeImageStatuses[0])
+				fields["next_firmware_version"] = nil
+			}
+		}
+	}
+	return fields, nil
+}
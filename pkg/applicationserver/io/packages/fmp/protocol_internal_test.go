@@ -0,0 +1,178 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fmp
+
+import (
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEncodeCommands(t *testing.T) {
+	// 2020-01-01T00:00:00Z is 1261872018 (0x4b36a392) seconds since the GPS epoch.
+	rebootTime := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		Name           string
+		Commands       *ttnpb.FirmwareManagementCommands
+		Payload        []byte
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:           "NoCommands",
+			Commands:       &ttnpb.FirmwareManagementCommands{},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Versions",
+			Commands: &ttnpb.FirmwareManagementCommands{
+				PackageVersion: true,
+				DevVersion:     true,
+			},
+			Payload: []byte{0x00, 0x01},
+		},
+		{
+			Name: "Reboot",
+			Commands: &ttnpb.FirmwareManagementCommands{
+				RebootTime:      &rebootTime,
+				RebootCountdown: 3600,
+			},
+			Payload: []byte{0x02, 0x92, 0xa3, 0x36, 0x4b, 0x03, 0x10, 0x0e, 0x00},
+		},
+		{
+			Name: "CancelReboot",
+			Commands: &ttnpb.FirmwareManagementCommands{
+				RebootTime:      &rebootTime,
+				RebootCountdown: 3600,
+				CancelReboot:    true,
+			},
+			Payload: []byte{0x02, 0xff, 0xff, 0xff, 0xff},
+		},
+		{
+			Name: "Image",
+			Commands: &ttnpb.FirmwareManagementCommands{
+				UpgradeImage:       true,
+				DeleteImage:        true,
+				DeleteImageVersion: 0x01020304,
+			},
+			Payload: []byte{0x04, 0x05, 0x04, 0x03, 0x02, 0x01},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			pld, err := encodeCommands(tc.Commands)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(pld, should.Resemble, tc.Payload)
+		})
+	}
+}
+
+func TestDecodeAnswers(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Payload        []byte
+		Fields         map[string]*pbtypes.Value
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:    "Versions",
+			Payload: []byte{0x00, 0x04, 0x01, 0x01, 0x04, 0x03, 0x02, 0x01, 0x02, 0x00, 0x00, 0x00},
+			Fields: map[string]*pbtypes.Value{
+				"package_identifier": numberValue(4),
+				"package_version":    numberValue(1),
+				"firmware_version":   numberValue(0x01020304),
+				"hardware_version":   numberValue(2),
+			},
+		},
+		{
+			Name:    "Reboot",
+			Payload: []byte{0x02, 0x92, 0xa3, 0x36, 0x4b, 0x03, 0x10, 0x0e, 0x00},
+			Fields: map[string]*pbtypes.Value{
+				"reboot_time":      stringValue("2020-01-01T00:00:00Z"),
+				"reboot_countdown": numberValue(3600),
+			},
+		},
+		{
+			Name:    "RebootCanceled",
+			Payload: []byte{0x02, 0xff, 0xff, 0xff, 0xff, 0x03, 0xff, 0xff, 0xff},
+			Fields: map[string]*pbtypes.Value{
+				"reboot_time":      nil,
+				"reboot_countdown": nil,
+			},
+		},
+		{
+			Name:    "ValidImage",
+			Payload: []byte{0x04, 0x03, 0x05, 0x00, 0x00, 0x00},
+			Fields: map[string]*pbtypes.Value{
+				"upgrade_image_status":  stringValue("VALID"),
+				"next_firmware_version": numberValue(5),
+			},
+		},
+		{
+			Name:    "CorruptImage",
+			Payload: []byte{0x04, 0x01},
+			Fields: map[string]*pbtypes.Value{
+				"upgrade_image_status":  stringValue("CORRUPT"),
+				"next_firmware_version": nil,
+			},
+		},
+		{
+			Name:    "ImageDeleted",
+			Payload: []byte{0x05, 0x00},
+			Fields: map[string]*pbtypes.Value{
+				"delete_image_error":    nil,
+				"upgrade_image_status":  stringValue("NO_IMAGE"),
+				"next_firmware_version": nil,
+			},
+		},
+		{
+			Name:    "ImageNotDeleted",
+			Payload: []byte{0x05, 0x02},
+			Fields: map[string]*pbtypes.Value{
+				"delete_image_error": stringValue("INVALID_VERSION"),
+			},
+		},
+		{
+			Name:           "UnknownAnswer",
+			Payload:        []byte{0x42},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "TooShort",
+			Payload:        []byte{0x01, 0x04, 0x03},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			fields, err := decodeAnswers(tc.Payload)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(fields, should.Resemble, tc.Fields)
+		})
+	}
+}
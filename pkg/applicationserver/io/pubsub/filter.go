@@ -0,0 +1,38 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// matchesFPort returns whether the upstream message passes the FPort filter of the pub/sub.
+// Messages without an FPort always pass the filter.
+func matchesFPort(pb *ttnpb.ApplicationPubSub, up *ttnpb.ApplicationUp) bool {
+	if len(pb.FPorts) == 0 {
+		return true
+	}
+	fPort, ok := io.UpFPort(up)
+	if !ok {
+		return true
+	}
+	for _, p := range pb.FPorts {
+		if p == fPort {
+			return true
+		}
+	}
+	return false
+}
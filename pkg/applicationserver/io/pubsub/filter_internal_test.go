@@ -0,0 +1,57 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMatchesFPort(t *testing.T) {
+	uplink := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 42}},
+	}
+	downlinkFailed := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_DownlinkFailed{DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+			ApplicationDownlink: ttnpb.ApplicationDownlink{FPort: 2},
+		}},
+	}
+	joinAccept := &ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_JoinAccept{JoinAccept: &ttnpb.ApplicationJoinAccept{}},
+	}
+
+	for i, tc := range []struct {
+		FPorts  []uint32
+		Up      *ttnpb.ApplicationUp
+		Matches bool
+	}{
+		{FPorts: nil, Up: uplink, Matches: true},
+		{FPorts: []uint32{1, 42}, Up: uplink, Matches: true},
+		{FPorts: []uint32{1, 2}, Up: uplink, Matches: false},
+		{FPorts: []uint32{1, 2}, Up: downlinkFailed, Matches: true},
+		{FPorts: []uint32{42}, Up: downlinkFailed, Matches: false},
+		{FPorts: []uint32{42}, Up: joinAccept, Matches: true},
+	} {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			a := assertions.New(t)
+			pb := &ttnpb.ApplicationPubSub{FPorts: tc.FPorts}
+			a.So(matchesFPort(pb, tc.Up), should.Equal, tc.Matches)
+		})
+	}
+}
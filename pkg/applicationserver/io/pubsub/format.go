@@ -22,7 +22,8 @@ import (
 // Format is a format to use for PubSub integrations.
 type Format struct {
 	formatters.Formatter
-	Name string
+	Name        string
+	ContentType string
 }
 
 var (
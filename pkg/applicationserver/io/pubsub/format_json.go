@@ -18,7 +18,8 @@ import "go.thethings.network/lorawan-stack/pkg/applicationserver/io/formatters"
 
 func init() {
 	formats["json"] = Format{
-		Formatter: formatters.JSON,
-		Name:      "JSON",
+		Formatter:   formatters.JSON,
+		Name:        "JSON",
+		ContentType: "application/json",
 	}
 }
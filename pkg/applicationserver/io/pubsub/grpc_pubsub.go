@@ -103,6 +103,70 @@ func (ps *PubSub) Set(ctx context.Context, req *ttnpb.SetApplicationPubSubReques
 	return pubsub, nil
 }
 
+// GetPubSubStatus implements ttnpb.ApplicationPubSubRegistryServer.
+func (ps *PubSub) GetPubSubStatus(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) (*ttnpb.ApplicationPubSubStatus, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if _, err := ps.registry.Get(ctx, *ids, []string{"ids"}); err != nil {
+		return nil, err
+	}
+	psUID := PubSubUID(unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+	if val, ok := ps.statuses.Load(psUID); ok {
+		return val.(*integrationStatus).Get(), nil
+	}
+	return &ttnpb.ApplicationPubSubStatus{
+		IDs: *ids,
+	}, nil
+}
+
+// Pause implements ttnpb.ApplicationPubSubRegistryServer.
+func (ps *PubSub) Pause(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := ps.registry.Get(ctx, *ids, []string{"ids"}); err != nil {
+		return nil, err
+	}
+	psUID := PubSubUID(unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+	if err := ps.pause(psUID).Pause(ctx); err != nil {
+		return nil, err
+	}
+	if val, ok := ps.integrations.Load(psUID); ok {
+		val.(*integration).status.SetState(ctx, ttnpb.ApplicationPubSubStatus_PAUSED)
+	}
+	events.Publish(evtPausePubSub(ctx, ids.ApplicationIdentifiers, *ids))
+	return ttnpb.Empty, nil
+}
+
+// Resume implements ttnpb.ApplicationPubSubRegistryServer.
+func (ps *PubSub) Resume(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers,
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if _, err := ps.registry.Get(ctx, *ids, []string{"ids"}); err != nil {
+		return nil, err
+	}
+	psUID := PubSubUID(unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+	ps.pause(psUID).Resume()
+	if val, ok := ps.integrations.Load(psUID); ok {
+		i := val.(*integration)
+		if i.status.Get().State == ttnpb.ApplicationPubSubStatus_PAUSED {
+			i.status.SetState(ctx, ttnpb.ApplicationPubSubStatus_CONNECTED)
+		}
+	}
+	events.Publish(evtResumePubSub(ctx, ids.ApplicationIdentifiers, *ids))
+	return ttnpb.Empty, nil
+}
+
 // Delete implements ttnpb.ApplicationPubSubRegistryServer.
 func (ps *PubSub) Delete(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) (*pbtypes.Empty, error) {
 	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers,
@@ -118,6 +182,9 @@ func (ps *PubSub) Delete(ctx context.Context, ids *ttnpb.ApplicationPubSubIdenti
 			"pub_sub_id", ids.PubSubID,
 		)).WithError(err).Warn("Failed to cancel integration")
 	}
+	psUID := PubSubUID(unique.ID(ctx, ids.ApplicationIdentifiers), ids.PubSubID)
+	ps.statuses.Delete(psUID)
+	ps.pauses.Delete(psUID)
 	_, err := ps.registry.Set(ctx, *ids, nil,
 		func(pubsub *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
 			return nil, nil, nil
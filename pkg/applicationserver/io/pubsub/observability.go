@@ -34,6 +34,14 @@ var (
 		"as.pubsub.delete", "delete pubsub",
 		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
 	)
+	evtPausePubSub = events.Define(
+		"as.pubsub.pause", "pause pubsub",
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+	)
+	evtResumePubSub = events.Define(
+		"as.pubsub.resume", "resume pubsub",
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+	)
 	evtPubSubStart = events.Define(
 		"as.pubsub.start", "start pubsub",
 		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
@@ -52,6 +60,11 @@ var (
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
 	)
+	evtPubSubStatusChange = events.Define(
+		"as.pubsub.status.change", "change pubsub status",
+		ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 )
 
 const (
@@ -0,0 +1,151 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/log"
+)
+
+// integrationPause controls the pausing of an integration.
+// It is kept by the PubSub, so that an integration stays paused when it restarts.
+type integrationPause struct {
+	mu         sync.Mutex
+	paused     bool
+	bufferSize int
+	buffer     []*io.ContextualApplicationUp
+	inFlight   int
+	drained    chan struct{}
+	// unpaused is closed when the integration is not paused.
+	unpaused chan struct{}
+	// resumed is signaled when the integration is resumed, so that the buffered messages get published.
+	resumed chan struct{}
+}
+
+func newIntegrationPause(bufferSize int) *integrationPause {
+	unpaused := make(chan struct{})
+	close(unpaused)
+	return &integrationPause{
+		bufferSize: bufferSize,
+		unpaused:   unpaused,
+		resumed:    make(chan struct{}, 1),
+	}
+}
+
+// Paused returns whether the integration is paused.
+func (p *integrationPause) Paused() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.paused
+}
+
+// Pause pauses the integration, and waits until the in-flight messages are published or until the context is done.
+func (p *integrationPause) Pause(ctx context.Context) error {
+	p.mu.Lock()
+	if !p.paused {
+		p.paused = true
+		p.unpaused = make(chan struct{})
+	}
+	if p.inFlight == 0 {
+		p.mu.Unlock()
+		return nil
+	}
+	if p.drained == nil {
+		p.drained = make(chan struct{})
+	}
+	drained := p.drained
+	p.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-drained:
+		return nil
+	}
+}
+
+// Resume resumes the integration.
+func (p *integrationPause) Resume() {
+	p.mu.Lock()
+	if p.paused {
+		p.paused = false
+		close(p.unpaused)
+	}
+	p.mu.Unlock()
+	select {
+	case p.resumed <- struct{}{}:
+	default:
+	}
+}
+
+// Resumed returns a channel that is signaled when the integration is resumed.
+func (p *integrationPause) Resumed() <-chan struct{} {
+	return p.resumed
+}
+
+// Wait blocks until the integration is not paused, or until the context is done.
+func (p *integrationPause) Wait(ctx context.Context) error {
+	p.mu.Lock()
+	unpaused := p.unpaused
+	p.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-unpaused:
+		return nil
+	}
+}
+
+// Take returns the messages to publish: the buffered messages followed by the given messages.
+// If the integration is paused, the given messages are buffered and no messages are returned. If the buffer is full,
+// the oldest messages are dropped.
+// When messages are returned, the caller must call Done when the messages are published.
+func (p *integrationPause) Take(ctx context.Context, ups ...*io.ContextualApplicationUp) []*io.ContextualApplicationUp {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.paused {
+		p.buffer = append(p.buffer, ups...)
+		if dropped := len(p.buffer) - p.bufferSize; dropped > 0 {
+			log.FromContext(ctx).WithField("count", dropped).Debug("Pause buffer full, drop upstream messages")
+			p.buffer = append(p.buffer[:0], p.buffer[dropped:]...)
+		}
+		return nil
+	}
+	res := append(p.buffer, ups...)
+	p.buffer = nil
+	if len(res) > 0 {
+		p.inFlight++
+	}
+	return res
+}
+
+// Done marks the messages returned by Take as published.
+func (p *integrationPause) Done() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.inFlight--
+	if p.inFlight == 0 && p.drained != nil {
+		close(p.drained)
+		p.drained = nil
+	}
+}
+
+// pause returns the pause of the integration with the given unique ID, and creates it if it does not exist.
+func (ps *PubSub) pause(psUID string) *integrationPause {
+	val, _ := ps.pauses.LoadOrStore(psUID, newIntegrationPause(ps.pauseBufferSize))
+	return val.(*integrationPause)
+}
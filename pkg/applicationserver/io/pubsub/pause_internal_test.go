@@ -0,0 +1,85 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestIntegrationPause(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	newUp := func(fPort uint32) *io.ContextualApplicationUp {
+		return &io.ContextualApplicationUp{
+			Context: ctx,
+			ApplicationUp: &ttnpb.ApplicationUp{
+				Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: fPort}},
+			},
+		}
+	}
+	ups := []*io.ContextualApplicationUp{newUp(1), newUp(2), newUp(3), newUp(4)}
+
+	p := newIntegrationPause(2)
+	a.So(p.Paused(), should.BeFalse)
+	a.So(p.Wait(ctx), should.BeNil)
+
+	// Messages are passed through when not paused.
+	a.So(p.Take(ctx, ups[0]), should.Resemble, ups[:1])
+
+	// Pausing waits for the in-flight messages.
+	pauseCtx, cancel := context.WithTimeout(ctx, 5*test.Delay)
+	err := p.Pause(pauseCtx)
+	cancel()
+	a.So(errors.Is(err, context.DeadlineExceeded), should.BeTrue)
+	a.So(p.Paused(), should.BeTrue)
+	go func() {
+		time.Sleep(test.Delay)
+		p.Done()
+	}()
+	a.So(p.Pause(ctx), should.BeNil)
+
+	// Messages are buffered when paused, and the oldest messages are dropped.
+	for _, up := range ups[1:] {
+		a.So(p.Take(ctx, up), should.BeEmpty)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, test.Delay)
+	err = p.Wait(waitCtx)
+	cancel()
+	a.So(errors.Is(err, context.DeadlineExceeded), should.BeTrue)
+
+	// The buffered messages are returned when resumed.
+	p.Resume()
+	a.So(p.Paused(), should.BeFalse)
+	a.So(p.Wait(ctx), should.BeNil)
+	select {
+	case <-p.Resumed():
+	default:
+		t.Fatal("Resume not signaled")
+	}
+	a.So(p.Take(ctx), should.Resemble, ups[2:])
+	p.Done()
+	a.So(p.Take(ctx), should.BeEmpty)
+	a.So(p.Pause(ctx), should.BeNil)
+}
@@ -0,0 +1,157 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/random"
+)
+
+var (
+	timeout          = (1 << 3) * time.Second
+	reconnectBackoff = []time.Duration{100 * time.Millisecond, 1 * time.Second, 10 * time.Second}
+
+	errConnectionClosed = errors.DefineUnavailable("connection_closed", "connection closed")
+)
+
+// connection is an AMQP connection that is re-established with backoff when it is lost.
+// Channels are opened on the current connection; callers open new channels after a reconnect.
+type connection struct {
+	url    string
+	config amqp.Config
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu        sync.RWMutex
+	conn      *amqp.Connection
+	connected chan struct{}
+}
+
+func dial(ctx context.Context, url string, config amqp.Config) (*connection, error) {
+	conn, err := amqp.DialConfig(url, config)
+	if err != nil {
+		return nil, err
+	}
+	c := &connection{
+		url:       url,
+		config:    config,
+		done:      make(chan struct{}),
+		conn:      conn,
+		connected: make(chan struct{}),
+	}
+	close(c.connected)
+	c.ctx, c.cancel = context.WithCancel(ctx)
+	go c.run(conn, conn.NotifyClose(make(chan *amqp.Error, 1)))
+	return c, nil
+}
+
+func (c *connection) run(conn *amqp.Connection, closed <-chan *amqp.Error) {
+	defer close(c.done)
+	logger := log.FromContext(c.ctx)
+	for {
+		select {
+		case <-c.ctx.Done():
+			conn.Close()
+			return
+		case err := <-closed:
+			if err == nil {
+				// The connection has been closed by the client.
+				return
+			}
+			logger.WithError(err).Warn("Connection lost")
+		}
+		c.mu.Lock()
+		c.conn = nil
+		c.connected = make(chan struct{})
+		c.mu.Unlock()
+
+		if conn, closed = c.reconnect(); conn == nil {
+			return
+		}
+
+		c.mu.Lock()
+		c.conn = conn
+		close(c.connected)
+		c.mu.Unlock()
+	}
+}
+
+// reconnect dials the server until it succeeds or the connection is shut down.
+func (c *connection) reconnect() (*amqp.Connection, <-chan *amqp.Error) {
+	logger := log.FromContext(c.ctx)
+	for attempt := 0; ; attempt++ {
+		bi := attempt
+		if bi >= len(reconnectBackoff) {
+			bi = len(reconnectBackoff) - 1
+		}
+		select {
+		case <-c.ctx.Done():
+			return nil, nil
+		case <-time.After(random.Jitter(reconnectBackoff[bi], 0.1)):
+		}
+		conn, err := amqp.DialConfig(c.url, c.config)
+		if err != nil {
+			logger.WithError(err).WithField("attempt", attempt+1).Warn("Failed to reconnect")
+			continue
+		}
+		logger.Info("Reconnected")
+		return conn, conn.NotifyClose(make(chan *amqp.Error, 1))
+	}
+}
+
+// channel opens a channel on the connection.
+// If the connection is lost, channel blocks until the connection is re-established or the context is done.
+func (c *connection) channel(ctx context.Context) (*amqp.Channel, error) {
+	for {
+		c.mu.RLock()
+		conn, connected := c.conn, c.connected
+		c.mu.RUnlock()
+		if conn != nil {
+			ch, err := conn.Channel()
+			if err != amqp.ErrClosed {
+				return ch, err
+			}
+			// The connection is closed, but the reconnect has not started yet.
+			connected = nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.ctx.Done():
+			return nil, errConnectionClosed
+		case <-connected:
+		case <-time.After(reconnectBackoff[0]):
+		}
+	}
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(ctx context.Context) error {
+	c.cancel()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.done:
+		return nil
+	}
+}
@@ -0,0 +1,381 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package amqp
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	conn       *connection
+	exchange   string
+	routingKey string
+
+	mu sync.Mutex
+	ch *amqp.Channel
+}
+
+// openTopic returns a *pubsub.Topic that publishes to the given exchange with the given routing key.
+func openTopic(conn *connection, exchange, routingKey string) *pubsub.Topic {
+	return pubsub.NewTopic(&topic{
+		conn:       conn,
+		exchange:   exchange,
+		routingKey: routingKey,
+	}, nil)
+}
+
+func (t *topic) channel(ctx context.Context) (*amqp.Channel, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ch != nil {
+		return t.ch, nil
+	}
+	ch, err := t.conn.channel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t.ch = ch
+	return ch, nil
+}
+
+// resetChannel discards the given channel, so that the next batch is sent on a new channel.
+func (t *topic) resetChannel(ch *amqp.Channel) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ch == ch {
+		t.ch = nil
+	}
+	ch.Close()
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	ch, err := t.channel(ctx)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		publishing := &amqp.Publishing{
+			Headers:      encodeHeaders(msg.Metadata),
+			ContentType:  msg.Metadata[provider.MetadataContentType],
+			DeliveryMode: amqp.Persistent,
+			Timestamp:    time.Now(),
+			Body:         msg.Body,
+		}
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**amqp.Publishing)
+				if !ok {
+					return false
+				}
+				*p = publishing
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if err := ch.Publish(t.exchange, t.routingKey, false, false, *publishing); err != nil {
+			t.resetChannel(ch)
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeHeaders returns the headers of the given message metadata.
+// The content type is carried in the content type property instead of the headers.
+func encodeHeaders(metadata map[string]string) amqp.Table {
+	var headers amqp.Table
+	for k, v := range metadata {
+		if k == provider.MetadataContentType {
+			continue
+		}
+		if headers == nil {
+			headers = make(amqp.Table, len(metadata))
+		}
+		headers[k] = v
+	}
+	return headers
+}
+
+func decodeMessage(delivery amqp.Delivery) *driver.Message {
+	asFunc := func(i interface{}) bool {
+		p, ok := i.(*amqp.Delivery)
+		if !ok {
+			return false
+		}
+		*p = delivery
+		return true
+	}
+	var metadata map[string]string
+	for k, v := range delivery.Headers {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string, len(delivery.Headers))
+		}
+		metadata[k] = s
+	}
+	return &driver.Message{
+		Body:     delivery.Body,
+		Metadata: metadata,
+		AckID:    delivery.DeliveryTag,
+		AsFunc:   asFunc,
+	}
+}
+
+// IsRetryable implements driver.Topic.
+func (*topic) IsRetryable(err error) bool { return isRetryable(err) }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	c, ok := i.(**amqp.Channel)
+	if !ok {
+		return false
+	}
+	t.mu.Lock()
+	*c = t.ch
+	t.mu.Unlock()
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(err error, i interface{}) bool { return errorAs(err, i) }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (t *topic) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.ch == nil {
+		return nil
+	}
+	err := t.ch.Close()
+	t.ch = nil
+	if err == amqp.ErrClosed {
+		return nil
+	}
+	return err
+}
+
+type subscription struct {
+	conn       *connection
+	exchange   string
+	routingKey string
+	queue      string
+
+	mu         sync.Mutex
+	ch         *amqp.Channel
+	deliveries <-chan amqp.Delivery
+}
+
+// openSubscription returns a *pubsub.Subscription that consumes the given queue.
+// The queue is declared and, if an exchange is given, bound to the exchange with the given routing key.
+// The queue is consumed again when the connection is re-established.
+func openSubscription(ctx context.Context, conn *connection, exchange, routingKey, queue string) (*pubsub.Subscription, error) {
+	ds := &subscription{
+		conn:       conn,
+		exchange:   exchange,
+		routingKey: routingKey,
+		queue:      queue,
+	}
+	if _, err := ds.consume(ctx); err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+// consume returns the deliveries of the queue, declaring and consuming the queue on a new channel if needed.
+func (s *subscription) consume(ctx context.Context) (<-chan amqp.Delivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deliveries != nil {
+		return s.deliveries, nil
+	}
+	ch, err := s.conn.channel(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ch.QueueDeclare(s.queue, true, false, false, false, nil); err != nil {
+		ch.Close()
+		return nil, err
+	}
+	if s.exchange != "" {
+		if err := ch.QueueBind(s.queue, s.routingKey, s.exchange, false, nil); err != nil {
+			ch.Close()
+			return nil, err
+		}
+	}
+	deliveries, err := ch.Consume(s.queue, "", true, false, false, false, nil)
+	if err != nil {
+		ch.Close()
+		return nil, err
+	}
+	s.ch, s.deliveries = ch, deliveries
+	return deliveries, nil
+}
+
+// resetDeliveries discards the channel when the deliveries are closed, so that the queue is consumed again.
+func (s *subscription) resetDeliveries(deliveries <-chan amqp.Delivery) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.deliveries != deliveries {
+		return
+	}
+	s.ch.Close()
+	s.ch, s.deliveries = nil, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	deliveries, err := s.consume(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var messages []*driver.Message
+outer:
+	for i := 0; i < maxMessages; i++ {
+		select {
+		case <-ctx.Done():
+			break outer
+		case delivery, ok := <-deliveries:
+			if !ok {
+				s.resetDeliveries(deliveries)
+				break outer
+			}
+			messages = append(messages, decodeMessage(delivery))
+		// We cannot delay the messages for too long for the sake of
+		// having bigger batches. Avoid busy waiting, but don't wait
+		// for too long.
+		case <-time.After(1 * time.Millisecond):
+			break outer
+		}
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+// The deliveries are acknowledged automatically by the server.
+func (*subscription) SendAcks(context.Context, []driver.AckID) error { return nil }
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(err error) bool { return isRetryable(err) }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	c, ok := i.(**amqp.Channel)
+	if !ok {
+		return false
+	}
+	s.mu.Lock()
+	*c = s.ch
+	s.mu.Unlock()
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(err error, i interface{}) bool { return errorAs(err, i) }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ch == nil {
+		return nil
+	}
+	err := s.ch.Close()
+	s.ch, s.deliveries = nil, nil
+	if err == amqp.ErrClosed {
+		return nil
+	}
+	return err
+}
+
+// isRetryable returns whether the operation can be retried on a new channel.
+func isRetryable(err error) bool {
+	if err == amqp.ErrClosed {
+		return true
+	}
+	amqpErr, ok := err.(*amqp.Error)
+	return ok && amqpErr.Recover
+}
+
+func errorAs(err error, i interface{}) bool {
+	amqpErr, ok := err.(*amqp.Error)
+	if !ok {
+		return false
+	}
+	p, ok := i.(**amqp.Error)
+	if !ok {
+		return false
+	}
+	*p = amqpErr
+	return true
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	case amqp.ErrClosed:
+		return gcerrors.FailedPrecondition
+	}
+	if amqpErr, ok := err.(*amqp.Error); ok {
+		switch amqpErr.Code {
+		case amqp.NotFound:
+			return gcerrors.NotFound
+		case amqp.AccessRefused:
+			return gcerrors.PermissionDenied
+		case amqp.PreconditionFailed:
+			return gcerrors.FailedPrecondition
+		case amqp.NotImplemented:
+			return gcerrors.Unimplemented
+		}
+	}
+	return gcerrors.Unknown
+}
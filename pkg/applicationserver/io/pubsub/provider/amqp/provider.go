@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package amqp implements the AMQP 0.9.1 provider using the amqp driver.
+package amqp
+
+import (
+	"context"
+	"time"
+
+	amqp "github.com/streadway/amqp"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+var errInvalidServerURL = errors.DefineInvalidArgument("server_url", "invalid server URL")
+
+type impl struct {
+}
+
+// createConfig returns the server URL and connection configuration of the given settings.
+// If TLS is used, the amqp scheme is upgraded to amqps, including the default port.
+func createConfig(settings *ttnpb.ApplicationPubSub_AMQPProvider) (string, amqp.Config, error) {
+	config := amqp.Config{
+		Heartbeat: 10 * time.Second,
+		Locale:    "en_US",
+		Dial:      amqp.DefaultDial(timeout),
+	}
+	uri, err := amqp.ParseURI(settings.ServerURL)
+	if err != nil {
+		return "", config, errInvalidServerURL.WithCause(err)
+	}
+	if settings.UseTLS {
+		tlsConfig, err := tlsconfig.New(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return "", config, err
+		}
+		config.TLSClientConfig = tlsConfig
+		if uri.Scheme == "amqp" {
+			uri.Scheme = "amqps"
+			if uri.Port == 5672 {
+				uri.Port = 5671
+			}
+		}
+	}
+	return uri.String(), config, nil
+}
+
+// OpenConnection implements provider.Provider using the amqp driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AMQP)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	url, config, err := createConfig(settings.AMQP)
+	if err != nil {
+		return nil, err
+	}
+	client, err := dial(ctx, url, config)
+	if err != nil {
+		return nil, err
+	}
+	conn := &provider.Connection{
+		ProviderConnection:  client,
+		ContentTypeMetadata: true,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	exchange := settings.AMQP.Exchange
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		*t.topic = openTopic(client, exchange, combineRoutingKeys(target.GetBaseTopic(), t.message.GetTopic()))
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		// The queue is named after the routing key, so that it can be used with the default exchange.
+		routingKey := combineRoutingKeys(target.GetBaseTopic(), s.message.GetTopic())
+		if *s.subscription, err = openSubscription(ctx, client, exchange, routingKey, routingKey); err != nil {
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AMQP{}, impl{})
+}
@@ -0,0 +1,211 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiot
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDeriveRegion(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		endpoint  string
+		expected  string
+		assertErr func(error) bool
+	}{
+		{
+			name:     "ATS",
+			endpoint: "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+			expected: "eu-west-1",
+		},
+		{
+			name:     "Legacy",
+			endpoint: "a1b2c3d4e5f6g7.iot.us-east-2.amazonaws.com",
+			expected: "us-east-2",
+		},
+		{
+			name:      "Custom",
+			endpoint:  "iot.example.com",
+			assertErr: errors.IsInvalidArgument,
+		},
+		{
+			name:      "Empty",
+			endpoint:  "",
+			assertErr: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			region, err := deriveRegion(tc.endpoint)
+			if tc.assertErr != nil {
+				a.So(tc.assertErr(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(region, should.Equal, tc.expected)
+		})
+	}
+}
+
+func TestTopicName(t *testing.T) {
+	a := assertions.New(t)
+	replacer := strings.NewReplacer(thingNamePlaceholder, "my-thing")
+	a.So(topicName(replacer, "things/{thing_name}", "uplink"), should.Equal, "things/my-thing/uplink")
+	a.So(topicName(replacer, "app", "{thing_name}/down/push"), should.Equal, "app/my-thing/down/push")
+	a.So(topicName(replacer, "", "up"), should.Equal, "up")
+}
+
+func TestPresignURL(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Date(2019, time.November, 20, 12, 0, 0, 0, time.UTC)
+
+	s, err := presignURL("a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com", "eu-west-1", "AKIAEXAMPLE", "secret", "", now)
+	a.So(err, should.BeNil)
+	u, err := url.Parse(s)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(u.Scheme, should.Equal, "wss")
+	a.So(u.Host, should.Equal, "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com")
+	a.So(u.Path, should.Equal, "/mqtt")
+	q := u.Query()
+	a.So(q.Get("X-Amz-Algorithm"), should.Equal, "AWS4-HMAC-SHA256")
+	a.So(q.Get("X-Amz-Credential"), should.Equal, "AKIAEXAMPLE/20191120/eu-west-1/iotdevicegateway/aws4_request")
+	a.So(q.Get("X-Amz-Date"), should.Equal, "20191120T120000Z")
+	a.So(q.Get("X-Amz-Expires"), should.Equal, "900")
+	a.So(q.Get("X-Amz-Signature"), should.NotBeEmpty)
+	a.So(q.Get("X-Amz-Security-Token"), should.BeEmpty)
+
+	s, err = presignURL("a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com", "eu-west-1", "AKIAEXAMPLE", "secret", "token/+=", now)
+	a.So(err, should.BeNil)
+	u, err = url.Parse(s)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(u.Query().Get("X-Amz-Security-Token"), should.Equal, "token/+=")
+}
+
+func TestCreateClientOptions(t *testing.T) {
+	now := time.Date(2019, time.November, 20, 12, 0, 0, 0, time.UTC)
+	target := &ttnpb.ApplicationPubSub{
+		ApplicationPubSubIdentifiers: ttnpb.ApplicationPubSubIdentifiers{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+				ApplicationID: "app1",
+			},
+			PubSubID: "ps1",
+		},
+	}
+	for _, tc := range []struct {
+		name                  string
+		settings              *ttnpb.ApplicationPubSub_AWSIoTProvider
+		assertErr             func(error) bool
+		expectedClientID      string
+		expectedScheme        string
+		expectedAutoReconnect bool
+	}{
+		{
+			name: "SigV4",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+				ThingName:            "my-thing",
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AWSIoTProvider_SIGV4_WEBSOCKET,
+				AccessKeyID:          "AKIAEXAMPLE",
+				SecretAccessKey:      "secret",
+			},
+			expectedClientID: "my-thing",
+			expectedScheme:   "wss",
+		},
+		{
+			name: "X509",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+				ThingName:            "my-thing",
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AWSIoTProvider_X509,
+			},
+			expectedClientID:      "my-thing",
+			expectedScheme:        "ssl",
+			expectedAutoReconnect: true,
+		},
+		{
+			name: "SigV4/NoThingName",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AWSIoTProvider_SIGV4_WEBSOCKET,
+				AccessKeyID:          "AKIAEXAMPLE",
+				SecretAccessKey:      "secret",
+			},
+			expectedClientID: "ttn-lw-as.app1.ps1",
+			expectedScheme:   "wss",
+		},
+		{
+			name: "SigV4/NoRegion",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "iot.example.com",
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AWSIoTProvider_SIGV4_WEBSOCKET,
+			},
+			assertErr: errors.IsInvalidArgument,
+		},
+		{
+			name: "X509/InvalidCertificate",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AWSIoTProvider_X509,
+				TLSClientCert:        []byte("invalid"),
+				TLSClientKey:         []byte("invalid"),
+			},
+			assertErr: func(err error) bool { return err != nil },
+		},
+		{
+			name: "InvalidEndpoint",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint: "ssl://a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com:8883",
+			},
+			assertErr: errors.IsInvalidArgument,
+		},
+		{
+			name: "InvalidAuthenticationMethod",
+			settings: &ttnpb.ApplicationPubSub_AWSIoTProvider{
+				Endpoint:             "a1b2c3d4e5f6g7-ats.iot.eu-west-1.amazonaws.com",
+				AuthenticationMethod: 42,
+			},
+			assertErr: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			opts, err := createClientOptions(target, tc.settings, now)
+			if tc.assertErr != nil {
+				a.So(tc.assertErr(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(opts.ClientID, should.Equal, tc.expectedClientID)
+			if a.So(opts.Servers, should.HaveLength, 1) {
+				a.So(opts.Servers[0].Scheme, should.Equal, tc.expectedScheme)
+			}
+			a.So(opts.AutoReconnect, should.Equal, tc.expectedAutoReconnect)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsiot implements the AWS IoT Core provider using the mqtt driver.
+package awsiot
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	mqtt_topic "github.com/TheThingsIndustries/mystique/pkg/topic"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
+	mqtt_provider "go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/mqtt"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+const (
+	// signingService is the service name used in the SigV4 signature of the AWS IoT device gateway.
+	signingService = "iotdevicegateway"
+	// signatureExpiry is the validity of the presigned WebSocket URL.
+	// AWS IoT only verifies the signature when the connection is established, and the URL is signed again for each
+	// connection, so it only has to be valid while connecting.
+	signatureExpiry = 15 * time.Minute
+	// qos is the MQTT QoS used for publishing and subscribing. AWS IoT does not support QoS 2.
+	qos = 1
+	// thingNamePlaceholder is replaced with the thing name in the base topic and the message topics.
+	thingNamePlaceholder = "{thing_name}"
+)
+
+var (
+	timeout = (1 << 3) * time.Second
+
+	errEndpoint             = errors.DefineInvalidArgument("endpoint", "invalid endpoint `{endpoint}`")
+	errRegion               = errors.DefineInvalidArgument("region", "region cannot be derived from endpoint `{endpoint}`, set the region explicitly")
+	errAuthenticationMethod = errors.DefineInvalidArgument("authentication_method", "authentication method `{method}` is not supported")
+	errConnect              = errors.DefineUnavailable("connect", "connect to AWS IoT endpoint `{endpoint}`")
+	errConnectionLost       = errors.DefineUnavailable("connection_lost", "connection to AWS IoT endpoint `{endpoint}` lost")
+)
+
+type impl struct {
+}
+
+// identifiers is implemented by targets that are identified by application and pub/sub ID, such as
+// ttnpb.ApplicationPubSub. It is used to derive the client ID if no thing name is set.
+type identifiers interface {
+	GetApplicationID() string
+	GetPubSubID() string
+}
+
+type connection struct {
+	mqtt.Client
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	c.Disconnect(uint(timeout / time.Millisecond))
+	return nil
+}
+
+// deriveRegion returns the AWS region of the given AWS IoT endpoint, i.e. xxx-ats.iot.<region>.amazonaws.com.
+// Other endpoints, such as custom domains, require the region to be set explicitly.
+func deriveRegion(endpoint string) (string, error) {
+	parts := strings.Split(endpoint, ".")
+	if n := len(parts); n >= 5 && parts[n-4] == "iot" && parts[n-3] != "" && parts[n-2] == "amazonaws" && parts[n-1] == "com" {
+		return parts[n-3], nil
+	}
+	return "", errRegion.WithAttributes("endpoint", endpoint)
+}
+
+// presignURL returns the WebSocket URL of the given endpoint, presigned with SigV4 using the given credentials.
+// The session token is appended after signing, as AWS IoT does not include it in the signature.
+func presignURL(endpoint, region, accessKeyID, secretAccessKey, sessionToken string, now time.Time) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("wss://%s/mqtt", endpoint), nil)
+	if err != nil {
+		return "", errEndpoint.WithCause(err).WithAttributes("endpoint", endpoint)
+	}
+	signer := v4.NewSigner(credentials.NewStaticCredentials(accessKeyID, secretAccessKey, ""))
+	if _, err := signer.Presign(req, nil, signingService, region, signatureExpiry, now); err != nil {
+		return "", err
+	}
+	if sessionToken != "" {
+		req.URL.RawQuery += "&X-Amz-Security-Token=" + url.QueryEscape(sessionToken)
+	}
+	return req.URL.String(), nil
+}
+
+func clientID(target provider.Target, settings *ttnpb.ApplicationPubSub_AWSIoTProvider) string {
+	if settings.ThingName != "" {
+		return settings.ThingName
+	}
+	if ids, ok := target.(identifiers); ok {
+		return fmt.Sprintf("ttn-lw-as.%s.%s", ids.GetApplicationID(), ids.GetPubSubID())
+	}
+	return "ttn-lw-as"
+}
+
+func createClientOptions(target provider.Target, settings *ttnpb.ApplicationPubSub_AWSIoTProvider, now time.Time) (*mqtt.ClientOptions, error) {
+	if settings.Endpoint == "" || strings.ContainsAny(settings.Endpoint, "/:") {
+		return nil, errEndpoint.WithAttributes("endpoint", settings.Endpoint)
+	}
+	clientOpts := mqtt.NewClientOptions()
+	clientOpts.SetClientID(clientID(target, settings))
+	switch settings.AuthenticationMethod {
+	case ttnpb.ApplicationPubSub_AWSIoTProvider_X509:
+		config, err := tlsconfig.New(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		clientOpts.AddBroker(fmt.Sprintf("ssl://%s:8883", settings.Endpoint))
+		clientOpts.SetTLSConfig(config)
+	case ttnpb.ApplicationPubSub_AWSIoTProvider_SIGV4_WEBSOCKET:
+		region := settings.Region
+		if region == "" {
+			var err error
+			if region, err = deriveRegion(settings.Endpoint); err != nil {
+				return nil, err
+			}
+		}
+		config, err := tlsconfig.New(settings.TLSCA, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		brokerURL, err := presignURL(settings.Endpoint, region, settings.AccessKeyID, settings.SecretAccessKey, settings.SessionToken, now)
+		if err != nil {
+			return nil, err
+		}
+		clientOpts.AddBroker(brokerURL)
+		clientOpts.SetTLSConfig(config)
+		// The MQTT client reconnects with the same URL, which is rejected when the signature or the session token
+		// expired. Instead, the connection fails when it is lost, and the integration is restarted with a new URL.
+		clientOpts.SetAutoReconnect(false)
+	default:
+		return nil, errAuthenticationMethod.WithAttributes("method", settings.AuthenticationMethod)
+	}
+	return clientOpts, nil
+}
+
+// topicName returns the MQTT topic of the given message topic, with the thing name placeholders replaced.
+// The base topic is omitted if it is empty.
+func topicName(replacer *strings.Replacer, baseTopic, topic string) string {
+	if baseTopic == "" {
+		return replacer.Replace(topic)
+	}
+	return replacer.Replace(mqtt_topic.Join(append(mqtt_topic.Split(baseTopic), mqtt_topic.Split(topic)...)))
+}
+
+// OpenConnection implements provider.Provider using the mqtt driver.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_AWSIoT)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	clientOpts, err := createClientOptions(target, settings.AWSIoT, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	var errCh chan error
+	if !clientOpts.AutoReconnect {
+		errCh = make(chan error, 1)
+		clientOpts.SetConnectionLostHandler(func(_ mqtt.Client, err error) {
+			select {
+			case errCh <- errConnectionLost.WithAttributes("endpoint", settings.AWSIoT.Endpoint).WithCause(err):
+			default:
+			}
+		})
+	}
+	client := mqtt.NewClient(clientOpts)
+	if token := client.Connect(); !token.WaitTimeout(timeout) {
+		return nil, errConnect.WithAttributes("endpoint", settings.AWSIoT.Endpoint).WithCause(context.DeadlineExceeded)
+	} else if err := token.Error(); err != nil {
+		return nil, errConnect.WithAttributes("endpoint", settings.AWSIoT.Endpoint).WithCause(err)
+	}
+	conn := &provider.Connection{
+		ProviderConnection: &connection{
+			Client: client,
+		},
+		Errors: errCh,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	replacer := strings.NewReplacer(thingNamePlaceholder, settings.AWSIoT.ThingName)
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = mqtt_provider.OpenTopic(
+			client,
+			topicName(replacer, target.GetBaseTopic(), t.message.GetTopic()),
+			timeout,
+			qos,
+		); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if *s.subscription, err = mqtt_provider.OpenSubscription(
+			client,
+			topicName(replacer, target.GetBaseTopic(), s.message.GetTopic()),
+			timeout,
+			qos,
+		); err != nil {
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_AWSIoT{}, impl{})
+}
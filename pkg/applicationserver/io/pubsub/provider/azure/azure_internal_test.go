@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"testing"
+
+	servicebus "github.com/Azure/azure-service-bus-go"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub/driver"
+)
+
+func TestCreateCredentials(t *testing.T) {
+	for _, tc := range []struct {
+		name              string
+		settings          *ttnpb.ApplicationPubSub_AzureProvider
+		connectionString  string
+		namespace         string
+		assertErr         func(error) bool
+		expectedNamespace string
+	}{
+		{
+			name:              "ConnectionString",
+			settings:          &ttnpb.ApplicationPubSub_AzureProvider{},
+			connectionString:  "Endpoint=sb://my-namespace.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=c2VjcmV0",
+			expectedNamespace: "my-namespace",
+		},
+		{
+			name:             "ConnectionString/Invalid",
+			settings:         &ttnpb.ApplicationPubSub_AzureProvider{},
+			connectionString: "invalid",
+			assertErr:        errors.IsInvalidArgument,
+		},
+		{
+			name: "AAD",
+			settings: &ttnpb.ApplicationPubSub_AzureProvider{
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AzureProvider_AAD,
+				TenantID:             "00000000-0000-0000-0000-000000000001",
+				ClientID:             "00000000-0000-0000-0000-000000000002",
+				ClientSecret:         "secret",
+			},
+			namespace:         "my-namespace",
+			expectedNamespace: "my-namespace",
+		},
+		{
+			name: "AAD/InvalidNamespace",
+			settings: &ttnpb.ApplicationPubSub_AzureProvider{
+				AuthenticationMethod: ttnpb.ApplicationPubSub_AzureProvider_AAD,
+			},
+			namespace: "my-namespace.servicebus.windows.net",
+			assertErr: errors.IsInvalidArgument,
+		},
+		{
+			name: "InvalidAuthenticationMethod",
+			settings: &ttnpb.ApplicationPubSub_AzureProvider{
+				AuthenticationMethod: 42,
+			},
+			assertErr: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			creds, err := createCredentials(tc.settings, tc.connectionString, tc.namespace)
+			if tc.assertErr != nil {
+				a.So(tc.assertErr(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(creds.namespace, should.Equal, tc.expectedNamespace)
+			a.So(creds.tokenProvider, should.NotBeNil)
+		})
+	}
+}
+
+func TestCombineEntityNames(t *testing.T) {
+	a := assertions.New(t)
+	a.So(combineEntityNames("app1", "up"), should.Equal, "app1.up")
+	a.So(combineEntityNames("app1.", ".down.push"), should.Equal, "app1.down.push")
+	a.So(combineEntityNames("", "up"), should.Equal, "up")
+	a.So(combineEntityNames("app1", ""), should.Equal, "app1")
+}
+
+func TestEncodeEvent(t *testing.T) {
+	for _, tc := range []struct {
+		name                 string
+		metadata             map[string]string
+		expectedPartitionKey *string
+	}{
+		{
+			name: "DevEUI",
+			metadata: map[string]string{
+				provider.MetadataDeviceID: "dev1",
+				provider.MetadataDevEUI:   "0102030405060708",
+			},
+			expectedPartitionKey: func(s string) *string { return &s }("0102030405060708"),
+		},
+		{
+			name: "DeviceID",
+			metadata: map[string]string{
+				provider.MetadataDeviceID: "dev1",
+			},
+			expectedPartitionKey: func(s string) *string { return &s }("dev1"),
+		},
+		{
+			name: "NoMetadata",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			event := encodeEvent(&driver.Message{
+				Body:     []byte("foobar"),
+				Metadata: tc.metadata,
+			})
+			a.So(event.Data, should.Resemble, []byte("foobar"))
+			a.So(event.PartitionKey, should.Resemble, tc.expectedPartitionKey)
+			a.So(event.Properties, should.HaveLength, len(tc.metadata))
+		})
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	a := assertions.New(t)
+	msg := &servicebus.Message{
+		ID:   "msg1",
+		Data: []byte("foobar"),
+		UserProperties: map[string]interface{}{
+			"key":    "value",
+			"number": 42,
+		},
+	}
+	dm := decodeMessage(msg)
+	a.So(dm.Body, should.Resemble, []byte("foobar"))
+	a.So(dm.Metadata, should.Resemble, map[string]string{"key": "value"})
+	a.So(dm.AckID, should.Equal, "msg1")
+	var res *servicebus.Message
+	a.So(dm.AsFunc(&res), should.BeTrue)
+	a.So(res, should.Equal, msg)
+}
@@ -0,0 +1,130 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+type topic struct {
+	hub *eventhub.Hub
+}
+
+// openTopic returns a *pubsub.Topic that publishes to the given Event Hub.
+func openTopic(creds *credentials, name string) (*pubsub.Topic, error) {
+	hub, err := eventhub.NewHub(creds.namespace, name, creds.tokenProvider)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(&topic{
+		hub: hub,
+	}, nil), nil
+}
+
+// partitionKey returns the partition key of the message with the given metadata.
+// The messages are partitioned by DevEUI, so that the messages of an end device are ordered.
+// The messages of end devices without DevEUI are partitioned by device ID.
+func partitionKey(metadata map[string]string) string {
+	if devEUI, ok := metadata[provider.MetadataDevEUI]; ok {
+		return devEUI
+	}
+	return metadata[provider.MetadataDeviceID]
+}
+
+func encodeEvent(msg *driver.Message) *eventhub.Event {
+	event := eventhub.NewEvent(msg.Body)
+	if key := partitionKey(msg.Metadata); key != "" {
+		event.PartitionKey = &key
+	}
+	if len(msg.Metadata) > 0 {
+		event.Properties = make(map[string]interface{}, len(msg.Metadata))
+		for k, v := range msg.Metadata {
+			event.Properties[k] = v
+		}
+	}
+	return event
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		event := encodeEvent(msg)
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**eventhub.Event)
+				if !ok {
+					return false
+				}
+				*p = event
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		if err := t.hub.Send(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Topic.
+// The Event Hubs client retries sending internally.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(**eventhub.Hub)
+	if !ok {
+		return false
+	}
+	*p = t.hub
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+func (t *topic) Close() error {
+	return t.hub.Close(context.Background())
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	}
+	return gcerrors.Unknown
+}
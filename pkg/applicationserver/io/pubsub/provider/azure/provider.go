@@ -0,0 +1,240 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package azure implements the Azure provider, which publishes to Event Hubs and subscribes to Service Bus queues.
+package azure
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-amqp-common-go/v3/auth"
+	amqp_conn "github.com/Azure/azure-amqp-common-go/v3/conn"
+	"github.com/Azure/azure-amqp-common-go/v3/sas"
+	servicebus "github.com/Azure/azure-service-bus-go"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+var (
+	errAuthenticationMethod = errors.DefineInvalidArgument("authentication_method", "authentication method `{method}` is not supported")
+	errConnectionString     = errors.DefineInvalidArgument("connection_string", "invalid connection string")
+	errNamespace            = errors.DefineInvalidArgument("namespace", "invalid namespace `{namespace}`")
+	errTokenProvider        = errors.DefineInvalidArgument("token_provider", "create token provider")
+
+	// namespaceRegex matches the names of Event Hubs and Service Bus namespaces.
+	namespaceRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$`)
+)
+
+type impl struct {
+}
+
+type connection struct {
+}
+
+// Shutdown implements provider.Shutdowner.
+// The Event Hubs and Service Bus clients are owned by the topics and subscriptions, which are shut down by the
+// provider.Connection.
+func (c *connection) Shutdown(_ context.Context) error {
+	return nil
+}
+
+// credentials are the name of an Event Hubs or Service Bus namespace and the token provider to authenticate with.
+type credentials struct {
+	namespace     string
+	tokenProvider auth.TokenProvider
+}
+
+const (
+	activeDirectoryEndpoint = "https://login.microsoftonline.com/"
+	aadResourceURI          = "https://eventhubs.azure.net/"
+)
+
+// aadTokenProvider is an auth.TokenProvider for AAD authentication.
+// Unlike the provider of aad.NewJWTProvider, it does not request a token until it is used.
+type aadTokenProvider struct {
+	token *adal.ServicePrincipalToken
+}
+
+// GetToken implements auth.TokenProvider.
+func (p *aadTokenProvider) GetToken(string) (*auth.Token, error) {
+	if err := p.token.EnsureFresh(); err != nil {
+		return nil, err
+	}
+	token := p.token.Token()
+	return auth.NewToken(auth.CBSTokenTypeJWT, token.AccessToken, string(token.ExpiresOn)), nil
+}
+
+// createCredentials returns the credentials of the given namespace. The connection string is used for
+// CONNECTION_STRING authentication, the namespace name for AAD authentication.
+func createCredentials(settings *ttnpb.ApplicationPubSub_AzureProvider, connectionString, namespace string) (*credentials, error) {
+	switch settings.AuthenticationMethod {
+	case ttnpb.ApplicationPubSub_AzureProvider_CONNECTION_STRING:
+		parsed, err := amqp_conn.ParsedConnectionFromStr(connectionString)
+		if err != nil {
+			return nil, errConnectionString.WithCause(err)
+		}
+		tokenProvider, err := sas.NewTokenProvider(sas.TokenProviderWithKey(parsed.KeyName, parsed.Key))
+		if err != nil {
+			return nil, errTokenProvider.WithCause(err)
+		}
+		return &credentials{
+			namespace:     parsed.Namespace,
+			tokenProvider: tokenProvider,
+		}, nil
+	case ttnpb.ApplicationPubSub_AzureProvider_AAD:
+		if !namespaceRegex.MatchString(namespace) {
+			return nil, errNamespace.WithAttributes("namespace", namespace)
+		}
+		oauthConfig, err := adal.NewOAuthConfig(activeDirectoryEndpoint, settings.TenantID)
+		if err != nil {
+			return nil, errTokenProvider.WithCause(err)
+		}
+		token, err := adal.NewServicePrincipalToken(*oauthConfig, settings.ClientID, settings.ClientSecret, aadResourceURI)
+		if err != nil {
+			return nil, errTokenProvider.WithCause(err)
+		}
+		return &credentials{
+			namespace:     namespace,
+			tokenProvider: &aadTokenProvider{token: token},
+		}, nil
+	default:
+		return nil, errAuthenticationMethod.WithAttributes("method", settings.AuthenticationMethod)
+	}
+}
+
+// combineEntityNames returns the name of the Event Hub or Service Bus queue of the given base topic and message topic.
+func combineEntityNames(n1, n2 string) string {
+	n1 = strings.Trim(n1, ".")
+	n2 = strings.Trim(n2, ".")
+	if n1 == "" {
+		return n2
+	}
+	if n2 == "" {
+		return n1
+	}
+	return fmt.Sprintf("%s.%s", n1, n2)
+}
+
+// OpenConnection implements provider.Provider using the Event Hubs and Service Bus clients.
+// The upstream messages are published to the Event Hubs partitions keyed by the DevEUI of the end device.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Azure)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	conn := &provider.Connection{
+		ProviderConnection:  &connection{},
+		EndDeviceMetadata:   true,
+		ContentTypeMetadata: true,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	var eventHubs *credentials
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if eventHubs == nil {
+			if eventHubs, err = createCredentials(settings.Azure, settings.Azure.EventHubsConnectionString, settings.Azure.EventHubsNamespace); err != nil {
+				return nil, err
+			}
+		}
+		if *t.topic, err = openTopic(
+			eventHubs,
+			combineEntityNames(target.GetBaseTopic(), t.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	var serviceBus *servicebus.Namespace
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if serviceBus == nil {
+			if serviceBus, err = openNamespace(settings.Azure); err != nil {
+				return nil, err
+			}
+		}
+		if *s.subscription, err = openSubscription(
+			ctx,
+			serviceBus,
+			combineEntityNames(target.GetBaseTopic(), s.message.GetTopic()),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Azure{}, impl{})
+}
@@ -0,0 +1,180 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azure
+
+import (
+	"context"
+	"time"
+
+	servicebus "github.com/Azure/azure-service-bus-go"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// receiveBackoff is the time to wait before receiving from a queue again after the receiver failed.
+var receiveBackoff = (1 << 3) * time.Second
+
+// openNamespace returns the Service Bus namespace of the given settings.
+func openNamespace(settings *ttnpb.ApplicationPubSub_AzureProvider) (*servicebus.Namespace, error) {
+	creds, err := createCredentials(settings, settings.ServiceBusConnectionString, settings.ServiceBusNamespace)
+	if err != nil {
+		return nil, err
+	}
+	if settings.AuthenticationMethod == ttnpb.ApplicationPubSub_AzureProvider_CONNECTION_STRING {
+		return servicebus.NewNamespace(servicebus.NamespaceWithConnectionString(settings.ServiceBusConnectionString))
+	}
+	return servicebus.NewNamespace(func(ns *servicebus.Namespace) error {
+		ns.Name = creds.namespace
+		ns.TokenProvider = creds.tokenProvider
+		return nil
+	})
+}
+
+type subscription struct {
+	queue    *servicebus.Queue
+	messages chan *servicebus.Message
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// openSubscription returns a *pubsub.Subscription that receives from the given Service Bus queue.
+// The messages are removed from the queue when they are received.
+func openSubscription(ctx context.Context, ns *servicebus.Namespace, name string) (*pubsub.Subscription, error) {
+	queue, err := ns.NewQueue(name, servicebus.QueueWithReceiveAndDelete())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &subscription{
+		queue:    queue,
+		messages: make(chan *servicebus.Message),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go s.receive(ctx)
+	return pubsub.NewSubscription(s, nil, nil), nil
+}
+
+// receive receives from the queue until the context is done. The receiver is restarted when it fails.
+func (s *subscription) receive(ctx context.Context) {
+	defer close(s.done)
+	logger := log.FromContext(ctx)
+	handler := servicebus.HandlerFunc(func(ctx context.Context, msg *servicebus.Message) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s.messages <- msg:
+			return nil
+		}
+	})
+	for ctx.Err() == nil {
+		if err := s.queue.Receive(ctx, handler); err != nil && ctx.Err() == nil {
+			logger.WithError(err).Warn("Failed to receive from Service Bus queue")
+			select {
+			case <-ctx.Done():
+			case <-time.After(receiveBackoff):
+			}
+		}
+	}
+}
+
+func decodeMessage(msg *servicebus.Message) *driver.Message {
+	asFunc := func(i interface{}) bool {
+		p, ok := i.(**servicebus.Message)
+		if !ok {
+			return false
+		}
+		*p = msg
+		return true
+	}
+	var metadata map[string]string
+	for k, v := range msg.UserProperties {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string, len(msg.UserProperties))
+		}
+		metadata[k] = s
+	}
+	return &driver.Message{
+		Body:     msg.Data,
+		Metadata: metadata,
+		AckID:    msg.ID,
+		AsFunc:   asFunc,
+	}
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	var messages []*driver.Message
+outer:
+	for i := 0; i < maxMessages; i++ {
+		select {
+		case <-ctx.Done():
+			break outer
+		case msg := <-s.messages:
+			messages = append(messages, decodeMessage(msg))
+		// We cannot delay the messages for too long for the sake of
+		// having bigger batches. Avoid busy waiting, but don't wait
+		// for too long.
+		case <-time.After(1 * time.Millisecond):
+			break outer
+		}
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+// The messages are removed from the queue when they are received.
+func (*subscription) SendAcks(context.Context, []driver.AckID) error { return nil }
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	p, ok := i.(**servicebus.Queue)
+	if !ok {
+		return false
+	}
+	*p = s.queue
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscription) Close() error {
+	s.cancel()
+	<-s.done
+	return s.queue.Close(context.Background())
+}
@@ -21,6 +21,17 @@ import (
 	"gocloud.dev/pubsub"
 )
 
+const (
+	// MetadataDeviceID is the metadata key of the device ID of the end device of an upstream message.
+	MetadataDeviceID = "device_id"
+	// MetadataDevEUI is the metadata key of the DevEUI of the end device of an upstream message.
+	MetadataDevEUI = "dev_eui"
+	// MetadataCorrelationIDs is the metadata key of the comma separated correlation IDs of a message.
+	MetadataCorrelationIDs = "correlation_ids"
+	// MetadataContentType is the metadata key of the content type of the body of an upstream message.
+	MetadataContentType = "content_type"
+)
+
 // DownlinkSubscriptions contains the subscriptions for the push and replace queue operations.
 type DownlinkSubscriptions struct {
 	Push    *pubsub.Subscription
@@ -90,6 +101,18 @@ type Connection struct {
 	Topics             UplinkTopics
 	Subscriptions      DownlinkSubscriptions
 	ProviderConnection ProviderConnection
+	// EndDeviceMetadata indicates that the upstream messages carry the end device identifiers in their metadata.
+	// See MetadataDeviceID and MetadataDevEUI.
+	EndDeviceMetadata bool
+	// CorrelationIDsMetadata indicates that the messages carry the correlation IDs in their metadata.
+	// See MetadataCorrelationIDs.
+	CorrelationIDsMetadata bool
+	// ContentTypeMetadata indicates that the upstream messages carry the content type of their body in their metadata.
+	// See MetadataContentType.
+	ContentTypeMetadata bool
+	// Errors receives the errors that occur asynchronously on the connection, such as the server closing the
+	// connection. An error on this channel fails the integration. Errors may be nil.
+	Errors <-chan error
 }
 
 // Shutdown shuts down the topics, subscriptions and the connections if required.
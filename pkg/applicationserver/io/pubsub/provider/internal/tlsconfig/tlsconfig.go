@@ -1,4 +1,4 @@
-// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package mqtt
+// Package tlsconfig creates the TLS configuration of pub/sub providers.
+package tlsconfig
 
 import (
 	"crypto/tls"
@@ -23,7 +24,10 @@ import (
 
 var errInvalidCAPEMData = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
 
-func createTLSConfig(caPEM []byte, certPEM []byte, keyPEM []byte) (*tls.Config, error) {
+// New returns a TLS configuration from the given PEM encoded CA certificate, client certificate and client key.
+// If the CA certificate is empty, the system-wide CA pool is used.
+// If the client certificate and key are empty, no client certificate is used.
+func New(caPEM []byte, certPEM []byte, keyPEM []byte) (*tls.Config, error) {
 	// Change the CA certificate pool only if a CA has been provided.
 	// This allows the system-wide CA pool to be used.
 	var certPool *x509.CertPool
@@ -33,12 +37,18 @@ func createTLSConfig(caPEM []byte, certPEM []byte, keyPEM []byte) (*tls.Config,
 			return nil, errInvalidCAPEMData
 		}
 	}
+	config := &tls.Config{
+		RootCAs: certPool,
+	}
+	// Servers that authenticate clients otherwise, for example with SASL, credentials in the URL or SigV4,
+	// typically do not require a client certificate.
+	if len(certPEM) == 0 && len(keyPEM) == 0 {
+		return config, nil
+	}
 	cert, err := tls.X509KeyPair(certPEM, keyPEM)
 	if err != nil {
 		return nil, err
 	}
-	return &tls.Config{
-		RootCAs:      certPool,
-		Certificates: []tls.Certificate{cert},
-	}, nil
+	config.Certificates = []tls.Certificate{cert}
+	return config, nil
 }
@@ -0,0 +1,60 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tlsconfig_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestNew(t *testing.T) {
+	a := assertions.New(t)
+
+	ca, err := ioutil.ReadFile("../../mqtt/testdata/rootCA.pem")
+	a.So(err, should.BeNil)
+	clientCert, err := ioutil.ReadFile("../../mqtt/testdata/clientcert.pem")
+	a.So(err, should.BeNil)
+	clientKey, err := ioutil.ReadFile("../../mqtt/testdata/clientkey.pem")
+	a.So(err, should.BeNil)
+
+	config, err := tlsconfig.New(nil, nil, nil)
+	if a.So(err, should.BeNil) {
+		a.So(config.RootCAs, should.BeNil)
+		a.So(config.Certificates, should.BeEmpty)
+	}
+
+	config, err = tlsconfig.New(ca, nil, nil)
+	if a.So(err, should.BeNil) {
+		a.So(config.RootCAs, should.NotBeNil)
+		a.So(config.Certificates, should.BeEmpty)
+	}
+
+	config, err = tlsconfig.New(ca, clientCert, clientKey)
+	if a.So(err, should.BeNil) {
+		a.So(config.RootCAs, should.NotBeNil)
+		a.So(config.Certificates, should.HaveLength, 1)
+	}
+
+	_, err = tlsconfig.New([]byte("not a certificate"), nil, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = tlsconfig.New(ca, clientCert, nil)
+	a.So(err, should.NotBeNil)
+}
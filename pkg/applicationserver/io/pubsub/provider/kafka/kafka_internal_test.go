@@ -0,0 +1,163 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCombineTopics(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		topic1   string
+		topic2   string
+		expected string
+	}{
+		{
+			name:     "EmptyTopic1",
+			topic1:   "",
+			topic2:   "bar.bar2",
+			expected: "bar.bar2",
+		},
+		{
+			name:     "EmptyTopic2",
+			topic1:   "foo.foo2",
+			topic2:   "",
+			expected: "foo.foo2",
+		},
+		{
+			name:     "BothProvided",
+			topic1:   "foo.foo2",
+			topic2:   "bar.bar2",
+			expected: "foo.foo2.bar.bar2",
+		},
+		{
+			name:     "Trailing",
+			topic1:   "foo.",
+			topic2:   ".bar",
+			expected: "foo.bar",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(combineTopics(tc.topic1, tc.topic2), should.Equal, tc.expected)
+		})
+	}
+}
+
+func TestCreateConfig(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		settings       *ttnpb.ApplicationPubSub_KafkaProvider
+		assertion      func(*assertions.Assertion, *sarama.Config)
+		errorAssertion func(error) bool
+	}{
+		{
+			name: "NoAuthentication",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9092"},
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.TLS.Enable, should.BeFalse)
+				a.So(config.Net.SASL.Enable, should.BeFalse)
+			},
+		},
+		{
+			name: "TLSWithoutClientCertificate",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9093"},
+				UseTLS:  true,
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.TLS.Enable, should.BeTrue)
+				a.So(config.Net.TLS.Config, should.NotBeNil)
+				a.So(config.Net.TLS.Config.Certificates, should.BeEmpty)
+			},
+		},
+		{
+			name: "InvalidCA",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers: []string{"localhost:9093"},
+				UseTLS:  true,
+				TLSCA:   []byte("invalid"),
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			name: "PLAIN",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:       []string{"localhost:9092"},
+				SASLMechanism: ttnpb.ApplicationPubSub_KafkaProvider_PLAIN,
+				SASLUsername:  "user",
+				SASLPassword:  "secret",
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLTypePlaintext)
+				a.So(config.Net.SASL.User, should.Equal, "user")
+				a.So(config.Net.SASL.Password, should.Equal, "secret")
+				a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.BeNil)
+			},
+		},
+		{
+			name: "SCRAM-SHA-512",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:       []string{"localhost:9092"},
+				SASLMechanism: ttnpb.ApplicationPubSub_KafkaProvider_SCRAM_SHA_512,
+				SASLUsername:  "user",
+				SASLPassword:  "secret",
+			},
+			assertion: func(a *assertions.Assertion, config *sarama.Config) {
+				a.So(config.Net.SASL.Enable, should.BeTrue)
+				a.So(config.Net.SASL.Mechanism, should.Equal, sarama.SASLTypeSCRAMSHA512)
+				if a.So(config.Net.SASL.SCRAMClientGeneratorFunc, should.NotBeNil) {
+					client := config.Net.SASL.SCRAMClientGeneratorFunc()
+					a.So(client.Begin("user", "secret", ""), should.BeNil)
+					first, err := client.Step("")
+					a.So(err, should.BeNil)
+					a.So(first, should.StartWith, "n,,n=user,r=")
+					a.So(client.Done(), should.BeFalse)
+				}
+			},
+		},
+		{
+			name: "UnknownMechanism",
+			settings: &ttnpb.ApplicationPubSub_KafkaProvider{
+				Brokers:       []string{"localhost:9092"},
+				SASLMechanism: ttnpb.ApplicationPubSub_KafkaProvider_SASLMechanism(42),
+			},
+			errorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			config, err := createConfig(tc.settings)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			tc.assertion(a, config)
+		})
+	}
+}
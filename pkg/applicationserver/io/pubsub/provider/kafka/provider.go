@@ -0,0 +1,190 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka implements the Kafka provider using the kafkapubsub driver.
+package kafka
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Shopify/sarama"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/kafkapubsub"
+)
+
+var errSASLMechanism = errors.DefineInvalidArgument("sasl_mechanism", "SASL mechanism `{mechanism}` is not supported")
+
+type impl struct {
+}
+
+// identifiers is implemented by targets that are identified by application and pub/sub ID, such as
+// ttnpb.ApplicationPubSub. It is used to derive the consumer groups of the downlink subscriptions.
+type identifiers interface {
+	GetApplicationID() string
+	GetPubSubID() string
+}
+
+type connection struct {
+}
+
+// Shutdown implements provider.Shutdowner.
+// The Kafka clients are owned by the topics and subscriptions, which are shut down by the provider.Connection.
+func (c *connection) Shutdown(_ context.Context) error {
+	return nil
+}
+
+func createConfig(settings *ttnpb.ApplicationPubSub_KafkaProvider) (*sarama.Config, error) {
+	config := kafkapubsub.MinimalConfig()
+	if settings.UseTLS {
+		tlsConfig, err := tlsconfig.New(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey)
+		if err != nil {
+			return nil, err
+		}
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsConfig
+	}
+	switch settings.SASLMechanism {
+	case ttnpb.ApplicationPubSub_KafkaProvider_NONE:
+		return config, nil
+	case ttnpb.ApplicationPubSub_KafkaProvider_PLAIN:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case ttnpb.ApplicationPubSub_KafkaProvider_SCRAM_SHA_256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scramSHA256)
+	case ttnpb.ApplicationPubSub_KafkaProvider_SCRAM_SHA_512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = newSCRAMClientGenerator(scramSHA512)
+	default:
+		return nil, errSASLMechanism.WithAttributes("mechanism", settings.SASLMechanism)
+	}
+	config.Net.SASL.Enable = true
+	config.Net.SASL.User = settings.SASLUsername
+	config.Net.SASL.Password = settings.SASLPassword
+	return config, nil
+}
+
+func consumerGroup(target provider.Target) string {
+	if ids, ok := target.(identifiers); ok {
+		return fmt.Sprintf("ttn-lw-as.%s.%s", ids.GetApplicationID(), ids.GetPubSubID())
+	}
+	return combineTopics("ttn-lw-as", target.GetBaseTopic())
+}
+
+// OpenConnection implements provider.Provider using the kafkapubsub package.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Kafka)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	config, err := createConfig(settings.Kafka)
+	if err != nil {
+		return nil, err
+	}
+	conn := &provider.Connection{
+		ProviderConnection:  &connection{},
+		ContentTypeMetadata: true,
+	}
+	defer func() {
+		if err != nil {
+			conn.Shutdown(ctx)
+		}
+	}()
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &conn.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &conn.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &conn.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &conn.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		if *t.topic, err = kafkapubsub.OpenTopic(
+			settings.Kafka.Brokers,
+			config,
+			combineTopics(target.GetBaseTopic(), t.message.GetTopic()),
+			&kafkapubsub.TopicOptions{},
+		); err != nil {
+			return nil, err
+		}
+	}
+	group := consumerGroup(target)
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &conn.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &conn.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		topic := combineTopics(target.GetBaseTopic(), s.message.GetTopic())
+		if *s.subscription, err = kafkapubsub.OpenSubscription(
+			settings.Kafka.Brokers,
+			config,
+			combineTopics(group, topic),
+			[]string{topic},
+			&kafkapubsub.SubscriptionOptions{},
+		); err != nil {
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Kafka{}, impl{})
+}
@@ -0,0 +1,361 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/eclipse/paho.golang/paho"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// userPropertyCorrelationID is the MQTT 5 user property that carries a correlation ID.
+// Messages carry one user property per correlation ID.
+const userPropertyCorrelationID = "correlation_id"
+
+var (
+	errPublish   = errors.DefineUnavailable("publish", "publish message with reason code `{reason_code}`", "reason_string")
+	errSubscribe = errors.DefineUnavailable("subscribe", "subscribe to `{topic}` with reason code `{reason_code}`")
+)
+
+type topicV5 struct {
+	client        *paho.Client
+	topic         string
+	timeout       time.Duration
+	qos           byte
+	messageExpiry *uint32
+}
+
+// OpenTopicV5 returns a *pubsub.Topic that publishes to the given topic name with the given MQTT 5 client.
+// If messageExpiry is not nil, the published messages expire after the given duration.
+func OpenTopicV5(client *paho.Client, topicName string, timeout time.Duration, qos byte, messageExpiry *time.Duration) (*pubsub.Topic, error) {
+	dt, err := openDriverTopicV5(client, topicName, timeout, qos, messageExpiry)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewTopic(dt, nil), nil
+}
+
+func openDriverTopicV5(client *paho.Client, topicName string, timeout time.Duration, qos byte, messageExpiry *time.Duration) (driver.Topic, error) {
+	if client == nil {
+		return nil, errNilClient
+	}
+	dt := &topicV5{
+		client:  client,
+		topic:   topicName,
+		timeout: timeout,
+		qos:     qos,
+	}
+	if messageExpiry != nil {
+		dt.messageExpiry = intervalSeconds(*messageExpiry)
+	}
+	return dt, nil
+}
+
+// SendBatch implements driver.Topic.
+func (t *topicV5) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	if t == nil || t.client == nil {
+		return errNilClient
+	}
+	for _, msg := range msgs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool { return false }
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		err := func() error {
+			ctx, cancel := context.WithTimeout(ctx, t.timeout)
+			defer cancel()
+			res, err := t.client.Publish(ctx, &paho.Publish{
+				Topic:   t.topic,
+				QoS:     t.qos,
+				Payload: msg.Body,
+				Properties: &paho.PublishProperties{
+					ContentType:   msg.Metadata[provider.MetadataContentType],
+					MessageExpiry: t.messageExpiry,
+					User:          toUserProperties(msg.Metadata),
+				},
+			})
+			if res != nil && res.ReasonCode >= 0x80 {
+				err := errPublish.WithAttributes("reason_code", int(res.ReasonCode))
+				if res.Properties != nil && res.Properties.ReasonString != "" {
+					err = err.WithAttributes("reason_string", res.Properties.ReasonString)
+				}
+				return err
+			}
+			return err
+		}()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toUserProperties returns the MQTT 5 user properties of the given message metadata.
+// The correlation IDs are carried as one user property per correlation ID. The content type is carried in the content
+// type property instead of the user properties.
+func toUserProperties(metadata map[string]string) paho.UserProperties {
+	if len(metadata) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	props := make(paho.UserProperties, 0, len(metadata))
+	for _, k := range keys {
+		if k == provider.MetadataContentType {
+			continue
+		}
+		if k == provider.MetadataCorrelationIDs {
+			for _, cid := range strings.Split(metadata[k], ",") {
+				if cid == "" {
+					continue
+				}
+				props = append(props, paho.UserProperty{Key: userPropertyCorrelationID, Value: cid})
+			}
+			continue
+		}
+		props = append(props, paho.UserProperty{Key: k, Value: metadata[k]})
+	}
+	return props
+}
+
+// fromUserProperties returns the message metadata of the given MQTT 5 user properties.
+func fromUserProperties(props paho.UserProperties) map[string]string {
+	if len(props) == 0 {
+		return nil
+	}
+	metadata := make(map[string]string, len(props))
+	var cids []string
+	for _, p := range props {
+		if p.Key == userPropertyCorrelationID {
+			cids = append(cids, p.Value)
+			continue
+		}
+		metadata[p.Key] = p.Value
+	}
+	if len(cids) > 0 {
+		metadata[provider.MetadataCorrelationIDs] = strings.Join(cids, ",")
+	}
+	return metadata
+}
+
+func decodeMessageV5(message *paho.Publish) *driver.Message {
+	asFunc := func(i interface{}) bool {
+		p, ok := i.(**paho.Publish)
+		if !ok {
+			return false
+		}
+		*p = message
+		return true
+	}
+	dm := &driver.Message{
+		AckID:  -1,
+		AsFunc: asFunc,
+		Body:   message.Payload,
+	}
+	if message.Properties != nil {
+		dm.Metadata = fromUserProperties(message.Properties.User)
+	}
+	return dm
+}
+
+// IsRetryable implements driver.Topic.
+func (*topicV5) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topicV5) As(i interface{}) bool {
+	c, ok := i.(**paho.Client)
+	if !ok {
+		return false
+	}
+	*c = t.client
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topicV5) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topicV5) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCodeV5(err)
+}
+
+// Close implements driver.Topic.
+func (*topicV5) Close() error { return nil }
+
+type subscriptionV5 struct {
+	client  *paho.Client
+	router  *paho.StandardRouter
+	topic   string
+	subCh   chan *paho.Publish
+	timeout time.Duration
+}
+
+// OpenSubscriptionV5 returns a *pubsub.Subscription that subscribes to the given topic name with the given MQTT 5
+// client. The router must be the router of the client.
+func OpenSubscriptionV5(ctx context.Context, client *paho.Client, router *paho.StandardRouter, topicName string, timeout time.Duration, qos byte) (*pubsub.Subscription, error) {
+	ds, err := openDriverSubscriptionV5(ctx, client, router, topicName, timeout, qos)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(ds, nil, nil), nil
+}
+
+func openDriverSubscriptionV5(ctx context.Context, client *paho.Client, router *paho.StandardRouter, topicName string, timeout time.Duration, qos byte) (driver.Subscription, error) {
+	if client == nil {
+		return nil, errNilClient
+	}
+	subCh := make(chan *paho.Publish, subscriptionQueueSize)
+	router.RegisterHandler(topicName, func(msg *paho.Publish) {
+		subCh <- msg
+	})
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	res, err := client.Subscribe(ctx, &paho.Subscribe{
+		Subscriptions: map[string]paho.SubscribeOptions{
+			topicName: {QoS: qos},
+		},
+	})
+	if res != nil {
+		for _, code := range res.Reasons {
+			if code >= 0x80 {
+				router.UnregisterHandler(topicName)
+				return nil, errSubscribe.WithAttributes(
+					"topic", topicName,
+					"reason_code", int(code),
+				)
+			}
+		}
+	}
+	if err != nil {
+		router.UnregisterHandler(topicName)
+		return nil, err
+	}
+	ds := &subscriptionV5{
+		client:  client,
+		router:  router,
+		topic:   topicName,
+		subCh:   subCh,
+		timeout: timeout,
+	}
+	return ds, nil
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscriptionV5) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	if s == nil || s.client == nil {
+		return nil, errNilClient
+	}
+	var messages []*driver.Message
+outer:
+	for i := 0; i < maxMessages; i++ {
+		select {
+		case <-ctx.Done():
+			break outer
+		case msg, ok := <-s.subCh:
+			if !ok {
+				break outer
+			}
+			messages = append(messages, decodeMessageV5(msg))
+		// We cannot delay the messages for too long for the sake of
+		// having bigger batches. Avoid busy waiting, but don't wait
+		// for too long.
+		case <-time.After(1 * time.Millisecond):
+			break outer
+		}
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+func (*subscriptionV5) SendAcks(context.Context, []driver.AckID) error { return nil }
+
+// CanNack implements driver.Subscription.
+func (*subscriptionV5) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscriptionV5) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscriptionV5) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscriptionV5) As(i interface{}) bool {
+	c, ok := i.(**paho.Client)
+	if !ok {
+		return false
+	}
+	*c = s.client
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscriptionV5) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscriptionV5) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCodeV5(err)
+}
+
+// Close implements driver.Subscription.
+func (s *subscriptionV5) Close() error {
+	if s == nil || s.client == nil {
+		return nil
+	}
+	s.router.UnregisterHandler(s.topic)
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	_, err := s.client.Unsubscribe(ctx, &paho.Unsubscribe{
+		Topics: []string{s.topic},
+	})
+	return err
+}
+
+func toErrorCodeV5(err error) gcerrors.ErrorCode {
+	if d, ok := err.(errors.Definition); ok && d.FullName() == errNilClient.FullName() {
+		return gcerrors.NotFound
+	}
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	default:
+		return gcerrors.Unknown
+	}
+}
+
+// intervalSeconds returns the given duration in whole seconds, as used by MQTT 5 intervals.
+func intervalSeconds(d time.Duration) *uint32 {
+	s := uint32(d / time.Second)
+	return &s
+}
@@ -0,0 +1,120 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"testing"
+
+	"github.com/eclipse/paho.golang/paho"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUserProperties(t *testing.T) {
+	a := assertions.New(t)
+
+	a.So(toUserProperties(nil), should.BeNil)
+	a.So(fromUserProperties(nil), should.BeNil)
+
+	metadata := map[string]string{
+		provider.MetadataDeviceID:       "dev1",
+		provider.MetadataDevEUI:         "0102030405060708",
+		provider.MetadataCorrelationIDs: "as:up:01,gs:uplink:02",
+	}
+	props := toUserProperties(metadata)
+	a.So(props, should.Resemble, paho.UserProperties{
+		{Key: userPropertyCorrelationID, Value: "as:up:01"},
+		{Key: userPropertyCorrelationID, Value: "gs:uplink:02"},
+		{Key: provider.MetadataDevEUI, Value: "0102030405060708"},
+		{Key: provider.MetadataDeviceID, Value: "dev1"},
+	})
+	a.So(fromUserProperties(props), should.Resemble, metadata)
+
+	a.So(toUserProperties(map[string]string{
+		provider.MetadataDeviceID:    "dev1",
+		provider.MetadataContentType: "application/json",
+	}), should.Resemble, paho.UserProperties{
+		{Key: provider.MetadataDeviceID, Value: "dev1"},
+	})
+}
+
+func TestServerAddress(t *testing.T) {
+	for _, tc := range []struct {
+		serverURL string
+		address   string
+		useTLS    bool
+		assertErr func(error) bool
+	}{
+		{
+			serverURL: "tcp://broker.example.com",
+			address:   "broker.example.com:1883",
+		},
+		{
+			serverURL: "mqtt://broker.example.com:1884",
+			address:   "broker.example.com:1884",
+		},
+		{
+			serverURL: "mqtts://broker.example.com",
+			address:   "broker.example.com:8883",
+			useTLS:    true,
+		},
+		{
+			serverURL: "ssl://[::1]:8884",
+			address:   "[::1]:8884",
+			useTLS:    true,
+		},
+		{
+			serverURL: "ws://broker.example.com",
+			assertErr: errors.IsInvalidArgument,
+		},
+		{
+			serverURL: "tcp://",
+			assertErr: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.serverURL, func(t *testing.T) {
+			a := assertions.New(t)
+			address, useTLS, err := serverAddress(tc.serverURL)
+			if tc.assertErr != nil {
+				a.So(tc.assertErr(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(address, should.Equal, tc.address)
+			a.So(useTLS, should.Equal, tc.useTLS)
+		})
+	}
+}
+
+func TestConnectError(t *testing.T) {
+	a := assertions.New(t)
+	a.So(errors.IsFailedPrecondition(connectError(&paho.Connack{ReasonCode: 0x84})), should.BeTrue)
+	a.So(errors.IsInvalidArgument(connectError(&paho.Connack{ReasonCode: 0x85})), should.BeTrue)
+	a.So(errors.IsUnauthenticated(connectError(&paho.Connack{ReasonCode: 0x86})), should.BeTrue)
+	a.So(errors.IsPermissionDenied(connectError(&paho.Connack{ReasonCode: 0x87})), should.BeTrue)
+
+	err := connectError(&paho.Connack{
+		ReasonCode: 0x89,
+		Properties: &paho.ConnackProperties{
+			ReasonString: "server busy",
+		},
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	attributes := errors.Attributes(err)
+	a.So(attributes["reason_code"], should.Equal, 0x89)
+	a.So(attributes["reason_string"], should.Equal, "server busy")
+}
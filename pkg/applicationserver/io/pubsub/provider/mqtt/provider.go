@@ -22,6 +22,7 @@ import (
 	mqtt_topic "github.com/TheThingsIndustries/mystique/pkg/topic"
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"gocloud.dev/pubsub"
 )
@@ -47,13 +48,16 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 	if !ok {
 		panic("wrong provider type provided to OpenConnection")
 	}
+	if settings.MQTT.ProtocolVersion == ttnpb.ApplicationPubSub_MQTTProvider_V5 {
+		return openConnectionV5(ctx, target, settings.MQTT)
+	}
 	clientOpts := mqtt.NewClientOptions()
 	clientOpts.AddBroker(settings.MQTT.ServerURL)
 	clientOpts.SetClientID(settings.MQTT.ClientID)
 	clientOpts.SetUsername(settings.MQTT.Username)
 	clientOpts.SetPassword(settings.MQTT.Password)
 	if settings.MQTT.UseTLS {
-		config, err := createTLSConfig(settings.MQTT.TLSCA, settings.MQTT.TLSClientCert, settings.MQTT.TLSClientKey)
+		config, err := tlsconfig.New(settings.MQTT.TLSCA, settings.MQTT.TLSClientCert, settings.MQTT.TLSClientKey)
 		if err != nil {
 			return nil, err
 		}
@@ -68,6 +72,27 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 			Client: client,
 		},
 	}
+	if err := openTopicsAndSubscriptions(pc, target,
+		func(topicName string) (*pubsub.Topic, error) {
+			return OpenTopic(client, topicName, timeout, byte(settings.MQTT.PublishQoS))
+		},
+		func(topicName string) (*pubsub.Subscription, error) {
+			return OpenSubscription(client, topicName, timeout, byte(settings.MQTT.SubscribeQoS))
+		},
+	); err != nil {
+		client.Disconnect(uint(timeout / time.Millisecond))
+		return nil, err
+	}
+	return pc, nil
+}
+
+// openTopicsAndSubscriptions opens the topics and subscriptions of the given target on the connection.
+func openTopicsAndSubscriptions(
+	pc *provider.Connection,
+	target provider.Target,
+	openTopic func(topicName string) (*pubsub.Topic, error),
+	openSubscription func(topicName string) (*pubsub.Subscription, error),
+) (err error) {
 	for _, t := range []struct {
 		topic   **pubsub.Topic
 		message *ttnpb.ApplicationPubSub_Message
@@ -108,14 +133,10 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 		if t.message == nil {
 			continue
 		}
-		if *t.topic, err = OpenTopic(
-			client,
+		if *t.topic, err = openTopic(
 			mqtt_topic.Join(append(mqtt_topic.Split(target.GetBaseTopic()), mqtt_topic.Split(t.message.GetTopic())...)),
-			timeout,
-			byte(settings.MQTT.PublishQoS),
 		); err != nil {
-			client.Disconnect(uint(timeout / time.Millisecond))
-			return nil, err
+			return err
 		}
 	}
 	for _, s := range []struct {
@@ -134,17 +155,13 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 		if s.message == nil {
 			continue
 		}
-		if *s.subscription, err = OpenSubscription(
-			client,
+		if *s.subscription, err = openSubscription(
 			mqtt_topic.Join(append(mqtt_topic.Split(target.GetBaseTopic()), mqtt_topic.Split(s.message.GetTopic())...)),
-			timeout,
-			byte(settings.MQTT.SubscribeQoS),
 		); err != nil {
-			client.Disconnect(uint(timeout / time.Millisecond))
-			return nil, err
+			return err
 		}
 	}
-	return pc, nil
+	return nil
 }
 
 func init() {
@@ -24,6 +24,7 @@ import (
 	paho_mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
@@ -45,9 +46,9 @@ func TestOpenConnection(t *testing.T) {
 	serverKey, err := ioutil.ReadFile("testdata/serverkey.pem")
 	a.So(err, should.BeNil)
 
-	clientTLSConfig, err := createTLSConfig(ca, clientCert, clientKey)
+	clientTLSConfig, err := tlsconfig.New(ca, clientCert, clientKey)
 	a.So(err, should.BeNil)
-	serverTLSConfig, err := createTLSConfig(ca, serverCert, serverKey)
+	serverTLSConfig, err := tlsconfig.New(ca, serverCert, serverKey)
 	a.So(err, should.BeNil)
 
 	lis, tlsLis, err := startMQTTServer(ctx, serverTLSConfig)
@@ -0,0 +1,222 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/eclipse/paho.golang/paho"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider/internal/tlsconfig"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+var keepAlive = 30 * time.Second
+
+var (
+	errInvalidServerURL           = errors.DefineInvalidArgument("server_url", "invalid server URL")
+	errUnsupportedScheme          = errors.DefineInvalidArgument("scheme", "unsupported scheme `{scheme}`")
+	errDial                       = errors.DefineUnavailable("dial", "dial server `{address}`")
+	errConnectionRefused          = errors.DefineUnavailable("connection_refused", "connection refused with reason code `{reason_code}`", "reason_string")
+	errUnsupportedProtocolVersion = errors.DefineFailedPrecondition("unsupported_protocol_version", "server does not support MQTT 5", "reason_code", "reason_string")
+	errClientIDNotValid           = errors.DefineInvalidArgument("client_id_not_valid", "client ID not valid", "reason_code", "reason_string")
+	errBadCredentials             = errors.DefineUnauthenticated("bad_credentials", "bad username or password", "reason_code", "reason_string")
+	errNotAuthorized              = errors.DefinePermissionDenied("not_authorized", "not authorized", "reason_code", "reason_string")
+	errServerDisconnect           = errors.DefineUnavailable("server_disconnect", "server closed the connection with reason code `{reason_code}`", "reason_string")
+	errClient                     = errors.DefineUnavailable("client", "client failed")
+)
+
+// MQTT 5 CONNACK reason codes that do not indicate a transient failure.
+const (
+	reasonCodeUnsupportedProtocolVersion = 0x84
+	reasonCodeClientIDNotValid           = 0x85
+	reasonCodeBadUsernameOrPassword      = 0x86
+	reasonCodeNotAuthorized              = 0x87
+	reasonCodeBanned                     = 0x8a
+)
+
+type connectionV5 struct {
+	client *paho.Client
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connectionV5) Shutdown(_ context.Context) error {
+	return c.client.Disconnect(&paho.Disconnect{})
+}
+
+// serverAddress returns the address of the given MQTT server URL, and whether the connection uses TLS.
+// The tcp and mqtt schemes use plain TCP, and the ssl, tls, tcps and mqtts schemes use TLS.
+func serverAddress(serverURL string) (string, bool, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return "", false, errInvalidServerURL.WithCause(err)
+	}
+	var (
+		useTLS      bool
+		defaultPort string
+	)
+	switch u.Scheme {
+	case "tcp", "mqtt":
+		defaultPort = "1883"
+	case "ssl", "tls", "tcps", "mqtts":
+		useTLS, defaultPort = true, "8883"
+	default:
+		return "", false, errUnsupportedScheme.WithAttributes("scheme", u.Scheme)
+	}
+	if u.Hostname() == "" {
+		return "", false, errInvalidServerURL
+	}
+	if u.Port() == "" {
+		return net.JoinHostPort(u.Hostname(), defaultPort), useTLS, nil
+	}
+	return u.Host, useTLS, nil
+}
+
+func dialV5(ctx context.Context, settings *ttnpb.ApplicationPubSub_MQTTProvider) (net.Conn, error) {
+	address, useTLS, err := serverAddress(settings.ServerURL)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &net.Dialer{Timeout: timeout}
+	if !useTLS {
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return nil, errDial.WithAttributes("address", address).WithCause(err)
+		}
+		return conn, nil
+	}
+	config := &tls.Config{}
+	if settings.UseTLS {
+		if config, err = tlsconfig.New(settings.TLSCA, settings.TLSClientCert, settings.TLSClientKey); err != nil {
+			return nil, err
+		}
+	}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, config)
+	if err != nil {
+		return nil, errDial.WithAttributes("address", address).WithCause(err)
+	}
+	return conn, nil
+}
+
+// connectError returns the error of the given unsuccessful CONNACK.
+func connectError(ack *paho.Connack) error {
+	var def errors.Definition
+	switch ack.ReasonCode {
+	case reasonCodeUnsupportedProtocolVersion:
+		def = errUnsupportedProtocolVersion
+	case reasonCodeClientIDNotValid:
+		def = errClientIDNotValid
+	case reasonCodeBadUsernameOrPassword:
+		def = errBadCredentials
+	case reasonCodeNotAuthorized, reasonCodeBanned:
+		def = errNotAuthorized
+	default:
+		def = errConnectionRefused
+	}
+	err := def.WithAttributes("reason_code", int(ack.ReasonCode))
+	if ack.Properties != nil && ack.Properties.ReasonString != "" {
+		err = err.WithAttributes("reason_string", ack.Properties.ReasonString)
+	}
+	return err
+}
+
+// disconnectError returns the error of the given DISCONNECT sent by the server.
+func disconnectError(d *paho.Disconnect) error {
+	err := errServerDisconnect.WithAttributes("reason_code", int(d.ReasonCode))
+	if d.Properties != nil && d.Properties.ReasonString != "" {
+		err = err.WithAttributes("reason_string", d.Properties.ReasonString)
+	}
+	return err
+}
+
+// openConnectionV5 opens a connection to the MQTT 5 server of the given settings.
+// The reason codes of the server are carried in the errors of the connection, so that they are surfaced in the status
+// of the integration.
+func openConnectionV5(ctx context.Context, target provider.Target, settings *ttnpb.ApplicationPubSub_MQTTProvider) (pc *provider.Connection, err error) {
+	conn, err := dialV5(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+	errCh := make(chan error, 1)
+	reportErr := func(err error) {
+		select {
+		case errCh <- err:
+		default:
+		}
+	}
+	router := paho.NewStandardRouter()
+	client := paho.NewClient(paho.ClientConfig{
+		Conn:   conn,
+		Router: router,
+		OnServerDisconnect: func(d *paho.Disconnect) {
+			reportErr(disconnectError(d))
+		},
+		OnClientError: func(err error) {
+			reportErr(errClient.WithCause(err))
+		},
+	})
+	connect := &paho.Connect{
+		ClientID:     settings.ClientID,
+		KeepAlive:    uint16(keepAlive / time.Second),
+		CleanStart:   settings.SessionExpiryInterval == nil,
+		Username:     settings.Username,
+		UsernameFlag: settings.Username != "",
+		Password:     []byte(settings.Password),
+		PasswordFlag: settings.Password != "",
+	}
+	if settings.SessionExpiryInterval != nil {
+		connect.Properties = &paho.ConnectProperties{
+			SessionExpiryInterval: intervalSeconds(*settings.SessionExpiryInterval),
+		}
+	}
+	connectCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ack, err := client.Connect(connectCtx, connect)
+	if ack != nil && ack.ReasonCode >= 0x80 {
+		conn.Close()
+		return nil, connectError(ack)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	pc = &provider.Connection{
+		ProviderConnection: &connectionV5{
+			client: client,
+		},
+		EndDeviceMetadata:      true,
+		CorrelationIDsMetadata: true,
+		ContentTypeMetadata:    true,
+		Errors:                 errCh,
+	}
+	if err := openTopicsAndSubscriptions(pc, target,
+		func(topicName string) (*pubsub.Topic, error) {
+			return OpenTopicV5(client, topicName, timeout, byte(settings.PublishQoS), settings.MessageExpiryInterval)
+		},
+		func(topicName string) (*pubsub.Subscription, error) {
+			return OpenSubscriptionV5(ctx, client, router, topicName, timeout, byte(settings.SubscribeQoS))
+		},
+	); err != nil {
+		client.Disconnect(&paho.Disconnect{})
+		return nil, err
+	}
+	return pc, nil
+}
@@ -0,0 +1,377 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// defaultStream is the JetStream stream which stores the messages if none is configured.
+// It is also the default prefix of the durable consumer names.
+const defaultStream = "ttn-lw-application-server"
+
+var (
+	errJetStreamAPI    = errors.Define("jetstream_api", "JetStream API error `{code}`: {description}")
+	errInvalidResponse = errors.Define("invalid_response", "invalid JetStream API response")
+)
+
+// apiTimeout is the timeout of the JetStream API requests.
+var apiTimeout = 5 * time.Second
+
+// receiveWait is the time to wait for new messages when no messages are available.
+var receiveWait = time.Second
+
+// Acknowledgement bodies of the JetStream consumers.
+var (
+	ackBody = []byte("+ACK")
+	nakBody = []byte("-NAK")
+)
+
+type apiError struct {
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+}
+
+type apiResponse struct {
+	Error *apiError `json:"error,omitempty"`
+}
+
+func (r apiResponse) err() error {
+	if r.Error == nil {
+		return nil
+	}
+	return errJetStreamAPI.WithAttributes(
+		"code", r.Error.Code,
+		"description", r.Error.Description,
+	)
+}
+
+type streamConfig struct {
+	Name      string   `json:"name"`
+	Subjects  []string `json:"subjects"`
+	Retention string   `json:"retention"`
+	Storage   string   `json:"storage"`
+}
+
+type streamInfoResponse struct {
+	apiResponse
+	Config streamConfig `json:"config"`
+}
+
+type consumerConfig struct {
+	DurableName    string `json:"durable_name"`
+	DeliverSubject string `json:"deliver_subject"`
+	DeliverPolicy  string `json:"deliver_policy"`
+	AckPolicy      string `json:"ack_policy"`
+	FilterSubject  string `json:"filter_subject"`
+}
+
+type createConsumerRequest struct {
+	Stream string         `json:"stream_name"`
+	Config consumerConfig `json:"config"`
+}
+
+type pubAckResponse struct {
+	apiResponse
+	Stream   string `json:"stream"`
+	Sequence uint64 `json:"seq"`
+}
+
+// request sends the given body to the subject and decodes the JetStream API response in res.
+func request(ctx context.Context, conn *nats.Conn, subject string, body []byte, res interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, apiTimeout)
+	defer cancel()
+	msg, err := conn.RequestWithContext(ctx, subject, body)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(msg.Data, res); err != nil {
+		return errInvalidResponse.WithCause(err)
+	}
+	return nil
+}
+
+// requestJSON sends the given JetStream API request encoded as JSON and decodes the response in res.
+func requestJSON(ctx context.Context, conn *nats.Conn, subject string, req interface{}, res interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	return request(ctx, conn, subject, body, res)
+}
+
+// mergeSubjects returns the subjects with the given subjects appended, if they are not yet present.
+// The boolean indicates whether any subject has been added.
+func mergeSubjects(subjects []string, add ...string) ([]string, bool) {
+	res := append([]string(nil), subjects...)
+	changed := false
+outer:
+	for _, s := range add {
+		for _, existing := range res {
+			if existing == s {
+				continue outer
+			}
+		}
+		res = append(res, s)
+		changed = true
+	}
+	return res, changed
+}
+
+// ensureStream creates the given stream if it does not exist, and adds the given subjects to the stream if they
+// are not yet part of it.
+func ensureStream(ctx context.Context, conn *nats.Conn, stream string, subjects ...string) error {
+	var info streamInfoResponse
+	if err := request(ctx, conn, fmt.Sprintf("$JS.API.STREAM.INFO.%s", stream), nil, &info); err != nil {
+		return err
+	}
+	if info.Error != nil && info.Error.Code != 404 {
+		return info.err()
+	}
+	verb := "CREATE"
+	config := streamConfig{
+		Name:      stream,
+		Retention: "limits",
+		Storage:   "file",
+	}
+	if info.Error == nil {
+		var changed bool
+		config = info.Config
+		if config.Subjects, changed = mergeSubjects(config.Subjects, subjects...); !changed {
+			return nil
+		}
+		verb = "UPDATE"
+	} else {
+		config.Subjects, _ = mergeSubjects(nil, subjects...)
+	}
+	var res streamInfoResponse
+	if err := requestJSON(ctx, conn, fmt.Sprintf("$JS.API.STREAM.%s.%s", verb, stream), config, &res); err != nil {
+		return err
+	}
+	return res.err()
+}
+
+// durableName returns the name of the durable consumer of the given subject.
+// The characters which are not allowed in consumer names are replaced by dashes.
+func durableName(prefix, subject string) string {
+	return prefix + "-" + strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '*', '>', ' ', '\t', '\r', '\n':
+			return '-'
+		}
+		return r
+	}, subject)
+}
+
+type jetStreamTopic struct {
+	conn    *nats.Conn
+	subject string
+}
+
+// openJetStreamTopic returns a *pubsub.Topic that publishes the messages to the given subject and waits for the
+// acknowledgement of the stream.
+func openJetStreamTopic(conn *nats.Conn, subject string) *pubsub.Topic {
+	return pubsub.NewTopic(&jetStreamTopic{
+		conn:    conn,
+		subject: subject,
+	}, nil)
+}
+
+// SendBatch implements driver.Topic.
+func (t *jetStreamTopic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	for _, msg := range msgs {
+		if msg.BeforeSend != nil {
+			if err := msg.BeforeSend(func(interface{}) bool { return false }); err != nil {
+				return err
+			}
+		}
+		var ack pubAckResponse
+		if err := request(ctx, t.conn, t.subject, msg.Body, &ack); err != nil {
+			return err
+		}
+		if err := ack.err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsRetryable implements driver.Topic.
+func (*jetStreamTopic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *jetStreamTopic) As(i interface{}) bool {
+	p, ok := i.(**nats.Conn)
+	if !ok {
+		return false
+	}
+	*p = t.conn
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*jetStreamTopic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*jetStreamTopic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+// The connection is closed by the provider connection.
+func (*jetStreamTopic) Close() error { return nil }
+
+type jetStreamSubscription struct {
+	conn *nats.Conn
+	sub  *nats.Subscription
+}
+
+// openJetStreamSubscription returns a *pubsub.Subscription that consumes the messages of the given subject using
+// a durable push consumer of the given stream. The consumer is created if it does not exist. The messages which
+// were delivered but not acknowledged, for example because the Application Server restarted, are delivered again.
+func openJetStreamSubscription(ctx context.Context, conn *nats.Conn, stream, durable, subject string) (*pubsub.Subscription, error) {
+	deliverSubject := fmt.Sprintf("_DELIVER.%s.%s", stream, durable)
+	var res apiResponse
+	if err := requestJSON(ctx, conn, fmt.Sprintf("$JS.API.CONSUMER.DURABLE.CREATE.%s.%s", stream, durable), createConsumerRequest{
+		Stream: stream,
+		Config: consumerConfig{
+			DurableName:    durable,
+			DeliverSubject: deliverSubject,
+			DeliverPolicy:  "all",
+			AckPolicy:      "explicit",
+			FilterSubject:  subject,
+		},
+	}, &res); err != nil {
+		return nil, err
+	}
+	if err := res.err(); err != nil {
+		return nil, err
+	}
+	sub, err := conn.SubscribeSync(deliverSubject)
+	if err != nil {
+		return nil, err
+	}
+	return pubsub.NewSubscription(&jetStreamSubscription{
+		conn: conn,
+		sub:  sub,
+	}, nil, nil), nil
+}
+
+func decodeMessage(msg *nats.Msg) *driver.Message {
+	return &driver.Message{
+		Body:  msg.Data,
+		AckID: msg.Reply,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(**nats.Msg)
+			if !ok {
+				return false
+			}
+			*p = msg
+			return true
+		},
+	}
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *jetStreamSubscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, receiveWait)
+	defer cancel()
+	msg, err := s.sub.NextMsgWithContext(waitCtx)
+	if err != nil {
+		if ctx.Err() == nil && waitCtx.Err() != nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	messages := []*driver.Message{decodeMessage(msg)}
+	for len(messages) < maxMessages {
+		msg, err := s.sub.NextMsg(0)
+		if err != nil {
+			break
+		}
+		messages = append(messages, decodeMessage(msg))
+	}
+	return messages, ctx.Err()
+}
+
+func (s *jetStreamSubscription) reply(ackIDs []driver.AckID, body []byte) error {
+	for _, id := range ackIDs {
+		if err := s.conn.Publish(id.(string), body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SendAcks implements driver.Subscription.
+func (s *jetStreamSubscription) SendAcks(_ context.Context, ackIDs []driver.AckID) error {
+	return s.reply(ackIDs, ackBody)
+}
+
+// CanNack implements driver.Subscription.
+func (*jetStreamSubscription) CanNack() bool { return true }
+
+// SendNacks implements driver.Subscription.
+func (s *jetStreamSubscription) SendNacks(_ context.Context, ackIDs []driver.AckID) error {
+	return s.reply(ackIDs, nakBody)
+}
+
+// IsRetryable implements driver.Subscription.
+func (*jetStreamSubscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *jetStreamSubscription) As(i interface{}) bool {
+	p, ok := i.(**nats.Subscription)
+	if !ok {
+		return false
+	}
+	*p = s.sub
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*jetStreamSubscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*jetStreamSubscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+func (s *jetStreamSubscription) Close() error {
+	return s.sub.Unsubscribe()
+}
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	}
+	return gcerrors.Unknown
+}
@@ -78,3 +78,82 @@ func TestCombineSubjects(t *testing.T) {
 		})
 	}
 }
+
+func TestDurableName(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		name     string
+		prefix   string
+		subject  string
+		expected string
+	}{
+		{
+			name:     "Simple",
+			prefix:   "as",
+			subject:  "foo",
+			expected: "as-foo",
+		},
+		{
+			name:     "Separators",
+			prefix:   "as",
+			subject:  "app1.down.push",
+			expected: "as-app1-down-push",
+		},
+		{
+			name:     "Wildcards",
+			prefix:   "as",
+			subject:  "app1.*.push.>",
+			expected: "as-app1---push--",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a.So(durableName(tc.prefix, tc.subject), should.Equal, tc.expected)
+		})
+	}
+}
+
+func TestMergeSubjects(t *testing.T) {
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		name            string
+		subjects        []string
+		add             []string
+		expected        []string
+		expectedChanged bool
+	}{
+		{
+			name:            "Empty",
+			add:             []string{"foo", "bar"},
+			expected:        []string{"foo", "bar"},
+			expectedChanged: true,
+		},
+		{
+			name:            "Existing",
+			subjects:        []string{"foo", "bar"},
+			add:             []string{"bar", "foo"},
+			expected:        []string{"foo", "bar"},
+			expectedChanged: false,
+		},
+		{
+			name:            "Partial",
+			subjects:        []string{"foo"},
+			add:             []string{"foo", "bar"},
+			expected:        []string{"foo", "bar"},
+			expectedChanged: true,
+		},
+		{
+			name:            "Duplicates",
+			add:             []string{"foo", "foo"},
+			expected:        []string{"foo"},
+			expectedChanged: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			subjects, changed := mergeSubjects(tc.subjects, tc.add...)
+			a.So(subjects, should.Resemble, tc.expected)
+			a.So(changed, should.Equal, tc.expectedChanged)
+		})
+	}
+}
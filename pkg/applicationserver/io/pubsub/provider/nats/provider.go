@@ -12,7 +12,8 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package nats implements the NATS provider using the natspubsub driver.
+// Package nats implements the NATS provider using the natspubsub driver, or using JetStream streams and durable
+// consumers for at-least-once delivery.
 package nats
 
 import (
@@ -53,6 +54,39 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 			Conn: conn,
 		},
 	}
+	jetStream := settings.NATS.JetStream
+	stream := settings.NATS.Stream
+	if stream == "" {
+		stream = defaultStream
+	}
+	durablePrefix := settings.NATS.DurablePrefix
+	if durablePrefix == "" {
+		durablePrefix = defaultStream
+	}
+	if jetStream {
+		var subjects []string
+		for _, message := range []*ttnpb.ApplicationPubSub_Message{
+			target.GetUplinkMessage(),
+			target.GetJoinAccept(),
+			target.GetDownlinkAck(),
+			target.GetDownlinkNack(),
+			target.GetDownlinkSent(),
+			target.GetDownlinkFailed(),
+			target.GetDownlinkQueued(),
+			target.GetLocationSolved(),
+			target.GetDownlinkPush(),
+			target.GetDownlinkReplace(),
+		} {
+			if message == nil {
+				continue
+			}
+			subjects = append(subjects, combineSubjects(target.GetBaseTopic(), message.GetTopic()))
+		}
+		if err = ensureStream(ctx, conn, stream, subjects...); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
 	for _, t := range []struct {
 		topic   **pubsub.Topic
 		message *ttnpb.ApplicationPubSub_Message
@@ -93,9 +127,14 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 		if t.message == nil {
 			continue
 		}
+		subject := combineSubjects(target.GetBaseTopic(), t.message.GetTopic())
+		if jetStream {
+			*t.topic = openJetStreamTopic(conn, subject)
+			continue
+		}
 		if *t.topic, err = natspubsub.OpenTopic(
 			conn,
-			combineSubjects(target.GetBaseTopic(), t.message.GetTopic()),
+			subject,
 			&natspubsub.TopicOptions{},
 		); err != nil {
 			conn.Close()
@@ -118,9 +157,23 @@ func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *pro
 		if s.message == nil {
 			continue
 		}
+		subject := combineSubjects(target.GetBaseTopic(), s.message.GetTopic())
+		if jetStream {
+			if *s.subscription, err = openJetStreamSubscription(
+				ctx,
+				conn,
+				stream,
+				durableName(durablePrefix, subject),
+				subject,
+			); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			continue
+		}
 		if *s.subscription, err = natspubsub.OpenSubscription(
 			conn,
-			combineSubjects(target.GetBaseTopic(), s.message.GetTopic()),
+			subject,
 			&natspubsub.SubscriptionOptions{},
 		); err != nil {
 			conn.Close()
@@ -0,0 +1,163 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// readBlock is the time to block while reading from the stream when no new entries are available.
+var readBlock = time.Second
+
+type subscription struct {
+	client   *redis.Client
+	stream   string
+	group    string
+	consumer string
+	// pending indicates that the pending entries of the consumer are read before the new entries.
+	pending bool
+}
+
+// openSubscription returns a *pubsub.Subscription that reads from the given stream as a consumer of the given
+// consumer group. The consumer group is created if it does not exist. The entries which were delivered to the consumer
+// but not acknowledged, for example because the Application Server restarted, are delivered again first.
+func openSubscription(ctx context.Context, client *redis.Client, stream, group, consumer string) (*pubsub.Subscription, error) {
+	if err := client.WithContext(ctx).XGroupCreateMkStream(stream, group, "$").Err(); err != nil && !isBusyGroup(err) {
+		return nil, err
+	}
+	return pubsub.NewSubscription(&subscription{
+		client:   client,
+		stream:   stream,
+		group:    group,
+		consumer: consumer,
+		pending:  true,
+	}, nil, nil), nil
+}
+
+// isBusyGroup returns whether the error indicates that the consumer group already exists.
+func isBusyGroup(err error) bool {
+	return strings.HasPrefix(err.Error(), "BUSYGROUP")
+}
+
+func decodeMessage(msg redis.XMessage) *driver.Message {
+	var (
+		body     []byte
+		metadata map[string]string
+	)
+	for k, v := range msg.Values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		if k == payloadField {
+			body = []byte(s)
+			continue
+		}
+		if metadata == nil {
+			metadata = make(map[string]string, len(msg.Values))
+		}
+		metadata[k] = s
+	}
+	return &driver.Message{
+		Body:     body,
+		Metadata: metadata,
+		AckID:    msg.ID,
+		AsFunc: func(i interface{}) bool {
+			p, ok := i.(*redis.XMessage)
+			if !ok {
+				return false
+			}
+			*p = msg
+			return true
+		},
+	}
+}
+
+// ReceiveBatch implements driver.Subscription.
+func (s *subscription) ReceiveBatch(ctx context.Context, maxMessages int) ([]*driver.Message, error) {
+	args := &redis.XReadGroupArgs{
+		Group:    s.group,
+		Consumer: s.consumer,
+		Count:    int64(maxMessages),
+	}
+	if s.pending {
+		args.Streams = []string{s.stream, "0"}
+		args.Block = -1
+	} else {
+		args.Streams = []string{s.stream, ">"}
+		args.Block = readBlock
+	}
+	streams, err := s.client.WithContext(ctx).XReadGroup(args).Result()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	var messages []*driver.Message
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			messages = append(messages, decodeMessage(msg))
+		}
+	}
+	if s.pending && len(messages) == 0 {
+		s.pending = false
+	}
+	return messages, ctx.Err()
+}
+
+// SendAcks implements driver.Subscription.
+func (s *subscription) SendAcks(ctx context.Context, ackIDs []driver.AckID) error {
+	ids := make([]string, 0, len(ackIDs))
+	for _, id := range ackIDs {
+		ids = append(ids, id.(string))
+	}
+	return s.client.WithContext(ctx).XAck(s.stream, s.group, ids...).Err()
+}
+
+// CanNack implements driver.Subscription.
+func (*subscription) CanNack() bool { return false }
+
+// SendNacks implements driver.Subscription.
+func (*subscription) SendNacks(context.Context, []driver.AckID) error { panic("unreachable") }
+
+// IsRetryable implements driver.Subscription.
+func (*subscription) IsRetryable(error) bool { return false }
+
+// As implements driver.Subscription.
+func (s *subscription) As(i interface{}) bool {
+	p, ok := i.(**redis.Client)
+	if !ok {
+		return false
+	}
+	*p = s.client
+	return true
+}
+
+// ErrorAs implements driver.Subscription.
+func (*subscription) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Subscription.
+func (*subscription) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Subscription.
+// The client is closed by the connection.
+func (*subscription) Close() error { return nil }
@@ -0,0 +1,179 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the Redis Streams provider, which adds the upstream messages to streams and reads the
+// downstream messages from streams using a consumer group.
+package redis
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/pubsub"
+)
+
+// defaultConsumerGroup is the consumer group which reads the downstream messages if none is configured.
+const defaultConsumerGroup = "ttn-lw-application-server"
+
+var errServerURL = errors.DefineInvalidArgument("server_url", "invalid server URL")
+
+type impl struct {
+}
+
+type connection struct {
+	*redis.Client
+}
+
+// Shutdown implements provider.Shutdowner.
+func (c *connection) Shutdown(_ context.Context) error {
+	return c.Close()
+}
+
+// combineStreamNames returns the name of the stream of the given base topic and message topic.
+func combineStreamNames(s1, s2 string) string {
+	s1 = strings.Trim(s1, ":")
+	s2 = strings.Trim(s2, ":")
+	if s1 == "" {
+		return s2
+	}
+	if s2 == "" {
+		return s1
+	}
+	return fmt.Sprintf("%s:%s", s1, s2)
+}
+
+// consumerName returns the name of the consumer of the consumer group.
+// The host name is used so that the pending messages are claimed again after a restart.
+func consumerName() string {
+	if hostname, err := os.Hostname(); err == nil && hostname != "" {
+		return hostname
+	}
+	return defaultConsumerGroup
+}
+
+// OpenConnection implements provider.Provider using the go-redis client.
+func (impl) OpenConnection(ctx context.Context, target provider.Target) (pc *provider.Connection, err error) {
+	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Redis)
+	if !ok {
+		panic("wrong provider type provided to OpenConnection")
+	}
+	opts, err := redis.ParseURL(settings.Redis.ServerURL)
+	if err != nil {
+		return nil, errServerURL.WithCause(err)
+	}
+	client := redis.NewClient(opts)
+	pc = &provider.Connection{
+		ProviderConnection: &connection{
+			Client: client,
+		},
+		EndDeviceMetadata:      true,
+		CorrelationIDsMetadata: true,
+		ContentTypeMetadata:    true,
+	}
+	defer func() {
+		if err != nil {
+			pc.Shutdown(ctx)
+		}
+	}()
+	if err = client.WithContext(ctx).Ping().Err(); err != nil {
+		return nil, err
+	}
+	for _, t := range []struct {
+		topic   **pubsub.Topic
+		message *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			topic:   &pc.Topics.UplinkMessage,
+			message: target.GetUplinkMessage(),
+		},
+		{
+			topic:   &pc.Topics.JoinAccept,
+			message: target.GetJoinAccept(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkAck,
+			message: target.GetDownlinkAck(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkNack,
+			message: target.GetDownlinkNack(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkSent,
+			message: target.GetDownlinkSent(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkFailed,
+			message: target.GetDownlinkFailed(),
+		},
+		{
+			topic:   &pc.Topics.DownlinkQueued,
+			message: target.GetDownlinkQueued(),
+		},
+		{
+			topic:   &pc.Topics.LocationSolved,
+			message: target.GetLocationSolved(),
+		},
+	} {
+		if t.message == nil {
+			continue
+		}
+		*t.topic = openTopic(
+			client,
+			combineStreamNames(target.GetBaseTopic(), t.message.GetTopic()),
+			settings.Redis,
+		)
+	}
+	group := settings.Redis.ConsumerGroup
+	if group == "" {
+		group = defaultConsumerGroup
+	}
+	for _, s := range []struct {
+		subscription **pubsub.Subscription
+		message      *ttnpb.ApplicationPubSub_Message
+	}{
+		{
+			subscription: &pc.Subscriptions.Push,
+			message:      target.GetDownlinkPush(),
+		},
+		{
+			subscription: &pc.Subscriptions.Replace,
+			message:      target.GetDownlinkReplace(),
+		},
+	} {
+		if s.message == nil {
+			continue
+		}
+		if *s.subscription, err = openSubscription(
+			ctx,
+			client,
+			combineStreamNames(target.GetBaseTopic(), s.message.GetTopic()),
+			group,
+			consumerName(),
+		); err != nil {
+			return nil, err
+		}
+	}
+	return pc, nil
+}
+
+func init() {
+	provider.RegisterProvider(&ttnpb.ApplicationPubSub_Redis{}, impl{})
+}
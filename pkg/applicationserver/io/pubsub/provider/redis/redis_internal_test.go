@@ -0,0 +1,110 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"gocloud.dev/pubsub/driver"
+)
+
+func TestCombineStreamNames(t *testing.T) {
+	a := assertions.New(t)
+	a.So(combineStreamNames("app1", "up"), should.Equal, "app1:up")
+	a.So(combineStreamNames("app1:", ":down:push"), should.Equal, "app1:down:push")
+	a.So(combineStreamNames("", "up"), should.Equal, "up")
+	a.So(combineStreamNames("app1", ""), should.Equal, "app1")
+}
+
+func TestAddArgs(t *testing.T) {
+	for _, tc := range []struct {
+		name                 string
+		settings             *ttnpb.ApplicationPubSub_RedisProvider
+		expectedMaxLen       int64
+		expectedMaxLenApprox int64
+	}{
+		{
+			name:     "NoTrimming",
+			settings: &ttnpb.ApplicationPubSub_RedisProvider{},
+		},
+		{
+			name: "ExactTrimming",
+			settings: &ttnpb.ApplicationPubSub_RedisProvider{
+				StreamMaxLength: 1000,
+			},
+			expectedMaxLen: 1000,
+		},
+		{
+			name: "ApproximateTrimming",
+			settings: &ttnpb.ApplicationPubSub_RedisProvider{
+				StreamMaxLength:     1000,
+				ApproximateTrimming: true,
+			},
+			expectedMaxLenApprox: 1000,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			tp := &topic{
+				stream:   "app1:up",
+				settings: tc.settings,
+			}
+			args := tp.addArgs(&driver.Message{
+				Body: []byte("foobar"),
+				Metadata: map[string]string{
+					provider.MetadataDeviceID: "dev1",
+				},
+			})
+			a.So(args.Stream, should.Equal, "app1:up")
+			a.So(args.MaxLen, should.Equal, tc.expectedMaxLen)
+			a.So(args.MaxLenApprox, should.Equal, tc.expectedMaxLenApprox)
+			a.So(args.Values, should.Resemble, map[string]interface{}{
+				payloadField:              []byte("foobar"),
+				provider.MetadataDeviceID: "dev1",
+			})
+		})
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	a := assertions.New(t)
+	msg := redis.XMessage{
+		ID: "1575907400000-0",
+		Values: map[string]interface{}{
+			payloadField: "foobar",
+			"key":        "value",
+			"number":     42,
+		},
+	}
+	dm := decodeMessage(msg)
+	a.So(dm.Body, should.Resemble, []byte("foobar"))
+	a.So(dm.Metadata, should.Resemble, map[string]string{"key": "value"})
+	a.So(dm.AckID, should.Equal, "1575907400000-0")
+	var res redis.XMessage
+	a.So(dm.AsFunc(&res), should.BeTrue)
+	a.So(res, should.Resemble, msg)
+}
+
+func TestIsBusyGroup(t *testing.T) {
+	a := assertions.New(t)
+	a.So(isBusyGroup(errors.New("BUSYGROUP Consumer Group name already exists")), should.BeTrue)
+	a.So(isBusyGroup(errors.New("ERR no such key")), should.BeFalse)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"gocloud.dev/gcerrors"
+	"gocloud.dev/pubsub"
+	"gocloud.dev/pubsub/driver"
+)
+
+// payloadField is the field of the stream entries which contains the message body.
+// The metadata of the messages is stored in the other fields of the entries.
+const payloadField = "payload"
+
+type topic struct {
+	client   *redis.Client
+	stream   string
+	settings *ttnpb.ApplicationPubSub_RedisProvider
+}
+
+// openTopic returns a *pubsub.Topic that adds the messages to the given stream.
+func openTopic(client *redis.Client, stream string, settings *ttnpb.ApplicationPubSub_RedisProvider) *pubsub.Topic {
+	return pubsub.NewTopic(&topic{
+		client:   client,
+		stream:   stream,
+		settings: settings,
+	}, nil)
+}
+
+// addArgs returns the arguments of the XADD command which adds the given message to the stream.
+// The stream is trimmed to the configured maximum length, if any.
+func (t *topic) addArgs(msg *driver.Message) *redis.XAddArgs {
+	values := make(map[string]interface{}, len(msg.Metadata)+1)
+	for k, v := range msg.Metadata {
+		values[k] = v
+	}
+	values[payloadField] = msg.Body
+	args := &redis.XAddArgs{
+		Stream: t.stream,
+		Values: values,
+	}
+	if maxLen := int64(t.settings.GetStreamMaxLength()); maxLen > 0 {
+		if t.settings.GetApproximateTrimming() {
+			args.MaxLenApprox = maxLen
+		} else {
+			args.MaxLen = maxLen
+		}
+	}
+	return args
+}
+
+// SendBatch implements driver.Topic.
+func (t *topic) SendBatch(ctx context.Context, msgs []*driver.Message) error {
+	pipe := t.client.WithContext(ctx).Pipeline()
+	defer pipe.Close()
+	for _, msg := range msgs {
+		args := t.addArgs(msg)
+		if msg.BeforeSend != nil {
+			asFunc := func(i interface{}) bool {
+				p, ok := i.(**redis.XAddArgs)
+				if !ok {
+					return false
+				}
+				*p = args
+				return true
+			}
+			if err := msg.BeforeSend(asFunc); err != nil {
+				return err
+			}
+		}
+		pipe.XAdd(args)
+	}
+	_, err := pipe.Exec()
+	return err
+}
+
+// IsRetryable implements driver.Topic.
+// The Redis client retries sending internally.
+func (*topic) IsRetryable(error) bool { return false }
+
+// As implements driver.Topic.
+func (t *topic) As(i interface{}) bool {
+	p, ok := i.(**redis.Client)
+	if !ok {
+		return false
+	}
+	*p = t.client
+	return true
+}
+
+// ErrorAs implements driver.Topic.
+func (*topic) ErrorAs(error, interface{}) bool { return false }
+
+// ErrorCode implements driver.Topic.
+func (*topic) ErrorCode(err error) gcerrors.ErrorCode {
+	return toErrorCode(err)
+}
+
+// Close implements driver.Topic.
+// The client is closed by the connection.
+func (*topic) Close() error { return nil }
+
+func toErrorCode(err error) gcerrors.ErrorCode {
+	switch err {
+	case nil:
+		return gcerrors.OK
+	case context.Canceled:
+		return gcerrors.Canceled
+	case context.DeadlineExceeded:
+		return gcerrors.DeadlineExceeded
+	}
+	return gcerrors.Unknown
+}
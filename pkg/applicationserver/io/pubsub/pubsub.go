@@ -18,6 +18,7 @@ package pubsub
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,12 +43,25 @@ type PubSub struct {
 	server   io.Server
 	registry Registry
 
-	integrations      sync.Map
-	integrationErrors sync.Map
+	integrations sync.Map
+	statuses     sync.Map
+	pauses       sync.Map
+
+	pauseBufferSize int
+}
+
+// Option configures the PubSub.
+type Option func(*PubSub)
+
+// WithPauseBufferSize configures the number of upstream messages that are buffered per paused integration.
+func WithPauseBufferSize(size int) Option {
+	return func(ps *PubSub) {
+		ps.pauseBufferSize = size
+	}
 }
 
 // New creates a new pusub frontend.
-func New(c *component.Component, server io.Server, registry Registry) (*PubSub, error) {
+func New(c *component.Component, server io.Server, registry Registry, opts ...Option) (*PubSub, error) {
 	ctx := log.NewContextWithField(c.FillContext(c.Context()), "namespace", "applicationserver/io/pubsub")
 	ps := &PubSub{
 		Component: c,
@@ -55,6 +69,9 @@ func New(c *component.Component, server io.Server, registry Registry) (*PubSub,
 		server:    server,
 		registry:  registry,
 	}
+	for _, opt := range opts {
+		opt(ps)
+	}
 	ps.RegisterTask(ctx, "pubsubs_start_all", ps.startAll, component.TaskRestartOnFailure)
 	return ps, nil
 }
@@ -107,7 +124,9 @@ type integration struct {
 	cancel errorcontext.CancelFunc
 	closed chan struct{}
 
-	conn *provider.Connection
+	conn   *provider.Connection
+	status *integrationStatus
+	pause  *integrationPause
 
 	server io.Server
 	sub    *io.Subscription
@@ -117,56 +136,106 @@ type integration struct {
 func (i *integration) handleUp(ctx context.Context) {
 	logger := log.FromContext(ctx)
 	for {
+		var ups []*io.ContextualApplicationUp
 		select {
 		case <-ctx.Done():
 			logger.WithError(ctx.Err()).Debug("Done sending upstream messages")
 			return
+		case <-i.pause.Resumed():
+			ups = i.pause.Take(ctx)
 		case up := <-i.sub.Up():
-			var topic *pubsub.Topic
-			switch up.ApplicationUp.Up.(type) {
-			case *ttnpb.ApplicationUp_UplinkMessage:
-				topic = i.conn.Topics.UplinkMessage
-			case *ttnpb.ApplicationUp_JoinAccept:
-				topic = i.conn.Topics.JoinAccept
-			case *ttnpb.ApplicationUp_DownlinkAck:
-				topic = i.conn.Topics.DownlinkAck
-			case *ttnpb.ApplicationUp_DownlinkNack:
-				topic = i.conn.Topics.DownlinkNack
-			case *ttnpb.ApplicationUp_DownlinkSent:
-				topic = i.conn.Topics.DownlinkSent
-			case *ttnpb.ApplicationUp_DownlinkFailed:
-				topic = i.conn.Topics.DownlinkFailed
-			case *ttnpb.ApplicationUp_DownlinkQueued:
-				topic = i.conn.Topics.DownlinkQueued
-			case *ttnpb.ApplicationUp_LocationSolved:
-				topic = i.conn.Topics.LocationSolved
-			}
-			if topic == nil {
-				continue
-			}
-			buf, err := i.format.FromUp(up.ApplicationUp)
-			if err != nil {
-				logger.WithError(err).Warn("Failed to marshal upstream message")
-				continue
-			}
-			err = topic.Send(ctx, &pubsub.Message{
-				Body: buf,
-			})
-			if err != nil {
-				logger.WithError(err).Warn("Failed to publish upstream message")
-				continue
-			}
-			logger.Debug("Publish upstream message")
+			ups = i.pause.Take(ctx, up)
 		}
+		if len(ups) == 0 {
+			continue
+		}
+		for _, up := range ups {
+			i.publishUp(ctx, up)
+		}
+		i.pause.Done()
 	}
 }
 
+func (i *integration) publishUp(ctx context.Context, up *io.ContextualApplicationUp) {
+	logger := log.FromContext(ctx)
+	var topic *pubsub.Topic
+	switch up.ApplicationUp.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		topic = i.conn.Topics.UplinkMessage
+	case *ttnpb.ApplicationUp_JoinAccept:
+		topic = i.conn.Topics.JoinAccept
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		topic = i.conn.Topics.DownlinkAck
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		topic = i.conn.Topics.DownlinkNack
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		topic = i.conn.Topics.DownlinkSent
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		topic = i.conn.Topics.DownlinkFailed
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		topic = i.conn.Topics.DownlinkQueued
+	case *ttnpb.ApplicationUp_LocationSolved:
+		topic = i.conn.Topics.LocationSolved
+	}
+	if topic == nil || !matchesFPort(&i.ApplicationPubSub, up.ApplicationUp) || !io.MatchesUpFilter(i.UpFilter, up.ApplicationUp) {
+		return
+	}
+	buf, err := i.format.FromUp(up.ApplicationUp)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal upstream message")
+		return
+	}
+	msg := &pubsub.Message{
+		Body: buf,
+	}
+	if i.conn.EndDeviceMetadata {
+		msg.Metadata = endDeviceMetadata(up.ApplicationUp.EndDeviceIdentifiers)
+	}
+	if i.conn.CorrelationIDsMetadata && len(up.ApplicationUp.CorrelationIDs) > 0 {
+		if msg.Metadata == nil {
+			msg.Metadata = make(map[string]string)
+		}
+		msg.Metadata[provider.MetadataCorrelationIDs] = strings.Join(up.ApplicationUp.CorrelationIDs, ",")
+	}
+	if i.conn.ContentTypeMetadata && i.format.ContentType != "" {
+		if msg.Metadata == nil {
+			msg.Metadata = make(map[string]string)
+		}
+		msg.Metadata[provider.MetadataContentType] = i.format.ContentType
+	}
+	err = topic.Send(ctx, msg)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to publish upstream message")
+		i.status.Degrade(ctx, err)
+		return
+	}
+	logger.Debug("Publish upstream message")
+	i.status.Published(ctx)
+}
+
+// endDeviceMetadata returns the message metadata of the given end device identifiers.
+func endDeviceMetadata(ids ttnpb.EndDeviceIdentifiers) map[string]string {
+	metadata := map[string]string{
+		provider.MetadataDeviceID: ids.DeviceID,
+	}
+	if ids.DevEUI != nil && !ids.DevEUI.IsZero() {
+		metadata[provider.MetadataDevEUI] = ids.DevEUI.String()
+	}
+	return metadata
+}
+
 func (i *integration) handleDown(ctx context.Context, op func(io.Server, context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error, subscription *pubsub.Subscription) {
 	logger := log.FromContext(ctx)
 	for ctx.Err() == nil {
+		if err := i.pause.Wait(ctx); err != nil {
+			continue
+		}
 		msg, err := subscription.Receive(ctx)
 		if err != nil {
 			logger.WithError(err).Warn("Failed to receive downlink queue operation")
+			if ctx.Err() == nil {
+				i.status.Degrade(ctx, err)
+			}
 			continue
 		}
 		msg.Ack()
@@ -179,6 +248,11 @@ func (i *integration) handleDown(ctx context.Context, op func(io.Server, context
 			logger.WithError(err).Warn("Failed to validate downlink queue operation")
 			continue
 		}
+		if cids := msg.Metadata[provider.MetadataCorrelationIDs]; i.conn.CorrelationIDsMetadata && cids != "" {
+			for _, down := range operation.Downlinks {
+				down.CorrelationIDs = append(down.CorrelationIDs, strings.Split(cids, ",")...)
+			}
+		}
 		logger.WithFields(log.Fields(
 			"device_uid", unique.ID(ctx, operation.EndDeviceIdentifiers),
 			"count", len(operation.Downlinks),
@@ -238,19 +312,24 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 		ctx:               ctx,
 		cancel:            cancel,
 		closed:            make(chan struct{}),
+		status:            ps.status(psUID, pb.ApplicationPubSubIdentifiers),
+		pause:             ps.pause(psUID),
 		server:            ps.server,
 	}
 	if _, loaded := ps.integrations.LoadOrStore(psUID, i); loaded {
 		log.FromContext(ctx).Warn("Integration already started")
 		return errAlreadyConfigured.WithAttributes("application_uid", appUID, "pub_sub_id", pb.PubSubID)
 	}
+	i.status.SetState(ctx, ttnpb.ApplicationPubSubStatus_CONNECTING)
 	go func() {
 		<-ctx.Done()
-		ps.integrationErrors.Store(psUID, ctx.Err())
 		ps.integrations.Delete(psUID)
 		if err := ctx.Err(); err != nil && !errors.IsCanceled(err) {
 			log.FromContext(ctx).WithError(err).Warn("Integration failed")
 			registerIntegrationFail(ctx, i, err)
+			i.status.Fail(ctx, ttnpb.ApplicationPubSubStatus_FAILED, err)
+		} else {
+			i.status.SetState(ctx, ttnpb.ApplicationPubSubStatus_STOPPED)
 		}
 		close(i.closed)
 	}()
@@ -275,15 +354,30 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 	i.format = format
 	go i.handleUp(ctx)
 	i.startHandleDown(ctx)
+	if i.conn.Errors != nil {
+		go func() {
+			select {
+			case <-ctx.Done():
+			case err := <-i.conn.Errors:
+				cancel(err)
+			}
+		}()
+	}
 	logger.Info("Started")
 	registerIntegrationStart(ctx, i)
+	if i.pause.Paused() {
+		i.status.SetState(ctx, ttnpb.ApplicationPubSubStatus_PAUSED)
+	} else {
+		i.status.SetState(ctx, ttnpb.ApplicationPubSubStatus_CONNECTED)
+	}
 	<-ctx.Done()
+	i.conn.Shutdown(ctx)
 	if err := ctx.Err(); errors.IsCanceled(err) {
 		logger.Info("Integration canceled")
-		i.conn.Shutdown(ctx)
 		registerIntegrationStop(ctx, i)
+		return nil
 	}
-	return
+	return ctx.Err()
 }
 
 func (ps *PubSub) stop(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifiers) error {
@@ -298,7 +392,7 @@ func (ps *PubSub) stop(ctx context.Context, ids ttnpb.ApplicationPubSubIdentifie
 		i.cancel(context.Canceled)
 		<-i.closed
 	} else {
-		ps.integrationErrors.Delete(psUID)
+		ps.statuses.Delete(psUID)
 	}
 	return nil
 }
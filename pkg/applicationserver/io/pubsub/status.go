@@ -0,0 +1,126 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errProvider = errors.Define("provider", "provider error: `{message}`")
+
+// integrationStatus keeps the health status of an integration.
+type integrationStatus struct {
+	mu     sync.RWMutex
+	status ttnpb.ApplicationPubSubStatus
+}
+
+func newIntegrationStatus(ids ttnpb.ApplicationPubSubIdentifiers) *integrationStatus {
+	return &integrationStatus{
+		status: ttnpb.ApplicationPubSubStatus{
+			IDs: ids,
+		},
+	}
+}
+
+// Get returns a copy of the status.
+func (s *integrationStatus) Get() *ttnpb.ApplicationPubSubStatus {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	status := s.status
+	return &status
+}
+
+// setStateLocked sets the state, and returns whether the state changed. The caller must hold the lock.
+func (s *integrationStatus) setStateLocked(state ttnpb.ApplicationPubSubStatus_State, now time.Time) bool {
+	if s.status.State == state && s.status.StateChangedAt != nil {
+		return false
+	}
+	s.status.State = state
+	s.status.StateChangedAt = &now
+	return true
+}
+
+func (s *integrationStatus) setErrorLocked(err error, now time.Time) {
+	if ttnErr, ok := errors.From(err); ok {
+		s.status.LastError = ttnpb.ErrorDetailsToProto(ttnErr)
+	} else {
+		// Errors of the provider libraries are not registered; keep their message.
+		s.status.LastError = ttnpb.ErrorDetailsToProto(errProvider.WithAttributes("message", err.Error()))
+	}
+	s.status.LastErrorAt = &now
+}
+
+// update applies the function to the status, and publishes an event if the state changed.
+func (s *integrationStatus) update(ctx context.Context, f func(now time.Time) bool) {
+	s.mu.Lock()
+	changed := f(time.Now().UTC())
+	var status ttnpb.ApplicationPubSubStatus
+	if changed {
+		status = s.status
+	}
+	s.mu.Unlock()
+	if changed {
+		events.Publish(evtPubSubStatusChange(ctx, status.IDs.ApplicationIdentifiers, &status))
+	}
+}
+
+// SetState sets the state of the integration.
+func (s *integrationStatus) SetState(ctx context.Context, state ttnpb.ApplicationPubSubStatus_State) {
+	s.update(ctx, func(now time.Time) bool {
+		return s.setStateLocked(state, now)
+	})
+}
+
+// Fail sets the state of the integration to the given state, and records the error.
+func (s *integrationStatus) Fail(ctx context.Context, state ttnpb.ApplicationPubSubStatus_State, err error) {
+	s.update(ctx, func(now time.Time) bool {
+		s.setErrorLocked(err, now)
+		return s.setStateLocked(state, now)
+	})
+}
+
+// Degrade records the error of a running integration, and marks the integration as degraded.
+func (s *integrationStatus) Degrade(ctx context.Context, err error) {
+	s.update(ctx, func(now time.Time) bool {
+		s.setErrorLocked(err, now)
+		if s.status.State != ttnpb.ApplicationPubSubStatus_CONNECTED {
+			return false
+		}
+		return s.setStateLocked(ttnpb.ApplicationPubSubStatus_DEGRADED, now)
+	})
+}
+
+// Published records a successfully published message, and marks a degraded integration as connected.
+func (s *integrationStatus) Published(ctx context.Context) {
+	s.update(ctx, func(now time.Time) bool {
+		s.status.LastPublishedAt = &now
+		if s.status.State != ttnpb.ApplicationPubSubStatus_DEGRADED {
+			return false
+		}
+		return s.setStateLocked(ttnpb.ApplicationPubSubStatus_CONNECTED, now)
+	})
+}
+
+// status returns the status of the integration with the given unique ID, and creates it if it does not exist.
+func (ps *PubSub) status(psUID string, ids ttnpb.ApplicationPubSubIdentifiers) *integrationStatus {
+	val, _ := ps.statuses.LoadOrStore(psUID, newIntegrationStatus(ids))
+	return val.(*integrationStatus)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestIntegrationStatus(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationPubSubIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		PubSubID:               "foo-pubsub",
+	}
+	s := newIntegrationStatus(ids)
+	a.So(s.Get().IDs, should.Resemble, ids)
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_STOPPED)
+
+	s.SetState(ctx, ttnpb.ApplicationPubSubStatus_CONNECTING)
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_CONNECTING)
+	a.So(s.Get().StateChangedAt, should.NotBeNil)
+
+	// Errors do not degrade integrations that are not connected.
+	s.Degrade(ctx, errors.New("nats: authorization violation"))
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_CONNECTING)
+	a.So(s.Get().LastError, should.NotBeNil)
+	a.So(s.Get().LastError.MessageFormat, should.ContainSubstring, "provider error")
+	a.So(s.Get().LastErrorAt, should.NotBeNil)
+
+	s.SetState(ctx, ttnpb.ApplicationPubSubStatus_CONNECTED)
+	s.Published(ctx)
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_CONNECTED)
+	a.So(s.Get().LastPublishedAt, should.NotBeNil)
+
+	s.Degrade(ctx, errProvider.WithAttributes("message", "timeout"))
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_DEGRADED)
+	a.So(s.Get().LastError.Name, should.Equal, "provider")
+
+	s.Published(ctx)
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_CONNECTED)
+
+	s.Fail(ctx, ttnpb.ApplicationPubSubStatus_FAILED, errors.New("connection lost"))
+	a.So(s.Get().State, should.Equal, ttnpb.ApplicationPubSubStatus_FAILED)
+	a.So(s.Get().LastError.Attributes.Fields["message"].GetStringValue(), should.Equal, "connection lost")
+}
@@ -0,0 +1,140 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedules_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/schedules"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"@every",
+	} {
+		t.Run(expr, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := schedules.ParseCron(expr)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Skipf("No time zone database available: %v", err)
+	}
+	// Wednesday 15 January 2020 10:30 UTC.
+	now := time.Date(2020, time.January, 15, 10, 30, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		Expr     string
+		From     time.Time
+		Expected time.Time
+	}{
+		{
+			Expr:     "* * * * *",
+			From:     now,
+			Expected: now.Add(time.Minute),
+		},
+		{
+			Expr:     "*/15 * * * *",
+			From:     now.Add(20 * time.Second),
+			Expected: time.Date(2020, time.January, 15, 10, 45, 0, 0, time.UTC),
+		},
+		{
+			Expr:     "0 3 * * *",
+			From:     now,
+			Expected: time.Date(2020, time.January, 16, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			Expr:     "@daily",
+			From:     now,
+			Expected: time.Date(2020, time.January, 16, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Expr:     "0 3 * * *",
+			From:     now.In(amsterdam),
+			Expected: time.Date(2020, time.January, 16, 3, 0, 0, 0, amsterdam),
+		},
+		{
+			Expr:     "0 9 * * mon-fri",
+			From:     time.Date(2020, time.January, 17, 10, 0, 0, 0, time.UTC),
+			Expected: time.Date(2020, time.January, 20, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			Expr:     "0 0 1 */3 *",
+			From:     now,
+			Expected: time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// The day of month or the day of week matches: the 20th or the next Sunday.
+			Expr:     "0 0 20 * 7",
+			From:     now,
+			Expected: time.Date(2020, time.January, 19, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Expr:     "30 12 29 feb *",
+			From:     now,
+			Expected: time.Date(2020, time.February, 29, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			// 02:30 does not exist on 29 March 2020 in Amsterdam, so the next time is the day after.
+			Expr:     "30 2 * * *",
+			From:     time.Date(2020, time.March, 28, 12, 0, 0, 0, amsterdam),
+			Expected: time.Date(2020, time.March, 30, 2, 30, 0, 0, amsterdam),
+		},
+		{
+			Expr: "0 0 31 2 *",
+			From: now,
+		},
+	} {
+		t.Run(tc.Expr, func(t *testing.T) {
+			a := assertions.New(t)
+			cron, err := schedules.ParseCron(tc.Expr)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(cron.Next(tc.From), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestCronMatch(t *testing.T) {
+	a := assertions.New(t)
+	cron, err := schedules.ParseCron("30 3 * * mon-fri")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(cron.Match(time.Date(2020, time.January, 15, 3, 30, 0, 0, time.UTC)), should.BeTrue)
+	a.So(cron.Match(time.Date(2020, time.January, 15, 3, 30, 59, 0, time.UTC)), should.BeTrue)
+	a.So(cron.Match(time.Date(2020, time.January, 15, 3, 31, 0, 0, time.UTC)), should.BeFalse)
+	a.So(cron.Match(time.Date(2020, time.January, 18, 3, 30, 0, 0, time.UTC)), should.BeFalse)
+}
@@ -0,0 +1,380 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedules implements scheduled downlink messages.
+//
+// A schedule pushes a predefined downlink message to end devices of an application at the times of a cron expression,
+// for example a daily synchronization command at 03:00 local time.
+//
+// Schedules with local time interpret the cron expression in the time zone of each end device, so that end devices
+// in different time zones run at the same local time.
+package schedules
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// Conflict handling of end devices that have downlink messages in their queue when the schedule runs.
+const (
+	// ConflictSkipDuplicate skips end devices that already have the scheduled downlink message in their queue.
+	ConflictSkipDuplicate = "skip-duplicate"
+	// ConflictSkipQueued skips end devices that have any downlink message in their queue.
+	ConflictSkipQueued = "skip-queued"
+	// ConflictPush pushes the scheduled downlink message to the end of the queue.
+	ConflictPush = "push"
+	// ConflictReplace replaces the queue with the scheduled downlink message.
+	ConflictReplace = "replace"
+)
+
+// Schedule is a recurring downlink message to end devices of an application.
+type Schedule struct {
+	Enabled       bool     `name:"enabled" description:"Run the schedule"`
+	ApplicationID string   `name:"application-id" description:"Application ID of the end devices"`
+	DeviceIDs     []string `name:"device-ids" description:"IDs of the end devices"`
+	Cron          string   `name:"cron" description:"Cron expression of the times to push the downlink message"`
+	Location      string   `name:"location" description:"Time zone of the cron expression, for example Europe/Amsterdam (default UTC)"`
+	LocalTime     bool     `name:"local-time" description:"Interpret the cron expression in the time zone of each end device"`
+	FPort         uint32   `name:"f-port" description:"FPort of the downlink message"`
+	FRMPayload    string   `name:"frm-payload" description:"Hex encoded payload of the downlink message"`
+	Confirmed     bool     `name:"confirmed" description:"Push confirmed downlink messages"`
+	Conflict      string   `name:"conflict" description:"Handling of end devices with queued downlink messages (skip-duplicate, skip-queued, push, replace)"`
+}
+
+// Run is a run of a schedule.
+type Run struct {
+	Time    time.Time `json:"time"`
+	Pushed  []string  `json:"pushed,omitempty"`
+	Skipped []string  `json:"skipped,omitempty"`
+	// Failed are the errors of the end devices to which the downlink message could not be pushed, by end device ID.
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// Status is the status of a schedule.
+type Status struct {
+	ID        string   `json:"id"`
+	DeviceIDs []string `json:"device_ids"`
+	Cron      string   `json:"cron"`
+	Location  string   `json:"location"`
+	LocalTime bool     `json:"local_time"`
+	Enabled   bool     `json:"enabled"`
+	// Next is the next run of the schedule. Schedules with local time run per end device and have no next run.
+	Next *time.Time `json:"next,omitempty"`
+	// History are the recent runs of the schedule, most recent first.
+	History []Run `json:"history"`
+}
+
+var (
+	errSchedule         = errors.DefineInvalidArgument("schedule", "invalid schedule `{schedule_id}`")
+	errLocation         = errors.DefineInvalidArgument("location", "invalid location `{location}`")
+	errFRMPayload       = errors.DefineInvalidArgument("frm_payload", "invalid hex encoded payload")
+	errConflict         = errors.DefineInvalidArgument("conflict", "invalid conflict handling `{conflict}`")
+	errNoDevices        = errors.DefineInvalidArgument("no_devices", "no end devices")
+	errScheduleNotFound = errors.DefineNotFound("schedule_not_found", "schedule `{schedule_id}` not found")
+)
+
+type schedule struct {
+	Schedule
+	id       string
+	ids      ttnpb.ApplicationIdentifiers
+	cron     *Cron
+	location *time.Location
+	payload  []byte
+
+	enabled bool
+	next    time.Time
+	history []Run
+}
+
+func newSchedule(ctx context.Context, id string, conf Schedule) (*schedule, error) {
+	s := &schedule{
+		Schedule: conf,
+		id:       id,
+		ids:      ttnpb.ApplicationIdentifiers{ApplicationID: conf.ApplicationID},
+		location: time.UTC,
+		enabled:  conf.Enabled,
+	}
+	if err := s.ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	if len(conf.DeviceIDs) == 0 {
+		return nil, errNoDevices
+	}
+	for _, deviceID := range conf.DeviceIDs {
+		ids := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: s.ids,
+			DeviceID:               deviceID,
+		}
+		if err := ids.ValidateContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var err error
+	if s.cron, err = ParseCron(conf.Cron); err != nil {
+		return nil, err
+	}
+	if conf.Location != "" {
+		if s.location, err = time.LoadLocation(conf.Location); err != nil {
+			return nil, errLocation.WithAttributes("location", conf.Location).WithCause(err)
+		}
+	}
+	if s.payload, err = hex.DecodeString(conf.FRMPayload); err != nil {
+		return nil, errFRMPayload.WithCause(err)
+	}
+	switch conf.Conflict {
+	case "":
+		s.Conflict = ConflictSkipDuplicate
+	case ConflictSkipDuplicate, ConflictSkipQueued, ConflictPush, ConflictReplace:
+	default:
+		return nil, errConflict.WithAttributes("conflict", conf.Conflict)
+	}
+	if err := s.downlink().ValidateFields(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// downlink returns a new downlink message of the schedule.
+func (s *schedule) downlink() *ttnpb.ApplicationDownlink {
+	return &ttnpb.ApplicationDownlink{
+		FPort:          s.FPort,
+		FRMPayload:     append([]byte(nil), s.payload...),
+		Confirmed:      s.Confirmed,
+		CorrelationIDs: []string{fmt.Sprintf("as:schedule:%s", s.id)},
+	}
+}
+
+// skip returns whether the end device with the given queue is skipped.
+func (s *schedule) skip(queue []*ttnpb.ApplicationDownlink) bool {
+	switch s.Conflict {
+	case ConflictSkipQueued:
+		return len(queue) > 0
+	case ConflictSkipDuplicate:
+		for _, item := range queue {
+			if item.FPort == s.FPort && bytes.Equal(item.FRMPayload, s.payload) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// nextRun returns the first run of the schedule after t, or the zero time if the schedule is disabled or uses local
+// time.
+func (s *schedule) nextRun(t time.Time) time.Time {
+	if !s.enabled || s.LocalTime {
+		return time.Time{}
+	}
+	return s.cron.Next(t.In(s.location))
+}
+
+func (s *schedule) status() Status {
+	st := Status{
+		ID:        s.id,
+		DeviceIDs: s.DeviceIDs,
+		Cron:      s.Cron,
+		Location:  s.location.String(),
+		LocalTime: s.LocalTime,
+		Enabled:   s.enabled,
+		History:   append([]Run{}, s.history...),
+	}
+	if !s.next.IsZero() {
+		next := s.next
+		st.Next = &next
+	}
+	return st
+}
+
+// Scheduler runs the downlink schedules.
+type Scheduler struct {
+	ctx         context.Context
+	server      io.Server
+	timeZones   *timeZoneCache
+	historySize int
+
+	mu        sync.RWMutex
+	schedules map[string]*schedule
+}
+
+// New returns a new Scheduler of the given schedules by schedule ID. The Scheduler keeps historySize runs per schedule.
+// The time zones of end devices of schedules with local time are fetched with fetcher. If fetcher is nil, these
+// schedules use their own location.
+func New(ctx context.Context, server io.Server, fetcher TimeZoneFetcher, schedules map[string]Schedule, historySize int) (*Scheduler, error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/schedules")
+	s := &Scheduler{
+		ctx:         ctx,
+		server:      server,
+		timeZones:   newTimeZoneCache(fetcher),
+		historySize: historySize,
+		schedules:   make(map[string]*schedule, len(schedules)),
+	}
+	now := time.Now()
+	for id, conf := range schedules {
+		sch, err := newSchedule(ctx, id, conf)
+		if err != nil {
+			return nil, errSchedule.WithAttributes("schedule_id", id).WithCause(err)
+		}
+		sch.next = sch.nextRun(now)
+		s.schedules[id] = sch
+	}
+	return s, nil
+}
+
+// Run runs the schedules until the context is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	for {
+		now := time.Now()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(now.Truncate(time.Minute).Add(time.Minute).Sub(now)):
+		}
+		s.runDue(ctx, time.Now())
+	}
+}
+
+// runDue runs the enabled schedules of which the next run is due.
+// Schedules with local time run for the end devices of which the local time matches the cron expression.
+func (s *Scheduler) runDue(ctx context.Context, now time.Time) {
+	var due, local []*schedule
+	s.mu.Lock()
+	for _, sch := range s.schedules {
+		switch {
+		case !sch.enabled:
+		case sch.LocalTime:
+			local = append(local, sch)
+		case sch.next.IsZero() || sch.next.After(now):
+		default:
+			due = append(due, sch)
+			sch.next = sch.nextRun(now)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, sch := range due {
+		s.addRun(sch, s.run(ctx, sch, sch.DeviceIDs, now))
+	}
+	for _, sch := range local {
+		var deviceIDs []string
+		for _, deviceID := range sch.DeviceIDs {
+			ids := ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: sch.ids,
+				DeviceID:               deviceID,
+			}
+			if sch.cron.Match(now.In(s.timeZones.Location(ctx, ids, sch.location, now))) {
+				deviceIDs = append(deviceIDs, deviceID)
+			}
+		}
+		if len(deviceIDs) > 0 {
+			s.addRun(sch, s.run(ctx, sch, deviceIDs, now))
+		}
+	}
+}
+
+// addRun adds the run to the history of the schedule.
+func (s *Scheduler) addRun(sch *schedule, run Run) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sch.history = append([]Run{run}, sch.history...)
+	if len(sch.history) > s.historySize {
+		sch.history = sch.history[:s.historySize]
+	}
+}
+
+// run pushes the downlink message of the schedule to the given end devices.
+func (s *Scheduler) run(ctx context.Context, sch *schedule, deviceIDs []string, now time.Time) Run {
+	logger := log.FromContext(ctx).WithField("schedule_id", sch.id)
+	run := Run{
+		Time: now,
+	}
+	for _, deviceID := range deviceIDs {
+		ids := ttnpb.EndDeviceIdentifiers{
+			ApplicationIdentifiers: sch.ids,
+			DeviceID:               deviceID,
+		}
+		items := []*ttnpb.ApplicationDownlink{sch.downlink()}
+		var err error
+		switch sch.Conflict {
+		case ConflictReplace:
+			err = s.server.DownlinkQueueReplace(ctx, ids, items)
+		case ConflictPush:
+			err = s.server.DownlinkQueuePush(ctx, ids, items)
+		default:
+			var queue []*ttnpb.ApplicationDownlink
+			if queue, err = s.server.DownlinkQueueList(ctx, ids); err == nil {
+				if sch.skip(queue) {
+					run.Skipped = append(run.Skipped, deviceID)
+					continue
+				}
+				err = s.server.DownlinkQueuePush(ctx, ids, items)
+			}
+		}
+		if err != nil {
+			logger.WithField("device_id", deviceID).WithError(err).Warn("Failed to push scheduled downlink message")
+			if run.Failed == nil {
+				run.Failed = make(map[string]string)
+			}
+			run.Failed[deviceID] = err.Error()
+			continue
+		}
+		run.Pushed = append(run.Pushed, deviceID)
+	}
+	logger.WithFields(log.Fields(
+		"pushed", len(run.Pushed),
+		"skipped", len(run.Skipped),
+		"failed", len(run.Failed),
+	)).Debug("Ran schedule")
+	return run
+}
+
+// List returns the status of the schedules of the application, ordered by schedule ID.
+func (s *Scheduler) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers) []Status {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := make([]Status, 0, len(s.schedules))
+	for _, sch := range s.schedules {
+		if sch.ids != ids {
+			continue
+		}
+		res = append(res, sch.status())
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	return res
+}
+
+// SetEnabled enables or disables the schedule of the application.
+// The state is kept in memory; when the Application Server restarts, the schedule is enabled as configured.
+func (s *Scheduler) SetEnabled(ctx context.Context, ids ttnpb.ApplicationIdentifiers, id string, enabled bool) (Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sch, ok := s.schedules[id]
+	if !ok || sch.ids != ids {
+		return Status{}, errScheduleNotFound.WithAttributes("schedule_id", id)
+	}
+	if sch.enabled != enabled {
+		sch.enabled = enabled
+		sch.next = sch.nextRun(time.Now())
+	}
+	return sch.status(), nil
+}
@@ -0,0 +1,303 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedules
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+var errQueue = errors.DefineUnavailable("queue", "queue unavailable")
+
+type mockServer struct {
+	io.Server
+	queues map[string][]*ttnpb.ApplicationDownlink
+}
+
+func (s *mockServer) DownlinkQueuePush(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	if ids.DeviceID == "failing-device" {
+		return errQueue
+	}
+	s.queues[ids.DeviceID] = append(s.queues[ids.DeviceID], items...)
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueReplace(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink) error {
+	s.queues[ids.DeviceID] = items
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueList(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.ApplicationDownlink, error) {
+	return s.queues[ids.DeviceID], nil
+}
+
+func TestScheduler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	server := &mockServer{
+		queues: map[string][]*ttnpb.ApplicationDownlink{
+			"queued-device": {
+				{FPort: 10, FRMPayload: []byte{0x02}},
+			},
+			"duplicate-device": {
+				{FPort: 10, FRMPayload: []byte{0x01}},
+			},
+		},
+	}
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+	s, err := New(ctx, server, nil, map[string]Schedule{
+		"sync": {
+			Enabled:       true,
+			ApplicationID: "foo-app",
+			DeviceIDs:     []string{"empty-device", "queued-device", "duplicate-device", "failing-device"},
+			Cron:          "0 3 * * *",
+			FPort:         10,
+			FRMPayload:    "01",
+		},
+		"other": {
+			ApplicationID: "bar-app",
+			DeviceIDs:     []string{"bar-device"},
+			Cron:          "@hourly",
+			FPort:         1,
+		},
+	}, 2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	statuses := s.List(ctx, appIDs)
+	if !a.So(statuses, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(statuses[0].ID, should.Equal, "sync")
+	a.So(statuses[0].Enabled, should.BeTrue)
+	a.So(statuses[0].Next, should.NotBeNil)
+
+	// Schedules that are not due do not run.
+	sch := s.schedules["sync"]
+	at := time.Date(2020, time.January, 15, 3, 0, 0, 0, time.UTC)
+	sch.next = at
+	s.runDue(ctx, at.Add(-time.Minute))
+	a.So(sch.history, should.BeEmpty)
+
+	s.runDue(ctx, at)
+	if !a.So(sch.history, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	run := sch.history[0]
+	a.So(run.Pushed, should.Resemble, []string{"empty-device", "queued-device"})
+	a.So(run.Skipped, should.Resemble, []string{"duplicate-device"})
+	a.So(run.Failed, should.ContainKey, "failing-device")
+	a.So(sch.next, should.Equal, time.Date(2020, time.January, 16, 3, 0, 0, 0, time.UTC))
+	a.So(server.queues["empty-device"], should.HaveLength, 1)
+	a.So(server.queues["queued-device"], should.HaveLength, 2)
+	a.So(server.queues["duplicate-device"], should.HaveLength, 1)
+
+	// The history is limited.
+	for i := 0; i < 3; i++ {
+		sch.next = at
+		s.runDue(ctx, at)
+	}
+	a.So(sch.history, should.HaveLength, 2)
+
+	// Disabled schedules do not run.
+	status, err := s.SetEnabled(ctx, appIDs, "sync", false)
+	a.So(err, should.BeNil)
+	a.So(status.Enabled, should.BeFalse)
+	a.So(status.Next, should.BeNil)
+	s.runDue(ctx, at.Add(24*time.Hour))
+	a.So(sch.history[0].Time, should.Equal, at)
+
+	status, err = s.SetEnabled(ctx, appIDs, "sync", true)
+	a.So(err, should.BeNil)
+	a.So(status.Next, should.NotBeNil)
+
+	_, err = s.SetEnabled(ctx, appIDs, "other", true)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestSchedulerConflictReplace(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	server := &mockServer{
+		queues: map[string][]*ttnpb.ApplicationDownlink{
+			"foo-device": {
+				{FPort: 10, FRMPayload: []byte{0x02}},
+			},
+		},
+	}
+	s, err := New(ctx, server, nil, map[string]Schedule{
+		"sync": {
+			Enabled:       true,
+			ApplicationID: "foo-app",
+			DeviceIDs:     []string{"foo-device"},
+			Cron:          "@daily",
+			FPort:         10,
+			FRMPayload:    "01",
+			Conflict:      ConflictReplace,
+		},
+	}, 10)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	at := time.Date(2020, time.January, 15, 0, 0, 0, 0, time.UTC)
+	s.schedules["sync"].next = at
+	s.runDue(ctx, at)
+	if a.So(server.queues["foo-device"], should.HaveLength, 1) {
+		a.So(server.queues["foo-device"][0].FRMPayload, should.Resemble, []byte{0x01})
+		a.So(server.queues["foo-device"][0].CorrelationIDs, should.Contain, "as:schedule:sync")
+	}
+}
+
+type mockTimeZoneFetcher struct {
+	endDevices   map[string]string
+	applications map[string]string
+}
+
+func (f *mockTimeZoneFetcher) EndDeviceTimeZone(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (string, error) {
+	return f.endDevices[ids.DeviceID], nil
+}
+
+func (f *mockTimeZoneFetcher) ApplicationTimeZone(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (string, error) {
+	return f.applications[ids.ApplicationID], nil
+}
+
+func TestSchedulerLocalTime(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	server := &mockServer{
+		queues: make(map[string][]*ttnpb.ApplicationDownlink),
+	}
+	fetcher := &mockTimeZoneFetcher{
+		endDevices: map[string]string{
+			"amsterdam-device": "Europe/Amsterdam",
+			"new-york-device":  "America/New_York",
+		},
+		applications: map[string]string{
+			"foo-app": "Asia/Tokyo",
+		},
+	}
+	s, err := New(ctx, server, fetcher, map[string]Schedule{
+		"sync": {
+			Enabled:       true,
+			ApplicationID: "foo-app",
+			DeviceIDs:     []string{"amsterdam-device", "new-york-device", "tokyo-device"},
+			Cron:          "0 3 * * *",
+			LocalTime:     true,
+			FPort:         10,
+			FRMPayload:    "01",
+		},
+	}, 10)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	sch := s.schedules["sync"]
+	a.So(sch.status().Next, should.BeNil)
+
+	for _, tc := range []struct {
+		At     time.Time
+		Pushed []string
+	}{
+		{
+			// 03:00 in Tokyo, the time zone of the application.
+			At:     time.Date(2020, time.January, 14, 18, 0, 0, 0, time.UTC),
+			Pushed: []string{"tokyo-device"},
+		},
+		{
+			At: time.Date(2020, time.January, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// 03:00 in Amsterdam.
+			At:     time.Date(2020, time.January, 15, 2, 0, 0, 0, time.UTC),
+			Pushed: []string{"amsterdam-device"},
+		},
+		{
+			// 03:00 in New York.
+			At:     time.Date(2020, time.January, 15, 8, 0, 0, 0, time.UTC),
+			Pushed: []string{"new-york-device"},
+		},
+	} {
+		history := len(sch.history)
+		s.runDue(ctx, tc.At)
+		if tc.Pushed == nil {
+			a.So(sch.history, should.HaveLength, history)
+			continue
+		}
+		if a.So(sch.history, should.HaveLength, history+1) {
+			a.So(sch.history[0].Pushed, should.Resemble, tc.Pushed)
+		}
+	}
+}
+
+func TestNewSchedulerInvalid(t *testing.T) {
+	valid := Schedule{
+		ApplicationID: "foo-app",
+		DeviceIDs:     []string{"foo-device"},
+		Cron:          "@daily",
+		FPort:         1,
+	}
+	for _, tc := range []struct {
+		Name   string
+		Modify func(*Schedule)
+	}{
+		{
+			Name:   "NoDevices",
+			Modify: func(s *Schedule) { s.DeviceIDs = nil },
+		},
+		{
+			Name:   "InvalidDevice",
+			Modify: func(s *Schedule) { s.DeviceIDs = []string{"_"} },
+		},
+		{
+			Name:   "InvalidCron",
+			Modify: func(s *Schedule) { s.Cron = "every day" },
+		},
+		{
+			Name:   "InvalidLocation",
+			Modify: func(s *Schedule) { s.Location = "Nowhere/Nothing" },
+		},
+		{
+			Name:   "InvalidPayload",
+			Modify: func(s *Schedule) { s.FRMPayload = "xyz" },
+		},
+		{
+			Name:   "InvalidFPort",
+			Modify: func(s *Schedule) { s.FPort = 0 },
+		},
+		{
+			Name:   "InvalidConflict",
+			Modify: func(s *Schedule) { s.Conflict = "ignore" },
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			conf := valid
+			tc.Modify(&conf)
+			_, err := New(test.Context(), &mockServer{}, nil, map[string]Schedule{"test": conf}, 10)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedules
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// TimeZoneFetcher fetches the time zones of end devices and applications.
+// The time zones are IANA time zone names, or empty if the entity has no time zone.
+type TimeZoneFetcher interface {
+	EndDeviceTimeZone(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (string, error)
+	ApplicationTimeZone(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (string, error)
+}
+
+// timeZoneTTL is the duration for which fetched time zones are cached.
+const timeZoneTTL = time.Hour
+
+type timeZoneEntry struct {
+	name      string
+	expiresAt time.Time
+}
+
+// timeZoneCache keeps the time zones of end devices and applications in memory, so that the Identity Server is not
+// queried for every run.
+type timeZoneCache struct {
+	fetcher TimeZoneFetcher
+
+	mu      sync.Mutex
+	entries map[string]timeZoneEntry
+}
+
+func newTimeZoneCache(fetcher TimeZoneFetcher) *timeZoneCache {
+	return &timeZoneCache{
+		fetcher: fetcher,
+		entries: make(map[string]timeZoneEntry),
+	}
+}
+
+// get returns the cached time zone by key, or fetches it when it is expired.
+// If the time zone cannot be fetched, the last known time zone is used.
+func (c *timeZoneCache) get(ctx context.Context, key string, now time.Time, fetch func() (string, error)) string {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.name
+	}
+	name, err := fetch()
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get time zone")
+		name = entry.name
+	}
+	c.mu.Lock()
+	c.entries[key] = timeZoneEntry{
+		name:      name,
+		expiresAt: now.Add(timeZoneTTL),
+	}
+	c.mu.Unlock()
+	return name
+}
+
+// Location returns the location of the end device: the time zone of the end device, the time zone of the application,
+// or the given fallback location if neither has a valid time zone.
+func (c *timeZoneCache) Location(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, fallback *time.Location, now time.Time) *time.Location {
+	if c.fetcher == nil {
+		return fallback
+	}
+	name := c.get(ctx, unique.ID(ctx, ids), now, func() (string, error) {
+		return c.fetcher.EndDeviceTimeZone(ctx, ids)
+	})
+	if name == "" {
+		name = c.get(ctx, unique.ID(ctx, ids.ApplicationIdentifiers), now, func() (string, error) {
+			return c.fetcher.ApplicationTimeZone(ctx, ids.ApplicationIdentifiers)
+		})
+	}
+	if name == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).WithField("time_zone", name).Warn("Invalid time zone")
+		return fallback
+	}
+	return loc
+}
@@ -0,0 +1,104 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedules
+
+import (
+	"net/http"
+	"strings"
+
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	web_errors "go.thethings.network/lorawan-stack/pkg/errors/web"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/web"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	applicationIDKey = "application_id"
+	scheduleIDKey    = "schedule_id"
+)
+
+// RegisterRoutes implements web.Registerer.
+func (s *Scheduler) RegisterRoutes(server *web.Server) {
+	group := server.Group(ttnpb.HTTPAPIPrefix+"/as/applications/:application_id/downlink-schedules", s.handleError())
+	group.GET("", s.handleList, s.requireApplicationRights(ttnpb.RIGHT_APPLICATION_TRAFFIC_READ))
+	group.POST("/:schedule_id/enable", func(c echo.Context) error {
+		return s.handleSetEnabled(c, true)
+	}, s.requireApplicationRights(ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE))
+	group.POST("/:schedule_id/disable", func(c echo.Context) error {
+		return s.handleSetEnabled(c, false)
+	}, s.requireApplicationRights(ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE))
+}
+
+func (s *Scheduler) handleError() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+			if err == nil || c.Response().Committed {
+				return err
+			}
+			log.FromContext(s.ctx).WithError(err).Debug("HTTP request failed")
+			statusCode, err := web_errors.ProcessError(err)
+			if strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "application/json") {
+				return c.JSON(statusCode, err)
+			}
+			return c.String(statusCode, err.Error())
+		}
+	}
+}
+
+func (s *Scheduler) requireApplicationRights(required ...ttnpb.Right) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := s.server.FillContext(c.Request().Context())
+			appID := ttnpb.ApplicationIdentifiers{
+				ApplicationID: c.Param(applicationIDKey),
+			}
+			if err := appID.ValidateContext(ctx); err != nil {
+				return err
+			}
+			md := metadata.New(map[string]string{
+				"id":            appID.ApplicationID,
+				"authorization": c.Request().Header.Get(echo.HeaderAuthorization),
+			})
+			if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
+				md = metadata.Join(ctxMd, md)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+			if err := rights.RequireApplication(ctx, appID, required...); err != nil {
+				return err
+			}
+			c.Set(applicationIDKey, appID)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+func (s *Scheduler) handleList(c echo.Context) error {
+	appID := c.Get(applicationIDKey).(ttnpb.ApplicationIdentifiers)
+	return c.JSON(http.StatusOK, s.List(c.Request().Context(), appID))
+}
+
+func (s *Scheduler) handleSetEnabled(c echo.Context, enabled bool) error {
+	appID := c.Get(applicationIDKey).(ttnpb.ApplicationIdentifiers)
+	status, err := s.SetEnabled(c.Request().Context(), appID, c.Param(scheduleIDKey), enabled)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, status)
+}
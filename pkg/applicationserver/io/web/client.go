@@ -0,0 +1,110 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var (
+	errReadRootCA    = errors.DefineInvalidArgument("read_root_ca", "read root CA `{file}`")
+	errInvalidCA     = errors.DefineInvalidArgument("invalid_root_ca", "no valid certificates found in root CA `{file}`")
+	errInvalidProxy  = errors.DefineInvalidArgument("invalid_proxy", "invalid proxy URL `{proxy}`")
+	errMissingHost   = errors.DefineInvalidArgument("missing_host", "missing host in HTTP client configuration")
+	errDuplicateHost = errors.DefineInvalidArgument("duplicate_host", "duplicate HTTP client configuration for host `{host}`")
+)
+
+// HTTPClientConfig is the configuration of the HTTP client that delivers webhooks.
+type HTTPClientConfig struct {
+	RootCA string `name:"root-ca" description:"Location of PEM encoded CA certificates to trust in addition to the system roots (optional)"`
+	Proxy  string `name:"proxy" description:"URL of the HTTP(S) proxy to use; if empty, the proxy is taken from the environment (optional)"`
+}
+
+// IsZero returns whether the HTTPClientConfig is empty.
+func (c HTTPClientConfig) IsZero() bool {
+	return c.RootCA == "" && c.Proxy == ""
+}
+
+// NewClient returns a new HTTP client based on the configuration.
+func (c HTTPClientConfig) NewClient(timeout time.Duration) (*http.Client, error) {
+	tr := http.DefaultTransport.(*http.Transport).Clone()
+	if c.RootCA != "" {
+		pem, err := ioutil.ReadFile(c.RootCA)
+		if err != nil {
+			return nil, errReadRootCA.WithCause(err).WithAttributes("file", c.RootCA)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, errInvalidCA.WithAttributes("file", c.RootCA)
+		}
+		tr.TLSClientConfig = &tls.Config{
+			RootCAs: rootCAs,
+		}
+	}
+	if c.Proxy != "" {
+		proxyURL, err := url.Parse(c.Proxy)
+		if err != nil {
+			return nil, errInvalidProxy.WithCause(err).WithAttributes("proxy", c.Proxy)
+		}
+		if proxyURL.Host == "" {
+			return nil, errInvalidProxy.WithAttributes("proxy", c.Proxy)
+		}
+		tr.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{
+		Transport: tr,
+		Timeout:   timeout,
+	}, nil
+}
+
+// HostHTTPClientConfig is the configuration of the HTTP client that delivers webhooks to a host.
+type HostHTTPClientConfig struct {
+	Host             string `name:"host" description:"Host name of the webhook endpoints"`
+	HTTPClientConfig `name:",squash"`
+}
+
+// HostClients returns the HTTP clients per host for the given configuration per host.
+func HostClients(hosts []HostHTTPClientConfig, timeout time.Duration) (map[string]*http.Client, error) {
+	if len(hosts) == 0 {
+		return nil, nil
+	}
+	clients := make(map[string]*http.Client, len(hosts))
+	for _, conf := range hosts {
+		host := strings.ToLower(conf.Host)
+		if host == "" {
+			return nil, errMissingHost
+		}
+		if _, ok := clients[host]; ok {
+			return nil, errDuplicateHost.WithAttributes("host", host)
+		}
+		client, err := conf.NewClient(timeout)
+		if err != nil {
+			return nil, err
+		}
+		clients[host] = client
+	}
+	return clients, nil
+}
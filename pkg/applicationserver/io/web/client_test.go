@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestHTTPClientConfig(t *testing.T) {
+	a := assertions.New(t)
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	t.Run("RootCA", func(t *testing.T) {
+		a := assertions.New(t)
+
+		client, err := web.HTTPClientConfig{}.NewClient(time.Second)
+		a.So(err, should.BeNil)
+		_, err = client.Get(srv.URL)
+		a.So(err, should.NotBeNil)
+
+		f, err := ioutil.TempFile("", "root-ca")
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		defer os.Remove(f.Name())
+		pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+		f.Close()
+
+		client, err = web.HTTPClientConfig{RootCA: f.Name()}.NewClient(time.Second)
+		a.So(err, should.BeNil)
+		res, err := client.Get(srv.URL)
+		if a.So(err, should.BeNil) {
+			res.Body.Close()
+			a.So(res.StatusCode, should.Equal, http.StatusNoContent)
+		}
+
+		_, err = web.HTTPClientConfig{RootCA: "/does/not/exist"}.NewClient(time.Second)
+		a.So(err, should.NotBeNil)
+	})
+
+	t.Run("Proxy", func(t *testing.T) {
+		a := assertions.New(t)
+
+		proxied := make(chan string, 1)
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied <- r.URL.String()
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer proxy.Close()
+
+		client, err := web.HTTPClientConfig{Proxy: proxy.URL}.NewClient(time.Second)
+		a.So(err, should.BeNil)
+		res, err := client.Get("http://webhooks.example.com/up")
+		if a.So(err, should.BeNil) {
+			res.Body.Close()
+			a.So(res.StatusCode, should.Equal, http.StatusOK)
+		}
+		select {
+		case u := <-proxied:
+			a.So(u, should.Equal, "http://webhooks.example.com/up")
+		default:
+			t.Fatal("Expected request to be proxied")
+		}
+
+		_, err = web.HTTPClientConfig{Proxy: "not-a-url"}.NewClient(time.Second)
+		a.So(err, should.NotBeNil)
+	})
+
+	a.So(web.HTTPClientConfig{}.IsZero(), should.BeTrue)
+}
+
+func TestHTTPClientSinkHostClients(t *testing.T) {
+	a := assertions.New(t)
+
+	hosts := make(chan string, 2)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts <- r.URL.Hostname()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	hostClients, err := web.HostClients([]web.HostHTTPClientConfig{
+		{
+			Host:             "Proxied.Example.com",
+			HTTPClientConfig: web.HTTPClientConfig{Proxy: proxy.URL},
+		},
+	}, time.Second)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	sink := &web.HTTPClientSink{
+		Client:      http.DefaultClient,
+		HostClients: hostClients,
+	}
+	req, _ := http.NewRequest(http.MethodPost, "http://proxied.example.com/up", nil)
+	a.So(sink.Process(req), should.BeNil)
+	select {
+	case host := <-hosts:
+		a.So(host, should.Equal, "proxied.example.com")
+	default:
+		t.Fatal("Expected request to be proxied")
+	}
+}
+
+func TestHostClients(t *testing.T) {
+	a := assertions.New(t)
+
+	clients, err := web.HostClients(nil, time.Second)
+	a.So(err, should.BeNil)
+	a.So(clients, should.BeEmpty)
+
+	_, err = web.HostClients([]web.HostHTTPClientConfig{
+		{HTTPClientConfig: web.HTTPClientConfig{Proxy: "http://proxy.example.com"}},
+	}, time.Second)
+	a.So(err, should.NotBeNil)
+
+	_, err = web.HostClients([]web.HostHTTPClientConfig{
+		{Host: "example.com"},
+		{Host: "Example.com"},
+	}, time.Second)
+	a.So(err, should.NotBeNil)
+
+	clients, err = web.HostClients([]web.HostHTTPClientConfig{
+		{Host: "example.com"},
+		{Host: "192.168.0.1"},
+	}, time.Second)
+	a.So(err, should.BeNil)
+	a.So(clients, should.ContainKey, "example.com")
+	a.So(clients, should.ContainKey, "192.168.0.1")
+}
@@ -0,0 +1,35 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type webhookIDKeyType struct{}
+
+var webhookIDCtxKey webhookIDKeyType
+
+func withWebhookID(req *http.Request, ids ttnpb.ApplicationWebhookIdentifiers) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), webhookIDCtxKey, ids))
+}
+
+func webhookIDFromRequest(req *http.Request) (ttnpb.ApplicationWebhookIdentifiers, bool) {
+	ids, ok := req.Context().Value(webhookIDCtxKey).(ttnpb.ApplicationWebhookIdentifiers)
+	return ids, ok
+}
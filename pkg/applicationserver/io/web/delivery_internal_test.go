@@ -0,0 +1,107 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockDeliveryRegistry struct {
+	added []*ttnpb.ApplicationWebhookDelivery
+	ids   []ttnpb.ApplicationWebhookIdentifiers
+}
+
+func (r *mockDeliveryRegistry) Add(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, delivery *ttnpb.ApplicationWebhookDelivery, size int) error {
+	r.ids = append(r.ids, ids)
+	r.added = append(r.added, delivery)
+	return nil
+}
+
+func (r *mockDeliveryRegistry) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	return r.added, nil
+}
+
+func (r *mockDeliveryRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	r.added, r.ids = nil, nil
+	return nil
+}
+
+func TestHTTPClientSinkDeliveries(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(strings.Repeat("x", 2*MaxDeliveryResponseSize)))
+	}))
+	defer srv.Close()
+
+	registry := &mockDeliveryRegistry{}
+	sink := &HTTPClientSink{
+		Client:        http.DefaultClient,
+		Deliveries:    registry,
+		MaxDeliveries: 2,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		WebhookID:              "foo-hook",
+	}
+
+	// Requests that are not for a webhook are not stored.
+	req, err := http.NewRequest(http.MethodPost, srv.URL+"/up", nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(sink.Process(req.WithContext(ctx)), should.NotBeNil)
+	a.So(registry.added, should.BeEmpty)
+
+	req, err = http.NewRequest(http.MethodPost, srv.URL+"/up", nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(sink.Process(withWebhookID(req.WithContext(ctx), ids)), should.NotBeNil)
+	if !a.So(registry.added, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(registry.ids[0], should.Resemble, ids)
+	delivery := registry.added[0]
+	a.So(delivery.URL, should.Equal, srv.URL+"/up")
+	a.So(delivery.StatusCode, should.Equal, http.StatusBadRequest)
+	a.So(delivery.Response, should.HaveLength, MaxDeliveryResponseSize)
+	a.So(delivery.Error, should.BeEmpty)
+
+	// Failed requests are stored with their error.
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+	req, err = http.NewRequest(http.MethodPost, closed.URL+"/up", nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(sink.Process(withWebhookID(req.WithContext(ctx), ids)), should.NotBeNil)
+	if !a.So(registry.added, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(registry.added[1].StatusCode, should.Equal, 0)
+	a.So(registry.added[1].Error, should.NotBeEmpty)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io/web/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestDeliveryRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+	registry := &redis.DeliveryRegistry{Redis: redisClient}
+
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	otherIDs := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              "other",
+	}
+
+	deliveries, err := registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(deliveries, should.BeEmpty)
+
+	now := time.Now().UTC()
+	for i := 0; i < 5; i++ {
+		err := registry.Add(ctx, ids, &ttnpb.ApplicationWebhookDelivery{
+			Time:       now.Add(time.Duration(i) * time.Second),
+			StatusCode: uint32(200 + i),
+			Latency:    time.Duration(i) * time.Millisecond,
+		}, 3)
+		a.So(err, should.BeNil)
+	}
+	err = registry.Add(ctx, otherIDs, &ttnpb.ApplicationWebhookDelivery{
+		Time:  now,
+		Error: "connection refused",
+	}, 3)
+	a.So(err, should.BeNil)
+
+	deliveries, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	if !a.So(deliveries, should.HaveLength, 3) {
+		t.FailNow()
+	}
+	for i, d := range deliveries {
+		a.So(d.StatusCode, should.Equal, 204-i)
+		a.So(d.Time, should.Equal, now.Add(time.Duration(4-i)*time.Second))
+		a.So(d.Latency, should.Equal, time.Duration(4-i)*time.Millisecond)
+	}
+
+	a.So(registry.Delete(ctx, ids), should.BeNil)
+	deliveries, err = registry.List(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(deliveries, should.BeEmpty)
+
+	deliveries, err = registry.List(ctx, otherIDs)
+	a.So(err, should.BeNil)
+	a.So(deliveries, should.Resemble, []*ttnpb.ApplicationWebhookDelivery{
+		{
+			Time:  now,
+			Error: "connection refused",
+		},
+	})
+}
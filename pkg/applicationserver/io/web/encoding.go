@@ -0,0 +1,53 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"compress/gzip"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// contentEncodings are the content encodings that compress the body of webhook requests.
+var contentEncodings = map[string]func([]byte) ([]byte, error){
+	"gzip": encodeGzip,
+}
+
+var errContentEncodingNotFound = errors.DefineNotFound("content_encoding_not_found", "content encoding `{content_encoding}` not found")
+
+func encodeGzip(buf []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write(buf); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// encodeBody compresses the body with the content encoding. The body is not compressed if the content encoding is empty.
+func encodeBody(contentEncoding string, buf []byte) ([]byte, error) {
+	if contentEncoding == "" {
+		return buf, nil
+	}
+	encode, ok := contentEncodings[contentEncoding]
+	if !ok {
+		return nil, errContentEncodingNotFound.WithAttributes("content_encoding", contentEncoding)
+	}
+	return encode(buf)
+}
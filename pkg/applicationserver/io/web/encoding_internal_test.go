@@ -0,0 +1,50 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEncodeBody(t *testing.T) {
+	a := assertions.New(t)
+	body := []byte(`{"uplink_message":{"f_port":42}}`)
+
+	buf, err := encodeBody("", body)
+	a.So(err, should.BeNil)
+	a.So(buf, should.Resemble, body)
+
+	buf, err = encodeBody("gzip", body)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	r, err := gzip.NewReader(bytes.NewReader(buf))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	decoded, err := ioutil.ReadAll(r)
+	a.So(err, should.BeNil)
+	a.So(decoded, should.Resemble, body)
+
+	_, err = encodeBody("unknown", body)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
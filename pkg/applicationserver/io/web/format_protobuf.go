@@ -20,6 +20,6 @@ func init() {
 	formats["protobuf"] = Format{
 		Formatter:   formatters.Protobuf,
 		Name:        "Protocol Buffers",
-		ContentType: "application/octet-stream",
+		ContentType: "application/x-protobuf",
 	}
 }
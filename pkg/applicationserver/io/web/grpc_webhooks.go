@@ -38,15 +38,18 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 }
 
 type webhookRegistryRPC struct {
-	webhooks  WebhookRegistry
-	templates *TemplateStore
+	webhooks   WebhookRegistry
+	templates  *TemplateStore
+	deliveries DeliveryRegistry
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates *TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// If deliveries is nil, no delivery attempts are listed.
+func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates *TemplateStore, deliveries DeliveryRegistry) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:   webhooks,
+		templates:  templates,
+		deliveries: deliveries,
 	}
 }
 
@@ -130,5 +133,26 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	if s.deliveries != nil {
+		if err := s.deliveries.Delete(ctx, *req); err != nil {
+			return nil, err
+		}
+	}
 	return ttnpb.Empty, nil
 }
+
+func (s webhookRegistryRPC) ListWebhookDeliveries(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return &ttnpb.ApplicationWebhookDeliveries{}, nil
+	}
+	deliveries, err := s.deliveries.List(ctx, *req)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
@@ -17,6 +17,7 @@ package web_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	pbtypes "github.com/gogo/protobuf/types"
@@ -55,7 +56,8 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	defer flush()
 	defer redisClient.Close()
 	webhookReg := &redis.WebhookRegistry{Redis: redisClient}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	deliveryReg := &redis.DeliveryRegistry{Redis: redisClient}
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, deliveryReg)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -74,6 +76,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		res, err := client.GetFormats(ctx, ttnpb.Empty, creds)
 		a.So(err, should.BeNil)
 		a.So(res.Formats, should.HaveSameElementsDeep, map[string]string{
+			"influxdb": "InfluxDB line protocol",
 			"json":     "JSON",
 			"protobuf": "Protocol Buffers",
 		})
@@ -136,6 +139,31 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(res.BaseURL, should.Equal, "http://localhost/test")
 	}
 
+	// List deliveries; assert most recent first.
+	{
+		hookIDs := ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		}
+		now := time.Now().UTC()
+		for i, code := range []uint32{200, 500} {
+			err := deliveryReg.Add(ctx, hookIDs, &ttnpb.ApplicationWebhookDelivery{
+				Time:       now.Add(time.Duration(i) * time.Second),
+				URL:        "http://localhost/test/up",
+				StatusCode: code,
+			}, 20)
+			a.So(err, should.BeNil)
+		}
+		res, err := client.ListWebhookDeliveries(ctx, &hookIDs, creds)
+		a.So(err, should.BeNil)
+		if a.So(res.Deliveries, should.HaveLength, 2) {
+			a.So(res.Deliveries[0].StatusCode, should.Equal, 500)
+			a.So(res.Deliveries[0].Time, should.Equal, now.Add(time.Second))
+			a.So(res.Deliveries[1].StatusCode, should.Equal, 200)
+			a.So(res.Deliveries[1].URL, should.Equal, "http://localhost/test/up")
+		}
+	}
+
 	// Delete.
 	{
 		_, err := client.Delete(ctx, &ttnpb.ApplicationWebhookIdentifiers{
@@ -156,6 +184,16 @@ func TestWebhookRegistryRPC(t *testing.T) {
 		a.So(err, should.BeNil)
 		a.So(res.Webhooks, should.BeEmpty)
 	}
+
+	// Check deliveries pruned.
+	{
+		res, err := client.ListWebhookDeliveries(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+			ApplicationIdentifiers: registeredApplicationID,
+			WebhookID:              registeredWebhookID,
+		}, creds)
+		a.So(err, should.BeNil)
+		a.So(res.Deliveries, should.BeEmpty)
+	}
 }
 
 func TestTemplateStoreRPC(t *testing.T) {
@@ -234,7 +272,7 @@ description: Bar`),
 			a.So(err, should.BeNil)
 
 			c := componenttest.NewComponent(t, &component.Config{})
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
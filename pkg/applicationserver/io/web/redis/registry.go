@@ -218,3 +218,50 @@ func (r WebhookRegistry) Set(ctx context.Context, ids ttnpb.ApplicationWebhookId
 	}
 	return pb, nil
 }
+
+// DeliveryRegistry is a Redis registry of the most recent delivery attempts of webhooks.
+// The delivery attempts of a webhook are stored in a list, most recent first.
+type DeliveryRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DeliveryRegistry) key(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids.ApplicationIdentifiers), ids.WebhookID)
+}
+
+// Add implements DeliveryRegistry.
+func (r DeliveryRegistry) Add(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, delivery *ttnpb.ApplicationWebhookDelivery, size int) error {
+	s, err := ttnredis.MarshalProto(delivery)
+	if err != nil {
+		return err
+	}
+	k := r.key(ctx, ids)
+	_, err = r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		p.LTrim(k, 0, int64(size-1))
+		return nil
+	})
+	return ttnredis.ConvertError(err)
+}
+
+// List implements DeliveryRegistry.
+func (r DeliveryRegistry) List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]*ttnpb.ApplicationWebhookDelivery, error) {
+	values, err := r.Redis.LRange(r.key(ctx, ids), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(values))
+	for _, s := range values {
+		pb := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// Delete implements DeliveryRegistry.
+func (r DeliveryRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error {
+	return ttnredis.ConvertError(r.Redis.Del(r.key(ctx, ids)).Err())
+}
@@ -29,3 +29,13 @@ type WebhookRegistry interface {
 	// Set creates, updates or deletes the webhook by its identifiers.
 	Set(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, paths []string, f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error)) (*ttnpb.ApplicationWebhook, error)
 }
+
+// DeliveryRegistry is a store for the most recent delivery attempts of webhooks.
+type DeliveryRegistry interface {
+	// Add adds the delivery attempt of the webhook, and removes the oldest delivery attempts so that at most size are kept.
+	Add(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, delivery *ttnpb.ApplicationWebhookDelivery, size int) error
+	// List returns the delivery attempts of the webhook, most recent first.
+	List(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) ([]*ttnpb.ApplicationWebhookDelivery, error)
+	// Delete deletes the delivery attempts of the webhook.
+	Delete(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers) error
+}
@@ -24,6 +24,7 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"time"
 
 	echo "github.com/labstack/echo/v4"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
@@ -54,26 +55,67 @@ type ControllableSink interface {
 // HTTPClientSink contains an HTTP client to make outgoing requests.
 type HTTPClientSink struct {
 	*http.Client
+	// HostClients are the HTTP clients to use per host, instead of the default client.
+	HostClients map[string]*http.Client
+	// Deliveries is the registry of delivery attempts. If nil, delivery attempts are not stored.
+	Deliveries DeliveryRegistry
+	// MaxDeliveries is the number of delivery attempts to keep per webhook.
+	MaxDeliveries int
 }
 
 var errRequest = errors.DefineUnavailable("request", "request failed with status `{code}`")
 
+// MaxDeliveryResponseSize is the maximum size of the response body that is stored with a delivery attempt.
+const MaxDeliveryResponseSize = 1024
+
 // Process uses the HTTP client to perform the request.
+// If there is an HTTP client for the host of the request, that client is used.
+// If the request is made for a webhook, the delivery attempt is stored in the delivery registry.
 func (s *HTTPClientSink) Process(req *http.Request) error {
-	res, err := s.Do(req)
+	client := s.Client
+	if hostClient, ok := s.HostClients[strings.ToLower(req.URL.Hostname())]; ok {
+		client = hostClient
+	}
+	hookID, addDelivery := webhookIDFromRequest(req)
+	addDelivery = addDelivery && s.Deliveries != nil && s.MaxDeliveries > 0
+	delivery := &ttnpb.ApplicationWebhookDelivery{
+		Time: time.Now().UTC(),
+		URL:  req.URL.String(),
+	}
+	res, err := client.Do(req)
+	delivery.Latency = time.Since(delivery.Time)
 	if err != nil {
+		if addDelivery {
+			delivery.Error = err.Error()
+			s.addDelivery(req.Context(), hookID, delivery)
+		}
 		return err
 	}
 	defer func() {
 		stdio.Copy(ioutil.Discard, res.Body)
 		res.Body.Close()
 	}()
+	if addDelivery {
+		delivery.StatusCode = uint32(res.StatusCode)
+		body, err := ioutil.ReadAll(stdio.LimitReader(res.Body, MaxDeliveryResponseSize))
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		delivery.Response = string(body)
+		s.addDelivery(req.Context(), hookID, delivery)
+	}
 	if res.StatusCode >= 200 && res.StatusCode <= 299 {
 		return nil
 	}
 	return errRequest.WithAttributes("code", res.StatusCode)
 }
 
+func (s *HTTPClientSink) addDelivery(ctx context.Context, ids ttnpb.ApplicationWebhookIdentifiers, delivery *ttnpb.ApplicationWebhookDelivery) {
+	if err := s.Deliveries.Add(ctx, ids, delivery, s.MaxDeliveries); err != nil {
+		log.FromContext(ctx).WithError(err).WithField("hook", ids.WebhookID).Warn("Failed to store delivery attempt")
+	}
+}
+
 // QueuedSink is a ControllableSink with queue.
 type QueuedSink struct {
 	Target  Sink
@@ -213,14 +255,16 @@ func (w *webhooks) validateAndFillIDs() echo.MiddlewareFunc {
 			}
 			c.Set(applicationIDKey, appID)
 
-			devID := ttnpb.EndDeviceIdentifiers{
-				ApplicationIdentifiers: appID,
-				DeviceID:               c.Param(deviceIDKey),
-			}
-			if err := devID.ValidateContext(w.ctx); err != nil {
-				return err
+			if deviceID := c.Param(deviceIDKey); deviceID != "" {
+				devID := ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               deviceID,
+				}
+				if err := devID.ValidateContext(w.ctx); err != nil {
+					return err
+				}
+				c.Set(deviceIDKey, devID)
 			}
-			c.Set(deviceIDKey, devID)
 
 			hookID := ttnpb.ApplicationWebhookIdentifiers{
 				ApplicationIdentifiers: appID,
@@ -291,6 +335,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"headers",
 			"join_accept",
 			"location_solved",
+			"up_filter",
 			"uplink_message",
 		},
 	)
@@ -342,7 +387,7 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	case *ttnpb.ApplicationUp_LocationSolved:
 		cfg = hook.LocationSolved
 	}
-	if cfg == nil {
+	if cfg == nil || !io.MatchesUpFilter(hook.UpFilter, msg) {
 		return nil, nil
 	}
 	url, err := url.Parse(hook.BaseURL)
@@ -362,6 +407,9 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 	if err != nil {
 		return nil, err
 	}
+	if buf, err = encodeBody(hook.ContentEncoding, buf); err != nil {
+		return nil, err
+	}
 	req, err := http.NewRequest(http.MethodPost, url.String(), bytes.NewReader(buf))
 	if err != nil {
 		return nil, err
@@ -370,8 +418,11 @@ func (w *webhooks) newRequest(ctx context.Context, msg *ttnpb.ApplicationUp, hoo
 		req.Header.Set(key, value)
 	}
 	req.Header.Set("Content-Type", format.ContentType)
+	if hook.ContentEncoding != "" {
+		req.Header.Set("Content-Encoding", hook.ContentEncoding)
+	}
 	req.Header.Set("User-Agent", userAgent)
-	return req, nil
+	return withWebhookID(req, hook.ApplicationWebhookIdentifiers), nil
 }
 
 var errWebhookNotFound = errors.DefineNotFound("webhook_not_found", "webhook not found")
@@ -408,6 +408,80 @@ func TestWebhooks(t *testing.T) {
 	})
 }
 
+func TestWebhooksProtobuf(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	redisClient, flush := test.NewRedis(t, "web_test")
+	defer flush()
+	defer redisClient.Close()
+	registry := &redis.WebhookRegistry{
+		Redis: redisClient,
+	}
+	ids := ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIdentifiers: registeredApplicationID,
+		WebhookID:              registeredWebhookID,
+	}
+	_, err := registry.Set(ctx, ids, nil, func(_ *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+		return &ttnpb.ApplicationWebhook{
+				ApplicationWebhookIdentifiers: ids,
+				BaseURL:                       "https://myapp.com/api/ttn/v3",
+				Format:                        "protobuf",
+				UplinkMessage: &ttnpb.ApplicationWebhook_Message{
+					Path: "up",
+				},
+			},
+			[]string{
+				"base_url",
+				"format",
+				"ids",
+				"uplink_message",
+			}, nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to set webhook in registry: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	testSink := &mockSink{
+		ch: make(chan *http.Request, 1),
+	}
+	w := web.NewWebhooks(ctx, nil, registry, testSink)
+	sub := w.NewSubscription()
+
+	msg := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: registeredDeviceID,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				SessionKeyID: []byte{0x11},
+				FPort:        42,
+				FCnt:         42,
+				FRMPayload:   []byte{0x1, 0x2, 0x3},
+			},
+		},
+	}
+	if err := sub.SendUp(ctx, msg); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var req *http.Request
+	select {
+	case req = <-testSink.ch:
+	case <-time.After(timeout):
+		t.Fatal("Expected message but nothing received")
+	}
+	a.So(req.URL.String(), should.Equal, "https://myapp.com/api/ttn/v3/up")
+	a.So(req.Header.Get("Content-Type"), should.Equal, "application/x-protobuf")
+	actualBody, err := ioutil.ReadAll(req.Body)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	actual := &ttnpb.ApplicationUp{}
+	if err := actual.Unmarshal(actualBody); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(actual, should.Resemble, msg)
+}
+
 type mockSink struct {
 	Component *component.Component
 	Server    io.Server
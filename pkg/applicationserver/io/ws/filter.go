@@ -0,0 +1,122 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"net/url"
+	"strconv"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// filter is the filter of upstream messages of a connection.
+// Empty filter fields match all messages.
+type filter struct {
+	deviceIDs map[string]bool
+	fPorts    map[uint32]bool
+	types     map[string]bool
+}
+
+var (
+	errFilterFPort = errors.DefineInvalidArgument("filter_f_port", "invalid FPort `{f_port}` in filter")
+	errFilterType  = errors.DefineInvalidArgument("filter_type", "invalid message type `{type}` in filter")
+)
+
+// messageTypes are the upstream message types that can be filtered on.
+var messageTypes = map[string]bool{
+	"uplink_message":  true,
+	"join_accept":     true,
+	"downlink_ack":    true,
+	"downlink_nack":   true,
+	"downlink_sent":   true,
+	"downlink_failed": true,
+	"downlink_queued": true,
+	"location_solved": true,
+}
+
+// parseFilter parses the filter from the device_id, f_port and type query parameters.
+// Each parameter may be repeated to match any of the values.
+func parseFilter(query url.Values) (filter, error) {
+	var f filter
+	if ids := query["device_id"]; len(ids) > 0 {
+		f.deviceIDs = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			f.deviceIDs[id] = true
+		}
+	}
+	if fPorts := query["f_port"]; len(fPorts) > 0 {
+		f.fPorts = make(map[uint32]bool, len(fPorts))
+		for _, s := range fPorts {
+			fPort, err := strconv.ParseUint(s, 10, 8)
+			if err != nil || fPort == 0 {
+				return filter{}, errFilterFPort.WithAttributes("f_port", s)
+			}
+			f.fPorts[uint32(fPort)] = true
+		}
+	}
+	if types := query["type"]; len(types) > 0 {
+		f.types = make(map[string]bool, len(types))
+		for _, t := range types {
+			if !messageTypes[t] {
+				return filter{}, errFilterType.WithAttributes("type", t)
+			}
+			f.types[t] = true
+		}
+	}
+	return f, nil
+}
+
+// messageType returns the message type and, if the message has one, the FPort of the upstream message.
+func messageType(up *ttnpb.ApplicationUp) (typ string, fPort uint32, hasFPort bool) {
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return "uplink_message", p.UplinkMessage.FPort, true
+	case *ttnpb.ApplicationUp_JoinAccept:
+		return "join_accept", 0, false
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return "downlink_ack", p.DownlinkAck.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return "downlink_nack", p.DownlinkNack.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return "downlink_sent", p.DownlinkSent.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return "downlink_failed", p.DownlinkFailed.FPort, true
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return "downlink_queued", p.DownlinkQueued.FPort, true
+	case *ttnpb.ApplicationUp_LocationSolved:
+		return "location_solved", 0, false
+	}
+	return "", 0, false
+}
+
+// matches returns whether the upstream message passes the filter.
+// Messages without an FPort pass the FPort filter.
+func (f filter) matches(up *ttnpb.ApplicationUp) bool {
+	if f.deviceIDs != nil && !f.deviceIDs[up.DeviceID] {
+		return false
+	}
+	typ, fPort, hasFPort := messageType(up)
+	if typ == "" {
+		return false
+	}
+	if f.types != nil && !f.types[typ] {
+		return false
+	}
+	if f.fPorts != nil && hasFPort && !f.fPorts[fPort] {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,149 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ws
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestParseFilter(t *testing.T) {
+	for _, tc := range []struct {
+		Name           string
+		Query          url.Values
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:  "Empty",
+			Query: url.Values{},
+		},
+		{
+			Name: "Valid",
+			Query: url.Values{
+				"device_id": {"dev1", "dev2"},
+				"f_port":    {"1", "223"},
+				"type":      {"uplink_message", "join_accept"},
+			},
+		},
+		{
+			Name:           "FPortZero",
+			Query:          url.Values{"f_port": {"0"}},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "FPortOverflow",
+			Query:          url.Values{"f_port": {"256"}},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name:           "UnknownType",
+			Query:          url.Values{"type": {"service_data"}},
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			_, err := parseFilter(tc.Query)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	uplink := func(deviceID string, fPort uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{DeviceID: deviceID},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FPort: fPort},
+			},
+		}
+	}
+	joinAccept := &ttnpb.ApplicationUp{
+		EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{DeviceID: "dev1"},
+		Up: &ttnpb.ApplicationUp_JoinAccept{
+			JoinAccept: &ttnpb.ApplicationJoinAccept{},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name     string
+		Query    url.Values
+		Message  *ttnpb.ApplicationUp
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Message:  uplink("dev1", 1),
+			Expected: true,
+		},
+		{
+			Name:     "DeviceID",
+			Query:    url.Values{"device_id": {"dev1", "dev2"}},
+			Message:  uplink("dev2", 1),
+			Expected: true,
+		},
+		{
+			Name:    "OtherDeviceID",
+			Query:   url.Values{"device_id": {"dev1"}},
+			Message: uplink("dev2", 1),
+		},
+		{
+			Name:     "FPort",
+			Query:    url.Values{"f_port": {"1", "2"}},
+			Message:  uplink("dev1", 2),
+			Expected: true,
+		},
+		{
+			Name:    "OtherFPort",
+			Query:   url.Values{"f_port": {"1"}},
+			Message: uplink("dev1", 2),
+		},
+		{
+			Name:     "FPortWithoutFPort",
+			Query:    url.Values{"f_port": {"1"}},
+			Message:  joinAccept,
+			Expected: true,
+		},
+		{
+			Name:     "Type",
+			Query:    url.Values{"type": {"join_accept"}},
+			Message:  joinAccept,
+			Expected: true,
+		},
+		{
+			Name:    "OtherType",
+			Query:   url.Values{"type": {"join_accept"}},
+			Message: uplink("dev1", 1),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			f, err := parseFilter(tc.Query)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(f.matches(tc.Message), should.Equal, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ws implements the WebSocket frontend.
+//
+// The WebSocket frontend streams upstream messages of an application as JSON text frames, and accepts downlink
+// messages as JSON text frames. It is intended for tooling that cannot use MQTT, like Node-RED and browsers.
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/web"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	pingInterval = 30 * time.Second
+	writeTimeout = 10 * time.Second
+)
+
+// Config is the configuration of the WebSocket frontend.
+type Config struct {
+	// AllowedOrigins are the origins that are allowed to connect from browsers. The wildcard * allows any origin.
+	// If empty, only same origin connections are allowed.
+	AllowedOrigins []string
+}
+
+type srv struct {
+	ctx      context.Context
+	server   io.Server
+	upgrader *websocket.Upgrader
+}
+
+// New returns a new WebSocket frontend that can be registered to the web server.
+func New(ctx context.Context, server io.Server, conf Config) web.Registerer {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/ws")
+	s := &srv{
+		ctx:    ctx,
+		server: server,
+		upgrader: &websocket.Upgrader{
+			CheckOrigin: CheckOrigin(conf.AllowedOrigins),
+		},
+	}
+	return s
+}
+
+// CheckOrigin returns a function that checks the Origin header of requests against the allowed origins.
+// Requests without Origin header are not made by browsers and are always allowed.
+func CheckOrigin(allowed []string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		u, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		for _, a := range allowed {
+			if a == "*" || strings.EqualFold(a, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RegisterRoutes implements web.Registerer.
+func (s *srv) RegisterRoutes(server *web.Server) {
+	server.GET(ttnpb.HTTPAPIPrefix+"/as/applications/:application_id/ws", s.handleConnect)
+}
+
+var errNoAuthorization = errors.DefineUnauthenticated("no_authorization", "no authorization")
+
+// authorization returns the authorization of the request.
+// Browsers cannot set headers on WebSocket requests, so the API key can also be passed as api_key query parameter.
+func authorization(r *http.Request) (string, error) {
+	if auth := r.Header.Get(echo.HeaderAuthorization); auth != "" {
+		return auth, nil
+	}
+	if key := r.URL.Query().Get("api_key"); key != "" {
+		return fmt.Sprintf("Bearer %s", key), nil
+	}
+	return "", errNoAuthorization
+}
+
+func (s *srv) handleConnect(c echo.Context) error {
+	appID := ttnpb.ApplicationIdentifiers{
+		ApplicationID: c.Param("application_id"),
+	}
+	if err := appID.ValidateContext(s.ctx); err != nil {
+		return err
+	}
+	filter, err := parseFilter(c.QueryParams())
+	if err != nil {
+		return err
+	}
+	auth, err := authorization(c.Request())
+	if err != nil {
+		return err
+	}
+
+	ctx := s.server.FillContext(c.Request().Context())
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/ws")
+	md := metadata.New(map[string]string{
+		"id":            appID.ApplicationID,
+		"authorization": auth,
+	})
+	if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
+		md = metadata.Join(ctxMd, md)
+	}
+	ctx = metadata.NewIncomingContext(ctx, md)
+
+	sub, err := s.server.Subscribe(ctx, "websocket", appID)
+	if err != nil {
+		return err
+	}
+	ctx = sub.Context()
+	logger := log.FromContext(ctx).WithField("remote_addr", c.RealIP())
+
+	ws, err := s.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to upgrade request to websocket connection")
+		sub.Disconnect(err)
+		return nil
+	}
+	defer ws.Close()
+
+	conn := &connection{
+		server: s.server,
+		sub:    sub,
+		filter: filter,
+		ws:     ws,
+		errCh:  make(chan error, 1),
+	}
+	logger.Info("Connected")
+	go conn.readDownlinks(ctx)
+	err = conn.writeUpstream(ctx)
+	sub.Disconnect(err)
+	logger.WithError(err).Info("Disconnected")
+	return nil
+}
+
+type connection struct {
+	server io.Server
+	sub    *io.Subscription
+	filter filter
+	ws     *websocket.Conn
+	// errCh contains the errors of downlink frames that are reported back to the client.
+	errCh chan error
+}
+
+func (c *connection) write(messageType int, data []byte) error {
+	if err := c.ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return err
+	}
+	return c.ws.WriteMessage(messageType, data)
+}
+
+// writeUpstream writes the upstream messages that pass the filter, the downlink errors and the pings to the WebSocket.
+// This is the only goroutine that writes to the WebSocket.
+func (c *connection) writeUpstream(ctx context.Context) error {
+	ping := time.NewTicker(pingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ping.C:
+			if err := c.write(websocket.PingMessage, nil); err != nil {
+				return err
+			}
+		case err := <-c.errCh:
+			buf, mErr := json.Marshal(errorFrame{Error: err.Error()})
+			if mErr != nil {
+				return mErr
+			}
+			if err := c.write(websocket.TextMessage, buf); err != nil {
+				return err
+			}
+		case up := <-c.sub.Up():
+			if !c.filter.matches(up.ApplicationUp) {
+				continue
+			}
+			buf, err := jsonpb.TTN().Marshal(up.ApplicationUp)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to marshal upstream message")
+				continue
+			}
+			if err := c.write(websocket.TextMessage, buf); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readDownlinks reads downlink frames from the WebSocket until the connection closes.
+func (c *connection) readDownlinks(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		_, data, err := c.ws.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				logger.WithError(err).Debug("Failed to read message")
+			}
+			c.sub.Disconnect(err)
+			return
+		}
+		if err := c.handleDownlink(ctx, data); err != nil {
+			logger.WithError(err).Warn("Failed to handle downlink messages")
+			select {
+			case c.errCh <- err:
+			default:
+			}
+		}
+	}
+}
+
+var (
+	errDownlinkOperation = errors.DefineInvalidArgument("downlink_operation", "invalid downlink operation `{operation}`")
+	errDecodeDownlink    = errors.DefineInvalidArgument("decode_downlink", "decode downlink frame")
+)
+
+func (c *connection) handleDownlink(ctx context.Context, data []byte) error {
+	frame := downlinkFrame{}
+	if err := json.Unmarshal(data, &frame); err != nil {
+		return errDecodeDownlink.WithCause(err)
+	}
+	var op func(io.Server, context.Context, ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+	switch frame.Operation {
+	case "push":
+		op = io.Server.DownlinkQueuePush
+	case "replace":
+		op = io.Server.DownlinkQueueReplace
+	default:
+		return errDownlinkOperation.WithAttributes("operation", frame.Operation)
+	}
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: *c.sub.ApplicationIDs(),
+		DeviceID:               frame.DeviceID,
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return err
+	}
+	items := make([]*ttnpb.ApplicationDownlink, 0, len(frame.Downlinks))
+	for _, raw := range frame.Downlinks {
+		item := &ttnpb.ApplicationDownlink{}
+		if err := jsonpb.TTN().Unmarshal(raw, item); err != nil {
+			return errDecodeDownlink.WithCause(err)
+		}
+		items = append(items, item)
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"device_uid", unique.ID(ctx, ids),
+		"count", len(items),
+	)).Debug("Handle downlink messages")
+	return op(c.server, ctx, ids, items)
+}
+
+// downlinkFrame is a frame that pushes downlink messages to, or replaces, the downlink queue of an end device.
+type downlinkFrame struct {
+	DeviceID  string            `json:"device_id"`
+	Operation string            `json:"operation"`
+	Downlinks []json.RawMessage `json:"downlinks"`
+}
+
+// errorFrame is a frame that reports an error in handling a downlink frame.
+type errorFrame struct {
+	Error string `json:"error"`
+}
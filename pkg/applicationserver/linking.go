@@ -99,11 +99,17 @@ type link struct {
 	connReady chan struct{}
 	callOpts  []grpc.CallOption
 
-	handleUp upstreamTrafficHandler
+	handleUp            upstreamTrafficHandler
+	requireNotSuspended func(context.Context, ttnpb.ApplicationIdentifiers) error
+	activeMuteWindow    func(context.Context, ttnpb.EndDeviceIdentifiers, time.Time) (*ttnpb.MuteWindow, bool)
+	muteBufferSize      int
 
 	subscribeCh   chan *io.Subscription
 	unsubscribeCh chan *io.Subscription
 	upCh          chan *io.ContextualApplicationUp
+	holdCh        chan *heldApplicationUp
+
+	traffic *trafficStats
 }
 
 const linkBufferSize = 10
@@ -173,9 +179,14 @@ func (as *ApplicationServer) link(ctx context.Context, ids ttnpb.ApplicationIden
 		closed:                 make(chan struct{}),
 		connReady:              make(chan struct{}),
 		handleUp:               as.handleUp,
+		requireNotSuspended:    as.requireNotSuspended,
+		activeMuteWindow:       as.muteWindows.Active,
+		muteBufferSize:         as.config.MuteWindows.BufferSize,
 		subscribeCh:            make(chan *io.Subscription, 1),
 		unsubscribeCh:          make(chan *io.Subscription, 1),
 		upCh:                   make(chan *io.ContextualApplicationUp, linkBufferSize),
+		holdCh:                 make(chan *heldApplicationUp, linkBufferSize),
+		traffic:                newTrafficStats(),
 	}
 	if _, loaded := as.links.LoadOrStore(uid, l); loaded {
 		log.FromContext(ctx).Warn("Link already started")
@@ -276,6 +287,22 @@ func (as *ApplicationServer) getLink(ctx context.Context, ids ttnpb.ApplicationI
 
 func (l *link) run() {
 	subscribers := make(map[*io.Subscription]string)
+	sendUp := func(up *io.ContextualApplicationUp) {
+		for sub := range subscribers {
+			if err := sub.SendUp(up.Context, up.ApplicationUp); err != nil {
+				log.FromContext(sub.Context()).WithError(err).Warn("Send upstream message failed")
+			}
+		}
+	}
+	held := &muteBuffer{size: l.muteBufferSize}
+	releaseHeld := func(ids *ttnpb.EndDeviceIdentifiers) {
+		for _, up := range held.Release(time.Now(), ids) {
+			sendUp(up)
+			registerForwardUp(up.Context, up.ApplicationUp)
+		}
+	}
+	releaseTicker := time.NewTicker(muteReleaseInterval)
+	defer releaseTicker.Stop()
 	for {
 		select {
 		case <-l.ctx.Done():
@@ -292,11 +319,15 @@ func (l *link) run() {
 				log.FromContext(sub.Context()).Debug("Unsubscribed")
 			}
 		case up := <-l.upCh:
-			for sub := range subscribers {
-				if err := sub.SendUp(up.Context, up.ApplicationUp); err != nil {
-					log.FromContext(sub.Context()).WithError(err).Warn("Send upstream message failed")
-				}
+			// Held upstream messages of the end device are released first, so that the order of arrival is kept.
+			releaseHeld(&up.EndDeviceIdentifiers)
+			sendUp(up)
+		case up := <-l.holdCh:
+			if dropped := held.Hold(up); dropped != nil {
+				registerDropUp(dropped.Context, dropped.ApplicationUp, errMuteBufferFull.WithAttributes("size", l.muteBufferSize))
 			}
+		case <-releaseTicker.C:
+			releaseHeld(nil)
 		}
 	}
 }
@@ -338,6 +369,31 @@ func (l *link) sendUp(ctx context.Context, up *ttnpb.ApplicationUp, ack func() e
 		return nil
 	}
 
+	// Upstream messages of suspended applications are processed to keep the end device state intact, but they are not
+	// forwarded to the application frontends.
+	if err := l.requireNotSuspended(ctx, l.ApplicationIdentifiers); err != nil {
+		registerDropUp(ctx, up, err)
+		return nil
+	}
+
+	// Upstream messages during mute windows are held until the mute window ends, or dropped, depending on the policy of
+	// the mute window. Held messages are released by the link.
+	if window, ok := l.activeMuteWindow(ctx, up.EndDeviceIdentifiers, now); ok {
+		if window.Upstream == ttnpb.MuteWindow_DROP {
+			registerDropUp(ctx, up, errMuted.WithAttributes("end_at", window.EndAt))
+			return nil
+		}
+		l.holdCh <- &heldApplicationUp{
+			ContextualApplicationUp: &io.ContextualApplicationUp{
+				Context:       ctx,
+				ApplicationUp: up,
+			},
+			until: window.EndAt,
+		}
+		registerHoldUp(ctx, up, window)
+		return nil
+	}
+
 	l.upCh <- &io.ContextualApplicationUp{
 		Context:       ctx,
 		ApplicationUp: up,
@@ -0,0 +1,80 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// muteReleaseInterval is the interval in which upstream messages held during mute windows are released.
+const muteReleaseInterval = time.Second
+
+var (
+	errMuted = errors.DefineUnavailable(
+		"muted", "end device is muted until `{end_at}`",
+	)
+	errMuteBufferFull = errors.DefineResourceExhausted(
+		"mute_buffer_full", "more than `{size}` upstream messages held during mute windows",
+	)
+)
+
+// heldApplicationUp is an upstream message that is held during a mute window.
+type heldApplicationUp struct {
+	*io.ContextualApplicationUp
+	until time.Time
+}
+
+// muteBuffer holds the upstream messages of an application during mute windows, in order of arrival.
+// When the buffer is full, the oldest message is dropped.
+type muteBuffer struct {
+	size int
+	ups  []*heldApplicationUp
+}
+
+// Hold holds the upstream message until the given time. Hold returns the message that is dropped, if any.
+func (b *muteBuffer) Hold(up *heldApplicationUp) *heldApplicationUp {
+	if b.size <= 0 {
+		return up
+	}
+	var dropped *heldApplicationUp
+	if len(b.ups) >= b.size {
+		dropped, b.ups = b.ups[0], b.ups[1:]
+	}
+	b.ups = append(b.ups, up)
+	return dropped
+}
+
+// Release returns the held upstream messages that are held until before now, and the held upstream messages of the
+// given end device, if any. The order of arrival is kept.
+func (b *muteBuffer) Release(now time.Time, ids *ttnpb.EndDeviceIdentifiers) []*io.ContextualApplicationUp {
+	var released []*io.ContextualApplicationUp
+	remaining := b.ups[:0]
+	for _, up := range b.ups {
+		if now.Before(up.until) && (ids == nil || up.DeviceID != ids.DeviceID) {
+			remaining = append(remaining, up)
+			continue
+		}
+		released = append(released, up.ContextualApplicationUp)
+	}
+	for i := len(remaining); i < len(b.ups); i++ {
+		b.ups[i] = nil
+	}
+	b.ups = remaining
+	return released
+}
@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMuteBuffer(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	now := time.Unix(1600000000, 0)
+
+	newHeld := func(deviceID string, until time.Time) *heldApplicationUp {
+		return &heldApplicationUp{
+			ContextualApplicationUp: &io.ContextualApplicationUp{
+				Context: ctx,
+				ApplicationUp: &ttnpb.ApplicationUp{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+						DeviceID:               deviceID,
+					},
+				},
+			},
+			until: until,
+		}
+	}
+
+	// Nothing is held without buffer.
+	empty := &muteBuffer{}
+	up := newHeld("foo-device", now.Add(time.Minute))
+	a.So(empty.Hold(up), should.Equal, up)
+
+	b := &muteBuffer{size: 3}
+	foo1 := newHeld("foo-device", now.Add(time.Minute))
+	bar1 := newHeld("bar-device", now.Add(time.Hour))
+	foo2 := newHeld("foo-device", now.Add(time.Minute))
+	baz1 := newHeld("baz-device", now.Add(time.Minute))
+	a.So(b.Hold(foo1), should.BeNil)
+	a.So(b.Hold(bar1), should.BeNil)
+	a.So(b.Hold(foo2), should.BeNil)
+
+	// The oldest message is dropped when the buffer is full.
+	a.So(b.Hold(baz1), should.Equal, foo1)
+
+	// Nothing is released before the mute windows end.
+	a.So(b.Release(now, nil), should.BeEmpty)
+
+	// The messages of an end device are released on demand.
+	a.So(b.Release(now, &bar1.EndDeviceIdentifiers), should.Resemble, []*io.ContextualApplicationUp{
+		bar1.ContextualApplicationUp,
+	})
+
+	// The messages are released in order of arrival when the mute windows end.
+	a.So(b.Release(now.Add(time.Minute), nil), should.Resemble, []*io.ContextualApplicationUp{
+		foo2.ContextualApplicationUp,
+		baz1.ContextualApplicationUp,
+	})
+	a.So(b.ups, should.BeEmpty)
+}
@@ -58,6 +58,10 @@ var (
 		"as.up.data.forward", "forward uplink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtHoldUp = events.Define(
+		"as.up.mute.hold", "hold upstream message during mute window",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtDecodeFailDataUp = events.Define(
 		"as.up.data.decode.fail", "decode uplink data message failure",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -74,6 +78,10 @@ var (
 		"as.up.join.forward", "forward join-accept message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtInferFormattersJoinAccept = events.Define(
+		"as.up.join.formatters.infer", "infer payload formatters from Device Repository",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtReceiveDataDown = events.Define(
 		"as.down.data.receive", "receive downlink data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -94,6 +102,10 @@ var (
 		"as.down.data.queue.invalid", "invalid downlink data queue",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtUpdateStatusDataDown = events.Define(
+		"as.down.data.status.update", "update downlink data message delivery status",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 )
 
 const (
@@ -308,6 +320,10 @@ func registerDropUp(ctx context.Context, msg *ttnpb.ApplicationUp, err error) {
 	}
 }
 
+func registerHoldUp(ctx context.Context, msg *ttnpb.ApplicationUp, window *ttnpb.MuteWindow) {
+	events.Publish(evtHoldUp(ctx, msg.EndDeviceIdentifiers, window))
+}
+
 func registerReceiveDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationDownlink) {
 	events.Publish(evtReceiveDataDown(ctx, ids, msg))
 	asMetrics.downlinkReceived.WithLabelValues(ctx, ids.ApplicationID).Inc()
@@ -326,3 +342,7 @@ func registerDropDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, m
 		asMetrics.downlinkDropped.WithLabelValues(ctx, unknown).Inc()
 	}
 }
+
+func registerDownlinkStatus(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, status *ttnpb.ApplicationDownlinkStatus) {
+	events.Publish(evtUpdateStatusDataDown(events.ContextWithCorrelationID(ctx, status.Downlink.CorrelationIDs...), ids, status))
+}
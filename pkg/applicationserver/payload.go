@@ -24,11 +24,34 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
 var errNoPayload = errors.Define("no_payload", "no payload")
 
+// upFormatter returns the uplink payload formatter and its parameter for messages on the given FPort.
+// The payload formatters of the first FPort range that contains the FPort take precedence.
+func upFormatter(formatters *ttnpb.MessagePayloadFormatters, fPort uint32) (ttnpb.PayloadFormatter, string) {
+	for _, f := range formatters.FPortFormatters {
+		if fPort >= f.FPortRange.Min && fPort <= f.FPortRange.Max {
+			return f.UpFormatter, f.UpFormatterParameter
+		}
+	}
+	return formatters.UpFormatter, formatters.UpFormatterParameter
+}
+
+// downFormatter returns the downlink payload formatter and its parameter for messages on the given FPort.
+// The payload formatters of the first FPort range that contains the FPort take precedence.
+func downFormatter(formatters *ttnpb.MessagePayloadFormatters, fPort uint32) (ttnpb.PayloadFormatter, string) {
+	for _, f := range formatters.FPortFormatters {
+		if fPort >= f.FPortRange.Min && fPort <= f.FPortRange.Max {
+			return f.DownFormatter, f.DownFormatterParameter
+		}
+	}
+	return formatters.DownFormatter, formatters.DownFormatterParameter
+}
+
 func (as *ApplicationServer) encodeAndEncrypt(ctx context.Context, dev *ttnpb.EndDevice, session *ttnpb.Session, downlink *ttnpb.ApplicationDownlink, defaultFormatters *ttnpb.MessagePayloadFormatters) error {
 	if session == nil || session.AppSKey == nil {
 		return errNoAppSKey
@@ -40,9 +63,9 @@ func (as *ApplicationServer) encodeAndEncrypt(ctx context.Context, dev *ttnpb.En
 		var formatter ttnpb.PayloadFormatter
 		var parameter string
 		if dev.Formatters != nil {
-			formatter, parameter = dev.Formatters.DownFormatter, dev.Formatters.DownFormatterParameter
+			formatter, parameter = downFormatter(dev.Formatters, downlink.FPort)
 		} else if defaultFormatters != nil {
-			formatter, parameter = defaultFormatters.DownFormatter, defaultFormatters.DownFormatterParameter
+			formatter, parameter = downFormatter(defaultFormatters, downlink.FPort)
 		}
 		if formatter != ttnpb.PayloadFormatter_FORMATTER_NONE {
 			if err := as.formatter.Encode(ctx, dev.EndDeviceIdentifiers, dev.VersionIDs, downlink, formatter, parameter); err != nil {
@@ -78,9 +101,9 @@ func (as *ApplicationServer) decryptAndDecode(ctx context.Context, dev *ttnpb.En
 	var formatter ttnpb.PayloadFormatter
 	var parameter string
 	if dev.Formatters != nil {
-		formatter, parameter = dev.Formatters.UpFormatter, dev.Formatters.UpFormatterParameter
+		formatter, parameter = upFormatter(dev.Formatters, uplink.FPort)
 	} else if defaultFormatters != nil {
-		formatter, parameter = defaultFormatters.UpFormatter, defaultFormatters.UpFormatterParameter
+		formatter, parameter = upFormatter(defaultFormatters, uplink.FPort)
 	}
 	if formatter != ttnpb.PayloadFormatter_FORMATTER_NONE {
 		if err := as.formatter.Decode(ctx, dev.EndDeviceIdentifiers, dev.VersionIDs, uplink, formatter, parameter); err != nil {
@@ -88,13 +111,122 @@ func (as *ApplicationServer) decryptAndDecode(ctx context.Context, dev *ttnpb.En
 			events.Publish(evtDecodeFailDataUp(ctx, dev.EndDeviceIdentifiers, err))
 		}
 	}
+	if len(uplink.NormalizedPayload) > 0 {
+		uplink.NormalizedPayload, uplink.NormalizedPayloadWarnings = normalizedpayload.Validate(uplink.NormalizedPayload)
+		if len(uplink.NormalizedPayloadWarnings) > 0 {
+			log.FromContext(ctx).WithField("warnings", uplink.NormalizedPayloadWarnings).Debug("Invalid normalized payload measurements removed")
+		}
+	}
 	return nil
 }
 
+var (
+	errNoFormatter     = errors.DefineFailedPrecondition("no_formatter", "no uplink payload formatter configured")
+	errNoDownFormatter = errors.DefineFailedPrecondition("no_down_formatter", "no downlink payload formatter configured")
+	errPayloadDecoding = errors.Define("payload_decoding", "payload decoding failed")
+	errPayloadEncoding = errors.Define("payload_encoding", "payload encoding failed")
+)
+
+// DecodeUplink decodes the uplink message of the end device with the given payload formatter, without processing the
+// uplink message. If no payload formatter is given, the uplink payload formatter of the end device, or the default
+// uplink payload formatter of the application link is used. Errors of the payload formatter are returned in the
+// response, so that payload formatters can be tested.
+func (as *ApplicationServer) DecodeUplink(ctx context.Context, req *ttnpb.DecodeUplinkRequest) (*ttnpb.DecodeUplinkResponse, error) {
+	formatter, parameter, version := req.Formatter, req.Parameter, req.VersionIDs
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE || (formatter == ttnpb.PayloadFormatter_FORMATTER_REPOSITORY && version == nil) {
+		dev, err := as.deviceRegistry.Get(ctx, req.EndDeviceIdentifiers, []string{"formatters", "version_ids"})
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			version = dev.VersionIDs
+		}
+		if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+			if dev.Formatters != nil {
+				formatter, parameter = upFormatter(dev.Formatters, req.Uplink.FPort)
+			} else {
+				link, err := as.linkRegistry.Get(ctx, req.ApplicationIdentifiers, []string{"default_formatters"})
+				if err != nil && !errors.IsNotFound(err) {
+					return nil, err
+				}
+				if link != nil && link.DefaultFormatters != nil {
+					formatter, parameter = upFormatter(link.DefaultFormatters, req.Uplink.FPort)
+				}
+			}
+		}
+	}
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+		return nil, errNoFormatter
+	}
+
+	uplink := req.Uplink
+	uplink.DecodedPayload, uplink.NormalizedPayload, uplink.NormalizedPayloadWarnings = nil, nil, nil
+	res := &ttnpb.DecodeUplinkResponse{}
+	if err := as.formatter.Decode(ctx, req.EndDeviceIdentifiers, version, &uplink, formatter, parameter); err != nil {
+		if ttnErr, ok := errors.From(err); ok {
+			res.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+		} else {
+			res.Error = ttnpb.ErrorDetailsToProto(errPayloadDecoding.WithCause(err))
+		}
+	}
+	if len(uplink.NormalizedPayload) > 0 {
+		uplink.NormalizedPayload, uplink.NormalizedPayloadWarnings = normalizedpayload.Validate(uplink.NormalizedPayload)
+	}
+	res.Uplink = uplink
+	return res, nil
+}
+
+// EncodeDownlink encodes the downlink message of the end device with the given payload formatter, without scheduling
+// the downlink message. If no payload formatter is given, the downlink payload formatter of the end device, or the
+// default downlink payload formatter of the application link is used. Errors of the payload formatter, including the
+// errors of fields of the decoded payload, are returned in the response, so that payload formatters can be tested.
+func (as *ApplicationServer) EncodeDownlink(ctx context.Context, req *ttnpb.EncodeDownlinkRequest) (*ttnpb.EncodeDownlinkResponse, error) {
+	formatter, parameter, version := req.Formatter, req.Parameter, req.VersionIDs
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE || (formatter == ttnpb.PayloadFormatter_FORMATTER_REPOSITORY && version == nil) {
+		dev, err := as.deviceRegistry.Get(ctx, req.EndDeviceIdentifiers, []string{"formatters", "version_ids"})
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			version = dev.VersionIDs
+		}
+		if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+			if dev.Formatters != nil {
+				formatter, parameter = downFormatter(dev.Formatters, req.Downlink.FPort)
+			} else {
+				link, err := as.linkRegistry.Get(ctx, req.ApplicationIdentifiers, []string{"default_formatters"})
+				if err != nil && !errors.IsNotFound(err) {
+					return nil, err
+				}
+				if link != nil && link.DefaultFormatters != nil {
+					formatter, parameter = downFormatter(link.DefaultFormatters, req.Downlink.FPort)
+				}
+			}
+		}
+	}
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+		return nil, errNoDownFormatter
+	}
+
+	downlink := req.Downlink
+	downlink.FRMPayload = nil
+	res := &ttnpb.EncodeDownlinkResponse{}
+	if err := as.formatter.Encode(ctx, req.EndDeviceIdentifiers, version, &downlink, formatter, parameter); err != nil {
+		if ttnErr, ok := errors.From(err); ok {
+			res.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+		} else {
+			res.Error = ttnpb.ErrorDetailsToProto(errPayloadEncoding.WithCause(err))
+		}
+	}
+	res.Downlink = downlink
+	return res, nil
+}
+
 type payloadFormatter struct {
-	repository     *devicerepository.Client
-	upFormatters   map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder
-	downFormatters map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder
+	repository      *devicerepository.Client
+	repositoryCache *repositoryFormatterCache
+	upFormatters    map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder
+	downFormatters  map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder
 }
 
 var (
@@ -102,10 +234,20 @@ var (
 	errVersionUnavailable = errors.DefineUnavailable("version_unavailable", "end device version is unavailable in the repository")
 )
 
-func (p payloadFormatter) getRepositoryFormatters(version *ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatters, error) {
-	if version == nil || p.repository == nil {
+func (p payloadFormatter) getRepositoryFormatters(ctx context.Context, version *ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatters, error) {
+	if version == nil || p.repository == nil || p.repository.Fetcher == nil {
 		return nil, errNoVersion
 	}
+	if p.repositoryCache != nil {
+		formatters, ok, err := p.repositoryCache.Get(ctx, p.repository, version)
+		if err != nil {
+			return nil, errVersionUnavailable.WithCause(err)
+		}
+		if !ok {
+			return nil, errVersionUnavailable
+		}
+		return formatters, nil
+	}
 	versions, err := p.repository.DeviceVersions(version.BrandID, version.ModelID)
 	if err != nil {
 		return nil, errVersionUnavailable.WithCause(err)
@@ -118,15 +260,102 @@ func (p payloadFormatter) getRepositoryFormatters(version *ttnpb.EndDeviceVersio
 	return nil, errVersionUnavailable
 }
 
+var errNoRepository = errors.DefineFailedPrecondition("no_repository", "no Device Repository configured")
+
+// inferDeviceVersion returns the end device version of the given end device from the Device Repository.
+// If the end device has no version identifiers, the version is inferred from the JoinEUI and DevEUI using the vendor
+// profiles of the Device Repository.
+func (p payloadFormatter) inferDeviceVersion(dev *ttnpb.EndDevice) (*ttnpb.EndDeviceVersion, error) {
+	if p.repository == nil || p.repository.Fetcher == nil {
+		return nil, errNoRepository
+	}
+	if dev.VersionIDs == nil {
+		if dev.JoinEUI == nil || dev.DevEUI == nil {
+			return nil, errNoVersion
+		}
+		return p.repository.InferDeviceVersion(*dev.JoinEUI, *dev.DevEUI)
+	}
+	versions, err := p.repository.DeviceVersions(dev.VersionIDs.BrandID, dev.VersionIDs.ModelID)
+	if err != nil {
+		return nil, errVersionUnavailable.WithCause(err)
+	}
+	for _, v := range versions {
+		if v.FirmwareVersion == dev.VersionIDs.FirmwareVersion && v.HardwareVersion == dev.VersionIDs.HardwareVersion {
+			return &v, nil
+		}
+	}
+	return nil, errVersionUnavailable
+}
+
+// repositoryFormatter returns the Device Repository formatter if the given formatter of the Device Repository is set.
+func repositoryFormatter(formatter ttnpb.PayloadFormatter) ttnpb.PayloadFormatter {
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+		return ttnpb.PayloadFormatter_FORMATTER_NONE
+	}
+	return ttnpb.PayloadFormatter_FORMATTER_REPOSITORY
+}
+
+// inferFormatters configures the Device Repository payload formatters for end devices that have no payload formatters
+// configured, and of which the version is known or can be inferred from the vendor profiles of the Device Repository.
+// It returns the changed field mask paths.
+func (as *ApplicationServer) inferFormatters(ctx context.Context, dev *ttnpb.EndDevice, defaultFormatters *ttnpb.MessagePayloadFormatters) []string {
+	if dev.Formatters != nil || defaultFormatters != nil {
+		return nil
+	}
+	version, err := as.formatter.inferDeviceVersion(dev)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to infer end device version")
+		return nil
+	}
+	if version.DefaultFormatters.UpFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE &&
+		version.DefaultFormatters.DownFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+		return nil
+	}
+	paths := []string{"formatters"}
+	if dev.VersionIDs == nil {
+		dev.VersionIDs = &version.EndDeviceVersionIdentifiers
+		paths = append(paths, "version_ids")
+	}
+	dev.Formatters = &ttnpb.MessagePayloadFormatters{
+		UpFormatter:   repositoryFormatter(version.DefaultFormatters.UpFormatter),
+		DownFormatter: repositoryFormatter(version.DefaultFormatters.DownFormatter),
+	}
+	return paths
+}
+
+// assignRepositoryFormatters configures the Device Repository payload formatters for end devices that are created with
+// the version identifiers of the Device Repository and without payload formatters, for example from a Device Repository
+// template. The payload formatters of the Device Repository are resolved when messages are processed, so that updates
+// of the Device Repository are applied. It returns the changed field mask paths.
+func (as *ApplicationServer) assignRepositoryFormatters(ctx context.Context, dev *ttnpb.EndDevice) []string {
+	if dev.Formatters != nil || dev.VersionIDs == nil {
+		return nil
+	}
+	formatters, err := as.formatter.getRepositoryFormatters(ctx, dev.VersionIDs)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to get payload formatters from Device Repository")
+		return nil
+	}
+	if formatters.UpFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE &&
+		formatters.DownFormatter == ttnpb.PayloadFormatter_FORMATTER_NONE {
+		return nil
+	}
+	dev.Formatters = &ttnpb.MessagePayloadFormatters{
+		UpFormatter:   repositoryFormatter(formatters.UpFormatter),
+		DownFormatter: repositoryFormatter(formatters.DownFormatter),
+	}
+	return []string{"formatters"}
+}
+
 var errFormatterNotConfigured = errors.DefineFailedPrecondition("formatter_not_configured", "formatter `{formatter}` is not configured")
 
 func (p payloadFormatter) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, formatter ttnpb.PayloadFormatter, parameter string) error {
 	if formatter == ttnpb.PayloadFormatter_FORMATTER_REPOSITORY {
-		formatters, err := p.getRepositoryFormatters(version)
+		formatters, err := p.getRepositoryFormatters(ctx, version)
 		if err != nil {
 			return err
 		}
-		formatter, parameter = formatters.DownFormatter, formatters.DownFormatterParameter
+		formatter, parameter = downFormatter(formatters, msg.FPort)
 	}
 	mp, ok := p.downFormatters[formatter]
 	if !ok {
@@ -140,11 +369,11 @@ func (p payloadFormatter) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentif
 
 func (p payloadFormatter) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, formatter ttnpb.PayloadFormatter, parameter string) error {
 	if formatter == ttnpb.PayloadFormatter_FORMATTER_REPOSITORY {
-		formatters, err := p.getRepositoryFormatters(version)
+		formatters, err := p.getRepositoryFormatters(ctx, version)
 		if err != nil {
 			return err
 		}
-		formatter, parameter = formatters.UpFormatter, formatters.UpFormatterParameter
+		formatter, parameter = upFormatter(formatters, msg.FPort)
 	}
 	mp, ok := p.upFormatters[formatter]
 	if !ok {
@@ -0,0 +1,573 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/devicerepository"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/fetch"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/pkg/scripting"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestInferFormatters(t *testing.T) {
+	as := &ApplicationServer{
+		formatter: payloadFormatter{
+			repository: &devicerepository.Client{
+				Fetcher: fetch.NewMemFetcher(map[string][]byte{
+					"brands.yml": []byte(`version: '3'
+brands:
+  thethingsproducts:
+    name: The Things Products
+    profiles:
+    - dev_eui_prefix: 70B3D57ED0000000/40
+      model_id: thethingsnode
+      hardware_version: '1.0'
+      firmware_version: '1.1'
+    - dev_eui_prefix: 70B3D57ED1000000/40
+      model_id: thethingsnode
+      hardware_version: '2.0'
+      firmware_version: '1.0'`),
+					"thethingsproducts/thethingsnode/versions.yml": []byte(`version: '3'
+hardware_versions:
+  '1.0':
+    - firmware_version: 1.1
+      payload_format:
+        up:
+          type: cayennelpp
+  '2.0':
+    - firmware_version: 1.0`),
+				}),
+			},
+		},
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "thethingsproducts",
+		ModelID:         "thethingsnode",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.1",
+	}
+	inferredFormatters := &ttnpb.MessagePayloadFormatters{
+		UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+		DownFormatter: ttnpb.PayloadFormatter_FORMATTER_NONE,
+	}
+
+	for _, tc := range []struct {
+		Name               string
+		Device             *ttnpb.EndDevice
+		DefaultFormatters  *ttnpb.MessagePayloadFormatters
+		ExpectedPaths      []string
+		ExpectedVersion    *ttnpb.EndDeviceVersionIdentifiers
+		ExpectedFormatters *ttnpb.MessagePayloadFormatters
+	}{
+		{
+			Name: "VendorProfile",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42},
+				},
+			},
+			ExpectedPaths:      []string{"formatters", "version_ids"},
+			ExpectedVersion:    version,
+			ExpectedFormatters: inferredFormatters,
+		},
+		{
+			Name: "VersionIDs",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+				VersionIDs: version,
+			},
+			ExpectedPaths:      []string{"formatters"},
+			ExpectedVersion:    version,
+			ExpectedFormatters: inferredFormatters,
+		},
+		{
+			Name: "NoFormatters",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x42},
+				},
+			},
+		},
+		{
+			Name: "NoVendorProfile",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+				},
+			},
+		},
+		{
+			Name: "DeviceFormatters",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42},
+				},
+				Formatters: &ttnpb.MessagePayloadFormatters{
+					UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+				},
+			},
+			ExpectedFormatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			},
+		},
+		{
+			Name: "DefaultFormatters",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					JoinEUI: &types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+					DevEUI:  &types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42},
+				},
+			},
+			DefaultFormatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			paths := as.inferFormatters(test.Context(), tc.Device, tc.DefaultFormatters)
+			a.So(paths, should.Resemble, tc.ExpectedPaths)
+			a.So(tc.Device.VersionIDs, should.Resemble, tc.ExpectedVersion)
+			a.So(tc.Device.Formatters, should.Resemble, tc.ExpectedFormatters)
+		})
+	}
+}
+
+type countingFetcher struct {
+	fetch.Interface
+	files map[string]int
+}
+
+func (f *countingFetcher) File(pathElements ...string) ([]byte, error) {
+	f.files[strings.Join(pathElements, "/")]++
+	return f.Interface.File(pathElements...)
+}
+
+func TestAssignRepositoryFormatters(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	store := map[string][]byte{
+		"thethingsproducts/thethingsnode/versions.yml": []byte(`version: '3'
+hardware_versions:
+  '1.0':
+    - firmware_version: 1.1
+      payload_format:
+        up:
+          type: cayennelpp
+  '2.0':
+    - firmware_version: 1.0`),
+	}
+	fetcher := &countingFetcher{
+		Interface: fetch.NewMemFetcher(store),
+		files:     make(map[string]int),
+	}
+	as := &ApplicationServer{
+		formatter: payloadFormatter{
+			repository: &devicerepository.Client{
+				Fetcher: fetcher,
+			},
+			repositoryCache: newRepositoryFormatterCache(time.Hour),
+		},
+	}
+	version := func(hardwareVersion, firmwareVersion string) *ttnpb.EndDeviceVersionIdentifiers {
+		return &ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         "thethingsproducts",
+			ModelID:         "thethingsnode",
+			HardwareVersion: hardwareVersion,
+			FirmwareVersion: firmwareVersion,
+		}
+	}
+
+	// The Device Repository formatters are assigned to end devices with a version with payload formatters.
+	dev := &ttnpb.EndDevice{VersionIDs: version("1.0", "1.1")}
+	a.So(as.assignRepositoryFormatters(ctx, dev), should.Resemble, []string{"formatters"})
+	a.So(dev.Formatters, should.Resemble, &ttnpb.MessagePayloadFormatters{
+		UpFormatter: ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+	})
+
+	// No formatters are assigned to end devices with a version without payload formatters, an unknown version, or
+	// payload formatters.
+	for _, dev := range []*ttnpb.EndDevice{
+		{VersionIDs: version("2.0", "1.0")},
+		{VersionIDs: version("3.0", "1.0")},
+		{},
+		{
+			VersionIDs: version("1.0", "1.1"),
+			Formatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			},
+		},
+	} {
+		formatters := dev.Formatters
+		a.So(as.assignRepositoryFormatters(ctx, dev), should.BeEmpty)
+		a.So(dev.Formatters, should.Equal, formatters)
+	}
+
+	// The versions of the model are fetched once while they are cached.
+	a.So(fetcher.files["thethingsproducts/thethingsnode/versions.yml"], should.Equal, 1)
+
+	// Updates of the Device Repository are applied when the cached payload formatters expire.
+	store["thethingsproducts/thethingsnode/versions.yml"] = []byte(`version: '3'
+hardware_versions:
+  '1.0':
+    - firmware_version: 1.1
+      payload_format:
+        up:
+          type: grpc
+          parameter: localhost:1234`)
+	as.formatter.repositoryCache.ttl = 0
+	formatters, err := as.formatter.getRepositoryFormatters(ctx, version("1.0", "1.1"))
+	a.So(err, should.BeNil)
+	a.So(formatters, should.Resemble, &ttnpb.MessagePayloadFormatters{
+		UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE,
+		UpFormatterParameter: "localhost:1234",
+	})
+	a.So(fetcher.files["thethingsproducts/thethingsnode/versions.yml"], should.Equal, 2)
+
+	// The last known payload formatters are used when the Device Repository is unavailable.
+	delete(store, "thethingsproducts/thethingsnode/versions.yml")
+	formatters, err = as.formatter.getRepositoryFormatters(ctx, version("1.0", "1.1"))
+	a.So(err, should.BeNil)
+	a.So(formatters.UpFormatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE)
+}
+
+type deviceGetter struct {
+	DeviceRegistry
+	devices map[string]*ttnpb.EndDevice
+}
+
+func (r deviceGetter) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, paths []string) (*ttnpb.EndDevice, error) {
+	dev, ok := r.devices[ids.DeviceID]
+	if !ok {
+		return nil, errDeviceNotFound.WithAttributes("device_uid", ids.DeviceID)
+	}
+	return dev, nil
+}
+
+type linkGetter struct {
+	LinkRegistry
+	link *ttnpb.ApplicationLink
+}
+
+func (r linkGetter) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.ApplicationLink, error) {
+	if r.link == nil {
+		return nil, errNotLinked.WithAttributes("application_uid", ids.ApplicationID)
+	}
+	return r.link, nil
+}
+
+func TestDecodeUplink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	jsFormatter := javascript.New(scripting.DefaultOptions)
+	as := &ApplicationServer{
+		deviceRegistry: deviceGetter{
+			devices: map[string]*ttnpb.EndDevice{
+				"with-formatters": {
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+					},
+				},
+				"with-f-port-formatters": {
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+						FPortFormatters: []*ttnpb.FPortPayloadFormatters{
+							{
+								FPortRange:           ttnpb.FPortRange{Min: 10, Max: 20},
+								UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+								UpFormatterParameter: "function Decoder(payload, f_port) { return { port: f_port } }",
+							},
+						},
+					},
+				},
+				"without-formatters": {},
+			},
+		},
+		linkRegistry: linkGetter{},
+		formatter: payloadFormatter{
+			upFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadDecoder{
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: jsFormatter,
+				ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP: cayennelpp.New(),
+			},
+		},
+	}
+	request := func(deviceID string, formatter ttnpb.PayloadFormatter, parameter string) *ttnpb.DecodeUplinkRequest {
+		return &ttnpb.DecodeUplinkRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+				DeviceID:               deviceID,
+			},
+			Uplink: ttnpb.ApplicationUplink{
+				FPort:      1,
+				FRMPayload: []byte{0x01, 0x00, 0xff},
+			},
+			Formatter: formatter,
+			Parameter: parameter,
+		}
+	}
+
+	// The given payload formatter is used.
+	res, err := as.DecodeUplink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT, `
+		function Decoder(payload, f_port) {
+			return { value: payload[2] }
+		}
+		function Normalizer(decoded, f_port) {
+			return { battery: decoded.value }
+		}
+	`))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		m, err := gogoproto.Map(res.Uplink.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{"value": 255.0})
+		a.So(res.Uplink.NormalizedPayload, should.BeEmpty)
+		a.So(res.Uplink.NormalizedPayloadWarnings, should.HaveLength, 1)
+	}
+
+	// Errors of the payload formatter are returned in the response.
+	res, err = as.DecodeUplink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT, `
+		function Decoder(payload, f_port) {
+			throw Error('invalid payload')
+		}
+	`))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.NotBeNil)
+		a.So(res.Uplink.DecodedPayload, should.BeNil)
+	}
+
+	// The payload formatter of the end device is used if no payload formatter is given.
+	res, err = as.DecodeUplink(ctx, request("with-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		a.So(res.Uplink.DecodedPayload.GetFields(), should.ContainKey, "digital_in_1")
+	}
+
+	// The payload formatter of the FPort range of the end device is used if the FPort is in the range.
+	res, err = as.DecodeUplink(ctx, request("with-f-port-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		a.So(res.Uplink.DecodedPayload.GetFields(), should.ContainKey, "digital_in_1")
+	}
+	req := request("with-f-port-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, "")
+	req.Uplink.FPort = 15
+	res, err = as.DecodeUplink(ctx, req)
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		m, err := gogoproto.Map(res.Uplink.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{"port": 15.0})
+	}
+
+	// Without payload formatter of the end device or the link, no payload formatter is configured.
+	_, err = as.DecodeUplink(ctx, request("without-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	// The default payload formatter of the link is used if the end device has no payload formatters.
+	as.linkRegistry = linkGetter{
+		link: &ttnpb.ApplicationLink{
+			DefaultFormatters: &ttnpb.MessagePayloadFormatters{
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			},
+		},
+	}
+	res, err = as.DecodeUplink(ctx, request("without-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	if a.So(err, should.BeNil) {
+		a.So(res.Uplink.DecodedPayload.GetFields(), should.ContainKey, "digital_in_1")
+	}
+
+	// End devices that are not found are not decoded with the payload formatter of the end device.
+	_, err = as.DecodeUplink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestEncodeDownlink(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	as := &ApplicationServer{
+		deviceRegistry: deviceGetter{
+			devices: map[string]*ttnpb.EndDevice{
+				"with-formatters": {
+					Formatters: &ttnpb.MessagePayloadFormatters{
+						DownFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+						DownFormatterParameter: "function Encoder(payload, f_port) { return [payload.value] }",
+					},
+				},
+				"without-formatters": {},
+			},
+		},
+		linkRegistry: linkGetter{},
+		formatter: payloadFormatter{
+			downFormatters: map[ttnpb.PayloadFormatter]messageprocessors.PayloadEncoder{
+				ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT: javascript.New(scripting.DefaultOptions),
+			},
+		},
+	}
+	request := func(deviceID string, formatter ttnpb.PayloadFormatter, parameter string) *ttnpb.EncodeDownlinkRequest {
+		decoded, err := gogoproto.Struct(map[string]interface{}{
+			"value": 42,
+		})
+		if err != nil {
+			t.Fatalf("Failed to build decoded payload: %v", err)
+		}
+		return &ttnpb.EncodeDownlinkRequest{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+				DeviceID:               deviceID,
+			},
+			Downlink: ttnpb.ApplicationDownlink{
+				FPort:          1,
+				DecodedPayload: decoded,
+			},
+			Formatter: formatter,
+			Parameter: parameter,
+		}
+	}
+
+	// The given payload formatter is used.
+	res, err := as.EncodeDownlink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT, `
+		function Encoder(payload, f_port) {
+			return [f_port, payload.value]
+		}
+	`))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		a.So(res.Downlink.FRMPayload, should.Resemble, []byte{0x01, 0x2a})
+	}
+
+	// Errors of fields of the decoded payload are returned in the details of the error.
+	res, err = as.EncodeDownlink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT, `
+		function Encoder(payload, f_port) {
+			return { errors: [{ field: "value", message: "must be less than 10" }] }
+		}
+	`))
+	if a.So(err, should.BeNil) && a.So(res.Error, should.NotBeNil) {
+		a.So(res.Error.Name, should.Equal, "decoded_payload")
+		a.So(res.Downlink.FRMPayload, should.BeNil)
+		if a.So(res.Error.Details, should.HaveLength, 1) {
+			details := &ttnpb.EncodeDownlinkErrorDetails{}
+			a.So(pbtypes.UnmarshalAny(res.Error.Details[0], details), should.BeNil)
+			if a.So(details.FieldErrors, should.HaveLength, 1) {
+				m, err := gogoproto.Map(details.FieldErrors[0].Attributes)
+				a.So(err, should.BeNil)
+				a.So(m, should.Resemble, map[string]interface{}{
+					"field":   "value",
+					"message": "must be less than 10",
+				})
+			}
+		}
+	}
+
+	// The payload formatter of the end device is used if no payload formatter is given.
+	res, err = as.EncodeDownlink(ctx, request("with-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	if a.So(err, should.BeNil) {
+		a.So(res.Error, should.BeNil)
+		a.So(res.Downlink.FRMPayload, should.Resemble, []byte{0x2a})
+	}
+
+	// Without payload formatter of the end device or the link, no payload formatter is configured.
+	_, err = as.EncodeDownlink(ctx, request("without-formatters", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	// End devices that are not found are not encoded with the payload formatter of the end device.
+	_, err = as.EncodeDownlink(ctx, request("unknown", ttnpb.PayloadFormatter_FORMATTER_NONE, ""))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestFPortFormatters(t *testing.T) {
+	a := assertions.New(t)
+
+	formatters := &ttnpb.MessagePayloadFormatters{
+		UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+		DownFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+		FPortFormatters: []*ttnpb.FPortPayloadFormatters{
+			{
+				FPortRange:             ttnpb.FPortRange{Min: 1, Max: 10},
+				UpFormatter:            ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				UpFormatterParameter:   "up",
+				DownFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				DownFormatterParameter: "down",
+			},
+			{
+				FPortRange:  ttnpb.FPortRange{Min: 5, Max: 100},
+				UpFormatter: ttnpb.PayloadFormatter_FORMATTER_CBOR,
+			},
+		},
+	}
+	for _, tc := range []struct {
+		FPort         uint32
+		UpFormatter   ttnpb.PayloadFormatter
+		UpParameter   string
+		DownFormatter ttnpb.PayloadFormatter
+		DownParameter string
+	}{
+		{
+			FPort:         1,
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			UpParameter:   "up",
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			DownParameter: "down",
+		},
+		{
+			FPort:         10,
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			UpParameter:   "up",
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			DownParameter: "down",
+		},
+		{
+			FPort:         100,
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_CBOR,
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_NONE,
+		},
+		{
+			FPort:         200,
+			UpFormatter:   ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+		},
+	} {
+		formatter, parameter := upFormatter(formatters, tc.FPort)
+		a.So(formatter, should.Equal, tc.UpFormatter)
+		a.So(parameter, should.Equal, tc.UpParameter)
+		formatter, parameter = downFormatter(formatters, tc.FPort)
+		a.So(formatter, should.Equal, tc.DownFormatter)
+		a.So(parameter, should.Equal, tc.DownParameter)
+	}
+}
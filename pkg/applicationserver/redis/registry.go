@@ -384,3 +384,306 @@ func (r *LinkRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers
 	}
 	return pb, nil
 }
+
+// DeviceStateRegistry is a Redis end device state registry.
+type DeviceStateRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DeviceStateRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the state of the end device by its identifiers.
+func (r *DeviceStateRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceState, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get end device state").End()
+
+	pb := &ttnpb.EndDeviceState{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set creates, updates or deletes the state of the end device by its identifiers.
+func (r *DeviceStateRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.EndDeviceState) (*ttnpb.EndDeviceState, error)) (*ttnpb.EndDeviceState, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "set end device state").End()
+
+	var pb *ttnpb.EndDeviceState
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		stored := &ttnpb.EndDeviceState{}
+		if err := ttnredis.GetProto(tx, uk).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			pb.EndDeviceIdentifiers = ids
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, uk, pb, 0)
+				return err
+			}
+		}
+		_, err = tx.Pipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// RetainedUplinkRegistry is a Redis registry of the last uplink message of end devices.
+// The messages of the end devices of an application are stored in a hash by end device ID.
+type RetainedUplinkRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *RetainedUplinkRegistry) appKey(uid string) string {
+	return r.Redis.Key("application", uid)
+}
+
+// Range ranges the last uplink messages of the end devices of the application and calls the callback function,
+// until false is returned.
+func (r *RetainedUplinkRegistry) Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.ApplicationUp) bool) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "range retained uplink messages").End()
+
+	values, err := r.Redis.HGetAll(r.appKey(unique.ID(ctx, ids))).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	for _, s := range values {
+		up := &ttnpb.ApplicationUp{}
+		if err := ttnredis.UnmarshalProto(s, up); err != nil {
+			return err
+		}
+		if !f(up) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Set sets or deletes the last uplink message of the end device by its identifiers.
+func (r *RetainedUplinkRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUp) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	ak := r.appKey(unique.ID(ctx, ids.ApplicationIdentifiers))
+
+	defer trace.StartRegion(ctx, "set retained uplink message").End()
+
+	if up == nil {
+		return ttnredis.ConvertError(r.Redis.HDel(ak, ids.DeviceID).Err())
+	}
+	s, err := ttnredis.MarshalProto(up)
+	if err != nil {
+		return err
+	}
+	return ttnredis.ConvertError(r.Redis.HSet(ak, ids.DeviceID, s).Err())
+}
+
+// PayloadFormattersHistoryRegistry is a Redis registry of the versions of payload formatters.
+// The versions of an end device or application link are stored in a list, newest first, next to a counter of the
+// last version number.
+type PayloadFormattersHistoryRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *PayloadFormattersHistoryRegistry) key(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) (string, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return "", err
+	}
+	if deviceID == "" {
+		return r.Redis.Key("application", unique.ID(ctx, ids)), nil
+	}
+	devIDs := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ids,
+		DeviceID:               deviceID,
+	}
+	if err := devIDs.ValidateContext(ctx); err != nil {
+		return "", err
+	}
+	return r.Redis.Key("device", unique.ID(ctx, devIDs)), nil
+}
+
+func versionKey(k string) string {
+	return ttnredis.Key(k, "version")
+}
+
+// Add stores the payload formatters as new version and returns the stored version.
+func (r *PayloadFormattersHistoryRegistry) Add(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, formatters *ttnpb.MessagePayloadFormatters, size int) (*ttnpb.PayloadFormattersVersion, error) {
+	k, err := r.key(ctx, ids, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "add payload formatters version").End()
+
+	version, err := r.Redis.Incr(versionKey(k)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pb := &ttnpb.PayloadFormattersVersion{
+		Version:    uint32(version),
+		Formatters: *formatters,
+		CreatedAt:  time.Now().UTC(),
+	}
+	s, err := ttnredis.MarshalProto(pb)
+	if err != nil {
+		return nil, err
+	}
+	_, err = r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.LPush(k, s)
+		p.LTrim(k, 0, int64(size-1))
+		return nil
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// List returns the stored versions, newest first.
+func (r *PayloadFormattersHistoryRegistry) List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) ([]*ttnpb.PayloadFormattersVersion, error) {
+	k, err := r.key(ctx, ids, deviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "list payload formatters versions").End()
+
+	values, err := r.Redis.LRange(k, 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	versions := make([]*ttnpb.PayloadFormattersVersion, 0, len(values))
+	for _, s := range values {
+		pb := &ttnpb.PayloadFormattersVersion{}
+		if err := ttnredis.UnmarshalProto(s, pb); err != nil {
+			return nil, err
+		}
+		versions = append(versions, pb)
+	}
+	return versions, nil
+}
+
+// Delete deletes the stored versions.
+func (r *PayloadFormattersHistoryRegistry) Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) error {
+	k, err := r.key(ctx, ids, deviceID)
+	if err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "delete payload formatters versions").End()
+
+	return ttnredis.ConvertError(r.Redis.Del(k, versionKey(k)).Err())
+}
+
+// DownlinkStatusRegistry is a Redis registry of the delivery status of tracked confirmed downlink messages.
+// The statuses of an end device are stored together by its unique ID.
+type DownlinkStatusRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DownlinkStatusRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get returns the statuses of the tracked downlink messages of the end device, oldest first.
+func (r *DownlinkStatusRegistry) Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationDownlinkStatuses, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get downlink statuses").End()
+
+	pb := &ttnpb.ApplicationDownlinkStatuses{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set creates, updates or deletes the statuses of the tracked downlink messages of the end device.
+func (r *DownlinkStatusRegistry) Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, ttl time.Duration, f func(*ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error)) (*ttnpb.ApplicationDownlinkStatuses, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "set downlink statuses").End()
+
+	var pb *ttnpb.ApplicationDownlinkStatuses
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		stored := &ttnpb.ApplicationDownlinkStatuses{}
+		if err := ttnredis.GetProto(tx, uk).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if pb == nil {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, uk, pb, ttl)
+				return err
+			}
+		}
+		_, err = tx.Pipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -42,3 +43,45 @@ type LinkRegistry interface {
 	// Set creates, updates or deletes the link by the application identifiers.
 	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, paths []string, f func(*ttnpb.ApplicationLink) (*ttnpb.ApplicationLink, []string, error)) (*ttnpb.ApplicationLink, error)
 }
+
+// DeviceStateRegistry is a store for the current state of end devices.
+type DeviceStateRegistry interface {
+	// Get returns the state of the end device by its identifiers.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.EndDeviceState, error)
+	// Set creates, updates or deletes the state of the end device by its identifiers.
+	// The state is deleted if the callback function returns nil.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, f func(*ttnpb.EndDeviceState) (*ttnpb.EndDeviceState, error)) (*ttnpb.EndDeviceState, error)
+}
+
+// RetainedUplinkRegistry is a store for the last uplink message of end devices.
+type RetainedUplinkRegistry interface {
+	// Range ranges the last uplink messages of the end devices of the application and calls the callback function,
+	// until false is returned.
+	Range(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.ApplicationUp) bool) error
+	// Set sets or deletes the last uplink message of the end device by its identifiers.
+	// The message is deleted if it is nil.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.ApplicationUp) error
+}
+
+// PayloadFormattersHistoryRegistry is a store for the versions of the payload formatters of end devices and of the
+// default payload formatters of application links.
+// The versions of the application link are identified by an empty device ID.
+type PayloadFormattersHistoryRegistry interface {
+	// Add stores the payload formatters as new version and returns the stored version.
+	// Only the given number of most recent versions are kept.
+	Add(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string, formatters *ttnpb.MessagePayloadFormatters, size int) (*ttnpb.PayloadFormattersVersion, error)
+	// List returns the stored versions, newest first.
+	List(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) ([]*ttnpb.PayloadFormattersVersion, error)
+	// Delete deletes the stored versions.
+	Delete(ctx context.Context, ids ttnpb.ApplicationIdentifiers, deviceID string) error
+}
+
+// DownlinkStatusRegistry is a store for the delivery status of the tracked confirmed downlink messages of end devices.
+type DownlinkStatusRegistry interface {
+	// Get returns the statuses of the tracked downlink messages of the end device, oldest first.
+	Get(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationDownlinkStatuses, error)
+	// Set creates, updates or deletes the statuses of the tracked downlink messages of the end device.
+	// The statuses are deleted if the callback function returns nil. The statuses expire after the given TTL,
+	// unless it is zero.
+	Set(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, ttl time.Duration, f func(*ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error)) (*ttnpb.ApplicationDownlinkStatuses, error)
+}
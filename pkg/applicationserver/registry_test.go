@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/applicationserver/redis"
@@ -144,3 +145,194 @@ func TestLinkRegistry(t *testing.T) {
 		}
 	}
 }
+
+func TestRetainedUplinkRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	reg := &redis.RetainedUplinkRegistry{Redis: cl}
+
+	appIDs := ttnpb.ApplicationIdentifiers{
+		ApplicationID: "foo-app",
+	}
+	up := func(deviceID string, fPort uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appIDs,
+				DeviceID:               deviceID,
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{
+					FPort: fPort,
+				},
+			},
+		}
+	}
+	rangeUps := func() map[string]*ttnpb.ApplicationUp {
+		ups := make(map[string]*ttnpb.ApplicationUp)
+		err := reg.Range(ctx, appIDs, func(up *ttnpb.ApplicationUp) bool {
+			ups[up.DeviceID] = up
+			return true
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return ups
+	}
+
+	a.So(rangeUps(), should.BeEmpty)
+
+	for _, up := range []*ttnpb.ApplicationUp{
+		up("foo-device", 1),
+		up("bar-device", 1),
+		up("foo-device", 2),
+	} {
+		if err := reg.Set(ctx, up.EndDeviceIdentifiers, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	a.So(rangeUps(), should.Resemble, map[string]*ttnpb.ApplicationUp{
+		"foo-device": up("foo-device", 2),
+		"bar-device": up("bar-device", 1),
+	})
+
+	if err := reg.Set(ctx, up("foo-device", 0).EndDeviceIdentifiers, nil); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(rangeUps(), should.Resemble, map[string]*ttnpb.ApplicationUp{
+		"bar-device": up("bar-device", 1),
+	})
+}
+
+func TestPayloadFormattersHistoryRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	reg := &redis.PayloadFormattersHistoryRegistry{Redis: cl}
+
+	appIDs := ttnpb.ApplicationIdentifiers{
+		ApplicationID: "foo-app",
+	}
+	formatters := func(parameter string) *ttnpb.MessagePayloadFormatters {
+		return &ttnpb.MessagePayloadFormatters{
+			UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			UpFormatterParameter: parameter,
+		}
+	}
+	list := func(deviceID string) []string {
+		versions, err := reg.List(ctx, appIDs, deviceID)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		res := make([]string, 0, len(versions))
+		for _, v := range versions {
+			res = append(res, fmt.Sprintf("%d:%s", v.Version, v.Formatters.UpFormatterParameter))
+		}
+		return res
+	}
+
+	a.So(list(""), should.BeEmpty)
+
+	for _, parameter := range []string{"a", "b", "c"} {
+		v, err := reg.Add(ctx, appIDs, "foo-device", formatters(parameter), 2)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(v.Formatters, should.Resemble, *formatters(parameter))
+	}
+	v, err := reg.Add(ctx, appIDs, "", formatters("link"), 2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(v.Version, should.Equal, 1)
+
+	// Only the most recent versions are kept, and version numbers are per end device or link.
+	a.So(list("foo-device"), should.Resemble, []string{"3:c", "2:b"})
+	a.So(list(""), should.Resemble, []string{"1:link"})
+	a.So(list("bar-device"), should.BeEmpty)
+
+	// Invalid device IDs are rejected.
+	_, err = reg.List(ctx, appIDs, "Foo")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Deleting the versions resets the version number.
+	if err := reg.Delete(ctx, appIDs, "foo-device"); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(list("foo-device"), should.BeEmpty)
+	a.So(list(""), should.Resemble, []string{"1:link"})
+	v, err = reg.Add(ctx, appIDs, "foo-device", formatters("d"), 2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(v.Version, should.Equal, 1)
+}
+
+func TestDownlinkStatusRegistry(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	cl, flush := test.NewRedis(t, "applicationserver_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	reg := &redis.DownlinkStatusRegistry{Redis: cl}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	_, err := reg.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	statuses := &ttnpb.ApplicationDownlinkStatuses{
+		Statuses: []*ttnpb.ApplicationDownlinkStatus{
+			{
+				Downlink: ttnpb.ApplicationDownlink{
+					FPort:          1,
+					Confirmed:      true,
+					CorrelationIDs: []string{"as:downlink:track:test"},
+				},
+				State:     ttnpb.ApplicationDownlinkStatus_QUEUED,
+				UpdatedAt: time.Now().UTC(),
+			},
+		},
+	}
+	_, err = reg.Set(ctx, ids, time.Hour, func(stored *ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		a.So(stored, should.BeNil)
+		return statuses, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	stored, err := reg.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(stored, should.Resemble, statuses)
+
+	// The statuses expire after the TTL.
+	ttl, err := cl.TTL(cl.Key("uid", unique.ID(ctx, ids))).Result()
+	a.So(err, should.BeNil)
+	a.So(ttl, should.BeBetweenOrEqual, time.Hour-time.Minute, time.Hour)
+
+	_, err = reg.Set(ctx, ids, time.Hour, func(stored *ttnpb.ApplicationDownlinkStatuses) (*ttnpb.ApplicationDownlinkStatuses, error) {
+		a.So(stored, should.Resemble, statuses)
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	_, err = reg.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,89 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/devicerepository"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// repositoryFormatterCache keeps the payload formatters of the end device versions of the Device Repository in memory,
+// so that the Device Repository is not fetched for every message. The payload formatters of all versions of a model
+// are fetched at once, and are fetched again when they expire, so that updates of the Device Repository are applied.
+type repositoryFormatterCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]repositoryFormatterEntry
+}
+
+type repositoryFormatterEntry struct {
+	formatters map[string]ttnpb.MessagePayloadFormatters
+	expiresAt  time.Time
+}
+
+// newRepositoryFormatterCache returns a new repositoryFormatterCache that caches the payload formatters for ttl.
+func newRepositoryFormatterCache(ttl time.Duration) *repositoryFormatterCache {
+	return &repositoryFormatterCache{
+		ttl:     ttl,
+		entries: make(map[string]repositoryFormatterEntry),
+	}
+}
+
+func repositoryModelKey(brandID, modelID string) string {
+	return brandID + "/" + modelID
+}
+
+func repositoryVersionKey(hardwareVersion, firmwareVersion string) string {
+	return hardwareVersion + "/" + firmwareVersion
+}
+
+// Get returns the payload formatters of the given end device version, and whether the version is in the Device
+// Repository. If the versions of the model cannot be fetched, the last known payload formatters are used.
+func (c *repositoryFormatterCache) Get(ctx context.Context, repository *devicerepository.Client, version *ttnpb.EndDeviceVersionIdentifiers) (*ttnpb.MessagePayloadFormatters, bool, error) {
+	modelKey := repositoryModelKey(version.BrandID, version.ModelID)
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.entries[modelKey]
+	c.mu.Unlock()
+	if !ok || !now.Before(entry.expiresAt) {
+		versions, err := repository.DeviceVersions(version.BrandID, version.ModelID)
+		if err != nil {
+			if !ok {
+				return nil, false, err
+			}
+			log.FromContext(ctx).WithError(err).Warn("Failed to refresh payload formatters from Device Repository")
+		} else {
+			entry.formatters = make(map[string]ttnpb.MessagePayloadFormatters, len(versions))
+			for _, v := range versions {
+				entry.formatters[repositoryVersionKey(v.HardwareVersion, v.FirmwareVersion)] = v.DefaultFormatters
+			}
+		}
+		entry.expiresAt = now.Add(c.ttl)
+		c.mu.Lock()
+		c.entries[modelKey] = entry
+		c.mu.Unlock()
+	}
+	formatters, ok := entry.formatters[repositoryVersionKey(version.HardwareVersion, version.FirmwareVersion)]
+	if !ok {
+		return nil, false, nil
+	}
+	return &formatters, true, nil
+}
@@ -0,0 +1,52 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var errNoRetainedUplinkRegistry = errors.DefineUnimplemented("no_retained_uplink_registry", "no retained uplink message registry available")
+
+// RangeRetainedUplinks ranges the last uplink messages of the end devices of the given application.
+func (as *ApplicationServer) RangeRetainedUplinks(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.ApplicationUp) bool) error {
+	if as.retainedUplinks == nil {
+		return errNoRetainedUplinkRegistry
+	}
+	return as.retainedUplinks.Range(ctx, ids, f)
+}
+
+// retainUplink stores the uplink message as the last uplink message of the end device.
+// Failing to store the message does not fail the handling of the uplink message.
+func (as *ApplicationServer) retainUplink(ctx context.Context, up *ttnpb.ApplicationUp) {
+	if as.retainedUplinks == nil {
+		return
+	}
+	if err := as.retainedUplinks.Set(ctx, up.EndDeviceIdentifiers, up); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to retain uplink message")
+	}
+}
+
+// deleteRetainedUplink deletes the last uplink message of the end device.
+func (as *ApplicationServer) deleteRetainedUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) error {
+	if as.retainedUplinks == nil {
+		return nil
+	}
+	return as.retainedUplinks.Set(ctx, ids, nil)
+}
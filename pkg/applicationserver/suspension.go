@@ -0,0 +1,129 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+var errApplicationSuspended = errors.DefineFailedPrecondition("application_suspended", "application `{application_uid}` is suspended")
+
+// suspensionEvents are the names of the events published by the Identity Server when applications get suspended or
+// resumed.
+var suspensionEvents = []string{"application.suspend", "application.resume"}
+
+// suspensionCache keeps whether applications are suspended in memory, so that the Identity Server is not queried for
+// every message.
+type suspensionCache struct {
+	ttl   time.Duration
+	fetch func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error)
+
+	mu      sync.RWMutex
+	entries map[string]suspensionEntry
+}
+
+type suspensionEntry struct {
+	suspended bool
+	expiresAt time.Time
+}
+
+// newSuspensionCache returns a new suspensionCache that caches the results of fetch for ttl.
+func newSuspensionCache(ttl time.Duration, fetch func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error)) *suspensionCache {
+	return &suspensionCache{
+		ttl:     ttl,
+		fetch:   fetch,
+		entries: make(map[string]suspensionEntry),
+	}
+}
+
+// IsSuspended returns whether the given application is suspended.
+// If the suspension state cannot be fetched, the last known state is used. Unknown applications are not suspended.
+func (c *suspensionCache) IsSuspended(ctx context.Context, ids ttnpb.ApplicationIdentifiers) bool {
+	uid := unique.ID(ctx, ids)
+	now := time.Now()
+	c.mu.RLock()
+	entry, ok := c.entries[uid]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.suspended
+	}
+	suspended, err := c.fetch(ctx, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get application suspension state")
+		suspended = entry.suspended
+	}
+	c.set(uid, suspended, now)
+	return suspended
+}
+
+func (c *suspensionCache) set(uid string, suspended bool, now time.Time) {
+	c.mu.Lock()
+	c.entries[uid] = suspensionEntry{
+		suspended: suspended,
+		expiresAt: now.Add(c.ttl),
+	}
+	c.mu.Unlock()
+}
+
+// HandleEvent updates the suspension state of the applications in application.suspend and application.resume events.
+func (c *suspensionCache) HandleEvent(evt events.Event) {
+	var suspended bool
+	switch evt.Name() {
+	case "application.suspend":
+		suspended = true
+	case "application.resume":
+	default:
+		return
+	}
+	now := time.Now()
+	for _, ids := range evt.Identifiers() {
+		if appIDs := ids.GetApplicationIDs(); appIDs != nil {
+			c.set(unique.ID(context.Background(), *appIDs), suspended, now)
+		}
+	}
+}
+
+// fetchApplicationSuspended gets whether the application is suspended from the Identity Server.
+func (as *ApplicationServer) fetchApplicationSuspended(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (bool, error) {
+	cc, err := as.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return false, err
+	}
+	app, err := ttnpb.NewApplicationRegistryClient(cc).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"suspended"}},
+	}, as.WithClusterAuth())
+	if err != nil {
+		return false, err
+	}
+	return app.Suspended, nil
+}
+
+// requireNotSuspended returns an error if the given application is suspended.
+func (as *ApplicationServer) requireNotSuspended(ctx context.Context, ids ttnpb.ApplicationIdentifiers) error {
+	if as.suspensions.IsSuspended(ctx, ids) {
+		return errApplicationSuspended.WithAttributes("application_uid", unique.ID(ctx, ids))
+	}
+	return nil
+}
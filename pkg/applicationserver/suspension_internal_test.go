@@ -0,0 +1,74 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSuspensionCache(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	ids := ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"}
+
+	var (
+		fetches   int
+		suspended bool
+		fetchErr  error
+	)
+	cache := newSuspensionCache(time.Hour, func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error) {
+		fetches++
+		return suspended, fetchErr
+	})
+
+	a.So(cache.IsSuspended(ctx, ids), should.BeFalse)
+	a.So(fetches, should.Equal, 1)
+
+	// The cached state is used until it expires.
+	suspended = true
+	a.So(cache.IsSuspended(ctx, ids), should.BeFalse)
+	a.So(fetches, should.Equal, 1)
+
+	// Events update the cached state.
+	cache.HandleEvent(events.New(ctx, "application.suspend", ids, nil))
+	a.So(cache.IsSuspended(ctx, ids), should.BeTrue)
+	cache.HandleEvent(events.New(ctx, "application.resume", ids, nil))
+	a.So(cache.IsSuspended(ctx, ids), should.BeFalse)
+	cache.HandleEvent(events.New(ctx, "application.update", ids, nil))
+	a.So(cache.IsSuspended(ctx, ids), should.BeFalse)
+	a.So(fetches, should.Equal, 1)
+
+	// The last known state is used when the state cannot be fetched.
+	cache.ttl = 0
+	cache.HandleEvent(events.New(ctx, "application.suspend", ids, nil))
+	fetchErr = errors.New("unavailable")
+	a.So(cache.IsSuspended(ctx, ids), should.BeTrue)
+	a.So(fetches, should.Equal, 2)
+
+	fetchErr = nil
+	suspended = false
+	a.So(cache.IsSuspended(ctx, ids), should.BeFalse)
+	a.So(fetches, should.Equal, 3)
+}
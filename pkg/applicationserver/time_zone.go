@@ -0,0 +1,59 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// timeZoneFetcher fetches the time zones of end devices and applications from the Identity Server.
+type timeZoneFetcher struct {
+	as *ApplicationServer
+}
+
+// EndDeviceTimeZone implements schedules.TimeZoneFetcher.
+func (f timeZoneFetcher) EndDeviceTimeZone(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) (string, error) {
+	cc, err := f.as.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return "", err
+	}
+	dev, err := ttnpb.NewEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"time_zone"}},
+	}, f.as.WithClusterAuth())
+	if err != nil {
+		return "", err
+	}
+	return dev.TimeZone, nil
+}
+
+// ApplicationTimeZone implements schedules.TimeZoneFetcher.
+func (f timeZoneFetcher) ApplicationTimeZone(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (string, error) {
+	cc, err := f.as.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return "", err
+	}
+	app, err := ttnpb.NewApplicationRegistryClient(cc).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"time_zone"}},
+	}, f.as.WithClusterAuth())
+	if err != nil {
+		return "", err
+	}
+	return app.TimeZone, nil
+}
@@ -0,0 +1,166 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"sort"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// messageTraffic aggregates the application layer messages in one direction.
+type messageTraffic struct {
+	count,
+	confirmedCount,
+	payloadBytes uint64
+	minPayloadSize,
+	maxPayloadSize uint32
+	dataRates map[ttnpb.DataRateIndex]uint64
+}
+
+func (t *messageTraffic) add(payloadSize int, confirmed bool) {
+	size := uint32(payloadSize)
+	if t.count == 0 || size < t.minPayloadSize {
+		t.minPayloadSize = size
+	}
+	if size > t.maxPayloadSize {
+		t.maxPayloadSize = size
+	}
+	t.count++
+	if confirmed {
+		t.confirmedCount++
+	}
+	t.payloadBytes += uint64(size)
+}
+
+func (t *messageTraffic) addDataRate(idx ttnpb.DataRateIndex) {
+	if t.dataRates == nil {
+		t.dataRates = make(map[ttnpb.DataRateIndex]uint64)
+	}
+	t.dataRates[idx]++
+}
+
+func (t *messageTraffic) proto() *ttnpb.MessageTrafficStats {
+	pb := &ttnpb.MessageTrafficStats{
+		Count:          t.count,
+		ConfirmedCount: t.confirmedCount,
+		PayloadBytes:   t.payloadBytes,
+		MinPayloadSize: t.minPayloadSize,
+		MaxPayloadSize: t.maxPayloadSize,
+	}
+	for idx, n := range t.dataRates {
+		pb.DataRates = append(pb.DataRates, &ttnpb.DataRateIndexCount{
+			DataRateIndex: idx,
+			Count:         n,
+		})
+	}
+	sort.Slice(pb.DataRates, func(i, j int) bool {
+		return pb.DataRates[i].DataRateIndex < pb.DataRates[j].DataRateIndex
+	})
+	return pb
+}
+
+type deviceProfileTraffic struct {
+	uplink,
+	downlink messageTraffic
+}
+
+// trafficStats aggregates the application layer traffic of an application, in total and per device profile.
+// The device profile is identified by the version identifiers of the end device.
+type trafficStats struct {
+	mu       sync.Mutex
+	uplink   messageTraffic
+	downlink messageTraffic
+	profiles map[ttnpb.EndDeviceVersionIdentifiers]*deviceProfileTraffic
+}
+
+func newTrafficStats() *trafficStats {
+	return &trafficStats{
+		profiles: make(map[ttnpb.EndDeviceVersionIdentifiers]*deviceProfileTraffic),
+	}
+}
+
+// profile returns the traffic of the device profile identified by the given version identifiers.
+// The caller must hold the lock.
+func (s *trafficStats) profile(ids *ttnpb.EndDeviceVersionIdentifiers) *deviceProfileTraffic {
+	var key ttnpb.EndDeviceVersionIdentifiers
+	if ids != nil {
+		key = ttnpb.EndDeviceVersionIdentifiers{
+			BrandID:         ids.BrandID,
+			ModelID:         ids.ModelID,
+			HardwareVersion: ids.HardwareVersion,
+			FirmwareVersion: ids.FirmwareVersion,
+		}
+	}
+	p, ok := s.profiles[key]
+	if !ok {
+		p = &deviceProfileTraffic{}
+		s.profiles[key] = p
+	}
+	return p
+}
+
+// AddUplink adds the given uplink message of an end device with the given version identifiers.
+func (s *trafficStats) AddUplink(versionIDs *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.profile(versionIDs)
+	for _, t := range []*messageTraffic{&s.uplink, &p.uplink} {
+		t.add(len(msg.FRMPayload), msg.Confirmed)
+		t.addDataRate(msg.Settings.DataRateIndex)
+	}
+}
+
+// AddDownlinks adds the given downlink messages of an end device with the given version identifiers.
+func (s *trafficStats) AddDownlinks(versionIDs *ttnpb.EndDeviceVersionIdentifiers, msgs ...*ttnpb.ApplicationDownlink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.profile(versionIDs)
+	for _, msg := range msgs {
+		for _, t := range []*messageTraffic{&s.downlink, &p.downlink} {
+			t.add(len(msg.FRMPayload), msg.Confirmed)
+		}
+	}
+}
+
+// Stats returns the aggregated uplink and downlink traffic, and the traffic per device profile ordered by version
+// identifiers.
+func (s *trafficStats) Stats() (uplink, downlink *ttnpb.MessageTrafficStats, profiles []*ttnpb.DeviceProfileTrafficStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for ids, p := range s.profiles {
+		ids := ids
+		profiles = append(profiles, &ttnpb.DeviceProfileTrafficStats{
+			VersionIDs: &ids,
+			Uplink:     p.uplink.proto(),
+			Downlink:   p.downlink.proto(),
+		})
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		a, b := profiles[i].VersionIDs, profiles[j].VersionIDs
+		switch {
+		case a.BrandID != b.BrandID:
+			return a.BrandID < b.BrandID
+		case a.ModelID != b.ModelID:
+			return a.ModelID < b.ModelID
+		case a.HardwareVersion != b.HardwareVersion:
+			return a.HardwareVersion < b.HardwareVersion
+		default:
+			return a.FirmwareVersion < b.FirmwareVersion
+		}
+	})
+	return s.uplink.proto(), s.downlink.proto(), profiles
+}
@@ -0,0 +1,109 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestTrafficStats(t *testing.T) {
+	a := assertions.New(t)
+
+	fooIDs := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "foo-brand",
+		ModelID:         "foo-model",
+		FirmwareVersion: "1.0",
+	}
+	barIDs := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "foo-brand",
+		ModelID:         "foo-model",
+		FirmwareVersion: "1.1",
+	}
+
+	stats := newTrafficStats()
+	stats.AddUplink(fooIDs, &ttnpb.ApplicationUplink{
+		FRMPayload: make([]byte, 10),
+		Settings:   ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_5},
+	})
+	stats.AddUplink(fooIDs, &ttnpb.ApplicationUplink{
+		FRMPayload: make([]byte, 40),
+		Settings:   ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_0},
+		Confirmed:  true,
+	})
+	stats.AddUplink(barIDs, &ttnpb.ApplicationUplink{
+		FRMPayload: make([]byte, 20),
+		Settings:   ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_5},
+	})
+	stats.AddUplink(nil, &ttnpb.ApplicationUplink{
+		FRMPayload: make([]byte, 5),
+		Settings:   ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_3},
+	})
+	stats.AddDownlinks(barIDs,
+		&ttnpb.ApplicationDownlink{FRMPayload: make([]byte, 2), Confirmed: true},
+		&ttnpb.ApplicationDownlink{FRMPayload: make([]byte, 4)},
+	)
+
+	uplink, downlink, profiles := stats.Stats()
+	a.So(uplink, should.Resemble, &ttnpb.MessageTrafficStats{
+		Count:          4,
+		ConfirmedCount: 1,
+		PayloadBytes:   75,
+		MinPayloadSize: 5,
+		MaxPayloadSize: 40,
+		DataRates: []*ttnpb.DataRateIndexCount{
+			{DataRateIndex: ttnpb.DATA_RATE_0, Count: 1},
+			{DataRateIndex: ttnpb.DATA_RATE_3, Count: 1},
+			{DataRateIndex: ttnpb.DATA_RATE_5, Count: 2},
+		},
+	})
+	a.So(downlink, should.Resemble, &ttnpb.MessageTrafficStats{
+		Count:          2,
+		ConfirmedCount: 1,
+		PayloadBytes:   6,
+		MinPayloadSize: 2,
+		MaxPayloadSize: 4,
+	})
+	if !a.So(profiles, should.HaveLength, 3) {
+		t.FailNow()
+	}
+
+	// End devices without version identifiers come first.
+	a.So(profiles[0].VersionIDs, should.Resemble, &ttnpb.EndDeviceVersionIdentifiers{})
+	a.So(profiles[0].Uplink.Count, should.Equal, 1)
+	a.So(profiles[0].Downlink.Count, should.Equal, 0)
+
+	a.So(profiles[1].VersionIDs, should.Resemble, fooIDs)
+	a.So(profiles[1].Uplink, should.Resemble, &ttnpb.MessageTrafficStats{
+		Count:          2,
+		ConfirmedCount: 1,
+		PayloadBytes:   50,
+		MinPayloadSize: 10,
+		MaxPayloadSize: 40,
+		DataRates: []*ttnpb.DataRateIndexCount{
+			{DataRateIndex: ttnpb.DATA_RATE_0, Count: 1},
+			{DataRateIndex: ttnpb.DATA_RATE_5, Count: 1},
+		},
+	})
+	a.So(profiles[1].Downlink.Count, should.Equal, 0)
+
+	a.So(profiles[2].VersionIDs, should.Resemble, barIDs)
+	a.So(profiles[2].Uplink.Count, should.Equal, 1)
+	a.So(profiles[2].Downlink.Count, should.Equal, 2)
+	a.So(profiles[2].Downlink.ConfirmedCount, should.Equal, 1)
+}
@@ -60,7 +60,7 @@ func TestRx1DataRate(t *testing.T) {
 		},
 		{
 			bandID:       "EU_863_870",
-			validIndexes: []ttnpb.DataRateIndex{0, 1, 2, 3, 4, 5, 6, 7}, invalidIndexes: []ttnpb.DataRateIndex{8, 9, 10, 11, 12, 13, 14, 15},
+			validIndexes: []ttnpb.DataRateIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, invalidIndexes: []ttnpb.DataRateIndex{12, 13, 14, 15},
 			validOffsets: []uint32{0, 1, 2, 3, 4, 5}, invalidOffsets: []uint32{6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
 		},
 		{
@@ -80,7 +80,7 @@ func TestRx1DataRate(t *testing.T) {
 		},
 		{
 			bandID:       "US_902_928",
-			validIndexes: []ttnpb.DataRateIndex{0, 1, 2, 3, 4}, invalidIndexes: []ttnpb.DataRateIndex{5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			validIndexes: []ttnpb.DataRateIndex{0, 1, 2, 3, 4, 5, 6}, invalidIndexes: []ttnpb.DataRateIndex{7, 8, 9, 10, 11, 12, 13, 14, 15},
 			validOffsets: []uint32{0, 1, 2, 3}, invalidOffsets: []uint32{4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
 		},
 	} {
@@ -35,7 +35,7 @@ func init() {
 	}
 	euBeaconChannel := uint32(869525000)
 
-	downlinkDRTable := [8][6]ttnpb.DataRateIndex{
+	downlinkDRTable := [12][6]ttnpb.DataRateIndex{
 		{0, 0, 0, 0, 0, 0},
 		{1, 0, 0, 0, 0, 0},
 		{2, 1, 0, 0, 0, 0},
@@ -44,6 +44,10 @@ func init() {
 		{5, 4, 3, 2, 1, 0},
 		{6, 5, 4, 3, 2, 1},
 		{7, 6, 5, 4, 3, 2},
+		{1, 0, 0, 0, 0, 0},
+		{2, 1, 0, 0, 0, 0},
+		{1, 0, 0, 0, 0, 0},
+		{2, 1, 0, 0, 0, 0},
 	}
 
 	eu_863_870 = Band{
@@ -134,7 +138,27 @@ func init() {
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{
 				BitRate: 50000,
 			}}}, DefaultMaxSize: constPayloadSizer(230)},
-			{}, {}, {}, {}, {}, {}, {}, // RFU
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 137000,
+				CodingRate:            "1/3",
+			}}}, DefaultMaxSize: constPayloadSizer(58)},
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 137000,
+				CodingRate:            "2/3",
+			}}}, DefaultMaxSize: constPayloadSizer(123)},
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 336000,
+				CodingRate:            "1/3",
+			}}}, DefaultMaxSize: constPayloadSizer(58)},
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 336000,
+				CodingRate:            "2/3",
+			}}}, DefaultMaxSize: constPayloadSizer(123)},
+			{}, {}, {}, // RFU
 			{}, // Used by LinkADRReq starting from LoRaWAN Regional Parameters 1.1, RFU before
 		},
 		MaxADRDataRateIndex: 5,
@@ -159,8 +183,8 @@ func init() {
 
 		Rx1Channel: channelIndexIdentity,
 		Rx1DataRate: func(idx ttnpb.DataRateIndex, offset uint32, _ bool) (ttnpb.DataRateIndex, error) {
-			if idx > 7 {
-				return 0, errDataRateIndexTooHigh.WithAttributes("max", 7)
+			if idx > 11 {
+				return 0, errDataRateIndexTooHigh.WithAttributes("max", 11)
 			}
 			if offset > 5 {
 				return 0, errDataRateOffsetTooHigh.WithAttributes("max", 5)
@@ -53,12 +53,14 @@ func init() {
 		})
 	}
 
-	downlinkDRTable := [5][4]ttnpb.DataRateIndex{
+	downlinkDRTable := [7][4]ttnpb.DataRateIndex{
 		{10, 9, 8, 8},
 		{11, 10, 9, 8},
 		{12, 11, 10, 9},
 		{13, 12, 11, 10},
 		{13, 13, 12, 11},
+		{10, 9, 8, 8},
+		{11, 10, 9, 8},
 	}
 
 	us_902_928 = Band{
@@ -101,7 +103,17 @@ func init() {
 				SpreadingFactor: 8,
 				Bandwidth:       500000,
 			}}}, DefaultMaxSize: constPayloadSizer(250)},
-			{}, {}, {}, // RFU
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 1523000,
+				CodingRate:            "1/3",
+			}}}, DefaultMaxSize: constPayloadSizer(58)},
+			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{
+				ModulationType:        0,
+				OperatingChannelWidth: 1523000,
+				CodingRate:            "2/3",
+			}}}, DefaultMaxSize: constPayloadSizer(133)},
+			{}, // RFU
 			{Rate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
 				SpreadingFactor: 12,
 				Bandwidth:       500000,
@@ -152,8 +164,8 @@ func init() {
 
 		Rx1Channel: channelIndexModulo(8),
 		Rx1DataRate: func(idx ttnpb.DataRateIndex, offset uint32, _ bool) (ttnpb.DataRateIndex, error) {
-			if idx > 4 {
-				return 0, errDataRateIndexTooHigh.WithAttributes("max", 4)
+			if idx > 6 {
+				return 0, errDataRateIndexTooHigh.WithAttributes("max", 6)
 			}
 			if offset > 3 {
 				return 0, errDataRateOffsetTooHigh.WithAttributes("max", 3)
@@ -252,7 +252,7 @@ var errNoTrust = errors.DefineInternal("no_trust", "no trusted certificate found
 
 // parseAddress parses a CUPS or LNS address.
 //
-// It supports the typical format "host:port" (port being optional).
+// It supports the typical format "host:port" (port being optional). IPv6 hosts are returned without brackets.
 // It allows schemes "http://host:port" to be present.
 // If schemes http/https/ws/wss are used, the port is inferred if not present.
 func parseAddress(address string) (scheme, host, port string, err error) {
@@ -269,7 +269,8 @@ func parseAddress(address string) (scheme, host, port string, err error) {
 	if strings.Contains(address, ":") {
 		host, port, err = net.SplitHostPort(address)
 		if err != nil {
-			host = address
+			// No port, i.e. an IPv6 address like 2001:db8::1 or [2001:db8::1].
+			host = strings.Trim(address, "[]")
 			err = nil
 		}
 	} else {
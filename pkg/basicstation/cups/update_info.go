@@ -258,6 +258,8 @@ func (s *Server) UpdateInfo(c echo.Context) error {
 		address := host
 		if port != "" {
 			address = net.JoinHostPort(host, port)
+		} else if strings.Contains(host, ":") {
+			address = "[" + host + "]"
 		}
 		res.LNSURI = fmt.Sprintf("%s://%s", scheme, address)
 	}
@@ -33,6 +33,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
 )
 
 // Cluster interface that is implemented by all different clustering implementations.
@@ -106,6 +107,13 @@ func WithTLSConfig(tlsConfig *tls.Config) Option {
 	})
 }
 
+// WithDialOptions adds gRPC dial options to the connections to cluster peers.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return optionFunc(func(c *cluster) {
+		c.dialOptions = append(c.dialOptions, opts...)
+	})
+}
+
 // CustomNew allows you to replace the clustering implementation. New will call CustomNew if not nil.
 var CustomNew func(ctx context.Context, config *config.Cluster, options ...Option) (Cluster, error)
 
@@ -141,6 +149,13 @@ func New(ctx context.Context, config *config.Cluster, options ...Option) (Cluste
 		log.FromContext(ctx).WithField("key", hex.EncodeToString(c.keys[0])).Warn("No cluster key configured, generated a random one")
 	}
 
+	if config.Compression != "" {
+		if encoding.GetCompressor(config.Compression) == nil {
+			return nil, errCompressorNotFound.WithAttributes("compressor", config.Compression)
+		}
+		c.dialOptions = append(c.dialOptions, grpc.WithDefaultCallOptions(grpc.UseCompressor(config.Compression)))
+	}
+
 	c.self = &peer{
 		name:   config.Name,
 		target: config.Address,
@@ -198,9 +213,16 @@ type cluster struct {
 	peers     map[string]*peer
 	self      *peer
 
+	dialOptions []grpc.DialOption
+
 	keys [][]byte
 }
 
+var errCompressorNotFound = errors.DefineNotFound(
+	"compressor_not_found",
+	"compressor `{compressor}` not found",
+)
+
 var errPeerConnection = errors.Define(
 	"peer_connection",
 	"connection to peer `{name}` on `{address}` failed",
@@ -218,6 +240,7 @@ func (c *cluster) Join() (err error) {
 	} else {
 		options = append(options, grpc.WithInsecure())
 	}
+	options = append(options, c.dialOptions...)
 	for _, peer := range c.peers {
 		if peer.conn != nil {
 			continue
@@ -27,6 +27,9 @@ func (c *Component) initCluster() (err error) {
 		cluster.WithServices(c.grpcSubsystems...),
 		cluster.WithConn(c.LoopbackConn()),
 	}
+	if c.chaos != nil {
+		clusterOpts = append(clusterOpts, cluster.WithDialOptions(c.chaos.DialOptions()...))
+	}
 	if tlsConfig, err := c.GetTLSClientConfig(c.Context()); err == nil {
 		clusterOpts = append(clusterOpts, cluster.WithTLSConfig(tlsConfig))
 	}
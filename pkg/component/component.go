@@ -23,6 +23,7 @@ import (
 	"os/signal"
 	"sort"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/getsentry/raven-go"
@@ -36,6 +37,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/log/middleware/sentry"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/chaos"
 	"go.thethings.network/lorawan-stack/pkg/rpcserver"
 	"go.thethings.network/lorawan-stack/pkg/version"
 	"go.thethings.network/lorawan-stack/pkg/web"
@@ -74,7 +76,9 @@ type Component struct {
 	interop           *interop.Server
 	interopSubsystems []interop.Registerer
 
-	healthHandler healthcheck.Handler
+	healthHandler         healthcheck.Handler
+	readinessChecksMu     sync.RWMutex
+	readinessChecksByName map[string]healthcheck.Check
 
 	loopback *grpc.ClientConn
 
@@ -87,6 +91,8 @@ type Component struct {
 
 	rightsFetcher rights.Fetcher
 
+	chaos *chaos.Injector
+
 	tasks []task
 }
 
@@ -136,7 +142,8 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		config: config,
 		logger: logger,
 
-		healthHandler: healthcheck.NewHandler(),
+		healthHandler:         healthcheck.NewHandler(),
+		readinessChecksByName: make(map[string]healthcheck.Check),
 
 		tcpListeners: make(map[string]*listener),
 
@@ -150,6 +157,10 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		c.logger.Use(sentry.New(c.sentry))
 	}
 
+	if config.Chaos.Enable {
+		c.chaos = &chaos.Injector{}
+	}
+
 	for _, opt := range opts {
 		opt(c)
 	}
@@ -47,7 +47,12 @@ func (c *Component) setupGRPC() (err error) {
 	}
 	metrics.InitializeServerMetrics(c.grpc.Server)
 	c.logger.Debug("Starting loopback connection")
-	c.loopback, err = rpcserver.StartLoopback(c.ctx, c.grpc.Server)
+	var loopbackOpts []grpc.DialOption
+	if c.chaos != nil {
+		c.logger.Warn("Fault injection between components is enabled")
+		loopbackOpts = c.chaos.DialOptions()
+	}
+	c.loopback, err = rpcserver.StartLoopback(c.ctx, c.grpc.Server, loopbackOpts...)
 	if err != nil {
 		return errors.New("could not start loopback connection").WithCause(err)
 	}
@@ -59,7 +64,7 @@ func (c *Component) setupGRPC() (err error) {
 		"/*",
 		echo.WrapHandler(http.StripPrefix(ttnpb.HTTPAPIPrefix, c.grpc)),
 		middleware.CORSWithConfig(middleware.CORSConfig{
-			AllowHeaders:     []string{"Authorization", "Content-Type", "X-CSRF-Token"},
+			AllowHeaders:     []string{"Authorization", "Content-Type", "X-CSRF-Token", "Idempotency-Key"},
 			AllowCredentials: true,
 			ExposeHeaders:    []string{"Date", "Content-Length", "X-Request-Id", "X-Total-Count", "X-Warning"},
 			MaxAge:           600,
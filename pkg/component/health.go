@@ -0,0 +1,156 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/heptiolabs/healthcheck"
+	echo "github.com/labstack/echo/v4"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc/connectivity"
+)
+
+// readinessCheckSeparator separates the name of the component from the name of the check.
+// Readiness checks that are named `<component>/<check>` are exposed per component on /healthz/ready/<component>.
+const readinessCheckSeparator = "/"
+
+const defaultReadinessCheckInterval = time.Second
+
+var (
+	errReadinessCheckNotFound = errors.DefineNotFound("readiness_check_not_found", "readiness check `{name}` not found")
+	errNotReady               = errors.DefineUnavailable("not_ready", "readiness check `{name}` failed")
+	errClusterNotJoined       = errors.DefineUnavailable("cluster_not_joined", "cluster not joined")
+	errPeerConnState          = errors.DefineUnavailable("peer_conn_state", "connection to `{role}` peer is `{state}`")
+)
+
+// ReadinessCheckName returns the name of the readiness check of the given component.
+func ReadinessCheckName(component, check string) string {
+	return component + readinessCheckSeparator + check
+}
+
+// readinessChecks returns the names of the readiness checks with the given prefix.
+func (c *Component) readinessChecks(prefix string) []string {
+	c.readinessChecksMu.RLock()
+	defer c.readinessChecksMu.RUnlock()
+	var names []string
+	for name := range c.readinessChecksByName {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Component) readinessCheck(name string) (healthcheck.Check, bool) {
+	c.readinessChecksMu.RLock()
+	defer c.readinessChecksMu.RUnlock()
+	check, ok := c.readinessChecksByName[name]
+	return check, ok
+}
+
+// CheckReadiness runs the readiness checks with the given names, and returns the first error.
+// If no names are given, all readiness checks are run.
+func (c *Component) CheckReadiness(names ...string) error {
+	if len(names) == 0 {
+		names = c.readinessChecks("")
+	}
+	for _, name := range names {
+		check, ok := c.readinessCheck(name)
+		if !ok {
+			return errReadinessCheckNotFound.WithAttributes("name", name)
+		}
+		if err := check(); err != nil {
+			return errNotReady.WithCause(err).WithAttributes("name", name)
+		}
+	}
+	return nil
+}
+
+// WaitReady blocks until the readiness checks with the given names pass, or until the context is done.
+// The checks are run on the given interval.
+func (c *Component) WaitReady(ctx context.Context, interval time.Duration, names ...string) error {
+	if interval <= 0 {
+		interval = defaultReadinessCheckInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	logger := log.FromContext(ctx)
+	for {
+		err := c.CheckReadiness(names...)
+		if err == nil {
+			return nil
+		}
+		logger.WithError(err).Debug("Not ready yet")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PeerReadinessCheck returns a readiness check that passes when a cluster peer with the given role is reachable.
+func (c *Component) PeerReadinessCheck(role ttnpb.ClusterRole) healthcheck.Check {
+	return func() error {
+		if c.cluster == nil {
+			return errClusterNotJoined
+		}
+		conn, err := c.GetPeerConn(c.ctx, role, nil)
+		if err != nil {
+			return err
+		}
+		switch state := conn.GetState(); state {
+		case connectivity.Idle, connectivity.Ready:
+			return nil
+		default:
+			return errPeerConnState.WithAttributes(
+				"role", role.String(),
+				"state", state.String(),
+			)
+		}
+	}
+}
+
+// componentReadyEndpoint runs the readiness checks of the component in the path.
+// The response contains the result of each check, like the `full` response of /healthz/ready.
+func (c *Component) componentReadyEndpoint(ctx echo.Context) error {
+	names := c.readinessChecks(ctx.Param("component") + readinessCheckSeparator)
+	if len(names) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound)
+	}
+	status := http.StatusOK
+	results := make(map[string]string, len(names))
+	for _, name := range names {
+		check, ok := c.readinessCheck(name)
+		if !ok {
+			continue
+		}
+		if err := check(); err != nil {
+			status = http.StatusServiceUnavailable
+			results[name] = err.Error()
+			continue
+		}
+		results[name] = "OK"
+	}
+	return ctx.JSON(status, results)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestReadiness(t *testing.T) {
+	a := assertions.New(t)
+
+	c, err := New(test.GetLogger(t), &Config{})
+	a.So(err, should.BeNil)
+
+	var ready uint32
+	gsName := ReadinessCheckName("gs", "ns")
+	c.RegisterReadinessCheck(gsName, func() error {
+		if atomic.LoadUint32(&ready) == 0 {
+			return errors.New("not ready")
+		}
+		return nil
+	})
+	asName := ReadinessCheckName("as", "redis")
+	c.RegisterReadinessCheck(asName, func() error {
+		return nil
+	})
+
+	a.So(c.readinessChecks("gs/"), should.Resemble, []string{gsName})
+	a.So(c.readinessChecks(""), should.Resemble, []string{asName, gsName})
+
+	a.So(c.CheckReadiness(asName), should.BeNil)
+	a.So(errors.IsUnavailable(c.CheckReadiness(gsName)), should.BeTrue)
+	a.So(errors.IsUnavailable(c.CheckReadiness()), should.BeTrue)
+	a.So(errors.IsNotFound(c.CheckReadiness("unknown")), should.BeTrue)
+
+	// Waiting stops when the context is done.
+	ctx, cancel := context.WithTimeout(test.Context(), 5*test.Delay)
+	err = c.WaitReady(ctx, test.Delay, gsName)
+	cancel()
+	a.So(errors.Is(err, context.DeadlineExceeded), should.BeTrue)
+
+	// Waiting returns when the checks pass.
+	go func() {
+		time.Sleep(2 * test.Delay)
+		atomic.StoreUint32(&ready, 1)
+	}()
+	ctx, cancel = context.WithTimeout(test.Context(), 50*test.Delay)
+	defer cancel()
+	a.So(c.WaitReady(ctx, test.Delay, gsName, asName), should.BeNil)
+	a.So(c.CheckReadiness(), should.BeNil)
+}
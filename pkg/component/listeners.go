@@ -73,8 +73,15 @@ func (l *listener) Close() error {
 func (c *Component) ListenTCP(address string) (Listener, error) {
 	l, ok := c.tcpListeners[address]
 	if !ok {
-		c.logger.WithField("address", address).Debug("Creating listener")
-		lis, err := net.Listen("tcp", address)
+		network, err := c.config.Listeners.Network("tcp", address)
+		if err != nil {
+			return nil, err
+		}
+		c.logger.WithFields(log.Fields(
+			"address", address,
+			"network", network,
+		)).Debug("Creating listener")
+		lis, err := net.Listen(network, address)
 		if err != nil {
 			return nil, err
 		}
@@ -100,11 +107,15 @@ func (c *Component) ListenTCP(address string) (Listener, error) {
 
 // ListenUDP starts a listener on a UDP address.
 func (c *Component) ListenUDP(address string) (*net.UDPConn, error) {
-	udpAddr, err := net.ResolveUDPAddr("udp", address)
+	network, err := c.config.Listeners.Network("udp", address)
+	if err != nil {
+		return nil, err
+	}
+	udpAddr, err := net.ResolveUDPAddr(network, address)
 	if err != nil {
 		return nil, err
 	}
-	return net.ListenUDP("udp", udpAddr)
+	return net.ListenUDP(network, udpAddr)
 }
 
 // Endpoint represents an endpoint that can be listened on.
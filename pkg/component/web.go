@@ -26,13 +26,16 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/metrics"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/chaos"
 	"go.thethings.network/lorawan-stack/pkg/web"
 )
 
 const (
-	metricsUsername = "metrics"
-	pprofUsername   = "pprof"
-	healthUsername  = "health"
+	metricsUsername   = "metrics"
+	pprofUsername     = "pprof"
+	healthUsername    = "health"
+	logLevelsUsername = "log"
+	chaosUsername     = "chaos"
 )
 
 func (c *Component) initWeb() error {
@@ -80,7 +83,11 @@ func (c *Component) RegisterLivenessCheck(name string, check healthcheck.Check)
 }
 
 // RegisterReadinessCheck registers a readiness check for the component.
+// Checks named with ReadinessCheckName are also exposed per component on /healthz/ready/<component>.
 func (c *Component) RegisterReadinessCheck(name string, check healthcheck.Check) {
+	c.readinessChecksMu.Lock()
+	c.readinessChecksByName[name] = check
+	c.readinessChecksMu.Unlock()
 	c.healthHandler.AddReadinessCheck(name, check)
 }
 
@@ -137,11 +144,98 @@ func (c *Component) listenWeb() (err error) {
 		g := c.web.RootGroup("/healthz", middleware...)
 		g.GET("/live", echo.WrapHandler(http.HandlerFunc(c.healthHandler.LiveEndpoint)))
 		g.GET("/ready", echo.WrapHandler(http.HandlerFunc(c.healthHandler.ReadyEndpoint)))
+		g.GET("/ready/:component", c.componentReadyEndpoint)
 	}
 
+	if c.config.HTTP.LogLevels.Enable {
+		if levels := c.namespaceLevels(); levels != nil {
+			var middleware []echo.MiddlewareFunc
+			if c.config.HTTP.LogLevels.Password != "" {
+				middleware = append(middleware, c.basicAuth(logLevelsUsername, c.config.HTTP.LogLevels.Password))
+			}
+			g := c.web.RootGroup("/debug/log-levels", middleware...)
+			g.GET("", getLogLevels(levels))
+			g.PUT("", setLogLevel(levels))
+		}
+	}
+
+	if c.chaos != nil {
+		var middleware []echo.MiddlewareFunc
+		if c.config.Chaos.Password != "" {
+			middleware = append(middleware, c.basicAuth(chaosUsername, c.config.Chaos.Password))
+		}
+		g := c.web.RootGroup("/debug/chaos", middleware...)
+		g.GET("", getChaosRules(c.chaos))
+		g.PUT("", setChaosRules(c.chaos))
+	}
+
+	return nil
+}
+
+func getChaosRules(injector *chaos.Injector) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, injector.Rules())
+	}
+}
+
+// setChaosRules replaces the fault injection rules by the rules in the request body.
+func setChaosRules(injector *chaos.Injector) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var rules []chaos.Rule
+		if err := c.Bind(&rules); err != nil {
+			return err
+		}
+		injector.SetRules(rules...)
+		log.FromContext(c.Request().Context()).WithField("rules", len(rules)).Warn("Fault injection rules changed")
+		return c.JSON(http.StatusOK, injector.Rules())
+	}
+}
+
+func (c *Component) namespaceLevels() *log.NamespaceLevels {
+	if logger, ok := c.logger.(interface{ NamespaceLevels() *log.NamespaceLevels }); ok {
+		return logger.NamespaceLevels()
+	}
 	return nil
 }
 
+type logLevels struct {
+	Default    log.Level            `json:"default"`
+	Namespaces []log.NamespaceLevel `json:"namespaces"`
+}
+
+func getLogLevels(levels *log.NamespaceLevels) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		def, namespaces := levels.Levels()
+		return c.JSON(http.StatusOK, logLevels{
+			Default:    def,
+			Namespaces: namespaces,
+		})
+	}
+}
+
+// setLogLevel sets the level of the namespace in the request body.
+// If the namespace is empty, the default level is set. If the level is empty, the level of the namespace is removed.
+func setLogLevel(levels *log.NamespaceLevels) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var req struct {
+			Namespace string `json:"namespace"`
+			Level     string `json:"level"`
+		}
+		if err := c.Bind(&req); err != nil {
+			return err
+		}
+		var level log.Level
+		if req.Level != "" {
+			var err error
+			if level, err = log.ParseLevel(req.Level); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			}
+		}
+		levels.SetLevel(req.Namespace, level)
+		return getLogLevels(levels)(c)
+	}
+}
+
 func (c *Component) basicAuth(username, password string) echo.MiddlewareFunc {
 	usernameBytes, passwordBytes := []byte(username), []byte(password)
 	return middleware.BasicAuth(func(username string, password string, ctx echo.Context) (bool, error) {
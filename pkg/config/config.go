@@ -45,6 +45,7 @@ type Manager struct {
 	defaultPaths []string
 	configFlag   string
 	dataDirFlag  string
+	schema       map[string]*KeyInfo
 }
 
 // Flags to be used in the command.
@@ -143,6 +144,7 @@ func Initialize(name, envPrefix string, defaults interface{}, opts ...Option) *M
 		flags:     pflag.NewFlagSet(name, pflag.ExitOnError),
 		replacer:  strings.NewReplacer(),
 		defaults:  defaults,
+		schema:    make(map[string]*KeyInfo),
 	}
 
 	m.viper.SetTypeByDefaultValue(true)
@@ -357,6 +359,7 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 			// if it's only for in the file, skip the rest
 			if fileOnly == "true" {
 				m.viper.SetDefault(name, face)
+				m.addKeyInfo(name, field, face, true)
 				continue
 			}
 
@@ -373,6 +376,7 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 
 				m.viper.SetDefault(name, val)
 				m.flags.StringP(name, shorthand, val, description)
+				m.addKeyInfo(name, field, val, false)
 				continue
 			}
 
@@ -398,11 +402,14 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 
 				m.viper.SetDefault(name, defs)
 				m.flags.StringSliceP(name, shorthand, defs, description)
+				m.addKeyInfo(name, field, defs, false)
 				continue
 			}
 
 			if fieldKind == reflect.Interface || fieldKind == reflect.Ptr {
 				if configValue.Field(i).IsNil() {
+					// Without a value, the structure of the key is unknown, so accept anything below it.
+					m.addKeyInfo(name, field, nil, true)
 					continue
 				}
 				elem := configValue.Field(i).Elem()
@@ -501,10 +508,12 @@ func (m *Manager) setDefaults(prefix string, flags *pflag.FlagSet, config interf
 						name = prefix
 					}
 					m.setDefaults(name, flags, configValue.Field(i).Interface())
+					continue
 				default:
 					panic(fmt.Errorf("config: cannot work with \"%v\" in configuration at name \"%s\"", field.Type, name))
 				}
 			}
+			m.addKeyInfo(name, field, face, false)
 		}
 	}
 }
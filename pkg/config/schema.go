@@ -0,0 +1,187 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+var errReadConfigFile = errors.DefineInvalidArgument("read_config_file", "read config file `{file}`")
+
+// KeyInfo describes a configuration key that is known to the Manager.
+type KeyInfo struct {
+	// Key is the full name of the configuration key.
+	Key string
+	// Type is the Go type of the configuration value.
+	Type string
+	// Description is the description of the configuration key.
+	Description string
+	// Default is the string representation of the default value.
+	Default string
+	// FileOnly indicates that the key can only be set in the configuration file.
+	FileOnly bool
+	// Open indicates that arbitrary sub-keys are accepted below this key, for example for maps.
+	Open bool
+}
+
+func (m *Manager) addKeyInfo(name string, field reflect.StructField, face interface{}, open bool) {
+	info := &KeyInfo{
+		Key:         name,
+		Type:        field.Type.String(),
+		Description: field.Tag.Get("description"),
+		FileOnly:    field.Tag.Get("file-only") == "true",
+		Open:        open,
+	}
+	if face != nil {
+		info.Default = fmt.Sprintf("%v", face)
+		if str, ok := face.(fmt.Stringer); ok {
+			info.Default = str.String()
+		}
+		if str, ok := face.(Stringer); ok {
+			info.Default = str.ConfigString()
+		}
+		if reflect.TypeOf(face).Kind() == reflect.Map {
+			info.Open = true
+		}
+	}
+	m.schema[name] = info
+}
+
+// Explain returns the information of the given configuration key.
+// If the key is not known, but is a prefix of known keys, the information of all those keys is returned.
+func (m *Manager) Explain(key string) []*KeyInfo {
+	key = strings.ToLower(key)
+	if info, ok := m.schema[key]; ok {
+		return []*KeyInfo{info}
+	}
+	var infos []*KeyInfo
+	for name, info := range m.schema {
+		if strings.HasPrefix(name, key+".") {
+			infos = append(infos, info)
+		}
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Key < infos[j].Key })
+	return infos
+}
+
+// isKnownKey returns whether the key is known to the Manager, either by being defined in the defaults,
+// by being a flag, or by being below an open key.
+func (m *Manager) isKnownKey(key string) bool {
+	if _, ok := m.schema[key]; ok {
+		return true
+	}
+	if m.flags.Lookup(key) != nil {
+		return true
+	}
+	for name, info := range m.schema {
+		if info.Open && strings.HasPrefix(key, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// suggestKey returns the known key that is closest to the given key, or an empty string if no key is close.
+func (m *Manager) suggestKey(key string) string {
+	var (
+		suggestion string
+		best       = len(key)/4 + 1
+	)
+	for name := range m.schema {
+		if d := editDistance(key, name); d < best || d == best && name < suggestion {
+			suggestion, best = name, d
+		}
+	}
+	return suggestion
+}
+
+// UnknownKey is a key in a configuration file that is not known to the Manager.
+type UnknownKey struct {
+	File       string
+	Key        string
+	Suggestion string
+}
+
+// ValidateConfigFiles reads all defined config files (according to the config file flag set by WithConfigFileFlag)
+// and returns the keys in those files that are not known to the Manager.
+// Unknown keys are ignored by ReadInConfig, so typos in key names silently fall back to the defaults.
+func (m *Manager) ValidateConfigFiles() ([]UnknownKey, error) {
+	var unknown []UnknownKey
+	files := m.viper.GetStringSlice(m.configFlag)
+	for _, file := range files {
+		if m.isDefault(file) && !m.inCLIFlags(file) {
+			if _, err := os.Stat(file); os.IsNotExist(err) {
+				continue
+			}
+		}
+
+		v := viper.New()
+		v.SetConfigFile(file)
+		if err := v.ReadInConfig(); err != nil {
+			return nil, errReadConfigFile.WithCause(err).WithAttributes("file", file)
+		}
+		keys := v.AllKeys()
+		sort.Strings(keys)
+		for _, key := range keys {
+			if m.isKnownKey(key) {
+				continue
+			}
+			unknown = append(unknown, UnknownKey{
+				File:       file,
+				Key:        key,
+				Suggestion: m.suggestKey(key),
+			})
+		}
+	}
+	return unknown, nil
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min(vs ...int) int {
+	m := vs[0]
+	for _, v := range vs[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,74 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"path"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestValidateConfigFiles(t *testing.T) {
+	a := assertions.New(t)
+
+	_, filename, _, _ := runtime.Caller(0)
+	typo := path.Join(filepath.Dir(filename), "typo.yml")
+
+	mgr := InitializeWithDefaults("empty", "empty", defaults)
+	a.So(mgr, should.NotBeNil)
+
+	mgr.Parse("--config", typo)
+	unknown, err := mgr.ValidateConfigFiles()
+	a.So(err, should.BeNil)
+	a.So(unknown, should.Resemble, []UnknownKey{
+		{File: typo, Key: "nested.strin", Suggestion: "nested.string"},
+		{File: typo, Key: "strng", Suggestion: "string"},
+		{File: typo, Key: "unrelated"},
+	})
+}
+
+func TestExplain(t *testing.T) {
+	a := assertions.New(t)
+
+	mgr := InitializeWithDefaults("empty", "empty", defaults)
+	a.So(mgr, should.NotBeNil)
+
+	infos := mgr.Explain("string")
+	if a.So(infos, should.HaveLength, 1) {
+		a.So(infos[0], should.Resemble, &KeyInfo{
+			Key:         "string",
+			Type:        "string",
+			Description: "A single string",
+			Default:     "foo",
+		})
+	}
+
+	infos = mgr.Explain("custom")
+	if a.So(infos, should.HaveLength, 1) {
+		a.So(infos[0].Default, should.Equal, "foo")
+	}
+
+	infos = mgr.Explain("nested")
+	if a.So(infos, should.HaveLength, 1) {
+		a.So(infos[0].Key, should.Equal, "nested.string")
+		a.So(infos[0].Default, should.Equal, "nested-foo")
+	}
+
+	a.So(mgr.Explain("unknown"), should.BeEmpty)
+}
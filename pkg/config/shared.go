@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/tls"
 	"io/ioutil"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -39,7 +40,27 @@ type Base struct {
 
 // Log represents configuration for the logger.
 type Log struct {
-	Level log.Level `name:"level" description:"The minimum level log messages must have to be shown"`
+	Level      log.Level         `name:"level" description:"The minimum level log messages must have to be shown"`
+	Format     string            `name:"format" description:"Format of the log messages on standard output (console, json)"`
+	Namespaces map[string]string `name:"namespaces" description:"The minimum level log messages must have to be shown per namespace (for example networkserver=debug)"`
+	File       LogFile           `name:"file"`
+	Syslog     LogSyslog         `name:"syslog"`
+}
+
+// LogFile represents configuration for logging to a file.
+type LogFile struct {
+	Path       string    `name:"path" description:"Location of the JSON log file (optional)"`
+	Level      log.Level `name:"level" description:"The minimum level log messages must have to be written to the file"`
+	MaxSize    int       `name:"max-size" description:"Size in megabytes after which the log file is rotated (0 is unlimited)"`
+	MaxBackups int       `name:"max-backups" description:"Number of rotated log files to keep"`
+}
+
+// LogSyslog represents configuration for logging to a syslog server.
+type LogSyslog struct {
+	Network string    `name:"network" description:"Network of the syslog server (tcp, udp)"`
+	Address string    `name:"address" description:"Address of the syslog server (optional)"`
+	Tag     string    `name:"tag" description:"Tag (app name) of the syslog messages"`
+	Level   log.Level `name:"level" description:"The minimum level log messages must have to be sent to the syslog server"`
 }
 
 // Sentry represents configuration for error tracking using Sentry.
@@ -60,6 +81,70 @@ type Cluster struct {
 	CryptoServer      string   `name:"crypto-server" description:"Address for the Crypto Server"`
 	TLS               bool     `name:"tls" description:"Do cluster gRPC over TLS"`
 	Keys              []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"`
+	Compression       string   `name:"compression" description:"Compression of cluster gRPC requests (gzip, or empty for no compression)"`
+}
+
+// Address families of listeners.
+const (
+	AddressFamilyDualStack = "dual-stack"
+	AddressFamilyIPv4      = "ipv4"
+	AddressFamilyIPv6      = "ipv6"
+)
+
+var (
+	errAddressFamily         = errors.DefineInvalidArgument("address_family", "invalid address family `{address_family}`")
+	errListenerAddressFamily = errors.DefineInvalidArgument("listener_address_family", "invalid listener address family `{value}`, expected `address=family`")
+)
+
+// ListenerAddressFamily is the address family of the listeners on a listen address.
+// It is configured as address=family, for example :1700=ipv6.
+type ListenerAddressFamily struct {
+	Address string
+	Family  string
+}
+
+// UnmarshalConfigString implements the Configurable interface.
+func (f *ListenerAddressFamily) UnmarshalConfigString(s string) error {
+	i := strings.LastIndex(s, "=")
+	if i < 0 {
+		return errListenerAddressFamily.WithAttributes("value", s)
+	}
+	f.Address, f.Family = s[:i], s[i+1:]
+	return nil
+}
+
+// ConfigString implements the Stringer interface.
+func (f ListenerAddressFamily) ConfigString() string {
+	return f.Address + "=" + f.Family
+}
+
+// Listeners represents the configuration of the network listeners.
+// The address family of a listener is the address family of its listen address in AddressFamilies, or AddressFamily.
+// Dual-stack listeners on unspecified addresses accept both IPv4 and IPv6 connections.
+type Listeners struct {
+	AddressFamily   string                  `name:"address-family" description:"Address family of listeners (dual-stack, ipv4, ipv6)"`
+	AddressFamilies []ListenerAddressFamily `name:"address-families" description:"Address family of listeners by listen address, as address=family (dual-stack, ipv4, ipv6)"`
+}
+
+// Network returns the network of a listener on the address, for example tcp6 for an IPv6 TCP listener.
+// The given network is tcp or udp.
+func (l Listeners) Network(network, address string) (string, error) {
+	family := l.AddressFamily
+	for _, f := range l.AddressFamilies {
+		if f.Address == address {
+			family = f.Family
+			break
+		}
+	}
+	switch family {
+	case "", AddressFamilyDualStack:
+		return network, nil
+	case AddressFamilyIPv4:
+		return network + "4", nil
+	case AddressFamilyIPv6:
+		return network + "6", nil
+	}
+	return "", errAddressFamily.WithAttributes("address_family", family)
 }
 
 // GRPC represents gRPC listener configuration.
@@ -91,6 +176,18 @@ type Metrics struct {
 	Password string `name:"password" description:"Password to protect metrics endpoint (username is metrics)"`
 }
 
+// LogLevels represents the configuration of the endpoint to change log levels at runtime.
+type LogLevels struct {
+	Enable   bool   `name:"enable" description:"Enable log levels endpoint on HTTP server"`
+	Password string `name:"password" description:"Password to protect log levels endpoint (username is log)"`
+}
+
+// Chaos represents the configuration of fault injection between components.
+type Chaos struct {
+	Enable   bool   `name:"enable" description:"Enable fault injection between components and its endpoint on HTTP server (do not use in production)"`
+	Password string `name:"password" description:"Password to protect fault injection endpoint (username is chaos)"`
+}
+
 // Health represents the health checks configuration.
 type Health struct {
 	Enable   bool   `name:"enable" description:"Enable health check endpoint on HTTP server"`
@@ -114,6 +211,7 @@ type HTTP struct {
 	PProf           PProf            `name:"pprof"`
 	Metrics         Metrics          `name:"metrics"`
 	Health          Health           `name:"health"`
+	LogLevels       LogLevels        `name:"log-levels"`
 }
 
 // Redis represents Redis configuration.
@@ -139,11 +237,18 @@ type Cache struct {
 	Redis   Redis  `name:"redis"`
 }
 
+// EventsCorrelation represents configuration for storing events by correlation ID.
+type EventsCorrelation struct {
+	TTL   time.Duration `name:"ttl" description:"How long to store events by correlation ID (0 disables storage)"`
+	Limit int           `name:"limit" description:"Maximum number of events to store per correlation ID"`
+}
+
 // Events represents configuration for the events system.
 type Events struct {
-	Backend string      `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
-	Redis   Redis       `name:"redis"`
-	Cloud   CloudEvents `name:"cloud"`
+	Backend     string            `name:"backend" description:"Backend to use for events (internal, redis, cloud)"`
+	Redis       Redis             `name:"redis"`
+	Cloud       CloudEvents       `name:"cloud"`
+	Correlation EventsCorrelation `name:"correlation"`
 }
 
 // Rights represents the configuration to apply when fetching entity rights.
@@ -442,6 +547,8 @@ type ServiceBase struct {
 	DeviceRepository DeviceRepositoryConfig `name:"device-repository" description:"Source of the device repository"`
 	Rights           Rights                 `name:"rights"`
 	KeyVault         KeyVault               `name:"key-vault"`
+	Chaos            Chaos                  `name:"chaos"`
+	Listeners        Listeners              `name:"listeners"`
 }
 
 // FrequencyPlansFetcher returns a fetch.Interface based on the frequency plans configuration.
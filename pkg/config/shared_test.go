@@ -0,0 +1,79 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestListenersNetwork(t *testing.T) {
+	l := Listeners{
+		AddressFamily: AddressFamilyIPv4,
+		AddressFamilies: []ListenerAddressFamily{
+			{Address: ":1700", Family: AddressFamilyIPv6},
+			{Address: ":1882", Family: AddressFamilyDualStack},
+			{Address: ":1883", Family: "ipx"},
+		},
+	}
+	for _, tc := range []struct {
+		Network, Address, Expected string
+	}{
+		{Network: "tcp", Address: ":1884", Expected: "tcp4"},
+		{Network: "udp", Address: ":1700", Expected: "udp6"},
+		{Network: "tcp", Address: ":1882", Expected: "tcp"},
+	} {
+		t.Run(tc.Address, func(t *testing.T) {
+			a := assertions.New(t)
+			network, err := l.Network(tc.Network, tc.Address)
+			a.So(err, should.BeNil)
+			a.So(network, should.Equal, tc.Expected)
+		})
+	}
+
+	a := assertions.New(t)
+	_, err := l.Network("tcp", ":1883")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	network, err := Listeners{}.Network("tcp", ":1884")
+	a.So(err, should.BeNil)
+	a.So(network, should.Equal, "tcp")
+}
+
+func TestListenerAddressFamily(t *testing.T) {
+	for _, tc := range []struct {
+		Value    string
+		Expected ListenerAddressFamily
+	}{
+		{Value: ":1700=ipv6", Expected: ListenerAddressFamily{Address: ":1700", Family: AddressFamilyIPv6}},
+		{Value: "0.0.0.0:1700=ipv4", Expected: ListenerAddressFamily{Address: "0.0.0.0:1700", Family: AddressFamilyIPv4}},
+		{Value: "[2001:db8::1]:1882=dual-stack", Expected: ListenerAddressFamily{Address: "[2001:db8::1]:1882", Family: AddressFamilyDualStack}},
+	} {
+		t.Run(tc.Value, func(t *testing.T) {
+			a := assertions.New(t)
+			var f ListenerAddressFamily
+			a.So(f.UnmarshalConfigString(tc.Value), should.BeNil)
+			a.So(f, should.Resemble, tc.Expected)
+			a.So(f.ConfigString(), should.Equal, tc.Value)
+		})
+	}
+
+	a := assertions.New(t)
+	var f ListenerAddressFamily
+	a.So(errors.IsInvalidArgument(f.UnmarshalConfigString(":1700")), should.BeTrue)
+}
@@ -16,9 +16,12 @@
 package devicerepository
 
 import (
+	"sort"
+
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"gopkg.in/yaml.v2"
 )
 
@@ -28,10 +31,40 @@ type Client struct {
 }
 
 type brand struct {
-	id    string
-	Name  string   `yaml:"name,omitempty"`
-	URL   string   `yaml:"url,omitempty"`
-	Logos []string `yaml:"logos,omitempty"`
+	id       string
+	Name     string          `yaml:"name,omitempty"`
+	URL      string          `yaml:"url,omitempty"`
+	Logos    []string        `yaml:"logos,omitempty"`
+	Profiles []vendorProfile `yaml:"profiles,omitempty"`
+}
+
+// vendorProfile maps the EUIs of the end devices of a vendor to an end device version.
+type vendorProfile struct {
+	DevEUIPrefix    string `yaml:"dev_eui_prefix"`
+	JoinEUIPrefix   string `yaml:"join_eui_prefix,omitempty"`
+	ModelID         string `yaml:"model_id"`
+	HardwareVersion string `yaml:"hardware_version"`
+	FirmwareVersion string `yaml:"firmware_version"`
+}
+
+// matches returns whether the profile matches the given JoinEUI and DevEUI.
+// The JoinEUI is only matched if the profile has a JoinEUI prefix.
+func (p vendorProfile) matches(joinEUI, devEUI types.EUI64) (bool, error) {
+	var devEUIPrefix types.EUI64Prefix
+	if err := devEUIPrefix.UnmarshalText([]byte(p.DevEUIPrefix)); err != nil {
+		return false, errParseFailed.WithCause(err)
+	}
+	if devEUIPrefix.IsZero() || !devEUI.HasPrefix(devEUIPrefix) {
+		return false, nil
+	}
+	if p.JoinEUIPrefix == "" {
+		return true, nil
+	}
+	var joinEUIPrefix types.EUI64Prefix
+	if err := joinEUIPrefix.UnmarshalText([]byte(p.JoinEUIPrefix)); err != nil {
+		return false, errParseFailed.WithCause(err)
+	}
+	return joinEUI.HasPrefix(joinEUIPrefix), nil
 }
 
 var (
@@ -45,8 +78,7 @@ const (
 	versionsFile = "versions.yml"
 )
 
-// Brands fetches and parses the list of brands.
-func (c Client) Brands() (map[string]ttnpb.EndDeviceBrand, error) {
+func (c Client) brands() (map[string]brand, error) {
 	content, err := c.Fetcher.File(brandsFile)
 	if err != nil {
 		return nil, errFetchFailed.WithCause(err).WithAttributes("filename", brandsFile)
@@ -59,9 +91,18 @@ func (c Client) Brands() (map[string]ttnpb.EndDeviceBrand, error) {
 	if err = yaml.Unmarshal(content, l); err != nil {
 		return nil, errParseFailed.WithCause(err)
 	}
+	return l.Brands, nil
+}
+
+// Brands fetches and parses the list of brands.
+func (c Client) Brands() (map[string]ttnpb.EndDeviceBrand, error) {
+	l, err := c.brands()
+	if err != nil {
+		return nil, err
+	}
 
 	brands := make(map[string]ttnpb.EndDeviceBrand)
-	for id, brand := range l.Brands {
+	for id, brand := range l {
 		brands[id] = ttnpb.EndDeviceBrand{
 			ID:    id,
 			Name:  brand.Name,
@@ -142,6 +183,10 @@ func (c Client) DeviceVersions(brandID, modelID string) ([]ttnpb.EndDeviceVersio
 				switch pf.Type {
 				case "cayennelpp":
 					return ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP, "", nil
+				case "cbor":
+					return ttnpb.PayloadFormatter_FORMATTER_CBOR, "", nil
+				case "senml":
+					return ttnpb.PayloadFormatter_FORMATTER_SENML, "", nil
 				case "grpc":
 					return ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE, pf.Parameter, nil
 				case "javascript":
@@ -188,3 +233,52 @@ func (c Client) DeviceVersions(brandID, modelID string) ([]ttnpb.EndDeviceVersio
 
 	return versions, nil
 }
+
+var (
+	errNoVendorProfile  = errors.DefineNotFound("no_vendor_profile", "no vendor profile for JoinEUI `{join_eui}` and DevEUI `{dev_eui}`")
+	errNoProfileVersion = errors.DefineNotFound("no_profile_version", "no end device version `{hardware_version}`/`{firmware_version}` of model `{brand_id}`/`{model_id}`")
+)
+
+// InferDeviceVersion returns the end device version of the first vendor profile that matches the given JoinEUI and
+// DevEUI. The brands are matched in alphabetical order.
+func (c Client) InferDeviceVersion(joinEUI, devEUI types.EUI64) (*ttnpb.EndDeviceVersion, error) {
+	brands, err := c.brands()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(brands))
+	for id := range brands {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, brandID := range ids {
+		for _, profile := range brands[brandID].Profiles {
+			ok, err := profile.matches(joinEUI, devEUI)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			versions, err := c.DeviceVersions(brandID, profile.ModelID)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range versions {
+				if v.HardwareVersion == profile.HardwareVersion && v.FirmwareVersion == profile.FirmwareVersion {
+					return &v, nil
+				}
+			}
+			return nil, errNoProfileVersion.WithAttributes(
+				"brand_id", brandID,
+				"model_id", profile.ModelID,
+				"hardware_version", profile.HardwareVersion,
+				"firmware_version", profile.FirmwareVersion,
+			)
+		}
+	}
+	return nil, errNoVendorProfile.WithAttributes(
+		"join_eui", joinEUI,
+		"dev_eui", devEUI,
+	)
+}
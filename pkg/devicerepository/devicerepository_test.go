@@ -22,6 +22,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/fetch"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
 )
 
@@ -33,7 +34,17 @@ brands:
     name: The Things Products
     url: https://www.thethingsnetwork.org
     logos:
-    - logo.png`),
+    - logo.png
+    profiles:
+    - dev_eui_prefix: 70B3D57ED0000000/40
+      join_eui_prefix: 70B3D57ED0000000/64
+      model_id: thethingsuno
+      hardware_version: '1.0'
+      firmware_version: '1.1'
+    - dev_eui_prefix: 70B3D57ED1000000/40
+      model_id: thethingsuno
+      hardware_version: '2.0'
+      firmware_version: '1.0'`),
 		"thethingsproducts/devices.yml": []byte(`version: '3'
 devices:
   thethingsuno:
@@ -233,3 +244,62 @@ func TestDeviceVersions(t *testing.T) {
 		})
 	}
 }
+
+func TestInferDeviceVersion(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		JoinEUI       types.EUI64
+		DevEUI        types.EUI64
+		Fetcher       fetch.Interface
+		ExpectedErr   func(err error) bool
+		ExpectedValue *ttnpb.EndDeviceVersionIdentifiers
+	}{
+		{
+			Name:        "Match",
+			JoinEUI:     types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00},
+			DevEUI:      types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42},
+			Fetcher:     validFetcher,
+			ExpectedErr: func(err error) bool { return err == nil },
+			ExpectedValue: &ttnpb.EndDeviceVersionIdentifiers{
+				BrandID:         "thethingsproducts",
+				ModelID:         "thethingsuno",
+				HardwareVersion: "1.0",
+				FirmwareVersion: "1.1",
+			},
+		},
+		{
+			Name:        "JoinEUIMismatch",
+			JoinEUI:     types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+			DevEUI:      types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x42},
+			Fetcher:     validFetcher,
+			ExpectedErr: errors.IsNotFound,
+		},
+		{
+			Name:        "UnknownVersion",
+			DevEUI:      types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x42},
+			Fetcher:     validFetcher,
+			ExpectedErr: errors.IsNotFound,
+		},
+		{
+			Name:        "NoMatch",
+			DevEUI:      types.EUI64{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x42},
+			Fetcher:     validFetcher,
+			ExpectedErr: errors.IsNotFound,
+		},
+		{
+			Name:        "Invalid",
+			Fetcher:     invalidFetcher,
+			ExpectedErr: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			repo := Client{Fetcher: tc.Fetcher}
+			version, err := repo.InferDeviceVersion(tc.JoinEUI, tc.DevEUI)
+			if a.So(tc.ExpectedErr(err), should.BeTrue) && err == nil {
+				a.So(version.EndDeviceVersionIdentifiers, should.Resemble, *tc.ExpectedValue)
+			}
+		})
+	}
+}
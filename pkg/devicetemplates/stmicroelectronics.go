@@ -0,0 +1,137 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var errSTMicroelectronicsData = errors.DefineInvalidArgument("stmicroelectronics_data", "invalid STMicroelectronics data")
+
+type stMicroelectronicsEntry struct {
+	UniqueID    string       `json:"uniqueId"`
+	JoinEUI     *types.EUI64 `json:"joinEui"`
+	Certificate []byte       `json:"certificate"`
+}
+
+// stMicroelectronicsSecureElement is a STMicroelectronics secure element device provisioner.
+type stMicroelectronicsSecureElement struct{}
+
+func (*stMicroelectronicsSecureElement) Format() *ttnpb.EndDeviceTemplateFormat {
+	return &ttnpb.EndDeviceTemplateFormat{
+		Name:           "STMicroelectronics Secure Element Manifest File",
+		Description:    "JSON manifest file with the serial numbers and device certificates of STMicroelectronics secure elements.",
+		FileExtensions: []string{".json"},
+	}
+}
+
+// Convert decodes the given manifest data.
+// The input data is an array of entries with the unique ID (serial number) of the secure element, the base64 encoded
+// DER certificate of the secure element and optionally the JoinEUI. The DevEUI is the hex encoded serial number in the
+// subject of the certificate. The certificate and its fingerprint, issuer and validity are stored as provisioning data.
+func (*stMicroelectronicsSecureElement) Convert(ctx context.Context, r io.Reader, ch chan<- *ttnpb.EndDeviceTemplate) error {
+	defer close(ch)
+
+	dec := json.NewDecoder(r)
+	delim, err := dec.Token()
+	if err != nil {
+		return errSTMicroelectronicsData.WithCause(err)
+	}
+	if _, ok := delim.(json.Delim); !ok {
+		return errSTMicroelectronicsData
+	}
+
+	for dec.More() {
+		var entry stMicroelectronicsEntry
+		if err := dec.Decode(&entry); err != nil {
+			return errSTMicroelectronicsData.WithCause(err)
+		}
+		if entry.UniqueID == "" {
+			return errSTMicroelectronicsData
+		}
+		cert, err := x509.ParseCertificate(entry.Certificate)
+		if err != nil {
+			return errSTMicroelectronicsData.WithCause(err)
+		}
+		var devEUI types.EUI64
+		if err := devEUI.UnmarshalText([]byte(cert.Subject.SerialNumber)); err != nil {
+			return errSTMicroelectronicsData.WithCause(err)
+		}
+		devJoinEUI := joinEUI
+		if entry.JoinEUI != nil {
+			devJoinEUI = *entry.JoinEUI
+		}
+		fingerprint := sha256.Sum256(cert.Raw)
+		s, err := gogoproto.Struct(map[string]interface{}{
+			"uniqueId":               entry.UniqueID,
+			"certificate":            base64.StdEncoding.EncodeToString(cert.Raw),
+			"certificateFingerprint": hex.EncodeToString(fingerprint[:]),
+			"certificateIssuer":      cert.Issuer.String(),
+			"certificateNotBefore":   cert.NotBefore.UTC().Format(time.RFC3339),
+			"certificateNotAfter":    cert.NotAfter.UTC().Format(time.RFC3339),
+		})
+		if err != nil {
+			return errSTMicroelectronicsData.WithCause(err)
+		}
+		ch <- &ttnpb.EndDeviceTemplate{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					DeviceID: strings.ToLower(fmt.Sprintf("eui-%s", devEUI)),
+					JoinEUI:  &devJoinEUI,
+					DevEUI:   &devEUI,
+				},
+				ProvisionerID:    provisioning.STMicroelectronics,
+				ProvisioningData: s,
+				RootKeys: &ttnpb.RootKeys{
+					RootKeyID: entry.UniqueID,
+				},
+				SupportsJoin: true,
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: []string{
+					"ids.device_id",
+					"ids.dev_eui",
+					"ids.join_eui",
+					"provisioner_id",
+					"provisioning_data",
+					"root_keys.root_key_id",
+					"supports_join",
+				},
+			},
+			MappingKey: entry.UniqueID,
+		}
+	}
+	return nil
+}
+
+func init() {
+	RegisterConverter("stmicroelectronics-secure-element", &stMicroelectronicsSecureElement{})
+}
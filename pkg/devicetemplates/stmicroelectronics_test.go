@@ -0,0 +1,140 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/provisioning"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestSTMicroelectronicsSecureElement(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	converter := GetConverter("stmicroelectronics-secure-element")
+	if !a.So(converter, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	format := converter.Format()
+	a.So(format.Name, should.Equal, "STMicroelectronics Secure Element Manifest File")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	notBefore := time.Date(2019, time.December, 1, 0, 0, 0, 0, time.UTC)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			CommonName:   "STSAFE",
+			SerialNumber: "0080E11500000001",
+		},
+		NotBefore: notBefore,
+		NotAfter:  notBefore.AddDate(20, 0, 0),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	fingerprint := sha256.Sum256(cert)
+
+	for _, tc := range []struct {
+		name            string
+		data            string
+		expectedJoinEUI types.EUI64
+		errorAssertion  func(error) bool
+	}{
+		{
+			name:           "Garbage",
+			data:           `garbage`,
+			errorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			name:           "NoUniqueID",
+			data:           fmt.Sprintf(`[{"certificate":"%s"}]`, base64.StdEncoding.EncodeToString(cert)),
+			errorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			name:           "InvalidCertificate",
+			data:           `[{"uniqueId":"0209a1b2c3d4e5f6a7b8","certificate":"AAAA"}]`,
+			errorAssertion: func(err error) bool { return err != nil },
+		},
+		{
+			name:            "DefaultJoinEUI",
+			data:            fmt.Sprintf(`[{"uniqueId":"0209a1b2c3d4e5f6a7b8","certificate":"%s"}]`, base64.StdEncoding.EncodeToString(cert)),
+			expectedJoinEUI: types.EUI64{0x70, 0xB3, 0xD5, 0x7E, 0xD0, 0x00, 0x00, 0x00},
+		},
+		{
+			name:            "CustomJoinEUI",
+			data:            fmt.Sprintf(`[{"uniqueId":"0209a1b2c3d4e5f6a7b8","joinEui":"0080E1FFFE000000","certificate":"%s"}]`, base64.StdEncoding.EncodeToString(cert)),
+			expectedJoinEUI: types.EUI64{0x00, 0x80, 0xE1, 0xFF, 0xFE, 0x00, 0x00, 0x00},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			ch := make(chan *ttnpb.EndDeviceTemplate, 1)
+			err := converter.Convert(ctx, bytes.NewReader([]byte(tc.data)), ch)
+			if tc.errorAssertion != nil {
+				a.So(tc.errorAssertion(err), should.BeTrue)
+				_, ok := <-ch
+				a.So(ok, should.BeFalse)
+				return
+			}
+			a.So(err, should.BeNil)
+
+			entry, ok := <-ch
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(entry.EndDevice.DeviceID, should.Equal, "eui-0080e11500000001")
+			a.So(entry.EndDevice.JoinEUI, should.Resemble, &tc.expectedJoinEUI)
+			a.So(entry.EndDevice.DevEUI, should.Resemble, &types.EUI64{0x00, 0x80, 0xE1, 0x15, 0x00, 0x00, 0x00, 0x01})
+			a.So(entry.EndDevice.ProvisionerID, should.Equal, provisioning.STMicroelectronics)
+			a.So(entry.EndDevice.RootKeys.GetRootKeyID(), should.Equal, "0209a1b2c3d4e5f6a7b8")
+			a.So(entry.EndDevice.SupportsJoin, should.BeTrue)
+			a.So(entry.MappingKey, should.Equal, "0209a1b2c3d4e5f6a7b8")
+
+			fields := entry.EndDevice.ProvisioningData.GetFields()
+			a.So(fields["uniqueId"].GetStringValue(), should.Equal, "0209a1b2c3d4e5f6a7b8")
+			a.So(fields["certificate"].GetStringValue(), should.Equal, base64.StdEncoding.EncodeToString(cert))
+			a.So(fields["certificateFingerprint"].GetStringValue(), should.Equal, hex.EncodeToString(fingerprint[:]))
+			a.So(fields["certificateIssuer"].GetStringValue(), should.Equal, "SERIALNUMBER=0080E11500000001,CN=STSAFE")
+			a.So(fields["certificateNotBefore"].GetStringValue(), should.Equal, "2019-12-01T00:00:00Z")
+			a.So(fields["certificateNotAfter"].GetStringValue(), should.Equal, "2039-12-01T00:00:00Z")
+		})
+	}
+}
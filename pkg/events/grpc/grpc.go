@@ -19,9 +19,11 @@ package grpc
 import (
 	"context"
 	"runtime"
+	"sort"
 
 	grpc_runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/warning"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -267,6 +269,41 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 	}
 }
 
+var errNoStore = errors.DefineUnimplemented("no_store", "events are not stored")
+
+// FindRelated implements the EventsServer interface.
+func (srv *EventsServer) FindRelated(ctx context.Context, req *ttnpb.FindRelatedEventsRequest) (*ttnpb.FindRelatedEventsResponse, error) {
+	store, ok := srv.pubsub.(events.Store)
+	if !ok {
+		return nil, errNoStore
+	}
+	evts, err := store.FindRelated(ctx, req.CorrelationID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(evts, func(i, j int) bool {
+		return evts[i].Time().Before(evts[j].Time())
+	})
+	res := &ttnpb.FindRelatedEventsResponse{
+		Events: make([]*ttnpb.Event, 0, len(evts)),
+	}
+	for _, evt := range evts {
+		isVisible, err := srv.isVisible(ctx, evt)
+		if err != nil {
+			return nil, err
+		}
+		if !isVisible {
+			continue
+		}
+		proto, err := events.Proto(evt)
+		if err != nil {
+			return nil, err
+		}
+		res.Events = append(res.Events, proto)
+	}
+	return res, nil
+}
+
 // Roles implements rpcserver.Registerer.
 func (srv *EventsServer) Roles() []ttnpb.ClusterRole {
 	return nil
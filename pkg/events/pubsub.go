@@ -15,6 +15,7 @@
 package events
 
 import (
+	"context"
 	"runtime/trace"
 	"sync"
 
@@ -45,6 +46,12 @@ type Subscriber interface {
 	Unsubscribe(name string, hdl Handler)
 }
 
+// Store interface lets you find stored events.
+type Store interface {
+	// FindRelated returns the stored events with the given correlation ID, ordered by time.
+	FindRelated(ctx context.Context, correlationID string) ([]Event, error)
+}
+
 type handler struct {
 	eventName string
 	glob.Glob
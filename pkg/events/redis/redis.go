@@ -16,16 +16,34 @@
 package redis
 
 import (
+	"context"
 	"encoding/json"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-redis/redis"
 	"go.thethings.network/lorawan-stack/pkg/config"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
 )
 
+// Option is an option for the Redis PubSub.
+type Option func(*PubSub)
+
+// WithCorrelationStore stores published events by their correlation IDs for the given TTL,
+// up to the given limit of events per correlation ID.
+// A zero TTL disables the storage.
+func WithCorrelationStore(ttl time.Duration, limit int) Option {
+	return func(ps *PubSub) {
+		ps.correlationTTL = ttl
+		ps.correlationLimit = limit
+	}
+}
+
 // WrapPubSub wraps an existing PubSub and publishes all events received from Redis to that PubSub.
-func WrapPubSub(wrapped events.PubSub, conf config.Redis) (ps *PubSub) {
+func WrapPubSub(wrapped events.PubSub, conf config.Redis, opts ...Option) (ps *PubSub) {
 	ps = &PubSub{
 		PubSub: wrapped,
 		client: redis.NewClient(&redis.Options{
@@ -36,6 +54,9 @@ func WrapPubSub(wrapped events.PubSub, conf config.Redis) (ps *PubSub) {
 		eventChannel: strings.Join(append(conf.Namespace, "events"), ":"),
 		closeWait:    make(chan struct{}),
 	}
+	for _, opt := range opts {
+		opt(ps)
+	}
 	ps.sub = ps.client.Subscribe(ps.eventChannel)
 	go func() {
 		defer close(ps.closeWait)
@@ -53,8 +74,8 @@ func WrapPubSub(wrapped events.PubSub, conf config.Redis) (ps *PubSub) {
 }
 
 // NewPubSub creates a new PubSub that publishes and subscribes to Redis.
-func NewPubSub(conf config.Redis) *PubSub {
-	return WrapPubSub(events.NewPubSub(events.DefaultBufferSize), conf)
+func NewPubSub(conf config.Redis, opts ...Option) *PubSub {
+	return WrapPubSub(events.NewPubSub(events.DefaultBufferSize), conf, opts...)
 }
 
 // PubSub with Redis backend.
@@ -65,6 +86,9 @@ type PubSub struct {
 	client       *redis.Client
 	sub          *redis.PubSub
 	closeWait    chan struct{}
+
+	correlationTTL   time.Duration
+	correlationLimit int
 }
 
 // Close the Redis publisher.
@@ -78,10 +102,55 @@ func (ps *PubSub) Close() error {
 	return closeErr
 }
 
+func (ps *PubSub) correlationKey(correlationID string) string {
+	return ps.eventChannel + ":correlation:" + correlationID
+}
+
 // Publish an event to Redis.
 func (ps *PubSub) Publish(evt events.Event) {
 	json, err := json.Marshal(evt)
-	if err == nil {
+	if err != nil {
+		return
+	}
+	if ps.correlationTTL == 0 || len(evt.CorrelationIDs()) == 0 {
 		ps.client.Publish(ps.eventChannel, string(json))
+		return
+	}
+	ps.client.Pipelined(func(p redis.Pipeliner) error {
+		p.Publish(ps.eventChannel, string(json))
+		for _, cid := range evt.CorrelationIDs() {
+			key := ps.correlationKey(cid)
+			p.RPush(key, string(json))
+			if ps.correlationLimit > 0 {
+				p.LTrim(key, int64(-ps.correlationLimit), -1)
+			}
+			p.PExpire(key, ps.correlationTTL)
+		}
+		return nil
+	})
+}
+
+var errCorrelationStoreDisabled = errors.DefineUnimplemented("correlation_store_disabled", "storing events by correlation ID is disabled")
+
+// FindRelated implements events.Store.
+func (ps *PubSub) FindRelated(ctx context.Context, correlationID string) ([]events.Event, error) {
+	if ps.correlationTTL == 0 {
+		return nil, errCorrelationStoreDisabled
+	}
+	res, err := ps.client.WithContext(ctx).LRange(ps.correlationKey(correlationID), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	evts := make([]events.Event, 0, len(res))
+	for _, s := range res {
+		evt, err := events.UnmarshalJSON([]byte(s))
+		if err != nil {
+			return nil, err
+		}
+		evts = append(evts, evt)
 	}
+	sort.SliceStable(evts, func(i, j int) bool {
+		return evts[i].Time().Before(evts[j].Time())
+	})
+	return evts, nil
 }
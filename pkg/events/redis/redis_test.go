@@ -95,3 +95,36 @@ func TestRedisPubSub(t *testing.T) {
 		t.FailNow()
 	}
 }
+
+func TestRedisCorrelationStore(t *testing.T) {
+	a := assertions.New(t)
+
+	pubsub := redis.NewPubSub(redisConfig(), redis.WithCorrelationStore(time.Minute, 2))
+	defer pubsub.Close()
+
+	ctx := events.ContextWithCorrelationID(test.Context(), t.Name())
+
+	appID := &ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+
+	for _, name := range []string{"redis.test.evt0", "redis.test.evt1", "redis.test.evt2"} {
+		pubsub.Publish(events.New(ctx, name, appID, nil))
+	}
+
+	evts, err := pubsub.FindRelated(ctx, t.Name())
+	if !a.So(err, should.BeNil) || !a.So(evts, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(evts[0].Name(), should.Equal, "redis.test.evt1")
+	a.So(evts[1].Name(), should.Equal, "redis.test.evt2")
+	a.So(evts[0].Time().After(evts[1].Time()), should.BeFalse)
+
+	evts, err = pubsub.FindRelated(ctx, "unknown")
+	a.So(err, should.BeNil)
+	a.So(evts, should.BeEmpty)
+
+	disabled := redis.NewPubSub(redisConfig())
+	defer disabled.Close()
+
+	_, err = disabled.FindRelated(ctx, t.Name())
+	a.So(err, should.NotBeNil)
+}
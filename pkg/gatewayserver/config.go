@@ -15,6 +15,8 @@
 package gatewayserver
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/pkg/config"
 	"go.thethings.network/lorawan-stack/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -34,10 +36,25 @@ type BasicStationConfig struct {
 	UseTrafficTLSAddress    bool   `name:"use-traffic-tls-address" description:"Use WSS for the traffic address regardless of the TLS setting"`
 }
 
+// ConnectionLimitsConfig defines the limits on gateway connections of the Gateway Server.
+type ConnectionLimitsConfig struct {
+	MaxGateways int `name:"max-gateways" description:"Maximum number of concurrently connected gateways (0 is unlimited)"`
+}
+
+// StartupConfig defines the startup behavior of the Gateway Server.
+type StartupConfig struct {
+	WaitForDependencies bool          `name:"wait-for-dependencies" description:"Start the gateway frontends only when the Entity Registry and Network Server are reachable"`
+	CheckInterval       time.Duration `name:"check-interval" description:"Interval between dependency checks"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
+	ConnectionLimits ConnectionLimitsConfig `name:"connection-limits"`
+
+	Startup StartupConfig `name:"startup"`
+
 	Forward map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
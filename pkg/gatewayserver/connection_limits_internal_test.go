@@ -0,0 +1,51 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestReserveConnection(t *testing.T) {
+	a := assertions.New(t)
+
+	gs := &GatewayServer{
+		config: &Config{
+			ConnectionLimits: ConnectionLimitsConfig{
+				MaxGateways: 2,
+			},
+		},
+	}
+	a.So(gs.reserveConnection("gtw-1"), should.BeTrue)
+	gs.connections.Store("gtw-1", nil)
+	a.So(gs.reserveConnection("gtw-2"), should.BeTrue)
+	gs.connections.Store("gtw-2", nil)
+
+	// The limit is reached for new gateways, but connected gateways can reconnect.
+	a.So(gs.reserveConnection("gtw-3"), should.BeFalse)
+	a.So(gs.reserveConnection("gtw-1"), should.BeTrue)
+	gs.releaseConnection()
+
+	gs.connections.Delete("gtw-2")
+	gs.releaseConnection()
+	a.So(gs.reserveConnection("gtw-3"), should.BeTrue)
+
+	// Without a limit, connections are always reserved.
+	gs.config.ConnectionLimits.MaxGateways = 0
+	a.So(gs.reserveConnection("gtw-4"), should.BeTrue)
+}
@@ -68,7 +68,9 @@ type GatewayServer struct {
 
 	upstreamHandlers map[string]upstream.Handler
 
-	connections sync.Map
+	connections       sync.Map
+	connectedGateways int32
+	frontendsStarted  uint32
 }
 
 func (gs *GatewayServer) getRegistry(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (ttnpb.GatewayRegistryClient, error) {
@@ -106,6 +108,7 @@ var (
 	errSetupUpstream       = errors.DefineFailedPrecondition("upstream", "failed to setup upstream `{hostname}`")
 	errUpstreamType        = errors.DefineUnimplemented("upstream_type_not_implemented", "upstream `{name}` not implemented")
 	errInvalidUpstreamName = errors.DefineInvalidArgument("invalid_upstream_name", "upstream `{name}`is invalid")
+	errFrontendsNotStarted = errors.DefineUnavailable("frontends_not_started", "frontends not started")
 )
 
 // New returns new *GatewayServer.
@@ -129,6 +132,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	for _, opt := range opts {
 		opt(gs)
 	}
+	gsMetrics.connectionLimit.Set(float64(conf.ConnectionLimits.MaxGateways))
 
 	ctx, cancel := context.WithCancel(gs.Context())
 	defer func() {
@@ -137,6 +141,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		}
 	}()
 
+	// The frontends listen right away, so that invalid listen addresses fail early, but they are only started when
+	// the dependencies of the Gateway Server are ready, if configured.
+	var frontends []func()
+
 	for addr, fallbackFrequencyPlanID := range conf.UDP.Listeners {
 		var conn *net.UDPConn
 		conn, err = gs.ListenUDP(addr)
@@ -150,7 +158,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		if fallbackFrequencyPlanID != "" {
 			lisCtx = frequencyplans.WithFallbackID(ctx, fallbackFrequencyPlanID)
 		}
-		udp.Start(lisCtx, gs, conn, conf.UDP.Config)
+		frontends = append(frontends, func() {
+			udp.Start(lisCtx, gs, conn, conf.UDP.Config)
+		})
 	}
 
 	for _, version := range []struct {
@@ -184,7 +194,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 					"protocol", endpoint.Protocol(),
 				)
 			}
-			mqtt.Start(ctx, gs, lis, version.Format, endpoint.Protocol())
+			format, protocol := version.Format, endpoint.Protocol()
+			frontends = append(frontends, func() {
+				mqtt.Start(ctx, gs, lis, format, protocol)
+			})
 		}
 	}
 
@@ -213,11 +226,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 				"protocol", endpoint.Protocol(),
 			)
 		}
-		go func() error {
-			return http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		frontends = append(frontends, func() {
+			go http.Serve(lis, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				bsWebServer.ServeHTTP(w, r)
 			}))
-		}()
+		})
 	}
 
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.NsGs", cluster.HookName, c.ClusterAuthUnaryHook())
@@ -245,6 +258,51 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		}
 	}
 
+	var dependencies []string
+	if gs.registry == nil {
+		name := component.ReadinessCheckName("gatewayserver", "entity-registry")
+		c.RegisterReadinessCheck(name, c.PeerReadinessCheck(ttnpb.ClusterRole_ENTITY_REGISTRY))
+		dependencies = append(dependencies, name)
+	}
+	if _, ok := gs.upstreamHandlers["cluster"]; ok {
+		name := component.ReadinessCheckName("gatewayserver", "network-server")
+		c.RegisterReadinessCheck(name, c.PeerReadinessCheck(ttnpb.ClusterRole_NETWORK_SERVER))
+		dependencies = append(dependencies, name)
+	}
+	c.RegisterReadinessCheck(component.ReadinessCheckName("gatewayserver", "frontends"), func() error {
+		if atomic.LoadUint32(&gs.frontendsStarted) == 0 {
+			return errFrontendsNotStarted
+		}
+		return nil
+	})
+	startFrontends := func() {
+		for _, start := range frontends {
+			start()
+		}
+		atomic.StoreUint32(&gs.frontendsStarted, 1)
+	}
+	if conf.Startup.WaitForDependencies {
+		c.RegisterTask(ctx, "gatewayserver_start_frontends", func(ctx context.Context) error {
+			if err := c.WaitReady(ctx, conf.Startup.CheckInterval, dependencies...); err != nil {
+				return err
+			}
+			log.FromContext(ctx).Info("Dependencies ready, starting frontends")
+			startFrontends()
+			return nil
+		}, component.TaskRestartOnFailure)
+	} else {
+		startFrontends()
+	}
+
+	suspendHandler := events.HandlerFunc(gs.handleGatewaySuspend)
+	if err := events.Subscribe("gateway.suspend", suspendHandler); err != nil {
+		return nil, err
+	}
+	go func() {
+		<-gs.Context().Done()
+		events.Unsubscribe("gateway.suspend", suspendHandler)
+	}()
+
 	c.RegisterGRPC(gs)
 	return gs, nil
 }
@@ -330,8 +388,36 @@ var (
 		"no_fallback_frequency_plan",
 		"gateway `{gateway_uid}` is not registered and no fallback frequency plan defined",
 	)
+	errGatewaySuspended = errors.DefineFailedPrecondition(
+		"gateway_suspended",
+		"gateway `{gateway_uid}` is suspended",
+	)
+	errConnectionLimit = errors.DefineResourceExhausted(
+		"connection_limit",
+		"maximum number of `{max_gateways}` connected gateways reached",
+	)
 )
 
+// reserveConnection reserves a connection for the gateway. This method returns false if the maximum number of connected
+// gateways is reached. Gateways that are already connected can always reconnect, as their connection gets replaced.
+func (gs *GatewayServer) reserveConnection(uid string) bool {
+	max := int32(gs.config.ConnectionLimits.MaxGateways)
+	_, connected := gs.connections.Load(uid)
+	for {
+		n := atomic.LoadInt32(&gs.connectedGateways)
+		if max > 0 && n >= max && !connected {
+			return false
+		}
+		if atomic.CompareAndSwapInt32(&gs.connectedGateways, n, n+1) {
+			return true
+		}
+	}
+}
+
+func (gs *GatewayServer) releaseConnection() {
+	atomic.AddInt32(&gs.connectedGateways, -1)
+}
+
 // Connect connects a gateway by its identifiers to the Gateway Server, and returns a io.Connection for traffic and
 // control.
 func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids ttnpb.GatewayIdentifiers) (*io.Connection, error) {
@@ -346,6 +432,18 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	))
 	ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:conn:%s", events.NewCorrelationID()))
 
+	if !gs.reserveConnection(uid) {
+		registerRejectConnection(ctx, frontend.Protocol())
+		logger.Warn("Maximum number of connected gateways reached, reject connection")
+		return nil, errConnectionLimit.WithAttributes("max_gateways", gs.config.ConnectionLimits.MaxGateways)
+	}
+	connected := false
+	defer func() {
+		if !connected {
+			gs.releaseConnection()
+		}
+	}()
+
 	var err error
 	var callOpt grpc.CallOption
 	callOpt, err = rpcmetadata.WithForwardedAuth(ctx, gs.AllowInsecureForCredentials())
@@ -366,6 +464,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 				"schedule_downlink_late",
 				"enforce_duty_cycle",
 				"downlink_path_constraint",
+				"suspended",
 			},
 		},
 	}, callOpt)
@@ -386,6 +485,8 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		}
 	} else if err != nil {
 		return nil, err
+	} else if gtw.Suspended {
+		return nil, errGatewaySuspended.WithAttributes("gateway_uid", uid)
 	}
 	fp, err := gs.FrequencyPlans.GetByID(gtw.FrequencyPlanID)
 	if err != nil {
@@ -397,6 +498,7 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 		return nil, err
 	}
 	gs.connections.Store(uid, conn)
+	connected = true
 	registerGatewayConnect(ctx, ids)
 	logger.Info("Connected")
 	go gs.handleUpstream(conn)
@@ -412,6 +514,23 @@ func (gs *GatewayServer) Connect(ctx context.Context, frontend io.Frontend, ids
 	return conn, nil
 }
 
+// handleGatewaySuspend disconnects the gateways that got suspended in the Identity Server.
+func (gs *GatewayServer) handleGatewaySuspend(evt events.Event) {
+	for _, ids := range evt.Identifiers() {
+		gtwIDs := ids.GetGatewayIDs()
+		if gtwIDs == nil {
+			continue
+		}
+		conn, ok := gs.GetConnection(gs.Context(), *gtwIDs)
+		if !ok {
+			continue
+		}
+		uid := unique.ID(gs.Context(), *gtwIDs)
+		log.FromContext(conn.Context()).Info("Disconnect suspended gateway")
+		conn.Disconnect(errGatewaySuspended.WithAttributes("gateway_uid", uid))
+	}
+}
+
 // GetConnection returns the *io.Connection for the given gateway. If not found, this method returns nil, false.
 func (gs *GatewayServer) GetConnection(ctx context.Context, ids ttnpb.GatewayIdentifiers) (*io.Connection, bool) {
 	conn, loaded := gs.connections.Load(unique.ID(ctx, ids))
@@ -456,6 +575,7 @@ func (gs *GatewayServer) handleUpstream(conn *io.Connection) {
 	defer func() {
 		ids := conn.Gateway().GatewayIdentifiers
 		gs.connections.Delete(unique.ID(ctx, ids))
+		gs.releaseConnection()
 		registerGatewayDisconnect(ctx, ids)
 		logger.Info("Disconnected")
 	}()
@@ -229,6 +229,17 @@ func (s *srv) handleTraffic(c echo.Context) (err error) {
 	conn, err := s.server.Connect(ctx, s, ids)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to connect")
+		if errors.IsResourceExhausted(err) {
+			// Close the connection with a reason that the station can log, and let it retry later.
+			ws, upgradeErr := s.upgrader.Upgrade(c.Response(), c.Request(), nil)
+			if upgradeErr != nil {
+				logger.WithError(upgradeErr).Debug("Failed to upgrade request to websocket connection")
+				return err
+			}
+			writeTryAgainLater(ctx, ws, err)
+			ws.Close()
+			return nil
+		}
 		return err
 	}
 	defer func() {
@@ -426,6 +437,22 @@ func writeDiscoverError(ctx context.Context, ws *websocket.Conn, msg string) {
 	}
 }
 
+// maxCloseReasonLength is the maximum length of the reason in a WS close message, which is limited to 125 bytes
+// including the status code.
+const maxCloseReasonLength = 123
+
+// writeTryAgainLater closes the WS connection to the station with the Try Again Later status code.
+func writeTryAgainLater(ctx context.Context, ws *websocket.Conn, err error) {
+	reason := err.Error()
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+	}
+	msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, reason)
+	if err := ws.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second)); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to write close message")
+	}
+}
+
 func recordRTT(conn *io.Connection, receivedAt time.Time, refTime float64) {
 	sec, nsec := math.Modf(refTime)
 	if sec != 0 {
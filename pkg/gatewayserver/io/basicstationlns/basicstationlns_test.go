@@ -948,6 +948,8 @@ func TestTraffic(t *testing.T) {
 						}
 						up.RawPayload = nil
 						up.RxMetadata[0].UplinkToken = nil
+						a.So(up.RxMetadata[0].FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+						up.RxMetadata[0].FrequencyPlanID = ""
 						expectedUp := tc.ExpectedNetworkUpstream.(ttnpb.UplinkMessage)
 						a.So(up, should.Resemble, &expectedUp)
 					case <-time.After(timeout):
@@ -350,6 +350,8 @@ func TestTraffic(t *testing.T) {
 						expected := tc.UplinkMessages[ups]
 						up.ReceivedAt = expected.ReceivedAt
 						up.RxMetadata[0].UplinkToken = expected.RxMetadata[0].UplinkToken
+						a.So(up.RxMetadata[0].FrequencyPlanID, should.Equal, test.EUFrequencyPlanID)
+						up.RxMetadata[0].FrequencyPlanID = expected.RxMetadata[0].FrequencyPlanID
 						a.So(up, should.Resemble, expected)
 						ups++
 					case status := <-conn.Status():
@@ -144,7 +144,13 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage) error {
 		)).Debug("Synchronized server absolute time only")
 	}
 
+	var rtt *time.Duration
+	if _, _, median, n := c.rtts.Stats(); n > 0 {
+		rtt = &median
+	}
 	for _, md := range up.RxMetadata {
+		md.FrequencyPlanID = c.gateway.FrequencyPlanID
+		md.RoundTripTime = rtt
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
 			md.DownlinkPathConstraint = ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
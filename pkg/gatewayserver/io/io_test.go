@@ -89,6 +89,7 @@ func Test(t *testing.T) {
 			a.So(tokenIDs.GatewayIdentifiers, should.Resemble, ids)
 			a.So(tokenIDs.AntennaIndex, should.Equal, 0)
 			a.So(timestamp, should.Equal, 100)
+			a.So(up.RxMetadata[0].FrequencyPlanID, should.Equal, "EU_863_870")
 		case <-time.After(timeout):
 			t.Fatalf("Expected uplink message time-out")
 		}
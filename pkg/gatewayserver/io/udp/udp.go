@@ -171,6 +171,12 @@ func (s *srv) handlePackets() {
 
 			cs, err := s.connect(ctx, eui)
 			if err != nil {
+				if errors.IsResourceExhausted(err) {
+					// The UDP protocol has no means to reject connections; the gateway keeps sending packets, which are
+					// dropped until a connection becomes available.
+					logger.WithError(err).Debug("Drop packet of rejected connection")
+					break
+				}
 				logger.WithError(err).Warn("Failed to connect")
 				break
 			}
@@ -86,6 +86,7 @@ const (
 	unknown       = "unknown"
 	gatewayID     = "gateway_id"
 	networkServer = "network_server"
+	protocol      = "protocol"
 )
 
 var gsMetrics = &messageMetrics{
@@ -97,6 +98,21 @@ var gsMetrics = &messageMetrics{
 		},
 		[]string{gatewayID},
 	),
+	connectionLimit: prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "connected_gateways_limit",
+			Help:      "Maximum number of concurrently connected gateways (0 is unlimited)",
+		},
+	),
+	connectionsRejected: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "connections_rejected_total",
+			Help:      "Total number of gateway connections rejected because the maximum number of connected gateways is reached",
+		},
+		[]string{protocol},
+	),
 	statusReceived: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -193,6 +209,8 @@ func init() {
 
 type messageMetrics struct {
 	gatewaysConnected   *metrics.ContextualGaugeVec
+	connectionLimit     prometheus.Gauge
+	connectionsRejected *metrics.ContextualCounterVec
 	statusReceived      *metrics.ContextualCounterVec
 	statusForwarded     *metrics.ContextualCounterVec
 	statusDropped       *metrics.ContextualCounterVec
@@ -208,6 +226,8 @@ type messageMetrics struct {
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.gatewaysConnected.Describe(ch)
+	m.connectionLimit.Describe(ch)
+	m.connectionsRejected.Describe(ch)
 	m.statusReceived.Describe(ch)
 	m.uplinkReceived.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
@@ -220,6 +240,8 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.gatewaysConnected.Collect(ch)
+	m.connectionLimit.Collect(ch)
+	m.connectionsRejected.Collect(ch)
 	m.statusReceived.Collect(ch)
 	m.uplinkReceived.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
@@ -240,6 +262,10 @@ func registerGatewayDisconnect(ctx context.Context, ids ttnpb.GatewayIdentifiers
 	gsMetrics.gatewaysConnected.WithLabelValues(ctx, ids.GatewayID).Dec()
 }
 
+func registerRejectConnection(ctx context.Context, protocol string) {
+	gsMetrics.connectionsRejected.WithLabelValues(ctx, protocol).Inc()
+}
+
 func registerReceiveStatus(ctx context.Context, gtw *ttnpb.Gateway, status *ttnpb.GatewayStatus) {
 	events.Publish(evtReceiveStatus(ctx, gtw, status))
 	gsMetrics.statusReceived.WithLabelValues(ctx, gtw.GatewayID).Inc()
@@ -93,6 +93,29 @@ func (is *IdentityServer) createApplicationAPIKey(ctx context.Context, req *ttnp
 	return key, nil
 }
 
+func (is *IdentityServer) createApplicationAPIKeyBulk(ctx context.Context, req *ttnpb.CreateApplicationAPIKeyBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	res := &ttnpb.ApplicationBulkResults{
+		Results: make([]*ttnpb.ApplicationBulkResult, 0, len(req.ApplicationIDs)),
+	}
+	for _, ids := range req.ApplicationIDs {
+		result := &ttnpb.ApplicationBulkResult{
+			ApplicationIDs: *ids,
+		}
+		key, err := is.createApplicationAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{
+			ApplicationIdentifiers: *ids,
+			Name:                   req.Name,
+			Rights:                 req.Rights,
+		})
+		if err != nil {
+			result.Error = bulkErrorDetails(err)
+		} else {
+			result.APIKey = key
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
 func (is *IdentityServer) listApplicationAPIKeys(ctx context.Context, req *ttnpb.ListApplicationAPIKeysRequest) (keys *ttnpb.APIKeys, err error) {
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_API_KEYS); err != nil {
 		return nil, err
@@ -269,6 +292,26 @@ func (is *IdentityServer) setApplicationCollaborator(ctx context.Context, req *t
 	return ttnpb.Empty, nil
 }
 
+func (is *IdentityServer) setApplicationCollaboratorBulk(ctx context.Context, req *ttnpb.SetApplicationCollaboratorBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	res := &ttnpb.ApplicationBulkResults{
+		Results: make([]*ttnpb.ApplicationBulkResult, 0, len(req.ApplicationIDs)),
+	}
+	for _, ids := range req.ApplicationIDs {
+		result := &ttnpb.ApplicationBulkResult{
+			ApplicationIDs: *ids,
+		}
+		_, err := is.setApplicationCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIdentifiers: *ids,
+			Collaborator:           req.Collaborator,
+		})
+		if err != nil {
+			result.Error = bulkErrorDetails(err)
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
 func (is *IdentityServer) listApplicationCollaborators(ctx context.Context, req *ttnpb.ListApplicationCollaboratorsRequest) (collaborators *ttnpb.Collaborators, err error) {
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_COLLABORATORS); err != nil {
 		return nil, err
@@ -312,6 +355,10 @@ func (aa *applicationAccess) CreateAPIKey(ctx context.Context, req *ttnpb.Create
 	return aa.createApplicationAPIKey(ctx, req)
 }
 
+func (aa *applicationAccess) CreateAPIKeyBulk(ctx context.Context, req *ttnpb.CreateApplicationAPIKeyBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	return aa.createApplicationAPIKeyBulk(ctx, req)
+}
+
 func (aa *applicationAccess) ListAPIKeys(ctx context.Context, req *ttnpb.ListApplicationAPIKeysRequest) (*ttnpb.APIKeys, error) {
 	return aa.listApplicationAPIKeys(ctx, req)
 }
@@ -332,6 +379,10 @@ func (aa *applicationAccess) SetCollaborator(ctx context.Context, req *ttnpb.Set
 	return aa.setApplicationCollaborator(ctx, req)
 }
 
+func (aa *applicationAccess) SetCollaboratorBulk(ctx context.Context, req *ttnpb.SetApplicationCollaboratorBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	return aa.setApplicationCollaboratorBulk(ctx, req)
+}
+
 func (aa *applicationAccess) ListCollaborators(ctx context.Context, req *ttnpb.ListApplicationCollaboratorsRequest) (*ttnpb.Collaborators, error) {
 	return aa.listApplicationCollaborators(ctx, req)
 }
@@ -350,6 +350,78 @@ func TestApplicationAccessCRUD(t *testing.T) {
 	})
 }
 
+func TestApplicationAccessBulk(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		userID, creds := defaultUser.UserIdentifiers, userCreds(defaultUserIdx)
+		applicationID := userApplications(&userID).Applications[0].ApplicationIdentifiers
+		collaboratorID := collaboratorUser.UserIdentifiers.OrganizationOrUserIdentifiers()
+
+		modifiedApplicationID := applicationID
+		modifiedApplicationID.ApplicationID = reverse(modifiedApplicationID.ApplicationID)
+
+		reg := ttnpb.NewApplicationAccessClient(cc)
+
+		APIKeyName := "test-application-bulk-api-key-name"
+		keys, err := reg.CreateAPIKeyBulk(ctx, &ttnpb.CreateApplicationAPIKeyBulkRequest{
+			ApplicationIDs: []*ttnpb.ApplicationIdentifiers{&applicationID, &modifiedApplicationID},
+			Name:           APIKeyName,
+			Rights:         []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(keys, should.NotBeNil) && a.So(keys.Results, should.HaveLength, 2) {
+			a.So(keys.Results[0].ApplicationIDs, should.Resemble, applicationID)
+			a.So(keys.Results[0].Error, should.BeNil)
+			if a.So(keys.Results[0].APIKey, should.NotBeNil) {
+				a.So(keys.Results[0].APIKey.Name, should.Equal, APIKeyName)
+				a.So(keys.Results[0].APIKey.Key, should.NotBeEmpty)
+			}
+			a.So(keys.Results[1].ApplicationIDs, should.Resemble, modifiedApplicationID)
+			a.So(keys.Results[1].APIKey, should.BeNil)
+			if a.So(keys.Results[1].Error, should.NotBeNil) {
+				a.So(errors.IsPermissionDenied(ttnpb.ErrorDetailsFromProto(keys.Results[1].Error)), should.BeTrue)
+			}
+		}
+
+		collaborators, err := reg.SetCollaboratorBulk(ctx, &ttnpb.SetApplicationCollaboratorBulkRequest{
+			ApplicationIDs: []*ttnpb.ApplicationIdentifiers{&modifiedApplicationID, &applicationID},
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorID,
+				Rights:                        []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(collaborators, should.NotBeNil) && a.So(collaborators.Results, should.HaveLength, 2) {
+			a.So(collaborators.Results[0].Error, should.NotBeNil)
+			a.So(collaborators.Results[1].Error, should.BeNil)
+		}
+
+		res, err := reg.GetCollaborator(ctx, &ttnpb.GetApplicationCollaboratorRequest{
+			ApplicationIdentifiers:        applicationID,
+			OrganizationOrUserIdentifiers: *collaboratorID,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(res, should.NotBeNil) {
+			a.So(res.Rights, should.Resemble, []ttnpb.Right{ttnpb.RIGHT_APPLICATION_INFO})
+		}
+
+		_, err = reg.SetCollaboratorBulk(ctx, &ttnpb.SetApplicationCollaboratorBulkRequest{
+			Collaborator: ttnpb.Collaborator{
+				OrganizationOrUserIdentifiers: *collaboratorID,
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+	})
+}
+
 func TestApplicationAccessRights(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
@@ -40,6 +40,14 @@ var (
 		"application.delete", "delete application",
 		ttnpb.RIGHT_APPLICATION_INFO,
 	)
+	evtSuspendApplication = events.Define(
+		"application.suspend", "suspend application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
+	evtResumeApplication = events.Define(
+		"application.resume", "resume application",
+		ttnpb.RIGHT_APPLICATION_INFO,
+	)
 )
 
 func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.CreateApplicationRequest) (app *ttnpb.Application, err error) {
@@ -58,6 +66,16 @@ func (is *IdentityServer) createApplication(ctx context.Context, req *ttnpb.Crea
 	if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 		return nil, err
 	}
+	if err := validateTimeZone(req.Application.TimeZone); err != nil {
+		return nil, err
+	}
+	if err := validateMuteWindows(req.Application.MuteWindows); err != nil {
+		return nil, err
+	}
+	if !is.IsAdmin(ctx) {
+		req.Application.Suspended = false
+		req.Application.PacketLogger = false
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).CreateApplication(ctx, &req.Application)
 		if err != nil {
@@ -181,6 +199,8 @@ func (is *IdentityServer) listApplications(ctx context.Context, req *ttnpb.ListA
 	return apps, nil
 }
 
+var errUpdateApplicationAdminField = errors.DefinePermissionDenied("application_update_admin_field", "only admins can update the `{field}` field")
+
 func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.UpdateApplicationRequest) (app *ttnpb.Application, err error) {
 	if err = rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -189,11 +209,28 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 	if len(req.FieldMask.Paths) == 0 {
 		req.FieldMask.Paths = updatePaths
 	}
+	if !is.IsAdmin(ctx) {
+		for _, field := range []string{"suspended", "packet_logger"} {
+			if ttnpb.HasAnyField(req.FieldMask.Paths, field) {
+				return nil, errUpdateApplicationAdminField.WithAttributes("field", field)
+			}
+		}
+	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "contact_info") {
 		if err := validateContactInfo(req.Application.ContactInfo); err != nil {
 			return nil, err
 		}
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "time_zone") {
+		if err := validateTimeZone(req.Application.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mute_windows") {
+		if err := validateMuteWindows(req.Application.MuteWindows); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		app, err = store.GetApplicationStore(db).UpdateApplication(ctx, &req.Application, &req.FieldMask)
 		if err != nil {
@@ -212,6 +249,13 @@ func (is *IdentityServer) updateApplication(ctx context.Context, req *ttnpb.Upda
 		return nil, err
 	}
 	events.Publish(evtUpdateApplication(ctx, req.ApplicationIdentifiers, req.FieldMask.Paths))
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "suspended") {
+		if req.Suspended {
+			events.Publish(evtSuspendApplication(ctx, req.ApplicationIdentifiers, nil))
+		} else {
+			events.Publish(evtResumeApplication(ctx, req.ApplicationIdentifiers, nil))
+		}
+	}
 	return app, nil
 }
 
@@ -203,6 +203,202 @@ func TestApplicationsCRUD(t *testing.T) {
 	})
 }
 
+func TestApplicationsSuspend(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		created, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "suspended-app"},
+				Suspended:              true,
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.Suspended, should.BeFalse)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				Suspended:              true,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"suspended"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		for _, suspended := range []bool{true, false} {
+			_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+				Application: ttnpb.Application{
+					ApplicationIdentifiers: created.ApplicationIdentifiers,
+					Suspended:              suspended,
+				},
+				FieldMask: types.FieldMask{Paths: []string{"suspended"}},
+			}, adminCreds)
+
+			a.So(err, should.BeNil)
+
+			got, err := reg.Get(ctx, &ttnpb.GetApplicationRequest{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				FieldMask:              types.FieldMask{Paths: []string{"suspended"}},
+			}, creds)
+
+			a.So(err, should.BeNil)
+			if a.So(got, should.NotBeNil) {
+				a.So(got.Suspended, should.Equal, suspended)
+			}
+		}
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestApplicationsPacketLogger(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		created, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "packet-logger-app"},
+				PacketLogger:           true,
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.PacketLogger, should.BeFalse)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				PacketLogger:           true,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"packet_logger"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				PacketLogger:           true,
+			},
+			FieldMask: types.FieldMask{Paths: []string{"packet_logger"}},
+		}, adminCreds)
+
+		a.So(err, should.BeNil)
+
+		got, err := reg.Get(ctx, &ttnpb.GetApplicationRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"packet_logger"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.PacketLogger, should.BeTrue)
+		}
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestApplicationsTimeZone(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewApplicationRegistryClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+
+		_, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "time-zone-app"},
+				TimeZone:               "Europe/Nowhere",
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		created, err := reg.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "time-zone-app"},
+				TimeZone:               "Europe/Amsterdam",
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.TimeZone, should.Equal, "Europe/Amsterdam")
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				TimeZone:               "Local",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"time_zone"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateApplicationRequest{
+			Application: ttnpb.Application{
+				ApplicationIdentifiers: created.ApplicationIdentifiers,
+				TimeZone:               "America/New_York",
+			},
+			FieldMask: types.FieldMask{Paths: []string{"time_zone"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		got, err := reg.Get(ctx, &ttnpb.GetApplicationRequest{
+			ApplicationIdentifiers: created.ApplicationIdentifiers,
+			FieldMask:              types.FieldMask{Paths: []string{"time_zone"}},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.TimeZone, should.Equal, "America/New_York")
+		}
+
+		_, err = reg.Delete(ctx, &created.ApplicationIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
 func TestApplicationsPagination(t *testing.T) {
 	a := assertions.New(t)
 
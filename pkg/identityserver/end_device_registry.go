@@ -48,6 +48,12 @@ func (is *IdentityServer) createEndDevice(ctx context.Context, req *ttnpb.Create
 	if err = blacklist.Check(ctx, req.DeviceID); err != nil {
 		return nil, err
 	}
+	if err = validateTimeZone(req.EndDevice.TimeZone); err != nil {
+		return nil, err
+	}
+	if err = validateMuteWindows(req.EndDevice.MuteWindows); err != nil {
+		return nil, err
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		dev, err = store.GetEndDeviceStore(db).CreateEndDevice(ctx, &req.EndDevice)
 		if err != nil {
@@ -132,6 +138,16 @@ func (is *IdentityServer) updateEndDevice(ctx context.Context, req *ttnpb.Update
 	if len(req.FieldMask.Paths) == 0 {
 		req.FieldMask.Paths = updatePaths
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "time_zone") {
+		if err = validateTimeZone(req.EndDevice.TimeZone); err != nil {
+			return nil, err
+		}
+	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "mute_windows") {
+		if err = validateMuteWindows(req.EndDevice.MuteWindows); err != nil {
+			return nil, err
+		}
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		dev, err = store.GetEndDeviceStore(db).UpdateEndDevice(ctx, &req.EndDevice, &req.FieldMask)
 		return err
@@ -225,3 +225,157 @@ func TestEndDevicesCRUD(t *testing.T) {
 		}
 	})
 }
+
+func TestEndDevicesTimeZone(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewEndDeviceRegistryClient(cc)
+
+		userID := defaultUser.UserIdentifiers
+		creds := userCreds(defaultUserIdx)
+		app := userApplications(&userID).Applications[0]
+
+		ids := ttnpb.EndDeviceIdentifiers{
+			DeviceID:               "time-zone-device",
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+		}
+
+		_, err := reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				TimeZone:             "Europe/Nowhere",
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		created, err := reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				TimeZone:             "Europe/Amsterdam",
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.TimeZone, should.Equal, "Europe/Amsterdam")
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+			FieldMask: pbtypes.FieldMask{Paths: []string{"time_zone"}},
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				TimeZone:             "Europe/Nowhere",
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+			FieldMask: pbtypes.FieldMask{Paths: []string{"time_zone"}},
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+
+		got, err := reg.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"time_zone"}},
+			EndDeviceIdentifiers: ids,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.TimeZone, should.BeEmpty)
+		}
+
+		_, err = reg.Delete(ctx, &ids, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
+func TestEndDevicesMuteWindows(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewEndDeviceRegistryClient(cc)
+
+		userID := defaultUser.UserIdentifiers
+		creds := userCreds(defaultUserIdx)
+		app := userApplications(&userID).Applications[0]
+
+		ids := ttnpb.EndDeviceIdentifiers{
+			DeviceID:               "mute-windows-device",
+			ApplicationIdentifiers: app.ApplicationIdentifiers,
+		}
+		start := time.Now().Add(time.Hour).UTC().Truncate(time.Millisecond)
+
+		_, err := reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				MuteWindows: []*ttnpb.MuteWindow{
+					{StartAt: start, EndAt: start},
+				},
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		window := &ttnpb.MuteWindow{
+			StartAt:         start,
+			EndAt:           start.Add(time.Hour),
+			QueuedDownlinks: ttnpb.MuteWindow_DROP,
+			Description:     "Regulatory silence",
+		}
+		created, err := reg.Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				MuteWindows:          []*ttnpb.MuteWindow{window},
+			},
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.MuteWindows, should.Resemble, []*ttnpb.MuteWindow{window})
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateEndDeviceRequest{
+			FieldMask: pbtypes.FieldMask{Paths: []string{"mute_windows"}},
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ids,
+				MuteWindows: []*ttnpb.MuteWindow{
+					{StartAt: start, EndAt: start.Add(-time.Hour)},
+				},
+			},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		}
+
+		got, err := reg.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			FieldMask:            pbtypes.FieldMask{Paths: []string{"mute_windows"}},
+			EndDeviceIdentifiers: ids,
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.MuteWindows, should.Resemble, []*ttnpb.MuteWindow{window})
+		}
+
+		_, err = reg.Delete(ctx, &ids, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
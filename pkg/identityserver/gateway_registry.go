@@ -20,6 +20,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/jinzhu/gorm"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/blacklist"
 	"go.thethings.network/lorawan-stack/pkg/identityserver/store"
@@ -39,6 +40,14 @@ var (
 		"gateway.delete", "delete gateway",
 		ttnpb.RIGHT_GATEWAY_INFO,
 	)
+	evtSuspendGateway = events.Define(
+		"gateway.suspend", "suspend gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
+	evtResumeGateway = events.Define(
+		"gateway.resume", "resume gateway",
+		ttnpb.RIGHT_GATEWAY_INFO,
+	)
 )
 
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
@@ -57,6 +66,9 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 	if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 		return nil, err
 	}
+	if !is.IsAdmin(ctx) {
+		req.Gateway.Suspended = false
+	}
 	err = is.withDatabase(ctx, func(db *gorm.DB) (err error) {
 		gtw, err = store.GetGatewayStore(db).CreateGateway(ctx, &req.Gateway)
 		if err != nil {
@@ -200,6 +212,8 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 	return gtws, nil
 }
 
+var errUpdateGatewayAdminField = errors.DefinePermissionDenied("gateway_update_admin_field", "only admins can update the `{field}` field")
+
 func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	if err = rights.RequireGateway(ctx, req.GatewayIdentifiers, ttnpb.RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
 		return nil, err
@@ -208,6 +222,9 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	if len(req.FieldMask.Paths) == 0 {
 		req.FieldMask.Paths = updatePaths
 	}
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "suspended") && !is.IsAdmin(ctx) {
+		return nil, errUpdateGatewayAdminField.WithAttributes("field", "suspended")
+	}
 	if ttnpb.HasAnyField(req.FieldMask.Paths, "contact_info") {
 		if err := validateContactInfo(req.Gateway.ContactInfo); err != nil {
 			return nil, err
@@ -231,6 +248,13 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 		return nil, err
 	}
 	events.Publish(evtUpdateGateway(ctx, req.GatewayIdentifiers, req.FieldMask.Paths))
+	if ttnpb.HasAnyField(req.FieldMask.Paths, "suspended") {
+		if req.Suspended {
+			events.Publish(evtSuspendGateway(ctx, req.GatewayIdentifiers, nil))
+		} else {
+			events.Publish(evtResumeGateway(ctx, req.GatewayIdentifiers, nil))
+		}
+	}
 	return gtw, nil
 }
 
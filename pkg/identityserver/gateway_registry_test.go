@@ -221,6 +221,69 @@ func TestGatewaysCRUD(t *testing.T) {
 	})
 }
 
+func TestGatewaysSuspend(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	testWithIdentityServer(t, func(is *IdentityServer, cc *grpc.ClientConn) {
+		reg := ttnpb.NewGatewayRegistryClient(cc)
+
+		userID, creds := population.Users[defaultUserIdx].UserIdentifiers, userCreds(defaultUserIdx)
+		adminCreds := userCreds(adminUserIdx)
+
+		created, err := reg.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "suspended-gtw"},
+				Suspended:          true,
+			},
+			Collaborator: *userID.OrganizationOrUserIdentifiers(),
+		}, creds)
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.Suspended, should.BeFalse)
+		}
+
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				Suspended:          true,
+			},
+			FieldMask: ptypes.FieldMask{Paths: []string{"suspended"}},
+		}, creds)
+
+		if a.So(err, should.NotBeNil) {
+			a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		}
+
+		for _, suspended := range []bool{true, false} {
+			_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+				Gateway: ttnpb.Gateway{
+					GatewayIdentifiers: created.GatewayIdentifiers,
+					Suspended:          suspended,
+				},
+				FieldMask: ptypes.FieldMask{Paths: []string{"suspended"}},
+			}, adminCreds)
+
+			a.So(err, should.BeNil)
+
+			got, err := reg.Get(ctx, &ttnpb.GetGatewayRequest{
+				GatewayIdentifiers: created.GatewayIdentifiers,
+				FieldMask:          ptypes.FieldMask{Paths: []string{"suspended"}},
+			}, creds)
+
+			a.So(err, should.BeNil)
+			if a.So(got, should.NotBeNil) {
+				a.So(got.Suspended, should.Equal, suspended)
+			}
+		}
+
+		_, err = reg.Delete(ctx, &created.GatewayIdentifiers, creds)
+
+		a.So(err, should.BeNil)
+	})
+}
+
 func TestGatewaysPagination(t *testing.T) {
 	a := assertions.New(t)
 
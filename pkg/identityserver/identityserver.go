@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/oauth"
 	"go.thethings.network/lorawan-stack/pkg/redis"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -40,7 +41,8 @@ import (
 
 // Config for the Identity Server
 type Config struct {
-	DatabaseURI      string `name:"database-uri" description:"Database connection URI"`
+	DatabaseURI      string             `name:"database-uri" description:"Database connection URI"`
+	Idempotency      *idempotency.Store `name:"-"`
 	UserRegistration struct {
 		Invitation struct {
 			Required bool          `name:"required" description:"Require invitations for new users"`
@@ -183,6 +185,12 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.EntityAccess", cluster.HookName, c.ClusterAuthUnaryHook())
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.OAuthAuthorizationRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("identityserver"))
 
+	if is.config.Idempotency != nil {
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.ApplicationRegistry/Create", idempotency.HookName, is.config.Idempotency.UnaryHook())
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.EndDeviceRegistry/Create", idempotency.HookName, is.config.Idempotency.UnaryHook())
+		hooks.RegisterUnaryHook("/ttn.lorawan.v3.GatewayRegistry/Create", idempotency.HookName, is.config.Idempotency.UnaryHook())
+	}
+
 	c.RegisterGRPC(is)
 	c.RegisterWeb(is.oauth)
 
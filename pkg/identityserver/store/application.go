@@ -32,6 +32,13 @@ type Application struct {
 	APIKeys       []APIKey     `gorm:"polymorphic:Entity;polymorphic_value:application"`
 	Memberships   []Membership `gorm:"polymorphic:Entity;polymorphic_value:application"`
 	// END common fields
+
+	Suspended    bool `gorm:"not null"`
+	PacketLogger bool `gorm:"not null"`
+
+	TimeZone string `gorm:"type:VARCHAR"`
+
+	MuteWindows []MuteWindow `gorm:"polymorphic:Entity;polymorphic_value:application"`
 }
 
 func init() {
@@ -40,9 +47,13 @@ func init() {
 
 // functions to set fields from the application model into the application proto.
 var applicationPBSetters = map[string]func(*ttnpb.Application, *Application){
-	nameField:        func(pb *ttnpb.Application, app *Application) { pb.Name = app.Name },
-	descriptionField: func(pb *ttnpb.Application, app *Application) { pb.Description = app.Description },
-	attributesField:  func(pb *ttnpb.Application, app *Application) { pb.Attributes = attributes(app.Attributes).toMap() },
+	nameField:         func(pb *ttnpb.Application, app *Application) { pb.Name = app.Name },
+	descriptionField:  func(pb *ttnpb.Application, app *Application) { pb.Description = app.Description },
+	attributesField:   func(pb *ttnpb.Application, app *Application) { pb.Attributes = attributes(app.Attributes).toMap() },
+	suspendedField:    func(pb *ttnpb.Application, app *Application) { pb.Suspended = app.Suspended },
+	packetLoggerField: func(pb *ttnpb.Application, app *Application) { pb.PacketLogger = app.PacketLogger },
+	timeZoneField:     func(pb *ttnpb.Application, app *Application) { pb.TimeZone = app.TimeZone },
+	muteWindowsField:  func(pb *ttnpb.Application, app *Application) { pb.MuteWindows = muteWindows(app.MuteWindows).toPB() },
 }
 
 // functions to set fields from the application proto into the application model.
@@ -52,6 +63,12 @@ var applicationModelSetters = map[string]func(*Application, *ttnpb.Application){
 	attributesField: func(app *Application, pb *ttnpb.Application) {
 		app.Attributes = attributes(app.Attributes).updateFromMap(pb.Attributes)
 	},
+	suspendedField:    func(app *Application, pb *ttnpb.Application) { app.Suspended = pb.Suspended },
+	packetLoggerField: func(app *Application, pb *ttnpb.Application) { app.PacketLogger = pb.PacketLogger },
+	timeZoneField:     func(app *Application, pb *ttnpb.Application) { app.TimeZone = pb.TimeZone },
+	muteWindowsField: func(app *Application, pb *ttnpb.Application) {
+		app.MuteWindows = muteWindows(app.MuteWindows).updateFromPB(pb.MuteWindows)
+	},
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -69,10 +86,14 @@ func init() {
 
 // fieldmask path to column name in applications table.
 var applicationColumnNames = map[string][]string{
-	attributesField:  {},
-	contactInfoField: {},
-	nameField:        {nameField},
-	descriptionField: {descriptionField},
+	attributesField:   {},
+	contactInfoField:  {},
+	nameField:         {nameField},
+	descriptionField:  {descriptionField},
+	suspendedField:    {suspendedField},
+	packetLoggerField: {packetLoggerField},
+	timeZoneField:     {timeZoneField},
+	muteWindowsField:  {},
 }
 
 func (app Application) toPB(pb *ttnpb.Application, fieldMask *types.FieldMask) {
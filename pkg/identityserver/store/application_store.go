@@ -39,7 +39,7 @@ type applicationStore struct {
 // selectApplicationFields selects relevant fields (based on fieldMask) and preloads details if needed.
 func selectApplicationFields(ctx context.Context, query *gorm.DB, fieldMask *types.FieldMask) *gorm.DB {
 	if fieldMask == nil || len(fieldMask.Paths) == 0 {
-		return query.Preload("Attributes")
+		return query.Preload("Attributes").Preload("MuteWindows")
 	}
 	var applicationColumns []string
 	var notFoundPaths []string
@@ -49,6 +49,8 @@ func selectApplicationFields(ctx context.Context, query *gorm.DB, fieldMask *typ
 			// always selected
 		case attributesField:
 			query = query.Preload("Attributes")
+		case muteWindowsField:
+			query = query.Preload("MuteWindows")
 		default:
 			if columns, ok := applicationColumnNames[path]; ok {
 				applicationColumns = append(applicationColumns, columns...)
@@ -134,7 +136,7 @@ func (s *applicationStore) UpdateApplication(ctx context.Context, app *ttnpb.App
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
-	oldAttributes := appModel.Attributes
+	oldAttributes, oldMuteWindows := appModel.Attributes, appModel.MuteWindows
 	columns := appModel.fromPB(app, fieldMask)
 	if err = s.updateEntity(ctx, &appModel, columns...); err != nil {
 		return nil, err
@@ -144,6 +146,11 @@ func (s *applicationStore) UpdateApplication(ctx context.Context, app *ttnpb.App
 			return nil, err
 		}
 	}
+	if !reflect.DeepEqual(oldMuteWindows, appModel.MuteWindows) {
+		if err = s.replaceMuteWindows(ctx, "application", appModel.ID, oldMuteWindows, appModel.MuteWindows); err != nil {
+			return nil, err
+		}
+	}
 	updated = &ttnpb.Application{}
 	appModel.toPB(updated, fieldMask)
 	return updated, nil
@@ -32,7 +32,7 @@ func TestApplicationStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &Application{}, &Attribute{})
+		prepareTest(db, &Application{}, &Attribute{}, &MuteWindow{})
 		store := GetApplicationStore(db)
 
 		created, err := store.CreateApplication(ctx, &ttnpb.Application{
@@ -130,3 +130,66 @@ func TestApplicationStore(t *testing.T) {
 		a.So(list, should.BeEmpty)
 	})
 }
+
+func TestApplicationStoreMuteWindows(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	WithDB(t, func(t *testing.T, db *gorm.DB) {
+		prepareTest(db, &Application{}, &Attribute{}, &MuteWindow{})
+		store := GetApplicationStore(db)
+
+		start := time.Date(2020, time.August, 1, 12, 0, 0, 0, time.UTC)
+		first := &ttnpb.MuteWindow{
+			StartAt:     start,
+			EndAt:       start.Add(time.Hour),
+			Description: "Maintenance",
+		}
+		second := &ttnpb.MuteWindow{
+			StartAt:         start.Add(24 * time.Hour),
+			EndAt:           start.Add(25 * time.Hour),
+			QueuedDownlinks: ttnpb.MuteWindow_DROP,
+			Upstream:        ttnpb.MuteWindow_DROP,
+		}
+
+		created, err := store.CreateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+			MuteWindows:            []*ttnpb.MuteWindow{second, first},
+		})
+
+		a.So(err, should.BeNil)
+		if a.So(created, should.NotBeNil) {
+			a.So(created.MuteWindows, should.Resemble, []*ttnpb.MuteWindow{first, second})
+		}
+
+		updated, err := store.UpdateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+			MuteWindows:            []*ttnpb.MuteWindow{second},
+		}, &types.FieldMask{Paths: []string{"mute_windows"}})
+
+		a.So(err, should.BeNil)
+		if a.So(updated, should.NotBeNil) {
+			a.So(updated.MuteWindows, should.Resemble, []*ttnpb.MuteWindow{second})
+		}
+
+		got, err := store.GetApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, &types.FieldMask{Paths: []string{"mute_windows"}})
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.MuteWindows, should.Resemble, []*ttnpb.MuteWindow{second})
+		}
+
+		_, err = store.UpdateApplication(ctx, &ttnpb.Application{
+			ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo"},
+		}, &types.FieldMask{Paths: []string{"mute_windows"}})
+
+		a.So(err, should.BeNil)
+
+		got, err = store.GetApplication(ctx, &ttnpb.ApplicationIdentifiers{ApplicationID: "foo"}, &types.FieldMask{Paths: []string{"mute_windows"}})
+
+		a.So(err, should.BeNil)
+		if a.So(got, should.NotBeNil) {
+			a.So(got.MuteWindows, should.BeEmpty)
+		}
+	})
+}
@@ -47,7 +47,11 @@ type EndDevice struct {
 
 	ServiceProfileID string `gorm:"type:VARCHAR"`
 
+	TimeZone string `gorm:"type:VARCHAR"`
+
 	Locations []EndDeviceLocation
+
+	MuteWindows []MuteWindow `gorm:"polymorphic:Entity;polymorphic_value:device"`
 }
 
 func init() {
@@ -93,6 +97,8 @@ var devicePBSetters = map[string]func(*ttnpb.EndDevice, *EndDevice){
 	joinServerAddressField:        func(pb *ttnpb.EndDevice, dev *EndDevice) { pb.JoinServerAddress = dev.JoinServerAddress },
 	serviceProfileIDField:         func(pb *ttnpb.EndDevice, dev *EndDevice) { pb.ServiceProfileID = dev.ServiceProfileID },
 	locationsField:                func(pb *ttnpb.EndDevice, dev *EndDevice) { pb.Locations = deviceLocations(dev.Locations).toMap() },
+	timeZoneField:                 func(pb *ttnpb.EndDevice, dev *EndDevice) { pb.TimeZone = dev.TimeZone },
+	muteWindowsField:              func(pb *ttnpb.EndDevice, dev *EndDevice) { pb.MuteWindows = muteWindows(dev.MuteWindows).toPB() },
 }
 
 // functions to set fields from the device proto into the device model.
@@ -125,6 +131,10 @@ var deviceModelSetters = map[string]func(*EndDevice, *ttnpb.EndDevice){
 	locationsField: func(dev *EndDevice, pb *ttnpb.EndDevice) {
 		dev.Locations = deviceLocations(dev.Locations).updateFromMap(pb.Locations)
 	},
+	timeZoneField: func(dev *EndDevice, pb *ttnpb.EndDevice) { dev.TimeZone = pb.TimeZone },
+	muteWindowsField: func(dev *EndDevice, pb *ttnpb.EndDevice) {
+		dev.MuteWindows = muteWindows(dev.MuteWindows).updateFromPB(pb.MuteWindows)
+	},
 }
 
 // fieldMask to use if a nil or empty fieldmask is passed.
@@ -157,6 +167,8 @@ var deviceColumnNames = map[string][]string{
 	joinServerAddressField:        {joinServerAddressField},
 	serviceProfileIDField:         {serviceProfileIDField},
 	locationsField:                {},
+	timeZoneField:                 {timeZoneField},
+	muteWindowsField:              {},
 }
 
 func (dev EndDevice) toPB(pb *ttnpb.EndDevice, fieldMask *types.FieldMask) {
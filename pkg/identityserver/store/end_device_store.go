@@ -40,7 +40,7 @@ type deviceStore struct {
 // selectEndDeviceFields selects relevant fields (based on fieldMask) and preloads details if needed.
 func selectEndDeviceFields(ctx context.Context, query *gorm.DB, fieldMask *types.FieldMask) *gorm.DB {
 	if fieldMask == nil || len(fieldMask.Paths) == 0 {
-		return query.Preload("Attributes").Preload("Locations")
+		return query.Preload("Attributes").Preload("Locations").Preload("MuteWindows")
 	}
 	var deviceColumns []string
 	var notFoundPaths []string
@@ -52,6 +52,8 @@ func selectEndDeviceFields(ctx context.Context, query *gorm.DB, fieldMask *types
 			query = query.Preload("Attributes")
 		case locationsField:
 			query = query.Preload("Locations")
+		case muteWindowsField:
+			query = query.Preload("MuteWindows")
 		default:
 			if columns, ok := deviceColumnNames[path]; ok {
 				deviceColumns = append(deviceColumns, columns...)
@@ -168,7 +170,7 @@ func (s *deviceStore) UpdateEndDevice(ctx context.Context, dev *ttnpb.EndDevice,
 	if err := ctx.Err(); err != nil { // Early exit if context canceled
 		return nil, err
 	}
-	oldAttributes, oldLocations := devModel.Attributes, devModel.Locations
+	oldAttributes, oldLocations, oldMuteWindows := devModel.Attributes, devModel.Locations, devModel.MuteWindows
 	columns := devModel.fromPB(dev, fieldMask)
 	s.updateEntity(ctx, &devModel, columns...)
 	if !reflect.DeepEqual(oldAttributes, devModel.Attributes) {
@@ -181,6 +183,11 @@ func (s *deviceStore) UpdateEndDevice(ctx context.Context, dev *ttnpb.EndDevice,
 			return nil, err
 		}
 	}
+	if !reflect.DeepEqual(oldMuteWindows, devModel.MuteWindows) {
+		if err = s.replaceMuteWindows(ctx, "device", devModel.ID, oldMuteWindows, devModel.MuteWindows); err != nil {
+			return nil, err
+		}
+	}
 	updated = &ttnpb.EndDevice{}
 	devModel.toPB(updated, fieldMask)
 	return updated, nil
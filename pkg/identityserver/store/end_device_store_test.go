@@ -33,7 +33,7 @@ func TestEndDeviceStore(t *testing.T) {
 	ctx := test.Context()
 
 	WithDB(t, func(t *testing.T, db *gorm.DB) {
-		prepareTest(db, &EndDevice{}, &Attribute{}, &EndDeviceLocation{})
+		prepareTest(db, &EndDevice{}, &Attribute{}, &EndDeviceLocation{}, &MuteWindow{})
 		store := GetEndDeviceStore(db)
 
 		deviceID := ttnpb.EndDeviceIdentifiers{
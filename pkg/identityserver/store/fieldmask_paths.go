@@ -36,8 +36,10 @@ const (
 	locationPublicField                 = "location_public"
 	locationsField                      = "locations"
 	modelIDField                        = "version_ids.model_id"
+	muteWindowsField                    = "mute_windows"
 	nameField                           = "name"
 	networkServerAddressField           = "network_server_address"
+	packetLoggerField                   = "packet_logger"
 	passwordField                       = "password"
 	passwordUpdatedAtField              = "password_updated_at"
 	primaryEmailAddressField            = "primary_email_address"
@@ -52,9 +54,11 @@ const (
 	skipAuthorizationField              = "skip_authorization"
 	stateField                          = "state"
 	statusPublicField                   = "status_public"
+	suspendedField                      = "suspended"
 	temporaryPasswordCreatedAtField     = "temporary_password_created_at"
 	temporaryPasswordExpiresAtField     = "temporary_password_expires_at"
 	temporaryPasswordField              = "temporary_password"
+	timeZoneField                       = "time_zone"
 	updateChannelField                  = "update_channel"
 	versionIDsField                     = "version_ids"
 )
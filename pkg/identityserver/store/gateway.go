@@ -56,6 +56,8 @@ type Gateway struct {
 	EnforceDutyCycle       bool `gorm:"not null"`
 	DownlinkPathConstraint int
 
+	Suspended bool `gorm:"not null"`
+
 	Antennas []GatewayAntenna
 }
 
@@ -92,6 +94,7 @@ var gatewayPBSetters = map[string]func(*ttnpb.Gateway, *Gateway){
 	downlinkPathConstraintField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		pb.DownlinkPathConstraint = ttnpb.DownlinkPathConstraint(gtw.DownlinkPathConstraint)
 	},
+	suspendedField: func(pb *ttnpb.Gateway, gtw *Gateway) { pb.Suspended = gtw.Suspended },
 	antennasField: func(pb *ttnpb.Gateway, gtw *Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		pb.Antennas = make([]ttnpb.GatewayAntenna, len(gtw.Antennas))
@@ -128,6 +131,7 @@ var gatewayModelSetters = map[string]func(*Gateway, *ttnpb.Gateway){
 	scheduleDownlinkLateField:   func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.ScheduleDownlinkLate = pb.ScheduleDownlinkLate },
 	enforceDutyCycleField:       func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.EnforceDutyCycle = pb.EnforceDutyCycle },
 	downlinkPathConstraintField: func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.DownlinkPathConstraint = int(pb.DownlinkPathConstraint) },
+	suspendedField:              func(gtw *Gateway, pb *ttnpb.Gateway) { gtw.Suspended = pb.Suspended },
 	antennasField: func(gtw *Gateway, pb *ttnpb.Gateway) {
 		sort.Slice(gtw.Antennas, func(i int, j int) bool { return gtw.Antennas[i].Index < gtw.Antennas[j].Index })
 		antennas := make([]GatewayAntenna, len(pb.Antennas))
@@ -176,6 +180,7 @@ var gatewayColumnNames = map[string][]string{
 	scheduleDownlinkLateField:   {scheduleDownlinkLateField},
 	enforceDutyCycleField:       {enforceDutyCycleField},
 	downlinkPathConstraintField: {downlinkPathConstraintField},
+	suspendedField:              {suspendedField},
 	antennasField:               {},
 }
 
@@ -0,0 +1,89 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// MuteWindow model.
+type MuteWindow struct {
+	ID string `gorm:"type:UUID;primary_key;default:gen_random_uuid()"`
+
+	EntityID   string `gorm:"type:UUID;index:mute_window_entity_index;not null"`
+	EntityType string `gorm:"type:VARCHAR(32);index:mute_window_entity_index;not null"`
+
+	StartAt time.Time `gorm:"not null"`
+	EndAt   time.Time `gorm:"not null"`
+
+	QueuedDownlinks int `gorm:"not null"`
+	Upstream        int `gorm:"not null"`
+
+	Description string `gorm:"type:TEXT"`
+}
+
+func init() {
+	registerModel(&MuteWindow{})
+}
+
+func (w MuteWindow) toPB() *ttnpb.MuteWindow {
+	return &ttnpb.MuteWindow{
+		StartAt:         cleanTime(w.StartAt),
+		EndAt:           cleanTime(w.EndAt),
+		QueuedDownlinks: ttnpb.MuteWindow_Policy(w.QueuedDownlinks),
+		Upstream:        ttnpb.MuteWindow_Policy(w.Upstream),
+		Description:     w.Description,
+	}
+}
+
+func (w *MuteWindow) fromPB(pb *ttnpb.MuteWindow) {
+	w.StartAt = cleanTime(pb.StartAt)
+	w.EndAt = cleanTime(pb.EndAt)
+	w.QueuedDownlinks = int(pb.QueuedDownlinks)
+	w.Upstream = int(pb.Upstream)
+	w.Description = pb.Description
+}
+
+type muteWindows []MuteWindow
+
+// toPB returns the mute windows sorted by start time.
+func (a muteWindows) toPB() []*ttnpb.MuteWindow {
+	if len(a) == 0 {
+		return nil
+	}
+	pb := make([]*ttnpb.MuteWindow, len(a))
+	for i, w := range a {
+		pb[i] = w.toPB()
+	}
+	sort.SliceStable(pb, func(i, j int) bool { return pb[i].StartAt.Before(pb[j].StartAt) })
+	return pb
+}
+
+// updateFromPB returns the updated mute windows. Mute windows have no identity, so existing rows are reused in order.
+func (a muteWindows) updateFromPB(pb []*ttnpb.MuteWindow) muteWindows {
+	var updated []MuteWindow
+	for i, w := range pb {
+		var model MuteWindow
+		if i < len(a) {
+			model = a[i]
+		}
+		model.fromPB(w)
+		updated = append(updated, model)
+	}
+	return updated
+}
@@ -0,0 +1,54 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/jinzhu/gorm"
+)
+
+func (s *store) replaceMuteWindows(ctx context.Context, entityType, entityUUID string, old []MuteWindow, new []MuteWindow) error {
+	return replaceMuteWindows(ctx, s.DB, entityType, entityUUID, old, new)
+}
+
+func replaceMuteWindows(ctx context.Context, db *gorm.DB, entityType, entityUUID string, old []MuteWindow, new []MuteWindow) (err error) {
+	defer trace.StartRegion(ctx, "update mute windows").End()
+	newByUUID := make(map[string]MuteWindow, len(new))
+	for _, w := range new {
+		if w.ID != "" {
+			newByUUID[w.ID] = w
+		}
+	}
+	for _, w := range new {
+		w.EntityType, w.EntityID = entityType, entityUUID
+		if err = db.Save(&w).Error; err != nil {
+			return err
+		}
+	}
+	var toDelete []string
+	for _, w := range old {
+		if _, ok := newByUUID[w.ID]; !ok {
+			toDelete = append(toDelete, w.ID)
+		}
+	}
+	if len(toDelete) > 0 {
+		if err = db.Where("id in (?)", toDelete).Delete(&MuteWindow{}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
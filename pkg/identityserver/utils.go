@@ -17,7 +17,9 @@ package identityserver
 import (
 	"context"
 	"strconv"
+	"time"
 
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/validate"
 	"google.golang.org/grpc"
@@ -68,6 +70,44 @@ func validateContactInfo(info []*ttnpb.ContactInfo) error {
 	return nil
 }
 
+var errTimeZone = errors.DefineInvalidArgument("time_zone", "invalid time zone `{time_zone}`")
+
+// validateTimeZone validates that the time zone is empty or a known IANA time zone name.
+func validateTimeZone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	if tz == "Local" {
+		return errTimeZone.WithAttributes("time_zone", tz)
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return errTimeZone.WithAttributes("time_zone", tz).WithCause(err)
+	}
+	return nil
+}
+
+var errMuteWindow = errors.DefineInvalidArgument("mute_window", "mute window `{index}` does not end after it starts")
+
+// validateMuteWindows validates that the mute windows end after they start.
+func validateMuteWindows(windows []*ttnpb.MuteWindow) error {
+	for i, w := range windows {
+		if !w.EndAt.After(w.StartAt) {
+			return errMuteWindow.WithAttributes("index", i)
+		}
+	}
+	return nil
+}
+
 func setTotalHeader(ctx context.Context, total uint64) {
 	grpc.SetHeader(ctx, metadata.Pairs("x-total-count", strconv.FormatUint(total, 10)))
 }
+
+var errBulkOperation = errors.Define("bulk_operation", "operation failed")
+
+// bulkErrorDetails returns the details of the error of a single operation in a bulk request.
+func bulkErrorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errBulkOperation.WithCause(err))
+}
@@ -0,0 +1,152 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"context"
+	"crypto/tls"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	errJoinAcceptHookPrefix  = errors.DefineInvalidArgument("join_accept_hook_prefix", "invalid JoinEUI prefix `{prefix}` of join-accept hook")
+	errJoinAcceptHookConnect = errors.DefineUnavailable("join_accept_hook_connect", "connect to join-accept hook `{address}`")
+)
+
+const defaultJoinAcceptHookTimeout = time.Second
+
+type joinAcceptHook struct {
+	prefix  types.EUI64Prefix
+	address string
+	client  ttnpb.JsJoinAcceptHookClient
+}
+
+// joinAcceptHooks adjusts the join-accept parameters of join-requests by external services.
+type joinAcceptHooks struct {
+	// hooks are sorted by descending prefix length, so that the most specific prefix matches first.
+	hooks   []joinAcceptHook
+	timeout time.Duration
+}
+
+// parseJoinAcceptHookPrefixes parses the JoinEUI prefixes of the addresses and returns the hooks sorted by descending
+// prefix length. The clients of the returned hooks are not set.
+func parseJoinAcceptHookPrefixes(addresses map[string]string) ([]joinAcceptHook, error) {
+	hooks := make([]joinAcceptHook, 0, len(addresses))
+	for s, address := range addresses {
+		var prefix types.EUI64Prefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil || prefix.Length > 64 {
+			return nil, errJoinAcceptHookPrefix.WithCause(err).WithAttributes("prefix", s)
+		}
+		hooks = append(hooks, joinAcceptHook{
+			prefix:  prefix,
+			address: address,
+		})
+	}
+	sort.Slice(hooks, func(i, j int) bool {
+		if hooks[i].prefix.Length != hooks[j].prefix.Length {
+			return hooks[i].prefix.Length > hooks[j].prefix.Length
+		}
+		return hooks[i].prefix.String() < hooks[j].prefix.String()
+	})
+	return hooks, nil
+}
+
+func newJoinAcceptHooks(ctx context.Context, conf JoinAcceptHookConfig, tlsConfig *tls.Config) (*joinAcceptHooks, error) {
+	hooks, err := parseJoinAcceptHookPrefixes(conf.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	opts := rpcclient.DefaultDialOptions(ctx)
+	if conf.TLS {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conns := make(map[string]*grpc.ClientConn)
+	for i, hook := range hooks {
+		conn, ok := conns[hook.address]
+		if !ok {
+			if conn, err = grpc.DialContext(ctx, hook.address, opts...); err != nil {
+				return nil, errJoinAcceptHookConnect.WithCause(err).WithAttributes("address", hook.address)
+			}
+			conns[hook.address] = conn
+		}
+		hooks[i].client = ttnpb.NewJsJoinAcceptHookClient(conn)
+	}
+	go func() {
+		<-ctx.Done()
+		for _, conn := range conns {
+			conn.Close()
+		}
+	}()
+	h := &joinAcceptHooks{
+		hooks:   hooks,
+		timeout: conf.Timeout,
+	}
+	if h.timeout == 0 {
+		h.timeout = defaultJoinAcceptHookTimeout
+	}
+	return h, nil
+}
+
+// match returns the hook with the most specific prefix that matches the JoinEUI, if any.
+func (h *joinAcceptHooks) match(joinEUI types.EUI64) (joinAcceptHook, bool) {
+	for _, hook := range h.hooks {
+		if hook.prefix.Matches(joinEUI) {
+			return hook, true
+		}
+	}
+	return joinAcceptHook{}, false
+}
+
+// Adjust adjusts the downlink settings, Rx delay and CFList of the join-request by the hook that matches the JoinEUI.
+// If the hook fails or returns invalid parameters, the join-request is not adjusted, so that hooks cannot fail joins.
+func (h *joinAcceptHooks) Adjust(ctx context.Context, joinEUI types.EUI64, req *ttnpb.JoinRequest) {
+	if h == nil {
+		return
+	}
+	hook, ok := h.match(joinEUI)
+	if !ok {
+		return
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"hook_address", hook.address,
+		"hook_prefix", hook.prefix,
+	))
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := hook.client.AdjustJoinAccept(ctx, req)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to adjust join-accept parameters")
+		return
+	}
+	if err := res.ValidateFields("downlink_settings", "rx_delay", "cf_list"); err != nil {
+		logger.WithError(err).Warn("Join-accept hook returned invalid join-accept parameters")
+		return
+	}
+	req.DownlinkSettings = res.DownlinkSettings
+	req.RxDelay = res.RxDelay
+	req.CFList = res.CFList
+	logger.Debug("Adjusted join-accept parameters")
+}
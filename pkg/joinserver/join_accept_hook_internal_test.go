@@ -0,0 +1,72 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestJoinAcceptHooksMatch(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := parseJoinAcceptHookPrefixes(map[string]string{"70B3D57ED0000000": "hook.example.com:1884"})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	hooks, err := parseJoinAcceptHookPrefixes(map[string]string{
+		"70B3D57ED0000000/36": "broad.example.com:1884",
+		"70B3D57ED0000000/48": "specific.example.com:1884",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(hooks, should.HaveLength, 2)
+	h := &joinAcceptHooks{hooks: hooks}
+
+	for _, tc := range []struct {
+		Name    string
+		JoinEUI types.EUI64
+		Address string
+		Match   bool
+	}{
+		{
+			Name:    "Specific",
+			JoinEUI: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01},
+			Address: "specific.example.com:1884",
+			Match:   true,
+		},
+		{
+			Name:    "Broad",
+			JoinEUI: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x01, 0x00, 0x01},
+			Address: "broad.example.com:1884",
+			Match:   true,
+		},
+		{
+			Name:    "NoMatch",
+			JoinEUI: types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xe0, 0x00, 0x00, 0x01},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			hook, ok := h.match(tc.JoinEUI)
+			a.So(ok, should.Equal, tc.Match)
+			a.So(hook.address, should.Equal, tc.Address)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package joinserver
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"crypto/x509/pkix"
 	"encoding/binary"
 	"io"
@@ -51,10 +52,22 @@ import (
 
 // Config represents the JoinServer configuration.
 type Config struct {
-	Devices         DeviceRegistry      `name:"-"`
-	Keys            KeyRegistry         `name:"-"`
-	JoinEUIPrefixes []types.EUI64Prefix `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
-	DeviceKEKLabel  string              `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	Devices         DeviceRegistry       `name:"-"`
+	Keys            KeyRegistry          `name:"-"`
+	JoinEUIPrefixes []types.EUI64Prefix  `name:"join-eui-prefix" description:"JoinEUI prefixes handled by this JS"`
+	DeviceKEKLabel  string               `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	JoinAcceptHooks JoinAcceptHookConfig `name:"join-accept-hooks" description:"External services that adjust join-accept parameters"`
+}
+
+// JoinAcceptHookConfig defines the external services that adjust the join-accept parameters of join-requests by
+// JoinEUI prefix. The most specific prefix that matches the JoinEUI of a join-request is used.
+//
+// The Network Server is not informed of the adjusted parameters, so hooks should only be used in deployments where
+// the Network Server is configured accordingly, for example research deployments.
+type JoinAcceptHookConfig struct {
+	Addresses map[string]string `name:"addresses" description:"Addresses of the join-accept hooks by JoinEUI prefix (for example 70B3D57ED0000000/36=hook.example.com:1884)"`
+	TLS       bool              `name:"tls" description:"Use TLS to connect to the join-accept hooks"`
+	Timeout   time.Duration     `name:"timeout" description:"Timeout of adjusting join-accept parameters"`
 }
 
 // JoinServer implements the Join Server component.
@@ -67,7 +80,8 @@ type JoinServer struct {
 	devices DeviceRegistry
 	keys    KeyRegistry
 
-	euiPrefixes []types.EUI64Prefix
+	euiPrefixes     []types.EUI64Prefix
+	joinAcceptHooks *joinAcceptHooks
 
 	entropyMu *sync.Mutex
 	entropy   io.Reader
@@ -101,6 +115,21 @@ func New(c *component.Component, conf *Config) (*JoinServer, error) {
 		entropy:   ulid.Monotonic(rand.New(rand.NewSource(time.Now().UnixNano())), 0),
 	}
 
+	if len(conf.JoinAcceptHooks.Addresses) > 0 {
+		var (
+			tlsConfig *tls.Config
+			err       error
+		)
+		if conf.JoinAcceptHooks.TLS {
+			if tlsConfig, err = c.GetTLSClientConfig(js.ctx); err != nil {
+				return nil, err
+			}
+		}
+		if js.joinAcceptHooks, err = newJoinAcceptHooks(js.ctx, conf.JoinAcceptHooks, tlsConfig); err != nil {
+			return nil, err
+		}
+	}
+
 	js.grpc.jsDevices = jsEndDeviceRegistryServer{
 		JS:       js,
 		kekLabel: conf.DeviceKEKLabel,
@@ -256,6 +285,7 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest) (r
 	if !match {
 		return nil, errUnknownJoinEUI
 	}
+	js.joinAcceptHooks.Adjust(ctx, pld.JoinEUI, req)
 
 	var handled bool
 	dev, err := js.devices.SetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
@@ -0,0 +1,121 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NamespaceLevels is a Middleware that filters entries based on the minimum level of their namespace.
+// The namespace of an entry is taken from its "namespace" field. A namespace level also applies to
+// sub-namespaces, so the level of "gatewayserver" applies to "gatewayserver/io/udp" unless that has its own level.
+// The levels can be changed at runtime.
+type NamespaceLevels struct {
+	mu     sync.RWMutex
+	def    Level
+	levels map[string]Level
+}
+
+// NewNamespaceLevels returns a new NamespaceLevels with the given default level and levels per namespace.
+func NewNamespaceLevels(def Level, levels map[string]Level) *NamespaceLevels {
+	n := &NamespaceLevels{
+		def:    def,
+		levels: make(map[string]Level, len(levels)),
+	}
+	for namespace, level := range levels {
+		n.levels[namespace] = level
+	}
+	return n
+}
+
+// Level returns the minimum level for the given namespace.
+func (n *NamespaceLevels) Level(namespace string) Level {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	for namespace != "" {
+		if level, ok := n.levels[namespace]; ok {
+			return level
+		}
+		i := strings.LastIndex(namespace, "/")
+		if i < 0 {
+			break
+		}
+		namespace = namespace[:i]
+	}
+	return n.def
+}
+
+// SetLevel sets the minimum level for the given namespace. If the namespace is empty, the default level is set.
+// If the level is invalid, the level of the namespace is removed, so the namespace falls back to the default level.
+func (n *NamespaceLevels) SetLevel(namespace string, level Level) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	switch {
+	case namespace == "" && level != invalid:
+		n.def = level
+	case level == invalid:
+		delete(n.levels, namespace)
+	default:
+		n.levels[namespace] = level
+	}
+}
+
+// NamespaceLevel is the minimum level of a namespace.
+type NamespaceLevel struct {
+	Namespace string `json:"namespace"`
+	Level     Level  `json:"level"`
+}
+
+// Levels returns the default level and the levels per namespace, sorted by namespace.
+func (n *NamespaceLevels) Levels() (Level, []NamespaceLevel) {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	levels := make([]NamespaceLevel, 0, len(n.levels))
+	for namespace, level := range n.levels {
+		levels = append(levels, NamespaceLevel{Namespace: namespace, Level: level})
+	}
+	sort.Slice(levels, func(i, j int) bool { return levels[i].Namespace < levels[j].Namespace })
+	return n.def, levels
+}
+
+// Wrap implements Middleware.
+func (n *NamespaceLevels) Wrap(next Handler) Handler {
+	return HandlerFunc(func(e Entry) error {
+		var namespace string
+		if v, ok := e.Fields().Fields()["namespace"]; ok {
+			namespace, _ = v.(string)
+		}
+		if e.Level() < n.Level(namespace) {
+			return nil
+		}
+		return next.HandleLog(e)
+	})
+}
+
+// LevelHandler is a Handler that only passes entries with at least the given level to the next handler.
+type LevelHandler struct {
+	Level Level
+	Next  Handler
+}
+
+// HandleLog implements Handler.
+func (h *LevelHandler) HandleLog(e Entry) error {
+	if e.Level() < h.Level {
+		return nil
+	}
+	return h.Next.HandleLog(e)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+)
+
+func TestNamespaceLevels(t *testing.T) {
+	a := assertions.New(t)
+
+	rec := newRecorder()
+	levels := NewNamespaceLevels(InfoLevel, map[string]Level{
+		"gatewayserver":        WarnLevel,
+		"gatewayserver/io/udp": DebugLevel,
+	})
+	logger, err := NewLogger(WithHandler(rec), WithNamespaceLevels(levels))
+	a.So(err, should.BeNil)
+	a.So(logger.NamespaceLevels(), should.Equal, levels)
+
+	logger.Debug("Default debug")
+	logger.Info("Default info")
+	logger.WithField("namespace", "gatewayserver").Info("GS info")
+	logger.WithField("namespace", "gatewayserver/io/mqtt").Info("GS MQTT info")
+	logger.WithField("namespace", "gatewayserver/io/udp").Debug("GS UDP debug")
+	logger.WithField("namespace", "networkserver").Debug("NS debug")
+
+	var messages []string
+	for _, e := range rec.entries {
+		messages = append(messages, e.Message())
+	}
+	a.So(messages, should.Resemble, []string{"Default info", "GS UDP debug"})
+
+	levels.SetLevel("networkserver", DebugLevel)
+	levels.SetLevel("gatewayserver/io/udp", invalid)
+	levels.SetLevel("", WarnLevel)
+	a.So(levels.Level("networkserver/io"), should.Equal, DebugLevel)
+	a.So(levels.Level("gatewayserver/io/udp"), should.Equal, WarnLevel)
+	a.So(levels.Level("joinserver"), should.Equal, WarnLevel)
+
+	def, namespaces := levels.Levels()
+	a.So(def, should.Equal, WarnLevel)
+	a.So(namespaces, should.Resemble, []NamespaceLevel{
+		{Namespace: "gatewayserver", Level: WarnLevel},
+		{Namespace: "networkserver", Level: DebugLevel},
+	})
+}
+
+func TestLevelHandler(t *testing.T) {
+	a := assertions.New(t)
+
+	rec := newRecorder()
+	logger := &Logger{
+		Level: DebugLevel,
+		Handler: &LevelHandler{
+			Level: WarnLevel,
+			Next:  rec,
+		},
+	}
+	logger.Info("Info")
+	logger.Error("Error")
+	if a.So(rec.entries, should.HaveLength, 1) {
+		a.So(rec.entries[0].Message(), should.Equal, "Error")
+	}
+}
+
+func TestJSONHandler(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	logger := &Logger{
+		Level:   DebugLevel,
+		Handler: NewJSON(&buf),
+	}
+	logger.WithFields(Fields(
+		"namespace", "networkserver",
+		"count", 42,
+	)).WithError(errors.New("failed")).Warn("Something happened")
+
+	var obj map[string]interface{}
+	err := json.Unmarshal(buf.Bytes(), &obj)
+	a.So(err, should.BeNil)
+	a.So(obj["msg"], should.Equal, "Something happened")
+	a.So(obj["level"], should.Equal, "warn")
+	a.So(obj["namespace"], should.Equal, "networkserver")
+	a.So(obj["count"], should.Equal, float64(42))
+	a.So(obj["error"], should.Equal, "failed")
+	a.So(obj["time"], should.NotBeEmpty)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// JSONHandler implements Handler by writing entries as JSON objects, one per line.
+type JSONHandler struct {
+	mu     sync.Mutex
+	Writer io.Writer
+}
+
+// NewJSON returns a new JSONHandler.
+func NewJSON(w io.Writer) *JSONHandler {
+	return &JSONHandler{
+		Writer: w,
+	}
+}
+
+// jsonValue returns a value that can be marshaled to JSON.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	case json.Marshaler:
+		return v
+	}
+	if _, err := json.Marshal(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return v
+}
+
+// marshalJSONEntry marshals the entry to JSON.
+func marshalJSONEntry(e Entry) ([]byte, error) {
+	fields := e.Fields().Fields()
+	obj := make(map[string]interface{}, len(fields)+3)
+	for k, v := range fields {
+		obj[k] = jsonValue(v)
+	}
+	obj["level"] = e.Level().String()
+	obj["msg"] = e.Message()
+	obj["time"] = e.Timestamp().UTC().Format(time.RFC3339Nano)
+	return json.Marshal(obj)
+}
+
+// HandleLog implements Handler.
+func (h *JSONHandler) HandleLog(e Entry) error {
+	b, err := marshalJSONEntry(e)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	_, err = h.Writer.Write(append(b, '\n'))
+	return err
+}
@@ -40,11 +40,17 @@ func NewLogger(opts ...Option) (*Logger, error) {
 
 // Logger implements Stack.
 type Logger struct {
-	mutex      sync.RWMutex
-	Level      Level
-	Handler    Handler
-	middleware []Middleware
-	stack      Handler
+	mutex           sync.RWMutex
+	Level           Level
+	Handler         Handler
+	middleware      []Middleware
+	stack           Handler
+	namespaceLevels *NamespaceLevels
+}
+
+// NamespaceLevels returns the levels per namespace of the logger, if any.
+func (l *Logger) NamespaceLevels() *NamespaceLevels {
+	return l.namespaceLevels
 }
 
 // Use installs the handler middleware.
@@ -40,3 +40,15 @@ func WithLevel(level Level) Option {
 		return nil
 	}
 }
+
+// WithNamespaceLevels filters entries based on the minimum level of their namespace.
+// This sets the level of the logger to debug, so that the namespace levels decide which entries are logged.
+// Any WithLevel option should therefore come before this option.
+func WithNamespaceLevels(levels *NamespaceLevels) Option {
+	return func(logger *Logger) error {
+		logger.Level = DebugLevel
+		logger.namespaceLevels = levels
+		logger.Use(levels)
+		return nil
+	}
+}
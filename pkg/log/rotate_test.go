@@ -0,0 +1,58 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+)
+
+func TestRotatingFile(t *testing.T) {
+	a := assertions.New(t)
+
+	dir, err := ioutil.TempDir("", "log")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "stack.log")
+
+	f, err := OpenRotatingFile(path, 10, 2)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := f.Write([]byte(line))
+		a.So(err, should.BeNil)
+	}
+	a.So(f.Close(), should.BeNil)
+
+	for name, expected := range map[string]string{
+		"stack.log":   "fourth\n",
+		"stack.log.1": "third\n",
+		"stack.log.2": "second\n",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, name))
+		a.So(err, should.BeNil)
+		a.So(string(b), should.Equal, expected)
+	}
+	_, err = os.Stat(filepath.Join(dir, "stack.log.3"))
+	a.So(os.IsNotExist(err), should.BeTrue)
+}
@@ -0,0 +1,164 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+)
+
+// syslogSeverities maps the levels to syslog severities.
+var syslogSeverities = [...]int{
+	DebugLevel: 7,
+	InfoLevel:  6,
+	WarnLevel:  4,
+	ErrorLevel: 3,
+	FatalLevel: 2,
+}
+
+// syslogFacility is the syslog facility of the messages (local0).
+const syslogFacility = 16
+
+const (
+	syslogDialTimeout  = 5 * time.Second
+	syslogWriteTimeout = time.Second
+)
+
+// syslogBackoff is the backoff between attempts to connect to the syslog server.
+var syslogBackoff = []time.Duration{100 * time.Millisecond, 1 * time.Second, 10 * time.Second}
+
+// SyslogHandler implements Handler by sending entries as RFC 5424 syslog messages with a JSON message body
+// to a remote syslog server. The connection is established in the background and re-established with backoff
+// after write failures. Entries are dropped while there is no connection, so that logging never waits for the
+// syslog server.
+type SyslogHandler struct {
+	network  string
+	address  string
+	tag      string
+	hostname string
+	closed   chan struct{}
+
+	mu         sync.Mutex
+	conn       net.Conn
+	connecting bool
+	closing    bool
+}
+
+// NewSyslog returns a new SyslogHandler that sends messages over the given network (tcp or udp) to the address.
+func NewSyslog(network, address, tag string) *SyslogHandler {
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+	if tag == "" {
+		tag = "-"
+	}
+	h := &SyslogHandler{
+		network:    network,
+		address:    address,
+		tag:        tag,
+		hostname:   hostname,
+		closed:     make(chan struct{}),
+		connecting: true,
+	}
+	go h.connect()
+	return h
+}
+
+// connect connects to the syslog server with backoff until it succeeds or the handler is closed.
+// The caller must have set h.connecting.
+func (h *SyslogHandler) connect() {
+	for bi := 0; ; bi++ {
+		conn, err := net.DialTimeout(h.network, h.address, syslogDialTimeout)
+		if err == nil {
+			h.mu.Lock()
+			h.connecting = false
+			if h.closing {
+				h.mu.Unlock()
+				conn.Close()
+				return
+			}
+			h.conn = conn
+			h.mu.Unlock()
+			return
+		}
+		if bi >= len(syslogBackoff) {
+			bi = len(syslogBackoff) - 1
+		}
+		select {
+		case <-h.closed:
+			h.mu.Lock()
+			h.connecting = false
+			h.mu.Unlock()
+			return
+		case <-time.After(syslogBackoff[bi]):
+		}
+	}
+}
+
+// HandleLog implements Handler.
+func (h *SyslogHandler) HandleLog(e Entry) error {
+	body, err := marshalJSONEntry(e)
+	if err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("<%d>1 %s %s %s %d - - %s\n",
+		syslogFacility*8+syslogSeverities[e.Level()],
+		e.Timestamp().UTC().Format(time.RFC3339Nano),
+		h.hostname, h.tag, os.Getpid(), body,
+	)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closing {
+		return nil
+	}
+	if h.conn == nil {
+		if !h.connecting {
+			h.connecting = true
+			go h.connect()
+		}
+		return nil
+	}
+	h.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if _, err := h.conn.Write([]byte(msg)); err != nil {
+		h.conn.Close()
+		h.conn = nil
+		h.connecting = true
+		go h.connect()
+		return err
+	}
+	return nil
+}
+
+// Close closes the connection to the syslog server.
+func (h *SyslogHandler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closing {
+		return nil
+	}
+	h.closing = true
+	close(h.closed)
+	if h.conn == nil {
+		return nil
+	}
+	err := h.conn.Close()
+	h.conn = nil
+	return err
+}
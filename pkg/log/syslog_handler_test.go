@@ -0,0 +1,88 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"github.com/smartystreets/assertions/should"
+)
+
+func TestSyslogHandler(t *testing.T) {
+	a := assertions.New(t)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	address := lis.Addr().String()
+	lis.Close()
+
+	h := NewSyslog("tcp", address, "test")
+	defer h.Close()
+
+	e := &entry{
+		message: "Foo",
+		level:   InfoLevel,
+		time:    time.Now(),
+		fields:  Fields("a", 10),
+	}
+
+	// Without syslog server, the entries are dropped without waiting for a connection.
+	start := time.Now()
+	a.So(h.HandleLog(e), should.BeNil)
+	a.So(time.Since(start), should.BeLessThan, 100*time.Millisecond)
+
+	lis, err = net.Listen("tcp", address)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer lis.Close()
+	lines := make(chan string, 16)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s := bufio.NewScanner(conn)
+		for s.Scan() {
+			lines <- s.Text()
+		}
+	}()
+
+	timeout := time.After(5 * time.Second)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		a.So(h.HandleLog(e), should.BeNil)
+		select {
+		case line := <-lines:
+			a.So(line, should.StartWith, "<134>1 ")
+			a.So(line, should.ContainSubstring, " test ")
+			a.So(strings.HasSuffix(line, "}"), should.BeTrue)
+			a.So(line, should.ContainSubstring, `"msg":"Foo"`)
+			return
+		case <-ticker.C:
+		case <-timeout:
+			t.Fatal("Expected syslog message but nothing received")
+		}
+	}
+}
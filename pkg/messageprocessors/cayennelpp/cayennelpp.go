@@ -16,9 +16,9 @@
 package cayennelpp
 
 import (
-	"bytes"
 	"context"
 	"runtime/trace"
+	"sort"
 
 	lpp "github.com/TheThingsNetwork/go-cayenne-lib/cayennelpp"
 	"go.thethings.network/lorawan-stack/pkg/errors"
@@ -43,6 +43,7 @@ var (
 )
 
 // Encode encodes the message's DecodedPayload to FRMPayload using CayenneLPP encoding.
+// Values of the extended CayenneLPP types are encoded with their type, like in uplink messages.
 func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
 	defer trace.StartRegion(ctx, "encode message").End()
 
@@ -54,8 +55,14 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	if err != nil {
 		return errInput.WithCause(err)
 	}
-	encoder := lpp.NewEncoder()
-	for name, value := range m {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var frmPayload []byte
+	for _, name := range names {
+		value := m[name]
 		key, channel, err := parseName(name)
 		if err != nil {
 			continue
@@ -63,21 +70,31 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 		switch key {
 		case valueKey:
 			if val, ok := value.(float64); ok {
+				encoder := lpp.NewEncoder()
 				encoder.AddPort(channel, float32(val))
+				frmPayload = append(frmPayload, encoder.Bytes()...)
+			}
+		default:
+			t, ok := extendedTypesByKey[key]
+			if !ok {
+				continue
+			}
+			if b, ok := t.encode(value); ok {
+				frmPayload = append(append(frmPayload, channel, t.typ), b...)
 			}
 		}
 	}
-	msg.FRMPayload = encoder.Bytes()
+	msg.FRMPayload = frmPayload
 	return nil
 }
 
 // Decode decodes the message's FRMPayload to DecodedPayload using CayenneLPP decoding.
+// The original and the extended CayenneLPP types are decoded.
 func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, script string) error {
 	defer trace.StartRegion(ctx, "decode message").End()
 
-	decoder := lpp.NewDecoder(bytes.NewBuffer(msg.FRMPayload))
 	m := decodedMap(make(map[string]interface{}))
-	if err := decoder.DecodeUplink(m); err != nil {
+	if err := decodeUplink(msg.FRMPayload, m); err != nil {
 		return errOutput.WithCause(err)
 	}
 	s, err := gogoproto.Struct(m)
@@ -172,3 +172,101 @@ func TestDecode(t *testing.T) {
 	a.So(m["gps_12"].(map[string]interface{})["longitude"], should.AlmostEqual, 4.8885, 0.00001)
 	a.So(m["gps_12"].(map[string]interface{})["altitude"], should.AlmostEqual, 21.54, 0.00001)
 }
+
+func TestExtendedTypes(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	decoded := map[string]interface{}{
+		"generic_sensor_1": 4294967295.0,
+		"energy_2":         1.234,
+		"gps_precise_3": map[string]interface{}{
+			"latitude":  52.365512,
+			"longitude": -4.888512,
+			"altitude":  -21.54,
+		},
+		"power_4":     1500.0,
+		"unix_time_5": 1600000000.0,
+	}
+	frmPayload := []byte{
+		2, energy, 0, 0, 4, 210,
+		1, genericSensor, 255, 255, 255, 255,
+		3, preciseGPS, 3, 31, 8, 200, 255, 181, 104, 64, 255, 247, 150,
+		4, power, 5, 220,
+		5, unixTime, 95, 94, 16, 0,
+	}
+
+	// Encode the extended types in order of the names.
+	s, err := gogoproto.Struct(decoded)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	down := &ttnpb.ApplicationDownlink{DecodedPayload: s}
+	err = host.Encode(ctx, ids, nil, down, "")
+	a.So(err, should.BeNil)
+	a.So(down.FRMPayload, should.Resemble, frmPayload)
+
+	// Decode the encoded payload back to the same values.
+	up := &ttnpb.ApplicationUplink{FRMPayload: down.FRMPayload}
+	err = host.Decode(ctx, ids, nil, up, "")
+	a.So(err, should.BeNil)
+	m, err := gogoproto.Map(up.DecodedPayload)
+	a.So(err, should.BeNil)
+	a.So(m, should.Resemble, decoded)
+
+	// Decode extended types mixed with original types.
+	up = &ttnpb.ApplicationUplink{
+		FRMPayload: []byte{
+			1, lpp.DigitalInput, 255,
+			2, unixTime, 95, 94, 16, 0,
+			3, lpp.Temperature, 255, 100,
+		},
+	}
+	err = host.Decode(ctx, ids, nil, up, "")
+	a.So(err, should.BeNil)
+	m, err = gogoproto.Map(up.DecodedPayload)
+	a.So(err, should.BeNil)
+	a.So(m, should.HaveLength, 3)
+	a.So(m["digital_in_1"], should.Equal, 255)
+	a.So(m["unix_time_2"], should.Equal, 1600000000)
+	a.So(m["temperature_3"], should.AlmostEqual, -15.6, 0.00001)
+
+	// Values that are out of range are not encoded.
+	s, err = gogoproto.Struct(map[string]interface{}{
+		"power_1":          -1.0,
+		"power_2":          65536.0,
+		"generic_sensor_3": "42",
+		"gps_precise_4": map[string]interface{}{
+			"latitude":  91.0,
+			"longitude": 0.0,
+			"altitude":  0.0,
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	down = &ttnpb.ApplicationDownlink{DecodedPayload: s}
+	err = host.Encode(ctx, ids, nil, down, "")
+	a.So(err, should.BeNil)
+	a.So(down.FRMPayload, should.BeEmpty)
+
+	// Truncated and unknown types are invalid.
+	for _, frmPayload := range [][]byte{
+		{1, power, 5},
+		{1, unixTime, 95, 94, 16},
+		{1, 255, 0},
+		{1},
+	} {
+		err = host.Decode(ctx, ids, nil, &ttnpb.ApplicationUplink{FRMPayload: frmPayload}, "")
+		a.So(err, should.NotBeNil)
+	}
+}
@@ -0,0 +1,242 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cayennelpp
+
+import (
+	"bytes"
+	"math"
+
+	lpp "github.com/TheThingsNetwork/go-cayenne-lib/cayennelpp"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+)
+
+// Extended CayenneLPP types. These types are not part of the original CayenneLPP specification, but are commonly
+// supported by CayenneLPP libraries of end devices. Like the original types, the type is the IPSO object ID minus 3200.
+const (
+	// genericSensor is a 4 byte unsigned value.
+	genericSensor = 100
+	// power is a 2 byte unsigned value in W.
+	power = 128
+	// energy is a 4 byte unsigned value in 0.001 kWh.
+	energy = 131
+	// unixTime is a 4 byte unsigned value in seconds since the Unix epoch.
+	unixTime = 133
+	// preciseGPS is a 4 byte signed latitude and longitude in 0.000001°, and a 3 byte signed altitude in 0.01 m.
+	preciseGPS = 137
+)
+
+// standardSizes are the data sizes of the original CayenneLPP types.
+var standardSizes = map[byte]int{
+	lpp.DigitalInput:       1,
+	lpp.DigitalOutput:      1,
+	lpp.AnalogInput:        2,
+	lpp.AnalogOutput:       2,
+	lpp.Luminosity:         2,
+	lpp.Presence:           1,
+	lpp.Temperature:        2,
+	lpp.RelativeHumidity:   1,
+	lpp.Accelerometer:      6,
+	lpp.BarometricPressure: 2,
+	lpp.Gyrometer:          6,
+	lpp.GPS:                9,
+}
+
+// extendedType is an extended CayenneLPP type with its data size and key of the decoded value.
+type extendedType struct {
+	typ    byte
+	key    string
+	size   int
+	decode func(b []byte) interface{}
+	encode func(v interface{}) ([]byte, bool)
+}
+
+var extendedTypes = []extendedType{
+	{
+		typ:  genericSensor,
+		key:  genericSensorKey,
+		size: 4,
+		decode: func(b []byte) interface{} {
+			return uint32(decodeUnsigned(b))
+		},
+		encode: func(v interface{}) ([]byte, bool) {
+			return encodeUnsigned(v, 1, 4)
+		},
+	},
+	{
+		typ:  power,
+		key:  powerKey,
+		size: 2,
+		decode: func(b []byte) interface{} {
+			return uint16(decodeUnsigned(b))
+		},
+		encode: func(v interface{}) ([]byte, bool) {
+			return encodeUnsigned(v, 1, 2)
+		},
+	},
+	{
+		typ:  energy,
+		key:  energyKey,
+		size: 4,
+		decode: func(b []byte) interface{} {
+			return float64(decodeUnsigned(b)) / 1000
+		},
+		encode: func(v interface{}) ([]byte, bool) {
+			return encodeUnsigned(v, 1000, 4)
+		},
+	},
+	{
+		typ:  unixTime,
+		key:  unixTimeKey,
+		size: 4,
+		decode: func(b []byte) interface{} {
+			return uint32(decodeUnsigned(b))
+		},
+		encode: func(v interface{}) ([]byte, bool) {
+			return encodeUnsigned(v, 1, 4)
+		},
+	},
+	{
+		typ:  preciseGPS,
+		key:  preciseGPSKey,
+		size: 11,
+		decode: func(b []byte) interface{} {
+			return map[string]float64{
+				"latitude":  float64(decodeSigned(b[0:4])) / 1000000,
+				"longitude": float64(decodeSigned(b[4:8])) / 1000000,
+				"altitude":  float64(decodeSigned(b[8:11])) / 100,
+			}
+		},
+		encode: func(v interface{}) ([]byte, bool) {
+			m, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			lat, ok := m["latitude"].(float64)
+			if !ok || lat < -90 || lat > 90 {
+				return nil, false
+			}
+			lon, ok := m["longitude"].(float64)
+			if !ok || lon < -180 || lon > 180 {
+				return nil, false
+			}
+			b := make([]byte, 0, 11)
+			b = append(b, encodeSigned(math.Round(lat*1000000), 4)...)
+			b = append(b, encodeSigned(math.Round(lon*1000000), 4)...)
+			alt, ok := m["altitude"].(float64)
+			if !ok {
+				return nil, false
+			}
+			alt = math.Round(alt * 100)
+			if alt < -(1<<23) || alt >= 1<<23 {
+				return nil, false
+			}
+			return append(b, encodeSigned(alt, 3)...), true
+		},
+	},
+}
+
+var (
+	extendedTypesByType = make(map[byte]extendedType, len(extendedTypes))
+	extendedTypesByKey  = make(map[string]extendedType, len(extendedTypes))
+)
+
+func init() {
+	for _, t := range extendedTypes {
+		extendedTypesByType[t.typ] = t
+		extendedTypesByKey[t.key] = t
+	}
+}
+
+func decodeUnsigned(b []byte) uint64 {
+	var v uint64
+	for _, x := range b {
+		v = v<<8 | uint64(x)
+	}
+	return v
+}
+
+func decodeSigned(b []byte) int64 {
+	v := int64(decodeUnsigned(b))
+	if bits := uint(len(b) * 8); v&(1<<(bits-1)) != 0 {
+		v -= 1 << bits
+	}
+	return v
+}
+
+// encodeUnsigned encodes the number multiplied by the factor as unsigned value of the given size.
+func encodeUnsigned(v interface{}, factor float64, size int) ([]byte, bool) {
+	f, ok := v.(float64)
+	if !ok {
+		return nil, false
+	}
+	f = math.Round(f * factor)
+	if f < 0 || f >= math.Exp2(float64(size*8)) {
+		return nil, false
+	}
+	n := uint64(f)
+	b := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+	return b, true
+}
+
+// encodeSigned encodes the integral number as two's complement value of the given size.
+func encodeSigned(f float64, size int) []byte {
+	n := uint64(int64(f))
+	b := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+	return b
+}
+
+var (
+	errPayloadLength = errors.DefineInvalidArgument("payload_length", "payload too short for type `{type}`")
+	errUnknownType   = errors.DefineInvalidArgument("unknown_type", "unknown type `{type}`")
+)
+
+// decodeUplink decodes the payload with original and extended CayenneLPP types.
+// The original types are decoded by the CayenneLPP library.
+func decodeUplink(payload []byte, m decodedMap) error {
+	for len(payload) > 0 {
+		if len(payload) < 2 {
+			return errPayloadLength.WithAttributes("type", "unknown")
+		}
+		channel, typ := payload[0], payload[1]
+		if size, ok := standardSizes[typ]; ok {
+			if len(payload) < 2+size {
+				return errPayloadLength.WithAttributes("type", typ)
+			}
+			if err := lpp.NewDecoder(bytes.NewBuffer(payload[:2+size])).DecodeUplink(m); err != nil {
+				return err
+			}
+			payload = payload[2+size:]
+			continue
+		}
+		t, ok := extendedTypesByType[typ]
+		if !ok {
+			return errUnknownType.WithAttributes("type", typ)
+		}
+		if len(payload) < 2+t.size {
+			return errPayloadLength.WithAttributes("type", typ)
+		}
+		m[formatName(t.key, channel)] = t.decode(payload[2 : 2+t.size])
+		payload = payload[2+t.size:]
+	}
+	return nil
+}
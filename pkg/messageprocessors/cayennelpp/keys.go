@@ -36,6 +36,11 @@ const (
 	barometricPressureKey = "barometric_pressure"
 	gyrometerKey          = "gyrometer"
 	gpsKey                = "gps"
+	genericSensorKey      = "generic_sensor"
+	powerKey              = "power"
+	energyKey             = "energy"
+	unixTimeKey           = "unix_time"
+	preciseGPSKey         = "gps_precise"
 )
 
 func formatName(key string, channel uint8) string {
@@ -0,0 +1,142 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cbor contains the CBOR payload formatter message processors.
+//
+// Uplink messages are decoded from a CBOR map to the decoded payload, and downlink messages are encoded from the
+// decoded payload to a CBOR map. Integers are encoded as CBOR integers and other numbers in the shortest floating
+// point representation that preserves their value.
+package cbor
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math"
+	"runtime/trace"
+	"time"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type host struct {
+	enc fxcbor.EncMode
+}
+
+// New creates and returns a new CBOR payload encoder and decoder.
+func New() messageprocessors.PayloadEncodeDecoder {
+	enc, err := fxcbor.CanonicalEncOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return &host{
+		enc: enc,
+	}
+}
+
+var (
+	errInput      = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput     = errors.Define("output", "invalid output")
+	errOutputType = errors.DefineInvalidArgument("output_type", "output is of type `{type}` instead of a map")
+)
+
+// Encode encodes the message's DecodedPayload to FRMPayload using CBOR encoding.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "encode message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	m, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	frmPayload, err := h.enc.Marshal(fromDecodedValue(m))
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	msg.FRMPayload = frmPayload
+	return nil
+}
+
+// Decode decodes the message's FRMPayload to DecodedPayload using CBOR decoding.
+// The FRMPayload must be a CBOR map.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode message").End()
+
+	var v interface{}
+	if err := fxcbor.Unmarshal(msg.FRMPayload, &v); err != nil {
+		return errOutput.WithCause(err)
+	}
+	m, ok := toDecodedValue(v).(map[string]interface{})
+	if !ok {
+		return errOutputType.WithAttributes("type", fmt.Sprintf("%T", v))
+	}
+	s, err := gogoproto.Struct(m)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = s
+	return nil
+}
+
+// fromDecodedValue replaces the numbers without fractional part in the given decoded payload value by integers, so
+// that they are encoded as CBOR integers instead of floating point numbers.
+func fromDecodedValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			v[k] = fromDecodedValue(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = fromDecodedValue(e)
+		}
+	case float64:
+		if v == math.Trunc(v) && v >= math.MinInt64 && v < math.MaxInt64 {
+			return int64(v)
+		}
+	}
+	return v
+}
+
+// toDecodedValue replaces the values in the given CBOR value that have no decoded payload representation.
+// Maps with non-string keys get string keys, byte strings become base64 encoded strings, timestamps become RFC3339
+// strings and tags are replaced by their content.
+func toDecodedValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			m[fmt.Sprint(k)] = toDecodedValue(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = toDecodedValue(e)
+		}
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case fxcbor.Tag:
+		return toDecodedValue(v.Content)
+	}
+	return v
+}
@@ -0,0 +1,141 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cbor
+
+import (
+	"testing"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEncode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	// No decoded payload.
+	{
+		message := &ttnpb.ApplicationDownlink{
+			FRMPayload: []byte{0x1},
+		}
+		err := host.Encode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{0x1})
+	}
+
+	// Integers are encoded as CBOR integers and keys are sorted.
+	{
+		decoded, err := gogoproto.Struct(map[string]interface{}{
+			"led":      true,
+			"interval": 60,
+			"level":    -1.5,
+			"name":     "foo",
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		message := &ttnpb.ApplicationDownlink{
+			DecodedPayload: decoded,
+		}
+		err = host.Encode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{
+			0xa4,
+			0x63, 'l', 'e', 'd', 0xf5,
+			0x64, 'n', 'a', 'm', 'e', 0x63, 'f', 'o', 'o',
+			0x65, 'l', 'e', 'v', 'e', 'l', 0xf9, 0xbe, 0x00,
+			0x68, 'i', 'n', 't', 'e', 'r', 'v', 'a', 'l', 0x18, 0x3c,
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	mustMarshal := func(v interface{}) []byte {
+		b, err := fxcbor.Marshal(v)
+		if err != nil {
+			t.Fatalf("Failed to marshal CBOR: %v", err)
+		}
+		return b
+	}
+
+	// Map with nested values.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(map[interface{}]interface{}{
+				"temperature": 21.5,
+				"count":       uint64(42),
+				"offset":      int64(-3),
+				"raw":         []byte{0x1, 0x2},
+				"readings":    []interface{}{uint64(1), uint64(2)},
+				1:             "one",
+			}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"temperature": 21.5,
+			"count":       42.0,
+			"offset":      -3.0,
+			"raw":         "AQI=",
+			"readings":    []interface{}{1.0, 2.0},
+			"1":           "one",
+		})
+	}
+
+	// Data item that is not a map.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal([]interface{}{1, 2}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutputType)
+	}
+
+	// Invalid CBOR.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xa1, 0x61},
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutput)
+	}
+}
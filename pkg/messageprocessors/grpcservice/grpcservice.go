@@ -0,0 +1,194 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcservice contains the payload formatter message processors that call external gRPC services.
+//
+// The services implement the UplinkMessageProcessor and DownlinkMessageProcessor services. The parameter of the
+// payload formatter is the address of the service, which must be one of the configured services.
+package grpcservice
+
+import (
+	"context"
+	"crypto/tls"
+	"runtime/trace"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Service is the configuration of a payload formatter service.
+type Service struct {
+	Address      string   `name:"address" description:"Address of the service (host:port)"`
+	TLS          bool     `name:"tls" description:"Connect to the service with TLS"`
+	Token        string   `name:"token" description:"Bearer token to authenticate with the service"`
+	Applications []string `name:"applications" description:"IDs of the applications that can use the service (default all)"`
+}
+
+const defaultTimeout = 5 * time.Second
+
+var (
+	errServiceAddress = errors.DefineInvalidArgument("service_address", "no address of payload formatter service `{name}`")
+	errServiceConnect = errors.DefineUnavailable("service_connect", "connect to payload formatter service `{address}`")
+	errServiceUnknown = errors.DefinePermissionDenied("service_unknown", "payload formatter service `{address}` is not configured")
+	errServiceDenied  = errors.DefinePermissionDenied("service_denied", "application `{application_id}` cannot use payload formatter service `{address}`")
+	errOutputFPort    = errors.Define("output_f_port", "payload formatter service changed FPort from `{expected}` to `{actual}`")
+)
+
+type service struct {
+	upClient     ttnpb.UplinkMessageProcessorClient
+	downClient   ttnpb.DownlinkMessageProcessorClient
+	callOpts     []grpc.CallOption
+	applications map[string]struct{}
+}
+
+// allows returns whether the application can use the service.
+func (s *service) allows(ids ttnpb.ApplicationIdentifiers) bool {
+	if len(s.applications) == 0 {
+		return true
+	}
+	_, ok := s.applications[ids.ApplicationID]
+	return ok
+}
+
+type host struct {
+	timeout  time.Duration
+	services map[string]*service
+}
+
+// New returns a new payload encoder and decoder that calls the given services by name.
+// The connections are closed when the context is done. If timeout is zero, calls time out after 5 seconds.
+func New(ctx context.Context, services map[string]Service, timeout time.Duration, tlsConfig *tls.Config) (messageprocessors.PayloadEncodeDecoder, error) {
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	h := &host{
+		timeout:  timeout,
+		services: make(map[string]*service, len(services)),
+	}
+	for name, conf := range services {
+		if conf.Address == "" {
+			return nil, errServiceAddress.WithAttributes("name", name)
+		}
+		opts := rpcclient.DefaultDialOptions(ctx)
+		if conf.TLS {
+			opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		} else {
+			opts = append(opts, grpc.WithInsecure())
+		}
+		conn, err := grpc.DialContext(ctx, conf.Address, opts...)
+		if err != nil {
+			return nil, errServiceConnect.WithCause(err).WithAttributes("address", conf.Address)
+		}
+		go func() {
+			<-ctx.Done()
+			conn.Close()
+		}()
+		s := &service{
+			upClient:     ttnpb.NewUplinkMessageProcessorClient(conn),
+			downClient:   ttnpb.NewDownlinkMessageProcessorClient(conn),
+			applications: make(map[string]struct{}, len(conf.Applications)),
+		}
+		if conf.Token != "" {
+			s.callOpts = append(s.callOpts, grpc.PerRPCCredentials(rpcmetadata.MD{
+				AuthType:      "Bearer",
+				AuthValue:     conf.Token,
+				AllowInsecure: !conf.TLS,
+			}))
+		}
+		for _, id := range conf.Applications {
+			s.applications[id] = struct{}{}
+		}
+		h.services[conf.Address] = s
+	}
+	return h, nil
+}
+
+// service returns the service at the address, if the application can use it.
+func (h *host) service(ids ttnpb.ApplicationIdentifiers, address string) (*service, error) {
+	s, ok := h.services[address]
+	if !ok {
+		return nil, errServiceUnknown.WithAttributes("address", address)
+	}
+	if !s.allows(ids) {
+		return nil, errServiceDenied.WithAttributes(
+			"application_id", ids.ApplicationID,
+			"address", address,
+		)
+	}
+	return s, nil
+}
+
+// Encode encodes the message's DecodedPayload to FRMPayload using the service at the given address.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, address string) error {
+	defer trace.StartRegion(ctx, "encode message").End()
+
+	s, err := h.service(ids.ApplicationIdentifiers, address)
+	if err != nil {
+		return err
+	}
+	req := &ttnpb.ProcessDownlinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		Message:              *msg,
+	}
+	if version != nil {
+		req.EndDeviceVersionIDs = *version
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := s.downClient.Process(ctx, req, s.callOpts...)
+	if err != nil {
+		return err
+	}
+	if res.FPort != msg.FPort {
+		return errOutputFPort.WithAttributes(
+			"expected", msg.FPort,
+			"actual", res.FPort,
+		)
+	}
+	msg.FRMPayload = res.FRMPayload
+	return nil
+}
+
+// Decode decodes the message's FRMPayload to DecodedPayload and NormalizedPayload using the service at the given
+// address.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, address string) error {
+	defer trace.StartRegion(ctx, "decode message").End()
+
+	s, err := h.service(ids.ApplicationIdentifiers, address)
+	if err != nil {
+		return err
+	}
+	req := &ttnpb.ProcessUplinkMessageRequest{
+		EndDeviceIdentifiers: ids,
+		Message:              *msg,
+	}
+	if version != nil {
+		req.EndDeviceVersionIDs = *version
+	}
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+	res, err := s.upClient.Process(ctx, req, s.callOpts...)
+	if err != nil {
+		return err
+	}
+	msg.DecodedPayload = res.DecodedPayload
+	msg.NormalizedPayload = res.NormalizedPayload
+	return nil
+}
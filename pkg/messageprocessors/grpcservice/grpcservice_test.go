@@ -0,0 +1,146 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcservice_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors/cayennelpp"
+	. "go.thethings.network/lorawan-stack/pkg/messageprocessors/grpcservice"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var errUnauthenticated = errors.DefineUnauthenticated("test_unauthenticated", "unauthenticated")
+
+// startService starts a payload formatter service that formats Cayenne LPP and requires the given token.
+func startService(ctx context.Context, t *testing.T, token string) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if auth := md.Get("authorization"); len(auth) != 1 || auth[0] != "Bearer "+token {
+			return nil, errUnauthenticated
+		}
+		return handler(ctx, req)
+	}))
+	formatter := cayennelpp.New()
+	ttnpb.RegisterUplinkMessageProcessorServer(srv, &messageprocessors.PayloadDecoderRPC{PayloadDecoder: formatter})
+	ttnpb.RegisterDownlinkMessageProcessorServer(srv, &messageprocessors.PayloadEncoderRPC{PayloadEncoder: formatter})
+	go srv.Serve(lis)
+	go func() {
+		<-ctx.Done()
+		srv.Stop()
+	}()
+	return lis.Addr().String()
+}
+
+func TestGRPCService(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	address := startService(ctx, t, "secret")
+	host, err := New(ctx, map[string]Service{
+		"lpp": {
+			Address:      address,
+			Token:        "secret",
+			Applications: []string{"foo-app"},
+		},
+	}, 0, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}
+	version := &ttnpb.EndDeviceVersionIdentifiers{
+		BrandID:         "The Things Products",
+		ModelID:         "The Things Uno",
+		HardwareVersion: "1.0",
+		FirmwareVersion: "1.0.0",
+	}
+
+	down := &ttnpb.ApplicationDownlink{
+		FPort: 1,
+		DecodedPayload: &pbtypes.Struct{
+			Fields: map[string]*pbtypes.Value{
+				"value_2": {
+					Kind: &pbtypes.Value_NumberValue{
+						NumberValue: -50.51,
+					},
+				},
+			},
+		},
+	}
+	err = host.Encode(ctx, ids, version, down, address)
+	a.So(err, should.BeNil)
+	a.So(down.FRMPayload, should.Resemble, []byte{2, 236, 69})
+
+	up := &ttnpb.ApplicationUplink{
+		FPort:      1,
+		FRMPayload: []byte{2, 2, 236, 69}, // Analog input on channel 2.
+	}
+	err = host.Decode(ctx, ids, nil, up, address)
+	a.So(err, should.BeNil)
+	if a.So(up.DecodedPayload, should.NotBeNil) {
+		a.So(up.DecodedPayload.Fields, should.ContainKey, "analog_in_2")
+	}
+
+	// Addresses that are not configured cannot be used.
+	err = host.Decode(ctx, ids, nil, &ttnpb.ApplicationUplink{}, "127.0.0.1:2")
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// Applications that are not allowed cannot use the service.
+	otherIDs := ids
+	otherIDs.ApplicationID = "bar-app"
+	err = host.Decode(ctx, otherIDs, nil, &ttnpb.ApplicationUplink{}, address)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+}
+
+func TestGRPCServiceUnauthenticated(t *testing.T) {
+	a := assertions.New(t)
+	ctx, cancel := context.WithCancel(test.Context())
+	defer cancel()
+
+	address := startService(ctx, t, "secret")
+	host, err := New(ctx, map[string]Service{
+		"lpp": {
+			Address: address,
+			Token:   "wrong",
+		},
+	}, 0, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	err = host.Decode(ctx, ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "foo-app"},
+		DeviceID:               "foo-device",
+	}, nil, &ttnpb.ApplicationUplink{FPort: 1, FRMPayload: []byte{2, 2, 236, 69}}, address)
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+}
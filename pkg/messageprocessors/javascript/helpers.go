@@ -0,0 +1,235 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+// helpersScript defines the helpers object that is available to payload formatter scripts.
+// The helpers only use indexing and the length of byte arrays, so that they work with both JavaScript arrays and the
+// FRMPayload that is passed to decoders.
+const helpersScript = `
+var helpers = (function() {
+	var base64Alphabet = 'ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/';
+
+	function checkRange(bytes, offset, length) {
+		if (offset < 0 || length < 1 || offset + length > bytes.length) {
+			throw new RangeError('read of ' + length + ' bytes at offset ' + offset + ' out of range');
+		}
+	}
+
+	function readUint(bytes, offset, length, littleEndian) {
+		if (length > 6) {
+			throw new RangeError('read of more than 6 bytes');
+		}
+		checkRange(bytes, offset, length);
+		var value = 0;
+		for (var i = 0; i < length; i++) {
+			var b = littleEndian ? bytes[offset + length - 1 - i] : bytes[offset + i];
+			value = value * 256 + (b & 0xff);
+		}
+		return value;
+	}
+
+	function toSigned(value, bits) {
+		var max = Math.pow(2, bits);
+		value = value % max;
+		return value >= max / 2 ? value - max : value;
+	}
+
+	function readInt(bytes, offset, length, littleEndian) {
+		return toSigned(readUint(bytes, offset, length, littleEndian), length * 8);
+	}
+
+	function writeUint(value, length, littleEndian) {
+		if (length < 1 || length > 6) {
+			throw new RangeError('write of ' + length + ' bytes');
+		}
+		var max = Math.pow(2, length * 8);
+		value = Math.floor(value);
+		if (value < 0) {
+			value += max;
+		}
+		if (value < 0 || value >= max) {
+			throw new RangeError('value ' + value + ' out of range for ' + length + ' bytes');
+		}
+		var bytes = new Array(length);
+		for (var i = length - 1; i >= 0; i--) {
+			bytes[littleEndian ? length - 1 - i : i] = value % 256;
+			value = Math.floor(value / 256);
+		}
+		return bytes;
+	}
+
+	function float16(bits) {
+		var sign = (bits >> 15) & 0x1 ? -1 : 1;
+		var exponent = (bits >> 10) & 0x1f;
+		var fraction = bits & 0x3ff;
+		if (exponent === 0) {
+			return sign * fraction * Math.pow(2, -24);
+		}
+		if (exponent === 0x1f) {
+			return fraction ? NaN : sign * Infinity;
+		}
+		return sign * (1 + fraction / 0x400) * Math.pow(2, exponent - 15);
+	}
+
+	function float32(bits) {
+		var sign = bits >= 0x80000000 || bits < 0 ? -1 : 1;
+		var exponent = Math.floor(bits / 0x800000) & 0xff;
+		var fraction = bits & 0x7fffff;
+		if (exponent === 0) {
+			return sign * fraction * Math.pow(2, -149);
+		}
+		if (exponent === 0xff) {
+			return fraction ? NaN : sign * Infinity;
+		}
+		return sign * (1 + fraction / 0x800000) * Math.pow(2, exponent - 127);
+	}
+
+	function bitReader(bytes) {
+		var position = 0;
+		return {
+			readBits: function(n) {
+				if (n < 1 || n > 48) {
+					throw new RangeError('read of ' + n + ' bits');
+				}
+				if (position + n > bytes.length * 8) {
+					throw new RangeError('read of ' + n + ' bits at bit ' + position + ' out of range');
+				}
+				var value = 0;
+				for (var i = 0; i < n; i++, position++) {
+					value = value * 2 + ((bytes[position >> 3] >> (7 - (position & 7))) & 0x1);
+				}
+				return value;
+			},
+			readSignedBits: function(n) {
+				return toSigned(this.readBits(n), n);
+			},
+			skip: function(n) {
+				if (position + n > bytes.length * 8) {
+					throw new RangeError('skip of ' + n + ' bits at bit ' + position + ' out of range');
+				}
+				position += n;
+			},
+			remaining: function() {
+				return bytes.length * 8 - position;
+			}
+		};
+	}
+
+	function bytesToHex(bytes) {
+		var hex = '';
+		for (var i = 0; i < bytes.length; i++) {
+			hex += ((bytes[i] & 0xff) < 0x10 ? '0' : '') + (bytes[i] & 0xff).toString(16);
+		}
+		return hex;
+	}
+
+	function hexToBytes(hex) {
+		hex = hex.replace(/\s/g, '');
+		if (hex.length % 2 !== 0 || !/^[0-9a-fA-F]*$/.test(hex)) {
+			throw new TypeError('invalid hex string');
+		}
+		var bytes = new Array(hex.length / 2);
+		for (var i = 0; i < bytes.length; i++) {
+			bytes[i] = parseInt(hex.substr(i * 2, 2), 16);
+		}
+		return bytes;
+	}
+
+	function bytesToBase64(bytes) {
+		var s = '';
+		for (var i = 0; i < bytes.length; i += 3) {
+			var n = (bytes[i] & 0xff) << 16;
+			if (i + 1 < bytes.length) {
+				n |= (bytes[i + 1] & 0xff) << 8;
+			}
+			if (i + 2 < bytes.length) {
+				n |= bytes[i + 2] & 0xff;
+			}
+			s += base64Alphabet.charAt((n >> 18) & 0x3f) + base64Alphabet.charAt((n >> 12) & 0x3f);
+			s += i + 1 < bytes.length ? base64Alphabet.charAt((n >> 6) & 0x3f) : '=';
+			s += i + 2 < bytes.length ? base64Alphabet.charAt(n & 0x3f) : '=';
+		}
+		return s;
+	}
+
+	function base64ToBytes(s) {
+		s = s.replace(/\s/g, '').replace(/=+$/, '').replace(/-/g, '+').replace(/_/g, '/');
+		if (s.length % 4 === 1) {
+			throw new TypeError('invalid base64 string');
+		}
+		var bytes = [];
+		var n = 0;
+		for (var i = 0; i < s.length; i++) {
+			var v = base64Alphabet.indexOf(s.charAt(i));
+			if (v < 0) {
+				throw new TypeError('invalid base64 string');
+			}
+			n = (n << 6) | v;
+			if (i % 4 === 3) {
+				bytes.push((n >> 16) & 0xff, (n >> 8) & 0xff, n & 0xff);
+				n = 0;
+			}
+		}
+		if (s.length % 4 === 2) {
+			bytes.push((n >> 4) & 0xff);
+		} else if (s.length % 4 === 3) {
+			bytes.push((n >> 10) & 0xff, (n >> 2) & 0xff);
+		}
+		return bytes;
+	}
+
+	// gpsEpochOffset is the number of seconds between the Unix epoch and the GPS epoch.
+	var gpsEpochOffset = 315964800;
+	// gpsLeapSeconds is the number of leap seconds between UTC and GPS time since 2017-01-01.
+	var gpsLeapSeconds = 18;
+
+	return {
+		readUint8: function(bytes, offset) { return readUint(bytes, offset, 1, false); },
+		readInt8: function(bytes, offset) { return readInt(bytes, offset, 1, false); },
+		readUint16BE: function(bytes, offset) { return readUint(bytes, offset, 2, false); },
+		readUint16LE: function(bytes, offset) { return readUint(bytes, offset, 2, true); },
+		readInt16BE: function(bytes, offset) { return readInt(bytes, offset, 2, false); },
+		readInt16LE: function(bytes, offset) { return readInt(bytes, offset, 2, true); },
+		readUint24BE: function(bytes, offset) { return readUint(bytes, offset, 3, false); },
+		readUint24LE: function(bytes, offset) { return readUint(bytes, offset, 3, true); },
+		readInt24BE: function(bytes, offset) { return readInt(bytes, offset, 3, false); },
+		readInt24LE: function(bytes, offset) { return readInt(bytes, offset, 3, true); },
+		readUint32BE: function(bytes, offset) { return readUint(bytes, offset, 4, false); },
+		readUint32LE: function(bytes, offset) { return readUint(bytes, offset, 4, true); },
+		readInt32BE: function(bytes, offset) { return readInt(bytes, offset, 4, false); },
+		readInt32LE: function(bytes, offset) { return readInt(bytes, offset, 4, true); },
+		readFloat16BE: function(bytes, offset) { return float16(readUint(bytes, offset, 2, false)); },
+		readFloat16LE: function(bytes, offset) { return float16(readUint(bytes, offset, 2, true)); },
+		readFloat32BE: function(bytes, offset) { return float32(readUint(bytes, offset, 4, false)); },
+		readFloat32LE: function(bytes, offset) { return float32(readUint(bytes, offset, 4, true)); },
+		writeUintBE: function(value, length) { return writeUint(value, length, false); },
+		writeUintLE: function(value, length) { return writeUint(value, length, true); },
+		toSigned: toSigned,
+		float16: float16,
+		float32: float32,
+		bitReader: bitReader,
+		bytesToHex: bytesToHex,
+		hexToBytes: hexToBytes,
+		bytesToBase64: bytesToBase64,
+		base64ToBytes: base64ToBytes,
+		unixToISOString: function(seconds) {
+			return new Date(seconds * 1000).toISOString();
+		},
+		gpsToUnix: function(seconds) {
+			return seconds + gpsEpochOffset - gpsLeapSeconds;
+		}
+	};
+})();
+`
@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"runtime/trace"
 
+	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gogoproto"
 	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
@@ -33,10 +34,10 @@ type host struct {
 	engine scripting.Engine
 }
 
-// New creates and returns a new Javascript payload encoder and decoder.
-func New() messageprocessors.PayloadEncodeDecoder {
+// New creates and returns a new Javascript payload encoder and decoder with the given engine options.
+func New(options scripting.Options) messageprocessors.PayloadEncodeDecoder {
 	return &host{
-		engine: js.New(scripting.DefaultOptions),
+		engine: js.New(options),
 	}
 }
 
@@ -59,9 +60,17 @@ var (
 	errOutput      = errors.Define("output", "invalid output")
 	errOutputType  = errors.Define("output_type", "invalid output of type `{type}`")
 	errOutputRange = errors.Define("output_range", "output value `{value}` does not fall between `{low}` and `{high}`")
+
+	errOutputNormalized = errors.Define("output_normalized", "invalid normalized output of type `{type}`")
+
+	errDecodedPayload      = errors.DefineInvalidArgument("decoded_payload", "invalid decoded payload")
+	errDecodedPayloadField = errors.DefineInvalidArgument("decoded_payload_field", "invalid field `{field}` of decoded payload: {message}")
 )
 
 // Encode encodes the message's DecodedPayload to FRMPayload using the given script.
+// The script can use the helpers object, see helpersScript.
+// If the Encoder function returns an object with an errors field instead of the bytes, the errors of the fields of the
+// decoded payload are returned as EncodeDownlinkErrorDetails in the details of the error.
 func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, script string) error {
 	defer trace.StartRegion(ctx, "encode message").End()
 
@@ -77,13 +86,19 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	env["payload"] = m
 	env["f_port"] = msg.FPort
 	script = fmt.Sprintf(`
+		%s
 		%s
 		Encoder(env.payload, env.f_port)
-	`, script)
+	`, helpersScript, script)
 	value, err := h.engine.Run(ctx, script, env)
 	if err != nil {
 		return err
 	}
+	if m, ok := value.(map[string]interface{}); ok {
+		if fieldErrors, ok := m["errors"]; ok {
+			return encodeFieldErrors(fieldErrors)
+		}
+	}
 	if value == nil || reflect.TypeOf(value).Kind() != reflect.Slice {
 		return errOutputType
 	}
@@ -127,7 +142,34 @@ func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	return nil
 }
 
+// encodeFieldErrors returns the error with the errors of the fields of the decoded payload that the Encoder function
+// returned. Each field error is an object with the path of the field and a message.
+func encodeFieldErrors(value interface{}) error {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return errOutputType.WithAttributes("type", fmt.Sprintf("%T", value))
+	}
+	details := &ttnpb.EncodeDownlinkErrorDetails{
+		FieldErrors: make([]*ttnpb.ErrorDetails, 0, rv.Len()),
+	}
+	for i := 0; i < rv.Len(); i++ {
+		v := rv.Index(i).Interface()
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return errOutputType.WithAttributes("type", fmt.Sprintf("%T", v))
+		}
+		field, _ := m["field"].(string)
+		message, _ := m["message"].(string)
+		details.FieldErrors = append(details.FieldErrors, ttnpb.ErrorDetailsToProto(
+			errDecodedPayloadField.WithAttributes("field", field, "message", message),
+		))
+	}
+	return errDecodedPayload.WithDetails(details)
+}
+
 // Decode decodes the message's FRMPayload to DecodedPayload using the given script.
+// If the script defines a Normalizer function, it is called with the decoded payload and the FPort, and its output is
+// set as NormalizedPayload. The script can use the helpers object, see helpersScript.
 func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, script string) error {
 	defer trace.StartRegion(ctx, "decode message").End()
 
@@ -136,13 +178,25 @@ func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	env["f_port"] = msg.FPort
 	script = fmt.Sprintf(`
 		%s
-		Decoder(env.payload, env.f_port)
-	`, script)
+		%s
+		(function() {
+			var decoded = Decoder(env.payload, env.f_port);
+			var normalized;
+			if (typeof Normalizer === 'function') {
+				normalized = Normalizer(decoded, env.f_port);
+			}
+			return { decoded: decoded, normalized: normalized };
+		})()
+	`, helpersScript, script)
 	value, err := h.engine.Run(ctx, script, env)
 	if err != nil {
 		return err
 	}
-	m, ok := value.(map[string]interface{})
+	output, ok := value.(map[string]interface{})
+	if !ok {
+		return errOutput
+	}
+	m, ok := output["decoded"].(map[string]interface{})
 	if !ok {
 		return errOutput
 	}
@@ -150,6 +204,44 @@ func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, versi
 	if err != nil {
 		return errOutput.WithCause(err)
 	}
+	normalized, err := normalizedOutput(output["normalized"])
+	if err != nil {
+		return err
+	}
 	msg.DecodedPayload = s
+	msg.NormalizedPayload = normalized
 	return nil
 }
+
+// normalizedOutput returns the measurements of the output of the Normalizer function, which is either a single
+// measurement or a list of measurements.
+func normalizedOutput(value interface{}) ([]*pbtypes.Struct, error) {
+	if value == nil {
+		return nil, nil
+	}
+	var values []interface{}
+	switch rv := reflect.ValueOf(value); rv.Kind() {
+	case reflect.Map:
+		values = []interface{}{value}
+	case reflect.Slice:
+		values = make([]interface{}, rv.Len())
+		for i := range values {
+			values[i] = rv.Index(i).Interface()
+		}
+	default:
+		return nil, errOutputNormalized.WithAttributes("type", fmt.Sprintf("%T", value))
+	}
+	measurements := make([]*pbtypes.Struct, 0, len(values))
+	for _, v := range values {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, errOutputNormalized.WithAttributes("type", fmt.Sprintf("%T", v))
+		}
+		s, err := gogoproto.Struct(m)
+		if err != nil {
+			return nil, errOutputNormalized.WithCause(err).WithAttributes("type", fmt.Sprintf("%T", v))
+		}
+		measurements = append(measurements, s)
+	}
+	return measurements, nil
+}
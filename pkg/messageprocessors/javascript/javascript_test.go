@@ -19,7 +19,9 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/scripting"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
 	"go.thethings.network/lorawan-stack/pkg/util/test"
@@ -30,7 +32,7 @@ func TestEncode(t *testing.T) {
 	a := assertions.New(t)
 
 	ctx := test.Context()
-	host := New()
+	host := New(scripting.DefaultOptions)
 
 	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
 	ids := ttnpb.EndDeviceIdentifiers{
@@ -157,13 +159,42 @@ func TestEncode(t *testing.T) {
 		err := host.Encode(ctx, ids, version, message, script)
 		a.So(err, should.HaveSameErrorDefinitionAs, errOutputType)
 	}
+
+	// Return errors of fields.
+	{
+		script := `
+		function Encoder(payload, f_port) {
+			return {
+				errors: [
+					{ field: "temperature", message: "too cold" },
+					{ field: "mode", message: "unknown mode" }
+				]
+			}
+		}
+		`
+		err := host.Encode(ctx, ids, version, message, script)
+		a.So(err, should.HaveSameErrorDefinitionAs, errDecodedPayload)
+		ttnErr, ok := errors.From(err)
+		if a.So(ok, should.BeTrue) && a.So(ttnErr.Details(), should.HaveLength, 1) {
+			details, ok := ttnErr.Details()[0].(*ttnpb.EncodeDownlinkErrorDetails)
+			if a.So(ok, should.BeTrue) && a.So(details.FieldErrors, should.HaveLength, 2) {
+				a.So(details.FieldErrors[0].Name, should.Equal, "decoded_payload_field")
+				m, err := gogoproto.Map(details.FieldErrors[1].Attributes)
+				a.So(err, should.BeNil)
+				a.So(m, should.Resemble, map[string]interface{}{
+					"field":   "mode",
+					"message": "unknown mode",
+				})
+			}
+		}
+	}
 }
 
 func TestDecode(t *testing.T) {
 	a := assertions.New(t)
 
 	ctx := test.Context()
-	host := New()
+	host := New(scripting.DefaultOptions)
 
 	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
 	ids := ttnpb.EndDeviceIdentifiers{
@@ -226,6 +257,51 @@ func TestDecode(t *testing.T) {
 		})
 	}
 
+	// Normalize decoded payload.
+	{
+		script := `
+		function Decoder(payload, f_port) {
+			return {
+				temperature: -21.3
+			}
+		}
+
+		function Normalizer(decoded, f_port) {
+			return {
+				air: {
+					temperature: decoded.temperature
+				}
+			}
+		}
+		`
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.BeNil)
+		if a.So(message.NormalizedPayload, should.HaveLength, 1) {
+			m, err := gogoproto.Map(message.NormalizedPayload[0])
+			a.So(err, should.BeNil)
+			a.So(m, should.Resemble, map[string]interface{}{
+				"air": map[string]interface{}{
+					"temperature": -21.3,
+				},
+			})
+		}
+	}
+
+	// Return invalid normalized type.
+	{
+		script := `
+		function Decoder(payload, f_port) {
+			return {}
+		}
+
+		function Normalizer(decoded, f_port) {
+			return 42
+		}
+		`
+		err := host.Decode(ctx, ids, version, message, script)
+		a.So(err, should.NotBeNil)
+	}
+
 	// Return invalid type.
 	{
 		script := `
@@ -248,3 +324,93 @@ func TestDecode(t *testing.T) {
 		a.So(err, should.NotBeNil)
 	}
 }
+
+func TestHelpers(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New(scripting.DefaultOptions)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	// Read integers, floats and bits from the payload.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0xf7, 0xae, 0x3c, 0x00, 0xa5, 0x01, 0x02, 0x03},
+		}
+		script := `
+		function Decoder(payload, f_port) {
+			var r = helpers.bitReader(payload);
+			r.skip(32);
+			return {
+				temperature: helpers.readInt16BE(payload, 0) / 100,
+				ratio: helpers.readFloat16BE(payload, 2),
+				counter: helpers.readUint32LE(payload, 4),
+				offset: r.readSignedBits(4),
+				flags: r.readBits(4),
+				remaining: r.remaining(),
+				hex: helpers.bytesToHex(payload),
+				base64: helpers.bytesToBase64(helpers.base64ToBytes("AQI=")),
+				time: helpers.unixToISOString(helpers.gpsToUnix(1000000000)),
+			}
+		}
+		`
+		err := host.Decode(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"temperature": -21.3,
+			"ratio":       1.0,
+			"counter":     50463141.0,
+			"offset":      -6.0,
+			"flags":       5.0,
+			"remaining":   24.0,
+			"hex":         "f7ae3c00a5010203",
+			"base64":      "AQI=",
+			"time":        "2011-09-14T01:46:22.000Z",
+		})
+	}
+
+	// Read out of range.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: []byte{0x01},
+		}
+		script := `
+		function Decoder(payload, f_port) {
+			return {
+				value: helpers.readUint16BE(payload, 0),
+			}
+		}
+		`
+		err := host.Decode(ctx, ids, nil, message, script)
+		a.So(err, should.NotBeNil)
+	}
+
+	// Write integers and hex.
+	{
+		message := &ttnpb.ApplicationDownlink{
+			DecodedPayload: &pbtypes.Struct{
+				Fields: map[string]*pbtypes.Value{
+					"interval": {
+						Kind: &pbtypes.Value_NumberValue{NumberValue: 600},
+					},
+				},
+			},
+		}
+		script := `
+		function Encoder(payload, f_port) {
+			return helpers.writeUintBE(payload.interval, 2).concat(helpers.writeUintLE(-2, 2), helpers.hexToBytes("AB cd"));
+		}
+		`
+		err := host.Encode(ctx, ids, nil, message, script)
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{0x02, 0x58, 0xfe, 0xff, 0xab, 0xcd})
+	}
+}
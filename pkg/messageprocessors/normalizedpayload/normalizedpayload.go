@@ -0,0 +1,154 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package normalizedpayload contains the schema of normalized payloads.
+//
+// A normalized payload is a list of measurements. Each measurement is an object with fields of the schema, such as
+// air.temperature in degrees Celsius and battery in volts. Payload formatters emit normalized payloads next to the
+// decoded payload, so that integrations can process measurements of end devices of different vendors in the same way.
+package normalizedpayload
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+)
+
+// MaxMeasurements is the maximum number of measurements in a normalized payload.
+const MaxMeasurements = 32
+
+type fieldKind int
+
+const (
+	number fieldKind = iota
+	timestamp
+)
+
+// field is a field of a measurement. Numbers must be between min and max.
+type field struct {
+	kind     fieldKind
+	min, max float64
+}
+
+// valid returns whether the value is valid for the field, and the reason if it is not.
+func (f field) valid(v *pbtypes.Value) (string, bool) {
+	switch f.kind {
+	case number:
+		n, ok := v.GetKind().(*pbtypes.Value_NumberValue)
+		if !ok {
+			return "not a number", false
+		}
+		if n.NumberValue < f.min || n.NumberValue > f.max {
+			return fmt.Sprintf("%v is not between %v and %v", n.NumberValue, f.min, f.max), false
+		}
+	case timestamp:
+		s, ok := v.GetKind().(*pbtypes.Value_StringValue)
+		if !ok {
+			return "not a string", false
+		}
+		if _, err := time.Parse(time.RFC3339Nano, s.StringValue); err != nil {
+			return "not an RFC3339 timestamp", false
+		}
+	}
+	return "", true
+}
+
+// schema is the schema of measurements by field path. Temperatures are in degrees Celsius, relative humidity and
+// soil moisture in percent, pressure in hectopascal, CO2 in parts per million, light intensity in lux, wind speed in
+// meters per second, directions and coordinates in degrees, altitude in meters and battery in volts.
+var schema = map[string]field{
+	"time":                 {kind: timestamp},
+	"battery":              {min: 0, max: 100},
+	"air.temperature":      {min: -273.15, max: math.Inf(1)},
+	"air.relativeHumidity": {min: 0, max: 100},
+	"air.pressure":         {min: 900, max: 1100},
+	"air.co2":              {min: 0, max: 1000000},
+	"air.lightIntensity":   {min: 0, max: math.Inf(1)},
+	"soil.temperature":     {min: -273.15, max: math.Inf(1)},
+	"soil.moisture":        {min: 0, max: 100},
+	"water.temperature":    {min: -273.15, max: math.Inf(1)},
+	"wind.speed":           {min: 0, max: math.Inf(1)},
+	"wind.direction":       {min: 0, max: 360},
+	"position.latitude":    {min: -90, max: 90},
+	"position.longitude":   {min: -180, max: 180},
+	"position.altitude":    {min: math.Inf(-1), max: math.Inf(1)},
+}
+
+// Validate validates the measurements against the schema. It returns the valid measurements without the invalid and
+// unknown fields, and a warning for each field that is removed.
+func Validate(measurements []*pbtypes.Struct) ([]*pbtypes.Struct, []string) {
+	var warnings []string
+	if len(measurements) > MaxMeasurements {
+		warnings = append(warnings, fmt.Sprintf("more than %d measurements", MaxMeasurements))
+		measurements = measurements[:MaxMeasurements]
+	}
+	valid := make([]*pbtypes.Struct, 0, len(measurements))
+	for i, measurement := range measurements {
+		s, ws := validateStruct(measurement, "")
+		for _, w := range ws {
+			warnings = append(warnings, fmt.Sprintf("measurement %d: %s", i, w))
+		}
+		if s != nil {
+			valid = append(valid, s)
+		}
+	}
+	if len(valid) == 0 {
+		valid = nil
+	}
+	return valid, warnings
+}
+
+// validateStruct returns the struct with only the valid fields, or nil if no field is valid.
+func validateStruct(s *pbtypes.Struct, prefix string) (*pbtypes.Struct, []string) {
+	if s == nil {
+		return nil, nil
+	}
+	names := make([]string, 0, len(s.Fields))
+	for name := range s.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var warnings []string
+	fields := make(map[string]*pbtypes.Value, len(s.Fields))
+	for _, name := range names {
+		v := s.Fields[name]
+		path := prefix + name
+		if nested, ok := v.GetKind().(*pbtypes.Value_StructValue); ok {
+			n, ws := validateStruct(nested.StructValue, path+".")
+			warnings = append(warnings, ws...)
+			if n != nil {
+				fields[name] = &pbtypes.Value{Kind: &pbtypes.Value_StructValue{StructValue: n}}
+			}
+			continue
+		}
+		f, ok := schema[path]
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("unknown field `%s`", path))
+			continue
+		}
+		if reason, ok := f.valid(v); !ok {
+			warnings = append(warnings, fmt.Sprintf("invalid field `%s`: %s", path, reason))
+			continue
+		}
+		fields[name] = v
+	}
+	if len(fields) == 0 {
+		return nil, warnings
+	}
+	return &pbtypes.Struct{Fields: fields}, warnings
+}
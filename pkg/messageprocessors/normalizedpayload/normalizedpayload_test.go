@@ -0,0 +1,129 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package normalizedpayload_test
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	. "go.thethings.network/lorawan-stack/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Measurements []map[string]interface{}
+		Expected     []map[string]interface{}
+		Warnings     []string
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name: "Valid",
+			Measurements: []map[string]interface{}{
+				{
+					"time": "2020-08-01T12:00:00Z",
+					"air": map[string]interface{}{
+						"temperature":      21.5,
+						"relativeHumidity": 40.0,
+					},
+					"battery": 3.6,
+				},
+				{
+					"wind": map[string]interface{}{
+						"speed":     4.2,
+						"direction": 270.0,
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"time": "2020-08-01T12:00:00Z",
+					"air": map[string]interface{}{
+						"temperature":      21.5,
+						"relativeHumidity": 40.0,
+					},
+					"battery": 3.6,
+				},
+				{
+					"wind": map[string]interface{}{
+						"speed":     4.2,
+						"direction": 270.0,
+					},
+				},
+			},
+		},
+		{
+			Name: "Invalid",
+			Measurements: []map[string]interface{}{
+				{
+					"time": "yesterday",
+					"air": map[string]interface{}{
+						"temperature":      -300.0,
+						"relativeHumidity": 40.0,
+					},
+					"battery": "full",
+					"foo":     1.0,
+				},
+				{
+					"wind": map[string]interface{}{
+						"direction": 400.0,
+					},
+				},
+			},
+			Expected: []map[string]interface{}{
+				{
+					"air": map[string]interface{}{
+						"relativeHumidity": 40.0,
+					},
+				},
+			},
+			Warnings: []string{
+				"measurement 0: invalid field `air.temperature`: -300 is not between -273.15 and +Inf",
+				"measurement 0: invalid field `battery`: not a number",
+				"measurement 0: unknown field `foo`",
+				"measurement 0: invalid field `time`: not an RFC3339 timestamp",
+				"measurement 1: invalid field `wind.direction`: 400 is not between 0 and 360",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var measurements []*pbtypes.Struct
+			for _, m := range tc.Measurements {
+				s, err := gogoproto.Struct(m)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				measurements = append(measurements, s)
+			}
+			valid, warnings := Validate(measurements)
+			a.So(warnings, should.Resemble, tc.Warnings)
+			var actual []map[string]interface{}
+			for _, s := range valid {
+				m, err := gogoproto.Map(s)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				actual = append(actual, m)
+			}
+			a.So(actual, should.Resemble, tc.Expected)
+		})
+	}
+}
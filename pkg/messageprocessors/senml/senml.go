@@ -0,0 +1,333 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package senml contains the SenML payload formatter message processors.
+//
+// The FRMPayload is a SenML pack in CBOR representation, as defined in RFC 8428. The decoded payload contains the
+// resolved records in the records field, with the labels of the JSON representation:
+//
+//	{"records": [{"n": "urn:dev:ow:10e2073a01080063:temp", "u": "Cel", "v": 23.1, "t": 1.276020076e+09}]}
+//
+// Downlink messages are encoded from the records in the decoded payload to a SenML pack in CBOR representation.
+package senml
+
+import (
+	"context"
+	"encoding/base64"
+	"runtime/trace"
+	"strings"
+	"time"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+type host struct {
+	enc fxcbor.EncMode
+}
+
+// New creates and returns a new SenML payload encoder and decoder.
+func New() messageprocessors.PayloadEncodeDecoder {
+	enc, err := fxcbor.CanonicalEncOptions().EncMode()
+	if err != nil {
+		panic(err)
+	}
+	return &host{
+		enc: enc,
+	}
+}
+
+var (
+	errInput        = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput       = errors.Define("output", "invalid output")
+	errRecords      = errors.DefineInvalidArgument("records", "no list of records in field `records`")
+	errRecord       = errors.DefineInvalidArgument("record", "record `{index}` is not a map")
+	errLabel        = errors.DefineInvalidArgument("label", "invalid value of label `{label}` in record `{index}`")
+	errUnknownLabel = errors.DefineInvalidArgument("unknown_label", "unknown label `{label}` in record `{index}`")
+)
+
+// Labels of the SenML CBOR representation, as defined in RFC 8428 section 6.
+const (
+	baseNameLabel    = -2
+	baseTimeLabel    = -3
+	baseUnitLabel    = -4
+	baseValueLabel   = -5
+	baseSumLabel     = -6
+	nameLabel        = 0
+	unitLabel        = 1
+	valueLabel       = 2
+	stringValueLabel = 3
+	boolValueLabel   = 4
+	sumLabel         = 5
+	timeLabel        = 6
+	updateTimeLabel  = 7
+	dataValueLabel   = 8
+)
+
+// recordLabels maps the labels of the JSON representation of resolved records to the CBOR labels.
+var recordLabels = map[string]int{
+	"n":  nameLabel,
+	"u":  unitLabel,
+	"v":  valueLabel,
+	"vs": stringValueLabel,
+	"vb": boolValueLabel,
+	"s":  sumLabel,
+	"t":  timeLabel,
+	"ut": updateTimeLabel,
+	"vd": dataValueLabel,
+}
+
+// relativeTimeLimit is the limit below which times are relative to the current time.
+const relativeTimeLimit = 1 << 28
+
+// record is a SenML record in CBOR representation.
+type record map[interface{}]interface{}
+
+func (r record) label(i int) (interface{}, bool) {
+	for k, v := range r {
+		switch k := k.(type) {
+		case int64:
+			if k == int64(i) {
+				return v, true
+			}
+		case uint64:
+			if i >= 0 && k == uint64(i) {
+				return v, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (r record) string(i int) (string, bool, bool) {
+	v, ok := r.label(i)
+	if !ok {
+		return "", true, false
+	}
+	s, ok := v.(string)
+	return s, ok, true
+}
+
+func (r record) number(i int) (float64, bool, bool) {
+	v, ok := r.label(i)
+	if !ok {
+		return 0, true, false
+	}
+	switch v := v.(type) {
+	case uint64:
+		return float64(v), true, true
+	case int64:
+		return float64(v), true, true
+	case float32:
+		return float64(v), true, true
+	case float64:
+		return v, true, true
+	default:
+		return 0, false, true
+	}
+}
+
+// resolver resolves records with the base values of the preceding records, as defined in RFC 8428 section 4.6.
+type resolver struct {
+	now time.Time
+
+	baseName  string
+	baseTime  float64
+	baseUnit  string
+	baseValue float64
+	baseSum   float64
+}
+
+func (rs *resolver) resolve(i int, r record) (map[string]interface{}, error) {
+	labelErr := func(label string) error {
+		return errLabel.WithAttributes("label", label, "index", i)
+	}
+	for k := range r {
+		if k, ok := k.(string); ok && strings.HasSuffix(k, "_") {
+			return nil, errUnknownLabel.WithAttributes("label", k, "index", i)
+		}
+	}
+	if s, ok, set := r.string(baseNameLabel); !ok {
+		return nil, labelErr("bn")
+	} else if set {
+		rs.baseName = s
+	}
+	if n, ok, set := r.number(baseTimeLabel); !ok {
+		return nil, labelErr("bt")
+	} else if set {
+		rs.baseTime = n
+	}
+	if s, ok, set := r.string(baseUnitLabel); !ok {
+		return nil, labelErr("bu")
+	} else if set {
+		rs.baseUnit = s
+	}
+	if n, ok, set := r.number(baseValueLabel); !ok {
+		return nil, labelErr("bv")
+	} else if set {
+		rs.baseValue = n
+	}
+	if n, ok, set := r.number(baseSumLabel); !ok {
+		return nil, labelErr("bs")
+	} else if set {
+		rs.baseSum = n
+	}
+
+	res := make(map[string]interface{})
+	name, ok, _ := r.string(nameLabel)
+	if !ok {
+		return nil, labelErr("n")
+	}
+	res["n"] = rs.baseName + name
+	unit, ok, set := r.string(unitLabel)
+	if !ok {
+		return nil, labelErr("u")
+	}
+	if !set {
+		unit = rs.baseUnit
+	}
+	if unit != "" {
+		res["u"] = unit
+	}
+	if v, ok, set := r.number(valueLabel); !ok {
+		return nil, labelErr("v")
+	} else if set {
+		res["v"] = rs.baseValue + v
+	}
+	if s, ok, set := r.string(stringValueLabel); !ok {
+		return nil, labelErr("vs")
+	} else if set {
+		res["vs"] = s
+	}
+	if v, set := r.label(boolValueLabel); set {
+		b, ok := v.(bool)
+		if !ok {
+			return nil, labelErr("vb")
+		}
+		res["vb"] = b
+	}
+	if v, set := r.label(dataValueLabel); set {
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, labelErr("vd")
+		}
+		res["vd"] = base64.RawURLEncoding.EncodeToString(b)
+	}
+	if s, ok, set := r.number(sumLabel); !ok {
+		return nil, labelErr("s")
+	} else if set {
+		res["s"] = rs.baseSum + s
+	}
+	t, ok, _ := r.number(timeLabel)
+	if !ok {
+		return nil, labelErr("t")
+	}
+	t += rs.baseTime
+	if t < relativeTimeLimit && !rs.now.IsZero() {
+		t += float64(rs.now.UnixNano()) / float64(time.Second)
+	}
+	if t != 0 {
+		res["t"] = t
+	}
+	if ut, ok, set := r.number(updateTimeLabel); !ok {
+		return nil, labelErr("ut")
+	} else if set {
+		res["ut"] = ut
+	}
+	return res, nil
+}
+
+// Encode encodes the records in the message's DecodedPayload to FRMPayload as a SenML pack in CBOR representation.
+func (h *host) Encode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationDownlink, parameter string) error {
+	defer trace.StartRegion(ctx, "encode message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	m, err := gogoproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	records, ok := m["records"].([]interface{})
+	if !ok {
+		return errRecords
+	}
+	pack := make([]map[int]interface{}, 0, len(records))
+	for i, r := range records {
+		r, ok := r.(map[string]interface{})
+		if !ok {
+			return errRecord.WithAttributes("index", i)
+		}
+		cr := make(map[int]interface{}, len(r))
+		for k, v := range r {
+			label, ok := recordLabels[k]
+			if !ok {
+				return errUnknownLabel.WithAttributes("label", k, "index", i)
+			}
+			if label == dataValueLabel {
+				s, ok := v.(string)
+				if !ok {
+					return errLabel.WithAttributes("label", k, "index", i)
+				}
+				b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+				if err != nil {
+					return errLabel.WithCause(err).WithAttributes("label", k, "index", i)
+				}
+				v = b
+			}
+			cr[label] = v
+		}
+		pack = append(pack, cr)
+	}
+	frmPayload, err := h.enc.Marshal(pack)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	msg.FRMPayload = frmPayload
+	return nil
+}
+
+// Decode decodes the message's FRMPayload as a SenML pack in CBOR representation to the resolved records in
+// DecodedPayload. Times relative to the current time are resolved with the time that the message was received.
+func (h *host) Decode(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, version *ttnpb.EndDeviceVersionIdentifiers, msg *ttnpb.ApplicationUplink, parameter string) error {
+	defer trace.StartRegion(ctx, "decode message").End()
+
+	var pack []record
+	if err := fxcbor.Unmarshal(msg.FRMPayload, &pack); err != nil {
+		return errOutput.WithCause(err)
+	}
+	rs := &resolver{
+		now: msg.ReceivedAt,
+	}
+	records := make([]interface{}, 0, len(pack))
+	for i, r := range pack {
+		res, err := rs.resolve(i, r)
+		if err != nil {
+			return err
+		}
+		records = append(records, res)
+	}
+	s, err := gogoproto.Struct(map[string]interface{}{
+		"records": records,
+	})
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = s
+	return nil
+}
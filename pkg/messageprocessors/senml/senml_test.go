@@ -0,0 +1,256 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package senml
+
+import (
+	"testing"
+	"time"
+
+	fxcbor "github.com/fxamacker/cbor/v2"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/gogoproto"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	b, err := fxcbor.Marshal(v)
+	if err != nil {
+		t.Fatalf("Failed to marshal CBOR: %v", err)
+	}
+	return b
+}
+
+func TestEncode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	// Records are encoded with CBOR labels.
+	{
+		decoded, err := gogoproto.Struct(map[string]interface{}{
+			"records": []interface{}{
+				map[string]interface{}{
+					"n":  "led",
+					"vb": true,
+				},
+				map[string]interface{}{
+					"n":  "config",
+					"vd": "AQI",
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		message := &ttnpb.ApplicationDownlink{
+			DecodedPayload: decoded,
+		}
+		err = host.Encode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		a.So(message.FRMPayload, should.Resemble, []byte{
+			0x82,
+			0xa2, 0x00, 0x63, 'l', 'e', 'd', 0x04, 0xf5,
+			0xa2, 0x00, 0x66, 'c', 'o', 'n', 'f', 'i', 'g', 0x08, 0x42, 0x01, 0x02,
+		})
+	}
+
+	// No records.
+	{
+		decoded, err := gogoproto.Struct(map[string]interface{}{
+			"led": true,
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		message := &ttnpb.ApplicationDownlink{
+			DecodedPayload: decoded,
+		}
+		err = host.Encode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errRecords)
+	}
+
+	// Unknown label.
+	{
+		decoded, err := gogoproto.Struct(map[string]interface{}{
+			"records": []interface{}{
+				map[string]interface{}{
+					"n":   "led",
+					"foo": 1,
+				},
+			},
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		message := &ttnpb.ApplicationDownlink{
+			DecodedPayload: decoded,
+		}
+		err = host.Encode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errUnknownLabel)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := New()
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{
+			ApplicationID: "foo-app",
+		},
+		DeviceID: "foo-device",
+	}
+
+	// Records are resolved with base values.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(t, []map[int]interface{}{
+				{
+					baseNameLabel:  "urn:dev:ow:10e2073a01080063:",
+					baseTimeLabel:  1.320067464e+09,
+					baseUnitLabel:  "%RH",
+					baseValueLabel: 20,
+					nameLabel:      "humidity",
+					valueLabel:     0.5,
+				},
+				{
+					nameLabel:  "temp",
+					unitLabel:  "Cel",
+					valueLabel: 3.1,
+					timeLabel:  60,
+				},
+				{
+					nameLabel:        "label",
+					stringValueLabel: "foo",
+				},
+				{
+					nameLabel:      "data",
+					dataValueLabel: []byte{0x1, 0x2},
+				},
+			}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"records": []interface{}{
+				map[string]interface{}{
+					"n": "urn:dev:ow:10e2073a01080063:humidity",
+					"u": "%RH",
+					"v": 20.5,
+					"t": 1.320067464e+09,
+				},
+				map[string]interface{}{
+					"n": "urn:dev:ow:10e2073a01080063:temp",
+					"u": "Cel",
+					"v": 23.1,
+					"t": 1.320067524e+09,
+				},
+				map[string]interface{}{
+					"n":  "urn:dev:ow:10e2073a01080063:label",
+					"u":  "%RH",
+					"vs": "foo",
+					"t":  1.320067464e+09,
+				},
+				map[string]interface{}{
+					"n":  "urn:dev:ow:10e2073a01080063:data",
+					"u":  "%RH",
+					"vd": "AQI",
+					"t":  1.320067464e+09,
+				},
+			},
+		})
+	}
+
+	// Relative times are resolved with the time that the message was received.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(t, []map[int]interface{}{
+				{
+					nameLabel:  "temp",
+					valueLabel: 21,
+					timeLabel:  -10,
+				},
+			}),
+			ReceivedAt: time.Unix(1600000000, 0),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.BeNil)
+		m, err := gogoproto.Map(message.DecodedPayload)
+		a.So(err, should.BeNil)
+		a.So(m, should.Resemble, map[string]interface{}{
+			"records": []interface{}{
+				map[string]interface{}{
+					"n": "temp",
+					"v": 21.0,
+					"t": 1599999990.0,
+				},
+			},
+		})
+	}
+
+	// Invalid label value.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(t, []map[int]interface{}{
+				{
+					nameLabel:  "temp",
+					valueLabel: "hot",
+				},
+			}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errLabel)
+	}
+
+	// Must-understand label.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(t, []map[interface{}]interface{}{
+				{
+					nameLabel:  "temp",
+					valueLabel: 21,
+					"foo_":     1,
+				},
+			}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errUnknownLabel)
+	}
+
+	// Not a pack.
+	{
+		message := &ttnpb.ApplicationUplink{
+			FRMPayload: mustMarshal(t, map[string]interface{}{
+				"temp": 21,
+			}),
+		}
+		err := host.Decode(ctx, ids, nil, message, "")
+		a.So(err, should.HaveSameErrorDefinitionAs, errOutput)
+	}
+}
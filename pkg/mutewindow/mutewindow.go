@@ -0,0 +1,211 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mutewindow implements a cache of the mute windows of end devices and applications.
+//
+// During a mute window, the Network Server suppresses downlink messages and the Application Server pauses
+// integrations. The mute windows are stored in the Entity Registry, and are cached by the components that apply them.
+package mutewindow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"google.golang.org/grpc"
+)
+
+// DefaultCacheTTL is the default duration for which fetched mute windows are cached.
+const DefaultCacheTTL = time.Minute
+
+// UpdateEvents are the names of the events published by the Identity Server when the mute windows of end devices or
+// applications may have changed.
+var UpdateEvents = []string{"end_device.update", "end_device.delete", "application.update", "application.delete"}
+
+// Fetcher fetches the mute windows of end devices and applications.
+type Fetcher interface {
+	EndDeviceMuteWindows(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.MuteWindow, error)
+	ApplicationMuteWindows(ctx context.Context, ids ttnpb.ApplicationIdentifiers) ([]*ttnpb.MuteWindow, error)
+}
+
+// Cluster is the cluster that the registry fetcher uses to reach the Entity Registry.
+type Cluster interface {
+	GetPeerConn(ctx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) (*grpc.ClientConn, error)
+	WithClusterAuth() grpc.CallOption
+}
+
+type registryFetcher struct {
+	cluster Cluster
+}
+
+// NewRegistryFetcher returns a Fetcher that fetches mute windows from the Entity Registry in the cluster.
+// End devices and applications that are not found in the Entity Registry have no mute windows.
+func NewRegistryFetcher(cluster Cluster) Fetcher {
+	return registryFetcher{cluster: cluster}
+}
+
+// EndDeviceMuteWindows implements Fetcher.
+func (f registryFetcher) EndDeviceMuteWindows(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) ([]*ttnpb.MuteWindow, error) {
+	cc, err := f.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	dev, err := ttnpb.NewEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIdentifiers: ids,
+		FieldMask:            pbtypes.FieldMask{Paths: []string{"mute_windows"}},
+	}, f.cluster.WithClusterAuth())
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dev.MuteWindows, nil
+}
+
+// ApplicationMuteWindows implements Fetcher.
+func (f registryFetcher) ApplicationMuteWindows(ctx context.Context, ids ttnpb.ApplicationIdentifiers) ([]*ttnpb.MuteWindow, error) {
+	cc, err := f.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	app, err := ttnpb.NewApplicationRegistryClient(cc).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"mute_windows"}},
+	}, f.cluster.WithClusterAuth())
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return app.MuteWindows, nil
+}
+
+type entry struct {
+	windows   []*ttnpb.MuteWindow
+	expiresAt time.Time
+}
+
+// Cache keeps the mute windows of end devices and applications in memory, so that the Entity Registry is not queried
+// for every message.
+type Cache struct {
+	fetcher Fetcher
+	ttl     time.Duration
+
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+// NewCache returns a new Cache that caches the mute windows fetched by fetcher for ttl.
+// If ttl is zero, DefaultCacheTTL is used.
+func NewCache(fetcher Fetcher, ttl time.Duration) *Cache {
+	if ttl == 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{
+		fetcher: fetcher,
+		ttl:     ttl,
+		entries: make(map[string]entry),
+	}
+}
+
+// get returns the cached mute windows by key, or fetches them when they are expired.
+// If the mute windows cannot be fetched, the last known mute windows are used.
+func (c *Cache) get(ctx context.Context, key string, now time.Time, fetch func() ([]*ttnpb.MuteWindow, error)) []*ttnpb.MuteWindow {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+	if ok && now.Before(e.expiresAt) {
+		return e.windows
+	}
+	windows, err := fetch()
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get mute windows")
+		windows = e.windows
+	}
+	c.mu.Lock()
+	c.entries[key] = entry{
+		windows:   windows,
+		expiresAt: now.Add(c.ttl),
+	}
+	c.mu.Unlock()
+	return windows
+}
+
+// Active returns the mute window of the end device that is active at the given time. The mute windows of the end
+// device and its application apply. If multiple mute windows are active, they are merged into one window that ends
+// when the last active window ends, and that drops messages if any of the active windows drops them.
+func (c *Cache) Active(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, t time.Time) (*ttnpb.MuteWindow, bool) {
+	if c == nil || c.fetcher == nil {
+		return nil, false
+	}
+	devWindows := c.get(ctx, unique.ID(ctx, ids), t, func() ([]*ttnpb.MuteWindow, error) {
+		return c.fetcher.EndDeviceMuteWindows(ctx, ids)
+	})
+	appWindows := c.get(ctx, unique.ID(ctx, ids.ApplicationIdentifiers), t, func() ([]*ttnpb.MuteWindow, error) {
+		return c.fetcher.ApplicationMuteWindows(ctx, ids.ApplicationIdentifiers)
+	})
+	return Merge(t, devWindows, appWindows)
+}
+
+// Merge returns the merged mute window of the windows that are active at the given time.
+func Merge(t time.Time, windows ...[]*ttnpb.MuteWindow) (*ttnpb.MuteWindow, bool) {
+	var active *ttnpb.MuteWindow
+	for _, ws := range windows {
+		for _, w := range ws {
+			if t.Before(w.StartAt) || !t.Before(w.EndAt) {
+				continue
+			}
+			if active == nil {
+				active = &ttnpb.MuteWindow{}
+				*active = *w
+				continue
+			}
+			if w.StartAt.Before(active.StartAt) {
+				active.StartAt = w.StartAt
+			}
+			if w.EndAt.After(active.EndAt) {
+				active.EndAt = w.EndAt
+			}
+			if w.QueuedDownlinks == ttnpb.MuteWindow_DROP {
+				active.QueuedDownlinks = ttnpb.MuteWindow_DROP
+			}
+			if w.Upstream == ttnpb.MuteWindow_DROP {
+				active.Upstream = ttnpb.MuteWindow_DROP
+			}
+		}
+	}
+	return active, active != nil
+}
+
+// HandleEvent removes the cached mute windows of the end devices and applications in the events of UpdateEvents.
+func (c *Cache) HandleEvent(evt events.Event) {
+	ctx := evt.Context()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, ids := range evt.Identifiers() {
+		if devIDs := ids.GetDeviceIDs(); devIDs != nil {
+			delete(c.entries, unique.ID(ctx, *devIDs))
+		} else if appIDs := ids.GetApplicationIDs(); appIDs != nil {
+			delete(c.entries, unique.ID(ctx, *appIDs))
+		}
+	}
+}
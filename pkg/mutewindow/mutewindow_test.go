@@ -0,0 +1,135 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutewindow_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	. "go.thethings.network/lorawan-stack/pkg/mutewindow"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+type mockFetcher struct {
+	devWindows, appWindows []*ttnpb.MuteWindow
+	devCalls, appCalls     int
+}
+
+func (f *mockFetcher) EndDeviceMuteWindows(context.Context, ttnpb.EndDeviceIdentifiers) ([]*ttnpb.MuteWindow, error) {
+	f.devCalls++
+	return f.devWindows, nil
+}
+
+func (f *mockFetcher) ApplicationMuteWindows(context.Context, ttnpb.ApplicationIdentifiers) ([]*ttnpb.MuteWindow, error) {
+	f.appCalls++
+	return f.appWindows, nil
+}
+
+func TestMerge(t *testing.T) {
+	start := time.Date(2020, time.August, 1, 12, 0, 0, 0, time.UTC)
+	keep := &ttnpb.MuteWindow{
+		StartAt: start,
+		EndAt:   start.Add(time.Hour),
+	}
+	drop := &ttnpb.MuteWindow{
+		StartAt:         start.Add(30 * time.Minute),
+		EndAt:           start.Add(2 * time.Hour),
+		QueuedDownlinks: ttnpb.MuteWindow_DROP,
+	}
+	for _, tc := range []struct {
+		Name     string
+		Time     time.Time
+		Expected *ttnpb.MuteWindow
+	}{
+		{
+			Name: "Before",
+			Time: start.Add(-time.Second),
+		},
+		{
+			Name:     "Start",
+			Time:     start,
+			Expected: keep,
+		},
+		{
+			Name: "Overlap",
+			Time: start.Add(45 * time.Minute),
+			Expected: &ttnpb.MuteWindow{
+				StartAt:         start,
+				EndAt:           start.Add(2 * time.Hour),
+				QueuedDownlinks: ttnpb.MuteWindow_DROP,
+			},
+		},
+		{
+			Name:     "Second",
+			Time:     start.Add(time.Hour),
+			Expected: drop,
+		},
+		{
+			Name: "End",
+			Time: start.Add(2 * time.Hour),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			w, ok := Merge(tc.Time, []*ttnpb.MuteWindow{keep}, []*ttnpb.MuteWindow{drop})
+			a.So(ok, should.Equal, tc.Expected != nil)
+			a.So(w, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	now := time.Now()
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"},
+		DeviceID:               "test-dev",
+	}
+	fetcher := &mockFetcher{
+		appWindows: []*ttnpb.MuteWindow{
+			{
+				StartAt:  now.Add(-time.Minute),
+				EndAt:    now.Add(time.Minute),
+				Upstream: ttnpb.MuteWindow_DROP,
+			},
+		},
+	}
+	cache := NewCache(fetcher, time.Hour)
+
+	w, ok := cache.Active(ctx, ids, now)
+	a.So(ok, should.BeTrue)
+	a.So(w, should.Resemble, fetcher.appWindows[0])
+
+	// The mute windows are cached.
+	fetcher.appWindows = nil
+	_, ok = cache.Active(ctx, ids, now)
+	a.So(ok, should.BeTrue)
+	a.So(fetcher.devCalls, should.Equal, 1)
+	a.So(fetcher.appCalls, should.Equal, 1)
+
+	// Updates of the application remove the cached mute windows.
+	cache.HandleEvent(events.New(ctx, "application.update", ids.ApplicationIdentifiers, nil))
+	_, ok = cache.Active(ctx, ids, now)
+	a.So(ok, should.BeFalse)
+	a.So(fetcher.devCalls, should.Equal, 1)
+	a.So(fetcher.appCalls, should.Equal, 2)
+}
@@ -41,6 +41,27 @@ func (acc *accumulator) Reset() {
 
 type metadataAccumulator struct {
 	accumulator
+
+	mu        sync.RWMutex
+	mergedIDs *ttnpb.EndDeviceIdentifiers
+}
+
+// Merged marks the accumulated metadata as merged into the uplink message of the device identified by ids.
+// Metadata added after Merged is late.
+func (acc *metadataAccumulator) Merged(ids ttnpb.EndDeviceIdentifiers) {
+	acc.mu.Lock()
+	acc.mergedIDs = &ids
+	acc.mu.Unlock()
+}
+
+// MergedIdentifiers returns the identifiers of the device passed to Merged, if the metadata is merged.
+func (acc *metadataAccumulator) MergedIdentifiers() (ttnpb.EndDeviceIdentifiers, bool) {
+	acc.mu.RLock()
+	defer acc.mu.RUnlock()
+	if acc.mergedIDs == nil {
+		return ttnpb.EndDeviceIdentifiers{}, false
+	}
+	return *acc.mergedIDs, true
 }
 
 func (acc *metadataAccumulator) Accumulated() (md []*ttnpb.RxMetadata) {
@@ -15,6 +15,7 @@
 package networkserver
 
 import (
+	"go.thethings.network/lorawan-stack/pkg/band"
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
@@ -85,6 +86,52 @@ func deviceADRMargin(dev *ttnpb.EndDevice, defaults ttnpb.MACSettings) float32 {
 	return DefaultADRMargin
 }
 
+// deviceADRDataRateIndexRange returns the range of data rate indexes that ADR may use for the device.
+// The range is limited to the data rates supported by the desired enabled uplink channels of the device.
+func deviceADRDataRateIndexRange(dev *ttnpb.EndDevice, phy band.Band) (ttnpb.DataRateIndex, ttnpb.DataRateIndex) {
+	min, max := ttnpb.DataRateIndex(0), ttnpb.DataRateIndex(phy.MaxADRDataRateIndex)
+	if chs := dev.GetMACState().GetDesiredParameters().Channels; len(chs) > 0 {
+		chMin, chMax := max, ttnpb.DataRateIndex(0)
+		var enabled bool
+		for _, ch := range chs {
+			if !ch.GetEnableUplink() {
+				continue
+			}
+			enabled = true
+			if ch.MinDataRateIndex < chMin {
+				chMin = ch.MinDataRateIndex
+			}
+			if ch.MaxDataRateIndex > chMax {
+				chMax = ch.MaxDataRateIndex
+			}
+		}
+		if enabled {
+			if chMin > min {
+				min = chMin
+			}
+			if chMax < max {
+				max = chMax
+			}
+		}
+	}
+	if v := dev.GetMACSettings().GetADRMaxDataRateIndex(); v != nil && v.Value < max {
+		max = v.Value
+	}
+	if v := dev.GetMACSettings().GetADRMinDataRateIndex(); v != nil {
+		min = v.Value
+	}
+	return min, max
+}
+
+// deviceADRMaxTxPowerIndex returns the maximum TX power index that ADR may use for the device.
+func deviceADRMaxTxPowerIndex(dev *ttnpb.EndDevice, phy band.Band) uint32 {
+	max := uint32(phy.MaxTxPowerIndex)
+	if v := dev.GetMACSettings().GetADRMaxTxPowerIndex(); v != nil && v.Value < max {
+		max = v.Value
+	}
+	return max
+}
+
 func lossRate(nbTrans uint32, ups ...*ttnpb.UplinkMessage) float32 {
 	if len(ups) < 2 {
 		return 0
@@ -132,7 +179,9 @@ loop:
 	return float32(lost) / float32(n)
 }
 
-func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MACSettings) error {
+// adaptDataRate sets the desired ADR parameters of the device based on its recent ADR uplinks.
+// The marginOffset is added to the ADR margin of the device.
+func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttnpb.MACSettings, marginOffset float32) error {
 	ups := dev.RecentADRUplinks
 	if len(ups) == 0 {
 		return nil
@@ -171,14 +220,27 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttn
 	// minimum (floor) that we need to demodulate the signal. We subtract a
 	// configurable margin, and an extra safety margin if we're afraid that we
 	// don't have enough data for our decision.
-	margin := maxSNR - df - deviceADRMargin(dev, defaults)
+	margin := maxSNR - df - deviceADRMargin(dev, defaults) - marginOffset
 	if len(ups) < optimalADRUplinkCount {
 		margin -= safetyMargin
 	}
 
+	// The data rate is kept within the bounds of the device, regardless of the margin.
+	minDataRateIndex, maxDataRateIndex := deviceADRDataRateIndexRange(dev, phy)
+	for dev.MACState.DesiredParameters.ADRDataRateIndex < minDataRateIndex {
+		margin -= drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex++
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	}
+	for dev.MACState.DesiredParameters.ADRDataRateIndex > maxDataRateIndex {
+		margin += drStep
+		dev.MACState.DesiredParameters.ADRDataRateIndex--
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+	}
+
 	// As long as we have enough margin to increase the data rate, we do that.
 	// If we change the DR, we reset the Tx power.
-	for int(dev.MACState.DesiredParameters.ADRDataRateIndex) < int(phy.MaxADRDataRateIndex) {
+	for dev.MACState.DesiredParameters.ADRDataRateIndex < maxDataRateIndex {
 		newMargin := margin - drStep
 		if newMargin < 0 {
 			break
@@ -189,7 +251,11 @@ func adaptDataRate(dev *ttnpb.EndDevice, fps *frequencyplans.Store, defaults ttn
 	}
 
 	// If we still have margin left, we decrease the Tx power (increase the index).
-	for int(dev.MACState.DesiredParameters.ADRTxPowerIndex) < int(phy.MaxTxPowerIndex) {
+	maxTxPowerIndex := deviceADRMaxTxPowerIndex(dev, phy)
+	if dev.MACState.DesiredParameters.ADRTxPowerIndex > maxTxPowerIndex {
+		dev.MACState.DesiredParameters.ADRTxPowerIndex = maxTxPowerIndex
+	}
+	for dev.MACState.DesiredParameters.ADRTxPowerIndex < maxTxPowerIndex {
 		newMargin := margin - (phy.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex] - phy.TxOffset[dev.MACState.DesiredParameters.ADRTxPowerIndex+1])
 		if newMargin < 0 {
 			break
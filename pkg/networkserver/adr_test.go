@@ -229,6 +229,62 @@ func TestLossRate(t *testing.T) {
 	}
 }
 
+// newSemtechADRDevice returns the device of the adapted example from the Semtech paper with the given MAC settings.
+func newSemtechADRDevice(macSettings *ttnpb.MACSettings) *ttnpb.EndDevice {
+	return &ttnpb.EndDevice{
+		LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+		MACState: &ttnpb.MACState{
+			CurrentParameters: ttnpb.MACParameters{
+				ADRDataRateIndex: 0,
+				ADRNbTrans:       0,
+				ADRTxPowerIndex:  1,
+			},
+			DesiredParameters: ttnpb.MACParameters{
+				ADRDataRateIndex: 5,
+				ADRNbTrans:       3,
+				ADRTxPowerIndex:  2,
+			},
+		},
+		MACSettings:     macSettings,
+		FrequencyPlanID: test.EUFrequencyPlanID,
+		RecentADRUplinks: adrMatrixToUplinks([]adrMatrixRow{
+			{FCnt: 10, MaxSNR: -6, GtwDiversity: 2},
+			{FCnt: 11, MaxSNR: -7, GtwDiversity: 2},
+			{FCnt: 12, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 13, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 14, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 16, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 17, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 19, MaxSNR: -10, GtwDiversity: 3},
+			{FCnt: 20, MaxSNR: -6, GtwDiversity: 2},
+			{FCnt: 21, MaxSNR: -7, GtwDiversity: 2},
+			{FCnt: 22, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 23, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 24, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 25, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 26, MaxSNR: -25, GtwDiversity: 1},
+			{FCnt: 27, MaxSNR: -8, GtwDiversity: 2},
+			{FCnt: 28, MaxSNR: -10, GtwDiversity: 2},
+			{FCnt: 29, MaxSNR: -10, GtwDiversity: 3},
+			{FCnt: 30, MaxSNR: -9, GtwDiversity: 3},
+			{
+				FCnt: 31, MaxSNR: -7, GtwDiversity: 2,
+				TxSettings: ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{
+							LoRa: &ttnpb.LoRaDataRate{
+								SpreadingFactor: 12,
+								Bandwidth:       125000,
+							},
+						},
+					},
+					DataRateIndex: 0,
+				},
+			},
+		}),
+	}
+}
+
 func TestAdaptDataRate(t *testing.T) {
 	for _, tc := range []struct {
 		Name       string
@@ -300,13 +356,96 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MACState.DesiredParameters.ADRTxPowerIndex = 1
 			},
 		},
+		{
+			Name: "maximum data rate index",
+			Device: newSemtechADRDevice(&ttnpb.MACSettings{
+				ADRMargin: &pbtypes.FloatValue{
+					Value: 2,
+				},
+				ADRMaxDataRateIndex: &ttnpb.DataRateIndexValue{
+					Value: ttnpb.DATA_RATE_3,
+				},
+			}),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 3
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 2
+			},
+		},
+		{
+			Name: "maximum data rate index of enabled channels",
+			Device: func() *ttnpb.EndDevice {
+				dev := newSemtechADRDevice(&ttnpb.MACSettings{
+					ADRMargin: &pbtypes.FloatValue{
+						Value: 2,
+					},
+				})
+				dev.MACState.DesiredParameters.Channels = []*ttnpb.MACParameters_Channel{
+					{
+						UplinkFrequency:  868100000,
+						MinDataRateIndex: ttnpb.DATA_RATE_0,
+						MaxDataRateIndex: ttnpb.DATA_RATE_3,
+						EnableUplink:     true,
+					},
+					{
+						UplinkFrequency:  868300000,
+						MinDataRateIndex: ttnpb.DATA_RATE_0,
+						MaxDataRateIndex: ttnpb.DATA_RATE_2,
+						EnableUplink:     true,
+					},
+					{
+						UplinkFrequency:  868500000,
+						MinDataRateIndex: ttnpb.DATA_RATE_0,
+						MaxDataRateIndex: ttnpb.DATA_RATE_5,
+					},
+				}
+				return dev
+			}(),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 3
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 2
+			},
+		},
+		{
+			Name: "minimum data rate index",
+			Device: newSemtechADRDevice(&ttnpb.MACSettings{
+				ADRMargin: &pbtypes.FloatValue{
+					Value: 2,
+				},
+				ADRMinDataRateIndex: &ttnpb.DataRateIndexValue{
+					Value: ttnpb.DATA_RATE_5,
+				},
+			}),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 5
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			},
+		},
+		{
+			Name: "maximum TX power index",
+			Device: newSemtechADRDevice(&ttnpb.MACSettings{
+				ADRMargin: &pbtypes.FloatValue{
+					Value: 2,
+				},
+				ADRMaxTxPowerIndex: &pbtypes.UInt32Value{
+					Value: 0,
+				},
+			}),
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MACState.DesiredParameters.ADRDataRateIndex = 4
+				dev.MACState.DesiredParameters.ADRNbTrans = 1
+				dev.MACState.DesiredParameters.ADRTxPowerIndex = 0
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
 			dev := CopyEndDevice(tc.Device)
 
-			err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), ttnpb.MACSettings{})
+			err := adaptDataRate(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher), ttnpb.MACSettings{}, 0)
 			if err != nil && !a.So(err, should.Equal, tc.Error) ||
 				err == nil && !a.So(err, should.BeNil) {
 				t.FailNow()
@@ -0,0 +1,184 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"fmt"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+const currentParametersPath = "mac_state.current_parameters"
+
+func dataRateDefined(phy band.Band, idx ttnpb.DataRateIndex) bool {
+	return int(idx) < len(phy.DataRates) && phy.DataRates[idx].Rate.Modulation != nil
+}
+
+// downlinkDataRateDefined returns whether the data rate is defined and can be used for downlink.
+// LR-FHSS data rates are uplink only.
+func downlinkDataRateDefined(phy band.Band, idx ttnpb.DataRateIndex) bool {
+	return dataRateDefined(phy, idx) && phy.DataRates[idx].Rate.GetLRFHSS() == nil
+}
+
+func frequencyInBand(phy band.Band, freq uint64) bool {
+	_, ok := phy.FindSubBand(freq)
+	return ok
+}
+
+// checkCompliance returns the violations of the regional parameters of the given band in the given MAC parameters.
+// The frequency plan limits are taken into account where they are stricter than the band's.
+func checkCompliance(params ttnpb.MACParameters, fp *frequencyplans.FrequencyPlan, phy band.Band) []*ttnpb.RegionalParametersViolation {
+	var violations []*ttnpb.RegionalParametersViolation
+	violate := func(field, remediation, format string, args ...interface{}) {
+		violations = append(violations, &ttnpb.RegionalParametersViolation{
+			Field:       fmt.Sprintf("%s.%s", currentParametersPath, field),
+			Description: fmt.Sprintf(format, args...),
+			Remediation: remediation,
+		})
+	}
+
+	if len(params.Channels) > int(phy.MaxUplinkChannels) {
+		violate("channels", "Reset the MAC state to restore the default channels of the band",
+			"%d channels are configured, but band `%s` allows at most %d", len(params.Channels), phy.ID, phy.MaxUplinkChannels)
+	}
+	for i, ch := range params.Channels {
+		if ch == nil {
+			continue
+		}
+		if ch.UplinkFrequency != 0 && !frequencyInBand(phy, ch.UplinkFrequency) {
+			violate(fmt.Sprintf("channels[%d].uplink_frequency", i), "Remove the channel or reset the MAC state",
+				"uplink frequency %d Hz is outside the sub-bands of band `%s`", ch.UplinkFrequency, phy.ID)
+		}
+		if ch.DownlinkFrequency != 0 && !frequencyInBand(phy, ch.DownlinkFrequency) {
+			violate(fmt.Sprintf("channels[%d].downlink_frequency", i), "Remove the Rx1 frequency of the channel or reset the MAC state",
+				"downlink frequency %d Hz is outside the sub-bands of band `%s`", ch.DownlinkFrequency, phy.ID)
+		}
+		if !dataRateDefined(phy, ch.MinDataRateIndex) {
+			violate(fmt.Sprintf("channels[%d].min_data_rate_index", i), "Set a data rate index that is defined in the band",
+				"data rate %d is not defined in band `%s`", ch.MinDataRateIndex, phy.ID)
+		}
+		if !dataRateDefined(phy, ch.MaxDataRateIndex) {
+			violate(fmt.Sprintf("channels[%d].max_data_rate_index", i), "Set a data rate index that is defined in the band",
+				"data rate %d is not defined in band `%s`", ch.MaxDataRateIndex, phy.ID)
+		}
+		if ch.MinDataRateIndex > ch.MaxDataRateIndex {
+			violate(fmt.Sprintf("channels[%d].min_data_rate_index", i), "Set a minimum data rate index that does not exceed the maximum data rate index",
+				"minimum data rate %d exceeds maximum data rate %d", ch.MinDataRateIndex, ch.MaxDataRateIndex)
+		}
+	}
+
+	if !downlinkDataRateDefined(phy, params.Rx2DataRateIndex) {
+		violate("rx2_data_rate_index", fmt.Sprintf("Set the Rx2 data rate index to the band default %d", phy.DefaultRx2Parameters.DataRateIndex),
+			"data rate %d is not defined for downlink in band `%s`", params.Rx2DataRateIndex, phy.ID)
+	}
+	if !frequencyInBand(phy, params.Rx2Frequency) {
+		violate("rx2_frequency", fmt.Sprintf("Set the Rx2 frequency to the band default %d Hz", phy.DefaultRx2Parameters.Frequency),
+			"Rx2 frequency %d Hz is outside the sub-bands of band `%s`", params.Rx2Frequency, phy.ID)
+	}
+	if params.PingSlotFrequency != 0 && !frequencyInBand(phy, params.PingSlotFrequency) {
+		violate("ping_slot_frequency", "Unset the ping slot frequency to use the default of the band",
+			"ping slot frequency %d Hz is outside the sub-bands of band `%s`", params.PingSlotFrequency, phy.ID)
+	}
+	if params.BeaconFrequency != 0 && !frequencyInBand(phy, params.BeaconFrequency) {
+		violate("beacon_frequency", "Unset the beacon frequency to use the default of the band",
+			"beacon frequency %d Hz is outside the sub-bands of band `%s`", params.BeaconFrequency, phy.ID)
+	}
+
+	if params.ADRDataRateIndex > ttnpb.DataRateIndex(phy.MaxADRDataRateIndex) || !dataRateDefined(phy, params.ADRDataRateIndex) {
+		violate("adr_data_rate_index", fmt.Sprintf("Set an ADR data rate index of at most %d", phy.MaxADRDataRateIndex),
+			"ADR data rate %d is not allowed in band `%s`", params.ADRDataRateIndex, phy.ID)
+	} else if _, err := phy.Rx1DataRate(params.ADRDataRateIndex, params.Rx1DataRateOffset, params.DownlinkDwellTime.GetValue()); err != nil {
+		violate("rx1_data_rate_offset", "Set the Rx1 data rate offset to 0",
+			"Rx1 data rate offset %d is not allowed in band `%s`", params.Rx1DataRateOffset, phy.ID)
+	}
+	if params.ADRTxPowerIndex > uint32(phy.MaxTxPowerIndex) {
+		violate("adr_tx_power_index", fmt.Sprintf("Set an ADR transmission power index of at most %d", phy.MaxTxPowerIndex),
+			"ADR transmission power index %d is not allowed in band `%s`", params.ADRTxPowerIndex, phy.ID)
+	}
+
+	maxEIRP := phy.DefaultMaxEIRP
+	if fp != nil && fp.MaxEIRP != nil && *fp.MaxEIRP < maxEIRP {
+		maxEIRP = *fp.MaxEIRP
+	}
+	if params.MaxEIRP > maxEIRP {
+		violate("max_eirp", fmt.Sprintf("Set the maximum EIRP to at most %.2f dBm", maxEIRP),
+			"maximum EIRP %.2f dBm exceeds the limit of %.2f dBm", params.MaxEIRP, maxEIRP)
+	}
+
+	var uplinkDwellTime, downlinkDwellTime *bool
+	if fp != nil {
+		uplinkDwellTime, downlinkDwellTime = fp.DwellTime.Uplinks, fp.DwellTime.Downlinks
+	}
+	for _, dwellTime := range []struct {
+		field    string
+		current  *pbtypes.BoolValue
+		required *bool
+	}{
+		{
+			field:    "uplink_dwell_time",
+			current:  params.UplinkDwellTime,
+			required: uplinkDwellTime,
+		},
+		{
+			field:    "downlink_dwell_time",
+			current:  params.DownlinkDwellTime,
+			required: downlinkDwellTime,
+		},
+	} {
+		switch {
+		case dwellTime.current == nil:
+		case !phy.TxParamSetupReqSupport && dwellTime.current.Value:
+			violate(dwellTime.field, "Unset the dwell time, as the band does not support TxParamSetupReq",
+				"dwell time is set, but band `%s` does not support configuring it", phy.ID)
+		case phy.TxParamSetupReqSupport && dwellTime.required != nil && *dwellTime.required && !dwellTime.current.Value:
+			violate(dwellTime.field, "Enable the dwell time, as required by the frequency plan",
+				"dwell time is disabled, but the frequency plan requires it")
+		}
+	}
+	return violations
+}
+
+// GetComplianceReport implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetComplianceReport(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*ttnpb.RegionalParametersComplianceReport, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"mac_state",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if dev.MACState == nil {
+		return nil, errUnknownMACState
+	}
+	fp, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.RegionalParametersComplianceReport{
+		FrequencyPlanID:   dev.FrequencyPlanID,
+		BandID:            phy.ID,
+		LoRaWANPHYVersion: dev.LoRaWANPHYVersion,
+		Violations:        checkCompliance(dev.MACState.CurrentParameters, fp, phy),
+	}, nil
+}
@@ -0,0 +1,152 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestCheckCompliance(t *testing.T) {
+	eu, err := band.GetByID(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to get band: %s", err)
+	}
+	eu, err = eu.Version(ttnpb.PHY_V1_0_2_REV_B)
+	if err != nil {
+		t.Fatalf("Failed to get band version: %s", err)
+	}
+
+	makeParams := func() ttnpb.MACParameters {
+		params := ttnpb.MACParameters{
+			MaxEIRP:          eu.DefaultMaxEIRP,
+			ADRDataRateIndex: ttnpb.DATA_RATE_5,
+			ADRTxPowerIndex:  1,
+			Rx2DataRateIndex: eu.DefaultRx2Parameters.DataRateIndex,
+			Rx2Frequency:     eu.DefaultRx2Parameters.Frequency,
+		}
+		for _, ch := range eu.UplinkChannels {
+			params.Channels = append(params.Channels, &ttnpb.MACParameters_Channel{
+				UplinkFrequency:   ch.Frequency,
+				DownlinkFrequency: ch.Frequency,
+				MinDataRateIndex:  ch.MinDataRate,
+				MaxDataRateIndex:  ch.MaxDataRate,
+				EnableUplink:      true,
+			})
+		}
+		return params
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ParamsFunc     func(*ttnpb.MACParameters)
+		ExpectedFields []string
+	}{
+		{
+			Name:       "Compliant",
+			ParamsFunc: func(*ttnpb.MACParameters) {},
+		},
+		{
+			Name: "US915 channel",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.Channels = append(params.Channels, &ttnpb.MACParameters_Channel{
+					UplinkFrequency:  902300000,
+					MinDataRateIndex: ttnpb.DATA_RATE_0,
+					MaxDataRateIndex: ttnpb.DATA_RATE_3,
+				})
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.channels[3].uplink_frequency",
+			},
+		},
+		{
+			Name: "Rx2",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.Rx2DataRateIndex = ttnpb.DATA_RATE_15
+				params.Rx2Frequency = 923200000
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.rx2_data_rate_index",
+				"mac_state.current_parameters.rx2_frequency",
+			},
+		},
+		{
+			Name: "Rx2 LR-FHSS",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.Rx2DataRateIndex = ttnpb.DATA_RATE_8
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.rx2_data_rate_index",
+			},
+		},
+		{
+			Name: "ADR",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.ADRDataRateIndex = ttnpb.DATA_RATE_7
+				params.ADRTxPowerIndex = 15
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.adr_data_rate_index",
+				"mac_state.current_parameters.adr_tx_power_index",
+			},
+		},
+		{
+			Name: "Rx1 data rate offset",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.Rx1DataRateOffset = 7
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.rx1_data_rate_offset",
+			},
+		},
+		{
+			Name: "Max EIRP",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.MaxEIRP = 30
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.max_eirp",
+			},
+		},
+		{
+			Name: "Dwell time",
+			ParamsFunc: func(params *ttnpb.MACParameters) {
+				params.UplinkDwellTime = &pbtypes.BoolValue{Value: true}
+				params.DownlinkDwellTime = &pbtypes.BoolValue{Value: false}
+			},
+			ExpectedFields: []string{
+				"mac_state.current_parameters.uplink_dwell_time",
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			params := makeParams()
+			tc.ParamsFunc(&params)
+			var fields []string
+			for _, v := range checkCompliance(params, nil, eu) {
+				a.So(v.Description, should.NotBeEmpty)
+				a.So(v.Remediation, should.NotBeEmpty)
+				fields = append(fields, v.Field)
+			}
+			a.So(fields, should.Resemble, tc.ExpectedFields)
+		})
+	}
+}
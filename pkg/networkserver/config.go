@@ -15,6 +15,8 @@
 package networkserver
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"go.thethings.network/lorawan-stack/pkg/config"
@@ -25,17 +27,184 @@ import (
 
 // Config represents the NetworkServer configuration.
 type Config struct {
-	ApplicationUplinks  ApplicationUplinkQueue `name:"-"`
-	Devices             DeviceRegistry         `name:"-"`
-	DownlinkTasks       DownlinkTaskQueue      `name:"-"`
-	NetID               types.NetID            `name:"net-id" description:"NetID of this Network Server"`
-	DevAddrPrefixes     []types.DevAddrPrefix  `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
-	DeduplicationWindow time.Duration          `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
-	CooldownWindow      time.Duration          `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
-	DownlinkPriorities  DownlinkPriorityConfig `name:"downlink-priorities" description:"Downlink message priorities"`
-	DefaultMACSettings  MACSettingConfig       `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
-	Interop             config.InteropClient   `name:"interop" description:"Interop client configuration"`
-	DeviceKEKLabel      string                 `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	ApplicationUplinks         ApplicationUplinkQueue     `name:"-"`
+	Devices                    DeviceRegistry             `name:"-"`
+	DownlinkTasks              DownlinkTaskQueue          `name:"-"`
+	EmergencyBroadcasts        EmergencyBroadcastRegistry `name:"-"`
+	NetID                      types.NetID                `name:"net-id" description:"NetID of this Network Server"`
+	DevAddrPrefixes            []types.DevAddrPrefix      `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	ApplicationDevAddrPrefixes []ApplicationDevAddrPrefix `name:"application-dev-addr-prefixes" description:"Device address prefixes of end devices of applications, as application-id=prefix"`
+	DeduplicationWindow        time.Duration              `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
+	CooldownWindow             time.Duration              `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
+	LateUplinkGrace            time.Duration              `name:"late-uplink-grace" description:"Time after the cooldown window during which the metadata of duplicate messages is published as late metadata (0 is disabled)"`
+	BandDeduplicationWindows   []BandWindow               `name:"band-deduplication-windows" description:"Deduplication windows of bands that override the deduplication window, as band-id=duration"`
+	BandCooldownWindows        []BandWindow               `name:"band-cooldown-windows" description:"Cooldown windows of bands that override the cooldown window, as band-id=duration"`
+	RecentUplinkCount          int                        `name:"recent-uplink-count" description:"Maximum amount of recent uplink messages stored per end device"`
+	RecentDownlinkCount        int                        `name:"recent-downlink-count" description:"Maximum amount of recent downlink messages stored per end device"`
+	MACTraceCount              int                        `name:"mac-trace-count" description:"Maximum amount of MAC trace entries of data messages stored per end device (0 is disabled)"`
+	FCntResetGrace             uint32                     `name:"f-cnt-reset-grace" description:"Maximum FCnt of uplink messages that are accepted as frame counter reset of end devices that reset frame counters (0 is unlimited)"`
+	DownlinkPriorities         DownlinkPriorityConfig     `name:"downlink-priorities" description:"Downlink message priorities"`
+	DefaultMACSettings         MACSettingConfig           `name:"default-mac-settings" description:"Default MAC settings to fallback to if not specified by device, band or frequency plan"`
+	Interop                    config.InteropClient       `name:"interop" description:"Interop client configuration"`
+	DeviceKEKLabel             string                     `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
+	UplinkMirror               UplinkMirrorConfig         `name:"uplink-mirror" description:"Mirroring of uplink messages to another Network Server"`
+	FrequencyPlanRoaming       FrequencyPlanRoamingConfig `name:"frequency-plan-roaming" description:"Handling of end devices that roam to gateways of a compatible frequency plan"`
+	PacketLogger               PacketLoggerConfig         `name:"packet-logger" description:"Recording of uplink messages of unprovisioned devices for a packet logger application"`
+	FairUse                    FairUseConfig              `name:"fair-use" description:"Enforcement of a maximum uplink airtime per end device"`
+	MuteWindows                MuteWindowsConfig          `name:"mute-windows" description:"Suppression of downlink messages during mute windows of end devices and applications"`
+	DownlinkPathScoring        DownlinkPathScoringConfig  `name:"downlink-path-scoring" description:"Selection of the gateway to send downlink messages through"`
+	JoinAccept                 JoinAcceptConfig           `name:"join-accept" description:"Handling of join-requests by the Join Server and scheduling of join-accept messages"`
+}
+
+// JoinAcceptConfig defines the handling of join-requests by the Join Server and the scheduling of join-accept messages.
+// Join Servers with a high latency, for example interop Join Servers, may answer too late for the Rx1 window of the
+// join-accept message. If the join-request was received longer than Rx2Threshold ago, the join-accept message is only
+// scheduled in Rx2, so that the gateway does not reject the downlink message as too late.
+type JoinAcceptConfig struct {
+	JoinServerTimeout time.Duration `name:"join-server-timeout" description:"Timeout of handling a join-request by the Join Server (0 is no timeout)"`
+	Rx2Threshold      time.Duration `name:"rx2-threshold" description:"Time after receiving a join-request after which the join-accept message is only scheduled in Rx2 (0 is disabled)"`
+}
+
+var errApplicationDevAddrPrefix = errors.DefineInvalidArgument("application_dev_addr_prefix", "invalid application DevAddr prefix `{value}`, expected `application-id=prefix`")
+
+// ApplicationDevAddrPrefix is a device address prefix from which the Network Server allocates the device addresses of
+// the end devices of an application. It is configured as application-id=prefix, for example app1=26011000/20.
+// End devices of applications without device address prefixes get a device address of the Network Server prefixes.
+type ApplicationDevAddrPrefix struct {
+	ApplicationID string
+	DevAddrPrefix types.DevAddrPrefix
+}
+
+// UnmarshalConfigString implements the config.Configurable interface.
+func (p *ApplicationDevAddrPrefix) UnmarshalConfigString(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return errApplicationDevAddrPrefix.WithAttributes("value", s)
+	}
+	var prefix types.DevAddrPrefix
+	if err := prefix.UnmarshalConfigString(s[i+1:]); err != nil {
+		return errApplicationDevAddrPrefix.WithAttributes("value", s).WithCause(err)
+	}
+	p.ApplicationID, p.DevAddrPrefix = s[:i], prefix
+	return nil
+}
+
+// ConfigString implements the config.Stringer interface.
+func (p ApplicationDevAddrPrefix) ConfigString() string {
+	return p.ApplicationID + "=" + p.DevAddrPrefix.ConfigString()
+}
+
+// DownlinkPathScoringConfig defines the selection of the gateway that the Network Server sends a downlink message
+// through, out of the gateways that received the last uplink message of the end device. The paths are tried in order of
+// descending score, where the score is the weighted sum of the SNR and the RSSI of the uplink message, plus the
+// preference of the gateway, minus the RTT penalty per millisecond of median round-trip time of the gateway connection.
+// Gateways that prefer other paths are always tried last.
+// If both weights are zero, the SNR has weight 1.
+// If the age penalty is set, class B and C downlink considers the gateways that received any of the recent uplink
+// messages, where the age penalty per second since the uplink message was received is subtracted from the score.
+type DownlinkPathScoringConfig struct {
+	SNRWeight          float32             `name:"snr-weight" description:"Weight of the SNR (dB) of the uplink message in the score"`
+	RSSIWeight         float32             `name:"rssi-weight" description:"Weight of the RSSI (dBm) of the uplink message in the score"`
+	RTTPenalty         float32             `name:"rtt-penalty" description:"Penalty per millisecond of median round-trip time of the gateway connection subtracted from the score"`
+	AgePenalty         float32             `name:"age-penalty" description:"Penalty per second of age of the uplink message subtracted from the score. If set, class B and C downlink considers the gateways of all recent uplink messages"`
+	GatewayPreferences []GatewayPreference `name:"gateway-preferences" description:"Preference of gateways added to the score, as gateway-id=preference. Negative values steer downlink away from the gateway"`
+}
+
+var errGatewayPreference = errors.DefineInvalidArgument("gateway_preference", "invalid gateway preference `{value}`, expected `gateway-id=preference`")
+
+// GatewayPreference is the preference of a gateway that is added to the score of downlink paths through the gateway.
+// It is configured as gateway-id=preference, for example gtw1=-10.
+type GatewayPreference struct {
+	GatewayID  string
+	Preference float32
+}
+
+// UnmarshalConfigString implements the config.Configurable interface.
+func (p *GatewayPreference) UnmarshalConfigString(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return errGatewayPreference.WithAttributes("value", s)
+	}
+	preference, err := strconv.ParseFloat(s[i+1:], 32)
+	if err != nil {
+		return errGatewayPreference.WithAttributes("value", s).WithCause(err)
+	}
+	p.GatewayID, p.Preference = s[:i], float32(preference)
+	return nil
+}
+
+// ConfigString implements the config.Stringer interface.
+func (p GatewayPreference) ConfigString() string {
+	return p.GatewayID + "=" + strconv.FormatFloat(float64(p.Preference), 'f', -1, 32)
+}
+
+// MuteWindowsConfig defines the caching of the mute windows of end devices and applications. During a mute window, the
+// Network Server does not send downlink messages to the end device. Depending on the policy of the mute window, the
+// queued application downlink messages are kept until the mute window ends, or dropped.
+type MuteWindowsConfig struct {
+	Enable   bool          `name:"enable" description:"Suppress downlink messages during mute windows"`
+	CacheTTL time.Duration `name:"cache-ttl" description:"Time to cache the mute windows of end devices and applications"`
+}
+
+// FairUseConfig defines the enforcement of a maximum uplink airtime per end device, for example for a community fair
+// use policy. The airtime of the data uplink messages of each end device is accumulated per window in the registry,
+// which is shared by all Network Server instances. End devices that exceed the limit are published as event once per
+// window. If configured, these end devices are not sent application downlink messages and use a different ADR margin
+// until the window ends.
+type FairUseConfig struct {
+	Registry        FairUseRegistry `name:"-"`
+	UplinkAirtime   time.Duration   `name:"uplink-airtime" description:"Maximum uplink airtime per end device per window (0 is disabled)"`
+	Window          time.Duration   `name:"window" description:"Window in which the uplink airtime of an end device is accumulated"`
+	StopDownlink    bool            `name:"stop-downlink" description:"Do not send application downlink messages to end devices that exceed the limit"`
+	ADRMarginOffset float32         `name:"adr-margin-offset" description:"Offset (dB) of the ADR margin of end devices that exceed the limit. Positive values make ADR less aggressive"`
+}
+
+// IsZero returns whether the fair use policy is not configured.
+func (c FairUseConfig) IsZero() bool {
+	return c.UplinkAirtime == 0
+}
+
+// PacketLoggerConfig defines the recording of uplink messages of unprovisioned devices, for example for spectrum
+// monitoring and detection of rogue devices. Data uplink messages that match a DevAddr prefix but no device in the
+// registry are published as events of the application, without payload. The application must be marked as packet
+// logger by an admin, and only the metadata of gateways that use a frequency plan of one of the bands in which
+// recording is legally allowed is recorded.
+type PacketLoggerConfig struct {
+	ApplicationID   string                `name:"application-id" description:"ID of the packet logger application"`
+	DevAddrPrefixes []types.DevAddrPrefix `name:"dev-addr-prefixes" description:"Device address prefixes of the unprovisioned devices to record"`
+	Bands           []string              `name:"bands" description:"IDs of the bands in which recording uplink messages of unprovisioned devices is legally allowed"`
+	CacheTTL        time.Duration         `name:"cache-ttl" description:"Time to cache whether the application is a packet logger application"`
+}
+
+// IsZero returns whether the packet logger is not configured.
+func (c PacketLoggerConfig) IsZero() bool {
+	return c.ApplicationID == ""
+}
+
+// FrequencyPlanRoamingConfig defines the handling of end devices of which the uplink messages are only received by
+// gateways that use a different frequency plan of the same band, for example one of the AS923 variants.
+// Roaming is always detected and published as event. If Reprovision is set, the end device is switched to the frequency
+// plan of the gateways and the channels are provisioned with MAC commands, so that downlink remains possible.
+type FrequencyPlanRoamingConfig struct {
+	Reprovision bool `name:"reprovision" description:"Switch roaming end devices to the frequency plan of the gateways and provision the channels with MAC commands"`
+}
+
+// UplinkMirrorConfig defines the mirroring of uplink messages to another Network Server, for example of a test cluster.
+// Data uplink messages that match a DevAddr prefix or an application ID are forwarded after deduplication.
+// Responses of the other Network Server are ignored, so the mirror does not affect the handling of the uplink messages.
+type UplinkMirrorConfig struct {
+	Address         string                `name:"address" description:"Address of the Network Server to mirror uplink messages to"`
+	TLS             bool                  `name:"tls" description:"Connect to the Network Server with TLS"`
+	Key             string                `name:"key" description:"Hex encoded cluster key to authenticate with the Network Server"`
+	DevAddrPrefixes []types.DevAddrPrefix `name:"dev-addr-prefixes" description:"Device address prefixes of the uplink messages to mirror"`
+	ApplicationIDs  []string              `name:"application-ids" description:"Application IDs of the uplink messages to mirror"`
+	QueueSize       int                   `name:"queue-size" description:"Number of uplink messages to queue for mirroring"`
+	Timeout         time.Duration         `name:"timeout" description:"Timeout of mirroring an uplink message"`
+}
+
+// IsZero returns whether the uplink mirror is not configured.
+func (c UplinkMirrorConfig) IsZero() bool {
+	return c.Address == ""
 }
 
 // MACSettingConfig defines MAC-layer configuration.
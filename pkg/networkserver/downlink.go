@@ -81,6 +81,9 @@ func loggerWithApplicationDownlinkFields(logger log.Interface, down *ttnpb.Appli
 	} else {
 		pairs = append(pairs, "class_b_c", false)
 	}
+	if down.ExpiresAt != nil {
+		pairs = append(pairs, "expires_at", *down.ExpiresAt)
+	}
 	return logger.WithFields(log.Fields(pairs...))
 }
 
@@ -88,10 +91,12 @@ var errApplicationDownlinkTooLong = errors.DefineInvalidArgument("application_do
 var errNoDownlink = errors.Define("no_downlink", "no downlink to send")
 
 type generatedDownlink struct {
-	Payload  []byte
-	FCnt     uint32
-	NeedsAck bool
-	Priority ttnpb.TxSchedulePriority
+	Payload     []byte
+	FCnt        uint32
+	ADR         bool
+	MACCommands []*ttnpb.MACCommand
+	NeedsAck    bool
+	Priority    ttnpb.TxSchedulePriority
 }
 
 type generateDownlinkState struct {
@@ -137,6 +142,10 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 	))
 	logger := log.FromContext(ctx)
 
+	if genState, err := ns.muteDownlink(ctx, dev); err != nil {
+		return nil, genState, err
+	}
+
 	// NOTE: len(MHDR) + len(FHDR) + len(MIC) = 1 + 7 + 4 = 12
 	if maxDownLen < 12 || maxUpLen < 12 {
 		panic("payload length limits too short to generate downlink")
@@ -294,6 +303,38 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 		"adr", pld.FHDR.FCtrl.ADR,
 	))
 
+	if broadcast, ok := activeEmergencyBroadcast(ctx, ns.emergencyBroadcasts, dev.ApplicationIdentifiers, timeNow()); ok {
+		if queue, ok := preemptDownlinkQueue(dev.QueuedApplicationDownlinks); ok {
+			// The application downlinks are encrypted with their FCnt, so the Application Server needs to recalculate the
+			// reordered queue, starting at the FCnt of the first application downlink.
+			logger.Info("Pre-empt downlink queue for emergency broadcast")
+			genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				CorrelationIDs:       events.CorrelationIDsFromContext(ctx),
+				Up: &ttnpb.ApplicationUp_DownlinkQueueInvalidated{
+					DownlinkQueueInvalidated: &ttnpb.ApplicationInvalidatedDownlinks{
+						Downlinks:    queue,
+						LastFCntDown: dev.QueuedApplicationDownlinks[0].FCnt - 1,
+					},
+				},
+			})
+			dev.QueuedApplicationDownlinks = nil
+			genState.NeedsDownlinkQueueUpdate = true
+			events.Publish(evtPreemptDownlinkQueue(ctx, dev.EndDeviceIdentifiers, broadcast))
+			return nil, genState, errEmergencyBroadcastHold
+		}
+		if class != ttnpb.CLASS_A {
+			switch {
+			case len(dev.QueuedApplicationDownlinks) == 0:
+				logger.Debug("Skip MAC-only class B/C downlink during emergency broadcast")
+				return nil, genState, errNoDownlink
+			case !isEmergencyDownlink(dev.QueuedApplicationDownlinks[0]):
+				logger.Debug("Hold class B/C downlink during emergency broadcast")
+				return nil, genState, errEmergencyBroadcastHold
+			}
+		}
+	}
+
 	var skipAppDown bool
 	var startIdx int
 	for _, down := range dev.QueuedApplicationDownlinks {
@@ -349,6 +390,21 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 			startIdx++
 			skipAppDown = true
 
+		case down.ExpiresAt != nil && down.ExpiresAt.Before(timeNow()):
+			logger.Debug("Drop expired application downlink")
+			genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
+				Up: &ttnpb.ApplicationUp_DownlinkFailed{
+					DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+						ApplicationDownlink: *down,
+						Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
+					},
+				},
+			})
+			startIdx++
+			continue
+
 		case down.ClassBC != nil:
 			if down.ClassBC.AbsoluteTime != nil && down.ClassBC.AbsoluteTime.Before(timeNow()) {
 				logger.Debug("Drop expired downlink")
@@ -380,6 +436,10 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 	}
 	dev.QueuedApplicationDownlinks = dev.QueuedApplicationDownlinks[startIdx:]
 	genState.NeedsDownlinkQueueUpdate = startIdx > 0
+	if !skipAppDown && len(dev.QueuedApplicationDownlinks) > 0 && ns.fairUse.StopDownlink(ctx, dev.EndDeviceIdentifiers) {
+		logger.Debug("Skip application downlink for device that exceeds uplink airtime of fair use policy")
+		skipAppDown = true
+	}
 
 	switch {
 	case !skipAppDown && len(dev.QueuedApplicationDownlinks) > 0 && len(cmdBuf) <= fOptsCapacity:
@@ -517,10 +577,12 @@ func (ns *NetworkServer) generateDownlink(ctx context.Context, dev *ttnpb.EndDev
 		"priority", priority,
 	)).Debug("Generated downlink")
 	return &generatedDownlink{
-		Payload:  b,
-		FCnt:     pld.FHDR.FCnt,
-		NeedsAck: needsAck,
-		Priority: priority,
+		Payload:     b,
+		FCnt:        pld.FHDR.FCnt,
+		ADR:         pld.FHDR.ADR,
+		MACCommands: cmds,
+		NeedsAck:    needsAck,
+		Priority:    priority,
 	}, genState, nil
 }
 
@@ -529,16 +591,72 @@ type downlinkPath struct {
 	*ttnpb.DownlinkPath
 }
 
-func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
-	mds = append(mds[:0:0], mds...)
+// downlinkPathScorer orders the downlink paths of uplink messages by score.
+type downlinkPathScorer struct {
+	snrWeight          float32
+	rssiWeight         float32
+	rttPenalty         float32
+	agePenalty         float32
+	gatewayPreferences map[string]float32
+}
+
+func newDownlinkPathScorer(conf DownlinkPathScoringConfig) downlinkPathScorer {
+	s := downlinkPathScorer{
+		snrWeight:  conf.SNRWeight,
+		rssiWeight: conf.RSSIWeight,
+		rttPenalty: conf.RTTPenalty,
+		agePenalty: conf.AgePenalty,
+	}
+	if s.snrWeight == 0 && s.rssiWeight == 0 {
+		s.snrWeight = 1
+	}
+	if len(conf.GatewayPreferences) > 0 {
+		s.gatewayPreferences = make(map[string]float32, len(conf.GatewayPreferences))
+		for _, p := range conf.GatewayPreferences {
+			s.gatewayPreferences[p.GatewayID] = p.Preference
+		}
+	}
+	return s
+}
+
+func (s downlinkPathScorer) score(md *ttnpb.RxMetadata) float32 {
+	score := s.snrWeight*md.SNR + s.rssiWeight*md.RSSI + s.gatewayPreferences[md.GatewayID]
+	if md.RoundTripTime != nil {
+		score -= s.rttPenalty * float32(*md.RoundTripTime) / float32(time.Millisecond)
+	}
+	return score
+}
+
+type scoredRxMetadata struct {
+	*ttnpb.RxMetadata
+	score float32
+}
+
+func hasDownlinkPath(md *ttnpb.RxMetadata) bool {
+	return len(md.UplinkToken) > 0 && md.DownlinkPathConstraint != ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER
+}
+
+func (s downlinkPathScorer) downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
+	scored := make([]scoredRxMetadata, 0, len(mds))
+	for _, md := range mds {
+		scored = append(scored, scoredRxMetadata{
+			RxMetadata: md,
+			score:      s.score(md),
+		})
+	}
+	return downlinkPathsFromScoredMetadata(scored)
+}
+
+// downlinkPathsFromScoredMetadata returns the downlink paths of mds in order of descending score.
+// Paths through gateways that prefer other paths are returned last. mds is sorted in place.
+func downlinkPathsFromScoredMetadata(mds []scoredRxMetadata) []downlinkPath {
 	sort.SliceStable(mds, func(i, j int) bool {
-		// TODO: Improve the sorting algorithm (https://github.com/TheThingsNetwork/lorawan-stack/issues/13)
-		return mds[i].SNR > mds[j].SNR
+		return mds[i].score > mds[j].score
 	})
 	head := make([]downlinkPath, 0, len(mds))
 	tail := make([]downlinkPath, 0, len(mds))
 	for _, md := range mds {
-		if len(md.UplinkToken) == 0 || md.DownlinkPathConstraint == ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER {
+		if !hasDownlinkPath(md.RxMetadata) {
 			continue
 		}
 
@@ -563,7 +681,7 @@ func downlinkPathsFromMetadata(mds ...*ttnpb.RxMetadata) []downlinkPath {
 
 // downlinkPathsForClassA returns the last paths, if any, of the given uplink messages.
 // This function returns whether class A downlink can be made in either window considering the given Rx delay.
-func downlinkPathsForClassA(rxDelay ttnpb.RxDelay, ups ...*ttnpb.UplinkMessage) (rx1, rx2 bool, paths []downlinkPath) {
+func (s downlinkPathScorer) downlinkPathsForClassA(rxDelay ttnpb.RxDelay, ups ...*ttnpb.UplinkMessage) (rx1, rx2 bool, paths []downlinkPath) {
 	if rxDelay == ttnpb.RX_DELAY_0 {
 		rxDelay = ttnpb.RX_DELAY_1
 	}
@@ -572,20 +690,51 @@ func downlinkPathsForClassA(rxDelay ttnpb.RxDelay, ups ...*ttnpb.UplinkMessage)
 		up := ups[i]
 		delta := timeSince(up.ReceivedAt)
 		rx1, rx2 := delta < maxDelta, delta < maxDelta+time.Second
-		if paths := downlinkPathsFromMetadata(up.RxMetadata...); len(paths) > 0 {
+		if paths := s.downlinkPathsFromMetadata(up.RxMetadata...); len(paths) > 0 {
 			return rx1, rx2, paths
 		}
 	}
 	return false, false, nil
 }
 
-func downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath {
+// downlinkPathsFromRecentUplinks returns the paths for class B and C downlink.
+// Without age penalty, these are the paths of the last uplink message that has any.
+// With age penalty, the paths of all given uplink messages are scored, where the age penalty per second since the
+// uplink message was received is subtracted from the score. Only the best scoring path per gateway is returned.
+func (s downlinkPathScorer) downlinkPathsFromRecentUplinks(ups ...*ttnpb.UplinkMessage) []downlinkPath {
+	if s.agePenalty == 0 {
+		for i := len(ups) - 1; i >= 0; i-- {
+			if paths := s.downlinkPathsFromMetadata(ups[i].RxMetadata...); len(paths) > 0 {
+				return paths
+			}
+		}
+		return nil
+	}
+
+	var mds []scoredRxMetadata
+	gtwIdx := make(map[string]int)
 	for i := len(ups) - 1; i >= 0; i-- {
-		if paths := downlinkPathsFromMetadata(ups[i].RxMetadata...); len(paths) > 0 {
-			return paths
+		up := ups[i]
+		agePenalty := s.agePenalty * float32(timeSince(up.ReceivedAt)) / float32(time.Second)
+		for _, md := range up.RxMetadata {
+			if !hasDownlinkPath(md) {
+				continue
+			}
+			scored := scoredRxMetadata{
+				RxMetadata: md,
+				score:      s.score(md) - agePenalty,
+			}
+			j, ok := gtwIdx[md.GatewayID]
+			switch {
+			case !ok:
+				gtwIdx[md.GatewayID] = len(mds)
+				mds = append(mds, scored)
+			case scored.score > mds[j].score:
+				mds[j] = scored
+			}
 		}
 	}
-	return nil
+	return downlinkPathsFromScoredMetadata(mds)
 }
 
 type scheduledDownlink struct {
@@ -721,6 +870,43 @@ func (ns *NetworkServer) scheduleDownlinkByPaths(ctx context.Context, req *ttnpb
 	return nil, downlinkSchedulingError(errs)
 }
 
+// scheduleDownlinkByAllPaths attempts to schedule payload b using parameters in req on each of the given paths.
+// It returns the scheduled downlink with the latest transmission time, if scheduling succeeded on at least one path.
+// This is used for multicast downlinks, which are transmitted by all gateways covering the members of the group.
+func (ns *NetworkServer) scheduleDownlinkByAllPaths(ctx context.Context, req *ttnpb.TxRequest, b []byte, paths ...downlinkPath) (*scheduledDownlink, error) {
+	if len(paths) == 0 {
+		return nil, errNoPath
+	}
+
+	logger := log.FromContext(ctx)
+
+	var scheduled *scheduledDownlink
+	var errs downlinkSchedulingError
+	for _, path := range paths {
+		pathReq := *req
+		down, err := ns.scheduleDownlinkByPaths(ctx, &pathReq, b, path)
+		if err != nil {
+			logger.WithField("gateway_uid", unique.ID(ctx, path.GatewayIdentifiers)).WithError(err).Debug("Failed to schedule downlink on path")
+			if schedErr, ok := err.(downlinkSchedulingError); ok {
+				errs = append(errs, schedErr...)
+			} else {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if scheduled == nil || down.TransmitAt.After(scheduled.TransmitAt) {
+			scheduled = down
+		}
+	}
+	if scheduled == nil {
+		return nil, errs
+	}
+	if len(errs) > 0 {
+		logger.WithField("failed_paths", len(errs)).Warn("Failed to schedule downlink on some paths")
+	}
+	return scheduled, nil
+}
+
 func loggerWithTxRequestFields(logger log.Interface, req *ttnpb.TxRequest, rx1, rx2 bool) log.Interface {
 	pairs := []interface{}{
 		"attempt_rx1", rx1,
@@ -825,7 +1011,7 @@ const gsScheduleWindow = 30 * time.Second
 // nsScheduleWindow is the time interval, which is sufficient for NS to ensure downlink is scheduled.
 var nsScheduleWindow = 100 * time.Millisecond
 
-func recordDataDownlink(dev *ttnpb.EndDevice, genDown *generatedDownlink, genState generateDownlinkState, down *scheduledDownlink, defaults ttnpb.MACSettings) {
+func recordDataDownlink(dev *ttnpb.EndDevice, genDown *generatedDownlink, genState generateDownlinkState, down *scheduledDownlink, defaults ttnpb.MACSettings, recentDownlinkCount int) {
 	if genState.ApplicationDownlink == nil || dev.MACState.LoRaWANVersion.Compare(ttnpb.MAC_V1_1) < 0 && genDown.FCnt > dev.Session.LastNFCntDown {
 		dev.Session.LastNFCntDown = genDown.FCnt
 	}
@@ -897,7 +1083,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	}
 	ctx = events.ContextWithCorrelationID(ctx, up.CorrelationIDs...)
 
-	rx1, rx2, paths := downlinkPathsForClassA(rxDelay, dev.RecentUplinks...)
+	rx1, rx2, paths := ns.downlinkPathScorer.downlinkPathsForClassA(rxDelay, dev.RecentUplinks...)
 	if !rx1 && !rx2 {
 		logger.Warn("Rx1 and Rx2 are expired, skip class A downlink slot")
 		dev.MACState.QueuedResponses = nil
@@ -963,6 +1149,12 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		case errors.Resemble(err, errNoDownlink):
 			logger.Debug("No class A downlink to send, skip class A downlink slot")
 
+		case errors.Resemble(err, errEmergencyBroadcastHold):
+			logger.Debug("Class A downlink held during emergency broadcast, skip class A downlink slot")
+
+		case errors.Resemble(err, errMuted):
+			logger.Debug("End device is muted, skip class A downlink slot")
+
 		default:
 			logger.WithError(err).Warn("Failed to generate class A downlink, skip class A downlink slot")
 		}
@@ -1029,7 +1221,11 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	if genState.ApplicationDownlink != nil {
 		sets = append(sets, "queued_application_downlinks")
 	}
-	recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+	recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings, ns.recentDownlinkCount)
+	if ns.macTraceCount > 0 {
+		dev.MACTrace = appendMACTrace(dev.MACTrace, macTraceDownlinkEntry(genDown, req, down, paths...), ns.macTraceCount)
+		sets = append(sets, "mac_trace")
+	}
 	return downlinkAttemptResult{
 		SetPaths: append(sets,
 			"mac_state.last_confirmed_downlink_at",
@@ -1064,9 +1260,10 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 		var queuedEvents []events.Event
 		var nextDownlinkAt time.Time
 		_, err := ns.devices.SetByID(ctx, devID.ApplicationIdentifiers, devID.DeviceID,
-			[]string{
+			ns.withMACTracePaths(
 				"frequency_plan_id",
 				"last_dev_status_received_at",
+				"lifecycle_state",
 				"lorawan_phy_version",
 				"mac_settings",
 				"mac_state",
@@ -1076,13 +1273,19 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 				"recent_downlinks",
 				"recent_uplinks",
 				"session",
-			},
+			),
 			func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 				if dev == nil {
 					logger.Warn("Device not found")
 					return nil, nil, nil
 				}
 
+				switch dev.LifecycleState {
+				case ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED, ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED:
+					logger.WithField("lifecycle_state", dev.LifecycleState).Debug("Device is out of service, skip downlink slot")
+					return dev, nil, nil
+				}
+
 				fp, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans)
 				if err != nil {
 					nextDownlinkAt = timeNow().Add(downlinkRetryInterval).UTC()
@@ -1111,7 +1314,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 
 					rxDelay := ttnpb.RxDelay(phy.JoinAcceptDelay1 / time.Second)
 
-					rx1, rx2, paths := downlinkPathsForClassA(rxDelay, dev.RecentUplinks...)
+					rx1, rx2, paths := ns.downlinkPathScorer.downlinkPathsForClassA(rxDelay, dev.RecentUplinks...)
+					if rx1 && ns.joinAcceptRx2Threshold > 0 && timeNow().Sub(up.ReceivedAt) > ns.joinAcceptRx2Threshold {
+						logger.Debug("Join-request received longer ago than Rx2 threshold, skip Rx1")
+						rx1 = false
+					}
 					if !rx1 && !rx2 {
 						logger.Warn("Rx1 and Rx2 are expired, skip downlink slot")
 						dev.PendingMACState.RxWindowsAvailable = false
@@ -1154,7 +1361,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					dev.PendingMACState.PendingJoinRequest = &dev.PendingMACState.QueuedJoinAccept.Request
 					dev.PendingMACState.QueuedJoinAccept = nil
 					dev.PendingMACState.RxWindowsAvailable = false
-					dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, recentDownlinkCount)
+					dev.RecentDownlinks = appendRecentDownlink(dev.RecentDownlinks, down.Message, ns.recentDownlinkCount)
 					return dev, []string{
 						"pending_mac_state.pending_join_request",
 						"pending_mac_state.queued_join_accept",
@@ -1242,6 +1449,14 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						nextDownlinkAt = dev.MACState.LastConfirmedDownlinkAt.Add(deviceClassCTimeout(dev, ns.defaultMACSettings))
 						logger.WithField("retry_at", nextDownlinkAt).Info("Confirmed downlink scheduled too soon, retry")
 
+					case errors.Resemble(err, errEmergencyBroadcastHold):
+						nextDownlinkAt = timeNow().Add(emergencyBroadcastHoldInterval).UTC()
+						logger.WithField("retry_at", nextDownlinkAt).Debug("Class B/C downlink held during emergency broadcast, retry")
+
+					case errors.Resemble(err, errMuted):
+						nextDownlinkAt = ns.muteWindowRetryAt(ctx, dev.EndDeviceIdentifiers)
+						logger.WithField("retry_at", nextDownlinkAt).Debug("Class B/C downlink held during mute window, retry")
+
 					default:
 						logger.WithError(err).Warn("Failed to generate class B/C downlink, skip class B/C downlink slot")
 					}
@@ -1272,7 +1487,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 						})
 					}
 				} else {
-					paths = downlinkPathsFromRecentUplinks(dev.RecentUplinks...)
+					paths = ns.downlinkPathScorer.downlinkPathsFromRecentUplinks(dev.RecentUplinks...)
 					if len(paths) == 0 {
 						logger.Warn("No downlink path available, skip class B/C downlink slot")
 						queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, false)
@@ -1295,7 +1510,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					req.AbsoluteTime = absTime
 				}
 
-				down, err := ns.scheduleDownlinkByPaths(
+				scheduleDownlink := ns.scheduleDownlinkByPaths
+				if dev.Multicast {
+					scheduleDownlink = ns.scheduleDownlinkByAllPaths
+				}
+				down, err := scheduleDownlink(
 					log.NewContext(ctx, loggerWithTxRequestFields(logger, req, false, true)),
 					req,
 					genDown.Payload,
@@ -1359,7 +1578,11 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context) error {
 					return dev, sets, nil
 				}
 
-				recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings)
+				recordDataDownlink(dev, genDown, genState, down, ns.defaultMACSettings, ns.recentDownlinkCount)
+				if ns.macTraceCount > 0 {
+					dev.MACTrace = appendMACTrace(dev.MACTrace, macTraceDownlinkEntry(genDown, req, down, paths...), ns.macTraceCount)
+					sets = append(sets, "mac_trace")
+				}
 				queuedEvents = append(queuedEvents, genState.Events...)
 				queuedApplicationUplinks = genState.appendApplicationUplinks(queuedApplicationUplinks, true)
 				if genState.ApplicationDownlink != nil {
@@ -47,6 +47,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 	getPaths := []string{
 		"frequency_plan_id",
 		"last_dev_status_received_at",
+		"lifecycle_state",
 		"lorawan_phy_version",
 		"mac_settings",
 		"mac_state",
@@ -245,10 +246,11 @@ func TestProcessDownlinkTask(t *testing.T) {
 	}
 
 	for _, tc := range []struct {
-		Name               string
-		DownlinkPriorities DownlinkPriorities
-		Handler            func(context.Context, TestEnvironment) bool
-		ErrorAssertion     func(*testing.T, error) bool
+		Name                   string
+		DownlinkPriorities     DownlinkPriorities
+		JoinAcceptRx2Threshold time.Duration
+		Handler                func(context.Context, TestEnvironment) bool
+		ErrorAssertion         func(*testing.T, error) bool
 	}{
 		{
 			Name: "no device",
@@ -4383,6 +4385,258 @@ func TestProcessDownlinkTask(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "Class C/windows closed/1.1/multicast/no MAC/classBC application downlink/forced gateways/data/RXC/EU868",
+			DownlinkPriorities: DownlinkPriorities{
+				JoinAccept:             ttnpb.TxSchedulePriority_HIGHEST,
+				MACCommands:            ttnpb.TxSchedulePriority_HIGH,
+				MaxApplicationDownlink: ttnpb.TxSchedulePriority_NORMAL,
+			},
+			Handler: func(ctx context.Context, env TestEnvironment) bool {
+				t := test.MustTFromContext(ctx)
+				a := assertions.New(t)
+
+				var popRespCh chan<- error
+				popFuncRespCh := make(chan error)
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop to be called")
+					return false
+
+				case req := <-env.DownlinkTasks.Pop:
+					popRespCh = req.Response
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					go func() {
+						popFuncRespCh <- req.Func(req.Context, ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: appID,
+							DeviceID:               devID,
+						}, time.Now())
+					}()
+				}
+
+				gtwA := &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+						GatewayID: "multicast-gateway-a",
+					},
+				}
+				gtwB := &ttnpb.GatewayAntennaIdentifiers{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{
+						GatewayID: "multicast-gateway-b",
+					},
+				}
+
+				getDevice := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					MACSettings: &ttnpb.MACSettings{
+						StatusCountPeriodicity: &pbtypes.UInt32Value{Value: 0},
+						StatusTimePeriodicity:  DurationPtr(0),
+					},
+					MACState: &ttnpb.MACState{
+						CurrentParameters: *CopyMACParameters(eu868macParameters),
+						DesiredParameters: *CopyMACParameters(eu868macParameters),
+						DeviceClass:       ttnpb.CLASS_C,
+						LoRaWANVersion:    ttnpb.MAC_V1_1,
+					},
+					Multicast: true,
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							CorrelationIDs: []string{"correlation-app-down-1", "correlation-app-down-2"},
+							FCnt:           0x42,
+							FPort:          0x1,
+							FRMPayload:     []byte("testPayload"),
+							Priority:       ttnpb.TxSchedulePriority_HIGHEST,
+							SessionKeyID:   []byte{0x11, 0x22, 0x33, 0x44},
+							ClassBC: &ttnpb.ApplicationDownlink_ClassBC{
+								Gateways: []*ttnpb.GatewayAntennaIdentifiers{gtwA, gtwB},
+							},
+						},
+					},
+					Session: &ttnpb.Session{
+						DevAddr:       devAddr,
+						LastNFCntDown: 0x24,
+						SessionKeys:   *CopySessionKeys(sessionKeys),
+					},
+				}
+
+				var setRespCh chan<- DeviceRegistrySetByIDResponse
+				setFuncRespCh := make(chan DeviceRegistrySetByIDRequestFuncResponse)
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID to be called")
+					return false
+
+				case req := <-env.DeviceRegistry.SetByID:
+					setRespCh = req.Response
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					a.So(req.ApplicationIdentifiers, should.Resemble, appID)
+					a.So(req.DeviceID, should.Resemble, devID)
+					a.So(req.Paths, should.Resemble, getPaths)
+
+					go func() {
+						dev, sets, err := req.Func(CopyEndDevice(getDevice))
+						setFuncRespCh <- DeviceRegistrySetByIDRequestFuncResponse{
+							Device: dev,
+							Paths:  sets,
+							Error:  err,
+						}
+					}()
+				}
+
+				payload := func() []byte {
+					b := []byte{
+						/* MHDR */
+						0b011_000_00,
+						/* MACPayload */
+						/** FHDR **/
+						/*** DevAddr ***/
+						devAddr[3], devAddr[2], devAddr[1], devAddr[0],
+						/*** FCtrl ***/
+						0b1_0_0_0_0000,
+						/*** FCnt ***/
+						0x42, 0x00,
+					}
+
+					/** FPort **/
+					b = append(b, 0x1)
+
+					/** FRMPayload **/
+					b = append(b, []byte("testPayload")...)
+
+					/* MIC */
+					mic := test.Must(crypto.ComputeDownlinkMIC(
+						sNwkSIntKey,
+						devAddr,
+						0,
+						0x42,
+						b,
+					)).([4]byte)
+					return append(b, mic[:]...)
+				}()
+
+				var lastDown *ttnpb.DownlinkMessage
+				for _, tc := range []struct {
+					Gateway *ttnpb.GatewayAntennaIdentifiers
+					Delay   time.Duration
+				}{
+					{
+						Gateway: gtwA,
+						Delay:   time.Second,
+					},
+					{
+						Gateway: gtwB,
+						Delay:   2 * time.Second,
+					},
+				} {
+					scheduleDownlinkCh := make(chan NsGsScheduleDownlinkRequest)
+					peer := NewGSPeer(ctx, &MockNsGsServer{
+						ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlinkCh),
+					})
+					if !a.So(test.AssertClusterGetPeerRequest(ctx, env.Cluster.GetPeer,
+						func(reqCtx context.Context, role ttnpb.ClusterRole, ids ttnpb.Identifiers) bool {
+							return a.So(reqCtx, should.HaveParentContextOrEqual, ctx) &&
+								a.So(role, should.Equal, ttnpb.ClusterRole_GATEWAY_SERVER) &&
+								a.So(ids, should.Resemble, tc.Gateway.GatewayIdentifiers)
+						},
+						test.ClusterGetPeerResponse{Peer: peer},
+					), should.BeTrue) {
+						return false
+					}
+					if !a.So(AssertAuthNsGsScheduleDownlinkRequest(ctx, env.Cluster.Auth, scheduleDownlinkCh,
+						func(ctx context.Context, msg *ttnpb.DownlinkMessage) bool {
+							lastDown = &ttnpb.DownlinkMessage{
+								CorrelationIDs: msg.CorrelationIDs,
+								RawPayload:     payload,
+								Settings: &ttnpb.DownlinkMessage_Request{
+									Request: &ttnpb.TxRequest{
+										Class: ttnpb.CLASS_C,
+										DownlinkPaths: []*ttnpb.DownlinkPath{
+											{
+												Path: &ttnpb.DownlinkPath_Fixed{
+													Fixed: tc.Gateway,
+												},
+											},
+										},
+										Priority:         ttnpb.TxSchedulePriority_NORMAL,
+										Rx2DataRateIndex: ttnpb.DATA_RATE_1,
+										Rx2Frequency:     420000000,
+									},
+								},
+							}
+							return a.So(msg, should.Resemble, lastDown)
+						},
+						grpc.EmptyCallOption{},
+						NsGsScheduleDownlinkResponse{
+							Response: &ttnpb.ScheduleDownlinkResponse{
+								Delay: tc.Delay,
+							},
+						},
+					), should.BeTrue) {
+						t.Errorf("Downlink assertion failed for gateway %s", tc.Gateway.GatewayID)
+						return false
+					}
+				}
+
+				setDevice := CopyEndDevice(getDevice)
+				setDevice.QueuedApplicationDownlinks = []*ttnpb.ApplicationDownlink{}
+				setDevice.RecentDownlinks = []*ttnpb.DownlinkMessage{
+					lastDown,
+				}
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID callback to return")
+
+				case resp := <-setFuncRespCh:
+					a.So(resp.Error, should.BeNil)
+					a.So(resp.Paths, should.HaveSameElementsDeep, []string{
+						"mac_state.last_confirmed_downlink_at",
+						"mac_state.pending_application_downlink",
+						"mac_state.pending_requests",
+						"mac_state.queued_responses",
+						"mac_state.rx_windows_available",
+						"queued_application_downlinks",
+						"recent_downlinks",
+						"session",
+					})
+					a.So(resp.Device, should.Resemble, setDevice)
+				}
+				close(setFuncRespCh)
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID response to be processed")
+
+				case setRespCh <- DeviceRegistrySetByIDResponse{
+					Device: setDevice,
+				}:
+				}
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop callback to return")
+
+				case resp := <-popFuncRespCh:
+					a.So(resp, should.BeNil)
+				}
+				close(popFuncRespCh)
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop response to be processed")
+
+				case popRespCh <- nil:
+				}
+
+				return true
+			},
+		},
+
 		{
 			Name: "Class C/windows open/1.1/RX1,RX2 available/no MAC/classBC application downlink/absolute time outside window",
 			DownlinkPriorities: DownlinkPriorities{
@@ -4649,30 +4903,313 @@ func TestProcessDownlinkTask(t *testing.T) {
 						LastNFCntDown: 0x24,
 						SessionKeys:   *CopySessionKeys(sessionKeys),
 					},
-				}
-
-				var setRespCh chan<- DeviceRegistrySetByIDResponse
-				setFuncRespCh := make(chan DeviceRegistrySetByIDRequestFuncResponse)
-				select {
-				case <-ctx.Done():
-					t.Error("Timed out while waiting for DeviceRegistry.SetByID to be called")
-					return false
-
-				case req := <-env.DeviceRegistry.SetByID:
-					setRespCh = req.Response
-					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
-					a.So(req.ApplicationIdentifiers, should.Resemble, appID)
-					a.So(req.DeviceID, should.Resemble, devID)
-					a.So(req.Paths, should.Resemble, getPaths)
-
-					go func() {
-						dev, sets, err := req.Func(CopyEndDevice(getDevice))
-						setFuncRespCh <- DeviceRegistrySetByIDRequestFuncResponse{
-							Device: dev,
-							Paths:  sets,
-							Error:  err,
-						}
-					}()
+				}
+
+				var setRespCh chan<- DeviceRegistrySetByIDResponse
+				setFuncRespCh := make(chan DeviceRegistrySetByIDRequestFuncResponse)
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID to be called")
+					return false
+
+				case req := <-env.DeviceRegistry.SetByID:
+					setRespCh = req.Response
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					a.So(req.ApplicationIdentifiers, should.Resemble, appID)
+					a.So(req.DeviceID, should.Resemble, devID)
+					a.So(req.Paths, should.Resemble, getPaths)
+
+					go func() {
+						dev, sets, err := req.Func(CopyEndDevice(getDevice))
+						setFuncRespCh <- DeviceRegistrySetByIDRequestFuncResponse{
+							Device: dev,
+							Paths:  sets,
+							Error:  err,
+						}
+					}()
+				}
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID callback to return")
+
+				case resp := <-setFuncRespCh:
+					a.So(resp.Error, should.BeNil)
+					a.So(resp.Paths, should.Resemble, []string{
+						"queued_application_downlinks",
+					})
+					if resp.Device != nil {
+						a.So(resp.Device.QueuedApplicationDownlinks, should.BeEmpty)
+					} else {
+						a.So(resp.Device, should.BeNil)
+					}
+				}
+				close(setFuncRespCh)
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID response to be processed")
+
+				case setRespCh <- DeviceRegistrySetByIDResponse{}:
+				}
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for ApplicationUplinks.Add to be called")
+
+				case req := <-env.ApplicationUplinks.Add:
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					a.So(req.Uplinks, should.Resemble, []*ttnpb.ApplicationUp{
+						{
+							EndDeviceIdentifiers: getDevice.EndDeviceIdentifiers,
+							CorrelationIDs:       append(lastUp.CorrelationIDs, getDevice.QueuedApplicationDownlinks[0].CorrelationIDs...),
+							Up: &ttnpb.ApplicationUp_DownlinkFailed{
+								DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+									ApplicationDownlink: *getDevice.QueuedApplicationDownlinks[0],
+									Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
+								},
+							},
+						},
+						{
+							EndDeviceIdentifiers: getDevice.EndDeviceIdentifiers,
+							CorrelationIDs:       append(lastUp.CorrelationIDs, getDevice.QueuedApplicationDownlinks[1].CorrelationIDs...),
+							Up: &ttnpb.ApplicationUp_DownlinkFailed{
+								DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+									ApplicationDownlink: *getDevice.QueuedApplicationDownlinks[1],
+									Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
+								},
+							},
+						},
+					})
+					close(req.Response)
+				}
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop callback to return")
+
+				case resp := <-popFuncRespCh:
+					a.So(resp, should.BeNil)
+				}
+				close(popFuncRespCh)
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop response to be processed")
+
+				case popRespCh <- nil:
+				}
+
+				return true
+			},
+		},
+
+		{
+			Name: "join-accept/windows open/RX1,RX2 available/no active MAC state/EU868",
+			DownlinkPriorities: DownlinkPriorities{
+				JoinAccept:             ttnpb.TxSchedulePriority_HIGHEST,
+				MACCommands:            ttnpb.TxSchedulePriority_HIGH,
+				MaxApplicationDownlink: ttnpb.TxSchedulePriority_NORMAL,
+			},
+			Handler: func(ctx context.Context, env TestEnvironment) bool {
+				t := test.MustTFromContext(ctx)
+				a := assertions.New(t)
+
+				var popRespCh chan<- error
+				popFuncRespCh := make(chan error)
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DownlinkTasks.Pop to be called")
+					return false
+
+				case req := <-env.DownlinkTasks.Pop:
+					popRespCh = req.Response
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					go func() {
+						popFuncRespCh <- req.Func(req.Context, ttnpb.EndDeviceIdentifiers{
+							ApplicationIdentifiers: appID,
+							DeviceID:               devID,
+						}, time.Now())
+					}()
+				}
+
+				lastUp := &ttnpb.UplinkMessage{
+					CorrelationIDs:     []string{"correlation-up-1", "correlation-up-2"},
+					DeviceChannelIndex: 3,
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_JOIN_REQUEST,
+						},
+						Payload: &ttnpb.Message_JoinRequestPayload{JoinRequestPayload: &ttnpb.JoinRequestPayload{
+							JoinEUI:  types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevEUI:   types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+							DevNonce: types.DevNonce{0x00, 0x42},
+						}},
+					},
+					ReceivedAt: time.Now().Add(-time.Second),
+					RxMetadata: deepcopy.Copy(rxMetadata).([]*ttnpb.RxMetadata),
+					Settings: ttnpb.TxSettings{
+						DataRateIndex: ttnpb.DATA_RATE_0,
+						Frequency:     430000000,
+					},
+				}
+
+				getDevice := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+					},
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					PendingMACState: &ttnpb.MACState{
+						CurrentParameters: *CopyMACParameters(eu868macParameters),
+						DesiredParameters: *CopyMACParameters(eu868macParameters),
+						DeviceClass:       ttnpb.CLASS_A,
+						LoRaWANVersion:    ttnpb.MAC_V1_1,
+						QueuedJoinAccept: &ttnpb.MACState_JoinAccept{
+							Keys:    *CopySessionKeys(sessionKeys),
+							Payload: bytes.Repeat([]byte{0x42}, 33),
+							Request: ttnpb.JoinRequest{
+								DevAddr: devAddr,
+							},
+						},
+						RxWindowsAvailable: true,
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							CorrelationIDs: []string{"correlation-app-down-1", "correlation-app-down-2"},
+							FCnt:           0x42,
+							FPort:          0x1,
+							FRMPayload:     []byte("testPayload"),
+							Priority:       ttnpb.TxSchedulePriority_HIGHEST,
+							SessionKeyID:   []byte{0x11, 0x22, 0x33, 0x44},
+						},
+					},
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						CopyUplinkMessage(lastUp),
+					},
+					SupportsJoin: true,
+				}
+
+				var setRespCh chan<- DeviceRegistrySetByIDResponse
+				setFuncRespCh := make(chan DeviceRegistrySetByIDRequestFuncResponse)
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID to be called")
+					return false
+
+				case req := <-env.DeviceRegistry.SetByID:
+					setRespCh = req.Response
+					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
+					a.So(req.ApplicationIdentifiers, should.Resemble, appID)
+					a.So(req.DeviceID, should.Resemble, devID)
+					a.So(req.Paths, should.Resemble, getPaths)
+
+					go func() {
+						dev, sets, err := req.Func(CopyEndDevice(getDevice))
+						setFuncRespCh <- DeviceRegistrySetByIDRequestFuncResponse{
+							Device: dev,
+							Paths:  sets,
+							Error:  err,
+						}
+					}()
+				}
+
+				scheduleDownlink124Ch := make(chan NsGsScheduleDownlinkRequest)
+				peer124 := NewGSPeer(ctx, &MockNsGsServer{
+					ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlink124Ch),
+				})
+
+				scheduleDownlink3Ch := make(chan NsGsScheduleDownlinkRequest)
+				peer3 := NewGSPeer(ctx, &MockNsGsServer{
+					ScheduleDownlinkFunc: MakeNsGsScheduleDownlinkChFunc(scheduleDownlink3Ch),
+				})
+
+				if !a.So(assertGetRxMetadataGatewayPeers(ctx, env.Cluster.GetPeer, peer124, peer3), should.BeTrue) {
+					return false
+				}
+
+				lastDown, ok := assertScheduleRxMetadataGateways(
+					ctx,
+					env.Cluster.Auth,
+					scheduleDownlink124Ch,
+					scheduleDownlink3Ch,
+					bytes.Repeat([]byte{0x42}, 33),
+					func(paths ...*ttnpb.DownlinkPath) *ttnpb.TxRequest {
+						return &ttnpb.TxRequest{
+							Class:            ttnpb.CLASS_A,
+							DownlinkPaths:    paths,
+							Priority:         ttnpb.TxSchedulePriority_HIGHEST,
+							Rx1Delay:         ttnpb.RX_DELAY_5,
+							Rx1DataRateIndex: ttnpb.DATA_RATE_0,
+							Rx1Frequency:     431000000,
+							Rx2DataRateIndex: ttnpb.DATA_RATE_1,
+							Rx2Frequency:     420000000,
+						}
+					},
+					NsGsScheduleDownlinkResponse{
+						Error: errors.New("test"),
+					},
+					NsGsScheduleDownlinkResponse{
+						Error: errors.New("test"),
+					},
+					NsGsScheduleDownlinkResponse{
+						Response: &ttnpb.ScheduleDownlinkResponse{
+							Delay: time.Second,
+						},
+					},
+				)
+				if !a.So(ok, should.BeTrue) {
+					t.Error("Scheduling assertion failed")
+					return false
+				}
+
+				if a.So(lastDown.CorrelationIDs, should.HaveLength, 3) {
+					a.So(lastDown.CorrelationIDs, should.Contain, "correlation-up-1")
+					a.So(lastDown.CorrelationIDs, should.Contain, "correlation-up-2")
+				}
+
+				setDevice := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						JoinEUI:                &types.EUI64{0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+						DevEUI:                 &types.EUI64{0x42, 0x42, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+					},
+					FrequencyPlanID:   test.EUFrequencyPlanID,
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					PendingMACState: &ttnpb.MACState{
+						CurrentParameters: *CopyMACParameters(eu868macParameters),
+						DesiredParameters: *CopyMACParameters(eu868macParameters),
+						DeviceClass:       ttnpb.CLASS_A,
+						LoRaWANVersion:    ttnpb.MAC_V1_1,
+						PendingJoinRequest: &ttnpb.JoinRequest{
+							DevAddr: devAddr,
+						},
+					},
+					PendingSession: &ttnpb.Session{
+						DevAddr:     devAddr,
+						SessionKeys: *CopySessionKeys(sessionKeys),
+					},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+						{
+							CorrelationIDs: []string{"correlation-app-down-1", "correlation-app-down-2"},
+							FCnt:           0x42,
+							FPort:          0x1,
+							FRMPayload:     []byte("testPayload"),
+							Priority:       ttnpb.TxSchedulePriority_HIGHEST,
+							SessionKeyID:   []byte{0x11, 0x22, 0x33, 0x44},
+						},
+					},
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						CopyUplinkMessage(lastUp),
+					},
+					RecentDownlinks: []*ttnpb.DownlinkMessage{
+						lastDown,
+					},
+					SupportsJoin: true,
 				}
 
 				select {
@@ -4682,13 +5219,14 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case resp := <-setFuncRespCh:
 					a.So(resp.Error, should.BeNil)
 					a.So(resp.Paths, should.Resemble, []string{
-						"queued_application_downlinks",
+						"pending_mac_state.pending_join_request",
+						"pending_mac_state.queued_join_accept",
+						"pending_mac_state.rx_windows_available",
+						"pending_session.dev_addr",
+						"pending_session.keys",
+						"recent_downlinks",
 					})
-					if resp.Device != nil {
-						a.So(resp.Device.QueuedApplicationDownlinks, should.BeEmpty)
-					} else {
-						a.So(resp.Device, should.BeNil)
-					}
+					a.So(resp.Device, should.Resemble, setDevice)
 				}
 				close(setFuncRespCh)
 
@@ -4696,38 +5234,9 @@ func TestProcessDownlinkTask(t *testing.T) {
 				case <-ctx.Done():
 					t.Error("Timed out while waiting for DeviceRegistry.SetByID response to be processed")
 
-				case setRespCh <- DeviceRegistrySetByIDResponse{}:
-				}
-
-				select {
-				case <-ctx.Done():
-					t.Error("Timed out while waiting for ApplicationUplinks.Add to be called")
-
-				case req := <-env.ApplicationUplinks.Add:
-					a.So(req.Context, should.HaveParentContextOrEqual, ctx)
-					a.So(req.Uplinks, should.Resemble, []*ttnpb.ApplicationUp{
-						{
-							EndDeviceIdentifiers: getDevice.EndDeviceIdentifiers,
-							CorrelationIDs:       append(lastUp.CorrelationIDs, getDevice.QueuedApplicationDownlinks[0].CorrelationIDs...),
-							Up: &ttnpb.ApplicationUp_DownlinkFailed{
-								DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
-									ApplicationDownlink: *getDevice.QueuedApplicationDownlinks[0],
-									Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
-								},
-							},
-						},
-						{
-							EndDeviceIdentifiers: getDevice.EndDeviceIdentifiers,
-							CorrelationIDs:       append(lastUp.CorrelationIDs, getDevice.QueuedApplicationDownlinks[1].CorrelationIDs...),
-							Up: &ttnpb.ApplicationUp_DownlinkFailed{
-								DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
-									ApplicationDownlink: *getDevice.QueuedApplicationDownlinks[1],
-									Error:               *ttnpb.ErrorDetailsToProto(errExpiredDownlink),
-								},
-							},
-						},
-					})
-					close(req.Response)
+				case setRespCh <- DeviceRegistrySetByIDResponse{
+					Device: setDevice,
+				}:
 				}
 
 				select {
@@ -4751,12 +5260,13 @@ func TestProcessDownlinkTask(t *testing.T) {
 		},
 
 		{
-			Name: "join-accept/windows open/RX1,RX2 available/no active MAC state/EU868",
+			Name: "join-accept/windows open/RX1,RX2 available/RX2 threshold exceeded/no active MAC state/EU868",
 			DownlinkPriorities: DownlinkPriorities{
 				JoinAccept:             ttnpb.TxSchedulePriority_HIGHEST,
 				MACCommands:            ttnpb.TxSchedulePriority_HIGH,
 				MaxApplicationDownlink: ttnpb.TxSchedulePriority_NORMAL,
 			},
+			JoinAcceptRx2Threshold: 500 * time.Millisecond,
 			Handler: func(ctx context.Context, env TestEnvironment) bool {
 				t := test.MustTFromContext(ctx)
 				a := assertions.New(t)
@@ -4889,8 +5399,6 @@ func TestProcessDownlinkTask(t *testing.T) {
 							DownlinkPaths:    paths,
 							Priority:         ttnpb.TxSchedulePriority_HIGHEST,
 							Rx1Delay:         ttnpb.RX_DELAY_5,
-							Rx1DataRateIndex: ttnpb.DATA_RATE_0,
-							Rx1Frequency:     431000000,
 							Rx2DataRateIndex: ttnpb.DATA_RATE_1,
 							Rx2Frequency:     420000000,
 						}
@@ -5011,6 +5519,7 @@ func TestProcessDownlinkTask(t *testing.T) {
 			ns, ctx, env, stopTest := StartTest(t, Config{}, (1<<10)*test.Delay, true)
 
 			ns.downlinkPriorities = tc.DownlinkPriorities
+			ns.joinAcceptRx2Threshold = tc.JoinAcceptRx2Threshold
 
 			go func() {
 				for ev := range env.Events {
@@ -5423,6 +5932,119 @@ func TestGenerateDownlink(t *testing.T) {
 				})
 			},
 		},
+		{
+			Name: "1.1/expired app downlink/unconfirmed app downlink/no MAC/no ack",
+			Device: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+					DevAddr:                &devAddr,
+				},
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion:     ttnpb.MAC_V1_1,
+					RxWindowsAvailable: true,
+				},
+				Session: &ttnpb.Session{
+					DevAddr: devAddr,
+					SessionKeys: ttnpb.SessionKeys{
+						NwkSEncKey: &ttnpb.KeyEnvelope{
+							Key: &nwkSEncKey,
+						},
+						SNwkSIntKey: &ttnpb.KeyEnvelope{
+							Key: &sNwkSIntKey,
+						},
+					},
+				},
+				QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+					{
+						Confirmed:  false,
+						FCnt:       41,
+						FPort:      1,
+						FRMPayload: []byte("expired"),
+						ExpiresAt:  TimePtr(time.Unix(42, 0)),
+					},
+					{
+						Confirmed:  false,
+						FCnt:       42,
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				FrequencyPlanID:   band.EU_863_870,
+				RecentUplinks: []*ttnpb.UplinkMessage{{
+					Payload: &ttnpb.Message{
+						MHDR: ttnpb.MHDR{
+							MType: ttnpb.MType_UNCONFIRMED_UP,
+						},
+						Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+					},
+				}},
+			},
+			Bytes: encodeMessage(&ttnpb.Message{
+				MHDR: ttnpb.MHDR{
+					MType: ttnpb.MType_UNCONFIRMED_DOWN,
+					Major: ttnpb.Major_LORAWAN_R1,
+				},
+				Payload: &ttnpb.Message_MACPayload{
+					MACPayload: &ttnpb.MACPayload{
+						FHDR: ttnpb.FHDR{
+							DevAddr: devAddr,
+							FCtrl: ttnpb.FCtrl{
+								Ack: false,
+								ADR: true,
+							},
+							FCnt: 42,
+						},
+						FPort:      1,
+						FRMPayload: []byte("test"),
+					},
+				},
+			}, ttnpb.MAC_V1_1, 0),
+			ApplicationDownlinkAssertion: func(t *testing.T, down *ttnpb.ApplicationDownlink) bool {
+				return assertions.New(t).So(down, should.Resemble, &ttnpb.ApplicationDownlink{
+					Confirmed:  false,
+					FCnt:       42,
+					FPort:      1,
+					FRMPayload: []byte("test"),
+				})
+			},
+			DeviceAssertion: func(t *testing.T, dev *ttnpb.EndDevice) bool {
+				return assertions.New(t).So(dev, should.Resemble, &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+						DevAddr:                &devAddr,
+					},
+					MACState: &ttnpb.MACState{
+						LoRaWANVersion:     ttnpb.MAC_V1_1,
+						RxWindowsAvailable: true,
+					},
+					Session: &ttnpb.Session{
+						DevAddr: devAddr,
+						SessionKeys: ttnpb.SessionKeys{
+							NwkSEncKey: &ttnpb.KeyEnvelope{
+								Key: &nwkSEncKey,
+							},
+							SNwkSIntKey: &ttnpb.KeyEnvelope{
+								Key: &sNwkSIntKey,
+							},
+						},
+					},
+					LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+					FrequencyPlanID:   band.EU_863_870,
+					RecentUplinks: []*ttnpb.UplinkMessage{{
+						Payload: &ttnpb.Message{
+							MHDR: ttnpb.MHDR{
+								MType: ttnpb.MType_UNCONFIRMED_UP,
+							},
+							Payload: &ttnpb.Message_MACPayload{MACPayload: &ttnpb.MACPayload{}},
+						},
+					}},
+					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{},
+				})
+			},
+		},
 		{
 			Name: "1.1/unconfirmed app downlink/no MAC/ack",
 			Device: &ttnpb.EndDevice{
@@ -6053,3 +6675,168 @@ func TestGenerateDownlink(t *testing.T) {
 		})
 	}
 }
+
+func TestDownlinkPathsFromMetadata(t *testing.T) {
+	mds := []*ttnpb.RxMetadata{
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-a"},
+			RSSI:               -100,
+			SNR:                10,
+			UplinkToken:        []byte("token-a"),
+			RoundTripTime:      DurationPtr(300 * time.Millisecond),
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-b"},
+			RSSI:               -60,
+			SNR:                5,
+			UplinkToken:        []byte("token-b"),
+			RoundTripTime:      DurationPtr(20 * time.Millisecond),
+		},
+		{
+			GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-c"},
+			RSSI:                   -50,
+			SNR:                    15,
+			UplinkToken:            []byte("token-c"),
+			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER,
+		},
+		{
+			GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-d"},
+			RSSI:                   -40,
+			SNR:                    20,
+			UplinkToken:            []byte("token-d"),
+			DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+		},
+		{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-e"},
+			RSSI:               -30,
+			SNR:                25,
+		},
+	}
+	for _, tc := range []struct {
+		Name             string
+		Config           DownlinkPathScoringConfig
+		ExpectedGateways []string
+	}{
+		{
+			Name:             "default",
+			ExpectedGateways: []string{"gateway-a", "gateway-b", "gateway-c"},
+		},
+		{
+			Name: "RSSI",
+			Config: DownlinkPathScoringConfig{
+				RSSIWeight: 1,
+			},
+			ExpectedGateways: []string{"gateway-b", "gateway-a", "gateway-c"},
+		},
+		{
+			Name: "SNR and RSSI",
+			Config: DownlinkPathScoringConfig{
+				SNRWeight:  1,
+				RSSIWeight: 0.1,
+			},
+			ExpectedGateways: []string{"gateway-a", "gateway-b", "gateway-c"},
+		},
+		{
+			Name: "gateway preferences",
+			Config: DownlinkPathScoringConfig{
+				GatewayPreferences: []GatewayPreference{
+					{GatewayID: "gateway-a", Preference: -10},
+					{GatewayID: "gateway-c", Preference: 100},
+				},
+			},
+			ExpectedGateways: []string{"gateway-b", "gateway-a", "gateway-c"},
+		},
+		{
+			Name: "RTT penalty",
+			Config: DownlinkPathScoringConfig{
+				RTTPenalty: 0.1,
+			},
+			ExpectedGateways: []string{"gateway-b", "gateway-a", "gateway-c"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			paths := newDownlinkPathScorer(tc.Config).downlinkPathsFromMetadata(mds...)
+			gtwIDs := make([]string, 0, len(paths))
+			for _, path := range paths {
+				gtwIDs = append(gtwIDs, path.GatewayID)
+			}
+			a.So(gtwIDs, should.Resemble, tc.ExpectedGateways)
+			a.So(mds[0].GatewayID, should.Equal, "gateway-a")
+		})
+	}
+}
+
+func TestDownlinkPathsFromRecentUplinks(t *testing.T) {
+	now := time.Unix(0, 42)
+	clock := MockClock(now)
+	defer SetTimeNow(clock.Now)()
+
+	ups := []*ttnpb.UplinkMessage{
+		{
+			ReceivedAt: now.Add(-time.Minute),
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-a"},
+					SNR:                20,
+					UplinkToken:        []byte("token-a-old"),
+				},
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-b"},
+					SNR:                10,
+					UplinkToken:        []byte("token-b-old"),
+				},
+			},
+		},
+		{
+			ReceivedAt: now.Add(-time.Second),
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "gateway-b"},
+					SNR:                5,
+					UplinkToken:        []byte("token-b"),
+				},
+				{
+					GatewayIdentifiers:     ttnpb.GatewayIdentifiers{GatewayID: "gateway-c"},
+					SNR:                    30,
+					UplinkToken:            []byte("token-c"),
+					DownlinkPathConstraint: ttnpb.DOWNLINK_PATH_CONSTRAINT_NEVER,
+				},
+			},
+		},
+	}
+	for _, tc := range []struct {
+		Name           string
+		Config         DownlinkPathScoringConfig
+		ExpectedTokens []string
+	}{
+		{
+			Name:           "last uplink",
+			ExpectedTokens: []string{"token-b"},
+		},
+		{
+			Name: "low age penalty",
+			Config: DownlinkPathScoringConfig{
+				AgePenalty: 0.1,
+			},
+			ExpectedTokens: []string{"token-a-old", "token-b"},
+		},
+		{
+			Name: "high age penalty",
+			Config: DownlinkPathScoringConfig{
+				AgePenalty: 1,
+			},
+			ExpectedTokens: []string{"token-b", "token-a-old"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			paths := newDownlinkPathScorer(tc.Config).downlinkPathsFromRecentUplinks(ups...)
+			tokens := make([]string, 0, len(paths))
+			for _, path := range paths {
+				tokens = append(tokens, string(path.GetUplinkToken()))
+			}
+			a.So(tokens, should.Resemble, tc.ExpectedTokens)
+		})
+	}
+}
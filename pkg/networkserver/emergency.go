@@ -0,0 +1,224 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+const (
+	// defaultEmergencyBroadcastDuration is the duration of an emergency broadcast without end time.
+	defaultEmergencyBroadcastDuration = time.Hour
+	// maxEmergencyBroadcastDuration is the maximum duration of an emergency broadcast.
+	maxEmergencyBroadcastDuration = 24 * time.Hour
+	// emergencyBroadcastHoldInterval is the interval in which held class B/C downlinks are retried.
+	emergencyBroadcastHoldInterval = time.Minute
+)
+
+var (
+	errEmergencyBroadcastAdmin = errors.DefinePermissionDenied(
+		"emergency_broadcast_admin", "only admins can start and stop the emergency broadcast mode",
+	)
+	errEmergencyBroadcastEnd = errors.DefineInvalidArgument(
+		"emergency_broadcast_end", "emergency broadcast must end in the future and within `{max}`",
+	)
+	errEmergencyBroadcastNotFound = errors.DefineNotFound(
+		"emergency_broadcast_not_found", "no emergency broadcast for application `{application_uid}`",
+	)
+	errEmergencyBroadcastHold = errors.DefineUnavailable(
+		"emergency_broadcast_hold", "downlink held during emergency broadcast",
+	)
+	errEmergencyBroadcastRegistry = errors.DefineUnimplemented(
+		"emergency_broadcast_registry", "no emergency broadcast registry configured",
+	)
+
+	evtStartEmergencyBroadcast = events.Define(
+		"ns.emergency_broadcast.start", "start emergency broadcast",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtStopEmergencyBroadcast = events.Define(
+		"ns.emergency_broadcast.stop", "stop emergency broadcast",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtPreemptDownlinkQueue = events.Define(
+		"ns.down.data.emergency_broadcast.preempt", "pre-empt downlink queue for emergency broadcast",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+)
+
+// EmergencyBroadcastRegistry stores the emergency broadcasts of applications, so that the emergency broadcast mode is
+// shared by all Network Server instances and survives restarts.
+type EmergencyBroadcastRegistry interface {
+	// Get returns the emergency broadcast of the application.
+	Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.EmergencyBroadcast, error)
+	// Set creates, updates or deletes the emergency broadcast of the application.
+	// The emergency broadcast is removed from the registry when it ends.
+	Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error)) (*ttnpb.EmergencyBroadcast, error)
+}
+
+// activeEmergencyBroadcast returns the emergency broadcast of the application, if it is active at the given time.
+// Errors of the registry are logged, and the application is then considered to not be in emergency broadcast mode.
+func activeEmergencyBroadcast(ctx context.Context, r EmergencyBroadcastRegistry, ids ttnpb.ApplicationIdentifiers, now time.Time) (*ttnpb.EmergencyBroadcast, bool) {
+	if r == nil {
+		return nil, false
+	}
+	broadcast, err := r.Get(ctx, ids)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.FromContext(ctx).WithError(err).Error("Failed to get emergency broadcast")
+		}
+		return nil, false
+	}
+	if !now.Before(broadcast.EndsAt) {
+		return nil, false
+	}
+	return broadcast, true
+}
+
+// isEmergencyDownlink returns whether the application downlink is an emergency message.
+func isEmergencyDownlink(down *ttnpb.ApplicationDownlink) bool {
+	return down.Priority == ttnpb.TxSchedulePriority_HIGHEST
+}
+
+// preemptDownlinkQueue returns the downlink queue with the emergency messages moved to the front, preserving the order
+// of the messages otherwise. It returns false if the queue does not need to be reordered.
+func preemptDownlinkQueue(queue []*ttnpb.ApplicationDownlink) ([]*ttnpb.ApplicationDownlink, bool) {
+	if len(queue) == 0 || isEmergencyDownlink(queue[0]) {
+		return nil, false
+	}
+	emergency := make([]*ttnpb.ApplicationDownlink, 0, len(queue))
+	other := make([]*ttnpb.ApplicationDownlink, 0, len(queue))
+	for _, down := range queue {
+		if isEmergencyDownlink(down) {
+			emergency = append(emergency, down)
+		} else {
+			other = append(other, down)
+		}
+	}
+	if len(emergency) == 0 {
+		return nil, false
+	}
+	return append(emergency, other...), true
+}
+
+// requireAdmin returns the identifiers of the caller if the caller is an admin.
+func (ns *NetworkServer) requireAdmin(ctx context.Context) (*ttnpb.OrganizationOrUserIdentifiers, error) {
+	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
+	if err != nil {
+		return nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, ns.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	info, err := ttnpb.NewEntityAccessClient(cc).AuthInfo(ctx, ttnpb.Empty, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsAdmin {
+		return nil, errEmergencyBroadcastAdmin
+	}
+	return info.GetOrganizationOrUserIdentifiers(), nil
+}
+
+// emergencyBroadcastIdentifiers returns the identifiers of the events of the emergency broadcast of the application,
+// which include the admin that started or stopped the emergency broadcast for the audit trail.
+func emergencyBroadcastIdentifiers(appIDs ttnpb.ApplicationIdentifiers, admin *ttnpb.OrganizationOrUserIdentifiers) *ttnpb.CombinedIdentifiers {
+	ids := appIDs.CombinedIdentifiers()
+	if admin != nil {
+		ids.EntityIdentifiers = append(ids.EntityIdentifiers, admin.EntityIdentifiers())
+	}
+	return ids
+}
+
+// StartEmergencyBroadcast implements ttnpb.NsServer.
+func (ns *NetworkServer) StartEmergencyBroadcast(ctx context.Context, req *ttnpb.StartEmergencyBroadcastRequest) (*ttnpb.EmergencyBroadcast, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.emergencyBroadcasts == nil {
+		return nil, errEmergencyBroadcastRegistry
+	}
+	startedBy, err := ns.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := timeNow().UTC()
+	endsAt := now.Add(defaultEmergencyBroadcastDuration)
+	if req.EndsAt != nil {
+		endsAt = req.EndsAt.UTC()
+	}
+	if !endsAt.After(now) || endsAt.Sub(now) > maxEmergencyBroadcastDuration {
+		return nil, errEmergencyBroadcastEnd.WithAttributes("max", maxEmergencyBroadcastDuration)
+	}
+	broadcast := &ttnpb.EmergencyBroadcast{
+		ApplicationIDs: req.ApplicationIDs,
+		Reason:         req.Reason,
+		StartedBy:      startedBy,
+		StartedAt:      now,
+		EndsAt:         endsAt,
+	}
+	if _, err := ns.emergencyBroadcasts.Set(ctx, req.ApplicationIDs, func(*ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error) {
+		return broadcast, nil
+	}); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"application_uid", unique.ID(ctx, req.ApplicationIDs),
+		"ends_at", endsAt,
+		"reason", req.Reason,
+	)).Warn("Start emergency broadcast")
+	events.Publish(evtStartEmergencyBroadcast(ctx, emergencyBroadcastIdentifiers(req.ApplicationIDs, startedBy), broadcast))
+	return broadcast, nil
+}
+
+// StopEmergencyBroadcast implements ttnpb.NsServer.
+func (ns *NetworkServer) StopEmergencyBroadcast(ctx context.Context, req *ttnpb.StopEmergencyBroadcastRequest) (*pbtypes.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if ns.emergencyBroadcasts == nil {
+		return nil, errEmergencyBroadcastRegistry
+	}
+	stoppedBy, err := ns.requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := timeNow()
+	if _, err := ns.emergencyBroadcasts.Set(ctx, req.ApplicationIDs, func(stored *ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error) {
+		if stored == nil || !now.Before(stored.EndsAt) {
+			return nil, errEmergencyBroadcastNotFound.WithAttributes("application_uid", unique.ID(ctx, req.ApplicationIDs))
+		}
+		return nil, nil
+	}); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"application_uid", unique.ID(ctx, req.ApplicationIDs),
+		"reason", req.Reason,
+	)).Warn("Stop emergency broadcast")
+	events.Publish(evtStopEmergencyBroadcast(ctx, emergencyBroadcastIdentifiers(req.ApplicationIDs, stoppedBy), req))
+	return ttnpb.Empty, nil
+}
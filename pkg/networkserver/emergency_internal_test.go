@@ -0,0 +1,146 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestEmergencyBroadcasts(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	start := time.Now().UTC()
+
+	appIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app"}
+	otherAppIDs := ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"}
+
+	_, ok := activeEmergencyBroadcast(ctx, nil, appIDs, start)
+	a.So(ok, should.BeFalse)
+
+	cl, flush := test.NewRedis(t, append(redisNamespace[:], "emergency-broadcasts")...)
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	// Each registry represents a Network Server instance.
+	reg := &redis.EmergencyBroadcastRegistry{Redis: cl}
+	otherReg := &redis.EmergencyBroadcastRegistry{Redis: cl}
+
+	_, ok = activeEmergencyBroadcast(ctx, reg, appIDs, start)
+	a.So(ok, should.BeFalse)
+
+	broadcast := &ttnpb.EmergencyBroadcast{
+		ApplicationIDs: appIDs,
+		Reason:         "test",
+		StartedAt:      start,
+		EndsAt:         start.Add(time.Hour),
+	}
+	set := func(pb *ttnpb.EmergencyBroadcast) func(*ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error) {
+		return func(*ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error) {
+			return pb, nil
+		}
+	}
+	_, err := reg.Set(ctx, appIDs, set(broadcast))
+	a.So(err, should.BeNil)
+
+	// The emergency broadcast is active on all Network Server instances that share the registry.
+	for _, r := range []EmergencyBroadcastRegistry{reg, otherReg} {
+		active, ok := activeEmergencyBroadcast(ctx, r, appIDs, start.Add(time.Minute))
+		a.So(ok, should.BeTrue)
+		a.So(active, should.Resemble, broadcast)
+	}
+
+	_, ok = activeEmergencyBroadcast(ctx, reg, otherAppIDs, start.Add(time.Minute))
+	a.So(ok, should.BeFalse)
+
+	_, ok = activeEmergencyBroadcast(ctx, reg, appIDs, start.Add(time.Hour))
+	a.So(ok, should.BeFalse)
+
+	// The emergency broadcast expires when it ends.
+	ttl, err := cl.PTTL(cl.Key("uid", unique.ID(ctx, appIDs))).Result()
+	a.So(err, should.BeNil)
+	a.So(ttl, should.BeBetweenOrEqual, 59*time.Minute, time.Hour)
+
+	_, err = otherReg.Set(ctx, appIDs, set(nil))
+	a.So(err, should.BeNil)
+	_, err = reg.Get(ctx, appIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, ok = activeEmergencyBroadcast(ctx, reg, appIDs, start.Add(time.Minute))
+	a.So(ok, should.BeFalse)
+
+	// Emergency broadcasts that ended are not stored.
+	ended := *broadcast
+	ended.EndsAt = start.Add(-time.Minute)
+	_, err = reg.Set(ctx, appIDs, set(&ended))
+	a.So(err, should.BeNil)
+	_, err = reg.Get(ctx, appIDs)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestPreemptDownlinkQueue(t *testing.T) {
+	normal := func(fCnt uint32) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{FCnt: fCnt, FPort: 1, Priority: ttnpb.TxSchedulePriority_NORMAL}
+	}
+	emergency := func(fCnt uint32) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{FCnt: fCnt, FPort: 2, Priority: ttnpb.TxSchedulePriority_HIGHEST}
+	}
+
+	for _, tc := range []struct {
+		Name      string
+		Queue     []*ttnpb.ApplicationDownlink
+		Expected  []*ttnpb.ApplicationDownlink
+		Preempted bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name:  "NoEmergency",
+			Queue: []*ttnpb.ApplicationDownlink{normal(1), normal(2)},
+		},
+		{
+			Name:  "EmergencyFirst",
+			Queue: []*ttnpb.ApplicationDownlink{emergency(1), normal(2)},
+		},
+		{
+			Name:      "EmergencyLast",
+			Queue:     []*ttnpb.ApplicationDownlink{normal(1), normal(2), emergency(3)},
+			Expected:  []*ttnpb.ApplicationDownlink{emergency(3), normal(1), normal(2)},
+			Preempted: true,
+		},
+		{
+			Name:      "Interleaved",
+			Queue:     []*ttnpb.ApplicationDownlink{normal(1), emergency(2), normal(3), emergency(4)},
+			Expected:  []*ttnpb.ApplicationDownlink{emergency(2), emergency(4), normal(1), normal(3)},
+			Preempted: true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			queue, ok := preemptDownlinkQueue(tc.Queue)
+			a.So(ok, should.Equal, tc.Preempted)
+			a.So(queue, should.Resemble, tc.Expected)
+		})
+	}
+}
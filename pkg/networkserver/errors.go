@@ -20,6 +20,10 @@ import (
 
 var (
 	errABPJoinRequest             = errors.DefineInvalidArgument("abp_join_request", "received a join-request from ABP device")
+	errApplicationDownlinkFPort   = errors.DefineInvalidArgument("application_downlink_f_port", "FPort `{f_port}` of application downlink is reserved for MAC commands", "f_port")
+	errApplicationDownlinkFOpts   = errors.DefineInvalidArgument("application_downlink_f_opts", "application downlink payload length `{length}` exceeds maximum of `{max_length}` at data rate `{data_rate_index}` with `{f_opts_length}` bytes of queued MAC commands", "length", "max_length", "data_rate_index", "f_opts_length")
+	errApplicationDownlinkLength  = errors.DefineInvalidArgument("application_downlink_length", "application downlink payload length `{length}` exceeds maximum of `{max_length}` at data rate `{data_rate_index}`", "length", "max_length", "data_rate_index")
+	errBulkOperation              = errors.Define("bulk_operation", "operation failed")
 	errClassAMulticast            = errors.DefineInvalidArgument("class_a_multicast", "multicast device in class A mode")
 	errClassBCForClassA           = errors.DefineInvalidArgument("class_b_c_for_class_a", "class B/C downlink queued for device in class A mode")
 	errComputeMIC                 = errors.DefineInvalidArgument("compute_mic", "failed to compute MIC")
@@ -28,6 +32,8 @@ var (
 	errCorruptedMACState          = errors.DefineCorruption("corrupted_mac_state", "MAC state is corrupted")
 	errDataRateNotFound           = errors.DefineNotFound("data_rate_not_found", "data rate not found")
 	errDecodePayload              = errors.DefineInvalidArgument("decode_payload", "failed to decode payload")
+	errDeviceApplicationMismatch  = errors.DefineInvalidArgument("device_application_mismatch", "device `{device_uid}` does not belong to application `{application_uid}`", "device_uid", "application_uid")
+	errDeviceDecommissioned       = errors.DefineFailedPrecondition("device_decommissioned", "device is decommissioned")
 	errDeviceNotFound             = errors.DefineNotFound("device_not_found", "device not found")
 	errEmptySession               = errors.DefineFailedPrecondition("empty_session", "session in empty")
 	errEncodeMAC                  = errors.DefineInternal("encode_mac", "failed to encode MAC commands")
@@ -35,6 +41,7 @@ var (
 	errEncryptMAC                 = errors.DefineInternal("encrypt_mac", "failed to encrypt MAC commands")
 	errExpiredDownlink            = errors.DefineFailedPrecondition("downlink_expired", "queued downlink is expired")
 	errFCntTooLow                 = errors.DefineInvalidArgument("f_cnt_too_low", "FCnt is too low")
+	errForbiddenFieldMaskPaths    = errors.DefineInvalidArgument("field_mask_paths", "forbidden path(s) in field mask", "forbidden_paths")
 	errInvalidAbsoluteTime        = errors.DefineInvalidArgument("absolute_time", "invalid absolute time set in application downlink")
 	errInvalidChannelIndex        = errors.DefineInvalidArgument("channel_index", "invalid channel index")
 	errInvalidConfiguration       = errors.DefineInvalidArgument("configuration", "invalid configuration")
@@ -44,12 +51,14 @@ var (
 	errInvalidFixedPaths          = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
 	errInvalidPayload             = errors.DefineInvalidArgument("payload", "invalid payload")
 	errJoinServerNotFound         = errors.DefineNotFound("join_server_not_found", "Join Server not found")
+	errLifecycleStateTransition   = errors.DefineFailedPrecondition("lifecycle_state_transition", "lifecycle state of device `{device_uid}` can not change from `{from}` to `{to}`", "device_uid", "from", "to")
 	errMACRequestNotFound         = errors.DefineInvalidArgument("mac_request_not_found", "MAC response received, but corresponding request not found")
 	errNoDevEUI                   = errors.DefineInvalidArgument("no_dev_eui", "no DevEUI specified")
 	errNoJoinEUI                  = errors.DefineInvalidArgument("no_join_eui", "no JoinEUI specified")
 	errNoPath                     = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errNoPayload                  = errors.DefineInvalidArgument("no_payload", "no message payload specified")
 	errOutdatedData               = errors.DefineNotFound("outdated_data", "data is outdated")
+	errPartialLifecycleStates     = errors.DefineAborted("partial_lifecycle_states", "lifecycle state of devices `{applied_device_ids}` changed, but not of device `{device_id}`", "applied_device_ids", "device_id")
 	errRawPayloadTooShort         = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
 	errSchedule                   = errors.Define("schedule", "all downlink scheduling attempts failed")
 	errUnknownChannel             = errors.Define("unknown_chanel", "channel is unknown")
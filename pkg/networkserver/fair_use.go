@@ -0,0 +1,149 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/toa"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errFairUseWindow   = errors.DefineInvalidArgument("fair_use_window", "fair use window must be positive")
+	errFairUseRegistry = errors.DefineInvalidArgument("fair_use_registry", "no fair use registry configured")
+)
+
+var evtExceedFairUse = events.Define(
+	"ns.up.data.fair_use.exceed", "exceed uplink airtime of fair use policy",
+	ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+)
+
+// FairUseRegistry stores the uplink airtime of end devices per window.
+type FairUseRegistry interface {
+	// AddAirtime adds the airtime to the usage of the end device in the window that starts at start, and returns the
+	// accumulated airtime in the window. The usage expires after ttl.
+	AddAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, start time.Time, airtime, ttl time.Duration) (time.Duration, error)
+	// GetAirtime returns the accumulated airtime of the end device in the window that starts at start.
+	GetAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, start time.Time) (time.Duration, error)
+}
+
+// fairUsePolicy accumulates the uplink airtime of end devices per window in the registry, and reports the end devices
+// that exceed the limit. The windows are aligned to the zero time, so that all Network Server instances that share the
+// registry use the same windows.
+type fairUsePolicy struct {
+	registry        FairUseRegistry
+	limit           time.Duration
+	window          time.Duration
+	stopDownlink    bool
+	adrMarginOffset float32
+}
+
+func newFairUsePolicy(conf FairUseConfig) (*fairUsePolicy, error) {
+	if conf.Window <= 0 {
+		return nil, errFairUseWindow
+	}
+	if conf.Registry == nil {
+		return nil, errFairUseRegistry
+	}
+	return &fairUsePolicy{
+		registry:        conf.Registry,
+		limit:           conf.UplinkAirtime,
+		window:          conf.Window,
+		stopDownlink:    conf.StopDownlink,
+		adrMarginOffset: conf.ADRMarginOffset,
+	}, nil
+}
+
+// add adds the airtime to the usage of the end device at the given time.
+// It returns the accumulated airtime in the window, and whether the end device exceeded the limit with this airtime.
+func (p *fairUsePolicy) add(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, now time.Time, airtime time.Duration) (time.Duration, bool, error) {
+	start := now.Truncate(p.window)
+	total, err := p.registry.AddAirtime(ctx, ids, start, airtime, start.Add(p.window).Sub(now))
+	if err != nil {
+		return 0, false, err
+	}
+	return total, total > p.limit && total-airtime <= p.limit, nil
+}
+
+// exceeds returns whether the end device exceeded the limit in the current window.
+func (p *fairUsePolicy) exceeds(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, now time.Time) (bool, error) {
+	total, err := p.registry.GetAirtime(ctx, ids, now.Truncate(p.window))
+	if err != nil {
+		return false, err
+	}
+	return total > p.limit, nil
+}
+
+// HandleUplink accumulates the airtime of the deduplicated data uplink message of the end device.
+// It returns the event to publish if the end device exceeded the limit with this message.
+func (p *fairUsePolicy) HandleUplink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, up *ttnpb.UplinkMessage) events.DefinitionDataClosure {
+	if p == nil {
+		return nil
+	}
+	airtime, err := toa.Compute(len(up.RawPayload), up.Settings)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Failed to compute airtime of uplink message")
+		return nil
+	}
+	total, exceeded, err := p.add(ctx, ids, timeNow(), airtime)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to add airtime of uplink message to fair use registry")
+		return nil
+	}
+	if !exceeded {
+		return nil
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"airtime", total,
+		"limit", p.limit,
+	)).Info("Device exceeds uplink airtime of fair use policy")
+	return evtExceedFairUse.BindData(map[string]interface{}{
+		"airtime": total,
+		"limit":   p.limit,
+		"window":  p.window,
+	})
+}
+
+// exceedsNow returns whether the end device exceeded the limit in the current window.
+// Errors of the registry are logged, and the end device is then considered to be within the limit.
+func (p *fairUsePolicy) exceedsNow(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) bool {
+	exceeds, err := p.exceeds(ctx, ids, timeNow())
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get airtime from fair use registry")
+		return false
+	}
+	return exceeds
+}
+
+// StopDownlink returns whether application downlink messages should not be sent to the end device.
+func (p *fairUsePolicy) StopDownlink(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) bool {
+	if p == nil || !p.stopDownlink {
+		return false
+	}
+	return p.exceedsNow(ctx, ids)
+}
+
+// ADRMarginOffset returns the offset of the ADR margin of the end device.
+func (p *fairUsePolicy) ADRMarginOffset(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) float32 {
+	if p == nil || p.adrMarginOffset == 0 || !p.exceedsNow(ctx, ids) {
+		return 0
+	}
+	return p.adrMarginOffset
+}
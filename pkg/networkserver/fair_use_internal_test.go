@@ -0,0 +1,193 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func newRedisFairUseRegistry(t *testing.T) (FairUseRegistry, func()) {
+	cl, flush := test.NewRedis(t, append(redisNamespace[:], "fair-use")...)
+	return &redis.FairUseRegistry{
+		Redis: cl,
+	}, func() {
+		flush()
+		cl.Close()
+	}
+}
+
+func TestNewFairUsePolicy(t *testing.T) {
+	a := assertions.New(t)
+
+	reg := &redis.FairUseRegistry{}
+
+	_, err := newFairUsePolicy(FairUseConfig{Registry: reg, UplinkAirtime: 30 * time.Second})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = newFairUsePolicy(FairUseConfig{UplinkAirtime: 30 * time.Second, Window: 24 * time.Hour})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	p, err := newFairUsePolicy(FairUseConfig{Registry: reg, UplinkAirtime: 30 * time.Second, Window: 24 * time.Hour})
+	a.So(err, should.BeNil)
+	a.So(p, should.NotBeNil)
+}
+
+func TestFairUsePolicy(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	reg, closeFn := newRedisFairUseRegistry(t)
+	defer closeFn()
+
+	p, err := newFairUsePolicy(FairUseConfig{
+		Registry:      reg,
+		UplinkAirtime: time.Second,
+		Window:        time.Hour,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	start := time.Unix(1577836800, 0)
+	dev1 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+	dev2 := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev2",
+	}
+
+	for _, step := range []struct {
+		Name     string
+		IDs      ttnpb.EndDeviceIdentifiers
+		At       time.Time
+		Airtime  time.Duration
+		Total    time.Duration
+		Exceeded bool
+		Exceeds  bool
+	}{
+		{
+			Name:    "WithinLimit",
+			IDs:     dev1,
+			At:      start,
+			Airtime: 600 * time.Millisecond,
+			Total:   600 * time.Millisecond,
+		},
+		{
+			Name:    "AtLimit",
+			IDs:     dev1,
+			At:      start.Add(time.Minute),
+			Airtime: 400 * time.Millisecond,
+			Total:   time.Second,
+		},
+		{
+			Name:     "ExceedLimit",
+			IDs:      dev1,
+			At:       start.Add(2 * time.Minute),
+			Airtime:  100 * time.Millisecond,
+			Total:    1100 * time.Millisecond,
+			Exceeded: true,
+			Exceeds:  true,
+		},
+		{
+			Name:    "ExceedLimitAgain",
+			IDs:     dev1,
+			At:      start.Add(3 * time.Minute),
+			Airtime: 100 * time.Millisecond,
+			Total:   1200 * time.Millisecond,
+			Exceeds: true,
+		},
+		{
+			Name:    "OtherDevice",
+			IDs:     dev2,
+			At:      start.Add(3 * time.Minute),
+			Airtime: 100 * time.Millisecond,
+			Total:   100 * time.Millisecond,
+		},
+		{
+			Name:    "NextWindow",
+			IDs:     dev1,
+			At:      start.Add(time.Hour),
+			Airtime: 100 * time.Millisecond,
+			Total:   100 * time.Millisecond,
+		},
+	} {
+		total, exceeded, err := p.add(ctx, step.IDs, step.At, step.Airtime)
+		a.So(err, should.BeNil)
+		a.So(total, should.Equal, step.Total)
+		a.So(exceeded, should.Equal, step.Exceeded)
+		exceeds, err := p.exceeds(ctx, step.IDs, step.At)
+		a.So(err, should.BeNil)
+		a.So(exceeds, should.Equal, step.Exceeds)
+	}
+}
+
+func TestFairUsePolicyInstances(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	reg, closeFn := newRedisFairUseRegistry(t)
+	defer closeFn()
+
+	// Each policy represents a Network Server instance that receives part of the uplink messages of the end device.
+	conf := FairUseConfig{
+		Registry:      reg,
+		UplinkAirtime: time.Second,
+		Window:        time.Hour,
+	}
+	p1, err := newFairUsePolicy(conf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	p2, err := newFairUsePolicy(conf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	start := time.Unix(1577836800, 0)
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "app1"},
+		DeviceID:               "dev1",
+	}
+
+	total, exceeded, err := p1.add(ctx, ids, start, 600*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, 600*time.Millisecond)
+	a.So(exceeded, should.BeFalse)
+
+	total, exceeded, err = p2.add(ctx, ids, start.Add(time.Minute), 600*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, 1200*time.Millisecond)
+	a.So(exceeded, should.BeTrue)
+
+	// The limit is exceeded once per window, regardless of the instance that handles the uplink message.
+	total, exceeded, err = p1.add(ctx, ids, start.Add(2*time.Minute), 100*time.Millisecond)
+	a.So(err, should.BeNil)
+	a.So(total, should.Equal, 1300*time.Millisecond)
+	a.So(exceeded, should.BeFalse)
+
+	for _, p := range []*fairUsePolicy{p1, p2} {
+		exceeds, err := p.exceeds(ctx, ids, start.Add(3*time.Minute))
+		a.So(err, should.BeNil)
+		a.So(exceeds, should.BeTrue)
+	}
+}
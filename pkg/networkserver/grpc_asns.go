@@ -23,7 +23,10 @@ import (
 
 	pbtypes "github.com/gogo/protobuf/types"
 	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/band"
+	"go.thethings.network/lorawan-stack/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -130,6 +133,8 @@ func validateApplicationDownlinks(macState ttnpb.MACState, session ttnpb.Session
 // - The device has neither MACState and Session, nor PendingMACState and PendingSession set.
 // - Items belong to different sessions;
 // - An item has ClassBC set, but device is in Class A mode.
+// - An item has expired.
+// - An item's FPort is 0, which is reserved for MAC commands.
 // - An item's FRMPayload is longer than 250.
 // - An item's session is neither the device's session or pending session;
 // - An item's FCnt is not higher than the previous for the corresponding session;
@@ -147,6 +152,12 @@ func validateQueuedApplicationDownlinks(dev *ttnpb.EndDevice) error {
 		if absTime := down.GetClassBC().GetAbsoluteTime(); absTime != nil && absTime.Before(timeNow()) {
 			return errExpiredDownlink
 		}
+		if down.ExpiresAt != nil && down.ExpiresAt.Before(timeNow()) {
+			return errExpiredDownlink
+		}
+		if down.FPort == 0 {
+			return errApplicationDownlinkFPort.WithAttributes("f_port", down.FPort)
+		}
 		if len(down.FRMPayload) > 250 {
 			return errInvalidPayload
 		}
@@ -168,6 +179,87 @@ func validateQueuedApplicationDownlinks(dev *ttnpb.EndDevice) error {
 	}
 }
 
+// queuedResponsesLength returns the length of the MAC command answers queued in macState, which are sent in FOpts of
+// the next application downlink. If the answers do not fit in FOpts, they are sent on FPort 0 and 0 is returned.
+func queuedResponsesLength(macState *ttnpb.MACState) uint16 {
+	var n uint16
+	for _, cmd := range macState.GetQueuedResponses() {
+		desc := lorawan.DefaultMACCommands[cmd.CID]
+		if desc == nil {
+			return 0
+		}
+		n += 1 + desc.DownlinkLength
+	}
+	if n > fOptsCapacity {
+		return 0
+	}
+	return n
+}
+
+// maxDownlinkDataRateIndex returns the highest data rate index at which a downlink may be transmitted to the device
+// given the MAC state and the most recent uplink: the Rx2 data rate, the ping slot data rate for class B or the Rx1 data
+// rate corresponding to the most recent data uplink.
+func maxDownlinkDataRateIndex(macState *ttnpb.MACState, phy band.Band, ups ...*ttnpb.UplinkMessage) ttnpb.DataRateIndex {
+	params := macState.CurrentParameters
+	drIdx := params.Rx2DataRateIndex
+	if macState.DeviceClass == ttnpb.CLASS_B && params.PingSlotDataRateIndex > drIdx {
+		drIdx = params.PingSlotDataRateIndex
+	}
+	if len(ups) == 0 {
+		return drIdx
+	}
+	up := ups[len(ups)-1]
+	if up.Payload == nil {
+		return drIdx
+	}
+	switch up.Payload.MHDR.MType {
+	case ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP:
+		rx1DRIdx, err := phy.Rx1DataRate(up.Settings.DataRateIndex, params.Rx1DataRateOffset, params.DownlinkDwellTime.GetValue())
+		if err == nil && rx1DRIdx > drIdx {
+			drIdx = rx1DRIdx
+		}
+	}
+	return drIdx
+}
+
+// validateApplicationDownlinkLengths validates that the FRMPayload of the given application downlinks fits in the
+// largest downlink the device can receive in the band. fOptsLen is the length of the MAC commands which are sent in FOpts
+// together with the first downlink.
+// This function returns nil if the device has no MAC state, as the length is then validated on scheduling.
+func validateApplicationDownlinkLengths(dev *ttnpb.EndDevice, fp *frequencyplans.FrequencyPlan, phy band.Band, fOptsLen uint16, downs ...*ttnpb.ApplicationDownlink) error {
+	macState := dev.MACState
+	if macState == nil {
+		macState = dev.PendingMACState
+	}
+	if macState == nil {
+		return nil
+	}
+	drIdx := maxDownlinkDataRateIndex(macState, phy, dev.RecentUplinks...)
+	if int(drIdx) >= len(phy.DataRates) {
+		return errDataRateNotFound
+	}
+	// NOTE: len(MHDR) + len(FHDR) + len(MIC) = 1 + 7 + 4 = 12, see generateDownlink.
+	maxLen := int(phy.DataRates[drIdx].DefaultMaxSize.PayloadSize(fp.DwellTime.GetDownlinks())) - 12
+	for i, down := range downs {
+		if len(down.FRMPayload) > maxLen {
+			return errApplicationDownlinkLength.WithAttributes(
+				"length", len(down.FRMPayload),
+				"max_length", maxLen,
+				"data_rate_index", drIdx,
+			)
+		}
+		if i == 0 && fOptsLen > 0 && len(down.FRMPayload)+int(fOptsLen) > maxLen {
+			return errApplicationDownlinkFOpts.WithAttributes(
+				"length", len(down.FRMPayload),
+				"max_length", maxLen,
+				"data_rate_index", drIdx,
+				"f_opts_length", fOptsLen,
+			)
+		}
+	}
+	return nil
+}
+
 // DownlinkQueueReplace is called by the Application Server to completely replace the downlink queue for a device.
 func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*pbtypes.Empty, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_LINK); err != nil {
@@ -177,6 +269,7 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 	logger := log.FromContext(ctx).WithField("device_uid", unique.ID(ctx, req.EndDeviceIdentifiers))
 
 	gets := []string{
+		"lifecycle_state",
 		"mac_state",
 		"multicast",
 		"pending_mac_state",
@@ -199,10 +292,24 @@ func (ns *NetworkServer) DownlinkQueueReplace(ctx context.Context, req *ttnpb.Do
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if len(req.Downlinks) > 0 && dev.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED {
+				return nil, nil, errDeviceDecommissioned
+			}
 			dev.QueuedApplicationDownlinks = req.Downlinks
 			if err := validateQueuedApplicationDownlinks(dev); err != nil {
 				return nil, nil, err
 			}
+			if len(req.Downlinks) > 0 {
+				if fp, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans); err == nil {
+					macState := dev.MACState
+					if macState == nil {
+						macState = dev.PendingMACState
+					}
+					if err := validateApplicationDownlinkLengths(dev, fp, phy, queuedResponsesLength(macState), req.Downlinks...); err != nil {
+						return nil, nil, err
+					}
+				}
+			}
 			return dev, []string{"queued_application_downlinks"}, nil
 		},
 	)
@@ -250,6 +357,7 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 		[]string{
 			"frequency_plan_id",
 			"last_dev_status_received_at",
+			"lifecycle_state",
 			"lorawan_phy_version",
 			"mac_settings",
 			"mac_state",
@@ -264,10 +372,26 @@ func (ns *NetworkServer) DownlinkQueuePush(ctx context.Context, req *ttnpb.Downl
 			if dev == nil {
 				return nil, nil, errDeviceNotFound
 			}
+			if dev.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED {
+				return nil, nil, errDeviceDecommissioned
+			}
+			var fOptsLen uint16
+			if len(dev.QueuedApplicationDownlinks) == 0 {
+				macState := dev.MACState
+				if macState == nil {
+					macState = dev.PendingMACState
+				}
+				fOptsLen = queuedResponsesLength(macState)
+			}
 			dev.QueuedApplicationDownlinks = append(dev.QueuedApplicationDownlinks, req.Downlinks...)
 			if err := validateQueuedApplicationDownlinks(dev); err != nil {
 				return nil, nil, err
 			}
+			if fp, phy, err := getDeviceBandVersion(dev, ns.FrequencyPlans); err == nil {
+				if err := validateApplicationDownlinkLengths(dev, fp, phy, fOptsLen, req.Downlinks...); err != nil {
+					return nil, nil, err
+				}
+			}
 			return dev, []string{"queued_application_downlinks"}, nil
 		},
 	)
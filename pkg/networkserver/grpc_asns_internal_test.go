@@ -0,0 +1,200 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestValidateQueuedApplicationDownlinksFPort(t *testing.T) {
+	a := assertions.New(t)
+
+	dev := &ttnpb.EndDevice{
+		MACState: &ttnpb.MACState{
+			LoRaWANVersion: ttnpb.MAC_V1_1,
+		},
+		Session: &ttnpb.Session{},
+		QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
+			{FPort: 1, FCnt: 1},
+			{FPort: 0, FCnt: 2},
+		},
+	}
+	a.So(validateQueuedApplicationDownlinks(dev), should.HaveSameErrorDefinitionAs, errApplicationDownlinkFPort)
+
+	dev.QueuedApplicationDownlinks = dev.QueuedApplicationDownlinks[:1]
+	a.So(validateQueuedApplicationDownlinks(dev), should.BeNil)
+}
+
+func TestQueuedResponsesLength(t *testing.T) {
+	for _, tc := range []struct {
+		Name      string
+		MACState  *ttnpb.MACState
+		Responses []*ttnpb.MACCommand
+		Expected  uint16
+	}{
+		{
+			Name: "No MAC state",
+		},
+		{
+			Name:     "No responses",
+			MACState: &ttnpb.MACState{},
+		},
+		{
+			Name: "LinkCheckAns",
+			MACState: &ttnpb.MACState{
+				QueuedResponses: []*ttnpb.MACCommand{
+					(&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand(),
+				},
+			},
+			Expected: 3,
+		},
+		{
+			Name: "Exceeds FOpts",
+			MACState: &ttnpb.MACState{
+				QueuedResponses: []*ttnpb.MACCommand{
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+					(&ttnpb.MACCommand_LinkCheckAns{}).MACCommand(),
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			assertions.New(t).So(queuedResponsesLength(tc.MACState), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestValidateApplicationDownlinkLengths(t *testing.T) {
+	fp, err := frequencyplans.NewStore(test.FrequencyPlansFetcher).GetByID(test.EUFrequencyPlanID)
+	if err != nil {
+		t.Fatalf("Failed to get frequency plan: %s", err)
+	}
+	dev := &ttnpb.EndDevice{
+		FrequencyPlanID:   test.EUFrequencyPlanID,
+		LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+	}
+	_, phy, err := getDeviceBandVersion(dev, frequencyplans.NewStore(test.FrequencyPlansFetcher))
+	if err != nil {
+		t.Fatalf("Failed to get band: %s", err)
+	}
+	// NOTE: Maximum FRMPayload length is 59 - 12 = 47 at DR0 and 230 - 12 = 218 at DR5.
+	macState := &ttnpb.MACState{
+		DeviceClass: ttnpb.CLASS_A,
+		CurrentParameters: ttnpb.MACParameters{
+			Rx2DataRateIndex: ttnpb.DATA_RATE_0,
+		},
+	}
+	dataUplink := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_UP},
+		},
+		Settings: ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_5},
+	}
+	joinRequest := &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{MType: ttnpb.MType_JOIN_REQUEST},
+		},
+		Settings: ttnpb.TxSettings{DataRateIndex: ttnpb.DATA_RATE_5},
+	}
+	makeDownlink := func(n int) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{FPort: 1, FRMPayload: bytes.Repeat([]byte{0x42}, n)}
+	}
+
+	for _, tc := range []struct {
+		Name          string
+		MACState      *ttnpb.MACState
+		Uplinks       []*ttnpb.UplinkMessage
+		FOptsLength   uint16
+		Downlinks     []*ttnpb.ApplicationDownlink
+		ExpectedError error
+	}{
+		{
+			Name:      "No MAC state",
+			Downlinks: []*ttnpb.ApplicationDownlink{makeDownlink(250)},
+		},
+		{
+			Name:      "Rx2",
+			MACState:  macState,
+			Downlinks: []*ttnpb.ApplicationDownlink{makeDownlink(47), makeDownlink(47)},
+		},
+		{
+			Name:          "Rx2 too long",
+			MACState:      macState,
+			Downlinks:     []*ttnpb.ApplicationDownlink{makeDownlink(47), makeDownlink(48)},
+			ExpectedError: errApplicationDownlinkLength,
+		},
+		{
+			Name:      "Rx1",
+			MACState:  macState,
+			Uplinks:   []*ttnpb.UplinkMessage{dataUplink},
+			Downlinks: []*ttnpb.ApplicationDownlink{makeDownlink(218)},
+		},
+		{
+			Name:          "Rx1 too long",
+			MACState:      macState,
+			Uplinks:       []*ttnpb.UplinkMessage{dataUplink},
+			Downlinks:     []*ttnpb.ApplicationDownlink{makeDownlink(219)},
+			ExpectedError: errApplicationDownlinkLength,
+		},
+		{
+			Name:          "Join-request",
+			MACState:      macState,
+			Uplinks:       []*ttnpb.UplinkMessage{dataUplink, joinRequest},
+			Downlinks:     []*ttnpb.ApplicationDownlink{makeDownlink(48)},
+			ExpectedError: errApplicationDownlinkLength,
+		},
+		{
+			Name:        "FOpts",
+			MACState:    macState,
+			FOptsLength: 3,
+			Downlinks:   []*ttnpb.ApplicationDownlink{makeDownlink(44), makeDownlink(47)},
+		},
+		{
+			Name:          "FOpts too long",
+			MACState:      macState,
+			FOptsLength:   3,
+			Downlinks:     []*ttnpb.ApplicationDownlink{makeDownlink(45)},
+			ExpectedError: errApplicationDownlinkFOpts,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			dev := &ttnpb.EndDevice{
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_0_2_REV_B,
+				MACState:          tc.MACState,
+				RecentUplinks:     tc.Uplinks,
+			}
+			err := validateApplicationDownlinkLengths(dev, fp, phy, tc.FOptsLength, tc.Downlinks...)
+			if tc.ExpectedError != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.ExpectedError)
+			} else {
+				a.So(err, should.BeNil)
+			}
+		})
+	}
+}
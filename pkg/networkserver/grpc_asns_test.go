@@ -319,7 +319,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -357,6 +357,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -382,7 +383,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -420,6 +421,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -443,10 +445,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeError) {
@@ -463,8 +465,8 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -502,6 +504,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -529,10 +532,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -558,8 +561,8 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 0},
-						{SessionKeyID: []byte("testSession"), FCnt: 42},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 					},
 				})
 				return dev, nil
@@ -570,8 +573,8 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			SetByIDCalls: 1,
@@ -607,6 +610,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -634,10 +638,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -663,8 +667,8 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 0},
-						{SessionKeyID: []byte("testSession"), FCnt: 42},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 					},
 				})
 				return dev, nil
@@ -675,8 +679,8 @@ func TestDownlinkQueueReplace(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			AddCalls:     1,
@@ -706,6 +710,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"lifecycle_state",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -726,10 +731,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeError) {
@@ -779,6 +784,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"lifecycle_state",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -803,10 +809,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -866,6 +872,7 @@ func TestDownlinkQueueReplace(t *testing.T) {
 				a.So(appID, should.Resemble, ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"})
 				a.So(devID, should.Equal, "test-dev-id")
 				a.So(gets, should.HaveSameElementsDeep, []string{
+					"lifecycle_state",
 					"mac_state",
 					"multicast",
 					"pending_mac_state",
@@ -890,10 +897,10 @@ func TestDownlinkQueueReplace(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -1025,7 +1032,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1063,6 +1070,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1089,7 +1097,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1127,6 +1135,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1150,10 +1159,10 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeError) {
@@ -1170,8 +1179,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1209,6 +1218,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1236,10 +1246,10 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -1265,12 +1275,12 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
-						{SessionKeyID: []byte("testSession"), FCnt: 6},
-						{SessionKeyID: []byte("testSession"), FCnt: 42},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 6},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 					},
 				})
 				return dev, nil
@@ -1281,8 +1291,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 6},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 6},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			SetByIDCalls: 1,
@@ -1313,6 +1323,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1345,10 +1356,10 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeNil) {
@@ -1379,12 +1390,12 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
-						{SessionKeyID: []byte("testSession"), FCnt: 6},
-						{SessionKeyID: []byte("testSession"), FCnt: 42},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 6},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 					},
 				})
 				return dev, nil
@@ -1395,8 +1406,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 6},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 6},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			SetByIDCalls: 1,
@@ -1432,6 +1443,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1459,10 +1471,10 @@ func TestDownlinkQueuePush(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 1},
-						{SessionKeyID: []byte("testSession"), FCnt: 2},
-						{SessionKeyID: []byte("testSession"), FCnt: 3},
-						{SessionKeyID: []byte("testSession"), FCnt: 5},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 2},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 3},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 5},
 					},
 				})
 				if !a.So(err, should.BeError) {
@@ -1479,8 +1491,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 1},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1523,6 +1535,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1566,8 +1579,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 1},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1610,6 +1623,7 @@ func TestDownlinkQueuePush(t *testing.T) {
 				a.So(gets, should.HaveSameElementsDeep, []string{
 					"frequency_plan_id",
 					"last_dev_status_received_at",
+					"lifecycle_state",
 					"lorawan_phy_version",
 					"mac_settings",
 					"mac_state",
@@ -1647,8 +1661,8 @@ func TestDownlinkQueuePush(t *testing.T) {
 					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
 				},
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 1},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 1},
 				},
 			},
 			ErrorAssertion: func(t *testing.T, err error) bool {
@@ -1823,8 +1837,8 @@ func TestDownlinkQueueList(t *testing.T) {
 						},
 					},
 					QueuedApplicationDownlinks: []*ttnpb.ApplicationDownlink{
-						{SessionKeyID: []byte("testSession"), FCnt: 0},
-						{SessionKeyID: []byte("testSession"), FCnt: 42},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+						{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 					},
 				}, nil
 			},
@@ -1834,8 +1848,8 @@ func TestDownlinkQueueList(t *testing.T) {
 			},
 			Downlinks: &ttnpb.ApplicationDownlinks{
 				Downlinks: []*ttnpb.ApplicationDownlink{
-					{SessionKeyID: []byte("testSession"), FCnt: 0},
-					{SessionKeyID: []byte("testSession"), FCnt: 42},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 0},
+					{SessionKeyID: []byte("testSession"), FPort: 1, FCnt: 42},
 				},
 			},
 			GetByIDCalls: 1,
@@ -16,6 +16,7 @@ package networkserver
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	pbtypes "github.com/gogo/protobuf/types"
@@ -26,6 +27,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/log"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
 )
 
 var (
@@ -41,8 +43,27 @@ var (
 		"ns.end_device.delete", "delete end device",
 		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
 	)
+	evtChangeLifecycleState = events.Define(
+		"ns.end_device.lifecycle_state.change", "change end device lifecycle state",
+		ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+	)
 )
 
+func lifecycleStateChangeData(from, to ttnpb.EndDeviceLifecycleState) map[string]interface{} {
+	return map[string]interface{}{
+		"previous_lifecycle_state": from.String(),
+		"lifecycle_state":          to.String(),
+	}
+}
+
+func lifecycleStateTransitionError(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, from, to ttnpb.EndDeviceLifecycleState) error {
+	return errLifecycleStateTransition.WithAttributes(
+		"device_uid", unique.ID(ctx, ids),
+		"from", from.String(),
+		"to", to.String(),
+	)
+}
+
 // Get implements NsEndDeviceRegistryServer.
 func (ns *NetworkServer) Get(ctx context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
 	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
@@ -367,6 +388,7 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	var needsDownlinkCheck bool
 	if ttnpb.HasAnyField([]string{
 		"frequency_plan_id",
+		"lifecycle_state",
 		"lorawan_phy_version",
 		"mac_settings",
 		"mac_state",
@@ -389,12 +411,28 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 		needsDownlinkCheck = true
 	}
 
-	var evt events.Event
+	var evt, lifecycleEvt events.Event
 	dev, err = ns.devices.SetByID(ctx, req.EndDevice.EndDeviceIdentifiers.ApplicationIdentifiers, req.EndDevice.EndDeviceIdentifiers.DeviceID, gets, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 		if ttnpb.HasAnyField(sets, "version_ids") {
 			// TODO: Apply version IDs (https://github.com/TheThingsIndustries/lorawan-stack/issues/1544)
 		}
 
+		if ttnpb.HasAnyField(sets, "mac_settings.adr_min_data_rate_index", "mac_settings.adr_max_data_rate_index") {
+			macSettings := &ttnpb.MACSettings{
+				ADRMinDataRateIndex: dev.GetMACSettings().GetADRMinDataRateIndex(),
+				ADRMaxDataRateIndex: dev.GetMACSettings().GetADRMaxDataRateIndex(),
+			}
+			if ttnpb.HasAnyField(sets, "mac_settings.adr_min_data_rate_index") {
+				macSettings.ADRMinDataRateIndex = req.EndDevice.MACSettings.GetADRMinDataRateIndex()
+			}
+			if ttnpb.HasAnyField(sets, "mac_settings.adr_max_data_rate_index") {
+				macSettings.ADRMaxDataRateIndex = req.EndDevice.MACSettings.GetADRMaxDataRateIndex()
+			}
+			if err := macSettings.ValidateContext(ctx); err != nil {
+				return nil, nil, errInvalidFieldValue.WithAttributes("field", "mac_settings.adr_min_data_rate_index").WithCause(err)
+			}
+		}
+
 		if dev != nil {
 			evt = evtUpdateEndDevice(ctx, req.EndDevice.EndDeviceIdentifiers, req.FieldMask.Paths)
 			if err := ttnpb.ProhibitFields(sets,
@@ -404,6 +442,12 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 			); err != nil {
 				return nil, nil, errInvalidFieldMask.WithCause(err)
 			}
+			if ttnpb.HasAnyField(sets, "lifecycle_state") && dev.LifecycleState != req.EndDevice.LifecycleState {
+				if !dev.LifecycleState.CanTransitionTo(req.EndDevice.LifecycleState) {
+					return nil, nil, lifecycleStateTransitionError(ctx, dev.EndDeviceIdentifiers, dev.LifecycleState, req.EndDevice.LifecycleState)
+				}
+				lifecycleEvt = evtChangeLifecycleState(ctx, req.EndDevice.EndDeviceIdentifiers, lifecycleStateChangeData(dev.LifecycleState, req.EndDevice.LifecycleState))
+			}
 			if ttnpb.HasAnyField(sets, "session.dev_addr") {
 				req.EndDevice.DevAddr = &req.EndDevice.Session.DevAddr
 				sets = append(sets, "ids.dev_addr")
@@ -525,6 +569,9 @@ func (ns *NetworkServer) Set(ctx context.Context, req *ttnpb.SetEndDeviceRequest
 	if evt != nil {
 		events.Publish(evt)
 	}
+	if lifecycleEvt != nil {
+		events.Publish(lifecycleEvt)
+	}
 
 	if !needsDownlinkCheck {
 		return ttnpb.FilterGetEndDevice(dev, req.FieldMask.Paths...)
@@ -570,3 +617,197 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 	}
 	return ttnpb.Empty, err
 }
+
+// SetLifecycleStates implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) SetLifecycleStates(ctx context.Context, req *ttnpb.SetEndDeviceLifecycleStatesRequest) (*ttnpb.EndDevices, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+
+	// Validate all transitions first, so that a request with an invalid transition does not change any device.
+	// This does not make the update atomic: a device may still change between validation and update, or an update may
+	// fail, in which case the error lists the devices that were already updated.
+	for _, devID := range req.DeviceIDs {
+		dev, err := ns.devices.GetByID(ctx, req.ApplicationIDs, devID, []string{"lifecycle_state"})
+		if err != nil {
+			return nil, err
+		}
+		if !dev.LifecycleState.CanTransitionTo(req.LifecycleState) {
+			return nil, lifecycleStateTransitionError(ctx, dev.EndDeviceIdentifiers, dev.LifecycleState, req.LifecycleState)
+		}
+	}
+
+	res := &ttnpb.EndDevices{
+		EndDevices: make([]*ttnpb.EndDevice, 0, len(req.DeviceIDs)),
+	}
+	// partialErr returns err, annotated with the devices of which the lifecycle state was already changed.
+	partialErr := func(devID string, err error) error {
+		if len(res.EndDevices) == 0 {
+			return err
+		}
+		applied := make([]string, 0, len(res.EndDevices))
+		for _, dev := range res.EndDevices {
+			applied = append(applied, dev.DeviceID)
+		}
+		return errPartialLifecycleStates.WithCause(err).WithAttributes(
+			"applied_device_ids", strings.Join(applied, ", "),
+			"device_id", devID,
+		)
+	}
+	for _, devID := range req.DeviceIDs {
+		var evt events.Event
+		var resumed bool
+		dev, err := ns.devices.SetByID(ctx, req.ApplicationIDs, devID, []string{"lifecycle_state"}, func(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if dev == nil {
+				return nil, nil, errDeviceNotFound
+			}
+			if dev.LifecycleState == req.LifecycleState {
+				return dev, nil, nil
+			}
+			if !dev.LifecycleState.CanTransitionTo(req.LifecycleState) {
+				return nil, nil, lifecycleStateTransitionError(ctx, dev.EndDeviceIdentifiers, dev.LifecycleState, req.LifecycleState)
+			}
+			evt = evtChangeLifecycleState(ctx, dev.EndDeviceIdentifiers, lifecycleStateChangeData(dev.LifecycleState, req.LifecycleState))
+			resumed = dev.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED && req.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE
+			dev.LifecycleState = req.LifecycleState
+			return dev, []string{"lifecycle_state"}, nil
+		})
+		if err != nil {
+			return nil, partialErr(devID, err)
+		}
+		if evt != nil {
+			events.Publish(evt)
+		}
+		if resumed {
+			downAt := timeNow().UTC()
+			log.FromContext(ctx).WithField("start_at", downAt).Debug("Add downlink task after device resume")
+			if err := ns.downlinkTasks.Add(ctx, dev.EndDeviceIdentifiers, downAt, true); err != nil {
+				log.FromContext(ctx).WithError(err).Error("Failed to add downlink task after device resume")
+			}
+		}
+		dev, err = ttnpb.FilterGetEndDevice(dev, "lifecycle_state")
+		if err != nil {
+			return nil, err
+		}
+		res.EndDevices = append(res.EndDevices, dev)
+	}
+	return res, nil
+}
+
+// bulkErrorDetails returns the details of the error of a single device in a bulk request.
+func bulkErrorDetails(err error) *ttnpb.ErrorDetails {
+	if ttnErr, ok := errors.From(err); ok {
+		return ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	return ttnpb.ErrorDetailsToProto(errBulkOperation.WithCause(err))
+}
+
+// GetBulk implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetBulk(ctx context.Context, req *ttnpb.GetEndDeviceBulkRequest) (*ttnpb.EndDeviceBulkResults, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.EndDeviceBulkResults{
+		Results: make([]*ttnpb.EndDeviceBulkResult, 0, len(req.DeviceIDs)),
+	}
+	for _, devID := range req.DeviceIDs {
+		result := &ttnpb.EndDeviceBulkResult{
+			EndDeviceIDs: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: req.ApplicationIDs,
+				DeviceID:               devID,
+			},
+		}
+		dev, err := ns.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIdentifiers: result.EndDeviceIDs,
+			FieldMask:            req.FieldMask,
+		})
+		if err != nil {
+			result.Error = bulkErrorDetails(err)
+		} else {
+			result.EndDevice = dev
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// setBulkFieldMaskPaths are the field mask paths allowed in the devices of SetBulk, which are those allowed in Set.
+// The field masks of the devices are not validated by the validator middleware, as they are not in the top level of
+// the request.
+var setBulkFieldMaskPaths = func() map[string]struct{} {
+	paths := make(map[string]struct{})
+	for _, path := range ttnpb.AllowedFieldMaskPathsForRPC["/ttn.lorawan.v3.NsEndDeviceRegistry/Set"] {
+		paths[path] = struct{}{}
+	}
+	return paths
+}()
+
+func validateSetBulkDevice(ctx context.Context, appIDs ttnpb.ApplicationIdentifiers, req *ttnpb.SetEndDeviceRequest) error {
+	if req.EndDevice.ApplicationID != appIDs.ApplicationID {
+		return errDeviceApplicationMismatch.WithAttributes(
+			"device_uid", unique.ID(ctx, req.EndDevice.EndDeviceIdentifiers),
+			"application_uid", unique.ID(ctx, appIDs),
+		)
+	}
+	var forbidden []string
+	for _, path := range req.FieldMask.Paths {
+		if _, ok := setBulkFieldMaskPaths[path]; !ok {
+			forbidden = append(forbidden, path)
+		}
+	}
+	if len(forbidden) > 0 {
+		return errForbiddenFieldMaskPaths.WithAttributes("forbidden_paths", forbidden)
+	}
+	return req.ValidateContext(ctx)
+}
+
+// SetBulk implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) SetBulk(ctx context.Context, req *ttnpb.SetEndDeviceBulkRequest) (*ttnpb.EndDeviceBulkResults, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.EndDeviceBulkResults{
+		Results: make([]*ttnpb.EndDeviceBulkResult, 0, len(req.EndDevices)),
+	}
+	for _, devReq := range req.EndDevices {
+		result := &ttnpb.EndDeviceBulkResult{
+			EndDeviceIDs: devReq.EndDevice.EndDeviceIdentifiers,
+		}
+		if err := validateSetBulkDevice(ctx, req.ApplicationIDs, devReq); err != nil {
+			result.Error = bulkErrorDetails(err)
+			res.Results = append(res.Results, result)
+			continue
+		}
+		dev, err := ns.Set(ctx, devReq)
+		if err != nil {
+			result.Error = bulkErrorDetails(err)
+		} else {
+			result.EndDevice = dev
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
+
+// DeleteBulk implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) DeleteBulk(ctx context.Context, req *ttnpb.DeleteEndDeviceBulkRequest) (*ttnpb.EndDeviceBulkResults, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIDs, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.EndDeviceBulkResults{
+		Results: make([]*ttnpb.EndDeviceBulkResult, 0, len(req.DeviceIDs)),
+	}
+	for _, devID := range req.DeviceIDs {
+		result := &ttnpb.EndDeviceBulkResult{
+			EndDeviceIDs: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: req.ApplicationIDs,
+				DeviceID:               devID,
+			},
+		}
+		if _, err := ns.Delete(ctx, &result.EndDeviceIDs); err != nil {
+			result.Error = bulkErrorDetails(err)
+		}
+		res.Results = append(res.Results, result)
+	}
+	return res, nil
+}
@@ -1553,3 +1553,524 @@ func TestDeviceRegistryDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceRegistrySetLifecycleStates(t *testing.T) {
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	writeRightsContext := func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), appID): {
+					Rights: []ttnpb.Right{
+						ttnpb.RIGHT_APPLICATION_DEVICES_WRITE,
+					},
+				},
+			},
+		})
+	}
+	states := map[string]ttnpb.EndDeviceLifecycleState{
+		"test-dev-1": ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE,
+		"test-dev-2": ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+		"test-dev-3": ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED,
+	}
+	getByID := func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, error) {
+		a := assertions.New(test.MustTFromContext(ctx))
+		a.So(gets, should.Resemble, []string{"lifecycle_state"})
+		return &ttnpb.EndDevice{
+			EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               devID,
+			},
+			LifecycleState: states[devID],
+		}, nil
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		ContextFunc    func(context.Context) context.Context
+		Request        *ttnpb.SetEndDeviceLifecycleStatesRequest
+		ErrorAssertion func(*testing.T, error) bool
+		Expected       []ttnpb.EndDeviceLifecycleState
+		FailDeviceID   string
+		SetByIDCalls   uint64
+		AddCalls       uint64
+	}{
+		{
+			Name: "No device write rights",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, rights.Rights{
+					ApplicationRights: map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), appID): {
+							Rights: []ttnpb.Right{
+								ttnpb.RIGHT_APPLICATION_DEVICES_READ,
+							},
+						},
+					},
+				})
+			},
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-1"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsPermissionDenied(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name:        "Reactivate decommissioned device",
+			ContextFunc: writeRightsContext,
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-1", "test-dev-3"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsFailedPrecondition(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name:        "Recommission active device",
+			ContextFunc: writeRightsContext,
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-1"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_COMMISSIONED,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(errors.IsFailedPrecondition(err), should.BeTrue)
+			},
+		},
+
+		{
+			Name:        "Suspend devices",
+			ContextFunc: writeRightsContext,
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-1", "test-dev-2"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+			},
+			Expected: []ttnpb.EndDeviceLifecycleState{
+				ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+				ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+			},
+			SetByIDCalls: 2,
+		},
+
+		{
+			Name:        "Suspend devices/Set failure",
+			ContextFunc: writeRightsContext,
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-1", "test-dev-2"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_SUSPENDED,
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				if !a.So(errors.IsAborted(err), should.BeTrue) {
+					return false
+				}
+				ttnErr, ok := errors.From(err)
+				if !a.So(ok, should.BeTrue) {
+					return false
+				}
+				attrs := ttnErr.PublicAttributes()
+				return a.So(attrs["applied_device_ids"], should.Equal, "test-dev-1") &&
+					a.So(attrs["device_id"], should.Equal, "test-dev-2")
+			},
+			FailDeviceID: "test-dev-2",
+			SetByIDCalls: 2,
+		},
+
+		{
+			Name:        "Resume device",
+			ContextFunc: writeRightsContext,
+			Request: &ttnpb.SetEndDeviceLifecycleStatesRequest{
+				ApplicationIDs: appID,
+				DeviceIDs:      []string{"test-dev-2"},
+				LifecycleState: ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE,
+			},
+			Expected: []ttnpb.EndDeviceLifecycleState{
+				ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE,
+			},
+			SetByIDCalls: 1,
+			AddCalls:     1,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var setByIDCalls, addCalls uint64
+
+			ns := test.Must(New(
+				componenttest.NewComponent(t, &component.Config{}),
+				&Config{
+					Devices: &MockDeviceRegistry{
+						GetByIDFunc: getByID,
+						SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+							atomic.AddUint64(&setByIDCalls, 1)
+							if devID == tc.FailDeviceID {
+								return nil, errors.New("test")
+							}
+							stored, err := getByID(ctx, appID, devID, gets)
+							if err != nil {
+								return nil, err
+							}
+							dev, sets, err := f(stored)
+							if err != nil {
+								return nil, err
+							}
+							if stored.LifecycleState != states[devID] {
+								assertions.New(test.MustTFromContext(ctx)).So(sets, should.Resemble, []string{"lifecycle_state"})
+							}
+							return dev, nil
+						},
+					},
+					DownlinkTasks: &MockDownlinkTaskQueue{
+						AddFunc: func(ctx context.Context, devID ttnpb.EndDeviceIdentifiers, t time.Time, replace bool) error {
+							atomic.AddUint64(&addCalls, 1)
+							return nil
+						},
+						PopFunc: DownlinkTaskPopBlockFunc,
+					},
+					DeduplicationWindow: 42,
+					CooldownWindow:      42,
+				})).(*NetworkServer)
+
+			ns.AddContextFiller(tc.ContextFunc)
+			ns.AddContextFiller(func(ctx context.Context) context.Context {
+				ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+				_ = cancel
+				return ctx
+			})
+			ns.AddContextFiller(func(ctx context.Context) context.Context {
+				return test.ContextWithT(ctx, t)
+			})
+			componenttest.StartComponent(t, ns.Component)
+			defer ns.Close()
+
+			req := deepcopy.Copy(tc.Request).(*ttnpb.SetEndDeviceLifecycleStatesRequest)
+
+			res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).SetLifecycleStates(test.Context(), req)
+			a.So(setByIDCalls, should.Equal, tc.SetByIDCalls)
+			a.So(addCalls, should.Equal, tc.AddCalls)
+			if tc.ErrorAssertion != nil && a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+				a.So(res, should.BeNil)
+			} else if a.So(err, should.BeNil) && a.So(res.EndDevices, should.HaveLength, len(tc.Expected)) {
+				for i, dev := range res.EndDevices {
+					a.So(dev.DeviceID, should.Equal, tc.Request.DeviceIDs[i])
+					a.So(dev.LifecycleState, should.Equal, tc.Expected[i])
+				}
+			}
+			a.So(req, should.Resemble, tc.Request)
+		})
+	}
+}
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+func newBulkTestNetworkServer(t *testing.T, devices DeviceRegistry, rightsFunc func(context.Context) context.Context) *NetworkServer {
+	ns := test.Must(New(
+		componenttest.NewComponent(t, &component.Config{}),
+		&Config{
+			Devices: devices,
+			DownlinkTasks: &MockDownlinkTaskQueue{
+				AddFunc: func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, at time.Time, replace bool) error {
+					return nil
+				},
+				PopFunc: DownlinkTaskPopBlockFunc,
+			},
+			DeduplicationWindow: 42,
+			CooldownWindow:      42,
+		})).(*NetworkServer)
+	ns.FrequencyPlans = frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	ns.AddContextFiller(rightsFunc)
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		ctx, cancel := context.WithDeadline(ctx, time.Now().Add(Timeout))
+		_ = cancel
+		return ctx
+	})
+	ns.AddContextFiller(func(ctx context.Context) context.Context {
+		return test.ContextWithT(ctx, t)
+	})
+	componenttest.StartComponent(t, ns.Component)
+	return ns
+}
+
+func applicationRightsContext(appID ttnpb.ApplicationIdentifiers, rs ...ttnpb.Right) func(context.Context) context.Context {
+	return func(ctx context.Context) context.Context {
+		return rights.NewContext(ctx, rights.Rights{
+			ApplicationRights: map[string]*ttnpb.Rights{
+				unique.ID(test.Context(), appID): {
+					Rights: rs,
+				},
+			},
+		})
+	}
+}
+
+func TestDeviceRegistryGetBulk(t *testing.T) {
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	req := &ttnpb.GetEndDeviceBulkRequest{
+		ApplicationIDs: appID,
+		DeviceIDs:      []string{"test-dev-1", "test-dev-2"},
+		FieldMask: pbtypes.FieldMask{
+			Paths: []string{"frequency_plan_id"},
+		},
+	}
+
+	t.Run("No device read rights", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, error) {
+				err := errors.New("GetByIDFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return nil, err
+			},
+		}, applicationRightsContext(appID, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE))
+		defer ns.Close()
+
+		res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).GetBulk(test.Context(), req)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		a.So(res, should.BeNil)
+	})
+
+	t.Run("Get devices", func(t *testing.T) {
+		a := assertions.New(t)
+
+		var getByIDCalls uint64
+		ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, error) {
+				atomic.AddUint64(&getByIDCalls, 1)
+				assertions.New(test.MustTFromContext(ctx)).So(gets, should.Resemble, []string{"frequency_plan_id"})
+				if devID != "test-dev-1" {
+					return nil, errNotFound
+				}
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+						ApplicationIdentifiers: appID,
+						DeviceID:               devID,
+					},
+					FrequencyPlanID: test.EUFrequencyPlanID,
+				}, nil
+			},
+		}, applicationRightsContext(appID, ttnpb.RIGHT_APPLICATION_DEVICES_READ))
+		defer ns.Close()
+
+		res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).GetBulk(test.Context(), req)
+		a.So(getByIDCalls, should.Equal, 2)
+		if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 2) {
+			t.FailNow()
+		}
+		a.So(res.Results[0], should.Resemble, &ttnpb.EndDeviceBulkResult{
+			EndDeviceIDs: ttnpb.EndDeviceIdentifiers{
+				ApplicationIdentifiers: appID,
+				DeviceID:               "test-dev-1",
+			},
+			EndDevice: &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               "test-dev-1",
+				},
+				FrequencyPlanID: test.EUFrequencyPlanID,
+			},
+		})
+		a.So(res.Results[1].EndDeviceIDs.DeviceID, should.Equal, "test-dev-2")
+		a.So(res.Results[1].EndDevice, should.BeNil)
+		a.So(res.Results[1].Error.GetName(), should.Equal, "not_found")
+	})
+}
+
+func TestDeviceRegistrySetBulk(t *testing.T) {
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	makeRequest := func(appID ttnpb.ApplicationIdentifiers, devID string, paths ...string) *ttnpb.SetEndDeviceRequest {
+		return &ttnpb.SetEndDeviceRequest{
+			EndDevice: ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+				},
+				MACState: &ttnpb.MACState{
+					DesiredParameters: ttnpb.MACParameters{
+						Rx2Frequency: 123456789,
+					},
+				},
+			},
+			FieldMask: pbtypes.FieldMask{
+				Paths: paths,
+			},
+		}
+	}
+	req := &ttnpb.SetEndDeviceBulkRequest{
+		ApplicationIDs: appID,
+		EndDevices: []*ttnpb.SetEndDeviceRequest{
+			makeRequest(appID, "test-dev-1", "mac_state.desired_parameters.rx2_frequency"),
+			makeRequest(ttnpb.ApplicationIdentifiers{ApplicationID: "other-app-id"}, "test-dev-2", "mac_state.desired_parameters.rx2_frequency"),
+			makeRequest(appID, "test-dev-3", "created_at"),
+			makeRequest(appID, "test-dev-4", "mac_state.desired_parameters.rx2_frequency"),
+		},
+	}
+
+	a := assertions.New(t)
+
+	var setByIDCalls uint64
+	ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+		SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+			atomic.AddUint64(&setByIDCalls, 1)
+			if devID != "test-dev-1" {
+				return nil, errNotFound
+			}
+			dev, _, err := f(&ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+				},
+				FrequencyPlanID:   test.EUFrequencyPlanID,
+				LoRaWANPHYVersion: ttnpb.PHY_V1_1_REV_B,
+				MACState: &ttnpb.MACState{
+					LoRaWANVersion: ttnpb.MAC_V1_1,
+					CurrentParameters: ttnpb.MACParameters{
+						Rx2Frequency: 868000000,
+					},
+					DesiredParameters: ttnpb.MACParameters{
+						Rx2Frequency: 868000000,
+					},
+				},
+			})
+			return dev, err
+		},
+	}, applicationRightsContext(appID, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE))
+	defer ns.Close()
+
+	res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).SetBulk(test.Context(), req)
+	a.So(setByIDCalls, should.Equal, 2)
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 4) {
+		t.FailNow()
+	}
+	a.So(res.Results[0], should.Resemble, &ttnpb.EndDeviceBulkResult{
+		EndDeviceIDs: req.EndDevices[0].EndDevice.EndDeviceIdentifiers,
+		EndDevice:    &req.EndDevices[0].EndDevice,
+	})
+	for i, name := range []string{
+		"",
+		"device_application_mismatch",
+		"field_mask_paths",
+		"not_found",
+	} {
+		a.So(res.Results[i].EndDeviceIDs, should.Resemble, req.EndDevices[i].EndDevice.EndDeviceIdentifiers)
+		a.So(res.Results[i].Error.GetName(), should.Equal, name)
+	}
+}
+
+func TestDeviceRegistryDeleteBulk(t *testing.T) {
+	appID := ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"}
+	a := assertions.New(t)
+
+	var deleted []string
+	ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+		SetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+			if devID == "test-dev-2" {
+				return nil, errors.New("test")
+			}
+			deleted = append(deleted, devID)
+			_, _, err := f(&ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: appID,
+					DeviceID:               devID,
+				},
+			})
+			return nil, err
+		},
+	}, applicationRightsContext(appID, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE))
+	defer ns.Close()
+
+	res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).DeleteBulk(test.Context(), &ttnpb.DeleteEndDeviceBulkRequest{
+		ApplicationIDs: appID,
+		DeviceIDs:      []string{"test-dev-1", "test-dev-2", "test-dev-3"},
+	})
+	if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 3) {
+		t.FailNow()
+	}
+	a.So(deleted, should.Resemble, []string{"test-dev-1", "test-dev-3"})
+	a.So(res.Results[0].Error, should.BeNil)
+	a.So(res.Results[1].Error.GetMessageFormat(), should.Equal, "test")
+	a.So(res.Results[2].Error, should.BeNil)
+}
+
+func TestDeviceRegistryGetMACTrace(t *testing.T) {
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+		DeviceID:               "test-dev-id",
+	}
+	transmitAt := time.Unix(44, 0).UTC()
+	trace := []*ttnpb.MACTraceEntry{
+		{
+			Time:          time.Unix(42, 0).UTC(),
+			Uplink:        true,
+			FCnt:          42,
+			ADR:           true,
+			MACCommands:   []*ttnpb.MACCommand{{CID: ttnpb.CID_LINK_CHECK}},
+			DataRateIndex: ttnpb.DATA_RATE_5,
+		},
+		{
+			Time: time.Unix(43, 0).UTC(),
+			FCnt: 24,
+			MACCommands: []*ttnpb.MACCommand{
+				(&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand(),
+			},
+			Class:    ttnpb.CLASS_A,
+			Priority: ttnpb.TxSchedulePriority_HIGHEST,
+			GatewayIDs: []ttnpb.GatewayIdentifiers{
+				{GatewayID: "test-gtw-1"},
+				{GatewayID: "test-gtw-2"},
+			},
+			TransmitAt: &transmitAt,
+		},
+	}
+
+	t.Run("No device read rights", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, error) {
+				err := errors.New("GetByIDFunc must not be called")
+				test.MustTFromContext(ctx).Error(err)
+				return nil, err
+			},
+		}, applicationRightsContext(ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_WRITE))
+		defer ns.Close()
+
+		res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).GetMACTrace(test.Context(), &ids)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+		a.So(res, should.BeNil)
+	})
+
+	t.Run("Get trace", func(t *testing.T) {
+		a := assertions.New(t)
+
+		ns := newBulkTestNetworkServer(t, &MockDeviceRegistry{
+			GetByIDFunc: func(ctx context.Context, appID ttnpb.ApplicationIdentifiers, devID string, gets []string) (*ttnpb.EndDevice, error) {
+				a := assertions.New(test.MustTFromContext(ctx))
+				a.So(appID, should.Resemble, ids.ApplicationIdentifiers)
+				a.So(devID, should.Equal, ids.DeviceID)
+				a.So(gets, should.Resemble, []string{"mac_trace"})
+				return &ttnpb.EndDevice{
+					EndDeviceIdentifiers: ids,
+					MACTrace:             trace,
+				}, nil
+			},
+		}, applicationRightsContext(ids.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ))
+		defer ns.Close()
+
+		res, err := ttnpb.NewNsEndDeviceRegistryClient(ns.LoopbackConn()).GetMACTrace(test.Context(), &ids)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res, should.Resemble, &ttnpb.MACTrace{
+			Entries: trace,
+		})
+	})
+}
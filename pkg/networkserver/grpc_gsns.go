@@ -42,8 +42,8 @@ import (
 )
 
 const (
-	// recentUplinkCount is the maximum amount of recent uplinks stored per device.
-	recentUplinkCount = 20
+	// DefaultRecentUplinkCount is the default maximum amount of recent uplinks stored per device.
+	DefaultRecentUplinkCount = 20
 
 	// accumulationCapacity is the initial capacity of the accumulator.
 	accumulationCapacity = 20
@@ -55,6 +55,9 @@ const (
 	maxConfNbTrans = 5
 )
 
+// deduplicateUplink adds the metadata of up to the accumulator of the uplink message with the same payload.
+// It returns the accumulator, a function that ends the deduplication and whether up is a duplicate.
+// If up is a duplicate, the returned accumulator is the one of the first uplink message and the function is nil.
 func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage) (*metadataAccumulator, func(), bool) {
 	h := ns.hashPool.Get().(hash.Hash64)
 	_, _ = h.Write(up.RawPayload)
@@ -70,7 +73,7 @@ func (ns *NetworkServer) deduplicateUplink(ctx context.Context, up *ttnpb.Uplink
 
 	if isDup {
 		ns.metadataAccumulatorPool.Put(acc)
-		return nil, nil, true
+		return lv.(*metadataAccumulator), nil, true
 	}
 	return acc, func() {
 		ns.metadataAccumulators.Delete(k)
@@ -119,6 +122,16 @@ func maxRetransmissionDelay(rxDelay ttnpb.RxDelay) time.Duration {
 	return rxDelay.Duration() + time.Second + retransmissionWindow
 }
 
+// withinFCntResetGrace returns whether an uplink message with FCnt fCnt is accepted as the uplink message of dev after
+// it reset its frame counters. The grace in the MAC settings of dev takes precedence over the grace of the Network Server.
+func (ns *NetworkServer) withinFCntResetGrace(dev *ttnpb.EndDevice, fCnt uint32) bool {
+	grace := ns.fCntResetGrace
+	if dev.GetMACSettings().GetFCntResetGrace() != nil {
+		grace = dev.MACSettings.FCntResetGrace.Value
+	}
+	return grace == 0 || fCnt <= grace
+}
+
 func fCntResetGap(last, recv uint32) uint32 {
 	if math.MaxUint32-last < recv {
 		return last + recv
@@ -157,6 +170,7 @@ type matchedDevice struct {
 	Device                   *ttnpb.EndDevice
 	FCnt                     uint32
 	FCntReset                bool
+	MACCommands              []*ttnpb.MACCommand
 	NbTrans                  uint32
 	Pending                  bool
 	QueuedApplicationUplinks []*ttnpb.ApplicationUp
@@ -332,6 +346,10 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, up *ttnpb
 				logger.Debug("FCnt too low, skip")
 				continue
 			}
+			if !ns.withinFCntResetGrace(dev, pld.FCnt) {
+				logger.Debug("FCnt too low and FCnt after reset exceeds grace, skip")
+				continue
+			}
 
 			macState, err := newMACState(dev, ns.FrequencyPlans, ns.defaultMACSettings)
 			if err != nil {
@@ -368,7 +386,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, up *ttnpb
 
 		logger = logger.WithField("transmission", 1)
 
-		if fCnt != pld.FCnt && resetsFCnt(dev, ns.defaultMACSettings) {
+		if fCnt != pld.FCnt && resetsFCnt(dev, ns.defaultMACSettings) && ns.withinFCntResetGrace(dev, pld.FCnt) {
 			macState, err := newMACState(dev, ns.FrequencyPlans, ns.defaultMACSettings)
 			if err != nil {
 				logger.WithError(err).Warn("Failed to generate new MAC state")
@@ -507,6 +525,7 @@ matchLoop:
 		}
 		logger = logger.WithField("mac_count", len(cmds))
 		ctx = log.NewContext(ctx, logger)
+		match.MACCommands = cmds
 
 		match.Device.MACState.QueuedResponses = match.Device.MACState.QueuedResponses[:0]
 	macLoop:
@@ -698,6 +717,12 @@ matchLoop:
 		if !match.Pending && match.Device.PendingSession != nil {
 			// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
 		}
+		if match.FCntReset {
+			match.QueuedEvents = append(match.QueuedEvents, evtResetFCnt.BindData(map[string]interface{}{
+				"last_f_cnt_up": match.Device.Session.LastFCntUp,
+				"f_cnt_up":      match.FCnt,
+			}))
+		}
 		if match.Pending || match.FCntReset {
 			match.Device.Session.StartedAt = up.ReceivedAt
 		}
@@ -732,6 +757,7 @@ func appendRecentUplink(recent []*ttnpb.UplinkMessage, up *ttnpb.UplinkMessage,
 var handleDataUplinkGetPaths = [...]string{
 	"frequency_plan_id",
 	"last_dev_status_received_at",
+	"lifecycle_state",
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
@@ -767,7 +793,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	logger.Debug("Match device")
 
 	var addrMatches []*ttnpb.EndDevice
-	if err := ns.devices.RangeByAddr(ctx, pld.DevAddr, handleDataUplinkGetPaths[:],
+	if err := ns.devices.RangeByAddr(ctx, pld.DevAddr, ns.withMACTracePaths(handleDataUplinkGetPaths[:]...),
 		func(dev *ttnpb.EndDevice) bool {
 			addrMatches = append(addrMatches, dev)
 			return true
@@ -776,6 +802,10 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return err
 	}
 
+	if len(addrMatches) == 0 {
+		ns.packetLogger.Log(ctx, up)
+	}
+
 	matched, err := ns.matchAndHandleDataUplink(ctx, up, false, addrMatches...)
 	if err != nil {
 		registerDropDataUplink(ctx, up, err)
@@ -788,6 +818,13 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 
 	logger.Debug("Matched device")
 
+	if matched.Device.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED {
+		logger.Debug("Decommissioned device sent a data message, drop")
+		events.Publish(evtDropDecommissionedDataUplink(ctx, matched.Device.EndDeviceIdentifiers, nil))
+		registerDropDataUplink(ctx, up, errDeviceDecommissioned)
+		return errDeviceDecommissioned
+	}
+
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
 	var queuedEvents []events.DefinitionDataClosure
 
@@ -798,11 +835,16 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 
 	up.RxMetadata = acc.Accumulated()
+	acc.Merged(matched.Device.EndDeviceIdentifiers)
 	logger = logger.WithField("metadata_count", len(up.RxMetadata))
 	logger.Debug("Merged metadata")
 	ctx = log.NewContext(ctx, logger)
 	queuedEvents = append(queuedEvents, evtMergeMetadata.BindData(len(up.RxMetadata)))
 	registerMergeMetadata(ctx, up)
+	ns.uplinkMirror.Mirror(ctx, matched.Device.EndDeviceIdentifiers, pld.DevAddr, up)
+	if evt := ns.fairUse.HandleUplink(ctx, matched.Device.EndDeviceIdentifiers, up); evt != nil {
+		queuedEvents = append(queuedEvents, evt)
+	}
 
 	for _, f := range matched.DeferredMACHandlers {
 		evs, err := f(ctx, matched.Device, up)
@@ -814,7 +856,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	}
 
 	var handleErr bool
-	stored, err := ns.devices.SetByID(ctx, matched.Device.ApplicationIdentifiers, matched.Device.DeviceID, handleDataUplinkGetPaths[:],
+	stored, err := ns.devices.SetByID(ctx, matched.Device.ApplicationIdentifiers, matched.Device.DeviceID, ns.withMACTracePaths(handleDataUplinkGetPaths[:]...),
 		func(stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			if stored == nil {
 				logger.Warn("Device deleted during uplink handling, drop")
@@ -838,21 +880,68 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			stored = matched.Device
 			paths := matched.SetPaths
 
-			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, recentUplinkCount)
+			if stored.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_COMMISSIONED {
+				logger.Info("Activate commissioned device")
+				queuedEvents = append(queuedEvents, evtChangeLifecycleState.BindData(lifecycleStateChangeData(stored.LifecycleState, ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE)))
+				stored.LifecycleState = ttnpb.EndDeviceLifecycleState_LIFECYCLE_ACTIVE
+				paths = append(paths, "lifecycle_state")
+			}
+
+			if fpID, ok := roamingFrequencyPlanID(stored, up.RxMetadata, ns.FrequencyPlans); ok {
+				var roamedBefore bool
+				if n := len(stored.RecentUplinks); n > 0 {
+					lastID, ok := roamingFrequencyPlanID(stored, stored.RecentUplinks[n-1].RxMetadata, ns.FrequencyPlans)
+					roamedBefore = ok && lastID == fpID
+				}
+				roamingLogger := logger.WithFields(log.Fields(
+					"frequency_plan_id", stored.FrequencyPlanID,
+					"gateway_frequency_plan_id", fpID,
+				))
+				data := map[string]interface{}{
+					"frequency_plan_id":         stored.FrequencyPlanID,
+					"gateway_frequency_plan_id": fpID,
+				}
+				var reprovisioned bool
+				if ns.reprovisionRoamingDevices {
+					if err := reprovisionFrequencyPlan(stored, fpID, ns.FrequencyPlans, ns.defaultMACSettings); err != nil {
+						roamingLogger.WithError(err).Warn("Failed to reprovision roaming device")
+					} else {
+						roamingLogger.Info("Reprovision roaming device")
+						reprovisioned = true
+						paths = ttnpb.AddFields(paths, "frequency_plan_id", "mac_state")
+					}
+				}
+				data["reprovisioned"] = reprovisioned
+				if reprovisioned || !roamedBefore {
+					roamingLogger.Debug("Detect frequency plan roaming")
+					queuedEvents = append(queuedEvents, evtDetectFrequencyPlanRoaming.BindData(data))
+				}
+			}
+
+			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, ns.recentUplinkCount)
 			paths = append(paths, "recent_uplinks")
 
+			if ns.macTraceCount > 0 {
+				stored.MACTrace = appendMACTrace(stored.MACTrace, macTraceUplinkEntry(up, matched.MACCommands), ns.macTraceCount)
+				paths = append(paths, "mac_trace")
+			}
+
 			paths = append(paths, "recent_adr_uplinks")
 			if !pld.FHDR.ADR {
 				stored.RecentADRUplinks = nil
 				return stored, paths, nil
 			}
+			if up.Settings.DataRate.GetLRFHSS() != nil {
+				// ADR is not defined for LR-FHSS, so LR-FHSS uplinks are not used by the ADR algorithm.
+				return stored, paths, nil
+			}
 			stored.RecentADRUplinks = appendRecentUplink(stored.RecentADRUplinks, up, optimalADRUplinkCount)
 
 			if !deviceUseADR(stored, ns.defaultMACSettings) {
 				return stored, paths, nil
 			}
 
-			if err := adaptDataRate(stored, ns.FrequencyPlans, ns.defaultMACSettings); err != nil {
+			if err := adaptDataRate(stored, ns.FrequencyPlans, ns.defaultMACSettings, ns.fairUse.ADRMarginOffset(ctx, stored.EndDeviceIdentifiers)); err != nil {
 				handleErr = true
 				return nil, nil, err
 			}
@@ -891,6 +980,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				SessionKeyID: stored.Session.SessionKeyID,
 				Settings:     up.Settings,
 				ReceivedAt:   up.ReceivedAt,
+				Confirmed:    up.Payload.MType == ttnpb.MType_CONFIRMED_UP,
 			}},
 		})
 		queuedEvents = append(queuedEvents, evtForwardDataUplink.BindData(nil))
@@ -911,14 +1001,26 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 }
 
 // newDevAddr generates a DevAddr for specified EndDevice.
-func (ns *NetworkServer) newDevAddr(context.Context, *ttnpb.EndDevice) types.DevAddr {
+// The DevAddr has one of the prefixes of the application of the device if configured, or of the Network Server.
+func (ns *NetworkServer) newDevAddr(_ context.Context, dev *ttnpb.EndDevice) types.DevAddr {
+	prefixes := ns.devAddrPrefixes
+	if dev != nil {
+		if appPrefixes, ok := ns.applicationDevAddrPrefixes[dev.ApplicationID]; ok {
+			prefixes = appPrefixes
+		}
+	}
 	var devAddr types.DevAddr
 	random.Read(devAddr[:])
-	prefix := ns.devAddrPrefixes[random.Intn(len(ns.devAddrPrefixes))]
+	prefix := prefixes[random.Intn(len(prefixes))]
 	return devAddr.WithPrefix(prefix)
 }
 
 func (ns *NetworkServer) sendJoinRequest(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, req *ttnpb.JoinRequest) (*ttnpb.JoinResponse, error) {
+	if ns.joinServerTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, ns.joinServerTimeout)
+		defer cancel()
+	}
 	logger := log.FromContext(ctx)
 	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_JOIN_SERVER, ids)
 	if err != nil {
@@ -964,6 +1066,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	dev, err := ns.devices.GetByEUI(ctx, pld.JoinEUI, pld.DevEUI,
 		[]string{
 			"frequency_plan_id",
+			"lifecycle_state",
 			"lorawan_phy_version",
 			"lorawan_version",
 			"mac_settings",
@@ -979,6 +1082,13 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		return err
 	}
 
+	if dev.LifecycleState == ttnpb.EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED {
+		logger.Debug("Decommissioned device sent a join-request, drop")
+		events.Publish(evtDropDecommissionedJoinRequest(ctx, dev.EndDeviceIdentifiers, nil))
+		registerDropJoinRequest(ctx, up, errDeviceDecommissioned)
+		return errDeviceDecommissioned
+	}
+
 	defer func(dev *ttnpb.EndDevice) {
 		if err != nil {
 			events.Publish(evtDropJoinRequest(ctx, dev.EndDeviceIdentifiers, err))
@@ -1098,6 +1208,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 	}
 
 	up.RxMetadata = acc.Accumulated()
+	acc.Merged(dev.EndDeviceIdentifiers)
 	events.Publish(evtMergeMetadata(ctx, dev.EndDeviceIdentifiers, len(up.RxMetadata)))
 	registerMergeMetadata(ctx, up)
 
@@ -1132,7 +1243,7 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 			}
 			up.Settings.DataRateIndex = upDRIdx
 
-			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, recentUplinkCount)
+			stored.RecentUplinks = appendRecentUplink(stored.RecentUplinks, up, ns.recentUplinkCount)
 			paths = append(paths, "recent_uplinks")
 
 			invalidatedQueue = stored.QueuedApplicationDownlinks
@@ -1231,7 +1342,12 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 	logger.Debug("Deduplicate uplink")
 	acc, stopDedup, ok := ns.deduplicateUplink(ctx, up)
 	if ok {
-		logger.Debug("Dropped duplicate uplink")
+		if ids, merged := acc.MergedIdentifiers(); merged {
+			logger.Debug("Received late duplicate uplink")
+			events.Publish(evtReceiveLateMetadata(ctx, ids, up.RxMetadata))
+		} else {
+			logger.Debug("Dropped duplicate uplink")
+		}
 		registerReceiveUplinkDuplicate(ctx, up)
 		return ttnpb.Empty, nil
 	}
@@ -1239,7 +1355,11 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	defer func() {
 		<-ns.collectionDone(ctx, up)
-		stopDedup()
+		if ns.lateUplinkGrace > 0 {
+			time.AfterFunc(ns.lateUplinkGrace, stopDedup)
+		} else {
+			stopDedup()
+		}
 		logger.Debug("Done deduplicating uplink")
 	}()
 
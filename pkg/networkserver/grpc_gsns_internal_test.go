@@ -101,6 +101,122 @@ func TestNewDevAddr(t *testing.T) {
 		a.So(seen[ns.devAddrPrefixes[1]], should.BeGreaterThan, 0)
 		a.So(seen[ns.devAddrPrefixes[2]], should.BeGreaterThan, 0)
 	}
+
+	// Configured application DevAddr prefixes.
+	{
+		var appPrefixes []ApplicationDevAddrPrefix
+		for _, s := range []string{
+			"test-app-1=26011000/20",
+			"test-app-1=26012000/20",
+			"test-app-2=27000000/8",
+		} {
+			var p ApplicationDevAddrPrefix
+			if !a.So(p.UnmarshalConfigString(s), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(p.ConfigString(), should.Equal, s)
+			appPrefixes = append(appPrefixes, p)
+		}
+		a.So(new(ApplicationDevAddrPrefix).UnmarshalConfigString("26011000/20"), should.HaveSameErrorDefinitionAs, errApplicationDevAddrPrefix)
+
+		ns := test.Must(New(
+			componenttest.NewComponent(t, &component.Config{}),
+			&Config{
+				NetID: types.NetID{0x00, 0x00, 0x13},
+				DevAddrPrefixes: []types.DevAddrPrefix{
+					{
+						DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00},
+						Length:  16,
+					},
+				},
+				ApplicationDevAddrPrefixes: appPrefixes,
+				DeduplicationWindow:        42,
+				CooldownWindow:             42,
+				DownlinkTasks: &MockDownlinkTaskQueue{
+					PopFunc: DownlinkTaskPopBlockFunc,
+				},
+			})).(*NetworkServer)
+
+		makeDevice := func(appID string) *ttnpb.EndDevice {
+			return &ttnpb.EndDevice{
+				EndDeviceIdentifiers: ttnpb.EndDeviceIdentifiers{
+					ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: appID},
+					DeviceID:               "test-dev",
+				},
+			}
+		}
+		seen := map[types.DevAddrPrefix]int{}
+		for i := 0; i < 100; i++ {
+			devAddr := ns.newDevAddr(test.Context(), makeDevice("test-app-1"))
+			for _, p := range appPrefixes[:2] {
+				if devAddr.HasPrefix(p.DevAddrPrefix) {
+					seen[p.DevAddrPrefix]++
+				}
+			}
+			a.So(ns.newDevAddr(test.Context(), makeDevice("test-app-2")).HasPrefix(appPrefixes[2].DevAddrPrefix), should.BeTrue)
+			a.So(ns.newDevAddr(test.Context(), makeDevice("test-app-3")).HasPrefix(ns.devAddrPrefixes[0]), should.BeTrue)
+			a.So(ns.newDevAddr(test.Context(), nil).HasPrefix(ns.devAddrPrefixes[0]), should.BeTrue)
+		}
+		a.So(seen[appPrefixes[0].DevAddrPrefix], should.BeGreaterThan, 0)
+		a.So(seen[appPrefixes[1].DevAddrPrefix], should.BeGreaterThan, 0)
+		a.So(seen[appPrefixes[0].DevAddrPrefix]+seen[appPrefixes[1].DevAddrPrefix], should.Equal, 100)
+	}
+}
+
+func TestDeduplicateUplink(t *testing.T) {
+	a := assertions.New(t)
+
+	ns := test.Must(New(
+		componenttest.NewComponent(t, &component.Config{}),
+		&Config{
+			NetID:               types.NetID{0x00, 0x00, 0x13},
+			DeduplicationWindow: 42,
+			CooldownWindow:      42,
+			DownlinkTasks: &MockDownlinkTaskQueue{
+				PopFunc: DownlinkTaskPopBlockFunc,
+			},
+		})).(*NetworkServer)
+
+	makeUplink := func(gtwID string) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x42, 0x42, 0x42, 0x42},
+			RxMetadata: []*ttnpb.RxMetadata{
+				{
+					GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: gtwID},
+				},
+			},
+		}
+	}
+
+	acc, stopDedup, isDup := ns.deduplicateUplink(test.Context(), makeUplink("gateway-1"))
+	if !a.So(isDup, should.BeFalse) || !a.So(stopDedup, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	dupAcc, _, isDup := ns.deduplicateUplink(test.Context(), makeUplink("gateway-2"))
+	a.So(isDup, should.BeTrue)
+	a.So(dupAcc, should.Equal, acc)
+	a.So(acc.Accumulated(), should.HaveLength, 2)
+	_, merged := acc.MergedIdentifiers()
+	a.So(merged, should.BeFalse)
+
+	ids := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "test-app-id"},
+		DeviceID:               "test-dev-id",
+	}
+	acc.Merged(ids)
+
+	dupAcc, _, isDup = ns.deduplicateUplink(test.Context(), makeUplink("gateway-3"))
+	a.So(isDup, should.BeTrue)
+	a.So(dupAcc, should.Equal, acc)
+	mergedIDs, merged := acc.MergedIdentifiers()
+	a.So(merged, should.BeTrue)
+	a.So(mergedIDs, should.Resemble, ids)
+
+	stopDedup()
+
+	_, _, isDup = ns.deduplicateUplink(test.Context(), makeUplink("gateway-1"))
+	a.So(isDup, should.BeFalse)
 }
 
 func TestMatchAndHandleUplink(t *testing.T) {
@@ -234,6 +350,7 @@ func TestMatchAndHandleUplink(t *testing.T) {
 		Uplink          *ttnpb.UplinkMessage
 		Deduplicated    bool
 		Devices         []*ttnpb.EndDevice
+		FCntResetGrace  uint32
 		DeviceAssertion func(ctx context.Context, dev *matchedDevice, up *ttnpb.UplinkMessage) bool
 		ErrorAssertion  func(ctx context.Context, err error) bool
 	}{
@@ -297,6 +414,7 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					ChannelIndex:        1,
 					DataRateIndex:       ttnpb.DATA_RATE_2,
 					DeferredMACHandlers: dev.DeferredMACHandlers,
+					QueuedEvents:        dev.QueuedEvents,
 					Device: &ttnpb.EndDevice{
 						EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
 						FrequencyPlanID:      test.EUFrequencyPlanID,
@@ -311,7 +429,10 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					},
 					FCnt:      12,
 					FCntReset: true,
-					NbTrans:   1,
+					MACCommands: []*ttnpb.MACCommand{
+						{CID: ttnpb.CID_LINK_CHECK},
+					},
+					NbTrans: 1,
 					SetPaths: []string{
 						"mac_state",
 						"pending_mac_state",
@@ -321,6 +442,12 @@ func TestMatchAndHandleUplink(t *testing.T) {
 				}
 
 				if !a.So([]time.Time{start, dev.Device.Session.StartedAt, time.Now()}, should.BeChronological) ||
+					!a.So(dev.QueuedEvents, should.ResembleEventDefinitionDataClosures, []events.DefinitionDataClosure{
+						evtResetFCnt.BindData(map[string]interface{}{
+							"last_f_cnt_up": uint32(33),
+							"f_cnt_up":      uint32(12),
+						}),
+					}) ||
 					!a.So(dev.DeferredMACHandlers, should.HaveLength, 1) ||
 					!a.So(dev, should.HaveEmptyDiff, expectedDev) {
 					return false
@@ -410,6 +537,7 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					ChannelIndex:        1,
 					DataRateIndex:       ttnpb.DATA_RATE_2,
 					DeferredMACHandlers: dev.DeferredMACHandlers,
+					QueuedEvents:        dev.QueuedEvents,
 					Device: &ttnpb.EndDevice{
 						EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
 						FrequencyPlanID:      test.EUFrequencyPlanID,
@@ -424,7 +552,10 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					},
 					FCnt:      12,
 					FCntReset: true,
-					NbTrans:   1,
+					MACCommands: []*ttnpb.MACCommand{
+						{CID: ttnpb.CID_LINK_CHECK},
+					},
+					NbTrans: 1,
 					SetPaths: []string{
 						"mac_state",
 						"pending_mac_state",
@@ -436,6 +567,12 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					},
 				}
 				if !a.So([]time.Time{start, dev.Device.Session.StartedAt, time.Now()}, should.BeChronological) ||
+					!a.So(dev.QueuedEvents, should.ResembleEventDefinitionDataClosures, []events.DefinitionDataClosure{
+						evtResetFCnt.BindData(map[string]interface{}{
+							"last_f_cnt_up": uint32(33),
+							"f_cnt_up":      uint32(12),
+						}),
+					}) ||
 					!a.So(dev.DeferredMACHandlers, should.HaveLength, 1) ||
 					!a.So(dev, should.HaveEmptyDiff, expectedDev) {
 					return false
@@ -520,6 +657,7 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					ChannelIndex:        1,
 					DataRateIndex:       ttnpb.DATA_RATE_2,
 					DeferredMACHandlers: dev.DeferredMACHandlers,
+					QueuedEvents:        dev.QueuedEvents,
 					Device: &ttnpb.EndDevice{
 						EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
 						FrequencyPlanID:      test.EUFrequencyPlanID,
@@ -533,7 +671,10 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					},
 					FCnt:      12,
 					FCntReset: true,
-					NbTrans:   1,
+					MACCommands: []*ttnpb.MACCommand{
+						{CID: ttnpb.CID_LINK_CHECK},
+					},
+					NbTrans: 1,
 					SetPaths: []string{
 						"mac_state",
 						"pending_mac_state",
@@ -542,6 +683,12 @@ func TestMatchAndHandleUplink(t *testing.T) {
 					},
 				}
 				if !a.So([]time.Time{start, dev.Device.Session.StartedAt, time.Now()}, should.BeChronological) ||
+					!a.So(dev.QueuedEvents, should.ResembleEventDefinitionDataClosures, []events.DefinitionDataClosure{
+						evtResetFCnt.BindData(map[string]interface{}{
+							"last_f_cnt_up": uint32(33),
+							"f_cnt_up":      uint32(12),
+						}),
+					}) ||
 					!a.So(dev.DeferredMACHandlers, should.HaveLength, 1) ||
 					!a.So(dev, should.HaveEmptyDiff, expectedDev) {
 					return false
@@ -567,6 +714,107 @@ func TestMatchAndHandleUplink(t *testing.T) {
 			},
 		},
 
+		{
+			Name: "1.1/Supports 32-bit FCnt/FCnt reset/FCnt exceeds grace",
+			Uplink: makeUplink(
+				&ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: devAddr,
+						FCnt:    12,
+						FOpts:   MustEncryptUplink(nwkSEncKey, devAddr, 12, 0x02),
+					},
+					FPort:      0x01,
+					FRMPayload: []byte("test-frm-payload"),
+				},
+				false,
+				12,
+				0,
+				ttnpb.DATA_RATE_2,
+				1,
+				ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 10,
+						}},
+					},
+					EnableCRC: true,
+					Frequency: 868300000,
+					Timestamp: 42,
+				},
+			),
+			Devices: []*ttnpb.EndDevice{
+				{
+					EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
+					FrequencyPlanID:      test.EUFrequencyPlanID,
+					LoRaWANPHYVersion:    ttnpb.PHY_V1_1_REV_B,
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					MACState:             MakeDefaultUS915MACState(ttnpb.CLASS_B, ttnpb.MAC_V1_1),
+					Session:              makeSession(ttnpb.MAC_V1_1, devAddr, 33),
+					MACSettings: &ttnpb.MACSettings{
+						ResetsFCnt: &pbtypes.BoolValue{Value: true},
+					},
+				},
+			},
+			FCntResetGrace: 10,
+			DeviceAssertion: func(ctx context.Context, dev *matchedDevice, up *ttnpb.UplinkMessage) bool {
+				return assertions.New(test.MustTFromContext(ctx)).So(dev, should.BeNil)
+			},
+			ErrorAssertion: func(ctx context.Context, err error) bool {
+				return assertions.New(test.MustTFromContext(ctx)).So(err, should.HaveSameErrorDefinitionAs, errDeviceNotFound)
+			},
+		},
+		{
+			Name: "1.1/Supports 32-bit FCnt/FCnt reset/FCnt exceeds grace of device",
+			Uplink: makeUplink(
+				&ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: devAddr,
+						FCnt:    12,
+						FOpts:   MustEncryptUplink(nwkSEncKey, devAddr, 12, 0x02),
+					},
+					FPort:      0x01,
+					FRMPayload: []byte("test-frm-payload"),
+				},
+				false,
+				12,
+				0,
+				ttnpb.DATA_RATE_2,
+				1,
+				ttnpb.TxSettings{
+					DataRate: ttnpb.DataRate{
+						Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+							Bandwidth:       125000,
+							SpreadingFactor: 10,
+						}},
+					},
+					EnableCRC: true,
+					Frequency: 868300000,
+					Timestamp: 42,
+				},
+			),
+			Devices: []*ttnpb.EndDevice{
+				{
+					EndDeviceIdentifiers: *makeABPIdentifiers(devAddr),
+					FrequencyPlanID:      test.EUFrequencyPlanID,
+					LoRaWANPHYVersion:    ttnpb.PHY_V1_1_REV_B,
+					LoRaWANVersion:       ttnpb.MAC_V1_1,
+					MACState:             MakeDefaultUS915MACState(ttnpb.CLASS_B, ttnpb.MAC_V1_1),
+					Session:              makeSession(ttnpb.MAC_V1_1, devAddr, 33),
+					MACSettings: &ttnpb.MACSettings{
+						ResetsFCnt:     &pbtypes.BoolValue{Value: true},
+						FCntResetGrace: &pbtypes.UInt32Value{Value: 10},
+					},
+				},
+			},
+			FCntResetGrace: 100,
+			DeviceAssertion: func(ctx context.Context, dev *matchedDevice, up *ttnpb.UplinkMessage) bool {
+				return assertions.New(test.MustTFromContext(ctx)).So(dev, should.BeNil)
+			},
+			ErrorAssertion: func(ctx context.Context, err error) bool {
+				return assertions.New(test.MustTFromContext(ctx)).So(err, should.HaveSameErrorDefinitionAs, errDeviceNotFound)
+			},
+		},
 		{
 			Name: "1.1/Ack",
 			Uplink: makeUplink(
@@ -642,7 +890,10 @@ func TestMatchAndHandleUplink(t *testing.T) {
 							{},
 						},
 					},
-					FCnt:    12,
+					FCnt: 12,
+					MACCommands: []*ttnpb.MACCommand{
+						{CID: ttnpb.CID_LINK_CHECK},
+					},
 					NbTrans: 1,
 					SetPaths: []string{
 						"mac_state",
@@ -682,7 +933,7 @@ func TestMatchAndHandleUplink(t *testing.T) {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
 
-			ns, ctx, env, stop := StartTest(t, Config{NetID: netID}, (1<<10)*test.Delay, true)
+			ns, ctx, env, stop := StartTest(t, Config{NetID: netID, FCntResetGrace: tc.FCntResetGrace}, (1<<10)*test.Delay, true)
 			defer stop()
 
 			<-env.DownlinkTasks.Pop
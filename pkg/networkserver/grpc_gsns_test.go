@@ -25,6 +25,7 @@ import (
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/pkg/errors"
 	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	. "go.thethings.network/lorawan-stack/pkg/networkserver"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/pkg/types"
@@ -33,7 +34,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-func sendUplinkDuplicates(ctx context.Context, handle func(ctx context.Context, up *ttnpb.UplinkMessage) <-chan error, windowEndCh <-chan WindowEndRequest, makeMessage func(decoded bool) *ttnpb.UplinkMessage, start time.Time, n int) []*ttnpb.RxMetadata {
+func sendUplinkDuplicates(ctx context.Context, handle func(ctx context.Context, up *ttnpb.UplinkMessage) <-chan error, eventsCh <-chan test.EventPubSubPublishRequest, windowEndCh <-chan WindowEndRequest, makeMessage func(decoded bool) *ttnpb.UplinkMessage, start time.Time, n int) []*ttnpb.RxMetadata {
 	t := test.MustTFromContext(ctx)
 	t.Helper()
 
@@ -71,6 +72,21 @@ func sendUplinkDuplicates(ctx context.Context, handle func(ctx context.Context,
 		wg := &sync.WaitGroup{}
 		wg.Add(n)
 
+		// Duplicates, which arrive after the metadata is merged, are published as late metadata.
+		dupDone := make(chan struct{})
+		go func() {
+			for {
+				select {
+				case <-dupDone:
+					return
+
+				case req := <-eventsCh:
+					a.So(req.Event.Name(), should.Equal, "ns.up.late_metadata")
+					req.Response <- struct{}{}
+				}
+			}
+		}()
+
 		for i := 0; i < n; i++ {
 			go func() {
 				defer wg.Done()
@@ -91,7 +107,9 @@ func sendUplinkDuplicates(ctx context.Context, handle func(ctx context.Context,
 		}
 
 		go func() {
-			if !test.WaitContext(ctx, wg.Wait) {
+			ok := test.WaitContext(ctx, wg.Wait)
+			close(dupDone)
+			if !ok {
 				t.Log("Timed out while waiting for duplicate uplinks to be processed")
 				return
 			}
@@ -122,6 +140,7 @@ func TestHandleUplink(t *testing.T) {
 	dataGetPaths := [...]string{
 		"frequency_plan_id",
 		"last_dev_status_received_at",
+		"lifecycle_state",
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
@@ -140,6 +159,7 @@ func TestHandleUplink(t *testing.T) {
 
 	joinGetByEUIPaths := [...]string{
 		"frequency_plan_id",
+		"lifecycle_state",
 		"lorawan_phy_version",
 		"lorawan_version",
 		"mac_settings",
@@ -500,8 +520,9 @@ func TestHandleUplink(t *testing.T) {
 	errTest := errors.New("testError")
 
 	for _, tc := range []struct {
-		Name    string
-		Handler func(context.Context, TestEnvironment, func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool
+		Name                 string
+		FrequencyPlanRoaming FrequencyPlanRoamingConfig
+		Handler              func(context.Context, TestEnvironment, func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool
 	}{
 		{
 			Name: "Invalid payload",
@@ -636,7 +657,7 @@ func TestHandleUplink(t *testing.T) {
 					}
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.EqualErrorOrDefinition, errTest)
@@ -692,7 +713,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.EqualErrorOrDefinition, ErrABPJoinRequest)
@@ -749,7 +770,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.EqualErrorOrDefinition, ErrABPJoinRequest)
@@ -851,7 +872,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.BeError)
@@ -955,7 +976,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.BeError)
@@ -1065,7 +1086,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.BeError)
@@ -1178,7 +1199,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeJoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeJoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.BeError)
@@ -1297,7 +1318,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
@@ -1401,7 +1422,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeJoinRequest(decoded)
 					if !decoded {
 						return msg
@@ -1514,7 +1535,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
@@ -1585,7 +1606,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeJoinRequest(decoded)
 					if !decoded {
 						return msg
@@ -1704,7 +1725,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
@@ -1808,7 +1829,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeJoinRequest(decoded)
 					if !decoded {
 						return msg
@@ -1927,7 +1948,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
@@ -2031,7 +2052,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeJoinRequest(decoded)
 					if !decoded {
 						return msg
@@ -2153,7 +2174,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeJoinRequest, start, duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
@@ -2257,7 +2278,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeJoinRequest(decoded)
 					if !decoded {
 						return msg
@@ -2288,7 +2309,7 @@ func TestHandleUplink(t *testing.T) {
 
 				handleUplinkErrCh := handle(ctx, msg)
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, makeRejoinRequest, start, duplicateCount)
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, makeRejoinRequest, start, duplicateCount)
 
 				return assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
 					return a.So(err, should.NotBeNil)
@@ -2356,7 +2377,7 @@ func TestHandleUplink(t *testing.T) {
 
 				now := clock.Add(time.Nanosecond)
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				now = clock.Add(time.Nanosecond)
@@ -2491,7 +2512,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeLegacyDataUplink(34, decoded)
 					if !decoded {
 						return msg
@@ -2509,6 +2530,256 @@ func TestHandleUplink(t *testing.T) {
 				return true
 			},
 		},
+		{
+			Name:                 "Data uplink/Matching device/No concurrent update/1.0.2/First transmission/No ADR/Frequency plan roaming/Reprovision/Set success/Downlink add success",
+			FrequencyPlanRoaming: FrequencyPlanRoamingConfig{Reprovision: true},
+			Handler: func(ctx context.Context, env TestEnvironment, handle func(context.Context, *ttnpb.UplinkMessage) <-chan error) bool {
+				t := test.MustTFromContext(ctx)
+				a := assertions.New(t)
+
+				start := time.Now().UTC()
+				clock := MockClock(start)
+				defer SetTimeNow(clock.Now)()
+
+				makeRoamingUplink := func(decoded bool) *ttnpb.UplinkMessage {
+					msg := makeLegacyDataUplink(34, decoded)
+					for _, md := range msg.RxMetadata {
+						md.FrequencyPlanID = test.ExampleFrequencyPlanID
+					}
+					return msg
+				}
+				msg := makeRoamingUplink(false)
+
+				handleUplinkErrCh := handle(ctx, msg)
+
+				rangeDevice := &ttnpb.EndDevice{
+					EndDeviceIdentifiers: *makeOTAAIdentifiers(&devAddr),
+					FrequencyPlanID:      test.EUFrequencyPlanID,
+					LoRaWANPHYVersion:    ttnpb.PHY_V1_0_2_REV_B,
+					LoRaWANVersion:       ttnpb.MAC_V1_0_2,
+					MACState:             MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2),
+					RecentUplinks: []*ttnpb.UplinkMessage{
+						makeLegacyDataUplink(31, true),
+						makeLegacyDataUplink(32, true),
+					},
+					Session:   makeSession(ttnpb.MAC_V1_0_2, devAddr, 32),
+					CreatedAt: start,
+					UpdatedAt: start,
+				}
+				rangeDevice.MACState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_5
+				rangeDevice.MACState.DesiredParameters.ADRTxPowerIndex = 2
+				rangeDevice.MACState.DesiredParameters.ADRNbTrans = 2
+
+				roamingDevice := CopyEndDevice(rangeDevice)
+				roamingDevice.FrequencyPlanID = test.ExampleFrequencyPlanID
+				roamingMACState, err := NewMACState(roamingDevice, frequencyplans.NewStore(test.FrequencyPlansFetcher), ttnpb.MACSettings{})
+				if !a.So(err, should.BeNil) {
+					return false
+				}
+
+				var upCtx context.Context
+				var upCorrelationIDs []string
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.RangeByAddr to be called")
+					return false
+
+				case req := <-env.DeviceRegistry.RangeByAddr:
+					upCtx = req.Context
+					upCorrelationIDs = events.CorrelationIDsFromContext(req.Context)
+					for _, id := range correlationIDs {
+						a.So(upCorrelationIDs, should.Contain, id)
+					}
+					a.So(upCorrelationIDs, should.HaveLength, len(correlationIDs)+2)
+					a.So(req.DevAddr, should.Resemble, devAddr)
+					a.So(req.Paths, should.HaveSameElementsDeep, dataGetPaths[:])
+					a.So(req.Func(CopyEndDevice(rangeDevice)), should.BeTrue)
+					multicastDevice := CopyEndDevice(rangeDevice)
+					multicastDevice.EndDeviceIdentifiers.DeviceID += "-multicast"
+					multicastDevice.Multicast = true
+					a.So(req.Func(multicastDevice), should.BeTrue)
+					fCntTooHighDevice := CopyEndDevice(rangeDevice)
+					fCntTooHighDevice.EndDeviceIdentifiers.DeviceID += "-too-high"
+					fCntTooHighDevice.RecentUplinks = append(fCntTooHighDevice.RecentUplinks, makeLegacyDataUplink(42, true))
+					fCntTooHighDevice.Session.LastFCntUp = 42
+					a.So(req.Func(fCntTooHighDevice), should.BeTrue)
+					req.Response <- nil
+				}
+
+				now := clock.Add(time.Nanosecond)
+
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, makeRoamingUplink, now.Add(-time.Nanosecond), duplicateCount)
+				mds = append(mds, msg.RxMetadata...)
+
+				now = clock.Add(time.Nanosecond)
+
+				select {
+				case <-ctx.Done():
+					t.Error("Timed out while waiting for DeviceRegistry.SetByID to be called")
+					return false
+
+				case req := <-env.DeviceRegistry.SetByID:
+					a.So(req.Context, should.HaveParentContextOrEqual, upCtx)
+					a.So(req.ApplicationIdentifiers, should.Resemble, appID)
+					a.So(req.DeviceID, should.Resemble, devID)
+					a.So(req.Paths, should.HaveSameElementsDeep, dataGetPaths[:])
+					dev, sets, err := req.Func(CopyEndDevice(rangeDevice))
+					if !a.So(err, should.BeNil) || !a.So(dev, should.NotBeNil) {
+						return false
+					}
+					a.So(sets, should.HaveSameElementsDeep, []string{
+						"frequency_plan_id",
+						"mac_state",
+						"pending_mac_state",
+						"pending_session",
+						"recent_adr_uplinks",
+						"recent_uplinks",
+						"session",
+					})
+
+					macState := MakeDefaultEU868MACState(ttnpb.CLASS_A, ttnpb.MAC_V1_0_2)
+					macState.DesiredParameters.ADRDataRateIndex = ttnpb.DATA_RATE_5
+					macState.DesiredParameters.ADRTxPowerIndex = 2
+					macState.DesiredParameters.ADRNbTrans = 2
+					macState.DesiredParameters.Channels = roamingMACState.DesiredParameters.Channels
+					macState.DesiredParameters.Rx2Frequency = roamingMACState.DesiredParameters.Rx2Frequency
+					macState.DesiredParameters.PingSlotFrequency = roamingMACState.DesiredParameters.PingSlotFrequency
+					macState.DesiredParameters.BeaconFrequency = roamingMACState.DesiredParameters.BeaconFrequency
+					macState.RxWindowsAvailable = true
+					macState.QueuedResponses = []*ttnpb.MACCommand{
+						MakeLinkCheckAns(mds...),
+					}
+					a.So(dev.FrequencyPlanID, should.Equal, test.ExampleFrequencyPlanID)
+					a.So(dev.MACState, should.Resemble, macState)
+					a.So(dev.PendingMACState, should.BeNil)
+					a.So(dev.PendingSession, should.BeNil)
+					a.So(dev.RecentADRUplinks, should.BeNil)
+					a.So(dev.Session, should.Resemble, makeSession(ttnpb.MAC_V1_0_2, devAddr, 34))
+					if !a.So(dev.RecentUplinks, should.NotBeEmpty) {
+						return false
+					}
+					recentUp := dev.RecentUplinks[len(dev.RecentUplinks)-1]
+					a.So(recentUp.RxMetadata, should.HaveSameElementsDiff, mds)
+					expectedUp := makeLegacyDataUplink(34, true)
+					expectedUp.CorrelationIDs = upCorrelationIDs
+					expectedUp.DeviceChannelIndex = 1
+					expectedUp.ReceivedAt = start
+					expectedUp.RxMetadata = recentUp.RxMetadata
+					expectedUp.Settings.DataRateIndex = ttnpb.DATA_RATE_2
+					a.So(dev.RecentUplinks, should.HaveEmptyDiff, append(CopyUplinkMessages(rangeDevice.RecentUplinks...), expectedUp))
+					req.Response <- DeviceRegistrySetByIDResponse{
+						Device: &ttnpb.EndDevice{
+							EndDeviceIdentifiers: *makeOTAAIdentifiers(&devAddr),
+							FrequencyPlanID:      test.ExampleFrequencyPlanID,
+							LoRaWANPHYVersion:    ttnpb.PHY_V1_0_2_REV_B,
+							LoRaWANVersion:       ttnpb.MAC_V1_0_2,
+							MACState:             macState,
+							RecentUplinks:        append(CopyUplinkMessages(rangeDevice.RecentUplinks...), recentUp),
+							Session:              makeSession(ttnpb.MAC_V1_0_2, devAddr, 34),
+							CreatedAt:            start,
+							UpdatedAt:            now,
+						},
+					}
+					mds = recentUp.RxMetadata
+				}
+
+				if !a.So(AssertDownlinkTaskAddRequest(ctx, env.DownlinkTasks.Add, func(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, startAt time.Time, replace bool) bool {
+					return a.So(ctx, should.HaveParentContextOrEqual, upCtx) &&
+						a.So(ids, should.Resemble, *makeOTAAIdentifiers(&devAddr)) &&
+						a.So(startAt, should.Resemble, start.Add(time.Second-NSScheduleWindow()-GSScheduleWindow())) &&
+						a.So(replace, should.BeTrue)
+				},
+					nil,
+				), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(AssertApplicationUplinkQueueAddRequest(ctx, env.ApplicationUplinks.Add, func(ctx context.Context, ups ...*ttnpb.ApplicationUp) bool {
+					return a.So(ctx, should.HaveParentContextOrEqual, upCtx) &&
+						a.So(ups, should.Resemble, []*ttnpb.ApplicationUp{
+							{
+								CorrelationIDs:       upCorrelationIDs,
+								EndDeviceIdentifiers: *makeOTAAIdentifiers(&devAddr),
+								Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+									SessionKeyID: makeSessionKeys(ttnpb.MAC_V1_1).SessionKeyID,
+									FPort:        fPort,
+									FCnt:         34,
+									FRMPayload:   makeDataUplinkFRMPayload(34),
+									RxMetadata:   mds,
+									Settings: ttnpb.TxSettings{
+										DataRateIndex: ttnpb.DATA_RATE_2,
+										DataRate: ttnpb.DataRate{
+											Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{
+												Bandwidth:       125000,
+												SpreadingFactor: 10,
+											}},
+										},
+										EnableCRC: true,
+										Frequency: 868300000,
+										Timestamp: 42,
+									},
+									ReceivedAt: start,
+								}},
+							},
+						})
+				},
+					nil,
+				), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev, should.ResembleEvent, EvtMergeMetadata(upCtx, rangeDevice.EndDeviceIdentifiers, len(mds)))
+				}), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev, should.ResembleEvent, EvtReceiveLinkCheckRequest(upCtx, rangeDevice.EndDeviceIdentifiers, nil))
+				}), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev, should.ResembleEvent, EvtEnqueueLinkCheckAnswer(upCtx, rangeDevice.EndDeviceIdentifiers, MakeLinkCheckAns(mds...).GetLinkCheckAns()))
+				}), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev, should.ResembleEvent, EvtDetectFrequencyPlanRoaming(upCtx, rangeDevice.EndDeviceIdentifiers, map[string]interface{}{
+						"frequency_plan_id":         test.EUFrequencyPlanID,
+						"gateway_frequency_plan_id": test.ExampleFrequencyPlanID,
+						"reprovisioned":             true,
+					}))
+				}), should.BeTrue) {
+					return false
+				}
+
+				if !a.So(test.AssertEventPubSubPublishRequest(ctx, env.Events, func(ev events.Event) bool {
+					return a.So(ev, should.ResembleEvent, EvtForwardDataUplink(upCtx, rangeDevice.EndDeviceIdentifiers, nil))
+				}), should.BeTrue) {
+					return false
+				}
+
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+					msg := makeRoamingUplink(decoded)
+					if !decoded {
+						return msg
+					}
+					msg.DeviceChannelIndex = 1
+					msg.Settings.DataRateIndex = ttnpb.DATA_RATE_2
+					return msg
+				}, start, duplicateCount)
+
+				if !assertHandleUplinkResponse(ctx, handleUplinkErrCh, func(err error) bool {
+					return a.So(err, should.BeNil)
+				}) {
+					return false
+				}
+				return true
+			},
+		},
 
 		{
 			Name: "Data uplink/Matching device/No concurrent update/1.1/First transmission/No ADR/Set success/Downlink add success",
@@ -2570,7 +2841,7 @@ func TestHandleUplink(t *testing.T) {
 
 				now := clock.Add(time.Nanosecond)
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, bindMakeDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, bindMakeDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				now = clock.Add(time.Nanosecond)
@@ -2705,7 +2976,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeDataUplink(34, decoded)
 					if !decoded {
 						return msg
@@ -2784,7 +3055,7 @@ func TestHandleUplink(t *testing.T) {
 
 				now := clock.Add(time.Nanosecond)
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				now = clock.Add(time.Nanosecond)
@@ -2921,7 +3192,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeLegacyDataUplink(34, decoded)
 					if !decoded {
 						return msg
@@ -3008,7 +3279,7 @@ func TestHandleUplink(t *testing.T) {
 
 				now := clock.Add(time.Nanosecond)
 
-				mds := sendUplinkDuplicates(ctx, handle, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
+				mds := sendUplinkDuplicates(ctx, handle, env.Events, env.DeduplicationDone, bindMakeLegacyDataUplinkFCnt(34), now.Add(-time.Nanosecond), duplicateCount)
 				mds = append(mds, msg.RxMetadata...)
 
 				now = clock.Add(time.Nanosecond)
@@ -3103,7 +3374,7 @@ func TestHandleUplink(t *testing.T) {
 					return false
 				}
 
-				_ = sendUplinkDuplicates(ctx, handle, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
+				_ = sendUplinkDuplicates(ctx, handle, env.Events, env.CollectionDone, func(decoded bool) *ttnpb.UplinkMessage {
 					msg := makeLegacyDataUplink(34, decoded)
 					if !decoded {
 						return msg
@@ -3121,8 +3392,9 @@ func TestHandleUplink(t *testing.T) {
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			ns, ctx, env, stop := StartTest(t, Config{
-				NetID:              *netID.Copy(&types.NetID{}),
-				DefaultMACSettings: MACSettingConfig{},
+				NetID:                *netID.Copy(&types.NetID{}),
+				DefaultMACSettings:   MACSettingConfig{},
+				FrequencyPlanRoaming: tc.FrequencyPlanRoaming,
 			}, (1<<12)*test.Delay, true)
 			defer stop()
 
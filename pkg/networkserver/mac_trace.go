@@ -0,0 +1,87 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// withMACTracePaths returns paths with the MAC trace path added if the Network Server stores MAC traces.
+func (ns *NetworkServer) withMACTracePaths(paths ...string) []string {
+	if ns.macTraceCount <= 0 {
+		return paths
+	}
+	return ttnpb.AddFields(paths, "mac_trace")
+}
+
+// appendMACTrace appends entry to trace and drops the oldest entries, so that at most count entries remain.
+func appendMACTrace(trace []*ttnpb.MACTraceEntry, entry *ttnpb.MACTraceEntry, count int) []*ttnpb.MACTraceEntry {
+	trace = append(trace, entry)
+	if len(trace) > count {
+		trace = trace[len(trace)-count:]
+	}
+	return trace
+}
+
+// macTraceUplinkEntry returns the MAC trace entry of the data uplink message, in which cmds were parsed.
+func macTraceUplinkEntry(up *ttnpb.UplinkMessage, cmds []*ttnpb.MACCommand) *ttnpb.MACTraceEntry {
+	pld := up.Payload.GetMACPayload()
+	return &ttnpb.MACTraceEntry{
+		Time:          up.ReceivedAt,
+		Uplink:        true,
+		FCnt:          pld.FCnt,
+		ADR:           pld.ADR,
+		MACCommands:   cmds,
+		DataRateIndex: up.Settings.DataRateIndex,
+	}
+}
+
+// macTraceDownlinkEntry returns the MAC trace entry of the data downlink message, which was scheduled using req
+// through one of paths.
+func macTraceDownlinkEntry(genDown *generatedDownlink, req *ttnpb.TxRequest, down *scheduledDownlink, paths ...downlinkPath) *ttnpb.MACTraceEntry {
+	gtwIDs := make([]ttnpb.GatewayIdentifiers, 0, len(paths))
+	for _, path := range paths {
+		gtwIDs = append(gtwIDs, path.GatewayIdentifiers)
+	}
+	return &ttnpb.MACTraceEntry{
+		Time:        timeNow().UTC(),
+		FCnt:        genDown.FCnt,
+		ADR:         genDown.ADR,
+		MACCommands: genDown.MACCommands,
+		Class:       req.Class,
+		Priority:    req.Priority,
+		GatewayIDs:  gtwIDs,
+		TransmitAt:  timePtr(down.TransmitAt),
+	}
+}
+
+// GetMACTrace implements NsEndDeviceRegistryServer.
+func (ns *NetworkServer) GetMACTrace(ctx context.Context, req *ttnpb.EndDeviceIdentifiers) (*ttnpb.MACTrace, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIdentifiers, ttnpb.RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	dev, err := ns.devices.GetByID(ctx, req.ApplicationIdentifiers, req.DeviceID, []string{
+		"mac_trace",
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.MACTrace{
+		Entries: dev.MACTrace,
+	}, nil
+}
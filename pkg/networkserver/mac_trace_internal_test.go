@@ -0,0 +1,107 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestAppendMACTrace(t *testing.T) {
+	makeEntries := func(fCnts ...uint32) []*ttnpb.MACTraceEntry {
+		entries := make([]*ttnpb.MACTraceEntry, 0, len(fCnts))
+		for _, fCnt := range fCnts {
+			entries = append(entries, &ttnpb.MACTraceEntry{FCnt: fCnt})
+		}
+		return entries
+	}
+	for _, tc := range []struct {
+		Trace    []*ttnpb.MACTraceEntry
+		Count    int
+		Expected []*ttnpb.MACTraceEntry
+	}{
+		{
+			Count:    3,
+			Expected: makeEntries(4),
+		},
+		{
+			Trace:    makeEntries(1, 2),
+			Count:    3,
+			Expected: makeEntries(1, 2, 4),
+		},
+		{
+			Trace:    makeEntries(1, 2, 3),
+			Count:    3,
+			Expected: makeEntries(2, 3, 4),
+		},
+		{
+			Trace:    makeEntries(1, 2, 3),
+			Count:    1,
+			Expected: makeEntries(4),
+		},
+	} {
+		t.Run(fmt.Sprintf("%d entries/count %d", len(tc.Trace), tc.Count), func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(appendMACTrace(tc.Trace, &ttnpb.MACTraceEntry{FCnt: 4}, tc.Count), should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestMACTraceDownlinkEntry(t *testing.T) {
+	a := assertions.New(t)
+
+	now := time.Unix(42, 0).UTC()
+	clock := MockClock(now)
+	defer SetTimeNow(clock.Now)()
+
+	cmds := []*ttnpb.MACCommand{
+		(&ttnpb.MACCommand_LinkCheckAns{Margin: 20, GatewayCount: 2}).MACCommand(),
+		{CID: ttnpb.CID_DEV_STATUS},
+	}
+	entry := macTraceDownlinkEntry(
+		&generatedDownlink{
+			FCnt:        24,
+			ADR:         true,
+			MACCommands: cmds,
+		},
+		&ttnpb.TxRequest{
+			Class:    ttnpb.CLASS_C,
+			Priority: ttnpb.TxSchedulePriority_HIGH,
+		},
+		&scheduledDownlink{
+			TransmitAt: now.Add(time.Second),
+		},
+		downlinkPath{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw-1"}},
+		downlinkPath{GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw-2"}},
+	)
+	a.So(entry, should.Resemble, &ttnpb.MACTraceEntry{
+		Time:        now,
+		FCnt:        24,
+		ADR:         true,
+		MACCommands: cmds,
+		Class:       ttnpb.CLASS_C,
+		Priority:    ttnpb.TxSchedulePriority_HIGH,
+		GatewayIDs: []ttnpb.GatewayIdentifiers{
+			{GatewayID: "test-gtw-1"},
+			{GatewayID: "test-gtw-2"},
+		},
+		TransmitAt: timePtr(now.Add(time.Second)),
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"time"
+
+	"github.com/mohae/deepcopy"
+	clusterauth "go.thethings.network/lorawan-stack/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	errUplinkMirrorKey     = errors.DefineInvalidArgument("uplink_mirror_key", "invalid uplink mirror key")
+	errUplinkMirrorFilter  = errors.DefineInvalidArgument("uplink_mirror_filter", "no DevAddr prefixes or application IDs to mirror")
+	errUplinkMirrorConnect = errors.DefineUnavailable("uplink_mirror_connect", "connect to uplink mirror `{address}`")
+)
+
+const (
+	defaultUplinkMirrorQueueSize = 64
+	defaultUplinkMirrorTimeout   = 5 * time.Second
+)
+
+// uplinkMirror forwards read-only copies of uplink messages to another Network Server.
+type uplinkMirror struct {
+	address  string
+	client   ttnpb.GsNsClient
+	callOpts []grpc.CallOption
+	timeout  time.Duration
+
+	devAddrPrefixes []types.DevAddrPrefix
+	applicationIDs  map[string]struct{}
+
+	queue chan *ttnpb.UplinkMessage
+}
+
+func newUplinkMirror(ctx context.Context, conf UplinkMirrorConfig, tlsConfig *tls.Config) (*uplinkMirror, error) {
+	if len(conf.DevAddrPrefixes) == 0 && len(conf.ApplicationIDs) == 0 {
+		return nil, errUplinkMirrorFilter
+	}
+	m := &uplinkMirror{
+		address:         conf.Address,
+		timeout:         conf.Timeout,
+		devAddrPrefixes: conf.DevAddrPrefixes,
+		applicationIDs:  make(map[string]struct{}, len(conf.ApplicationIDs)),
+	}
+	if m.timeout == 0 {
+		m.timeout = defaultUplinkMirrorTimeout
+	}
+	for _, id := range conf.ApplicationIDs {
+		m.applicationIDs[id] = struct{}{}
+	}
+	queueSize := conf.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultUplinkMirrorQueueSize
+	}
+	m.queue = make(chan *ttnpb.UplinkMessage, queueSize)
+
+	if conf.Key != "" {
+		if _, err := hex.DecodeString(conf.Key); err != nil {
+			return nil, errUplinkMirrorKey.WithCause(err)
+		}
+		m.callOpts = append(m.callOpts, grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      clusterauth.AuthType,
+			AuthValue:     conf.Key,
+			AllowInsecure: !conf.TLS,
+		}))
+	}
+
+	opts := rpcclient.DefaultDialOptions(ctx)
+	if conf.TLS {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.DialContext(ctx, conf.Address, opts...)
+	if err != nil {
+		return nil, errUplinkMirrorConnect.WithCause(err).WithAttributes("address", conf.Address)
+	}
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	m.client = ttnpb.NewGsNsClient(conn)
+	return m, nil
+}
+
+// matches returns whether uplink messages of the given device should be mirrored.
+func (m *uplinkMirror) matches(ids ttnpb.EndDeviceIdentifiers, devAddr types.DevAddr) bool {
+	if _, ok := m.applicationIDs[ids.ApplicationID]; ok {
+		return true
+	}
+	for _, prefix := range m.devAddrPrefixes {
+		if devAddr.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Mirror queues a copy of the uplink message for mirroring if it matches the filter.
+// This method does not block; if the queue is full, the uplink message is not mirrored.
+func (m *uplinkMirror) Mirror(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, devAddr types.DevAddr, up *ttnpb.UplinkMessage) {
+	if m == nil || !m.matches(ids, devAddr) {
+		return
+	}
+	msg := &ttnpb.UplinkMessage{
+		RawPayload:     up.RawPayload,
+		Settings:       deepcopy.Copy(up.Settings).(ttnpb.TxSettings),
+		RxMetadata:     deepcopy.Copy(up.RxMetadata).([]*ttnpb.RxMetadata),
+		CorrelationIDs: append(up.CorrelationIDs[:0:0], up.CorrelationIDs...),
+	}
+	select {
+	case m.queue <- msg:
+	default:
+		log.FromContext(ctx).Warn("Uplink mirror queue is full, drop uplink")
+	}
+}
+
+// run forwards the queued uplink messages until the context is done.
+func (m *uplinkMirror) run(ctx context.Context) error {
+	logger := log.FromContext(ctx).WithField("address", m.address)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case up := <-m.queue:
+			sendCtx, cancel := context.WithTimeout(ctx, m.timeout)
+			_, err := m.client.HandleUplink(sendCtx, up, m.callOpts...)
+			cancel()
+			if err != nil {
+				logger.WithError(err).Debug("Failed to mirror uplink")
+			}
+		}
+	}
+}
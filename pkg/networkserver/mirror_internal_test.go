@@ -0,0 +1,89 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestUplinkMirror(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	m := &uplinkMirror{
+		devAddrPrefixes: []types.DevAddrPrefix{
+			{
+				DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00},
+				Length:  16,
+			},
+		},
+		applicationIDs: map[string]struct{}{
+			"mirror-app": {},
+		},
+		queue: make(chan *ttnpb.UplinkMessage, 1),
+	}
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "test-gtw"},
+				RSSI:               -42,
+			},
+		},
+		CorrelationIDs: []string{"test"},
+	}
+	otherApp := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "other-app"},
+		DeviceID:               "test-dev",
+	}
+	mirrorApp := ttnpb.EndDeviceIdentifiers{
+		ApplicationIdentifiers: ttnpb.ApplicationIdentifiers{ApplicationID: "mirror-app"},
+		DeviceID:               "test-dev",
+	}
+
+	a.So(m.matches(otherApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}), should.BeFalse)
+	a.So(m.matches(otherApp, types.DevAddr{0x26, 0x01, 0x00, 0x01}), should.BeTrue)
+	a.So(m.matches(mirrorApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}), should.BeTrue)
+
+	m.Mirror(ctx, otherApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}, up)
+	a.So(m.queue, should.BeEmpty)
+
+	m.Mirror(ctx, mirrorApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}, up)
+	if !a.So(m.queue, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	// The queue is full, so the uplink message is dropped.
+	m.Mirror(ctx, mirrorApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}, up)
+	a.So(m.queue, should.HaveLength, 1)
+
+	mirrored := <-m.queue
+	a.So(mirrored, should.Resemble, &ttnpb.UplinkMessage{
+		RawPayload:     up.RawPayload,
+		RxMetadata:     up.RxMetadata,
+		CorrelationIDs: up.CorrelationIDs,
+	})
+	up.RxMetadata[0].RSSI = -100
+	a.So(mirrored.RxMetadata[0].RSSI, should.Equal, float32(-42))
+
+	var nilMirror *uplinkMirror
+	nilMirror.Mirror(ctx, mirrorApp, types.DevAddr{0x26, 0x02, 0x00, 0x01}, up)
+}
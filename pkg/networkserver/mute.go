@@ -0,0 +1,81 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// muteWindowRetryInterval is the maximum interval in which class B/C downlinks held during a mute window are retried.
+// Class B/C downlinks are retried earlier if the mute window ends earlier.
+const muteWindowRetryInterval = time.Minute
+
+var (
+	errMuted = errors.DefineUnavailable(
+		"muted", "end device is muted until `{end_at}`",
+	)
+
+	evtDropMutedDownlink = events.Define(
+		"ns.down.data.mute.drop", "drop application downlink during mute window",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+)
+
+// muteDownlink returns errMuted if the end device is muted, with the generate downlink state.
+// If the active mute window drops queued downlinks, the downlink queue is cleared and the Application Server is
+// notified of the failed application downlinks.
+func (ns *NetworkServer) muteDownlink(ctx context.Context, dev *ttnpb.EndDevice) (generateDownlinkState, error) {
+	window, ok := ns.muteWindows.Active(ctx, dev.EndDeviceIdentifiers, timeNow())
+	if !ok {
+		return generateDownlinkState{}, nil
+	}
+	err := errMuted.WithAttributes("end_at", window.EndAt)
+	var genState generateDownlinkState
+	if window.QueuedDownlinks == ttnpb.MuteWindow_DROP && len(dev.QueuedApplicationDownlinks) > 0 {
+		log.FromContext(ctx).WithField("count", len(dev.QueuedApplicationDownlinks)).Info("Drop application downlink queue during mute window")
+		for _, down := range dev.QueuedApplicationDownlinks {
+			genState.baseApplicationUps = append(genState.baseApplicationUps, &ttnpb.ApplicationUp{
+				EndDeviceIdentifiers: dev.EndDeviceIdentifiers,
+				CorrelationIDs:       append(events.CorrelationIDsFromContext(ctx), down.CorrelationIDs...),
+				Up: &ttnpb.ApplicationUp_DownlinkFailed{
+					DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+						ApplicationDownlink: *down,
+						Error:               *ttnpb.ErrorDetailsToProto(err),
+					},
+				},
+			})
+		}
+		dev.QueuedApplicationDownlinks = nil
+		genState.NeedsDownlinkQueueUpdate = true
+		events.Publish(evtDropMutedDownlink(ctx, dev.EndDeviceIdentifiers, window))
+	}
+	return genState, err
+}
+
+// muteWindowRetryAt returns the time at which a class B/C downlink held during a mute window is retried.
+func (ns *NetworkServer) muteWindowRetryAt(ctx context.Context, ids ttnpb.EndDeviceIdentifiers) time.Time {
+	now := timeNow()
+	retryAt := now.Add(muteWindowRetryInterval)
+	if window, ok := ns.muteWindows.Active(ctx, ids, now); ok && window.EndAt.Before(retryAt) {
+		retryAt = window.EndAt
+	}
+	return retryAt.UTC()
+}
@@ -27,8 +27,10 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/cluster"
 	"go.thethings.network/lorawan-stack/pkg/component"
 	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
 	"go.thethings.network/lorawan-stack/pkg/interop"
 	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/mutewindow"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
@@ -37,8 +39,8 @@ import (
 )
 
 const (
-	// recentDownlinkCount is the maximum amount of recent downlinks stored per device.
-	recentDownlinkCount = 20
+	// DefaultRecentDownlinkCount is the default maximum amount of recent downlinks stored per device.
+	DefaultRecentDownlinkCount = 20
 
 	// fOptsCapacity is the maximum length of FOpts in bytes.
 	fOptsCapacity = 15
@@ -98,8 +100,9 @@ type NetworkServer struct {
 
 	devices DeviceRegistry
 
-	netID           types.NetID
-	devAddrPrefixes []types.DevAddrPrefix
+	netID                      types.NetID
+	devAddrPrefixes            []types.DevAddrPrefix
+	applicationDevAddrPrefixes map[string][]types.DevAddrPrefix
 
 	applicationServers *sync.Map // string -> *applicationUpStream
 	applicationUplinks ApplicationUplinkQueue
@@ -120,6 +123,28 @@ type NetworkServer struct {
 	interopClient InteropClient
 
 	deviceKEKLabel string
+
+	uplinkMirror *uplinkMirror
+	packetLogger *packetLogger
+	fairUse      *fairUsePolicy
+
+	emergencyBroadcasts EmergencyBroadcastRegistry
+	muteWindows         *mutewindow.Cache
+
+	reprovisionRoamingDevices bool
+
+	downlinkPathScorer downlinkPathScorer
+
+	recentUplinkCount   int
+	recentDownlinkCount int
+	macTraceCount       int
+
+	fCntResetGrace uint32
+
+	joinServerTimeout      time.Duration
+	joinAcceptRx2Threshold time.Duration
+
+	lateUplinkGrace time.Duration
 }
 
 // Option configures the NetworkServer.
@@ -195,8 +220,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			ClassCTimeout:         conf.DefaultMACSettings.ClassCTimeout,
 			StatusTimePeriodicity: conf.DefaultMACSettings.StatusTimePeriodicity,
 		},
-		interopClient:  interopCl,
-		deviceKEKLabel: conf.DeviceKEKLabel,
+		interopClient:             interopCl,
+		deviceKEKLabel:            conf.DeviceKEKLabel,
+		reprovisionRoamingDevices: conf.FrequencyPlanRoaming.Reprovision,
+		emergencyBroadcasts:       conf.EmergencyBroadcasts,
 	}
 	ns.hashPool.New = func() interface{} {
 		return fnv.New64a()
@@ -205,6 +232,24 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		return &metadataAccumulator{}
 	}
 
+	if len(conf.ApplicationDevAddrPrefixes) > 0 {
+		ns.applicationDevAddrPrefixes = make(map[string][]types.DevAddrPrefix)
+		for _, p := range conf.ApplicationDevAddrPrefixes {
+			ns.applicationDevAddrPrefixes[p.ApplicationID] = append(ns.applicationDevAddrPrefixes[p.ApplicationID], p.DevAddrPrefix)
+		}
+	}
+	ns.downlinkPathScorer = newDownlinkPathScorer(conf.DownlinkPathScoring)
+	ns.fCntResetGrace = conf.FCntResetGrace
+	ns.lateUplinkGrace = conf.LateUplinkGrace
+	ns.joinServerTimeout, ns.joinAcceptRx2Threshold = conf.JoinAccept.JoinServerTimeout, conf.JoinAccept.Rx2Threshold
+	ns.recentUplinkCount, ns.recentDownlinkCount = conf.RecentUplinkCount, conf.RecentDownlinkCount
+	if ns.recentUplinkCount <= 0 {
+		ns.recentUplinkCount = DefaultRecentUplinkCount
+	}
+	if ns.recentDownlinkCount <= 0 {
+		ns.recentDownlinkCount = DefaultRecentDownlinkCount
+	}
+	ns.macTraceCount = conf.MACTraceCount
 	if conf.DefaultMACSettings.ADRMargin != nil {
 		ns.defaultMACSettings.ADRMargin = &pbtypes.FloatValue{Value: *conf.DefaultMACSettings.ADRMargin}
 	}
@@ -241,11 +286,53 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		return nil, errInvalidConfiguration.WithCause(errors.New("DownlinkTasks is not specified"))
 	}
 
+	deduplicationByBand, collectionByBand := bandWindows(conf.BandDeduplicationWindows, conf.BandCooldownWindows, conf.DeduplicationWindow, conf.CooldownWindow)
 	if ns.deduplicationDone == nil {
-		ns.deduplicationDone = NewWindowEndAfterFunc(conf.DeduplicationWindow)
+		ns.deduplicationDone = newBandWindowEndFunc(c.FrequencyPlans, conf.DeduplicationWindow, deduplicationByBand)
 	}
 	if ns.collectionDone == nil {
-		ns.collectionDone = NewWindowEndAfterFunc(conf.DeduplicationWindow + conf.CooldownWindow)
+		ns.collectionDone = newBandWindowEndFunc(c.FrequencyPlans, conf.DeduplicationWindow+conf.CooldownWindow, collectionByBand)
+	}
+
+	if !conf.UplinkMirror.IsZero() {
+		var tlsConfig *tls.Config
+		if conf.UplinkMirror.TLS {
+			if tlsConfig, err = c.GetTLSClientConfig(ctx); err != nil {
+				return nil, err
+			}
+		}
+		if ns.uplinkMirror, err = newUplinkMirror(ctx, conf.UplinkMirror, tlsConfig); err != nil {
+			return nil, err
+		}
+		ns.RegisterTask(ctx, "mirror_uplinks", ns.uplinkMirror.run, component.TaskRestartOnFailure)
+	}
+
+	if !conf.PacketLogger.IsZero() {
+		if ns.packetLogger, err = newPacketLogger(conf.PacketLogger, c.FrequencyPlans, ns.fetchApplicationPacketLogger); err != nil {
+			return nil, err
+		}
+	}
+
+	if !conf.FairUse.IsZero() {
+		if ns.fairUse, err = newFairUsePolicy(conf.FairUse); err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.MuteWindows.Enable {
+		ns.muteWindows = mutewindow.NewCache(mutewindow.NewRegistryFetcher(c), conf.MuteWindows.CacheTTL)
+		muteWindowHandler := events.HandlerFunc(ns.muteWindows.HandleEvent)
+		for _, name := range mutewindow.UpdateEvents {
+			if err := events.Subscribe(name, muteWindowHandler); err != nil {
+				return nil, err
+			}
+		}
+		go func() {
+			<-ns.Context().Done()
+			for _, name := range mutewindow.UpdateEvents {
+				events.Unsubscribe(name, muteWindowHandler)
+			}
+		}()
 	}
 
 	hooks.RegisterUnaryHook("/ttn.lorawan.v3.GsNs", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("networkserver"))
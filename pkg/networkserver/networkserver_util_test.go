@@ -51,18 +51,19 @@ var (
 	ErrDecodePayload             = errDecodePayload
 	ErrUnsupportedLoRaWANVersion = errUnsupportedLoRaWANVersion
 
-	EvtBeginApplicationLink    = evtBeginApplicationLink
-	EvtCreateEndDevice         = evtCreateEndDevice
-	EvtDeleteEndDevice         = evtDeleteEndDevice
-	EvtDropJoinRequest         = evtDropJoinRequest
-	EvtEndApplicationLink      = evtEndApplicationLink
-	EvtEnqueueDevStatusRequest = evtEnqueueDevStatusRequest
-	EvtEnqueueLinkCheckAnswer  = evtEnqueueLinkCheckAnswer
-	EvtForwardDataUplink       = evtForwardDataUplink
-	EvtForwardJoinRequest      = evtForwardJoinRequest
-	EvtMergeMetadata           = evtMergeMetadata
-	EvtReceiveLinkCheckRequest = evtReceiveLinkCheckRequest
-	EvtUpdateEndDevice         = evtUpdateEndDevice
+	EvtBeginApplicationLink       = evtBeginApplicationLink
+	EvtCreateEndDevice            = evtCreateEndDevice
+	EvtDeleteEndDevice            = evtDeleteEndDevice
+	EvtDetectFrequencyPlanRoaming = evtDetectFrequencyPlanRoaming
+	EvtDropJoinRequest            = evtDropJoinRequest
+	EvtEndApplicationLink         = evtEndApplicationLink
+	EvtEnqueueDevStatusRequest    = evtEnqueueDevStatusRequest
+	EvtEnqueueLinkCheckAnswer     = evtEnqueueLinkCheckAnswer
+	EvtForwardDataUplink          = evtForwardDataUplink
+	EvtForwardJoinRequest         = evtForwardJoinRequest
+	EvtMergeMetadata              = evtMergeMetadata
+	EvtReceiveLinkCheckRequest    = evtReceiveLinkCheckRequest
+	EvtUpdateEndDevice            = evtUpdateEndDevice
 
 	Timeout = (1 << 10) * test.Delay
 )
@@ -104,18 +104,38 @@ var (
 		"ns.up.merge_metadata", "merge uplink message metadata",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtReceiveLateMetadata = events.Define(
+		"ns.up.late_metadata", "receive late uplink message metadata",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtDropDataUplink = events.Define(
 		"ns.up.data.drop", "drop data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtDropDecommissionedDataUplink = events.Define(
+		"ns.up.data.drop.decommissioned", "drop data message of decommissioned device",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtForwardDataUplink = events.Define(
 		"ns.up.data.forward", "forward data message",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtResetFCnt = events.Define(
+		"ns.up.data.f_cnt_reset", "detect frame counter reset",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
+	evtDetectFrequencyPlanRoaming = events.Define(
+		"ns.up.data.frequency_plan_roaming", "detect roaming to gateways of compatible frequency plan",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtDropJoinRequest = events.Define(
 		"ns.up.join.drop", "drop join-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
 	)
+	evtDropDecommissionedJoinRequest = events.Define(
+		"ns.up.join.drop.decommissioned", "drop join-request of decommissioned device",
+		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+	)
 	evtForwardJoinRequest = events.Define(
 		"ns.up.join.forward", "forward join-request",
 		ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
@@ -0,0 +1,194 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/events"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+)
+
+var (
+	errPacketLoggerFilter = errors.DefineInvalidArgument("packet_logger_filter", "no DevAddr prefixes to record")
+	errPacketLoggerBands  = errors.DefineInvalidArgument("packet_logger_bands", "no bands in which recording is allowed")
+)
+
+const defaultPacketLoggerCacheTTL = time.Minute
+
+var evtLogPacket = events.Define(
+	"ns.packet_logger.up.receive", "receive uplink message of unprovisioned device",
+	ttnpb.RIGHT_APPLICATION_TRAFFIC_READ,
+)
+
+// packetLogger records the metadata of data uplink messages of unprovisioned devices as events of a packet logger
+// application. The payload of the messages is not recorded, and no cryptographic operations are performed.
+type packetLogger struct {
+	ids             ttnpb.ApplicationIdentifiers
+	devAddrPrefixes []types.DevAddrPrefix
+	bands           map[string]struct{}
+	fps             *frequencyplans.Store
+
+	ttl   time.Duration
+	fetch func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error)
+
+	mu        sync.Mutex
+	enabled   bool
+	expiresAt time.Time
+}
+
+func newPacketLogger(conf PacketLoggerConfig, fps *frequencyplans.Store, fetch func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error)) (*packetLogger, error) {
+	if len(conf.DevAddrPrefixes) == 0 {
+		return nil, errPacketLoggerFilter
+	}
+	if len(conf.Bands) == 0 {
+		return nil, errPacketLoggerBands
+	}
+	ids := ttnpb.ApplicationIdentifiers{ApplicationID: conf.ApplicationID}
+	if err := ids.ValidateFields("application_id"); err != nil {
+		return nil, err
+	}
+	l := &packetLogger{
+		ids:             ids,
+		devAddrPrefixes: conf.DevAddrPrefixes,
+		bands:           make(map[string]struct{}, len(conf.Bands)),
+		fps:             fps,
+		ttl:             conf.CacheTTL,
+		fetch:           fetch,
+	}
+	if l.ttl == 0 {
+		l.ttl = defaultPacketLoggerCacheTTL
+	}
+	for _, id := range conf.Bands {
+		l.bands[id] = struct{}{}
+	}
+	return l, nil
+}
+
+// matches returns whether uplink messages with the given DevAddr should be recorded.
+func (l *packetLogger) matches(devAddr types.DevAddr) bool {
+	for _, prefix := range l.devAddrPrefixes {
+		if devAddr.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedMetadata returns the metadata of the gateways which use a frequency plan of a band in which recording is
+// allowed. Metadata without frequency plan ID is never recorded.
+func (l *packetLogger) allowedMetadata(mds []*ttnpb.RxMetadata) []*ttnpb.RxMetadata {
+	var allowed []*ttnpb.RxMetadata
+	for _, md := range mds {
+		if md.FrequencyPlanID == "" {
+			continue
+		}
+		fp, err := l.fps.GetByID(md.FrequencyPlanID)
+		if err != nil {
+			continue
+		}
+		if _, ok := l.bands[fp.BandID]; ok {
+			allowed = append(allowed, md)
+		}
+	}
+	return allowed
+}
+
+// isEnabled returns whether the application is a packet logger application.
+// If the application cannot be fetched, the last known state is used.
+func (l *packetLogger) isEnabled(ctx context.Context) bool {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Before(l.expiresAt) {
+		return l.enabled
+	}
+	enabled, err := l.fetch(ctx, l.ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get packet logger application")
+	} else {
+		l.enabled = enabled
+	}
+	l.expiresAt = now.Add(l.ttl)
+	return l.enabled
+}
+
+// packetLoggerMessage returns a copy of the uplink message with only the metadata received by the given gateways and
+// the unencrypted frame header fields.
+func packetLoggerMessage(up *ttnpb.UplinkMessage, mds []*ttnpb.RxMetadata) *ttnpb.UplinkMessage {
+	pld := up.Payload.GetMACPayload()
+	return &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: up.Payload.MHDR,
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: pld.DevAddr,
+						FCtrl:   pld.FCtrl,
+						FCnt:    pld.FCnt,
+					},
+					FPort: pld.FPort,
+				},
+			},
+		},
+		Settings:       up.Settings,
+		RxMetadata:     mds,
+		ReceivedAt:     up.ReceivedAt,
+		CorrelationIDs: up.CorrelationIDs,
+	}
+}
+
+// Log publishes the metadata of the data uplink message of an unprovisioned device as event of the packet logger
+// application, if it matches the DevAddr prefixes and was received in a band in which recording is allowed.
+func (l *packetLogger) Log(ctx context.Context, up *ttnpb.UplinkMessage) {
+	if l == nil {
+		return
+	}
+	pld := up.Payload.GetMACPayload()
+	if pld == nil || !l.matches(pld.DevAddr) {
+		return
+	}
+	mds := l.allowedMetadata(up.RxMetadata)
+	if len(mds) == 0 {
+		return
+	}
+	if !l.isEnabled(ctx) {
+		return
+	}
+	events.Publish(evtLogPacket(ctx, l.ids, packetLoggerMessage(up, mds)))
+}
+
+// fetchApplicationPacketLogger gets whether the application is a packet logger application from the Identity Server.
+func (ns *NetworkServer) fetchApplicationPacketLogger(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (bool, error) {
+	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return false, err
+	}
+	app, err := ttnpb.NewApplicationRegistryClient(cc).Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIdentifiers: ids,
+		FieldMask:              pbtypes.FieldMask{Paths: []string{"packet_logger"}},
+	}, ns.WithClusterAuth())
+	if err != nil {
+		return false, err
+	}
+	return app.PacketLogger, nil
+}
@@ -0,0 +1,165 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/types"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestPacketLogger(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	var fetches int
+	var fetchErr error
+	l, err := newPacketLogger(PacketLoggerConfig{
+		ApplicationID: "packet-logger",
+		DevAddrPrefixes: []types.DevAddrPrefix{
+			{
+				DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00},
+				Length:  16,
+			},
+		},
+		Bands:    []string{"EU_863_870"},
+		CacheTTL: time.Hour,
+	}, frequencyplans.NewStore(test.FrequencyPlansFetcher), func(_ context.Context, ids ttnpb.ApplicationIdentifiers) (bool, error) {
+		a.So(ids.ApplicationID, should.Equal, "packet-logger")
+		fetches++
+		return true, fetchErr
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	a.So(l.matches(types.DevAddr{0x26, 0x01, 0x00, 0x01}), should.BeTrue)
+	a.So(l.matches(types.DevAddr{0x26, 0x02, 0x00, 0x01}), should.BeFalse)
+
+	euMD := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "eu-gtw"},
+		FrequencyPlanID:    test.EUFrequencyPlanID,
+		RSSI:               -42,
+	}
+	usMD := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "us-gtw"},
+		FrequencyPlanID:    test.USFrequencyPlanID,
+	}
+	unknownMD := &ttnpb.RxMetadata{
+		GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "unknown-gtw"},
+	}
+	a.So(l.allowedMetadata([]*ttnpb.RxMetadata{euMD, usMD, unknownMD}), should.Resemble, []*ttnpb.RxMetadata{euMD})
+	a.So(l.allowedMetadata([]*ttnpb.RxMetadata{usMD, unknownMD}), should.BeEmpty)
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01, 0x00, 0x01, 0x26, 0x00, 0x2a, 0x00, 0x01, 0x42, 0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			MIC: []byte{0x01, 0x02, 0x03, 0x04},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x01},
+						FCnt:    42,
+						FOpts:   []byte{0x02},
+					},
+					FPort:      1,
+					FRMPayload: []byte{0x42},
+				},
+			},
+		},
+		RxMetadata:     []*ttnpb.RxMetadata{euMD, usMD},
+		CorrelationIDs: []string{"test"},
+	}
+	a.So(packetLoggerMessage(up, []*ttnpb.RxMetadata{euMD}), should.Resemble, &ttnpb.UplinkMessage{
+		Payload: &ttnpb.Message{
+			MHDR: ttnpb.MHDR{
+				MType: ttnpb.MType_UNCONFIRMED_UP,
+				Major: ttnpb.Major_LORAWAN_R1,
+			},
+			Payload: &ttnpb.Message_MACPayload{
+				MACPayload: &ttnpb.MACPayload{
+					FHDR: ttnpb.FHDR{
+						DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x01},
+						FCnt:    42,
+					},
+					FPort: 1,
+				},
+			},
+		},
+		RxMetadata:     []*ttnpb.RxMetadata{euMD},
+		CorrelationIDs: []string{"test"},
+	})
+
+	a.So(l.isEnabled(ctx), should.BeTrue)
+	a.So(l.isEnabled(ctx), should.BeTrue)
+	a.So(fetches, should.Equal, 1)
+
+	// If fetching fails, the last known state is used.
+	l.expiresAt = time.Time{}
+	fetchErr = errors.New("test")
+	a.So(l.isEnabled(ctx), should.BeTrue)
+	a.So(fetches, should.Equal, 2)
+
+	var nilLogger *packetLogger
+	nilLogger.Log(ctx, up)
+}
+
+func TestNewPacketLogger(t *testing.T) {
+	a := assertions.New(t)
+
+	fetch := func(context.Context, ttnpb.ApplicationIdentifiers) (bool, error) { return true, nil }
+	prefixes := []types.DevAddrPrefix{{Length: 0}}
+
+	_, err := newPacketLogger(PacketLoggerConfig{
+		ApplicationID: "packet-logger",
+		Bands:         []string{"EU_863_870"},
+	}, nil, fetch)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = newPacketLogger(PacketLoggerConfig{
+		ApplicationID:   "packet-logger",
+		DevAddrPrefixes: prefixes,
+	}, nil, fetch)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = newPacketLogger(PacketLoggerConfig{
+		ApplicationID:   "Invalid ID",
+		DevAddrPrefixes: prefixes,
+		Bands:           []string{"EU_863_870"},
+	}, nil, fetch)
+	a.So(err, should.NotBeNil)
+
+	l, err := newPacketLogger(PacketLoggerConfig{
+		ApplicationID:   "packet-logger",
+		DevAddrPrefixes: prefixes,
+		Bands:           []string{"EU_863_870"},
+	}, nil, fetch)
+	a.So(err, should.BeNil)
+	if a.So(l, should.NotBeNil) {
+		a.So(l.ttl, should.Equal, defaultPacketLoggerCacheTTL)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/go-redis/redis"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// EmergencyBroadcastRegistry is an implementation of networkserver.EmergencyBroadcastRegistry.
+// The emergency broadcast of an application expires when it ends.
+type EmergencyBroadcastRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *EmergencyBroadcastRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Get implements networkserver.EmergencyBroadcastRegistry.
+func (r *EmergencyBroadcastRegistry) Get(ctx context.Context, ids ttnpb.ApplicationIdentifiers) (*ttnpb.EmergencyBroadcast, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get emergency broadcast").End()
+
+	pb := &ttnpb.EmergencyBroadcast{}
+	if err := ttnredis.GetProto(r.Redis, r.uidKey(unique.ID(ctx, ids))).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+// Set implements networkserver.EmergencyBroadcastRegistry.
+func (r *EmergencyBroadcastRegistry) Set(ctx context.Context, ids ttnpb.ApplicationIdentifiers, f func(*ttnpb.EmergencyBroadcast) (*ttnpb.EmergencyBroadcast, error)) (*ttnpb.EmergencyBroadcast, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return nil, err
+	}
+	uk := r.uidKey(unique.ID(ctx, ids))
+
+	defer trace.StartRegion(ctx, "set emergency broadcast").End()
+
+	var pb *ttnpb.EmergencyBroadcast
+	err := r.Redis.Watch(func(tx *redis.Tx) error {
+		stored := &ttnpb.EmergencyBroadcast{}
+		if err := ttnredis.GetProto(tx, uk).ScanProto(stored); errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+
+		var err error
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if stored == nil && pb == nil {
+			return nil
+		}
+
+		var pipelined func(redis.Pipeliner) error
+		if ttl := time.Until(pb.GetEndsAt()); pb == nil || ttl <= 0 {
+			pipelined = func(p redis.Pipeliner) error {
+				p.Del(uk)
+				return nil
+			}
+		} else {
+			if err := pb.ValidateFields(); err != nil {
+				return err
+			}
+			pipelined = func(p redis.Pipeliner) error {
+				_, err := ttnredis.SetProto(p, uk, pb, ttl)
+				return err
+			}
+		}
+		_, err = tx.Pipelined(pipelined)
+		return err
+	}, uk)
+	if err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
@@ -0,0 +1,76 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/unique"
+)
+
+// FairUseRegistry is an implementation of networkserver.FairUseRegistry.
+// The airtime of an end device in a window is stored as integer number of nanoseconds, so that it can be incremented
+// atomically by multiple Network Server instances.
+type FairUseRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *FairUseRegistry) windowKey(uid string, start time.Time) string {
+	return r.Redis.Key("uid", uid, strconv.FormatInt(start.UnixNano(), 10))
+}
+
+// AddAirtime implements networkserver.FairUseRegistry.
+func (r *FairUseRegistry) AddAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, start time.Time, airtime, ttl time.Duration) (time.Duration, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return 0, err
+	}
+	k := r.windowKey(unique.ID(ctx, ids), start)
+
+	defer trace.StartRegion(ctx, "add fair use airtime").End()
+
+	var cmd *redis.IntCmd
+	if _, err := r.Redis.TxPipelined(func(p redis.Pipeliner) error {
+		cmd = p.IncrBy(k, int64(airtime))
+		p.PExpire(k, ttl)
+		return nil
+	}); err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+	return time.Duration(cmd.Val()), nil
+}
+
+// GetAirtime implements networkserver.FairUseRegistry.
+func (r *FairUseRegistry) GetAirtime(ctx context.Context, ids ttnpb.EndDeviceIdentifiers, start time.Time) (time.Duration, error) {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return 0, err
+	}
+
+	defer trace.StartRegion(ctx, "get fair use airtime").End()
+
+	v, err := r.Redis.Get(r.windowKey(unique.ID(ctx, ids), start)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+	return time.Duration(v), nil
+}
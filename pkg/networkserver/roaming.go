@@ -0,0 +1,80 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"sort"
+
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+// roamingFrequencyPlanID returns the ID of the frequency plan that the end device roams to, if any.
+// An end device roams if none of the gateways that received the uplink message use the frequency plan of the end
+// device, and the frequency plan that is used by most of these gateways is of the same band as the one of the end device.
+// Metadata without frequency plan ID is ignored.
+func roamingFrequencyPlanID(dev *ttnpb.EndDevice, mds []*ttnpb.RxMetadata, fps *frequencyplans.Store) (string, bool) {
+	counts := make(map[string]int, len(mds))
+	for _, md := range mds {
+		switch md.FrequencyPlanID {
+		case "":
+			continue
+		case dev.FrequencyPlanID:
+			return "", false
+		}
+		counts[md.FrequencyPlanID]++
+	}
+	if len(counts) == 0 {
+		return "", false
+	}
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if counts[ids[i]] != counts[ids[j]] {
+			return counts[ids[i]] > counts[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	devFP, err := fps.GetByID(dev.FrequencyPlanID)
+	if err != nil {
+		return "", false
+	}
+	fp, err := fps.GetByID(ids[0])
+	if err != nil || fp.BandID != devFP.BandID {
+		return "", false
+	}
+	return ids[0], true
+}
+
+// reprovisionFrequencyPlan switches the end device to the frequency plan with the given ID.
+// Only the desired channels and the frequencies of the Rx2, ping slot and beacon channels are reset to the ones of the
+// frequency plan, so that they are provisioned with MAC commands in the next downlink messages. The other desired
+// MAC parameters, such as the ones set by ADR, are kept.
+func reprovisionFrequencyPlan(dev *ttnpb.EndDevice, fpID string, fps *frequencyplans.Store, defaults ttnpb.MACSettings) error {
+	fromID := dev.FrequencyPlanID
+	dev.FrequencyPlanID = fpID
+	macState, err := newMACState(dev, fps, defaults)
+	if err != nil {
+		dev.FrequencyPlanID = fromID
+		return err
+	}
+	dev.MACState.DesiredParameters.Channels = macState.DesiredParameters.Channels
+	dev.MACState.DesiredParameters.Rx2Frequency = macState.DesiredParameters.Rx2Frequency
+	dev.MACState.DesiredParameters.PingSlotFrequency = macState.DesiredParameters.PingSlotFrequency
+	dev.MACState.DesiredParameters.BeaconFrequency = macState.DesiredParameters.BeaconFrequency
+	return nil
+}
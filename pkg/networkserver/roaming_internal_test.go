@@ -0,0 +1,83 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestRoamingFrequencyPlanID(t *testing.T) {
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	dev := &ttnpb.EndDevice{
+		FrequencyPlanID: test.EUFrequencyPlanID,
+	}
+	for _, tc := range []struct {
+		Name       string
+		IDs        []string
+		ExpectedID string
+		ExpectedOK bool
+	}{
+		{
+			Name: "NoMetadata",
+		},
+		{
+			Name: "NoFrequencyPlan",
+			IDs:  []string{"", ""},
+		},
+		{
+			Name: "SameFrequencyPlan",
+			IDs:  []string{test.EUFrequencyPlanID, test.ExampleFrequencyPlanID},
+		},
+		{
+			Name:       "CompatibleFrequencyPlan",
+			IDs:        []string{"", test.ExampleFrequencyPlanID},
+			ExpectedID: test.ExampleFrequencyPlanID,
+			ExpectedOK: true,
+		},
+		{
+			Name: "IncompatibleFrequencyPlan",
+			IDs:  []string{test.KRFrequencyPlanID},
+		},
+		{
+			Name:       "MostCommonFrequencyPlan",
+			IDs:        []string{test.KRFrequencyPlanID, test.ExampleFrequencyPlanID, test.ExampleFrequencyPlanID},
+			ExpectedID: test.ExampleFrequencyPlanID,
+			ExpectedOK: true,
+		},
+		{
+			Name: "UnknownFrequencyPlan",
+			IDs:  []string{"unknown"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			var mds []*ttnpb.RxMetadata
+			for _, id := range tc.IDs {
+				mds = append(mds, &ttnpb.RxMetadata{
+					FrequencyPlanID: id,
+				})
+			}
+			id, ok := roamingFrequencyPlanID(dev, mds, fps)
+			a.So(ok, should.Equal, tc.ExpectedOK)
+			a.So(id, should.Equal, tc.ExpectedID)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+)
+
+var (
+	errBandWindow         = errors.DefineInvalidArgument("band_window", "invalid band window `{value}`, expected `band-id=duration`")
+	errBandWindowDuration = errors.DefineInvalidArgument("band_window_duration", "duration `{duration}` of band window is not positive")
+)
+
+// BandWindow is the duration of a deduplication or cooldown window of uplink messages in a band, for example a longer
+// window for gateways with a high-latency backhaul. It is configured as band-id=duration, for example EU_863_870=500ms.
+type BandWindow struct {
+	BandID string
+	Window time.Duration
+}
+
+// UnmarshalConfigString implements the config.Configurable interface.
+func (w *BandWindow) UnmarshalConfigString(s string) error {
+	i := strings.Index(s, "=")
+	if i <= 0 {
+		return errBandWindow.WithAttributes("value", s)
+	}
+	d, err := time.ParseDuration(s[i+1:])
+	if err != nil {
+		return errBandWindow.WithAttributes("value", s).WithCause(err)
+	}
+	if d <= 0 {
+		return errBandWindowDuration.WithAttributes("duration", d)
+	}
+	w.BandID, w.Window = s[:i], d
+	return nil
+}
+
+// ConfigString implements the config.Stringer interface.
+func (w BandWindow) ConfigString() string {
+	return w.BandID + "=" + w.Window.String()
+}
+
+// bandWindows returns the deduplication windows and the collection windows, which are the deduplication windows plus
+// the cooldown windows, of the bands that have either window configured. The other window of such a band defaults to
+// the given deduplication or cooldown window.
+func bandWindows(deduplication, cooldown []BandWindow, defaultDeduplication, defaultCooldown time.Duration) (deduplicationByBand, collectionByBand map[string]time.Duration) {
+	if len(deduplication) == 0 && len(cooldown) == 0 {
+		return nil, nil
+	}
+	deduplicationByBand = make(map[string]time.Duration, len(deduplication)+len(cooldown))
+	cooldownByBand := make(map[string]time.Duration, len(deduplication)+len(cooldown))
+	for _, w := range deduplication {
+		deduplicationByBand[w.BandID] = w.Window
+		if _, ok := cooldownByBand[w.BandID]; !ok {
+			cooldownByBand[w.BandID] = defaultCooldown
+		}
+	}
+	for _, w := range cooldown {
+		cooldownByBand[w.BandID] = w.Window
+		if _, ok := deduplicationByBand[w.BandID]; !ok {
+			deduplicationByBand[w.BandID] = defaultDeduplication
+		}
+	}
+	collectionByBand = make(map[string]time.Duration, len(cooldownByBand))
+	for bandID, d := range cooldownByBand {
+		collectionByBand[bandID] = deduplicationByBand[bandID] + d
+	}
+	return deduplicationByBand, collectionByBand
+}
+
+// newBandWindowEndFunc returns a WindowEndFunc, which ends the window after the duration of the band of the uplink
+// message, if configured, and after d otherwise. The band is the band of the frequency plan of the first gateway that
+// received the uplink message and reported its frequency plan.
+func newBandWindowEndFunc(fps *frequencyplans.Store, d time.Duration, byBand map[string]time.Duration) WindowEndFunc {
+	f := NewWindowEndAfterFunc(d)
+	if len(byBand) == 0 {
+		return f
+	}
+	fs := make(map[string]WindowEndFunc, len(byBand))
+	for bandID, d := range byBand {
+		fs[bandID] = NewWindowEndAfterFunc(d)
+	}
+	return func(ctx context.Context, up *ttnpb.UplinkMessage) <-chan time.Time {
+		for _, md := range up.RxMetadata {
+			if md.FrequencyPlanID == "" {
+				continue
+			}
+			if fp, err := fps.GetByID(md.FrequencyPlanID); err == nil {
+				if f, ok := fs[fp.BandID]; ok {
+					return f(ctx, up)
+				}
+			}
+			break
+		}
+		return f(ctx, up)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestBandWindowEndFunc(t *testing.T) {
+	a := assertions.New(t)
+
+	var windows []BandWindow
+	for _, s := range []string{
+		"EU_863_870=2s",
+		"US_902_928=3s",
+	} {
+		var w BandWindow
+		if !a.So(w.UnmarshalConfigString(s), should.BeNil) {
+			t.FailNow()
+		}
+		a.So(w.ConfigString(), should.Equal, s)
+		windows = append(windows, w)
+	}
+	a.So(new(BandWindow).UnmarshalConfigString("EU_863_870"), should.HaveSameErrorDefinitionAs, errBandWindow)
+	a.So(new(BandWindow).UnmarshalConfigString("EU_863_870=1x"), should.HaveSameErrorDefinitionAs, errBandWindow)
+	a.So(new(BandWindow).UnmarshalConfigString("EU_863_870=0s"), should.HaveSameErrorDefinitionAs, errBandWindowDuration)
+	a.So(new(BandWindow).UnmarshalConfigString("EU_863_870=-1s"), should.HaveSameErrorDefinitionAs, errBandWindowDuration)
+
+	deduplicationByBand, collectionByBand := bandWindows(windows, []BandWindow{
+		{BandID: "US_902_928", Window: 4 * time.Second},
+		{BandID: "AS_923", Window: 5 * time.Second},
+	}, 200*time.Millisecond, time.Second)
+	a.So(deduplicationByBand, should.Resemble, map[string]time.Duration{
+		"EU_863_870": 2 * time.Second,
+		"US_902_928": 3 * time.Second,
+		"AS_923":     200 * time.Millisecond,
+	})
+	a.So(collectionByBand, should.Resemble, map[string]time.Duration{
+		"EU_863_870": 3 * time.Second,
+		"US_902_928": 7 * time.Second,
+		"AS_923":     5200 * time.Millisecond,
+	})
+
+	windowEnd := newBandWindowEndFunc(frequencyplans.NewStore(test.FrequencyPlansFetcher), 200*time.Millisecond, deduplicationByBand)
+	receivedAt := time.Unix(42, 0)
+	for _, tc := range []struct {
+		Name        string
+		RxMetadata  []*ttnpb.RxMetadata
+		ExpectedEnd time.Time
+	}{
+		{
+			Name:        "no metadata",
+			ExpectedEnd: receivedAt.Add(200 * time.Millisecond),
+		},
+		{
+			Name: "EU868",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{FrequencyPlanID: test.EUFrequencyPlanID},
+				{FrequencyPlanID: test.USFrequencyPlanID},
+			},
+			ExpectedEnd: receivedAt.Add(2 * time.Second),
+		},
+		{
+			Name: "US915/no frequency plan of first gateway",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{},
+				{FrequencyPlanID: test.USFrequencyPlanID},
+			},
+			ExpectedEnd: receivedAt.Add(3 * time.Second),
+		},
+		{
+			Name: "unknown frequency plan",
+			RxMetadata: []*ttnpb.RxMetadata{
+				{FrequencyPlanID: "unknown"},
+				{FrequencyPlanID: test.EUFrequencyPlanID},
+			},
+			ExpectedEnd: receivedAt.Add(200 * time.Millisecond),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			select {
+			case end := <-windowEnd(test.Context(), &ttnpb.UplinkMessage{
+				ReceivedAt: receivedAt,
+				RxMetadata: tc.RxMetadata,
+			}):
+				a.So(end, should.Equal, tc.ExpectedEnd)
+			case <-time.After(test.Delay):
+				t.Fatal("Timed out waiting for window to end")
+			}
+		})
+	}
+}
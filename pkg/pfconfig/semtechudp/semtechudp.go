@@ -18,6 +18,7 @@ package semtechudp
 import (
 	"net"
 	"strconv"
+	"strings"
 
 	"go.thethings.network/lorawan-stack/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/pkg/pfconfig/shared"
@@ -45,7 +46,7 @@ func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error)
 
 	host, portStr, err := net.SplitHostPort(gateway.GatewayServerAddress)
 	if err != nil {
-		host = gateway.GatewayServerAddress
+		host = strings.Trim(gateway.GatewayServerAddress, "[]")
 		portStr = "1700"
 	}
 	port, err := strconv.Atoi(portStr)
@@ -0,0 +1,212 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chaos implements gRPC client interceptors that inject faults in calls between components.
+// This is meant for testing monitoring and recovery behavior in staging environments and must not be
+// enabled in production.
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+var (
+	errInjected = errors.DefineUnavailable("injected", "injected failure for `{method}`")
+	errDropped  = errors.DefineUnavailable("dropped", "dropped request of `{method}`")
+)
+
+// Rule defines the faults to inject in calls to gRPC methods.
+type Rule struct {
+	// Method is the prefix of the full gRPC method names that the rule applies to,
+	// for example /ttn.lorawan.v3.GsNs/ for all calls from the Gateway Server to the Network Server.
+	Method string `json:"method"`
+	// Latency is the latency that is added to each call or stream message.
+	Latency time.Duration `json:"latency"`
+	// ErrorRate is the probability (0-1) that a call fails with an Unavailable error.
+	ErrorRate float64 `json:"error_rate"`
+	// DropRate is the probability (0-1) that a request message is silently dropped.
+	// Dropped unary calls are not sent and return an Unavailable error. Dropped stream messages are not sent.
+	DropRate float64 `json:"drop_rate"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (r Rule) MarshalJSON() ([]byte, error) {
+	type alias Rule
+	return json.Marshal(struct {
+		alias
+		Latency string `json:"latency"`
+	}{
+		alias:   alias(r),
+		Latency: r.Latency.String(),
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (r *Rule) UnmarshalJSON(b []byte) error {
+	type alias Rule
+	var v struct {
+		*alias
+		Latency string `json:"latency"`
+	}
+	v.alias = (*alias)(r)
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	r.Latency = 0
+	if v.Latency != "" {
+		d, err := time.ParseDuration(v.Latency)
+		if err != nil {
+			return err
+		}
+		r.Latency = d
+	}
+	return nil
+}
+
+// Injector injects faults in gRPC calls according to its rules.
+// The zero value does not inject any faults.
+type Injector struct {
+	mu    sync.RWMutex
+	rules []Rule
+	rand  func() float64
+}
+
+// SetRules replaces the rules of the injector.
+func (i *Injector) SetRules(rules ...Rule) {
+	i.mu.Lock()
+	i.rules = append([]Rule(nil), rules...)
+	i.mu.Unlock()
+}
+
+// Rules returns the rules of the injector.
+func (i *Injector) Rules() []Rule {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return append([]Rule(nil), i.rules...)
+}
+
+// rule returns the first rule that matches the method.
+func (i *Injector) rule(method string) (Rule, bool) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	for _, rule := range i.rules {
+		if strings.HasPrefix(method, rule.Method) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+func (i *Injector) chance(p float64) bool {
+	if p <= 0 {
+		return false
+	}
+	if i.rand != nil {
+		return i.rand() < p
+	}
+	return rand.Float64() < p
+}
+
+func delay(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// UnaryClientInterceptor is a unary client interceptor that injects faults.
+func (i *Injector) UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	rule, ok := i.rule(method)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	if err := delay(ctx, rule.Latency); err != nil {
+		return err
+	}
+	if i.chance(rule.ErrorRate) {
+		return errInjected.WithAttributes("method", method)
+	}
+	if i.chance(rule.DropRate) {
+		return errDropped.WithAttributes("method", method)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor is a streaming client interceptor that injects faults.
+func (i *Injector) StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	rule, ok := i.rule(method)
+	if !ok {
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+	if err := delay(ctx, rule.Latency); err != nil {
+		return nil, err
+	}
+	if i.chance(rule.ErrorRate) {
+		return nil, errInjected.WithAttributes("method", method)
+	}
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &clientStream{
+		ClientStream: stream,
+		injector:     i,
+		method:       method,
+	}, nil
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	injector *Injector
+	method   string
+}
+
+// SendMsg implements grpc.ClientStream.
+func (s *clientStream) SendMsg(m interface{}) error {
+	rule, ok := s.injector.rule(s.method)
+	if !ok {
+		return s.ClientStream.SendMsg(m)
+	}
+	if err := delay(s.Context(), rule.Latency); err != nil {
+		return err
+	}
+	if s.injector.chance(rule.ErrorRate) {
+		return errInjected.WithAttributes("method", s.method)
+	}
+	if s.injector.chance(rule.DropRate) {
+		return nil
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+// DialOptions returns the gRPC dial options that install the interceptors of the injector.
+func (i *Injector) DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(i.UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(i.StreamClientInterceptor),
+	}
+}
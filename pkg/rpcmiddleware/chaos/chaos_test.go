@@ -0,0 +1,104 @@
+// Copyright © 2019 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	a := assertions.New(t)
+
+	var invoked int
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked++
+		return nil
+	}
+
+	var chance float64
+	i := &Injector{rand: func() float64 { return chance }}
+
+	// Without rules, calls pass.
+	a.So(i.UnaryClientInterceptor(context.Background(), "/ttn.lorawan.v3.GsNs/HandleUplink", nil, nil, nil, invoker), should.BeNil)
+	a.So(invoked, should.Equal, 1)
+
+	i.SetRules(Rule{
+		Method:    "/ttn.lorawan.v3.GsNs/",
+		Latency:   10 * time.Millisecond,
+		ErrorRate: 0.5,
+		DropRate:  0.5,
+	})
+
+	// Other methods pass.
+	a.So(i.UnaryClientInterceptor(context.Background(), "/ttn.lorawan.v3.NsAs/HandleUplink", nil, nil, nil, invoker), should.BeNil)
+	a.So(invoked, should.Equal, 2)
+
+	// Error.
+	chance = 0.1
+	start := time.Now()
+	err := i.UnaryClientInterceptor(context.Background(), "/ttn.lorawan.v3.GsNs/HandleUplink", nil, nil, nil, invoker)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(time.Since(start), should.BeGreaterThanOrEqualTo, 10*time.Millisecond)
+	a.So(invoked, should.Equal, 2)
+
+	// Pass.
+	chance = 0.9
+	a.So(i.UnaryClientInterceptor(context.Background(), "/ttn.lorawan.v3.GsNs/HandleUplink", nil, nil, nil, invoker), should.BeNil)
+	a.So(invoked, should.Equal, 3)
+
+	// Drop.
+	i.SetRules(Rule{
+		Method:   "/ttn.lorawan.v3.GsNs/",
+		DropRate: 1,
+	})
+	err = i.UnaryClientInterceptor(context.Background(), "/ttn.lorawan.v3.GsNs/HandleUplink", nil, nil, nil, invoker)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(invoked, should.Equal, 3)
+
+	// Latency respects context.
+	i.SetRules(Rule{
+		Method:  "/ttn.lorawan.v3.GsNs/",
+		Latency: time.Hour,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = i.UnaryClientInterceptor(ctx, "/ttn.lorawan.v3.GsNs/HandleUplink", nil, nil, nil, invoker)
+	a.So(errors.Is(err, context.DeadlineExceeded), should.BeTrue)
+}
+
+func TestRuleJSON(t *testing.T) {
+	a := assertions.New(t)
+
+	rule := Rule{
+		Method:    "/ttn.lorawan.v3.NsAs/",
+		Latency:   1500 * time.Millisecond,
+		ErrorRate: 0.1,
+	}
+	b, err := json.Marshal(rule)
+	a.So(err, should.BeNil)
+	a.So(string(b), should.Equal, `{"method":"/ttn.lorawan.v3.NsAs/","error_rate":0.1,"drop_rate":0,"latency":"1.5s"}`)
+
+	var decoded Rule
+	a.So(json.Unmarshal(b, &decoded), should.BeNil)
+	a.So(decoded, should.Resemble, rule)
+}
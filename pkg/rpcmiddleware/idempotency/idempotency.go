@@ -0,0 +1,218 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotency implements a gRPC hook that makes RPCs idempotent with idempotency keys.
+//
+// Clients set an idempotency key in the idempotency-key metadata (or the Idempotency-Key HTTP header). When a call is
+// retried with the same key, the response of the first successful call is returned instead of calling the handler
+// again. Keys are scoped to the method and the credentials of the caller, and the retried call must have the same
+// request. Failed calls are not remembered, so that they can be retried. The calls are remembered in Redis, so that
+// retries are recognized by all instances that share the store.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	pbtypes "github.com/gogo/protobuf/types"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	"go.thethings.network/lorawan-stack/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/pkg/redis"
+	"go.thethings.network/lorawan-stack/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// HookName is the name of the idempotency hook.
+const HookName = "idempotency"
+
+// MetadataKey is the gRPC metadata key of the idempotency key.
+const MetadataKey = "idempotency-key"
+
+// DefaultTTL is the default time that responses are remembered.
+const DefaultTTL = time.Hour
+
+const maxKeyLength = 128
+
+// pollInterval is the interval in which a retried call polls for the response of a call that is still in progress.
+const pollInterval = 100 * time.Millisecond
+
+var (
+	errKeyLength = errors.DefineInvalidArgument(
+		"key_length", "idempotency key is longer than `{max}` characters",
+	)
+	errKeyReused = errors.DefineInvalidArgument(
+		"key_reused", "idempotency key was used for a different request",
+	)
+)
+
+// Store remembers the responses of calls by idempotency key in Redis.
+// The request of a call is stored when the call starts, and the response when the call succeeds. Both expire after
+// the TTL of the store.
+type Store struct {
+	redis *ttnredis.Client
+	ttl   time.Duration
+}
+
+// NewStore returns a new store that remembers responses in Redis for the given TTL. If ttl is zero, DefaultTTL is
+// used.
+func NewStore(cl *ttnredis.Client, ttl time.Duration) *Store {
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	return &Store{
+		redis: cl,
+		ttl:   ttl,
+	}
+}
+
+func (s *Store) requestKey(key string) string {
+	return s.redis.Key(key, "request")
+}
+
+func (s *Store) responseKey(key string) string {
+	return s.redis.Key(key, "response")
+}
+
+func hash(parts ...string) string {
+	d := sha256.New()
+	for _, p := range parts {
+		d.Write([]byte(p))
+		d.Write([]byte{0})
+	}
+	return hex.EncodeToString(d.Sum(nil))
+}
+
+// call calls the handler, unless a call with the same key was made before, in which case it waits for that call to
+// finish and returns its response.
+func (s *Store) call(ctx context.Context, key string, req proto.Message, handler grpc.UnaryHandler) (interface{}, error) {
+	reqBytes, err := proto.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		ok, err := s.redis.SetNX(s.requestKey(key), reqBytes, s.ttl).Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		if ok {
+			return s.handle(ctx, key, req, handler)
+		}
+		res, ok, err := s.wait(ctx, key, req)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return res, nil
+		}
+		// The first call failed; call the handler again.
+	}
+}
+
+// handle calls the handler and stores its response if the call succeeds.
+// If the call fails, the request is removed, so that the call can be retried.
+func (s *Store) handle(ctx context.Context, key string, req proto.Message, handler grpc.UnaryHandler) (interface{}, error) {
+	logger := log.FromContext(ctx)
+	res, err := handler(ctx, req)
+	if err != nil {
+		if err := s.redis.Del(s.requestKey(key)).Err(); err != nil {
+			logger.WithError(err).Warn("Failed to remove request of failed call from idempotency store")
+		}
+		return nil, err
+	}
+	resAny, err := pbtypes.MarshalAny(res.(proto.Message))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal response for idempotency store")
+		return res, nil
+	}
+	resBytes, err := resAny.Marshal()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to marshal response for idempotency store")
+		return res, nil
+	}
+	if _, err := s.redis.TxPipelined(func(p redis.Pipeliner) error {
+		p.Set(s.responseKey(key), resBytes, s.ttl)
+		p.PExpire(s.requestKey(key), s.ttl)
+		return nil
+	}); err != nil {
+		logger.WithError(err).Warn("Failed to store response in idempotency store")
+	}
+	return res, nil
+}
+
+// wait waits for the call with the same key to finish, and returns its response.
+// It returns false if that call failed, so that the handler can be called again.
+func (s *Store) wait(ctx context.Context, key string, req proto.Message) (interface{}, bool, error) {
+	for {
+		reqBytes, err := s.redis.Get(s.requestKey(key)).Bytes()
+		if err == redis.Nil {
+			return nil, false, nil
+		} else if err != nil {
+			return nil, false, ttnredis.ConvertError(err)
+		}
+		stored := proto.Clone(req)
+		stored.Reset()
+		if err := proto.Unmarshal(reqBytes, stored); err != nil || !proto.Equal(stored, req) {
+			return nil, false, errKeyReused
+		}
+		resBytes, err := s.redis.Get(s.responseKey(key)).Bytes()
+		if err == nil {
+			resAny := &pbtypes.Any{}
+			if err := resAny.Unmarshal(resBytes); err != nil {
+				return nil, false, err
+			}
+			var res pbtypes.DynamicAny
+			if err := pbtypes.UnmarshalAny(resAny, &res); err != nil {
+				return nil, false, err
+			}
+			return res.Message, true, nil
+		} else if err != redis.Nil {
+			return nil, false, ttnredis.ConvertError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// UnaryHook returns a hook that makes the unary calls with an idempotency key idempotent.
+// The responses must be protocol buffer messages that are registered with the protocol buffer registry.
+func (s *Store) UnaryHook() hooks.UnaryHandlerMiddleware {
+	return func(next grpc.UnaryHandler) grpc.UnaryHandler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			keys := md.Get(MetadataKey)
+			if len(keys) == 0 || keys[0] == "" {
+				return next(ctx, req)
+			}
+			if len(keys[0]) > maxKeyLength {
+				return nil, errKeyLength.WithAttributes("max", maxKeyLength)
+			}
+			msg, ok := req.(proto.Message)
+			if !ok {
+				return next(ctx, req)
+			}
+			method, _ := grpc.Method(ctx)
+			auth := rpcmetadata.FromIncomingContext(ctx)
+			return s.call(ctx, hash(method, auth.AuthType, auth.AuthValue, keys[0]), msg, next)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package idempotency_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
+	"go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+)
+
+var errTest = errors.DefineUnavailable("test", "test")
+
+func TestUnaryHook(t *testing.T) {
+	a := assertions.New(t)
+
+	var mu sync.Mutex
+	var calls int
+	var fail bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if fail {
+			return nil, errTest
+		}
+		return &ttnpb.Gateway{
+			GatewayIdentifiers: req.(*ttnpb.CreateGatewayRequest).GatewayIdentifiers,
+			Name:               "call",
+		}, nil
+	}
+
+	cl, flush := test.NewRedis(t, "idempotency_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	// Each store represents an instance that receives part of the calls.
+	hook := NewStore(cl, test.Delay<<4).UnaryHook()(handler)
+	otherHook := NewStore(cl, test.Delay<<4).UnaryHook()(handler)
+
+	withKey := func(key, token string) context.Context {
+		return metadata.NewIncomingContext(test.Context(), metadata.Pairs(
+			MetadataKey, key,
+			"authorization", "Bearer "+token,
+		))
+	}
+	req := func(id string) *ttnpb.CreateGatewayRequest {
+		return &ttnpb.CreateGatewayRequest{
+			Gateway: ttnpb.Gateway{
+				GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: id},
+			},
+		}
+	}
+
+	// Calls without idempotency key are not remembered.
+	for i := 0; i < 2; i++ {
+		_, err := hook(test.Context(), req("foo"))
+		a.So(err, should.BeNil)
+	}
+	a.So(calls, should.Equal, 2)
+
+	// Retried calls with the same key return the first response.
+	res1, err := hook(withKey("key1", "token"), req("foo"))
+	a.So(err, should.BeNil)
+	res2, err := hook(withKey("key1", "token"), req("foo"))
+	a.So(err, should.BeNil)
+	a.So(res2, should.Resemble, res1)
+	a.So(calls, should.Equal, 3)
+
+	// Retried calls that reach another instance return the first response.
+	res3, err := otherHook(withKey("key1", "token"), req("foo"))
+	a.So(err, should.BeNil)
+	a.So(res3, should.Resemble, res1)
+	a.So(calls, should.Equal, 3)
+
+	// The same key with a different request is rejected.
+	_, err = hook(withKey("key1", "token"), req("bar"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	a.So(calls, should.Equal, 3)
+
+	// Keys are scoped to the credentials of the caller.
+	_, err = hook(withKey("key1", "other-token"), req("bar"))
+	a.So(err, should.BeNil)
+	a.So(calls, should.Equal, 4)
+
+	// Failed calls are not remembered.
+	fail = true
+	_, err = hook(withKey("key2", "token"), req("foo"))
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	fail = false
+	_, err = hook(withKey("key2", "token"), req("foo"))
+	a.So(err, should.BeNil)
+	a.So(calls, should.Equal, 6)
+
+	// Keys are limited in length.
+	_, err = hook(withKey(strings.Repeat("x", 129), "token"), req("foo"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	// Responses are forgotten after the TTL.
+	time.Sleep(test.Delay << 5)
+	_, err = hook(withKey("key1", "token"), req("bar"))
+	a.So(err, should.BeNil)
+	a.So(calls, should.Equal, 7)
+}
+
+func TestUnaryHookConcurrent(t *testing.T) {
+	a := assertions.New(t)
+
+	var calls uint64
+	release := make(chan struct{})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		atomic.AddUint64(&calls, 1)
+		<-release
+		return &ttnpb.Gateway{
+			GatewayIdentifiers: req.(*ttnpb.CreateGatewayRequest).GatewayIdentifiers,
+		}, nil
+	}
+
+	cl, flush := test.NewRedis(t, "idempotency_test")
+	defer func() {
+		flush()
+		cl.Close()
+	}()
+	hook := NewStore(cl, 0).UnaryHook()(handler)
+	otherHook := NewStore(cl, 0).UnaryHook()(handler)
+
+	ctx := metadata.NewIncomingContext(test.Context(), metadata.Pairs(
+		MetadataKey, "key",
+		"authorization", "Bearer token",
+	))
+	req := &ttnpb.CreateGatewayRequest{
+		Gateway: ttnpb.Gateway{
+			GatewayIdentifiers: ttnpb.GatewayIdentifiers{GatewayID: "foo"},
+		},
+	}
+
+	resCh := make(chan interface{}, 1)
+	go func() {
+		res, err := hook(ctx, req)
+		a.So(err, should.BeNil)
+		resCh <- res
+	}()
+	for atomic.LoadUint64(&calls) == 0 {
+		time.Sleep(test.Delay)
+	}
+
+	// A retried call that reaches another instance while the first call is in progress waits for its response.
+	otherResCh := make(chan interface{}, 1)
+	go func() {
+		res, err := otherHook(ctx, req)
+		a.So(err, should.BeNil)
+		otherResCh <- res
+	}()
+	time.Sleep(test.Delay << 3)
+	close(release)
+
+	res := <-resCh
+	a.So(<-otherResCh, should.Resemble, res)
+	a.So(atomic.LoadUint64(&calls), should.Equal, 1)
+}
@@ -23,6 +23,7 @@ import (
 	"os"
 	"runtime/debug"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/getsentry/raven-go"
@@ -41,6 +42,7 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware"
 	rpcfillcontext "go.thethings.network/lorawan-stack/pkg/rpcmiddleware/fillcontext"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/hooks"
+	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/idempotency"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/sentry"
 	"go.thethings.network/lorawan-stack/pkg/rpcmiddleware/validator"
@@ -205,6 +207,14 @@ func New(ctx context.Context, opts ...Option) *Server {
 
 			return md.ToMetadata()
 		}),
+		runtime.WithIncomingHeaderMatcher(func(s string) (string, bool) {
+			// NOTE: When adding headers, also add them to CORSConfig in ../component/grpc.go.
+			switch strings.ToLower(s) {
+			case idempotency.MetadataKey:
+				return idempotency.MetadataKey, true
+			}
+			return runtime.DefaultHeaderMatcher(s)
+		}),
 		runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) {
 			// NOTE: When adding headers, also add them to CORSConfig in ../component/grpc.go.
 			switch s {
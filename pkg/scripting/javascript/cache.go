@@ -0,0 +1,88 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/robertkrimen/otto"
+)
+
+type scriptKey [sha256.Size]byte
+
+type cacheEntry struct {
+	key    scriptKey
+	script *otto.Script
+}
+
+// scriptCache is a least recently used cache of compiled scripts by the hash of their source.
+type scriptCache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[scriptKey]*list.Element
+	order   *list.List
+}
+
+func newScriptCache(size int) *scriptCache {
+	return &scriptCache{
+		size:    size,
+		entries: make(map[scriptKey]*list.Element, size),
+		order:   list.New(),
+	}
+}
+
+// Get returns the compiled script by key, if it is cached.
+func (c *scriptCache) Get(key scriptKey) (*otto.Script, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		cacheLookups.WithLabelValues("miss").Inc()
+		return nil, false
+	}
+	cacheLookups.WithLabelValues("hit").Inc()
+	c.order.MoveToFront(el)
+	return el.Value.(*cacheEntry).script, true
+}
+
+// Add adds the compiled script by key. If the cache is full, the least recently used script is evicted.
+func (c *scriptCache) Add(key scriptKey, script *otto.Script) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*cacheEntry).script = script
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{
+		key:    key,
+		script: script,
+	})
+	for c.order.Len() > c.size {
+		el := c.order.Back()
+		c.order.Remove(el)
+		delete(c.entries, el.Value.(*cacheEntry).key)
+	}
+}
+
+// Len returns the number of cached scripts.
+func (c *scriptCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
@@ -0,0 +1,59 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package javascript
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/robertkrimen/otto"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestScriptCache(t *testing.T) {
+	a := assertions.New(t)
+	vm := otto.New()
+	c := newScriptCache(2)
+
+	keys := make([]scriptKey, 3)
+	scripts := make([]*otto.Script, 3)
+	for i, src := range []string{"1 + 1", "2 + 2", "3 + 3"} {
+		keys[i] = sha256.Sum256([]byte(src))
+		script, err := vm.Compile("", src)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		scripts[i] = script
+	}
+
+	c.Add(keys[0], scripts[0])
+	c.Add(keys[1], scripts[1])
+	a.So(c.Len(), should.Equal, 2)
+
+	// Getting the first script makes the second script the least recently used.
+	script, ok := c.Get(keys[0])
+	a.So(ok, should.BeTrue)
+	a.So(script, should.Equal, scripts[0])
+
+	c.Add(keys[2], scripts[2])
+	a.So(c.Len(), should.Equal, 2)
+	_, ok = c.Get(keys[1])
+	a.So(ok, should.BeFalse)
+	_, ok = c.Get(keys[0])
+	a.So(ok, should.BeTrue)
+	_, ok = c.Get(keys[2])
+	a.So(ok, should.BeTrue)
+}
@@ -17,7 +17,9 @@ package javascript
 
 import (
 	"context"
+	"crypto/sha256"
 	"runtime/trace"
+	"strings"
 	"time"
 
 	"github.com/robertkrimen/otto"
@@ -27,14 +29,45 @@ import (
 
 type js struct {
 	options scripting.Options
+	cache   *scriptCache
 }
 
 // New returns a new Javascript scripting engine.
+// If the cache size of the options is not zero, the engine caches compiled scripts by the hash of their source.
 func New(options scripting.Options) scripting.Engine {
-	return &js{options}
+	j := &js{
+		options: options,
+	}
+	if options.CacheSize > 0 {
+		j.cache = newScriptCache(options.CacheSize)
+	}
+	return j
 }
 
-var errRuntime = errors.Define("runtime", "runtime error")
+var (
+	errCompile = errors.DefineInvalidArgument("compile", "compile script")
+	errRuntime = errors.Define("runtime", "runtime error")
+)
+
+// compile compiles the script, or returns the compiled script from the cache.
+func (j *js) compile(vm *otto.Otto, script string) (*otto.Script, error) {
+	if j.cache == nil {
+		return vm.Compile("", script)
+	}
+	key := scriptKey(sha256.Sum256([]byte(script)))
+	if compiled, ok := j.cache.Get(key); ok {
+		return compiled, nil
+	}
+	compiled, err := vm.Compile("", script)
+	if err != nil {
+		return nil, err
+	}
+	j.cache.Add(key, compiled)
+	return compiled, nil
+}
+
+// stackOverflowMessage is the message of the error that the runtime returns when the stack depth limit is exceeded.
+const stackOverflowMessage = "Maximum call stack size exceeded"
 
 // Run executes the Javascript script in the environment env and returns the output.
 func (j *js) Run(ctx context.Context, script string, env map[string]interface{}) (val interface{}, err error) {
@@ -58,8 +91,16 @@ func (j *js) Run(ctx context.Context, script string, env map[string]interface{})
 		return
 	}
 
+	compiled, err := j.compile(vm, script)
+	if err != nil {
+		return nil, errCompile.WithCause(err)
+	}
+
 	defer func() {
 		if caught := recover(); caught != nil {
+			if caught == context.DeadlineExceeded {
+				aborts.WithLabelValues("timeout").Inc()
+			}
 			switch val := caught.(type) {
 			case error:
 				err = errRuntime.WithCause(val)
@@ -80,8 +121,11 @@ func (j *js) Run(ctx context.Context, script string, env map[string]interface{})
 		}
 	}()
 
-	output, err := vm.Run(script)
+	output, err := vm.Run(compiled)
 	if err != nil {
+		if strings.Contains(err.Error(), stackOverflowMessage) {
+			aborts.WithLabelValues("stack_depth").Inc()
+		}
 		return nil, errRuntime.WithCause(err)
 	}
 
@@ -96,3 +96,32 @@ func TestRunTimeout(t *testing.T) {
 	a.So(err, should.NotBeNil)
 	a.So(errors.IsDeadlineExceeded(errors.Cause(err)), should.BeTrue)
 }
+
+func TestRunCache(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	script := `"hello " + env.name`
+
+	e := New(scripting.Options{
+		StackDepthLimit: 32,
+		Timeout:         scripting.DefaultOptions.Timeout,
+		CacheSize:       1,
+	})
+	for _, name := range []string{"foo", "bar"} {
+		output, err := e.Run(ctx, script, map[string]interface{}{"name": name})
+		a.So(err, should.BeNil)
+		a.So(output, should.Equal, "hello "+name)
+	}
+}
+
+func TestRunCompileError(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	e := New(scripting.DefaultOptions)
+	_, err := e.Run(ctx, `function (`, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -38,6 +38,24 @@ var runLatency = metrics.NewHistogram(
 	},
 )
 
+var aborts = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "aborts_total",
+		Help:      "JavaScript runs that are aborted because they exceed a limit",
+	},
+	[]string{"reason"},
+)
+
+var cacheLookups = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "cache_lookups_total",
+		Help:      "Lookups of compiled JavaScript in the cache",
+	},
+	[]string{"result"},
+)
+
 func init() {
-	metrics.MustRegister(runs, runLatency)
+	metrics.MustRegister(runs, runLatency, aborts, cacheLookups)
 }
@@ -21,10 +21,13 @@ import "time"
 type Options struct {
 	StackDepthLimit int
 	Timeout         time.Duration
+	// CacheSize is the number of compiled scripts to cache. If zero, scripts are compiled on every run.
+	CacheSize int
 }
 
 // DefaultOptions are the default Options.
 var DefaultOptions = Options{
 	StackDepthLimit: 32,
 	Timeout:         100 * time.Millisecond,
+	CacheSize:       1024,
 }
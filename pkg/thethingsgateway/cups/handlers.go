@@ -119,18 +119,22 @@ func adaptGatewayAddress(address string) (result string, err error) {
 		return address, nil
 	}
 	var host, port string
-	if strings.Contains(address, ":") {
+	switch {
+	case net.ParseIP(strings.Trim(address, "[]")) != nil:
+		// IP address without port, i.e. 2001:db8::1 or [2001:db8::1].
+		host = strings.Trim(address, "[]")
+	case strings.Contains(address, ":"):
 		host, port, err = net.SplitHostPort(address)
 		if err != nil {
 			return "", err
 		}
-	} else {
+	default:
 		host = address
 	}
 	if port == "" {
 		port = "8882"
 	}
-	return fmt.Sprintf("mqtts://%v:%v", host, port), nil
+	return fmt.Sprintf("mqtts://%s", net.JoinHostPort(host, port)), nil
 }
 
 // adaptAuthorization removes the Authorization prefix.
@@ -105,6 +105,22 @@ func TestAdaptGatewayAddress(t *testing.T) {
 				a.So(address, assertions.ShouldEqual, "mqtts://localhost:8881")
 			},
 		},
+		{
+			Name:    "IPv6 host, no port or scheme",
+			Address: "2001:db8::1",
+			Assert: func(a *assertions.Assertion, address string, err error) {
+				a.So(err, assertions.ShouldBeNil)
+				a.So(address, assertions.ShouldEqual, "mqtts://[2001:db8::1]:8882")
+			},
+		},
+		{
+			Name:    "IPv6 host and port, no scheme",
+			Address: "[2001:db8::1]:8881",
+			Assert: func(a *assertions.Assertion, address string, err error) {
+				a.So(err, assertions.ShouldBeNil)
+				a.So(address, assertions.ShouldEqual, "mqtts://[2001:db8::1]:8881")
+			},
+		},
 		{
 			Name:    "Full mqtts address",
 			Address: "mqtts://localhost:8882",
@@ -31,6 +31,8 @@ func Compute(payloadSize int, settings ttnpb.TxSettings) (d time.Duration, err e
 		return computeLoRa(payloadSize, settings.Frequency, uint8(dr.LoRa.SpreadingFactor), dr.LoRa.Bandwidth, settings.CodingRate, settings.EnableCRC)
 	case *ttnpb.DataRate_FSK:
 		return computeFSK(payloadSize, settings.Frequency, dr.FSK.BitRate, settings.EnableCRC)
+	case *ttnpb.DataRate_LRFHSS:
+		return computeLRFHSS(payloadSize, dr.LRFHSS.CodingRate)
 	default:
 		panic("invalid modulation")
 	}
@@ -95,3 +97,24 @@ func computeFSK(payloadSize int, frequency uint64, bitRate uint32, crc bool) (ti
 		return 0, errFrequency
 	}
 }
+
+// lrFHSSHeaderDuration is the duration of an LR-FHSS header.
+const lrFHSSHeaderDuration = 233472 * time.Microsecond
+
+// lrFHSSFragmentDuration is the duration of an LR-FHSS payload fragment.
+const lrFHSSFragmentDuration = 102400 * time.Microsecond
+
+func computeLRFHSS(payloadSize int, codingRate string) (time.Duration, error) {
+	// See Semtech AN1200.64, LR-FHSS system performance.
+	var headers, fragmentSize int
+	switch codingRate {
+	case "1/3":
+		headers, fragmentSize = 3, 2
+	case "2/3":
+		headers, fragmentSize = 2, 4
+	default:
+		return 0, errCodingRate
+	}
+	fragments := (payloadSize + 3 + fragmentSize - 1) / fragmentSize
+	return time.Duration(headers)*lrFHSSHeaderDuration + time.Duration(fragments)*lrFHSSFragmentDuration, nil
+}
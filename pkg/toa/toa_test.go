@@ -175,6 +175,44 @@ func TestFSK(t *testing.T) {
 	a.So(toa, should.AlmostEqual, 33760*time.Microsecond)
 }
 
+func TestLRFHSS(t *testing.T) {
+	a := assertions.New(t)
+	for _, tc := range []struct {
+		CodingRate string
+		Expected   time.Duration
+	}{
+		{"1/3", 1417216 * time.Microsecond},
+		{"2/3", 876544 * time.Microsecond},
+	} {
+		toa, err := Compute(10, ttnpb.TxSettings{
+			Frequency: 868100000,
+			DataRate: ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_LRFHSS{
+					LRFHSS: &ttnpb.LRFHSSDataRate{
+						OperatingChannelWidth: 137000,
+						CodingRate:            tc.CodingRate,
+					},
+				},
+			},
+		})
+		a.So(err, should.BeNil)
+		a.So(toa, should.Equal, tc.Expected)
+	}
+
+	_, err := Compute(10, ttnpb.TxSettings{
+		Frequency: 868100000,
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LRFHSS{
+				LRFHSS: &ttnpb.LRFHSSDataRate{
+					OperatingChannelWidth: 137000,
+					CodingRate:            "4/5",
+				},
+			},
+		},
+	})
+	a.So(err, should.NotBeNil)
+}
+
 func getDownlink() ttnpb.DownlinkMessage { return ttnpb.DownlinkMessage{} }
 
 func ExampleCompute() {
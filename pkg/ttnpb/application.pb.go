@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -35,6 +36,29 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type MuteWindow_Policy int32
+
+const (
+	// Keep the messages and process them when the window ends.
+	MuteWindow_KEEP MuteWindow_Policy = 0
+	// Drop the messages.
+	MuteWindow_DROP MuteWindow_Policy = 1
+)
+
+var MuteWindow_Policy_name = map[int32]string{
+	0: "KEEP",
+	1: "DROP",
+}
+
+var MuteWindow_Policy_value = map[string]int32{
+	"KEEP": 0,
+	"DROP": 1,
+}
+
+func (MuteWindow_Policy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{1, 0}
+}
+
 // Application is the message that defines an Application in the network.
 type Application struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
@@ -44,8 +68,21 @@ type Application struct {
 	Description            string            `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Attributes             map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	ContactInfo            []*ContactInfo    `protobuf:"bytes,7,rep,name=contact_info,json=contactInfo,proto3" json:"contact_info,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}          `json:"-"`
-	XXX_sizecache          int32             `json:"-"`
+	// Suspended applications do not receive or send traffic, and their integrations are paused.
+	// Only admins can update this field.
+	Suspended bool `protobuf:"varint,8,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	// Packet logger applications record the metadata of uplink messages of unprovisioned devices,
+	// for example for spectrum monitoring and detection of rogue devices.
+	// The Network Server must be configured to record uplink messages for the application.
+	// Only admins can update this field.
+	PacketLogger bool `protobuf:"varint,9,opt,name=packet_logger,json=packetLogger,proto3" json:"packet_logger,omitempty"`
+	// Time zone of the application, as IANA time zone name, for example Europe/Amsterdam.
+	// Schedulers use the time zone to interpret local times of end devices without a time zone.
+	TimeZone string `protobuf:"bytes,10,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Mute windows of the application. The mute windows apply to all end devices of the application.
+	MuteWindows          []*MuteWindow `protobuf:"bytes,11,rep,name=mute_windows,json=muteWindows,proto3" json:"mute_windows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *Application) Reset()      { *m = Application{} }
@@ -122,6 +159,116 @@ func (m *Application) GetContactInfo() []*ContactInfo {
 	return nil
 }
 
+func (m *Application) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *Application) GetPacketLogger() bool {
+	if m != nil {
+		return m.PacketLogger
+	}
+	return false
+}
+
+func (m *Application) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *Application) GetMuteWindows() []*MuteWindow {
+	if m != nil {
+		return m.MuteWindows
+	}
+	return nil
+}
+
+// MuteWindow is a window of time in which the Network Server suppresses downlink messages
+// and the Application Server pauses integrations, for example for regulatory silence periods or maintenance.
+type MuteWindow struct {
+	StartAt time.Time `protobuf:"bytes,1,opt,name=start_at,json=startAt,proto3,stdtime" json:"start_at"`
+	EndAt   time.Time `protobuf:"bytes,2,opt,name=end_at,json=endAt,proto3,stdtime" json:"end_at"`
+	// Policy of application downlink messages that are queued in the Network Server during the window.
+	QueuedDownlinks MuteWindow_Policy `protobuf:"varint,3,opt,name=queued_downlinks,json=queuedDownlinks,proto3,enum=ttn.lorawan.v3.MuteWindow_Policy" json:"queued_downlinks,omitempty"`
+	// Policy of upstream messages that the Application Server receives during the window.
+	Upstream MuteWindow_Policy `protobuf:"varint,4,opt,name=upstream,proto3,enum=ttn.lorawan.v3.MuteWindow_Policy" json:"upstream,omitempty"`
+	// Description of the window, for example the reason of silence.
+	Description          string   `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MuteWindow) Reset()      { *m = MuteWindow{} }
+func (*MuteWindow) ProtoMessage() {}
+func (*MuteWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{1}
+}
+func (m *MuteWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MuteWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MuteWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MuteWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MuteWindow.Merge(m, src)
+}
+func (m *MuteWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MuteWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MuteWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MuteWindow proto.InternalMessageInfo
+
+func (m *MuteWindow) GetStartAt() time.Time {
+	if m != nil {
+		return m.StartAt
+	}
+	return time.Time{}
+}
+
+func (m *MuteWindow) GetEndAt() time.Time {
+	if m != nil {
+		return m.EndAt
+	}
+	return time.Time{}
+}
+
+func (m *MuteWindow) GetQueuedDownlinks() MuteWindow_Policy {
+	if m != nil {
+		return m.QueuedDownlinks
+	}
+	return MuteWindow_KEEP
+}
+
+func (m *MuteWindow) GetUpstream() MuteWindow_Policy {
+	if m != nil {
+		return m.Upstream
+	}
+	return MuteWindow_KEEP
+}
+
+func (m *MuteWindow) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 type Applications struct {
 	Applications         []*Application `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -131,7 +278,7 @@ type Applications struct {
 func (m *Applications) Reset()      { *m = Applications{} }
 func (*Applications) ProtoMessage() {}
 func (*Applications) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{1}
+	return fileDescriptor_57d90136b1f4f7b1, []int{2}
 }
 func (m *Applications) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -177,7 +324,7 @@ type GetApplicationRequest struct {
 func (m *GetApplicationRequest) Reset()      { *m = GetApplicationRequest{} }
 func (*GetApplicationRequest) ProtoMessage() {}
 func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{2}
+	return fileDescriptor_57d90136b1f4f7b1, []int{3}
 }
 func (m *GetApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +377,7 @@ type ListApplicationsRequest struct {
 func (m *ListApplicationsRequest) Reset()      { *m = ListApplicationsRequest{} }
 func (*ListApplicationsRequest) ProtoMessage() {}
 func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{3}
+	return fileDescriptor_57d90136b1f4f7b1, []int{4}
 }
 func (m *ListApplicationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -305,7 +452,7 @@ type CreateApplicationRequest struct {
 func (m *CreateApplicationRequest) Reset()      { *m = CreateApplicationRequest{} }
 func (*CreateApplicationRequest) ProtoMessage() {}
 func (*CreateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{4}
+	return fileDescriptor_57d90136b1f4f7b1, []int{5}
 }
 func (m *CreateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,7 +498,7 @@ type UpdateApplicationRequest struct {
 func (m *UpdateApplicationRequest) Reset()      { *m = UpdateApplicationRequest{} }
 func (*UpdateApplicationRequest) ProtoMessage() {}
 func (*UpdateApplicationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{5}
+	return fileDescriptor_57d90136b1f4f7b1, []int{6}
 }
 func (m *UpdateApplicationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,7 +547,7 @@ type ListApplicationAPIKeysRequest struct {
 func (m *ListApplicationAPIKeysRequest) Reset()      { *m = ListApplicationAPIKeysRequest{} }
 func (*ListApplicationAPIKeysRequest) ProtoMessage() {}
 func (*ListApplicationAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{6}
+	return fileDescriptor_57d90136b1f4f7b1, []int{7}
 }
 func (m *ListApplicationAPIKeysRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -454,7 +601,7 @@ type GetApplicationAPIKeyRequest struct {
 func (m *GetApplicationAPIKeyRequest) Reset()      { *m = GetApplicationAPIKeyRequest{} }
 func (*GetApplicationAPIKeyRequest) ProtoMessage() {}
 func (*GetApplicationAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{7}
+	return fileDescriptor_57d90136b1f4f7b1, []int{8}
 }
 func (m *GetApplicationAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +648,7 @@ type CreateApplicationAPIKeyRequest struct {
 func (m *CreateApplicationAPIKeyRequest) Reset()      { *m = CreateApplicationAPIKeyRequest{} }
 func (*CreateApplicationAPIKeyRequest) ProtoMessage() {}
 func (*CreateApplicationAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{8}
+	return fileDescriptor_57d90136b1f4f7b1, []int{9}
 }
 func (m *CreateApplicationAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +701,7 @@ type UpdateApplicationAPIKeyRequest struct {
 func (m *UpdateApplicationAPIKeyRequest) Reset()      { *m = UpdateApplicationAPIKeyRequest{} }
 func (*UpdateApplicationAPIKeyRequest) ProtoMessage() {}
 func (*UpdateApplicationAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{9}
+	return fileDescriptor_57d90136b1f4f7b1, []int{10}
 }
 func (m *UpdateApplicationAPIKeyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +743,7 @@ type ListApplicationCollaboratorsRequest struct {
 func (m *ListApplicationCollaboratorsRequest) Reset()      { *m = ListApplicationCollaboratorsRequest{} }
 func (*ListApplicationCollaboratorsRequest) ProtoMessage() {}
 func (*ListApplicationCollaboratorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{10}
+	return fileDescriptor_57d90136b1f4f7b1, []int{11}
 }
 func (m *ListApplicationCollaboratorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +796,7 @@ type GetApplicationCollaboratorRequest struct {
 func (m *GetApplicationCollaboratorRequest) Reset()      { *m = GetApplicationCollaboratorRequest{} }
 func (*GetApplicationCollaboratorRequest) ProtoMessage() {}
 func (*GetApplicationCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{11}
+	return fileDescriptor_57d90136b1f4f7b1, []int{12}
 }
 func (m *GetApplicationCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +835,7 @@ type SetApplicationCollaboratorRequest struct {
 func (m *SetApplicationCollaboratorRequest) Reset()      { *m = SetApplicationCollaboratorRequest{} }
 func (*SetApplicationCollaboratorRequest) ProtoMessage() {}
 func (*SetApplicationCollaboratorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_57d90136b1f4f7b1, []int{12}
+	return fileDescriptor_57d90136b1f4f7b1, []int{13}
 }
 func (m *SetApplicationCollaboratorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,11 +871,241 @@ func (m *SetApplicationCollaboratorRequest) GetCollaborator() Collaborator {
 	return Collaborator{}
 }
 
+type SetApplicationCollaboratorBulkRequest struct {
+	// The applications to set the collaborator of.
+	ApplicationIDs       []*ApplicationIdentifiers `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	Collaborator         Collaborator              `protobuf:"bytes,2,opt,name=collaborator,proto3" json:"collaborator"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *SetApplicationCollaboratorBulkRequest) Reset()      { *m = SetApplicationCollaboratorBulkRequest{} }
+func (*SetApplicationCollaboratorBulkRequest) ProtoMessage() {}
+func (*SetApplicationCollaboratorBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{14}
+}
+func (m *SetApplicationCollaboratorBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetApplicationCollaboratorBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetApplicationCollaboratorBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetApplicationCollaboratorBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetApplicationCollaboratorBulkRequest.Merge(m, src)
+}
+func (m *SetApplicationCollaboratorBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetApplicationCollaboratorBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetApplicationCollaboratorBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetApplicationCollaboratorBulkRequest proto.InternalMessageInfo
+
+func (m *SetApplicationCollaboratorBulkRequest) GetApplicationIDs() []*ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return nil
+}
+
+func (m *SetApplicationCollaboratorBulkRequest) GetCollaborator() Collaborator {
+	if m != nil {
+		return m.Collaborator
+	}
+	return Collaborator{}
+}
+
+type CreateApplicationAPIKeyBulkRequest struct {
+	// The applications to create the API key for.
+	ApplicationIDs       []*ApplicationIdentifiers `protobuf:"bytes,1,rep,name=application_ids,json=applicationIds,proto3" json:"application_ids,omitempty"`
+	Name                 string                    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rights               []Right                   `protobuf:"varint,3,rep,packed,name=rights,proto3,enum=ttn.lorawan.v3.Right" json:"rights,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) Reset()      { *m = CreateApplicationAPIKeyBulkRequest{} }
+func (*CreateApplicationAPIKeyBulkRequest) ProtoMessage() {}
+func (*CreateApplicationAPIKeyBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{15}
+}
+func (m *CreateApplicationAPIKeyBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateApplicationAPIKeyBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateApplicationAPIKeyBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateApplicationAPIKeyBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApplicationAPIKeyBulkRequest.Merge(m, src)
+}
+func (m *CreateApplicationAPIKeyBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateApplicationAPIKeyBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApplicationAPIKeyBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApplicationAPIKeyBulkRequest proto.InternalMessageInfo
+
+func (m *CreateApplicationAPIKeyBulkRequest) GetApplicationIDs() []*ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return nil
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) GetRights() []Right {
+	if m != nil {
+		return m.Rights
+	}
+	return nil
+}
+
+// The result of a bulk operation for an application.
+type ApplicationBulkResult struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// The created API key. This is only set for bulk API key creation.
+	APIKey *APIKey `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// The error if the operation failed for this application.
+	Error                *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ApplicationBulkResult) Reset()      { *m = ApplicationBulkResult{} }
+func (*ApplicationBulkResult) ProtoMessage() {}
+func (*ApplicationBulkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{16}
+}
+func (m *ApplicationBulkResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationBulkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationBulkResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationBulkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkResult.Merge(m, src)
+}
+func (m *ApplicationBulkResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationBulkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationBulkResult proto.InternalMessageInfo
+
+func (m *ApplicationBulkResult) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return ApplicationIdentifiers{}
+}
+
+func (m *ApplicationBulkResult) GetAPIKey() *APIKey {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *ApplicationBulkResult) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type ApplicationBulkResults struct {
+	// The results, in the order of the requested applications.
+	Results              []*ApplicationBulkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ApplicationBulkResults) Reset()      { *m = ApplicationBulkResults{} }
+func (*ApplicationBulkResults) ProtoMessage() {}
+func (*ApplicationBulkResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_57d90136b1f4f7b1, []int{17}
+}
+func (m *ApplicationBulkResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationBulkResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationBulkResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationBulkResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationBulkResults.Merge(m, src)
+}
+func (m *ApplicationBulkResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationBulkResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationBulkResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationBulkResults proto.InternalMessageInfo
+
+func (m *ApplicationBulkResults) GetResults() []*ApplicationBulkResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.MuteWindow_Policy", MuteWindow_Policy_name, MuteWindow_Policy_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.MuteWindow_Policy", MuteWindow_Policy_name, MuteWindow_Policy_value)
 	proto.RegisterType((*Application)(nil), "ttn.lorawan.v3.Application")
 	golang_proto.RegisterType((*Application)(nil), "ttn.lorawan.v3.Application")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.Application.AttributesEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.Application.AttributesEntry")
+	proto.RegisterType((*MuteWindow)(nil), "ttn.lorawan.v3.MuteWindow")
+	golang_proto.RegisterType((*MuteWindow)(nil), "ttn.lorawan.v3.MuteWindow")
 	proto.RegisterType((*Applications)(nil), "ttn.lorawan.v3.Applications")
 	golang_proto.RegisterType((*Applications)(nil), "ttn.lorawan.v3.Applications")
 	proto.RegisterType((*GetApplicationRequest)(nil), "ttn.lorawan.v3.GetApplicationRequest")
@@ -753,6 +1130,14 @@ func init() {
 	golang_proto.RegisterType((*GetApplicationCollaboratorRequest)(nil), "ttn.lorawan.v3.GetApplicationCollaboratorRequest")
 	proto.RegisterType((*SetApplicationCollaboratorRequest)(nil), "ttn.lorawan.v3.SetApplicationCollaboratorRequest")
 	golang_proto.RegisterType((*SetApplicationCollaboratorRequest)(nil), "ttn.lorawan.v3.SetApplicationCollaboratorRequest")
+	proto.RegisterType((*SetApplicationCollaboratorBulkRequest)(nil), "ttn.lorawan.v3.SetApplicationCollaboratorBulkRequest")
+	golang_proto.RegisterType((*SetApplicationCollaboratorBulkRequest)(nil), "ttn.lorawan.v3.SetApplicationCollaboratorBulkRequest")
+	proto.RegisterType((*CreateApplicationAPIKeyBulkRequest)(nil), "ttn.lorawan.v3.CreateApplicationAPIKeyBulkRequest")
+	golang_proto.RegisterType((*CreateApplicationAPIKeyBulkRequest)(nil), "ttn.lorawan.v3.CreateApplicationAPIKeyBulkRequest")
+	proto.RegisterType((*ApplicationBulkResult)(nil), "ttn.lorawan.v3.ApplicationBulkResult")
+	golang_proto.RegisterType((*ApplicationBulkResult)(nil), "ttn.lorawan.v3.ApplicationBulkResult")
+	proto.RegisterType((*ApplicationBulkResults)(nil), "ttn.lorawan.v3.ApplicationBulkResults")
+	golang_proto.RegisterType((*ApplicationBulkResults)(nil), "ttn.lorawan.v3.ApplicationBulkResults")
 }
 
 func init() {
@@ -763,78 +1148,107 @@ func init() {
 }
 
 var fileDescriptor_57d90136b1f4f7b1 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xde, 0xf1, 0x4f, 0x52, 0x8f, 0xd3, 0x24, 0x5a, 0x51, 0x58, 0x25, 0x65, 0xe2, 0x6e, 0xa3,
-	0x2a, 0x2d, 0xf1, 0x1a, 0xb9, 0x17, 0xa8, 0x80, 0xc8, 0x1b, 0x7e, 0x14, 0x02, 0x04, 0x16, 0x7a,
-	0xa1, 0x2a, 0xd6, 0xd8, 0x1e, 0x6f, 0x46, 0xb6, 0x77, 0x97, 0xd9, 0x71, 0xca, 0x16, 0x21, 0x55,
-	0x9c, 0x2a, 0x4e, 0x15, 0x27, 0xc4, 0x09, 0xf5, 0x80, 0x7a, 0xe0, 0xd0, 0x13, 0xaa, 0x04, 0x87,
-	0x9e, 0x50, 0x0e, 0x1c, 0x72, 0x42, 0x3d, 0x85, 0x7a, 0x7d, 0x89, 0xc4, 0xa5, 0x37, 0xaa, 0x9c,
-	0xd0, 0xce, 0xae, 0xe3, 0xf5, 0x0f, 0x91, 0xa0, 0x95, 0xd5, 0xdb, 0xcc, 0xce, 0xf7, 0xde, 0xfb,
-	0xde, 0x9b, 0xf7, 0xcd, 0x5b, 0x78, 0xb6, 0x69, 0x33, 0x7c, 0x0d, 0x5b, 0x79, 0x97, 0xe3, 0x6a,
-	0xa3, 0x80, 0x1d, 0x5a, 0xc0, 0x8e, 0xd3, 0xa4, 0x55, 0xcc, 0xa9, 0x6d, 0x69, 0x0e, 0xb3, 0xb9,
-	0x2d, 0xcf, 0x72, 0x6e, 0x69, 0x11, 0x50, 0xdb, 0xb9, 0xb8, 0x50, 0x32, 0x29, 0xdf, 0x6e, 0x57,
-	0xb4, 0xaa, 0xdd, 0x2a, 0x10, 0x6b, 0xc7, 0xf6, 0x1c, 0x66, 0x7f, 0xe1, 0x15, 0x04, 0xb8, 0x9a,
-	0x37, 0x89, 0x95, 0xdf, 0xc1, 0x4d, 0x5a, 0xc3, 0x9c, 0x14, 0x46, 0x16, 0xa1, 0xcb, 0x85, 0x7c,
-	0xcc, 0x85, 0x69, 0x9b, 0x76, 0x68, 0x5c, 0x69, 0xd7, 0xc5, 0x4e, 0x6c, 0xc4, 0x2a, 0x82, 0x9f,
-	0x36, 0x6d, 0xdb, 0x6c, 0x92, 0x90, 0x9f, 0x65, 0xd9, 0x5c, 0xd0, 0x73, 0xa3, 0xd3, 0x5c, 0x74,
-	0x7a, 0xe4, 0xa3, 0x4e, 0x49, 0xb3, 0x56, 0x6e, 0x61, 0xb7, 0x11, 0x21, 0x96, 0x86, 0x11, 0x9c,
-	0xb6, 0x88, 0xcb, 0x71, 0xcb, 0x89, 0x00, 0xcb, 0xa3, 0x75, 0xa8, 0xda, 0x16, 0xc7, 0x55, 0x5e,
-	0xa6, 0x56, 0xbd, 0x47, 0x63, 0x4c, 0xb5, 0x68, 0x8d, 0x58, 0x9c, 0xd6, 0x29, 0x61, 0x3d, 0x36,
-	0x68, 0x14, 0xc4, 0xa8, 0xb9, 0xcd, 0xa3, 0x73, 0xf5, 0xc7, 0x14, 0xcc, 0x96, 0xfa, 0x35, 0x96,
-	0xdf, 0x85, 0x49, 0x5a, 0x73, 0x15, 0x90, 0x03, 0x2b, 0xd9, 0xe2, 0x39, 0x6d, 0xb0, 0xd6, 0x5a,
-	0x0c, 0xb9, 0xd1, 0x0f, 0xa5, 0xcf, 0x1f, 0xea, 0xe9, 0x6f, 0x40, 0x62, 0x1e, 0xec, 0xee, 0x2f,
-	0x49, 0x7b, 0xfb, 0x4b, 0xc0, 0x08, 0x9c, 0xc8, 0xeb, 0x10, 0x56, 0x19, 0xc1, 0x9c, 0xd4, 0xca,
-	0x98, 0x2b, 0x09, 0xe1, 0x72, 0x41, 0x0b, 0x93, 0xd7, 0x7a, 0xc9, 0x6b, 0x9f, 0xf4, 0x92, 0xd7,
-	0x4f, 0x04, 0xe6, 0xb7, 0xfe, 0x5c, 0x02, 0x46, 0x26, 0xb2, 0x2b, 0xf1, 0xc0, 0x49, 0xdb, 0xa9,
-	0xf5, 0x9c, 0x24, 0xff, 0x8b, 0x93, 0xc8, 0xae, 0xc4, 0xe5, 0x45, 0x98, 0xb2, 0x70, 0x8b, 0x28,
-	0xa9, 0x1c, 0x58, 0xc9, 0xe8, 0xd3, 0x87, 0x7a, 0x8a, 0x25, 0x94, 0xa2, 0x21, 0x3e, 0xca, 0x17,
-	0x60, 0xb6, 0x46, 0xdc, 0x2a, 0xa3, 0x4e, 0x90, 0x97, 0x92, 0x16, 0x98, 0x13, 0x87, 0x7a, 0x9a,
-	0x25, 0x95, 0xbd, 0x39, 0x23, 0x7e, 0x28, 0x7b, 0x10, 0x62, 0xce, 0x19, 0xad, 0xb4, 0x39, 0x71,
-	0x95, 0xa9, 0x5c, 0x72, 0x25, 0x5b, 0x7c, 0xe9, 0x98, 0x2a, 0x69, 0xa5, 0x23, 0xf4, 0x5b, 0x16,
-	0x67, 0x9e, 0xbe, 0x7a, 0xa8, 0x9f, 0xff, 0x1e, 0x9c, 0x53, 0x97, 0x99, 0xaa, 0x2c, 0x17, 0xd1,
-	0x67, 0x57, 0x70, 0xfe, 0xfa, 0xcb, 0xf9, 0x57, 0xaf, 0xae, 0xac, 0x5d, 0xba, 0x92, 0xbf, 0xba,
-	0xd6, 0xdb, 0x9e, 0xff, 0xb2, 0xb8, 0xfa, 0xd5, 0xb2, 0x11, 0x0b, 0x26, 0xbf, 0x01, 0x67, 0xe2,
-	0x4d, 0xa0, 0x4c, 0x8b, 0xe0, 0x8b, 0xc3, 0xc1, 0xd7, 0x43, 0xcc, 0x86, 0x55, 0xb7, 0x8d, 0x6c,
-	0xb5, 0xbf, 0x59, 0x78, 0x1d, 0xce, 0x0d, 0x91, 0x91, 0xe7, 0x61, 0xb2, 0x41, 0x3c, 0x71, 0xd9,
-	0x19, 0x23, 0x58, 0xca, 0xcf, 0xc1, 0xf4, 0x0e, 0x6e, 0xb6, 0x89, 0xb8, 0xad, 0x8c, 0x11, 0x6e,
-	0x2e, 0x25, 0x5e, 0x01, 0xea, 0x16, 0x9c, 0x89, 0xe5, 0xe5, 0xca, 0x6b, 0x70, 0x26, 0xa6, 0xcd,
-	0xa0, 0x63, 0xc6, 0xd2, 0x89, 0xd9, 0x18, 0x03, 0x06, 0xea, 0x2f, 0x00, 0x9e, 0x7a, 0x87, 0xf0,
-	0x38, 0x80, 0x7c, 0xde, 0x26, 0x2e, 0x97, 0x31, 0x9c, 0x8b, 0x21, 0xcb, 0x4f, 0xa3, 0x1f, 0x67,
-	0x71, 0x1c, 0x19, 0xb0, 0x87, 0x7d, 0x59, 0xfe, 0x6b, 0x6b, 0xbe, 0x1d, 0x40, 0xde, 0xc7, 0x6e,
-	0x43, 0x4f, 0x05, 0x9e, 0x8c, 0x4c, 0xbd, 0xf7, 0x41, 0xfd, 0x1b, 0xc0, 0x17, 0xde, 0xa3, 0x6e,
-	0x9c, 0xbe, 0xdb, 0xe3, 0xff, 0x51, 0x70, 0x53, 0xcd, 0x26, 0xae, 0xd8, 0x0c, 0x73, 0x9b, 0x45,
-	0xe4, 0xf3, 0xc3, 0xe4, 0xb7, 0x98, 0x89, 0x2d, 0x7a, 0x5d, 0xd8, 0x6e, 0xb1, 0xcb, 0x2e, 0x61,
-	0xb1, 0x1c, 0x8c, 0x01, 0x17, 0x4f, 0xcc, 0x37, 0xb8, 0x58, 0x9b, 0xd5, 0x08, 0x13, 0x0a, 0xca,
-	0x18, 0xe1, 0x46, 0x46, 0x30, 0xdd, 0xa4, 0x2d, 0xca, 0x85, 0x30, 0x4e, 0x8a, 0xa6, 0xbf, 0x90,
-	0x54, 0x0e, 0xa6, 0x8d, 0xf0, 0xb3, 0x2c, 0xc3, 0x94, 0x83, 0x4d, 0x22, 0x34, 0x71, 0xd2, 0x10,
-	0x6b, 0xf5, 0x77, 0x00, 0x95, 0x75, 0x21, 0xcf, 0x31, 0x57, 0xb7, 0x05, 0xb3, 0xb1, 0x4a, 0x47,
-	0x99, 0x1f, 0xd7, 0x14, 0x63, 0xee, 0x2a, 0xee, 0x41, 0x2e, 0x0f, 0xd5, 0x32, 0xf1, 0x3f, 0x6a,
-	0xa9, 0xcf, 0xc4, 0x63, 0x0c, 0x56, 0x56, 0xfd, 0x09, 0x40, 0xe5, 0xb2, 0x78, 0x28, 0x26, 0x91,
-	0xce, 0x13, 0xf7, 0xdd, 0xcf, 0x00, 0xbe, 0x38, 0xd4, 0x77, 0xa5, 0x0f, 0x37, 0x36, 0x89, 0xe7,
-	0x4e, 0x50, 0x3d, 0x47, 0x6d, 0x93, 0x38, 0xbe, 0x6d, 0x92, 0xb1, 0xb6, 0xb9, 0x0d, 0xe0, 0xe2,
-	0xa0, 0xdc, 0x43, 0xde, 0x13, 0xa4, 0x9d, 0x83, 0x53, 0x0d, 0xe2, 0x95, 0x69, 0x2d, 0x7c, 0xdd,
-	0xf4, 0x8c, 0xbf, 0xbf, 0x94, 0xde, 0x24, 0xde, 0xc6, 0x9b, 0x46, 0xba, 0x41, 0xbc, 0x8d, 0x9a,
-	0xba, 0x0f, 0x20, 0x1a, 0xe9, 0xed, 0x89, 0xf3, 0xec, 0x4d, 0xab, 0xc4, 0xb8, 0x69, 0xf5, 0x1a,
-	0x9c, 0x0a, 0x07, 0xb8, 0x92, 0xcc, 0x25, 0x57, 0x66, 0x8b, 0xa7, 0x86, 0xc3, 0x1a, 0xc1, 0xa9,
-	0x7e, 0xf2, 0x50, 0x87, 0xdf, 0x82, 0x69, 0x35, 0xfd, 0x75, 0x10, 0xca, 0x88, 0x6c, 0xd4, 0xdf,
-	0x00, 0x44, 0x23, 0xdd, 0x3e, 0xf1, 0x04, 0x4b, 0x70, 0x1a, 0x3b, 0xb4, 0x1c, 0xcc, 0x9e, 0x50,
-	0x02, 0xcf, 0x8f, 0xb8, 0x16, 0x94, 0xc6, 0xb8, 0x9a, 0xc2, 0x0e, 0xdd, 0x24, 0x9e, 0xfa, 0x2b,
-	0x80, 0x67, 0x87, 0x74, 0xb0, 0x1e, 0x93, 0xf5, 0xb3, 0xae, 0x86, 0xbf, 0x00, 0x3c, 0x33, 0xa8,
-	0x86, 0x38, 0xfb, 0x09, 0x92, 0xaf, 0x3e, 0x8d, 0xf7, 0x75, 0x34, 0xcc, 0xe0, 0x1b, 0xfb, 0x07,
-	0x80, 0x67, 0x3e, 0x7e, 0x16, 0xb2, 0xfd, 0x60, 0x6c, 0xb6, 0xa7, 0x47, 0xff, 0xa1, 0xfa, 0x98,
-	0xe3, 0x86, 0x87, 0x7e, 0x1b, 0xec, 0x76, 0x10, 0xd8, 0xeb, 0x20, 0xf0, 0xa0, 0x83, 0xa4, 0x87,
-	0x1d, 0x24, 0x1d, 0x74, 0x90, 0xf4, 0xa8, 0x83, 0xa4, 0xc7, 0x1d, 0x04, 0x6e, 0xf8, 0x08, 0xdc,
-	0xf4, 0x91, 0x74, 0xc7, 0x47, 0xe0, 0xae, 0x8f, 0xa4, 0x7b, 0x3e, 0x92, 0xee, 0xfb, 0x48, 0xda,
-	0xf5, 0x11, 0xd8, 0xf3, 0x11, 0x78, 0xe0, 0x23, 0xe9, 0xa1, 0x8f, 0xc0, 0x81, 0x8f, 0xa4, 0x47,
-	0x3e, 0x02, 0x8f, 0x7d, 0x24, 0xdd, 0xe8, 0x22, 0xe9, 0x66, 0x17, 0x81, 0x5b, 0x5d, 0x24, 0x7d,
-	0xd7, 0x45, 0xe0, 0x87, 0x2e, 0x92, 0xee, 0x74, 0x91, 0x74, 0xb7, 0x8b, 0xc0, 0xbd, 0x2e, 0x02,
-	0xf7, 0xbb, 0x08, 0x7c, 0xba, 0x6a, 0xda, 0x1a, 0xdf, 0x26, 0x7c, 0x9b, 0x5a, 0xa6, 0xab, 0x59,
-	0x84, 0x5f, 0xb3, 0x59, 0xa3, 0x30, 0xf8, 0xa7, 0xef, 0x34, 0xcc, 0x02, 0xe7, 0x96, 0x53, 0xa9,
-	0x4c, 0x89, 0xb9, 0x72, 0xf1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0xb5, 0x97, 0xb7, 0x5e,
-	0x0d, 0x00, 0x00,
+	// 1452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4d, 0x6c, 0xdc, 0x44,
+	0x14, 0xf6, 0xec, 0x66, 0xff, 0x66, 0xf3, 0xb3, 0xb2, 0xda, 0x62, 0xa5, 0xe9, 0x24, 0x75, 0xd3,
+	0x2a, 0x2d, 0xdd, 0x0d, 0xda, 0x5e, 0xa0, 0x05, 0xc2, 0x3a, 0x09, 0x55, 0x48, 0x4b, 0x82, 0xa1,
+	0x20, 0x5a, 0x95, 0xd5, 0x64, 0x3d, 0x71, 0xac, 0xf5, 0xda, 0xae, 0x3d, 0x4e, 0xd8, 0x22, 0xa4,
+	0x8a, 0x53, 0xc5, 0xa9, 0xea, 0x09, 0x71, 0x42, 0x3d, 0xf5, 0xc0, 0xa1, 0x27, 0x54, 0x09, 0x0e,
+	0x3d, 0xa1, 0x1e, 0x38, 0xe4, 0x84, 0x7a, 0x0a, 0x8d, 0x57, 0x42, 0x95, 0x90, 0x50, 0x39, 0x20,
+	0xaa, 0x9c, 0x90, 0xc7, 0xde, 0xc4, 0xfb, 0x93, 0xa8, 0xa1, 0x6d, 0xe8, 0xcd, 0x33, 0xf3, 0xbd,
+	0x37, 0xdf, 0x7b, 0xf3, 0xbd, 0x37, 0xb3, 0x0b, 0x8f, 0xe8, 0xa6, 0x8d, 0x57, 0xb0, 0x91, 0x77,
+	0x28, 0xae, 0x54, 0xc7, 0xb1, 0xa5, 0x8d, 0x63, 0xcb, 0xd2, 0xb5, 0x0a, 0xa6, 0x9a, 0x69, 0x14,
+	0x2c, 0xdb, 0xa4, 0x26, 0xdf, 0x4f, 0xa9, 0x51, 0x08, 0x81, 0x85, 0xe5, 0x53, 0x83, 0x25, 0x55,
+	0xa3, 0x4b, 0xee, 0x42, 0xa1, 0x62, 0xd6, 0xc6, 0x89, 0xb1, 0x6c, 0xd6, 0x2d, 0xdb, 0xfc, 0xbc,
+	0x3e, 0xce, 0xc0, 0x95, 0xbc, 0x4a, 0x8c, 0xfc, 0x32, 0xd6, 0x35, 0x05, 0x53, 0x32, 0xde, 0xf1,
+	0x11, 0xb8, 0x1c, 0xcc, 0x47, 0x5c, 0xa8, 0xa6, 0x6a, 0x06, 0xc6, 0x0b, 0xee, 0x22, 0x1b, 0xb1,
+	0x01, 0xfb, 0x0a, 0xe1, 0x43, 0xaa, 0x69, 0xaa, 0x3a, 0x09, 0xf8, 0x19, 0x86, 0x49, 0x19, 0x3d,
+	0x27, 0x5c, 0x1d, 0x09, 0x57, 0x37, 0x7d, 0x2c, 0x6a, 0x44, 0x57, 0xca, 0x35, 0xec, 0x54, 0x43,
+	0xc4, 0x70, 0x3b, 0x82, 0x6a, 0x35, 0xe2, 0x50, 0x5c, 0xb3, 0x42, 0xc0, 0x68, 0x67, 0x1e, 0x2a,
+	0xa6, 0x41, 0x71, 0x85, 0x96, 0x35, 0x63, 0xb1, 0x49, 0xe3, 0x50, 0x27, 0x8a, 0xd8, 0xb6, 0x69,
+	0x87, 0xcb, 0x5d, 0x92, 0xa9, 0x29, 0xc4, 0xa0, 0xda, 0xa2, 0x46, 0xec, 0x26, 0x59, 0xd4, 0x09,
+	0xb2, 0x35, 0x75, 0x89, 0x86, 0xeb, 0xe2, 0x83, 0x04, 0xcc, 0x96, 0xb6, 0x8e, 0x80, 0x7f, 0x0f,
+	0xc6, 0x35, 0xc5, 0x11, 0xc0, 0x08, 0x18, 0xcb, 0x16, 0x8f, 0x15, 0x5a, 0x8f, 0xa2, 0x10, 0x41,
+	0xce, 0x6c, 0x6d, 0x25, 0xe5, 0x36, 0xa4, 0xc4, 0xd7, 0x20, 0x96, 0x03, 0xf7, 0xd7, 0x86, 0xb9,
+	0xd5, 0xb5, 0x61, 0x20, 0xfb, 0x4e, 0xf8, 0x49, 0x08, 0x2b, 0x36, 0xc1, 0x94, 0x28, 0x65, 0x4c,
+	0x85, 0x18, 0x73, 0x39, 0x58, 0x08, 0x72, 0x53, 0x68, 0xe6, 0xa6, 0xf0, 0x51, 0x33, 0x37, 0x52,
+	0xda, 0x37, 0xbf, 0xf1, 0xdb, 0x30, 0x90, 0x33, 0xa1, 0x5d, 0x89, 0xfa, 0x4e, 0x5c, 0x4b, 0x69,
+	0x3a, 0x89, 0xef, 0xc6, 0x49, 0x68, 0x57, 0xa2, 0xfc, 0x41, 0xd8, 0x63, 0xe0, 0x1a, 0x11, 0x7a,
+	0x46, 0xc0, 0x58, 0x46, 0x4a, 0x6d, 0x48, 0x3d, 0x76, 0x4c, 0x28, 0xca, 0x6c, 0x92, 0x3f, 0x01,
+	0xb3, 0x0a, 0x71, 0x2a, 0xb6, 0x66, 0xf9, 0x71, 0x09, 0x09, 0x86, 0x49, 0x6f, 0x48, 0x09, 0x3b,
+	0x2e, 0xac, 0x0e, 0xc8, 0xd1, 0x45, 0xbe, 0x0e, 0x21, 0xa6, 0xd4, 0xd6, 0x16, 0x5c, 0x4a, 0x1c,
+	0x21, 0x39, 0x12, 0x1f, 0xcb, 0x16, 0x5f, 0xdd, 0x21, 0x4b, 0x85, 0xd2, 0x26, 0x7a, 0xda, 0xa0,
+	0x76, 0x5d, 0x3a, 0xb9, 0x21, 0x1d, 0xff, 0x16, 0x1c, 0x13, 0x47, 0x6d, 0x51, 0x18, 0x2d, 0xa2,
+	0xcf, 0x2e, 0xe1, 0xfc, 0xd5, 0xd7, 0xf2, 0x6f, 0x5c, 0x1e, 0x9b, 0x38, 0x7d, 0x29, 0x7f, 0x79,
+	0xa2, 0x39, 0x3c, 0xfe, 0x45, 0xf1, 0xe4, 0x97, 0xa3, 0x72, 0x64, 0x33, 0xfe, 0x6d, 0xd8, 0x1b,
+	0xd5, 0x88, 0x90, 0x62, 0x9b, 0x1f, 0x6c, 0xdf, 0x7c, 0x32, 0xc0, 0xcc, 0x18, 0x8b, 0xa6, 0x9c,
+	0xad, 0x6c, 0x0d, 0xf8, 0x21, 0x98, 0x71, 0x5c, 0xc7, 0x22, 0x86, 0x42, 0x14, 0x21, 0x3d, 0x02,
+	0xc6, 0xd2, 0xf2, 0xd6, 0x04, 0x7f, 0x04, 0xf6, 0x59, 0xb8, 0x52, 0x25, 0xb4, 0xac, 0x9b, 0xaa,
+	0x4a, 0x6c, 0x21, 0xc3, 0x10, 0xbd, 0xc1, 0xe4, 0x39, 0x36, 0xc7, 0x8f, 0xc2, 0x8c, 0xaf, 0xe4,
+	0xf2, 0x55, 0xd3, 0x20, 0x02, 0x8c, 0xe6, 0xf2, 0x1d, 0x39, 0xed, 0xaf, 0x5c, 0x34, 0x0d, 0xc2,
+	0x9f, 0x85, 0xbd, 0x35, 0x97, 0x92, 0xf2, 0x8a, 0x66, 0x28, 0xe6, 0x8a, 0x23, 0x64, 0x19, 0xd1,
+	0xc1, 0x76, 0xa2, 0xe7, 0x5d, 0x4a, 0x3e, 0x61, 0x10, 0x96, 0xec, 0x9b, 0x20, 0x96, 0xdb, 0x27,
+	0x67, 0x6b, 0x9b, 0xb3, 0xce, 0xe0, 0x5b, 0x70, 0xa0, 0x2d, 0x7d, 0x7c, 0x0e, 0xc6, 0xab, 0xa4,
+	0xce, 0xe4, 0x99, 0x91, 0xfd, 0x4f, 0x7e, 0x1f, 0x4c, 0x2c, 0x63, 0xdd, 0x25, 0x4c, 0x5f, 0x19,
+	0x39, 0x18, 0x9c, 0x8e, 0xbd, 0x0e, 0xc4, 0xbf, 0x63, 0x10, 0x6e, 0x6d, 0xc2, 0x4f, 0xc0, 0xb4,
+	0x43, 0xb1, 0x4d, 0x7d, 0x19, 0x81, 0x5d, 0xc8, 0x28, 0xc5, 0xac, 0x4a, 0x94, 0x3f, 0x03, 0x93,
+	0xc4, 0xd8, 0xb5, 0x94, 0x13, 0xc4, 0xf0, 0x15, 0xf8, 0x31, 0xcc, 0x5d, 0x71, 0x89, 0x4b, 0x94,
+	0xb2, 0x62, 0xae, 0x18, 0xba, 0x66, 0x54, 0x1d, 0x26, 0xe6, 0xfe, 0xe2, 0xe1, 0xed, 0x13, 0x53,
+	0x98, 0x37, 0x75, 0xad, 0x52, 0x67, 0xf9, 0xf9, 0xca, 0xaf, 0x2f, 0x79, 0x20, 0x70, 0x32, 0xd5,
+	0xf4, 0xc1, 0x9f, 0x85, 0x69, 0xd7, 0x72, 0xa8, 0x4d, 0x70, 0x8d, 0xa9, 0x7b, 0x97, 0xfe, 0x36,
+	0x8d, 0x77, 0x53, 0x05, 0xe2, 0x10, 0x4c, 0x06, 0x9e, 0xf8, 0x34, 0xec, 0x99, 0x9d, 0x9e, 0x9e,
+	0xcf, 0x71, 0xfe, 0xd7, 0x94, 0x3c, 0x37, 0x9f, 0x03, 0xe2, 0x1c, 0xec, 0x8d, 0x54, 0x80, 0xc3,
+	0x4f, 0xc0, 0xde, 0x48, 0x93, 0xf7, 0x7b, 0x4b, 0x57, 0xe1, 0x46, 0x6c, 0xe4, 0x16, 0x03, 0xf1,
+	0x47, 0x00, 0xf7, 0x9f, 0x25, 0x34, 0x0a, 0x20, 0x57, 0x5c, 0xe2, 0x50, 0x1e, 0xc3, 0x81, 0x08,
+	0xb2, 0xfc, 0x3c, 0x3a, 0x57, 0x3f, 0x8e, 0x22, 0x7d, 0xf6, 0x70, 0xab, 0xbf, 0x6f, 0x7b, 0xf2,
+	0xef, 0xfa, 0x90, 0xf3, 0xd8, 0xa9, 0x4a, 0x3d, 0xbe, 0x27, 0x39, 0xb3, 0xd8, 0x9c, 0x10, 0xff,
+	0x01, 0xf0, 0x95, 0x73, 0x9a, 0x13, 0xa5, 0xef, 0x34, 0xf9, 0x7f, 0xe0, 0xd7, 0xb4, 0xae, 0xe3,
+	0x05, 0xd3, 0xc6, 0xd4, 0xb4, 0x43, 0xf2, 0xf9, 0x76, 0xf2, 0x73, 0xb6, 0x8a, 0x0d, 0xed, 0x2a,
+	0xb3, 0x9d, 0xb3, 0x2f, 0x38, 0xc4, 0x8e, 0xc4, 0x20, 0xb7, 0xb8, 0x78, 0x66, 0xbe, 0x7e, 0x41,
+	0x99, 0xb6, 0x42, 0x6c, 0x26, 0xcf, 0x8c, 0x1c, 0x0c, 0x78, 0x04, 0x13, 0xba, 0x56, 0xd3, 0x28,
+	0x13, 0x59, 0x1f, 0x13, 0xc6, 0x89, 0xb8, 0xf0, 0x28, 0x25, 0x07, 0xd3, 0x3c, 0x0f, 0x7b, 0x2c,
+	0xac, 0x12, 0xa6, 0x9b, 0x3e, 0x99, 0x7d, 0x8b, 0xbf, 0x00, 0x28, 0x4c, 0xb2, 0x46, 0xde, 0xe5,
+	0xe8, 0xe6, 0x60, 0x36, 0x92, 0xe9, 0x30, 0xf2, 0x9d, 0x44, 0xd1, 0xe5, 0xac, 0xa2, 0x1e, 0xf8,
+	0x72, 0x5b, 0x2e, 0x63, 0xff, 0x21, 0x97, 0x52, 0x6f, 0x74, 0x8f, 0xd6, 0xcc, 0x8a, 0xdf, 0x03,
+	0x28, 0x5c, 0x60, 0x57, 0xca, 0x5e, 0x84, 0xf3, 0xcc, 0xba, 0xfb, 0x01, 0xc0, 0x43, 0x6d, 0xba,
+	0x2b, 0xcd, 0xcf, 0xcc, 0x92, 0xba, 0xb3, 0x87, 0xd5, 0xb3, 0x29, 0x9b, 0xd8, 0xce, 0xb2, 0x89,
+	0x47, 0x64, 0x73, 0x0b, 0xc0, 0x83, 0xad, 0xe5, 0x1e, 0xf0, 0xde, 0x43, 0xda, 0x23, 0x30, 0x59,
+	0x25, 0xf5, 0xb2, 0xa6, 0x04, 0xb7, 0x8a, 0x94, 0xf1, 0xd6, 0x86, 0x13, 0xb3, 0xa4, 0x3e, 0x33,
+	0x25, 0x27, 0xaa, 0xa4, 0x3e, 0xa3, 0x88, 0x6b, 0x00, 0xa2, 0x0e, 0x6d, 0xef, 0x39, 0xcf, 0xe6,
+	0xbb, 0x26, 0xd6, 0xed, 0x5d, 0xf3, 0x26, 0x4c, 0x06, 0x4f, 0x3d, 0x21, 0x3e, 0x12, 0x1f, 0xeb,
+	0x2f, 0xee, 0x6f, 0xdf, 0x56, 0xf6, 0x57, 0xa5, 0xbe, 0x0d, 0x09, 0xde, 0x04, 0x29, 0x31, 0xbc,
+	0x11, 0x42, 0x1b, 0xf1, 0x67, 0x00, 0x51, 0x87, 0xda, 0xf7, 0x3c, 0xc0, 0x12, 0x4c, 0x61, 0x4b,
+	0x2b, 0xfb, 0x77, 0x7e, 0x50, 0x02, 0x07, 0x3a, 0x5c, 0x33, 0x4a, 0x5d, 0x5c, 0x25, 0xb1, 0xa5,
+	0xcd, 0x92, 0xba, 0xf8, 0x13, 0x80, 0x47, 0xda, 0xea, 0x60, 0x32, 0x52, 0xd6, 0x2f, 0x7b, 0x35,
+	0xfc, 0x01, 0xe0, 0xe1, 0xd6, 0x6a, 0x88, 0xb2, 0xdf, 0x43, 0xf2, 0x95, 0xe7, 0xd1, 0x5f, 0x3b,
+	0xb7, 0x69, 0xed, 0xb1, 0xbf, 0x02, 0x78, 0xf8, 0xc3, 0x97, 0x21, 0xda, 0xf7, 0xbb, 0x46, 0x3b,
+	0xd4, 0xf9, 0xda, 0xde, 0xc2, 0xec, 0x78, 0x79, 0xfc, 0x0e, 0xe0, 0xd1, 0xed, 0x03, 0x93, 0x5c,
+	0xbd, 0xda, 0x0c, 0xae, 0xd6, 0x2d, 0xb8, 0xf8, 0x2e, 0x82, 0x1b, 0xda, 0x90, 0x52, 0x37, 0x41,
+	0x4f, 0x1a, 0xe4, 0x14, 0x6f, 0x6d, 0xb8, 0x3f, 0x8a, 0x9a, 0x72, 0x5e, 0x78, 0xa0, 0x7f, 0x02,
+	0x28, 0x6e, 0xd3, 0x18, 0xff, 0xc7, 0x28, 0x5f, 0x60, 0xa3, 0xfc, 0x0b, 0xc0, 0xfd, 0xd1, 0x7b,
+	0x9d, 0x05, 0xe9, 0xb8, 0x3a, 0xe5, 0xd5, 0x67, 0x95, 0xe9, 0x01, 0x3f, 0xbf, 0x4f, 0x11, 0xdd,
+	0x99, 0xa7, 0xed, 0x92, 0xd0, 0x5b, 0x1b, 0x4e, 0x86, 0x4d, 0x3c, 0xec, 0x8f, 0x7c, 0x11, 0x26,
+	0xd8, 0xbf, 0x0a, 0xe1, 0x6f, 0xeb, 0x8e, 0x93, 0x9f, 0xf6, 0x17, 0xa7, 0x08, 0xc5, 0x9a, 0xee,
+	0xc8, 0x01, 0x54, 0xfc, 0x14, 0x1e, 0xe8, 0x1a, 0xb2, 0xff, 0x5c, 0x4e, 0xd9, 0xc1, 0x67, 0x78,
+	0x9e, 0x47, 0x77, 0x7a, 0x03, 0x6d, 0x1a, 0xca, 0x4d, 0x2b, 0xe9, 0x16, 0xb8, 0xbf, 0x8e, 0xc0,
+	0xea, 0x3a, 0x02, 0x0f, 0xd6, 0x11, 0xf7, 0x70, 0x1d, 0x71, 0x8f, 0xd6, 0x11, 0xf7, 0x78, 0x1d,
+	0x71, 0x4f, 0xd6, 0x11, 0xb8, 0xe6, 0x21, 0x70, 0xdd, 0x43, 0xdc, 0x6d, 0x0f, 0x81, 0x3b, 0x1e,
+	0xe2, 0xee, 0x7a, 0x88, 0xbb, 0xe7, 0x21, 0xee, 0xbe, 0x87, 0xc0, 0xaa, 0x87, 0xc0, 0x03, 0x0f,
+	0x71, 0x0f, 0x3d, 0x04, 0x1e, 0x79, 0x88, 0x7b, 0xec, 0x21, 0xf0, 0xc4, 0x43, 0xdc, 0xb5, 0x06,
+	0xe2, 0xae, 0x37, 0x10, 0xb8, 0xd1, 0x40, 0xdc, 0x37, 0x0d, 0x04, 0xbe, 0x6b, 0x20, 0xee, 0x76,
+	0x03, 0x71, 0x77, 0x1a, 0x08, 0xdc, 0x6d, 0x20, 0x70, 0xaf, 0x81, 0xc0, 0xc5, 0x93, 0xaa, 0x59,
+	0xa0, 0x4b, 0x84, 0x2e, 0x69, 0x86, 0xea, 0x14, 0x0c, 0x42, 0x57, 0x4c, 0xbb, 0x3a, 0xde, 0xfa,
+	0xe7, 0x89, 0x55, 0x55, 0xc7, 0x29, 0x35, 0xac, 0x85, 0x85, 0x24, 0x7b, 0x80, 0x9d, 0xfa, 0x37,
+	0x00, 0x00, 0xff, 0xff, 0x40, 0xdc, 0xcb, 0xb6, 0xd0, 0x12, 0x00, 0x00,
+}
+
+func (x MuteWindow_Policy) String() string {
+	s, ok := MuteWindow_Policy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
 }
-
 func (this *Application) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -885,45 +1299,33 @@ func (this *Application) Equal(that interface{}) bool {
 			return false
 		}
 	}
-	return true
-}
-func (this *Applications) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.Suspended != that1.Suspended {
+		return false
 	}
-
-	that1, ok := that.(*Applications)
-	if !ok {
-		that2, ok := that.(Applications)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+	if this.PacketLogger != that1.PacketLogger {
+		return false
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.TimeZone != that1.TimeZone {
 		return false
 	}
-	if len(this.Applications) != len(that1.Applications) {
+	if len(this.MuteWindows) != len(that1.MuteWindows) {
 		return false
 	}
-	for i := range this.Applications {
-		if !this.Applications[i].Equal(that1.Applications[i]) {
+	for i := range this.MuteWindows {
+		if !this.MuteWindows[i].Equal(that1.MuteWindows[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *GetApplicationRequest) Equal(that interface{}) bool {
+func (this *MuteWindow) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetApplicationRequest)
+	that1, ok := that.(*MuteWindow)
 	if !ok {
-		that2, ok := that.(GetApplicationRequest)
+		that2, ok := that.(MuteWindow)
 		if ok {
 			that1 = &that2
 		} else {
@@ -935,7 +1337,72 @@ func (this *GetApplicationRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+	if !this.StartAt.Equal(that1.StartAt) {
+		return false
+	}
+	if !this.EndAt.Equal(that1.EndAt) {
+		return false
+	}
+	if this.QueuedDownlinks != that1.QueuedDownlinks {
+		return false
+	}
+	if this.Upstream != that1.Upstream {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *Applications) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Applications)
+	if !ok {
+		that2, ok := that.(Applications)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Applications) != len(that1.Applications) {
+		return false
+	}
+	for i := range this.Applications {
+		if !this.Applications[i].Equal(that1.Applications[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *GetApplicationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
 	if !this.FieldMask.Equal(&that1.FieldMask) {
@@ -1236,6 +1703,137 @@ func (this *SetApplicationCollaboratorRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetApplicationCollaboratorBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationCollaboratorBulkRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationCollaboratorBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ApplicationIDs) != len(that1.ApplicationIDs) {
+		return false
+	}
+	for i := range this.ApplicationIDs {
+		if !this.ApplicationIDs[i].Equal(that1.ApplicationIDs[i]) {
+			return false
+		}
+	}
+	if !this.Collaborator.Equal(&that1.Collaborator) {
+		return false
+	}
+	return true
+}
+func (this *CreateApplicationAPIKeyBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CreateApplicationAPIKeyBulkRequest)
+	if !ok {
+		that2, ok := that.(CreateApplicationAPIKeyBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ApplicationIDs) != len(that1.ApplicationIDs) {
+		return false
+	}
+	for i := range this.ApplicationIDs {
+		if !this.ApplicationIDs[i].Equal(that1.ApplicationIDs[i]) {
+			return false
+		}
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Rights) != len(that1.Rights) {
+		return false
+	}
+	for i := range this.Rights {
+		if this.Rights[i] != that1.Rights[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ApplicationBulkResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationBulkResult)
+	if !ok {
+		that2, ok := that.(ApplicationBulkResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if !this.APIKey.Equal(that1.APIKey) {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationBulkResults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationBulkResults)
+	if !ok {
+		that2, ok := that.(ApplicationBulkResults)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Application) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1256,6 +1854,47 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MuteWindows) > 0 {
+		for iNdEx := len(m.MuteWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MuteWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PacketLogger {
+		i--
+		if m.PacketLogger {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ContactInfo) > 0 {
 		for iNdEx := len(m.ContactInfo) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1332,6 +1971,62 @@ func (m *Application) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MuteWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MuteWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MuteWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Upstream != 0 {
+		i = encodeVarintApplication(dAtA, i, uint64(m.Upstream))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.QueuedDownlinks != 0 {
+		i = encodeVarintApplication(dAtA, i, uint64(m.QueuedDownlinks))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintApplication(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintApplication(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Applications) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1664,20 +2359,20 @@ func (m *CreateApplicationAPIKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.Rights) > 0 {
-		dAtA15 := make([]byte, len(m.Rights)*10)
-		var j14 int
+		dAtA17 := make([]byte, len(m.Rights)*10)
+		var j16 int
 		for _, num := range m.Rights {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j16++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA17[j16] = uint8(num)
+			j16++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintApplication(dAtA, i, uint64(j14))
+		i -= j16
+		copy(dAtA[i:], dAtA17[:j16])
+		i = encodeVarintApplication(dAtA, i, uint64(j16))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -1873,28 +2568,231 @@ func (m *SetApplicationCollaboratorRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplication(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SetApplicationCollaboratorBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func NewPopulatedApplication(r randyApplication, easy bool) *Application {
-	this := &Application{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v3
-	this.Name = randStringApplication(r)
-	this.Description = randStringApplication(r)
-	if r.Intn(5) != 0 {
+
+func (m *SetApplicationCollaboratorBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetApplicationCollaboratorBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Collaborator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ApplicationIDs) > 0 {
+		for iNdEx := len(m.ApplicationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApplicationIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rights) > 0 {
+		dAtA28 := make([]byte, len(m.Rights)*10)
+		var j27 int
+		for _, num := range m.Rights {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintApplication(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ApplicationIDs) > 0 {
+		for iNdEx := len(m.ApplicationIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApplicationIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationBulkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationBulkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.APIKey != nil {
+		{
+			size, err := m.APIKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplication(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationBulkResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationBulkResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationBulkResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplication(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplication(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplication(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplication(r randyApplication, easy bool) *Application {
+	this := &Application{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	v2 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v3
+	this.Name = randStringApplication(r)
+	this.Description = randStringApplication(r)
+	if r.Intn(5) != 0 {
 		v4 := r.Intn(10)
 		this.Attributes = make(map[string]string)
 		for i := 0; i < v4; i++ {
@@ -1908,6 +2806,30 @@ func NewPopulatedApplication(r randyApplication, easy bool) *Application {
 			this.ContactInfo[i] = NewPopulatedContactInfo(r, easy)
 		}
 	}
+	this.Suspended = bool(r.Intn(2) == 0)
+	this.PacketLogger = bool(r.Intn(2) == 0)
+	this.TimeZone = randStringApplication(r)
+	if r.Intn(5) != 0 {
+		v6 := r.Intn(5)
+		this.MuteWindows = make([]*MuteWindow, v6)
+		for i := 0; i < v6; i++ {
+			this.MuteWindows[i] = NewPopulatedMuteWindow(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMuteWindow(r randyApplication, easy bool) *MuteWindow {
+	this := &MuteWindow{}
+	v7 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartAt = *v7
+	v8 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.EndAt = *v8
+	this.QueuedDownlinks = MuteWindow_Policy([]int32{0, 1}[r.Intn(2)])
+	this.Upstream = MuteWindow_Policy([]int32{0, 1}[r.Intn(2)])
+	this.Description = randStringApplication(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1916,9 +2838,9 @@ func NewPopulatedApplication(r randyApplication, easy bool) *Application {
 func NewPopulatedApplications(r randyApplication, easy bool) *Applications {
 	this := &Applications{}
 	if r.Intn(5) != 0 {
-		v6 := r.Intn(5)
-		this.Applications = make([]*Application, v6)
-		for i := 0; i < v6; i++ {
+		v9 := r.Intn(5)
+		this.Applications = make([]*Application, v9)
+		for i := 0; i < v9; i++ {
 			this.Applications[i] = NewPopulatedApplication(r, easy)
 		}
 	}
@@ -1929,10 +2851,10 @@ func NewPopulatedApplications(r randyApplication, easy bool) *Applications {
 
 func NewPopulatedGetApplicationRequest(r randyApplication, easy bool) *GetApplicationRequest {
 	this := &GetApplicationRequest{}
-	v7 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v7
-	v8 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v8
+	v10 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v10
+	v11 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v11
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1943,8 +2865,8 @@ func NewPopulatedListApplicationsRequest(r randyApplication, easy bool) *ListApp
 	if r.Intn(5) != 0 {
 		this.Collaborator = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
 	}
-	v9 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v9
+	v12 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v12
 	this.Order = randStringApplication(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -1955,10 +2877,10 @@ func NewPopulatedListApplicationsRequest(r randyApplication, easy bool) *ListApp
 
 func NewPopulatedCreateApplicationRequest(r randyApplication, easy bool) *CreateApplicationRequest {
 	this := &CreateApplicationRequest{}
-	v10 := NewPopulatedApplication(r, easy)
-	this.Application = *v10
-	v11 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.Collaborator = *v11
+	v13 := NewPopulatedApplication(r, easy)
+	this.Application = *v13
+	v14 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.Collaborator = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1966,10 +2888,10 @@ func NewPopulatedCreateApplicationRequest(r randyApplication, easy bool) *Create
 
 func NewPopulatedUpdateApplicationRequest(r randyApplication, easy bool) *UpdateApplicationRequest {
 	this := &UpdateApplicationRequest{}
-	v12 := NewPopulatedApplication(r, easy)
-	this.Application = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
+	v15 := NewPopulatedApplication(r, easy)
+	this.Application = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1977,8 +2899,8 @@ func NewPopulatedUpdateApplicationRequest(r randyApplication, easy bool) *Update
 
 func NewPopulatedListApplicationAPIKeysRequest(r randyApplication, easy bool) *ListApplicationAPIKeysRequest {
 	this := &ListApplicationAPIKeysRequest{}
-	v14 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v14
+	v17 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v17
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -1988,8 +2910,8 @@ func NewPopulatedListApplicationAPIKeysRequest(r randyApplication, easy bool) *L
 
 func NewPopulatedGetApplicationAPIKeyRequest(r randyApplication, easy bool) *GetApplicationAPIKeyRequest {
 	this := &GetApplicationAPIKeyRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
 	this.KeyID = randStringApplication(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -1998,12 +2920,12 @@ func NewPopulatedGetApplicationAPIKeyRequest(r randyApplication, easy bool) *Get
 
 func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *CreateApplicationAPIKeyRequest {
 	this := &CreateApplicationAPIKeyRequest{}
-	v16 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v16
+	v19 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v19
 	this.Name = randStringApplication(r)
-	v17 := r.Intn(10)
-	this.Rights = make([]Right, v17)
-	for i := 0; i < v17; i++ {
+	v20 := r.Intn(10)
+	this.Rights = make([]Right, v20)
+	for i := 0; i < v20; i++ {
 		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -2013,10 +2935,10 @@ func NewPopulatedCreateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *UpdateApplicationAPIKeyRequest {
 	this := &UpdateApplicationAPIKeyRequest{}
-	v18 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v18
-	v19 := NewPopulatedAPIKey(r, easy)
-	this.APIKey = *v19
+	v21 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v21
+	v22 := NewPopulatedAPIKey(r, easy)
+	this.APIKey = *v22
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2024,8 +2946,8 @@ func NewPopulatedUpdateApplicationAPIKeyRequest(r randyApplication, easy bool) *
 
 func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bool) *ListApplicationCollaboratorsRequest {
 	this := &ListApplicationCollaboratorsRequest{}
-	v20 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v20
+	v23 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v23
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
 	if !easy && r.Intn(10) != 0 {
@@ -2035,10 +2957,10 @@ func NewPopulatedListApplicationCollaboratorsRequest(r randyApplication, easy bo
 
 func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool) *GetApplicationCollaboratorRequest {
 	this := &GetApplicationCollaboratorRequest{}
-	v21 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v21
-	v22 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
-	this.OrganizationOrUserIdentifiers = *v22
+	v24 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v24
+	v25 := NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	this.OrganizationOrUserIdentifiers = *v25
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2046,10 +2968,75 @@ func NewPopulatedGetApplicationCollaboratorRequest(r randyApplication, easy bool
 
 func NewPopulatedSetApplicationCollaboratorRequest(r randyApplication, easy bool) *SetApplicationCollaboratorRequest {
 	this := &SetApplicationCollaboratorRequest{}
-	v23 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v23
-	v24 := NewPopulatedCollaborator(r, easy)
-	this.Collaborator = *v24
+	v26 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v26
+	v27 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v27
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetApplicationCollaboratorBulkRequest(r randyApplication, easy bool) *SetApplicationCollaboratorBulkRequest {
+	this := &SetApplicationCollaboratorBulkRequest{}
+	if r.Intn(5) != 0 {
+		v28 := r.Intn(5)
+		this.ApplicationIDs = make([]*ApplicationIdentifiers, v28)
+		for i := 0; i < v28; i++ {
+			this.ApplicationIDs[i] = NewPopulatedApplicationIdentifiers(r, easy)
+		}
+	}
+	v29 := NewPopulatedCollaborator(r, easy)
+	this.Collaborator = *v29
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedCreateApplicationAPIKeyBulkRequest(r randyApplication, easy bool) *CreateApplicationAPIKeyBulkRequest {
+	this := &CreateApplicationAPIKeyBulkRequest{}
+	if r.Intn(5) != 0 {
+		v30 := r.Intn(5)
+		this.ApplicationIDs = make([]*ApplicationIdentifiers, v30)
+		for i := 0; i < v30; i++ {
+			this.ApplicationIDs[i] = NewPopulatedApplicationIdentifiers(r, easy)
+		}
+	}
+	this.Name = randStringApplication(r)
+	v31 := r.Intn(10)
+	this.Rights = make([]Right, v31)
+	for i := 0; i < v31; i++ {
+		this.Rights[i] = Right([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 56, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55}[r.Intn(57)])
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationBulkResult(r randyApplication, easy bool) *ApplicationBulkResult {
+	this := &ApplicationBulkResult{}
+	v32 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v32
+	if r.Intn(5) != 0 {
+		this.APIKey = NewPopulatedAPIKey(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationBulkResults(r randyApplication, easy bool) *ApplicationBulkResults {
+	this := &ApplicationBulkResults{}
+	if r.Intn(5) == 0 {
+		v33 := r.Intn(5)
+		this.Results = make([]*ApplicationBulkResult, v33)
+		for i := 0; i < v33; i++ {
+			this.Results[i] = NewPopulatedApplicationBulkResult(r, easy)
+		}
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -2074,9 +3061,9 @@ func randUTF8RuneApplication(r randyApplication) rune {
 	return rune(ru + 61)
 }
 func randStringApplication(r randyApplication) string {
-	v25 := r.Intn(100)
-	tmps := make([]rune, v25)
-	for i := 0; i < v25; i++ {
+	v34 := r.Intn(100)
+	tmps := make([]rune, v34)
+	for i := 0; i < v34; i++ {
 		tmps[i] = randUTF8RuneApplication(r)
 	}
 	return string(tmps)
@@ -2098,11 +3085,11 @@ func randFieldApplication(dAtA []byte, r randyApplication, fieldNumber int, wire
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
-		v26 := r.Int63()
+		v35 := r.Int63()
 		if r.Intn(2) == 0 {
-			v26 *= -1
+			v35 *= -1
 		}
-		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v26))
+		dAtA = encodeVarintPopulateApplication(dAtA, uint64(v35))
 	case 1:
 		dAtA = encodeVarintPopulateApplication(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2161,17 +3148,18 @@ func (m *Application) Size() (n int) {
 			n += 1 + l + sovApplication(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *Applications) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Suspended {
+		n += 2
 	}
-	var l int
-	_ = l
-	if len(m.Applications) > 0 {
-		for _, e := range m.Applications {
+	if m.PacketLogger {
+		n += 2
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.MuteWindows) > 0 {
+		for _, e := range m.MuteWindows {
 			l = e.Size()
 			n += 1 + l + sovApplication(uint64(l))
 		}
@@ -2179,7 +3167,45 @@ func (m *Applications) Size() (n int) {
 	return n
 }
 
-func (m *GetApplicationRequest) Size() (n int) {
+func (m *MuteWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartAt)
+	n += 1 + l + sovApplication(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndAt)
+	n += 1 + l + sovApplication(uint64(l))
+	if m.QueuedDownlinks != 0 {
+		n += 1 + sovApplication(uint64(m.QueuedDownlinks))
+	}
+	if m.Upstream != 0 {
+		n += 1 + sovApplication(uint64(m.Upstream))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *Applications) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Applications) > 0 {
+		for _, e := range m.Applications {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetApplicationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2353,6 +3379,83 @@ func (m *SetApplicationCollaboratorRequest) Size() (n int) {
 	return n
 }
 
+func (m *SetApplicationCollaboratorBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApplicationIDs) > 0 {
+		for _, e := range m.ApplicationIDs {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = m.Collaborator.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	return n
+}
+
+func (m *CreateApplicationAPIKeyBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ApplicationIDs) > 0 {
+		for _, e := range m.ApplicationIDs {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if len(m.Rights) > 0 {
+		l = 0
+		for _, e := range m.Rights {
+			l += sovApplication(uint64(e))
+		}
+		n += 1 + sovApplication(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ApplicationBulkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovApplication(uint64(l))
+	if m.APIKey != nil {
+		l = m.APIKey.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationBulkResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovApplication(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovApplication(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2368,6 +3471,11 @@ func (this *Application) String() string {
 		repeatedStringForContactInfo += strings.Replace(fmt.Sprintf("%v", f), "ContactInfo", "ContactInfo", 1) + ","
 	}
 	repeatedStringForContactInfo += "}"
+	repeatedStringForMuteWindows := "[]*MuteWindow{"
+	for _, f := range this.MuteWindows {
+		repeatedStringForMuteWindows += strings.Replace(f.String(), "MuteWindow", "MuteWindow", 1) + ","
+	}
+	repeatedStringForMuteWindows += "}"
 	keysForAttributes := make([]string, 0, len(this.Attributes))
 	for k := range this.Attributes {
 		keysForAttributes = append(keysForAttributes, k)
@@ -2386,6 +3494,24 @@ func (this *Application) String() string {
 		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`Attributes:` + mapStringForAttributes + `,`,
 		`ContactInfo:` + repeatedStringForContactInfo + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
+		`PacketLogger:` + fmt.Sprintf("%v", this.PacketLogger) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`MuteWindows:` + repeatedStringForMuteWindows + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MuteWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MuteWindow{`,
+		`StartAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`EndAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`QueuedDownlinks:` + fmt.Sprintf("%v", this.QueuedDownlinks) + `,`,
+		`Upstream:` + fmt.Sprintf("%v", this.Upstream) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2532,6 +3658,66 @@ func (this *SetApplicationCollaboratorRequest) String() string {
 	}, "")
 	return s
 }
+func (this *SetApplicationCollaboratorBulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForApplicationIDs := "[]*ApplicationIdentifiers{"
+	for _, f := range this.ApplicationIDs {
+		repeatedStringForApplicationIDs += strings.Replace(fmt.Sprintf("%v", f), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + ","
+	}
+	repeatedStringForApplicationIDs += "}"
+	s := strings.Join([]string{`&SetApplicationCollaboratorBulkRequest{`,
+		`ApplicationIDs:` + repeatedStringForApplicationIDs + `,`,
+		`Collaborator:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Collaborator), "Collaborator", "Collaborator", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CreateApplicationAPIKeyBulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForApplicationIDs := "[]*ApplicationIdentifiers{"
+	for _, f := range this.ApplicationIDs {
+		repeatedStringForApplicationIDs += strings.Replace(fmt.Sprintf("%v", f), "ApplicationIdentifiers", "ApplicationIdentifiers", 1) + ","
+	}
+	repeatedStringForApplicationIDs += "}"
+	s := strings.Join([]string{`&CreateApplicationAPIKeyBulkRequest{`,
+		`ApplicationIDs:` + repeatedStringForApplicationIDs + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Rights:` + fmt.Sprintf("%v", this.Rights) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationBulkResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationBulkResult{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`APIKey:` + strings.Replace(fmt.Sprintf("%v", this.APIKey), "APIKey", "APIKey", 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationBulkResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*ApplicationBulkResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "ApplicationBulkResult", "ApplicationBulkResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&ApplicationBulkResults{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringApplication(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2857,11 +4043,635 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Attributes[mapkey] = mapvalue
+			m.Attributes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
+			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketLogger", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketLogger = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MuteWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MuteWindows = append(m.MuteWindows, &MuteWindow{})
+			if err := m.MuteWindows[len(m.MuteWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MuteWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MuteWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MuteWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDownlinks", wireType)
+			}
+			m.QueuedDownlinks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedDownlinks |= MuteWindow_Policy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upstream", wireType)
+			}
+			m.Upstream = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upstream |= MuteWindow_Policy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Applications) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Applications: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Applications: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applications = append(m.Applications, &Application{})
+			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListApplicationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Collaborator == nil {
+				m.Collaborator = &OrganizationOrUserIdentifiers{}
+			}
+			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2888,11 +4698,80 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactInfo = append(m.ContactInfo, &ContactInfo{})
-			if err := m.ContactInfo[len(m.ContactInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Order = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -2917,7 +4796,7 @@ func (m *Application) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Applications) Unmarshal(dAtA []byte) error {
+func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2940,15 +4819,15 @@ func (m *Applications) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Applications: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateApplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Applications: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Applications", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2975,8 +4854,40 @@ func (m *Applications) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Applications = append(m.Applications, &Application{})
-			if err := m.Applications[len(m.Applications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3004,7 +4915,7 @@ func (m *Applications) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3027,15 +4938,15 @@ func (m *GetApplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateApplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3062,7 +4973,7 @@ func (m *GetApplicationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3123,7 +5034,7 @@ func (m *GetApplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationAPIKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,15 +5057,15 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationAPIKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3181,18 +5092,15 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Collaborator == nil {
-				m.Collaborator = &OrganizationOrUserIdentifiers{}
-			}
-			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3202,30 +5110,16 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			var stringLen uint64
+			m.Page = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3235,29 +5129,69 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Page |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthApplication
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
 			}
-			m.Limit = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationAPIKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3267,16 +5201,30 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Page = 0
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3286,11 +5234,24 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3315,7 +5276,7 @@ func (m *ListApplicationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3338,15 +5299,15 @@ func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateApplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateApplicationAPIKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3373,15 +5334,15 @@ func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3391,25 +5352,93 @@ func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplication
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3434,7 +5463,7 @@ func (m *CreateApplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,15 +5486,15 @@ func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateApplicationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateApplicationAPIKeyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateApplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3492,13 +5521,13 @@ func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Application.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3525,7 +5554,7 @@ func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3553,7 +5582,7 @@ func (m *UpdateApplicationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationAPIKeysRequest) Unmarshal(dAtA []byte) error {
+func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3576,10 +5605,10 @@ func (m *ListApplicationAPIKeysRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationAPIKeysRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListApplicationCollaboratorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationAPIKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListApplicationCollaboratorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3677,7 +5706,7 @@ func (m *ListApplicationAPIKeysRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3700,10 +5729,10 @@ func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetApplicationCollaboratorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetApplicationCollaboratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3741,9 +5770,9 @@ func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationOrUserIdentifiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -3753,23 +5782,24 @@ func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplication
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplication
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyID = string(dAtA[iNdEx:postIndex])
+			if err := m.OrganizationOrUserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3795,7 +5825,7 @@ func (m *GetApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *SetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3818,10 +5848,10 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateApplicationAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetApplicationCollaboratorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetApplicationCollaboratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3844,120 +5874,52 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v Right
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Right(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Rights = append(m.Rights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowApplication
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthApplication
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthApplication
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Rights) == 0 {
-					m.Rights = make([]Right, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Right
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowApplication
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Right(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Rights = append(m.Rights, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplication(dAtA[iNdEx:])
@@ -3982,7 +5944,7 @@ func (m *CreateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
+func (m *SetApplicationCollaboratorBulkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4005,15 +5967,15 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateApplicationAPIKeyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetApplicationCollaboratorBulkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateApplicationAPIKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetApplicationCollaboratorBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4040,13 +6002,14 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationIDs = append(m.ApplicationIDs, &ApplicationIdentifiers{})
+			if err := m.ApplicationIDs[len(m.ApplicationIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4073,7 +6036,7 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4101,7 +6064,7 @@ func (m *UpdateApplicationAPIKeyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateApplicationAPIKeyBulkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,15 +6087,15 @@ func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListApplicationCollaboratorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateApplicationAPIKeyBulkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListApplicationCollaboratorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateApplicationAPIKeyBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4159,15 +6122,16 @@ func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ApplicationIDs = append(m.ApplicationIDs, &ApplicationIdentifiers{})
+			if err := m.ApplicationIDs[len(m.ApplicationIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplication
@@ -4177,29 +6141,92 @@ func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
 			}
-			m.Page = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Right
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Right(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Rights = append(m.Rights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplication
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplication
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplication
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Page |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Rights) == 0 {
+					m.Rights = make([]Right, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Right
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplication
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Right(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Rights = append(m.Rights, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rights", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4225,7 +6252,7 @@ func (m *ListApplicationCollaboratorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationBulkResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4248,15 +6275,15 @@ func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationCollaboratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationBulkResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationCollaboratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationBulkResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4283,13 +6310,13 @@ func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrganizationOrUserIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4316,7 +6343,46 @@ func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OrganizationOrUserIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.APIKey == nil {
+				m.APIKey = &APIKey{}
+			}
+			if err := m.APIKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4344,7 +6410,7 @@ func (m *GetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
+func (m *ApplicationBulkResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4367,48 +6433,15 @@ func (m *SetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationCollaboratorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationBulkResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationCollaboratorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationBulkResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplication
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplication
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplication
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collaborator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4435,7 +6468,8 @@ func (m *SetApplicationCollaboratorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collaborator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &ApplicationBulkResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
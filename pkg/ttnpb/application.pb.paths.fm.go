@@ -9,7 +9,11 @@ var ApplicationFieldPathsNested = []string{
 	"description",
 	"ids",
 	"ids.application_id",
+	"mute_windows",
 	"name",
+	"packet_logger",
+	"suspended",
+	"time_zone",
 	"updated_at",
 }
 
@@ -19,9 +23,29 @@ var ApplicationFieldPathsTopLevel = []string{
 	"created_at",
 	"description",
 	"ids",
+	"mute_windows",
 	"name",
+	"packet_logger",
+	"suspended",
+	"time_zone",
 	"updated_at",
 }
+var MuteWindowFieldPathsNested = []string{
+	"description",
+	"end_at",
+	"queued_downlinks",
+	"start_at",
+	"upstream",
+}
+
+var MuteWindowFieldPathsTopLevel = []string{
+	"description",
+	"end_at",
+	"queued_downlinks",
+	"start_at",
+	"upstream",
+}
+
 var ApplicationsFieldPathsNested = []string{
 	"applications",
 }
@@ -68,7 +92,11 @@ var CreateApplicationRequestFieldPathsNested = []string{
 	"application.description",
 	"application.ids",
 	"application.ids.application_id",
+	"application.mute_windows",
 	"application.name",
+	"application.packet_logger",
+	"application.suspended",
+	"application.time_zone",
 	"application.updated_at",
 	"collaborator",
 	"collaborator.ids",
@@ -91,7 +119,11 @@ var UpdateApplicationRequestFieldPathsNested = []string{
 	"application.description",
 	"application.ids",
 	"application.ids.application_id",
+	"application.mute_windows",
 	"application.name",
+	"application.packet_logger",
+	"application.suspended",
+	"application.time_zone",
 	"application.updated_at",
 	"field_mask",
 }
@@ -194,3 +226,71 @@ var SetApplicationCollaboratorRequestFieldPathsTopLevel = []string{
 	"application_ids",
 	"collaborator",
 }
+
+var SetApplicationCollaboratorBulkRequestFieldPathsNested = []string{
+	"application_ids",
+	"collaborator",
+	"collaborator.ids",
+	"collaborator.ids.ids",
+	"collaborator.ids.ids.organization_ids",
+	"collaborator.ids.ids.organization_ids.organization_id",
+	"collaborator.ids.ids.user_ids",
+	"collaborator.ids.ids.user_ids.email",
+	"collaborator.ids.ids.user_ids.user_id",
+	"collaborator.rights",
+}
+
+var SetApplicationCollaboratorBulkRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"collaborator",
+}
+
+var CreateApplicationAPIKeyBulkRequestFieldPathsNested = []string{
+	"application_ids",
+	"name",
+	"rights",
+}
+
+var CreateApplicationAPIKeyBulkRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"name",
+	"rights",
+}
+
+var ApplicationBulkResultFieldPathsNested = []string{
+	"api_key",
+	"api_key.id",
+	"api_key.key",
+	"api_key.name",
+	"api_key.rights",
+	"application_ids",
+	"application_ids.application_id",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+}
+
+var ApplicationBulkResultFieldPathsTopLevel = []string{
+	"api_key",
+	"application_ids",
+	"error",
+}
+
+var ApplicationBulkResultsFieldPathsNested = []string{
+	"results",
+}
+
+var ApplicationBulkResultsFieldPathsTopLevel = []string{
+	"results",
+}
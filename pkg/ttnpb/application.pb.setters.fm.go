@@ -88,6 +88,106 @@ func (dst *Application) SetFields(src *Application, paths ...string) error {
 			} else {
 				dst.ContactInfo = nil
 			}
+		case "suspended":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Suspended = src.Suspended
+			} else {
+				var zero bool
+				dst.Suspended = zero
+			}
+		case "packet_logger":
+			if len(subs) > 0 {
+				return fmt.Errorf("'packet_logger' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PacketLogger = src.PacketLogger
+			} else {
+				var zero bool
+				dst.PacketLogger = zero
+			}
+
+		case "time_zone":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time_zone' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TimeZone = src.TimeZone
+			} else {
+				var zero string
+				dst.TimeZone = zero
+			}
+		case "mute_windows":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mute_windows' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MuteWindows = src.MuteWindows
+			} else {
+				dst.MuteWindows = nil
+			}
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MuteWindow) SetFields(src *MuteWindow, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "start_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'start_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartAt = src.StartAt
+			} else {
+				var zero time.Time
+				dst.StartAt = zero
+			}
+		case "end_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndAt = src.EndAt
+			} else {
+				var zero time.Time
+				dst.EndAt = zero
+			}
+		case "queued_downlinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'queued_downlinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.QueuedDownlinks = src.QueuedDownlinks
+			} else {
+				var zero MuteWindow_Policy
+				dst.QueuedDownlinks = zero
+			}
+		case "upstream":
+			if len(subs) > 0 {
+				return fmt.Errorf("'upstream' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Upstream = src.Upstream
+			} else {
+				var zero MuteWindow_Policy
+				dst.Upstream = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -638,3 +738,179 @@ func (dst *SetApplicationCollaboratorRequest) SetFields(src *SetApplicationColla
 	}
 	return nil
 }
+
+func (dst *SetApplicationCollaboratorBulkRequest) SetFields(src *SetApplicationCollaboratorBulkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationIDs = src.ApplicationIDs
+			} else {
+				dst.ApplicationIDs = nil
+			}
+		case "collaborator":
+			if len(subs) > 0 {
+				newDst := &dst.Collaborator
+				var newSrc *Collaborator
+				if src != nil {
+					newSrc = &src.Collaborator
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Collaborator = src.Collaborator
+				} else {
+					var zero Collaborator
+					dst.Collaborator = zero
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CreateApplicationAPIKeyBulkRequest) SetFields(src *CreateApplicationAPIKeyBulkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'application_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApplicationIDs = src.ApplicationIDs
+			} else {
+				dst.ApplicationIDs = nil
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "rights":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rights' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rights = src.Rights
+			} else {
+				dst.Rights = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationBulkResult) SetFields(src *ApplicationBulkResult, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "api_key":
+			if len(subs) > 0 {
+				var newDst, newSrc *APIKey
+				if (src == nil || src.APIKey == nil) && dst.APIKey == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.APIKey
+				}
+				if dst.APIKey != nil {
+					newDst = dst.APIKey
+				} else {
+					newDst = &APIKey{}
+					dst.APIKey = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.APIKey = src.APIKey
+				} else {
+					dst.APIKey = nil
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationBulkResults) SetFields(src *ApplicationBulkResults, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
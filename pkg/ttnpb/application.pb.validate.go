@@ -143,6 +143,43 @@ func (m *Application) ValidateFields(paths ...string) error {
 
 			}
 
+		case "suspended":
+			// no validation rules for Suspended
+		case "packet_logger":
+			// no validation rules for PacketLogger
+		case "time_zone":
+
+			if utf8.RuneCountInString(m.GetTimeZone()) > 64 {
+				return ApplicationValidationError{
+					field:  "time_zone",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "mute_windows":
+
+			if len(m.GetMuteWindows()) > 20 {
+				return ApplicationValidationError{
+					field:  "mute_windows",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetMuteWindows() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationValidationError{
+							field:  fmt.Sprintf("mute_windows[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return ApplicationValidationError{
 				field:  name,
@@ -209,6 +246,138 @@ var _ interface {
 
 var _Application_Attributes_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
+// ValidateFields checks the field values on MuteWindow with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MuteWindow) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MuteWindowFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "start_at":
+
+			if v, ok := interface{}(&m.StartAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MuteWindowValidationError{
+						field:  "start_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_at":
+
+			if v, ok := interface{}(&m.EndAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MuteWindowValidationError{
+						field:  "end_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "queued_downlinks":
+
+			if _, ok := MuteWindow_Policy_name[int32(m.GetQueuedDownlinks())]; !ok {
+				return MuteWindowValidationError{
+					field:  "queued_downlinks",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "upstream":
+
+			if _, ok := MuteWindow_Policy_name[int32(m.GetUpstream())]; !ok {
+				return MuteWindowValidationError{
+					field:  "upstream",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return MuteWindowValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		default:
+			return MuteWindowValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MuteWindowValidationError is the validation error returned by
+// MuteWindow.ValidateFields if the designated constraints aren't met.
+type MuteWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MuteWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MuteWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MuteWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MuteWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MuteWindowValidationError) ErrorName() string {
+	return "MuteWindowValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MuteWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMuteWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MuteWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MuteWindowValidationError{}
+
 // ValidateFields checks the field values on Applications with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1480,3 +1649,468 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SetApplicationCollaboratorRequestValidationError{}
+
+// ValidateFields checks the field values on
+// SetApplicationCollaboratorBulkRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *SetApplicationCollaboratorBulkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetApplicationCollaboratorBulkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if l := len(m.GetApplicationIDs()); l < 1 || l > 100 {
+				return SetApplicationCollaboratorBulkRequestValidationError{
+					field:  "application_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetApplicationIDs() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SetApplicationCollaboratorBulkRequestValidationError{
+							field:  fmt.Sprintf("application_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "collaborator":
+
+			if v, ok := interface{}(&m.Collaborator).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetApplicationCollaboratorBulkRequestValidationError{
+						field:  "collaborator",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetApplicationCollaboratorBulkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetApplicationCollaboratorBulkRequestValidationError is the validation error
+// returned by SetApplicationCollaboratorBulkRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetApplicationCollaboratorBulkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetApplicationCollaboratorBulkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetApplicationCollaboratorBulkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetApplicationCollaboratorBulkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetApplicationCollaboratorBulkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetApplicationCollaboratorBulkRequestValidationError) ErrorName() string {
+	return "SetApplicationCollaboratorBulkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetApplicationCollaboratorBulkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetApplicationCollaboratorBulkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetApplicationCollaboratorBulkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetApplicationCollaboratorBulkRequestValidationError{}
+
+// ValidateFields checks the field values on CreateApplicationAPIKeyBulkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *CreateApplicationAPIKeyBulkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateApplicationAPIKeyBulkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if l := len(m.GetApplicationIDs()); l < 1 || l > 100 {
+				return CreateApplicationAPIKeyBulkRequestValidationError{
+					field:  "application_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetApplicationIDs() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return CreateApplicationAPIKeyBulkRequestValidationError{
+							field:  fmt.Sprintf("application_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return CreateApplicationAPIKeyBulkRequestValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "rights":
+
+			for idx, item := range m.GetRights() {
+				_, _ = idx, item
+
+				if _, ok := Right_name[int32(item)]; !ok {
+					return CreateApplicationAPIKeyBulkRequestValidationError{
+						field:  fmt.Sprintf("rights[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		default:
+			return CreateApplicationAPIKeyBulkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateApplicationAPIKeyBulkRequestValidationError is the validation error
+// returned by CreateApplicationAPIKeyBulkRequest.ValidateFields if the
+// designated constraints aren't met.
+type CreateApplicationAPIKeyBulkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApplicationAPIKeyBulkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApplicationAPIKeyBulkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApplicationAPIKeyBulkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApplicationAPIKeyBulkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApplicationAPIKeyBulkRequestValidationError) ErrorName() string {
+	return "CreateApplicationAPIKeyBulkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApplicationAPIKeyBulkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApplicationAPIKeyBulkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApplicationAPIKeyBulkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApplicationAPIKeyBulkRequestValidationError{}
+
+// ValidateFields checks the field values on ApplicationBulkResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationBulkResult) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationBulkResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationBulkResultValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "api_key":
+
+			if v, ok := interface{}(m.GetAPIKey()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationBulkResultValidationError{
+						field:  "api_key",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationBulkResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationBulkResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationBulkResultValidationError is the validation error returned by
+// ApplicationBulkResult.ValidateFields if the designated constraints aren't
+// met.
+type ApplicationBulkResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationBulkResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationBulkResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationBulkResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationBulkResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationBulkResultValidationError) ErrorName() string {
+	return "ApplicationBulkResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationBulkResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationBulkResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationBulkResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationBulkResultValidationError{}
+
+// ValidateFields checks the field values on ApplicationBulkResults with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationBulkResults) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationBulkResultsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationBulkResultsValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationBulkResultsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationBulkResultsValidationError is the validation error returned by
+// ApplicationBulkResults.ValidateFields if the designated constraints aren't
+// met.
+type ApplicationBulkResultsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationBulkResultsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationBulkResultsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationBulkResultsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationBulkResultsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationBulkResultsValidationError) ErrorName() string {
+	return "ApplicationBulkResultsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationBulkResultsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationBulkResults.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationBulkResultsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationBulkResultsValidationError{}
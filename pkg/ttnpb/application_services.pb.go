@@ -38,63 +38,65 @@ func init() {
 }
 
 var fileDescriptor_f6c42f4fe8e3c902 = []byte{
-	// 885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6c, 0xe3, 0x44,
-	0x14, 0xc7, 0x3d, 0x5d, 0x14, 0x89, 0xd9, 0x85, 0xd5, 0x0e, 0x12, 0x48, 0xde, 0x65, 0x84, 0x8c,
-	0x76, 0x8b, 0x96, 0xcd, 0x18, 0x36, 0x02, 0xb4, 0x50, 0x01, 0xfd, 0x40, 0xa1, 0x2a, 0x88, 0xaa,
-	0x15, 0x97, 0x5c, 0x8a, 0x93, 0x4e, 0x1d, 0x2b, 0xa9, 0xc7, 0x78, 0x26, 0x2d, 0x69, 0x54, 0xa9,
-	0x70, 0xaa, 0x7a, 0x02, 0xf1, 0x21, 0x84, 0x40, 0x42, 0x08, 0x44, 0x2f, 0x48, 0x3d, 0xf6, 0xd8,
-	0x63, 0x8f, 0x95, 0xb8, 0xf4, 0xd8, 0xd8, 0x1c, 0x7a, 0x00, 0xa9, 0xc7, 0x1e, 0x38, 0x20, 0x8f,
-	0x6d, 0x6a, 0x27, 0xa9, 0xd3, 0x24, 0x7b, 0xcb, 0xcc, 0xbc, 0x79, 0xef, 0x37, 0xef, 0xf9, 0xff,
-	0x57, 0xe0, 0x83, 0x3a, 0x73, 0x8d, 0x75, 0xc3, 0xce, 0x73, 0x61, 0x54, 0x6a, 0xba, 0xe1, 0x58,
-	0xba, 0xe1, 0x38, 0x75, 0xab, 0x62, 0x08, 0x8b, 0xd9, 0x4b, 0x9c, 0xba, 0x6b, 0x56, 0x85, 0x72,
-	0xe2, 0xb8, 0x4c, 0x30, 0xf4, 0xb4, 0x10, 0x36, 0x89, 0x6e, 0x90, 0xb5, 0x82, 0x9a, 0x37, 0x2d,
-	0x51, 0x6d, 0x94, 0x49, 0x85, 0xad, 0xea, 0x26, 0x33, 0x99, 0x2e, 0xc3, 0xca, 0x8d, 0x15, 0xb9,
-	0x92, 0x0b, 0xf9, 0x2b, 0xbc, 0xae, 0xde, 0x31, 0x19, 0x33, 0xeb, 0x34, 0xac, 0x62, 0xdb, 0x4c,
-	0xc8, 0x22, 0x51, 0x72, 0xf5, 0x76, 0x74, 0xfa, 0x7f, 0x0e, 0xba, 0xea, 0x88, 0x66, 0x74, 0xf8,
-	0x62, 0x26, 0xe7, 0xe5, 0x41, 0xd6, 0x32, 0xb5, 0x85, 0xb5, 0x62, 0x51, 0x37, 0x2e, 0x83, 0xbb,
-	0x83, 0x5c, 0xcb, 0xac, 0x8a, 0xe8, 0xfc, 0xe1, 0x3f, 0x39, 0xf8, 0xcc, 0xe4, 0x45, 0xea, 0x05,
-	0x6a, 0x5a, 0x5c, 0xb8, 0x4d, 0xe4, 0x03, 0x98, 0x9b, 0x76, 0xa9, 0x21, 0x28, 0x7a, 0x89, 0xa4,
-	0xfb, 0x40, 0xc2, 0xfd, 0xd4, 0xad, 0x4f, 0x1b, 0x94, 0x0b, 0xf5, 0x76, 0x67, 0x64, 0x22, 0x46,
-	0xfb, 0x0a, 0x7c, 0xf1, 0xe7, 0x5f, 0x5f, 0x8f, 0xed, 0x00, 0xad, 0xa0, 0x37, 0x38, 0x75, 0xb9,
-	0xde, 0xaa, 0xb0, 0x7a, 0xdd, 0x28, 0x33, 0xd7, 0x10, 0xcc, 0x25, 0xc1, 0xde, 0x92, 0xb5, 0xcc,
-	0xe3, 0x1f, 0x9b, 0xc9, 0x27, 0xf3, 0x37, 0xc1, 0xfd, 0xd2, 0xbc, 0x36, 0xa7, 0x33, 0xd7, 0x34,
-	0x6c, 0x6b, 0x23, 0xdc, 0xec, 0xc8, 0x90, 0x3c, 0x93, 0x99, 0x3a, 0x36, 0xba, 0x32, 0xa2, 0xcf,
-	0x01, 0xbc, 0x56, 0xa4, 0x02, 0xdd, 0xed, 0x04, 0x2f, 0x52, 0x31, 0xe8, 0xfb, 0x5e, 0x97, 0xcf,
-	0x7b, 0x05, 0x91, 0x54, 0x15, 0xbd, 0x95, 0xfc, 0xc0, 0x02, 0xa8, 0xf4, 0x7a, 0x13, 0xfd, 0x0d,
-	0xe0, 0x13, 0x1f, 0x58, 0x5c, 0xa0, 0xf1, 0xce, 0xec, 0xc1, 0x6e, 0xa2, 0x02, 0x8f, 0x31, 0xee,
-	0x64, 0x60, 0x70, 0xed, 0xc7, 0xb0, 0xcf, 0xdf, 0x02, 0xf4, 0x54, 0x8a, 0xa4, 0xf4, 0x1a, 0x1a,
-	0xa6, 0xf1, 0xa5, 0x0f, 0xd1, 0xe3, 0xec, 0x3a, 0xda, 0x01, 0x30, 0xf7, 0xb1, 0xb3, 0xdc, 0xf3,
-	0xc3, 0x0a, 0xf7, 0x07, 0x6d, 0xfc, 0x23, 0xf9, 0xde, 0x82, 0x9a, 0xd1, 0x78, 0xd2, 0xa3, 0xf1,
-	0xc1, 0xfc, 0x1d, 0x98, 0x9b, 0xa1, 0x75, 0x2a, 0x28, 0xba, 0x97, 0x51, 0x61, 0xf6, 0x42, 0x55,
-	0xea, 0xb3, 0x24, 0xd4, 0x2d, 0x89, 0x75, 0x4b, 0xde, 0x0b, 0x74, 0xab, 0xdd, 0x93, 0x10, 0x2f,
-	0xdc, 0xc7, 0x99, 0xd3, 0xdf, 0x7c, 0xf8, 0xef, 0x75, 0x78, 0x2b, 0x91, 0x7a, 0xb2, 0x52, 0xa1,
-	0x9c, 0xa3, 0x16, 0x84, 0xc1, 0xb0, 0x17, 0xa4, 0x32, 0x07, 0x60, 0xe9, 0x88, 0x0b, 0xef, 0x6b,
-	0x79, 0xc9, 0x32, 0x8e, 0xee, 0x66, 0xb3, 0x44, 0x46, 0x80, 0x7e, 0x00, 0xf0, 0x46, 0x24, 0xe9,
-	0xf9, 0xd9, 0x39, 0xda, 0x44, 0xa4, 0xaf, 0xe0, 0xc3, 0xc0, 0x78, 0x3a, 0x5d, 0x1c, 0xe1, 0xb1,
-	0x36, 0x25, 0x39, 0x26, 0xb4, 0x37, 0x06, 0x53, 0x44, 0x60, 0x52, 0xf9, 0x1a, 0x6d, 0x4a, 0x85,
-	0x7e, 0x07, 0xe0, 0x75, 0xa9, 0x03, 0x99, 0x92, 0xa3, 0x7c, 0x1f, 0x91, 0x44, 0x71, 0x31, 0xda,
-	0x73, 0xbd, 0xd1, 0xb8, 0xf6, 0x8e, 0x64, 0x7b, 0x84, 0x86, 0x65, 0x0b, 0xba, 0xf6, 0x64, 0xe0,
-	0x12, 0x61, 0xcb, 0x5e, 0xce, 0x36, 0x90, 0xab, 0xf5, 0xeb, 0x7d, 0xc9, 0x34, 0x85, 0xde, 0x1d,
-	0x92, 0x49, 0x6f, 0xd5, 0x68, 0x53, 0x7a, 0xca, 0xef, 0x00, 0xde, 0x88, 0xc4, 0x74, 0xc9, 0x48,
-	0xbb, 0xa4, 0x76, 0x35, 0xc4, 0x8f, 0x24, 0xe2, 0xac, 0x3a, 0x33, 0x34, 0xa2, 0xe1, 0x58, 0x4b,
-	0x35, 0xda, 0x24, 0x91, 0x02, 0xbf, 0xb9, 0x06, 0x6f, 0x16, 0xa9, 0x98, 0x4e, 0x38, 0x0a, 0x7a,
-	0x35, 0xbb, 0x99, 0xc9, 0xd8, 0x98, 0x77, 0xbc, 0xc7, 0x95, 0x74, 0x1c, 0x77, 0x98, 0xcd, 0xa9,
-	0xf6, 0xeb, 0x98, 0x7c, 0xc1, 0x4f, 0x63, 0xe8, 0xad, 0x01, 0x9f, 0x90, 0x34, 0xbd, 0x52, 0x19,
-	0x7d, 0x32, 0xc2, 0x75, 0x69, 0xc3, 0xfd, 0x5c, 0xb8, 0xb4, 0x81, 0x3e, 0x1b, 0xa5, 0x46, 0xd2,
-	0x86, 0x07, 0xb5, 0x6c, 0xf4, 0x1b, 0x80, 0x37, 0x17, 0xfb, 0x8d, 0x65, 0xb1, 0xef, 0x58, 0x2e,
-	0x73, 0xcb, 0xa2, 0x1c, 0xc2, 0xa4, 0x3a, 0x31, 0xc2, 0x03, 0xa5, 0x3d, 0xfc, 0x01, 0xe0, 0xad,
-	0xc0, 0x01, 0x92, 0xc5, 0x39, 0x2a, 0xf4, 0x31, 0x89, 0x54, 0x74, 0xcc, 0xfa, 0x7c, 0x97, 0xeb,
-	0x25, 0xa3, 0xb4, 0x19, 0x89, 0xfc, 0x36, 0x1a, 0x09, 0x79, 0xea, 0x17, 0x70, 0xd8, 0xc6, 0xe0,
-	0xa8, 0x8d, 0xc1, 0x71, 0x1b, 0x2b, 0x27, 0x6d, 0xac, 0x9c, 0xb6, 0xb1, 0x72, 0xd6, 0xc6, 0xca,
-	0x79, 0x1b, 0x83, 0x2d, 0x0f, 0x83, 0x6d, 0x0f, 0x2b, 0xbb, 0x1e, 0x06, 0x7b, 0x1e, 0x56, 0xf6,
-	0x3d, 0xac, 0x1c, 0x78, 0x58, 0x39, 0xf4, 0x30, 0x38, 0xf2, 0x30, 0x38, 0xf6, 0xb0, 0x72, 0xe2,
-	0x61, 0x70, 0xea, 0x61, 0xe5, 0xcc, 0xc3, 0xe0, 0xdc, 0xc3, 0xca, 0x96, 0x8f, 0x95, 0x6d, 0x1f,
-	0x83, 0x2f, 0x7d, 0xac, 0x7c, 0xef, 0x63, 0xf0, 0xb3, 0x8f, 0x95, 0x5d, 0x1f, 0x2b, 0x7b, 0x3e,
-	0x06, 0xfb, 0x3e, 0x06, 0x07, 0x3e, 0x06, 0xa5, 0x07, 0x26, 0x23, 0xa2, 0x4a, 0x45, 0xd5, 0xb2,
-	0x4d, 0x4e, 0x6c, 0x2a, 0xd6, 0x99, 0x5b, 0xd3, 0xd3, 0x7f, 0x0d, 0x9d, 0x9a, 0xa9, 0x0b, 0x61,
-	0x3b, 0xe5, 0x72, 0x4e, 0x4e, 0xab, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x33, 0x89,
-	0xa9, 0x2e, 0x0b, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xad, 0x56, 0x4d, 0x4c, 0xd4, 0x40,
+	0x14, 0xa6, 0x60, 0x48, 0xac, 0x0a, 0x3a, 0x24, 0x9a, 0x14, 0xdc, 0x90, 0x1a, 0xc0, 0x00, 0xdb,
+	0x2a, 0x1b, 0x34, 0x28, 0x51, 0xf9, 0x31, 0x48, 0xd0, 0x48, 0x20, 0x5e, 0xf6, 0x82, 0xbb, 0xcb,
+	0x50, 0x9a, 0x5d, 0xda, 0xda, 0x99, 0x05, 0x17, 0x42, 0x82, 0x9e, 0x08, 0x27, 0x8d, 0x3f, 0x31,
+	0x46, 0x13, 0x35, 0x1a, 0xb9, 0x98, 0x70, 0xc4, 0x1b, 0x47, 0x8e, 0x24, 0x5e, 0x38, 0xf2, 0xe3,
+	0x81, 0x83, 0x26, 0x1c, 0x39, 0xfa, 0x3a, 0x6d, 0xa1, 0xdd, 0x85, 0x2e, 0xbb, 0xeb, 0xe1, 0xed,
+	0x9b, 0xce, 0xbc, 0x79, 0xef, 0x9b, 0xf7, 0xe6, 0x7d, 0xb3, 0x7c, 0x6b, 0x4a, 0x37, 0x63, 0x53,
+	0x31, 0x2d, 0x4c, 0x68, 0x2c, 0x91, 0x94, 0x63, 0x86, 0x0a, 0x62, 0xa4, 0xd4, 0x44, 0x8c, 0xaa,
+	0xba, 0x36, 0x42, 0xb0, 0x39, 0xa9, 0x26, 0x30, 0x91, 0x0c, 0x53, 0xa7, 0x3a, 0xaa, 0xa2, 0x54,
+	0x93, 0x9c, 0x1d, 0xd2, 0x64, 0x44, 0x08, 0x2b, 0x2a, 0x1d, 0x4f, 0xc7, 0xa5, 0x84, 0x3e, 0x21,
+	0x2b, 0xba, 0xa2, 0xcb, 0xcc, 0x2c, 0x9e, 0x1e, 0x63, 0x5f, 0xec, 0x83, 0x8d, 0xec, 0xed, 0x42,
+	0x9d, 0xa2, 0xeb, 0x4a, 0x0a, 0xdb, 0x51, 0x34, 0x4d, 0xa7, 0x2c, 0x88, 0xe3, 0x5c, 0xa8, 0x75,
+	0x56, 0xf7, 0x7d, 0xe0, 0x09, 0x83, 0x66, 0x9c, 0xc5, 0x4b, 0x81, 0x38, 0x8f, 0x36, 0x52, 0x47,
+	0xb1, 0x46, 0xd5, 0x31, 0x15, 0x9b, 0x6e, 0x98, 0x50, 0xae, 0x91, 0xa9, 0x2a, 0xe3, 0xd4, 0x59,
+	0x6f, 0xfb, 0x5b, 0xc9, 0xd7, 0x74, 0x1d, 0xb8, 0x1e, 0xc2, 0x8a, 0x4a, 0xa8, 0x99, 0x41, 0xdb,
+	0x1c, 0x5f, 0xd9, 0x63, 0xe2, 0x18, 0xc5, 0xe8, 0xb2, 0xe4, 0xcf, 0x83, 0x64, 0xcf, 0xfb, 0x76,
+	0x3d, 0x49, 0x63, 0x42, 0x85, 0xda, 0x6c, 0x4b, 0x8f, 0x8d, 0xf8, 0x92, 0x7b, 0xfe, 0xeb, 0xf7,
+	0xab, 0xf2, 0x05, 0x4e, 0x8c, 0xc8, 0x69, 0xc8, 0x34, 0x91, 0x67, 0x12, 0x7a, 0x2a, 0x15, 0x8b,
+	0x83, 0x39, 0xd5, 0x4d, 0xc9, 0x9a, 0x1b, 0x51, 0x47, 0x89, 0x3b, 0x98, 0xf5, 0x1e, 0x99, 0xdc,
+	0xe0, 0x9a, 0xa3, 0x83, 0xe2, 0x80, 0xac, 0x9b, 0x4a, 0x4c, 0x53, 0xa7, 0xed, 0xc9, 0x2c, 0x0f,
+	0xde, 0x35, 0xe6, 0x29, 0x6b, 0x22, 0xc7, 0x23, 0x7a, 0xc6, 0xf1, 0x15, 0x7d, 0x98, 0xa2, 0x86,
+	0x6c, 0xe0, 0x30, 0x59, 0xe8, 0xf9, 0xae, 0xb1, 0xe3, 0x5d, 0x41, 0x92, 0x2f, 0x8a, 0x3c, 0xe3,
+	0xbd, 0x60, 0x16, 0x28, 0xff, 0xf7, 0x2c, 0xfa, 0xc3, 0xf1, 0x27, 0xee, 0x43, 0xd2, 0x51, 0x53,
+	0xb6, 0x77, 0x6b, 0xd6, 0x13, 0x81, 0xb8, 0x30, 0xea, 0x02, 0x60, 0x10, 0xf1, 0x83, 0x9d, 0xe7,
+	0x37, 0x1c, 0x3a, 0xe3, 0x43, 0x12, 0x6d, 0x47, 0xc5, 0x24, 0x3e, 0xfa, 0x00, 0xfd, 0xcf, 0xac,
+	0xa3, 0x05, 0xb8, 0x58, 0x8f, 0x8c, 0xd1, 0x43, 0x2f, 0x96, 0x3d, 0x5f, 0x68, 0xe2, 0x3b, 0xd8,
+	0x79, 0x23, 0x42, 0x40, 0xe2, 0xa5, 0x43, 0x12, 0x6f, 0xd5, 0xdf, 0xe0, 0x2b, 0x7b, 0x71, 0x0a,
+	0x03, 0x96, 0xc6, 0x80, 0x08, 0xfd, 0x07, 0x5d, 0x25, 0x9c, 0x97, 0xec, 0xbe, 0x95, 0xdc, 0xbe,
+	0x95, 0xee, 0x5a, 0x7d, 0x2b, 0x36, 0x32, 0x10, 0xf5, 0xcd, 0xa1, 0xc0, 0xea, 0xcf, 0xb6, 0xfd,
+	0xac, 0xe2, 0xcf, 0x79, 0x5c, 0x77, 0x25, 0x80, 0x6e, 0x08, 0x9a, 0xe1, 0x79, 0xab, 0xd8, 0x43,
+	0xac, 0x33, 0x0b, 0xc0, 0x92, 0x65, 0x67, 0xef, 0x17, 0xc3, 0x0c, 0x4b, 0x13, 0x6a, 0x08, 0xc6,
+	0xe2, 0x10, 0x01, 0x7a, 0xcf, 0xf1, 0xa7, 0x9d, 0x96, 0x1e, 0xec, 0x1f, 0xc0, 0x19, 0x24, 0xe5,
+	0x6d, 0x78, 0xdb, 0xd0, 0xad, 0x4e, 0x0e, 0x0e, 0x7b, 0x59, 0xec, 0x66, 0x38, 0x3a, 0xc5, 0xeb,
+	0x85, 0x75, 0x84, 0x45, 0x52, 0xe1, 0x24, 0xce, 0xb0, 0x0e, 0x7d, 0xcb, 0xf1, 0x67, 0xbd, 0xe0,
+	0xba, 0xd3, 0xa9, 0x24, 0x6a, 0x3b, 0x26, 0x40, 0xcb, 0xd8, 0x05, 0x19, 0x94, 0x54, 0xdb, 0x8e,
+	0xa4, 0x53, 0x90, 0x3c, 0xa7, 0x90, 0x62, 0xad, 0x1f, 0xb4, 0x8b, 0x49, 0x8e, 0x83, 0xad, 0x03,
+	0xec, 0x14, 0x6b, 0x50, 0x16, 0x89, 0xa0, 0x70, 0x9e, 0xee, 0x75, 0xec, 0x5c, 0x38, 0x17, 0x0e,
+	0xcf, 0x19, 0x11, 0x6f, 0xb3, 0xf8, 0x1d, 0xa8, 0xd8, 0xa4, 0x59, 0xe5, 0x3c, 0x69, 0xd1, 0x97,
+	0x5d, 0xcb, 0x96, 0x60, 0x66, 0x3b, 0x5e, 0x21, 0xef, 0x31, 0x4c, 0xdd, 0xe8, 0x4e, 0x91, 0x98,
+	0xe4, 0x19, 0xf8, 0x65, 0x64, 0xf7, 0x1d, 0xee, 0x9a, 0xd3, 0xe5, 0x47, 0xdc, 0xb5, 0x1c, 0x0e,
+	0x38, 0x1e, 0xc4, 0x87, 0x0c, 0x62, 0xbf, 0xd0, 0x5b, 0x34, 0x44, 0x18, 0x8d, 0xc0, 0x48, 0x72,
+	0xa8, 0xe1, 0x75, 0x05, 0x5f, 0x0d, 0xb9, 0xea, 0xf1, 0x50, 0x1d, 0xba, 0x1a, 0x9c, 0x4c, 0xaf,
+	0xad, 0x8b, 0xb7, 0xe9, 0x90, 0x2d, 0x7e, 0x3b, 0x62, 0x00, 0x50, 0x2c, 0x7e, 0x2d, 0x67, 0x27,
+	0xf8, 0x58, 0x8e, 0x6e, 0x16, 0x78, 0x04, 0x2f, 0x1b, 0x47, 0xe3, 0xe8, 0x71, 0x09, 0xdb, 0xd9,
+	0xfb, 0x90, 0xef, 0x79, 0x88, 0x4e, 0xa3, 0xa7, 0xa5, 0xc4, 0xf0, 0xbe, 0x0f, 0x85, 0xbe, 0x25,
+	0xe8, 0x1b, 0xc7, 0x57, 0x0f, 0xe7, 0x2b, 0xcb, 0x70, 0xde, 0xb2, 0x1c, 0x45, 0xe3, 0x7d, 0xac,
+	0x08, 0x5d, 0x42, 0x67, 0x09, 0x07, 0x64, 0xbc, 0xf5, 0x99, 0xe3, 0x6b, 0xb2, 0x70, 0x32, 0xea,
+	0x6a, 0x3f, 0x3e, 0xd6, 0x62, 0xd8, 0xab, 0x85, 0xe1, 0x6f, 0x10, 0xea, 0xfd, 0xf8, 0x7d, 0xf0,
+	0xf6, 0x29, 0xec, 0x07, 0xc7, 0x9f, 0xb3, 0x58, 0xca, 0x1b, 0x94, 0xa0, 0x48, 0x1e, 0x22, 0xf3,
+	0x59, 0xbb, 0xf8, 0x2e, 0xe6, 0x30, 0xb2, 0xd7, 0x4a, 0xec, 0x65, 0xb0, 0x6e, 0xa1, 0x92, 0xd2,
+	0xda, 0xfd, 0x85, 0x5b, 0xdd, 0x0c, 0x71, 0x6b, 0x20, 0xeb, 0x9b, 0xa1, 0xb2, 0x0d, 0x90, 0x1d,
+	0x90, 0x5d, 0x90, 0x3d, 0x98, 0x9b, 0xdb, 0x0a, 0x71, 0xf3, 0x5b, 0xa1, 0xb2, 0x45, 0xd0, 0x4b,
+	0xa0, 0x97, 0x41, 0x56, 0x40, 0x56, 0xe1, 0x7b, 0x0d, 0x64, 0x1d, 0xc6, 0x1b, 0xa0, 0x77, 0x40,
+	0xef, 0x82, 0xde, 0x03, 0x3d, 0xb7, 0x1d, 0x2a, 0x9b, 0xdf, 0x0e, 0x71, 0x2f, 0x40, 0xbf, 0x03,
+	0xfd, 0x09, 0xf4, 0x22, 0xc8, 0x12, 0x8c, 0x97, 0x41, 0x56, 0x40, 0xa2, 0xad, 0xf0, 0x8f, 0x9e,
+	0x8e, 0x63, 0x3a, 0xae, 0x6a, 0x0a, 0x91, 0x34, 0x4c, 0xa7, 0x74, 0x33, 0x29, 0xfb, 0xff, 0x57,
+	0x1b, 0x49, 0x45, 0x86, 0x0c, 0x18, 0xf1, 0x78, 0x25, 0xbb, 0x51, 0x91, 0x7f, 0xe4, 0x06, 0x93,
+	0x7f, 0x6b, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -339,6 +341,10 @@ var _ApplicationRegistry_serviceDesc = grpc.ServiceDesc{
 type ApplicationAccessClient interface {
 	ListRights(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*Rights, error)
 	CreateAPIKey(ctx context.Context, in *CreateApplicationAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
+	// Create an API key with the same name and rights for each of the given applications.
+	// The result of each application is reported separately; a failure for one application
+	// does not affect the others.
+	CreateAPIKeyBulk(ctx context.Context, in *CreateApplicationAPIKeyBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResults, error)
 	ListAPIKeys(ctx context.Context, in *ListApplicationAPIKeysRequest, opts ...grpc.CallOption) (*APIKeys, error)
 	GetAPIKey(ctx context.Context, in *GetApplicationAPIKeyRequest, opts ...grpc.CallOption) (*APIKey, error)
 	// Update the rights of an existing application API key. To generate an API key,
@@ -352,6 +358,10 @@ type ApplicationAccessClient interface {
 	// have all assigned or/and removed rights.
 	// Setting a collaborator without rights, removes them.
 	SetCollaborator(ctx context.Context, in *SetApplicationCollaboratorRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	// Set the rights of a collaborator (member) on each of the given applications.
+	// The result of each application is reported separately; a failure for one application
+	// does not affect the others.
+	SetCollaboratorBulk(ctx context.Context, in *SetApplicationCollaboratorBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResults, error)
 	ListCollaborators(ctx context.Context, in *ListApplicationCollaboratorsRequest, opts ...grpc.CallOption) (*Collaborators, error)
 }
 
@@ -381,6 +391,15 @@ func (c *applicationAccessClient) CreateAPIKey(ctx context.Context, in *CreateAp
 	return out, nil
 }
 
+func (c *applicationAccessClient) CreateAPIKeyBulk(ctx context.Context, in *CreateApplicationAPIKeyBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResults, error) {
+	out := new(ApplicationBulkResults)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationAccess/CreateAPIKeyBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationAccessClient) ListAPIKeys(ctx context.Context, in *ListApplicationAPIKeysRequest, opts ...grpc.CallOption) (*APIKeys, error) {
 	out := new(APIKeys)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationAccess/ListAPIKeys", in, out, opts...)
@@ -426,6 +445,15 @@ func (c *applicationAccessClient) SetCollaborator(ctx context.Context, in *SetAp
 	return out, nil
 }
 
+func (c *applicationAccessClient) SetCollaboratorBulk(ctx context.Context, in *SetApplicationCollaboratorBulkRequest, opts ...grpc.CallOption) (*ApplicationBulkResults, error) {
+	out := new(ApplicationBulkResults)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationAccess/SetCollaboratorBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationAccessClient) ListCollaborators(ctx context.Context, in *ListApplicationCollaboratorsRequest, opts ...grpc.CallOption) (*Collaborators, error) {
 	out := new(Collaborators)
 	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationAccess/ListCollaborators", in, out, opts...)
@@ -439,6 +467,10 @@ func (c *applicationAccessClient) ListCollaborators(ctx context.Context, in *Lis
 type ApplicationAccessServer interface {
 	ListRights(context.Context, *ApplicationIdentifiers) (*Rights, error)
 	CreateAPIKey(context.Context, *CreateApplicationAPIKeyRequest) (*APIKey, error)
+	// Create an API key with the same name and rights for each of the given applications.
+	// The result of each application is reported separately; a failure for one application
+	// does not affect the others.
+	CreateAPIKeyBulk(context.Context, *CreateApplicationAPIKeyBulkRequest) (*ApplicationBulkResults, error)
 	ListAPIKeys(context.Context, *ListApplicationAPIKeysRequest) (*APIKeys, error)
 	GetAPIKey(context.Context, *GetApplicationAPIKeyRequest) (*APIKey, error)
 	// Update the rights of an existing application API key. To generate an API key,
@@ -452,6 +484,10 @@ type ApplicationAccessServer interface {
 	// have all assigned or/and removed rights.
 	// Setting a collaborator without rights, removes them.
 	SetCollaborator(context.Context, *SetApplicationCollaboratorRequest) (*types.Empty, error)
+	// Set the rights of a collaborator (member) on each of the given applications.
+	// The result of each application is reported separately; a failure for one application
+	// does not affect the others.
+	SetCollaboratorBulk(context.Context, *SetApplicationCollaboratorBulkRequest) (*ApplicationBulkResults, error)
 	ListCollaborators(context.Context, *ListApplicationCollaboratorsRequest) (*Collaborators, error)
 }
 
@@ -465,6 +501,9 @@ func (*UnimplementedApplicationAccessServer) ListRights(ctx context.Context, req
 func (*UnimplementedApplicationAccessServer) CreateAPIKey(ctx context.Context, req *CreateApplicationAPIKeyRequest) (*APIKey, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
 }
+func (*UnimplementedApplicationAccessServer) CreateAPIKeyBulk(ctx context.Context, req *CreateApplicationAPIKeyBulkRequest) (*ApplicationBulkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKeyBulk not implemented")
+}
 func (*UnimplementedApplicationAccessServer) ListAPIKeys(ctx context.Context, req *ListApplicationAPIKeysRequest) (*APIKeys, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAPIKeys not implemented")
 }
@@ -480,6 +519,9 @@ func (*UnimplementedApplicationAccessServer) GetCollaborator(ctx context.Context
 func (*UnimplementedApplicationAccessServer) SetCollaborator(ctx context.Context, req *SetApplicationCollaboratorRequest) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetCollaborator not implemented")
 }
+func (*UnimplementedApplicationAccessServer) SetCollaboratorBulk(ctx context.Context, req *SetApplicationCollaboratorBulkRequest) (*ApplicationBulkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollaboratorBulk not implemented")
+}
 func (*UnimplementedApplicationAccessServer) ListCollaborators(ctx context.Context, req *ListApplicationCollaboratorsRequest) (*Collaborators, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
 }
@@ -524,6 +566,24 @@ func _ApplicationAccess_CreateAPIKey_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationAccess_CreateAPIKeyBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationAPIKeyBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationAccessServer).CreateAPIKeyBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationAccess/CreateAPIKeyBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationAccessServer).CreateAPIKeyBulk(ctx, req.(*CreateApplicationAPIKeyBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationAccess_ListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApplicationAPIKeysRequest)
 	if err := dec(in); err != nil {
@@ -614,6 +674,24 @@ func _ApplicationAccess_SetCollaborator_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationAccess_SetCollaboratorBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicationCollaboratorBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationAccessServer).SetCollaboratorBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationAccess/SetCollaboratorBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationAccessServer).SetCollaboratorBulk(ctx, req.(*SetApplicationCollaboratorBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationAccess_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListApplicationCollaboratorsRequest)
 	if err := dec(in); err != nil {
@@ -644,6 +722,10 @@ var _ApplicationAccess_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAPIKey",
 			Handler:    _ApplicationAccess_CreateAPIKey_Handler,
 		},
+		{
+			MethodName: "CreateAPIKeyBulk",
+			Handler:    _ApplicationAccess_CreateAPIKeyBulk_Handler,
+		},
 		{
 			MethodName: "ListAPIKeys",
 			Handler:    _ApplicationAccess_ListAPIKeys_Handler,
@@ -664,6 +746,10 @@ var _ApplicationAccess_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetCollaborator",
 			Handler:    _ApplicationAccess_SetCollaborator_Handler,
 		},
+		{
+			MethodName: "SetCollaboratorBulk",
+			Handler:    _ApplicationAccess_SetCollaboratorBulk_Handler,
+		},
 		{
 			MethodName: "ListCollaborators",
 			Handler:    _ApplicationAccess_ListCollaborators_Handler,
@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 	time "time"
 
@@ -38,6 +39,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ApplicationDownlinkStatus_State int32
+
+const (
+	// The downlink message is queued on the Network Server.
+	ApplicationDownlinkStatus_QUEUED ApplicationDownlinkStatus_State = 0
+	// The downlink message has been sent by the Network Server and is awaiting acknowledgment.
+	ApplicationDownlinkStatus_SENT ApplicationDownlinkStatus_State = 1
+	// The downlink message has been acknowledged by the end device.
+	ApplicationDownlinkStatus_ACKNOWLEDGED ApplicationDownlinkStatus_State = 2
+	// The downlink message could not be sent or has been invalidated by the Network Server.
+	ApplicationDownlinkStatus_FAILED ApplicationDownlinkStatus_State = 3
+	// The downlink message has not been acknowledged within the configured number of uplink messages.
+	ApplicationDownlinkStatus_TIMED_OUT ApplicationDownlinkStatus_State = 4
+)
+
+var ApplicationDownlinkStatus_State_name = map[int32]string{
+	0: "QUEUED",
+	1: "SENT",
+	2: "ACKNOWLEDGED",
+	3: "FAILED",
+	4: "TIMED_OUT",
+}
+
+var ApplicationDownlinkStatus_State_value = map[string]int32{
+	"QUEUED":       0,
+	"SENT":         1,
+	"ACKNOWLEDGED": 2,
+	"FAILED":       3,
+	"TIMED_OUT":    4,
+}
+
+func (ApplicationDownlinkStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{4, 0}
+}
+
 type ApplicationLink struct {
 	// The address of the external Network Server where to link to.
 	// The typical format of the address is "host:port". If the port is omitted,
@@ -297,1366 +333,7085 @@ func (m *ApplicationLinkStats) GetDownlinkCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
-	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
-	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
-	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
-	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+// The delivery status of a confirmed downlink message.
+type ApplicationDownlinkStatus struct {
+	Downlink ApplicationDownlink             `protobuf:"bytes,1,opt,name=downlink,proto3" json:"downlink"`
+	State    ApplicationDownlinkStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=ttn.lorawan.v3.ApplicationDownlinkStatus_State" json:"state,omitempty"`
+	// Number of uplink messages received since the downlink message was first sent, without acknowledgment.
+	UplinksSinceSent     uint32    `protobuf:"varint,3,opt,name=uplinks_since_sent,json=uplinksSinceSent,proto3" json:"uplinks_since_sent,omitempty"`
+	UpdatedAt            time.Time `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *ApplicationDownlinkStatus) Reset()      { *m = ApplicationDownlinkStatus{} }
+func (*ApplicationDownlinkStatus) ProtoMessage() {}
+func (*ApplicationDownlinkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{4}
 }
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+func (m *ApplicationDownlinkStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-var fileDescriptor_df9d75a19dc066e1 = []byte{
-	// 1347 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6c, 0x13, 0xc7,
-	0x1a, 0xdf, 0xb1, 0x4d, 0x42, 0x86, 0xf7, 0x20, 0x2c, 0x3c, 0x5e, 0xe2, 0xc7, 0x1b, 0x47, 0x86,
-	0x22, 0xc7, 0xc2, 0xbb, 0xd4, 0xb4, 0x55, 0x9b, 0xaa, 0x8d, 0xd6, 0x10, 0x52, 0x4a, 0xa2, 0x82,
-	0x1d, 0x54, 0x29, 0xfc, 0xb1, 0x26, 0xde, 0xb1, 0xb3, 0xb2, 0xbd, 0xbb, 0xec, 0xcc, 0x26, 0x18,
-	0x88, 0x84, 0xaa, 0x8a, 0x22, 0x0e, 0x2d, 0x6a, 0x85, 0xc4, 0xb1, 0x6a, 0x2f, 0x1c, 0x51, 0x7b,
-	0x28, 0xa7, 0x96, 0x4b, 0x25, 0xd4, 0x5e, 0xa8, 0x7a, 0x41, 0xaa, 0x94, 0xe2, 0x75, 0x0f, 0x48,
-	0xbd, 0x70, 0xa4, 0x9c, 0xaa, 0x9d, 0x5d, 0xff, 0x89, 0x37, 0x09, 0x86, 0x22, 0xaa, 0xde, 0x66,
-	0x77, 0x7e, 0xdf, 0xef, 0xfb, 0x7d, 0xdf, 0xfc, 0xbe, 0xf1, 0x1a, 0x8e, 0x56, 0x0c, 0x0b, 0x2f,
-	0x62, 0x3d, 0x45, 0x19, 0x2e, 0x94, 0x65, 0x6c, 0x6a, 0x32, 0x36, 0xcd, 0x8a, 0x56, 0xc0, 0x4c,
-	0x33, 0x74, 0x4a, 0xac, 0x05, 0x62, 0x49, 0xa6, 0x65, 0x30, 0x43, 0xdc, 0xcc, 0x98, 0x2e, 0xf9,
-	0x70, 0x69, 0x61, 0x7f, 0x54, 0x29, 0x69, 0x6c, 0xde, 0x9e, 0x93, 0x0a, 0x46, 0x55, 0x26, 0xfa,
-	0x82, 0x51, 0x33, 0x2d, 0xe3, 0x6c, 0x4d, 0xe6, 0xe0, 0x42, 0xaa, 0x44, 0xf4, 0xd4, 0x02, 0xae,
-	0x68, 0x2a, 0x66, 0x44, 0x0e, 0x2c, 0x3c, 0xca, 0x68, 0xaa, 0x83, 0xa2, 0x64, 0x94, 0x0c, 0x2f,
-	0x78, 0xce, 0x2e, 0xf2, 0x27, 0xfe, 0xc0, 0x57, 0x3e, 0x7c, 0x67, 0xc9, 0x30, 0x4a, 0x15, 0xe2,
-	0xa9, 0xd4, 0x75, 0x83, 0x79, 0x22, 0xfd, 0xdd, 0xff, 0xf9, 0xbb, 0x2d, 0x0e, 0x52, 0x35, 0x59,
-	0xcd, 0xdf, 0x1c, 0xe9, 0xde, 0x2c, 0x6a, 0xa4, 0xa2, 0xe6, 0xab, 0x98, 0x96, 0x7d, 0x44, 0xac,
-	0x1b, 0xc1, 0xb4, 0x2a, 0xa1, 0x0c, 0x57, 0x4d, 0x1f, 0x10, 0x0f, 0xb6, 0x8a, 0xe8, 0x6a, 0x5e,
-	0x25, 0x0b, 0x5a, 0xa1, 0x59, 0xd0, 0xae, 0x20, 0x46, 0x53, 0x89, 0xce, 0xb4, 0xa2, 0x46, 0xac,
-	0xa6, 0xd0, 0x91, 0x20, 0xa8, 0x4a, 0x28, 0xc5, 0x25, 0xd2, 0x44, 0xec, 0x5c, 0x05, 0x71, 0x86,
-	0x31, 0x6f, 0x37, 0xfe, 0x47, 0x08, 0x6e, 0x51, 0xda, 0x87, 0x34, 0xa5, 0xe9, 0x65, 0xf1, 0x7b,
-	0x00, 0x77, 0xe8, 0x84, 0x2d, 0x1a, 0x56, 0x39, 0xef, 0x9d, 0x5a, 0x1e, 0xab, 0xaa, 0x45, 0x28,
-	0x1d, 0x02, 0x23, 0x20, 0x31, 0x90, 0xf9, 0x18, 0x3c, 0xce, 0x5c, 0x01, 0xd6, 0x47, 0x20, 0xfd,
-	0x21, 0x38, 0x9d, 0x18, 0x1f, 0x4b, 0x8c, 0x8f, 0x9d, 0xc0, 0xa9, 0x73, 0x4a, 0x6a, 0x76, 0x5f,
-	0xea, 0x8d, 0x53, 0x17, 0x3a, 0xd6, 0xed, 0xe5, 0xc9, 0xd4, 0xa9, 0x64, 0xc7, 0xc6, 0xe8, 0x49,
-	0x69, 0x34, 0xe9, 0xc6, 0x29, 0xa9, 0x59, 0x9c, 0x3a, 0xe7, 0xc5, 0xb5, 0xd7, 0xed, 0x25, 0x8f,
-	0x6b, 0x6f, 0x8c, 0x26, 0xc6, 0xc7, 0xc6, 0x4e, 0xb8, 0xab, 0xf3, 0x2f, 0xef, 0x7d, 0x75, 0x69,
-	0x74, 0x7c, 0xf7, 0x85, 0xd3, 0xbb, 0xb3, 0xdb, 0x7d, 0xb9, 0x39, 0xae, 0x56, 0xf1, 0xc4, 0x8a,
-	0x49, 0xd8, 0x8f, 0x4d, 0x2d, 0x5f, 0x26, 0xb5, 0xa1, 0x10, 0xd7, 0xbd, 0xf5, 0x71, 0x26, 0x62,
-	0x85, 0x06, 0x81, 0xb3, 0x1c, 0xeb, 0x53, 0x8e, 0x1e, 0x3e, 0x42, 0x6a, 0xd9, 0x3e, 0x6c, 0x6a,
-	0x47, 0x48, 0x4d, 0x7c, 0x1f, 0x8a, 0x2a, 0x29, 0x62, 0xbb, 0xc2, 0xf2, 0x45, 0xc3, 0xaa, 0x62,
-	0xc6, 0x88, 0x45, 0x87, 0xc2, 0x23, 0x20, 0xb1, 0x29, 0x9d, 0x90, 0x56, 0xba, 0x55, 0x9a, 0xf6,
-	0x3a, 0x7c, 0x14, 0xd7, 0x2a, 0x06, 0x56, 0x0f, 0xb5, 0xf0, 0xd9, 0xad, 0x3e, 0x47, 0xfb, 0x95,
-	0x38, 0x0c, 0xc3, 0xac, 0x42, 0x87, 0x22, 0x23, 0x20, 0xb1, 0x31, 0xd3, 0xef, 0x2c, 0xc7, 0xc2,
-	0x33, 0x53, 0xb9, 0xac, 0xfb, 0x2e, 0xfe, 0x1d, 0x80, 0xc3, 0x93, 0x84, 0x75, 0xb5, 0x3f, 0x4b,
-	0xce, 0xd8, 0x84, 0x32, 0x11, 0xc3, 0x2d, 0x1d, 0xd3, 0x93, 0xd7, 0x54, 0xaf, 0xfb, 0x9b, 0xd2,
-	0x7b, 0xba, 0xe5, 0x74, 0x10, 0x1c, 0x6e, 0x1b, 0x24, 0x33, 0xf8, 0x38, 0xb3, 0xe1, 0x0a, 0x08,
-	0x0d, 0x82, 0x3b, 0xcb, 0x31, 0xe1, 0xee, 0x72, 0x0c, 0x64, 0x37, 0xe3, 0x4e, 0x24, 0x15, 0xc7,
-	0x21, 0x6c, 0x5b, 0x97, 0xf7, 0x68, 0x53, 0x3a, 0x2a, 0x79, 0xde, 0x95, 0x9a, 0xde, 0x95, 0x0e,
-	0xb9, 0x90, 0x69, 0x4c, 0xcb, 0x99, 0x88, 0xcb, 0x94, 0x1d, 0x28, 0x36, 0x5f, 0xc4, 0x2f, 0x85,
-	0xe0, 0x70, 0xee, 0xef, 0xac, 0x60, 0x02, 0x46, 0x2a, 0x9a, 0xde, 0xd4, 0x1e, 0x5b, 0x87, 0xd7,
-	0x15, 0xb6, 0x0a, 0x21, 0x0f, 0xef, 0x6a, 0x44, 0xf8, 0xe9, 0x1b, 0xf1, 0x49, 0x04, 0x6e, 0xef,
-	0x4a, 0x96, 0x63, 0x98, 0x51, 0xf1, 0x2d, 0x38, 0xe0, 0x66, 0x20, 0x6a, 0x1e, 0x33, 0xbf, 0xfa,
-	0x20, 0xf1, 0x4c, 0xf3, 0x76, 0xc8, 0x44, 0xae, 0xfe, 0x1a, 0x03, 0xd9, 0x8d, 0x5e, 0x88, 0xc2,
-	0xd6, 0x1b, 0xc5, 0xd0, 0x3f, 0x69, 0x14, 0xdf, 0x83, 0xdb, 0x2a, 0x98, 0xb2, 0xbc, 0x6d, 0xe6,
-	0x2d, 0x52, 0x20, 0xda, 0x82, 0xd7, 0x90, 0x70, 0x8f, 0x0d, 0x19, 0x74, 0x83, 0x8f, 0x9b, 0x59,
-	0x3f, 0x54, 0x61, 0xe2, 0x30, 0xdc, 0x68, 0x9b, 0xf9, 0x82, 0x61, 0xeb, 0x8c, 0xcf, 0x56, 0x24,
-	0xdb, 0x6f, 0x9b, 0x07, 0xdc, 0x47, 0xf1, 0x14, 0x8c, 0xf2, 0x5c, 0xaa, 0xb1, 0xa8, 0xbb, 0x8d,
-	0x74, 0x07, 0x7a, 0x11, 0x5b, 0xaa, 0x97, 0x72, 0x43, 0x8f, 0x29, 0xff, 0xeb, 0x72, 0x1c, 0xf4,
-	0x29, 0x0e, 0x35, 0x19, 0x14, 0x26, 0xbe, 0x04, 0x37, 0xb7, 0x98, 0xbd, 0xfc, 0x7d, 0x3c, 0xff,
-	0xbf, 0x9b, 0x6f, 0xb9, 0x8a, 0xf4, 0x0f, 0x11, 0x18, 0x52, 0xa8, 0x78, 0x0d, 0xc0, 0xfe, 0x49,
-	0xc2, 0xf8, 0xbd, 0x3a, 0xda, 0x6d, 0xcf, 0x35, 0x87, 0x3f, 0xfa, 0x24, 0x27, 0xc7, 0xdf, 0xfe,
-	0xe0, 0xe7, 0xdf, 0x3e, 0x0b, 0xbd, 0x2e, 0xbe, 0x26, 0x63, 0xba, 0xe2, 0x57, 0x56, 0x3e, 0xdf,
-	0x35, 0x73, 0xd2, 0xca, 0xe7, 0x25, 0x99, 0x3b, 0xfe, 0x3a, 0x80, 0xfd, 0xb9, 0xb5, 0x74, 0xe5,
-	0x9e, 0x5d, 0x97, 0xc2, 0x75, 0xbd, 0x19, 0x7d, 0x46, 0x5d, 0x63, 0x20, 0x29, 0x5e, 0x80, 0xf0,
-	0x20, 0xa9, 0x10, 0x46, 0xb8, 0xb8, 0x1e, 0xef, 0x8a, 0xe8, 0x8e, 0xc0, 0x89, 0x4e, 0xb8, 0x3f,
-	0xd9, 0x71, 0x89, 0x0b, 0x4a, 0x24, 0xf7, 0x3c, 0x49, 0x90, 0xdf, 0x98, 0x4f, 0x01, 0xfc, 0x97,
-	0x7f, 0x60, 0xde, 0x04, 0xf7, 0x2a, 0x60, 0xf7, 0x13, 0x5a, 0xc3, 0xd9, 0xe2, 0xaf, 0x70, 0x39,
-	0x92, 0xb8, 0xb7, 0x37, 0x39, 0x32, 0x75, 0xa3, 0xd2, 0xbf, 0xf4, 0xc1, 0x0d, 0x8a, 0x69, 0x2a,
-	0x54, 0x9c, 0x81, 0x03, 0x39, 0x7b, 0x8e, 0x16, 0x2c, 0x6d, 0x8e, 0xf4, 0x2c, 0xed, 0xff, 0xeb,
-	0xe0, 0x8e, 0x9b, 0xfb, 0x80, 0xf8, 0x23, 0x80, 0x5b, 0x9b, 0x5e, 0x3f, 0x66, 0x13, 0x9b, 0x1c,
-	0xb5, 0xe9, 0xbc, 0x18, 0xa8, 0x68, 0x05, 0xa4, 0x69, 0x89, 0xb5, 0x1a, 0x7f, 0x96, 0x57, 0x6a,
-	0xc5, 0xab, 0xc1, 0x4a, 0xdb, 0x9f, 0x3a, 0xab, 0x18, 0x21, 0x68, 0x0c, 0x0f, 0x1a, 0x8c, 0x6b,
-	0x2d, 0x97, 0x64, 0x77, 0xf6, 0x64, 0xd3, 0xa6, 0xf3, 0xae, 0x81, 0x7e, 0x02, 0x70, 0x7b, 0x97,
-	0x54, 0xb3, 0x82, 0x0b, 0xe4, 0x2f, 0x16, 0x74, 0x9e, 0x17, 0x64, 0xc7, 0xcd, 0x17, 0x56, 0x90,
-	0xe5, 0xe9, 0x76, 0x6b, 0xfa, 0xba, 0xfb, 0x84, 0xa6, 0x34, 0xca, 0x82, 0x05, 0x4d, 0xe8, 0xea,
-	0x41, 0x4e, 0xd2, 0xab, 0x33, 0x9b, 0x9c, 0x34, 0x9e, 0xe5, 0xe5, 0x4d, 0x89, 0xef, 0x3e, 0xfd,
-	0xe4, 0xb6, 0xea, 0xe9, 0x2a, 0x40, 0xfc, 0x12, 0xc0, 0xff, 0x4c, 0x12, 0x36, 0x7d, 0x6c, 0x66,
-	0xe6, 0x80, 0xa1, 0xeb, 0xa4, 0xc0, 0x9d, 0xa9, 0x17, 0x8d, 0x9e, 0xad, 0x1b, 0x0f, 0x7c, 0x7b,
-	0x05, 0xb8, 0x7a, 0xbf, 0x0b, 0x97, 0xf8, 0x97, 0x6f, 0xaa, 0xd0, 0x0a, 0x4f, 0x69, 0x7a, 0xd1,
-	0x48, 0xff, 0x1e, 0x81, 0xdb, 0x14, 0xda, 0x6a, 0x5d, 0x96, 0x94, 0x34, 0xca, 0xac, 0x9a, 0xf8,
-	0x15, 0x80, 0xe1, 0x49, 0xc2, 0xc4, 0x5d, 0xab, 0xdc, 0xdb, 0x1d, 0x68, 0xcf, 0x35, 0xc3, 0x6b,
-	0x1e, 0x45, 0xbc, 0xcc, 0xf5, 0x11, 0xb1, 0xf0, 0x02, 0x8c, 0x23, 0x5e, 0x0a, 0xc1, 0x70, 0x6e,
-	0x35, 0xd1, 0xb9, 0xa7, 0x13, 0xfd, 0x2d, 0xe0, 0xaa, 0xbf, 0x01, 0xd1, 0x75, 0x65, 0x4b, 0xcf,
-	0x28, 0x5b, 0x5a, 0x29, 0x7b, 0x0c, 0x24, 0x67, 0xa7, 0xe3, 0xef, 0x3c, 0xaf, 0x4c, 0xee, 0xc4,
-	0x5c, 0x03, 0xb0, 0xcf, 0xfb, 0x1d, 0xe9, 0x71, 0x4c, 0xd6, 0x9a, 0xfb, 0x69, 0xde, 0x88, 0xc9,
-	0xe4, 0xc4, 0x73, 0x19, 0x8c, 0xcc, 0x17, 0xe0, 0x4e, 0x1d, 0x81, 0xbb, 0x75, 0x04, 0xee, 0xd5,
-	0x91, 0x70, 0xbf, 0x8e, 0x84, 0x07, 0x75, 0x24, 0x3c, 0xac, 0x23, 0xe1, 0x51, 0x1d, 0x81, 0x8b,
-	0x0e, 0x02, 0x97, 0x1d, 0x24, 0xdc, 0x70, 0x10, 0xb8, 0xe9, 0x20, 0xe1, 0x96, 0x83, 0x84, 0xdb,
-	0x0e, 0x12, 0xee, 0x38, 0x08, 0xdc, 0x75, 0x10, 0xb8, 0xe7, 0x20, 0xe1, 0xbe, 0x83, 0xc0, 0x03,
-	0x07, 0x09, 0x0f, 0x1d, 0x04, 0x1e, 0x39, 0x48, 0xb8, 0xd8, 0x40, 0xc2, 0xe5, 0x06, 0x02, 0x57,
-	0x1b, 0x48, 0xb8, 0xde, 0x40, 0xe0, 0xf3, 0x06, 0x12, 0x6e, 0x34, 0x90, 0x70, 0xb3, 0x81, 0xc0,
-	0xad, 0x06, 0x02, 0xb7, 0x1b, 0x08, 0xcc, 0xee, 0x2d, 0x19, 0x12, 0x9b, 0x27, 0x6c, 0x5e, 0xd3,
-	0x4b, 0x54, 0xf2, 0x3f, 0xd2, 0xe4, 0x95, 0xff, 0x0d, 0xcd, 0x72, 0x49, 0x66, 0x4c, 0x37, 0xe7,
-	0xe6, 0xfa, 0x78, 0x0f, 0xf6, 0xff, 0x19, 0x00, 0x00, 0xff, 0xff, 0x64, 0x22, 0xcb, 0xfb, 0xd3,
-	0x0f, 0x00, 0x00,
+func (m *ApplicationDownlinkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDownlinkStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationDownlinkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkStatus.Merge(m, src)
+}
+func (m *ApplicationDownlinkStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDownlinkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkStatus.DiscardUnknown(m)
 }
 
-func (this *ApplicationLink) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+var xxx_messageInfo_ApplicationDownlinkStatus proto.InternalMessageInfo
 
-	that1, ok := that.(*ApplicationLink)
-	if !ok {
-		that2, ok := that.(ApplicationLink)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NetworkServerAddress != that1.NetworkServerAddress {
-		return false
-	}
-	if this.APIKey != that1.APIKey {
-		return false
-	}
-	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
-		return false
-	}
-	if this.TLS != that1.TLS {
-		return false
+func (m *ApplicationDownlinkStatus) GetDownlink() ApplicationDownlink {
+	if m != nil {
+		return m.Downlink
 	}
-	return true
+	return ApplicationDownlink{}
 }
-func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(GetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+func (m *ApplicationDownlinkStatus) GetState() ApplicationDownlinkStatus_State {
+	if m != nil {
+		return m.State
 	}
-	return true
+	return ApplicationDownlinkStatus_QUEUED
 }
-func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SetApplicationLinkRequest)
-	if !ok {
-		that2, ok := that.(SetApplicationLinkRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if !this.ApplicationLink.Equal(&that1.ApplicationLink) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
-		return false
+func (m *ApplicationDownlinkStatus) GetUplinksSinceSent() uint32 {
+	if m != nil {
+		return m.UplinksSinceSent
 	}
-	return true
+	return 0
 }
-func (this *ApplicationLinkStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationLinkStats)
-	if !ok {
-		that2, ok := that.(ApplicationLinkStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.LinkedAt == nil {
-		if this.LinkedAt != nil {
-			return false
-		}
-	} else if !this.LinkedAt.Equal(*that1.LinkedAt) {
-		return false
-	}
-	if this.NetworkServerAddress != that1.NetworkServerAddress {
-		return false
-	}
-	if that1.LastUpReceivedAt == nil {
-		if this.LastUpReceivedAt != nil {
-			return false
-		}
-	} else if !this.LastUpReceivedAt.Equal(*that1.LastUpReceivedAt) {
-		return false
-	}
-	if this.UpCount != that1.UpCount {
-		return false
+func (m *ApplicationDownlinkStatus) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	if that1.LastDownlinkForwardedAt == nil {
-		if this.LastDownlinkForwardedAt != nil {
-			return false
+	return time.Time{}
+}
+
+type ApplicationDownlinkStatuses struct {
+	Statuses             []*ApplicationDownlinkStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ApplicationDownlinkStatuses) Reset()      { *m = ApplicationDownlinkStatuses{} }
+func (*ApplicationDownlinkStatuses) ProtoMessage() {}
+func (*ApplicationDownlinkStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{5}
+}
+func (m *ApplicationDownlinkStatuses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDownlinkStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationDownlinkStatuses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.LastDownlinkForwardedAt.Equal(*that1.LastDownlinkForwardedAt) {
-		return false
-	}
-	if this.DownlinkCount != that1.DownlinkCount {
-		return false
+		return b[:n], nil
 	}
-	return true
+}
+func (m *ApplicationDownlinkStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDownlinkStatuses.Merge(m, src)
+}
+func (m *ApplicationDownlinkStatuses) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDownlinkStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDownlinkStatuses.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ApplicationDownlinkStatuses proto.InternalMessageInfo
 
-// AsClient is the client API for As service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsClient interface {
-	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	// Set a link configuration from the Application Server a Network Server.
-	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
-	// To get link statistics or errors, use the `GetLinkStats` call.
-	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
-	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
-	// GetLinkStats returns the link statistics.
-	// This call returns a NotFound error code if there is no link for the given application identifiers.
-	// This call returns the error code of the link error if linking to a Network Server failed.
-	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+func (m *ApplicationDownlinkStatuses) GetStatuses() []*ApplicationDownlinkStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
 }
 
-type asClient struct {
-	cc *grpc.ClientConn
+// Number of messages transmitted at a data rate.
+type DataRateIndexCount struct {
+	DataRateIndex        DataRateIndex `protobuf:"varint,1,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	Count                uint64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func NewAsClient(cc *grpc.ClientConn) AsClient {
-	return &asClient{cc}
+func (m *DataRateIndexCount) Reset()      { *m = DataRateIndexCount{} }
+func (*DataRateIndexCount) ProtoMessage() {}
+func (*DataRateIndexCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{6}
 }
-
-func (c *asClient) GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error) {
-	out := new(ApplicationLink)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataRateIndexCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRateIndexCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRateIndexCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *DataRateIndexCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRateIndexCount.Merge(m, src)
+}
+func (m *DataRateIndexCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRateIndexCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRateIndexCount.DiscardUnknown(m)
 }
 
-func (c *asClient) SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error) {
-	out := new(ApplicationLink)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/SetLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DataRateIndexCount proto.InternalMessageInfo
+
+func (m *DataRateIndexCount) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
 	}
-	return out, nil
+	return DATA_RATE_0
 }
 
-func (c *asClient) DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/DeleteLink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DataRateIndexCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return out, nil
+	return 0
 }
 
-func (c *asClient) GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error) {
-	out := new(ApplicationLinkStats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetLinkStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Traffic statistics of application layer messages in one direction.
+type MessageTrafficStats struct {
+	// Number of messages.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Number of confirmed messages.
+	ConfirmedCount uint64 `protobuf:"varint,2,opt,name=confirmed_count,json=confirmedCount,proto3" json:"confirmed_count,omitempty"`
+	// Total size of the application payloads in bytes.
+	PayloadBytes uint64 `protobuf:"varint,3,opt,name=payload_bytes,json=payloadBytes,proto3" json:"payload_bytes,omitempty"`
+	// Size of the smallest application payload in bytes.
+	MinPayloadSize uint32 `protobuf:"varint,4,opt,name=min_payload_size,json=minPayloadSize,proto3" json:"min_payload_size,omitempty"`
+	// Size of the largest application payload in bytes.
+	MaxPayloadSize uint32 `protobuf:"varint,5,opt,name=max_payload_size,json=maxPayloadSize,proto3" json:"max_payload_size,omitempty"`
+	// Number of messages per data rate index, ordered by data rate index.
+	// This is only known for uplink messages.
+	DataRates            []*DataRateIndexCount `protobuf:"bytes,6,rep,name=data_rates,json=dataRates,proto3" json:"data_rates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-// AsServer is the server API for As service.
-type AsServer interface {
-	GetLink(context.Context, *GetApplicationLinkRequest) (*ApplicationLink, error)
-	// Set a link configuration from the Application Server a Network Server.
-	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
-	// To get link statistics or errors, use the `GetLinkStats` call.
-	SetLink(context.Context, *SetApplicationLinkRequest) (*ApplicationLink, error)
-	DeleteLink(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
-	// GetLinkStats returns the link statistics.
-	// This call returns a NotFound error code if there is no link for the given application identifiers.
-	// This call returns the error code of the link error if linking to a Network Server failed.
-	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
+func (m *MessageTrafficStats) Reset()      { *m = MessageTrafficStats{} }
+func (*MessageTrafficStats) ProtoMessage() {}
+func (*MessageTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{7}
 }
-
-// UnimplementedAsServer can be embedded to have forward compatible implementations.
-type UnimplementedAsServer struct {
+func (m *MessageTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedAsServer) GetLink(ctx context.Context, req *GetApplicationLinkRequest) (*ApplicationLink, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLink not implemented")
+func (m *MessageTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageTrafficStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAsServer) SetLink(ctx context.Context, req *SetApplicationLinkRequest) (*ApplicationLink, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLink not implemented")
+func (m *MessageTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageTrafficStats.Merge(m, src)
 }
-func (*UnimplementedAsServer) DeleteLink(ctx context.Context, req *ApplicationIdentifiers) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteLink not implemented")
+func (m *MessageTrafficStats) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAsServer) GetLinkStats(ctx context.Context, req *ApplicationIdentifiers) (*ApplicationLinkStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
+func (m *MessageTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageTrafficStats.DiscardUnknown(m)
 }
 
-func RegisterAsServer(s *grpc.Server, srv AsServer) {
-	s.RegisterService(&_As_serviceDesc, srv)
-}
+var xxx_messageInfo_MessageTrafficStats proto.InternalMessageInfo
 
-func _As_GetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationLinkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsServer).GetLink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.As/GetLink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsServer).GetLink(ctx, req.(*GetApplicationLinkRequest))
+func (m *MessageTrafficStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _As_SetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetApplicationLinkRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsServer).SetLink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.As/SetLink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsServer).SetLink(ctx, req.(*SetApplicationLinkRequest))
+func (m *MessageTrafficStats) GetConfirmedCount() uint64 {
+	if m != nil {
+		return m.ConfirmedCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _As_DeleteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsServer).DeleteLink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.As/DeleteLink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsServer).DeleteLink(ctx, req.(*ApplicationIdentifiers))
+func (m *MessageTrafficStats) GetPayloadBytes() uint64 {
+	if m != nil {
+		return m.PayloadBytes
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _As_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsServer).GetLinkStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.As/GetLinkStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsServer).GetLinkStats(ctx, req.(*ApplicationIdentifiers))
+func (m *MessageTrafficStats) GetMinPayloadSize() uint32 {
+	if m != nil {
+		return m.MinPayloadSize
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _As_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.As",
-	HandlerType: (*AsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetLink",
-			Handler:    _As_GetLink_Handler,
-		},
-		{
-			MethodName: "SetLink",
-			Handler:    _As_SetLink_Handler,
-		},
-		{
-			MethodName: "DeleteLink",
-			Handler:    _As_DeleteLink_Handler,
-		},
-		{
-			MethodName: "GetLinkStats",
-			Handler:    _As_GetLinkStats_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/applicationserver.proto",
+func (m *MessageTrafficStats) GetMaxPayloadSize() uint32 {
+	if m != nil {
+		return m.MaxPayloadSize
+	}
+	return 0
 }
 
-// AppAsClient is the client API for AppAs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AppAsClient interface {
-	Subscribe(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (AppAs_SubscribeClient, error)
-	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
-	GetMQTTConnectionInfo(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+func (m *MessageTrafficStats) GetDataRates() []*DataRateIndexCount {
+	if m != nil {
+		return m.DataRates
+	}
+	return nil
 }
 
-type appAsClient struct {
-	cc *grpc.ClientConn
+// Traffic statistics of the end devices of an application that share a device profile.
+type DeviceProfileTrafficStats struct {
+	// Version identifiers of the end devices.
+	// This is empty for end devices without version identifiers.
+	VersionIDs           *EndDeviceVersionIdentifiers `protobuf:"bytes,1,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	Uplink               *MessageTrafficStats         `protobuf:"bytes,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink             *MessageTrafficStats         `protobuf:"bytes,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func NewAppAsClient(cc *grpc.ClientConn) AppAsClient {
-	return &appAsClient{cc}
+func (m *DeviceProfileTrafficStats) Reset()      { *m = DeviceProfileTrafficStats{} }
+func (*DeviceProfileTrafficStats) ProtoMessage() {}
+func (*DeviceProfileTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{8}
 }
-
-func (c *appAsClient) Subscribe(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (AppAs_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AppAs_serviceDesc.Streams[0], "/ttn.lorawan.v3.AppAs/Subscribe", opts...)
-	if err != nil {
-		return nil, err
+func (m *DeviceProfileTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceProfileTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceProfileTrafficStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &appAsSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+}
+func (m *DeviceProfileTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceProfileTrafficStats.Merge(m, src)
+}
+func (m *DeviceProfileTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceProfileTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceProfileTrafficStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceProfileTrafficStats proto.InternalMessageInfo
+
+func (m *DeviceProfileTrafficStats) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *DeviceProfileTrafficStats) GetUplink() *MessageTrafficStats {
+	if m != nil {
+		return m.Uplink
 	}
-	return x, nil
+	return nil
 }
 
-type AppAs_SubscribeClient interface {
-	Recv() (*ApplicationUp, error)
-	grpc.ClientStream
+func (m *DeviceProfileTrafficStats) GetDownlink() *MessageTrafficStats {
+	if m != nil {
+		return m.Downlink
+	}
+	return nil
 }
 
-type appAsSubscribeClient struct {
-	grpc.ClientStream
+// Traffic statistics of an application as monitored by the Application Server.
+type ApplicationTrafficStats struct {
+	// Timestamp since when the statistics are collected. This is when the link was established.
+	StartedAt time.Time            `protobuf:"bytes,1,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	Uplink    *MessageTrafficStats `protobuf:"bytes,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	Downlink  *MessageTrafficStats `protobuf:"bytes,3,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	// Traffic statistics per device profile, ordered by version identifiers.
+	DeviceProfiles       []*DeviceProfileTrafficStats `protobuf:"bytes,4,rep,name=device_profiles,json=deviceProfiles,proto3" json:"device_profiles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
-func (x *appAsSubscribeClient) Recv() (*ApplicationUp, error) {
-	m := new(ApplicationUp)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *ApplicationTrafficStats) Reset()      { *m = ApplicationTrafficStats{} }
+func (*ApplicationTrafficStats) ProtoMessage() {}
+func (*ApplicationTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{9}
+}
+func (m *ApplicationTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationTrafficStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *ApplicationTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationTrafficStats.Merge(m, src)
+}
+func (m *ApplicationTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationTrafficStats.DiscardUnknown(m)
 }
 
-func (c *appAsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueuePush", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplicationTrafficStats proto.InternalMessageInfo
+
+func (m *ApplicationTrafficStats) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *appAsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationTrafficStats) GetUplink() *MessageTrafficStats {
+	if m != nil {
+		return m.Uplink
 	}
-	return out, nil
+	return nil
 }
 
-func (c *appAsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
-	out := new(ApplicationDownlinks)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueueList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationTrafficStats) GetDownlink() *MessageTrafficStats {
+	if m != nil {
+		return m.Downlink
 	}
-	return out, nil
+	return nil
 }
 
-func (c *appAsClient) GetMQTTConnectionInfo(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
-	out := new(MQTTConnectionInfo)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ApplicationTrafficStats) GetDeviceProfiles() []*DeviceProfileTrafficStats {
+	if m != nil {
+		return m.DeviceProfiles
 	}
-	return out, nil
+	return nil
 }
 
-// AppAsServer is the server API for AppAs service.
-type AppAsServer interface {
-	Subscribe(*ApplicationIdentifiers, AppAs_SubscribeServer) error
-	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
-	GetMQTTConnectionInfo(context.Context, *ApplicationIdentifiers) (*MQTTConnectionInfo, error)
+// The current state of an end device, as maintained by the Application Server.
+type EndDeviceState struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Time when the last uplink message was received.
+	LastSeenAt *time.Time `protobuf:"bytes,2,opt,name=last_seen_at,json=lastSeenAt,proto3,stdtime" json:"last_seen_at,omitempty"`
+	// Decoded payload of the last uplink message that had a decoded payload.
+	LastDecodedPayload *types.Struct `protobuf:"bytes,3,opt,name=last_decoded_payload,json=lastDecodedPayload,proto3" json:"last_decoded_payload,omitempty"`
+	// Time when the last decoded payload was received.
+	LastDecodedPayloadReceivedAt *time.Time `protobuf:"bytes,4,opt,name=last_decoded_payload_received_at,json=lastDecodedPayloadReceivedAt,proto3,stdtime" json:"last_decoded_payload_received_at,omitempty"`
+	// FPort of the last decoded payload.
+	LastDecodedPayloadFPort uint32 `protobuf:"varint,5,opt,name=last_decoded_payload_f_port,json=lastDecodedPayloadFPort,proto3" json:"last_decoded_payload_f_port,omitempty"`
+	// Last location of the end device, as solved by a location solver.
+	LastLocation *ApplicationLocation `protobuf:"bytes,6,opt,name=last_location,json=lastLocation,proto3" json:"last_location,omitempty"`
+	// Battery level from the `battery` field of the last decoded payload that had one.
+	// The unit depends on the payload formatter of the end device.
+	Battery              *types.FloatValue `protobuf:"bytes,7,opt,name=battery,proto3" json:"battery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-// UnimplementedAppAsServer can be embedded to have forward compatible implementations.
-type UnimplementedAppAsServer struct {
+func (m *EndDeviceState) Reset()      { *m = EndDeviceState{} }
+func (*EndDeviceState) ProtoMessage() {}
+func (*EndDeviceState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{10}
 }
-
-func (*UnimplementedAppAsServer) Subscribe(req *ApplicationIdentifiers, srv AppAs_SubscribeServer) error {
-	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+func (m *EndDeviceState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAppAsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+func (m *EndDeviceState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAppAsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+func (m *EndDeviceState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceState.Merge(m, src)
 }
-func (*UnimplementedAppAsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+func (m *EndDeviceState) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedAppAsServer) GetMQTTConnectionInfo(ctx context.Context, req *ApplicationIdentifiers) (*MQTTConnectionInfo, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+func (m *EndDeviceState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceState.DiscardUnknown(m)
 }
 
-func RegisterAppAsServer(s *grpc.Server, srv AppAsServer) {
-	s.RegisterService(&_AppAs_serviceDesc, srv)
-}
+var xxx_messageInfo_EndDeviceState proto.InternalMessageInfo
 
-func _AppAs_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ApplicationIdentifiers)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *EndDeviceState) GetLastSeenAt() *time.Time {
+	if m != nil {
+		return m.LastSeenAt
 	}
-	return srv.(AppAsServer).Subscribe(m, &appAsSubscribeServer{stream})
+	return nil
 }
 
-type AppAs_SubscribeServer interface {
-	Send(*ApplicationUp) error
-	grpc.ServerStream
+func (m *EndDeviceState) GetLastDecodedPayload() *types.Struct {
+	if m != nil {
+		return m.LastDecodedPayload
+	}
+	return nil
 }
 
-type appAsSubscribeServer struct {
-	grpc.ServerStream
+func (m *EndDeviceState) GetLastDecodedPayloadReceivedAt() *time.Time {
+	if m != nil {
+		return m.LastDecodedPayloadReceivedAt
+	}
+	return nil
 }
 
-func (x *appAsSubscribeServer) Send(m *ApplicationUp) error {
-	return x.ServerStream.SendMsg(m)
+func (m *EndDeviceState) GetLastDecodedPayloadFPort() uint32 {
+	if m != nil {
+		return m.LastDecodedPayloadFPort
+	}
+	return 0
 }
 
-func _AppAs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AppAsServer).DownlinkQueuePush(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueuePush",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppAsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+func (m *EndDeviceState) GetLastLocation() *ApplicationLocation {
+	if m != nil {
+		return m.LastLocation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AppAs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AppAsServer).DownlinkQueueReplace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppAsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+func (m *EndDeviceState) GetBattery() *types.FloatValue {
+	if m != nil {
+		return m.Battery
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AppAs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AppAsServer).DownlinkQueueList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueueList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppAsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
-	}
-	return interceptor(ctx, in, info, handler)
+type DecodeUplinkRequest struct {
+	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
+	// Version identifiers of the end device, used by the Device Repository payload formatter.
+	// If not set, the version identifiers of the end device are used.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Uplink message with the decrypted FRMPayload and FPort to decode.
+	Uplink ApplicationUplink `protobuf:"bytes,3,opt,name=uplink,proto3" json:"uplink"`
+	// Payload formatter to decode the uplink message with.
+	// If not set, the uplink payload formatter of the end device, or the default uplink payload formatter of the application link is used.
+	Formatter PayloadFormatter `protobuf:"varint,4,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// Parameter of the payload formatter, like the JavaScript code.
+	Parameter            string   `protobuf:"bytes,5,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AppAs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AppAsServer).GetMQTTConnectionInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AppAsServer).GetMQTTConnectionInfo(ctx, req.(*ApplicationIdentifiers))
+func (m *DecodeUplinkRequest) Reset()      { *m = DecodeUplinkRequest{} }
+func (*DecodeUplinkRequest) ProtoMessage() {}
+func (*DecodeUplinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{11}
+}
+func (m *DecodeUplinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodeUplinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeUplinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _AppAs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.AppAs",
-	HandlerType: (*AppAsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DownlinkQueuePush",
-			Handler:    _AppAs_DownlinkQueuePush_Handler,
-		},
-		{
-			MethodName: "DownlinkQueueReplace",
-			Handler:    _AppAs_DownlinkQueueReplace_Handler,
-		},
-		{
-			MethodName: "DownlinkQueueList",
-			Handler:    _AppAs_DownlinkQueueList_Handler,
-		},
-		{
-			MethodName: "GetMQTTConnectionInfo",
-			Handler:    _AppAs_GetMQTTConnectionInfo_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Subscribe",
-			Handler:       _AppAs_Subscribe_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/applicationserver.proto",
+func (m *DecodeUplinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeUplinkRequest.Merge(m, src)
 }
-
-// AsEndDeviceRegistryClient is the client API for AsEndDeviceRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsEndDeviceRegistryClient interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *DecodeUplinkRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type asEndDeviceRegistryClient struct {
-	cc *grpc.ClientConn
+func (m *DecodeUplinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeUplinkRequest.DiscardUnknown(m)
 }
 
-func NewAsEndDeviceRegistryClient(cc *grpc.ClientConn) AsEndDeviceRegistryClient {
-	return &asEndDeviceRegistryClient{cc}
-}
+var xxx_messageInfo_DecodeUplinkRequest proto.InternalMessageInfo
 
-func (c *asEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DecodeUplinkRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *asEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DecodeUplinkRequest) GetUplink() ApplicationUplink {
+	if m != nil {
+		return m.Uplink
 	}
-	return out, nil
+	return ApplicationUplink{}
 }
 
-func (c *asEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DecodeUplinkRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
 	}
-	return out, nil
+	return PayloadFormatter_FORMATTER_NONE
 }
 
-// AsEndDeviceRegistryServer is the server API for AsEndDeviceRegistry service.
-type AsEndDeviceRegistryServer interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+func (m *DecodeUplinkRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
 }
 
-// UnimplementedAsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
-type UnimplementedAsEndDeviceRegistryServer struct {
+type DecodeUplinkResponse struct {
+	// Uplink message with the decoded payload, and the normalized payload and its warnings.
+	Uplink ApplicationUplink `protobuf:"bytes,1,opt,name=uplink,proto3" json:"uplink"`
+	// Error of the payload formatter, if the uplink message could not be decoded.
+	Error                *ErrorDetails `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (*UnimplementedAsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *DecodeUplinkResponse) Reset()      { *m = DecodeUplinkResponse{} }
+func (*DecodeUplinkResponse) ProtoMessage() {}
+func (*DecodeUplinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{12}
 }
-func (*UnimplementedAsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *DecodeUplinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *DecodeUplinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodeUplinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterAsEndDeviceRegistryServer(s *grpc.Server, srv AsEndDeviceRegistryServer) {
-	s.RegisterService(&_AsEndDeviceRegistry_serviceDesc, srv)
+func (m *DecodeUplinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodeUplinkResponse.Merge(m, src)
+}
+func (m *DecodeUplinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodeUplinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodeUplinkResponse.DiscardUnknown(m)
 }
 
-func _AsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsEndDeviceRegistryServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
+var xxx_messageInfo_DecodeUplinkResponse proto.InternalMessageInfo
+
+func (m *DecodeUplinkResponse) GetUplink() ApplicationUplink {
+	if m != nil {
+		return m.Uplink
 	}
-	return interceptor(ctx, in, info, handler)
+	return ApplicationUplink{}
 }
 
-func _AsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DecodeUplinkResponse) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	if interceptor == nil {
-		return srv.(AsEndDeviceRegistryServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsEndDeviceRegistryServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
-	}
-	return interceptor(ctx, in, info, handler)
+type EncodeDownlinkRequest struct {
+	EndDeviceIdentifiers EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids"`
+	// Version identifiers of the end device, used by the Device Repository payload formatter.
+	// If not set, the version identifiers of the end device are used.
+	VersionIDs *EndDeviceVersionIdentifiers `protobuf:"bytes,2,opt,name=version_ids,json=versionIds,proto3" json:"version_ids,omitempty"`
+	// Downlink message with the decoded payload and FPort to encode.
+	Downlink ApplicationDownlink `protobuf:"bytes,3,opt,name=downlink,proto3" json:"downlink"`
+	// Payload formatter to encode the downlink message with.
+	// If not set, the downlink payload formatter of the end device, or the default downlink payload formatter of the application link is used.
+	Formatter PayloadFormatter `protobuf:"varint,4,opt,name=formatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"formatter,omitempty"`
+	// Parameter of the payload formatter, like the JavaScript code.
+	Parameter            string   `protobuf:"bytes,5,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.AsEndDeviceRegistry",
-	HandlerType: (*AsEndDeviceRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _AsEndDeviceRegistry_Get_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _AsEndDeviceRegistry_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _AsEndDeviceRegistry_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/applicationserver.proto",
+func (m *EncodeDownlinkRequest) Reset()      { *m = EncodeDownlinkRequest{} }
+func (*EncodeDownlinkRequest) ProtoMessage() {}
+func (*EncodeDownlinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{13}
 }
-
-func (m *ApplicationLink) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncodeDownlinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodeDownlinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodeDownlinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ApplicationLink) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EncodeDownlinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeDownlinkRequest.Merge(m, src)
+}
+func (m *EncodeDownlinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodeDownlinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeDownlinkRequest.DiscardUnknown(m)
 }
 
-func (m *ApplicationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TLS {
-		i--
-		if m.TLS {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+var xxx_messageInfo_EncodeDownlinkRequest proto.InternalMessageInfo
+
+func (m *EncodeDownlinkRequest) GetEndDeviceIdentifiers() EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIdentifiers
 	}
-	if m.DefaultFormatters != nil {
-		{
-			size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return EndDeviceIdentifiers{}
+}
+
+func (m *EncodeDownlinkRequest) GetVersionIDs() *EndDeviceVersionIdentifiers {
+	if m != nil {
+		return m.VersionIDs
 	}
-	if len(m.APIKey) > 0 {
-		i -= len(m.APIKey)
-		copy(dAtA[i:], m.APIKey)
-		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.APIKey)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EncodeDownlinkRequest) GetDownlink() ApplicationDownlink {
+	if m != nil {
+		return m.Downlink
 	}
-	if len(m.NetworkServerAddress) > 0 {
-		i -= len(m.NetworkServerAddress)
-		copy(dAtA[i:], m.NetworkServerAddress)
-		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.NetworkServerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ApplicationDownlink{}
+}
+
+func (m *EncodeDownlinkRequest) GetFormatter() PayloadFormatter {
+	if m != nil {
+		return m.Formatter
 	}
-	return len(dAtA) - i, nil
+	return PayloadFormatter_FORMATTER_NONE
 }
 
-func (m *GetApplicationLinkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncodeDownlinkRequest) GetParameter() string {
+	if m != nil {
+		return m.Parameter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EncodeDownlinkResponse struct {
+	// Downlink message with the encoded FRMPayload.
+	Downlink ApplicationDownlink `protobuf:"bytes,1,opt,name=downlink,proto3" json:"downlink"`
+	// Error of the payload formatter, if the downlink message could not be encoded.
+	// Errors of fields of the decoded payload are in the details of the error as EncodeDownlinkErrorDetails.
+	Error                *ErrorDetails `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetApplicationLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+func (m *EncodeDownlinkResponse) Reset()      { *m = EncodeDownlinkResponse{} }
+func (*EncodeDownlinkResponse) ProtoMessage() {}
+func (*EncodeDownlinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{14}
+}
+func (m *EncodeDownlinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodeDownlinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodeDownlinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+}
+func (m *EncodeDownlinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeDownlinkResponse.Merge(m, src)
+}
+func (m *EncodeDownlinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodeDownlinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeDownlinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EncodeDownlinkResponse proto.InternalMessageInfo
+
+func (m *EncodeDownlinkResponse) GetDownlink() ApplicationDownlink {
+	if m != nil {
+		return m.Downlink
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ApplicationDownlink{}
 }
 
-func (m *SetApplicationLinkRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EncodeDownlinkResponse) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EncodeDownlinkErrorDetails struct {
+	// Errors of fields of the decoded payload. The path of the field is in the field attribute of the error.
+	FieldErrors          []*ErrorDetails `protobuf:"bytes,1,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *SetApplicationLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.ApplicationLink.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+func (m *EncodeDownlinkErrorDetails) Reset()      { *m = EncodeDownlinkErrorDetails{} }
+func (*EncodeDownlinkErrorDetails) ProtoMessage() {}
+func (*EncodeDownlinkErrorDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{15}
+}
+func (m *EncodeDownlinkErrorDetails) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EncodeDownlinkErrorDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncodeDownlinkErrorDetails.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EncodeDownlinkErrorDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncodeDownlinkErrorDetails.Merge(m, src)
+}
+func (m *EncodeDownlinkErrorDetails) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncodeDownlinkErrorDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncodeDownlinkErrorDetails.DiscardUnknown(m)
 }
 
-func (m *ApplicationLinkStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EncodeDownlinkErrorDetails proto.InternalMessageInfo
+
+func (m *EncodeDownlinkErrorDetails) GetFieldErrors() []*ErrorDetails {
+	if m != nil {
+		return m.FieldErrors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ApplicationLinkStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A stored version of the payload formatters of an end device or of the default payload formatters of an application link.
+type PayloadFormattersVersion struct {
+	// Version number, incremented on every change of the payload formatters.
+	Version              uint32                   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Formatters           MessagePayloadFormatters `protobuf:"bytes,2,opt,name=formatters,proto3" json:"formatters"`
+	CreatedAt            time.Time                `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ApplicationLinkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DownlinkCount != 0 {
-		i = encodeVarintApplicationserver(dAtA, i, m.DownlinkCount)
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.LastDownlinkForwardedAt != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkForwardedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintApplicationserver(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.UpCount != 0 {
-		i = encodeVarintApplicationserver(dAtA, i, m.UpCount)
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.LastUpReceivedAt != nil {
-		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintApplicationserver(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NetworkServerAddress) > 0 {
-		i -= len(m.NetworkServerAddress)
-		copy(dAtA[i:], m.NetworkServerAddress)
-		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.NetworkServerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LinkedAt != nil {
-		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LinkedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt):])
-		if err9 != nil {
-			return 0, err9
+func (m *PayloadFormattersVersion) Reset()      { *m = PayloadFormattersVersion{} }
+func (*PayloadFormattersVersion) ProtoMessage() {}
+func (*PayloadFormattersVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{16}
+}
+func (m *PayloadFormattersVersion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadFormattersVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadFormattersVersion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n9
-		i = encodeVarintApplicationserver(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PayloadFormattersVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadFormattersVersion.Merge(m, src)
+}
+func (m *PayloadFormattersVersion) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadFormattersVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadFormattersVersion.DiscardUnknown(m)
 }
 
-func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PayloadFormattersVersion proto.InternalMessageInfo
+
+func (m *PayloadFormattersVersion) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
-	this := &ApplicationLink{}
-	this.NetworkServerAddress = randStringApplicationserver(r)
-	this.APIKey = randStringApplicationserver(r)
-	if r.Intn(5) != 0 {
-		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
-	}
-	this.TLS = bool(r.Intn(2) == 0)
-	if !easy && r.Intn(10) != 0 {
+
+func (m *PayloadFormattersVersion) GetFormatters() MessagePayloadFormatters {
+	if m != nil {
+		return m.Formatters
 	}
-	return this
+	return MessagePayloadFormatters{}
 }
 
-func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
-	this := &GetApplicationLinkRequest{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	v2 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v2
-	if !easy && r.Intn(10) != 0 {
+func (m *PayloadFormattersVersion) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return this
+	return time.Time{}
 }
 
-func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
-	this := &SetApplicationLinkRequest{}
-	v3 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v3
-	v4 := NewPopulatedApplicationLink(r, easy)
-	this.ApplicationLink = *v4
-	v5 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v5
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+type PayloadFormattersVersions struct {
+	// Versions, newest first.
+	Versions             []*PayloadFormattersVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
-	this := &ApplicationLinkStats{}
-	if r.Intn(5) != 0 {
-		this.LinkedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	this.NetworkServerAddress = randStringApplicationserver(r)
-	if r.Intn(5) != 0 {
-		this.LastUpReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	}
-	this.UpCount = uint64(r.Uint32())
-	if r.Intn(5) != 0 {
-		this.LastDownlinkForwardedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+func (m *PayloadFormattersVersions) Reset()      { *m = PayloadFormattersVersions{} }
+func (*PayloadFormattersVersions) ProtoMessage() {}
+func (*PayloadFormattersVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{17}
+}
+func (m *PayloadFormattersVersions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadFormattersVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadFormattersVersions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	this.DownlinkCount = uint64(r.Uint32())
-	if !easy && r.Intn(10) != 0 {
+}
+func (m *PayloadFormattersVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadFormattersVersions.Merge(m, src)
+}
+func (m *PayloadFormattersVersions) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadFormattersVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadFormattersVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadFormattersVersions proto.InternalMessageInfo
+
+func (m *PayloadFormattersVersions) GetVersions() []*PayloadFormattersVersion {
+	if m != nil {
+		return m.Versions
 	}
-	return this
+	return nil
 }
 
-type randyApplicationserver interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
+type ListPayloadFormattersVersionsRequest struct {
+	ApplicationIdentifiers ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// ID of the end device. If empty, the versions of the default payload formatters of the application link are listed.
+	DeviceID             string   `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
-	}
-	return rune(ru + 61)
+func (m *ListPayloadFormattersVersionsRequest) Reset()      { *m = ListPayloadFormattersVersionsRequest{} }
+func (*ListPayloadFormattersVersionsRequest) ProtoMessage() {}
+func (*ListPayloadFormattersVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{18}
 }
-func randStringApplicationserver(r randyApplicationserver) string {
-	v6 := r.Intn(100)
-	tmps := make([]rune, v6)
-	for i := 0; i < v6; i++ {
-		tmps[i] = randUTF8RuneApplicationserver(r)
-	}
-	return string(tmps)
+func (m *ListPayloadFormattersVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func randUnrecognizedApplicationserver(r randyApplicationserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *ListPayloadFormattersVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListPayloadFormattersVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApplicationserver(dAtA, r, fieldNumber, wire)
+		return b[:n], nil
 	}
-	return dAtA
 }
-func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		v7 := r.Int63()
-		if r.Intn(2) == 0 {
-			v7 *= -1
-		}
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v7))
-	case 1:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
+func (m *ListPayloadFormattersVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPayloadFormattersVersionsRequest.Merge(m, src)
 }
-func encodeVarintPopulateApplicationserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
+func (m *ListPayloadFormattersVersionsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (m *ApplicationLink) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NetworkServerAddress)
-	if l > 0 {
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	l = len(m.APIKey)
-	if l > 0 {
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	if m.DefaultFormatters != nil {
-		l = m.DefaultFormatters.Size()
-		n += 1 + l + sovApplicationserver(uint64(l))
-	}
-	if m.TLS {
-		n += 2
-	}
-	return n
+func (m *ListPayloadFormattersVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPayloadFormattersVersionsRequest.DiscardUnknown(m)
 }
 
-func (m *GetApplicationLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_ListPayloadFormattersVersionsRequest proto.InternalMessageInfo
+
+func (m *ListPayloadFormattersVersionsRequest) GetApplicationIdentifiers() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIdentifiers
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	return n
+	return ApplicationIdentifiers{}
 }
 
-func (m *SetApplicationLinkRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListPayloadFormattersVersionsRequest) GetDeviceID() string {
+	if m != nil {
+		return m.DeviceID
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.ApplicationLink.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserver(uint64(l))
-	return n
+	return ""
 }
 
-func (m *ApplicationLinkStats) Size() (n int) {
-	if m == nil {
-		return 0
+type RollbackPayloadFormattersRequest struct {
+	ApplicationIdentifiers ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// ID of the end device. If empty, the default payload formatters of the application link are rolled back.
+	DeviceID string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	// Version of the payload formatters to roll back to.
+	Version              uint32   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RollbackPayloadFormattersRequest) Reset()      { *m = RollbackPayloadFormattersRequest{} }
+func (*RollbackPayloadFormattersRequest) ProtoMessage() {}
+func (*RollbackPayloadFormattersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df9d75a19dc066e1, []int{19}
+}
+func (m *RollbackPayloadFormattersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackPayloadFormattersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackPayloadFormattersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.LinkedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
+}
+func (m *RollbackPayloadFormattersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackPayloadFormattersRequest.Merge(m, src)
+}
+func (m *RollbackPayloadFormattersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackPayloadFormattersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackPayloadFormattersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackPayloadFormattersRequest proto.InternalMessageInfo
+
+func (m *RollbackPayloadFormattersRequest) GetApplicationIdentifiers() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIdentifiers
 	}
-	l = len(m.NetworkServerAddress)
-	if l > 0 {
-		n += 1 + l + sovApplicationserver(uint64(l))
+	return ApplicationIdentifiers{}
+}
+
+func (m *RollbackPayloadFormattersRequest) GetDeviceID() string {
+	if m != nil {
+		return m.DeviceID
 	}
-	if m.LastUpReceivedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
+	return ""
+}
+
+func (m *RollbackPayloadFormattersRequest) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
 	}
-	if m.UpCount != 0 {
-		n += 1 + sovApplicationserver(m.UpCount)
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkStatus_State", ApplicationDownlinkStatus_State_name, ApplicationDownlinkStatus_State_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationDownlinkStatus_State", ApplicationDownlinkStatus_State_name, ApplicationDownlinkStatus_State_value)
+	proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	golang_proto.RegisterType((*ApplicationLink)(nil), "ttn.lorawan.v3.ApplicationLink")
+	proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	golang_proto.RegisterType((*GetApplicationLinkRequest)(nil), "ttn.lorawan.v3.GetApplicationLinkRequest")
+	proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	golang_proto.RegisterType((*SetApplicationLinkRequest)(nil), "ttn.lorawan.v3.SetApplicationLinkRequest")
+	proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	golang_proto.RegisterType((*ApplicationLinkStats)(nil), "ttn.lorawan.v3.ApplicationLinkStats")
+	proto.RegisterType((*ApplicationDownlinkStatus)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus")
+	golang_proto.RegisterType((*ApplicationDownlinkStatus)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatus")
+	proto.RegisterType((*ApplicationDownlinkStatuses)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatuses")
+	golang_proto.RegisterType((*ApplicationDownlinkStatuses)(nil), "ttn.lorawan.v3.ApplicationDownlinkStatuses")
+	proto.RegisterType((*DataRateIndexCount)(nil), "ttn.lorawan.v3.DataRateIndexCount")
+	golang_proto.RegisterType((*DataRateIndexCount)(nil), "ttn.lorawan.v3.DataRateIndexCount")
+	proto.RegisterType((*MessageTrafficStats)(nil), "ttn.lorawan.v3.MessageTrafficStats")
+	golang_proto.RegisterType((*MessageTrafficStats)(nil), "ttn.lorawan.v3.MessageTrafficStats")
+	proto.RegisterType((*DeviceProfileTrafficStats)(nil), "ttn.lorawan.v3.DeviceProfileTrafficStats")
+	golang_proto.RegisterType((*DeviceProfileTrafficStats)(nil), "ttn.lorawan.v3.DeviceProfileTrafficStats")
+	proto.RegisterType((*ApplicationTrafficStats)(nil), "ttn.lorawan.v3.ApplicationTrafficStats")
+	golang_proto.RegisterType((*ApplicationTrafficStats)(nil), "ttn.lorawan.v3.ApplicationTrafficStats")
+	proto.RegisterType((*EndDeviceState)(nil), "ttn.lorawan.v3.EndDeviceState")
+	golang_proto.RegisterType((*EndDeviceState)(nil), "ttn.lorawan.v3.EndDeviceState")
+	proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	golang_proto.RegisterType((*DecodeUplinkRequest)(nil), "ttn.lorawan.v3.DecodeUplinkRequest")
+	proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	golang_proto.RegisterType((*DecodeUplinkResponse)(nil), "ttn.lorawan.v3.DecodeUplinkResponse")
+	proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	golang_proto.RegisterType((*EncodeDownlinkRequest)(nil), "ttn.lorawan.v3.EncodeDownlinkRequest")
+	proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	golang_proto.RegisterType((*EncodeDownlinkResponse)(nil), "ttn.lorawan.v3.EncodeDownlinkResponse")
+	proto.RegisterType((*EncodeDownlinkErrorDetails)(nil), "ttn.lorawan.v3.EncodeDownlinkErrorDetails")
+	golang_proto.RegisterType((*EncodeDownlinkErrorDetails)(nil), "ttn.lorawan.v3.EncodeDownlinkErrorDetails")
+	proto.RegisterType((*PayloadFormattersVersion)(nil), "ttn.lorawan.v3.PayloadFormattersVersion")
+	golang_proto.RegisterType((*PayloadFormattersVersion)(nil), "ttn.lorawan.v3.PayloadFormattersVersion")
+	proto.RegisterType((*PayloadFormattersVersions)(nil), "ttn.lorawan.v3.PayloadFormattersVersions")
+	golang_proto.RegisterType((*PayloadFormattersVersions)(nil), "ttn.lorawan.v3.PayloadFormattersVersions")
+	proto.RegisterType((*ListPayloadFormattersVersionsRequest)(nil), "ttn.lorawan.v3.ListPayloadFormattersVersionsRequest")
+	golang_proto.RegisterType((*ListPayloadFormattersVersionsRequest)(nil), "ttn.lorawan.v3.ListPayloadFormattersVersionsRequest")
+	proto.RegisterType((*RollbackPayloadFormattersRequest)(nil), "ttn.lorawan.v3.RollbackPayloadFormattersRequest")
+	golang_proto.RegisterType((*RollbackPayloadFormattersRequest)(nil), "ttn.lorawan.v3.RollbackPayloadFormattersRequest")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver.proto", fileDescriptor_df9d75a19dc066e1)
+}
+
+var fileDescriptor_df9d75a19dc066e1 = []byte{
+	// 2575 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x5a, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xf6, 0x92, 0xd4, 0x0f, 0xc7, 0x12, 0x2d, 0x8f, 0x9d, 0x58, 0xa2, 0x6d, 0xc9, 0x59, 0xcb,
+	0xb6, 0xa4, 0x98, 0xa4, 0xcb, 0x38, 0x46, 0xeb, 0xb4, 0x35, 0x48, 0x93, 0xb6, 0x15, 0x4b, 0xfe,
+	0x59, 0xca, 0x49, 0xe3, 0x3f, 0x62, 0x45, 0x0e, 0xe9, 0x85, 0xa8, 0x5d, 0x7a, 0x77, 0x29, 0x4b,
+	0x91, 0x5d, 0x18, 0x41, 0x91, 0x06, 0x29, 0xd2, 0x1a, 0x0d, 0x02, 0xe4, 0x54, 0x04, 0xed, 0x25,
+	0xa7, 0xc2, 0x68, 0x0f, 0xcd, 0xa9, 0x35, 0x5a, 0x14, 0x70, 0xd1, 0x43, 0x5d, 0xf4, 0xd0, 0x1c,
+	0x0a, 0x27, 0x71, 0x7a, 0x30, 0xd0, 0x4b, 0x0e, 0x05, 0x9a, 0xfa, 0xd4, 0x37, 0x3f, 0x4b, 0x2e,
+	0xb9, 0x22, 0xb5, 0x72, 0x55, 0x1b, 0x29, 0xa0, 0xc5, 0xce, 0xce, 0xbc, 0xf7, 0xe6, 0x7b, 0x6f,
+	0xde, 0xcf, 0xcc, 0x50, 0x68, 0xbc, 0x62, 0x98, 0xea, 0x35, 0x55, 0x8f, 0x59, 0xb6, 0x5a, 0x98,
+	0x4b, 0xa8, 0x55, 0x0d, 0x9e, 0x6a, 0x45, 0x2b, 0xa8, 0xb6, 0x66, 0xe8, 0x16, 0x31, 0x17, 0x88,
+	0x19, 0xaf, 0x9a, 0x86, 0x6d, 0xe0, 0x88, 0x6d, 0xeb, 0x71, 0x41, 0x1e, 0x5f, 0x78, 0x21, 0x9a,
+	0x2a, 0x6b, 0xf6, 0x95, 0xda, 0x6c, 0xbc, 0x60, 0xcc, 0x27, 0x88, 0xbe, 0x60, 0x2c, 0x01, 0xd9,
+	0xe2, 0x52, 0x82, 0x11, 0x17, 0x62, 0x65, 0xa2, 0xc7, 0x16, 0xd4, 0x8a, 0x56, 0x54, 0x6d, 0x92,
+	0xf0, 0x34, 0xb8, 0xc8, 0x68, 0xcc, 0x25, 0xa2, 0x6c, 0x94, 0x0d, 0xce, 0x3c, 0x5b, 0x2b, 0xb1,
+	0x2f, 0xf6, 0xc1, 0x5a, 0x82, 0x7c, 0x47, 0xd9, 0x30, 0xca, 0x15, 0xc2, 0x51, 0xea, 0xba, 0x61,
+	0x73, 0x90, 0x62, 0x74, 0xbb, 0x18, 0xad, 0xcb, 0x20, 0xf3, 0x55, 0x7b, 0x49, 0x0c, 0xee, 0x6a,
+	0x1d, 0x2c, 0x69, 0xa4, 0x52, 0xcc, 0xcf, 0xab, 0xd6, 0x5c, 0x8b, 0xf0, 0x3a, 0x85, 0x65, 0x9b,
+	0xb5, 0x82, 0x2d, 0x46, 0x47, 0x5a, 0x47, 0x6d, 0x6d, 0x9e, 0x80, 0xcd, 0xe6, 0xab, 0x82, 0x60,
+	0xb8, 0x95, 0xe0, 0x9a, 0x09, 0x86, 0x24, 0xa6, 0x83, 0x4e, 0xf6, 0x1a, 0x9a, 0xe8, 0xc5, 0x7c,
+	0x91, 0x2c, 0x68, 0x05, 0xc7, 0x1c, 0x3b, 0x57, 0xa0, 0x31, 0x4d, 0x43, 0x2c, 0x40, 0x74, 0xb7,
+	0x77, 0x58, 0x2b, 0x12, 0xdd, 0xd6, 0x40, 0x97, 0xfa, 0x3c, 0x23, 0x5e, 0x22, 0x67, 0xcd, 0x84,
+	0x25, 0xbc, 0x04, 0xa0, 0x89, 0xa5, 0x96, 0x89, 0x23, 0x62, 0xc7, 0x0a, 0x14, 0x57, 0x6d, 0x61,
+	0x09, 0xf9, 0xdf, 0x01, 0xb4, 0x29, 0xd5, 0x70, 0x91, 0x29, 0x4d, 0x9f, 0xc3, 0xbf, 0x97, 0xd0,
+	0xb3, 0x3a, 0xb1, 0xaf, 0x19, 0xe6, 0x5c, 0x9e, 0xfb, 0x4c, 0x5e, 0x2d, 0x16, 0x4d, 0x10, 0x3b,
+	0x28, 0xed, 0x92, 0xc6, 0xc2, 0xe9, 0x1f, 0x4a, 0x8f, 0xd2, 0x6f, 0x4b, 0xe6, 0xf7, 0xa5, 0xe4,
+	0xf7, 0xa4, 0xcb, 0x63, 0x47, 0x0e, 0xc3, 0xdf, 0x05, 0x35, 0xf6, 0x7a, 0x2a, 0x76, 0xfe, 0x40,
+	0xec, 0x1b, 0x97, 0xae, 0xbb, 0xda, 0x8d, 0xe6, 0xc5, 0xd8, 0xa5, 0x09, 0xd7, 0xc0, 0xf8, 0xc5,
+	0xf8, 0xf8, 0x04, 0xe5, 0x83, 0x6f, 0xe8, 0xe5, 0x7c, 0x8d, 0x76, 0xa3, 0xc9, 0xf8, 0x1a, 0x03,
+	0xe3, 0xc0, 0x73, 0xf8, 0x02, 0x6d, 0x2d, 0x7f, 0x6d, 0xff, 0x8b, 0x37, 0xc6, 0x8f, 0x8c, 0x5e,
+	0xbf, 0x3c, 0xaa, 0x6c, 0x15, 0x70, 0x73, 0x0c, 0x6d, 0x8a, 0x83, 0xc5, 0x13, 0xa8, 0x07, 0xb4,
+	0xcd, 0xcf, 0x91, 0xa5, 0xc1, 0x00, 0xc3, 0xbd, 0xf9, 0x51, 0x3a, 0x64, 0x06, 0x06, 0xa4, 0x07,
+	0xf7, 0x47, 0xba, 0x53, 0x67, 0x26, 0x4f, 0x92, 0x25, 0xa5, 0x1b, 0x28, 0xe0, 0x8d, 0x5f, 0x45,
+	0xb8, 0x48, 0x4a, 0x6a, 0xad, 0x62, 0xe7, 0x4b, 0x86, 0x39, 0xaf, 0xda, 0x36, 0x2c, 0xc2, 0x60,
+	0x10, 0xd8, 0x36, 0x26, 0xc7, 0xe2, 0xcd, 0xb1, 0x12, 0x9f, 0xe6, 0x16, 0x3e, 0xa3, 0x2e, 0x55,
+	0x0c, 0xb5, 0x78, 0xac, 0x4e, 0xaf, 0x6c, 0x16, 0x32, 0x1a, 0x5d, 0x78, 0x08, 0x05, 0xed, 0x8a,
+	0x35, 0x18, 0x02, 0x49, 0xbd, 0xe9, 0x1e, 0x98, 0x39, 0x38, 0x33, 0x95, 0x53, 0x68, 0x9f, 0xfc,
+	0x1b, 0x09, 0x0d, 0x1d, 0x27, 0x76, 0x8b, 0xf9, 0x15, 0x72, 0xb5, 0x06, 0xbe, 0x88, 0x55, 0xb4,
+	0xc9, 0x15, 0xbb, 0x79, 0xad, 0xc8, 0xad, 0xbf, 0x31, 0xb9, 0xb7, 0x15, 0x8e, 0x4b, 0xc0, 0x64,
+	0xc3, 0x83, 0xd2, 0x03, 0x8f, 0xd2, 0x5d, 0x6f, 0x4b, 0xa0, 0xee, 0xdd, 0xfb, 0x23, 0x1b, 0xee,
+	0xdd, 0x1f, 0x91, 0x94, 0x88, 0xea, 0xa6, 0xb4, 0xf0, 0x11, 0x84, 0x1a, 0x81, 0xc3, 0x6c, 0xb4,
+	0x31, 0x19, 0x8d, 0x73, 0xd7, 0x8f, 0x3b, 0xae, 0x1f, 0x3f, 0x46, 0x49, 0xa6, 0x81, 0x22, 0x1d,
+	0xa2, 0x92, 0x94, 0x70, 0xc9, 0xe9, 0x90, 0xdf, 0x0c, 0xa0, 0xa1, 0xdc, 0xd3, 0xd4, 0x20, 0x8b,
+	0x42, 0x15, 0x98, 0x51, 0x60, 0x1f, 0xe9, 0x20, 0x97, 0x02, 0x5b, 0x41, 0x20, 0x63, 0x6f, 0x31,
+	0x44, 0x70, 0xed, 0x86, 0xf8, 0x51, 0x08, 0x6d, 0x6d, 0x99, 0x2c, 0x07, 0xf9, 0xcc, 0xc2, 0xdf,
+	0x42, 0x61, 0x3a, 0x03, 0x29, 0xe6, 0x55, 0x5b, 0x68, 0xef, 0x15, 0x3c, 0xe3, 0x64, 0x9f, 0x74,
+	0xe8, 0xd6, 0x27, 0x00, 0xaa, 0x97, 0xb3, 0xa4, 0xec, 0x4e, 0xa1, 0x18, 0xf8, 0x2a, 0x85, 0xe2,
+	0x69, 0xb4, 0xa5, 0xa2, 0x5a, 0x76, 0xbe, 0x56, 0xcd, 0x9b, 0xa4, 0x40, 0xb4, 0x05, 0x6e, 0x90,
+	0xa0, 0x4f, 0x83, 0x0c, 0x50, 0xe6, 0x73, 0x55, 0x45, 0xb0, 0x82, 0x61, 0x86, 0x50, 0x2f, 0xc8,
+	0x2a, 0x18, 0x35, 0xdd, 0x66, 0xb1, 0x15, 0x52, 0x7a, 0x6a, 0xd5, 0xa3, 0xf4, 0x13, 0x5f, 0x42,
+	0x51, 0x36, 0x57, 0xd1, 0xb8, 0xa6, 0x53, 0x43, 0xd2, 0x80, 0xbe, 0xa6, 0x9a, 0x45, 0x3e, 0x65,
+	0x97, 0xcf, 0x29, 0xb7, 0x51, 0x19, 0x19, 0x21, 0xe2, 0x98, 0x23, 0x01, 0x66, 0xde, 0x83, 0x22,
+	0x75, 0xc9, 0x7c, 0xfe, 0x6e, 0x36, 0x7f, 0xbf, 0xd3, 0xcb, 0x50, 0xc8, 0x0f, 0x21, 0x34, 0x5c,
+	0x1e, 0xe1, 0x48, 0xa2, 0x5e, 0x51, 0xa3, 0x7e, 0xdb, 0xeb, 0x90, 0x0b, 0xaf, 0xd8, 0xdd, 0xc1,
+	0x77, 0x1d, 0x66, 0xe1, 0x77, 0x75, 0x56, 0x10, 0xd3, 0x05, 0x98, 0x6d, 0xc2, 0x9c, 0x21, 0x92,
+	0x4c, 0xf8, 0x90, 0xc1, 0x01, 0xc4, 0xe9, 0x8b, 0x28, 0x9c, 0x1b, 0xef, 0x47, 0xb8, 0x56, 0xa5,
+	0x83, 0x56, 0xde, 0xd2, 0xf4, 0x02, 0x01, 0x57, 0xd3, 0xf9, 0xe2, 0xf4, 0x2b, 0x03, 0x62, 0x24,
+	0x47, 0x07, 0x72, 0xd0, 0x8f, 0x8f, 0x22, 0x54, 0xab, 0xd2, 0xb2, 0xcf, 0xec, 0x19, 0x5a, 0xd5,
+	0x9e, 0xbd, 0x14, 0x34, 0xb3, 0x69, 0x58, 0xf0, 0xa5, 0x6c, 0xf9, 0x65, 0xd4, 0xc5, 0x20, 0x60,
+	0x84, 0xba, 0xcf, 0x9e, 0xcb, 0x9e, 0xcb, 0x66, 0x06, 0x36, 0xe0, 0x5e, 0x14, 0xca, 0x65, 0x4f,
+	0xcd, 0x0c, 0x48, 0x78, 0x00, 0xf5, 0xa5, 0x8e, 0x9e, 0x3c, 0x75, 0xfa, 0xd5, 0xa9, 0x6c, 0xe6,
+	0x38, 0x8c, 0x05, 0x28, 0xdd, 0xb1, 0xd4, 0x24, 0x7c, 0x0e, 0x04, 0x71, 0x3f, 0x0a, 0xcf, 0x4c,
+	0x4e, 0x67, 0x33, 0xf9, 0xd3, 0xe7, 0x66, 0x06, 0x42, 0x72, 0x11, 0x6d, 0x6f, 0xab, 0x28, 0x61,
+	0xb6, 0xb6, 0x44, 0x1b, 0x6c, 0x1d, 0x04, 0xb4, 0xe3, 0xbe, 0xed, 0xa4, 0xd4, 0x59, 0xe5, 0x65,
+	0x84, 0x33, 0xaa, 0xad, 0x2a, 0x00, 0x7a, 0x52, 0x2f, 0x92, 0x45, 0xee, 0x6c, 0xa7, 0xd1, 0x26,
+	0x50, 0x49, 0xcd, 0x9b, 0xd0, 0x9d, 0xd7, 0x68, 0x3f, 0x5b, 0xcf, 0x48, 0x72, 0x67, 0xeb, 0x1c,
+	0x4d, 0xcc, 0xe9, 0x5e, 0xc8, 0x44, 0x6f, 0xd0, 0x4c, 0x04, 0x7e, 0xe3, 0x1e, 0xc0, 0x5b, 0x51,
+	0x17, 0xf7, 0xaa, 0x00, 0xf3, 0x2a, 0xfe, 0x21, 0xdf, 0x0a, 0xa0, 0x2d, 0xa2, 0xea, 0xcc, 0x98,
+	0x6a, 0xa9, 0xa4, 0x15, 0x78, 0x7a, 0xa9, 0x53, 0x4b, 0x2e, 0x6a, 0xbc, 0x0f, 0x6d, 0x2a, 0x18,
+	0x7a, 0x49, 0x33, 0xe7, 0x61, 0x8d, 0xdc, 0xd2, 0x22, 0xf5, 0x6e, 0x8e, 0x7e, 0x37, 0xea, 0xaf,
+	0xf2, 0x22, 0x96, 0x9f, 0x5d, 0xb2, 0x09, 0x2f, 0x78, 0x21, 0xa5, 0x4f, 0x74, 0xa6, 0x69, 0x1f,
+	0x1e, 0x43, 0x03, 0xf3, 0x9a, 0x9e, 0x77, 0x08, 0x2d, 0xed, 0x75, 0xc2, 0x56, 0xbd, 0x5f, 0x89,
+	0x40, 0xbf, 0x28, 0x82, 0x39, 0xe8, 0x65, 0x94, 0xea, 0x62, 0x33, 0x65, 0x97, 0xa0, 0x54, 0x17,
+	0xdd, 0x94, 0x29, 0x84, 0xea, 0x66, 0xb3, 0x20, 0x80, 0xe8, 0xaa, 0xc8, 0x1d, 0x2d, 0xc6, 0x00,
+	0x2b, 0x61, 0xc7, 0x58, 0x96, 0xfc, 0x2f, 0xa8, 0x9e, 0x19, 0xb6, 0xdf, 0x3a, 0x63, 0x1a, 0x25,
+	0xad, 0xd2, 0x6c, 0x98, 0x8b, 0x68, 0x23, 0xa4, 0x1f, 0xab, 0xb9, 0xee, 0x3c, 0xdf, 0x3a, 0x43,
+	0x56, 0x2f, 0x72, 0x11, 0xaf, 0x70, 0x5a, 0x77, 0xf1, 0x89, 0x40, 0xad, 0x46, 0x4e, 0x7f, 0xc6,
+	0x52, 0xd0, 0x82, 0x43, 0x63, 0xe1, 0x97, 0x50, 0x37, 0x0f, 0x0b, 0x51, 0x78, 0x76, 0xb7, 0xd9,
+	0x21, 0xb8, 0x21, 0x29, 0x82, 0x05, 0x8a, 0x4d, 0x23, 0xf6, 0x83, 0xfe, 0xd9, 0xeb, 0x4c, 0xf2,
+	0xed, 0x00, 0xda, 0xe6, 0xf2, 0xd8, 0x26, 0xbd, 0x21, 0x38, 0xc1, 0x63, 0x4d, 0xdb, 0x6f, 0xc1,
+	0x71, 0x05, 0xa7, 0xe0, 0x83, 0x14, 0xf7, 0x54, 0xd5, 0xc3, 0x0a, 0x84, 0x14, 0x5b, 0x94, 0x7c,
+	0x95, 0x2f, 0x2c, 0xdd, 0x3d, 0xad, 0x18, 0xb6, 0x6d, 0x97, 0x5f, 0x89, 0x14, 0xdd, 0x43, 0x96,
+	0xfc, 0xbb, 0x10, 0x8a, 0xd4, 0x17, 0x9b, 0x27, 0x9e, 0x8b, 0x28, 0xd2, 0xd8, 0xb2, 0xbb, 0x9c,
+	0x64, 0xb4, 0xad, 0x93, 0x74, 0xde, 0x9a, 0xf4, 0x91, 0x06, 0x9d, 0x85, 0xd3, 0xa8, 0x8f, 0x15,
+	0x21, 0x8b, 0x10, 0x9d, 0xae, 0x44, 0xc0, 0x67, 0xd9, 0x41, 0x94, 0x2b, 0x07, 0x4c, 0xb0, 0x0c,
+	0x93, 0x68, 0x2b, 0x2f, 0x64, 0xa4, 0x60, 0xd0, 0xea, 0x25, 0xe2, 0x4a, 0x58, 0x75, 0x9b, 0x47,
+	0x56, 0x8e, 0x1d, 0x71, 0x14, 0xcc, 0x2a, 0x17, 0xe7, 0x11, 0x31, 0x87, 0xaf, 0xa0, 0x5d, 0x2b,
+	0x89, 0x6a, 0x2a, 0xc6, 0x21, 0x9f, 0x10, 0x77, 0x78, 0xe5, 0xbb, 0x0a, 0xf3, 0x6b, 0x68, 0xfb,
+	0x8a, 0x33, 0x95, 0xf2, 0x55, 0xc3, 0xe4, 0xe5, 0xb7, 0x3f, 0xbd, 0x1d, 0x62, 0x6b, 0xdb, 0x94,
+	0x47, 0xcc, 0xb1, 0x33, 0x40, 0x22, 0x2a, 0xaf, 0x77, 0x00, 0x9f, 0x40, 0xfd, 0x4c, 0x74, 0xc5,
+	0xe0, 0x8e, 0xcf, 0x0a, 0x6f, 0xe7, 0xca, 0x39, 0x25, 0x48, 0x15, 0xb6, 0x1a, 0xce, 0x17, 0x7e,
+	0x11, 0xf5, 0xcc, 0xb2, 0xfd, 0xf9, 0xd2, 0x60, 0x0f, 0x93, 0xb1, 0xdd, 0xbb, 0xd9, 0x83, 0x79,
+	0xed, 0x57, 0xd4, 0x4a, 0x8d, 0x28, 0x0e, 0xad, 0xfc, 0x4e, 0x10, 0x6d, 0xe1, 0xc0, 0xce, 0x31,
+	0x5f, 0x77, 0x36, 0xba, 0xff, 0x5b, 0x57, 0x6a, 0x49, 0x65, 0x81, 0xf5, 0x4d, 0x65, 0xc7, 0xeb,
+	0xb1, 0xce, 0xdd, 0xea, 0xb9, 0x0e, 0xd6, 0xe4, 0x5a, 0xa7, 0xfb, 0xdc, 0x80, 0xeb, 0x71, 0x7f,
+	0x02, 0x85, 0xeb, 0x27, 0x27, 0xe6, 0x4b, 0x91, 0xe4, 0xae, 0x56, 0x59, 0xad, 0x27, 0x26, 0x57,
+	0x19, 0x6c, 0x30, 0x43, 0xf9, 0x0a, 0x57, 0x55, 0x53, 0x9d, 0x27, 0x54, 0x52, 0x17, 0xdb, 0xe6,
+	0x86, 0x1f, 0xa5, 0xbb, 0xcd, 0xd0, 0xe0, 0xcd, 0x3b, 0x01, 0xa5, 0x31, 0x26, 0xff, 0x40, 0x42,
+	0x5b, 0x9b, 0xd7, 0xc3, 0xaa, 0xd2, 0x6b, 0x0e, 0xc8, 0x41, 0x8e, 0x52, 0x92, 0x5f, 0xa5, 0x42,
+	0x4d, 0xca, 0x24, 0x51, 0x17, 0x3b, 0xab, 0x0b, 0x6b, 0xef, 0xf0, 0x58, 0x9b, 0x0e, 0x66, 0x88,
+	0xad, 0x6a, 0x15, 0x4b, 0xe1, 0xa4, 0xf2, 0x7b, 0x41, 0xf4, 0x4c, 0x56, 0xa7, 0x68, 0x9c, 0x3d,
+	0xc4, 0xff, 0x83, 0x7f, 0x4c, 0xaf, 0x9e, 0xce, 0x57, 0xda, 0xa9, 0x36, 0xfb, 0x48, 0x23, 0xb9,
+	0x3f, 0x05, 0x2f, 0x79, 0x17, 0xce, 0x50, 0xad, 0xeb, 0x22, 0xfc, 0x64, 0x9d, 0xb6, 0xe1, 0x8f,
+	0xe3, 0x2d, 0x70, 0x4a, 0x69, 0x06, 0xe5, 0x26, 0x02, 0x07, 0xee, 0xe3, 0x07, 0x52, 0x46, 0xec,
+	0xec, 0x5b, 0x3b, 0x0b, 0xde, 0xc8, 0x38, 0x58, 0x97, 0x25, 0xff, 0x41, 0x42, 0x83, 0x9e, 0xfb,
+	0x09, 0xb1, 0xc4, 0x78, 0x10, 0xf5, 0x88, 0x15, 0x66, 0x5a, 0xf7, 0x2b, 0xce, 0x27, 0x3e, 0x05,
+	0x07, 0xe1, 0xc6, 0xf5, 0x47, 0x60, 0x6d, 0xd7, 0x1f, 0xc2, 0x2e, 0x2e, 0x09, 0x74, 0x3b, 0x52,
+	0x30, 0x89, 0x73, 0x56, 0x08, 0xae, 0x65, 0x3b, 0x22, 0xf8, 0xe0, 0xac, 0xa0, 0xa2, 0xa1, 0x76,
+	0xaa, 0x58, 0x38, 0x83, 0x7a, 0x05, 0x78, 0xc7, 0x4a, 0x63, 0xab, 0xf9, 0x93, 0xc3, 0xac, 0xd4,
+	0x39, 0xe5, 0xfb, 0x12, 0x1a, 0x9d, 0xd2, 0x2c, 0xbb, 0xed, 0x3c, 0x4f, 0xf0, 0x4e, 0x23, 0x87,
+	0xc2, 0xf5, 0x4c, 0x21, 0x4e, 0xf9, 0x87, 0x1e, 0xa5, 0xf7, 0x99, 0x7b, 0x06, 0x47, 0x93, 0xcf,
+	0x5d, 0xbe, 0x20, 0x4e, 0xdf, 0xf4, 0xc0, 0x1e, 0xbb, 0x74, 0xc4, 0xf9, 0x1c, 0x5f, 0x4e, 0xee,
+	0xbf, 0x41, 0x0f, 0xe0, 0x10, 0xca, 0xbd, 0x22, 0x37, 0x64, 0xc0, 0x45, 0x45, 0x96, 0x90, 0xff,
+	0x29, 0xa1, 0x5d, 0x8a, 0x51, 0xa9, 0xcc, 0xaa, 0x85, 0x39, 0xef, 0xbd, 0xd5, 0x57, 0x5b, 0x39,
+	0xb7, 0x3f, 0x07, 0x9b, 0xfc, 0x39, 0xf9, 0x93, 0x30, 0x0a, 0xa4, 0x2c, 0xfc, 0x9e, 0x84, 0x7a,
+	0x8e, 0x13, 0x9b, 0xdd, 0x6e, 0x7a, 0x76, 0x91, 0x6d, 0xaf, 0xe0, 0xa2, 0xab, 0xdd, 0x27, 0xc9,
+	0xdf, 0x7e, 0xe3, 0x2f, 0x7f, 0x7f, 0x37, 0xf0, 0x75, 0x7c, 0x28, 0xa1, 0x5a, 0x4d, 0x37, 0xed,
+	0x89, 0xe5, 0x16, 0x43, 0xc6, 0x9b, 0xbf, 0x6f, 0x24, 0x58, 0xe2, 0xf8, 0x93, 0x84, 0x76, 0x76,
+	0x74, 0x3b, 0x7c, 0xb0, 0x15, 0x82, 0x1f, 0x2f, 0x8d, 0x8e, 0xfb, 0x0d, 0x01, 0x4b, 0x9e, 0x66,
+	0x2a, 0x1c, 0xc7, 0xd9, 0xb5, 0xab, 0xd0, 0x88, 0xf3, 0x84, 0x13, 0x48, 0xf8, 0x7d, 0xb0, 0x74,
+	0xae, 0x9d, 0xa5, 0x73, 0x8f, 0x6f, 0xe9, 0x14, 0x83, 0xf9, 0x52, 0xf4, 0x31, 0x2d, 0x7d, 0x58,
+	0x9a, 0xc0, 0x7f, 0x83, 0x03, 0x63, 0xdb, 0x10, 0xc0, 0x07, 0x5a, 0x11, 0xac, 0x16, 0x2d, 0x51,
+	0xdf, 0x79, 0x46, 0x2e, 0x30, 0xf0, 0x97, 0xe4, 0xef, 0xac, 0x8b, 0x8d, 0x13, 0xcb, 0xa2, 0x75,
+	0x23, 0x61, 0x0a, 0x8c, 0x54, 0xbd, 0xeb, 0x08, 0x65, 0x48, 0x05, 0x2a, 0x1e, 0xb3, 0xbd, 0xcf,
+	0x88, 0x8d, 0x3e, 0xeb, 0x49, 0xc6, 0x59, 0xfa, 0x3b, 0x8b, 0x1c, 0x67, 0x90, 0xc7, 0x26, 0xf6,
+	0xae, 0x06, 0x59, 0x78, 0xf2, 0x8f, 0x25, 0xd4, 0x27, 0x22, 0x8c, 0x1f, 0x44, 0xfd, 0x02, 0x18,
+	0x5d, 0x65, 0xe5, 0x99, 0x34, 0xf9, 0x20, 0x83, 0x13, 0xc7, 0xfb, 0xfd, 0xc1, 0x49, 0x58, 0x0c,
+	0xc3, 0x07, 0x12, 0xda, 0x04, 0xa0, 0x9a, 0x0e, 0xc8, 0x7e, 0x71, 0xed, 0xeb, 0x40, 0xe7, 0x16,
+	0x28, 0x7f, 0x93, 0x41, 0x3b, 0x84, 0x0f, 0xae, 0x05, 0x5a, 0xc2, 0xe6, 0x22, 0x92, 0xef, 0xf4,
+	0xa3, 0x2e, 0x90, 0x0c, 0x39, 0x6a, 0x06, 0x85, 0x73, 0xb5, 0x59, 0xab, 0x60, 0x6a, 0xb3, 0xc4,
+	0x37, 0xca, 0x9d, 0x1d, 0x37, 0xb6, 0x07, 0x24, 0xfc, 0x47, 0x09, 0x6d, 0x76, 0x76, 0x18, 0x67,
+	0x6b, 0xa4, 0x46, 0xce, 0xd4, 0xac, 0x2b, 0xd8, 0x63, 0xf4, 0x26, 0x12, 0xc7, 0xc1, 0xdb, 0xf9,
+	0xc6, 0x22, 0xd3, 0xd8, 0x94, 0xe7, 0xbd, 0x1a, 0x37, 0x6f, 0x73, 0xe3, 0xab, 0x79, 0x37, 0x27,
+	0xf5, 0xf2, 0xd5, 0x9b, 0x40, 0x02, 0xc8, 0x12, 0x55, 0x00, 0x4d, 0x7d, 0xfc, 0xcf, 0x74, 0xc3,
+	0xdf, 0x0c, 0xb5, 0x5a, 0x51, 0x0b, 0xe4, 0xbf, 0x54, 0x68, 0x99, 0x29, 0x54, 0x93, 0xab, 0x4f,
+	0x4c, 0x21, 0x93, 0xe3, 0xa6, 0x3a, 0xfd, 0x15, 0x22, 0xc7, 0x7d, 0x88, 0xc1, 0xbb, 0xbd, 0xd7,
+	0x1c, 0x9e, 0x23, 0xa7, 0x37, 0x6c, 0x56, 0x3a, 0x07, 0xc9, 0xd7, 0x99, 0x62, 0x0b, 0xf2, 0xd5,
+	0x27, 0xa2, 0x18, 0x43, 0x90, 0xe7, 0x27, 0x28, 0xaa, 0xd9, 0x27, 0x12, 0xbd, 0x74, 0x71, 0xef,
+	0x71, 0xf1, 0x1e, 0xef, 0xb1, 0x64, 0x85, 0x03, 0x53, 0x74, 0xef, 0x6a, 0x64, 0x42, 0xbf, 0xef,
+	0x32, 0xfd, 0x16, 0x65, 0xeb, 0x49, 0xe8, 0x47, 0x18, 0x86, 0xfa, 0xcf, 0x0a, 0x54, 0xc3, 0x5f,
+	0xb6, 0x46, 0x17, 0xad, 0xce, 0xd8, 0xd7, 0xb1, 0xae, 0x63, 0xe2, 0x73, 0x64, 0x5a, 0xb2, 0xc2,
+	0x34, 0x9c, 0xc2, 0x2f, 0xaf, 0xbd, 0x74, 0xd4, 0x55, 0x6a, 0x71, 0x3e, 0xfc, 0x5b, 0x09, 0xe1,
+	0xe6, 0x6b, 0xee, 0x35, 0xc0, 0x7e, 0xde, 0xf7, 0xdd, 0x39, 0xb1, 0xe4, 0xd7, 0x18, 0xfa, 0x1c,
+	0x3e, 0xbb, 0x7e, 0xe8, 0x13, 0xfc, 0x42, 0x1e, 0xff, 0x1c, 0x4c, 0x0f, 0xb9, 0xbd, 0xe5, 0x52,
+	0xcf, 0x9f, 0x0e, 0xc3, 0x6d, 0xa9, 0x98, 0x14, 0x39, 0xc7, 0x60, 0x4f, 0xe3, 0x93, 0xeb, 0x03,
+	0x9b, 0xff, 0xc8, 0xf2, 0x33, 0x09, 0x3d, 0x03, 0x80, 0xa7, 0xcf, 0xce, 0xcc, 0x1c, 0x35, 0x74,
+	0x9d, 0x14, 0x58, 0x2e, 0xd7, 0x4b, 0x86, 0xef, 0x64, 0xef, 0xb9, 0x20, 0xf7, 0xca, 0xf2, 0xbf,
+	0x23, 0xbd, 0xc1, 0xfe, 0x0b, 0x20, 0x56, 0xa8, 0xb3, 0xc7, 0x34, 0xe0, 0x4f, 0xfe, 0x23, 0x84,
+	0xb6, 0xa4, 0xac, 0xba, 0x3d, 0x14, 0x52, 0x06, 0xd7, 0x30, 0x97, 0xf0, 0x2f, 0x24, 0x14, 0x04,
+	0xf4, 0xde, 0xe4, 0xe4, 0x5e, 0x03, 0x27, 0x7c, 0x87, 0xda, 0xda, 0x57, 0x9e, 0x63, 0xf8, 0x08,
+	0x2e, 0x3c, 0x81, 0x88, 0xc5, 0x6f, 0x06, 0x50, 0x30, 0xb7, 0x12, 0xe8, 0xdc, 0xda, 0x40, 0xff,
+	0x5a, 0x62, 0xa8, 0x7f, 0x25, 0x45, 0x3b, 0xc2, 0x8e, 0x3f, 0x26, 0xec, 0x78, 0x33, 0x6c, 0x48,
+	0x2c, 0xe7, 0xa7, 0xe5, 0x13, 0xeb, 0x35, 0x13, 0xcd, 0x53, 0x70, 0xfe, 0xe9, 0xe6, 0x9b, 0x43,
+	0x9f, 0x11, 0xd2, 0xae, 0x52, 0x8a, 0xd3, 0xc2, 0x44, 0x76, 0x5d, 0x22, 0x23, 0xfd, 0x53, 0xe9,
+	0xee, 0x67, 0xc3, 0xd2, 0x3d, 0x78, 0x3e, 0xfe, 0x6c, 0x78, 0xc3, 0xa7, 0xf0, 0x3c, 0x84, 0xe7,
+	0x0b, 0x78, 0xbe, 0x84, 0xbe, 0x9b, 0x0f, 0x86, 0xa5, 0xb7, 0x1e, 0x0c, 0x6f, 0xf8, 0x10, 0xde,
+	0xb7, 0xe1, 0xfd, 0x11, 0x3c, 0x77, 0xe0, 0xb9, 0x0b, 0xdf, 0xf7, 0xe0, 0xf9, 0x18, 0xda, 0x9f,
+	0xc2, 0xfb, 0x21, 0xbc, 0xbf, 0x80, 0xf7, 0x97, 0xf0, 0xbe, 0xf9, 0xf9, 0xf0, 0x86, 0xb7, 0x3e,
+	0x1f, 0x96, 0x6e, 0xc1, 0xfb, 0x7d, 0x78, 0x7f, 0x00, 0xef, 0x0f, 0xe1, 0xb9, 0x0d, 0xed, 0x8f,
+	0xe0, 0xb9, 0x03, 0xcf, 0xf9, 0xfd, 0x65, 0x23, 0x6e, 0x5f, 0x21, 0xf6, 0x15, 0x4d, 0x2f, 0x5b,
+	0x71, 0xf1, 0x83, 0x75, 0xa2, 0xf9, 0xff, 0x64, 0xaa, 0x73, 0xe5, 0x04, 0x58, 0xaa, 0x3a, 0x3b,
+	0xdb, 0xcd, 0x6c, 0xf0, 0xc2, 0x7f, 0x00, 0x47, 0x01, 0x17, 0xd1, 0x5d, 0x25, 0x00, 0x00,
+}
+
+func (x ApplicationDownlinkStatus_State) String() string {
+	s, ok := ApplicationDownlinkStatus_State_name[int32(x)]
+	if ok {
+		return s
 	}
-	if m.LastDownlinkForwardedAt != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt)
-		n += 1 + l + sovApplicationserver(uint64(l))
+	return strconv.Itoa(int(x))
+}
+
+func (this *ApplicationLink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.DownlinkCount != 0 {
-		n += 1 + sovApplicationserver(m.DownlinkCount)
+
+	that1, ok := that.(*ApplicationLink)
+	if !ok {
+		that2, ok := that.(ApplicationLink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NetworkServerAddress != that1.NetworkServerAddress {
+		return false
+	}
+	if this.APIKey != that1.APIKey {
+		return false
+	}
+	if !this.DefaultFormatters.Equal(that1.DefaultFormatters) {
+		return false
+	}
+	if this.TLS != that1.TLS {
+		return false
+	}
+	return true
+}
+func (this *GetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetApplicationLinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetApplicationLinkRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationLinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.ApplicationLink.Equal(&that1.ApplicationLink) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationLinkStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationLinkStats)
+	if !ok {
+		that2, ok := that.(ApplicationLinkStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.LinkedAt == nil {
+		if this.LinkedAt != nil {
+			return false
+		}
+	} else if !this.LinkedAt.Equal(*that1.LinkedAt) {
+		return false
+	}
+	if this.NetworkServerAddress != that1.NetworkServerAddress {
+		return false
+	}
+	if that1.LastUpReceivedAt == nil {
+		if this.LastUpReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastUpReceivedAt.Equal(*that1.LastUpReceivedAt) {
+		return false
+	}
+	if this.UpCount != that1.UpCount {
+		return false
+	}
+	if that1.LastDownlinkForwardedAt == nil {
+		if this.LastDownlinkForwardedAt != nil {
+			return false
+		}
+	} else if !this.LastDownlinkForwardedAt.Equal(*that1.LastDownlinkForwardedAt) {
+		return false
+	}
+	if this.DownlinkCount != that1.DownlinkCount {
+		return false
+	}
+	return true
+}
+func (this *ApplicationDownlinkStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationDownlinkStatus)
+	if !ok {
+		that2, ok := that.(ApplicationDownlinkStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Downlink.Equal(&that1.Downlink) {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.UplinksSinceSent != that1.UplinksSinceSent {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationDownlinkStatuses) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationDownlinkStatuses)
+	if !ok {
+		that2, ok := that.(ApplicationDownlinkStatuses)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Statuses) != len(that1.Statuses) {
+		return false
+	}
+	for i := range this.Statuses {
+		if !this.Statuses[i].Equal(that1.Statuses[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DataRateIndexCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRateIndexCount)
+	if !ok {
+		that2, ok := that.(DataRateIndexCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *MessageTrafficStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessageTrafficStats)
+	if !ok {
+		that2, ok := that.(MessageTrafficStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.ConfirmedCount != that1.ConfirmedCount {
+		return false
+	}
+	if this.PayloadBytes != that1.PayloadBytes {
+		return false
+	}
+	if this.MinPayloadSize != that1.MinPayloadSize {
+		return false
+	}
+	if this.MaxPayloadSize != that1.MaxPayloadSize {
+		return false
+	}
+	if len(this.DataRates) != len(that1.DataRates) {
+		return false
+	}
+	for i := range this.DataRates {
+		if !this.DataRates[i].Equal(that1.DataRates[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeviceProfileTrafficStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeviceProfileTrafficStats)
+	if !ok {
+		that2, ok := that.(DeviceProfileTrafficStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	return true
+}
+func (this *ApplicationTrafficStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationTrafficStats)
+	if !ok {
+		that2, ok := that.(ApplicationTrafficStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
+		return false
+	}
+	if !this.Uplink.Equal(that1.Uplink) {
+		return false
+	}
+	if !this.Downlink.Equal(that1.Downlink) {
+		return false
+	}
+	if len(this.DeviceProfiles) != len(that1.DeviceProfiles) {
+		return false
+	}
+	for i := range this.DeviceProfiles {
+		if !this.DeviceProfiles[i].Equal(that1.DeviceProfiles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EndDeviceState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceState)
+	if !ok {
+		that2, ok := that.(EndDeviceState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if that1.LastSeenAt == nil {
+		if this.LastSeenAt != nil {
+			return false
+		}
+	} else if !this.LastSeenAt.Equal(*that1.LastSeenAt) {
+		return false
+	}
+	if !this.LastDecodedPayload.Equal(that1.LastDecodedPayload) {
+		return false
+	}
+	if that1.LastDecodedPayloadReceivedAt == nil {
+		if this.LastDecodedPayloadReceivedAt != nil {
+			return false
+		}
+	} else if !this.LastDecodedPayloadReceivedAt.Equal(*that1.LastDecodedPayloadReceivedAt) {
+		return false
+	}
+	if this.LastDecodedPayloadFPort != that1.LastDecodedPayloadFPort {
+		return false
+	}
+	if !this.LastLocation.Equal(that1.LastLocation) {
+		return false
+	}
+	if !this.Battery.Equal(that1.Battery) {
+		return false
+	}
+	return true
+}
+func (this *DecodeUplinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecodeUplinkRequest)
+	if !ok {
+		that2, ok := that.(DecodeUplinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if !this.Uplink.Equal(&that1.Uplink) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	return true
+}
+func (this *DecodeUplinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DecodeUplinkResponse)
+	if !ok {
+		that2, ok := that.(DecodeUplinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Uplink.Equal(&that1.Uplink) {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *EncodeDownlinkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkRequest)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if !this.VersionIDs.Equal(that1.VersionIDs) {
+		return false
+	}
+	if !this.Downlink.Equal(&that1.Downlink) {
+		return false
+	}
+	if this.Formatter != that1.Formatter {
+		return false
+	}
+	if this.Parameter != that1.Parameter {
+		return false
+	}
+	return true
+}
+func (this *EncodeDownlinkResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkResponse)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Downlink.Equal(&that1.Downlink) {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *EncodeDownlinkErrorDetails) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EncodeDownlinkErrorDetails)
+	if !ok {
+		that2, ok := that.(EncodeDownlinkErrorDetails)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.FieldErrors) != len(that1.FieldErrors) {
+		return false
+	}
+	for i := range this.FieldErrors {
+		if !this.FieldErrors[i].Equal(that1.FieldErrors[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PayloadFormattersVersion) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadFormattersVersion)
+	if !ok {
+		that2, ok := that.(PayloadFormattersVersion)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !this.Formatters.Equal(&that1.Formatters) {
+		return false
+	}
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	return true
+}
+func (this *PayloadFormattersVersions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadFormattersVersions)
+	if !ok {
+		that2, ok := that.(PayloadFormattersVersions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Versions) != len(that1.Versions) {
+		return false
+	}
+	for i := range this.Versions {
+		if !this.Versions[i].Equal(that1.Versions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ListPayloadFormattersVersionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ListPayloadFormattersVersionsRequest)
+	if !ok {
+		that2, ok := that.(ListPayloadFormattersVersionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if this.DeviceID != that1.DeviceID {
+		return false
+	}
+	return true
+}
+func (this *RollbackPayloadFormattersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RollbackPayloadFormattersRequest)
+	if !ok {
+		that2, ok := that.(RollbackPayloadFormattersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if this.DeviceID != that1.DeviceID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AsClient is the client API for As service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsClient interface {
+	GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	ListPayloadFormattersVersions(ctx context.Context, in *ListPayloadFormattersVersionsRequest, opts ...grpc.CallOption) (*PayloadFormattersVersions, error)
+	// Set a link configuration from the Application Server a Network Server.
+	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
+	// To get link statistics or errors, use the `GetLinkStats` call.
+	SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error)
+	RollbackPayloadFormatters(ctx context.Context, in *RollbackPayloadFormattersRequest, opts ...grpc.CallOption) (*PayloadFormattersVersion, error)
+	DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetLinkStats returns the link statistics.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	// This call returns the error code of the link error if linking to a Network Server failed.
+	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
+	// GetTrafficStats returns the uplink and downlink traffic statistics of the application since the link was established.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	GetTrafficStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationTrafficStats, error)
+}
+
+type asClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsClient(cc *grpc.ClientConn) AsClient {
+	return &asClient{cc}
+}
+
+func (c *asClient) GetLink(ctx context.Context, in *GetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error) {
+	out := new(ApplicationLink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) ListPayloadFormattersVersions(ctx context.Context, in *ListPayloadFormattersVersionsRequest, opts ...grpc.CallOption) (*PayloadFormattersVersions, error) {
+	out := new(PayloadFormattersVersions)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/ListPayloadFormattersVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) SetLink(ctx context.Context, in *SetApplicationLinkRequest, opts ...grpc.CallOption) (*ApplicationLink, error) {
+	out := new(ApplicationLink)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/SetLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) RollbackPayloadFormatters(ctx context.Context, in *RollbackPayloadFormattersRequest, opts ...grpc.CallOption) (*PayloadFormattersVersion, error) {
+	out := new(PayloadFormattersVersion)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/RollbackPayloadFormatters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) DeleteLink(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/DeleteLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error) {
+	out := new(ApplicationLinkStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetLinkStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) GetTrafficStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationTrafficStats, error) {
+	out := new(ApplicationTrafficStats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.As/GetTrafficStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsServer is the server API for As service.
+type AsServer interface {
+	GetLink(context.Context, *GetApplicationLinkRequest) (*ApplicationLink, error)
+	ListPayloadFormattersVersions(context.Context, *ListPayloadFormattersVersionsRequest) (*PayloadFormattersVersions, error)
+	// Set a link configuration from the Application Server a Network Server.
+	// This call returns immediately after setting the link configuration; it does not wait for a link to establish.
+	// To get link statistics or errors, use the `GetLinkStats` call.
+	SetLink(context.Context, *SetApplicationLinkRequest) (*ApplicationLink, error)
+	RollbackPayloadFormatters(context.Context, *RollbackPayloadFormattersRequest) (*PayloadFormattersVersion, error)
+	DeleteLink(context.Context, *ApplicationIdentifiers) (*types.Empty, error)
+	// GetLinkStats returns the link statistics.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	// This call returns the error code of the link error if linking to a Network Server failed.
+	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
+	// GetTrafficStats returns the uplink and downlink traffic statistics of the application since the link was established.
+	// This call returns a NotFound error code if there is no link for the given application identifiers.
+	GetTrafficStats(context.Context, *ApplicationIdentifiers) (*ApplicationTrafficStats, error)
+}
+
+// UnimplementedAsServer can be embedded to have forward compatible implementations.
+type UnimplementedAsServer struct {
+}
+
+func (*UnimplementedAsServer) GetLink(ctx context.Context, req *GetApplicationLinkRequest) (*ApplicationLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLink not implemented")
+}
+func (*UnimplementedAsServer) ListPayloadFormattersVersions(ctx context.Context, req *ListPayloadFormattersVersionsRequest) (*PayloadFormattersVersions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayloadFormattersVersions not implemented")
+}
+func (*UnimplementedAsServer) SetLink(ctx context.Context, req *SetApplicationLinkRequest) (*ApplicationLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLink not implemented")
+}
+func (*UnimplementedAsServer) RollbackPayloadFormatters(ctx context.Context, req *RollbackPayloadFormattersRequest) (*PayloadFormattersVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPayloadFormatters not implemented")
+}
+func (*UnimplementedAsServer) DeleteLink(ctx context.Context, req *ApplicationIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLink not implemented")
+}
+func (*UnimplementedAsServer) GetLinkStats(ctx context.Context, req *ApplicationIdentifiers) (*ApplicationLinkStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkStats not implemented")
+}
+func (*UnimplementedAsServer) GetTrafficStats(ctx context.Context, req *ApplicationIdentifiers) (*ApplicationTrafficStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrafficStats not implemented")
+}
+
+func RegisterAsServer(s *grpc.Server, srv AsServer) {
+	s.RegisterService(&_As_serviceDesc, srv)
+}
+
+func _As_GetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).GetLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/GetLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).GetLink(ctx, req.(*GetApplicationLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_ListPayloadFormattersVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayloadFormattersVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).ListPayloadFormattersVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/ListPayloadFormattersVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).ListPayloadFormattersVersions(ctx, req.(*ListPayloadFormattersVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_SetLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicationLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).SetLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/SetLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).SetLink(ctx, req.(*SetApplicationLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_RollbackPayloadFormatters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPayloadFormattersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).RollbackPayloadFormatters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/RollbackPayloadFormatters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).RollbackPayloadFormatters(ctx, req.(*RollbackPayloadFormattersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_DeleteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).DeleteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/DeleteLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).DeleteLink(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_GetLinkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).GetLinkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/GetLinkStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).GetLinkStats(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_GetTrafficStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).GetTrafficStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.As/GetTrafficStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).GetTrafficStats(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _As_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.As",
+	HandlerType: (*AsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLink",
+			Handler:    _As_GetLink_Handler,
+		},
+		{
+			MethodName: "ListPayloadFormattersVersions",
+			Handler:    _As_ListPayloadFormattersVersions_Handler,
+		},
+		{
+			MethodName: "SetLink",
+			Handler:    _As_SetLink_Handler,
+		},
+		{
+			MethodName: "RollbackPayloadFormatters",
+			Handler:    _As_RollbackPayloadFormatters_Handler,
+		},
+		{
+			MethodName: "DeleteLink",
+			Handler:    _As_DeleteLink_Handler,
+		},
+		{
+			MethodName: "GetLinkStats",
+			Handler:    _As_GetLinkStats_Handler,
+		},
+		{
+			MethodName: "GetTrafficStats",
+			Handler:    _As_GetTrafficStats_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
+// AppAsClient is the client API for AppAs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AppAsClient interface {
+	Subscribe(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (AppAs_SubscribeClient, error)
+	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
+	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
+	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+	DownlinkStatusList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinkStatuses, error)
+	GetEndDeviceState(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceState, error)
+	GetMQTTConnectionInfo(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error)
+}
+
+type appAsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAppAsClient(cc *grpc.ClientConn) AppAsClient {
+	return &appAsClient{cc}
+}
+
+func (c *appAsClient) Subscribe(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (AppAs_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AppAs_serviceDesc.Streams[0], "/ttn.lorawan.v3.AppAs/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &appAsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AppAs_SubscribeClient interface {
+	Recv() (*ApplicationUp, error)
+	grpc.ClientStream
+}
+
+type appAsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *appAsSubscribeClient) Recv() (*ApplicationUp, error) {
+	m := new(ApplicationUp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *appAsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueuePush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error) {
+	out := new(DecodeUplinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DecodeUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error) {
+	out := new(EncodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/EncodeDownlink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
+	out := new(ApplicationDownlinks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkQueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) DownlinkStatusList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinkStatuses, error) {
+	out := new(ApplicationDownlinkStatuses)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/DownlinkStatusList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) GetEndDeviceState(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*EndDeviceState, error) {
+	out := new(EndDeviceState)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/GetEndDeviceState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appAsClient) GetMQTTConnectionInfo(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*MQTTConnectionInfo, error) {
+	out := new(MQTTConnectionInfo)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AppAsServer is the server API for AppAs service.
+type AppAsServer interface {
+	Subscribe(*ApplicationIdentifiers, AppAs_SubscribeServer) error
+	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
+	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
+	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+	DownlinkStatusList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinkStatuses, error)
+	GetEndDeviceState(context.Context, *EndDeviceIdentifiers) (*EndDeviceState, error)
+	GetMQTTConnectionInfo(context.Context, *ApplicationIdentifiers) (*MQTTConnectionInfo, error)
+}
+
+// UnimplementedAppAsServer can be embedded to have forward compatible implementations.
+type UnimplementedAppAsServer struct {
+}
+
+func (*UnimplementedAppAsServer) Subscribe(req *ApplicationIdentifiers, srv AppAs_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (*UnimplementedAppAsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+}
+func (*UnimplementedAppAsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+}
+func (*UnimplementedAppAsServer) DecodeUplink(ctx context.Context, req *DecodeUplinkRequest) (*DecodeUplinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeUplink not implemented")
+}
+func (*UnimplementedAppAsServer) EncodeDownlink(ctx context.Context, req *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeDownlink not implemented")
+}
+func (*UnimplementedAppAsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+}
+func (*UnimplementedAppAsServer) DownlinkStatusList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinkStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkStatusList not implemented")
+}
+func (*UnimplementedAppAsServer) GetEndDeviceState(ctx context.Context, req *EndDeviceIdentifiers) (*EndDeviceState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEndDeviceState not implemented")
+}
+func (*UnimplementedAppAsServer) GetMQTTConnectionInfo(ctx context.Context, req *ApplicationIdentifiers) (*MQTTConnectionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMQTTConnectionInfo not implemented")
+}
+
+func RegisterAppAsServer(s *grpc.Server, srv AppAsServer) {
+	s.RegisterService(&_AppAs_serviceDesc, srv)
+}
+
+func _AppAs_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ApplicationIdentifiers)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AppAsServer).Subscribe(m, &appAsSubscribeServer{stream})
+}
+
+type AppAs_SubscribeServer interface {
+	Send(*ApplicationUp) error
+	grpc.ServerStream
+}
+
+type appAsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *appAsSubscribeServer) Send(m *ApplicationUp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AppAs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DownlinkQueuePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueuePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DownlinkQueueReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueueReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_DecodeUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DecodeUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DecodeUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DecodeUplink(ctx, req.(*DecodeUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_EncodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).EncodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/EncodeDownlink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).EncodeDownlink(ctx, req.(*EncodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DownlinkQueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkQueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_DownlinkStatusList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).DownlinkStatusList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/DownlinkStatusList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).DownlinkStatusList(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_GetEndDeviceState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).GetEndDeviceState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/GetEndDeviceState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).GetEndDeviceState(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AppAs_GetMQTTConnectionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AppAsServer).GetMQTTConnectionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AppAs/GetMQTTConnectionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AppAsServer).GetMQTTConnectionInfo(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AppAs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AppAs",
+	HandlerType: (*AppAsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DownlinkQueuePush",
+			Handler:    _AppAs_DownlinkQueuePush_Handler,
+		},
+		{
+			MethodName: "DownlinkQueueReplace",
+			Handler:    _AppAs_DownlinkQueueReplace_Handler,
+		},
+		{
+			MethodName: "DecodeUplink",
+			Handler:    _AppAs_DecodeUplink_Handler,
+		},
+		{
+			MethodName: "EncodeDownlink",
+			Handler:    _AppAs_EncodeDownlink_Handler,
+		},
+		{
+			MethodName: "DownlinkQueueList",
+			Handler:    _AppAs_DownlinkQueueList_Handler,
+		},
+		{
+			MethodName: "DownlinkStatusList",
+			Handler:    _AppAs_DownlinkStatusList_Handler,
+		},
+		{
+			MethodName: "GetEndDeviceState",
+			Handler:    _AppAs_GetEndDeviceState_Handler,
+		},
+		{
+			MethodName: "GetMQTTConnectionInfo",
+			Handler:    _AppAs_GetMQTTConnectionInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _AppAs_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
+// AsEndDeviceRegistryClient is the client API for AsEndDeviceRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsEndDeviceRegistryClient interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type asEndDeviceRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsEndDeviceRegistryClient(cc *grpc.ClientConn) AsEndDeviceRegistryClient {
+	return &asEndDeviceRegistryClient{cc}
+}
+
+func (c *asEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsEndDeviceRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsEndDeviceRegistryServer is the server API for AsEndDeviceRegistry service.
+type AsEndDeviceRegistryServer interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+}
+
+// UnimplementedAsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedAsEndDeviceRegistryServer struct {
+}
+
+func (*UnimplementedAsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedAsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedAsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterAsEndDeviceRegistryServer(s *grpc.Server, srv AsEndDeviceRegistryServer) {
+	s.RegisterService(&_AsEndDeviceRegistry_serviceDesc, srv)
+}
+
+func _AsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsEndDeviceRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsEndDeviceRegistry",
+	HandlerType: (*AsEndDeviceRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _AsEndDeviceRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _AsEndDeviceRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AsEndDeviceRegistry_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
+func (m *ApplicationLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS {
+		i--
+		if m.TLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DefaultFormatters != nil {
+		{
+			size, err := m.DefaultFormatters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.APIKey) > 0 {
+		i -= len(m.APIKey)
+		copy(dAtA[i:], m.APIKey)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.APIKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NetworkServerAddress) > 0 {
+		i -= len(m.NetworkServerAddress)
+		copy(dAtA[i:], m.NetworkServerAddress)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.NetworkServerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApplicationLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApplicationLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetApplicationLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetApplicationLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetApplicationLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.ApplicationLink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationLinkStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationLinkStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationLinkStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DownlinkCount != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.DownlinkCount)
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastDownlinkForwardedAt != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDownlinkForwardedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintApplicationserver(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UpCount != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.UpCount)
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUpReceivedAt != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastUpReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintApplicationserver(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NetworkServerAddress) > 0 {
+		i -= len(m.NetworkServerAddress)
+		copy(dAtA[i:], m.NetworkServerAddress)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.NetworkServerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LinkedAt != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LinkedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintApplicationserver(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDownlinkStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDownlinkStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDownlinkStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.UplinksSinceSent != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.UplinksSinceSent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationDownlinkStatuses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDownlinkStatuses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDownlinkStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRateIndexCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRateIndexCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRateIndexCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.Count)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRates) > 0 {
+		for iNdEx := len(m.DataRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxPayloadSize != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.MaxPayloadSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinPayloadSize != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.MinPayloadSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PayloadBytes != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.PayloadBytes)
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConfirmedCount != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.ConfirmedCount)
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, m.Count)
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeviceProfileTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceProfileTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceProfileTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceProfiles) > 0 {
+		for iNdEx := len(m.DeviceProfiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeviceProfiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Downlink != nil {
+		{
+			size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Uplink != nil {
+		{
+			size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Battery != nil {
+		{
+			size, err := m.Battery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.LastLocation != nil {
+		{
+			size, err := m.LastLocation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastDecodedPayloadFPort != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.LastDecodedPayloadFPort))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastDecodedPayloadReceivedAt != nil {
+		n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDecodedPayloadReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDecodedPayloadReceivedAt):])
+		if err12 != nil {
+			return 0, err12
+		}
+		i -= n12
+		i = encodeVarintApplicationserver(dAtA, i, uint64(n12))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastDecodedPayload != nil {
+		{
+			size, err := m.LastDecodedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastSeenAt != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastSeenAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintApplicationserver(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodeUplinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodeUplinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodeUplinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodeUplinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodeUplinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodeUplinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Uplink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodeDownlinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodeDownlinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodeDownlinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Parameter) > 0 {
+		i -= len(m.Parameter)
+		copy(dAtA[i:], m.Parameter)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.Parameter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Formatter != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Formatter))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VersionIDs != nil {
+		{
+			size, err := m.VersionIDs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodeDownlinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodeDownlinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodeDownlinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Downlink.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EncodeDownlinkErrorDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncodeDownlinkErrorDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncodeDownlinkErrorDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FieldErrors) > 0 {
+		for iNdEx := len(m.FieldErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FieldErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayloadFormattersVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFormattersVersion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayloadFormattersVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintApplicationserver(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Formatters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayloadFormattersVersions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadFormattersVersions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayloadFormattersVersions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListPayloadFormattersVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPayloadFormattersVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListPayloadFormattersVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceID) > 0 {
+		i -= len(m.DeviceID)
+		copy(dAtA[i:], m.DeviceID)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.DeviceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackPayloadFormattersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPayloadFormattersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackPayloadFormattersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintApplicationserver(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeviceID) > 0 {
+		i -= len(m.DeviceID)
+		copy(dAtA[i:], m.DeviceID)
+		i = encodeVarintApplicationserver(dAtA, i, uint64(len(m.DeviceID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationLink(r randyApplicationserver, easy bool) *ApplicationLink {
+	this := &ApplicationLink{}
+	this.NetworkServerAddress = randStringApplicationserver(r)
+	this.APIKey = randStringApplicationserver(r)
+	if r.Intn(5) != 0 {
+		this.DefaultFormatters = NewPopulatedMessagePayloadFormatters(r, easy)
+	}
+	this.TLS = bool(r.Intn(2) == 0)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetApplicationLinkRequest(r randyApplicationserver, easy bool) *GetApplicationLinkRequest {
+	this := &GetApplicationLinkRequest{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	v2 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v2
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetApplicationLinkRequest(r randyApplicationserver, easy bool) *SetApplicationLinkRequest {
+	this := &SetApplicationLinkRequest{}
+	v3 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v3
+	v4 := NewPopulatedApplicationLink(r, easy)
+	this.ApplicationLink = *v4
+	v5 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v5
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationLinkStats(r randyApplicationserver, easy bool) *ApplicationLinkStats {
+	this := &ApplicationLinkStats{}
+	if r.Intn(5) != 0 {
+		this.LinkedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.NetworkServerAddress = randStringApplicationserver(r)
+	if r.Intn(5) != 0 {
+		this.LastUpReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.UpCount = uint64(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastDownlinkForwardedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.DownlinkCount = uint64(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationDownlinkStatus(r randyApplicationserver, easy bool) *ApplicationDownlinkStatus {
+	this := &ApplicationDownlinkStatus{}
+	v6 := NewPopulatedApplicationDownlink(r, easy)
+	this.Downlink = *v6
+	this.State = ApplicationDownlinkStatus_State([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.UplinksSinceSent = uint32(r.Uint32())
+	v7 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v7
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationDownlinkStatuses(r randyApplicationserver, easy bool) *ApplicationDownlinkStatuses {
+	this := &ApplicationDownlinkStatuses{}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.Statuses = make([]*ApplicationDownlinkStatus, v8)
+		for i := 0; i < v8; i++ {
+			this.Statuses[i] = NewPopulatedApplicationDownlinkStatus(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDataRateIndexCount(r randyApplicationserver, easy bool) *DataRateIndexCount {
+	this := &DataRateIndexCount{}
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.Count = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMessageTrafficStats(r randyApplicationserver, easy bool) *MessageTrafficStats {
+	this := &MessageTrafficStats{}
+	this.Count = uint64(uint64(r.Uint32()))
+	this.ConfirmedCount = uint64(uint64(r.Uint32()))
+	this.PayloadBytes = uint64(uint64(r.Uint32()))
+	this.MinPayloadSize = uint32(r.Uint32())
+	this.MaxPayloadSize = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.DataRates = make([]*DataRateIndexCount, v9)
+		for i := 0; i < v9; i++ {
+			this.DataRates[i] = NewPopulatedDataRateIndexCount(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeviceProfileTrafficStats(r randyApplicationserver, easy bool) *DeviceProfileTrafficStats {
+	this := &DeviceProfileTrafficStats{}
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedMessageTrafficStats(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedMessageTrafficStats(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationTrafficStats(r randyApplicationserver, easy bool) *ApplicationTrafficStats {
+	this := &ApplicationTrafficStats{}
+	v10 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v10
+	if r.Intn(5) != 0 {
+		this.Uplink = NewPopulatedMessageTrafficStats(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Downlink = NewPopulatedMessageTrafficStats(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.DeviceProfiles = make([]*DeviceProfileTrafficStats, v11)
+		for i := 0; i < v11; i++ {
+			this.DeviceProfiles[i] = NewPopulatedDeviceProfileTrafficStats(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceState(r randyApplicationserver, easy bool) *EndDeviceState {
+	this := &EndDeviceState{}
+	v12 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v12
+	if r.Intn(5) != 0 {
+		this.LastSeenAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastDecodedPayload = types.NewPopulatedStruct(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastDecodedPayloadReceivedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.LastDecodedPayloadFPort = uint32(r.Uint32())
+	if r.Intn(5) != 0 {
+		this.LastLocation = NewPopulatedApplicationLocation(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.Battery = types.NewPopulatedFloatValue(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeUplinkRequest(r randyApplicationserver, easy bool) *DecodeUplinkRequest {
+	this := &DecodeUplinkRequest{}
+	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v13
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	v14 := NewPopulatedApplicationUplink(r, easy)
+	this.Uplink = *v14
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.Parameter = randStringApplicationserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodeUplinkResponse(r randyApplicationserver, easy bool) *DecodeUplinkResponse {
+	this := &DecodeUplinkResponse{}
+	v15 := NewPopulatedApplicationUplink(r, easy)
+	this.Uplink = *v15
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEncodeDownlinkRequest(r randyApplicationserver, easy bool) *EncodeDownlinkRequest {
+	this := &EncodeDownlinkRequest{}
+	v16 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v16
+	if r.Intn(5) != 0 {
+		this.VersionIDs = NewPopulatedEndDeviceVersionIdentifiers(r, easy)
+	}
+	v17 := NewPopulatedApplicationDownlink(r, easy)
+	this.Downlink = *v17
+	this.Formatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.Parameter = randStringApplicationserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEncodeDownlinkResponse(r randyApplicationserver, easy bool) *EncodeDownlinkResponse {
+	this := &EncodeDownlinkResponse{}
+	v18 := NewPopulatedApplicationDownlink(r, easy)
+	this.Downlink = *v18
+	if r.Intn(5) != 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEncodeDownlinkErrorDetails(r randyApplicationserver, easy bool) *EncodeDownlinkErrorDetails {
+	this := &EncodeDownlinkErrorDetails{}
+	if r.Intn(5) != 0 {
+		v19 := r.Intn(5)
+		this.FieldErrors = make([]*ErrorDetails, v19)
+		for i := 0; i < v19; i++ {
+			this.FieldErrors[i] = NewPopulatedErrorDetails(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPayloadFormattersVersion(r randyApplicationserver, easy bool) *PayloadFormattersVersion {
+	this := &PayloadFormattersVersion{}
+	this.Version = uint32(r.Uint32())
+	v20 := NewPopulatedMessagePayloadFormatters(r, easy)
+	this.Formatters = *v20
+	v21 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPayloadFormattersVersions(r randyApplicationserver, easy bool) *PayloadFormattersVersions {
+	this := &PayloadFormattersVersions{}
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(5)
+		this.Versions = make([]*PayloadFormattersVersion, v22)
+		for i := 0; i < v22; i++ {
+			this.Versions[i] = NewPopulatedPayloadFormattersVersion(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListPayloadFormattersVersionsRequest(r randyApplicationserver, easy bool) *ListPayloadFormattersVersionsRequest {
+	this := &ListPayloadFormattersVersionsRequest{}
+	v23 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v23
+	this.DeviceID = randStringApplicationserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRollbackPayloadFormattersRequest(r randyApplicationserver, easy bool) *RollbackPayloadFormattersRequest {
+	this := &RollbackPayloadFormattersRequest{}
+	v24 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v24
+	this.DeviceID = randStringApplicationserver(r)
+	this.Version = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserver interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserver(r randyApplicationserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserver(r randyApplicationserver) string {
+	v6 := r.Intn(100)
+	tmps := make([]rune, v6)
+	for i := 0; i < v6; i++ {
+		tmps[i] = randUTF8RuneApplicationserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplicationserver(r randyApplicationserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplicationserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplicationserver(dAtA []byte, r randyApplicationserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		v7 := r.Int63()
+		if r.Intn(2) == 0 {
+			v7 *= -1
+		}
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(v7))
+	case 1:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplicationserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ApplicationLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = len(m.APIKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.DefaultFormatters != nil {
+		l = m.DefaultFormatters.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.TLS {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetApplicationLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *SetApplicationLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.ApplicationLink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *ApplicationLinkStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LinkedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LinkedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = len(m.NetworkServerAddress)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.LastUpReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastUpReceivedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.UpCount != 0 {
+		n += 1 + sovApplicationserver(m.UpCount)
+	}
+	if m.LastDownlinkForwardedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDownlinkForwardedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.DownlinkCount != 0 {
+		n += 1 + sovApplicationserver(m.DownlinkCount)
+	}
+	return n
+}
+
+func (m *ApplicationDownlinkStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Downlink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovApplicationserver(uint64(m.State))
+	}
+	if m.UplinksSinceSent != 0 {
+		n += 1 + sovApplicationserver(uint64(m.UplinksSinceSent))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *ApplicationDownlinkStatuses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DataRateIndexCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DataRateIndex != 0 {
+		n += 1 + sovApplicationserver(uint64(m.DataRateIndex))
+	}
+	if m.Count != 0 {
+		n += 1 + sovApplicationserver(m.Count)
+	}
+	return n
+}
+
+func (m *MessageTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovApplicationserver(m.Count)
+	}
+	if m.ConfirmedCount != 0 {
+		n += 1 + sovApplicationserver(m.ConfirmedCount)
+	}
+	if m.PayloadBytes != 0 {
+		n += 1 + sovApplicationserver(m.PayloadBytes)
+	}
+	if m.MinPayloadSize != 0 {
+		n += 1 + sovApplicationserver(uint64(m.MinPayloadSize))
+	}
+	if m.MaxPayloadSize != 0 {
+		n += 1 + sovApplicationserver(uint64(m.MaxPayloadSize))
+	}
+	if len(m.DataRates) > 0 {
+		for _, e := range m.DataRates {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeviceProfileTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Uplink != nil {
+		l = m.Uplink.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Downlink != nil {
+		l = m.Downlink.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if len(m.DeviceProfiles) > 0 {
+		for _, e := range m.DeviceProfiles {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.LastSeenAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastSeenAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.LastDecodedPayload != nil {
+		l = m.LastDecodedPayload.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.LastDecodedPayloadReceivedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDecodedPayloadReceivedAt)
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.LastDecodedPayloadFPort != 0 {
+		n += 1 + sovApplicationserver(uint64(m.LastDecodedPayloadFPort))
+	}
+	if m.LastLocation != nil {
+		l = m.LastLocation.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Battery != nil {
+		l = m.Battery.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodeUplinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = m.Uplink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodeUplinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Uplink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EncodeDownlinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.VersionIDs != nil {
+		l = m.VersionIDs.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	l = m.Downlink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Formatter != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Formatter))
+	}
+	l = len(m.Parameter)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EncodeDownlinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Downlink.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EncodeDownlinkErrorDetails) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FieldErrors) > 0 {
+		for _, e := range m.FieldErrors {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayloadFormattersVersion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Version))
+	}
+	l = m.Formatters.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserver(uint64(l))
+	return n
+}
+
+func (m *PayloadFormattersVersions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovApplicationserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListPayloadFormattersVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = len(m.DeviceID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackPayloadFormattersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserver(uint64(l))
+	l = len(m.DeviceID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserver(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovApplicationserver(uint64(m.Version))
+	}
+	return n
+}
+
+func sovApplicationserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserver(x uint64) (n int) {
+	return sovApplicationserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *ApplicationLink) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationLink{`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
+		`DefaultFormatters:` + strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
+		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationLinkRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetApplicationLinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetApplicationLinkRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`ApplicationLink:` + strings.Replace(strings.Replace(this.ApplicationLink.String(), "ApplicationLink", "ApplicationLink", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationLinkStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationLinkStats{`,
+		`LinkedAt:` + strings.Replace(fmt.Sprintf("%v", this.LinkedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
+		`LastUpReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUpReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`UpCount:` + fmt.Sprintf("%v", this.UpCount) + `,`,
+		`LastDownlinkForwardedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkForwardedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationDownlinkStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDownlinkStatus{`,
+		`Downlink:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Downlink), "ApplicationDownlink", "ApplicationDownlink", 1), `&`, ``, 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`UplinksSinceSent:` + fmt.Sprintf("%v", this.UplinksSinceSent) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *ApplicationDownlinkStatuses) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForStatuses := "[]*ApplicationDownlinkStatus{"
+	for _, f := range this.Statuses {
+		repeatedStringForStatuses += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlinkStatus", "ApplicationDownlinkStatus", 1) + ","
+	}
+	repeatedStringForStatuses += "}"
+	s := strings.Join([]string{`&ApplicationDownlinkStatuses{`,
+		`Statuses:` + repeatedStringForStatuses + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *DataRateIndexCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRateIndexCount{`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *MessageTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDataRates := "[]*DataRateIndexCount{"
+	for _, f := range this.DataRates {
+		repeatedStringForDataRates += strings.Replace(fmt.Sprintf("%v", f), "DataRateIndexCount", "DataRateIndexCount", 1) + ","
+	}
+	repeatedStringForDataRates += "}"
+	s := strings.Join([]string{`&MessageTrafficStats{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`ConfirmedCount:` + fmt.Sprintf("%v", this.ConfirmedCount) + `,`,
+		`PayloadBytes:` + fmt.Sprintf("%v", this.PayloadBytes) + `,`,
+		`MinPayloadSize:` + fmt.Sprintf("%v", this.MinPayloadSize) + `,`,
+		`MaxPayloadSize:` + fmt.Sprintf("%v", this.MaxPayloadSize) + `,`,
+		`DataRates:` + repeatedStringForDataRates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *DeviceProfileTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeviceProfileTrafficStats{`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Uplink:` + strings.Replace(fmt.Sprintf("%v", this.Uplink), "MessageTrafficStats", "MessageTrafficStats", 1) + `,`,
+		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "MessageTrafficStats", "MessageTrafficStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *ApplicationTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeviceProfiles := "[]*DeviceProfileTrafficStats{"
+	for _, f := range this.DeviceProfiles {
+		repeatedStringForDeviceProfiles += strings.Replace(fmt.Sprintf("%v", f), "DeviceProfileTrafficStats", "DeviceProfileTrafficStats", 1) + ","
+	}
+	repeatedStringForDeviceProfiles += "}"
+	s := strings.Join([]string{`&ApplicationTrafficStats{`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Uplink:` + strings.Replace(fmt.Sprintf("%v", this.Uplink), "MessageTrafficStats", "MessageTrafficStats", 1) + `,`,
+		`Downlink:` + strings.Replace(fmt.Sprintf("%v", this.Downlink), "MessageTrafficStats", "MessageTrafficStats", 1) + `,`,
+		`DeviceProfiles:` + repeatedStringForDeviceProfiles + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *EndDeviceState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceState{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`LastSeenAt:` + strings.Replace(fmt.Sprintf("%v", this.LastSeenAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastDecodedPayload:` + strings.Replace(fmt.Sprintf("%v", this.LastDecodedPayload), "Struct", "types.Struct", 1) + `,`,
+		`LastDecodedPayloadReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDecodedPayloadReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastDecodedPayloadFPort:` + fmt.Sprintf("%v", this.LastDecodedPayloadFPort) + `,`,
+		`LastLocation:` + strings.Replace(fmt.Sprintf("%v", this.LastLocation), "ApplicationLocation", "ApplicationLocation", 1) + `,`,
+		`Battery:` + strings.Replace(fmt.Sprintf("%v", this.Battery), "FloatValue", "types.FloatValue", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *DecodeUplinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodeUplinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Uplink:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Uplink), "ApplicationUplink", "ApplicationUplink", 1), `&`, ``, 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *DecodeUplinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodeUplinkResponse{`,
+		`Uplink:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Uplink), "ApplicationUplink", "ApplicationUplink", 1), `&`, ``, 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *EncodeDownlinkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncodeDownlinkRequest{`,
+		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`VersionIDs:` + strings.Replace(fmt.Sprintf("%v", this.VersionIDs), "EndDeviceVersionIdentifiers", "EndDeviceVersionIdentifiers", 1) + `,`,
+		`Downlink:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Downlink), "ApplicationDownlink", "ApplicationDownlink", 1), `&`, ``, 1) + `,`,
+		`Formatter:` + fmt.Sprintf("%v", this.Formatter) + `,`,
+		`Parameter:` + fmt.Sprintf("%v", this.Parameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *EncodeDownlinkResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EncodeDownlinkResponse{`,
+		`Downlink:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Downlink), "ApplicationDownlink", "ApplicationDownlink", 1), `&`, ``, 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *EncodeDownlinkErrorDetails) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFieldErrors := "[]*ErrorDetails{"
+	for _, f := range this.FieldErrors {
+		repeatedStringForFieldErrors += strings.Replace(fmt.Sprintf("%v", f), "ErrorDetails", "ErrorDetails", 1) + ","
+	}
+	repeatedStringForFieldErrors += "}"
+	s := strings.Join([]string{`&EncodeDownlinkErrorDetails{`,
+		`FieldErrors:` + repeatedStringForFieldErrors + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *PayloadFormattersVersion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadFormattersVersion{`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Formatters:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Formatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *PayloadFormattersVersions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVersions := "[]*PayloadFormattersVersion{"
+	for _, f := range this.Versions {
+		repeatedStringForVersions += strings.Replace(fmt.Sprintf("%v", f), "PayloadFormattersVersion", "PayloadFormattersVersion", 1) + ","
+	}
+	repeatedStringForVersions += "}"
+	s := strings.Join([]string{`&PayloadFormattersVersions{`,
+		`Versions:` + repeatedStringForVersions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *ListPayloadFormattersVersionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListPayloadFormattersVersionsRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceID:` + fmt.Sprintf("%v", this.DeviceID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *RollbackPayloadFormattersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackPayloadFormattersRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceID:` + fmt.Sprintf("%v", this.DeviceID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func valueToStringApplicationserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DefaultFormatters == nil {
+				m.DefaultFormatters = &MessagePayloadFormatters{}
+			}
+			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TLS = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkedAt == nil {
+				m.LinkedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastUpReceivedAt == nil {
+				m.LastUpReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
+			}
+			m.UpCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDownlinkForwardedAt == nil {
+				m.LastDownlinkForwardedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+			}
+			m.DownlinkCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownlinkCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDownlinkStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDownlinkStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDownlinkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ApplicationDownlinkStatus_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinksSinceSent", wireType)
+			}
+			m.UplinksSinceSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UplinksSinceSent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ApplicationDownlinkStatuses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDownlinkStatuses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDownlinkStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &ApplicationDownlinkStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DataRateIndexCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRateIndexCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRateIndexCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
+			}
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MessageTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmedCount", wireType)
+			}
+			m.ConfirmedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadBytes", wireType)
+			}
+			m.PayloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPayloadSize", wireType)
+			}
+			m.MinPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPayloadSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayloadSize", wireType)
+			}
+			m.MaxPayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayloadSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRates = append(m.DataRates, &DataRateIndexCount{})
+			if err := m.DataRates[len(m.DataRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DeviceProfileTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceProfileTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceProfileTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &MessageTrafficStats{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &MessageTrafficStats{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ApplicationTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Uplink == nil {
+				m.Uplink = &MessageTrafficStats{}
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Downlink == nil {
+				m.Downlink = &MessageTrafficStats{}
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceProfiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceProfiles = append(m.DeviceProfiles, &DeviceProfileTrafficStats{})
+			if err := m.DeviceProfiles[len(m.DeviceProfiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EndDeviceState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeenAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeenAt == nil {
+				m.LastSeenAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastSeenAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDecodedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDecodedPayload == nil {
+				m.LastDecodedPayload = &types.Struct{}
+			}
+			if err := m.LastDecodedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDecodedPayloadReceivedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastDecodedPayloadReceivedAt == nil {
+				m.LastDecodedPayloadReceivedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDecodedPayloadReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDecodedPayloadFPort", wireType)
+			}
+			m.LastDecodedPayloadFPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDecodedPayloadFPort |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastLocation == nil {
+				m.LastLocation = &ApplicationLocation{}
+			}
+			if err := m.LastLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Battery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Battery == nil {
+				m.Battery = &types.FloatValue{}
+			}
+			if err := m.Battery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DecodeUplinkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodeUplinkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodeUplinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DecodeUplinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodeUplinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodeUplinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uplink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovApplicationserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozApplicationserver(x uint64) (n int) {
-	return sovApplicationserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *ApplicationLink) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationLink{`,
-		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
-		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
-		`DefaultFormatters:` + strings.Replace(fmt.Sprintf("%v", this.DefaultFormatters), "MessagePayloadFormatters", "MessagePayloadFormatters", 1) + `,`,
-		`TLS:` + fmt.Sprintf("%v", this.TLS) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetApplicationLinkRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GetApplicationLinkRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SetApplicationLinkRequest) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&SetApplicationLinkRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`ApplicationLink:` + strings.Replace(strings.Replace(this.ApplicationLink.String(), "ApplicationLink", "ApplicationLink", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApplicationLinkStats) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationLinkStats{`,
-		`LinkedAt:` + strings.Replace(fmt.Sprintf("%v", this.LinkedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`NetworkServerAddress:` + fmt.Sprintf("%v", this.NetworkServerAddress) + `,`,
-		`LastUpReceivedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastUpReceivedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`UpCount:` + fmt.Sprintf("%v", this.UpCount) + `,`,
-		`LastDownlinkForwardedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastDownlinkForwardedAt), "Timestamp", "types.Timestamp", 1) + `,`,
-		`DownlinkCount:` + fmt.Sprintf("%v", this.DownlinkCount) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringApplicationserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
+
+func (m *EncodeDownlinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1679,17 +7434,17 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationLink: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodeDownlinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationLink: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodeDownlinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIdentifiers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -1699,27 +7454,116 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.EndDeviceIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionIDs == nil {
+				m.VersionIDs = &EndDeviceVersionIdentifiers{}
+			}
+			if err := m.VersionIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatter", wireType)
+			}
+			m.Formatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1747,11 +7591,65 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIKey = string(dAtA[iNdEx:postIndex])
+			m.Parameter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *EncodeDownlinkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncodeDownlinkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncodeDownlinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFormatters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downlink", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1778,18 +7676,15 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DefaultFormatters == nil {
-				m.DefaultFormatters = &MessagePayloadFormatters{}
-			}
-			if err := m.DefaultFormatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Downlink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -1799,12 +7694,28 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.TLS = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserver(dAtA[iNdEx:])
@@ -1829,7 +7740,8 @@ func (m *ApplicationLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+
+func (m *EncodeDownlinkErrorDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1852,48 +7764,15 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EncodeDownlinkErrorDetails: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EncodeDownlinkErrorDetails: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserver
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldErrors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1920,7 +7799,8 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FieldErrors = append(m.FieldErrors, &ErrorDetails{})
+			if err := m.FieldErrors[len(m.FieldErrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1948,7 +7828,8 @@ func (m *GetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
+
+func (m *PayloadFormattersVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1971,15 +7852,34 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayloadFormattersVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetApplicationLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayloadFormattersVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formatters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2006,13 +7906,13 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Formatters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationLink", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2039,13 +7939,67 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationLink.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *PayloadFormattersVersions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadFormattersVersions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadFormattersVersions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2072,7 +8026,8 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, &PayloadFormattersVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2100,7 +8055,8 @@ func (m *SetApplicationLinkRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
+
+func (m *ListPayloadFormattersVersionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2123,15 +8079,15 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationLinkStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPayloadFormattersVersionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationLinkStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPayloadFormattersVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,16 +8114,13 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LinkedAt == nil {
-				m.LinkedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LinkedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkServerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2195,11 +8148,65 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkServerAddress = string(dAtA[iNdEx:postIndex])
+			m.DeviceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *RollbackPayloadFormattersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackPayloadFormattersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackPayloadFormattersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpReceivedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2226,37 +8233,15 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastUpReceivedAt == nil {
-				m.LastUpReceivedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastUpReceivedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpCount", wireType)
-			}
-			m.UpCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowApplicationserver
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastDownlinkForwardedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -2266,33 +8251,29 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastDownlinkForwardedAt == nil {
-				m.LastDownlinkForwardedAt = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastDownlinkForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeviceID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.DownlinkCount = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserver
@@ -2302,7 +8283,7 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownlinkCount |= uint64(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2331,6 +8312,7 @@ func (m *ApplicationLinkStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+
 func skipApplicationserver(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,7 @@ var ApplicationLinkFieldPathsNested = []string{
 	"default_formatters",
 	"default_formatters.down_formatter",
 	"default_formatters.down_formatter_parameter",
+	"default_formatters.f_port_formatters",
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"network_server_address",
@@ -38,6 +39,7 @@ var SetApplicationLinkRequestFieldPathsNested = []string{
 	"link.default_formatters",
 	"link.default_formatters.down_formatter",
 	"link.default_formatters.down_formatter_parameter",
+	"link.default_formatters.f_port_formatters",
 	"link.default_formatters.up_formatter",
 	"link.default_formatters.up_formatter_parameter",
 	"link.network_server_address",
@@ -66,3 +68,362 @@ var ApplicationLinkStatsFieldPathsTopLevel = []string{
 	"network_server_address",
 	"up_count",
 }
+
+var ApplicationDownlinkStatusFieldPathsNested = []string{
+	"downlink",
+	"downlink.class_b_c",
+	"downlink.class_b_c.absolute_time",
+	"downlink.class_b_c.gateways",
+	"downlink.confirmed",
+	"downlink.correlation_ids",
+	"downlink.decoded_payload",
+	"downlink.expires_at",
+	"downlink.f_cnt",
+	"downlink.f_port",
+	"downlink.frm_payload",
+	"downlink.priority",
+	"downlink.session_key_id",
+	"state",
+	"updated_at",
+	"uplinks_since_sent",
+}
+
+var ApplicationDownlinkStatusFieldPathsTopLevel = []string{
+	"downlink",
+	"state",
+	"updated_at",
+	"uplinks_since_sent",
+}
+
+var ApplicationDownlinkStatusesFieldPathsNested = []string{
+	"statuses",
+}
+
+var ApplicationDownlinkStatusesFieldPathsTopLevel = []string{
+	"statuses",
+}
+
+var DataRateIndexCountFieldPathsNested = []string{
+	"count",
+	"data_rate_index",
+}
+
+var DataRateIndexCountFieldPathsTopLevel = []string{
+	"count",
+	"data_rate_index",
+}
+
+var MessageTrafficStatsFieldPathsNested = []string{
+	"confirmed_count",
+	"count",
+	"data_rates",
+	"max_payload_size",
+	"min_payload_size",
+	"payload_bytes",
+}
+
+var MessageTrafficStatsFieldPathsTopLevel = []string{
+	"confirmed_count",
+	"count",
+	"data_rates",
+	"max_payload_size",
+	"min_payload_size",
+	"payload_bytes",
+}
+
+var DeviceProfileTrafficStatsFieldPathsNested = []string{
+	"downlink",
+	"downlink.confirmed_count",
+	"downlink.count",
+	"downlink.data_rates",
+	"downlink.max_payload_size",
+	"downlink.min_payload_size",
+	"downlink.payload_bytes",
+	"uplink",
+	"uplink.confirmed_count",
+	"uplink.count",
+	"uplink.data_rates",
+	"uplink.max_payload_size",
+	"uplink.min_payload_size",
+	"uplink.payload_bytes",
+	"version_ids",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var DeviceProfileTrafficStatsFieldPathsTopLevel = []string{
+	"downlink",
+	"uplink",
+	"version_ids",
+}
+
+var ApplicationTrafficStatsFieldPathsNested = []string{
+	"device_profiles",
+	"downlink",
+	"downlink.confirmed_count",
+	"downlink.count",
+	"downlink.data_rates",
+	"downlink.max_payload_size",
+	"downlink.min_payload_size",
+	"downlink.payload_bytes",
+	"started_at",
+	"uplink",
+	"uplink.confirmed_count",
+	"uplink.count",
+	"uplink.data_rates",
+	"uplink.max_payload_size",
+	"uplink.min_payload_size",
+	"uplink.payload_bytes",
+}
+
+var ApplicationTrafficStatsFieldPathsTopLevel = []string{
+	"device_profiles",
+	"downlink",
+	"started_at",
+	"uplink",
+}
+
+var EndDeviceStateFieldPathsNested = []string{
+	"battery",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"last_decoded_payload",
+	"last_decoded_payload_f_port",
+	"last_decoded_payload_received_at",
+	"last_location",
+	"last_location.attributes",
+	"last_location.location",
+	"last_location.location.accuracy",
+	"last_location.location.altitude",
+	"last_location.location.latitude",
+	"last_location.location.longitude",
+	"last_location.location.source",
+	"last_location.service",
+	"last_seen_at",
+}
+
+var EndDeviceStateFieldPathsTopLevel = []string{
+	"battery",
+	"end_device_ids",
+	"last_decoded_payload",
+	"last_decoded_payload_f_port",
+	"last_decoded_payload_received_at",
+	"last_location",
+	"last_seen_at",
+}
+
+var DecodeUplinkRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"formatter",
+	"parameter",
+	"uplink",
+	"uplink.confirmed",
+	"uplink.decoded_payload",
+	"uplink.f_cnt",
+	"uplink.f_port",
+	"uplink.frm_payload",
+	"uplink.normalized_payload",
+	"uplink.normalized_payload_warnings",
+	"uplink.received_at",
+	"uplink.rx_metadata",
+	"uplink.session_key_id",
+	"uplink.settings",
+	"uplink.settings.coding_rate",
+	"uplink.settings.data_rate",
+	"uplink.settings.data_rate.modulation",
+	"uplink.settings.data_rate.modulation.fsk",
+	"uplink.settings.data_rate.modulation.fsk.bit_rate",
+	"uplink.settings.data_rate.modulation.lora",
+	"uplink.settings.data_rate.modulation.lora.bandwidth",
+	"uplink.settings.data_rate.modulation.lora.spreading_factor",
+	"uplink.settings.data_rate.modulation.lrfhss",
+	"uplink.settings.data_rate.modulation.lrfhss.coding_rate",
+	"uplink.settings.data_rate.modulation.lrfhss.modulation_type",
+	"uplink.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"uplink.settings.data_rate_index",
+	"uplink.settings.downlink",
+	"uplink.settings.downlink.antenna_index",
+	"uplink.settings.downlink.invert_polarization",
+	"uplink.settings.downlink.tx_power",
+	"uplink.settings.enable_crc",
+	"uplink.settings.frequency",
+	"uplink.settings.time",
+	"uplink.settings.timestamp",
+	"version_ids",
+}
+
+var DecodeUplinkRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"formatter",
+	"parameter",
+	"uplink",
+	"version_ids",
+}
+
+var DecodeUplinkResponseFieldPathsNested = []string{
+	"error",
+	"uplink",
+	"uplink.confirmed",
+	"uplink.decoded_payload",
+	"uplink.f_cnt",
+	"uplink.f_port",
+	"uplink.frm_payload",
+	"uplink.normalized_payload",
+	"uplink.normalized_payload_warnings",
+	"uplink.received_at",
+	"uplink.rx_metadata",
+	"uplink.session_key_id",
+	"uplink.settings",
+	"uplink.settings.coding_rate",
+	"uplink.settings.data_rate",
+	"uplink.settings.data_rate.modulation",
+	"uplink.settings.data_rate.modulation.fsk",
+	"uplink.settings.data_rate.modulation.fsk.bit_rate",
+	"uplink.settings.data_rate.modulation.lora",
+	"uplink.settings.data_rate.modulation.lora.bandwidth",
+	"uplink.settings.data_rate.modulation.lora.spreading_factor",
+	"uplink.settings.data_rate.modulation.lrfhss",
+	"uplink.settings.data_rate.modulation.lrfhss.coding_rate",
+	"uplink.settings.data_rate.modulation.lrfhss.modulation_type",
+	"uplink.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"uplink.settings.data_rate_index",
+	"uplink.settings.downlink",
+	"uplink.settings.downlink.antenna_index",
+	"uplink.settings.downlink.invert_polarization",
+	"uplink.settings.downlink.tx_power",
+	"uplink.settings.enable_crc",
+	"uplink.settings.frequency",
+	"uplink.settings.time",
+	"uplink.settings.timestamp",
+}
+
+var DecodeUplinkResponseFieldPathsTopLevel = []string{
+	"error",
+	"uplink",
+}
+
+var EncodeDownlinkRequestFieldPathsNested = []string{
+	"downlink",
+	"downlink.class_b_c",
+	"downlink.class_b_c.absolute_time",
+	"downlink.class_b_c.gateways",
+	"downlink.confirmed",
+	"downlink.correlation_ids",
+	"downlink.decoded_payload",
+	"downlink.expires_at",
+	"downlink.f_cnt",
+	"downlink.f_port",
+	"downlink.frm_payload",
+	"downlink.priority",
+	"downlink.session_key_id",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"formatter",
+	"parameter",
+	"version_ids",
+}
+
+var EncodeDownlinkRequestFieldPathsTopLevel = []string{
+	"downlink",
+	"end_device_ids",
+	"formatter",
+	"parameter",
+	"version_ids",
+}
+
+var EncodeDownlinkResponseFieldPathsNested = []string{
+	"downlink",
+	"downlink.class_b_c",
+	"downlink.class_b_c.absolute_time",
+	"downlink.class_b_c.gateways",
+	"downlink.confirmed",
+	"downlink.correlation_ids",
+	"downlink.decoded_payload",
+	"downlink.expires_at",
+	"downlink.f_cnt",
+	"downlink.f_port",
+	"downlink.frm_payload",
+	"downlink.priority",
+	"downlink.session_key_id",
+	"error",
+}
+
+var EncodeDownlinkResponseFieldPathsTopLevel = []string{
+	"downlink",
+	"error",
+}
+
+var EncodeDownlinkErrorDetailsFieldPathsNested = []string{
+	"field_errors",
+}
+
+var EncodeDownlinkErrorDetailsFieldPathsTopLevel = []string{
+	"field_errors",
+}
+
+var PayloadFormattersVersionFieldPathsNested = []string{
+	"created_at",
+	"formatters",
+	"formatters.down_formatter",
+	"formatters.down_formatter_parameter",
+	"formatters.f_port_formatters",
+	"formatters.up_formatter",
+	"formatters.up_formatter_parameter",
+	"version",
+}
+
+var PayloadFormattersVersionFieldPathsTopLevel = []string{
+	"created_at",
+	"formatters",
+	"version",
+}
+
+var PayloadFormattersVersionsFieldPathsNested = []string{
+	"versions",
+}
+
+var PayloadFormattersVersionsFieldPathsTopLevel = []string{
+	"versions",
+}
+
+var ListPayloadFormattersVersionsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_id",
+}
+
+var ListPayloadFormattersVersionsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_id",
+}
+
+var RollbackPayloadFormattersRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_id",
+	"version",
+}
+
+var RollbackPayloadFormattersRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_id",
+	"version",
+}
@@ -4,6 +4,7 @@ package ttnpb
 
 import (
 	fmt "fmt"
+	time "time"
 
 	types "github.com/gogo/protobuf/types"
 )
@@ -233,3 +234,854 @@ func (dst *ApplicationLinkStats) SetFields(src *ApplicationLinkStats, paths ...s
 	}
 	return nil
 }
+
+func (dst *ApplicationDownlinkStatus) SetFields(src *ApplicationDownlinkStatus, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "downlink":
+			if len(subs) > 0 {
+				newDst := &dst.Downlink
+				var newSrc *ApplicationDownlink
+				if src != nil {
+					newSrc = &src.Downlink
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Downlink = src.Downlink
+				} else {
+					var zero ApplicationDownlink
+					dst.Downlink = zero
+				}
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero ApplicationDownlinkStatus_State
+				dst.State = zero
+			}
+		case "uplinks_since_sent":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplinks_since_sent' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinksSinceSent = src.UplinksSinceSent
+			} else {
+				var zero uint32
+				dst.UplinksSinceSent = zero
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				var zero time.Time
+				dst.UpdatedAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationDownlinkStatuses) SetFields(src *ApplicationDownlinkStatuses, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "statuses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'statuses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Statuses = src.Statuses
+			} else {
+				dst.Statuses = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DataRateIndexCount) SetFields(src *DataRateIndexCount, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint64
+				dst.Count = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MessageTrafficStats) SetFields(src *MessageTrafficStats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Count = src.Count
+			} else {
+				var zero uint64
+				dst.Count = zero
+			}
+		case "confirmed_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConfirmedCount = src.ConfirmedCount
+			} else {
+				var zero uint64
+				dst.ConfirmedCount = zero
+			}
+		case "payload_bytes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'payload_bytes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PayloadBytes = src.PayloadBytes
+			} else {
+				var zero uint64
+				dst.PayloadBytes = zero
+			}
+		case "min_payload_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_payload_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinPayloadSize = src.MinPayloadSize
+			} else {
+				var zero uint32
+				dst.MinPayloadSize = zero
+			}
+		case "max_payload_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_payload_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxPayloadSize = src.MaxPayloadSize
+			} else {
+				var zero uint32
+				dst.MaxPayloadSize = zero
+			}
+		case "data_rates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRates = src.DataRates
+			} else {
+				dst.DataRates = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeviceProfileTrafficStats) SetFields(src *DeviceProfileTrafficStats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIDs == nil) && dst.VersionIDs == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIDs
+				}
+				if dst.VersionIDs != nil {
+					newDst = dst.VersionIDs
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIDs = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIDs = src.VersionIDs
+				} else {
+					dst.VersionIDs = nil
+				}
+			}
+		case "uplink":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessageTrafficStats
+				if (src == nil || src.Uplink == nil) && dst.Uplink == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Uplink
+				}
+				if dst.Uplink != nil {
+					newDst = dst.Uplink
+				} else {
+					newDst = &MessageTrafficStats{}
+					dst.Uplink = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Uplink = src.Uplink
+				} else {
+					dst.Uplink = nil
+				}
+			}
+		case "downlink":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessageTrafficStats
+				if (src == nil || src.Downlink == nil) && dst.Downlink == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Downlink
+				}
+				if dst.Downlink != nil {
+					newDst = dst.Downlink
+				} else {
+					newDst = &MessageTrafficStats{}
+					dst.Downlink = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Downlink = src.Downlink
+				} else {
+					dst.Downlink = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationTrafficStats) SetFields(src *ApplicationTrafficStats, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				var zero time.Time
+				dst.StartedAt = zero
+			}
+		case "uplink":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessageTrafficStats
+				if (src == nil || src.Uplink == nil) && dst.Uplink == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Uplink
+				}
+				if dst.Uplink != nil {
+					newDst = dst.Uplink
+				} else {
+					newDst = &MessageTrafficStats{}
+					dst.Uplink = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Uplink = src.Uplink
+				} else {
+					dst.Uplink = nil
+				}
+			}
+		case "downlink":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessageTrafficStats
+				if (src == nil || src.Downlink == nil) && dst.Downlink == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Downlink
+				}
+				if dst.Downlink != nil {
+					newDst = dst.Downlink
+				} else {
+					newDst = &MessageTrafficStats{}
+					dst.Downlink = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Downlink = src.Downlink
+				} else {
+					dst.Downlink = nil
+				}
+			}
+		case "device_profiles":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_profiles' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceProfiles = src.DeviceProfiles
+			} else {
+				dst.DeviceProfiles = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceState) SetFields(src *EndDeviceState, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "last_seen_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_seen_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastSeenAt = src.LastSeenAt
+			} else {
+				dst.LastSeenAt = nil
+			}
+		case "last_decoded_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_decoded_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastDecodedPayload = src.LastDecodedPayload
+			} else {
+				dst.LastDecodedPayload = nil
+			}
+		case "last_decoded_payload_received_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_decoded_payload_received_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastDecodedPayloadReceivedAt = src.LastDecodedPayloadReceivedAt
+			} else {
+				dst.LastDecodedPayloadReceivedAt = nil
+			}
+		case "last_decoded_payload_f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_decoded_payload_f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastDecodedPayloadFPort = src.LastDecodedPayloadFPort
+			} else {
+				var zero uint32
+				dst.LastDecodedPayloadFPort = zero
+			}
+		case "last_location":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationLocation
+				if (src == nil || src.LastLocation == nil) && dst.LastLocation == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastLocation
+				}
+				if dst.LastLocation != nil {
+					newDst = dst.LastLocation
+				} else {
+					newDst = &ApplicationLocation{}
+					dst.LastLocation = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastLocation = src.LastLocation
+				} else {
+					dst.LastLocation = nil
+				}
+			}
+		case "battery":
+			if len(subs) > 0 {
+				return fmt.Errorf("'battery' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Battery = src.Battery
+			} else {
+				dst.Battery = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeUplinkRequest) SetFields(src *DecodeUplinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.VersionIDs = src.VersionIDs
+			} else {
+				dst.VersionIDs = nil
+			}
+		case "uplink":
+			if len(subs) > 0 {
+				newDst := &dst.Uplink
+				var newSrc *ApplicationUplink
+				if src != nil {
+					newSrc = &src.Uplink
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Uplink = src.Uplink
+				} else {
+					var zero ApplicationUplink
+					dst.Uplink = zero
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeUplinkResponse) SetFields(src *DecodeUplinkResponse, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "uplink":
+			if len(subs) > 0 {
+				newDst := &dst.Uplink
+				var newSrc *ApplicationUplink
+				if src != nil {
+					newSrc = &src.Uplink
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Uplink = src.Uplink
+				} else {
+					var zero ApplicationUplink
+					dst.Uplink = zero
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				dst.Error = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EncodeDownlinkRequest) SetFields(src *EncodeDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIdentifiers
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIdentifiers = src.EndDeviceIdentifiers
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIdentifiers = zero
+				}
+			}
+		case "version_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.VersionIDs = src.VersionIDs
+			} else {
+				dst.VersionIDs = nil
+			}
+		case "downlink":
+			if len(subs) > 0 {
+				newDst := &dst.Downlink
+				var newSrc *ApplicationDownlink
+				if src != nil {
+					newSrc = &src.Downlink
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Downlink = src.Downlink
+				} else {
+					var zero ApplicationDownlink
+					dst.Downlink = zero
+				}
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				var zero PayloadFormatter
+				dst.Formatter = zero
+			}
+		case "parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Parameter = src.Parameter
+			} else {
+				var zero string
+				dst.Parameter = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EncodeDownlinkResponse) SetFields(src *EncodeDownlinkResponse, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "downlink":
+			if len(subs) > 0 {
+				newDst := &dst.Downlink
+				var newSrc *ApplicationDownlink
+				if src != nil {
+					newSrc = &src.Downlink
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Downlink = src.Downlink
+				} else {
+					var zero ApplicationDownlink
+					dst.Downlink = zero
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				dst.Error = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EncodeDownlinkErrorDetails) SetFields(src *EncodeDownlinkErrorDetails, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "field_errors":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_errors' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldErrors = src.FieldErrors
+			} else {
+				dst.FieldErrors = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PayloadFormattersVersion) SetFields(src *PayloadFormattersVersion, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+		case "formatters":
+			if len(subs) > 0 {
+				newDst := &dst.Formatters
+				var newSrc *MessagePayloadFormatters
+				if src != nil {
+					newSrc = &src.Formatters
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Formatters = src.Formatters
+				} else {
+					var zero MessagePayloadFormatters
+					dst.Formatters = zero
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				var zero time.Time
+				dst.CreatedAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PayloadFormattersVersions) SetFields(src *PayloadFormattersVersions, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "versions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'versions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Versions = src.Versions
+			} else {
+				dst.Versions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListPayloadFormattersVersionsRequest) SetFields(src *ListPayloadFormattersVersionsRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIdentifiers
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceID = src.DeviceID
+			} else {
+				var zero string
+				dst.DeviceID = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RollbackPayloadFormattersRequest) SetFields(src *RollbackPayloadFormattersRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIdentifiers
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIdentifiers = src.ApplicationIdentifiers
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIdentifiers = zero
+				}
+			}
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceID = src.DeviceID
+			} else {
+				var zero string
+				dst.DeviceID = zero
+			}
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -503,3 +503,1836 @@ var _ interface {
 } = ApplicationLinkStatsValidationError{}
 
 var _ApplicationLinkStats_NetworkServerAddress_Pattern = regexp.MustCompile("^(?:(?:[a-zA-Z0-9]|[a-zA-Z0-9][a-zA-Z0-9\\-]*[a-zA-Z0-9])\\.)*(?:[A-Za-z0-9]|[A-Za-z0-9][A-Za-z0-9\\-]*[A-Za-z0-9])(?::[0-9]{1,5})?$|^$")
+
+// ValidateFields checks the field values on ApplicationDownlinkStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDownlinkStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "downlink":
+
+			if v, ok := interface{}(&m.Downlink).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatusValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "state":
+			// no validation rules for State
+		case "uplinks_since_sent":
+			// no validation rules for UplinksSinceSent
+		case "updated_at":
+
+			if v, ok := interface{}(&m.UpdatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkStatusValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationDownlinkStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkStatusValidationError is the validation error returned by
+// ApplicationDownlinkStatus.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDownlinkStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkStatusValidationError) ErrorName() string {
+	return "ApplicationDownlinkStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkStatusValidationError{}
+
+// ValidateFields checks the field values on ApplicationDownlinkStatuses with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationDownlinkStatuses) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationDownlinkStatusesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "statuses":
+
+			for idx, item := range m.GetStatuses() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationDownlinkStatusesValidationError{
+							field:  fmt.Sprintf("statuses[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationDownlinkStatusesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationDownlinkStatusesValidationError is the validation error returned
+// by ApplicationDownlinkStatuses.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationDownlinkStatusesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationDownlinkStatusesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationDownlinkStatusesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationDownlinkStatusesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationDownlinkStatusesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationDownlinkStatusesValidationError) ErrorName() string {
+	return "ApplicationDownlinkStatusesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationDownlinkStatusesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationDownlinkStatuses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationDownlinkStatusesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationDownlinkStatusesValidationError{}
+
+// ValidateFields checks the field values on DataRateIndexCount with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *DataRateIndexCount) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DataRateIndexCountFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return DataRateIndexCountValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "count":
+			// no validation rules for Count
+		default:
+			return DataRateIndexCountValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DataRateIndexCountValidationError is the validation error returned by
+// DataRateIndexCount.ValidateFields if the designated constraints aren't met.
+type DataRateIndexCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DataRateIndexCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DataRateIndexCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DataRateIndexCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DataRateIndexCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DataRateIndexCountValidationError) ErrorName() string {
+	return "DataRateIndexCountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DataRateIndexCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDataRateIndexCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DataRateIndexCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DataRateIndexCountValidationError{}
+
+// ValidateFields checks the field values on MessageTrafficStats with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *MessageTrafficStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MessageTrafficStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "count":
+			// no validation rules for Count
+		case "confirmed_count":
+			// no validation rules for ConfirmedCount
+		case "payload_bytes":
+			// no validation rules for PayloadBytes
+		case "min_payload_size":
+			// no validation rules for MinPayloadSize
+		case "max_payload_size":
+			// no validation rules for MaxPayloadSize
+		case "data_rates":
+
+			for idx, item := range m.GetDataRates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MessageTrafficStatsValidationError{
+							field:  fmt.Sprintf("data_rates[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MessageTrafficStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MessageTrafficStatsValidationError is the validation error returned by
+// MessageTrafficStats.ValidateFields if the designated constraints aren't met.
+type MessageTrafficStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageTrafficStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageTrafficStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageTrafficStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageTrafficStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageTrafficStatsValidationError) ErrorName() string {
+	return "MessageTrafficStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MessageTrafficStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageTrafficStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageTrafficStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageTrafficStatsValidationError{}
+
+// ValidateFields checks the field values on DeviceProfileTrafficStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeviceProfileTrafficStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeviceProfileTrafficStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeviceProfileTrafficStatsValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink":
+
+			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeviceProfileTrafficStatsValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink":
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeviceProfileTrafficStatsValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DeviceProfileTrafficStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeviceProfileTrafficStatsValidationError is the validation error returned by
+// DeviceProfileTrafficStats.ValidateFields if the designated constraints
+// aren't met.
+type DeviceProfileTrafficStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeviceProfileTrafficStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeviceProfileTrafficStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeviceProfileTrafficStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeviceProfileTrafficStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeviceProfileTrafficStatsValidationError) ErrorName() string {
+	return "DeviceProfileTrafficStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeviceProfileTrafficStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeviceProfileTrafficStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeviceProfileTrafficStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeviceProfileTrafficStatsValidationError{}
+
+// ValidateFields checks the field values on ApplicationTrafficStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationTrafficStats) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationTrafficStatsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "started_at":
+
+			if v, ok := interface{}(&m.StartedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationTrafficStatsValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink":
+
+			if v, ok := interface{}(m.GetUplink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationTrafficStatsValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink":
+
+			if v, ok := interface{}(m.GetDownlink()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationTrafficStatsValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_profiles":
+
+			for idx, item := range m.GetDeviceProfiles() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationTrafficStatsValidationError{
+							field:  fmt.Sprintf("device_profiles[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationTrafficStatsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationTrafficStatsValidationError is the validation error returned by
+// ApplicationTrafficStats.ValidateFields if the designated constraints aren't
+// met.
+type ApplicationTrafficStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationTrafficStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationTrafficStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationTrafficStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationTrafficStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationTrafficStatsValidationError) ErrorName() string {
+	return "ApplicationTrafficStatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationTrafficStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationTrafficStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationTrafficStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationTrafficStatsValidationError{}
+
+// ValidateFields checks the field values on EndDeviceState with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *EndDeviceState) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceStateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_seen_at":
+
+			if v, ok := interface{}(m.GetLastSeenAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "last_seen_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_decoded_payload":
+
+			if v, ok := interface{}(m.GetLastDecodedPayload()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "last_decoded_payload",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_decoded_payload_received_at":
+
+			if v, ok := interface{}(m.GetLastDecodedPayloadReceivedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "last_decoded_payload_received_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_decoded_payload_f_port":
+			// no validation rules for LastDecodedPayloadFPort
+		case "last_location":
+
+			if v, ok := interface{}(m.GetLastLocation()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "last_location",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "battery":
+
+			if v, ok := interface{}(m.GetBattery()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceStateValidationError{
+						field:  "battery",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceStateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceStateValidationError is the validation error returned by
+// EndDeviceState.ValidateFields if the designated constraints aren't met.
+type EndDeviceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceStateValidationError) ErrorName() string {
+	return "EndDeviceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceStateValidationError{}
+
+// ValidateFields checks the field values on DecodeUplinkRequest with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *DecodeUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink":
+
+			if v, ok := interface{}(&m.Uplink).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkRequestValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return DecodeUplinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+
+			if utf8.RuneCountInString(m.GetParameter()) > 40960 {
+				return DecodeUplinkRequestValidationError{
+					field:  "parameter",
+					reason: "value length must be at most 40960 runes",
+				}
+			}
+
+		default:
+			return DecodeUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkRequestValidationError is the validation error returned by
+// DecodeUplinkRequest.ValidateFields if the designated constraints aren't met.
+type DecodeUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkRequestValidationError) ErrorName() string {
+	return "DecodeUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeUplinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeUplinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeUplinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "uplink":
+
+			if v, ok := interface{}(&m.Uplink).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "uplink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeUplinkResponseValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DecodeUplinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeUplinkResponseValidationError is the validation error returned by
+// DecodeUplinkResponse.ValidateFields if the designated constraints aren't
+// met.
+type DecodeUplinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeUplinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeUplinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeUplinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeUplinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeUplinkResponseValidationError) ErrorName() string {
+	return "DecodeUplinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeUplinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeUplinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeUplinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeUplinkResponseValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "version_ids":
+
+			if v, ok := interface{}(m.GetVersionIDs()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "downlink":
+
+			if v, ok := interface{}(&m.Downlink).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkRequestValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetFormatter())]; !ok {
+				return EncodeDownlinkRequestValidationError{
+					field:  "formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "parameter":
+
+			if utf8.RuneCountInString(m.GetParameter()) > 40960 {
+				return EncodeDownlinkRequestValidationError{
+					field:  "parameter",
+					reason: "value length must be at most 40960 runes",
+				}
+			}
+
+		default:
+			return EncodeDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkRequestValidationError is the validation error returned by
+// EncodeDownlinkRequest.ValidateFields if the designated constraints aren't
+// met.
+type EncodeDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkRequestValidationError) ErrorName() string {
+	return "EncodeDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "downlink":
+
+			if v, ok := interface{}(&m.Downlink).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkResponseValidationError{
+						field:  "downlink",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EncodeDownlinkResponseValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EncodeDownlinkResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkResponseValidationError is the validation error returned by
+// EncodeDownlinkResponse.ValidateFields if the designated constraints aren't
+// met.
+type EncodeDownlinkResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkResponseValidationError) ErrorName() string {
+	return "EncodeDownlinkResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkResponseValidationError{}
+
+// ValidateFields checks the field values on EncodeDownlinkErrorDetails with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EncodeDownlinkErrorDetails) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EncodeDownlinkErrorDetailsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "field_errors":
+
+			for idx, item := range m.GetFieldErrors() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EncodeDownlinkErrorDetailsValidationError{
+							field:  fmt.Sprintf("field_errors[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return EncodeDownlinkErrorDetailsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EncodeDownlinkErrorDetailsValidationError is the validation error returned
+// by EncodeDownlinkErrorDetails.ValidateFields if the designated constraints
+// aren't met.
+type EncodeDownlinkErrorDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EncodeDownlinkErrorDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EncodeDownlinkErrorDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EncodeDownlinkErrorDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EncodeDownlinkErrorDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EncodeDownlinkErrorDetailsValidationError) ErrorName() string {
+	return "EncodeDownlinkErrorDetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EncodeDownlinkErrorDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEncodeDownlinkErrorDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EncodeDownlinkErrorDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EncodeDownlinkErrorDetailsValidationError{}
+
+// ValidateFields checks the field values on PayloadFormattersVersion with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PayloadFormattersVersion) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PayloadFormattersVersionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "version":
+			// no validation rules for Version
+		case "formatters":
+
+			if v, ok := interface{}(&m.Formatters).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PayloadFormattersVersionValidationError{
+						field:  "formatters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(&m.CreatedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PayloadFormattersVersionValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return PayloadFormattersVersionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PayloadFormattersVersionValidationError is the validation error returned by
+// PayloadFormattersVersion.ValidateFields if the designated constraints aren't
+// met.
+type PayloadFormattersVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadFormattersVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadFormattersVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadFormattersVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadFormattersVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadFormattersVersionValidationError) ErrorName() string {
+	return "PayloadFormattersVersionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadFormattersVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadFormattersVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadFormattersVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadFormattersVersionValidationError{}
+
+// ValidateFields checks the field values on PayloadFormattersVersions with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *PayloadFormattersVersions) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PayloadFormattersVersionsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "versions":
+
+			for idx, item := range m.GetVersions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return PayloadFormattersVersionsValidationError{
+							field:  fmt.Sprintf("versions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return PayloadFormattersVersionsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PayloadFormattersVersionsValidationError is the validation error returned by
+// PayloadFormattersVersions.ValidateFields if the designated constraints
+// aren't met.
+type PayloadFormattersVersionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PayloadFormattersVersionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PayloadFormattersVersionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PayloadFormattersVersionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PayloadFormattersVersionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PayloadFormattersVersionsValidationError) ErrorName() string {
+	return "PayloadFormattersVersionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PayloadFormattersVersionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPayloadFormattersVersions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PayloadFormattersVersionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PayloadFormattersVersionsValidationError{}
+
+// ValidateFields checks the field values on
+// ListPayloadFormattersVersionsRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is
+// returned.
+func (m *ListPayloadFormattersVersionsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListPayloadFormattersVersionsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListPayloadFormattersVersionsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceID()) > 36 {
+				return ListPayloadFormattersVersionsRequestValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ListPayloadFormattersVersionsRequest_DeviceID_Pattern.MatchString(m.GetDeviceID()) {
+				return ListPayloadFormattersVersionsRequestValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		default:
+			return ListPayloadFormattersVersionsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListPayloadFormattersVersionsRequestValidationError is the validation error
+// returned by ListPayloadFormattersVersionsRequest.ValidateFields if the
+// designated constraints aren't met.
+type ListPayloadFormattersVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPayloadFormattersVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPayloadFormattersVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPayloadFormattersVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPayloadFormattersVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPayloadFormattersVersionsRequestValidationError) ErrorName() string {
+	return "ListPayloadFormattersVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPayloadFormattersVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPayloadFormattersVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPayloadFormattersVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPayloadFormattersVersionsRequestValidationError{}
+
+var _ListPayloadFormattersVersionsRequest_DeviceID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+// ValidateFields checks the field values on RollbackPayloadFormattersRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *RollbackPayloadFormattersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RollbackPayloadFormattersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RollbackPayloadFormattersRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_id":
+
+			if utf8.RuneCountInString(m.GetDeviceID()) > 36 {
+				return RollbackPayloadFormattersRequestValidationError{
+					field:  "device_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_RollbackPayloadFormattersRequest_DeviceID_Pattern.MatchString(m.GetDeviceID()) {
+				return RollbackPayloadFormattersRequestValidationError{
+					field:  "device_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		case "version":
+			// no validation rules for Version
+		default:
+			return RollbackPayloadFormattersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RollbackPayloadFormattersRequestValidationError is the validation error
+// returned by RollbackPayloadFormattersRequest.ValidateFields if the
+// designated constraints aren't met.
+type RollbackPayloadFormattersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RollbackPayloadFormattersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RollbackPayloadFormattersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RollbackPayloadFormattersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RollbackPayloadFormattersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RollbackPayloadFormattersRequestValidationError) ErrorName() string {
+	return "RollbackPayloadFormattersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RollbackPayloadFormattersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRollbackPayloadFormattersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RollbackPayloadFormattersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RollbackPayloadFormattersRequestValidationError{}
+
+var _RollbackPayloadFormattersRequest_DeviceID_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
@@ -462,6 +462,117 @@ func (m *SetApplicationPackageAssociationRequest) GetFieldMask() types.FieldMask
 	return types.FieldMask{}
 }
 
+// FirmwareManagementCommands are LoRaWAN Firmware Management Protocol (TS006) commands to send to an end device.
+// The commands are sent in one downlink message on the FPort of the application package association.
+type FirmwareManagementCommands struct {
+	ApplicationPackageAssociationIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	// Request the version of the Firmware Management Protocol package.
+	PackageVersion bool `protobuf:"varint,2,opt,name=package_version,json=packageVersion,proto3" json:"package_version,omitempty"`
+	// Request the firmware and hardware version of the end device.
+	DevVersion bool `protobuf:"varint,3,opt,name=dev_version,json=devVersion,proto3" json:"dev_version,omitempty"`
+	// Reboot the end device at the given time.
+	RebootTime *time.Time `protobuf:"bytes,4,opt,name=reboot_time,json=rebootTime,proto3,stdtime" json:"reboot_time,omitempty"`
+	// Reboot the end device after the given number of seconds.
+	RebootCountdown uint32 `protobuf:"varint,5,opt,name=reboot_countdown,json=rebootCountdown,proto3" json:"reboot_countdown,omitempty"`
+	// Cancel the scheduled reboot of the end device.
+	CancelReboot bool `protobuf:"varint,6,opt,name=cancel_reboot,json=cancelReboot,proto3" json:"cancel_reboot,omitempty"`
+	// Request the status of the firmware upgrade image of the end device.
+	UpgradeImage bool `protobuf:"varint,7,opt,name=upgrade_image,json=upgradeImage,proto3" json:"upgrade_image,omitempty"`
+	// Delete the firmware upgrade image with the given version.
+	DeleteImage          bool     `protobuf:"varint,8,opt,name=delete_image,json=deleteImage,proto3" json:"delete_image,omitempty"`
+	DeleteImageVersion   uint32   `protobuf:"varint,9,opt,name=delete_image_version,json=deleteImageVersion,proto3" json:"delete_image_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FirmwareManagementCommands) Reset()      { *m = FirmwareManagementCommands{} }
+func (*FirmwareManagementCommands) ProtoMessage() {}
+func (*FirmwareManagementCommands) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa4ce58e965b6ca0, []int{8}
+}
+func (m *FirmwareManagementCommands) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FirmwareManagementCommands) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FirmwareManagementCommands.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FirmwareManagementCommands) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FirmwareManagementCommands.Merge(m, src)
+}
+func (m *FirmwareManagementCommands) XXX_Size() int {
+	return m.Size()
+}
+func (m *FirmwareManagementCommands) XXX_DiscardUnknown() {
+	xxx_messageInfo_FirmwareManagementCommands.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FirmwareManagementCommands proto.InternalMessageInfo
+
+func (m *FirmwareManagementCommands) GetPackageVersion() bool {
+	if m != nil {
+		return m.PackageVersion
+	}
+	return false
+}
+
+func (m *FirmwareManagementCommands) GetDevVersion() bool {
+	if m != nil {
+		return m.DevVersion
+	}
+	return false
+}
+
+func (m *FirmwareManagementCommands) GetRebootTime() *time.Time {
+	if m != nil {
+		return m.RebootTime
+	}
+	return nil
+}
+
+func (m *FirmwareManagementCommands) GetRebootCountdown() uint32 {
+	if m != nil {
+		return m.RebootCountdown
+	}
+	return 0
+}
+
+func (m *FirmwareManagementCommands) GetCancelReboot() bool {
+	if m != nil {
+		return m.CancelReboot
+	}
+	return false
+}
+
+func (m *FirmwareManagementCommands) GetUpgradeImage() bool {
+	if m != nil {
+		return m.UpgradeImage
+	}
+	return false
+}
+
+func (m *FirmwareManagementCommands) GetDeleteImage() bool {
+	if m != nil {
+		return m.DeleteImage
+	}
+	return false
+}
+
+func (m *FirmwareManagementCommands) GetDeleteImageVersion() uint32 {
+	if m != nil {
+		return m.DeleteImageVersion
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ApplicationPackage)(nil), "ttn.lorawan.v3.ApplicationPackage")
 	golang_proto.RegisterType((*ApplicationPackage)(nil), "ttn.lorawan.v3.ApplicationPackage")
@@ -479,6 +590,8 @@ func init() {
 	golang_proto.RegisterType((*ListApplicationPackageAssociationRequest)(nil), "ttn.lorawan.v3.ListApplicationPackageAssociationRequest")
 	proto.RegisterType((*SetApplicationPackageAssociationRequest)(nil), "ttn.lorawan.v3.SetApplicationPackageAssociationRequest")
 	golang_proto.RegisterType((*SetApplicationPackageAssociationRequest)(nil), "ttn.lorawan.v3.SetApplicationPackageAssociationRequest")
+	proto.RegisterType((*FirmwareManagementCommands)(nil), "ttn.lorawan.v3.FirmwareManagementCommands")
+	golang_proto.RegisterType((*FirmwareManagementCommands)(nil), "ttn.lorawan.v3.FirmwareManagementCommands")
 }
 
 func init() {
@@ -489,76 +602,88 @@ func init() {
 }
 
 var fileDescriptor_aa4ce58e965b6ca0 = []byte{
-	// 1101 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6c, 0x1b, 0x45,
-	0x14, 0xde, 0x49, 0xec, 0x36, 0x99, 0xa4, 0x51, 0x18, 0x10, 0x58, 0x06, 0x26, 0x61, 0x1b, 0x29,
-	0x26, 0xd4, 0xbb, 0x95, 0x7b, 0x00, 0x8a, 0x44, 0x14, 0xd3, 0x96, 0x1f, 0x41, 0x15, 0x36, 0xc0,
-	0x81, 0xb6, 0x58, 0x93, 0xdd, 0xf1, 0x66, 0x65, 0x7b, 0x77, 0xd9, 0x19, 0xa7, 0x84, 0x28, 0x52,
-	0x54, 0x84, 0x54, 0x21, 0x84, 0x8a, 0x7a, 0xe9, 0x11, 0x71, 0x2a, 0xe2, 0x52, 0x71, 0x8a, 0xb8,
-	0xd0, 0x63, 0x2e, 0x48, 0x91, 0xb8, 0xf4, 0x54, 0xea, 0x35, 0x87, 0xf4, 0xd6, 0x03, 0x87, 0x2a,
-	0x07, 0xa8, 0x76, 0x76, 0x1d, 0xaf, 0x7f, 0x1a, 0xdb, 0xa9, 0x7a, 0xdb, 0xd9, 0x79, 0xdf, 0xdb,
-	0xf7, 0x7d, 0xef, 0x9b, 0x37, 0x0b, 0x73, 0x65, 0xc7, 0x23, 0x97, 0x89, 0x9d, 0x65, 0x9c, 0xe8,
-	0x25, 0x95, 0xb8, 0x96, 0x4a, 0x5c, 0xb7, 0x6c, 0xe9, 0x84, 0x5b, 0x8e, 0xcd, 0xa8, 0xb7, 0x4a,
-	0xbd, 0x82, 0x4b, 0xf4, 0x12, 0x31, 0x29, 0x53, 0x5c, 0xcf, 0xe1, 0x0e, 0x9a, 0xe0, 0xdc, 0x56,
-	0x22, 0x9c, 0xb2, 0x7a, 0x2a, 0xbd, 0x60, 0x5a, 0x7c, 0xa5, 0xba, 0xac, 0xe8, 0x4e, 0x45, 0xa5,
-	0xf6, 0xaa, 0xb3, 0xe6, 0x7a, 0xce, 0x57, 0x6b, 0xaa, 0x08, 0xd6, 0xb3, 0x26, 0xb5, 0xb3, 0xab,
-	0xa4, 0x6c, 0x19, 0x84, 0x53, 0xb5, 0xe3, 0x21, 0x4c, 0x99, 0xce, 0xc6, 0x52, 0x98, 0x8e, 0xe9,
-	0x84, 0xe0, 0xe5, 0x6a, 0x51, 0xac, 0xc4, 0x42, 0x3c, 0x45, 0xe1, 0x2f, 0x99, 0x8e, 0x63, 0x96,
-	0x69, 0x58, 0xae, 0x6d, 0x3b, 0x3c, 0xac, 0x36, 0xda, 0x7d, 0x31, 0xda, 0xdd, 0xcf, 0x41, 0x2b,
-	0x2e, 0x5f, 0x8b, 0x36, 0xa7, 0xdb, 0x37, 0x8b, 0x16, 0x2d, 0x1b, 0x85, 0x0a, 0x61, 0xa5, 0x28,
-	0x62, 0xaa, 0x3d, 0x82, 0x5b, 0x15, 0xca, 0x38, 0xa9, 0xb8, 0x6d, 0x5f, 0xdf, 0x0f, 0x60, 0xdc,
-	0xab, 0xea, 0x3c, 0xda, 0x3d, 0xde, 0xa9, 0xa8, 0x65, 0x50, 0x9b, 0x5b, 0x45, 0x8b, 0x7a, 0x51,
-	0x89, 0xf2, 0x37, 0x00, 0xa2, 0x85, 0xa6, 0xce, 0x8b, 0xa1, 0xc0, 0xe8, 0x2d, 0x98, 0xb0, 0x49,
-	0x85, 0xa6, 0xc0, 0x34, 0xc8, 0x8c, 0xe6, 0x67, 0xf7, 0xf2, 0x33, 0x9e, 0x9c, 0x9a, 0xc9, 0xe1,
-	0x2f, 0x2e, 0x90, 0xec, 0xd7, 0x27, 0xb3, 0x6f, 0x5e, 0xca, 0xcc, 0x9f, 0xbe, 0x90, 0xbd, 0x34,
-	0xdf, 0x58, 0xbe, 0xba, 0x9e, 0x3b, 0xb1, 0x31, 0xa3, 0x09, 0x10, 0x3a, 0x09, 0x27, 0x0c, 0x5a,
-	0x24, 0xd5, 0x32, 0x2f, 0x14, 0x0b, 0xae, 0xe3, 0xf1, 0xd4, 0xd0, 0x34, 0xc8, 0x1c, 0xcb, 0xc3,
-	0xbd, 0xfc, 0xd1, 0xb9, 0x64, 0xea, 0x7f, 0x90, 0x01, 0xda, 0x78, 0x14, 0x71, 0x6e, 0xd1, 0xf1,
-	0xb8, 0xfc, 0x29, 0x7c, 0xb6, 0xb3, 0x08, 0x86, 0xde, 0x86, 0x23, 0x8d, 0x8e, 0xa7, 0xc0, 0xf4,
-	0x70, 0x66, 0x2c, 0x27, 0x2b, 0xad, 0x2d, 0x57, 0x3a, 0x61, 0xda, 0x3e, 0x46, 0xfe, 0x15, 0xc0,
-	0x4c, 0x67, 0xc0, 0x02, 0x63, 0x8e, 0x6e, 0x89, 0x37, 0xef, 0x37, 0xf5, 0x40, 0x17, 0xe1, 0x04,
-	0xb5, 0x8d, 0x82, 0x41, 0x57, 0x2d, 0x9d, 0x16, 0x2c, 0x83, 0x09, 0xf2, 0x63, 0xb9, 0x99, 0xf6,
-	0x4f, 0x9e, 0xb5, 0x8d, 0x33, 0x22, 0x28, 0x86, 0xce, 0x4f, 0xee, 0xe5, 0x93, 0xdf, 0x81, 0xa1,
-	0x49, 0xb0, 0x7d, 0x77, 0x4a, 0xda, 0xb9, 0x3b, 0x05, 0xb4, 0x71, 0xda, 0x8c, 0x63, 0xe8, 0x15,
-	0x78, 0xe4, 0xb1, 0x5a, 0x24, 0x8b, 0x42, 0x84, 0xcd, 0x61, 0xf8, 0xf2, 0x81, 0xd5, 0xa2, 0x8b,
-	0x70, 0xb8, 0x59, 0xd7, 0x1b, 0xbd, 0xa5, 0xe8, 0xce, 0xb4, 0x4b, 0xad, 0x41, 0x5a, 0xf4, 0x0e,
-	0x84, 0xba, 0x47, 0x09, 0xa7, 0x46, 0x81, 0x84, 0x65, 0x8e, 0xe5, 0xd2, 0x4a, 0x68, 0x31, 0xa5,
-	0x61, 0x31, 0xe5, 0x93, 0x86, 0x07, 0xf3, 0x23, 0x01, 0xfc, 0xda, 0xdf, 0x53, 0x40, 0x1b, 0x8d,
-	0x70, 0x0b, 0x3c, 0x48, 0x52, 0x75, 0x8d, 0x46, 0x92, 0xe1, 0x41, 0x92, 0x44, 0xb8, 0x05, 0x8e,
-	0x3e, 0x80, 0xe3, 0x51, 0x0f, 0x0b, 0xc2, 0x85, 0x89, 0xb8, 0x0b, 0x9f, 0xeb, 0xe9, 0xc2, 0xb1,
-	0x08, 0x7c, 0x3e, 0x30, 0xe3, 0x6b, 0x30, 0x61, 0x10, 0x4e, 0x52, 0x49, 0x51, 0xca, 0x0b, 0x1d,
-	0xa5, 0x2c, 0x89, 0x23, 0xa3, 0x89, 0x20, 0x99, 0x41, 0x7c, 0xa0, 0x8a, 0x0c, 0x7d, 0x0c, 0xc7,
-	0x49, 0x6c, 0x1d, 0xd9, 0x32, 0x3b, 0x50, 0x2f, 0xb4, 0x96, 0x14, 0xf2, 0x36, 0x80, 0xb3, 0xef,
-	0x52, 0x7e, 0x30, 0x84, 0x7e, 0x59, 0xa5, 0x8c, 0x3f, 0x65, 0x07, 0xcc, 0x43, 0xd8, 0x1c, 0x42,
-	0x8f, 0x75, 0xc0, 0xb9, 0x20, 0xe4, 0x23, 0xc2, 0x4a, 0xf9, 0x44, 0x00, 0xd7, 0x46, 0x8b, 0x8d,
-	0x17, 0xf2, 0x7d, 0x00, 0x33, 0x1f, 0x5a, 0xac, 0x3f, 0x2e, 0xef, 0xc5, 0xb9, 0x1c, 0xf6, 0x94,
-	0x89, 0xba, 0x31, 0x4c, 0x96, 0xad, 0x8a, 0xd5, 0x38, 0x5b, 0x23, 0x7b, 0xf9, 0xe4, 0xdc, 0x70,
-	0x6a, 0xf7, 0xa8, 0x16, 0xbe, 0x46, 0x08, 0x26, 0x5c, 0x62, 0x52, 0x61, 0xc7, 0x63, 0x9a, 0x78,
-	0x6e, 0xe3, 0x9a, 0x18, 0x9c, 0xeb, 0x9f, 0x00, 0xce, 0x2e, 0xf5, 0xd9, 0x36, 0x02, 0xc7, 0x62,
-	0x2d, 0x8f, 0x28, 0x0f, 0x66, 0x9a, 0x2e, 0xdc, 0xe3, 0x39, 0x9f, 0xb8, 0x77, 0xb9, 0xff, 0x46,
-	0x61, 0xba, 0xcb, 0x34, 0xa5, 0xa6, 0xc5, 0xb8, 0xb7, 0x86, 0x7e, 0x01, 0x30, 0x11, 0xb4, 0x16,
-	0xf5, 0xd5, 0xa9, 0xf4, 0xf1, 0xde, 0xe4, 0x98, 0xfc, 0xd9, 0x95, 0xbf, 0xfe, 0xb9, 0x3e, 0xb4,
-	0x88, 0xce, 0xab, 0x84, 0xb5, 0xdc, 0xf6, 0xea, 0x7a, 0x6c, 0x15, 0xcc, 0x62, 0xa5, 0x75, 0xbd,
-	0xa1, 0x86, 0x63, 0x9a, 0xa9, 0xeb, 0xfb, 0xf3, 0x7a, 0x43, 0x6d, 0xcc, 0x7d, 0x74, 0x7d, 0x08,
-	0x4e, 0x04, 0x27, 0x2a, 0x26, 0xcf, 0xeb, 0xed, 0xf5, 0xf4, 0x79, 0xe2, 0xd2, 0x83, 0x75, 0x49,
-	0xbe, 0x01, 0x04, 0xa7, 0x1f, 0x01, 0xfa, 0x01, 0x74, 0xb2, 0x0a, 0x98, 0xb4, 0x5e, 0x32, 0x4a,
-	0xdf, 0x44, 0xbb, 0x60, 0xbb, 0x70, 0x57, 0xe3, 0xa3, 0x25, 0x04, 0x85, 0xf7, 0xce, 0x06, 0xba,
-	0x0f, 0xe0, 0xa4, 0x38, 0x9c, 0xf1, 0x79, 0xd6, 0x31, 0x43, 0xfa, 0x3d, 0xbe, 0x69, 0x65, 0x20,
-	0x61, 0x98, 0x5c, 0x12, 0xc2, 0x50, 0xa4, 0x77, 0x97, 0x65, 0x20, 0x1d, 0x7a, 0x11, 0x47, 0xbf,
-	0x0f, 0xc1, 0x89, 0xa5, 0x1e, 0x0e, 0x58, 0x7a, 0x3a, 0x0e, 0xf8, 0x23, 0x74, 0xc0, 0x16, 0x48,
-	0xff, 0xd6, 0xc5, 0x01, 0xb1, 0x2a, 0x95, 0x27, 0x71, 0x43, 0x8f, 0x3c, 0xbd, 0x9d, 0xd1, 0x9e,
-	0x20, 0x72, 0xc9, 0x69, 0x30, 0x87, 0xfe, 0x05, 0xf0, 0x99, 0x33, 0xb4, 0x4c, 0x79, 0xcb, 0xcf,
-	0xc7, 0xa1, 0x6f, 0x9b, 0xf4, 0xf3, 0x1d, 0x63, 0xe8, 0x6c, 0xf0, 0x1f, 0x2c, 0x7f, 0x1f, 0x2a,
-	0xf5, 0x2d, 0x98, 0xbb, 0xd2, 0x45, 0xa9, 0xc3, 0x2a, 0x33, 0xb0, 0x12, 0x11, 0xf3, 0xfc, 0xcf,
-	0x60, 0xbb, 0x86, 0xc1, 0x4e, 0x0d, 0x83, 0x3b, 0x35, 0x2c, 0xdd, 0xab, 0x61, 0x69, 0xb7, 0x86,
-	0xa5, 0x07, 0x35, 0x2c, 0x3d, 0xac, 0x61, 0xb0, 0xe9, 0x63, 0x70, 0xd5, 0xc7, 0xd2, 0x4d, 0x1f,
-	0x83, 0x5b, 0x3e, 0x96, 0xb6, 0x7c, 0x2c, 0xdd, 0xf6, 0xb1, 0xb4, 0xed, 0x63, 0xb0, 0xe3, 0x63,
-	0x70, 0xc7, 0xc7, 0xd2, 0x3d, 0x1f, 0x83, 0x5d, 0x1f, 0x4b, 0x0f, 0x7c, 0x0c, 0x1e, 0xfa, 0x58,
-	0xda, 0xac, 0x63, 0xe9, 0x6a, 0x1d, 0x83, 0x6b, 0x75, 0x2c, 0xdd, 0xa8, 0x63, 0xf0, 0x53, 0x1d,
-	0x4b, 0x37, 0xeb, 0x58, 0xba, 0x55, 0xc7, 0x60, 0xab, 0x8e, 0xc1, 0xed, 0x3a, 0x06, 0x9f, 0x9f,
-	0x30, 0x1d, 0x85, 0xaf, 0x50, 0xbe, 0x62, 0xd9, 0x26, 0x53, 0x6c, 0xca, 0x2f, 0x3b, 0x5e, 0x49,
-	0x6d, 0xfd, 0x77, 0x77, 0x4b, 0xa6, 0xca, 0xb9, 0xed, 0x2e, 0x2f, 0x1f, 0x11, 0x1a, 0x9e, 0x7a,
-	0x14, 0x00, 0x00, 0xff, 0xff, 0xb2, 0xf0, 0xb2, 0x77, 0x2f, 0x0d, 0x00, 0x00,
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x57, 0x4d, 0x6c, 0xdc, 0x44,
+	0x14, 0x8e, 0xb3, 0x9b, 0x26, 0x99, 0x4d, 0xd2, 0x30, 0x54, 0xb0, 0x5a, 0x60, 0x53, 0x9c, 0x48,
+	0x09, 0xa1, 0x6b, 0x47, 0x5b, 0x21, 0xa0, 0x48, 0x44, 0xd9, 0xb4, 0x81, 0x22, 0x5a, 0x05, 0x07,
+	0x7a, 0xa0, 0x94, 0x95, 0x63, 0xcf, 0x3a, 0x56, 0xd6, 0x3f, 0xd8, 0xb3, 0x09, 0xa1, 0x8a, 0x14,
+	0x15, 0x21, 0x55, 0x08, 0xa1, 0x42, 0x25, 0x94, 0x1b, 0x88, 0x53, 0x11, 0x97, 0x8a, 0x53, 0xc4,
+	0x85, 0x1e, 0x73, 0x41, 0x8a, 0xc4, 0xa5, 0x17, 0x4a, 0x7f, 0x38, 0x84, 0x5b, 0x0f, 0x1c, 0xaa,
+	0x1c, 0x1a, 0x9e, 0xc7, 0xf6, 0xae, 0xf7, 0x27, 0xd9, 0xdd, 0x54, 0x91, 0x38, 0x8c, 0xc6, 0xf3,
+	0xe6, 0x7b, 0xcf, 0xef, 0x7d, 0xf3, 0xe6, 0x3d, 0x1b, 0x65, 0x8b, 0x96, 0x23, 0x2f, 0xcb, 0x66,
+	0xc6, 0xa5, 0xb2, 0xb2, 0x28, 0xca, 0xb6, 0x0e, 0xc3, 0x2e, 0xea, 0x8a, 0x4c, 0x75, 0xcb, 0x74,
+	0x89, 0xb3, 0x44, 0x9c, 0xbc, 0x0d, 0x5b, 0xb2, 0x46, 0x5c, 0xc1, 0x76, 0x2c, 0x6a, 0xe1, 0x01,
+	0x4a, 0x4d, 0x21, 0xd0, 0x13, 0x96, 0x4e, 0xa6, 0xa6, 0x34, 0x9d, 0x2e, 0x94, 0xe6, 0x05, 0xc5,
+	0x32, 0x44, 0x62, 0x2e, 0x59, 0x2b, 0x00, 0xfb, 0x74, 0x45, 0x64, 0x60, 0x25, 0xa3, 0x11, 0x33,
+	0xb3, 0x24, 0x17, 0x75, 0x55, 0xa6, 0x44, 0xac, 0x7b, 0xf0, 0x4d, 0xa6, 0x32, 0x11, 0x13, 0x9a,
+	0xa5, 0x59, 0xbe, 0xf2, 0x7c, 0xa9, 0xc0, 0x56, 0x6c, 0xc1, 0x9e, 0x02, 0xf8, 0xf3, 0x9a, 0x65,
+	0x69, 0x45, 0xe2, 0xbb, 0x6b, 0x9a, 0x16, 0xf5, 0xbd, 0x0d, 0x76, 0x9f, 0x0b, 0x76, 0xcb, 0x36,
+	0x88, 0x61, 0xd3, 0x95, 0x60, 0xf3, 0x78, 0xed, 0x66, 0x41, 0x27, 0x45, 0x35, 0x6f, 0xc8, 0xee,
+	0x62, 0x80, 0x18, 0xaa, 0x45, 0x50, 0xdd, 0x20, 0x40, 0x8f, 0x61, 0xd7, 0xbc, 0xbd, 0x0c, 0x70,
+	0xa9, 0x53, 0x52, 0x68, 0xb0, 0x3b, 0x5c, 0xcf, 0xa8, 0xae, 0x12, 0x93, 0xea, 0xf0, 0x22, 0x27,
+	0x70, 0x91, 0xff, 0x9c, 0x43, 0x78, 0xaa, 0xc2, 0xf3, 0xac, 0x4f, 0x30, 0x7e, 0x03, 0xc5, 0x4d,
+	0xd9, 0x20, 0x49, 0xee, 0x38, 0x37, 0xd6, 0x9b, 0x1b, 0xdd, 0xc9, 0x8d, 0x38, 0x7c, 0x72, 0x24,
+	0x9b, 0xfe, 0xf8, 0xa2, 0x9c, 0xf9, 0x6c, 0x22, 0xf3, 0xfa, 0xa5, 0xb1, 0xc9, 0x53, 0x17, 0x33,
+	0x97, 0x26, 0xc3, 0xe5, 0x4b, 0x97, 0xb3, 0x27, 0x56, 0x47, 0x24, 0xa6, 0x84, 0x27, 0xd0, 0x80,
+	0x4a, 0x0a, 0x72, 0xa9, 0x48, 0xf3, 0x85, 0xbc, 0x6d, 0x39, 0x34, 0xd9, 0x09, 0x66, 0xfa, 0x73,
+	0x68, 0x27, 0xd7, 0x3d, 0xde, 0x95, 0xdc, 0xe5, 0xc6, 0x38, 0xa9, 0x2f, 0x40, 0xcc, 0xcc, 0xc2,
+	0x3e, 0xff, 0x01, 0x7a, 0xba, 0xde, 0x09, 0x17, 0xbf, 0x89, 0x7a, 0xc2, 0x13, 0x07, 0x4f, 0x62,
+	0x63, 0x89, 0x2c, 0x2f, 0x54, 0x1f, 0xb9, 0x50, 0xaf, 0x26, 0x95, 0x75, 0xf8, 0x9f, 0x39, 0x34,
+	0x56, 0x0f, 0x98, 0x72, 0x5d, 0x4b, 0xd1, 0x99, 0xe4, 0x6c, 0x85, 0x0f, 0xfc, 0x11, 0x1a, 0x20,
+	0xa6, 0x9a, 0x57, 0xc9, 0x92, 0xae, 0x90, 0xbc, 0xae, 0xba, 0x2c, 0xf8, 0x44, 0x76, 0xa4, 0xf6,
+	0x95, 0x67, 0x4c, 0xf5, 0x34, 0x03, 0x45, 0xb4, 0x73, 0x83, 0x3b, 0xb9, 0xae, 0x2f, 0xb9, 0xce,
+	0x41, 0x6e, 0xf3, 0xce, 0x50, 0xc7, 0xd6, 0x9d, 0x21, 0x88, 0x90, 0x54, 0x70, 0x2e, 0x7e, 0x11,
+	0x1d, 0xd9, 0x93, 0x8b, 0xae, 0x02, 0x23, 0x61, 0x2d, 0x86, 0x5e, 0xd8, 0xd7, 0x5b, 0x70, 0x31,
+	0x56, 0xf1, 0xeb, 0xb5, 0xe6, 0x54, 0x34, 0x8e, 0xb4, 0x81, 0xaf, 0x9e, 0x59, 0x3c, 0x8d, 0x90,
+	0xe2, 0x10, 0xb8, 0x0a, 0x6a, 0x5e, 0xf6, 0xdd, 0x4c, 0x64, 0x53, 0x82, 0x9f, 0x62, 0x42, 0x98,
+	0x62, 0xc2, 0xfb, 0x61, 0x0e, 0xe6, 0x7a, 0x3c, 0xf5, 0x6b, 0x7f, 0x81, 0x7a, 0x6f, 0xa0, 0x37,
+	0x45, 0x3d, 0x23, 0x25, 0x5b, 0x0d, 0x8d, 0xc4, 0xda, 0x31, 0x12, 0xe8, 0x81, 0x91, 0x77, 0x50,
+	0x5f, 0x70, 0x86, 0x79, 0x96, 0x85, 0xf1, 0x68, 0x16, 0x1e, 0x6b, 0x9a, 0x85, 0x89, 0x40, 0xf9,
+	0xbc, 0x97, 0x8c, 0x2f, 0xa3, 0x38, 0x98, 0x95, 0x93, 0x5d, 0xcc, 0x95, 0x67, 0xeb, 0x5c, 0x99,
+	0x63, 0x57, 0x46, 0x62, 0x20, 0xde, 0x45, 0xe9, 0x7d, 0x59, 0x74, 0xf1, 0x7b, 0xa8, 0x4f, 0x8e,
+	0xac, 0x83, 0xb4, 0xcc, 0xb4, 0x75, 0x16, 0x52, 0x95, 0x09, 0x7e, 0x93, 0x43, 0xa3, 0x6f, 0x11,
+	0xba, 0xbf, 0x0a, 0xf9, 0xa4, 0x04, 0x8c, 0x1d, 0x72, 0x06, 0x4c, 0x22, 0x54, 0x29, 0x42, 0x7b,
+	0x66, 0xc0, 0x8c, 0x07, 0x39, 0x07, 0x88, 0x5c, 0xdc, 0x53, 0x97, 0x7a, 0x0b, 0xa1, 0x80, 0xff,
+	0x07, 0x2e, 0xdc, 0xbb, 0xba, 0xdb, 0x5a, 0x2c, 0x6f, 0x47, 0x63, 0x39, 0xe8, 0x2d, 0x63, 0x7e,
+	0xa7, 0x51, 0x57, 0x51, 0x37, 0xf4, 0xf0, 0x6e, 0xf5, 0x00, 0x6a, 0x3c, 0x96, 0xdc, 0xee, 0x96,
+	0x7c, 0x31, 0xc6, 0x28, 0x6e, 0x83, 0x0f, 0x2c, 0x1d, 0xfb, 0x25, 0xf6, 0x5c, 0x13, 0x6b, 0xbc,
+	0xfd, 0x58, 0x7f, 0x87, 0x63, 0x9b, 0x6b, 0xf1, 0xd8, 0x64, 0x94, 0x88, 0x1c, 0x79, 0x10, 0x72,
+	0x7b, 0x49, 0xd3, 0x20, 0xf6, 0xa8, 0xcd, 0x27, 0x3f, 0xbb, 0x3f, 0x63, 0x28, 0x35, 0xa3, 0x3b,
+	0xc6, 0xb2, 0xec, 0x90, 0x73, 0xb2, 0x09, 0x6f, 0x37, 0x80, 0xf8, 0x69, 0xcb, 0x30, 0x64, 0x53,
+	0x75, 0x0f, 0x39, 0xf3, 0x46, 0xd1, 0xd1, 0xf0, 0xc6, 0x43, 0x9f, 0x77, 0x3d, 0x92, 0xbc, 0x10,
+	0x7a, 0xa4, 0x81, 0x40, 0x7c, 0xc1, 0x97, 0xe2, 0x21, 0x94, 0x80, 0x0a, 0x5d, 0x06, 0xc5, 0x18,
+	0x08, 0x81, 0x28, 0x04, 0x4c, 0xa1, 0x84, 0x43, 0xe6, 0x2d, 0x8b, 0xe6, 0xbd, 0x6e, 0xb9, 0xe7,
+	0xc1, 0x56, 0x2a, 0x50, 0x9c, 0x55, 0x1f, 0xe4, 0x2b, 0x79, 0x62, 0xfc, 0x0a, 0x1a, 0x0c, 0x4c,
+	0x28, 0x56, 0xc9, 0xa4, 0xaa, 0xb5, 0x6c, 0xb2, 0xf2, 0x51, 0xae, 0xda, 0x8f, 0x77, 0x77, 0xbb,
+	0xa5, 0xa3, 0x3e, 0x66, 0x3a, 0x84, 0xe0, 0x61, 0xd4, 0xaf, 0xc8, 0xa6, 0x42, 0x8a, 0x79, 0x7f,
+	0x27, 0x79, 0x84, 0x39, 0xd7, 0xe7, 0x0b, 0x25, 0x26, 0xf3, 0x40, 0x25, 0x5b, 0x73, 0x64, 0x15,
+	0x5a, 0x8c, 0xe1, 0xe5, 0x64, 0xb7, 0x0f, 0x0a, 0x84, 0x67, 0x3d, 0x19, 0x34, 0x0b, 0x68, 0x8f,
+	0x45, 0x42, 0x43, 0x4c, 0x0f, 0xc3, 0x24, 0x7c, 0x99, 0x0f, 0x99, 0x40, 0xc7, 0xa2, 0x90, 0x32,
+	0x21, 0xbd, 0x2c, 0xc5, 0x71, 0x04, 0x1a, 0x10, 0x93, 0x7d, 0xdc, 0x8b, 0x52, 0x0d, 0xba, 0x25,
+	0xd1, 0xe0, 0xbe, 0x3a, 0x2b, 0xf8, 0x27, 0x0e, 0xc5, 0xbd, 0xab, 0x8b, 0x5b, 0xba, 0x89, 0xa9,
+	0xe1, 0xe6, 0x19, 0xe0, 0xf2, 0x17, 0xae, 0xfc, 0xf1, 0xf7, 0xf5, 0xce, 0x59, 0x7c, 0x5e, 0x94,
+	0xdd, 0xaa, 0xaf, 0x39, 0xf1, 0x72, 0x64, 0xe5, 0xf5, 0x5a, 0xa1, 0x7a, 0xbd, 0x2a, 0xfa, 0x6d,
+	0x18, 0x80, 0xe5, 0x7e, 0xbc, 0x2a, 0x86, 0x7d, 0x1d, 0x5f, 0xef, 0x44, 0x03, 0x5e, 0xc5, 0x8c,
+	0xa4, 0xff, 0xab, 0xb5, 0xfe, 0xb4, 0x58, 0x51, 0x53, 0xed, 0xdd, 0x42, 0x7e, 0x9d, 0x63, 0x31,
+	0x7d, 0xc3, 0xe1, 0xaf, 0xb9, 0xfa, 0xa8, 0xbc, 0x48, 0xaa, 0x3f, 0x22, 0x84, 0x96, 0x03, 0x6d,
+	0xa0, 0xdb, 0x20, 0x76, 0x31, 0xda, 0x3a, 0x7c, 0x25, 0xff, 0xbb, 0x62, 0x15, 0x43, 0xf1, 0x1d,
+	0x64, 0xc5, 0x37, 0xda, 0xaf, 0xea, 0x6e, 0x6a, 0xab, 0xe5, 0x39, 0x25, 0xb4, 0x45, 0x8c, 0xcb,
+	0x2f, 0x32, 0x62, 0x08, 0x56, 0x1a, 0xd3, 0xd2, 0x16, 0x0f, 0xcd, 0x02, 0xc7, 0xbf, 0x42, 0x06,
+	0xcc, 0x35, 0xc9, 0x80, 0xb9, 0xc3, 0xc9, 0x80, 0xdf, 0xfc, 0x0c, 0xd8, 0xe0, 0x52, 0xbf, 0x34,
+	0xc8, 0x80, 0x88, 0x97, 0xc2, 0x93, 0x64, 0x43, 0x13, 0x3b, 0xcd, 0x33, 0xa3, 0xd6, 0x40, 0x90,
+	0x25, 0xa7, 0xb8, 0x71, 0xfc, 0x2f, 0x87, 0x9e, 0x3a, 0xcd, 0x0a, 0x44, 0x94, 0xbf, 0x03, 0xd7,
+	0xf4, 0xd4, 0x33, 0x75, 0xd5, 0xf5, 0x8c, 0xf7, 0x9f, 0xc3, 0x7f, 0xe5, 0x33, 0xf5, 0x05, 0x37,
+	0x7e, 0xa5, 0x01, 0x53, 0x07, 0x65, 0xa6, 0x6d, 0x26, 0x82, 0xc8, 0xb3, 0xdf, 0x75, 0x56, 0x7d,
+	0x5f, 0xd7, 0xf7, 0x3a, 0x8f, 0x98, 0xbe, 0x39, 0xb0, 0x5f, 0x6e, 0x7a, 0xe3, 0xb5, 0x9c, 0xec,
+	0xdd, 0x20, 0xf7, 0x64, 0xe1, 0x7b, 0x9f, 0x85, 0x75, 0x8e, 0xff, 0xf6, 0x7f, 0x56, 0x31, 0xc4,
+	0x82, 0x61, 0x43, 0x3e, 0xe4, 0x7e, 0xe4, 0x36, 0xef, 0xa5, 0xb9, 0x2d, 0x18, 0xb7, 0xef, 0xa5,
+	0x3b, 0xee, 0xc2, 0xd8, 0x86, 0xf1, 0x10, 0xc6, 0x23, 0x90, 0xad, 0xdd, 0x4f, 0x73, 0x57, 0xef,
+	0xa7, 0x3b, 0x6e, 0xc0, 0x7c, 0x13, 0xe6, 0x0d, 0x18, 0xb7, 0x60, 0x6c, 0xc2, 0x7a, 0x0b, 0xc6,
+	0x6d, 0x78, 0xbe, 0x0b, 0xf3, 0x36, 0xcc, 0x0f, 0x61, 0x7e, 0x04, 0xf3, 0xda, 0x83, 0x74, 0xc7,
+	0xd5, 0x07, 0x69, 0xee, 0x1a, 0xcc, 0xeb, 0x30, 0xff, 0x00, 0xf3, 0x0d, 0x18, 0x37, 0xe1, 0x79,
+	0x03, 0xc6, 0x2d, 0x18, 0x1f, 0x9e, 0x80, 0xbf, 0x68, 0xba, 0x40, 0xe8, 0x82, 0x6e, 0x6a, 0xae,
+	0x60, 0x12, 0xba, 0x6c, 0x39, 0x8b, 0x62, 0xf5, 0x4f, 0xab, 0xbd, 0xa8, 0x89, 0x70, 0x04, 0xf6,
+	0xfc, 0xfc, 0x11, 0x46, 0xeb, 0xc9, 0xff, 0x00, 0xff, 0x52, 0xb6, 0xec, 0x28, 0x10, 0x00, 0x00,
 }
 
 func (this *ApplicationPackage) Equal(that interface{}) bool {
@@ -796,6 +921,58 @@ func (this *SetApplicationPackageAssociationRequest) Equal(that interface{}) boo
 	}
 	return true
 }
+func (this *FirmwareManagementCommands) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FirmwareManagementCommands)
+	if !ok {
+		that2, ok := that.(FirmwareManagementCommands)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationPackageAssociationIdentifiers.Equal(&that1.ApplicationPackageAssociationIdentifiers) {
+		return false
+	}
+	if this.PackageVersion != that1.PackageVersion {
+		return false
+	}
+	if this.DevVersion != that1.DevVersion {
+		return false
+	}
+	if that1.RebootTime == nil {
+		if this.RebootTime != nil {
+			return false
+		}
+	} else if !this.RebootTime.Equal(*that1.RebootTime) {
+		return false
+	}
+	if this.RebootCountdown != that1.RebootCountdown {
+		return false
+	}
+	if this.CancelReboot != that1.CancelReboot {
+		return false
+	}
+	if this.UpgradeImage != that1.UpgradeImage {
+		return false
+	}
+	if this.DeleteImage != that1.DeleteImage {
+		return false
+	}
+	if this.DeleteImageVersion != that1.DeleteImageVersion {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -1031,6 +1208,82 @@ var _ApplicationPackageRegistry_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/applicationserver_packages.proto",
 }
 
+// ApplicationFirmwareManagementClient is the client API for ApplicationFirmwareManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationFirmwareManagementClient interface {
+	// SendCommands sends the Firmware Management Protocol commands to the end device.
+	// The answers of the end device are stored in the data of the application package association.
+	SendCommands(ctx context.Context, in *FirmwareManagementCommands, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type applicationFirmwareManagementClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationFirmwareManagementClient(cc *grpc.ClientConn) ApplicationFirmwareManagementClient {
+	return &applicationFirmwareManagementClient{cc}
+}
+
+func (c *applicationFirmwareManagementClient) SendCommands(ctx context.Context, in *FirmwareManagementCommands, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationFirmwareManagement/SendCommands", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationFirmwareManagementServer is the server API for ApplicationFirmwareManagement service.
+type ApplicationFirmwareManagementServer interface {
+	// SendCommands sends the Firmware Management Protocol commands to the end device.
+	// The answers of the end device are stored in the data of the application package association.
+	SendCommands(context.Context, *FirmwareManagementCommands) (*types.Empty, error)
+}
+
+// UnimplementedApplicationFirmwareManagementServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationFirmwareManagementServer struct {
+}
+
+func (*UnimplementedApplicationFirmwareManagementServer) SendCommands(ctx context.Context, req *FirmwareManagementCommands) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommands not implemented")
+}
+
+func RegisterApplicationFirmwareManagementServer(s *grpc.Server, srv ApplicationFirmwareManagementServer) {
+	s.RegisterService(&_ApplicationFirmwareManagement_serviceDesc, srv)
+}
+
+func _ApplicationFirmwareManagement_SendCommands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FirmwareManagementCommands)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationFirmwareManagementServer).SendCommands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationFirmwareManagement/SendCommands",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationFirmwareManagementServer).SendCommands(ctx, req.(*FirmwareManagementCommands))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationFirmwareManagement_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationFirmwareManagement",
+	HandlerType: (*ApplicationFirmwareManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendCommands",
+			Handler:    _ApplicationFirmwareManagement_SendCommands_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_packages.proto",
+}
+
 func (m *ApplicationPackage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1385,6 +1638,109 @@ func (m *SetApplicationPackageAssociationRequest) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *FirmwareManagementCommands) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FirmwareManagementCommands) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FirmwareManagementCommands) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeleteImageVersion != 0 {
+		i = encodeVarintApplicationserverPackages(dAtA, i, uint64(m.DeleteImageVersion))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DeleteImage {
+		i--
+		if m.DeleteImage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UpgradeImage {
+		i--
+		if m.UpgradeImage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CancelReboot {
+		i--
+		if m.CancelReboot {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RebootCountdown != 0 {
+		i = encodeVarintApplicationserverPackages(dAtA, i, uint64(m.RebootCountdown))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RebootTime != nil {
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.RebootTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.RebootTime):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintApplicationserverPackages(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DevVersion {
+		i--
+		if m.DevVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PackageVersion {
+		i--
+		if m.PackageVersion {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ApplicationPackageAssociationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPackages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplicationserverPackages(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplicationserverPackages(v)
 	base := offset
@@ -1495,6 +1851,25 @@ func NewPopulatedSetApplicationPackageAssociationRequest(r randyApplicationserve
 	return this
 }
 
+func NewPopulatedFirmwareManagementCommands(r randyApplicationserverPackages, easy bool) *FirmwareManagementCommands {
+	this := &FirmwareManagementCommands{}
+	v13 := NewPopulatedApplicationPackageAssociationIdentifiers(r, easy)
+	this.ApplicationPackageAssociationIdentifiers = *v13
+	this.PackageVersion = bool(r.Intn(2) == 0)
+	this.DevVersion = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		this.RebootTime = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	this.RebootCountdown = uint32(r.Uint32())
+	this.CancelReboot = bool(r.Intn(2) == 0)
+	this.UpgradeImage = bool(r.Intn(2) == 0)
+	this.DeleteImage = bool(r.Intn(2) == 0)
+	this.DeleteImageVersion = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyApplicationserverPackages interface {
 	Float32() float32
 	Float64() float64
@@ -1695,6 +2070,42 @@ func (m *SetApplicationPackageAssociationRequest) Size() (n int) {
 	return n
 }
 
+func (m *FirmwareManagementCommands) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationPackageAssociationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPackages(uint64(l))
+	if m.PackageVersion {
+		n += 2
+	}
+	if m.DevVersion {
+		n += 2
+	}
+	if m.RebootTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.RebootTime)
+		n += 1 + l + sovApplicationserverPackages(uint64(l))
+	}
+	if m.RebootCountdown != 0 {
+		n += 1 + sovApplicationserverPackages(uint64(m.RebootCountdown))
+	}
+	if m.CancelReboot {
+		n += 2
+	}
+	if m.UpgradeImage {
+		n += 2
+	}
+	if m.DeleteImage {
+		n += 2
+	}
+	if m.DeleteImageVersion != 0 {
+		n += 1 + sovApplicationserverPackages(uint64(m.DeleteImageVersion))
+	}
+	return n
+}
+
 func sovApplicationserverPackages(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1802,6 +2213,25 @@ func (this *SetApplicationPackageAssociationRequest) String() string {
 	}, "")
 	return s
 }
+func (this *FirmwareManagementCommands) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FirmwareManagementCommands{`,
+		`ApplicationPackageAssociationIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationPackageAssociationIdentifiers.String(), "ApplicationPackageAssociationIdentifiers", "ApplicationPackageAssociationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`PackageVersion:` + fmt.Sprintf("%v", this.PackageVersion) + `,`,
+		`DevVersion:` + fmt.Sprintf("%v", this.DevVersion) + `,`,
+		`RebootTime:` + strings.Replace(fmt.Sprintf("%v", this.RebootTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`RebootCountdown:` + fmt.Sprintf("%v", this.RebootCountdown) + `,`,
+		`CancelReboot:` + fmt.Sprintf("%v", this.CancelReboot) + `,`,
+		`UpgradeImage:` + fmt.Sprintf("%v", this.UpgradeImage) + `,`,
+		`DeleteImage:` + fmt.Sprintf("%v", this.DeleteImage) + `,`,
+		`DeleteImageVersion:` + fmt.Sprintf("%v", this.DeleteImageVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func valueToStringApplicationserverPackages(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2808,6 +3238,267 @@ func (m *SetApplicationPackageAssociationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FirmwareManagementCommands) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPackages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FirmwareManagementCommands: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FirmwareManagementCommands: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationPackageAssociationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationPackageAssociationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PackageVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PackageVersion = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevVersion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DevVersion = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebootTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RebootTime == nil {
+				m.RebootTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.RebootTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebootCountdown", wireType)
+			}
+			m.RebootCountdown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RebootCountdown |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelReboot", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CancelReboot = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeImage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpgradeImage = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteImage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeleteImage = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteImageVersion", wireType)
+			}
+			m.DeleteImageVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPackages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeleteImageVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPackages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPackages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipApplicationserverPackages(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -121,3 +121,35 @@ var SetApplicationPackageAssociationRequestFieldPathsTopLevel = []string{
 	"association",
 	"field_mask",
 }
+
+var FirmwareManagementCommandsFieldPathsNested = []string{
+	"cancel_reboot",
+	"delete_image",
+	"delete_image_version",
+	"dev_version",
+	"ids",
+	"ids.end_device_ids",
+	"ids.end_device_ids.application_ids",
+	"ids.end_device_ids.application_ids.application_id",
+	"ids.end_device_ids.dev_addr",
+	"ids.end_device_ids.dev_eui",
+	"ids.end_device_ids.device_id",
+	"ids.end_device_ids.join_eui",
+	"ids.f_port",
+	"package_version",
+	"reboot_countdown",
+	"reboot_time",
+	"upgrade_image",
+}
+
+var FirmwareManagementCommandsFieldPathsTopLevel = []string{
+	"cancel_reboot",
+	"delete_image",
+	"delete_image_version",
+	"dev_version",
+	"ids",
+	"package_version",
+	"reboot_countdown",
+	"reboot_time",
+	"upgrade_image",
+}
@@ -323,3 +323,111 @@ func (dst *SetApplicationPackageAssociationRequest) SetFields(src *SetApplicatio
 	}
 	return nil
 }
+
+func (dst *FirmwareManagementCommands) SetFields(src *FirmwareManagementCommands, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationPackageAssociationIdentifiers
+				var newSrc *ApplicationPackageAssociationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationPackageAssociationIdentifiers
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationPackageAssociationIdentifiers = src.ApplicationPackageAssociationIdentifiers
+				} else {
+					var zero ApplicationPackageAssociationIdentifiers
+					dst.ApplicationPackageAssociationIdentifiers = zero
+				}
+			}
+		case "package_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'package_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PackageVersion = src.PackageVersion
+			} else {
+				var zero bool
+				dst.PackageVersion = zero
+			}
+		case "dev_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevVersion = src.DevVersion
+			} else {
+				var zero bool
+				dst.DevVersion = zero
+			}
+		case "reboot_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reboot_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RebootTime = src.RebootTime
+			} else {
+				dst.RebootTime = nil
+			}
+		case "reboot_countdown":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reboot_countdown' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RebootCountdown = src.RebootCountdown
+			} else {
+				var zero uint32
+				dst.RebootCountdown = zero
+			}
+		case "cancel_reboot":
+			if len(subs) > 0 {
+				return fmt.Errorf("'cancel_reboot' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CancelReboot = src.CancelReboot
+			} else {
+				var zero bool
+				dst.CancelReboot = zero
+			}
+		case "upgrade_image":
+			if len(subs) > 0 {
+				return fmt.Errorf("'upgrade_image' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpgradeImage = src.UpgradeImage
+			} else {
+				var zero bool
+				dst.UpgradeImage = zero
+			}
+		case "delete_image":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delete_image' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeleteImage = src.DeleteImage
+			} else {
+				var zero bool
+				dst.DeleteImage = zero
+			}
+		case "delete_image_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'delete_image_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeleteImageVersion = src.DeleteImageVersion
+			} else {
+				var zero uint32
+				dst.DeleteImageVersion = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -919,3 +919,130 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SetApplicationPackageAssociationRequestValidationError{}
+
+// ValidateFields checks the field values on FirmwareManagementCommands with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FirmwareManagementCommands) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FirmwareManagementCommandsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.ApplicationPackageAssociationIdentifiers).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FirmwareManagementCommandsValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "package_version":
+			// no validation rules for PackageVersion
+		case "dev_version":
+			// no validation rules for DevVersion
+		case "reboot_time":
+
+			if v, ok := interface{}(m.GetRebootTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FirmwareManagementCommandsValidationError{
+						field:  "reboot_time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reboot_countdown":
+
+			if m.GetRebootCountdown() > 16777214 {
+				return FirmwareManagementCommandsValidationError{
+					field:  "reboot_countdown",
+					reason: "value must be less than or equal to 16777214",
+				}
+			}
+
+		case "cancel_reboot":
+			// no validation rules for CancelReboot
+		case "upgrade_image":
+			// no validation rules for UpgradeImage
+		case "delete_image":
+			// no validation rules for DeleteImage
+		case "delete_image_version":
+			// no validation rules for DeleteImageVersion
+		default:
+			return FirmwareManagementCommandsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FirmwareManagementCommandsValidationError is the validation error returned
+// by FirmwareManagementCommands.ValidateFields if the designated constraints
+// aren't met.
+type FirmwareManagementCommandsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FirmwareManagementCommandsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FirmwareManagementCommandsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FirmwareManagementCommandsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FirmwareManagementCommandsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FirmwareManagementCommandsValidationError) ErrorName() string {
+	return "FirmwareManagementCommandsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FirmwareManagementCommandsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFirmwareManagementCommands.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FirmwareManagementCommandsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FirmwareManagementCommandsValidationError{}
@@ -65,6 +65,135 @@ func (ApplicationPubSub_MQTTProvider_QoS) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 1, 0}
 }
 
+type ApplicationPubSub_MQTTProvider_ProtocolVersion int32
+
+const (
+	ApplicationPubSub_MQTTProvider_V3_1_1 ApplicationPubSub_MQTTProvider_ProtocolVersion = 0
+	ApplicationPubSub_MQTTProvider_V5     ApplicationPubSub_MQTTProvider_ProtocolVersion = 1
+)
+
+var ApplicationPubSub_MQTTProvider_ProtocolVersion_name = map[int32]string{
+	0: "V3_1_1",
+	1: "V5",
+}
+
+var ApplicationPubSub_MQTTProvider_ProtocolVersion_value = map[string]int32{
+	"V3_1_1": 0,
+	"V5":     1,
+}
+
+func (ApplicationPubSub_MQTTProvider_ProtocolVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 1, 1}
+}
+
+type ApplicationPubSub_KafkaProvider_SASLMechanism int32
+
+const (
+	ApplicationPubSub_KafkaProvider_NONE          ApplicationPubSub_KafkaProvider_SASLMechanism = 0
+	ApplicationPubSub_KafkaProvider_PLAIN         ApplicationPubSub_KafkaProvider_SASLMechanism = 1
+	ApplicationPubSub_KafkaProvider_SCRAM_SHA_256 ApplicationPubSub_KafkaProvider_SASLMechanism = 2
+	ApplicationPubSub_KafkaProvider_SCRAM_SHA_512 ApplicationPubSub_KafkaProvider_SASLMechanism = 3
+)
+
+var ApplicationPubSub_KafkaProvider_SASLMechanism_name = map[int32]string{
+	0: "NONE",
+	1: "PLAIN",
+	2: "SCRAM_SHA_256",
+	3: "SCRAM_SHA_512",
+}
+
+var ApplicationPubSub_KafkaProvider_SASLMechanism_value = map[string]int32{
+	"NONE":          0,
+	"PLAIN":         1,
+	"SCRAM_SHA_256": 2,
+	"SCRAM_SHA_512": 3,
+}
+
+func (ApplicationPubSub_KafkaProvider_SASLMechanism) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 2, 0}
+}
+
+type ApplicationPubSub_AWSIoTProvider_AuthenticationMethod int32
+
+const (
+	ApplicationPubSub_AWSIoTProvider_X509            ApplicationPubSub_AWSIoTProvider_AuthenticationMethod = 0
+	ApplicationPubSub_AWSIoTProvider_SIGV4_WEBSOCKET ApplicationPubSub_AWSIoTProvider_AuthenticationMethod = 1
+)
+
+var ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_name = map[int32]string{
+	0: "X509",
+	1: "SIGV4_WEBSOCKET",
+}
+
+var ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_value = map[string]int32{
+	"X509":            0,
+	"SIGV4_WEBSOCKET": 1,
+}
+
+func (ApplicationPubSub_AWSIoTProvider_AuthenticationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 4, 0}
+}
+
+type ApplicationPubSub_AzureProvider_AuthenticationMethod int32
+
+const (
+	ApplicationPubSub_AzureProvider_CONNECTION_STRING ApplicationPubSub_AzureProvider_AuthenticationMethod = 0
+	ApplicationPubSub_AzureProvider_AAD               ApplicationPubSub_AzureProvider_AuthenticationMethod = 1
+)
+
+var ApplicationPubSub_AzureProvider_AuthenticationMethod_name = map[int32]string{
+	0: "CONNECTION_STRING",
+	1: "AAD",
+}
+
+var ApplicationPubSub_AzureProvider_AuthenticationMethod_value = map[string]int32{
+	"CONNECTION_STRING": 0,
+	"AAD":               1,
+}
+
+func (ApplicationPubSub_AzureProvider_AuthenticationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 5, 0}
+}
+
+type ApplicationPubSubStatus_State int32
+
+const (
+	// The integration is not running.
+	ApplicationPubSubStatus_STOPPED ApplicationPubSubStatus_State = 0
+	// The integration is connecting to the pub/sub.
+	ApplicationPubSubStatus_CONNECTING ApplicationPubSubStatus_State = 1
+	// The integration is connected to the pub/sub.
+	ApplicationPubSubStatus_CONNECTED ApplicationPubSubStatus_State = 2
+	// The integration is connected to the pub/sub, but publishing or receiving messages fails.
+	ApplicationPubSubStatus_DEGRADED ApplicationPubSubStatus_State = 3
+	// The integration failed to connect or lost the connection, and is restarted with backoff.
+	ApplicationPubSubStatus_FAILED ApplicationPubSubStatus_State = 4
+	// The integration is paused. Upstream messages are buffered and downlink queue operations are not received.
+	ApplicationPubSubStatus_PAUSED ApplicationPubSubStatus_State = 5
+)
+
+var ApplicationPubSubStatus_State_name = map[int32]string{
+	0: "STOPPED",
+	1: "CONNECTING",
+	2: "CONNECTED",
+	3: "DEGRADED",
+	4: "FAILED",
+	5: "PAUSED",
+}
+
+var ApplicationPubSubStatus_State_value = map[string]int32{
+	"STOPPED":    0,
+	"CONNECTING": 1,
+	"CONNECTED":  2,
+	"DEGRADED":   3,
+	"FAILED":     4,
+	"PAUSED":     5,
+}
+
+func (ApplicationPubSubStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{7, 0}
+}
+
 type ApplicationPubSubIdentifiers struct {
 	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
 	PubSubID               string   `protobuf:"bytes,2,opt,name=pub_sub_id,json=pubSubId,proto3" json:"pub_sub_id,omitempty"`
@@ -123,23 +252,33 @@ type ApplicationPubSub struct {
 	// Types that are valid to be assigned to Provider:
 	//	*ApplicationPubSub_NATS
 	//	*ApplicationPubSub_MQTT
+	//	*ApplicationPubSub_Kafka
+	//	*ApplicationPubSub_AMQP
+	//	*ApplicationPubSub_AWSIoT
+	//	*ApplicationPubSub_Azure
+	//	*ApplicationPubSub_Redis
 	Provider isApplicationPubSub_Provider `protobuf_oneof:"provider"`
 	// Base topic name to which the messages topic is appended.
 	BaseTopic string `protobuf:"bytes,6,opt,name=base_topic,json=baseTopic,proto3" json:"base_topic,omitempty"`
 	// The topic to which the Application Server subscribes for downlink queue push operations.
 	DownlinkPush *ApplicationPubSub_Message `protobuf:"bytes,7,opt,name=downlink_push,json=downlinkPush,proto3" json:"downlink_push,omitempty"`
 	// The topic to which the Application Server subscribes for downlink queue replace operations.
-	DownlinkReplace      *ApplicationPubSub_Message `protobuf:"bytes,8,opt,name=downlink_replace,json=downlinkReplace,proto3" json:"downlink_replace,omitempty"`
-	UplinkMessage        *ApplicationPubSub_Message `protobuf:"bytes,9,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationPubSub_Message `protobuf:"bytes,10,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationPubSub_Message `protobuf:"bytes,11,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationPubSub_Message `protobuf:"bytes,12,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationPubSub_Message `protobuf:"bytes,13,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationPubSub_Message `protobuf:"bytes,14,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationPubSub_Message `protobuf:"bytes,15,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationPubSub_Message `protobuf:"bytes,16,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	DownlinkReplace *ApplicationPubSub_Message `protobuf:"bytes,8,opt,name=downlink_replace,json=downlinkReplace,proto3" json:"downlink_replace,omitempty"`
+	UplinkMessage   *ApplicationPubSub_Message `protobuf:"bytes,9,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept      *ApplicationPubSub_Message `protobuf:"bytes,10,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck     *ApplicationPubSub_Message `protobuf:"bytes,11,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack    *ApplicationPubSub_Message `protobuf:"bytes,12,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent    *ApplicationPubSub_Message `protobuf:"bytes,13,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed  *ApplicationPubSub_Message `protobuf:"bytes,14,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued  *ApplicationPubSub_Message `protobuf:"bytes,15,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved  *ApplicationPubSub_Message `protobuf:"bytes,16,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	// The FPorts of the messages that are published. If empty, messages on all FPorts are published.
+	// Only messages with an FPort, i.e. uplink messages and downlink messages, are filtered.
+	FPorts []uint32 `protobuf:"varint,31,rep,packed,name=f_ports,json=fPorts,proto3" json:"f_ports,omitempty"`
+	// Filter of the upstream messages that are published. If not set, all messages are published.
+	UpFilter             *ApplicationUpFilter `protobuf:"bytes,32,opt,name=up_filter,json=upFilter,proto3" json:"up_filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *ApplicationPubSub) Reset()      { *m = ApplicationPubSub{} }
@@ -187,9 +326,29 @@ type ApplicationPubSub_NATS struct {
 type ApplicationPubSub_MQTT struct {
 	MQTT *ApplicationPubSub_MQTTProvider `protobuf:"bytes,25,opt,name=mqtt,proto3,oneof" json:"mqtt,omitempty"`
 }
+type ApplicationPubSub_Kafka struct {
+	Kafka *ApplicationPubSub_KafkaProvider `protobuf:"bytes,26,opt,name=kafka,proto3,oneof" json:"kafka,omitempty"`
+}
+type ApplicationPubSub_AMQP struct {
+	AMQP *ApplicationPubSub_AMQPProvider `protobuf:"bytes,27,opt,name=amqp,proto3,oneof" json:"amqp,omitempty"`
+}
+type ApplicationPubSub_AWSIoT struct {
+	AWSIoT *ApplicationPubSub_AWSIoTProvider `protobuf:"bytes,28,opt,name=aws_iot,json=awsIot,proto3,oneof" json:"aws_iot,omitempty"`
+}
+type ApplicationPubSub_Azure struct {
+	Azure *ApplicationPubSub_AzureProvider `protobuf:"bytes,29,opt,name=azure,proto3,oneof" json:"azure,omitempty"`
+}
+type ApplicationPubSub_Redis struct {
+	Redis *ApplicationPubSub_RedisProvider `protobuf:"bytes,30,opt,name=redis,proto3,oneof" json:"redis,omitempty"`
+}
 
-func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider() {}
-func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_NATS) isApplicationPubSub_Provider()   {}
+func (*ApplicationPubSub_MQTT) isApplicationPubSub_Provider()   {}
+func (*ApplicationPubSub_Kafka) isApplicationPubSub_Provider()  {}
+func (*ApplicationPubSub_AMQP) isApplicationPubSub_Provider()   {}
+func (*ApplicationPubSub_AWSIoT) isApplicationPubSub_Provider() {}
+func (*ApplicationPubSub_Azure) isApplicationPubSub_Provider()  {}
+func (*ApplicationPubSub_Redis) isApplicationPubSub_Provider()  {}
 
 func (m *ApplicationPubSub) GetProvider() isApplicationPubSub_Provider {
 	if m != nil {
@@ -233,6 +392,41 @@ func (m *ApplicationPubSub) GetMQTT() *ApplicationPubSub_MQTTProvider {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetKafka() *ApplicationPubSub_KafkaProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Kafka); ok {
+		return x.Kafka
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetAMQP() *ApplicationPubSub_AMQPProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_AMQP); ok {
+		return x.AMQP
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetAWSIoT() *ApplicationPubSub_AWSIoTProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_AWSIoT); ok {
+		return x.AWSIoT
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetAzure() *ApplicationPubSub_AzureProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Azure); ok {
+		return x.Azure
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetRedis() *ApplicationPubSub_RedisProvider {
+	if x, ok := m.GetProvider().(*ApplicationPubSub_Redis); ok {
+		return x.Redis
+	}
+	return nil
+}
+
 func (m *ApplicationPubSub) GetBaseTopic() string {
 	if m != nil {
 		return m.BaseTopic
@@ -310,18 +504,46 @@ func (m *ApplicationPubSub) GetLocationSolved() *ApplicationPubSub_Message {
 	return nil
 }
 
+func (m *ApplicationPubSub) GetFPorts() []uint32 {
+	if m != nil {
+		return m.FPorts
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub) GetUpFilter() *ApplicationUpFilter {
+	if m != nil {
+		return m.UpFilter
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ApplicationPubSub) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ApplicationPubSub_NATS)(nil),
 		(*ApplicationPubSub_MQTT)(nil),
+		(*ApplicationPubSub_Kafka)(nil),
+		(*ApplicationPubSub_AMQP)(nil),
+		(*ApplicationPubSub_AWSIoT)(nil),
+		(*ApplicationPubSub_Azure)(nil),
+		(*ApplicationPubSub_Redis)(nil),
 	}
 }
 
 // The NATS provider settings.
 type ApplicationPubSub_NATSProvider struct {
 	// The server connection URL.
-	ServerURL            string   `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	// Use JetStream for at-least-once delivery. The messages are published to a stream with acknowledgements,
+	// and the downlink messages are consumed by durable consumers.
+	JetStream bool `protobuf:"varint,2,opt,name=jetstream,proto3" json:"jetstream,omitempty"`
+	// The JetStream stream which stores the messages. The stream is created if it does not exist,
+	// and the subjects of the messages are added to it. If empty, ttn-lw-application-server is used.
+	Stream string `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+	// The prefix of the names of the durable consumers of the downlink subjects.
+	// If empty, ttn-lw-application-server is used.
+	DurablePrefix        string   `protobuf:"bytes,4,opt,name=durable_prefix,json=durablePrefix,proto3" json:"durable_prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -365,6 +587,27 @@ func (m *ApplicationPubSub_NATSProvider) GetServerURL() string {
 	return ""
 }
 
+func (m *ApplicationPubSub_NATSProvider) GetJetStream() bool {
+	if m != nil {
+		return m.JetStream
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_NATSProvider) GetStream() string {
+	if m != nil {
+		return m.Stream
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_NATSProvider) GetDurablePrefix() string {
+	if m != nil {
+		return m.DurablePrefix
+	}
+	return ""
+}
+
 // The MQTT provider settings.
 type ApplicationPubSub_MQTTProvider struct {
 	ServerURL    string                             `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
@@ -379,9 +622,17 @@ type ApplicationPubSub_MQTTProvider struct {
 	// The client certificate. PEM formatted.
 	TLSClientCert []byte `protobuf:"bytes,9,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
 	// The client private key. PEM formatted.
-	TLSClientKey         []byte   `protobuf:"bytes,10,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	TLSClientKey []byte `protobuf:"bytes,10,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The MQTT protocol version to use.
+	ProtocolVersion ApplicationPubSub_MQTTProvider_ProtocolVersion `protobuf:"varint,11,opt,name=protocol_version,json=protocolVersion,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_ProtocolVersion" json:"protocol_version,omitempty"`
+	// The time the server keeps the session after the connection is closed.
+	// Only used with MQTT 5. If not set, the session ends when the connection is closed.
+	SessionExpiryInterval *time.Duration `protobuf:"bytes,12,opt,name=session_expiry_interval,json=sessionExpiryInterval,proto3,stdduration" json:"session_expiry_interval,omitempty"`
+	// The time the server keeps published messages for subscribers that are not connected.
+	// Only used with MQTT 5. If not set, messages do not expire.
+	MessageExpiryInterval *time.Duration `protobuf:"bytes,13,opt,name=message_expiry_interval,json=messageExpiryInterval,proto3,stdduration" json:"message_expiry_interval,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}       `json:"-"`
+	XXX_sizecache         int32          `json:"-"`
 }
 
 func (m *ApplicationPubSub_MQTTProvider) Reset()      { *m = ApplicationPubSub_MQTTProvider{} }
@@ -486,24 +737,57 @@ func (m *ApplicationPubSub_MQTTProvider) GetTLSClientKey() []byte {
 	return nil
 }
 
-type ApplicationPubSub_Message struct {
-	// The topic on which the Application Server publishes or receives the messages.
-	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ApplicationPubSub_MQTTProvider) GetProtocolVersion() ApplicationPubSub_MQTTProvider_ProtocolVersion {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return ApplicationPubSub_MQTTProvider_V3_1_1
 }
 
-func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
-func (*ApplicationPubSub_Message) ProtoMessage() {}
-func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
+func (m *ApplicationPubSub_MQTTProvider) GetSessionExpiryInterval() *time.Duration {
+	if m != nil {
+		return m.SessionExpiryInterval
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_MQTTProvider) GetMessageExpiryInterval() *time.Duration {
+	if m != nil {
+		return m.MessageExpiryInterval
+	}
+	return nil
+}
+
+// The Kafka provider settings.
+type ApplicationPubSub_KafkaProvider struct {
+	// The addresses of the bootstrap brokers, in host:port format.
+	Brokers []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	UseTLS  bool     `protobuf:"varint,2,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,3,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,4,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,5,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The SASL mechanism to authenticate with. NONE disables SASL authentication.
+	SASLMechanism        ApplicationPubSub_KafkaProvider_SASLMechanism `protobuf:"varint,6,opt,name=sasl_mechanism,json=saslMechanism,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASLMechanism" json:"sasl_mechanism,omitempty"`
+	SASLUsername         string                                        `protobuf:"bytes,7,opt,name=sasl_username,json=saslUsername,proto3" json:"sasl_username,omitempty"`
+	SASLPassword         string                                        `protobuf:"bytes,8,opt,name=sasl_password,json=saslPassword,proto3" json:"sasl_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Reset()      { *m = ApplicationPubSub_KafkaProvider{} }
+func (*ApplicationPubSub_KafkaProvider) ProtoMessage() {}
+func (*ApplicationPubSub_KafkaProvider) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1dce56ec18597200, []int{1, 2}
 }
-func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
+func (m *ApplicationPubSub_KafkaProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationPubSub_Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_KafkaProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationPubSub_Message.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_KafkaProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -513,42 +797,103 @@ func (m *ApplicationPubSub_Message) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ApplicationPubSub_Message) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationPubSub_Message.Merge(m, src)
+func (m *ApplicationPubSub_KafkaProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.Merge(m, src)
 }
-func (m *ApplicationPubSub_Message) XXX_Size() int {
+func (m *ApplicationPubSub_KafkaProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationPubSub_Message) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationPubSub_Message.DiscardUnknown(m)
+func (m *ApplicationPubSub_KafkaProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_KafkaProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationPubSub_Message proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_KafkaProvider proto.InternalMessageInfo
 
-func (m *ApplicationPubSub_Message) GetTopic() string {
+func (m *ApplicationPubSub_KafkaProvider) GetBrokers() []string {
 	if m != nil {
-		return m.Topic
+		return m.Brokers
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASLMechanism() ApplicationPubSub_KafkaProvider_SASLMechanism {
+	if m != nil {
+		return m.SASLMechanism
+	}
+	return ApplicationPubSub_KafkaProvider_NONE
+}
+
+func (m *ApplicationPubSub_KafkaProvider) GetSASLUsername() string {
+	if m != nil {
+		return m.SASLUsername
 	}
 	return ""
 }
 
-type ApplicationPubSubs struct {
-	Pubsubs              []*ApplicationPubSub `protobuf:"bytes,1,rep,name=pubsubs,proto3" json:"pubsubs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+func (m *ApplicationPubSub_KafkaProvider) GetSASLPassword() string {
+	if m != nil {
+		return m.SASLPassword
+	}
+	return ""
 }
 
-func (m *ApplicationPubSubs) Reset()      { *m = ApplicationPubSubs{} }
-func (*ApplicationPubSubs) ProtoMessage() {}
-func (*ApplicationPubSubs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{2}
+// The AMQP provider settings.
+type ApplicationPubSub_AMQPProvider struct {
+	// The server connection URL, in amqp:// or amqps:// format.
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	UseTLS    bool   `protobuf:"varint,2,opt,name=use_tls,json=useTls,proto3" json:"use_tls,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	TLSCA []byte `protobuf:"bytes,3,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted.
+	TLSClientCert []byte `protobuf:"bytes,4,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted.
+	TLSClientKey []byte `protobuf:"bytes,5,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The exchange to which the messages are published, with the topic as routing key.
+	// The downlink queues are bound to this exchange. The default exchange is used if empty.
+	Exchange             string   `protobuf:"bytes,6,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ApplicationPubSubs) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationPubSub_AMQPProvider) Reset()      { *m = ApplicationPubSub_AMQPProvider{} }
+func (*ApplicationPubSub_AMQPProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AMQPProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 3}
+}
+func (m *ApplicationPubSub_AMQPProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationPubSubs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_AMQPProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationPubSubs.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_AMQPProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -558,43 +903,98 @@ func (m *ApplicationPubSubs) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ApplicationPubSubs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationPubSubs.Merge(m, src)
+func (m *ApplicationPubSub_AMQPProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.Merge(m, src)
 }
-func (m *ApplicationPubSubs) XXX_Size() int {
+func (m *ApplicationPubSub_AMQPProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationPubSubs) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationPubSubs.DiscardUnknown(m)
+func (m *ApplicationPubSub_AMQPProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AMQPProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationPubSubs proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_AMQPProvider proto.InternalMessageInfo
 
-func (m *ApplicationPubSubs) GetPubsubs() []*ApplicationPubSub {
+func (m *ApplicationPubSub_AMQPProvider) GetServerURL() string {
 	if m != nil {
-		return m.Pubsubs
+		return m.ServerURL
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetUseTLS() bool {
+	if m != nil {
+		return m.UseTLS
+	}
+	return false
+}
+
+func (m *ApplicationPubSub_AMQPProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
 	}
 	return nil
 }
 
-type ApplicationPubSubFormats struct {
-	// Format and description.
-	Formats              map[string]string `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
 }
 
-func (m *ApplicationPubSubFormats) Reset()      { *m = ApplicationPubSubFormats{} }
-func (*ApplicationPubSubFormats) ProtoMessage() {}
-func (*ApplicationPubSubFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{3}
+func (m *ApplicationPubSub_AMQPProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
 }
-func (m *ApplicationPubSubFormats) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationPubSub_AMQPProvider) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+// The AWS IoT Core provider settings.
+type ApplicationPubSub_AWSIoTProvider struct {
+	// The AWS IoT device data endpoint, i.e. xxx-ats.iot.<region>.amazonaws.com.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// The AWS region. If empty, the region is derived from the endpoint.
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// The name of the thing that represents the pub/sub. It is used as MQTT client ID.
+	// {thing_name} in the base topic and the message topics is replaced with the thing name.
+	ThingName            string                                                `protobuf:"bytes,3,opt,name=thing_name,json=thingName,proto3" json:"thing_name,omitempty"`
+	AuthenticationMethod ApplicationPubSub_AWSIoTProvider_AuthenticationMethod `protobuf:"varint,4,opt,name=authentication_method,json=authenticationMethod,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_AWSIoTProvider_AuthenticationMethod" json:"authentication_method,omitempty"`
+	// The server Root CA certificate. PEM formatted.
+	// If empty, the system Root CA certificates are used.
+	TLSCA []byte `protobuf:"bytes,5,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// The client certificate. PEM formatted. Used for X509 authentication.
+	TLSClientCert []byte `protobuf:"bytes,6,opt,name=tls_client_cert,json=tlsClientCert,proto3" json:"tls_client_cert,omitempty"`
+	// The client private key. PEM formatted. Used for X509 authentication.
+	TLSClientKey []byte `protobuf:"bytes,7,opt,name=tls_client_key,json=tlsClientKey,proto3" json:"tls_client_key,omitempty"`
+	// The AWS access key ID. Used for SIGV4_WEBSOCKET authentication.
+	AccessKeyID string `protobuf:"bytes,8,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`
+	// The AWS secret access key. Used for SIGV4_WEBSOCKET authentication.
+	SecretAccessKey string `protobuf:"bytes,9,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"`
+	// The AWS session token of temporary credentials. Used for SIGV4_WEBSOCKET authentication.
+	SessionToken         string   `protobuf:"bytes,10,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) Reset()      { *m = ApplicationPubSub_AWSIoTProvider{} }
+func (*ApplicationPubSub_AWSIoTProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AWSIoTProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 4}
+}
+func (m *ApplicationPubSub_AWSIoTProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ApplicationPubSubFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_AWSIoTProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ApplicationPubSubFormats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_AWSIoTProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -604,43 +1004,120 @@ func (m *ApplicationPubSubFormats) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ApplicationPubSubFormats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ApplicationPubSubFormats.Merge(m, src)
+func (m *ApplicationPubSub_AWSIoTProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AWSIoTProvider.Merge(m, src)
 }
-func (m *ApplicationPubSubFormats) XXX_Size() int {
+func (m *ApplicationPubSub_AWSIoTProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *ApplicationPubSubFormats) XXX_DiscardUnknown() {
-	xxx_messageInfo_ApplicationPubSubFormats.DiscardUnknown(m)
+func (m *ApplicationPubSub_AWSIoTProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AWSIoTProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ApplicationPubSubFormats proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_AWSIoTProvider proto.InternalMessageInfo
 
-func (m *ApplicationPubSubFormats) GetFormats() map[string]string {
+func (m *ApplicationPubSub_AWSIoTProvider) GetEndpoint() string {
 	if m != nil {
-		return m.Formats
+		return m.Endpoint
 	}
-	return nil
+	return ""
 }
 
-type GetApplicationPubSubRequest struct {
-	ApplicationPubSubIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
-	FieldMask                    types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
-	XXX_sizecache                int32           `json:"-"`
+func (m *ApplicationPubSub_AWSIoTProvider) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
 }
 
-func (m *GetApplicationPubSubRequest) Reset()      { *m = GetApplicationPubSubRequest{} }
-func (*GetApplicationPubSubRequest) ProtoMessage() {}
-func (*GetApplicationPubSubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{4}
+func (m *ApplicationPubSub_AWSIoTProvider) GetThingName() string {
+	if m != nil {
+		return m.ThingName
+	}
+	return ""
 }
-func (m *GetApplicationPubSubRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetAuthenticationMethod() ApplicationPubSub_AWSIoTProvider_AuthenticationMethod {
+	if m != nil {
+		return m.AuthenticationMethod
+	}
+	return ApplicationPubSub_AWSIoTProvider_X509
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSCA() []byte {
+	if m != nil {
+		return m.TLSCA
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientCert() []byte {
+	if m != nil {
+		return m.TLSClientCert
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetTLSClientKey() []byte {
+	if m != nil {
+		return m.TLSClientKey
+	}
+	return nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetAccessKeyID() string {
+	if m != nil {
+		return m.AccessKeyID
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetSecretAccessKey() string {
+	if m != nil {
+		return m.SecretAccessKey
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) GetSessionToken() string {
+	if m != nil {
+		return m.SessionToken
+	}
+	return ""
+}
+
+// The Azure Event Hubs and Service Bus provider settings.
+type ApplicationPubSub_AzureProvider struct {
+	AuthenticationMethod ApplicationPubSub_AzureProvider_AuthenticationMethod `protobuf:"varint,1,opt,name=authentication_method,json=authenticationMethod,proto3,enum=ttn.lorawan.v3.ApplicationPubSub_AzureProvider_AuthenticationMethod" json:"authentication_method,omitempty"`
+	// The connection string of the Event Hubs namespace. Used for CONNECTION_STRING authentication.
+	EventHubsConnectionString string `protobuf:"bytes,2,opt,name=event_hubs_connection_string,json=eventHubsConnectionString,proto3" json:"event_hubs_connection_string,omitempty"`
+	// The connection string of the Service Bus namespace. Used for CONNECTION_STRING authentication.
+	ServiceBusConnectionString string `protobuf:"bytes,3,opt,name=service_bus_connection_string,json=serviceBusConnectionString,proto3" json:"service_bus_connection_string,omitempty"`
+	// The name of the Event Hubs namespace. Used for AAD authentication.
+	EventHubsNamespace string `protobuf:"bytes,4,opt,name=event_hubs_namespace,json=eventHubsNamespace,proto3" json:"event_hubs_namespace,omitempty"`
+	// The name of the Service Bus namespace. Used for AAD authentication.
+	ServiceBusNamespace string `protobuf:"bytes,5,opt,name=service_bus_namespace,json=serviceBusNamespace,proto3" json:"service_bus_namespace,omitempty"`
+	// The Azure Active Directory tenant ID. Used for AAD authentication.
+	TenantID string `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// The client ID of the Azure Active Directory application. Used for AAD authentication.
+	ClientID string `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The client secret of the Azure Active Directory application. Used for AAD authentication.
+	ClientSecret         string   `protobuf:"bytes,8,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_AzureProvider) Reset()      { *m = ApplicationPubSub_AzureProvider{} }
+func (*ApplicationPubSub_AzureProvider) ProtoMessage() {}
+func (*ApplicationPubSub_AzureProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 5}
+}
+func (m *ApplicationPubSub_AzureProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_AzureProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetApplicationPubSubRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_AzureProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,43 +1127,102 @@ func (m *GetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetApplicationPubSubRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetApplicationPubSubRequest.Merge(m, src)
+func (m *ApplicationPubSub_AzureProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_AzureProvider.Merge(m, src)
 }
-func (m *GetApplicationPubSubRequest) XXX_Size() int {
+func (m *ApplicationPubSub_AzureProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetApplicationPubSubRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetApplicationPubSubRequest.DiscardUnknown(m)
+func (m *ApplicationPubSub_AzureProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_AzureProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetApplicationPubSubRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_AzureProvider proto.InternalMessageInfo
 
-func (m *GetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationPubSub_AzureProvider) GetAuthenticationMethod() ApplicationPubSub_AzureProvider_AuthenticationMethod {
 	if m != nil {
-		return m.FieldMask
+		return m.AuthenticationMethod
 	}
-	return types.FieldMask{}
+	return ApplicationPubSub_AzureProvider_CONNECTION_STRING
 }
 
-type ListApplicationPubSubsRequest struct {
-	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
-	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
-	XXX_sizecache          int32           `json:"-"`
+func (m *ApplicationPubSub_AzureProvider) GetEventHubsConnectionString() string {
+	if m != nil {
+		return m.EventHubsConnectionString
+	}
+	return ""
 }
 
-func (m *ListApplicationPubSubsRequest) Reset()      { *m = ListApplicationPubSubsRequest{} }
-func (*ListApplicationPubSubsRequest) ProtoMessage() {}
-func (*ListApplicationPubSubsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{5}
+func (m *ApplicationPubSub_AzureProvider) GetServiceBusConnectionString() string {
+	if m != nil {
+		return m.ServiceBusConnectionString
+	}
+	return ""
 }
-func (m *ListApplicationPubSubsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationPubSub_AzureProvider) GetEventHubsNamespace() string {
+	if m != nil {
+		return m.EventHubsNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AzureProvider) GetServiceBusNamespace() string {
+	if m != nil {
+		return m.ServiceBusNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AzureProvider) GetTenantID() string {
+	if m != nil {
+		return m.TenantID
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AzureProvider) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
+}
+
+func (m *ApplicationPubSub_AzureProvider) GetClientSecret() string {
+	if m != nil {
+		return m.ClientSecret
+	}
+	return ""
+}
+
+// The Redis Streams provider settings.
+type ApplicationPubSub_RedisProvider struct {
+	// The URL of the Redis server, in redis://[:password@]host[:port][/db] format.
+	// Use the rediss scheme to connect using TLS.
+	ServerURL string `protobuf:"bytes,1,opt,name=server_url,json=serverUrl,proto3" json:"server_url,omitempty"`
+	// The maximum number of entries of the streams. The streams are trimmed when entries are added.
+	// Zero disables trimming.
+	StreamMaxLength uint32 `protobuf:"varint,2,opt,name=stream_max_length,json=streamMaxLength,proto3" json:"stream_max_length,omitempty"`
+	// Trim the streams approximately, which is more efficient than exact trimming.
+	ApproximateTrimming bool `protobuf:"varint,3,opt,name=approximate_trimming,json=approximateTrimming,proto3" json:"approximate_trimming,omitempty"`
+	// The consumer group which reads the downlink messages from the streams.
+	// If empty, ttn-lw-application-server is used.
+	ConsumerGroup        string   `protobuf:"bytes,4,opt,name=consumer_group,json=consumerGroup,proto3" json:"consumer_group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_RedisProvider) Reset()      { *m = ApplicationPubSub_RedisProvider{} }
+func (*ApplicationPubSub_RedisProvider) ProtoMessage() {}
+func (*ApplicationPubSub_RedisProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 6}
+}
+func (m *ApplicationPubSub_RedisProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListApplicationPubSubsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_RedisProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListApplicationPubSubsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_RedisProvider.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -696,43 +1232,64 @@ func (m *ListApplicationPubSubsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ListApplicationPubSubsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListApplicationPubSubsRequest.Merge(m, src)
+func (m *ApplicationPubSub_RedisProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_RedisProvider.Merge(m, src)
 }
-func (m *ListApplicationPubSubsRequest) XXX_Size() int {
+func (m *ApplicationPubSub_RedisProvider) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListApplicationPubSubsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListApplicationPubSubsRequest.DiscardUnknown(m)
+func (m *ApplicationPubSub_RedisProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_RedisProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListApplicationPubSubsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_RedisProvider proto.InternalMessageInfo
 
-func (m *ListApplicationPubSubsRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationPubSub_RedisProvider) GetServerURL() string {
 	if m != nil {
-		return m.FieldMask
+		return m.ServerURL
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-type SetApplicationPubSubRequest struct {
-	ApplicationPubSub    `protobuf:"bytes,1,opt,name=pubsub,proto3,embedded=pubsub" json:"pubsub"`
-	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *ApplicationPubSub_RedisProvider) GetStreamMaxLength() uint32 {
+	if m != nil {
+		return m.StreamMaxLength
+	}
+	return 0
 }
 
-func (m *SetApplicationPubSubRequest) Reset()      { *m = SetApplicationPubSubRequest{} }
-func (*SetApplicationPubSubRequest) ProtoMessage() {}
-func (*SetApplicationPubSubRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1dce56ec18597200, []int{6}
+func (m *ApplicationPubSub_RedisProvider) GetApproximateTrimming() bool {
+	if m != nil {
+		return m.ApproximateTrimming
+	}
+	return false
 }
-func (m *SetApplicationPubSubRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ApplicationPubSub_RedisProvider) GetConsumerGroup() string {
+	if m != nil {
+		return m.ConsumerGroup
+	}
+	return ""
+}
+
+type ApplicationPubSub_Message struct {
+	// The topic on which the Application Server publishes or receives the messages.
+	Topic                string   `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationPubSub_Message) Reset()      { *m = ApplicationPubSub_Message{} }
+func (*ApplicationPubSub_Message) ProtoMessage() {}
+func (*ApplicationPubSub_Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{1, 7}
+}
+func (m *ApplicationPubSub_Message) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApplicationPubSub_Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SetApplicationPubSubRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApplicationPubSub_Message.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -742,429 +1299,639 @@ func (m *SetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *SetApplicationPubSubRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetApplicationPubSubRequest.Merge(m, src)
+func (m *ApplicationPubSub_Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSub_Message.Merge(m, src)
 }
-func (m *SetApplicationPubSubRequest) XXX_Size() int {
+func (m *ApplicationPubSub_Message) XXX_Size() int {
 	return m.Size()
 }
-func (m *SetApplicationPubSubRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetApplicationPubSubRequest.DiscardUnknown(m)
+func (m *ApplicationPubSub_Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSub_Message.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetApplicationPubSubRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApplicationPubSub_Message proto.InternalMessageInfo
 
-func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
+func (m *ApplicationPubSub_Message) GetTopic() string {
 	if m != nil {
-		return m.FieldMask
+		return m.Topic
 	}
-	return types.FieldMask{}
+	return ""
 }
 
-func init() {
-	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
-	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
-	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
-	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
-	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
-	golang_proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
-	proto.RegisterType((*ApplicationPubSub_NATSProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.NATSProvider")
-	golang_proto.RegisterType((*ApplicationPubSub_NATSProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.NATSProvider")
-	proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
-	golang_proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
-	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
-	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
-	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
-	golang_proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
-	proto.RegisterType((*ApplicationPubSubFormats)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats")
-	golang_proto.RegisterType((*ApplicationPubSubFormats)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats")
-	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry")
-	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry")
-	proto.RegisterType((*GetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.GetApplicationPubSubRequest")
-	golang_proto.RegisterType((*GetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.GetApplicationPubSubRequest")
-	proto.RegisterType((*ListApplicationPubSubsRequest)(nil), "ttn.lorawan.v3.ListApplicationPubSubsRequest")
-	golang_proto.RegisterType((*ListApplicationPubSubsRequest)(nil), "ttn.lorawan.v3.ListApplicationPubSubsRequest")
-	proto.RegisterType((*SetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.SetApplicationPubSubRequest")
-	golang_proto.RegisterType((*SetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.SetApplicationPubSubRequest")
+type ApplicationPubSubs struct {
+	Pubsubs              []*ApplicationPubSub `protobuf:"bytes,1,rep,name=pubsubs,proto3" json:"pubsubs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/applicationserver_pubsub.proto", fileDescriptor_1dce56ec18597200)
-}
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_pubsub.proto", fileDescriptor_1dce56ec18597200)
+func (m *ApplicationPubSubs) Reset()      { *m = ApplicationPubSubs{} }
+func (*ApplicationPubSubs) ProtoMessage() {}
+func (*ApplicationPubSubs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{2}
 }
-
-var fileDescriptor_1dce56ec18597200 = []byte{
-	// 1622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0x41, 0x6c, 0xdb, 0xd6,
-	0x19, 0xc7, 0xf9, 0x2c, 0x4b, 0x96, 0x9e, 0x65, 0x59, 0x79, 0xcb, 0x36, 0x56, 0x69, 0x29, 0x4f,
-	0x09, 0x3a, 0x37, 0x8d, 0xa8, 0x40, 0xd9, 0x8a, 0xd6, 0x1d, 0x90, 0x8a, 0x8e, 0x93, 0x76, 0x71,
-	0x1c, 0x9b, 0x52, 0x80, 0xad, 0x45, 0x47, 0x50, 0xe4, 0xb3, 0xcc, 0x8a, 0x22, 0x69, 0xbe, 0x47,
-	0x7b, 0x5e, 0x11, 0xa0, 0xd8, 0xc9, 0xd8, 0x61, 0x30, 0xb0, 0xc3, 0x76, 0xdb, 0xb0, 0xcb, 0x0a,
-	0xec, 0x12, 0xec, 0xd4, 0xdb, 0x0a, 0xec, 0x92, 0x63, 0x80, 0xed, 0xd0, 0x93, 0x56, 0x53, 0x3b,
-	0xf4, 0xb6, 0x1e, 0x03, 0x9f, 0x06, 0x3e, 0x92, 0x92, 0x6c, 0xb9, 0x96, 0xe5, 0x60, 0x3d, 0x99,
-	0xef, 0x7d, 0xdf, 0xf7, 0x7b, 0xff, 0xf7, 0xbd, 0x8f, 0x1f, 0x9f, 0x0c, 0x6f, 0x9a, 0xb6, 0xab,
-	0xee, 0xaa, 0x56, 0x99, 0x50, 0x55, 0x6b, 0x57, 0x54, 0xc7, 0xa8, 0xa8, 0x8e, 0x63, 0x1a, 0x9a,
-	0x4a, 0x0d, 0xdb, 0x22, 0xd8, 0xdd, 0xc1, 0xae, 0xe2, 0x78, 0x4d, 0xe2, 0x35, 0x45, 0xc7, 0xb5,
-	0xa9, 0x8d, 0x72, 0x94, 0x5a, 0x62, 0x14, 0x25, 0xee, 0xdc, 0x2a, 0xd4, 0x5a, 0x06, 0xdd, 0xf2,
-	0x9a, 0xa2, 0x66, 0x77, 0x2a, 0xd8, 0xda, 0xb1, 0xf7, 0x1c, 0xd7, 0xfe, 0xe5, 0x5e, 0x85, 0x39,
-	0x6b, 0xe5, 0x16, 0xb6, 0xca, 0x3b, 0xaa, 0x69, 0xe8, 0x2a, 0xc5, 0x95, 0x91, 0x87, 0x10, 0x59,
-	0x28, 0x0f, 0x21, 0x5a, 0x76, 0xcb, 0x0e, 0x83, 0x9b, 0xde, 0x26, 0x1b, 0xb1, 0x01, 0x7b, 0x8a,
-	0xdc, 0x5f, 0x6e, 0xd9, 0x76, 0xcb, 0xc4, 0xa1, 0x58, 0xcb, 0xb2, 0x69, 0xa8, 0x35, 0xb2, 0x5e,
-	0x89, 0xac, 0x7d, 0x06, 0xee, 0x38, 0x74, 0x2f, 0x32, 0x2e, 0x9c, 0x34, 0x6e, 0x1a, 0xd8, 0xd4,
-	0x95, 0x8e, 0x4a, 0xda, 0x91, 0x47, 0xf1, 0xa4, 0x07, 0x35, 0x3a, 0x98, 0x50, 0xb5, 0xe3, 0x44,
-	0x0e, 0x57, 0x47, 0x33, 0x66, 0xe8, 0xd8, 0xa2, 0xc6, 0xa6, 0x81, 0xdd, 0x48, 0x44, 0xe9, 0x5f,
-	0x00, 0xbe, 0x5c, 0x1b, 0xe4, 0x71, 0xdd, 0x6b, 0xd6, 0xbd, 0xe6, 0x7b, 0x03, 0x37, 0xa4, 0xc2,
-	0xf9, 0xa1, 0x3c, 0x2b, 0x86, 0x4e, 0x78, 0xb0, 0x00, 0x16, 0x67, 0xab, 0xaf, 0x8a, 0xc7, 0xf3,
-	0x2b, 0x0e, 0x61, 0x86, 0x00, 0x52, 0xfe, 0x48, 0x4a, 0xfe, 0x06, 0x4c, 0xe5, 0xc1, 0xd3, 0x6e,
-	0x91, 0x7b, 0xd6, 0x2d, 0x02, 0x39, 0xa7, 0x0e, 0x7b, 0x12, 0xb4, 0x01, 0xa1, 0xe3, 0x35, 0x15,
-	0xe2, 0x35, 0x15, 0x43, 0xe7, 0xa7, 0x16, 0xc0, 0x62, 0x46, 0xba, 0x75, 0x24, 0x5d, 0x73, 0x4b,
-	0xfc, 0xb5, 0xaa, 0xf0, 0x8b, 0x0f, 0xd4, 0xf2, 0xaf, 0x6e, 0x96, 0xdf, 0xfa, 0x70, 0xf1, 0xf6,
-	0xd2, 0x07, 0xe5, 0x0f, 0x6f, 0xc7, 0xc3, 0xd7, 0x3e, 0xae, 0xde, 0x78, 0x7c, 0xcd, 0xef, 0x16,
-	0xd3, 0x91, 0xe8, 0x3b, 0x72, 0xda, 0x89, 0xe4, 0x97, 0xfe, 0x96, 0x87, 0x97, 0x46, 0xb6, 0x85,
-	0xd6, 0x61, 0x62, 0xa0, 0xff, 0xc6, 0x19, 0xfa, 0x47, 0xd2, 0x70, 0xca, 0x2e, 0x02, 0x14, 0x5a,
-	0x86, 0x50, 0x73, 0xb1, 0x4a, 0xb1, 0xae, 0xa8, 0x94, 0x49, 0x9f, 0xad, 0x16, 0xc4, 0xf0, 0x64,
-	0xc4, 0xf8, 0x64, 0xc4, 0x46, 0x7c, 0x32, 0x52, 0x3a, 0x08, 0x3f, 0xf8, 0x77, 0x11, 0xc8, 0x99,
-	0x28, 0xae, 0x46, 0x03, 0x88, 0xe7, 0xe8, 0x31, 0x24, 0x31, 0x09, 0x24, 0x8a, 0xab, 0x51, 0x74,
-	0x1b, 0xa6, 0x36, 0x6d, 0xb7, 0xa3, 0x52, 0x7e, 0x9a, 0x25, 0xf0, 0x87, 0x61, 0x02, 0x2f, 0x8f,
-	0x4b, 0xa0, 0x1c, 0x85, 0xa1, 0x35, 0x38, 0x6d, 0xa9, 0x94, 0xf0, 0x97, 0xd8, 0xfa, 0xe2, 0xd8,
-	0xec, 0x88, 0x6b, 0xb5, 0x46, 0x7d, 0xdd, 0xb5, 0x77, 0x0c, 0x1d, 0xbb, 0x52, 0xda, 0xef, 0x16,
-	0xa7, 0x83, 0x99, 0x77, 0x39, 0x99, 0x71, 0x02, 0x5e, 0x67, 0x9b, 0x52, 0xfe, 0xa5, 0xf3, 0xf2,
-	0x1e, 0x6c, 0x34, 0x1a, 0xc7, 0x79, 0xc1, 0x4c, 0xc0, 0x0b, 0x38, 0xe8, 0x55, 0x08, 0x9b, 0x2a,
-	0xc1, 0x0a, 0xb5, 0x1d, 0x43, 0xe3, 0x53, 0x6c, 0x93, 0x33, 0x47, 0xd2, 0xb4, 0x3b, 0xc5, 0xeb,
-	0x72, 0x26, 0x30, 0x35, 0x02, 0x0b, 0x5a, 0x83, 0x73, 0xba, 0xbd, 0x6b, 0x99, 0x86, 0xd5, 0x56,
-	0x1c, 0x8f, 0x6c, 0xf1, 0x33, 0x4c, 0xc0, 0x6b, 0xe7, 0x10, 0x80, 0x09, 0x51, 0x5b, 0x58, 0xce,
-	0xc6, 0xf1, 0xeb, 0x1e, 0xd9, 0x42, 0x0d, 0x98, 0xef, 0xf3, 0x5c, 0xec, 0x98, 0xaa, 0x86, 0xf9,
-	0xf4, 0xa4, 0xc8, 0xf9, 0x18, 0x21, 0x87, 0x04, 0xb4, 0x0e, 0x73, 0x9e, 0xc3, 0x98, 0x9d, 0xd0,
-	0x85, 0xcf, 0x4c, 0xca, 0x9c, 0x0b, 0x01, 0xd1, 0x10, 0xfd, 0x14, 0xce, 0x7e, 0x64, 0x1b, 0x96,
-	0xa2, 0x6a, 0x1a, 0x76, 0x28, 0x0f, 0x27, 0xc5, 0xc1, 0x20, 0xba, 0xc6, 0x82, 0xd1, 0x2a, 0xec,
-	0xe7, 0x40, 0x51, 0xb5, 0x36, 0x3f, 0x3b, 0x29, 0x6c, 0x36, 0x0e, 0xaf, 0x69, 0xed, 0x63, 0x27,
-	0x62, 0x05, 0xb8, 0xec, 0x85, 0x4f, 0x64, 0x4d, 0x3d, 0xc1, 0x23, 0xd8, 0xa2, 0xfc, 0xdc, 0x85,
-	0x79, 0x75, 0x6c, 0x51, 0x24, 0xc3, 0xfe, 0xf1, 0x28, 0x9b, 0xaa, 0x61, 0x62, 0x9d, 0xcf, 0x4d,
-	0x4a, 0xcc, 0xc5, 0x84, 0xbb, 0x0c, 0x70, 0x8c, 0xb9, 0xed, 0x61, 0x0f, 0xeb, 0xfc, 0xfc, 0x85,
-	0x99, 0x1b, 0x0c, 0x10, 0x30, 0x4d, 0x3b, 0xea, 0xc3, 0xc4, 0x36, 0x77, 0xb0, 0xce, 0xe7, 0x27,
-	0x66, 0xc6, 0x84, 0x3a, 0x03, 0x14, 0xee, 0xc0, 0xec, 0xf0, 0x7b, 0x8c, 0x7e, 0x04, 0x61, 0xf4,
-	0x2d, 0xf5, 0x5c, 0x93, 0x75, 0xca, 0x8c, 0xf4, 0xdd, 0x23, 0x29, 0xe9, 0x26, 0xf6, 0x01, 0xf0,
-	0xbb, 0xc5, 0x4c, 0x9d, 0x59, 0x1f, 0xc9, 0xab, 0x72, 0x26, 0x74, 0x7c, 0xe4, 0x9a, 0x85, 0xfd,
-	0x24, 0xcc, 0x0e, 0xbf, 0xbe, 0x17, 0xc3, 0xa0, 0x9b, 0x30, 0xa3, 0x99, 0x06, 0xb6, 0xe8, 0xe0,
-	0x3b, 0xf0, 0x9d, 0xf0, 0x0d, 0xff, 0x7e, 0xd0, 0xe7, 0x97, 0x99, 0x2d, 0xe8, 0xf3, 0xa1, 0xd7,
-	0x7b, 0x3a, 0xba, 0x0a, 0xd3, 0x1e, 0xc1, 0xae, 0xa5, 0x76, 0x30, 0x6b, 0x9c, 0x43, 0x2d, 0xa1,
-	0x6f, 0x08, 0x9c, 0x1c, 0x95, 0x90, 0x5d, 0xdb, 0xd5, 0xa3, 0xe6, 0x38, 0x70, 0x8a, 0x0d, 0xc8,
-	0x80, 0x73, 0xc4, 0x6b, 0x12, 0xcd, 0x35, 0x9a, 0x58, 0xd9, 0xb6, 0x09, 0x9f, 0x5c, 0x00, 0x8b,
-	0xb9, 0x6a, 0x75, 0xb2, 0xbe, 0x25, 0x6e, 0xd8, 0x75, 0x29, 0xef, 0x77, 0x8b, 0xd9, 0x7a, 0x0c,
-	0xdb, 0xb0, 0xeb, 0x72, 0x96, 0x0c, 0x46, 0x04, 0x69, 0x70, 0xd6, 0xf1, 0x9a, 0xa6, 0x41, 0xb6,
-	0xd8, 0x42, 0xa9, 0x0b, 0x2f, 0x94, 0xf3, 0xbb, 0x45, 0xb8, 0x1e, 0xa2, 0x82, 0x65, 0xa0, 0x13,
-	0x3f, 0x13, 0x74, 0x15, 0xce, 0x78, 0x41, 0xb7, 0x34, 0x09, 0x6b, 0x80, 0x69, 0x09, 0xfa, 0xdd,
-	0x62, 0xea, 0x11, 0xc1, 0x8d, 0xd5, 0xba, 0x9c, 0xf2, 0x08, 0x6e, 0x98, 0x04, 0x2d, 0xc0, 0x14,
-	0x35, 0x89, 0xa2, 0xa9, 0xac, 0xa3, 0x65, 0xa5, 0x8c, 0xdf, 0x2d, 0x26, 0x1b, 0xab, 0xf5, 0xe5,
-	0x9a, 0x9c, 0xa4, 0x26, 0x59, 0x56, 0xd1, 0x5b, 0x70, 0x9e, 0x79, 0x84, 0xc7, 0xa2, 0x61, 0x97,
-	0xb2, 0x46, 0x95, 0x95, 0x2e, 0xf9, 0xdd, 0xe2, 0x5c, 0xe0, 0xca, 0x2c, 0xcb, 0xd8, 0xa5, 0xf2,
-	0x5c, 0x10, 0xd2, 0x1f, 0xa2, 0x37, 0x60, 0x6e, 0x28, 0xb4, 0x8d, 0xf7, 0x58, 0x4f, 0xca, 0x86,
-	0xe9, 0xe9, 0x47, 0xde, 0xc7, 0x7b, 0x72, 0xb6, 0x1f, 0x78, 0x1f, 0xef, 0x95, 0x7e, 0x02, 0x13,
-	0x1b, 0x76, 0x1d, 0xe5, 0x61, 0xb6, 0xd6, 0x50, 0x1e, 0x3c, 0xac, 0x37, 0x94, 0x87, 0x6b, 0xcb,
-	0x2b, 0x79, 0x0e, 0x5d, 0x82, 0x73, 0xb5, 0x86, 0xb2, 0xba, 0x52, 0x8b, 0xa7, 0x40, 0xe0, 0xb4,
-	0xf2, 0xb3, 0xda, 0x72, 0x63, 0xf5, 0xe7, 0xe1, 0xcc, 0x54, 0x61, 0x11, 0xce, 0xc4, 0x1d, 0xf1,
-	0x15, 0x98, 0x0c, 0x3f, 0x16, 0xe0, 0xf8, 0xa1, 0x87, 0xb3, 0xd2, 0x3c, 0x4c, 0x3b, 0x71, 0xbd,
-	0x26, 0x9e, 0x4b, 0xa0, 0xb4, 0x01, 0xd1, 0x48, 0xd2, 0x09, 0x7a, 0x1b, 0xce, 0x84, 0xd7, 0xca,
-	0xe0, 0xe2, 0x90, 0x58, 0x9c, 0xad, 0xfe, 0x60, 0xec, 0x49, 0xc9, 0x71, 0x44, 0xe9, 0x2f, 0x00,
-	0xf2, 0x23, 0xe6, 0xbb, 0xec, 0x83, 0x4b, 0xd0, 0x43, 0x38, 0x13, 0x7e, 0x7b, 0x63, 0xf2, 0x8f,
-	0xc7, 0x92, 0xa3, 0x50, 0x31, 0xfa, 0xbb, 0x62, 0x51, 0x77, 0x4f, 0x8e, 0x29, 0x85, 0x25, 0x98,
-	0x1d, 0x36, 0xa0, 0x3c, 0x4c, 0x04, 0x69, 0x67, 0xdb, 0x97, 0x83, 0x47, 0x74, 0x19, 0x26, 0x77,
-	0x54, 0xd3, 0xc3, 0xe1, 0xdb, 0x25, 0x87, 0x83, 0xa5, 0xa9, 0x37, 0x41, 0xe9, 0x09, 0x80, 0x57,
-	0xee, 0x61, 0x3a, 0xba, 0x17, 0xbc, 0xed, 0x61, 0x42, 0xff, 0x0f, 0x77, 0xa7, 0xdb, 0x10, 0x0e,
-	0x2e, 0xb5, 0xdf, 0x78, 0x77, 0xba, 0x1b, 0xb8, 0x3c, 0x50, 0x49, 0x5b, 0x9a, 0x0e, 0xc2, 0xe5,
-	0xcc, 0x66, 0x3c, 0x51, 0xfa, 0x07, 0x80, 0xaf, 0xac, 0x1a, 0x64, 0x54, 0x33, 0x89, 0x45, 0x7f,
-	0x0b, 0x97, 0xd7, 0x17, 0xde, 0xc5, 0x5f, 0x01, 0xbc, 0x52, 0x3f, 0x23, 0xf1, 0xf7, 0x61, 0x2a,
-	0xac, 0xa6, 0x48, 0xfa, 0xf8, 0xf2, 0x3b, 0x45, 0x75, 0x84, 0x78, 0x61, 0xb5, 0xd5, 0xbf, 0xa7,
-	0xe0, 0x4b, 0xa7, 0x48, 0x6d, 0x19, 0x24, 0x28, 0xb8, 0x8f, 0x20, 0xbc, 0x87, 0x69, 0x5c, 0xdf,
-	0xdf, 0x1b, 0x01, 0xaf, 0x04, 0xbf, 0x70, 0x0a, 0x8b, 0xe7, 0x2d, 0xf3, 0x52, 0xe1, 0xd7, 0xff,
-	0xfc, 0xcf, 0xef, 0xa6, 0x2e, 0x23, 0x54, 0x51, 0x49, 0x25, 0xdc, 0x42, 0x39, 0x2a, 0x76, 0xf4,
-	0x47, 0x00, 0x13, 0xf7, 0x30, 0x45, 0xaf, 0x9f, 0xa4, 0x9d, 0x51, 0xc5, 0x85, 0xf1, 0xc9, 0x2b,
-	0xbd, 0xcb, 0xd6, 0x94, 0xd0, 0x3b, 0x83, 0x35, 0x2b, 0x1f, 0x1b, 0x3a, 0x11, 0x4f, 0x54, 0xd2,
-	0x89, 0xf1, 0xe3, 0xd0, 0x69, 0xf0, 0x43, 0xe6, 0x31, 0xfa, 0x2d, 0x80, 0xd3, 0x41, 0x7d, 0xa2,
-	0xf2, 0xc9, 0x55, 0xcf, 0xac, 0xda, 0x42, 0x69, 0xac, 0x48, 0x52, 0xba, 0xc5, 0x54, 0x96, 0xd1,
-	0xeb, 0xc3, 0x2a, 0xc7, 0x28, 0x44, 0xff, 0x05, 0x30, 0x51, 0x3f, 0x2d, 0x65, 0xf5, 0x17, 0x4b,
-	0xd9, 0xef, 0x01, 0x53, 0x73, 0x00, 0x0a, 0x6b, 0xc3, 0x72, 0xa2, 0x5f, 0xe3, 0xe7, 0xca, 0xdd,
-	0x90, 0xef, 0x50, 0x0a, 0x97, 0xc0, 0xf5, 0xf7, 0xdf, 0x2e, 0xbd, 0x71, 0x31, 0xe8, 0x12, 0xb8,
-	0x8e, 0x0e, 0x00, 0x4c, 0xdd, 0xc1, 0x26, 0xa6, 0x18, 0x4d, 0xd4, 0xb3, 0x0a, 0xdf, 0x50, 0xbb,
-	0xa5, 0x77, 0xd8, 0x4e, 0x97, 0xae, 0xbf, 0x39, 0x41, 0xde, 0x99, 0xe8, 0x78, 0x4b, 0xd2, 0x9f,
-	0xc1, 0xd3, 0x43, 0x01, 0x3c, 0x3b, 0x14, 0xc0, 0x17, 0x87, 0x02, 0xf7, 0xe5, 0xa1, 0xc0, 0x7d,
-	0x75, 0x28, 0x70, 0x5f, 0x1f, 0x0a, 0xdc, 0xf3, 0x43, 0x01, 0x7c, 0xe2, 0x0b, 0x60, 0xdf, 0x17,
-	0xb8, 0x4f, 0x7d, 0x01, 0x3c, 0xf1, 0x05, 0xee, 0x33, 0x5f, 0xe0, 0x3e, 0xf7, 0x05, 0xee, 0xa9,
-	0x2f, 0x80, 0x67, 0xbe, 0x00, 0xbe, 0xf0, 0x05, 0xee, 0x4b, 0x5f, 0x00, 0x5f, 0xf9, 0x02, 0xf7,
-	0xb5, 0x2f, 0x80, 0xe7, 0xbe, 0xc0, 0x7d, 0xd2, 0x13, 0xb8, 0xfd, 0x9e, 0x00, 0x0e, 0x7a, 0x02,
-	0xf7, 0x87, 0x9e, 0x00, 0xfe, 0xd4, 0x13, 0xb8, 0x4f, 0x7b, 0x02, 0xf7, 0xa4, 0x27, 0x80, 0xcf,
-	0x7a, 0x02, 0xf8, 0xbc, 0x27, 0x80, 0xf7, 0x6f, 0xb4, 0x6c, 0x91, 0x6e, 0x61, 0xba, 0x65, 0x58,
-	0x2d, 0x22, 0x5a, 0x98, 0xee, 0xda, 0x6e, 0xbb, 0x72, 0xfc, 0x5f, 0x04, 0x4e, 0xbb, 0x55, 0xa1,
-	0xd4, 0x72, 0x9a, 0xcd, 0x14, 0xdb, 0xf6, 0xad, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x84, 0x92,
-	0x8b, 0x2c, 0x76, 0x11, 0x00, 0x00,
+func (m *ApplicationPubSubs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
-	s, ok := ApplicationPubSub_MQTTProvider_QoS_name[int32(x)]
-	if ok {
-		return s
+func (m *ApplicationPubSubs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSubs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return strconv.Itoa(int(x))
 }
-func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ApplicationPubSubs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSubs.Merge(m, src)
+}
+func (m *ApplicationPubSubs) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSubs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSubs.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ApplicationPubSubIdentifiers)
-	if !ok {
-		that2, ok := that.(ApplicationPubSubIdentifiers)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if this.PubSubID != that1.PubSubID {
-		return false
+var xxx_messageInfo_ApplicationPubSubs proto.InternalMessageInfo
+
+func (m *ApplicationPubSubs) GetPubsubs() []*ApplicationPubSub {
+	if m != nil {
+		return m.Pubsubs
 	}
-	return true
+	return nil
 }
-func (this *ApplicationPubSub) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationPubSub)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.ApplicationPubSubIdentifiers.Equal(&that1.ApplicationPubSubIdentifiers) {
-		return false
-	}
-	if !this.CreatedAt.Equal(that1.CreatedAt) {
-		return false
-	}
-	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
-		return false
-	}
-	if this.Format != that1.Format {
-		return false
-	}
-	if that1.Provider == nil {
-		if this.Provider != nil {
-			return false
-		}
-	} else if this.Provider == nil {
-		return false
-	} else if !this.Provider.Equal(that1.Provider) {
-		return false
-	}
-	if this.BaseTopic != that1.BaseTopic {
-		return false
-	}
-	if !this.DownlinkPush.Equal(that1.DownlinkPush) {
-		return false
-	}
-	if !this.DownlinkReplace.Equal(that1.DownlinkReplace) {
-		return false
-	}
-	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
-		return false
-	}
-	if !this.JoinAccept.Equal(that1.JoinAccept) {
-		return false
-	}
-	if !this.DownlinkAck.Equal(that1.DownlinkAck) {
-		return false
-	}
-	if !this.DownlinkNack.Equal(that1.DownlinkNack) {
-		return false
-	}
-	if !this.DownlinkSent.Equal(that1.DownlinkSent) {
-		return false
-	}
-	if !this.DownlinkFailed.Equal(that1.DownlinkFailed) {
-		return false
-	}
-	if !this.DownlinkQueued.Equal(that1.DownlinkQueued) {
-		return false
-	}
-	if !this.LocationSolved.Equal(that1.LocationSolved) {
-		return false
-	}
-	return true
+type ApplicationPubSubFormats struct {
+	// Format and description.
+	Formats              map[string]string `protobuf:"bytes,1,rep,name=formats,proto3" json:"formats,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (this *ApplicationPubSub_NATS) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationPubSub_NATS)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub_NATS)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ApplicationPubSubFormats) Reset()      { *m = ApplicationPubSubFormats{} }
+func (*ApplicationPubSubFormats) ProtoMessage() {}
+func (*ApplicationPubSubFormats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{3}
+}
+func (m *ApplicationPubSubFormats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSubFormats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSubFormats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.NATS.Equal(that1.NATS) {
-		return false
-	}
-	return true
 }
-func (this *ApplicationPubSub_MQTT) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ApplicationPubSubFormats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSubFormats.Merge(m, src)
+}
+func (m *ApplicationPubSubFormats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSubFormats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSubFormats.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*ApplicationPubSub_MQTT)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub_MQTT)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.MQTT.Equal(that1.MQTT) {
-		return false
+var xxx_messageInfo_ApplicationPubSubFormats proto.InternalMessageInfo
+
+func (m *ApplicationPubSubFormats) GetFormats() map[string]string {
+	if m != nil {
+		return m.Formats
 	}
-	return true
+	return nil
 }
-func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationPubSub_NATSProvider)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub_NATSProvider)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ServerURL != that1.ServerURL {
-		return false
-	}
-	return true
+type GetApplicationPubSubRequest struct {
+	ApplicationPubSubIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
+	FieldMask                    types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral         struct{}        `json:"-"`
+	XXX_sizecache                int32           `json:"-"`
 }
-func (this *ApplicationPubSub_MQTTProvider) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ApplicationPubSub_MQTTProvider)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub_MQTTProvider)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *GetApplicationPubSubRequest) Reset()      { *m = GetApplicationPubSubRequest{} }
+func (*GetApplicationPubSubRequest) ProtoMessage() {}
+func (*GetApplicationPubSubRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{4}
+}
+func (m *GetApplicationPubSubRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetApplicationPubSubRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ServerURL != that1.ServerURL {
-		return false
+}
+func (m *GetApplicationPubSubRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationPubSubRequest.Merge(m, src)
+}
+func (m *GetApplicationPubSubRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetApplicationPubSubRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationPubSubRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationPubSubRequest proto.InternalMessageInfo
+
+func (m *GetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.ClientID != that1.ClientID {
-		return false
+	return types.FieldMask{}
+}
+
+type ListApplicationPubSubsRequest struct {
+	ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3,embedded=application_ids" json:"application_ids"`
+	FieldMask              types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
+	XXX_sizecache          int32           `json:"-"`
+}
+
+func (m *ListApplicationPubSubsRequest) Reset()      { *m = ListApplicationPubSubsRequest{} }
+func (*ListApplicationPubSubsRequest) ProtoMessage() {}
+func (*ListApplicationPubSubsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{5}
+}
+func (m *ListApplicationPubSubsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListApplicationPubSubsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListApplicationPubSubsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Username != that1.Username {
-		return false
+}
+func (m *ListApplicationPubSubsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationPubSubsRequest.Merge(m, src)
+}
+func (m *ListApplicationPubSubsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListApplicationPubSubsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationPubSubsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationPubSubsRequest proto.InternalMessageInfo
+
+func (m *ListApplicationPubSubsRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.Password != that1.Password {
-		return false
+	return types.FieldMask{}
+}
+
+type SetApplicationPubSubRequest struct {
+	ApplicationPubSub    `protobuf:"bytes,1,opt,name=pubsub,proto3,embedded=pubsub" json:"pubsub"`
+	FieldMask            types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetApplicationPubSubRequest) Reset()      { *m = SetApplicationPubSubRequest{} }
+func (*SetApplicationPubSubRequest) ProtoMessage() {}
+func (*SetApplicationPubSubRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{6}
+}
+func (m *SetApplicationPubSubRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetApplicationPubSubRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetApplicationPubSubRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.SubscribeQoS != that1.SubscribeQoS {
-		return false
+}
+func (m *SetApplicationPubSubRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetApplicationPubSubRequest.Merge(m, src)
+}
+func (m *SetApplicationPubSubRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetApplicationPubSubRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetApplicationPubSubRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetApplicationPubSubRequest proto.InternalMessageInfo
+
+func (m *SetApplicationPubSubRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
 	}
-	if this.PublishQoS != that1.PublishQoS {
-		return false
+	return types.FieldMask{}
+}
+
+// The health status of a pub/sub integration, as observed by the Application Server.
+type ApplicationPubSubStatus struct {
+	IDs            ApplicationPubSubIdentifiers  `protobuf:"bytes,1,opt,name=ids,proto3" json:"ids"`
+	State          ApplicationPubSubStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=ttn.lorawan.v3.ApplicationPubSubStatus_State" json:"state,omitempty"`
+	StateChangedAt *time.Time                    `protobuf:"bytes,3,opt,name=state_changed_at,json=stateChangedAt,proto3,stdtime" json:"state_changed_at,omitempty"`
+	// The last error of the integration.
+	LastError   *ErrorDetails `protobuf:"bytes,4,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorAt *time.Time    `protobuf:"bytes,5,opt,name=last_error_at,json=lastErrorAt,proto3,stdtime" json:"last_error_at,omitempty"`
+	// Time of the last successfully published upstream message.
+	LastPublishedAt      *time.Time `protobuf:"bytes,6,opt,name=last_published_at,json=lastPublishedAt,proto3,stdtime" json:"last_published_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ApplicationPubSubStatus) Reset()      { *m = ApplicationPubSubStatus{} }
+func (*ApplicationPubSubStatus) ProtoMessage() {}
+func (*ApplicationPubSubStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dce56ec18597200, []int{7}
+}
+func (m *ApplicationPubSubStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationPubSubStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationPubSubStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.UseTLS != that1.UseTLS {
-		return false
+}
+func (m *ApplicationPubSubStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationPubSubStatus.Merge(m, src)
+}
+func (m *ApplicationPubSubStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationPubSubStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationPubSubStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationPubSubStatus proto.InternalMessageInfo
+
+func (m *ApplicationPubSubStatus) GetIDs() ApplicationPubSubIdentifiers {
+	if m != nil {
+		return m.IDs
 	}
-	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
-		return false
+	return ApplicationPubSubIdentifiers{}
+}
+
+func (m *ApplicationPubSubStatus) GetState() ApplicationPubSubStatus_State {
+	if m != nil {
+		return m.State
 	}
-	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
-		return false
+	return ApplicationPubSubStatus_STOPPED
+}
+
+func (m *ApplicationPubSubStatus) GetStateChangedAt() *time.Time {
+	if m != nil {
+		return m.StateChangedAt
 	}
-	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
-		return false
+	return nil
+}
+
+func (m *ApplicationPubSubStatus) GetLastError() *ErrorDetails {
+	if m != nil {
+		return m.LastError
 	}
-	return true
+	return nil
 }
-func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ApplicationPubSubStatus) GetLastErrorAt() *time.Time {
+	if m != nil {
+		return m.LastErrorAt
 	}
+	return nil
+}
 
-	that1, ok := that.(*ApplicationPubSub_Message)
-	if !ok {
-		that2, ok := that.(ApplicationPubSub_Message)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *ApplicationPubSubStatus) GetLastPublishedAt() *time.Time {
+	if m != nil {
+		return m.LastPublishedAt
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_QoS", ApplicationPubSub_MQTTProvider_QoS_name, ApplicationPubSub_MQTTProvider_QoS_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_ProtocolVersion", ApplicationPubSub_MQTTProvider_ProtocolVersion_name, ApplicationPubSub_MQTTProvider_ProtocolVersion_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_MQTTProvider_ProtocolVersion", ApplicationPubSub_MQTTProvider_ProtocolVersion_name, ApplicationPubSub_MQTTProvider_ProtocolVersion_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASLMechanism", ApplicationPubSub_KafkaProvider_SASLMechanism_name, ApplicationPubSub_KafkaProvider_SASLMechanism_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_KafkaProvider_SASLMechanism", ApplicationPubSub_KafkaProvider_SASLMechanism_name, ApplicationPubSub_KafkaProvider_SASLMechanism_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_AWSIoTProvider_AuthenticationMethod", ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_name, ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_AWSIoTProvider_AuthenticationMethod", ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_name, ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_AzureProvider_AuthenticationMethod", ApplicationPubSub_AzureProvider_AuthenticationMethod_name, ApplicationPubSub_AzureProvider_AuthenticationMethod_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSub_AzureProvider_AuthenticationMethod", ApplicationPubSub_AzureProvider_AuthenticationMethod_name, ApplicationPubSub_AzureProvider_AuthenticationMethod_value)
+	proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSubStatus_State", ApplicationPubSubStatus_State_name, ApplicationPubSubStatus_State_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.ApplicationPubSubStatus_State", ApplicationPubSubStatus_State_name, ApplicationPubSubStatus_State_value)
+	proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
+	golang_proto.RegisterType((*ApplicationPubSubIdentifiers)(nil), "ttn.lorawan.v3.ApplicationPubSubIdentifiers")
+	proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
+	golang_proto.RegisterType((*ApplicationPubSub)(nil), "ttn.lorawan.v3.ApplicationPubSub")
+	proto.RegisterType((*ApplicationPubSub_NATSProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.NATSProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_NATSProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.NATSProvider")
+	proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_MQTTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.MQTTProvider")
+	proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_KafkaProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.KafkaProvider")
+	proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AMQPProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AMQPProvider")
+	proto.RegisterType((*ApplicationPubSub_AWSIoTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AWSIoTProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AWSIoTProvider")
+	proto.RegisterType((*ApplicationPubSub_AzureProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AzureProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_AzureProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.AzureProvider")
+	proto.RegisterType((*ApplicationPubSub_RedisProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.RedisProvider")
+	golang_proto.RegisterType((*ApplicationPubSub_RedisProvider)(nil), "ttn.lorawan.v3.ApplicationPubSub.RedisProvider")
+	proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
+	golang_proto.RegisterType((*ApplicationPubSub_Message)(nil), "ttn.lorawan.v3.ApplicationPubSub.Message")
+	proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
+	golang_proto.RegisterType((*ApplicationPubSubs)(nil), "ttn.lorawan.v3.ApplicationPubSubs")
+	proto.RegisterType((*ApplicationPubSubFormats)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats")
+	golang_proto.RegisterType((*ApplicationPubSubFormats)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats")
+	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationPubSubFormats.FormatsEntry")
+	proto.RegisterType((*GetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.GetApplicationPubSubRequest")
+	golang_proto.RegisterType((*GetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.GetApplicationPubSubRequest")
+	proto.RegisterType((*ListApplicationPubSubsRequest)(nil), "ttn.lorawan.v3.ListApplicationPubSubsRequest")
+	golang_proto.RegisterType((*ListApplicationPubSubsRequest)(nil), "ttn.lorawan.v3.ListApplicationPubSubsRequest")
+	proto.RegisterType((*SetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.SetApplicationPubSubRequest")
+	golang_proto.RegisterType((*SetApplicationPubSubRequest)(nil), "ttn.lorawan.v3.SetApplicationPubSubRequest")
+	proto.RegisterType((*ApplicationPubSubStatus)(nil), "ttn.lorawan.v3.ApplicationPubSubStatus")
+	golang_proto.RegisterType((*ApplicationPubSubStatus)(nil), "ttn.lorawan.v3.ApplicationPubSubStatus")
+}
+
+func init() {
+	proto.RegisterFile("lorawan-stack/api/applicationserver_pubsub.proto", fileDescriptor_1dce56ec18597200)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/applicationserver_pubsub.proto", fileDescriptor_1dce56ec18597200)
+}
+
+var fileDescriptor_1dce56ec18597200 = []byte{
+	// 2991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xe7, 0x8a, 0x22, 0x45, 0x3e, 0x91, 0x14, 0x35, 0x96, 0x3f, 0xd3, 0xb4, 0xbd, 0xd2, 0x47,
+	0xfb, 0x4b, 0x14, 0x27, 0xa2, 0x6c, 0x39, 0x36, 0x62, 0x3b, 0x5f, 0x6c, 0x52, 0xa4, 0x65, 0x59,
+	0x12, 0x45, 0xed, 0xd2, 0x76, 0xfe, 0x20, 0x5d, 0x2c, 0xc9, 0x11, 0xb5, 0x11, 0xb9, 0xbb, 0xde,
+	0x99, 0x95, 0xad, 0x04, 0x41, 0x83, 0xb4, 0x87, 0xa0, 0x87, 0xc2, 0x48, 0x51, 0x24, 0xb7, 0x16,
+	0x0d, 0x8a, 0x06, 0xe8, 0xa1, 0xe9, 0x2d, 0x97, 0x02, 0x41, 0x7b, 0xc9, 0x31, 0x40, 0x83, 0x22,
+	0x27, 0x35, 0xa6, 0x7a, 0xc8, 0xad, 0x41, 0x81, 0x02, 0x81, 0x2f, 0x29, 0x66, 0x76, 0x97, 0x7f,
+	0x44, 0xd9, 0xa2, 0x94, 0xb4, 0x40, 0x4f, 0xdc, 0x99, 0xf7, 0xde, 0x6f, 0x7e, 0xf3, 0xe6, 0xed,
+	0x9b, 0x37, 0xb3, 0x84, 0x33, 0x75, 0xc3, 0x52, 0xef, 0xaa, 0xfa, 0x14, 0xa1, 0x6a, 0x65, 0x7d,
+	0x5a, 0x35, 0xb5, 0x69, 0xd5, 0x34, 0xeb, 0x5a, 0x45, 0xa5, 0x9a, 0xa1, 0x13, 0x6c, 0x6d, 0x60,
+	0x4b, 0x31, 0xed, 0x32, 0xb1, 0xcb, 0x69, 0xd3, 0x32, 0xa8, 0x81, 0x62, 0x94, 0xea, 0x69, 0xd7,
+	0x2a, 0xbd, 0x71, 0x2e, 0x99, 0xa9, 0x69, 0x74, 0xcd, 0x2e, 0xa7, 0x2b, 0x46, 0x63, 0x1a, 0xeb,
+	0x1b, 0xc6, 0xa6, 0x69, 0x19, 0xf7, 0x36, 0xa7, 0xb9, 0x72, 0x65, 0xaa, 0x86, 0xf5, 0xa9, 0x0d,
+	0xb5, 0xae, 0x55, 0x55, 0x8a, 0xa7, 0x7b, 0x1e, 0x1c, 0xc8, 0xe4, 0x54, 0x07, 0x44, 0xcd, 0xa8,
+	0x19, 0x8e, 0x71, 0xd9, 0x5e, 0xe5, 0x2d, 0xde, 0xe0, 0x4f, 0xae, 0xfa, 0xf1, 0x9a, 0x61, 0xd4,
+	0xea, 0xd8, 0x21, 0xab, 0xeb, 0x06, 0x75, 0xb8, 0xba, 0x52, 0xd1, 0x95, 0xb6, 0x30, 0xaa, 0xb6,
+	0xc5, 0x15, 0x5c, 0xf9, 0xb1, 0x9d, 0x72, 0xdc, 0x30, 0xe9, 0xa6, 0x2b, 0x9c, 0xd8, 0x29, 0x5c,
+	0xd5, 0x70, 0xbd, 0xaa, 0x34, 0x54, 0xb2, 0xee, 0x6a, 0x8c, 0xef, 0xd4, 0xa0, 0x5a, 0x03, 0x13,
+	0xaa, 0x36, 0x4c, 0x57, 0xe1, 0x44, 0xaf, 0x47, 0xb1, 0x65, 0x19, 0x96, 0x2b, 0x3e, 0xd9, 0x2b,
+	0xd6, 0xaa, 0x58, 0xa7, 0xda, 0xaa, 0x86, 0x2d, 0x6f, 0x0e, 0x13, 0xbd, 0x4a, 0x0d, 0x4c, 0x88,
+	0x5a, 0xc3, 0xae, 0x46, 0xea, 0x73, 0x01, 0x8e, 0x67, 0xda, 0x0b, 0x55, 0xb4, 0xcb, 0xb2, 0x5d,
+	0x9e, 0x6f, 0x03, 0x21, 0x15, 0x46, 0x3a, 0x16, 0x52, 0xd1, 0xaa, 0x24, 0x21, 0x4c, 0x08, 0x93,
+	0xc3, 0x33, 0x4f, 0xa4, 0xbb, 0x17, 0x30, 0xdd, 0x01, 0xd3, 0x01, 0x90, 0x8d, 0x3f, 0xcc, 0x06,
+	0x7e, 0x22, 0x0c, 0xc4, 0x85, 0x4f, 0xb7, 0xc6, 0x7d, 0x9f, 0x6d, 0x8d, 0x0b, 0x52, 0x4c, 0xed,
+	0xd4, 0x24, 0x68, 0x05, 0xc0, 0xb4, 0xcb, 0x0a, 0xb1, 0xcb, 0x8a, 0x56, 0x4d, 0x0c, 0x4c, 0x08,
+	0x93, 0xe1, 0xec, 0xb9, 0x87, 0xd9, 0x53, 0x56, 0x2a, 0x71, 0x6a, 0x46, 0xfc, 0xc1, 0x2b, 0xea,
+	0xd4, 0xeb, 0x67, 0xa6, 0x2e, 0xbe, 0x3a, 0x79, 0xe5, 0xd2, 0x2b, 0x53, 0xaf, 0x5e, 0xf1, 0x9a,
+	0x4f, 0xbd, 0x31, 0xf3, 0xcc, 0x9b, 0xa7, 0x9a, 0x5b, 0xe3, 0x21, 0x97, 0x74, 0x4e, 0x0a, 0x99,
+	0x2e, 0xfd, 0xd4, 0xef, 0x4e, 0xc3, 0x68, 0xcf, 0xb4, 0x50, 0x11, 0xfc, 0x6d, 0xfe, 0xcf, 0x3c,
+	0x86, 0x7f, 0x8f, 0x1b, 0x76, 0x99, 0x05, 0x83, 0x42, 0xb3, 0x00, 0x15, 0x0b, 0xab, 0x14, 0x57,
+	0x15, 0x95, 0x72, 0xea, 0xc3, 0x33, 0xc9, 0xb4, 0xb3, 0xb4, 0x69, 0x6f, 0x69, 0xd3, 0x25, 0x6f,
+	0x69, 0xb3, 0x21, 0x66, 0x7e, 0xff, 0xaf, 0xe3, 0x82, 0x14, 0x76, 0xed, 0x32, 0x94, 0x81, 0xd8,
+	0x66, 0xd5, 0x03, 0xf1, 0xef, 0x07, 0xc4, 0xb5, 0xcb, 0x50, 0x74, 0x05, 0x82, 0xab, 0x86, 0xd5,
+	0x50, 0x69, 0x62, 0x90, 0x3b, 0xf0, 0x49, 0xc7, 0x81, 0x63, 0x7b, 0x39, 0x50, 0x72, 0xcd, 0x50,
+	0x01, 0x06, 0x75, 0x95, 0x92, 0xc4, 0x28, 0x1f, 0x3f, 0xbd, 0xa7, 0x77, 0xd2, 0x85, 0x4c, 0x49,
+	0x2e, 0x5a, 0xc6, 0x86, 0x56, 0xc5, 0x56, 0x36, 0xd4, 0xdc, 0x1a, 0x1f, 0x64, 0x3d, 0xd7, 0x7d,
+	0x12, 0xc7, 0x61, 0x78, 0x8d, 0x3b, 0x94, 0x26, 0x8e, 0xf6, 0x8b, 0xb7, 0xb4, 0x52, 0x2a, 0x75,
+	0xe3, 0xb1, 0x1e, 0x86, 0xc7, 0x70, 0x90, 0x04, 0x81, 0x75, 0x75, 0x75, 0x5d, 0x4d, 0x24, 0x39,
+	0xe0, 0xf4, 0xde, 0x80, 0x0b, 0x4c, 0xbd, 0x85, 0x18, 0x6e, 0x6e, 0x8d, 0x07, 0x78, 0xd7, 0x75,
+	0x9f, 0xe4, 0x40, 0x31, 0x8e, 0x6a, 0xe3, 0x8e, 0x99, 0x38, 0xd6, 0x2f, 0xc7, 0xcc, 0xd2, 0x4a,
+	0xb1, 0x9b, 0x23, 0xeb, 0x61, 0x1c, 0x19, 0x0e, 0xba, 0x0d, 0x43, 0xea, 0x5d, 0xa2, 0x68, 0x06,
+	0x4d, 0x1c, 0xe7, 0x90, 0x67, 0xfa, 0x80, 0xbc, 0x2d, 0xcf, 0x1b, 0xed, 0x89, 0x43, 0x73, 0x6b,
+	0x3c, 0xe8, 0xf4, 0x5d, 0xf7, 0x49, 0x41, 0xf5, 0x2e, 0x99, 0x37, 0x28, 0x9a, 0x83, 0x80, 0xfa,
+	0xba, 0x6d, 0xe1, 0xc4, 0x89, 0x7e, 0x27, 0x9f, 0x61, 0xea, 0x1e, 0x2a, 0x9b, 0x31, 0xb7, 0x67,
+	0x40, 0x16, 0xae, 0x6a, 0x24, 0x21, 0xf6, 0x0b, 0x24, 0x31, 0xf5, 0x4e, 0x20, 0x6e, 0x8f, 0x9e,
+	0x00, 0x28, 0xab, 0x04, 0x2b, 0xd4, 0x30, 0xb5, 0x4a, 0x22, 0xc8, 0x63, 0x6e, 0xe8, 0x61, 0x76,
+	0xd0, 0x1a, 0x48, 0x54, 0xa5, 0x30, 0x13, 0x95, 0x98, 0x04, 0x15, 0x20, 0x5a, 0x35, 0xee, 0xea,
+	0x75, 0x4d, 0x5f, 0x57, 0x4c, 0x9b, 0xac, 0x25, 0x86, 0xf8, 0xc0, 0x4f, 0xf5, 0x11, 0x0f, 0x4e,
+	0xa6, 0x92, 0x22, 0x9e, 0x7d, 0xd1, 0x26, 0x6b, 0xa8, 0x04, 0xf1, 0x16, 0x9e, 0x85, 0xcd, 0xba,
+	0x5a, 0xc1, 0x89, 0xd0, 0x7e, 0x21, 0x47, 0x3c, 0x08, 0xc9, 0x41, 0x40, 0x45, 0x88, 0xd9, 0x26,
+	0xc7, 0x74, 0xf3, 0x63, 0x22, 0xbc, 0x5f, 0xcc, 0xa8, 0x03, 0xe0, 0x36, 0xd1, 0x0d, 0x18, 0x7e,
+	0xcd, 0xd0, 0x74, 0x45, 0xad, 0x54, 0xb0, 0x49, 0x13, 0xb0, 0x5f, 0x38, 0x60, 0xd6, 0x19, 0x6e,
+	0x8c, 0x16, 0xa1, 0xe5, 0x03, 0x45, 0xad, 0xac, 0x27, 0x86, 0xf7, 0x0b, 0x36, 0xec, 0x99, 0x67,
+	0x2a, 0xeb, 0x5d, 0x2b, 0xa2, 0x33, 0xb8, 0xc8, 0x81, 0x57, 0xa4, 0xa0, 0xee, 0xc0, 0x23, 0x58,
+	0xa7, 0x89, 0xe8, 0x81, 0xf1, 0x64, 0xac, 0xb3, 0x17, 0xbd, 0xb5, 0x3c, 0xca, 0xaa, 0xaa, 0xd5,
+	0x71, 0x35, 0x11, 0xdb, 0x2f, 0x62, 0xcc, 0x43, 0xb8, 0xc6, 0x01, 0xba, 0x30, 0xef, 0xd8, 0xd8,
+	0xc6, 0xd5, 0xc4, 0xc8, 0x81, 0x31, 0x57, 0x38, 0x00, 0xc3, 0xac, 0x1b, 0xee, 0xb6, 0x48, 0x8c,
+	0xfa, 0x06, 0xae, 0x26, 0xe2, 0xfb, 0xc6, 0xf4, 0x10, 0x64, 0x0e, 0x80, 0xce, 0xc1, 0xd0, 0xaa,
+	0x62, 0x1a, 0x16, 0x25, 0x89, 0xf1, 0x09, 0xff, 0x64, 0x34, 0x9b, 0x7c, 0x98, 0x8d, 0xbe, 0x2b,
+	0x40, 0xfc, 0x5b, 0x21, 0x15, 0x38, 0xed, 0x4f, 0x7c, 0x2b, 0xb0, 0xf4, 0x70, 0xad, 0xc8, 0x34,
+	0xa4, 0xe0, 0x2a, 0xff, 0x45, 0x57, 0x21, 0x6c, 0x9b, 0xca, 0xaa, 0x56, 0xa7, 0xd8, 0x4a, 0x4c,
+	0x70, 0x0a, 0x27, 0x1f, 0x43, 0xe1, 0xa6, 0x79, 0x8d, 0xab, 0x4a, 0x21, 0xdb, 0x7d, 0x4a, 0xfe,
+	0x51, 0x80, 0x48, 0x67, 0x3a, 0x47, 0xcf, 0x02, 0xb8, 0x35, 0x9b, 0x6d, 0xd5, 0xf9, 0x86, 0x19,
+	0xce, 0x1e, 0x7e, 0x98, 0x0d, 0x58, 0xfe, 0x77, 0x04, 0x46, 0x21, 0x2c, 0x73, 0xe9, 0x4d, 0x69,
+	0x51, 0x0a, 0x3b, 0x8a, 0x37, 0xad, 0x3a, 0x7a, 0x1a, 0xc2, 0xaf, 0x61, 0x4a, 0xa8, 0x85, 0xd5,
+	0x06, 0xdf, 0x0c, 0x43, 0xd9, 0x28, 0x53, 0xbe, 0x81, 0xa9, 0xcc, 0x3b, 0xa5, 0xb6, 0x1c, 0x8d,
+	0x43, 0xd0, 0xd5, 0xf4, 0x77, 0x27, 0x0f, 0xb7, 0x1b, 0xa5, 0x21, 0xc6, 0x4a, 0xae, 0x72, 0x1d,
+	0x2b, 0xa6, 0x85, 0x57, 0xb5, 0x7b, 0xee, 0xce, 0xd6, 0x52, 0x8c, 0xba, 0xe2, 0x22, 0x97, 0x26,
+	0xff, 0x32, 0x04, 0x91, 0xce, 0x3d, 0xe4, 0x80, 0x93, 0x38, 0x03, 0xe1, 0x4a, 0x5d, 0xc3, 0x3a,
+	0x6d, 0x17, 0x23, 0x87, 0x9c, 0x11, 0x8f, 0xb0, 0x62, 0x63, 0x96, 0xcb, 0x58, 0xb1, 0xe1, 0x68,
+	0xcd, 0x57, 0xd1, 0x49, 0x08, 0xd9, 0x04, 0x5b, 0xba, 0xda, 0xc0, 0x3b, 0xe7, 0xd2, 0x12, 0x30,
+	0x25, 0x53, 0x25, 0xe4, 0xae, 0x61, 0x55, 0x77, 0xce, 0xa3, 0x25, 0x40, 0x1a, 0x44, 0x89, 0x5d,
+	0x26, 0x15, 0x4b, 0x2b, 0x63, 0xe5, 0x8e, 0x41, 0x12, 0x81, 0x09, 0x61, 0x32, 0x36, 0x33, 0xb3,
+	0xbf, 0xcd, 0x33, 0xbd, 0x62, 0xc8, 0xd9, 0x78, 0x73, 0x6b, 0x3c, 0x22, 0x7b, 0x60, 0x2b, 0x86,
+	0x2c, 0x45, 0x48, 0xbb, 0x45, 0x50, 0x05, 0x86, 0x4d, 0xbb, 0x5c, 0xd7, 0xc8, 0x1a, 0x1f, 0x28,
+	0x78, 0xe0, 0x81, 0x62, 0xcd, 0xad, 0x71, 0x28, 0x3a, 0x50, 0x6c, 0x18, 0x30, 0xbd, 0x67, 0x82,
+	0x4e, 0xc2, 0x90, 0xcd, 0xf6, 0x88, 0x3a, 0xe1, 0x69, 0x3f, 0xe4, 0xec, 0x6e, 0x37, 0x09, 0x2e,
+	0x2d, 0xca, 0x52, 0xd0, 0x26, 0xb8, 0x54, 0x27, 0x68, 0x02, 0x82, 0xb4, 0x4e, 0x94, 0x8a, 0xca,
+	0xf3, 0x78, 0xc4, 0xd9, 0xa8, 0x4b, 0x8b, 0xf2, 0x6c, 0x46, 0x0a, 0xd0, 0x3a, 0x99, 0x55, 0xd1,
+	0x45, 0x18, 0xe1, 0x1a, 0xce, 0xb2, 0x54, 0xb0, 0x45, 0x79, 0x7a, 0x8e, 0x64, 0x47, 0x9b, 0x5b,
+	0xe3, 0x51, 0xa6, 0xca, 0x25, 0xb3, 0xd8, 0xa2, 0x52, 0x94, 0x99, 0xb4, 0x9a, 0xe8, 0x02, 0xc4,
+	0x3a, 0x4c, 0xd7, 0xf1, 0x26, 0xcf, 0xc4, 0x11, 0xc7, 0x3d, 0x2d, 0xcb, 0x05, 0xbc, 0x29, 0x45,
+	0x5a, 0x86, 0x0b, 0x78, 0x13, 0x11, 0x88, 0x3b, 0x27, 0x0f, 0xa3, 0xae, 0x6c, 0x60, 0x8b, 0x68,
+	0x86, 0xce, 0xd3, 0x6e, 0x6c, 0xe6, 0x85, 0x7d, 0xfa, 0xa8, 0xe8, 0xc2, 0xdc, 0x72, 0x50, 0xb2,
+	0xa1, 0x87, 0xd9, 0xc0, 0xdb, 0xac, 0x92, 0x94, 0x46, 0xcc, 0x6e, 0x11, 0xba, 0x0d, 0x47, 0x08,
+	0x26, 0xec, 0x51, 0xc1, 0xf7, 0x4c, 0xcd, 0xda, 0x54, 0x34, 0x9d, 0x62, 0x6b, 0x43, 0xad, 0xbb,
+	0x39, 0xfa, 0x68, 0x4f, 0x55, 0x98, 0x73, 0x0f, 0x25, 0xd9, 0xc1, 0xf7, 0x59, 0x41, 0x78, 0xd8,
+	0xb5, 0xcf, 0x73, 0xf3, 0x79, 0xd7, 0x9a, 0x01, 0xbb, 0xfb, 0x5a, 0x0f, 0x70, 0xb4, 0x4f, 0x60,
+	0xd7, 0xbe, 0x1b, 0x38, 0xf5, 0x3c, 0xf8, 0x57, 0x0c, 0x19, 0xc5, 0x21, 0x92, 0x29, 0x29, 0x4b,
+	0xcb, 0x72, 0x49, 0x59, 0x2e, 0xcc, 0xe6, 0xe3, 0x3e, 0x34, 0x0a, 0xd1, 0x4c, 0x49, 0x59, 0xcc,
+	0x67, 0xbc, 0x2e, 0x81, 0x29, 0xe5, 0x5f, 0xcc, 0xcc, 0x96, 0x16, 0x5f, 0x72, 0x7a, 0x06, 0x52,
+	0xff, 0x07, 0x23, 0x3b, 0xbc, 0x83, 0x00, 0x82, 0xb7, 0xce, 0x29, 0x67, 0x95, 0xb3, 0x71, 0x1f,
+	0x0a, 0xc2, 0xc0, 0xad, 0xf3, 0x71, 0x21, 0xf9, 0x87, 0x41, 0x88, 0x76, 0xd5, 0x72, 0xe8, 0x29,
+	0x18, 0x2a, 0x5b, 0xc6, 0x3a, 0xb6, 0x58, 0x31, 0xef, 0x9f, 0x0c, 0x67, 0x47, 0x1e, 0x66, 0x23,
+	0xef, 0x0a, 0xe1, 0x90, 0x90, 0x0a, 0x58, 0xfe, 0xc4, 0x5b, 0x03, 0x92, 0x27, 0xef, 0x0c, 0xc1,
+	0x81, 0x3e, 0x42, 0xd0, 0xdf, 0x7f, 0x08, 0x0e, 0x1e, 0x38, 0x04, 0x03, 0x7d, 0x85, 0xe0, 0x0f,
+	0x21, 0x46, 0x54, 0x52, 0x57, 0x1a, 0xb8, 0xb2, 0xa6, 0xea, 0x1a, 0x69, 0xb8, 0x2f, 0xe9, 0xff,
+	0xef, 0xb3, 0xf2, 0x4d, 0xcb, 0x19, 0x79, 0x71, 0xc9, 0x03, 0xc9, 0x1e, 0xf5, 0xe2, 0x8f, 0x11,
+	0xef, 0x12, 0x49, 0x51, 0x36, 0x5e, 0xab, 0x89, 0x9e, 0x07, 0xde, 0xa1, 0xb4, 0x92, 0xdb, 0x10,
+	0xcf, 0x5b, 0x47, 0xdc, 0xbc, 0xc5, 0x13, 0x4c, 0x46, 0x5e, 0xbc, 0xe9, 0x8a, 0xa5, 0x08, 0xd3,
+	0xf6, 0x5a, 0x2d, 0xeb, 0x56, 0xd6, 0x0b, 0xed, 0x6a, 0x5d, 0x74, 0xc5, 0x8e, 0xb5, 0xd7, 0x4a,
+	0xdd, 0x80, 0x6e, 0x6e, 0x28, 0x04, 0x83, 0x85, 0xe5, 0x02, 0x0b, 0xad, 0x30, 0x04, 0x8a, 0x8b,
+	0x99, 0xf9, 0x42, 0x5c, 0x60, 0x51, 0x26, 0xcf, 0x4a, 0x99, 0x25, 0x45, 0xbe, 0x9e, 0x51, 0x66,
+	0xce, 0x5f, 0x88, 0x0f, 0x74, 0x77, 0x9d, 0x3f, 0x3b, 0x13, 0xf7, 0x27, 0x3f, 0x18, 0x80, 0x48,
+	0x67, 0xe1, 0x7e, 0xc0, 0x8d, 0xe1, 0xbf, 0x37, 0x92, 0x4e, 0x42, 0x08, 0xdf, 0x63, 0x8e, 0xac,
+	0xe1, 0x9d, 0x95, 0x7a, 0x4b, 0x90, 0xfc, 0xe7, 0x20, 0xc4, 0xba, 0xcf, 0x22, 0xe8, 0x14, 0x84,
+	0xb0, 0x5e, 0x35, 0x0d, 0x4d, 0xa7, 0xae, 0x97, 0x42, 0xdc, 0x4b, 0xec, 0x05, 0x6b, 0x49, 0xd8,
+	0x46, 0x6e, 0xe1, 0x1a, 0x4b, 0x90, 0x03, 0x9d, 0xd8, 0x13, 0x92, 0xdb, 0x8d, 0x9e, 0x04, 0xa0,
+	0x6b, 0x9a, 0x5e, 0x53, 0x3a, 0x76, 0x48, 0x0f, 0x48, 0x90, 0xc2, 0x5c, 0x56, 0x60, 0x21, 0xf3,
+	0x63, 0x01, 0x0e, 0xab, 0x36, 0x5d, 0x63, 0xc7, 0x6e, 0xb7, 0xb0, 0x6a, 0x60, 0xba, 0x66, 0x38,
+	0x3b, 0x66, 0x6c, 0x26, 0xbf, 0xdf, 0xd3, 0x54, 0x3a, 0xd3, 0x85, 0xb6, 0xc4, 0xc1, 0x3a, 0x32,
+	0xf0, 0x98, 0xba, 0x8b, 0xbc, 0x63, 0x0d, 0x03, 0xfd, 0xaf, 0x61, 0xf0, 0xc0, 0x6b, 0x38, 0xd4,
+	0xd7, 0x1a, 0x5e, 0x86, 0x28, 0x3b, 0x4a, 0x10, 0xc2, 0x6c, 0x58, 0x69, 0xd2, 0x7a, 0x9d, 0x1c,
+	0x3f, 0x36, 0xb7, 0xc6, 0x87, 0x33, 0x5c, 0x61, 0x01, 0x6f, 0xce, 0xe7, 0xa4, 0x61, 0xb5, 0xd5,
+	0xa8, 0xa2, 0x67, 0x61, 0x94, 0xe0, 0x8a, 0x85, 0xa9, 0xd2, 0xc6, 0xe0, 0x5b, 0x68, 0xe7, 0x42,
+	0x8c, 0x38, 0x2a, 0x2d, 0x10, 0x34, 0x05, 0x51, 0x6f, 0x3b, 0xa2, 0xc6, 0x3a, 0xd6, 0xf9, 0xd6,
+	0xd9, 0xb6, 0x88, 0x4b, 0x11, 0x57, 0x5c, 0x62, 0xd2, 0xd4, 0x79, 0x18, 0xdb, 0xcd, 0xdd, 0xec,
+	0xcd, 0x7d, 0xf1, 0xfc, 0x99, 0x8b, 0x71, 0x1f, 0x3a, 0x04, 0x23, 0xf2, 0xfc, 0xdc, 0xad, 0x67,
+	0x95, 0xdb, 0xf9, 0xac, 0xbc, 0x3c, 0xbb, 0x90, 0x2f, 0xc5, 0x85, 0xe4, 0x3f, 0x06, 0x21, 0xda,
+	0x75, 0x58, 0x45, 0x3f, 0x7a, 0x64, 0x18, 0x08, 0x3c, 0x0c, 0x72, 0xfb, 0x3c, 0xfd, 0x1e, 0x2c,
+	0x0a, 0xe6, 0xe1, 0x38, 0xde, 0x60, 0x6b, 0xb4, 0x66, 0x97, 0x89, 0x52, 0x31, 0x74, 0x1d, 0x57,
+	0x9c, 0x5a, 0x9f, 0x5a, 0x9a, 0x5e, 0x73, 0x83, 0xdd, 0x73, 0x46, 0x48, 0x3a, 0xca, 0xb5, 0xaf,
+	0xdb, 0x65, 0x32, 0xdb, 0xd2, 0x95, 0xb9, 0x2a, 0x5a, 0x80, 0x13, 0x2c, 0x8d, 0x68, 0x15, 0xac,
+	0x94, 0xed, 0xdd, 0xb0, 0xfc, 0x3b, 0xb0, 0x92, 0xae, 0x7a, 0xd6, 0xee, 0x05, 0xbb, 0x08, 0x63,
+	0x1d, 0xbc, 0xd8, 0x2b, 0x45, 0x4c, 0x76, 0x08, 0xee, 0x2a, 0x2a, 0xaf, 0x4a, 0xa8, 0x45, 0xa7,
+	0xe0, 0xa9, 0xa0, 0xcb, 0x70, 0xb8, 0x93, 0x47, 0xdb, 0x36, 0xd0, 0x6d, 0x7b, 0xa8, 0x3d, 0x7c,
+	0xdb, 0xf8, 0x2c, 0x84, 0x29, 0xd6, 0x55, 0xa7, 0x2e, 0x76, 0xb2, 0xc8, 0x58, 0x47, 0xf0, 0x85,
+	0x4a, 0x5c, 0xc8, 0x0a, 0x63, 0x47, 0x6d, 0xbe, 0xca, 0x4c, 0xda, 0xa5, 0xf4, 0x50, 0xaf, 0xc9,
+	0x2e, 0xb5, 0xf4, 0x14, 0x44, 0x5d, 0x13, 0x27, 0x1a, 0xdd, 0x30, 0x6f, 0xe7, 0x9d, 0x88, 0x23,
+	0x96, 0xb9, 0x34, 0x75, 0xe1, 0x11, 0x31, 0x77, 0x18, 0x46, 0x67, 0x97, 0x0b, 0x85, 0xfc, 0x6c,
+	0x69, 0x7e, 0xb9, 0xa0, 0xc8, 0x25, 0x69, 0xbe, 0x30, 0x17, 0xf7, 0xa1, 0x21, 0xf0, 0x67, 0x32,
+	0xb9, 0xb8, 0x90, 0xfc, 0x5c, 0x80, 0x68, 0xd7, 0xc5, 0xc6, 0x01, 0xf7, 0x84, 0xd3, 0x30, 0xea,
+	0x9c, 0x56, 0x94, 0x86, 0x7a, 0x4f, 0xa9, 0x63, 0xbd, 0x46, 0xd7, 0x78, 0x64, 0x44, 0xa5, 0x11,
+	0x47, 0xb0, 0xa4, 0xde, 0x5b, 0xe4, 0xdd, 0xe8, 0x2c, 0x8c, 0xa9, 0xa6, 0x69, 0x19, 0xf7, 0xb4,
+	0x86, 0x4a, 0xb1, 0x42, 0x2d, 0xad, 0xd1, 0xf0, 0x16, 0x3f, 0x24, 0x1d, 0xea, 0x90, 0x95, 0x5c,
+	0x11, 0x3b, 0x02, 0x55, 0x0c, 0x9d, 0xd8, 0x0d, 0x6c, 0x29, 0x35, 0xcb, 0xb0, 0xcd, 0x9e, 0x23,
+	0x90, 0x27, 0x9e, 0x63, 0xd2, 0xe4, 0x24, 0x0c, 0x79, 0xf7, 0x0f, 0x27, 0x20, 0xe0, 0x5c, 0xcd,
+	0x08, 0xdd, 0x16, 0x4e, 0x6f, 0x76, 0x04, 0x42, 0xa6, 0x37, 0x75, 0xff, 0x37, 0x59, 0x21, 0xb5,
+	0x02, 0xa8, 0xe7, 0x35, 0x22, 0xe8, 0x32, 0x0c, 0x39, 0x97, 0xf6, 0x4e, 0xa1, 0x35, 0x3c, 0xf3,
+	0xbf, 0x7b, 0xbe, 0x7b, 0x92, 0x67, 0x91, 0xfa, 0x8d, 0x00, 0x89, 0x1e, 0xf1, 0x35, 0x7e, 0xdb,
+	0x48, 0xd0, 0x32, 0x0c, 0x39, 0x17, 0x8f, 0x1e, 0xf2, 0xf9, 0x3d, 0x91, 0x5d, 0xd3, 0xb4, 0xfb,
+	0x9b, 0xd7, 0xa9, 0xb5, 0x29, 0x79, 0x28, 0xc9, 0x4b, 0x10, 0xe9, 0x14, 0xa0, 0x38, 0xf8, 0x59,
+	0x96, 0xe3, 0xd3, 0x97, 0xd8, 0x23, 0x1a, 0x83, 0xc0, 0x86, 0x5a, 0xb7, 0xb1, 0xf3, 0xea, 0x4a,
+	0x4e, 0xe3, 0xd2, 0xc0, 0x73, 0x42, 0xea, 0x23, 0x01, 0x8e, 0xcd, 0x61, 0xda, 0x3b, 0x17, 0x7c,
+	0xc7, 0xc6, 0x84, 0xfe, 0x1b, 0x2e, 0x8e, 0xaf, 0x00, 0xb4, 0x3f, 0x09, 0x3c, 0xf2, 0xe2, 0xf8,
+	0x1a, 0x53, 0x59, 0x52, 0xc9, 0x7a, 0x76, 0x90, 0x99, 0x4b, 0xe1, 0x55, 0xaf, 0x23, 0xf5, 0x27,
+	0x01, 0x4e, 0x2c, 0x6a, 0xa4, 0x97, 0x33, 0xf1, 0x48, 0xff, 0x07, 0x6e, 0xee, 0xbf, 0xf3, 0x2c,
+	0x7e, 0x2b, 0xc0, 0x31, 0xf9, 0x31, 0x8e, 0x5f, 0x80, 0xa0, 0x13, 0x4d, 0x2e, 0xf5, 0xbd, 0xc3,
+	0x6f, 0x17, 0xd6, 0x2e, 0xc4, 0x77, 0x67, 0xfb, 0xc1, 0x20, 0x1c, 0xe9, 0x19, 0x50, 0xa6, 0x2a,
+	0xb5, 0x09, 0x5a, 0x38, 0x78, 0x88, 0x0c, 0xb3, 0x71, 0x9a, 0x5b, 0xe3, 0xfe, 0xf9, 0x1c, 0xf1,
+	0x3e, 0x2b, 0x04, 0x08, 0x55, 0xa9, 0x13, 0xa9, 0xb1, 0x99, 0xa9, 0x3d, 0xe1, 0x1c, 0x12, 0x69,
+	0xf6, 0x83, 0x25, 0xc7, 0x16, 0xdd, 0x80, 0x38, 0x7f, 0x50, 0x9c, 0x02, 0xaf, 0xcf, 0x8f, 0x0b,
+	0x83, 0xfc, 0xc3, 0x42, 0x8c, 0x5b, 0xce, 0x3a, 0x86, 0x19, 0x8a, 0x2e, 0x03, 0xd4, 0x55, 0x42,
+	0x15, 0xfe, 0x05, 0x8a, 0x27, 0xa1, 0xe1, 0x99, 0xe3, 0x3b, 0x59, 0xe5, 0x99, 0x30, 0x87, 0xa9,
+	0xaa, 0xd5, 0x89, 0x14, 0x66, 0xfa, 0xbc, 0x07, 0xe5, 0x20, 0xda, 0x36, 0x66, 0x2c, 0x02, 0x7d,
+	0xb2, 0x18, 0x6e, 0x61, 0x64, 0x28, 0x5a, 0x84, 0x51, 0x8e, 0xe2, 0x5e, 0x2f, 0x38, 0xf3, 0x09,
+	0xf6, 0x89, 0x34, 0xc2, 0x4c, 0x8b, 0x9e, 0x65, 0x86, 0xa6, 0x5e, 0x82, 0x00, 0x77, 0x16, 0x1a,
+	0x86, 0x21, 0xb9, 0xb4, 0x5c, 0x2c, 0xe6, 0x73, 0x71, 0x1f, 0x8a, 0x01, 0x78, 0xdb, 0x46, 0x61,
+	0x2e, 0x2e, 0xa0, 0x28, 0x84, 0xdd, 0x76, 0x3e, 0x17, 0x1f, 0x40, 0x11, 0x08, 0xe5, 0xf2, 0x73,
+	0x52, 0x26, 0x97, 0xcf, 0xc5, 0xfd, 0xec, 0xa8, 0x7a, 0x2d, 0x33, 0xbf, 0x98, 0xcf, 0xc5, 0x07,
+	0xd9, 0x73, 0x31, 0x73, 0x53, 0xce, 0xe7, 0xe2, 0x81, 0x99, 0x5f, 0x03, 0x1c, 0xdd, 0x25, 0xa0,
+	0x6b, 0x1a, 0x61, 0x69, 0xe9, 0x35, 0x80, 0x39, 0x4c, 0xbd, 0x2c, 0xf8, 0x3f, 0x3d, 0xcc, 0xf3,
+	0x0d, 0x93, 0x6e, 0x26, 0x27, 0xfb, 0x4d, 0x86, 0xa9, 0xe4, 0xdb, 0x7f, 0xfe, 0xdb, 0xcf, 0x06,
+	0xc6, 0x10, 0x9a, 0x56, 0xc9, 0xb4, 0x13, 0xe8, 0x53, 0x6e, 0x4a, 0x44, 0xbf, 0x10, 0xc0, 0x3f,
+	0x87, 0x29, 0x7a, 0x7a, 0x27, 0xda, 0x63, 0x72, 0x5d, 0x72, 0xef, 0x57, 0x2c, 0x75, 0x9d, 0x8f,
+	0x99, 0x45, 0x57, 0xdb, 0x63, 0x4e, 0xbf, 0xa1, 0x55, 0x49, 0x7a, 0x47, 0xbe, 0xd9, 0xd1, 0x7e,
+	0xd3, 0x51, 0x6a, 0x7f, 0xeb, 0x7b, 0x13, 0xfd, 0x54, 0x80, 0x41, 0x96, 0xc5, 0x50, 0x4f, 0x88,
+	0x3f, 0x36, 0xb7, 0x25, 0x53, 0x7b, 0x92, 0x24, 0xa9, 0x73, 0x9c, 0xe5, 0x14, 0x7a, 0xba, 0x93,
+	0xe5, 0x1e, 0x0c, 0xd1, 0xdf, 0x05, 0xf0, 0xcb, 0xbb, 0xb9, 0x4c, 0xfe, 0x6e, 0x2e, 0x7b, 0x4f,
+	0xe0, 0x6c, 0xee, 0x0b, 0xc9, 0x42, 0x27, 0x1d, 0xf7, 0x8b, 0x78, 0x5f, 0xbe, 0xeb, 0xd0, 0xed,
+	0x70, 0xe1, 0x25, 0xe1, 0xf4, 0xcb, 0x97, 0x53, 0x17, 0x0e, 0x06, 0x7a, 0x49, 0x38, 0x8d, 0xee,
+	0x0b, 0x10, 0xcc, 0xe1, 0x3a, 0xa6, 0x18, 0xed, 0x2b, 0x6d, 0x25, 0x1f, 0x11, 0xbb, 0xa9, 0xab,
+	0x7c, 0xa6, 0x97, 0x4e, 0x3f, 0xb7, 0x0f, 0xbf, 0x73, 0xd2, 0xad, 0xa8, 0xf8, 0xbd, 0x00, 0x23,
+	0x73, 0x98, 0x76, 0xe5, 0xd7, 0xfd, 0x71, 0x7b, 0xb2, 0xcf, 0x8c, 0x99, 0x9a, 0xe3, 0x64, 0x33,
+	0xe8, 0xca, 0x41, 0xc9, 0x4e, 0x13, 0x87, 0xdf, 0xcf, 0x05, 0x08, 0x14, 0x55, 0x9b, 0x7c, 0x5f,
+	0x5e, 0xbc, 0xc6, 0x89, 0x5d, 0x4d, 0xbd, 0x70, 0x60, 0x62, 0x26, 0x67, 0xf3, 0x9e, 0x00, 0x41,
+	0x09, 0xb3, 0x1a, 0xf1, 0x7b, 0x22, 0xe6, 0x7a, 0x2c, 0x75, 0x70, 0x8f, 0x59, 0x9c, 0x4e, 0xf6,
+	0x57, 0xc2, 0xa7, 0x0f, 0x44, 0xe1, 0xb3, 0x07, 0xa2, 0xf0, 0xc5, 0x03, 0xd1, 0xf7, 0xe5, 0x03,
+	0xd1, 0xf7, 0xd5, 0x03, 0xd1, 0xf7, 0xf5, 0x03, 0xd1, 0xf7, 0xcd, 0x03, 0x51, 0x78, 0xab, 0x29,
+	0x0a, 0xef, 0x34, 0x45, 0xdf, 0x87, 0x4d, 0x51, 0xf8, 0xa8, 0x29, 0xfa, 0x3e, 0x6e, 0x8a, 0xbe,
+	0x4f, 0x9a, 0xa2, 0xef, 0xd3, 0xa6, 0x28, 0x7c, 0xd6, 0x14, 0x85, 0x2f, 0x9a, 0xa2, 0xef, 0xcb,
+	0xa6, 0x28, 0x7c, 0xd5, 0x14, 0x7d, 0x5f, 0x37, 0x45, 0xe1, 0x9b, 0xa6, 0xe8, 0x7b, 0x6b, 0x5b,
+	0xf4, 0xbd, 0xb3, 0x2d, 0x0a, 0xf7, 0xb7, 0x45, 0xdf, 0xfb, 0xdb, 0xa2, 0xf0, 0xcb, 0x6d, 0xd1,
+	0xf7, 0xe1, 0xb6, 0xe8, 0xfb, 0x68, 0x5b, 0x14, 0x3e, 0xde, 0x16, 0x85, 0x4f, 0xb6, 0x45, 0xe1,
+	0xe5, 0x67, 0x6a, 0x46, 0x9a, 0xae, 0x61, 0x7e, 0xeb, 0x40, 0xd2, 0x3a, 0xa6, 0x77, 0x0d, 0x6b,
+	0x7d, 0xba, 0xfb, 0x8f, 0x12, 0xe6, 0x7a, 0x6d, 0x9a, 0x52, 0xdd, 0x2c, 0x97, 0x83, 0x7c, 0xf6,
+	0xe7, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xb5, 0x71, 0x1a, 0xe0, 0x22, 0x00, 0x00,
+}
+
+func (x ApplicationPubSub_MQTTProvider_QoS) String() string {
+	s, ok := ApplicationPubSub_MQTTProvider_QoS_name[int32(x)]
+	if ok {
+		return s
 	}
-	if this.Topic != that1.Topic {
-		return false
+	return strconv.Itoa(int(x))
+}
+func (x ApplicationPubSub_MQTTProvider_ProtocolVersion) String() string {
+	s, ok := ApplicationPubSub_MQTTProvider_ProtocolVersion_name[int32(x)]
+	if ok {
+		return s
 	}
-	return true
+	return strconv.Itoa(int(x))
 }
-func (this *ApplicationPubSubs) Equal(that interface{}) bool {
+func (x ApplicationPubSub_KafkaProvider_SASLMechanism) String() string {
+	s, ok := ApplicationPubSub_KafkaProvider_SASLMechanism_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ApplicationPubSub_AWSIoTProvider_AuthenticationMethod) String() string {
+	s, ok := ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ApplicationPubSub_AzureProvider_AuthenticationMethod) String() string {
+	s, ok := ApplicationPubSub_AzureProvider_AuthenticationMethod_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x ApplicationPubSubStatus_State) String() string {
+	s, ok := ApplicationPubSubStatus_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ApplicationPubSubIdentifiers) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationPubSubs)
+	that1, ok := that.(*ApplicationPubSubIdentifiers)
 	if !ok {
-		that2, ok := that.(ApplicationPubSubs)
+		that2, ok := that.(ApplicationPubSubIdentifiers)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1176,24 +1943,22 @@ func (this *ApplicationPubSubs) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Pubsubs) != len(that1.Pubsubs) {
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
 		return false
 	}
-	for i := range this.Pubsubs {
-		if !this.Pubsubs[i].Equal(that1.Pubsubs[i]) {
-			return false
-		}
+	if this.PubSubID != that1.PubSubID {
+		return false
 	}
 	return true
 }
-func (this *ApplicationPubSubFormats) Equal(that interface{}) bool {
+func (this *ApplicationPubSub) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ApplicationPubSubFormats)
+	that1, ok := that.(*ApplicationPubSub)
 	if !ok {
-		that2, ok := that.(ApplicationPubSubFormats)
+		that2, ok := that.(ApplicationPubSub)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1205,24 +1970,81 @@ func (this *ApplicationPubSubFormats) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if len(this.Formats) != len(that1.Formats) {
+	if !this.ApplicationPubSubIdentifiers.Equal(&that1.ApplicationPubSubIdentifiers) {
 		return false
 	}
-	for i := range this.Formats {
-		if this.Formats[i] != that1.Formats[i] {
+	if !this.CreatedAt.Equal(that1.CreatedAt) {
+		return false
+	}
+	if !this.UpdatedAt.Equal(that1.UpdatedAt) {
+		return false
+	}
+	if this.Format != that1.Format {
+		return false
+	}
+	if that1.Provider == nil {
+		if this.Provider != nil {
+			return false
+		}
+	} else if this.Provider == nil {
+		return false
+	} else if !this.Provider.Equal(that1.Provider) {
+		return false
+	}
+	if this.BaseTopic != that1.BaseTopic {
+		return false
+	}
+	if !this.DownlinkPush.Equal(that1.DownlinkPush) {
+		return false
+	}
+	if !this.DownlinkReplace.Equal(that1.DownlinkReplace) {
+		return false
+	}
+	if !this.UplinkMessage.Equal(that1.UplinkMessage) {
+		return false
+	}
+	if !this.JoinAccept.Equal(that1.JoinAccept) {
+		return false
+	}
+	if !this.DownlinkAck.Equal(that1.DownlinkAck) {
+		return false
+	}
+	if !this.DownlinkNack.Equal(that1.DownlinkNack) {
+		return false
+	}
+	if !this.DownlinkSent.Equal(that1.DownlinkSent) {
+		return false
+	}
+	if !this.DownlinkFailed.Equal(that1.DownlinkFailed) {
+		return false
+	}
+	if !this.DownlinkQueued.Equal(that1.DownlinkQueued) {
+		return false
+	}
+	if !this.LocationSolved.Equal(that1.LocationSolved) {
+		return false
+	}
+	if len(this.FPorts) != len(that1.FPorts) {
+		return false
+	}
+	for i := range this.FPorts {
+		if this.FPorts[i] != that1.FPorts[i] {
 			return false
 		}
 	}
+	if !this.UpFilter.Equal(that1.UpFilter) {
+		return false
+	}
 	return true
 }
-func (this *GetApplicationPubSubRequest) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_NATS) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*GetApplicationPubSubRequest)
+	that1, ok := that.(*ApplicationPubSub_NATS)
 	if !ok {
-		that2, ok := that.(GetApplicationPubSubRequest)
+		that2, ok := that.(ApplicationPubSub_NATS)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1234,22 +2056,19 @@ func (this *GetApplicationPubSubRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationPubSubIdentifiers.Equal(&that1.ApplicationPubSubIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.NATS.Equal(that1.NATS) {
 		return false
 	}
 	return true
 }
-func (this *ListApplicationPubSubsRequest) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_MQTT) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ListApplicationPubSubsRequest)
+	that1, ok := that.(*ApplicationPubSub_MQTT)
 	if !ok {
-		that2, ok := that.(ListApplicationPubSubsRequest)
+		that2, ok := that.(ApplicationPubSub_MQTT)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1261,22 +2080,19 @@ func (this *ListApplicationPubSubsRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.MQTT.Equal(that1.MQTT) {
 		return false
 	}
 	return true
 }
-func (this *SetApplicationPubSubRequest) Equal(that interface{}) bool {
+func (this *ApplicationPubSub_Kafka) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*SetApplicationPubSubRequest)
+	that1, ok := that.(*ApplicationPubSub_Kafka)
 	if !ok {
-		that2, ok := that.(SetApplicationPubSubRequest)
+		that2, ok := that.(ApplicationPubSub_Kafka)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1288,1597 +2104,5158 @@ func (this *SetApplicationPubSubRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.ApplicationPubSub.Equal(&that1.ApplicationPubSub) {
-		return false
-	}
-	if !this.FieldMask.Equal(&that1.FieldMask) {
+	if !this.Kafka.Equal(that1.Kafka) {
 		return false
 	}
 	return true
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// ApplicationPubSubRegistryClient is the client API for ApplicationPubSubRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ApplicationPubSubRegistryClient interface {
-	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationPubSubFormats, error)
-	Get(ctx context.Context, in *GetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error)
-	List(ctx context.Context, in *ListApplicationPubSubsRequest, opts ...grpc.CallOption) (*ApplicationPubSubs, error)
-	Set(ctx context.Context, in *SetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error)
-	Delete(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
-}
-
-type applicationPubSubRegistryClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewApplicationPubSubRegistryClient(cc *grpc.ClientConn) ApplicationPubSubRegistryClient {
-	return &applicationPubSubRegistryClient{cc}
-}
-
-func (c *applicationPubSubRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationPubSubFormats, error) {
-	out := new(ApplicationPubSubFormats)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetFormats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *ApplicationPubSub_AMQP) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
 
-func (c *applicationPubSubRegistryClient) Get(ctx context.Context, in *GetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error) {
-	out := new(ApplicationPubSub)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ApplicationPubSub_AMQP)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return out, nil
-}
-
-func (c *applicationPubSubRegistryClient) List(ctx context.Context, in *ListApplicationPubSubsRequest, opts ...grpc.CallOption) (*ApplicationPubSubs, error) {
-	out := new(ApplicationPubSubs)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return out, nil
-}
-
-func (c *applicationPubSubRegistryClient) Set(ctx context.Context, in *SetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error) {
-	out := new(ApplicationPubSub)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+	if !this.AMQP.Equal(that1.AMQP) {
+		return false
 	}
-	return out, nil
+	return true
 }
-
-func (c *applicationPubSubRegistryClient) Delete(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (this *ApplicationPubSub_AWSIoT) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return out, nil
-}
-
-// ApplicationPubSubRegistryServer is the server API for ApplicationPubSubRegistry service.
-type ApplicationPubSubRegistryServer interface {
-	GetFormats(context.Context, *types.Empty) (*ApplicationPubSubFormats, error)
-	Get(context.Context, *GetApplicationPubSubRequest) (*ApplicationPubSub, error)
-	List(context.Context, *ListApplicationPubSubsRequest) (*ApplicationPubSubs, error)
-	Set(context.Context, *SetApplicationPubSubRequest) (*ApplicationPubSub, error)
-	Delete(context.Context, *ApplicationPubSubIdentifiers) (*types.Empty, error)
-}
-
-// UnimplementedApplicationPubSubRegistryServer can be embedded to have forward compatible implementations.
-type UnimplementedApplicationPubSubRegistryServer struct {
-}
-
-func (*UnimplementedApplicationPubSubRegistryServer) GetFormats(ctx context.Context, req *types.Empty) (*ApplicationPubSubFormats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFormats not implemented")
-}
-func (*UnimplementedApplicationPubSubRegistryServer) Get(ctx context.Context, req *GetApplicationPubSubRequest) (*ApplicationPubSub, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (*UnimplementedApplicationPubSubRegistryServer) List(ctx context.Context, req *ListApplicationPubSubsRequest) (*ApplicationPubSubs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (*UnimplementedApplicationPubSubRegistryServer) Set(ctx context.Context, req *SetApplicationPubSubRequest) (*ApplicationPubSub, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
-}
-func (*UnimplementedApplicationPubSubRegistryServer) Delete(ctx context.Context, req *ApplicationPubSubIdentifiers) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
-}
-
-func RegisterApplicationPubSubRegistryServer(s *grpc.Server, srv ApplicationPubSubRegistryServer) {
-	s.RegisterService(&_ApplicationPubSubRegistry_serviceDesc, srv)
-}
 
-func _ApplicationPubSubRegistry_GetFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationPubSubRegistryServer).GetFormats(ctx, in)
+	that1, ok := that.(*ApplicationPubSub_AWSIoT)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AWSIoT)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetFormats",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationPubSubRegistryServer).GetFormats(ctx, req.(*types.Empty))
+	if !this.AWSIoT.Equal(that1.AWSIoT) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
-
-func _ApplicationPubSubRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApplicationPubSubRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *ApplicationPubSub_Azure) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(ApplicationPubSubRegistryServer).Get(ctx, in)
+
+	that1, ok := that.(*ApplicationPubSub_Azure)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Azure)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Get",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationPubSubRegistryServer).Get(ctx, req.(*GetApplicationPubSubRequest))
+	if !this.Azure.Equal(that1.Azure) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ApplicationPubSub_Redis) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ApplicationPubSubRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApplicationPubSubsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApplicationPubSubRegistryServer).List(ctx, in)
+	that1, ok := that.(*ApplicationPubSub_Redis)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Redis)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/List",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationPubSubRegistryServer).List(ctx, req.(*ListApplicationPubSubsRequest))
+	if !this.Redis.Equal(that1.Redis) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	return true
 }
+func (this *ApplicationPubSub_NATSProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ApplicationPubSubRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetApplicationPubSubRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ApplicationPubSub_NATSProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_NATSProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ApplicationPubSubRegistryServer).Set(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Set",
+	if this.ServerURL != that1.ServerURL {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationPubSubRegistryServer).Set(ctx, req.(*SetApplicationPubSubRequest))
+	if this.JetStream != that1.JetStream {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
+	if this.Stream != that1.Stream {
+		return false
+	}
+	if this.DurablePrefix != that1.DurablePrefix {
+		return false
+	}
+	return true
 }
+func (this *ApplicationPubSub_MQTTProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func _ApplicationPubSubRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplicationPubSubIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+	that1, ok := that.(*ApplicationPubSub_MQTTProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_MQTTProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if interceptor == nil {
-		return srv.(ApplicationPubSubRegistryServer).Delete(ctx, in)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Delete",
+	if this.ServerURL != that1.ServerURL {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApplicationPubSubRegistryServer).Delete(ctx, req.(*ApplicationPubSubIdentifiers))
+	if this.ClientID != that1.ClientID {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _ApplicationPubSubRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.ApplicationPubSubRegistry",
-	HandlerType: (*ApplicationPubSubRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetFormats",
-			Handler:    _ApplicationPubSubRegistry_GetFormats_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _ApplicationPubSubRegistry_Get_Handler,
-		},
-		{
-			MethodName: "List",
-			Handler:    _ApplicationPubSubRegistry_List_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _ApplicationPubSubRegistry_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _ApplicationPubSubRegistry_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/applicationserver_pubsub.proto",
-}
-
-func (m *ApplicationPubSubIdentifiers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Username != that1.Username {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSubIdentifiers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationPubSubIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubSubID) > 0 {
-		i -= len(m.PubSubID)
-		copy(dAtA[i:], m.PubSubID)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.PubSubID)))
-		i--
-		dAtA[i] = 0x12
+	if this.Password != that1.Password {
+		return false
 	}
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if this.SubscribeQoS != that1.SubscribeQoS {
+		return false
+	}
+	if this.PublishQoS != that1.PublishQoS {
+		return false
+	}
+	if this.UseTLS != that1.UseTLS {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	if this.SessionExpiryInterval != nil && that1.SessionExpiryInterval != nil {
+		if *this.SessionExpiryInterval != *that1.SessionExpiryInterval {
+			return false
 		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	} else if this.SessionExpiryInterval != nil {
+		return false
+	} else if that1.SessionExpiryInterval != nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSub) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MessageExpiryInterval != nil && that1.MessageExpiryInterval != nil {
+		if *this.MessageExpiryInterval != *that1.MessageExpiryInterval {
+			return false
+		}
+	} else if this.MessageExpiryInterval != nil {
+		return false
+	} else if that1.MessageExpiryInterval != nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSub) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return true
 }
+func (this *ApplicationPubSub_KafkaProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationPubSub) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Provider != nil {
-		{
-			size := m.Provider.Size()
-			i -= size
-			if _, err := m.Provider.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	that1, ok := that.(*ApplicationPubSub_KafkaProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_KafkaProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.LocationSolved != nil {
-		{
-			size, err := m.LocationSolved.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DownlinkQueued != nil {
-		{
-			size, err := m.DownlinkQueued.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	if len(this.Brokers) != len(that1.Brokers) {
+		return false
+	}
+	for i := range this.Brokers {
+		if this.Brokers[i] != that1.Brokers[i] {
+			return false
 		}
-		i--
-		dAtA[i] = 0x7a
 	}
-	if m.DownlinkFailed != nil {
-		{
-			size, err := m.DownlinkFailed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
+	if this.UseTLS != that1.UseTLS {
+		return false
 	}
-	if m.DownlinkSent != nil {
-		{
-			size, err := m.DownlinkSent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
 	}
-	if m.DownlinkNack != nil {
-		{
-			size, err := m.DownlinkNack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
 	}
-	if m.DownlinkAck != nil {
-		{
-			size, err := m.DownlinkAck.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
 	}
-	if m.JoinAccept != nil {
-		{
-			size, err := m.JoinAccept.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
+	if this.SASLMechanism != that1.SASLMechanism {
+		return false
 	}
-	if m.UplinkMessage != nil {
-		{
-			size, err := m.UplinkMessage.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	if this.SASLUsername != that1.SASLUsername {
+		return false
 	}
-	if m.DownlinkReplace != nil {
-		{
-			size, err := m.DownlinkReplace.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	if this.SASLPassword != that1.SASLPassword {
+		return false
 	}
-	if m.DownlinkPush != nil {
-		{
-			size, err := m.DownlinkPush.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	return true
+}
+func (this *ApplicationPubSub_AMQPProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_AMQPProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AMQPProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	if len(m.BaseTopic) > 0 {
-		i -= len(m.BaseTopic)
-		copy(dAtA[i:], m.BaseTopic)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.BaseTopic)))
-		i--
-		dAtA[i] = 0x32
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Format)))
-		i--
-		dAtA[i] = 0x22
+	if this.ServerURL != that1.ServerURL {
+		return false
 	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err12 != nil {
-		return 0, err12
+	if this.UseTLS != that1.UseTLS {
+		return false
 	}
-	i -= n12
-	i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x1a
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err13 != nil {
-		return 0, err13
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
 	}
-	i -= n13
-	i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n13))
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSub_NATS) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if this.Exchange != that1.Exchange {
+		return false
+	}
+	return true
 }
+func (this *ApplicationPubSub_AWSIoTProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationPubSub_NATS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.NATS != nil {
-		{
-			size, err := m.NATS.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	that1, ok := that.(*ApplicationPubSub_AWSIoTProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AWSIoTProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ApplicationPubSub_MQTT) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Endpoint != that1.Endpoint {
+		return false
+	}
+	if this.Region != that1.Region {
+		return false
+	}
+	if this.ThingName != that1.ThingName {
+		return false
+	}
+	if this.AuthenticationMethod != that1.AuthenticationMethod {
+		return false
+	}
+	if !bytes.Equal(this.TLSCA, that1.TLSCA) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientCert, that1.TLSClientCert) {
+		return false
+	}
+	if !bytes.Equal(this.TLSClientKey, that1.TLSClientKey) {
+		return false
+	}
+	if this.AccessKeyID != that1.AccessKeyID {
+		return false
+	}
+	if this.SecretAccessKey != that1.SecretAccessKey {
+		return false
+	}
+	if this.SessionToken != that1.SessionToken {
+		return false
+	}
+	return true
 }
+func (this *ApplicationPubSub_AzureProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationPubSub_MQTT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MQTT != nil {
-		{
-			size, err := m.MQTT.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	that1, ok := that.(*ApplicationPubSub_AzureProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_AzureProvider)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
 	}
-	return len(dAtA) - i, nil
-}
-func (m *ApplicationPubSub_NATSProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSub_NATSProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationPubSub_NATSProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ServerURL) > 0 {
-		i -= len(m.ServerURL)
-		copy(dAtA[i:], m.ServerURL)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
-		i--
-		dAtA[i] = 0xa
+	if this.AuthenticationMethod != that1.AuthenticationMethod {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSub_MQTTProvider) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.EventHubsConnectionString != that1.EventHubsConnectionString {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSub_MQTTProvider) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ApplicationPubSub_MQTTProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TLSClientKey) > 0 {
-		i -= len(m.TLSClientKey)
-		copy(dAtA[i:], m.TLSClientKey)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
-		i--
-		dAtA[i] = 0x52
+	if this.ServiceBusConnectionString != that1.ServiceBusConnectionString {
+		return false
 	}
-	if len(m.TLSClientCert) > 0 {
-		i -= len(m.TLSClientCert)
-		copy(dAtA[i:], m.TLSClientCert)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
-		i--
-		dAtA[i] = 0x4a
+	if this.EventHubsNamespace != that1.EventHubsNamespace {
+		return false
 	}
-	if len(m.TLSCA) > 0 {
-		i -= len(m.TLSCA)
-		copy(dAtA[i:], m.TLSCA)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
-		i--
-		dAtA[i] = 0x42
+	if this.ServiceBusNamespace != that1.ServiceBusNamespace {
+		return false
 	}
-	if m.UseTLS {
-		i--
-		if m.UseTLS {
-			dAtA[i] = 1
+	if this.TenantID != that1.TenantID {
+		return false
+	}
+	if this.ClientID != that1.ClientID {
+		return false
+	}
+	if this.ClientSecret != that1.ClientSecret {
+		return false
+	}
+	return true
+}
+func (this *ApplicationPubSub_RedisProvider) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationPubSub_RedisProvider)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_RedisProvider)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.PublishQoS != 0 {
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.PublishQoS))
-		i--
-		dAtA[i] = 0x30
 	}
-	if m.SubscribeQoS != 0 {
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.SubscribeQoS))
-		i--
-		dAtA[i] = 0x28
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Password) > 0 {
-		i -= len(m.Password)
-		copy(dAtA[i:], m.Password)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
-		i--
-		dAtA[i] = 0x22
+	if this.ServerURL != that1.ServerURL {
+		return false
 	}
-	if len(m.Username) > 0 {
-		i -= len(m.Username)
-		copy(dAtA[i:], m.Username)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
-		i--
-		dAtA[i] = 0x1a
+	if this.StreamMaxLength != that1.StreamMaxLength {
+		return false
 	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x12
+	if this.ApproximateTrimming != that1.ApproximateTrimming {
+		return false
 	}
-	if len(m.ServerURL) > 0 {
-		i -= len(m.ServerURL)
-		copy(dAtA[i:], m.ServerURL)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
-		i--
-		dAtA[i] = 0xa
+	if this.ConsumerGroup != that1.ConsumerGroup {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
-
-func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ApplicationPubSub_Message) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Topic) > 0 {
-		i -= len(m.Topic)
-		copy(dAtA[i:], m.Topic)
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
-		i--
-		dAtA[i] = 0xa
+	that1, ok := that.(*ApplicationPubSub_Message)
+	if !ok {
+		that2, ok := that.(ApplicationPubSub_Message)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if this.Topic != that1.Topic {
+		return false
+	}
+	return true
 }
+func (this *ApplicationPubSubs) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pubsubs) > 0 {
-		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ApplicationPubSubs)
+	if !ok {
+		that2, ok := that.(ApplicationPubSubs)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if len(this.Pubsubs) != len(that1.Pubsubs) {
+		return false
+	}
+	for i := range this.Pubsubs {
+		if !this.Pubsubs[i].Equal(that1.Pubsubs[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *ApplicationPubSubFormats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k := range m.Formats {
-			v := m.Formats[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+	that1, ok := that.(*ApplicationPubSubFormats)
+	if !ok {
+		that2, ok := that.(ApplicationPubSubFormats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if len(this.Formats) != len(that1.Formats) {
+		return false
+	}
+	for i := range this.Formats {
+		if this.Formats[i] != that1.Formats[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *GetApplicationPubSubRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*GetApplicationPubSubRequest)
+	if !ok {
+		that2, ok := that.(GetApplicationPubSubRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if !this.ApplicationPubSubIdentifiers.Equal(&that1.ApplicationPubSubIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *ListApplicationPubSubsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListApplicationPubSubsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*ListApplicationPubSubsRequest)
+	if !ok {
+		that2, ok := that.(ListApplicationPubSubsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIdentifiers.Equal(&that1.ApplicationIdentifiers) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *SetApplicationPubSubRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListApplicationPubSubsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	that1, ok := that.(*SetApplicationPubSubRequest)
+	if !ok {
+		that2, ok := that.(SetApplicationPubSubRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationPubSub.Equal(&that1.ApplicationPubSub) {
+		return false
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
 }
+func (this *ApplicationPubSubStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ListApplicationPubSubsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*ApplicationPubSubStatus)
+	if !ok {
+		that2, ok := that.(ApplicationPubSubStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.IDs.Equal(&that1.IDs) {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if that1.StateChangedAt == nil {
+		if this.StateChangedAt != nil {
+			return false
 		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	} else if !this.StateChangedAt.Equal(*that1.StateChangedAt) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if !this.LastError.Equal(that1.LastError) {
+		return false
+	}
+	if that1.LastErrorAt == nil {
+		if this.LastErrorAt != nil {
+			return false
+		}
+	} else if !this.LastErrorAt.Equal(*that1.LastErrorAt) {
+		return false
+	}
+	if that1.LastPublishedAt == nil {
+		if this.LastPublishedAt != nil {
+			return false
+		}
+	} else if !this.LastPublishedAt.Equal(*that1.LastPublishedAt) {
+		return false
+	}
+	return true
 }
 
-func (m *SetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApplicationPubSubRegistryClient is the client API for ApplicationPubSubRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationPubSubRegistryClient interface {
+	GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationPubSubFormats, error)
+	Get(ctx context.Context, in *GetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error)
+	List(ctx context.Context, in *ListApplicationPubSubsRequest, opts ...grpc.CallOption) (*ApplicationPubSubs, error)
+	Set(ctx context.Context, in *SetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error)
+	Delete(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	GetPubSubStatus(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*ApplicationPubSubStatus, error)
+	Pause(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	Resume(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type applicationPubSubRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApplicationPubSubRegistryClient(cc *grpc.ClientConn) ApplicationPubSubRegistryClient {
+	return &applicationPubSubRegistryClient{cc}
+}
+
+func (c *applicationPubSubRegistryClient) GetFormats(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ApplicationPubSubFormats, error) {
+	out := new(ApplicationPubSubFormats)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetFormats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *SetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *applicationPubSubRegistryClient) Get(ctx context.Context, in *GetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error) {
+	out := new(ApplicationPubSub)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *SetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationPubSub.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+func (c *applicationPubSubRegistryClient) List(ctx context.Context, in *ListApplicationPubSubsRequest, opts ...grpc.CallOption) (*ApplicationPubSubs, error) {
+	out := new(ApplicationPubSubs)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/List", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintApplicationserverPubsub(dAtA []byte, offset int, v uint64) int {
-	offset -= sovApplicationserverPubsub(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *applicationPubSubRegistryClient) Set(ctx context.Context, in *SetApplicationPubSubRequest, opts ...grpc.CallOption) (*ApplicationPubSub, error) {
+	out := new(ApplicationPubSub)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func NewPopulatedApplicationPubSubIdentifiers(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubIdentifiers {
-	this := &ApplicationPubSubIdentifiers{}
-	v1 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v1
-	this.PubSubID = randStringApplicationserverPubsub(r)
-	if !easy && r.Intn(10) != 0 {
+
+func (c *applicationPubSubRegistryClient) Delete(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub {
-	this := &ApplicationPubSub{}
-	v2 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v2
-	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.CreatedAt = *v3
-	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.UpdatedAt = *v4
-	this.Format = randStringApplicationserverPubsub(r)
-	this.BaseTopic = randStringApplicationserverPubsub(r)
-	if r.Intn(5) != 0 {
-		this.DownlinkPush = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkReplace = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.UplinkMessage = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.JoinAccept = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkAck = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkNack = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkSent = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkFailed = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.DownlinkQueued = NewPopulatedApplicationPubSub_Message(r, easy)
-	}
-	if r.Intn(5) != 0 {
-		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
+func (c *applicationPubSubRegistryClient) GetPubSubStatus(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*ApplicationPubSubStatus, error) {
+	out := new(ApplicationPubSubStatus)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetPubSubStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	oneofNumber_Provider := []int32{17, 25}[r.Intn(2)]
-	switch oneofNumber_Provider {
-	case 17:
-		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
-	case 25:
-		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
+	return out, nil
+}
+
+func (c *applicationPubSubRegistryClient) Pause(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
+	return out, nil
+}
+
+func (c *applicationPubSubRegistryClient) Resume(ctx context.Context, in *ApplicationPubSubIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationPubSubRegistry/Resume", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return this
+	return out, nil
 }
 
-func NewPopulatedApplicationPubSub_NATS(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATS {
-	this := &ApplicationPubSub_NATS{}
-	this.NATS = NewPopulatedApplicationPubSub_NATSProvider(r, easy)
-	return this
+// ApplicationPubSubRegistryServer is the server API for ApplicationPubSubRegistry service.
+type ApplicationPubSubRegistryServer interface {
+	GetFormats(context.Context, *types.Empty) (*ApplicationPubSubFormats, error)
+	Get(context.Context, *GetApplicationPubSubRequest) (*ApplicationPubSub, error)
+	List(context.Context, *ListApplicationPubSubsRequest) (*ApplicationPubSubs, error)
+	Set(context.Context, *SetApplicationPubSubRequest) (*ApplicationPubSub, error)
+	Delete(context.Context, *ApplicationPubSubIdentifiers) (*types.Empty, error)
+	GetPubSubStatus(context.Context, *ApplicationPubSubIdentifiers) (*ApplicationPubSubStatus, error)
+	Pause(context.Context, *ApplicationPubSubIdentifiers) (*types.Empty, error)
+	Resume(context.Context, *ApplicationPubSubIdentifiers) (*types.Empty, error)
 }
-func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTT {
-	this := &ApplicationPubSub_MQTT{}
-	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
-	return this
+
+// UnimplementedApplicationPubSubRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationPubSubRegistryServer struct {
 }
-func NewPopulatedApplicationPubSub_NATSProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATSProvider {
-	this := &ApplicationPubSub_NATSProvider{}
-	this.ServerURL = randStringApplicationserverPubsub(r)
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
+
+func (*UnimplementedApplicationPubSubRegistryServer) GetFormats(ctx context.Context, req *types.Empty) (*ApplicationPubSubFormats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormats not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) Get(ctx context.Context, req *GetApplicationPubSubRequest) (*ApplicationPubSub, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) List(ctx context.Context, req *ListApplicationPubSubsRequest) (*ApplicationPubSubs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) Set(ctx context.Context, req *SetApplicationPubSubRequest) (*ApplicationPubSub, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) Delete(ctx context.Context, req *ApplicationPubSubIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) GetPubSubStatus(ctx context.Context, req *ApplicationPubSubIdentifiers) (*ApplicationPubSubStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPubSubStatus not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) Pause(ctx context.Context, req *ApplicationPubSubIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedApplicationPubSubRegistryServer) Resume(ctx context.Context, req *ApplicationPubSubIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resume not implemented")
 }
 
-func NewPopulatedApplicationPubSub_MQTTProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTTProvider {
-	this := &ApplicationPubSub_MQTTProvider{}
-	this.ServerURL = randStringApplicationserverPubsub(r)
-	this.ClientID = randStringApplicationserverPubsub(r)
-	this.Username = randStringApplicationserverPubsub(r)
-	this.Password = randStringApplicationserverPubsub(r)
-	this.SubscribeQoS = ApplicationPubSub_MQTTProvider_QoS([]int32{0, 1, 2}[r.Intn(3)])
-	this.PublishQoS = ApplicationPubSub_MQTTProvider_QoS([]int32{0, 1, 2}[r.Intn(3)])
-	this.UseTLS = bool(r.Intn(2) == 0)
-	v5 := r.Intn(100)
-	this.TLSCA = make([]byte, v5)
-	for i := 0; i < v5; i++ {
-		this.TLSCA[i] = byte(r.Intn(256))
+func RegisterApplicationPubSubRegistryServer(s *grpc.Server, srv ApplicationPubSubRegistryServer) {
+	s.RegisterService(&_ApplicationPubSubRegistry_serviceDesc, srv)
+}
+
+func _ApplicationPubSubRegistry_GetFormats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	v6 := r.Intn(100)
-	this.TLSClientCert = make([]byte, v6)
-	for i := 0; i < v6; i++ {
-		this.TLSClientCert[i] = byte(r.Intn(256))
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).GetFormats(ctx, in)
 	}
-	v7 := r.Intn(100)
-	this.TLSClientKey = make([]byte, v7)
-	for i := 0; i < v7; i++ {
-		this.TLSClientKey[i] = byte(r.Intn(256))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetFormats",
 	}
-	if !easy && r.Intn(10) != 0 {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).GetFormats(ctx, req.(*types.Empty))
 	}
-	return this
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
-	this := &ApplicationPubSub_Message{}
-	this.Topic = randStringApplicationserverPubsub(r)
-	if !easy && r.Intn(10) != 0 {
+func _ApplicationPubSubRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationPubSubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return this
-}
-
-func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
-	this := &ApplicationPubSubs{}
-	if r.Intn(5) != 0 {
-		v8 := r.Intn(5)
-		this.Pubsubs = make([]*ApplicationPubSub, v8)
-		for i := 0; i < v8; i++ {
-			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
-		}
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).Get(ctx, in)
 	}
-	if !easy && r.Intn(10) != 0 {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Get",
 	}
-	return this
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).Get(ctx, req.(*GetApplicationPubSubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
-	this := &ApplicationPubSubFormats{}
-	if r.Intn(5) != 0 {
-		v9 := r.Intn(10)
-		this.Formats = make(map[string]string)
-		for i := 0; i < v9; i++ {
-			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
-		}
+func _ApplicationPubSubRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationPubSubsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if !easy && r.Intn(10) != 0 {
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).List(ctx, in)
 	}
-	return this
-}
-
-func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
-	this := &GetApplicationPubSubRequest{}
-	v10 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
-	this.ApplicationPubSubIdentifiers = *v10
-	v11 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v11
-	if !easy && r.Intn(10) != 0 {
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/List",
 	}
-	return this
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).List(ctx, req.(*ListApplicationPubSubsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
-	this := &ListApplicationPubSubsRequest{}
-	v12 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v12
-	v13 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v13
-	if !easy && r.Intn(10) != 0 {
-	}
-	return this
-}
-
-func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
-	this := &SetApplicationPubSubRequest{}
-	v14 := NewPopulatedApplicationPubSub(r, easy)
-	this.ApplicationPubSub = *v14
-	v15 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v15
-	if !easy && r.Intn(10) != 0 {
+func _ApplicationPubSubRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApplicationPubSubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return this
-}
-
-type randyApplicationserverPubsub interface {
-	Float32() float32
-	Float64() float64
-	Int63() int64
-	Int31() int32
-	Uint32() uint32
-	Intn(n int) int
-}
-
-func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).Set(ctx, in)
 	}
-	return rune(ru + 61)
-}
-func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
-	v16 := r.Intn(100)
-	tmps := make([]rune, v16)
-	for i := 0; i < v16; i++ {
-		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Set",
 	}
-	return string(tmps)
-}
-func randUnrecognizedApplicationserverPubsub(r randyApplicationserverPubsub, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
-		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldApplicationserverPubsub(dAtA, r, fieldNumber, wire)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).Set(ctx, req.(*SetApplicationPubSubRequest))
 	}
-	return dAtA
+	return interceptor(ctx, in, info, handler)
 }
-func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsub, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		v17 := r.Int63()
-		if r.Intn(2) == 0 {
-			v17 *= -1
-		}
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v17))
-	case 1:
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
-		}
-	default:
-		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+
+func _ApplicationPubSubRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPubSubIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return dAtA
-}
-func encodeVarintPopulateApplicationserverPubsub(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).Delete(ctx, in)
 	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *ApplicationPubSubIdentifiers) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Delete",
 	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = len(m.PubSubID)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).Delete(ctx, req.(*ApplicationPubSubIdentifiers))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationPubSub) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ApplicationPubSubIdentifiers.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = len(m.Format)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	l = len(m.BaseTopic)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+func _ApplicationPubSubRegistry_GetPubSubStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPubSubIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownlinkPush != nil {
-		l = m.DownlinkPush.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).GetPubSubStatus(ctx, in)
 	}
-	if m.DownlinkReplace != nil {
-		l = m.DownlinkReplace.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/GetPubSubStatus",
 	}
-	if m.UplinkMessage != nil {
-		l = m.UplinkMessage.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).GetPubSubStatus(ctx, req.(*ApplicationPubSubIdentifiers))
 	}
-	if m.JoinAccept != nil {
-		l = m.JoinAccept.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationPubSubRegistry_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPubSubIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownlinkAck != nil {
-		l = m.DownlinkAck.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).Pause(ctx, in)
 	}
-	if m.DownlinkNack != nil {
-		l = m.DownlinkNack.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Pause",
 	}
-	if m.DownlinkSent != nil {
-		l = m.DownlinkSent.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).Pause(ctx, req.(*ApplicationPubSubIdentifiers))
 	}
-	if m.DownlinkFailed != nil {
-		l = m.DownlinkFailed.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationPubSubRegistry_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationPubSubIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.DownlinkQueued != nil {
-		l = m.DownlinkQueued.Size()
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if interceptor == nil {
+		return srv.(ApplicationPubSubRegistryServer).Resume(ctx, in)
 	}
-	if m.LocationSolved != nil {
-		l = m.LocationSolved.Size()
-		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationPubSubRegistry/Resume",
 	}
-	if m.Provider != nil {
-		n += m.Provider.Size()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationPubSubRegistryServer).Resume(ctx, req.(*ApplicationPubSubIdentifiers))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ApplicationPubSub_NATS) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NATS != nil {
-		l = m.NATS.Size()
-		n += 2 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	return n
+var _ApplicationPubSubRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.ApplicationPubSubRegistry",
+	HandlerType: (*ApplicationPubSubRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFormats",
+			Handler:    _ApplicationPubSubRegistry_GetFormats_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ApplicationPubSubRegistry_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ApplicationPubSubRegistry_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _ApplicationPubSubRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ApplicationPubSubRegistry_Delete_Handler,
+		},
+		{
+			MethodName: "GetPubSubStatus",
+			Handler:    _ApplicationPubSubRegistry_GetPubSubStatus_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _ApplicationPubSubRegistry_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ApplicationPubSubRegistry_Resume_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver_pubsub.proto",
 }
-func (m *ApplicationPubSub_MQTT) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ApplicationPubSubIdentifiers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubIdentifiers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubIdentifiers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MQTT != nil {
-		l = m.MQTT.Size()
-		n += 2 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	return n
-}
-func (m *ApplicationPubSub_NATSProvider) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PubSubID) > 0 {
+		i -= len(m.PubSubID)
+		copy(dAtA[i:], m.PubSubID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.PubSubID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.ServerURL)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ApplicationPubSub_MQTTProvider) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationPubSub) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ServerURL)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	l = len(m.Username)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	l = len(m.Password)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	}
-	if m.SubscribeQoS != 0 {
-		n += 1 + sovApplicationserverPubsub(uint64(m.SubscribeQoS))
+	if m.UpFilter != nil {
+		{
+			size, err := m.UpFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.PublishQoS != 0 {
-		n += 1 + sovApplicationserverPubsub(uint64(m.PublishQoS))
+	if m.Provider != nil {
+		{
+			size := m.Provider.Size()
+			i -= size
+			if _, err := m.Provider.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.UseTLS {
-		n += 2
+	if len(m.FPorts) > 0 {
+		dAtA20 := make([]byte, len(m.FPorts)*10)
+		var j19 int
+		for _, num := range m.FPorts {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(j19))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
-	l = len(m.TLSCA)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.LocationSolved != nil {
+		{
+			size, err := m.LocationSolved.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	l = len(m.TLSClientCert)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.DownlinkQueued != nil {
+		{
+			size, err := m.DownlinkQueued.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	l = len(m.TLSClientKey)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.DownlinkFailed != nil {
+		{
+			size, err := m.DownlinkFailed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	return n
-}
-
-func (m *ApplicationPubSub_Message) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DownlinkSent != nil {
+		{
+			size, err := m.DownlinkSent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	var l int
-	_ = l
-	l = len(m.Topic)
-	if l > 0 {
-		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.DownlinkNack != nil {
+		{
+			size, err := m.DownlinkNack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *ApplicationPubSubs) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DownlinkAck != nil {
+		{
+			size, err := m.DownlinkAck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	if len(m.Pubsubs) > 0 {
-		for _, e := range m.Pubsubs {
-			l = e.Size()
-			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.JoinAccept != nil {
+		{
+			size, err := m.JoinAccept.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-
-func (m *ApplicationPubSubFormats) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UplinkMessage != nil {
+		{
+			size, err := m.UplinkMessage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if len(m.Formats) > 0 {
-		for k, v := range m.Formats {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovApplicationserverPubsub(uint64(len(k))) + 1 + len(v) + sovApplicationserverPubsub(uint64(len(v)))
-			n += mapEntrySize + 1 + sovApplicationserverPubsub(uint64(mapEntrySize))
+	if m.DownlinkReplace != nil {
+		{
+			size, err := m.DownlinkReplace.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *GetApplicationPubSubRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DownlinkPush != nil {
+		{
+			size, err := m.DownlinkPush.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = m.ApplicationPubSubIdentifiers.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	return n
+	if len(m.BaseTopic) > 0 {
+		i -= len(m.BaseTopic)
+		copy(dAtA[i:], m.BaseTopic)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.BaseTopic)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ListApplicationPubSubsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ApplicationIdentifiers.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	return n
+func (m *ApplicationPubSub_NATS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SetApplicationPubSubRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ApplicationPubSub_NATS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NATS != nil {
+		{
+			size, err := m.NATS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	var l int
-	_ = l
-	l = m.ApplicationPubSub.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	l = m.FieldMask.Size()
-	n += 1 + l + sovApplicationserverPubsub(uint64(l))
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationPubSub_MQTT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovApplicationserverPubsub(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ApplicationPubSub_MQTT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MQTT != nil {
+		{
+			size, err := m.MQTT.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	return len(dAtA) - i, nil
 }
-func sozApplicationserverPubsub(x uint64) (n int) {
-	return sovApplicationserverPubsub((x << 1) ^ uint64((int64(x) >> 63)))
+func (m *ApplicationPubSub_Kafka) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ApplicationPubSubIdentifiers) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_Kafka) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	s := strings.Join([]string{`&ApplicationPubSubIdentifiers{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`PubSubID:` + fmt.Sprintf("%v", this.PubSubID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ApplicationPubSub) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationPubSub{`,
-		`ApplicationPubSubIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationPubSubIdentifiers.String(), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1), `&`, ``, 1) + `,`,
-		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
-		`BaseTopic:` + fmt.Sprintf("%v", this.BaseTopic) + `,`,
-		`DownlinkPush:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPush), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkReplace:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkReplace), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`JoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.JoinAccept), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkAck:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkAck), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkNack:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkNack), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkSent:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkSent), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
-		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ApplicationPubSub_AMQP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ApplicationPubSub_NATS) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_AMQP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AMQP != nil {
+		{
+			size, err := m.AMQP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	s := strings.Join([]string{`&ApplicationPubSub_NATS{`,
-		`NATS:` + strings.Replace(fmt.Sprintf("%v", this.NATS), "ApplicationPubSub_NATSProvider", "ApplicationPubSub_NATSProvider", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ApplicationPubSub_MQTT) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationPubSub_MQTT{`,
-		`MQTT:` + strings.Replace(fmt.Sprintf("%v", this.MQTT), "ApplicationPubSub_MQTTProvider", "ApplicationPubSub_MQTTProvider", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ApplicationPubSub_AWSIoT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ApplicationPubSub_NATSProvider) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_AWSIoT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AWSIoT != nil {
+		{
+			size, err := m.AWSIoT.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	s := strings.Join([]string{`&ApplicationPubSub_NATSProvider{`,
-		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ApplicationPubSub_MQTTProvider) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ApplicationPubSub_MQTTProvider{`,
-		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
-		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
-		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
-		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
-		`SubscribeQoS:` + fmt.Sprintf("%v", this.SubscribeQoS) + `,`,
-		`PublishQoS:` + fmt.Sprintf("%v", this.PublishQoS) + `,`,
-		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
-		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
-		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
-		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *ApplicationPubSub_Azure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ApplicationPubSub_Message) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_Azure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	s := strings.Join([]string{`&ApplicationPubSub_Message{`,
-		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ApplicationPubSubs) String() string {
-	if this == nil {
-		return "nil"
+func (m *ApplicationPubSub_Redis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Redis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Redis != nil {
+		{
+			size, err := m.Redis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	repeatedStringForPubsubs := "[]*ApplicationPubSub{"
-	for _, f := range this.Pubsubs {
-		repeatedStringForPubsubs += strings.Replace(f.String(), "ApplicationPubSub", "ApplicationPubSub", 1) + ","
+	return len(dAtA) - i, nil
+}
+func (m *ApplicationPubSub_NATSProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForPubsubs += "}"
-	s := strings.Join([]string{`&ApplicationPubSubs{`,
-		`Pubsubs:` + repeatedStringForPubsubs + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ApplicationPubSubFormats) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_NATSProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_NATSProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DurablePrefix) > 0 {
+		i -= len(m.DurablePrefix)
+		copy(dAtA[i:], m.DurablePrefix)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.DurablePrefix)))
+		i--
+		dAtA[i] = 0x22
 	}
-	keysForFormats := make([]string, 0, len(this.Formats))
-	for k := range this.Formats {
-		keysForFormats = append(keysForFormats, k)
+	if len(m.Stream) > 0 {
+		i -= len(m.Stream)
+		copy(dAtA[i:], m.Stream)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Stream)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
-	mapStringForFormats := "map[string]string{"
-	for _, k := range keysForFormats {
-		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	if m.JetStream {
+		i--
+		if m.JetStream {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	mapStringForFormats += "}"
-	s := strings.Join([]string{`&ApplicationPubSubFormats{`,
-		`Formats:` + mapStringForFormats + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetApplicationPubSubRequest) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
 	}
-	s := strings.Join([]string{`&GetApplicationPubSubRequest{`,
-		`ApplicationPubSubIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationPubSubIdentifiers.String(), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ListApplicationPubSubsRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_MQTTProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ListApplicationPubSubsRequest{`,
-		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *SetApplicationPubSubRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ApplicationPubSub_MQTTProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_MQTTProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MessageExpiryInterval != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MessageExpiryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MessageExpiryInterval):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x6a
 	}
-	s := strings.Join([]string{`&SetApplicationPubSubRequest{`,
-		`ApplicationPubSub:` + strings.Replace(strings.Replace(this.ApplicationPubSub.String(), "ApplicationPubSub", "ApplicationPubSub", 1), `&`, ``, 1) + `,`,
-		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.SessionExpiryInterval != nil {
+		n15, err15 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.SessionExpiryInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionExpiryInterval):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ProtocolVersion != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.ProtocolVersion))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PublishQoS != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.PublishQoS))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SubscribeQoS != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.SubscribeQoS))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func valueToStringApplicationserverPubsub(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+func (m *ApplicationPubSub_KafkaProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return dAtA[:n], nil
 }
-func (m *ApplicationPubSubIdentifiers) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverPubsub
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_KafkaProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SASLPassword) > 0 {
+		i -= len(m.SASLPassword)
+		copy(dAtA[i:], m.SASLPassword)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.SASLPassword)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SASLUsername) > 0 {
+		i -= len(m.SASLUsername)
+		copy(dAtA[i:], m.SASLUsername)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.SASLUsername)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SASLMechanism != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.SASLMechanism))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_AMQPProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AMQPProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Exchange) > 0 {
+		i -= len(m.Exchange)
+		copy(dAtA[i:], m.Exchange)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Exchange)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UseTLS {
+		i--
+		if m.UseTLS {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SessionToken) > 0 {
+		i -= len(m.SessionToken)
+		copy(dAtA[i:], m.SessionToken)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.SessionToken)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SecretAccessKey) > 0 {
+		i -= len(m.SecretAccessKey)
+		copy(dAtA[i:], m.SecretAccessKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.SecretAccessKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AccessKeyID) > 0 {
+		i -= len(m.AccessKeyID)
+		copy(dAtA[i:], m.AccessKeyID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.AccessKeyID)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TLSClientKey) > 0 {
+		i -= len(m.TLSClientKey)
+		copy(dAtA[i:], m.TLSClientKey)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TLSClientCert) > 0 {
+		i -= len(m.TLSClientCert)
+		copy(dAtA[i:], m.TLSClientCert)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSClientCert)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TLSCA) > 0 {
+		i -= len(m.TLSCA)
+		copy(dAtA[i:], m.TLSCA)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TLSCA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AuthenticationMethod != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.AuthenticationMethod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ThingName) > 0 {
+		i -= len(m.ThingName)
+		copy(dAtA[i:], m.ThingName)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ThingName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_AzureProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_AzureProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_AzureProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientSecret) > 0 {
+		i -= len(m.ClientSecret)
+		copy(dAtA[i:], m.ClientSecret)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ClientSecret)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TenantID) > 0 {
+		i -= len(m.TenantID)
+		copy(dAtA[i:], m.TenantID)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.TenantID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ServiceBusNamespace) > 0 {
+		i -= len(m.ServiceBusNamespace)
+		copy(dAtA[i:], m.ServiceBusNamespace)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServiceBusNamespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EventHubsNamespace) > 0 {
+		i -= len(m.EventHubsNamespace)
+		copy(dAtA[i:], m.EventHubsNamespace)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.EventHubsNamespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceBusConnectionString) > 0 {
+		i -= len(m.ServiceBusConnectionString)
+		copy(dAtA[i:], m.ServiceBusConnectionString)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServiceBusConnectionString)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventHubsConnectionString) > 0 {
+		i -= len(m.EventHubsConnectionString)
+		copy(dAtA[i:], m.EventHubsConnectionString)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.EventHubsConnectionString)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AuthenticationMethod != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.AuthenticationMethod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_RedisProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_RedisProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_RedisProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerGroup) > 0 {
+		i -= len(m.ConsumerGroup)
+		copy(dAtA[i:], m.ConsumerGroup)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ConsumerGroup)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ApproximateTrimming {
+		i--
+		if m.ApproximateTrimming {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamMaxLength != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.StreamMaxLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ServerURL) > 0 {
+		i -= len(m.ServerURL)
+		copy(dAtA[i:], m.ServerURL)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.ServerURL)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSub_Message) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSub_Message) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSub_Message) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for iNdEx := len(m.Pubsubs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pubsubs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubFormats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubFormats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubFormats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k := range m.Formats {
+			v := m.Formats[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationPubSubIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationPubSubsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationPubSubsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationPubSubsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetApplicationPubSubRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetApplicationPubSubRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetApplicationPubSubRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationPubSub.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationPubSubStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationPubSubStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationPubSubStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPublishedAt != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastPublishedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPublishedAt):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastErrorAt != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastErrorAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastErrorAt):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastError != nil {
+		{
+			size, err := m.LastError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StateChangedAt != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StateChangedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StateChangedAt):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.State != 0 {
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.IDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverPubsub(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApplicationserverPubsub(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApplicationserverPubsub(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedApplicationPubSubIdentifiers(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubIdentifiers {
+	this := &ApplicationPubSubIdentifiers{}
+	v1 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v1
+	this.PubSubID = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub {
+	this := &ApplicationPubSub{}
+	v2 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v2
+	v3 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.CreatedAt = *v3
+	v4 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.UpdatedAt = *v4
+	this.Format = randStringApplicationserverPubsub(r)
+	this.BaseTopic = randStringApplicationserverPubsub(r)
+	if r.Intn(5) != 0 {
+		this.DownlinkPush = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkReplace = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UplinkMessage = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.JoinAccept = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkAck = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkNack = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkSent = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkFailed = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.DownlinkQueued = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LocationSolved = NewPopulatedApplicationPubSub_Message(r, easy)
+	}
+	v28 := r.Intn(10)
+	this.FPorts = make([]uint32, v28)
+	for i := 0; i < v28; i++ {
+		this.FPorts[i] = uint32(r.Uint32())
+	}
+	oneofNumber_Provider := []int32{17, 25, 26, 27, 28, 29, 30}[r.Intn(7)]
+	switch oneofNumber_Provider {
+	case 17:
+		this.Provider = NewPopulatedApplicationPubSub_NATS(r, easy)
+	case 25:
+		this.Provider = NewPopulatedApplicationPubSub_MQTT(r, easy)
+	case 26:
+		this.Provider = NewPopulatedApplicationPubSub_Kafka(r, easy)
+	case 27:
+		this.Provider = NewPopulatedApplicationPubSub_AMQP(r, easy)
+	case 28:
+		this.Provider = NewPopulatedApplicationPubSub_AWSIoT(r, easy)
+	case 29:
+		this.Provider = NewPopulatedApplicationPubSub_Azure(r, easy)
+	case 30:
+		this.Provider = NewPopulatedApplicationPubSub_Redis(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.UpFilter = NewPopulatedApplicationUpFilter(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_NATS(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATS {
+	this := &ApplicationPubSub_NATS{}
+	this.NATS = NewPopulatedApplicationPubSub_NATSProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_MQTT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTT {
+	this := &ApplicationPubSub_MQTT{}
+	this.MQTT = NewPopulatedApplicationPubSub_MQTTProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_Kafka(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Kafka {
+	this := &ApplicationPubSub_Kafka{}
+	this.Kafka = NewPopulatedApplicationPubSub_KafkaProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_AMQP(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQP {
+	this := &ApplicationPubSub_AMQP{}
+	this.AMQP = NewPopulatedApplicationPubSub_AMQPProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_AWSIoT(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AWSIoT {
+	this := &ApplicationPubSub_AWSIoT{}
+	this.AWSIoT = NewPopulatedApplicationPubSub_AWSIoTProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_Azure(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Azure {
+	this := &ApplicationPubSub_Azure{}
+	this.Azure = NewPopulatedApplicationPubSub_AzureProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_Redis(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Redis {
+	this := &ApplicationPubSub_Redis{}
+	this.Redis = NewPopulatedApplicationPubSub_RedisProvider(r, easy)
+	return this
+}
+func NewPopulatedApplicationPubSub_NATSProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_NATSProvider {
+	this := &ApplicationPubSub_NATSProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.JetStream = bool(r.Intn(2) == 0)
+	this.Stream = randStringApplicationserverPubsub(r)
+	this.DurablePrefix = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_MQTTProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_MQTTProvider {
+	this := &ApplicationPubSub_MQTTProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.ClientID = randStringApplicationserverPubsub(r)
+	this.Username = randStringApplicationserverPubsub(r)
+	this.Password = randStringApplicationserverPubsub(r)
+	this.SubscribeQoS = ApplicationPubSub_MQTTProvider_QoS([]int32{0, 1, 2}[r.Intn(3)])
+	this.PublishQoS = ApplicationPubSub_MQTTProvider_QoS([]int32{0, 1, 2}[r.Intn(3)])
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v5 := r.Intn(100)
+	this.TLSCA = make([]byte, v5)
+	for i := 0; i < v5; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v6 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v6)
+	for i := 0; i < v6; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v7 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v7)
+	for i := 0; i < v7; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	this.ProtocolVersion = ApplicationPubSub_MQTTProvider_ProtocolVersion([]int32{0, 1}[r.Intn(2)])
+	if r.Intn(5) != 0 {
+		this.SessionExpiryInterval = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.MessageExpiryInterval = github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_KafkaProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_KafkaProvider {
+	this := &ApplicationPubSub_KafkaProvider{}
+	v8 := r.Intn(10)
+	this.Brokers = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.Brokers[i] = randStringApplicationserverPubsub(r)
+	}
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v9 := r.Intn(100)
+	this.TLSCA = make([]byte, v9)
+	for i := 0; i < v9; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v10 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v10)
+	for i := 0; i < v10; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v11 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v11)
+	for i := 0; i < v11; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	this.SASLMechanism = ApplicationPubSub_KafkaProvider_SASLMechanism([]int32{0, 1, 2, 3}[r.Intn(4)])
+	this.SASLUsername = randStringApplicationserverPubsub(r)
+	this.SASLPassword = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_AMQPProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AMQPProvider {
+	this := &ApplicationPubSub_AMQPProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.UseTLS = bool(r.Intn(2) == 0)
+	v12 := r.Intn(100)
+	this.TLSCA = make([]byte, v12)
+	for i := 0; i < v12; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v13 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v13)
+	for i := 0; i < v13; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v14 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v14)
+	for i := 0; i < v14; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	this.Exchange = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_AWSIoTProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AWSIoTProvider {
+	this := &ApplicationPubSub_AWSIoTProvider{}
+	this.Endpoint = randStringApplicationserverPubsub(r)
+	this.Region = randStringApplicationserverPubsub(r)
+	this.ThingName = randStringApplicationserverPubsub(r)
+	this.AuthenticationMethod = ApplicationPubSub_AWSIoTProvider_AuthenticationMethod([]int32{0, 1}[r.Intn(2)])
+	v15 := r.Intn(100)
+	this.TLSCA = make([]byte, v15)
+	for i := 0; i < v15; i++ {
+		this.TLSCA[i] = byte(r.Intn(256))
+	}
+	v16 := r.Intn(100)
+	this.TLSClientCert = make([]byte, v16)
+	for i := 0; i < v16; i++ {
+		this.TLSClientCert[i] = byte(r.Intn(256))
+	}
+	v17 := r.Intn(100)
+	this.TLSClientKey = make([]byte, v17)
+	for i := 0; i < v17; i++ {
+		this.TLSClientKey[i] = byte(r.Intn(256))
+	}
+	this.AccessKeyID = randStringApplicationserverPubsub(r)
+	this.SecretAccessKey = randStringApplicationserverPubsub(r)
+	this.SessionToken = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_AzureProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_AzureProvider {
+	this := &ApplicationPubSub_AzureProvider{}
+	this.AuthenticationMethod = ApplicationPubSub_AzureProvider_AuthenticationMethod([]int32{0, 1}[r.Intn(2)])
+	this.EventHubsConnectionString = randStringApplicationserverPubsub(r)
+	this.ServiceBusConnectionString = randStringApplicationserverPubsub(r)
+	this.EventHubsNamespace = randStringApplicationserverPubsub(r)
+	this.ServiceBusNamespace = randStringApplicationserverPubsub(r)
+	this.TenantID = randStringApplicationserverPubsub(r)
+	this.ClientID = randStringApplicationserverPubsub(r)
+	this.ClientSecret = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_RedisProvider(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_RedisProvider {
+	this := &ApplicationPubSub_RedisProvider{}
+	this.ServerURL = randStringApplicationserverPubsub(r)
+	this.StreamMaxLength = uint32(r.Uint32())
+	this.ApproximateTrimming = bool(r.Intn(2) == 0)
+	this.ConsumerGroup = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSub_Message(r randyApplicationserverPubsub, easy bool) *ApplicationPubSub_Message {
+	this := &ApplicationPubSub_Message{}
+	this.Topic = randStringApplicationserverPubsub(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSubs(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubs {
+	this := &ApplicationPubSubs{}
+	if r.Intn(5) != 0 {
+		v18 := r.Intn(5)
+		this.Pubsubs = make([]*ApplicationPubSub, v18)
+		for i := 0; i < v18; i++ {
+			this.Pubsubs[i] = NewPopulatedApplicationPubSub(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSubFormats(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubFormats {
+	this := &ApplicationPubSubFormats{}
+	if r.Intn(5) != 0 {
+		v19 := r.Intn(10)
+		this.Formats = make(map[string]string)
+		for i := 0; i < v19; i++ {
+			this.Formats[randStringApplicationserverPubsub(r)] = randStringApplicationserverPubsub(r)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *GetApplicationPubSubRequest {
+	this := &GetApplicationPubSubRequest{}
+	v20 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.ApplicationPubSubIdentifiers = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedListApplicationPubSubsRequest(r randyApplicationserverPubsub, easy bool) *ListApplicationPubSubsRequest {
+	this := &ListApplicationPubSubsRequest{}
+	v22 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetApplicationPubSubRequest(r randyApplicationserverPubsub, easy bool) *SetApplicationPubSubRequest {
+	this := &SetApplicationPubSubRequest{}
+	v24 := NewPopulatedApplicationPubSub(r, easy)
+	this.ApplicationPubSub = *v24
+	v25 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v25
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationPubSubStatus(r randyApplicationserverPubsub, easy bool) *ApplicationPubSubStatus {
+	this := &ApplicationPubSubStatus{}
+	v26 := NewPopulatedApplicationPubSubIdentifiers(r, easy)
+	this.IDs = *v26
+	this.State = ApplicationPubSubStatus_State([]int32{0, 1, 2, 3, 4, 5}[r.Intn(6)])
+	if r.Intn(5) != 0 {
+		this.StateChangedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastError = NewPopulatedErrorDetails(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastErrorAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.LastPublishedAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyApplicationserverPubsub interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneApplicationserverPubsub(r randyApplicationserverPubsub) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringApplicationserverPubsub(r randyApplicationserverPubsub) string {
+	v26 := r.Intn(100)
+	tmps := make([]rune, v26)
+	for i := 0; i < v26; i++ {
+		tmps[i] = randUTF8RuneApplicationserverPubsub(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedApplicationserverPubsub(r randyApplicationserverPubsub, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldApplicationserverPubsub(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldApplicationserverPubsub(dAtA []byte, r randyApplicationserverPubsub, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
+		v27 := r.Int63()
+		if r.Intn(2) == 0 {
+			v27 *= -1
+		}
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(v27))
+	case 1:
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateApplicationserverPubsub(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateApplicationserverPubsub(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *ApplicationPubSubIdentifiers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = len(m.PubSubID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationPubSubIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.BaseTopic)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkPush != nil {
+		l = m.DownlinkPush.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkReplace != nil {
+		l = m.DownlinkReplace.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.UplinkMessage != nil {
+		l = m.UplinkMessage.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.JoinAccept != nil {
+		l = m.JoinAccept.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkAck != nil {
+		l = m.DownlinkAck.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkNack != nil {
+		l = m.DownlinkNack.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkSent != nil {
+		l = m.DownlinkSent.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkFailed != nil {
+		l = m.DownlinkFailed.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.DownlinkQueued != nil {
+		l = m.DownlinkQueued.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.LocationSolved != nil {
+		l = m.LocationSolved.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if len(m.FPorts) > 0 {
+		l = 0
+		for _, e := range m.FPorts {
+			l += sovApplicationserverPubsub(uint64(e))
+		}
+		n += 2 + sovApplicationserverPubsub(uint64(l)) + l
+	}
+	if m.Provider != nil {
+		n += m.Provider.Size()
+	}
+	if m.UpFilter != nil {
+		l = m.UpFilter.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_NATS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NATS != nil {
+		l = m.NATS.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_MQTT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MQTT != nil {
+		l = m.MQTT.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_Kafka) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_AMQP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AMQP != nil {
+		l = m.AMQP.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_AWSIoT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AWSIoT != nil {
+		l = m.AWSIoT.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_Azure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_Redis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Redis != nil {
+		l = m.Redis.Size()
+		n += 2 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+func (m *ApplicationPubSub_NATSProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.JetStream {
+		n += 2
+	}
+	l = len(m.Stream)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.DurablePrefix)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_MQTTProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SubscribeQoS != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.SubscribeQoS))
+	}
+	if m.PublishQoS != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.PublishQoS))
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.ProtocolVersion))
+	}
+	if m.SessionExpiryInterval != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.SessionExpiryInterval)
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.MessageExpiryInterval != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MessageExpiryInterval)
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_KafkaProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.SASLMechanism != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.SASLMechanism))
+	}
+	l = len(m.SASLUsername)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.SASLPassword)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_AMQPProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.UseTLS {
+		n += 2
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Exchange)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_AWSIoTProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ThingName)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.AuthenticationMethod != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.AuthenticationMethod))
+	}
+	l = len(m.TLSCA)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientCert)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TLSClientKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.AccessKeyID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.SecretAccessKey)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.SessionToken)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_AzureProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuthenticationMethod != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.AuthenticationMethod))
+	}
+	l = len(m.EventHubsConnectionString)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ServiceBusConnectionString)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.EventHubsNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ServiceBusNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.TenantID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	l = len(m.ClientSecret)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_RedisProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ServerURL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.StreamMaxLength != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.StreamMaxLength))
+	}
+	if m.ApproximateTrimming {
+		n += 2
+	}
+	l = len(m.ConsumerGroup)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSub_Message) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationPubSubs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubsubs) > 0 {
+		for _, e := range m.Pubsubs {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverPubsub(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ApplicationPubSubFormats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Formats) > 0 {
+		for k, v := range m.Formats {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovApplicationserverPubsub(uint64(len(k))) + 1 + len(v) + sovApplicationserverPubsub(uint64(len(v)))
+			n += mapEntrySize + 1 + sovApplicationserverPubsub(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *GetApplicationPubSubRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationPubSubIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	return n
+}
+
+func (m *ListApplicationPubSubsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIdentifiers.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	return n
+}
+
+func (m *SetApplicationPubSubRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationPubSub.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	l = m.FieldMask.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	return n
+}
+
+func (m *ApplicationPubSubStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IDs.Size()
+	n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	if m.State != 0 {
+		n += 1 + sovApplicationserverPubsub(uint64(m.State))
+	}
+	if m.StateChangedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StateChangedAt)
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.LastError != nil {
+		l = m.LastError.Size()
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.LastErrorAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastErrorAt)
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	if m.LastPublishedAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastPublishedAt)
+		n += 1 + l + sovApplicationserverPubsub(uint64(l))
+	}
+	return n
+}
+
+func sovApplicationserverPubsub(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApplicationserverPubsub(x uint64) (n int) {
+	return sovApplicationserverPubsub((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *ApplicationPubSubIdentifiers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSubIdentifiers{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`PubSubID:` + fmt.Sprintf("%v", this.PubSubID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub{`,
+		`ApplicationPubSubIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationPubSubIdentifiers.String(), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1), `&`, ``, 1) + `,`,
+		`CreatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`UpdatedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.UpdatedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`BaseTopic:` + fmt.Sprintf("%v", this.BaseTopic) + `,`,
+		`DownlinkPush:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkPush), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkReplace:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkReplace), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`UplinkMessage:` + strings.Replace(fmt.Sprintf("%v", this.UplinkMessage), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`JoinAccept:` + strings.Replace(fmt.Sprintf("%v", this.JoinAccept), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkAck:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkAck), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkNack:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkNack), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkSent:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkSent), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkFailed:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkFailed), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`DownlinkQueued:` + strings.Replace(fmt.Sprintf("%v", this.DownlinkQueued), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationPubSub_Message", "ApplicationPubSub_Message", 1) + `,`,
+		`FPorts:` + fmt.Sprintf("%v", this.FPorts) + `,`,
+		`Provider:` + fmt.Sprintf("%v", this.Provider) + `,`,
+		`UpFilter:` + strings.Replace(fmt.Sprintf("%v", this.UpFilter), "ApplicationUpFilter", "ApplicationUpFilter", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_NATS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_NATS{`,
+		`NATS:` + strings.Replace(fmt.Sprintf("%v", this.NATS), "ApplicationPubSub_NATSProvider", "ApplicationPubSub_NATSProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_MQTT) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_MQTT{`,
+		`MQTT:` + strings.Replace(fmt.Sprintf("%v", this.MQTT), "ApplicationPubSub_MQTTProvider", "ApplicationPubSub_MQTTProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_Kafka) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Kafka{`,
+		`Kafka:` + strings.Replace(fmt.Sprintf("%v", this.Kafka), "ApplicationPubSub_KafkaProvider", "ApplicationPubSub_KafkaProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQP{`,
+		`AMQP:` + strings.Replace(fmt.Sprintf("%v", this.AMQP), "ApplicationPubSub_AMQPProvider", "ApplicationPubSub_AMQPProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AWSIoT) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AWSIoT{`,
+		`AWSIoT:` + strings.Replace(fmt.Sprintf("%v", this.AWSIoT), "ApplicationPubSub_AWSIoTProvider", "ApplicationPubSub_AWSIoTProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_Azure) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Azure{`,
+		`Azure:` + strings.Replace(fmt.Sprintf("%v", this.Azure), "ApplicationPubSub_AzureProvider", "ApplicationPubSub_AzureProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_Redis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Redis{`,
+		`Redis:` + strings.Replace(fmt.Sprintf("%v", this.Redis), "ApplicationPubSub_RedisProvider", "ApplicationPubSub_RedisProvider", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_NATSProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_NATSProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`JetStream:` + fmt.Sprintf("%v", this.JetStream) + `,`,
+		`Stream:` + fmt.Sprintf("%v", this.Stream) + `,`,
+		`DurablePrefix:` + fmt.Sprintf("%v", this.DurablePrefix) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_MQTTProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_MQTTProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Password:` + fmt.Sprintf("%v", this.Password) + `,`,
+		`SubscribeQoS:` + fmt.Sprintf("%v", this.SubscribeQoS) + `,`,
+		`PublishQoS:` + fmt.Sprintf("%v", this.PublishQoS) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`SessionExpiryInterval:` + strings.Replace(fmt.Sprintf("%v", this.SessionExpiryInterval), "Duration", "types.Duration", 1) + `,`,
+		`MessageExpiryInterval:` + strings.Replace(fmt.Sprintf("%v", this.MessageExpiryInterval), "Duration", "types.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_KafkaProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_KafkaProvider{`,
+		`Brokers:` + fmt.Sprintf("%v", this.Brokers) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`SASLMechanism:` + fmt.Sprintf("%v", this.SASLMechanism) + `,`,
+		`SASLUsername:` + fmt.Sprintf("%v", this.SASLUsername) + `,`,
+		`SASLPassword:` + fmt.Sprintf("%v", this.SASLPassword) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AMQPProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AMQPProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`UseTLS:` + fmt.Sprintf("%v", this.UseTLS) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`Exchange:` + fmt.Sprintf("%v", this.Exchange) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AWSIoTProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AWSIoTProvider{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`ThingName:` + fmt.Sprintf("%v", this.ThingName) + `,`,
+		`AuthenticationMethod:` + fmt.Sprintf("%v", this.AuthenticationMethod) + `,`,
+		`TLSCA:` + fmt.Sprintf("%v", this.TLSCA) + `,`,
+		`TLSClientCert:` + fmt.Sprintf("%v", this.TLSClientCert) + `,`,
+		`TLSClientKey:` + fmt.Sprintf("%v", this.TLSClientKey) + `,`,
+		`AccessKeyID:` + fmt.Sprintf("%v", this.AccessKeyID) + `,`,
+		`SecretAccessKey:` + fmt.Sprintf("%v", this.SecretAccessKey) + `,`,
+		`SessionToken:` + fmt.Sprintf("%v", this.SessionToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_AzureProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_AzureProvider{`,
+		`AuthenticationMethod:` + fmt.Sprintf("%v", this.AuthenticationMethod) + `,`,
+		`EventHubsConnectionString:` + fmt.Sprintf("%v", this.EventHubsConnectionString) + `,`,
+		`ServiceBusConnectionString:` + fmt.Sprintf("%v", this.ServiceBusConnectionString) + `,`,
+		`EventHubsNamespace:` + fmt.Sprintf("%v", this.EventHubsNamespace) + `,`,
+		`ServiceBusNamespace:` + fmt.Sprintf("%v", this.ServiceBusNamespace) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`ClientID:` + fmt.Sprintf("%v", this.ClientID) + `,`,
+		`ClientSecret:` + fmt.Sprintf("%v", this.ClientSecret) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_RedisProvider) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_RedisProvider{`,
+		`ServerURL:` + fmt.Sprintf("%v", this.ServerURL) + `,`,
+		`StreamMaxLength:` + fmt.Sprintf("%v", this.StreamMaxLength) + `,`,
+		`ApproximateTrimming:` + fmt.Sprintf("%v", this.ApproximateTrimming) + `,`,
+		`ConsumerGroup:` + fmt.Sprintf("%v", this.ConsumerGroup) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSub_Message) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSub_Message{`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSubs) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPubsubs := "[]*ApplicationPubSub{"
+	for _, f := range this.Pubsubs {
+		repeatedStringForPubsubs += strings.Replace(f.String(), "ApplicationPubSub", "ApplicationPubSub", 1) + ","
+	}
+	repeatedStringForPubsubs += "}"
+	s := strings.Join([]string{`&ApplicationPubSubs{`,
+		`Pubsubs:` + repeatedStringForPubsubs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSubFormats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForFormats := make([]string, 0, len(this.Formats))
+	for k := range this.Formats {
+		keysForFormats = append(keysForFormats, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFormats)
+	mapStringForFormats := "map[string]string{"
+	for _, k := range keysForFormats {
+		mapStringForFormats += fmt.Sprintf("%v: %v,", k, this.Formats[k])
+	}
+	mapStringForFormats += "}"
+	s := strings.Join([]string{`&ApplicationPubSubFormats{`,
+		`Formats:` + mapStringForFormats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetApplicationPubSubRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetApplicationPubSubRequest{`,
+		`ApplicationPubSubIdentifiers:` + strings.Replace(strings.Replace(this.ApplicationPubSubIdentifiers.String(), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ListApplicationPubSubsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ListApplicationPubSubsRequest{`,
+		`ApplicationIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIdentifiers), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetApplicationPubSubRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetApplicationPubSubRequest{`,
+		`ApplicationPubSub:` + strings.Replace(strings.Replace(this.ApplicationPubSub.String(), "ApplicationPubSub", "ApplicationPubSub", 1), `&`, ``, 1) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationPubSubStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationPubSubStatus{`,
+		`IDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IDs), "ApplicationPubSubIdentifiers", "ApplicationPubSubIdentifiers", 1), `&`, ``, 1) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`StateChangedAt:` + strings.Replace(fmt.Sprintf("%v", this.StateChangedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastError:` + strings.Replace(fmt.Sprintf("%v", this.LastError), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`LastErrorAt:` + strings.Replace(fmt.Sprintf("%v", this.LastErrorAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`LastPublishedAt:` + strings.Replace(fmt.Sprintf("%v", this.LastPublishedAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func valueToStringApplicationserverPubsub(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ApplicationPubSubIdentifiers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPubSubIdentifiers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPubSubIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubSubID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubSubID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPubSub: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPubSub: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationPubSubIdentifiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationPubSubIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseTopic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseTopic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPush", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkPush == nil {
+				m.DownlinkPush = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkReplace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkReplace == nil {
+				m.DownlinkReplace = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkReplace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UplinkMessage == nil {
+				m.UplinkMessage = &ApplicationPubSub_Message{}
+			}
+			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JoinAccept == nil {
+				m.JoinAccept = &ApplicationPubSub_Message{}
+			}
+			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkAck == nil {
+				m.DownlinkAck = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkNack == nil {
+				m.DownlinkNack = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkSent == nil {
+				m.DownlinkSent = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkFailed == nil {
+				m.DownlinkFailed = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownlinkQueued == nil {
+				m.DownlinkQueued = &ApplicationPubSub_Message{}
+			}
+			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocationSolved == nil {
+				m.LocationSolved = &ApplicationPubSub_Message{}
+			}
+			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_NATSProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_NATS{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_MQTTProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_MQTT{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_KafkaProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Kafka{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AMQP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AMQPProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_AMQP{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AWSIoT", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AWSIoTProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_AWSIoT{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_AzureProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Azure{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ApplicationPubSub_RedisProvider{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Provider = &ApplicationPubSub_Redis{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverPubsub
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FPorts = append(m.FPorts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowApplicationserverPubsub
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthApplicationserverPubsub
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthApplicationserverPubsub
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FPorts) == 0 {
+					m.FPorts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowApplicationserverPubsub
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FPorts = append(m.FPorts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPorts", wireType)
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpFilter == nil {
+				m.UpFilter = &ApplicationUpFilter{}
+			}
+			if err := m.UpFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_NATSProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NATSProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NATSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JetStream", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.JetStream = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stream = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurablePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DurablePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MQTTProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MQTTProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPubSubIdentifiers: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPubSubIdentifiers: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscribeQoS", wireType)
+			}
+			m.SubscribeQoS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubscribeQoS |= ApplicationPubSub_MQTTProvider_QoS(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishQoS", wireType)
+			}
+			m.PublishQoS = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishQoS |= ApplicationPubSub_MQTTProvider_QoS(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= ApplicationPubSub_MQTTProvider_ProtocolVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionExpiryInterval", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2905,15 +7282,18 @@ func (m *ApplicationPubSubIdentifiers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SessionExpiryInterval == nil {
+				m.SessionExpiryInterval = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.SessionExpiryInterval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubSubID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageExpiryInterval", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -2923,23 +7303,27 @@ func (m *ApplicationPubSubIdentifiers) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubSubID = string(dAtA[iNdEx:postIndex])
+			if m.MessageExpiryInterval == nil {
+				m.MessageExpiryInterval = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MessageExpiryInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2965,7 +7349,7 @@ func (m *ApplicationPubSubIdentifiers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPubSub_KafkaProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2988,17 +7372,17 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ApplicationPubSub: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApplicationPubSub: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationPubSubIdentifiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3008,30 +7392,49 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ApplicationPubSubIdentifiers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3041,30 +7444,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3074,28 +7478,82 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLMechanism", wireType)
+			}
+			m.SASLMechanism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SASLMechanism |= ApplicationPubSub_KafkaProvider_SASLMechanism(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3123,11 +7581,11 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Format = string(dAtA[iNdEx:postIndex])
+			m.SASLUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseTopic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASLPassword", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3155,13 +7613,66 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseTopic = string(dAtA[iNdEx:postIndex])
+			m.SASLPassword = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_AMQPProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMQPProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMQPProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkPush", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3171,33 +7682,83 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkPush == nil {
-				m.DownlinkPush = &ApplicationPubSub_Message{}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
 			}
-			if err := m.DownlinkPush.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseTLS = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkReplace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3207,33 +7768,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkReplace == nil {
-				m.DownlinkReplace = &ApplicationPubSub_Message{}
-			}
-			if err := m.DownlinkReplace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UplinkMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3243,33 +7802,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UplinkMessage == nil {
-				m.UplinkMessage = &ApplicationPubSub_Message{}
-			}
-			if err := m.UplinkMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinAccept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exchange", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3279,33 +7836,82 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JoinAccept == nil {
-				m.JoinAccept = &ApplicationPubSub_Message{}
-			}
-			if err := m.JoinAccept.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exchange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_AWSIoTProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AWSIoTProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AWSIoTProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkAck", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3315,33 +7921,29 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkAck == nil {
-				m.DownlinkAck = &ApplicationPubSub_Message{}
-			}
-			if err := m.DownlinkAck.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkNack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3351,33 +7953,29 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkNack == nil {
-				m.DownlinkNack = &ApplicationPubSub_Message{}
-			}
-			if err := m.DownlinkNack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkSent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThingName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3387,33 +7985,48 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkSent == nil {
-				m.DownlinkSent = &ApplicationPubSub_Message{}
+			m.ThingName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMethod", wireType)
 			}
-			if err := m.DownlinkSent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.AuthenticationMethod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticationMethod |= ApplicationPubSub_AWSIoTProvider_AuthenticationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkFailed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3423,33 +8036,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkFailed == nil {
-				m.DownlinkFailed = &ApplicationPubSub_Message{}
-			}
-			if err := m.DownlinkFailed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSCA == nil {
+				m.TLSCA = []byte{}
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownlinkQueued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3459,33 +8070,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownlinkQueued == nil {
-				m.DownlinkQueued = &ApplicationPubSub_Message{}
-			}
-			if err := m.DownlinkQueued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientCert == nil {
+				m.TLSClientCert = []byte{}
 			}
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocationSolved", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3495,33 +8104,31 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LocationSolved == nil {
-				m.LocationSolved = &ApplicationPubSub_Message{}
-			}
-			if err := m.LocationSolved.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.TLSClientKey == nil {
+				m.TLSClientKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NATS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessKeyID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3531,32 +8138,29 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplicationPubSub_NATSProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Provider = &ApplicationPubSub_NATS{v}
+			m.AccessKeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MQTT", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretAccessKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3566,83 +8170,27 @@ func (m *ApplicationPubSub) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ApplicationPubSub_MQTTProvider{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Provider = &ApplicationPubSub_MQTT{v}
+			m.SecretAccessKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthApplicationserverPubsub
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthApplicationserverPubsub
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApplicationPubSub_NATSProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowApplicationserverPubsub
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NATSProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NATSProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3670,7 +8218,7 @@ func (m *ApplicationPubSub_NATSProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			m.SessionToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3696,7 +8244,7 @@ func (m *ApplicationPubSub_NATSProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
+func (m *ApplicationPubSub_AzureProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3719,17 +8267,17 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MQTTProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MQTTProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticationMethod", wireType)
 			}
-			var stringLen uint64
+			m.AuthenticationMethod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3739,27 +8287,14 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuthenticationMethod |= ApplicationPubSub_AzureProvider_AuthenticationMethod(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthApplicationserverPubsub
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthApplicationserverPubsub
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ServerURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHubsConnectionString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3787,11 +8322,11 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.EventHubsConnectionString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceBusConnectionString", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3819,11 +8354,11 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Username = string(dAtA[iNdEx:postIndex])
+			m.ServiceBusConnectionString = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHubsNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3851,13 +8386,13 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Password = string(dAtA[iNdEx:postIndex])
+			m.EventHubsNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubscribeQoS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceBusNamespace", wireType)
 			}
-			m.SubscribeQoS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3867,16 +8402,29 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubscribeQoS |= ApplicationPubSub_MQTTProvider_QoS(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceBusNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublishQoS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
-			m.PublishQoS = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3886,16 +8434,29 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PublishQoS |= ApplicationPubSub_MQTTProvider_QoS(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseTLS", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3905,17 +8466,29 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseTLS = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSCA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3925,31 +8498,82 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSCA = append(m.TLSCA[:0], dAtA[iNdEx:postIndex]...)
-			if m.TLSCA == nil {
-				m.TLSCA = []byte{}
-			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationPubSub_RedisProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedisProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedisProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientCert", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerURL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3959,31 +8583,68 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientCert = append(m.TLSClientCert[:0], dAtA[iNdEx:postIndex]...)
-			if m.TLSClientCert == nil {
-				m.TLSClientCert = []byte{}
+			m.ServerURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamMaxLength", wireType)
+			}
+			m.StreamMaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamMaxLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApproximateTrimming", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+			m.ApproximateTrimming = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerGroup", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowApplicationserverPubsub
@@ -3993,25 +8654,23 @@ func (m *ApplicationPubSub_MQTTProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthApplicationserverPubsub
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSClientKey = append(m.TLSClientKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.TLSClientKey == nil {
-				m.TLSClientKey = []byte{}
-			}
+			m.ConsumerGroup = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4746,6 +9405,256 @@ func (m *SetApplicationPubSubRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationPubSubStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverPubsub
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationPubSubStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationPubSubStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= ApplicationPubSubStatus_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChangedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StateChangedAt == nil {
+				m.StateChangedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StateChangedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastError == nil {
+				m.LastError = &ErrorDetails{}
+			}
+			if err := m.LastError.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastErrorAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastErrorAt == nil {
+				m.LastErrorAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastErrorAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPublishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverPubsub
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastPublishedAt == nil {
+				m.LastPublishedAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.LastPublishedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverPubsub(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverPubsub
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipApplicationserverPubsub(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29,6 +29,7 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"downlink_replace.topic",
 	"downlink_sent",
 	"downlink_sent.topic",
+	"f_ports",
 	"format",
 	"ids",
 	"ids.application_ids",
@@ -39,11 +40,50 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"location_solved",
 	"location_solved.topic",
 	"provider",
+	"provider.amqp",
+	"provider.amqp.exchange",
+	"provider.amqp.server_url",
+	"provider.amqp.tls_ca",
+	"provider.amqp.tls_client_cert",
+	"provider.amqp.tls_client_key",
+	"provider.amqp.use_tls",
+	"provider.aws_iot",
+	"provider.aws_iot.access_key_id",
+	"provider.aws_iot.authentication_method",
+	"provider.aws_iot.endpoint",
+	"provider.aws_iot.region",
+	"provider.aws_iot.secret_access_key",
+	"provider.aws_iot.session_token",
+	"provider.aws_iot.thing_name",
+	"provider.aws_iot.tls_ca",
+	"provider.aws_iot.tls_client_cert",
+	"provider.aws_iot.tls_client_key",
+	"provider.azure",
+	"provider.azure.authentication_method",
+	"provider.azure.client_id",
+	"provider.azure.client_secret",
+	"provider.azure.event_hubs_connection_string",
+	"provider.azure.event_hubs_namespace",
+	"provider.azure.service_bus_connection_string",
+	"provider.azure.service_bus_namespace",
+	"provider.azure.tenant_id",
+	"provider.kafka",
+	"provider.kafka.brokers",
+	"provider.kafka.sasl_mechanism",
+	"provider.kafka.sasl_password",
+	"provider.kafka.sasl_username",
+	"provider.kafka.tls_ca",
+	"provider.kafka.tls_client_cert",
+	"provider.kafka.tls_client_key",
+	"provider.kafka.use_tls",
 	"provider.mqtt",
 	"provider.mqtt.client_id",
+	"provider.mqtt.message_expiry_interval",
 	"provider.mqtt.password",
+	"provider.mqtt.protocol_version",
 	"provider.mqtt.publish_qos",
 	"provider.mqtt.server_url",
+	"provider.mqtt.session_expiry_interval",
 	"provider.mqtt.subscribe_qos",
 	"provider.mqtt.tls_ca",
 	"provider.mqtt.tls_client_cert",
@@ -51,7 +91,18 @@ var ApplicationPubSubFieldPathsNested = []string{
 	"provider.mqtt.use_tls",
 	"provider.mqtt.username",
 	"provider.nats",
+	"provider.nats.durable_prefix",
+	"provider.nats.jetstream",
 	"provider.nats.server_url",
+	"provider.nats.stream",
+	"provider.redis",
+	"provider.redis.approximate_trimming",
+	"provider.redis.consumer_group",
+	"provider.redis.server_url",
+	"provider.redis.stream_max_length",
+	"up_filter",
+	"up_filter.decoded_payload_conditions",
+	"up_filter.f_port_ranges",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.topic",
@@ -67,11 +118,13 @@ var ApplicationPubSubFieldPathsTopLevel = []string{
 	"downlink_queued",
 	"downlink_replace",
 	"downlink_sent",
+	"f_ports",
 	"format",
 	"ids",
 	"join_accept",
 	"location_solved",
 	"provider",
+	"up_filter",
 	"updated_at",
 	"uplink_message",
 }
@@ -140,11 +193,50 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.location_solved",
 	"pubsub.location_solved.topic",
 	"pubsub.provider",
+	"pubsub.provider.amqp",
+	"pubsub.provider.amqp.exchange",
+	"pubsub.provider.amqp.server_url",
+	"pubsub.provider.amqp.tls_ca",
+	"pubsub.provider.amqp.tls_client_cert",
+	"pubsub.provider.amqp.tls_client_key",
+	"pubsub.provider.amqp.use_tls",
+	"pubsub.provider.aws_iot",
+	"pubsub.provider.aws_iot.access_key_id",
+	"pubsub.provider.aws_iot.authentication_method",
+	"pubsub.provider.aws_iot.endpoint",
+	"pubsub.provider.aws_iot.region",
+	"pubsub.provider.aws_iot.secret_access_key",
+	"pubsub.provider.aws_iot.session_token",
+	"pubsub.provider.aws_iot.thing_name",
+	"pubsub.provider.aws_iot.tls_ca",
+	"pubsub.provider.aws_iot.tls_client_cert",
+	"pubsub.provider.aws_iot.tls_client_key",
+	"pubsub.provider.azure",
+	"pubsub.provider.azure.authentication_method",
+	"pubsub.provider.azure.client_id",
+	"pubsub.provider.azure.client_secret",
+	"pubsub.provider.azure.event_hubs_connection_string",
+	"pubsub.provider.azure.event_hubs_namespace",
+	"pubsub.provider.azure.service_bus_connection_string",
+	"pubsub.provider.azure.service_bus_namespace",
+	"pubsub.provider.azure.tenant_id",
+	"pubsub.provider.kafka",
+	"pubsub.provider.kafka.brokers",
+	"pubsub.provider.kafka.sasl_mechanism",
+	"pubsub.provider.kafka.sasl_password",
+	"pubsub.provider.kafka.sasl_username",
+	"pubsub.provider.kafka.tls_ca",
+	"pubsub.provider.kafka.tls_client_cert",
+	"pubsub.provider.kafka.tls_client_key",
+	"pubsub.provider.kafka.use_tls",
 	"pubsub.provider.mqtt",
 	"pubsub.provider.mqtt.client_id",
+	"pubsub.provider.mqtt.message_expiry_interval",
 	"pubsub.provider.mqtt.password",
+	"pubsub.provider.mqtt.protocol_version",
 	"pubsub.provider.mqtt.publish_qos",
 	"pubsub.provider.mqtt.server_url",
+	"pubsub.provider.mqtt.session_expiry_interval",
 	"pubsub.provider.mqtt.subscribe_qos",
 	"pubsub.provider.mqtt.tls_ca",
 	"pubsub.provider.mqtt.tls_client_cert",
@@ -152,7 +244,15 @@ var SetApplicationPubSubRequestFieldPathsNested = []string{
 	"pubsub.provider.mqtt.use_tls",
 	"pubsub.provider.mqtt.username",
 	"pubsub.provider.nats",
+	"pubsub.provider.nats.durable_prefix",
+	"pubsub.provider.nats.jetstream",
 	"pubsub.provider.nats.server_url",
+	"pubsub.provider.nats.stream",
+	"pubsub.provider.redis",
+	"pubsub.provider.redis.approximate_trimming",
+	"pubsub.provider.redis.consumer_group",
+	"pubsub.provider.redis.server_url",
+	"pubsub.provider.redis.stream_max_length",
 	"pubsub.updated_at",
 	"pubsub.uplink_message",
 	"pubsub.uplink_message.topic",
@@ -163,17 +263,26 @@ var SetApplicationPubSubRequestFieldPathsTopLevel = []string{
 	"pubsub",
 }
 var ApplicationPubSub_NATSProviderFieldPathsNested = []string{
+	"durable_prefix",
+	"jetstream",
 	"server_url",
+	"stream",
 }
 
 var ApplicationPubSub_NATSProviderFieldPathsTopLevel = []string{
+	"durable_prefix",
+	"jetstream",
 	"server_url",
+	"stream",
 }
 var ApplicationPubSub_MQTTProviderFieldPathsNested = []string{
 	"client_id",
+	"message_expiry_interval",
 	"password",
+	"protocol_version",
 	"publish_qos",
 	"server_url",
+	"session_expiry_interval",
 	"subscribe_qos",
 	"tls_ca",
 	"tls_client_cert",
@@ -184,9 +293,12 @@ var ApplicationPubSub_MQTTProviderFieldPathsNested = []string{
 
 var ApplicationPubSub_MQTTProviderFieldPathsTopLevel = []string{
 	"client_id",
+	"message_expiry_interval",
 	"password",
+	"protocol_version",
 	"publish_qos",
 	"server_url",
+	"session_expiry_interval",
 	"subscribe_qos",
 	"tls_ca",
 	"tls_client_cert",
@@ -194,6 +306,103 @@ var ApplicationPubSub_MQTTProviderFieldPathsTopLevel = []string{
 	"use_tls",
 	"username",
 }
+var ApplicationPubSub_KafkaProviderFieldPathsNested = []string{
+	"brokers",
+	"sasl_mechanism",
+	"sasl_password",
+	"sasl_username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_KafkaProviderFieldPathsTopLevel = []string{
+	"brokers",
+	"sasl_mechanism",
+	"sasl_password",
+	"sasl_username",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+var ApplicationPubSub_AMQPProviderFieldPathsNested = []string{
+	"exchange",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+
+var ApplicationPubSub_AMQPProviderFieldPathsTopLevel = []string{
+	"exchange",
+	"server_url",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+	"use_tls",
+}
+var ApplicationPubSub_AWSIoTProviderFieldPathsNested = []string{
+	"access_key_id",
+	"authentication_method",
+	"endpoint",
+	"region",
+	"secret_access_key",
+	"session_token",
+	"thing_name",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+}
+
+var ApplicationPubSub_AWSIoTProviderFieldPathsTopLevel = []string{
+	"access_key_id",
+	"authentication_method",
+	"endpoint",
+	"region",
+	"secret_access_key",
+	"session_token",
+	"thing_name",
+	"tls_ca",
+	"tls_client_cert",
+	"tls_client_key",
+}
+var ApplicationPubSub_AzureProviderFieldPathsNested = []string{
+	"authentication_method",
+	"client_id",
+	"client_secret",
+	"event_hubs_connection_string",
+	"event_hubs_namespace",
+	"service_bus_connection_string",
+	"service_bus_namespace",
+	"tenant_id",
+}
+
+var ApplicationPubSub_AzureProviderFieldPathsTopLevel = []string{
+	"authentication_method",
+	"client_id",
+	"client_secret",
+	"event_hubs_connection_string",
+	"event_hubs_namespace",
+	"service_bus_connection_string",
+	"service_bus_namespace",
+	"tenant_id",
+}
+var ApplicationPubSub_RedisProviderFieldPathsNested = []string{
+	"approximate_trimming",
+	"consumer_group",
+	"server_url",
+	"stream_max_length",
+}
+
+var ApplicationPubSub_RedisProviderFieldPathsTopLevel = []string{
+	"approximate_trimming",
+	"consumer_group",
+	"server_url",
+	"stream_max_length",
+}
 var ApplicationPubSub_MessageFieldPathsNested = []string{
 	"topic",
 }
@@ -201,3 +410,37 @@ var ApplicationPubSub_MessageFieldPathsNested = []string{
 var ApplicationPubSub_MessageFieldPathsTopLevel = []string{
 	"topic",
 }
+
+var ApplicationPubSubStatusFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.pub_sub_id",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"last_error_at",
+	"last_published_at",
+	"state",
+	"state_changed_at",
+}
+
+var ApplicationPubSubStatusFieldPathsTopLevel = []string{
+	"ids",
+	"last_error",
+	"last_error_at",
+	"last_published_at",
+	"state",
+	"state_changed_at",
+}
@@ -320,6 +320,16 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 				}
 			}
 
+		case "f_ports":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_ports' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPorts = src.FPorts
+			} else {
+				dst.FPorts = nil
+			}
+
 		case "provider":
 			if len(subs) == 0 && src == nil {
 				dst.Provider = nil
@@ -383,12 +393,157 @@ func (dst *ApplicationPubSub) SetFields(src *ApplicationPubSub, paths ...string)
 							dst.Provider.(*ApplicationPubSub_MQTT).MQTT = nil
 						}
 					}
+				case "kafka":
+					if _, ok := dst.Provider.(*ApplicationPubSub_Kafka); !ok {
+						dst.Provider = &ApplicationPubSub_Kafka{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Provider.(*ApplicationPubSub_Kafka).Kafka
+						if newDst == nil {
+							newDst = &ApplicationPubSub_KafkaProvider{}
+							dst.Provider.(*ApplicationPubSub_Kafka).Kafka = newDst
+						}
+						var newSrc *ApplicationPubSub_KafkaProvider
+						if src != nil {
+							newSrc = src.GetKafka()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider.(*ApplicationPubSub_Kafka).Kafka = src.GetKafka()
+						} else {
+							dst.Provider.(*ApplicationPubSub_Kafka).Kafka = nil
+						}
+					}
+				case "amqp":
+					if _, ok := dst.Provider.(*ApplicationPubSub_AMQP); !ok {
+						dst.Provider = &ApplicationPubSub_AMQP{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Provider.(*ApplicationPubSub_AMQP).AMQP
+						if newDst == nil {
+							newDst = &ApplicationPubSub_AMQPProvider{}
+							dst.Provider.(*ApplicationPubSub_AMQP).AMQP = newDst
+						}
+						var newSrc *ApplicationPubSub_AMQPProvider
+						if src != nil {
+							newSrc = src.GetAMQP()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider.(*ApplicationPubSub_AMQP).AMQP = src.GetAMQP()
+						} else {
+							dst.Provider.(*ApplicationPubSub_AMQP).AMQP = nil
+						}
+					}
+				case "aws_iot":
+					if _, ok := dst.Provider.(*ApplicationPubSub_AWSIoT); !ok {
+						dst.Provider = &ApplicationPubSub_AWSIoT{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Provider.(*ApplicationPubSub_AWSIoT).AWSIoT
+						if newDst == nil {
+							newDst = &ApplicationPubSub_AWSIoTProvider{}
+							dst.Provider.(*ApplicationPubSub_AWSIoT).AWSIoT = newDst
+						}
+						var newSrc *ApplicationPubSub_AWSIoTProvider
+						if src != nil {
+							newSrc = src.GetAWSIoT()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider.(*ApplicationPubSub_AWSIoT).AWSIoT = src.GetAWSIoT()
+						} else {
+							dst.Provider.(*ApplicationPubSub_AWSIoT).AWSIoT = nil
+						}
+					}
+				case "azure":
+					if _, ok := dst.Provider.(*ApplicationPubSub_Azure); !ok {
+						dst.Provider = &ApplicationPubSub_Azure{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Provider.(*ApplicationPubSub_Azure).Azure
+						if newDst == nil {
+							newDst = &ApplicationPubSub_AzureProvider{}
+							dst.Provider.(*ApplicationPubSub_Azure).Azure = newDst
+						}
+						var newSrc *ApplicationPubSub_AzureProvider
+						if src != nil {
+							newSrc = src.GetAzure()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider.(*ApplicationPubSub_Azure).Azure = src.GetAzure()
+						} else {
+							dst.Provider.(*ApplicationPubSub_Azure).Azure = nil
+						}
+					}
+				case "redis":
+					if _, ok := dst.Provider.(*ApplicationPubSub_Redis); !ok {
+						dst.Provider = &ApplicationPubSub_Redis{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Provider.(*ApplicationPubSub_Redis).Redis
+						if newDst == nil {
+							newDst = &ApplicationPubSub_RedisProvider{}
+							dst.Provider.(*ApplicationPubSub_Redis).Redis = newDst
+						}
+						var newSrc *ApplicationPubSub_RedisProvider
+						if src != nil {
+							newSrc = src.GetRedis()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Provider.(*ApplicationPubSub_Redis).Redis = src.GetRedis()
+						} else {
+							dst.Provider.(*ApplicationPubSub_Redis).Redis = nil
+						}
+					}
 
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
 				}
 			}
 
+		case "up_filter":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUpFilter
+				if (src == nil || src.UpFilter == nil) && dst.UpFilter == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UpFilter
+				}
+				if dst.UpFilter != nil {
+					newDst = dst.UpFilter
+				} else {
+					newDst = &ApplicationUpFilter{}
+					dst.UpFilter = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UpFilter = src.UpFilter
+				} else {
+					dst.UpFilter = nil
+				}
+			}
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -566,6 +721,36 @@ func (dst *ApplicationPubSub_NATSProvider) SetFields(src *ApplicationPubSub_NATS
 				var zero string
 				dst.ServerURL = zero
 			}
+		case "jetstream":
+			if len(subs) > 0 {
+				return fmt.Errorf("'jetstream' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.JetStream = src.JetStream
+			} else {
+				var zero bool
+				dst.JetStream = zero
+			}
+		case "stream":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stream' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Stream = src.Stream
+			} else {
+				var zero string
+				dst.Stream = zero
+			}
+		case "durable_prefix":
+			if len(subs) > 0 {
+				return fmt.Errorf("'durable_prefix' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DurablePrefix = src.DurablePrefix
+			} else {
+				var zero string
+				dst.DurablePrefix = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -674,6 +859,439 @@ func (dst *ApplicationPubSub_MQTTProvider) SetFields(src *ApplicationPubSub_MQTT
 			} else {
 				dst.TLSClientKey = nil
 			}
+		case "protocol_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'protocol_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ProtocolVersion = src.ProtocolVersion
+			} else {
+				var zero ApplicationPubSub_MQTTProvider_ProtocolVersion
+				dst.ProtocolVersion = zero
+			}
+		case "session_expiry_interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_expiry_interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionExpiryInterval = src.SessionExpiryInterval
+			} else {
+				dst.SessionExpiryInterval = nil
+			}
+		case "message_expiry_interval":
+			if len(subs) > 0 {
+				return fmt.Errorf("'message_expiry_interval' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MessageExpiryInterval = src.MessageExpiryInterval
+			} else {
+				dst.MessageExpiryInterval = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_KafkaProvider) SetFields(src *ApplicationPubSub_KafkaProvider, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "brokers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'brokers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Brokers = src.Brokers
+			} else {
+				dst.Brokers = nil
+			}
+		case "use_tls":
+			if len(subs) > 0 {
+				return fmt.Errorf("'use_tls' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UseTLS = src.UseTLS
+			} else {
+				var zero bool
+				dst.UseTLS = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSCA = src.TLSCA
+			} else {
+				dst.TLSCA = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+		case "sasl_mechanism":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sasl_mechanism' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SASLMechanism = src.SASLMechanism
+			} else {
+				var zero ApplicationPubSub_KafkaProvider_SASLMechanism
+				dst.SASLMechanism = zero
+			}
+		case "sasl_username":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sasl_username' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SASLUsername = src.SASLUsername
+			} else {
+				var zero string
+				dst.SASLUsername = zero
+			}
+		case "sasl_password":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sasl_password' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SASLPassword = src.SASLPassword
+			} else {
+				var zero string
+				dst.SASLPassword = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_AMQPProvider) SetFields(src *ApplicationPubSub_AMQPProvider, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "server_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'server_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServerURL = src.ServerURL
+			} else {
+				var zero string
+				dst.ServerURL = zero
+			}
+		case "use_tls":
+			if len(subs) > 0 {
+				return fmt.Errorf("'use_tls' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UseTLS = src.UseTLS
+			} else {
+				var zero bool
+				dst.UseTLS = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSCA = src.TLSCA
+			} else {
+				dst.TLSCA = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+		case "exchange":
+			if len(subs) > 0 {
+				return fmt.Errorf("'exchange' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Exchange = src.Exchange
+			} else {
+				var zero string
+				dst.Exchange = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_AWSIoTProvider) SetFields(src *ApplicationPubSub_AWSIoTProvider, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "endpoint":
+			if len(subs) > 0 {
+				return fmt.Errorf("'endpoint' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Endpoint = src.Endpoint
+			} else {
+				var zero string
+				dst.Endpoint = zero
+			}
+		case "region":
+			if len(subs) > 0 {
+				return fmt.Errorf("'region' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Region = src.Region
+			} else {
+				var zero string
+				dst.Region = zero
+			}
+		case "thing_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'thing_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ThingName = src.ThingName
+			} else {
+				var zero string
+				dst.ThingName = zero
+			}
+		case "authentication_method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'authentication_method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthenticationMethod = src.AuthenticationMethod
+			} else {
+				var zero ApplicationPubSub_AWSIoTProvider_AuthenticationMethod
+				dst.AuthenticationMethod = zero
+			}
+		case "tls_ca":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_ca' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSCA = src.TLSCA
+			} else {
+				dst.TLSCA = nil
+			}
+		case "tls_client_cert":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_cert' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientCert = src.TLSClientCert
+			} else {
+				dst.TLSClientCert = nil
+			}
+		case "tls_client_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tls_client_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TLSClientKey = src.TLSClientKey
+			} else {
+				dst.TLSClientKey = nil
+			}
+		case "access_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'access_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AccessKeyID = src.AccessKeyID
+			} else {
+				var zero string
+				dst.AccessKeyID = zero
+			}
+		case "secret_access_key":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secret_access_key' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecretAccessKey = src.SecretAccessKey
+			} else {
+				var zero string
+				dst.SecretAccessKey = zero
+			}
+		case "session_token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'session_token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SessionToken = src.SessionToken
+			} else {
+				var zero string
+				dst.SessionToken = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_AzureProvider) SetFields(src *ApplicationPubSub_AzureProvider, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "authentication_method":
+			if len(subs) > 0 {
+				return fmt.Errorf("'authentication_method' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AuthenticationMethod = src.AuthenticationMethod
+			} else {
+				var zero ApplicationPubSub_AzureProvider_AuthenticationMethod
+				dst.AuthenticationMethod = zero
+			}
+		case "event_hubs_connection_string":
+			if len(subs) > 0 {
+				return fmt.Errorf("'event_hubs_connection_string' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EventHubsConnectionString = src.EventHubsConnectionString
+			} else {
+				var zero string
+				dst.EventHubsConnectionString = zero
+			}
+		case "service_bus_connection_string":
+			if len(subs) > 0 {
+				return fmt.Errorf("'service_bus_connection_string' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServiceBusConnectionString = src.ServiceBusConnectionString
+			} else {
+				var zero string
+				dst.ServiceBusConnectionString = zero
+			}
+		case "event_hubs_namespace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'event_hubs_namespace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EventHubsNamespace = src.EventHubsNamespace
+			} else {
+				var zero string
+				dst.EventHubsNamespace = zero
+			}
+		case "service_bus_namespace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'service_bus_namespace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServiceBusNamespace = src.ServiceBusNamespace
+			} else {
+				var zero string
+				dst.ServiceBusNamespace = zero
+			}
+		case "tenant_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tenant_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TenantID = src.TenantID
+			} else {
+				var zero string
+				dst.TenantID = zero
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientID = src.ClientID
+			} else {
+				var zero string
+				dst.ClientID = zero
+			}
+		case "client_secret":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_secret' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientSecret = src.ClientSecret
+			} else {
+				var zero string
+				dst.ClientSecret = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationPubSub_RedisProvider) SetFields(src *ApplicationPubSub_RedisProvider, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "server_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'server_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ServerURL = src.ServerURL
+			} else {
+				var zero string
+				dst.ServerURL = zero
+			}
+		case "stream_max_length":
+			if len(subs) > 0 {
+				return fmt.Errorf("'stream_max_length' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StreamMaxLength = src.StreamMaxLength
+			} else {
+				var zero uint32
+				dst.StreamMaxLength = zero
+			}
+		case "approximate_trimming":
+			if len(subs) > 0 {
+				return fmt.Errorf("'approximate_trimming' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApproximateTrimming = src.ApproximateTrimming
+			} else {
+				var zero bool
+				dst.ApproximateTrimming = zero
+			}
+		case "consumer_group":
+			if len(subs) > 0 {
+				return fmt.Errorf("'consumer_group' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ConsumerGroup = src.ConsumerGroup
+			} else {
+				var zero string
+				dst.ConsumerGroup = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -702,3 +1320,94 @@ func (dst *ApplicationPubSub_Message) SetFields(src *ApplicationPubSub_Message,
 	}
 	return nil
 }
+
+func (dst *ApplicationPubSubStatus) SetFields(src *ApplicationPubSubStatus, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				newDst := &dst.IDs
+				var newSrc *ApplicationPubSubIdentifiers
+				if src != nil {
+					newSrc = &src.IDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.IDs = src.IDs
+				} else {
+					var zero ApplicationPubSubIdentifiers
+					dst.IDs = zero
+				}
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				var zero ApplicationPubSubStatus_State
+				dst.State = zero
+			}
+		case "state_changed_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state_changed_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StateChangedAt = src.StateChangedAt
+			} else {
+				dst.StateChangedAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+		case "last_error_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_error_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastErrorAt = src.LastErrorAt
+			} else {
+				dst.LastErrorAt = nil
+			}
+		case "last_published_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_published_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastPublishedAt = src.LastPublishedAt
+			} else {
+				dst.LastPublishedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
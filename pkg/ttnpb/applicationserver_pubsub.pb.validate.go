@@ -343,6 +343,27 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "f_ports":
+
+			if len(m.GetFPorts()) > 255 {
+				return ApplicationPubSubValidationError{
+					field:  "f_ports",
+					reason: "value must contain no more than 255 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetFPorts() {
+				_, _ = idx, item
+
+				if item > 255 {
+					return ApplicationPubSubValidationError{
+						field:  fmt.Sprintf("f_ports[%v]", idx),
+						reason: "value must be less than or equal to 255",
+					}
+				}
+
+			}
+
 		case "provider":
 			if m.Provider == nil {
 				return ApplicationPubSubValidationError{
@@ -352,7 +373,7 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 			}
 			if len(subs) == 0 {
 				subs = []string{
-					"nats", "mqtt",
+					"nats", "mqtt", "kafka", "amqp", "aws_iot", "azure", "redis",
 				}
 			}
 			for name, subs := range _processPaths(subs) {
@@ -390,8 +411,100 @@ func (m *ApplicationPubSub) ValidateFields(paths ...string) error {
 						}
 					}
 
+				case "kafka":
+					w, ok := m.Provider.(*ApplicationPubSub_Kafka)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetKafka()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "kafka",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "amqp":
+					w, ok := m.Provider.(*ApplicationPubSub_AMQP)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetAMQP()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "amqp",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "aws_iot":
+					w, ok := m.Provider.(*ApplicationPubSub_AWSIoT)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetAWSIoT()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "aws_iot",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "azure":
+					w, ok := m.Provider.(*ApplicationPubSub_Azure)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetAzure()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "azure",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "redis":
+					w, ok := m.Provider.(*ApplicationPubSub_Redis)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRedis()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ApplicationPubSubValidationError{
+								field:  "redis",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		case "up_filter":
+
+			if v, ok := interface{}(m.GetUpFilter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubValidationError{
+						field:  "up_filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
+
 		default:
 			return ApplicationPubSubValidationError{
 				field:  name,
@@ -990,6 +1103,26 @@ func (m *ApplicationPubSub_NATSProvider) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "jetstream":
+			// no validation rules for JetStream
+		case "stream":
+
+			if utf8.RuneCountInString(m.GetStream()) > 100 {
+				return ApplicationPubSub_NATSProviderValidationError{
+					field:  "stream",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "durable_prefix":
+
+			if utf8.RuneCountInString(m.GetDurablePrefix()) > 100 {
+				return ApplicationPubSub_NATSProviderValidationError{
+					field:  "durable_prefix",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
 		default:
 			return ApplicationPubSub_NATSProviderValidationError{
 				field:  name,
@@ -1126,6 +1259,39 @@ func (m *ApplicationPubSub_MQTTProvider) ValidateFields(paths ...string) error {
 			// no validation rules for TLSClientCert
 		case "tls_client_key":
 			// no validation rules for TLSClientKey
+		case "protocol_version":
+
+			if _, ok := ApplicationPubSub_MQTTProvider_ProtocolVersion_name[int32(m.GetProtocolVersion())]; !ok {
+				return ApplicationPubSub_MQTTProviderValidationError{
+					field:  "protocol_version",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "session_expiry_interval":
+
+			if v, ok := interface{}(m.GetSessionExpiryInterval()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSub_MQTTProviderValidationError{
+						field:  "session_expiry_interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "message_expiry_interval":
+
+			if v, ok := interface{}(m.GetMessageExpiryInterval()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSub_MQTTProviderValidationError{
+						field:  "message_expiry_interval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationPubSub_MQTTProviderValidationError{
 				field:  name,
@@ -1193,32 +1359,79 @@ var _ interface {
 	ErrorName() string
 } = ApplicationPubSub_MQTTProviderValidationError{}
 
-// ValidateFields checks the field values on ApplicationPubSub_Message with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *ApplicationPubSub_Message) ValidateFields(paths ...string) error {
+// ValidateFields checks the field values on ApplicationPubSub_KafkaProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_KafkaProvider) ValidateFields(paths ...string) error {
 	if m == nil {
 		return nil
 	}
 
 	if len(paths) == 0 {
-		paths = ApplicationPubSub_MessageFieldPathsNested
+		paths = ApplicationPubSub_KafkaProviderFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		_ = subs
 		switch name {
-		case "topic":
+		case "brokers":
 
-			if utf8.RuneCountInString(m.GetTopic()) > 100 {
-				return ApplicationPubSub_MessageValidationError{
-					field:  "topic",
+			if len(m.GetBrokers()) < 1 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "brokers",
+					reason: "value must contain at least 1 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetBrokers() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 256 {
+					return ApplicationPubSub_KafkaProviderValidationError{
+						field:  fmt.Sprintf("brokers[%v]", idx),
+						reason: "value length must be at most 256 runes",
+					}
+				}
+
+			}
+
+		case "use_tls":
+			// no validation rules for UseTLS
+		case "tls_ca":
+			// no validation rules for TLSCA
+		case "tls_client_cert":
+			// no validation rules for TLSClientCert
+		case "tls_client_key":
+			// no validation rules for TLSClientKey
+		case "sasl_mechanism":
+
+			if _, ok := ApplicationPubSub_KafkaProvider_SASLMechanism_name[int32(m.GetSASLMechanism())]; !ok {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "sasl_mechanism",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "sasl_username":
+
+			if utf8.RuneCountInString(m.GetSASLUsername()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "sasl_username",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		case "sasl_password":
+
+			if utf8.RuneCountInString(m.GetSASLPassword()) > 100 {
+				return ApplicationPubSub_KafkaProviderValidationError{
+					field:  "sasl_password",
 					reason: "value length must be at most 100 runes",
 				}
 			}
 
 		default:
-			return ApplicationPubSub_MessageValidationError{
+			return ApplicationPubSub_KafkaProviderValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1227,10 +1440,10 @@ func (m *ApplicationPubSub_Message) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// ApplicationPubSub_MessageValidationError is the validation error returned by
-// ApplicationPubSub_Message.ValidateFields if the designated constraints
-// aren't met.
-type ApplicationPubSub_MessageValidationError struct {
+// ApplicationPubSub_KafkaProviderValidationError is the validation error
+// returned by ApplicationPubSub_KafkaProvider.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationPubSub_KafkaProviderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1238,24 +1451,24 @@ type ApplicationPubSub_MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e ApplicationPubSub_MessageValidationError) Field() string { return e.field }
+func (e ApplicationPubSub_KafkaProviderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ApplicationPubSub_MessageValidationError) Reason() string { return e.reason }
+func (e ApplicationPubSub_KafkaProviderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ApplicationPubSub_MessageValidationError) Cause() error { return e.cause }
+func (e ApplicationPubSub_KafkaProviderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ApplicationPubSub_MessageValidationError) Key() bool { return e.key }
+func (e ApplicationPubSub_KafkaProviderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ApplicationPubSub_MessageValidationError) ErrorName() string {
-	return "ApplicationPubSub_MessageValidationError"
+func (e ApplicationPubSub_KafkaProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_KafkaProviderValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ApplicationPubSub_MessageValidationError) Error() string {
+func (e ApplicationPubSub_KafkaProviderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1267,14 +1480,14 @@ func (e ApplicationPubSub_MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApplicationPubSub_Message.%s: %s%s",
+		"invalid %sApplicationPubSub_KafkaProvider.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ApplicationPubSub_MessageValidationError{}
+var _ error = ApplicationPubSub_KafkaProviderValidationError{}
 
 var _ interface {
 	Field() string
@@ -1282,4 +1495,768 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ApplicationPubSub_MessageValidationError{}
+} = ApplicationPubSub_KafkaProviderValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSub_AMQPProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_AMQPProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_AMQPProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "server_url":
+
+			if uri, err := url.Parse(m.GetServerURL()); err != nil {
+				return ApplicationPubSub_AMQPProviderValidationError{
+					field:  "server_url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return ApplicationPubSub_AMQPProviderValidationError{
+					field:  "server_url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "use_tls":
+			// no validation rules for UseTLS
+		case "tls_ca":
+			// no validation rules for TLSCA
+		case "tls_client_cert":
+			// no validation rules for TLSClientCert
+		case "tls_client_key":
+			// no validation rules for TLSClientKey
+		case "exchange":
+
+			if utf8.RuneCountInString(m.GetExchange()) > 100 {
+				return ApplicationPubSub_AMQPProviderValidationError{
+					field:  "exchange",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_AMQPProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_AMQPProviderValidationError is the validation error
+// returned by ApplicationPubSub_AMQPProvider.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationPubSub_AMQPProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_AMQPProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_AMQPProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_AMQPProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_AMQPProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_AMQPProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_AMQPProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_AMQPProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_AMQPProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_AMQPProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_AMQPProviderValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSub_AWSIoTProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_AWSIoTProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_AWSIoTProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "endpoint":
+
+			if utf8.RuneCountInString(m.GetEndpoint()) > 256 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "endpoint",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		case "region":
+
+			if utf8.RuneCountInString(m.GetRegion()) > 32 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "region",
+					reason: "value length must be at most 32 runes",
+				}
+			}
+
+		case "thing_name":
+
+			if utf8.RuneCountInString(m.GetThingName()) > 128 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "thing_name",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "authentication_method":
+
+			if _, ok := ApplicationPubSub_AWSIoTProvider_AuthenticationMethod_name[int32(m.GetAuthenticationMethod())]; !ok {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "authentication_method",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "tls_ca":
+			// no validation rules for TLSCA
+		case "tls_client_cert":
+			// no validation rules for TLSClientCert
+		case "tls_client_key":
+			// no validation rules for TLSClientKey
+		case "access_key_id":
+
+			if utf8.RuneCountInString(m.GetAccessKeyID()) > 128 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "access_key_id",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "secret_access_key":
+
+			if utf8.RuneCountInString(m.GetSecretAccessKey()) > 128 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "secret_access_key",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "session_token":
+
+			if utf8.RuneCountInString(m.GetSessionToken()) > 2048 {
+				return ApplicationPubSub_AWSIoTProviderValidationError{
+					field:  "session_token",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_AWSIoTProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_AWSIoTProviderValidationError is the validation error
+// returned by ApplicationPubSub_AWSIoTProvider.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationPubSub_AWSIoTProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_AWSIoTProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_AWSIoTProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_AWSIoTProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_AWSIoTProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_AWSIoTProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_AWSIoTProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_AWSIoTProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_AWSIoTProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_AWSIoTProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_AWSIoTProviderValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSub_AzureProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_AzureProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_AzureProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "authentication_method":
+
+			if _, ok := ApplicationPubSub_AzureProvider_AuthenticationMethod_name[int32(m.GetAuthenticationMethod())]; !ok {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "authentication_method",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "event_hubs_connection_string":
+
+			if utf8.RuneCountInString(m.GetEventHubsConnectionString()) > 1024 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "event_hubs_connection_string",
+					reason: "value length must be at most 1024 runes",
+				}
+			}
+
+		case "service_bus_connection_string":
+
+			if utf8.RuneCountInString(m.GetServiceBusConnectionString()) > 1024 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "service_bus_connection_string",
+					reason: "value length must be at most 1024 runes",
+				}
+			}
+
+		case "event_hubs_namespace":
+
+			if utf8.RuneCountInString(m.GetEventHubsNamespace()) > 64 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "event_hubs_namespace",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "service_bus_namespace":
+
+			if utf8.RuneCountInString(m.GetServiceBusNamespace()) > 64 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "service_bus_namespace",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "tenant_id":
+
+			if utf8.RuneCountInString(m.GetTenantID()) > 128 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "tenant_id",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "client_id":
+
+			if utf8.RuneCountInString(m.GetClientID()) > 128 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "client_id",
+					reason: "value length must be at most 128 runes",
+				}
+			}
+
+		case "client_secret":
+
+			if utf8.RuneCountInString(m.GetClientSecret()) > 256 {
+				return ApplicationPubSub_AzureProviderValidationError{
+					field:  "client_secret",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_AzureProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_AzureProviderValidationError is the validation error
+// returned by ApplicationPubSub_AzureProvider.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationPubSub_AzureProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_AzureProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_AzureProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_AzureProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_AzureProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_AzureProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_AzureProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_AzureProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_AzureProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_AzureProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_AzureProviderValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSub_RedisProvider
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationPubSub_RedisProvider) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_RedisProviderFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "server_url":
+
+			if uri, err := url.Parse(m.GetServerURL()); err != nil {
+				return ApplicationPubSub_RedisProviderValidationError{
+					field:  "server_url",
+					reason: "value must be a valid URI",
+					cause:  err,
+				}
+			} else if !uri.IsAbs() {
+				return ApplicationPubSub_RedisProviderValidationError{
+					field:  "server_url",
+					reason: "value must be absolute",
+				}
+			}
+
+		case "stream_max_length":
+			// no validation rules for StreamMaxLength
+		case "approximate_trimming":
+			// no validation rules for ApproximateTrimming
+		case "consumer_group":
+
+			if utf8.RuneCountInString(m.GetConsumerGroup()) > 100 {
+				return ApplicationPubSub_RedisProviderValidationError{
+					field:  "consumer_group",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_RedisProviderValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_RedisProviderValidationError is the validation error
+// returned by ApplicationPubSub_RedisProvider.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationPubSub_RedisProviderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_RedisProviderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_RedisProviderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_RedisProviderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_RedisProviderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_RedisProviderValidationError) ErrorName() string {
+	return "ApplicationPubSub_RedisProviderValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_RedisProviderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_RedisProvider.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_RedisProviderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_RedisProviderValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSub_Message with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationPubSub_Message) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSub_MessageFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "topic":
+
+			if utf8.RuneCountInString(m.GetTopic()) > 100 {
+				return ApplicationPubSub_MessageValidationError{
+					field:  "topic",
+					reason: "value length must be at most 100 runes",
+				}
+			}
+
+		default:
+			return ApplicationPubSub_MessageValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSub_MessageValidationError is the validation error returned by
+// ApplicationPubSub_Message.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationPubSub_MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSub_MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSub_MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSub_MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSub_MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSub_MessageValidationError) ErrorName() string {
+	return "ApplicationPubSub_MessageValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSub_MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSub_Message.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSub_MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSub_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSubStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationPubSubStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSubStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if v, ok := interface{}(&m.IDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "state":
+			// no validation rules for State
+		case "state_changed_at":
+
+			if v, ok := interface{}(m.GetStateChangedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "state_changed_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error_at":
+
+			if v, ok := interface{}(m.GetLastErrorAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "last_error_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_published_at":
+
+			if v, ok := interface{}(m.GetLastPublishedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "last_published_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationPubSubStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSubStatusValidationError is the validation error returned by
+// ApplicationPubSubStatus.ValidateFields if the designated constraints aren't
+// met.
+type ApplicationPubSubStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSubStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSubStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSubStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSubStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSubStatusValidationError) ErrorName() string {
+	return "ApplicationPubSubStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSubStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSubStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSubStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSubStatusValidationError{}
@@ -492,17 +492,22 @@ type ApplicationWebhook struct {
 	// The ID of the template that was used to create the Webhook.
 	*ApplicationWebhookTemplateIdentifiers `protobuf:"bytes,15,opt,name=template_ids,json=templateIds,proto3,embedded=template_ids" json:"template_ids,omitempty"`
 	// The value of the fields used by the template. Maps field.id to the value.
-	TemplateFields       map[string]string           `protobuf:"bytes,16,rep,name=template_fields,json=templateFields,proto3" json:"template_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	UplinkMessage        *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
-	JoinAccept           *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
-	DownlinkAck          *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
-	DownlinkNack         *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
-	DownlinkSent         *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
-	DownlinkFailed       *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
-	DownlinkQueued       *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
-	LocationSolved       *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	TemplateFields map[string]string           `protobuf:"bytes,16,rep,name=template_fields,json=templateFields,proto3" json:"template_fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UplinkMessage  *ApplicationWebhook_Message `protobuf:"bytes,7,opt,name=uplink_message,json=uplinkMessage,proto3" json:"uplink_message,omitempty"`
+	JoinAccept     *ApplicationWebhook_Message `protobuf:"bytes,8,opt,name=join_accept,json=joinAccept,proto3" json:"join_accept,omitempty"`
+	DownlinkAck    *ApplicationWebhook_Message `protobuf:"bytes,9,opt,name=downlink_ack,json=downlinkAck,proto3" json:"downlink_ack,omitempty"`
+	DownlinkNack   *ApplicationWebhook_Message `protobuf:"bytes,10,opt,name=downlink_nack,json=downlinkNack,proto3" json:"downlink_nack,omitempty"`
+	DownlinkSent   *ApplicationWebhook_Message `protobuf:"bytes,11,opt,name=downlink_sent,json=downlinkSent,proto3" json:"downlink_sent,omitempty"`
+	DownlinkFailed *ApplicationWebhook_Message `protobuf:"bytes,12,opt,name=downlink_failed,json=downlinkFailed,proto3" json:"downlink_failed,omitempty"`
+	DownlinkQueued *ApplicationWebhook_Message `protobuf:"bytes,13,opt,name=downlink_queued,json=downlinkQueued,proto3" json:"downlink_queued,omitempty"`
+	LocationSolved *ApplicationWebhook_Message `protobuf:"bytes,14,opt,name=location_solved,json=locationSolved,proto3" json:"location_solved,omitempty"`
+	// Filter of the upstream messages that are sent. If not set, all messages are sent.
+	UpFilter *ApplicationUpFilter `protobuf:"bytes,17,opt,name=up_filter,json=upFilter,proto3" json:"up_filter,omitempty"`
+	// The content encoding to compress the body with, for example gzip.
+	// If empty, the body is not compressed.
+	ContentEncoding      string   `protobuf:"bytes,18,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ApplicationWebhook) Reset()      { *m = ApplicationWebhook{} }
@@ -635,6 +640,20 @@ func (m *ApplicationWebhook) GetLocationSolved() *ApplicationWebhook_Message {
 	return nil
 }
 
+func (m *ApplicationWebhook) GetUpFilter() *ApplicationUpFilter {
+	if m != nil {
+		return m.UpFilter
+	}
+	return nil
+}
+
+func (m *ApplicationWebhook) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ""
+}
+
 type ApplicationWebhook_Message struct {
 	// Path to append to the base URL.
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
@@ -772,6 +791,143 @@ func (m *ApplicationWebhookFormats) GetFormats() map[string]string {
 	return nil
 }
 
+// ApplicationWebhookDelivery is an attempt to deliver a message to a webhook.
+type ApplicationWebhookDelivery struct {
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// URL to which the message was sent.
+	URL string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// HTTP status code of the response. Zero if no response was received.
+	StatusCode uint32 `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Time between sending the request and receiving the response.
+	Latency time.Duration `protobuf:"bytes,4,opt,name=latency,proto3,stdduration" json:"latency"`
+	// Body of the response, truncated to 1 KiB.
+	Response string `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	// Error of the request, if the request failed.
+	Error                string   `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationWebhookDelivery) Reset()      { *m = ApplicationWebhookDelivery{} }
+func (*ApplicationWebhookDelivery) ProtoMessage() {}
+func (*ApplicationWebhookDelivery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{8}
+}
+func (m *ApplicationWebhookDelivery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDelivery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDelivery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDelivery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDelivery.Merge(m, src)
+}
+func (m *ApplicationWebhookDelivery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDelivery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDelivery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDelivery proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDelivery) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ApplicationWebhookDelivery) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *ApplicationWebhookDelivery) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *ApplicationWebhookDelivery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ApplicationWebhookDeliveries struct {
+	// Delivery attempts, most recent first.
+	Deliveries           []*ApplicationWebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ApplicationWebhookDeliveries) Reset()      { *m = ApplicationWebhookDeliveries{} }
+func (*ApplicationWebhookDeliveries) ProtoMessage() {}
+func (*ApplicationWebhookDeliveries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2652f2d8eaceda0e, []int{9}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationWebhookDeliveries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationWebhookDeliveries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationWebhookDeliveries.Merge(m, src)
+}
+func (m *ApplicationWebhookDeliveries) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationWebhookDeliveries) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationWebhookDeliveries.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationWebhookDeliveries proto.InternalMessageInfo
+
+func (m *ApplicationWebhookDeliveries) GetDeliveries() []*ApplicationWebhookDelivery {
+	if m != nil {
+		return m.Deliveries
+	}
+	return nil
+}
+
 type GetApplicationWebhookRequest struct {
 	ApplicationWebhookIdentifiers `protobuf:"bytes,1,opt,name=ids,proto3,embedded=ids" json:"ids"`
 	FieldMask                     types.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
@@ -782,7 +938,7 @@ type GetApplicationWebhookRequest struct {
 func (m *GetApplicationWebhookRequest) Reset()      { *m = GetApplicationWebhookRequest{} }
 func (*GetApplicationWebhookRequest) ProtoMessage() {}
 func (*GetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{8}
+	return fileDescriptor_2652f2d8eaceda0e, []int{10}
 }
 func (m *GetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,7 +984,7 @@ type ListApplicationWebhooksRequest struct {
 func (m *ListApplicationWebhooksRequest) Reset()      { *m = ListApplicationWebhooksRequest{} }
 func (*ListApplicationWebhooksRequest) ProtoMessage() {}
 func (*ListApplicationWebhooksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{9}
+	return fileDescriptor_2652f2d8eaceda0e, []int{11}
 }
 func (m *ListApplicationWebhooksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -874,7 +1030,7 @@ type SetApplicationWebhookRequest struct {
 func (m *SetApplicationWebhookRequest) Reset()      { *m = SetApplicationWebhookRequest{} }
 func (*SetApplicationWebhookRequest) ProtoMessage() {}
 func (*SetApplicationWebhookRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{10}
+	return fileDescriptor_2652f2d8eaceda0e, []int{12}
 }
 func (m *SetApplicationWebhookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -920,7 +1076,7 @@ type GetApplicationWebhookTemplateRequest struct {
 func (m *GetApplicationWebhookTemplateRequest) Reset()      { *m = GetApplicationWebhookTemplateRequest{} }
 func (*GetApplicationWebhookTemplateRequest) ProtoMessage() {}
 func (*GetApplicationWebhookTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{11}
+	return fileDescriptor_2652f2d8eaceda0e, []int{13}
 }
 func (m *GetApplicationWebhookTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -967,7 +1123,7 @@ func (m *ListApplicationWebhookTemplatesRequest) Reset() {
 }
 func (*ListApplicationWebhookTemplatesRequest) ProtoMessage() {}
 func (*ListApplicationWebhookTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2652f2d8eaceda0e, []int{12}
+	return fileDescriptor_2652f2d8eaceda0e, []int{14}
 }
 func (m *ListApplicationWebhookTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,6 +1188,10 @@ func init() {
 	golang_proto.RegisterType((*ApplicationWebhookFormats)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats")
 	proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
 	golang_proto.RegisterMapType((map[string]string)(nil), "ttn.lorawan.v3.ApplicationWebhookFormats.FormatsEntry")
+	proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	golang_proto.RegisterType((*ApplicationWebhookDelivery)(nil), "ttn.lorawan.v3.ApplicationWebhookDelivery")
+	proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
+	golang_proto.RegisterType((*ApplicationWebhookDeliveries)(nil), "ttn.lorawan.v3.ApplicationWebhookDeliveries")
 	proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
 	golang_proto.RegisterType((*GetApplicationWebhookRequest)(nil), "ttn.lorawan.v3.GetApplicationWebhookRequest")
 	proto.RegisterType((*ListApplicationWebhooksRequest)(nil), "ttn.lorawan.v3.ListApplicationWebhooksRequest")
@@ -1052,116 +1212,130 @@ func init() {
 }
 
 var fileDescriptor_2652f2d8eaceda0e = []byte{
-	// 1729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6c, 0xdb, 0xc8,
-	0x15, 0xe6, 0x48, 0xb2, 0x65, 0x8d, 0xfc, 0xb7, 0xe3, 0xec, 0x96, 0x95, 0x1d, 0xda, 0xe0, 0xba,
-	0xbb, 0xb6, 0x6b, 0x51, 0x85, 0x77, 0xd3, 0x76, 0x8d, 0x76, 0x0d, 0xab, 0xde, 0x78, 0xdd, 0x26,
-	0xbb, 0x35, 0xb5, 0xde, 0xc5, 0x6e, 0xb0, 0x11, 0x68, 0x71, 0x24, 0xb3, 0xa2, 0x48, 0x86, 0x1c,
-	0xd9, 0x75, 0x03, 0xa3, 0x41, 0x4f, 0x41, 0x2f, 0x0d, 0x9a, 0x43, 0x7b, 0x28, 0x8a, 0x20, 0xbd,
-	0xa4, 0xa7, 0x06, 0x3d, 0xe5, 0x18, 0x14, 0x3d, 0xe4, 0x18, 0xa0, 0x87, 0xe6, 0xe4, 0xc6, 0x52,
-	0x0f, 0x39, 0x15, 0x39, 0x06, 0x3e, 0x15, 0x1c, 0x0e, 0x25, 0xea, 0xc7, 0x31, 0x25, 0x27, 0x7b,
-	0x12, 0x47, 0xf3, 0xde, 0xf7, 0xbe, 0xf7, 0xe6, 0xcd, 0x37, 0x43, 0xc2, 0xb4, 0x6e, 0xda, 0xca,
-	0x9e, 0x62, 0xa4, 0x1d, 0xa2, 0x14, 0xca, 0x19, 0xc5, 0xd2, 0x32, 0x8a, 0x65, 0xe9, 0x5a, 0x41,
-	0x21, 0x9a, 0x69, 0x38, 0xd8, 0xde, 0xc5, 0x76, 0x7e, 0x0f, 0x6f, 0x4b, 0x96, 0x6d, 0x12, 0x13,
-	0x8d, 0x12, 0x62, 0x48, 0xcc, 0x45, 0xda, 0x7d, 0x2f, 0xb5, 0x5a, 0xd2, 0xc8, 0x4e, 0x75, 0x5b,
-	0x2a, 0x98, 0x95, 0x0c, 0x36, 0x76, 0xcd, 0x7d, 0xcb, 0x36, 0x7f, 0xb9, 0x9f, 0xa1, 0xc6, 0x85,
-	0x74, 0x09, 0x1b, 0xe9, 0x5d, 0x45, 0xd7, 0x54, 0x85, 0xe0, 0x4c, 0xc7, 0x83, 0x07, 0x99, 0x4a,
-	0x07, 0x20, 0x4a, 0x66, 0xc9, 0xf4, 0x9c, 0xb7, 0xab, 0x45, 0x3a, 0xa2, 0x03, 0xfa, 0xc4, 0xcc,
-	0xa7, 0x4a, 0xa6, 0x59, 0xd2, 0xb1, 0xc7, 0xd4, 0x30, 0x4c, 0xe2, 0x11, 0x65, 0xb3, 0x93, 0x6c,
-	0xb6, 0x81, 0x81, 0x2b, 0x16, 0xd9, 0x67, 0x93, 0x33, 0xed, 0x93, 0x45, 0x0d, 0xeb, 0x6a, 0xbe,
-	0xa2, 0x38, 0x65, 0x66, 0x31, 0xdd, 0x6e, 0x41, 0xb4, 0x0a, 0x76, 0x88, 0x52, 0xb1, 0x98, 0xc1,
-	0xdb, 0x9d, 0xe5, 0xd2, 0x54, 0x6c, 0x10, 0xad, 0xa8, 0x61, 0x9b, 0x91, 0x10, 0xff, 0x0d, 0xe0,
-	0xf9, 0xd5, 0x66, 0x11, 0xbf, 0xc0, 0xdb, 0x3b, 0xa6, 0x59, 0xde, 0x68, 0xda, 0x21, 0x05, 0x8e,
-	0x05, 0xaa, 0x9c, 0xd7, 0x54, 0x87, 0x07, 0x33, 0x60, 0x2e, 0xb9, 0xf4, 0x8e, 0xd4, 0x5a, 0x60,
-	0x29, 0x80, 0x13, 0x00, 0xc8, 0x8e, 0x1f, 0x67, 0x07, 0x7e, 0x0b, 0x22, 0xe3, 0xe0, 0xd1, 0xe1,
-	0x34, 0xf7, 0xf8, 0x70, 0x1a, 0xc8, 0xa3, 0x4a, 0xd0, 0xd2, 0x41, 0x39, 0x08, 0xf7, 0xbc, 0xc0,
-	0x79, 0x4d, 0xe5, 0x23, 0x33, 0x60, 0x2e, 0x91, 0x7d, 0xff, 0x38, 0x3b, 0x6b, 0x8b, 0xfc, 0xec,
-	0x92, 0x70, 0xf5, 0x8a, 0x92, 0xfe, 0xd5, 0xf7, 0xd2, 0x1f, 0x7c, 0x3d, 0xb7, 0xb2, 0x7c, 0x25,
-	0xfd, 0xf5, 0x8a, 0x3f, 0x9c, 0xbf, 0xbe, 0xb4, 0x78, 0x30, 0x5b, 0x3b, 0x9c, 0x4e, 0xf8, 0xac,
-	0xd7, 0xe4, 0xc4, 0x9e, 0x9f, 0x80, 0xf8, 0x6b, 0xf8, 0x9d, 0xce, 0xc4, 0x3e, 0xc3, 0x15, 0x4b,
-	0x57, 0x08, 0x0e, 0x26, 0xf8, 0x39, 0x4c, 0x12, 0xf6, 0xb7, 0x1b, 0x1e, 0xd0, 0xf0, 0x17, 0xc2,
-	0x87, 0x87, 0x0d, 0xd0, 0x35, 0x19, 0x92, 0x46, 0x00, 0xf1, 0x7f, 0x00, 0x4e, 0x9f, 0xcc, 0xe0,
-	0xa2, 0xbb, 0x9e, 0xe8, 0xc7, 0x30, 0xd2, 0x08, 0x99, 0x0e, 0x1f, 0x32, 0xb2, 0xb1, 0x26, 0x47,
-	0x34, 0x15, 0x4d, 0xc2, 0x98, 0xa1, 0x54, 0x30, 0x2b, 0x59, 0xfc, 0x38, 0x1b, 0xb3, 0x23, 0xfc,
-	0x39, 0x99, 0xfe, 0x89, 0xe6, 0x61, 0x52, 0xc5, 0x4e, 0xc1, 0xd6, 0x2c, 0x37, 0x3c, 0x1f, 0x0d,
-	0xda, 0xa8, 0x72, 0x70, 0x0e, 0xbd, 0x05, 0x07, 0x1d, 0x5c, 0xb0, 0x31, 0xe1, 0x63, 0x33, 0x60,
-	0x6e, 0x48, 0x66, 0x23, 0xb4, 0x08, 0x47, 0x54, 0x5c, 0x54, 0xaa, 0x3a, 0xc9, 0xef, 0x2a, 0x7a,
-	0x15, 0xf3, 0x03, 0xad, 0x20, 0xc3, 0x6c, 0xf6, 0x73, 0x77, 0x52, 0xbc, 0x9b, 0x84, 0xa9, 0x93,
-	0x13, 0x46, 0x5f, 0xc2, 0x68, 0xb3, 0x79, 0x2e, 0xbc, 0xa4, 0x79, 0x4e, 0x5e, 0xab, 0x2e, 0xbd,
-	0xe4, 0x62, 0xbe, 0xb2, 0x3a, 0x48, 0x70, 0x48, 0x37, 0x4b, 0x66, 0xbe, 0x6a, 0xeb, 0xb4, 0x12,
-	0x89, 0xec, 0xc4, 0x71, 0x76, 0xc0, 0x8e, 0xde, 0x04, 0xa0, 0x76, 0x38, 0x1d, 0xbf, 0x64, 0x96,
-	0xcc, 0x2d, 0xf9, 0x92, 0x1c, 0x77, 0x8d, 0xb6, 0x6c, 0xdd, 0xb5, 0xd7, 0x8c, 0xa2, 0x67, 0x3f,
-	0xd0, 0x69, 0xbf, 0x61, 0x14, 0x3d, 0x7b, 0xd7, 0xc8, 0xb5, 0xdf, 0x80, 0x6f, 0xa8, 0x66, 0xa1,
-	0x5a, 0xc1, 0x86, 0x27, 0x05, 0xd4, 0x71, 0x90, 0x3a, 0x4e, 0x05, 0x1c, 0xc7, 0xd7, 0x82, 0x46,
-	0x2e, 0xc2, 0x78, 0x8b, 0x1b, 0x0b, 0xbd, 0xad, 0x38, 0x98, 0x22, 0xc4, 0x3b, 0x43, 0x67, 0x15,
-	0x07, 0xd3, 0xd0, 0xae, 0x91, 0x6b, 0xbf, 0x09, 0xe3, 0x3b, 0x58, 0x51, 0xb1, 0xed, 0xf0, 0x43,
-	0x33, 0xd1, 0xb9, 0xe4, 0xd2, 0x0f, 0xc2, 0xaf, 0x80, 0xf4, 0xb1, 0xe7, 0xf9, 0x91, 0x41, 0xec,
-	0x7d, 0xd9, 0xc7, 0x41, 0x2b, 0x70, 0xb0, 0x68, 0xda, 0x15, 0x85, 0xf0, 0x09, 0x4a, 0xe0, 0x5d,
-	0xaf, 0x81, 0xcf, 0x9d, 0xd6, 0xc0, 0x32, 0x73, 0x43, 0xeb, 0x70, 0x90, 0xca, 0x9a, 0xc3, 0x43,
-	0x4a, 0x29, 0x13, 0x9e, 0x12, 0xdd, 0x3e, 0x32, 0x73, 0x47, 0x5f, 0xc2, 0xd1, 0xaa, 0xa5, 0x6b,
-	0x46, 0x39, 0x5f, 0xc1, 0x8e, 0xa3, 0x94, 0x30, 0x9f, 0xa4, 0x5d, 0xb6, 0xd4, 0x43, 0x8e, 0x97,
-	0x3d, 0x4f, 0x79, 0xc4, 0x43, 0x62, 0x43, 0x94, 0x83, 0xc9, 0x5f, 0x98, 0x9a, 0x91, 0x57, 0x0a,
-	0x05, 0x6c, 0x11, 0x7e, 0xb8, 0x6f, 0x5c, 0xe8, 0xc2, 0xac, 0x52, 0x14, 0xb4, 0x05, 0x87, 0x55,
-	0x73, 0xcf, 0xa0, 0x8c, 0x95, 0x42, 0x99, 0x1f, 0xe9, 0x1b, 0x35, 0xe9, 0xe3, 0xac, 0x16, 0xca,
-	0xe8, 0x0b, 0x38, 0xd2, 0x80, 0x35, 0x5c, 0xdc, 0xd1, 0xbe, 0x71, 0x1b, 0xfc, 0x3e, 0x51, 0xda,
-	0x80, 0x1d, 0x6c, 0x10, 0x7e, 0xec, 0xec, 0xc0, 0x39, 0x6c, 0x10, 0x74, 0x05, 0x8e, 0x35, 0x80,
-	0x8b, 0x8a, 0xa6, 0x63, 0x95, 0x1f, 0xef, 0x1b, 0x7a, 0xd4, 0x87, 0xba, 0x48, 0x91, 0x5a, 0xc0,
-	0xaf, 0x55, 0x71, 0x15, 0xab, 0xfc, 0x1b, 0x67, 0x07, 0xdf, 0xa4, 0x48, 0x2e, 0xb8, 0x6e, 0xb2,
-	0x33, 0xd1, 0x31, 0xf5, 0x5d, 0xac, 0xf2, 0xa8, 0x7f, 0x70, 0x1f, 0x2a, 0x47, 0x91, 0x52, 0xcb,
-	0x70, 0x38, 0xb8, 0xe5, 0xd0, 0x38, 0x8c, 0x96, 0xf1, 0xbe, 0x77, 0x4e, 0xc8, 0xee, 0x23, 0x3a,
-	0x07, 0x07, 0x3c, 0x45, 0xa6, 0x92, 0x27, 0x7b, 0x83, 0xe5, 0xc8, 0x0f, 0x41, 0xea, 0x3c, 0x8c,
-	0xfb, 0xbd, 0x8b, 0x60, 0xcc, 0x52, 0xc8, 0x0e, 0xf3, 0xa3, 0xcf, 0x62, 0x09, 0x4e, 0x9e, 0x4c,
-	0xc8, 0x41, 0x1f, 0xc3, 0x84, 0x7f, 0x84, 0xb9, 0x52, 0xed, 0xee, 0xca, 0x85, 0xf0, 0x09, 0xc9,
-	0x4d, 0x67, 0xf1, 0x4f, 0x49, 0x88, 0x3a, 0x2d, 0xd1, 0x66, 0xf0, 0x14, 0x48, 0x9f, 0x0e, 0x1d,
-	0x42, 0xfd, 0x7f, 0x02, 0x61, 0xc1, 0xc6, 0x0a, 0xc1, 0x6a, 0x5e, 0x21, 0xb4, 0x20, 0xc9, 0xa5,
-	0x94, 0xe4, 0x5d, 0x8f, 0x24, 0xff, 0x7a, 0x24, 0x7d, 0xe6, 0x5f, 0x8f, 0xb2, 0x43, 0xae, 0xfb,
-	0xad, 0xff, 0x4c, 0x03, 0x39, 0xc1, 0xfc, 0x56, 0x89, 0x0b, 0x52, 0xb5, 0x54, 0x1f, 0x24, 0xda,
-	0x0b, 0x08, 0xf3, 0x5b, 0x25, 0x2d, 0xa2, 0x1c, 0x0b, 0x21, 0xca, 0x1b, 0x4d, 0x51, 0x1e, 0x08,
-	0xab, 0x80, 0xa7, 0x8a, 0xf1, 0x60, 0x7f, 0x62, 0x7c, 0x15, 0x0e, 0x07, 0xae, 0x41, 0x0e, 0xdb,
-	0xe2, 0x7d, 0x9e, 0xd3, 0x31, 0xba, 0x3a, 0xc9, 0xe6, 0x6d, 0xc8, 0x41, 0x79, 0x38, 0xd6, 0xc0,
-	0x67, 0xaa, 0x3f, 0x4e, 0x73, 0xfe, 0x7e, 0x88, 0x9c, 0x5b, 0x64, 0x9f, 0xa5, 0x3e, 0x4a, 0x5a,
-	0xfe, 0x44, 0x9b, 0x1d, 0x87, 0x40, 0x9c, 0xa6, 0x10, 0xa2, 0x7f, 0x4f, 0x12, 0xff, 0x9f, 0xb5,
-	0x8a, 0xff, 0x50, 0xcf, 0x78, 0x41, 0xd1, 0xbf, 0xdc, 0x26, 0xfa, 0x89, 0x9e, 0xd1, 0x5a, 0xc4,
-	0xfe, 0xd3, 0x76, 0xb1, 0x87, 0x3d, 0xe3, 0xb5, 0x8a, 0xfc, 0xa7, 0xed, 0x22, 0x9f, 0xec, 0x1f,
-	0x90, 0x8a, 0x7b, 0xae, 0x53, 0xdc, 0x87, 0x7b, 0x86, 0x6c, 0x17, 0xf5, 0x5c, 0xa7, 0xa8, 0x8f,
-	0xf4, 0x0f, 0xca, 0xc4, 0x3c, 0xd7, 0x29, 0xe6, 0xa3, 0xbd, 0x83, 0xbe, 0x42, 0x11, 0x5f, 0x85,
-	0x13, 0x5d, 0x5a, 0xfe, 0x55, 0x9e, 0x03, 0x5b, 0x70, 0xa2, 0x33, 0x17, 0x07, 0x7d, 0x08, 0x87,
-	0xd8, 0x2b, 0x94, 0x2f, 0xff, 0xe2, 0xe9, 0x25, 0x90, 0x1b, 0x3e, 0xe2, 0x5f, 0x01, 0xfc, 0x76,
-	0xa7, 0xc1, 0x45, 0x2a, 0x31, 0x0e, 0xfa, 0x39, 0x8c, 0x7b, 0x6a, 0xe3, 0x83, 0x87, 0xd8, 0xfb,
-	0xcc, 0x57, 0x62, 0xbf, 0x4c, 0xf6, 0x18, 0x8c, 0x5b, 0xe4, 0xe0, 0x44, 0x2f, 0x15, 0x12, 0xff,
-	0x0e, 0xe0, 0xd4, 0x3a, 0x26, 0x5d, 0xf2, 0xc1, 0xd7, 0xaa, 0xd8, 0x21, 0xaf, 0xe3, 0xac, 0x5a,
-	0x81, 0xb0, 0xf9, 0x26, 0x7f, 0xe2, 0x59, 0x45, 0xd7, 0xfc, 0xb2, 0xe2, 0x94, 0xb3, 0x31, 0xd7,
-	0x5d, 0x4e, 0x14, 0xfd, 0x3f, 0xc4, 0x7f, 0x02, 0x28, 0x5c, 0xd2, 0x9c, 0x2e, 0xac, 0x1d, 0x9f,
-	0xf6, 0x37, 0xf0, 0xc6, 0x7e, 0xe6, 0x34, 0xfe, 0x06, 0xe0, 0x54, 0xee, 0x65, 0xb5, 0xff, 0x04,
-	0xc6, 0x59, 0x53, 0x31, 0xf2, 0x21, 0xfa, 0xb0, 0x0b, 0x71, 0x1f, 0xe4, 0xec, 0x8c, 0xff, 0x01,
-	0xe0, 0x6c, 0xd7, 0x6e, 0x69, 0x5c, 0x7e, 0x18, 0xf3, 0xd7, 0xf8, 0x9e, 0x7b, 0xe6, 0x24, 0x34,
-	0xf8, 0x4e, 0xf7, 0xe6, 0x69, 0xdc, 0x00, 0xfd, 0x2c, 0x5a, 0x43, 0x81, 0x9e, 0x43, 0x2d, 0xfd,
-	0x2e, 0xd1, 0xed, 0x6b, 0x80, 0x8c, 0x4b, 0x9a, 0xe3, 0x6e, 0x54, 0x1d, 0xc2, 0x75, 0x4c, 0x7c,
-	0x61, 0x78, 0xab, 0x03, 0xf9, 0xa3, 0x8a, 0x45, 0xf6, 0x53, 0xf3, 0xa1, 0xf5, 0x41, 0x9c, 0xfc,
-	0xcd, 0xbf, 0xfe, 0x7b, 0x3b, 0xf2, 0x26, 0x9a, 0xc8, 0x28, 0x4e, 0x86, 0xad, 0x7a, 0x9a, 0xc9,
-	0x04, 0xba, 0x03, 0x60, 0x72, 0x1d, 0x93, 0xc6, 0xb7, 0x88, 0xf7, 0xdb, 0x71, 0xc3, 0xac, 0x6c,
-	0xaa, 0x87, 0x9b, 0xb0, 0x98, 0xa1, 0x74, 0xe6, 0xd1, 0xbb, 0x41, 0x3a, 0x8d, 0xdb, 0x71, 0xe6,
-	0xba, 0xa6, 0x3a, 0x52, 0xe0, 0xbe, 0x75, 0x80, 0x6e, 0x03, 0x38, 0xe2, 0xae, 0x4d, 0xf3, 0x2e,
-	0xde, 0x21, 0x8e, 0xe1, 0x96, 0x2e, 0xf5, 0xdd, 0xf0, 0x34, 0x1d, 0xf1, 0x3c, 0xe5, 0xf9, 0x2d,
-	0xf4, 0x66, 0x57, 0x9e, 0xe8, 0x2f, 0x00, 0x46, 0xd7, 0x31, 0x41, 0x8b, 0xa1, 0x0a, 0xe6, 0x33,
-	0x08, 0xb1, 0x57, 0xc5, 0x9f, 0xd2, 0xc0, 0x6b, 0x28, 0x1b, 0x08, 0xcc, 0xea, 0xd2, 0xa6, 0x5e,
-	0x6d, 0xe3, 0x03, 0xcf, 0xa8, 0xf9, 0xc5, 0xf0, 0x00, 0xfd, 0x1e, 0xc0, 0x98, 0x5b, 0x1c, 0x24,
-	0x85, 0x2b, 0x59, 0xa3, 0x54, 0x6f, 0x9f, 0x4e, 0xd4, 0x11, 0x2f, 0x50, 0xa6, 0x19, 0x94, 0x6e,
-	0x65, 0x7a, 0x0a, 0x4b, 0xf4, 0x02, 0xc0, 0x68, 0xae, 0x5b, 0xe9, 0x72, 0x67, 0x2d, 0xdd, 0x9f,
-	0x01, 0x65, 0xf4, 0x07, 0x90, 0x92, 0x5b, 0x29, 0xb1, 0x27, 0x29, 0x54, 0x11, 0x83, 0xc6, 0x81,
-	0x62, 0x2e, 0x83, 0x85, 0xaf, 0x3e, 0x14, 0x3f, 0xe8, 0x1b, 0x78, 0x19, 0x2c, 0xb8, 0xbd, 0x3c,
-	0xb8, 0x86, 0x75, 0x4c, 0x30, 0xea, 0xed, 0xd8, 0x4c, 0x9d, 0x20, 0x04, 0x62, 0x96, 0x66, 0xfc,
-	0xa3, 0x85, 0xe5, 0x9e, 0xd6, 0xa0, 0x41, 0xdc, 0x1d, 0x64, 0xef, 0x82, 0x47, 0x47, 0x02, 0x78,
-	0x7c, 0x24, 0x80, 0x27, 0x47, 0x02, 0xf7, 0xf4, 0x48, 0xe0, 0x9e, 0x1d, 0x09, 0xdc, 0xf3, 0x23,
-	0x81, 0x7b, 0x71, 0x24, 0x80, 0x1b, 0x35, 0x01, 0xdc, 0xac, 0x09, 0xdc, 0xbd, 0x9a, 0x00, 0xee,
-	0xd7, 0x04, 0xee, 0x41, 0x4d, 0xe0, 0x1e, 0xd6, 0x04, 0xee, 0x51, 0x4d, 0x00, 0x8f, 0x6b, 0x02,
-	0x78, 0x52, 0x13, 0xb8, 0xa7, 0x35, 0x01, 0x3c, 0xab, 0x09, 0xdc, 0xf3, 0x9a, 0x00, 0x5e, 0xd4,
-	0x04, 0xee, 0x46, 0x5d, 0xe0, 0x6e, 0xd6, 0x05, 0x70, 0xab, 0x2e, 0x70, 0x7f, 0xac, 0x0b, 0xe0,
-	0x4e, 0x5d, 0xe0, 0xee, 0xd5, 0x05, 0xee, 0x7e, 0x5d, 0x00, 0x0f, 0xea, 0x02, 0x78, 0x58, 0x17,
-	0xc0, 0x57, 0x8b, 0x25, 0x53, 0x22, 0x3b, 0x98, 0xec, 0x68, 0x46, 0xc9, 0x91, 0x0c, 0x4c, 0xf6,
-	0x4c, 0xbb, 0x9c, 0x69, 0xfd, 0x38, 0x6f, 0x95, 0x4b, 0x19, 0x42, 0x0c, 0x6b, 0x7b, 0x7b, 0x90,
-	0x26, 0xfe, 0xde, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x92, 0xcd, 0xbf, 0xed, 0x18, 0x00,
-	0x00,
+	// 1964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xf5, 0xe6, 0x88, 0x94, 0x28, 0x3e, 0xea, 0x5f, 0xc6, 0x76, 0x7e, 0x34, 0x2d, 0x2f, 0x85, 0xb5,
+	0x7f, 0x89, 0xec, 0x8a, 0x64, 0xc1, 0xc4, 0x6d, 0x22, 0x34, 0x71, 0xc5, 0xc8, 0x56, 0x94, 0xda,
+	0x49, 0xbd, 0x8c, 0x12, 0x24, 0x46, 0x42, 0xac, 0xb8, 0x43, 0x6a, 0xc3, 0xe5, 0xee, 0x66, 0x67,
+	0x28, 0x55, 0x0d, 0x8c, 0x06, 0xed, 0xc5, 0xe8, 0x29, 0x48, 0x0e, 0xcd, 0xa9, 0x08, 0xd2, 0x4b,
+	0x7a, 0x6a, 0xd0, 0x53, 0x8e, 0x41, 0xdb, 0x83, 0x8f, 0x06, 0x8a, 0xa2, 0x39, 0xa9, 0x11, 0xd9,
+	0x43, 0x4e, 0x45, 0x8e, 0x86, 0x4f, 0xc5, 0xce, 0xce, 0x92, 0xcb, 0x3f, 0xb2, 0x96, 0x54, 0xd2,
+	0x93, 0x38, 0x3b, 0xef, 0x7d, 0xef, 0x9b, 0x37, 0x33, 0xdf, 0x7b, 0xbb, 0x82, 0xac, 0x61, 0x39,
+	0xea, 0x9e, 0x6a, 0x66, 0x29, 0x53, 0x2b, 0xf5, 0xbc, 0x6a, 0xeb, 0x79, 0xd5, 0xb6, 0x0d, 0xbd,
+	0xa2, 0x32, 0xdd, 0x32, 0x29, 0x71, 0x76, 0x89, 0x53, 0xde, 0x23, 0xdb, 0x39, 0xdb, 0xb1, 0x98,
+	0x85, 0xe7, 0x18, 0x33, 0x73, 0xc2, 0x25, 0xb7, 0xfb, 0x54, 0x7a, 0xad, 0xa6, 0xb3, 0x9d, 0xe6,
+	0x76, 0xae, 0x62, 0x35, 0xf2, 0xc4, 0xdc, 0xb5, 0xf6, 0x6d, 0xc7, 0xfa, 0xc5, 0x7e, 0x9e, 0x1b,
+	0x57, 0xb2, 0x35, 0x62, 0x66, 0x77, 0x55, 0x43, 0xd7, 0x54, 0x46, 0xf2, 0x03, 0x3f, 0x3c, 0xc8,
+	0x74, 0x36, 0x00, 0x51, 0xb3, 0x6a, 0x96, 0xe7, 0xbc, 0xdd, 0xac, 0xf2, 0x11, 0x1f, 0xf0, 0x5f,
+	0xc2, 0x7c, 0xb1, 0x66, 0x59, 0x35, 0x83, 0x78, 0x4c, 0x4d, 0xd3, 0x62, 0x1e, 0x51, 0x31, 0x2b,
+	0x89, 0xd9, 0x0e, 0x86, 0xd6, 0x74, 0xb8, 0x81, 0x98, 0x3f, 0xd7, 0x3f, 0x4f, 0x1a, 0x36, 0xdb,
+	0x17, 0x93, 0x4b, 0xfd, 0x93, 0x55, 0x9d, 0x18, 0x5a, 0xb9, 0xa1, 0xd2, 0xba, 0xb0, 0xc8, 0xf4,
+	0x5b, 0x30, 0xbd, 0x41, 0x28, 0x53, 0x1b, 0xb6, 0x30, 0xb8, 0x30, 0x98, 0x4e, 0x5d, 0x23, 0x26,
+	0xd3, 0xab, 0x3a, 0x71, 0x7c, 0x92, 0x4b, 0x83, 0x46, 0x0d, 0x42, 0xa9, 0x5a, 0x23, 0xc2, 0x42,
+	0xfe, 0x27, 0x82, 0xf3, 0x6b, 0xdd, 0x6d, 0x78, 0x9d, 0x6c, 0xef, 0x58, 0x56, 0x7d, 0xb3, 0x8b,
+	0x84, 0x55, 0x98, 0x0f, 0xec, 0x53, 0x59, 0xd7, 0x68, 0x0a, 0x2d, 0xa1, 0xe5, 0x64, 0xe1, 0x89,
+	0x5c, 0xef, 0x16, 0xe5, 0x02, 0x38, 0x01, 0x80, 0xe2, 0xc2, 0xc3, 0xe2, 0xe4, 0x6f, 0xd1, 0xc4,
+	0x02, 0xba, 0x77, 0x90, 0x89, 0xdc, 0x3f, 0xc8, 0x20, 0x65, 0x4e, 0x0d, 0x5a, 0x52, 0x5c, 0x02,
+	0xd8, 0xf3, 0x02, 0x97, 0x75, 0x2d, 0x35, 0xb1, 0x84, 0x96, 0x13, 0xc5, 0xa7, 0x1f, 0x16, 0x2f,
+	0x3a, 0x72, 0xea, 0x62, 0x41, 0x7a, 0xfb, 0xb6, 0x9a, 0xfd, 0xe5, 0x0f, 0xb3, 0xcf, 0xbe, 0xb5,
+	0x7c, 0x75, 0xf5, 0x76, 0xf6, 0xad, 0xab, 0xfe, 0xf0, 0xd2, 0x7b, 0x85, 0x95, 0x3b, 0x17, 0x5b,
+	0x07, 0x99, 0x84, 0xcf, 0x7a, 0x5d, 0x49, 0xec, 0xf9, 0x0b, 0x90, 0x7f, 0x05, 0xff, 0x3f, 0xb8,
+	0xb0, 0x57, 0x49, 0xc3, 0x36, 0x54, 0x46, 0x82, 0x0b, 0x7c, 0x0d, 0x92, 0x4c, 0x3c, 0x76, 0xc3,
+	0x23, 0x1e, 0xfe, 0x4a, 0xf8, 0xf0, 0xd0, 0x01, 0x5d, 0x57, 0x80, 0x75, 0x02, 0xc8, 0xff, 0x41,
+	0x90, 0x39, 0x9a, 0xc1, 0x75, 0x77, 0xc7, 0xf1, 0x73, 0x30, 0xd1, 0x09, 0x99, 0x0d, 0x1f, 0x72,
+	0x62, 0x73, 0x5d, 0x99, 0xd0, 0x35, 0x7c, 0x0e, 0x62, 0xa6, 0xda, 0x20, 0x22, 0x65, 0xf1, 0x87,
+	0xc5, 0x98, 0x33, 0x91, 0x3a, 0xad, 0xf0, 0x87, 0xf8, 0x12, 0x24, 0x35, 0x42, 0x2b, 0x8e, 0x6e,
+	0xbb, 0xe1, 0x53, 0xd1, 0xa0, 0x8d, 0xa6, 0x04, 0xe7, 0xf0, 0xe3, 0x30, 0x45, 0x49, 0xc5, 0x21,
+	0x2c, 0x15, 0x5b, 0x42, 0xcb, 0xd3, 0x8a, 0x18, 0xe1, 0x15, 0x98, 0xd5, 0x48, 0x55, 0x6d, 0x1a,
+	0xac, 0xbc, 0xab, 0x1a, 0x4d, 0x92, 0x9a, 0xec, 0x05, 0x99, 0x11, 0xb3, 0xaf, 0xb9, 0x93, 0xf2,
+	0xa7, 0x49, 0x48, 0x1f, 0xbd, 0x60, 0xfc, 0x06, 0x44, 0xbb, 0x87, 0xe7, 0xca, 0x23, 0x0e, 0xcf,
+	0xd1, 0x7b, 0x35, 0xe4, 0x2c, 0xb9, 0x98, 0xdf, 0x59, 0x1e, 0x72, 0x30, 0x6d, 0x58, 0x35, 0xab,
+	0xdc, 0x74, 0x0c, 0x9e, 0x89, 0x44, 0xf1, 0xd4, 0xc3, 0xe2, 0xa4, 0x13, 0xbd, 0x8b, 0x50, 0xeb,
+	0x20, 0x13, 0xbf, 0x61, 0xd5, 0xac, 0x2d, 0xe5, 0x86, 0x12, 0x77, 0x8d, 0xb6, 0x1c, 0xc3, 0xb5,
+	0xd7, 0xcd, 0xaa, 0x67, 0x3f, 0x39, 0x68, 0xbf, 0x69, 0x56, 0x3d, 0x7b, 0xd7, 0xc8, 0xb5, 0xdf,
+	0x84, 0xc7, 0x34, 0xab, 0xd2, 0x6c, 0x10, 0xd3, 0x13, 0x13, 0xee, 0x38, 0xc5, 0x1d, 0x17, 0x03,
+	0x8e, 0x0b, 0xeb, 0x41, 0x23, 0x17, 0x61, 0xa1, 0xc7, 0x4d, 0x84, 0xde, 0x56, 0x29, 0xe1, 0x08,
+	0xf1, 0xc1, 0xd0, 0x45, 0x95, 0x12, 0x1e, 0xda, 0x35, 0x72, 0xed, 0x6f, 0x41, 0x7c, 0x87, 0xa8,
+	0x1a, 0x71, 0x68, 0x6a, 0x7a, 0x29, 0xba, 0x9c, 0x2c, 0xfc, 0x38, 0xfc, 0x0e, 0xe4, 0x5e, 0xf4,
+	0x3c, 0xaf, 0x99, 0xcc, 0xd9, 0x57, 0x7c, 0x1c, 0x7c, 0x15, 0xa6, 0xaa, 0x96, 0xd3, 0x50, 0x59,
+	0x2a, 0xc1, 0x09, 0x3c, 0xe9, 0x1d, 0xe0, 0xd3, 0xc7, 0x1d, 0x60, 0x45, 0xb8, 0xe1, 0x0d, 0x98,
+	0xe2, 0xc2, 0x47, 0x53, 0xc0, 0x29, 0xe5, 0xc3, 0x53, 0xe2, 0xd7, 0x47, 0x11, 0xee, 0xf8, 0x0d,
+	0x98, 0x6b, 0xda, 0x86, 0x6e, 0xd6, 0xcb, 0x42, 0xde, 0x52, 0x49, 0x7e, 0xca, 0x0a, 0x23, 0xac,
+	0xf1, 0xa6, 0xe7, 0xa9, 0xcc, 0x7a, 0x48, 0x62, 0x88, 0x4b, 0x90, 0x7c, 0xc7, 0xd2, 0xcd, 0xb2,
+	0x5a, 0xa9, 0x10, 0x9b, 0xa5, 0x66, 0xc6, 0xc6, 0x05, 0x17, 0x66, 0x8d, 0xa3, 0xe0, 0x2d, 0x98,
+	0xd1, 0xac, 0x3d, 0x93, 0x33, 0x56, 0x2b, 0xf5, 0xd4, 0xec, 0xd8, 0xa8, 0x49, 0x1f, 0x67, 0xad,
+	0x52, 0xc7, 0xaf, 0xc3, 0x6c, 0x07, 0xd6, 0x74, 0x71, 0xe7, 0xc6, 0xc6, 0xed, 0xf0, 0x7b, 0x59,
+	0xed, 0x03, 0xa6, 0xc4, 0x64, 0xa9, 0xf9, 0x93, 0x03, 0x97, 0x88, 0xc9, 0xf0, 0x6d, 0x98, 0xef,
+	0x00, 0x57, 0x55, 0xdd, 0x20, 0x5a, 0x6a, 0x61, 0x6c, 0xe8, 0x39, 0x1f, 0xea, 0x3a, 0x47, 0xea,
+	0x01, 0x7f, 0xb7, 0x49, 0x9a, 0x44, 0x4b, 0x3d, 0x76, 0x72, 0xf0, 0x5b, 0x1c, 0xc9, 0x05, 0x37,
+	0x2c, 0x51, 0x13, 0xa9, 0x65, 0xec, 0x12, 0x2d, 0x85, 0xc7, 0x07, 0xf7, 0xa1, 0x4a, 0x1c, 0x29,
+	0xbd, 0x0a, 0x33, 0xc1, 0x2b, 0x87, 0x17, 0x20, 0x5a, 0x27, 0xfb, 0x5e, 0x9d, 0x50, 0xdc, 0x9f,
+	0xf8, 0x34, 0x4c, 0x7a, 0x8a, 0xcc, 0x25, 0x4f, 0xf1, 0x06, 0xab, 0x13, 0xcf, 0xa0, 0xf4, 0x79,
+	0x88, 0xfb, 0x67, 0x17, 0x43, 0xcc, 0x56, 0xd9, 0x8e, 0xf0, 0xe3, 0xbf, 0xe5, 0x1a, 0x9c, 0x3b,
+	0x9a, 0x10, 0xc5, 0x2f, 0x42, 0xc2, 0x2f, 0x61, 0xae, 0x54, 0xbb, 0xb7, 0xf2, 0x72, 0xf8, 0x05,
+	0x29, 0x5d, 0x67, 0xf9, 0xc3, 0x19, 0xc0, 0x83, 0x96, 0xf8, 0x56, 0xb0, 0x0a, 0x64, 0x8f, 0x87,
+	0x0e, 0xa1, 0xfe, 0x2f, 0x00, 0x54, 0x1c, 0xa2, 0x32, 0xa2, 0x95, 0x55, 0xc6, 0x13, 0x92, 0x2c,
+	0xa4, 0x73, 0x5e, 0x03, 0x95, 0xf3, 0x1b, 0xa8, 0xdc, 0xab, 0x7e, 0x03, 0x55, 0x9c, 0x76, 0xdd,
+	0x3f, 0xf8, 0x57, 0x06, 0x29, 0x09, 0xe1, 0xb7, 0xc6, 0x5c, 0x90, 0xa6, 0xad, 0xf9, 0x20, 0xd1,
+	0x51, 0x40, 0x84, 0xdf, 0x1a, 0xeb, 0x11, 0xe5, 0x58, 0x08, 0x51, 0xde, 0xec, 0x8a, 0xf2, 0x64,
+	0x58, 0x05, 0x3c, 0x56, 0x8c, 0xa7, 0xc6, 0x13, 0xe3, 0xb7, 0x61, 0x26, 0xd0, 0x06, 0x51, 0x71,
+	0xc5, 0xc7, 0xac, 0xd3, 0x31, 0xbe, 0x3b, 0xc9, 0x6e, 0x37, 0x44, 0x71, 0x19, 0xe6, 0x3b, 0xf8,
+	0x42, 0xf5, 0x17, 0xf8, 0x9a, 0x7f, 0x14, 0x62, 0xcd, 0x3d, 0xb2, 0x2f, 0x96, 0x3e, 0xc7, 0x7a,
+	0x1e, 0xe2, 0x5b, 0x03, 0x45, 0x20, 0xce, 0x97, 0x10, 0xe2, 0xfc, 0x1e, 0x25, 0xfe, 0x3f, 0xeb,
+	0x15, 0xff, 0xe9, 0x91, 0xf1, 0x82, 0xa2, 0x7f, 0xb3, 0x4f, 0xf4, 0x13, 0x23, 0xa3, 0xf5, 0x88,
+	0xfd, 0x2b, 0xfd, 0x62, 0x0f, 0x23, 0xe3, 0xf5, 0x8a, 0xfc, 0x2b, 0xfd, 0x22, 0x9f, 0x1c, 0x1f,
+	0x90, 0x8b, 0x7b, 0x69, 0x50, 0xdc, 0x67, 0x46, 0x86, 0xec, 0x17, 0xf5, 0xd2, 0xa0, 0xa8, 0xcf,
+	0x8e, 0x0f, 0x2a, 0xc4, 0xbc, 0x34, 0x28, 0xe6, 0x73, 0xa3, 0x83, 0xf6, 0x8a, 0x38, 0xfe, 0x29,
+	0x24, 0x9a, 0x76, 0xb9, 0xaa, 0x1b, 0x8c, 0x38, 0xa2, 0xf0, 0x5c, 0x78, 0x04, 0xdc, 0x96, 0x7d,
+	0x9d, 0x9b, 0x2a, 0xd3, 0x4d, 0xf1, 0x0b, 0x17, 0x60, 0xa1, 0x62, 0x99, 0x8c, 0x98, 0xac, 0x4c,
+	0xcc, 0x8a, 0xa5, 0xe9, 0x66, 0x8d, 0x17, 0x99, 0x40, 0x8b, 0x3b, 0x2f, 0x0c, 0xae, 0x89, 0xf9,
+	0x13, 0x95, 0x8e, 0x35, 0x38, 0x35, 0xe4, 0xa2, 0x7d, 0x97, 0xd5, 0x67, 0x0b, 0x4e, 0x0d, 0x66,
+	0x90, 0xe2, 0xe7, 0x61, 0x5a, 0xbc, 0xb8, 0xf9, 0x45, 0x47, 0x3e, 0x3e, 0xf1, 0x4a, 0xc7, 0x47,
+	0xfe, 0x23, 0x82, 0xb3, 0x83, 0x06, 0xd7, 0xb9, 0xb0, 0x51, 0xfc, 0x73, 0x88, 0x7b, 0x1a, 0xe7,
+	0x83, 0x87, 0x50, 0x1c, 0xe1, 0x9b, 0x13, 0x7f, 0x85, 0xd8, 0x0a, 0x18, 0x37, 0xc9, 0xc1, 0x89,
+	0x51, 0x32, 0x24, 0xff, 0x66, 0x62, 0xd8, 0x5b, 0xd2, 0x3a, 0x31, 0xf4, 0x5d, 0xe2, 0xec, 0xe3,
+	0x67, 0x20, 0xe6, 0xbe, 0xea, 0x8b, 0x02, 0x19, 0xae, 0x02, 0x71, 0x0f, 0x7c, 0x16, 0xa2, 0x6e,
+	0xdd, 0x11, 0xef, 0x40, 0xad, 0x83, 0x4c, 0xd4, 0xad, 0x35, 0xee, 0x33, 0x9c, 0x81, 0x24, 0x65,
+	0x2a, 0x6b, 0xd2, 0x72, 0xc5, 0xd2, 0x08, 0xaf, 0x6e, 0xb3, 0x0a, 0x78, 0x8f, 0x5e, 0xb0, 0x34,
+	0x82, 0x9f, 0x83, 0xb8, 0xbb, 0xeb, 0x66, 0x65, 0x9f, 0xd7, 0xad, 0x64, 0xe1, 0xec, 0x40, 0xe0,
+	0x75, 0xf1, 0x7d, 0xc3, 0x8b, 0xfb, 0xb1, 0x1b, 0xd7, 0xf7, 0xc1, 0x69, 0x98, 0x76, 0x08, 0xb5,
+	0x2d, 0x93, 0x8a, 0x57, 0x44, 0xa5, 0x33, 0x76, 0x33, 0x41, 0x1c, 0xc7, 0x72, 0xbc, 0xba, 0xa4,
+	0x78, 0x03, 0xf9, 0x1d, 0x58, 0x3c, 0x32, 0x09, 0x3a, 0xa1, 0xf8, 0x25, 0x00, 0xad, 0x33, 0x0a,
+	0xdf, 0x88, 0xf8, 0x69, 0x54, 0x02, 0xde, 0xf2, 0x9f, 0x11, 0x2c, 0x6e, 0x10, 0x36, 0xe4, 0x04,
+	0x91, 0x77, 0x9b, 0x84, 0xb2, 0xef, 0xa3, 0x27, 0xb9, 0x0a, 0xd0, 0xfd, 0xa6, 0x73, 0x64, 0x4f,
+	0xc2, 0x6f, 0xd9, 0x4d, 0x95, 0xd6, 0x8b, 0x31, 0xd7, 0x5d, 0x49, 0x54, 0xfd, 0x07, 0xf2, 0xdf,
+	0x10, 0x48, 0x37, 0x74, 0x3a, 0x84, 0x35, 0xf5, 0x69, 0xff, 0x0f, 0xbe, 0xcc, 0x9c, 0x78, 0x19,
+	0x7f, 0x42, 0xb0, 0x58, 0x7a, 0x54, 0xee, 0x5f, 0x86, 0xb8, 0xb8, 0xc6, 0x82, 0x7c, 0x88, 0x9b,
+	0x3f, 0x84, 0xb8, 0x0f, 0x72, 0x72, 0xc6, 0x7f, 0x41, 0x70, 0x71, 0xe8, 0x69, 0xe9, 0x34, 0xb9,
+	0x82, 0xf9, 0xf7, 0xf8, 0x3d, 0xe3, 0xc4, 0x8b, 0xd0, 0xe1, 0x89, 0xe1, 0x87, 0xa7, 0xd3, 0xe9,
+	0xfb, 0xab, 0xe8, 0x0d, 0x85, 0x46, 0x0e, 0x55, 0xf8, 0x07, 0x0c, 0xd3, 0x33, 0x85, 0xd4, 0x74,
+	0xea, 0x4a, 0xa3, 0x01, 0xb0, 0x41, 0x98, 0x2f, 0xc5, 0x8f, 0x0f, 0x20, 0x5f, 0x6b, 0xd8, 0x6c,
+	0x3f, 0x7d, 0x29, 0xb4, 0x22, 0xcb, 0xe7, 0x7e, 0xfd, 0xf7, 0x7f, 0x7f, 0x34, 0x71, 0x06, 0x9f,
+	0xca, 0xab, 0x34, 0x2f, 0x76, 0x3d, 0x2b, 0x84, 0x19, 0x7f, 0x82, 0x20, 0xb9, 0x41, 0x58, 0xe7,
+	0x9b, 0xd3, 0xd3, 0xfd, 0xb8, 0x61, 0x76, 0x36, 0x3d, 0xc2, 0x1b, 0x8f, 0x9c, 0xe7, 0x74, 0x2e,
+	0xe1, 0x27, 0x83, 0x74, 0x3a, 0x6f, 0x41, 0xf9, 0xf7, 0x74, 0x8d, 0xe6, 0x02, 0x7d, 0xf5, 0x1d,
+	0xfc, 0x11, 0x82, 0x59, 0x77, 0x6f, 0xba, 0xef, 0x5c, 0x03, 0xe5, 0x28, 0xdc, 0xd6, 0xa5, 0x7f,
+	0x10, 0x9e, 0x26, 0x95, 0xcf, 0x73, 0x9e, 0xff, 0x87, 0xcf, 0x0c, 0xe5, 0x89, 0xff, 0x80, 0x20,
+	0xba, 0x41, 0x18, 0x5e, 0x09, 0x95, 0x30, 0x9f, 0x41, 0x88, 0xbb, 0x2a, 0xbf, 0xc4, 0x03, 0xaf,
+	0xe3, 0x62, 0x20, 0xb0, 0xc8, 0x4b, 0x9f, 0x7a, 0xf5, 0x8d, 0xef, 0x78, 0x46, 0xdd, 0x2f, 0xc3,
+	0x77, 0xf0, 0x87, 0x08, 0x62, 0x6e, 0x72, 0x70, 0x2e, 0x5c, 0xca, 0x3a, 0xa9, 0xba, 0x70, 0x3c,
+	0x51, 0x2a, 0x5f, 0xe1, 0x4c, 0xf3, 0x38, 0xdb, 0xcb, 0xf4, 0x18, 0x96, 0xf8, 0x01, 0x82, 0x68,
+	0x69, 0x58, 0xea, 0x4a, 0x27, 0x4d, 0xdd, 0xef, 0x11, 0x67, 0xf4, 0x3b, 0x94, 0x56, 0x7a, 0x29,
+	0x89, 0x5f, 0xb9, 0x50, 0x49, 0x0c, 0x1a, 0x07, 0x92, 0xb9, 0x8a, 0x2e, 0xbf, 0xf9, 0xbc, 0xfc,
+	0xec, 0xd8, 0xc0, 0xab, 0xe8, 0xb2, 0x7b, 0x96, 0xa7, 0xd6, 0x89, 0x41, 0x18, 0xc1, 0xa3, 0x95,
+	0xcd, 0xf4, 0x11, 0x42, 0x20, 0x17, 0xf9, 0x8a, 0x7f, 0x72, 0x79, 0x75, 0xa4, 0x3d, 0xe8, 0x10,
+	0xe7, 0x1b, 0xf2, 0x57, 0x04, 0x67, 0xdc, 0xf3, 0x30, 0xd8, 0x55, 0x8c, 0x48, 0x72, 0x25, 0x74,
+	0xc3, 0xe1, 0xb6, 0x19, 0x37, 0x39, 0xf5, 0x0d, 0x7c, 0x6d, 0x7c, 0xea, 0xf9, 0x6e, 0xd7, 0x52,
+	0xfc, 0x14, 0xdd, 0x3b, 0x94, 0xd0, 0xfd, 0x43, 0x09, 0x7d, 0x75, 0x28, 0x45, 0xbe, 0x3e, 0x94,
+	0x22, 0xdf, 0x1c, 0x4a, 0x91, 0x6f, 0x0f, 0xa5, 0xc8, 0x83, 0x43, 0x09, 0xbd, 0xdf, 0x92, 0xd0,
+	0xdd, 0x96, 0x14, 0xf9, 0xac, 0x25, 0xa1, 0xcf, 0x5b, 0x52, 0xe4, 0x8b, 0x96, 0x14, 0xf9, 0xb2,
+	0x25, 0x45, 0xee, 0xb5, 0x24, 0x74, 0xbf, 0x25, 0xa1, 0xaf, 0x5a, 0x52, 0xe4, 0xeb, 0x96, 0x84,
+	0xbe, 0x69, 0x49, 0x91, 0x6f, 0x5b, 0x12, 0x7a, 0xd0, 0x92, 0x22, 0xef, 0xb7, 0xa5, 0xc8, 0xdd,
+	0xb6, 0x84, 0x3e, 0x68, 0x4b, 0x91, 0x8f, 0xdb, 0x12, 0xfa, 0xa4, 0x2d, 0x45, 0x3e, 0x6b, 0x4b,
+	0x91, 0xcf, 0xdb, 0x12, 0xfa, 0xa2, 0x2d, 0xa1, 0x2f, 0xdb, 0x12, 0x7a, 0x73, 0xa5, 0x66, 0xe5,
+	0xd8, 0x0e, 0x61, 0x3b, 0xba, 0x59, 0xa3, 0x39, 0x93, 0xb0, 0x3d, 0xcb, 0xa9, 0xe7, 0x7b, 0xff,
+	0x8f, 0x64, 0xd7, 0x6b, 0x79, 0xc6, 0x4c, 0x7b, 0x7b, 0x7b, 0x8a, 0x6f, 0xdf, 0x53, 0xff, 0x0d,
+	0x00, 0x00, 0xff, 0xff, 0x31, 0x78, 0x84, 0xe2, 0xdd, 0x1b, 0x00, 0x00,
 }
 
 func (this *ApplicationWebhookIdentifiers) Equal(that interface{}) bool {
@@ -1466,6 +1640,12 @@ func (this *ApplicationWebhook) Equal(that interface{}) bool {
 	if !this.LocationSolved.Equal(that1.LocationSolved) {
 		return false
 	}
+	if !this.UpFilter.Equal(that1.UpFilter) {
+		return false
+	}
+	if this.ContentEncoding != that1.ContentEncoding {
+		return false
+	}
 	return true
 }
 func (this *ApplicationWebhook_Message) Equal(that interface{}) bool {
@@ -1550,6 +1730,74 @@ func (this *ApplicationWebhookFormats) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ApplicationWebhookDelivery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDelivery)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDelivery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.StatusCode != that1.StatusCode {
+		return false
+	}
+	if this.Latency != that1.Latency {
+		return false
+	}
+	if this.Response != that1.Response {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ApplicationWebhookDeliveries) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ApplicationWebhookDeliveries)
+	if !ok {
+		that2, ok := that.(ApplicationWebhookDeliveries)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Deliveries) != len(that1.Deliveries) {
+		return false
+	}
+	for i := range this.Deliveries {
+		if !this.Deliveries[i].Equal(that1.Deliveries[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *GetApplicationWebhookRequest) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1702,6 +1950,8 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// List the most recent delivery attempts of the webhook.
+	ListWebhookDeliveries(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -1775,6 +2025,15 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListWebhookDeliveries(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 type ApplicationWebhookRegistryServer interface {
 	GetFormats(context.Context, *types.Empty) (*ApplicationWebhookFormats, error)
@@ -1784,6 +2043,8 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*types.Empty, error)
+	// List the most recent delivery attempts of the webhook.
+	ListWebhookDeliveries(context.Context, *ApplicationWebhookIdentifiers) (*ApplicationWebhookDeliveries, error)
 }
 
 // UnimplementedApplicationWebhookRegistryServer can be embedded to have forward compatible implementations.
@@ -1811,6 +2072,9 @@ func (*UnimplementedApplicationWebhookRegistryServer) Set(ctx context.Context, r
 func (*UnimplementedApplicationWebhookRegistryServer) Delete(ctx context.Context, req *ApplicationWebhookIdentifiers) (*types.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedApplicationWebhookRegistryServer) ListWebhookDeliveries(ctx context.Context, req *ApplicationWebhookIdentifiers) (*ApplicationWebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
 
 func RegisterApplicationWebhookRegistryServer(s *grpc.Server, srv ApplicationWebhookRegistryServer) {
 	s.RegisterService(&_ApplicationWebhookRegistry_serviceDesc, srv)
@@ -1942,6 +2206,24 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListWebhookDeliveries(ctx, req.(*ApplicationWebhookIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.ApplicationWebhookRegistry",
 	HandlerType: (*ApplicationWebhookRegistryServer)(nil),
@@ -1974,6 +2256,10 @@ var _ApplicationWebhookRegistry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _ApplicationWebhookRegistry_ListWebhookDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -2414,6 +2700,29 @@ func (m *ApplicationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContentEncoding) > 0 {
+		i -= len(m.ContentEncoding)
+		copy(dAtA[i:], m.ContentEncoding)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.ContentEncoding)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.UpFilter != nil {
+		{
+			size, err := m.UpFilter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if len(m.TemplateFields) > 0 {
 		for k := range m.TemplateFields {
 			v := m.TemplateFields[k]
@@ -2714,7 +3023,7 @@ func (m *ApplicationWebhookFormats) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookDelivery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2724,40 +3033,62 @@ func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDelivery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDelivery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n24, err24 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency):])
+	if err24 != nil {
+		return 0, err24
 	}
+	i -= n24
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n24))
 	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	dAtA[i] = 0x22
+	if m.StatusCode != 0 {
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(m.StatusCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.URL) > 0 {
+		i -= len(m.URL)
+		copy(dAtA[i:], m.URL)
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(len(m.URL)))
+		i--
+		dAtA[i] = 0x12
 	}
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintApplicationserverWeb(dAtA, i, uint64(n25))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationWebhookDeliveries) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2767,35 +3098,115 @@ func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDeliveries) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListApplicationWebhooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationWebhookDeliveries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Deliveries) > 0 {
+		for iNdEx := len(m.Deliveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deliveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
 	}
-	i--
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApplicationWebhookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApplicationWebhookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApplicationWebhookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationWebhookIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ListApplicationWebhooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListApplicationWebhooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListApplicationWebhooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ApplicationIdentifiers.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintApplicationserverWeb(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
@@ -3087,6 +3498,10 @@ func NewPopulatedApplicationWebhook(r randyApplicationserverWeb, easy bool) *App
 			this.TemplateFields[randStringApplicationserverWeb(r)] = randStringApplicationserverWeb(r)
 		}
 	}
+	if r.Intn(5) != 0 {
+		this.UpFilter = NewPopulatedApplicationUpFilter(r, easy)
+	}
+	this.ContentEncoding = randStringApplicationserverWeb(r)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3128,12 +3543,41 @@ func NewPopulatedApplicationWebhookFormats(r randyApplicationserverWeb, easy boo
 	return this
 }
 
+func NewPopulatedApplicationWebhookDelivery(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDelivery {
+	this := &ApplicationWebhookDelivery{}
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v13
+	this.URL = randStringApplicationserverWeb(r)
+	this.StatusCode = r.Uint32()
+	v14 := github_com_gogo_protobuf_types.NewPopulatedStdDuration(r, easy)
+	this.Latency = *v14
+	this.Response = randStringApplicationserverWeb(r)
+	this.Error = randStringApplicationserverWeb(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedApplicationWebhookDeliveries(r randyApplicationserverWeb, easy bool) *ApplicationWebhookDeliveries {
+	this := &ApplicationWebhookDeliveries{}
+	if r.Intn(5) != 0 {
+		v15 := r.Intn(5)
+		this.Deliveries = make([]*ApplicationWebhookDelivery, v15)
+		for i := 0; i < v15; i++ {
+			this.Deliveries[i] = NewPopulatedApplicationWebhookDelivery(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookRequest {
 	this := &GetApplicationWebhookRequest{}
-	v13 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
-	this.ApplicationWebhookIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v16 := NewPopulatedApplicationWebhookIdentifiers(r, easy)
+	this.ApplicationWebhookIdentifiers = *v16
+	v17 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3141,10 +3585,10 @@ func NewPopulatedGetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhooksRequest {
 	this := &ListApplicationWebhooksRequest{}
-	v15 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v15
-	v16 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v16
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v18
+	v19 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v19
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3152,10 +3596,10 @@ func NewPopulatedListApplicationWebhooksRequest(r randyApplicationserverWeb, eas
 
 func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy bool) *SetApplicationWebhookRequest {
 	this := &SetApplicationWebhookRequest{}
-	v17 := NewPopulatedApplicationWebhook(r, easy)
-	this.ApplicationWebhook = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v20 := NewPopulatedApplicationWebhook(r, easy)
+	this.ApplicationWebhook = *v20
+	v21 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v21
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3163,10 +3607,10 @@ func NewPopulatedSetApplicationWebhookRequest(r randyApplicationserverWeb, easy
 
 func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWeb, easy bool) *GetApplicationWebhookTemplateRequest {
 	this := &GetApplicationWebhookTemplateRequest{}
-	v19 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
-	this.ApplicationWebhookTemplateIdentifiers = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v22 := NewPopulatedApplicationWebhookTemplateIdentifiers(r, easy)
+	this.ApplicationWebhookTemplateIdentifiers = *v22
+	v23 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v23
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3174,8 +3618,8 @@ func NewPopulatedGetApplicationWebhookTemplateRequest(r randyApplicationserverWe
 
 func NewPopulatedListApplicationWebhookTemplatesRequest(r randyApplicationserverWeb, easy bool) *ListApplicationWebhookTemplatesRequest {
 	this := &ListApplicationWebhookTemplatesRequest{}
-	v21 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v21
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3200,9 +3644,9 @@ func randUTF8RuneApplicationserverWeb(r randyApplicationserverWeb) rune {
 	return rune(ru + 61)
 }
 func randStringApplicationserverWeb(r randyApplicationserverWeb) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v25 := r.Intn(100)
+	tmps := make([]rune, v25)
+	for i := 0; i < v25; i++ {
 		tmps[i] = randUTF8RuneApplicationserverWeb(r)
 	}
 	return string(tmps)
@@ -3224,11 +3668,11 @@ func randFieldApplicationserverWeb(dAtA []byte, r randyApplicationserverWeb, fie
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
-		v23 := r.Int63()
+		v26 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v26 *= -1
 		}
-		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(v26))
 	case 1:
 		dAtA = encodeVarintPopulateApplicationserverWeb(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3494,6 +3938,14 @@ func (m *ApplicationWebhook) Size() (n int) {
 			n += mapEntrySize + 2 + sovApplicationserverWeb(uint64(mapEntrySize))
 		}
 	}
+	if m.UpFilter != nil {
+		l = m.UpFilter.Size()
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.ContentEncoding)
+	if l > 0 {
+		n += 2 + l + sovApplicationserverWeb(uint64(l))
+	}
 	return n
 }
 
@@ -3542,6 +3994,49 @@ func (m *ApplicationWebhookFormats) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationWebhookDelivery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sovApplicationserverWeb(uint64(m.StatusCode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovApplicationserverWeb(uint64(l))
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovApplicationserverWeb(uint64(l))
+	}
+	return n
+}
+
+func (m *ApplicationWebhookDeliveries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deliveries) > 0 {
+		for _, e := range m.Deliveries {
+			l = e.Size()
+			n += 1 + l + sovApplicationserverWeb(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GetApplicationWebhookRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3754,6 +4249,8 @@ func (this *ApplicationWebhook) String() string {
 		`LocationSolved:` + strings.Replace(fmt.Sprintf("%v", this.LocationSolved), "ApplicationWebhook_Message", "ApplicationWebhook_Message", 1) + `,`,
 		`ApplicationWebhookTemplateIdentifiers:` + strings.Replace(this.ApplicationWebhookTemplateIdentifiers.String(), "ApplicationWebhookTemplateIdentifiers", "ApplicationWebhookTemplateIdentifiers", 1) + `,`,
 		`TemplateFields:` + mapStringForTemplateFields + `,`,
+		`UpFilter:` + strings.Replace(fmt.Sprintf("%v", this.UpFilter), "ApplicationUpFilter", "ApplicationUpFilter", 1) + `,`,
+		`ContentEncoding:` + fmt.Sprintf("%v", this.ContentEncoding) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3803,6 +4300,36 @@ func (this *ApplicationWebhookFormats) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationWebhookDelivery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationWebhookDelivery{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`StatusCode:` + fmt.Sprintf("%v", this.StatusCode) + `,`,
+		`Latency:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Latency), "Duration", "types.Duration", 1), `&`, ``, 1) + `,`,
+		`Response:` + fmt.Sprintf("%v", this.Response) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApplicationWebhookDeliveries) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDeliveries := "[]*ApplicationWebhookDelivery{"
+	for _, f := range this.Deliveries {
+		repeatedStringForDeliveries += strings.Replace(f.String(), "ApplicationWebhookDelivery", "ApplicationWebhookDelivery", 1) + ","
+	}
+	repeatedStringForDeliveries += "}"
+	s := strings.Join([]string{`&ApplicationWebhookDeliveries{`,
+		`Deliveries:` + repeatedStringForDeliveries + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GetApplicationWebhookRequest) String() string {
 	if this == nil {
 		return "nil"
@@ -5970,6 +6497,74 @@ func (m *ApplicationWebhook) Unmarshal(dAtA []byte) error {
 			}
 			m.TemplateFields[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFilter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpFilter == nil {
+				m.UpFilter = &ApplicationUpFilter{}
+			}
+			if err := m.UpFilter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
@@ -6346,6 +6941,327 @@ func (m *ApplicationWebhookFormats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationWebhookDelivery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDelivery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+			}
+			m.StatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationWebhookDeliveries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationserverWeb
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationWebhookDeliveries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deliveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationserverWeb
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deliveries = append(m.Deliveries, &ApplicationWebhookDelivery{})
+			if err := m.Deliveries[len(m.Deliveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationserverWeb(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthApplicationserverWeb
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetApplicationWebhookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
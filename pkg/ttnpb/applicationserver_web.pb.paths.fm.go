@@ -93,6 +93,7 @@ var ApplicationWebhookTemplatesFieldPathsTopLevel = []string{
 }
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"content_encoding",
 	"created_at",
 	"downlink_ack",
 	"downlink_ack.path",
@@ -117,6 +118,9 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
+	"up_filter",
+	"up_filter.decoded_payload_conditions",
+	"up_filter.f_port_ranges",
 	"updated_at",
 	"uplink_message",
 	"uplink_message.path",
@@ -124,6 +128,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"content_encoding",
 	"created_at",
 	"downlink_ack",
 	"downlink_failed",
@@ -137,6 +142,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"location_solved",
 	"template_fields",
 	"template_ids",
+	"up_filter",
 	"updated_at",
 	"uplink_message",
 }
@@ -154,6 +160,32 @@ var ApplicationWebhookFormatsFieldPathsNested = []string{
 var ApplicationWebhookFormatsFieldPathsTopLevel = []string{
 	"formats",
 }
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"error",
+	"latency",
+	"response",
+	"status_code",
+	"time",
+	"url",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"error",
+	"latency",
+	"response",
+	"status_code",
+	"time",
+	"url",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+
 var GetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"ids",
@@ -180,6 +212,7 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.content_encoding",
 	"webhook.created_at",
 	"webhook.downlink_ack",
 	"webhook.downlink_ack.path",
@@ -204,6 +237,9 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
+	"webhook.up_filter",
+	"webhook.up_filter.decoded_payload_conditions",
+	"webhook.up_filter.f_port_ranges",
 	"webhook.updated_at",
 	"webhook.uplink_message",
 	"webhook.uplink_message.path",
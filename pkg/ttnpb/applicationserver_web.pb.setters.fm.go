@@ -704,6 +704,43 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 				}
 			}
 
+		case "up_filter":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUpFilter
+				if (src == nil || src.UpFilter == nil) && dst.UpFilter == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UpFilter
+				}
+				if dst.UpFilter != nil {
+					newDst = dst.UpFilter
+				} else {
+					newDst = &ApplicationUpFilter{}
+					dst.UpFilter = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UpFilter = src.UpFilter
+				} else {
+					dst.UpFilter = nil
+				}
+			}
+
+		case "content_encoding":
+			if len(subs) > 0 {
+				return fmt.Errorf("'content_encoding' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ContentEncoding = src.ContentEncoding
+			} else {
+				var zero string
+				dst.ContentEncoding = zero
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -751,6 +788,97 @@ func (dst *ApplicationWebhookFormats) SetFields(src *ApplicationWebhookFormats,
 	return nil
 }
 
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.URL = src.URL
+			} else {
+				var zero string
+				dst.URL = zero
+			}
+		case "status_code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status_code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StatusCode = src.StatusCode
+			} else {
+				var zero uint32
+				dst.StatusCode = zero
+			}
+		case "latency":
+			if len(subs) > 0 {
+				return fmt.Errorf("'latency' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Latency = src.Latency
+			} else {
+				var zero time.Duration
+				dst.Latency = zero
+			}
+		case "response":
+			if len(subs) > 0 {
+				return fmt.Errorf("'response' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Response = src.Response
+			} else {
+				var zero string
+				dst.Response = zero
+			}
+		case "error":
+			if len(subs) > 0 {
+				return fmt.Errorf("'error' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Error = src.Error
+			} else {
+				var zero string
+				dst.Error = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GetApplicationWebhookRequest) SetFields(src *GetApplicationWebhookRequest, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -974,6 +974,27 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "up_filter":
+
+			if v, ok := interface{}(m.GetUpFilter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "up_filter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "content_encoding":
+
+			if utf8.RuneCountInString(m.GetContentEncoding()) > 20 {
+				return ApplicationWebhookValidationError{
+					field:  "content_encoding",
+					reason: "value length must be at most 20 runes",
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -1224,6 +1245,209 @@ var _ interface {
 	ErrorName() string
 } = ApplicationWebhookFormatsValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookDelivery with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDelivery) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "url":
+			// no validation rules for URL
+		case "status_code":
+			// no validation rules for StatusCode
+		case "latency":
+
+		case "response":
+			// no validation rules for Response
+		case "error":
+			// no validation rules for Error
+		default:
+			return ApplicationWebhookDeliveryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveryValidationError is the validation error returned
+// by ApplicationWebhookDelivery.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveryValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveryValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeliveries with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookDeliveries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deliveries":
+
+			for idx, item := range m.GetDeliveries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeliveriesValidationError{
+							field:  fmt.Sprintf("deliveries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeliveriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveriesValidationError is the validation error returned
+// by ApplicationWebhookDeliveries.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveriesValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveriesValidationError{}
+
 // ValidateFields checks the field values on GetApplicationWebhookRequest with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
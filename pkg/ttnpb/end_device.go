@@ -54,6 +54,62 @@ func (v *PowerState) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalText implements encoding.TextMarshaler interface.
+func (v EndDeviceLifecycleState) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (v *EndDeviceLifecycleState) UnmarshalText(b []byte) error {
+	s := string(b)
+	if i, ok := EndDeviceLifecycleState_value[s]; ok {
+		*v = EndDeviceLifecycleState(i)
+		return nil
+	}
+	if !strings.HasPrefix(s, "LIFECYCLE_") {
+		if i, ok := EndDeviceLifecycleState_value["LIFECYCLE_"+s]; ok {
+			*v = EndDeviceLifecycleState(i)
+			return nil
+		}
+	}
+	return errCouldNotParse("EndDeviceLifecycleState")(string(b))
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (v *EndDeviceLifecycleState) UnmarshalJSON(b []byte) error {
+	if len(b) > 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return v.UnmarshalText(b[1 : len(b)-1])
+	}
+	i, err := strconv.Atoi(string(b))
+	if err != nil {
+		return errCouldNotParse("EndDeviceLifecycleState")(string(b)).WithCause(err)
+	}
+	*v = EndDeviceLifecycleState(i)
+	return nil
+}
+
+// CanTransitionTo returns whether the lifecycle state can be changed to the given state.
+// Devices cannot return to the commissioned state, and decommissioned devices cannot leave the decommissioned state.
+func (v EndDeviceLifecycleState) CanTransitionTo(state EndDeviceLifecycleState) bool {
+	switch {
+	case v == state:
+		return true
+	case v == EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED:
+		return false
+	case state == EndDeviceLifecycleState_LIFECYCLE_COMMISSIONED:
+		return false
+	}
+	return true
+}
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+func (m *MACSettings) ValidateContext(context.Context) error {
+	if min, max := m.GetADRMinDataRateIndex(), m.GetADRMaxDataRateIndex(); min != nil && max != nil && min.Value > max.Value {
+		return errExpectedLowerOrEqual("adr_min_data_rate_index", max.Value)(min.Value)
+	}
+	return m.ValidateFields()
+}
+
 // ValidateContext wraps the generated validator with (optionally context-based) custom checks.
 func (m *UpdateEndDeviceRequest) ValidateContext(context.Context) error {
 	if len(m.FieldMask.Paths) == 0 {
@@ -75,3 +131,12 @@ func (m *SetEndDeviceRequest) ValidateContext(context.Context) error {
 		"end_device.ids.device_id",
 	)...)
 }
+
+// ValidateContext wraps the generated validator with (optionally context-based) custom checks.
+// The devices are not validated here, so that the Network Server can report an invalid device in its result.
+func (m *SetEndDeviceBulkRequest) ValidateContext(context.Context) error {
+	if l := len(m.EndDevices); l < 1 || l > 100 {
+		return errExpectedBetween("end_devices", 1, 100)(l)
+	}
+	return m.ValidateFields("application_ids")
+}
@@ -64,6 +64,40 @@ func (PowerState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_a656ee0551c94a80, []int{0}
 }
 
+// Lifecycle state of the device.
+type EndDeviceLifecycleState int32
+
+const (
+	// The device has been activated on the network and is served normally.
+	// Devices are active by default.
+	EndDeviceLifecycleState_LIFECYCLE_ACTIVE EndDeviceLifecycleState = 0
+	// The device is registered, but has not been activated on the network yet.
+	// The device becomes active when the first data message is received.
+	EndDeviceLifecycleState_LIFECYCLE_COMMISSIONED EndDeviceLifecycleState = 1
+	// The device is temporarily taken out of service. Uplink messages are processed, but no downlink messages are scheduled.
+	EndDeviceLifecycleState_LIFECYCLE_SUSPENDED EndDeviceLifecycleState = 2
+	// The device is permanently taken out of service. Uplink messages and join-requests are dropped.
+	EndDeviceLifecycleState_LIFECYCLE_DECOMMISSIONED EndDeviceLifecycleState = 3
+)
+
+var EndDeviceLifecycleState_name = map[int32]string{
+	0: "LIFECYCLE_ACTIVE",
+	1: "LIFECYCLE_COMMISSIONED",
+	2: "LIFECYCLE_SUSPENDED",
+	3: "LIFECYCLE_DECOMMISSIONED",
+}
+
+var EndDeviceLifecycleState_value = map[string]int32{
+	"LIFECYCLE_ACTIVE":         0,
+	"LIFECYCLE_COMMISSIONED":   1,
+	"LIFECYCLE_SUSPENDED":      2,
+	"LIFECYCLE_DECOMMISSIONED": 3,
+}
+
+func (EndDeviceLifecycleState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{1}
+}
+
 type Session struct {
 	// Device Address, issued by the Network Server or chosen by device manufacturer in case of testing range (beginning with 00-03).
 	// Known by Network Server, Application Server and Join Server. Owned by Network Server.
@@ -888,8 +922,22 @@ type MACSettings struct {
 	// The ADR ACK delay Network Server should configure device to use via MAC commands.
 	// If unset, the default value from Network Server configuration or regional parameters specification will be used.
 	DesiredADRAckDelayExponent *ADRAckDelayExponentValue `protobuf:"bytes,24,opt,name=desired_adr_ack_delay_exponent,json=desiredAdrAckDelayExponent,proto3" json:"desired_adr_ack_delay_exponent,omitempty"`
-	XXX_NoUnkeyedLiteral       struct{}                  `json:"-"`
-	XXX_sizecache              int32                     `json:"-"`
+	// The minimum data rate index Network Server should use for the device in ADR.
+	// If unset, the minimum data rate index of the band is used.
+	ADRMinDataRateIndex *DataRateIndexValue `protobuf:"bytes,25,opt,name=adr_min_data_rate_index,json=adrMinDataRateIndex,proto3" json:"adr_min_data_rate_index,omitempty"`
+	// The maximum data rate index Network Server should use for the device in ADR.
+	// If unset, the maximum ADR data rate index of the band is used.
+	ADRMaxDataRateIndex *DataRateIndexValue `protobuf:"bytes,26,opt,name=adr_max_data_rate_index,json=adrMaxDataRateIndex,proto3" json:"adr_max_data_rate_index,omitempty"`
+	// The maximum TX power index Network Server should use for the device in ADR.
+	// A higher index means a lower TX power, so this bounds the TX power reduction.
+	// If unset, the maximum TX power index of the band is used.
+	ADRMaxTxPowerIndex *types.UInt32Value `protobuf:"bytes,27,opt,name=adr_max_tx_power_index,json=adrMaxTxPowerIndex,proto3" json:"adr_max_tx_power_index,omitempty"`
+	// The maximum FCnt of uplink messages that Network Server accepts as frame counter reset, if the device resets frame counters.
+	// 0 accepts frame counter resets with any FCnt.
+	// If unset, the default value from Network Server configuration will be used.
+	FCntResetGrace       *types.UInt32Value `protobuf:"bytes,28,opt,name=f_cnt_reset_grace,json=fCntResetGrace,proto3" json:"f_cnt_reset_grace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *MACSettings) Reset()      { *m = MACSettings{} }
@@ -1092,6 +1140,34 @@ func (m *MACSettings) GetDesiredADRAckDelayExponent() *ADRAckDelayExponentValue
 	return nil
 }
 
+func (m *MACSettings) GetADRMinDataRateIndex() *DataRateIndexValue {
+	if m != nil {
+		return m.ADRMinDataRateIndex
+	}
+	return nil
+}
+
+func (m *MACSettings) GetADRMaxDataRateIndex() *DataRateIndexValue {
+	if m != nil {
+		return m.ADRMaxDataRateIndex
+	}
+	return nil
+}
+
+func (m *MACSettings) GetADRMaxTxPowerIndex() *types.UInt32Value {
+	if m != nil {
+		return m.ADRMaxTxPowerIndex
+	}
+	return nil
+}
+
+func (m *MACSettings) GetFCntResetGrace() *types.UInt32Value {
+	if m != nil {
+		return m.FCntResetGrace
+	}
+	return nil
+}
+
 // MACState represents the state of MAC layer of the device.
 // MACState is reset on each join for OTAA or ResetInd for ABP devices.
 // This is used internally by the Network Server and is read only.
@@ -1322,6 +1398,134 @@ func (m *MACState_JoinAccept) GetKeys() SessionKeys {
 	return SessionKeys{}
 }
 
+// MAC layer trace of a data message that the Network Server received from or scheduled for an end device.
+type MACTraceEntry struct {
+	// Time when the Network Server received the uplink message or scheduled the downlink message.
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	// Whether the message is an uplink message. Otherwise, it is a downlink message.
+	Uplink bool `protobuf:"varint,2,opt,name=uplink,proto3" json:"uplink,omitempty"`
+	// Frame counter of the message.
+	FCnt uint32 `protobuf:"varint,3,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// Whether the ADR bit is set in the message.
+	ADR bool `protobuf:"varint,4,opt,name=adr,proto3" json:"adr,omitempty"`
+	// MAC commands in the message, as parsed or generated by the Network Server.
+	MACCommands []*MACCommand `protobuf:"bytes,5,rep,name=mac_commands,json=macCommands,proto3" json:"mac_commands,omitempty"`
+	// Data rate index of the uplink message.
+	DataRateIndex DataRateIndex `protobuf:"varint,6,opt,name=data_rate_index,json=dataRateIndex,proto3,enum=ttn.lorawan.v3.DataRateIndex" json:"data_rate_index,omitempty"`
+	// Class of the downlink message.
+	Class Class `protobuf:"varint,7,opt,name=class,proto3,enum=ttn.lorawan.v3.Class" json:"class,omitempty"`
+	// Priority of the downlink message.
+	Priority TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	// Gateways that the Network Server tried to schedule the downlink message through, in order.
+	GatewayIDs []GatewayIdentifiers `protobuf:"bytes,9,rep,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids"`
+	// Time when the downlink message is transmitted.
+	TransmitAt           *time.Time `protobuf:"bytes,10,opt,name=transmit_at,json=transmitAt,proto3,stdtime" json:"transmit_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *MACTraceEntry) Reset()      { *m = MACTraceEntry{} }
+func (*MACTraceEntry) ProtoMessage() {}
+func (*MACTraceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a656ee0551c94a80, []int{8}
+}
+func (m *MACTraceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACTraceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACTraceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MACTraceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACTraceEntry.Merge(m, src)
+}
+func (m *MACTraceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACTraceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACTraceEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MACTraceEntry proto.InternalMessageInfo
+
+func (m *MACTraceEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MACTraceEntry) GetUplink() bool {
+	if m != nil {
+		return m.Uplink
+	}
+	return false
+}
+
+func (m *MACTraceEntry) GetFCnt() uint32 {
+	if m != nil {
+		return m.FCnt
+	}
+	return 0
+}
+
+func (m *MACTraceEntry) GetADR() bool {
+	if m != nil {
+		return m.ADR
+	}
+	return false
+}
+
+func (m *MACTraceEntry) GetMACCommands() []*MACCommand {
+	if m != nil {
+		return m.MACCommands
+	}
+	return nil
+}
+
+func (m *MACTraceEntry) GetDataRateIndex() DataRateIndex {
+	if m != nil {
+		return m.DataRateIndex
+	}
+	return DATA_RATE_0
+}
+
+func (m *MACTraceEntry) GetClass() Class {
+	if m != nil {
+		return m.Class
+	}
+	return CLASS_A
+}
+
+func (m *MACTraceEntry) GetPriority() TxSchedulePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return TxSchedulePriority_LOWEST
+}
+
+func (m *MACTraceEntry) GetGatewayIDs() []GatewayIdentifiers {
+	if m != nil {
+		return m.GatewayIDs
+	}
+	return nil
+}
+
+func (m *MACTraceEntry) GetTransmitAt() *time.Time {
+	if m != nil {
+		return m.TransmitAt
+	}
+	return nil
+}
+
 // Authentication code for end devices.
 type EndDeviceAuthenticationCode struct {
 	Value                string     `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -1334,7 +1538,7 @@ type EndDeviceAuthenticationCode struct {
 func (m *EndDeviceAuthenticationCode) Reset()      { *m = EndDeviceAuthenticationCode{} }
 func (*EndDeviceAuthenticationCode) ProtoMessage() {}
 func (*EndDeviceAuthenticationCode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{8}
+	return fileDescriptor_a656ee0551c94a80, []int{9}
 }
 func (m *EndDeviceAuthenticationCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,14 +1734,27 @@ type EndDevice struct {
 	Multicast bool `protobuf:"varint,45,opt,name=multicast,proto3" json:"multicast,omitempty"`
 	// Authentication code to claim ownership of the end device. Stored in Join Server.
 	ClaimAuthenticationCode *EndDeviceAuthenticationCode `protobuf:"bytes,46,opt,name=claim_authentication_code,json=claimAuthenticationCode,proto3" json:"claim_authentication_code,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}                     `json:"-"`
-	XXX_sizecache           int32                        `json:"-"`
+	// Lifecycle state of the device.
+	// Stored in Network Server.
+	LifecycleState EndDeviceLifecycleState `protobuf:"varint,50,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=ttn.lorawan.v3.EndDeviceLifecycleState" json:"lifecycle_state,omitempty"`
+	// Time zone of the device, as IANA time zone name, for example Europe/Amsterdam.
+	// Schedulers use the time zone to interpret local times. If empty, the time zone of the application is used.
+	// Stored in Entity Registry.
+	TimeZone string `protobuf:"bytes,51,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Mute windows of the device, in addition to the mute windows of the application.
+	// Stored in Entity Registry.
+	MuteWindows []*MuteWindow `protobuf:"bytes,52,rep,name=mute_windows,json=muteWindows,proto3" json:"mute_windows,omitempty"`
+	// MAC layer trace of recent data messages sorted by time. Stored in Network Server.
+	// The number of entries stored depends on configuration; no entries are stored by default.
+	MACTrace             []*MACTraceEntry `protobuf:"bytes,53,rep,name=mac_trace,json=macTrace,proto3" json:"mac_trace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *EndDevice) Reset()      { *m = EndDevice{} }
 func (*EndDevice) ProtoMessage() {}
 func (*EndDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{9}
+	return fileDescriptor_a656ee0551c94a80, []int{10}
 }
 func (m *EndDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,6 +2112,34 @@ func (m *EndDevice) GetClaimAuthenticationCode() *EndDeviceAuthenticationCode {
 	return nil
 }
 
+func (m *EndDevice) GetLifecycleState() EndDeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleState
+	}
+	return EndDeviceLifecycleState_LIFECYCLE_ACTIVE
+}
+
+func (m *EndDevice) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *EndDevice) GetMuteWindows() []*MuteWindow {
+	if m != nil {
+		return m.MuteWindows
+	}
+	return nil
+}
+
+func (m *EndDevice) GetMACTrace() []*MACTraceEntry {
+	if m != nil {
+		return m.MACTrace
+	}
+	return nil
+}
+
 type EndDevices struct {
 	EndDevices           []*EndDevice `protobuf:"bytes,1,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -1904,7 +2149,7 @@ type EndDevices struct {
 func (m *EndDevices) Reset()      { *m = EndDevices{} }
 func (*EndDevices) ProtoMessage() {}
 func (*EndDevices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{10}
+	return fileDescriptor_a656ee0551c94a80, []int{11}
 }
 func (m *EndDevices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,7 +2194,7 @@ type CreateEndDeviceRequest struct {
 func (m *CreateEndDeviceRequest) Reset()      { *m = CreateEndDeviceRequest{} }
 func (*CreateEndDeviceRequest) ProtoMessage() {}
 func (*CreateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{11}
+	return fileDescriptor_a656ee0551c94a80, []int{12}
 }
 func (m *CreateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1988,7 +2233,7 @@ type UpdateEndDeviceRequest struct {
 func (m *UpdateEndDeviceRequest) Reset()      { *m = UpdateEndDeviceRequest{} }
 func (*UpdateEndDeviceRequest) ProtoMessage() {}
 func (*UpdateEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{12}
+	return fileDescriptor_a656ee0551c94a80, []int{13}
 }
 func (m *UpdateEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2034,7 +2279,7 @@ type GetEndDeviceRequest struct {
 func (m *GetEndDeviceRequest) Reset()      { *m = GetEndDeviceRequest{} }
 func (*GetEndDeviceRequest) ProtoMessage() {}
 func (*GetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{13}
+	return fileDescriptor_a656ee0551c94a80, []int{14}
 }
 func (m *GetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2080,7 +2325,7 @@ type GetEndDeviceIdentifiersForEUIsRequest struct {
 func (m *GetEndDeviceIdentifiersForEUIsRequest) Reset()      { *m = GetEndDeviceIdentifiersForEUIsRequest{} }
 func (*GetEndDeviceIdentifiersForEUIsRequest) ProtoMessage() {}
 func (*GetEndDeviceIdentifiersForEUIsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{14}
+	return fileDescriptor_a656ee0551c94a80, []int{15}
 }
 func (m *GetEndDeviceIdentifiersForEUIsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2126,7 +2371,7 @@ type ListEndDevicesRequest struct {
 func (m *ListEndDevicesRequest) Reset()      { *m = ListEndDevicesRequest{} }
 func (*ListEndDevicesRequest) ProtoMessage() {}
 func (*ListEndDevicesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{15}
+	return fileDescriptor_a656ee0551c94a80, []int{16}
 }
 func (m *ListEndDevicesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2193,7 +2438,7 @@ type SetEndDeviceRequest struct {
 func (m *SetEndDeviceRequest) Reset()      { *m = SetEndDeviceRequest{} }
 func (*SetEndDeviceRequest) ProtoMessage() {}
 func (*SetEndDeviceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{16}
+	return fileDescriptor_a656ee0551c94a80, []int{17}
 }
 func (m *SetEndDeviceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2247,7 +2492,7 @@ type EndDeviceTemplate struct {
 func (m *EndDeviceTemplate) Reset()      { *m = EndDeviceTemplate{} }
 func (*EndDeviceTemplate) ProtoMessage() {}
 func (*EndDeviceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{17}
+	return fileDescriptor_a656ee0551c94a80, []int{18}
 }
 func (m *EndDeviceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2308,7 +2553,7 @@ type EndDeviceTemplateFormat struct {
 func (m *EndDeviceTemplateFormat) Reset()      { *m = EndDeviceTemplateFormat{} }
 func (*EndDeviceTemplateFormat) ProtoMessage() {}
 func (*EndDeviceTemplateFormat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{18}
+	return fileDescriptor_a656ee0551c94a80, []int{19}
 }
 func (m *EndDeviceTemplateFormat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2367,7 +2612,7 @@ type EndDeviceTemplateFormats struct {
 func (m *EndDeviceTemplateFormats) Reset()      { *m = EndDeviceTemplateFormats{} }
 func (*EndDeviceTemplateFormats) ProtoMessage() {}
 func (*EndDeviceTemplateFormats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{19}
+	return fileDescriptor_a656ee0551c94a80, []int{20}
 }
 func (m *EndDeviceTemplateFormats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2415,7 +2660,7 @@ type ConvertEndDeviceTemplateRequest struct {
 func (m *ConvertEndDeviceTemplateRequest) Reset()      { *m = ConvertEndDeviceTemplateRequest{} }
 func (*ConvertEndDeviceTemplateRequest) ProtoMessage() {}
 func (*ConvertEndDeviceTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a656ee0551c94a80, []int{20}
+	return fileDescriptor_a656ee0551c94a80, []int{21}
 }
 func (m *ConvertEndDeviceTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,6 +2706,8 @@ func (m *ConvertEndDeviceTemplateRequest) GetData() []byte {
 func init() {
 	proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PowerState", PowerState_name, PowerState_value)
+	proto.RegisterEnum("ttn.lorawan.v3.EndDeviceLifecycleState", EndDeviceLifecycleState_name, EndDeviceLifecycleState_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.EndDeviceLifecycleState", EndDeviceLifecycleState_name, EndDeviceLifecycleState_value)
 	proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
 	golang_proto.RegisterType((*Session)(nil), "ttn.lorawan.v3.Session")
 	proto.RegisterType((*MACParameters)(nil), "ttn.lorawan.v3.MACParameters")
@@ -2481,6 +2728,8 @@ func init() {
 	golang_proto.RegisterType((*MACState)(nil), "ttn.lorawan.v3.MACState")
 	proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
 	golang_proto.RegisterType((*MACState_JoinAccept)(nil), "ttn.lorawan.v3.MACState.JoinAccept")
+	proto.RegisterType((*MACTraceEntry)(nil), "ttn.lorawan.v3.MACTraceEntry")
+	golang_proto.RegisterType((*MACTraceEntry)(nil), "ttn.lorawan.v3.MACTraceEntry")
 	proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	golang_proto.RegisterType((*EndDeviceAuthenticationCode)(nil), "ttn.lorawan.v3.EndDeviceAuthenticationCode")
 	proto.RegisterType((*EndDevice)(nil), "ttn.lorawan.v3.EndDevice")
@@ -2515,304 +2764,335 @@ func init() {
 	golang_proto.RegisterType((*ConvertEndDeviceTemplateRequest)(nil), "ttn.lorawan.v3.ConvertEndDeviceTemplateRequest")
 }
 
-func init() { proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80) }
+func init() {
+	proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
+}
 func init() {
 	golang_proto.RegisterFile("lorawan-stack/api/end_device.proto", fileDescriptor_a656ee0551c94a80)
 }
 
 var fileDescriptor_a656ee0551c94a80 = []byte{
-	// 4641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7a, 0x4b, 0x6c, 0x23, 0x47,
-	0x7a, 0x3f, 0x9b, 0xa4, 0x44, 0xb2, 0xf4, 0x20, 0x59, 0x7a, 0xf5, 0x68, 0x66, 0x48, 0x99, 0xa3,
-	0xb1, 0x39, 0xe3, 0x11, 0x67, 0xc4, 0xb1, 0xbd, 0xf6, 0xd8, 0xfe, 0x6b, 0xd9, 0xa2, 0x64, 0x73,
-	0x66, 0x24, 0x6b, 0x6b, 0x5e, 0x7f, 0xcf, 0xab, 0xb7, 0xc4, 0x2e, 0x69, 0xda, 0x22, 0xbb, 0xb9,
-	0xdd, 0x4d, 0x3d, 0x6c, 0x0f, 0x60, 0x04, 0x09, 0x76, 0xb1, 0x48, 0x82, 0xcd, 0x5e, 0xb2, 0xc8,
-	0x21, 0x30, 0x02, 0x04, 0xd8, 0xe3, 0x22, 0xc8, 0x02, 0xbe, 0x65, 0x2f, 0x09, 0x0c, 0x04, 0x01,
-	0xe6, 0xb0, 0x87, 0xc5, 0x1e, 0x98, 0x1d, 0xce, 0xc5, 0xc7, 0x3d, 0x2e, 0x74, 0x08, 0x82, 0x7a,
-	0xf4, 0x83, 0x0f, 0xbd, 0x6c, 0x67, 0xe1, 0x8b, 0xd4, 0xac, 0xfa, 0xbe, 0xdf, 0xf7, 0xd5, 0x57,
-	0x55, 0x5f, 0x7d, 0xdf, 0x57, 0x05, 0x72, 0x35, 0xd3, 0xc2, 0x3b, 0xd8, 0x98, 0xb3, 0x1d, 0x5c,
-	0xdd, 0xba, 0x8c, 0x1b, 0xfa, 0x65, 0x62, 0x68, 0xaa, 0x46, 0xb6, 0xf5, 0x2a, 0x29, 0x34, 0x2c,
-	0xd3, 0x31, 0xe1, 0xa8, 0xe3, 0x18, 0x05, 0x41, 0x57, 0xd8, 0xbe, 0x3a, 0x5d, 0xda, 0xd4, 0x9d,
-	0x27, 0xcd, 0xf5, 0x42, 0xd5, 0xac, 0x5f, 0x26, 0xc6, 0xb6, 0xb9, 0xd7, 0xb0, 0xcc, 0xdd, 0xbd,
-	0xcb, 0x8c, 0xb8, 0x3a, 0xb7, 0x49, 0x8c, 0xb9, 0x6d, 0x5c, 0xd3, 0x35, 0xec, 0x90, 0xcb, 0x3d,
-	0x1f, 0x1c, 0x72, 0x7a, 0x2e, 0x00, 0xb1, 0x69, 0x6e, 0x9a, 0x9c, 0x79, 0xbd, 0xb9, 0xc1, 0x7e,
-	0xb1, 0x1f, 0xec, 0x4b, 0x90, 0x9f, 0xd9, 0x34, 0xcd, 0xcd, 0x1a, 0x61, 0xea, 0x61, 0xc3, 0x30,
-	0x1d, 0xec, 0xe8, 0xa6, 0x61, 0x8b, 0xde, 0x8c, 0xe8, 0xf5, 0x30, 0xb4, 0xa6, 0xc5, 0x08, 0x44,
-	0xff, 0xe9, 0xee, 0x7e, 0x52, 0x6f, 0x38, 0x7b, 0xa2, 0x73, 0xa6, 0xbb, 0x73, 0x43, 0x27, 0x35,
-	0x4d, 0xad, 0x63, 0x7b, 0xab, 0x4b, 0xb8, 0x47, 0x61, 0x3b, 0x56, 0xb3, 0xea, 0x88, 0xde, 0x6c,
-	0x77, 0xaf, 0xa3, 0xd7, 0x89, 0xed, 0xe0, 0x7a, 0xe3, 0x20, 0xed, 0x76, 0x2c, 0xdc, 0x68, 0x10,
-	0xcb, 0xd5, 0xfe, 0x5c, 0xef, 0x0c, 0xe8, 0x1a, 0x31, 0x1c, 0x7d, 0x43, 0xf7, 0x89, 0xce, 0xf4,
-	0x12, 0x7d, 0x64, 0xea, 0xc6, 0xc1, 0xbd, 0x5b, 0x64, 0xcf, 0xe5, 0xcd, 0xf6, 0xf6, 0xba, 0x93,
-	0x29, 0x4c, 0xd0, 0x4b, 0x50, 0x27, 0xb6, 0x8d, 0x37, 0x89, 0x7d, 0x18, 0x85, 0x83, 0x35, 0xec,
-	0x60, 0x4e, 0x91, 0xfb, 0xfb, 0x08, 0x88, 0xdd, 0x22, 0xb6, 0xad, 0x9b, 0x06, 0xbc, 0x07, 0xe2,
-	0x1a, 0xd9, 0x56, 0xb1, 0xa6, 0x59, 0x72, 0x78, 0x46, 0xca, 0x0f, 0x2b, 0xef, 0x7c, 0xd9, 0xca,
-	0x86, 0x7e, 0xdf, 0xca, 0xbe, 0xb6, 0x69, 0x16, 0x9c, 0x27, 0xc4, 0x79, 0xa2, 0x1b, 0x9b, 0x76,
-	0xc1, 0x20, 0xce, 0x8e, 0x69, 0x6d, 0x5d, 0xee, 0x04, 0x6f, 0x6c, 0x6d, 0x5e, 0x76, 0xf6, 0x1a,
-	0xc4, 0x2e, 0x94, 0xc9, 0x76, 0x49, 0xd3, 0x2c, 0x14, 0xd3, 0xf8, 0x07, 0x2c, 0x81, 0x28, 0x1d,
-	0x97, 0x1c, 0x99, 0x91, 0xf2, 0x43, 0xc5, 0xd3, 0x85, 0xce, 0x75, 0x59, 0x10, 0xf2, 0x6f, 0x90,
-	0x3d, 0x5b, 0x49, 0xed, 0x2b, 0x03, 0x3f, 0x95, 0xc2, 0x29, 0x89, 0x4a, 0x7e, 0xd6, 0xca, 0x4a,
-	0x88, 0xb1, 0xc2, 0x97, 0xc0, 0x48, 0x0d, 0xdb, 0x8e, 0xba, 0xa1, 0x56, 0x0d, 0x47, 0x6d, 0x36,
-	0xe4, 0xe8, 0x8c, 0x94, 0x1f, 0x41, 0x80, 0x36, 0x2e, 0x2f, 0x1a, 0xce, 0x9d, 0x06, 0xcc, 0x83,
-	0x34, 0x23, 0x31, 0x04, 0x91, 0x66, 0xee, 0x18, 0xf2, 0x00, 0x23, 0x63, 0xbc, 0xab, 0x94, 0xae,
-	0x6c, 0xee, 0x18, 0x1e, 0x25, 0x0e, 0x52, 0x0e, 0xfa, 0x94, 0x25, 0x8f, 0xb2, 0x00, 0xc6, 0x19,
-	0x65, 0xd5, 0x34, 0x36, 0x82, 0xc4, 0x31, 0x46, 0x9c, 0xa2, 0x7d, 0x8b, 0xa6, 0xb1, 0xe1, 0xd1,
-	0x2f, 0x02, 0x60, 0x3b, 0xd8, 0x72, 0x88, 0xa6, 0x62, 0x47, 0x8e, 0xb3, 0xf1, 0x4e, 0x17, 0xf8,
-	0x4a, 0x2a, 0xb8, 0x2b, 0xa9, 0x70, 0xdb, 0x5d, 0x6a, 0x4a, 0x9c, 0x0e, 0xf3, 0x67, 0xff, 0x9d,
-	0x95, 0x50, 0x42, 0xf0, 0x95, 0x9c, 0xeb, 0xd1, 0xb8, 0x94, 0x0a, 0xe7, 0xfe, 0x33, 0x09, 0x46,
-	0x56, 0x4a, 0x8b, 0x6b, 0xd8, 0xc2, 0x75, 0xe2, 0x10, 0xcb, 0x86, 0x2f, 0x83, 0x78, 0x1d, 0xef,
-	0xaa, 0x44, 0xb7, 0x1a, 0xb2, 0x34, 0x23, 0xe5, 0xc3, 0xca, 0x50, 0xbb, 0x95, 0x8d, 0xad, 0xe0,
-	0xdd, 0xa5, 0x0a, 0x5a, 0x43, 0xb1, 0x3a, 0xde, 0x5d, 0xd2, 0xad, 0x06, 0xfc, 0x08, 0x8c, 0x61,
-	0xcd, 0x52, 0xe9, 0x2c, 0xab, 0x16, 0x76, 0x88, 0xaa, 0x1b, 0x1a, 0xd9, 0x65, 0x16, 0x1b, 0x2d,
-	0x9e, 0xed, 0xb6, 0x7e, 0x19, 0x3b, 0x18, 0x61, 0x87, 0x54, 0x28, 0x91, 0x72, 0x66, 0x5f, 0x19,
-	0xf8, 0x0b, 0x6a, 0xff, 0x76, 0x2b, 0x9b, 0x2a, 0x95, 0x51, 0x47, 0x2f, 0x4a, 0x61, 0xcd, 0xea,
-	0x68, 0x81, 0xef, 0x01, 0x48, 0x65, 0x39, 0xbb, 0x6a, 0xc3, 0xdc, 0x21, 0x96, 0x10, 0xc5, 0xac,
-	0xae, 0x4c, 0xef, 0x2b, 0xd1, 0x8b, 0x61, 0x39, 0xd9, 0x6e, 0x65, 0x93, 0xa5, 0x32, 0xba, 0xbd,
-	0xbb, 0x46, 0x49, 0x38, 0x52, 0x12, 0x6b, 0x56, 0xb0, 0x01, 0x7e, 0x0f, 0x0c, 0x53, 0x20, 0x63,
-	0x5d, 0x75, 0x2c, 0x6c, 0xd8, 0x7c, 0x3a, 0x94, 0x09, 0x1f, 0x02, 0x94, 0xca, 0x68, 0x75, 0xfd,
-	0x36, 0xed, 0x44, 0x00, 0x6b, 0x96, 0xf8, 0x86, 0xaf, 0x83, 0x11, 0xca, 0x88, 0xab, 0x5b, 0x6a,
-	0x4d, 0xaf, 0xeb, 0x0e, 0x9f, 0x1b, 0x25, 0xdd, 0x6e, 0x65, 0x87, 0x4a, 0x65, 0x54, 0xaa, 0x6e,
-	0xdd, 0x64, 0xcd, 0x12, 0x1a, 0xc2, 0x9a, 0xe5, 0xfe, 0x0c, 0xb2, 0x69, 0xa4, 0x86, 0xf7, 0xd8,
-	0x64, 0x75, 0xb0, 0x95, 0x59, 0xb3, 0xc7, 0xc6, 0x7e, 0xc2, 0xff, 0x07, 0x12, 0xd6, 0xee, 0xbc,
-	0x60, 0x49, 0x30, 0x8b, 0x4e, 0x75, 0x5b, 0x14, 0xed, 0x32, 0x5a, 0x25, 0xee, 0xda, 0x12, 0xc5,
-	0xad, 0xdd, 0x79, 0xce, 0xff, 0x26, 0x18, 0x67, 0xfc, 0xde, 0xdc, 0x98, 0x1b, 0x1b, 0x36, 0x71,
-	0x64, 0xc0, 0xa4, 0xc7, 0xf8, 0x70, 0x63, 0x28, 0x4d, 0x19, 0x84, 0xa1, 0x3f, 0x60, 0x14, 0xf0,
-	0x2e, 0x18, 0xb3, 0x76, 0x8b, 0x3d, 0xb3, 0x3a, 0x74, 0x9c, 0x59, 0xf5, 0x35, 0x49, 0x59, 0xbb,
-	0xc5, 0xce, 0x19, 0x2c, 0x80, 0x11, 0x8a, 0xbb, 0x61, 0x91, 0x1f, 0x35, 0x89, 0x51, 0xdd, 0x93,
-	0x87, 0x67, 0xa4, 0x7c, 0x54, 0x49, 0xec, 0x2b, 0x83, 0xc5, 0x68, 0xfe, 0xf3, 0xbf, 0x19, 0x44,
-	0xc3, 0xd6, 0x6e, 0x71, 0xd9, 0xed, 0x86, 0xb7, 0xc0, 0x28, 0x5d, 0x85, 0x5a, 0xd3, 0xd9, 0x53,
-	0xab, 0x7b, 0xd5, 0x1a, 0x91, 0x47, 0x98, 0x0a, 0xe7, 0xba, 0x55, 0x28, 0x6d, 0x6e, 0x5a, 0x64,
-	0x13, 0x3b, 0x44, 0x2b, 0x37, 0x9d, 0xbd, 0x45, 0x4a, 0x1a, 0x50, 0x64, 0xb8, 0x8e, 0x77, 0xbd,
-	0x76, 0xa8, 0x81, 0x29, 0x8b, 0x50, 0xcf, 0xa8, 0x52, 0x37, 0xac, 0x36, 0x88, 0xa5, 0x9b, 0x9a,
-	0x5e, 0xd5, 0x9d, 0x3d, 0x79, 0x94, 0xa1, 0xe7, 0x7a, 0x8c, 0xcc, 0xc8, 0xe9, 0x4e, 0x5a, 0xda,
-	0x6d, 0x98, 0x06, 0x31, 0x9c, 0x00, 0xf8, 0x84, 0xe5, 0xf5, 0xae, 0xf9, 0x50, 0x70, 0x13, 0xc8,
-	0x42, 0x4a, 0xd5, 0x6c, 0x1a, 0x4e, 0x87, 0x98, 0x64, 0xff, 0x41, 0x70, 0x31, 0x8b, 0x94, 0xbc,
-	0x8f, 0x9c, 0x49, 0xcb, 0xef, 0x0e, 0x0a, 0x7a, 0x1b, 0x8c, 0x35, 0x74, 0x63, 0x53, 0xb5, 0x6b,
-	0xa6, 0x13, 0xb0, 0x6c, 0x8a, 0x59, 0x76, 0x68, 0x5f, 0x89, 0x17, 0x07, 0xe5, 0x10, 0xb3, 0x6d,
-	0x9a, 0xd2, 0xdd, 0xaa, 0x99, 0x8e, 0x6f, 0x60, 0x0c, 0x4e, 0xf9, 0xcc, 0xdd, 0xd3, 0x9d, 0x3e,
-	0xd9, 0x74, 0x4f, 0xb8, 0xf0, 0x9d, 0x73, 0xfe, 0x06, 0x48, 0xad, 0x13, 0x5c, 0x35, 0x8d, 0x80,
-	0x72, 0xb0, 0x57, 0xb9, 0x24, 0x27, 0xf2, 0x55, 0xbb, 0x01, 0xe2, 0xd5, 0x27, 0xd8, 0x30, 0x48,
-	0xcd, 0x96, 0xc7, 0x66, 0x22, 0xf9, 0xa1, 0xe2, 0xf9, 0x6e, 0x4d, 0x3a, 0x5c, 0x56, 0x61, 0x91,
-	0x53, 0x33, 0x8d, 0x7e, 0x2e, 0x85, 0xe3, 0x12, 0xf2, 0x00, 0xe0, 0x32, 0x48, 0x37, 0x1b, 0x35,
-	0xdd, 0xd8, 0x52, 0xb5, 0x1d, 0x52, 0xab, 0xb1, 0x99, 0x97, 0xc7, 0x0f, 0x70, 0x99, 0x8a, 0x69,
-	0xd6, 0xee, 0xe2, 0x5a, 0x93, 0xa0, 0x24, 0x67, 0x2a, 0x53, 0x1e, 0x3a, 0xc1, 0xf0, 0x3a, 0x18,
-	0xa3, 0x3e, 0xb9, 0x1b, 0x69, 0xe2, 0x48, 0xa4, 0xb4, 0xcb, 0xe6, 0x63, 0x6d, 0x83, 0xc9, 0x0e,
-	0x67, 0xa2, 0x12, 0x31, 0xe9, 0xf2, 0x24, 0x83, 0xcb, 0xf7, 0x2c, 0x72, 0xdf, 0xc3, 0xb8, 0xeb,
-	0x83, 0x81, 0x2b, 0x53, 0xed, 0x56, 0x76, 0xac, 0x4f, 0x2f, 0x1a, 0x0b, 0x78, 0x21, 0xb7, 0x31,
-	0x28, 0x97, 0xb9, 0x16, 0x5f, 0xee, 0xd4, 0x61, 0x72, 0x99, 0x4f, 0x39, 0x50, 0x6e, 0x47, 0xaf,
-	0x2b, 0xb7, 0xa3, 0x71, 0xfa, 0xb7, 0x61, 0x10, 0x13, 0x73, 0x04, 0x5f, 0x03, 0x29, 0x31, 0x1f,
-	0xfe, 0xa2, 0x90, 0xba, 0x7d, 0x81, 0xb0, 0xbe, 0xbf, 0x24, 0xde, 0x04, 0xd0, 0xb3, 0xbe, 0xcf,
-	0x17, 0xee, 0xe6, 0xf3, 0x6c, 0xed, 0x73, 0xde, 0x05, 0x63, 0x75, 0xdd, 0xe8, 0x59, 0xe1, 0x91,
-	0x13, 0x3a, 0xb4, 0xba, 0x6e, 0x74, 0x2e, 0x6e, 0x8a, 0x4b, 0x1d, 0xd4, 0xd7, 0x39, 0xfe, 0x82,
-	0xb8, 0x78, 0xb7, 0x13, 0xf7, 0x1c, 0x18, 0x21, 0x06, 0x5e, 0xaf, 0x11, 0x95, 0xdb, 0x80, 0x9d,
-	0x72, 0x71, 0x34, 0xcc, 0x1b, 0xef, 0xb0, 0xb6, 0x6b, 0xd1, 0x2f, 0x3e, 0xcf, 0x86, 0xf8, 0xdf,
-	0xeb, 0xd1, 0x78, 0x38, 0x15, 0xb9, 0x1e, 0x8d, 0x47, 0x52, 0xd1, 0x5c, 0x1d, 0x8c, 0x2e, 0x19,
-	0x5a, 0x99, 0x85, 0xe7, 0x8a, 0x85, 0x0d, 0x0d, 0x4e, 0x82, 0xb0, 0xae, 0x31, 0x03, 0x27, 0x94,
-	0xc1, 0x76, 0x2b, 0x1b, 0xae, 0x94, 0x51, 0x58, 0xd7, 0x20, 0x04, 0x51, 0x03, 0xd7, 0x09, 0x33,
-	0x61, 0x02, 0xb1, 0x6f, 0x78, 0x0a, 0x44, 0x9a, 0x56, 0x8d, 0x99, 0x26, 0xa1, 0xc4, 0xda, 0xad,
-	0x6c, 0xe4, 0x0e, 0xba, 0x89, 0x68, 0x1b, 0x1c, 0x07, 0x03, 0x35, 0x73, 0xd3, 0xb4, 0xe5, 0xe8,
-	0x4c, 0x24, 0x9f, 0x40, 0xfc, 0x47, 0xee, 0x5f, 0xa4, 0x80, 0xbc, 0x15, 0x53, 0x23, 0x35, 0xb8,
-	0x02, 0xe2, 0xeb, 0x54, 0xb0, 0xea, 0x49, 0x2d, 0xee, 0x2b, 0xb3, 0x56, 0x4e, 0x9e, 0x2d, 0x66,
-	0x1e, 0x3f, 0xc0, 0x73, 0x1f, 0x5f, 0x99, 0x7b, 0xeb, 0x51, 0x7e, 0xe1, 0xda, 0x83, 0xb9, 0x47,
-	0x0b, 0xee, 0xcf, 0x0b, 0x9f, 0x14, 0x2f, 0x3d, 0x9d, 0xa5, 0x41, 0x06, 0xd3, 0xb9, 0x52, 0x46,
-	0x31, 0x86, 0x51, 0xd1, 0xe0, 0xbb, 0x4c, 0x7d, 0xa6, 0xa4, 0x32, 0x77, 0x7c, 0xa0, 0xee, 0x51,
-	0x46, 0xfc, 0x51, 0xe6, 0xfe, 0x2e, 0x0c, 0x4e, 0x7b, 0x4a, 0xdf, 0x25, 0x16, 0x0d, 0x0a, 0x2b,
-	0x7e, 0x48, 0xfd, 0x6d, 0x8f, 0x60, 0x05, 0xc4, 0xeb, 0xd4, 0x32, 0xaa, 0x37, 0x8e, 0x93, 0xc0,
-	0x31, 0xa3, 0x52, 0x38, 0x86, 0x51, 0xd1, 0xe0, 0x05, 0x90, 0x7a, 0x82, 0x2d, 0x6d, 0x07, 0x5b,
-	0x44, 0xdd, 0xe6, 0xca, 0x8b, 0xd1, 0x25, 0xdd, 0x76, 0x31, 0x26, 0x4a, 0xba, 0xa1, 0x5b, 0xf5,
-	0x0e, 0xd2, 0x28, 0x27, 0x75, 0xdb, 0x05, 0x69, 0xee, 0xb7, 0x83, 0x20, 0xd5, 0x6d, 0x13, 0xf8,
-	0x01, 0x88, 0xe8, 0x9a, 0xcd, 0x6c, 0x30, 0x54, 0x7c, 0xb5, 0x7b, 0x45, 0x1f, 0x62, 0xc2, 0x3e,
-	0xe1, 0x35, 0x45, 0x82, 0x2a, 0x48, 0x0a, 0x00, 0x4f, 0x9f, 0x30, 0xdb, 0x2e, 0xd3, 0x7d, 0xdc,
-	0xbb, 0x80, 0xa5, 0xe1, 0x9d, 0x17, 0x2a, 0x8e, 0xde, 0x34, 0x11, 0xbe, 0x57, 0x5a, 0x15, 0x7d,
-	0x68, 0x54, 0xb0, 0xb8, 0x1a, 0xeb, 0x60, 0xcc, 0x15, 0xd0, 0x78, 0xb2, 0xd7, 0x61, 0x9f, 0x3e,
-	0x42, 0xd6, 0xde, 0xff, 0xd0, 0x15, 0x72, 0x36, 0x20, 0x24, 0x2d, 0x84, 0xf8, 0xdd, 0x28, 0x2d,
-	0xb8, 0xd6, 0x9e, 0xec, 0xb9, 0xa2, 0x96, 0x41, 0xda, 0xf3, 0x43, 0x6a, 0xa3, 0x86, 0x0d, 0x3a,
-	0xbf, 0xcc, 0xba, 0x2c, 0x20, 0xb5, 0xc2, 0xf2, 0xf7, 0x69, 0x40, 0xea, 0xf9, 0xa1, 0xb5, 0x1a,
-	0x36, 0x2a, 0x65, 0x94, 0xdc, 0xe8, 0x68, 0xa0, 0xfb, 0x73, 0xb0, 0xf1, 0xc4, 0x74, 0x4c, 0x5b,
-	0x1e, 0x60, 0x3b, 0x4b, 0xfc, 0x82, 0x79, 0x90, 0xb2, 0x9b, 0x8d, 0x86, 0x69, 0x39, 0xb6, 0x5a,
-	0xad, 0x61, 0xdb, 0x56, 0xd7, 0x59, 0xb0, 0x1a, 0x47, 0xa3, 0x6e, 0xfb, 0x22, 0x6d, 0x56, 0xfa,
-	0x50, 0x56, 0x59, 0x70, 0xda, 0x4d, 0xb9, 0x08, 0x09, 0x18, 0xd7, 0xc8, 0x06, 0x6e, 0xd6, 0x1c,
-	0xb5, 0x8e, 0xab, 0xaa, 0x4d, 0x1c, 0x87, 0x66, 0x5a, 0x22, 0x81, 0x38, 0xdd, 0x67, 0x12, 0x6e,
-	0x09, 0x12, 0x65, 0xb2, 0xdd, 0xca, 0xc2, 0x32, 0x67, 0x0e, 0xb4, 0x23, 0x28, 0x00, 0x57, 0x70,
-	0xd5, 0x6d, 0xa3, 0x1e, 0x8c, 0x7a, 0x5c, 0xdf, 0x4d, 0xd3, 0x00, 0x36, 0x8a, 0x86, 0xeb, 0x7a,
-	0xe0, 0x8c, 0xa7, 0x44, 0x78, 0x37, 0x40, 0x04, 0x04, 0x11, 0xde, 0xed, 0x20, 0xf2, 0x86, 0x46,
-	0x23, 0x20, 0x16, 0x86, 0xc6, 0xd1, 0xb0, 0xdb, 0x78, 0xdd, 0xd4, 0x0d, 0x78, 0x09, 0x40, 0x8b,
-	0xd8, 0x44, 0x90, 0xa8, 0x86, 0x69, 0x54, 0x89, 0xcd, 0xc2, 0xcb, 0x38, 0x4a, 0xf1, 0x1e, 0x4a,
-	0xb7, 0xca, 0xda, 0x21, 0x01, 0xae, 0xca, 0xea, 0x86, 0x69, 0xd5, 0xb1, 0x43, 0x03, 0x08, 0x16,
-	0x5b, 0xf6, 0x39, 0xfe, 0x56, 0x78, 0x9e, 0xbb, 0x86, 0xf7, 0x6a, 0x26, 0xd6, 0x96, 0x3d, 0x7a,
-	0x65, 0x38, 0xb8, 0xc0, 0x51, 0x5a, 0x20, 0xfa, 0x04, 0xdc, 0x35, 0xe7, 0x7e, 0x9d, 0x02, 0x43,
-	0x01, 0x6b, 0xc1, 0xf7, 0x40, 0x52, 0xcc, 0x25, 0x0b, 0x1e, 0xcc, 0xa6, 0x23, 0x76, 0xd7, 0xa9,
-	0x9e, 0xf8, 0xa1, 0x2c, 0x8a, 0x14, 0x4a, 0xf4, 0x17, 0x34, 0x6f, 0x1b, 0x61, 0x7c, 0xca, 0x6d,
-	0xce, 0x05, 0xef, 0x81, 0x09, 0x3f, 0x78, 0x0b, 0xc6, 0x97, 0x61, 0x06, 0xd7, 0x13, 0x5f, 0xae,
-	0x89, 0xf8, 0x8c, 0x47, 0x8f, 0x3c, 0x2e, 0x19, 0x6b, 0x74, 0x34, 0xf2, 0x90, 0xf2, 0xe1, 0x61,
-	0x51, 0x21, 0x4f, 0xac, 0x73, 0x87, 0x9e, 0x6d, 0x1c, 0xfb, 0x80, 0x80, 0xf0, 0x5e, 0xff, 0x80,
-	0x35, 0xca, 0x70, 0xcf, 0xf4, 0xd8, 0xe0, 0x4e, 0xc5, 0x70, 0xde, 0x78, 0x8d, 0x07, 0x1c, 0xc1,
-	0x43, 0xbe, 0x37, 0x98, 0xf5, 0x0c, 0x5b, 0xf5, 0x0c, 0x3b, 0x70, 0x12, 0xc3, 0x2e, 0xba, 0x86,
-	0x7d, 0x2b, 0x98, 0x78, 0x0d, 0x0a, 0xbd, 0xfa, 0x27, 0x5e, 0x7c, 0xa4, 0x7e, 0xce, 0x75, 0xf7,
-	0x80, 0x9c, 0x2b, 0x76, 0xc8, 0xe8, 0xae, 0x16, 0xf9, 0xe8, 0x0e, 0xcb, 0xc8, 0x7e, 0xd0, 0x3f,
-	0x23, 0x8b, 0x1f, 0x7b, 0x32, 0x7a, 0x93, 0xb1, 0x9b, 0xdd, 0xc9, 0x58, 0xe2, 0x64, 0x33, 0xd0,
-	0x99, 0xaa, 0xbd, 0x03, 0xa6, 0x37, 0x70, 0xd5, 0x31, 0xad, 0x3d, 0xb5, 0xc1, 0xf6, 0x9b, 0x07,
-	0xac, 0x13, 0x5b, 0x06, 0x33, 0x91, 0x7c, 0x14, 0xc9, 0x82, 0x62, 0x8d, 0x11, 0x2c, 0xfb, 0xfd,
-	0x70, 0xb5, 0x27, 0xd1, 0x1b, 0x3a, 0x20, 0x16, 0xed, 0x4d, 0xf4, 0xf8, 0xf8, 0x3a, 0x73, 0xbc,
-	0x2a, 0x98, 0xf0, 0x7c, 0xc6, 0xd5, 0xa2, 0xba, 0xae, 0x8b, 0x6a, 0x0e, 0xf3, 0x08, 0x87, 0x46,
-	0xea, 0xca, 0x04, 0xf5, 0xfe, 0xb7, 0x04, 0xf3, 0xd5, 0xa2, 0xa2, 0xb3, 0x9a, 0x0f, 0x4a, 0xdb,
-	0xdd, 0x4d, 0x70, 0x01, 0xc4, 0x9a, 0x36, 0x51, 0xb1, 0x66, 0x09, 0xd7, 0x71, 0x18, 0x2c, 0x68,
-	0xb7, 0xb2, 0x83, 0x77, 0x6c, 0x52, 0x2a, 0x23, 0x34, 0xd8, 0xb4, 0x49, 0x49, 0xb3, 0x60, 0x05,
-	0x00, 0x1a, 0x89, 0xd7, 0xb1, 0xb5, 0xa9, 0x1b, 0x2c, 0xf9, 0xa4, 0x0e, 0xb8, 0x1b, 0x63, 0xb9,
-	0x66, 0x62, 0x11, 0x70, 0x8f, 0xb4, 0x5b, 0xd9, 0x44, 0xa9, 0x8c, 0x56, 0x18, 0x07, 0x4a, 0x60,
-	0xcd, 0xe2, 0x9f, 0xf0, 0x1d, 0x30, 0x2c, 0xfc, 0x1f, 0x1f, 0x67, 0xf2, 0xc8, 0x8c, 0x04, 0x70,
-	0x7a, 0x36, 0x92, 0x7b, 0x60, 0xca, 0x76, 0xb0, 0xd3, 0xb4, 0x7b, 0x53, 0xe2, 0xd4, 0xf1, 0x76,
-	0xd0, 0x04, 0xe7, 0xef, 0xce, 0x82, 0xef, 0x02, 0x59, 0x00, 0xf7, 0x66, 0xc1, 0xe9, 0xa3, 0xb7,
-	0x04, 0x9a, 0xe4, 0xdc, 0x3d, 0x49, 0xef, 0xfb, 0x20, 0xad, 0x11, 0x5b, 0xb7, 0x88, 0xa6, 0xfa,
-	0x3b, 0x15, 0x1e, 0x63, 0xa7, 0x26, 0x05, 0x1b, 0x72, 0x37, 0xec, 0x43, 0x70, 0xa6, 0x03, 0xa9,
-	0x7b, 0xe3, 0x8e, 0x1d, 0x43, 0x4b, 0x39, 0x00, 0xda, 0xb9, 0x6d, 0x7f, 0x08, 0x4e, 0xfb, 0xe8,
-	0xbd, 0xdb, 0x77, 0xfc, 0xd8, 0xdb, 0x77, 0xca, 0x13, 0xd1, 0xb5, 0x8b, 0x1f, 0x80, 0x89, 0xa0,
-	0x04, 0x7f, 0x37, 0x4f, 0x9c, 0x6c, 0x37, 0x8f, 0xf9, 0x02, 0xfc, 0x4d, 0xfd, 0x08, 0x4c, 0xba,
-	0xe0, 0x5d, 0xdb, 0x73, 0xf2, 0x84, 0xdb, 0xd3, 0x85, 0x5f, 0x09, 0xee, 0xd2, 0xbf, 0x96, 0x40,
-	0xc6, 0xc5, 0x3f, 0x20, 0x15, 0x9e, 0x3a, 0x61, 0x2a, 0x9c, 0x69, 0xb7, 0xb2, 0xd3, 0x65, 0x8e,
-	0xd9, 0x2f, 0x23, 0x9e, 0x16, 0xf2, 0x4a, 0x7d, 0x12, 0xe3, 0x7e, 0xea, 0x74, 0x65, 0xc8, 0xf2,
-	0x09, 0x33, 0xe4, 0x5e, 0x75, 0x3a, 0x13, 0xe5, 0x4e, 0x75, 0x3a, 0xfa, 0x72, 0xed, 0x04, 0x88,
-	0xd3, 0xb8, 0xc1, 0xc1, 0x0e, 0x81, 0xf7, 0x01, 0xac, 0x36, 0x2d, 0x8b, 0xd0, 0x3d, 0xe4, 0x95,
-	0x3c, 0x44, 0xdc, 0x70, 0xf6, 0xd0, 0xba, 0x48, 0x77, 0x98, 0x22, 0x60, 0x02, 0xb5, 0xde, 0xfb,
-	0x34, 0x1a, 0xe2, 0xc3, 0x0e, 0x60, 0x87, 0xbf, 0x06, 0xb6, 0x80, 0x09, 0x60, 0x2b, 0x60, 0x98,
-	0xdf, 0x13, 0xf1, 0xa8, 0x54, 0x44, 0xe1, 0x13, 0xdd, 0xa8, 0x3c, 0x8a, 0xf5, 0x33, 0xe2, 0x21,
-	0xce, 0xc4, 0x9a, 0xfb, 0x65, 0x0c, 0xd1, 0x6f, 0x35, 0x63, 0x78, 0x04, 0xa6, 0xbd, 0xca, 0xbb,
-	0x6e, 0xd5, 0x89, 0xa6, 0x7a, 0x65, 0x06, 0xec, 0xc6, 0x10, 0x87, 0x55, 0xd6, 0xa3, 0xac, 0xaa,
-	0x3e, 0xe5, 0x56, 0xe8, 0x19, 0x44, 0x59, 0x20, 0x94, 0x1c, 0xf8, 0x3a, 0x90, 0x19, 0xbc, 0x46,
-	0xb6, 0x55, 0xe1, 0x0d, 0xbd, 0xab, 0x05, 0x7e, 0x13, 0x30, 0x46, 0xfb, 0xcb, 0x64, 0xfb, 0x16,
-	0xeb, 0x15, 0x77, 0x0c, 0x0f, 0x0f, 0x0a, 0xef, 0x62, 0x6c, 0xf0, 0x99, 0xc3, 0xc3, 0xbb, 0x80,
-	0x31, 0xfb, 0xc6, 0x78, 0x04, 0x9c, 0x69, 0x10, 0x43, 0xa3, 0x02, 0x70, 0xa3, 0x51, 0xd3, 0xab,
-	0xcc, 0x9b, 0x7b, 0x03, 0x17, 0x91, 0x45, 0x6f, 0xa1, 0xd5, 0xa7, 0x75, 0x47, 0x88, 0xa6, 0x05,
-	0x50, 0x9f, 0x3e, 0xb8, 0x04, 0x52, 0x3f, 0x6a, 0x92, 0x26, 0xf5, 0x4e, 0xc4, 0x6e, 0x98, 0x86,
-	0x4d, 0x6c, 0x39, 0xc1, 0xaa, 0x79, 0xfd, 0x26, 0x6f, 0xd1, 0xac, 0xd7, 0xb1, 0xa1, 0xa1, 0x24,
-	0xe7, 0x41, 0x2e, 0x0b, 0x85, 0x71, 0xb5, 0x65, 0xce, 0xc9, 0x76, 0x78, 0x4c, 0x71, 0x04, 0x8c,
-	0xe0, 0x41, 0x82, 0x05, 0xfe, 0x00, 0x40, 0xa1, 0x0d, 0xcb, 0x12, 0x70, 0xb5, 0x4a, 0x1a, 0x8e,
-	0x08, 0x35, 0xce, 0xf5, 0xcb, 0x7c, 0xe8, 0xde, 0x2b, 0xd0, 0xc4, 0xa1, 0xc4, 0x48, 0x91, 0x18,
-	0x8c, 0xdf, 0x02, 0x57, 0xc0, 0xb8, 0xab, 0x19, 0xc3, 0x14, 0xea, 0x89, 0x40, 0xa3, 0x27, 0x9d,
-	0xa2, 0x9c, 0x42, 0x1d, 0x04, 0x05, 0x63, 0xa0, 0x0d, 0x5e, 0xa1, 0xf1, 0xa3, 0xba, 0xa3, 0x1b,
-	0x9a, 0xb9, 0x63, 0xab, 0x78, 0x1b, 0xeb, 0x35, 0xbc, 0x2e, 0xea, 0xde, 0x71, 0x04, 0xad, 0xdd,
-	0x7b, 0xbc, 0xab, 0xe4, 0xf6, 0x4c, 0xff, 0x5a, 0x02, 0x20, 0xa0, 0xcf, 0x39, 0x10, 0x6b, 0xf0,
-	0x4c, 0x85, 0x79, 0x87, 0x61, 0xe6, 0xe3, 0x3f, 0x8e, 0xa6, 0xd2, 0xf2, 0x4b, 0xc8, 0xed, 0x81,
-	0x8b, 0x20, 0xe6, 0xea, 0x19, 0x3e, 0x52, 0xcf, 0xae, 0x4d, 0xee, 0x72, 0xc2, 0x77, 0x8f, 0x7f,
-	0xd3, 0xd6, 0x89, 0xc0, 0xd8, 0x44, 0x72, 0xf4, 0x4c, 0x0a, 0xd4, 0x61, 0x4a, 0x4d, 0xe7, 0x09,
-	0x31, 0x1c, 0xb1, 0x86, 0x16, 0x4d, 0x8d, 0xc0, 0x39, 0x30, 0xb0, 0x4d, 0x3d, 0xa9, 0x28, 0xc2,
-	0x4c, 0xed, 0x2b, 0xe3, 0x16, 0x2c, 0xa6, 0x1e, 0x3f, 0x28, 0xcd, 0xdd, 0xbf, 0x32, 0xf7, 0xd6,
-	0xa3, 0x4f, 0xe6, 0x2f, 0x5d, 0x2d, 0x3e, 0x9d, 0x45, 0x9c, 0x0a, 0x2e, 0x00, 0xc0, 0x6e, 0x91,
-	0xd5, 0x0d, 0xcb, 0xac, 0x8b, 0xb1, 0x1d, 0xbd, 0x73, 0x13, 0x8c, 0x67, 0xd9, 0x32, 0xeb, 0xf0,
-	0x6d, 0x10, 0xe7, 0x00, 0x8e, 0x29, 0x06, 0x76, 0x34, 0x7b, 0x8c, 0x71, 0xdc, 0x36, 0xdd, 0x7c,
-	0x2f, 0x0b, 0x12, 0xde, 0x90, 0xe0, 0xfb, 0xc1, 0xfa, 0xc9, 0xec, 0x81, 0xf5, 0x93, 0x63, 0x14,
-	0x4e, 0x16, 0x01, 0xa8, 0x5a, 0x04, 0x8b, 0xfb, 0xbe, 0xf0, 0x49, 0xee, 0xfb, 0x04, 0x5f, 0xc9,
-	0xa1, 0x20, 0xcd, 0x86, 0xe6, 0x82, 0x44, 0x4e, 0x02, 0x22, 0xf8, 0x4a, 0x0e, 0x3c, 0x2d, 0x0a,
-	0x6a, 0xbc, 0xd2, 0x11, 0xe3, 0x95, 0x8e, 0xa2, 0xa8, 0x1f, 0x5e, 0x04, 0x43, 0x1a, 0xb1, 0xab,
-	0x96, 0xde, 0xa0, 0x93, 0xc8, 0xbc, 0x67, 0x82, 0x39, 0x23, 0x2b, 0x22, 0x3f, 0x4b, 0xa2, 0x60,
-	0x27, 0xdc, 0x01, 0x00, 0x3b, 0x8e, 0xa5, 0xaf, 0x37, 0x1d, 0x62, 0xcb, 0x83, 0x6c, 0x43, 0x5f,
-	0x38, 0xd0, 0x46, 0x85, 0x92, 0x47, 0xbb, 0x64, 0x38, 0xd6, 0x9e, 0x72, 0x69, 0x5f, 0xb9, 0xf0,
-	0x0f, 0xd2, 0xcb, 0xb9, 0x63, 0x15, 0xd2, 0x50, 0x40, 0x14, 0x7c, 0x08, 0x86, 0xc4, 0x51, 0xa2,
-	0xd2, 0xd9, 0x89, 0x9d, 0xbc, 0xba, 0x35, 0xda, 0x6e, 0x65, 0x81, 0xdb, 0x5e, 0xb6, 0x11, 0xd8,
-	0x76, 0x69, 0x6c, 0x58, 0x01, 0xd0, 0x26, 0x16, 0x3b, 0xf5, 0x1a, 0x96, 0xb9, 0xa1, 0xd7, 0x88,
-	0xaa, 0x6b, 0xcc, 0xa3, 0x26, 0x94, 0xd3, 0x7e, 0x5d, 0x28, 0x75, 0x8b, 0x13, 0xad, 0x71, 0x9a,
-	0x4a, 0x19, 0xa5, 0xec, 0xce, 0x16, 0x0d, 0xfe, 0xbb, 0x04, 0x26, 0xc5, 0x1d, 0xb8, 0x4a, 0x3b,
-	0x89, 0xc5, 0xee, 0xcc, 0x89, 0x6d, 0xb3, 0x74, 0x2d, 0xa1, 0xfc, 0xad, 0xb4, 0xaf, 0xfc, 0x54,
-	0xb2, 0x7e, 0x2c, 0x15, 0xff, 0x52, 0x7a, 0x9c, 0x5f, 0xb8, 0x46, 0xc7, 0x8e, 0xe7, 0x3e, 0x16,
-	0xdb, 0xe3, 0xd3, 0xc0, 0xb7, 0xff, 0xf9, 0x70, 0xee, 0xd1, 0xc5, 0x40, 0xc7, 0x85, 0x87, 0x85,
-	0x0b, 0x17, 0x29, 0x5f, 0x69, 0xee, 0xbe, 0x30, 0xd9, 0xa7, 0x81, 0x6f, 0xff, 0x93, 0xf1, 0xf9,
-	0x1d, 0x17, 0xf2, 0x0b, 0xd7, 0xae, 0x3d, 0x10, 0xbb, 0xf0, 0xf5, 0xa7, 0x17, 0x16, 0x66, 0x3f,
-	0x7d, 0x3c, 0x8b, 0xc6, 0x85, 0xba, 0xb7, 0x98, 0xb6, 0x25, 0xae, 0x2c, 0xbc, 0x0f, 0xe4, 0xae,
-	0x61, 0x6c, 0x91, 0x2d, 0xb5, 0x86, 0xd7, 0x49, 0x4d, 0xbe, 0xcc, 0x06, 0xf2, 0x12, 0x5f, 0x22,
-	0x9f, 0xa5, 0xda, 0xad, 0xec, 0xc4, 0x6a, 0x10, 0xe3, 0xc6, 0xd2, 0x8d, 0x9b, 0x94, 0x10, 0x4d,
-	0x74, 0x40, 0xdf, 0x20, 0x5b, 0xac, 0x19, 0xfe, 0x97, 0x04, 0xa6, 0x83, 0x67, 0x58, 0x97, 0x9d,
-	0xc0, 0x77, 0xd3, 0x4e, 0x72, 0x40, 0xe5, 0x4e, 0x5b, 0x6d, 0x80, 0x33, 0x7d, 0x86, 0xe3, 0xdb,
-	0xeb, 0x0a, 0x1b, 0xd0, 0xf9, 0x80, 0xbd, 0x4e, 0x95, 0xba, 0xb1, 0x3c, 0x9b, 0x9d, 0xea, 0x11,
-	0xe3, 0xd9, 0x0d, 0x81, 0x89, 0x3e, 0x72, 0x74, 0x4d, 0x9e, 0x67, 0x02, 0x32, 0x7c, 0xa5, 0x6a,
-	0xec, 0x92, 0xa7, 0x1b, 0xa4, 0x52, 0x46, 0x63, 0x3d, 0xc8, 0x15, 0x0d, 0xfe, 0x9b, 0x04, 0xc6,
-	0xd8, 0x39, 0xd8, 0x35, 0x09, 0x43, 0xdf, 0xcd, 0x49, 0x48, 0x53, 0x5d, 0x3b, 0xad, 0xef, 0x80,
-	0x44, 0xcd, 0xe4, 0xa3, 0xb2, 0xe5, 0x61, 0xe6, 0x92, 0xf2, 0x07, 0xbb, 0xa4, 0x9b, 0x2e, 0xe9,
-	0xd7, 0xf1, 0x48, 0xbe, 0xa0, 0xbe, 0x95, 0xde, 0x91, 0x63, 0x57, 0x7a, 0x47, 0xfb, 0x56, 0x7a,
-	0xfb, 0xc4, 0xcd, 0xc9, 0x3f, 0x47, 0xa5, 0x3d, 0xf5, 0xe7, 0xaa, 0xb4, 0xa7, 0x4f, 0x5e, 0x69,
-	0xef, 0x29, 0x4b, 0xc3, 0xe3, 0x94, 0xa5, 0xc7, 0x8e, 0x53, 0x96, 0x1e, 0x3f, 0x76, 0x59, 0x7a,
-	0xe2, 0x80, 0xb2, 0xf4, 0xeb, 0x20, 0x61, 0x99, 0xa6, 0xa3, 0xb2, 0xb0, 0x8a, 0x67, 0xd8, 0x72,
-	0x4f, 0x35, 0xc3, 0x34, 0x1d, 0x1a, 0x53, 0xa1, 0xb8, 0x25, 0xbe, 0xe0, 0x5d, 0x30, 0x68, 0x10,
-	0x87, 0x1a, 0x64, 0x8a, 0x45, 0x7c, 0x0b, 0xbf, 0x6f, 0x65, 0x8b, 0x27, 0x7a, 0x45, 0xb5, 0x4a,
-	0x9c, 0x4a, 0xb9, 0xdd, 0xca, 0x0e, 0xb0, 0x0f, 0x34, 0x60, 0x10, 0xa7, 0xa2, 0xc1, 0x0f, 0xc0,
-	0x70, 0xc7, 0x0d, 0x81, 0x7c, 0xf4, 0x0d, 0x41, 0xb2, 0xdd, 0xca, 0x06, 0x8b, 0xdd, 0x68, 0xa8,
-	0x1e, 0xb8, 0x13, 0x58, 0x04, 0x09, 0x06, 0x48, 0xa3, 0x6a, 0xf9, 0x54, 0xff, 0xf1, 0xb9, 0x51,
-	0xb7, 0x32, 0xdc, 0x6e, 0x65, 0xbd, 0xfc, 0x17, 0xc5, 0x29, 0x0e, 0xcb, 0x84, 0x3f, 0x04, 0x69,
-	0x37, 0xe0, 0xf6, 0xc1, 0x2e, 0x1d, 0x01, 0x36, 0x46, 0x17, 0xc7, 0x1a, 0x67, 0xf3, 0x30, 0xdd,
-	0xf4, 0x60, 0xc5, 0x85, 0x9e, 0x07, 0x31, 0x9b, 0x47, 0xad, 0xf2, 0x34, 0x03, 0x9c, 0x3a, 0x20,
-	0xa8, 0x45, 0x2e, 0x1d, 0xfc, 0x3e, 0x70, 0x51, 0x54, 0x97, 0xf5, 0xf4, 0xe1, 0xac, 0xa3, 0x82,
-	0xde, 0x7d, 0x09, 0x37, 0x0b, 0x46, 0xbd, 0xec, 0x90, 0xad, 0x0f, 0xf9, 0x0c, 0xcb, 0x09, 0x87,
-	0x45, 0x4e, 0xc8, 0xd6, 0x06, 0x7c, 0x19, 0x24, 0x9b, 0x36, 0xd1, 0x7c, 0x2a, 0x5b, 0x3e, 0x3b,
-	0x13, 0xc9, 0x8f, 0xa0, 0x11, 0xda, 0xec, 0x92, 0xd9, 0x94, 0x8e, 0xa1, 0xf9, 0xcb, 0x4d, 0xce,
-	0xf8, 0x8f, 0xcd, 0xbc, 0xb5, 0x06, 0xbf, 0x27, 0xe8, 0xac, 0x8f, 0x44, 0x65, 0xee, 0x8a, 0x9c,
-	0x65, 0xcf, 0x82, 0xe8, 0x71, 0x32, 0x7c, 0x13, 0xdb, 0x0e, 0xba, 0xce, 0xaa, 0x6e, 0x57, 0xb8,
-	0x22, 0xe8, 0x23, 0xfe, 0xab, 0x97, 0x71, 0x5e, 0x9e, 0xe9, 0xcb, 0x38, 0xdf, 0xc1, 0x38, 0x0f,
-	0x1f, 0x83, 0xd3, 0xdd, 0x59, 0xb0, 0x45, 0xaa, 0x44, 0xdf, 0xe6, 0xa1, 0xe8, 0x4b, 0x27, 0xc9,
-	0xb2, 0xbd, 0x54, 0x19, 0x09, 0x84, 0x92, 0x03, 0x97, 0xc0, 0x10, 0x7f, 0x16, 0xc6, 0x57, 0x44,
-	0xee, 0x00, 0x27, 0x44, 0x49, 0xf8, 0x9a, 0xf0, 0x13, 0x64, 0xd0, 0xf0, 0x5a, 0xe1, 0x03, 0x00,
-	0xd7, 0xd9, 0xf5, 0xcd, 0x1e, 0xcd, 0xb9, 0xab, 0xc4, 0x70, 0xf0, 0x26, 0x91, 0xcf, 0x1d, 0x5d,
-	0x9b, 0x4d, 0xee, 0x2b, 0xc3, 0x00, 0x9c, 0x0d, 0x85, 0x3e, 0x5b, 0x98, 0x0b, 0x85, 0x42, 0x21,
-	0x94, 0x16, 0x38, 0x6b, 0x1e, 0x0c, 0x7c, 0x05, 0x24, 0xbd, 0xca, 0x82, 0xa8, 0xfa, 0xce, 0xce,
-	0x48, 0xf9, 0x01, 0x34, 0xea, 0x36, 0x8b, 0x72, 0x2e, 0xa6, 0x7e, 0x83, 0x72, 0xb1, 0x42, 0x14,
-	0x7f, 0x03, 0x60, 0xcb, 0xe7, 0xd9, 0x69, 0xd4, 0x53, 0x92, 0xe1, 0xcf, 0x01, 0xc4, 0x35, 0x95,
-	0x32, 0x4e, 0x23, 0x4b, 0xc4, 0x98, 0x4b, 0x65, 0xc4, 0xfb, 0x6c, 0xea, 0x6c, 0x58, 0x8b, 0x66,
-	0x89, 0x16, 0x58, 0x06, 0xa3, 0x42, 0x84, 0x0b, 0xff, 0xf2, 0x31, 0xe0, 0xd1, 0x08, 0x67, 0x72,
-	0x51, 0xae, 0x03, 0x81, 0xec, 0x55, 0x0e, 0x6c, 0xf9, 0x15, 0x86, 0x93, 0xed, 0xa9, 0x6a, 0xba,
-	0x43, 0x14, 0x48, 0x49, 0xce, 0xe8, 0x36, 0xdb, 0x90, 0x80, 0x33, 0x22, 0x3b, 0xef, 0x57, 0x91,
-	0xb0, 0xe5, 0x3c, 0xc3, 0x3d, 0x5e, 0x49, 0x82, 0x03, 0xf5, 0xe9, 0xb2, 0xe1, 0xfb, 0x00, 0x04,
-	0x2e, 0xfd, 0x2e, 0x9c, 0xec, 0xd2, 0x0f, 0x05, 0x78, 0xe1, 0x3a, 0x18, 0x6d, 0x58, 0xe6, 0xb6,
-	0x4e, 0xf7, 0x31, 0x8f, 0x9c, 0x2e, 0xb2, 0x13, 0xe9, 0xed, 0x7d, 0xe5, 0x15, 0xeb, 0xbc, 0x3c,
-	0x5b, 0x7c, 0xe9, 0xf0, 0x00, 0xe0, 0xd3, 0xc7, 0xb3, 0xed, 0x56, 0x76, 0x64, 0xcd, 0xc7, 0xa8,
-	0x94, 0xd1, 0x48, 0x00, 0xb2, 0xa2, 0xc1, 0x32, 0x48, 0x7b, 0x0d, 0xd4, 0xcb, 0x68, 0xd8, 0xc1,
-	0xf2, 0xab, 0xc2, 0xc5, 0x74, 0x2f, 0xc7, 0x5b, 0xec, 0xd5, 0x31, 0x4a, 0x05, 0x39, 0xca, 0xd8,
-	0xc1, 0xf0, 0x0c, 0x48, 0xd4, 0x9b, 0x35, 0x9a, 0x59, 0xdb, 0x8e, 0x3c, 0xc7, 0x8e, 0x1f, 0xbf,
-	0x01, 0x6e, 0x82, 0x53, 0xd5, 0x1a, 0xd6, 0xeb, 0x2a, 0xee, 0x48, 0xc0, 0xd5, 0xaa, 0xa9, 0x11,
-	0xb9, 0x70, 0x44, 0x6e, 0xd4, 0x9b, 0xb4, 0xa3, 0x29, 0x86, 0xd6, 0xdb, 0x31, 0xfd, 0x2e, 0x48,
-	0x76, 0xe5, 0x70, 0x30, 0x05, 0x22, 0x5b, 0x84, 0x3f, 0xfe, 0x49, 0x20, 0xfa, 0x09, 0xc7, 0xdd,
-	0x94, 0x9f, 0xbf, 0x4a, 0xe1, 0x3f, 0xae, 0x85, 0xdf, 0x94, 0xa6, 0xef, 0x82, 0xd1, 0xce, 0x78,
-	0xab, 0x0f, 0x77, 0x21, 0xc8, 0xdd, 0xe7, 0x48, 0x70, 0x01, 0x02, 0xb8, 0x22, 0x6f, 0x7f, 0x1f,
-	0x00, 0x6f, 0x50, 0x36, 0xbc, 0x06, 0x86, 0xfc, 0x47, 0xee, 0x34, 0x7f, 0x8f, 0xb0, 0x6b, 0x90,
-	0x83, 0xac, 0x80, 0x00, 0xf1, 0x78, 0x73, 0x1a, 0x98, 0x5c, 0x64, 0x19, 0xb7, 0xdf, 0x2d, 0x6a,
-	0x26, 0xd7, 0x01, 0xf0, 0x51, 0xbd, 0x6b, 0xdf, 0x83, 0x40, 0xfb, 0x54, 0x02, 0x12, 0x9e, 0x98,
-	0xdc, 0x3f, 0x4b, 0x60, 0xf2, 0x0e, 0xcb, 0xc9, 0xff, 0x2f, 0xc5, 0xc0, 0x05, 0x00, 0xfc, 0xe7,
-	0xee, 0x07, 0x96, 0x1d, 0x96, 0x29, 0xc9, 0x0a, 0xb6, 0xb7, 0x94, 0x28, 0xab, 0xf1, 0x24, 0x36,
-	0xdc, 0x86, 0xdc, 0xbf, 0x4a, 0x60, 0xec, 0x3d, 0xe2, 0xf4, 0x28, 0xf9, 0x10, 0x8c, 0xfa, 0x4a,
-	0xaa, 0xdf, 0xbc, 0x48, 0x32, 0x4c, 0x7c, 0x3a, 0xfb, 0x9b, 0xab, 0xfd, 0x95, 0x04, 0xce, 0x07,
-	0xd5, 0x0e, 0x08, 0x5f, 0x36, 0xad, 0xa5, 0x3b, 0x15, 0xdb, 0x1d, 0xc8, 0x0f, 0x41, 0x9c, 0x1d,
-	0xb7, 0xa4, 0xa9, 0x8b, 0x9a, 0xdb, 0x92, 0x78, 0xcb, 0x7e, 0xb2, 0x28, 0x6c, 0xe9, 0x4e, 0xe5,
-	0x8d, 0xd7, 0xda, 0xad, 0x6c, 0x8c, 0x1e, 0xd3, 0x4b, 0x77, 0x2a, 0x28, 0x46, 0x61, 0x97, 0x9a,
-	0x3a, 0x7c, 0x04, 0x62, 0xf4, 0xd8, 0xa4, 0x02, 0xf8, 0x63, 0xf9, 0xf2, 0x37, 0x12, 0x30, 0x58,
-	0x26, 0xdb, 0x14, 0x7f, 0x50, 0x23, 0xdb, 0x4b, 0x4d, 0x3d, 0xf7, 0x57, 0x61, 0x30, 0x71, 0x53,
-	0xb7, 0xfd, 0xb1, 0x7a, 0x43, 0xc3, 0x20, 0x19, 0xf4, 0xc5, 0xfe, 0x24, 0xbd, 0x7c, 0x88, 0x17,
-	0x3e, 0x7c, 0x9a, 0x46, 0x71, 0x90, 0xf2, 0x9b, 0x4f, 0x14, 0xf5, 0x17, 0xa6, 0xa5, 0x11, 0x4b,
-	0xbc, 0x80, 0xe2, 0x3f, 0x60, 0x06, 0x0c, 0xf0, 0x27, 0xda, 0xec, 0xf1, 0x3e, 0x3b, 0xec, 0x2f,
-	0x46, 0xe4, 0xaf, 0x62, 0x88, 0x37, 0x43, 0x08, 0xa2, 0x0d, 0x7a, 0xb2, 0xf3, 0x47, 0xfb, 0xec,
-	0x3b, 0xf7, 0x8f, 0x12, 0x18, 0xbb, 0xd5, 0x67, 0xa5, 0x2e, 0x9f, 0x6c, 0x3b, 0x75, 0x56, 0x3b,
-	0xbf, 0xcd, 0xad, 0xf4, 0x1f, 0x12, 0x48, 0x7b, 0x72, 0x6e, 0x93, 0x7a, 0xa3, 0x46, 0x43, 0x96,
-	0xef, 0x8a, 0x7a, 0x30, 0x0f, 0x86, 0xea, 0xb8, 0xc1, 0x2e, 0x2d, 0xa8, 0x57, 0x8e, 0x04, 0xcb,
-	0x83, 0x1a, 0x02, 0xa2, 0xef, 0x06, 0xd9, 0xcb, 0x7d, 0x21, 0x81, 0xa9, 0x9e, 0x81, 0xf0, 0x53,
-	0xd6, 0xab, 0x2e, 0x4a, 0x9d, 0xec, 0x7d, 0xab, 0x8b, 0xe1, 0x60, 0x75, 0xf1, 0x4b, 0xa9, 0xb3,
-	0xba, 0x78, 0x1b, 0x24, 0x59, 0xed, 0x8d, 0xec, 0x3a, 0xc4, 0xb0, 0x59, 0x3e, 0x1f, 0x99, 0x89,
-	0xe4, 0x13, 0xca, 0xab, 0xfb, 0x4a, 0xfe, 0xe7, 0xd2, 0xf9, 0x94, 0x26, 0x4b, 0xb9, 0xac, 0x75,
-	0xb6, 0x78, 0xfa, 0x71, 0x7e, 0xe1, 0xda, 0xc3, 0x82, 0x7b, 0x38, 0x7f, 0x32, 0x7f, 0x69, 0xfe,
-	0x8d, 0xa7, 0x17, 0x3e, 0x99, 0xbf, 0x54, 0x7c, 0x3a, 0x8b, 0x46, 0x29, 0xc6, 0x92, 0x07, 0x91,
-	0xfb, 0x1f, 0x09, 0xc8, 0x07, 0xa8, 0x6e, 0xc3, 0xa7, 0x20, 0xc6, 0xe3, 0x03, 0xf7, 0xc4, 0x78,
-	0xfd, 0xc0, 0x79, 0xe8, 0x62, 0x2d, 0x88, 0xff, 0x5f, 0xa7, 0x8e, 0xe0, 0xca, 0x9c, 0xae, 0x82,
-	0xe1, 0x20, 0x4c, 0x9f, 0xe3, 0xf1, 0xdd, 0xce, 0xe3, 0xf1, 0x95, 0x63, 0xaa, 0x17, 0x38, 0x2d,
-	0x73, 0x3f, 0x96, 0x40, 0x76, 0xd1, 0x34, 0xb6, 0x89, 0xe5, 0xf4, 0x50, 0xbb, 0x3b, 0x66, 0x0d,
-	0x24, 0xb8, 0x4e, 0xfe, 0xfb, 0xc9, 0xab, 0xc7, 0x7f, 0xf0, 0x18, 0xe7, 0x42, 0x2b, 0x65, 0x14,
-	0xe7, 0x28, 0x15, 0xf6, 0x88, 0x93, 0x85, 0x3e, 0xcc, 0xff, 0x21, 0xf6, 0x7d, 0x71, 0x19, 0x00,
-	0x3f, 0x9e, 0x87, 0x69, 0x30, 0xb2, 0xf6, 0xc1, 0xbd, 0x25, 0xa4, 0xde, 0x59, 0xbd, 0xb1, 0xfa,
-	0xc1, 0xbd, 0xd5, 0x54, 0xc8, 0x6f, 0x52, 0x4a, 0xb7, 0x6f, 0x2f, 0xa1, 0x0f, 0x53, 0x12, 0x84,
-	0x60, 0x94, 0x37, 0x2d, 0xfd, 0xff, 0xdb, 0x4b, 0x68, 0xb5, 0x74, 0x33, 0x15, 0x56, 0xfe, 0x49,
-	0xfa, 0xf2, 0x79, 0x46, 0x7a, 0xf6, 0x3c, 0x23, 0xfd, 0xee, 0x79, 0x26, 0xf4, 0x87, 0xe7, 0x99,
-	0xd0, 0x57, 0xcf, 0x33, 0xa1, 0x3f, 0x3e, 0xcf, 0x84, 0xfe, 0xf4, 0x3c, 0x23, 0x7d, 0xd6, 0xce,
-	0x48, 0x3f, 0x69, 0x67, 0x42, 0xbf, 0x6c, 0x67, 0xa4, 0x5f, 0xb5, 0x33, 0xa1, 0x2f, 0xda, 0x99,
-	0xd0, 0x6f, 0xda, 0x99, 0xd0, 0x97, 0xed, 0x8c, 0xf4, 0xac, 0x9d, 0x91, 0x7e, 0xd7, 0xce, 0x84,
-	0xfe, 0xd0, 0xce, 0x48, 0x5f, 0xb5, 0x33, 0xa1, 0x3f, 0xb6, 0x33, 0xd2, 0x9f, 0xda, 0x99, 0xd0,
-	0x67, 0x2f, 0x32, 0xa1, 0x9f, 0xbc, 0xc8, 0x48, 0x3f, 0x7b, 0x91, 0x09, 0xfd, 0xe2, 0x45, 0x46,
-	0xfa, 0xfc, 0x45, 0x26, 0xf4, 0xcb, 0x17, 0x99, 0xd0, 0xaf, 0x5e, 0x64, 0xa4, 0x2f, 0x5e, 0x64,
-	0xa4, 0xdf, 0xbc, 0xc8, 0x48, 0xf7, 0x2f, 0x1d, 0xd7, 0x79, 0x3b, 0x46, 0x63, 0x7d, 0x7d, 0x90,
-	0xed, 0xc0, 0xab, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc2, 0x79, 0x98, 0xcd, 0x8e, 0x37, 0x00,
-	0x00,
+	// 5114 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x5b, 0x6c, 0x1b, 0x57,
+	0x7a, 0xe6, 0x50, 0x94, 0x48, 0xfe, 0xba, 0x90, 0x3a, 0xba, 0x8d, 0x65, 0x9b, 0x94, 0x69, 0x39,
+	0x91, 0xbd, 0x96, 0x6c, 0xd3, 0x71, 0x36, 0xeb, 0x24, 0x75, 0x48, 0x91, 0x72, 0x68, 0x4b, 0xb2,
+	0x76, 0x24, 0xdb, 0x8d, 0x6f, 0xb3, 0x47, 0x9c, 0x23, 0x79, 0x22, 0x72, 0x86, 0x99, 0x19, 0xea,
+	0x92, 0xc4, 0x40, 0xb0, 0x68, 0xb1, 0x8b, 0x45, 0x5b, 0x6c, 0xf7, 0xa5, 0x8b, 0x3e, 0x14, 0x41,
+	0x81, 0x02, 0xfb, 0xb8, 0x28, 0x5a, 0x20, 0x6f, 0xdd, 0x97, 0x16, 0x41, 0x8b, 0x02, 0x79, 0xd8,
+	0x02, 0xdb, 0x7d, 0x50, 0xd7, 0xf4, 0x4b, 0x1e, 0xf7, 0x71, 0xa1, 0x87, 0xa2, 0x38, 0x97, 0xb9,
+	0xf0, 0x22, 0x99, 0x4a, 0xd2, 0x45, 0x5e, 0xa4, 0x99, 0x73, 0xfe, 0xff, 0xfb, 0xff, 0x73, 0xfb,
+	0xcf, 0x7f, 0x19, 0x42, 0xa6, 0x62, 0x5a, 0x78, 0x07, 0x1b, 0xb3, 0xb6, 0x83, 0xcb, 0x5b, 0x97,
+	0x70, 0x4d, 0xbf, 0x44, 0x0c, 0x4d, 0xd5, 0xc8, 0xb6, 0x5e, 0x26, 0x73, 0x35, 0xcb, 0x74, 0x4c,
+	0x34, 0xe4, 0x38, 0xc6, 0x9c, 0xa0, 0x9b, 0xdb, 0xbe, 0x3a, 0x99, 0xdb, 0xd4, 0x9d, 0xa7, 0xf5,
+	0xf5, 0xb9, 0xb2, 0x59, 0xbd, 0x44, 0x8c, 0x6d, 0x73, 0xaf, 0x66, 0x99, 0xbb, 0x7b, 0x97, 0x18,
+	0x71, 0x79, 0x76, 0x93, 0x18, 0xb3, 0xdb, 0xb8, 0xa2, 0x6b, 0xd8, 0x21, 0x97, 0xda, 0x1e, 0x38,
+	0xe4, 0xe4, 0x6c, 0x00, 0x62, 0xd3, 0xdc, 0x34, 0x39, 0xf3, 0x7a, 0x7d, 0x83, 0xbd, 0xb1, 0x17,
+	0xf6, 0x24, 0xc8, 0x4f, 0x6d, 0x9a, 0xe6, 0x66, 0x85, 0x30, 0xf5, 0xb0, 0x61, 0x98, 0x0e, 0x76,
+	0x74, 0xd3, 0xb0, 0x45, 0x6f, 0x4a, 0xf4, 0x7a, 0x18, 0x5a, 0xdd, 0x62, 0x04, 0xa2, 0xff, 0x64,
+	0x6b, 0x3f, 0xa9, 0xd6, 0x9c, 0x3d, 0xd1, 0x39, 0xd5, 0xda, 0xb9, 0xa1, 0x93, 0x8a, 0xa6, 0x56,
+	0xb1, 0xbd, 0xd5, 0x22, 0xdc, 0xa3, 0xb0, 0x1d, 0xab, 0x5e, 0x76, 0x44, 0x6f, 0xba, 0xb5, 0xd7,
+	0xd1, 0xab, 0xc4, 0x76, 0x70, 0xb5, 0x76, 0x98, 0x76, 0x3b, 0x16, 0xae, 0xd5, 0x88, 0xe5, 0x6a,
+	0x7f, 0xb6, 0x7d, 0x05, 0x70, 0xad, 0x56, 0xd1, 0xcb, 0xc1, 0x21, 0x74, 0x20, 0xd2, 0x35, 0x62,
+	0x38, 0xfa, 0x86, 0xee, 0x23, 0x9d, 0x6a, 0x27, 0x7a, 0xdf, 0xd4, 0x8d, 0xc3, 0x7b, 0xb7, 0xc8,
+	0x9e, 0xcb, 0x9b, 0x6e, 0xef, 0x75, 0x57, 0x5c, 0xcc, 0x53, 0x3b, 0x41, 0x95, 0xd8, 0x36, 0xde,
+	0x24, 0xf6, 0x51, 0x14, 0x0e, 0xd6, 0xb0, 0x83, 0x39, 0x45, 0xe6, 0x6f, 0x7a, 0x20, 0xba, 0x4a,
+	0x6c, 0x5b, 0x37, 0x0d, 0x74, 0x1f, 0x62, 0x1a, 0xd9, 0x56, 0xb1, 0xa6, 0x59, 0x72, 0x78, 0x4a,
+	0x9a, 0x19, 0xc8, 0xbf, 0xf5, 0xf9, 0x7e, 0x3a, 0xf4, 0xdb, 0xfd, 0xf4, 0x6b, 0x9b, 0xe6, 0x9c,
+	0xf3, 0x94, 0x38, 0x4f, 0x75, 0x63, 0xd3, 0x9e, 0x33, 0x88, 0xb3, 0x63, 0x5a, 0x5b, 0x97, 0x9a,
+	0xc1, 0x6b, 0x5b, 0x9b, 0x97, 0x9c, 0xbd, 0x1a, 0xb1, 0xe7, 0x0a, 0x64, 0x3b, 0xa7, 0x69, 0x96,
+	0x12, 0xd5, 0xf8, 0x03, 0xca, 0x41, 0x84, 0x8e, 0x4b, 0xee, 0x99, 0x92, 0x66, 0xfa, 0xb3, 0x27,
+	0xe7, 0x9a, 0x37, 0xef, 0x9c, 0x90, 0x7f, 0x9b, 0xec, 0xd9, 0xf9, 0xe4, 0x41, 0xbe, 0xf7, 0x27,
+	0x52, 0x38, 0x29, 0x51, 0xc9, 0x5f, 0xec, 0xa7, 0x25, 0x85, 0xb1, 0xa2, 0x33, 0x30, 0x58, 0xc1,
+	0xb6, 0xa3, 0x6e, 0xa8, 0x65, 0xc3, 0x51, 0xeb, 0x35, 0x39, 0x32, 0x25, 0xcd, 0x0c, 0x2a, 0x40,
+	0x1b, 0x17, 0xe6, 0x0d, 0xe7, 0x6e, 0x0d, 0xcd, 0xc0, 0x30, 0x23, 0x31, 0x04, 0x91, 0x66, 0xee,
+	0x18, 0x72, 0x2f, 0x23, 0x63, 0xbc, 0xcb, 0x94, 0xae, 0x60, 0xee, 0x18, 0x1e, 0x25, 0x0e, 0x52,
+	0xf6, 0xf9, 0x94, 0x39, 0x8f, 0x72, 0x0e, 0x46, 0x19, 0x65, 0xd9, 0x34, 0x36, 0x82, 0xc4, 0x51,
+	0x46, 0x9c, 0xa4, 0x7d, 0xf3, 0xa6, 0xb1, 0xe1, 0xd1, 0xcf, 0x03, 0xd8, 0x0e, 0xb6, 0x1c, 0xa2,
+	0xa9, 0xd8, 0x91, 0x63, 0x6c, 0xbc, 0x93, 0x73, 0x7c, 0xbb, 0xcd, 0xb9, 0xdb, 0x6d, 0x6e, 0xcd,
+	0xdd, 0x8f, 0xf9, 0x18, 0x1d, 0xe6, 0x4f, 0xff, 0x27, 0x2d, 0x29, 0x71, 0xc1, 0x97, 0x73, 0x6e,
+	0x45, 0x62, 0x52, 0x32, 0x9c, 0xf9, 0x8f, 0x04, 0x0c, 0x2e, 0xe5, 0xe6, 0x57, 0xb0, 0x85, 0xab,
+	0xc4, 0x21, 0x96, 0x8d, 0x5e, 0x81, 0x58, 0x15, 0xef, 0xaa, 0x44, 0xb7, 0x6a, 0xb2, 0x34, 0x25,
+	0xcd, 0x84, 0xf3, 0xfd, 0x8d, 0xfd, 0x74, 0x74, 0x09, 0xef, 0x16, 0x4b, 0xca, 0x8a, 0x12, 0xad,
+	0xe2, 0xdd, 0xa2, 0x6e, 0xd5, 0xd0, 0xfb, 0x30, 0x82, 0x35, 0x4b, 0xa5, 0xab, 0xac, 0x5a, 0xd8,
+	0x21, 0xaa, 0x6e, 0x68, 0x64, 0x97, 0xcd, 0xd8, 0x50, 0xf6, 0x74, 0xeb, 0xec, 0x17, 0xb0, 0x83,
+	0x15, 0xec, 0x90, 0x12, 0x25, 0xca, 0x9f, 0x3a, 0xc8, 0xf7, 0xfe, 0x90, 0xce, 0x7f, 0x63, 0x3f,
+	0x9d, 0xcc, 0x15, 0x94, 0xa6, 0x5e, 0x25, 0x89, 0x35, 0xab, 0xa9, 0x05, 0xdd, 0x04, 0x44, 0x65,
+	0x39, 0xbb, 0x6a, 0xcd, 0xdc, 0x21, 0x96, 0x10, 0xc5, 0x66, 0x3d, 0x3f, 0x79, 0x90, 0x8f, 0x5c,
+	0x08, 0xcb, 0x89, 0xc6, 0x7e, 0x3a, 0x91, 0x2b, 0x28, 0x6b, 0xbb, 0x2b, 0x94, 0x84, 0x23, 0x25,
+	0xb0, 0x66, 0x05, 0x1b, 0xd0, 0x77, 0x61, 0x80, 0x02, 0x19, 0xeb, 0xaa, 0x63, 0x61, 0xc3, 0xe6,
+	0xcb, 0x91, 0x1f, 0xf3, 0x21, 0x20, 0x57, 0x50, 0x96, 0xd7, 0xd7, 0x68, 0xa7, 0x02, 0x58, 0xb3,
+	0xc4, 0x33, 0xba, 0x06, 0x83, 0x94, 0x11, 0x97, 0xb7, 0xd4, 0x8a, 0x5e, 0xd5, 0x1d, 0xbe, 0x36,
+	0xf9, 0xe1, 0xc6, 0x7e, 0xba, 0x3f, 0x57, 0x50, 0x72, 0xe5, 0xad, 0x45, 0xd6, 0x2c, 0x29, 0xfd,
+	0x58, 0xb3, 0xdc, 0xd7, 0x20, 0x9b, 0x46, 0x2a, 0x78, 0x8f, 0x2d, 0x56, 0x13, 0x5b, 0x81, 0x35,
+	0x7b, 0x6c, 0xec, 0x15, 0xfd, 0x09, 0xc4, 0xad, 0xdd, 0x2b, 0x82, 0x25, 0xce, 0x66, 0x74, 0xa2,
+	0x75, 0x46, 0x95, 0x5d, 0x46, 0x9b, 0x8f, 0xb9, 0x73, 0xa9, 0xc4, 0xac, 0xdd, 0x2b, 0x9c, 0xff,
+	0x0d, 0x18, 0x65, 0xfc, 0xde, 0xda, 0x98, 0x1b, 0x1b, 0x36, 0x71, 0x64, 0x60, 0xd2, 0xa3, 0x7c,
+	0xb8, 0x51, 0x65, 0x98, 0x32, 0x88, 0x89, 0xbe, 0xc3, 0x28, 0xd0, 0x3d, 0x18, 0xb1, 0x76, 0xb3,
+	0x6d, 0xab, 0xda, 0xdf, 0xcd, 0xaa, 0xfa, 0x9a, 0x24, 0xad, 0xdd, 0x6c, 0xf3, 0x0a, 0xce, 0xc1,
+	0x20, 0xc5, 0xdd, 0xb0, 0xc8, 0x07, 0x75, 0x62, 0x94, 0xf7, 0xe4, 0x81, 0x29, 0x69, 0x26, 0x92,
+	0x8f, 0x1f, 0xe4, 0xfb, 0xb2, 0x91, 0x99, 0x4f, 0xff, 0xb2, 0x4f, 0x19, 0xb0, 0x76, 0xb3, 0x0b,
+	0x6e, 0x37, 0x5a, 0x85, 0x21, 0xba, 0x0b, 0xb5, 0xba, 0xb3, 0xa7, 0x96, 0xf7, 0xca, 0x15, 0x22,
+	0x0f, 0x32, 0x15, 0xce, 0xb6, 0xaa, 0x90, 0xdb, 0xdc, 0xb4, 0xc8, 0x26, 0x76, 0x88, 0x56, 0xa8,
+	0x3b, 0x7b, 0xf3, 0x94, 0x34, 0xa0, 0xc8, 0x40, 0x15, 0xef, 0x7a, 0xed, 0x48, 0x83, 0x09, 0x8b,
+	0x50, 0xcb, 0xa8, 0x52, 0x5b, 0xad, 0xd6, 0x88, 0xa5, 0x9b, 0x9a, 0x5e, 0xd6, 0x9d, 0x3d, 0x79,
+	0x88, 0xa1, 0x67, 0xda, 0x26, 0x99, 0x91, 0xd3, 0x93, 0x54, 0xdc, 0xad, 0x99, 0x06, 0x31, 0x9c,
+	0x00, 0xf8, 0x98, 0xe5, 0xf5, 0xae, 0xf8, 0x50, 0x68, 0x13, 0x64, 0x21, 0xa5, 0x6c, 0xd6, 0x0d,
+	0xa7, 0x49, 0x4c, 0xa2, 0xf3, 0x20, 0xb8, 0x98, 0x79, 0x4a, 0xde, 0x41, 0xce, 0xb8, 0xe5, 0x77,
+	0x07, 0x05, 0xbd, 0x09, 0x23, 0x35, 0xdd, 0xd8, 0x54, 0xed, 0x8a, 0xe9, 0x04, 0x66, 0x36, 0xc9,
+	0x66, 0xb6, 0xff, 0x20, 0x1f, 0xcb, 0xf6, 0xc9, 0x21, 0x36, 0xb7, 0xc3, 0x94, 0x6e, 0xb5, 0x62,
+	0x3a, 0xfe, 0x04, 0x63, 0x38, 0xe1, 0x33, 0xb7, 0x2e, 0xf7, 0xf0, 0xf1, 0x96, 0x7b, 0xcc, 0x85,
+	0x6f, 0x5e, 0xf3, 0xd7, 0x21, 0xb9, 0x4e, 0x70, 0xd9, 0x34, 0x02, 0xca, 0xa1, 0x76, 0xe5, 0x12,
+	0x9c, 0xc8, 0x57, 0xed, 0x36, 0xc4, 0xca, 0x4f, 0xb1, 0x61, 0x90, 0x8a, 0x2d, 0x8f, 0x4c, 0xf5,
+	0xcc, 0xf4, 0x67, 0xcf, 0xb5, 0x6a, 0xd2, 0x64, 0xb2, 0xe6, 0xe6, 0x39, 0x35, 0xd3, 0xe8, 0x67,
+	0x52, 0x38, 0x26, 0x29, 0x1e, 0x00, 0x5a, 0x80, 0xe1, 0x7a, 0xad, 0xa2, 0x1b, 0x5b, 0xaa, 0xb6,
+	0x43, 0x2a, 0x15, 0xb6, 0xf2, 0xf2, 0xe8, 0x21, 0x26, 0x33, 0x6f, 0x9a, 0x95, 0x7b, 0xb8, 0x52,
+	0x27, 0x4a, 0x82, 0x33, 0x15, 0x28, 0x0f, 0x5d, 0x60, 0x74, 0x0b, 0x46, 0xa8, 0x4d, 0x6e, 0x45,
+	0x1a, 0x7b, 0x29, 0xd2, 0xb0, 0xcb, 0xe6, 0x63, 0x6d, 0xc3, 0x78, 0x93, 0x31, 0x51, 0x89, 0x58,
+	0x74, 0x79, 0x9c, 0xc1, 0xcd, 0xb4, 0x6d, 0x72, 0xdf, 0xc2, 0xb8, 0xfb, 0x83, 0x81, 0xe7, 0x27,
+	0x1a, 0xfb, 0xe9, 0x91, 0x0e, 0xbd, 0xca, 0x48, 0xc0, 0x0a, 0xb9, 0x8d, 0x41, 0xb9, 0xcc, 0xb4,
+	0xf8, 0x72, 0x27, 0x8e, 0x92, 0xcb, 0x6c, 0xca, 0xa1, 0x72, 0x9b, 0x7a, 0x5d, 0xb9, 0x4d, 0x8d,
+	0x93, 0xbf, 0x0e, 0x43, 0x54, 0xac, 0x11, 0x7a, 0x0d, 0x92, 0x62, 0x3d, 0xfc, 0x4d, 0x21, 0xb5,
+	0xda, 0x02, 0x31, 0xfb, 0xfe, 0x96, 0x78, 0x03, 0x90, 0x37, 0xfb, 0x3e, 0x5f, 0xb8, 0x95, 0xcf,
+	0x9b, 0x6b, 0x9f, 0xf3, 0x1e, 0x8c, 0x54, 0x75, 0xa3, 0x6d, 0x87, 0xf7, 0x1c, 0xd3, 0xa0, 0x55,
+	0x75, 0xa3, 0x79, 0x73, 0x53, 0x5c, 0x6a, 0xa0, 0xbe, 0xca, 0xf5, 0x17, 0xc4, 0xc5, 0xbb, 0xcd,
+	0xb8, 0x67, 0x61, 0x90, 0x18, 0x78, 0xbd, 0x42, 0x54, 0x3e, 0x07, 0xec, 0x96, 0x8b, 0x29, 0x03,
+	0xbc, 0xf1, 0x2e, 0x6b, 0xbb, 0x1e, 0xf9, 0xec, 0xd3, 0x74, 0x88, 0xff, 0xbd, 0x15, 0x89, 0x85,
+	0x93, 0x3d, 0xb7, 0x22, 0xb1, 0x9e, 0x64, 0x24, 0x53, 0x85, 0xa1, 0xa2, 0xa1, 0x15, 0x98, 0x0f,
+	0x9f, 0xb7, 0xb0, 0xa1, 0xa1, 0x71, 0x08, 0xeb, 0x1a, 0x9b, 0xe0, 0x78, 0xbe, 0xaf, 0xb1, 0x9f,
+	0x0e, 0x97, 0x0a, 0x4a, 0x58, 0xd7, 0x10, 0x82, 0x88, 0x81, 0xab, 0x84, 0x4d, 0x61, 0x5c, 0x61,
+	0xcf, 0xe8, 0x04, 0xf4, 0xd4, 0xad, 0x0a, 0x9b, 0x9a, 0x78, 0x3e, 0xda, 0xd8, 0x4f, 0xf7, 0xdc,
+	0x55, 0x16, 0x15, 0xda, 0x86, 0x46, 0xa1, 0xb7, 0x62, 0x6e, 0x9a, 0xb6, 0x1c, 0x99, 0xea, 0x99,
+	0x89, 0x2b, 0xfc, 0x25, 0xf3, 0x8f, 0x52, 0x40, 0xde, 0x92, 0xa9, 0x91, 0x0a, 0x5a, 0x82, 0xd8,
+	0x3a, 0x15, 0xac, 0x7a, 0x52, 0xb3, 0x07, 0xf9, 0x69, 0x2b, 0x23, 0x4f, 0x67, 0x53, 0x4f, 0x1e,
+	0xe2, 0xd9, 0x0f, 0x2f, 0xcf, 0x7e, 0xef, 0xf1, 0xcc, 0x8d, 0xeb, 0x0f, 0x67, 0x1f, 0xdf, 0x70,
+	0x5f, 0xcf, 0x7f, 0x94, 0xbd, 0xf8, 0x6c, 0x9a, 0x3a, 0x19, 0x4c, 0xe7, 0x52, 0x41, 0x89, 0x32,
+	0x8c, 0x92, 0x86, 0xde, 0x66, 0xea, 0x33, 0x25, 0xf3, 0xb3, 0xdd, 0x03, 0xb5, 0x8e, 0xb2, 0xc7,
+	0x1f, 0x65, 0xe6, 0xaf, 0xc3, 0x70, 0xd2, 0x53, 0xfa, 0x1e, 0xb1, 0xa8, 0x53, 0x58, 0xf2, 0x5d,
+	0xea, 0x6f, 0x7a, 0x04, 0x4b, 0x10, 0xab, 0xd2, 0x99, 0x51, 0xbd, 0x71, 0x1c, 0x07, 0x8e, 0x4d,
+	0x2a, 0x85, 0x63, 0x18, 0x25, 0x0d, 0x9d, 0x87, 0xe4, 0x53, 0x6c, 0x69, 0x3b, 0xd8, 0x22, 0xea,
+	0x36, 0x57, 0x5e, 0x8c, 0x2e, 0xe1, 0xb6, 0x8b, 0x31, 0x51, 0xd2, 0x0d, 0xdd, 0xaa, 0x36, 0x91,
+	0x46, 0x38, 0xa9, 0xdb, 0x2e, 0x48, 0x33, 0xbf, 0xee, 0x83, 0x64, 0xeb, 0x9c, 0xa0, 0x3b, 0xd0,
+	0xa3, 0x6b, 0x36, 0x9b, 0x83, 0xfe, 0xec, 0x77, 0x5a, 0x77, 0xf4, 0x11, 0x53, 0xd8, 0xc1, 0xbd,
+	0xa6, 0x48, 0x48, 0x85, 0x84, 0x00, 0xf0, 0xf4, 0x09, 0xb3, 0xe3, 0x32, 0xd9, 0xc1, 0xbc, 0x0b,
+	0x58, 0xea, 0xde, 0x79, 0xae, 0xe2, 0xd0, 0xa2, 0xa9, 0xe0, 0xfb, 0xb9, 0x65, 0xd1, 0xa7, 0x0c,
+	0x09, 0x16, 0x57, 0x63, 0x1d, 0x46, 0x5c, 0x01, 0xb5, 0xa7, 0x7b, 0x4d, 0xf3, 0xd3, 0x41, 0xc8,
+	0xca, 0xbb, 0xef, 0xb9, 0x42, 0x4e, 0x07, 0x84, 0x0c, 0x0b, 0x21, 0x7e, 0xb7, 0x32, 0x2c, 0xb8,
+	0x56, 0x9e, 0xee, 0xb9, 0xa2, 0x16, 0x60, 0xd8, 0xb3, 0x43, 0x6a, 0xad, 0x82, 0x0d, 0xba, 0xbe,
+	0x6c, 0x76, 0x99, 0x43, 0x6a, 0x85, 0xe5, 0x77, 0xa8, 0x43, 0xea, 0xd9, 0xa1, 0x95, 0x0a, 0x36,
+	0x4a, 0x05, 0x25, 0xb1, 0xd1, 0xd4, 0x40, 0xcf, 0x67, 0x5f, 0xed, 0xa9, 0xe9, 0x98, 0xb6, 0xdc,
+	0xcb, 0x4e, 0x96, 0x78, 0x43, 0x33, 0x90, 0xb4, 0xeb, 0xb5, 0x9a, 0x69, 0x39, 0xb6, 0x5a, 0xae,
+	0x60, 0xdb, 0x56, 0xd7, 0x99, 0xb3, 0x1a, 0x53, 0x86, 0xdc, 0xf6, 0x79, 0xda, 0x9c, 0xef, 0x40,
+	0x59, 0x66, 0xce, 0x69, 0x2b, 0xe5, 0x3c, 0x22, 0x30, 0xaa, 0x91, 0x0d, 0x5c, 0xaf, 0x38, 0x6a,
+	0x15, 0x97, 0x55, 0x9b, 0x38, 0x0e, 0x8d, 0xb4, 0x44, 0x00, 0x71, 0xb2, 0xc3, 0x22, 0xac, 0x0a,
+	0x92, 0xfc, 0x78, 0x63, 0x3f, 0x8d, 0x0a, 0x9c, 0x39, 0xd0, 0xae, 0x20, 0x01, 0xb8, 0x84, 0xcb,
+	0x6e, 0x1b, 0xb5, 0x60, 0xd4, 0xe2, 0xfa, 0x66, 0x9a, 0x3a, 0xb0, 0x11, 0x65, 0xa0, 0xaa, 0x07,
+	0xee, 0x78, 0x4a, 0x84, 0x77, 0x03, 0x44, 0x20, 0x88, 0xf0, 0x6e, 0x13, 0x91, 0x37, 0x34, 0xea,
+	0x01, 0x31, 0x37, 0x34, 0xa6, 0x0c, 0xb8, 0x8d, 0xb7, 0x4c, 0xdd, 0x40, 0x17, 0x01, 0x59, 0xc4,
+	0x26, 0x82, 0x44, 0x35, 0x4c, 0xa3, 0x4c, 0x6c, 0xe6, 0x5e, 0xc6, 0x94, 0x24, 0xef, 0xa1, 0x74,
+	0xcb, 0xac, 0x1d, 0x11, 0x70, 0x55, 0x56, 0x37, 0x4c, 0xab, 0x8a, 0x1d, 0xea, 0x40, 0x30, 0xdf,
+	0xb2, 0xc3, 0xf5, 0xb7, 0xc4, 0xe3, 0xdc, 0x15, 0xbc, 0x57, 0x31, 0xb1, 0xb6, 0xe0, 0xd1, 0xe7,
+	0x07, 0x82, 0x1b, 0x5c, 0x19, 0x16, 0x88, 0x3e, 0x01, 0x37, 0xcd, 0x99, 0xff, 0x1a, 0x81, 0xfe,
+	0xc0, 0x6c, 0xa1, 0x9b, 0x90, 0x10, 0x6b, 0xc9, 0x9c, 0x07, 0xb3, 0xee, 0x88, 0xd3, 0x75, 0xa2,
+	0xcd, 0x7f, 0x28, 0x88, 0x4c, 0x46, 0x3e, 0xf2, 0x73, 0x1a, 0xb7, 0x0d, 0x32, 0xbe, 0xfc, 0x1a,
+	0xe7, 0x42, 0xf7, 0x61, 0xcc, 0x77, 0xde, 0x82, 0xfe, 0x65, 0x98, 0xc1, 0xb5, 0xf9, 0x97, 0x2b,
+	0xc2, 0x3f, 0xe3, 0xde, 0x23, 0xf7, 0x4b, 0x46, 0x6a, 0x4d, 0x8d, 0xdc, 0xa5, 0x7c, 0x74, 0x94,
+	0x57, 0xc8, 0x03, 0xeb, 0xcc, 0x91, 0x77, 0x1b, 0xc7, 0x3e, 0xc4, 0x21, 0xbc, 0xdf, 0xd9, 0x61,
+	0x8d, 0x30, 0xdc, 0x53, 0x6d, 0x73, 0x70, 0xb7, 0x64, 0x38, 0xaf, 0xbf, 0xc6, 0x1d, 0x8e, 0xe0,
+	0x25, 0xdf, 0xee, 0xcc, 0x7a, 0x13, 0x5b, 0xf6, 0x26, 0xb6, 0xf7, 0x38, 0x13, 0x3b, 0xef, 0x4e,
+	0xec, 0xf7, 0x82, 0x81, 0x57, 0x9f, 0xd0, 0xab, 0x73, 0xe0, 0xc5, 0x47, 0xea, 0xc7, 0x5c, 0xf7,
+	0x0e, 0x89, 0xb9, 0xa2, 0x47, 0x8c, 0xee, 0x6a, 0x96, 0x8f, 0xee, 0xa8, 0x88, 0xec, 0xfb, 0x9d,
+	0x23, 0xb2, 0x58, 0xd7, 0x8b, 0xd1, 0x1e, 0x8c, 0x2d, 0xb6, 0x06, 0x63, 0xf1, 0xe3, 0xad, 0x40,
+	0x73, 0xa8, 0xf6, 0x16, 0x4c, 0x6e, 0xe0, 0xb2, 0x63, 0x5a, 0x7b, 0x6a, 0x8d, 0x9d, 0x37, 0x0f,
+	0x58, 0x27, 0xb6, 0x0c, 0x53, 0x3d, 0x33, 0x11, 0x45, 0x16, 0x14, 0x2b, 0x8c, 0x60, 0xc1, 0xef,
+	0x47, 0xcb, 0x6d, 0x81, 0x5e, 0xff, 0x21, 0xbe, 0x68, 0x7b, 0xa0, 0xc7, 0xc7, 0xd7, 0x1c, 0xe3,
+	0x95, 0x61, 0xcc, 0xb3, 0x19, 0x57, 0xb3, 0xea, 0xba, 0x2e, 0xb2, 0x39, 0xcc, 0x22, 0x1c, 0xe9,
+	0xa9, 0xe7, 0xc7, 0xa8, 0xf5, 0x5f, 0x15, 0xcc, 0x57, 0xb3, 0x79, 0x9d, 0xe5, 0x7c, 0x94, 0x61,
+	0xbb, 0xb5, 0x09, 0xdd, 0x80, 0x68, 0xdd, 0x26, 0x2a, 0xd6, 0x2c, 0x61, 0x3a, 0x8e, 0x82, 0x85,
+	0xc6, 0x7e, 0xba, 0xef, 0xae, 0x4d, 0x72, 0x05, 0x45, 0xe9, 0xab, 0xdb, 0x24, 0xa7, 0x59, 0xa8,
+	0x04, 0x40, 0x3d, 0xf1, 0x2a, 0xb6, 0x36, 0x75, 0x83, 0x05, 0x9f, 0xd4, 0x00, 0xb7, 0x62, 0x2c,
+	0x54, 0x4c, 0x2c, 0x1c, 0xee, 0xc1, 0xc6, 0x7e, 0x3a, 0x9e, 0x2b, 0x28, 0x4b, 0x8c, 0x43, 0x89,
+	0x63, 0xcd, 0xe2, 0x8f, 0xe8, 0x2d, 0x18, 0x10, 0xf6, 0x8f, 0x8f, 0x33, 0xf1, 0xd2, 0x88, 0x04,
+	0x38, 0x3d, 0x1b, 0xc9, 0x7d, 0x98, 0xb0, 0x1d, 0xec, 0xd4, 0xed, 0xf6, 0x90, 0x38, 0xd9, 0xdd,
+	0x09, 0x1a, 0xe3, 0xfc, 0xad, 0x51, 0xf0, 0x3d, 0x90, 0x05, 0x70, 0x7b, 0x14, 0x3c, 0xfc, 0xf2,
+	0x23, 0xa1, 0x8c, 0x73, 0xee, 0xb6, 0xa0, 0xf7, 0x5d, 0x18, 0xd6, 0x88, 0xad, 0x5b, 0x44, 0x53,
+	0xfd, 0x93, 0x8a, 0xba, 0x38, 0xa9, 0x09, 0xc1, 0xa6, 0xb8, 0x07, 0xf6, 0x11, 0x9c, 0x6a, 0x42,
+	0x6a, 0x3d, 0xb8, 0x23, 0x5d, 0x68, 0x29, 0x07, 0x40, 0x9b, 0x8f, 0xed, 0x0f, 0xe0, 0xa4, 0x8f,
+	0xde, 0x7e, 0x7c, 0x47, 0xbb, 0x3e, 0xbe, 0x13, 0x9e, 0x88, 0x96, 0x53, 0xfc, 0x10, 0xc6, 0x82,
+	0x12, 0xfc, 0xd3, 0x3c, 0x76, 0xbc, 0xd3, 0x3c, 0xe2, 0x0b, 0xf0, 0x0f, 0xf5, 0x63, 0x18, 0x77,
+	0xc1, 0x5b, 0x8e, 0xe7, 0xf8, 0x31, 0x8f, 0xa7, 0x0b, 0xbf, 0x14, 0x3c, 0xa5, 0x7f, 0x21, 0x41,
+	0xca, 0xc5, 0x3f, 0x24, 0x14, 0x9e, 0x38, 0x66, 0x28, 0x9c, 0x6a, 0xec, 0xa7, 0x27, 0x0b, 0x1c,
+	0xb3, 0x53, 0x44, 0x3c, 0x29, 0xe4, 0xe5, 0x3a, 0x04, 0xc6, 0x9d, 0xd4, 0x69, 0x89, 0x90, 0xe5,
+	0x63, 0x46, 0xc8, 0xed, 0xea, 0x34, 0x07, 0xca, 0xcd, 0xea, 0x34, 0xf5, 0xa1, 0x0f, 0x60, 0x82,
+	0x59, 0x87, 0x0e, 0x71, 0xeb, 0x89, 0x6e, 0xf7, 0x8d, 0x17, 0xa2, 0x2f, 0xb5, 0x44, 0xae, 0x2c,
+	0x44, 0x6f, 0x6d, 0xf4, 0x44, 0x76, 0x08, 0x69, 0x27, 0x8f, 0x2f, 0xb2, 0x25, 0xa8, 0xe5, 0x22,
+	0x5b, 0x23, 0x5d, 0x93, 0x67, 0x23, 0xa8, 0xc8, 0x96, 0xc4, 0xee, 0xc9, 0x2e, 0xae, 0xcc, 0xd3,
+	0x7e, 0xce, 0x16, 0x71, 0x91, 0x4d, 0x99, 0x5f, 0xc4, 0x25, 0x36, 0x25, 0x7f, 0x6f, 0xc2, 0x30,
+	0x4f, 0xae, 0xf3, 0x5b, 0x6a, 0xd3, 0xc2, 0x65, 0x22, 0x9f, 0xea, 0xe2, 0x94, 0x0f, 0x6d, 0xd0,
+	0x7b, 0x80, 0x32, 0xdd, 0xa4, 0x3c, 0x99, 0x46, 0x1c, 0x62, 0xd4, 0xaf, 0x73, 0xb0, 0x43, 0xd0,
+	0x03, 0x40, 0xe5, 0xba, 0x65, 0x11, 0x6a, 0xe3, 0xbc, 0x94, 0x94, 0xf0, 0xeb, 0x4e, 0x1f, 0x99,
+	0xb7, 0x6a, 0x75, 0x23, 0x05, 0x4c, 0x20, 0x17, 0xff, 0x80, 0x7a, 0xab, 0x7c, 0x5b, 0x06, 0xb0,
+	0xc3, 0x5f, 0x01, 0x5b, 0xc0, 0x04, 0xb0, 0xf3, 0x30, 0xc0, 0x8b, 0x7d, 0x3c, 0x6a, 0x10, 0x51,
+	0xd2, 0x58, 0x2b, 0x2a, 0x8f, 0x32, 0xfc, 0x8c, 0x45, 0x3f, 0x67, 0x62, 0xcd, 0x9d, 0x22, 0xba,
+	0xc8, 0x37, 0x1a, 0xd1, 0x3d, 0x86, 0x49, 0xaf, 0x32, 0xa2, 0x5b, 0x55, 0xa2, 0xa9, 0x5e, 0x1a,
+	0x08, 0xbb, 0x3e, 0xde, 0x51, 0x95, 0x8f, 0x08, 0xab, 0x7a, 0x4c, 0xb8, 0x15, 0x14, 0x06, 0x51,
+	0x10, 0x08, 0x39, 0x07, 0x5d, 0x03, 0x99, 0xc1, 0x6b, 0x64, 0x5b, 0x15, 0xb7, 0x95, 0x57, 0xfa,
+	0xe1, 0x95, 0x9a, 0x11, 0xda, 0x5f, 0x20, 0xdb, 0xab, 0xac, 0x57, 0xd4, 0x80, 0x1e, 0x1d, 0xe6,
+	0x7e, 0x47, 0xd9, 0xe0, 0x53, 0x47, 0xbb, 0xdf, 0x81, 0xc9, 0xec, 0xe8, 0x83, 0x13, 0x38, 0x55,
+	0x23, 0x86, 0x46, 0x05, 0x04, 0xea, 0x81, 0xde, 0xc0, 0x85, 0xe7, 0xd7, 0x9e, 0x08, 0xf7, 0x69,
+	0xdd, 0x11, 0x2a, 0x93, 0x02, 0xa8, 0x43, 0x1f, 0x2a, 0x42, 0xf2, 0x83, 0x3a, 0xa9, 0xd3, 0xdb,
+	0x83, 0xd8, 0x35, 0xd3, 0xb0, 0x89, 0x2d, 0xc7, 0x59, 0xb6, 0xb5, 0xd3, 0xe2, 0xcd, 0x9b, 0xd5,
+	0x2a, 0x36, 0x34, 0x25, 0xc1, 0x79, 0x14, 0x97, 0x85, 0xc2, 0xb8, 0xda, 0xb2, 0xcb, 0xc3, 0x76,
+	0xb8, 0xcf, 0xf7, 0x12, 0x18, 0xc1, 0xa3, 0x08, 0x16, 0xf4, 0x7d, 0x40, 0x42, 0x1b, 0x16, 0xc5,
+	0xe1, 0x72, 0x99, 0xd4, 0x1c, 0xe1, 0x0a, 0x9e, 0xed, 0x14, 0x99, 0xd2, 0xb3, 0x37, 0x47, 0x03,
+	0xbb, 0x1c, 0x23, 0x55, 0xc4, 0x60, 0xfc, 0x16, 0xb4, 0x04, 0xa3, 0xae, 0x66, 0x0c, 0x53, 0xa8,
+	0x27, 0x1c, 0xc1, 0xb6, 0x70, 0x97, 0x72, 0x0a, 0x75, 0x14, 0x24, 0x18, 0x03, 0x6d, 0xe8, 0x32,
+	0xf5, 0xef, 0xd5, 0x1d, 0xdd, 0xd0, 0xcc, 0x1d, 0x5b, 0xc5, 0xdb, 0x58, 0xaf, 0xe0, 0x75, 0x51,
+	0x97, 0x88, 0x29, 0xc8, 0xda, 0xbd, 0xcf, 0xbb, 0x72, 0x6e, 0xcf, 0xe4, 0x3f, 0x4b, 0x00, 0x01,
+	0x7d, 0xce, 0x42, 0xb4, 0xc6, 0x23, 0x49, 0x66, 0x1d, 0x06, 0xd8, 0x1d, 0xfc, 0x61, 0x24, 0x39,
+	0x2c, 0x9f, 0x51, 0xdc, 0x1e, 0x34, 0x0f, 0x51, 0x57, 0xcf, 0xf0, 0x4b, 0xf5, 0x6c, 0x39, 0xe4,
+	0x2e, 0x27, 0x7a, 0xbb, 0xfb, 0x4a, 0x68, 0x33, 0x02, 0x63, 0x13, 0xc1, 0xeb, 0x7f, 0x47, 0x58,
+	0x65, 0x70, 0x8d, 0x5a, 0xbc, 0xa2, 0xe1, 0x58, 0x7b, 0xe8, 0x0d, 0x88, 0xb0, 0x9c, 0xb7, 0x74,
+	0x8c, 0x82, 0x23, 0xe3, 0x40, 0xe3, 0xd0, 0x27, 0xb2, 0x99, 0x61, 0x36, 0x5b, 0xe2, 0x0d, 0x9d,
+	0x86, 0x5e, 0xee, 0xb4, 0xf6, 0xb0, 0xc2, 0x54, 0xac, 0xb1, 0x9f, 0x8e, 0x30, 0x9f, 0x3b, 0x42,
+	0x2d, 0x2e, 0x3a, 0x01, 0x3d, 0xd4, 0xc5, 0xa6, 0x26, 0x25, 0xc6, 0x13, 0x92, 0xd4, 0x87, 0xa6,
+	0x6d, 0x68, 0x19, 0x06, 0xaa, 0xb8, 0xac, 0x96, 0xf9, 0x7e, 0xe2, 0xd9, 0x93, 0x23, 0xb7, 0x5c,
+	0x9e, 0xde, 0x14, 0xfd, 0xfe, 0xbb, 0xad, 0xf4, 0x57, 0x71, 0xd9, 0x7d, 0x41, 0x77, 0x20, 0xd1,
+	0x7a, 0xef, 0xf5, 0x1d, 0x2f, 0x95, 0x3b, 0xa8, 0x35, 0xdd, 0x6e, 0xd7, 0xa0, 0x97, 0xdb, 0xd5,
+	0x68, 0x77, 0x76, 0x95, 0x53, 0xa3, 0x77, 0x21, 0x56, 0xb3, 0x74, 0xd3, 0xa2, 0xd6, 0x24, 0xd6,
+	0xb9, 0x26, 0xb5, 0xb6, 0xbb, 0x5a, 0x7e, 0x4a, 0xb4, 0x7a, 0x85, 0xac, 0x08, 0xca, 0x60, 0x0d,
+	0xd0, 0xe5, 0x46, 0xf7, 0xa1, 0x9f, 0xba, 0x63, 0x3b, 0x78, 0x4f, 0xd5, 0x35, 0xf7, 0x68, 0xb7,
+	0x81, 0xdd, 0xe4, 0x24, 0xc1, 0xec, 0x1d, 0xa2, 0x8b, 0xd7, 0xd8, 0x4f, 0x83, 0xdb, 0x57, 0xb0,
+	0x15, 0xd8, 0x74, 0xe9, 0x6c, 0x94, 0x83, 0x7e, 0x56, 0x3c, 0xa5, 0xde, 0x1a, 0xe6, 0x35, 0xc5,
+	0x6e, 0x8c, 0x30, 0xb8, 0x4c, 0x39, 0x27, 0xf3, 0x85, 0x14, 0xc8, 0xc1, 0xe6, 0xea, 0xce, 0x53,
+	0x2a, 0x9d, 0xdb, 0xa7, 0x79, 0x53, 0x23, 0x68, 0x16, 0x7a, 0xb7, 0xe9, 0xcd, 0x2b, 0x12, 0xb0,
+	0x13, 0x07, 0xf9, 0x51, 0x0b, 0x65, 0x93, 0x4f, 0x1e, 0xe6, 0x66, 0x1f, 0x5c, 0x9e, 0xfd, 0xde,
+	0xe3, 0x8f, 0xae, 0x5c, 0xbc, 0x9a, 0x7d, 0x36, 0xad, 0x70, 0x2a, 0x74, 0x03, 0x80, 0x7d, 0x66,
+	0xa2, 0x6e, 0x58, 0x66, 0x55, 0x9c, 0x9b, 0x97, 0x2b, 0x14, 0x67, 0x3c, 0x0b, 0x96, 0x59, 0x45,
+	0x6f, 0x42, 0x8c, 0x03, 0x38, 0xa6, 0x38, 0x34, 0x2f, 0x67, 0x8f, 0x32, 0x8e, 0x35, 0x53, 0x1c,
+	0x97, 0x7f, 0x3f, 0x03, 0x71, 0x6f, 0x48, 0xe8, 0xdd, 0x60, 0xee, 0x74, 0xfa, 0xd0, 0xdc, 0x69,
+	0x17, 0x49, 0xd3, 0x79, 0x80, 0xb2, 0x45, 0xb0, 0xa8, 0xf5, 0x87, 0x8f, 0x53, 0xeb, 0x17, 0x7c,
+	0x39, 0x87, 0x82, 0xd4, 0x6b, 0x9a, 0x0b, 0xd2, 0x73, 0x1c, 0x10, 0xc1, 0x97, 0x73, 0xd0, 0x49,
+	0x91, 0x4c, 0xe7, 0x59, 0xce, 0x28, 0xcf, 0x72, 0x66, 0x45, 0xed, 0xe0, 0x02, 0xf4, 0x6b, 0xc4,
+	0x2e, 0x5b, 0x7a, 0x8d, 0x2e, 0x22, 0xbb, 0x99, 0xe3, 0x6c, 0x5b, 0x5a, 0x3d, 0xf2, 0x17, 0x09,
+	0x25, 0xd8, 0x89, 0x76, 0x00, 0xb0, 0xe3, 0x58, 0xfa, 0x7a, 0xdd, 0x21, 0xb6, 0xdc, 0xc7, 0x36,
+	0xe6, 0xf9, 0x43, 0xe7, 0x68, 0x2e, 0xe7, 0xd1, 0x32, 0x33, 0x94, 0xbf, 0x78, 0x90, 0x3f, 0xff,
+	0xb7, 0xd2, 0x2b, 0x99, 0xae, 0x92, 0xe8, 0x4a, 0x40, 0x14, 0x7a, 0x04, 0xfd, 0xc2, 0x4d, 0x61,
+	0x47, 0x22, 0x7a, 0xfc, 0xcc, 0xf6, 0x10, 0x3d, 0x17, 0x6e, 0x3b, 0x3d, 0x17, 0xdb, 0x2e, 0x8d,
+	0x8d, 0x4a, 0x80, 0x6c, 0x62, 0x31, 0x8f, 0xaa, 0x66, 0x99, 0x1b, 0x7a, 0x85, 0xa8, 0xba, 0xc6,
+	0x0e, 0x71, 0x3c, 0x7f, 0xd2, 0xcf, 0x09, 0x27, 0x57, 0x39, 0xd1, 0x0a, 0xa7, 0x29, 0x15, 0x94,
+	0xa4, 0xdd, 0xdc, 0xa2, 0xa1, 0x7f, 0x95, 0x60, 0x5c, 0x7c, 0xff, 0xa2, 0xd2, 0x4e, 0x62, 0xb1,
+	0xef, 0x65, 0x88, 0x6d, 0xb3, 0x54, 0x4d, 0x3c, 0xff, 0x57, 0xd2, 0x41, 0xfe, 0x27, 0x92, 0xf5,
+	0x23, 0x29, 0xfb, 0x67, 0xd2, 0x93, 0x99, 0x1b, 0xd7, 0xe9, 0xd8, 0xf1, 0xec, 0x87, 0xe2, 0x78,
+	0x7c, 0x1c, 0x78, 0xf6, 0x1f, 0x1f, 0xcd, 0x3e, 0xbe, 0x10, 0xe8, 0x38, 0xff, 0x68, 0xee, 0xfc,
+	0x05, 0xca, 0x97, 0x9b, 0x7d, 0x20, 0xa6, 0xec, 0xe3, 0xc0, 0xb3, 0xff, 0xc8, 0xf8, 0xfc, 0x8e,
+	0xf3, 0x33, 0x37, 0xae, 0x5f, 0x7f, 0x28, 0x4e, 0xe1, 0xb5, 0x67, 0xe7, 0x6f, 0x4c, 0x7f, 0xfc,
+	0x64, 0x5a, 0x19, 0x15, 0xea, 0xae, 0x32, 0x6d, 0x73, 0x5c, 0x59, 0xf4, 0x00, 0xe4, 0x96, 0x61,
+	0x6c, 0x91, 0x2d, 0xb5, 0x82, 0xd7, 0x49, 0x45, 0xbe, 0xc4, 0x06, 0x72, 0x86, 0x6f, 0x91, 0x4f,
+	0x92, 0x8d, 0xfd, 0xf4, 0xd8, 0x72, 0x10, 0xe3, 0x76, 0xf1, 0xf6, 0x22, 0x25, 0x54, 0xc6, 0x9a,
+	0xa0, 0x6f, 0x93, 0x2d, 0xd6, 0x8c, 0xfe, 0x53, 0x82, 0xc9, 0xa0, 0x7f, 0xd4, 0x32, 0x4f, 0xf0,
+	0xed, 0x9c, 0x27, 0x39, 0xa0, 0x72, 0xf3, 0x5c, 0x6d, 0xc0, 0xa9, 0x0e, 0xc3, 0xf1, 0xe7, 0xeb,
+	0x32, 0x1b, 0xd0, 0xb9, 0xc0, 0x7c, 0x9d, 0xc8, 0xb5, 0x62, 0x79, 0x73, 0x76, 0xa2, 0x4d, 0x8c,
+	0x37, 0x6f, 0x0a, 0x8c, 0x75, 0x90, 0xa3, 0x6b, 0xf2, 0x15, 0x26, 0x20, 0xc5, 0x77, 0xaa, 0xc6,
+	0x42, 0xb9, 0x56, 0x90, 0x52, 0x41, 0x19, 0x69, 0x43, 0x2e, 0x69, 0xe8, 0x5f, 0x24, 0x18, 0x61,
+	0x3e, 0x56, 0xcb, 0x22, 0xf4, 0x7f, 0x3b, 0x17, 0x61, 0x98, 0xea, 0xda, 0x3c, 0xfb, 0x0e, 0xc4,
+	0x2b, 0x26, 0x1f, 0x95, 0x2d, 0x0f, 0x30, 0x93, 0x34, 0x73, 0xb8, 0x49, 0x5a, 0x74, 0x49, 0xbf,
+	0x8a, 0x45, 0xf2, 0x05, 0x75, 0xac, 0xf2, 0x0c, 0x76, 0x5d, 0xe5, 0x19, 0xea, 0x58, 0xe5, 0xe9,
+	0x10, 0x93, 0x25, 0xfe, 0x18, 0x55, 0xb6, 0xe4, 0x1f, 0xab, 0xca, 0x36, 0x7c, 0xfc, 0x2a, 0x5b,
+	0x5b, 0x49, 0x0a, 0x75, 0x53, 0x92, 0x1a, 0xe9, 0xa6, 0x24, 0x35, 0xda, 0x75, 0x49, 0x6a, 0xec,
+	0x90, 0x92, 0xd4, 0x35, 0x88, 0x5b, 0xa6, 0xe9, 0xa8, 0xcc, 0x65, 0xe7, 0xd9, 0x35, 0xb9, 0x2d,
+	0x93, 0x69, 0x9a, 0x0e, 0xf5, 0xd7, 0x95, 0x98, 0x25, 0x9e, 0xd0, 0x3d, 0xe8, 0x33, 0x88, 0x43,
+	0x27, 0x64, 0x82, 0x45, 0x13, 0x37, 0x7e, 0xbb, 0x9f, 0xce, 0x1e, 0xeb, 0x0b, 0xca, 0x65, 0xe2,
+	0x94, 0x0a, 0x8d, 0xfd, 0x74, 0x2f, 0x7b, 0x50, 0x7a, 0x0d, 0xe2, 0x94, 0x34, 0x74, 0x87, 0x7b,
+	0xd6, 0x5e, 0x75, 0x50, 0x7e, 0x79, 0x75, 0xd0, 0x75, 0xad, 0xbd, 0xb2, 0x20, 0x75, 0xad, 0xbd,
+	0xaa, 0xd7, 0x3c, 0xc4, 0x19, 0x20, 0x8d, 0xd8, 0x44, 0xfe, 0x4a, 0x3e, 0x2c, 0xa2, 0xcb, 0x0f,
+	0x34, 0xf6, 0xd3, 0x5e, 0x6e, 0x45, 0x89, 0x51, 0x1c, 0x96, 0x65, 0x79, 0x0f, 0x86, 0xdd, 0x60,
+	0xce, 0x07, 0xbb, 0xf8, 0x12, 0xb0, 0x11, 0xba, 0x39, 0x56, 0x38, 0x9b, 0x87, 0xe9, 0x86, 0x9e,
+	0x4b, 0x2e, 0xf4, 0x15, 0x88, 0xda, 0x3c, 0x22, 0x12, 0xa9, 0xae, 0x89, 0x43, 0x02, 0x26, 0xc5,
+	0xa5, 0x43, 0xef, 0x80, 0x8b, 0xa2, 0xba, 0xac, 0x27, 0x8f, 0x66, 0x1d, 0x12, 0xf4, 0xee, 0x57,
+	0xb0, 0xd3, 0x30, 0xe4, 0x65, 0x1e, 0xd8, 0xfe, 0x60, 0x89, 0xa8, 0x41, 0x65, 0x40, 0xe4, 0x1b,
+	0xd8, 0xde, 0x40, 0xaf, 0x40, 0xa2, 0x6e, 0x13, 0xcd, 0xa7, 0xb2, 0xe5, 0xd3, 0x53, 0x3d, 0x33,
+	0x83, 0xca, 0x20, 0x6d, 0x76, 0xc9, 0x6c, 0x4a, 0xc7, 0xd0, 0xfc, 0xed, 0x26, 0xa7, 0xfc, 0x0f,
+	0x4d, 0xbd, 0xbd, 0x86, 0xbe, 0x2b, 0xe8, 0xac, 0xf7, 0x45, 0x56, 0xfe, 0xb2, 0x9c, 0x66, 0x91,
+	0x17, 0xbd, 0x4e, 0x06, 0x16, 0xb1, 0xed, 0x28, 0xb7, 0x58, 0xc6, 0xfd, 0x32, 0x57, 0x44, 0x79,
+	0x9f, 0xbf, 0xb5, 0x33, 0x5e, 0x91, 0xa7, 0x3a, 0x32, 0x5e, 0x69, 0x62, 0xbc, 0x82, 0x9e, 0xc0,
+	0xc9, 0xd6, 0x0c, 0x8b, 0x45, 0xca, 0x44, 0xdf, 0xe6, 0xae, 0xe8, 0x99, 0xe3, 0x64, 0x70, 0xbc,
+	0x34, 0x8c, 0x22, 0x10, 0x72, 0x0e, 0x2a, 0x42, 0x3f, 0xcf, 0x1c, 0xf2, 0x1d, 0x91, 0x39, 0xc4,
+	0x08, 0x51, 0x12, 0xbe, 0x27, 0xfc, 0x50, 0x09, 0x6a, 0x5e, 0x2b, 0x7a, 0x08, 0x68, 0x9d, 0x95,
+	0x6e, 0xf7, 0xd4, 0x1a, 0xb1, 0xca, 0xc4, 0x70, 0xf0, 0x26, 0x91, 0xcf, 0xbe, 0xbc, 0x2e, 0x93,
+	0x38, 0xc8, 0x0f, 0x00, 0x9c, 0x0e, 0x85, 0x3e, 0xb9, 0x31, 0x1b, 0x0a, 0x85, 0x42, 0xca, 0xb0,
+	0xc0, 0x59, 0xf1, 0x60, 0xd0, 0xab, 0x90, 0xf0, 0xb2, 0x56, 0xa2, 0xe2, 0x33, 0x3d, 0x25, 0xcd,
+	0xf4, 0x2a, 0x43, 0x6e, 0xb3, 0x28, 0xe5, 0x60, 0x6a, 0x37, 0x28, 0x17, 0x4b, 0x42, 0xf3, 0x18,
+	0xd9, 0x96, 0xcf, 0xb1, 0xdb, 0xa8, 0x2d, 0x0e, 0xe5, 0x9f, 0x02, 0x89, 0x12, 0x75, 0x7e, 0x94,
+	0x7a, 0x96, 0x0a, 0x63, 0xce, 0x15, 0x14, 0xde, 0x67, 0x53, 0x63, 0xc3, 0x5a, 0x34, 0x4b, 0xb4,
+	0xa0, 0x02, 0x0c, 0x09, 0x11, 0x2e, 0xfc, 0x2b, 0x5d, 0xc0, 0x2b, 0x83, 0x9c, 0xc9, 0x45, 0xb9,
+	0x05, 0x02, 0xd9, 0xcb, 0x4a, 0xd9, 0xf2, 0xab, 0x0c, 0x27, 0xdd, 0x16, 0x2e, 0xbb, 0x43, 0x14,
+	0x48, 0x09, 0xce, 0xe8, 0x36, 0xdb, 0x88, 0xc0, 0x29, 0x91, 0xf9, 0xe9, 0x94, 0xed, 0xb2, 0xe5,
+	0x19, 0x86, 0xdb, 0x5d, 0xba, 0x8b, 0x03, 0x75, 0xe8, 0xa2, 0x81, 0x35, 0x04, 0x0a, 0xfe, 0xe7,
+	0x8f, 0x57, 0xf0, 0x57, 0x02, 0xbc, 0x68, 0x1d, 0x86, 0x6a, 0x96, 0xb9, 0xad, 0xd3, 0x73, 0xcc,
+	0x3d, 0xa7, 0x0b, 0xec, 0x46, 0x7a, 0xf3, 0x20, 0xff, 0xaa, 0x75, 0x4e, 0x9e, 0xce, 0x9e, 0x39,
+	0xda, 0x01, 0xf8, 0xf8, 0xc9, 0x74, 0x63, 0x3f, 0x3d, 0xb8, 0xe2, 0x63, 0x94, 0x0a, 0xca, 0x60,
+	0x00, 0xb2, 0xa4, 0xa1, 0x02, 0x0c, 0x7b, 0x0d, 0xd4, 0xca, 0x68, 0xd8, 0xc1, 0xf2, 0x77, 0x84,
+	0x89, 0x69, 0xdd, 0x8e, 0xab, 0xec, 0x67, 0x09, 0x4a, 0x32, 0xc8, 0x51, 0xc0, 0x0e, 0x46, 0xa7,
+	0x20, 0x5e, 0xad, 0x57, 0x68, 0x64, 0x6d, 0x3b, 0xf2, 0x2c, 0xbb, 0x7e, 0xfc, 0x06, 0xb4, 0x09,
+	0x27, 0xca, 0x15, 0xac, 0x57, 0x55, 0xdc, 0x14, 0x80, 0xab, 0x65, 0x53, 0x23, 0xf2, 0xdc, 0x4b,
+	0x62, 0xa3, 0xf6, 0xa0, 0x5d, 0x99, 0x60, 0x68, 0x1d, 0xa2, 0xf9, 0x07, 0x90, 0xa8, 0xe8, 0x1b,
+	0x84, 0x95, 0x8f, 0xc4, 0x39, 0xcd, 0xb2, 0x73, 0xfa, 0xea, 0xa1, 0xf0, 0x8b, 0x2e, 0x7d, 0xeb,
+	0xa1, 0x1d, 0xaa, 0x34, 0xf5, 0xa0, 0x69, 0x88, 0xb3, 0xc2, 0xe5, 0x87, 0xa6, 0x41, 0xe4, 0xab,
+	0xc1, 0xc8, 0xf4, 0x1d, 0x25, 0x46, 0x7b, 0x1e, 0x98, 0x06, 0x41, 0x37, 0x61, 0xa0, 0x5a, 0x77,
+	0x88, 0x9b, 0xbd, 0x93, 0x5f, 0x3b, 0x24, 0x5b, 0x54, 0x77, 0x08, 0xcf, 0xe2, 0xb9, 0x9f, 0x92,
+	0x26, 0x47, 0x95, 0xfe, 0xaa, 0xd7, 0x4a, 0x77, 0x11, 0xbb, 0xcb, 0x1c, 0x56, 0x3a, 0xb8, 0xd6,
+	0xf9, 0xe4, 0x34, 0x25, 0xcd, 0xbc, 0x0b, 0x8d, 0x35, 0xb1, 0x0b, 0x8d, 0x3d, 0x4d, 0xbe, 0x0d,
+	0x89, 0x96, 0xc0, 0x16, 0x25, 0xa1, 0x67, 0x8b, 0xf0, 0xaf, 0x21, 0xe3, 0x0a, 0x7d, 0x44, 0xa3,
+	0x6e, 0x1e, 0x84, 0x7f, 0xa6, 0xc7, 0x5f, 0xae, 0x87, 0xdf, 0x90, 0x26, 0xef, 0xc1, 0x50, 0xb3,
+	0x13, 0xda, 0x81, 0x7b, 0x2e, 0xc8, 0xdd, 0xe1, 0x9e, 0x74, 0x01, 0x02, 0xb8, 0x22, 0x99, 0xf1,
+	0x2e, 0x80, 0xb7, 0x14, 0x36, 0xba, 0x0e, 0xfd, 0xfe, 0x4f, 0x83, 0x6c, 0x59, 0x62, 0xc3, 0x3e,
+	0x71, 0xe8, 0xda, 0x29, 0x40, 0x3c, 0xde, 0x8c, 0x06, 0xe3, 0xf3, 0x2c, 0x0d, 0xe1, 0x77, 0x8b,
+	0x24, 0xe5, 0x2d, 0x00, 0x1f, 0xd5, 0xfb, 0x0e, 0xe6, 0x30, 0xd0, 0x0e, 0xe9, 0x91, 0xb8, 0x27,
+	0x26, 0xf3, 0x0f, 0x12, 0x8c, 0xdf, 0x65, 0x89, 0x8a, 0xff, 0x4f, 0x31, 0xe8, 0x06, 0x80, 0xff,
+	0x23, 0xa1, 0x43, 0x73, 0x31, 0x0b, 0x94, 0x64, 0x09, 0xdb, 0x5b, 0xf9, 0x08, 0x4b, 0xaa, 0xc6,
+	0x37, 0xdc, 0x86, 0xcc, 0x3f, 0x49, 0x30, 0x72, 0x93, 0x38, 0x6d, 0x4a, 0x3e, 0x82, 0x21, 0x5f,
+	0x49, 0xf5, 0xeb, 0x67, 0x8e, 0x06, 0x88, 0x4f, 0x67, 0x7f, 0x7d, 0xb5, 0xbf, 0x94, 0xe0, 0x5c,
+	0x50, 0xed, 0x80, 0xf0, 0x05, 0xd3, 0x2a, 0xde, 0x2d, 0xd9, 0xee, 0x40, 0x7e, 0x00, 0x31, 0xe6,
+	0x83, 0x90, 0xba, 0x2e, 0x92, 0xdc, 0x45, 0xf1, 0xe3, 0x9e, 0xe3, 0xb9, 0xa6, 0xc5, 0xbb, 0xa5,
+	0xd7, 0x5f, 0x6b, 0xec, 0xa7, 0xa3, 0xd4, 0x77, 0x29, 0xde, 0x2d, 0x29, 0x51, 0x0a, 0x5b, 0xac,
+	0xeb, 0xe8, 0x31, 0x44, 0xa9, 0x2f, 0x41, 0x05, 0xf0, 0x5f, 0x0f, 0x15, 0xbe, 0x96, 0x80, 0xbe,
+	0x02, 0xd9, 0xa6, 0xf8, 0x7d, 0x1a, 0xd9, 0x2e, 0xd6, 0xf5, 0xcc, 0x9f, 0x87, 0x61, 0x6c, 0x51,
+	0xb7, 0xfd, 0xb1, 0x7a, 0x43, 0xc3, 0x90, 0x08, 0x5e, 0x50, 0xfe, 0x22, 0xbd, 0x72, 0xc4, 0xd5,
+	0x74, 0xf4, 0x32, 0x0d, 0xe1, 0x20, 0xe5, 0xd7, 0x5f, 0x28, 0x6a, 0x2f, 0x4c, 0x4b, 0x23, 0x96,
+	0xf8, 0x24, 0x94, 0xbf, 0xa0, 0x14, 0xf4, 0xf2, 0xdf, 0xac, 0x44, 0x78, 0x96, 0xfd, 0x20, 0xdf,
+	0x7b, 0xa1, 0x47, 0xfe, 0x32, 0xaa, 0xf0, 0x66, 0x84, 0x20, 0x52, 0xa3, 0xee, 0x0e, 0xff, 0x15,
+	0x13, 0x7b, 0xce, 0xfc, 0x9d, 0x04, 0x23, 0xab, 0x1d, 0x76, 0xea, 0xc2, 0xf1, 0x8e, 0x53, 0x73,
+	0x79, 0xe1, 0x9b, 0x3c, 0x4a, 0xff, 0x26, 0xc1, 0xb0, 0x27, 0x67, 0x8d, 0x54, 0x6b, 0x15, 0x7a,
+	0x1d, 0x7c, 0x5b, 0xd4, 0x43, 0x33, 0xd0, 0x5f, 0xc5, 0x35, 0x56, 0x25, 0xa4, 0x56, 0xb9, 0x27,
+	0x78, 0x33, 0x69, 0x0a, 0x88, 0xbe, 0xdb, 0x64, 0x2f, 0xf3, 0x99, 0x04, 0x13, 0x6d, 0x03, 0xe1,
+	0xae, 0x87, 0x97, 0x72, 0x95, 0x9a, 0xd9, 0x3b, 0xa6, 0x5c, 0xc3, 0xc1, 0x94, 0xeb, 0xe7, 0x52,
+	0x73, 0xca, 0x75, 0x0d, 0x12, 0x2c, 0x21, 0x49, 0x76, 0x1d, 0x62, 0xd8, 0x2c, 0xc9, 0xd1, 0x33,
+	0xd5, 0x33, 0x13, 0xcf, 0x7f, 0xe7, 0x20, 0x3f, 0xf3, 0x33, 0xe9, 0x5c, 0x52, 0x93, 0xa5, 0x4c,
+	0xda, 0x3a, 0x9d, 0x3d, 0xf9, 0x64, 0xe6, 0xc6, 0xf5, 0x47, 0x73, 0xae, 0xc7, 0xf2, 0xd1, 0x95,
+	0x8b, 0x57, 0x5e, 0x7f, 0x76, 0xfe, 0xa3, 0x2b, 0x17, 0xb3, 0xcf, 0xa6, 0x95, 0x21, 0x8a, 0x51,
+	0xf4, 0x20, 0x32, 0xff, 0x2b, 0x81, 0x7c, 0x88, 0xea, 0x36, 0x7a, 0x06, 0x51, 0xee, 0x34, 0xb9,
+	0x37, 0xc6, 0xb5, 0x43, 0xd7, 0xa1, 0x85, 0x75, 0x4e, 0xfc, 0xff, 0x2a, 0xc9, 0x15, 0x57, 0xe6,
+	0x64, 0x19, 0x06, 0x82, 0x30, 0x1d, 0xae, 0xc7, 0xb7, 0x9b, 0xaf, 0xc7, 0x57, 0xbb, 0x54, 0x2f,
+	0x70, 0x5b, 0x66, 0x7e, 0x24, 0x41, 0x7a, 0xde, 0x34, 0xb6, 0x89, 0xe5, 0xb4, 0x51, 0xbb, 0x27,
+	0x66, 0x05, 0xe2, 0x5c, 0x27, 0xff, 0x83, 0xf2, 0xab, 0xdd, 0x7f, 0x01, 0x1e, 0xe3, 0x42, 0x4b,
+	0x05, 0x25, 0xc6, 0x51, 0x4a, 0xec, 0xab, 0x76, 0xe6, 0x0f, 0x32, 0xfb, 0xa7, 0xb0, 0xe7, 0x0b,
+	0x0b, 0x00, 0x7e, 0x90, 0x83, 0x86, 0x61, 0x70, 0xe5, 0xce, 0xfd, 0xa2, 0xa2, 0xde, 0x5d, 0xbe,
+	0xbd, 0x7c, 0xe7, 0xfe, 0x72, 0x32, 0xe4, 0x37, 0xe5, 0x73, 0x6b, 0x6b, 0x45, 0xe5, 0xbd, 0xa4,
+	0x84, 0x10, 0x0c, 0xf1, 0xa6, 0xe2, 0x9f, 0xae, 0x15, 0x95, 0xe5, 0xdc, 0x62, 0x32, 0x7c, 0xe1,
+	0x87, 0xc1, 0xdd, 0xd8, 0xec, 0x85, 0xa1, 0x51, 0x48, 0x2e, 0x96, 0x16, 0x8a, 0xf3, 0xef, 0xcd,
+	0x2f, 0x16, 0xd5, 0xdc, 0xfc, 0x5a, 0xe9, 0x5e, 0x31, 0x19, 0x42, 0x93, 0x30, 0xee, 0xb7, 0xce,
+	0xdf, 0x59, 0x5a, 0x2a, 0xad, 0xae, 0x96, 0xee, 0x2c, 0x17, 0x0b, 0x49, 0x09, 0x4d, 0xc0, 0x88,
+	0xdf, 0xb7, 0x7a, 0x77, 0x75, 0xa5, 0xb8, 0x5c, 0x28, 0x16, 0x92, 0x61, 0x74, 0x0a, 0x64, 0xbf,
+	0xa3, 0x50, 0x6c, 0x62, 0xeb, 0xc9, 0xff, 0xbd, 0xf4, 0xf9, 0xf3, 0x94, 0xf4, 0xc5, 0xf3, 0x94,
+	0xf4, 0x9b, 0xe7, 0xa9, 0xd0, 0xef, 0x9e, 0xa7, 0x42, 0x5f, 0x3e, 0x4f, 0x85, 0x7e, 0xff, 0x3c,
+	0x15, 0xfa, 0xc3, 0xf3, 0x94, 0xf4, 0x49, 0x23, 0x25, 0xfd, 0xb8, 0x91, 0x0a, 0xfd, 0xa2, 0x91,
+	0x92, 0x7e, 0xd9, 0x48, 0x85, 0x3e, 0x6b, 0xa4, 0x42, 0xbf, 0x6a, 0xa4, 0x42, 0x9f, 0x37, 0x52,
+	0xd2, 0x17, 0x8d, 0x94, 0xf4, 0x9b, 0x46, 0x2a, 0xf4, 0xbb, 0x46, 0x4a, 0xfa, 0xb2, 0x91, 0x0a,
+	0xfd, 0xbe, 0x91, 0x92, 0xfe, 0xd0, 0x48, 0x85, 0x3e, 0x79, 0x91, 0x0a, 0xfd, 0xf8, 0x45, 0x4a,
+	0xfa, 0xe9, 0x8b, 0x54, 0xe8, 0xe7, 0x2f, 0x52, 0xd2, 0xa7, 0x2f, 0x52, 0xa1, 0x5f, 0xbc, 0x48,
+	0x85, 0x7e, 0xf9, 0x22, 0x25, 0x7d, 0xf6, 0x22, 0x25, 0xfd, 0xea, 0x45, 0x4a, 0x7a, 0x70, 0xb1,
+	0xdb, 0x1b, 0xc4, 0x31, 0x6a, 0xeb, 0xeb, 0x7d, 0xcc, 0x0c, 0x5c, 0xfd, 0xbf, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x57, 0x36, 0x9f, 0xc4, 0x49, 0x3d, 0x00, 0x00,
 }
 
 func (x PowerState) String() string {
@@ -2822,6 +3102,13 @@ func (x PowerState) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x EndDeviceLifecycleState) String() string {
+	s, ok := EndDeviceLifecycleState_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *Session) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3269,6 +3556,18 @@ func (this *MACSettings) Equal(that interface{}) bool {
 	if !this.DesiredADRAckDelayExponent.Equal(that1.DesiredADRAckDelayExponent) {
 		return false
 	}
+	if !this.ADRMinDataRateIndex.Equal(that1.ADRMinDataRateIndex) {
+		return false
+	}
+	if !this.ADRMaxDataRateIndex.Equal(that1.ADRMaxDataRateIndex) {
+		return false
+	}
+	if !this.ADRMaxTxPowerIndex.Equal(that1.ADRMaxTxPowerIndex) {
+		return false
+	}
+	if !this.FCntResetGrace.Equal(that1.FCntResetGrace) {
+		return false
+	}
 	return true
 }
 func (this *MACState) Equal(that interface{}) bool {
@@ -3375,6 +3674,71 @@ func (this *MACState_JoinAccept) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MACTraceEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MACTraceEntry)
+	if !ok {
+		that2, ok := that.(MACTraceEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Uplink != that1.Uplink {
+		return false
+	}
+	if this.FCnt != that1.FCnt {
+		return false
+	}
+	if this.ADR != that1.ADR {
+		return false
+	}
+	if len(this.MACCommands) != len(that1.MACCommands) {
+		return false
+	}
+	for i := range this.MACCommands {
+		if !this.MACCommands[i].Equal(that1.MACCommands[i]) {
+			return false
+		}
+	}
+	if this.DataRateIndex != that1.DataRateIndex {
+		return false
+	}
+	if this.Class != that1.Class {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if len(this.GatewayIDs) != len(that1.GatewayIDs) {
+		return false
+	}
+	for i := range this.GatewayIDs {
+		if !this.GatewayIDs[i].Equal(&that1.GatewayIDs[i]) {
+			return false
+		}
+	}
+	if that1.TransmitAt == nil {
+		if this.TransmitAt != nil {
+			return false
+		}
+	} else if !this.TransmitAt.Equal(*that1.TransmitAt) {
+		return false
+	}
+	return true
+}
 func (this *EndDeviceAuthenticationCode) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -3622,6 +3986,28 @@ func (this *EndDevice) Equal(that interface{}) bool {
 	if !this.ClaimAuthenticationCode.Equal(that1.ClaimAuthenticationCode) {
 		return false
 	}
+	if this.LifecycleState != that1.LifecycleState {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if len(this.MuteWindows) != len(that1.MuteWindows) {
+		return false
+	}
+	for i := range this.MuteWindows {
+		if !this.MuteWindows[i].Equal(that1.MuteWindows[i]) {
+			return false
+		}
+	}
+	if len(this.MACTrace) != len(that1.MACTrace) {
+		return false
+	}
+	for i := range this.MACTrace {
+		if !this.MACTrace[i].Equal(that1.MACTrace[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *EndDevices) Equal(that interface{}) bool {
@@ -4547,9 +4933,9 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.DesiredADRAckDelayExponent != nil {
+	if m.FCntResetGrace != nil {
 		{
-			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FCntResetGrace.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4559,11 +4945,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xc2
+		dAtA[i] = 0xe2
 	}
-	if m.DesiredADRAckLimitExponent != nil {
+	if m.ADRMaxTxPowerIndex != nil {
 		{
-			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRMaxTxPowerIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4573,11 +4959,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xba
+		dAtA[i] = 0xda
 	}
-	if m.DesiredMaxDutyCycle != nil {
+	if m.ADRMaxDataRateIndex != nil {
 		{
-			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRMaxDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4587,11 +4973,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xb2
+		dAtA[i] = 0xd2
 	}
-	if m.DesiredRx2Frequency != nil {
+	if m.ADRMinDataRateIndex != nil {
 		{
-			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ADRMinDataRateIndex.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4601,11 +4987,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xca
 	}
-	if m.DesiredRx2DataRateIndex != nil {
+	if m.DesiredADRAckDelayExponent != nil {
 		{
-			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredADRAckDelayExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4615,11 +5001,11 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0xc2
 	}
-	if m.DesiredRx1DataRateOffset != nil {
+	if m.DesiredADRAckLimitExponent != nil {
 		{
-			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DesiredADRAckLimitExponent.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4629,9 +5015,65 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0xba
 	}
-	if m.DesiredRx1Delay != nil {
+	if m.DesiredMaxDutyCycle != nil {
+		{
+			size, err := m.DesiredMaxDutyCycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.DesiredRx2Frequency != nil {
+		{
+			size, err := m.DesiredRx2Frequency.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.DesiredRx2DataRateIndex != nil {
+		{
+			size, err := m.DesiredRx2DataRateIndex.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DesiredRx1DataRateOffset != nil {
+		{
+			size, err := m.DesiredRx1DataRateOffset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEndDevice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.DesiredRx1Delay != nil {
 		{
 			size, err := m.DesiredRx1Delay.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -4660,12 +5102,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if m.StatusTimePeriodicity != nil {
-		n18, err18 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
-		if err18 != nil {
-			return 0, err18
+		n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.StatusTimePeriodicity, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.StatusTimePeriodicity):])
+		if err22 != nil {
+			return 0, err22
 		}
-		i -= n18
-		i = encodeVarintEndDevice(dAtA, i, uint64(n18))
+		i -= n22
+		i = encodeVarintEndDevice(dAtA, i, uint64(n22))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -4732,20 +5174,20 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 	}
 	if len(m.FactoryPresetFrequencies) > 0 {
-		dAtA25 := make([]byte, len(m.FactoryPresetFrequencies)*10)
-		var j24 int
+		dAtA29 := make([]byte, len(m.FactoryPresetFrequencies)*10)
+		var j28 int
 		for _, num := range m.FactoryPresetFrequencies {
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(num&0x7f | 0x80)
+				dAtA29[j28] = uint8(num&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j28++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA29[j28] = uint8(num)
+			j28++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j24))
+		i -= j28
+		copy(dAtA[i:], dAtA29[:j28])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j28))
 		i--
 		dAtA[i] = 0x52
 	}
@@ -4798,12 +5240,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 	}
 	if m.ClassCTimeout != nil {
-		n30, err30 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
-		if err30 != nil {
-			return 0, err30
+		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassCTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassCTimeout):])
+		if err34 != nil {
+			return 0, err34
 		}
-		i -= n30
-		i = encodeVarintEndDevice(dAtA, i, uint64(n30))
+		i -= n34
+		i = encodeVarintEndDevice(dAtA, i, uint64(n34))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -4844,12 +5286,12 @@ func (m *MACSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if m.ClassBTimeout != nil {
-		n34, err34 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
-		if err34 != nil {
-			return 0, err34
+		n38, err38 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ClassBTimeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ClassBTimeout):])
+		if err38 != nil {
+			return 0, err38
 		}
-		i -= n34
-		i = encodeVarintEndDevice(dAtA, i, uint64(n34))
+		i -= n38
+		i = encodeVarintEndDevice(dAtA, i, uint64(n38))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4961,12 +5403,12 @@ func (m *MACState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x30
 	}
 	if m.LastConfirmedDownlinkAt != nil {
-		n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
-		if err38 != nil {
-			return 0, err38
+		n42, err42 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastConfirmedDownlinkAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastConfirmedDownlinkAt):])
+		if err42 != nil {
+			return 0, err42
 		}
-		i -= n38
-		i = encodeVarintEndDevice(dAtA, i, uint64(n38))
+		i -= n42
+		i = encodeVarintEndDevice(dAtA, i, uint64(n42))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -5053,6 +5495,115 @@ func (m *MACState_JoinAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MACTraceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACTraceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACTraceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TransmitAt != nil {
+		n47, err47 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.TransmitAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.TransmitAt):])
+		if err47 != nil {
+			return 0, err47
+		}
+		i -= n47
+		i = encodeVarintEndDevice(dAtA, i, uint64(n47))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.GatewayIDs) > 0 {
+		for iNdEx := len(m.GatewayIDs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GatewayIDs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Class != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.Class))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DataRateIndex != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.DataRateIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.MACCommands) > 0 {
+		for iNdEx := len(m.MACCommands) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MACCommands[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ADR {
+		i--
+		if m.ADR {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FCnt != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.FCnt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Uplink {
+		i--
+		if m.Uplink {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	n48, err48 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err48 != nil {
+		return 0, err48
+	}
+	i -= n48
+	i = encodeVarintEndDevice(dAtA, i, uint64(n48))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EndDeviceAuthenticationCode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5074,22 +5625,22 @@ func (m *EndDeviceAuthenticationCode) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.ValidTo != nil {
-		n43, err43 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
-		if err43 != nil {
-			return 0, err43
+		n49, err49 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidTo, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidTo):])
+		if err49 != nil {
+			return 0, err49
 		}
-		i -= n43
-		i = encodeVarintEndDevice(dAtA, i, uint64(n43))
+		i -= n49
+		i = encodeVarintEndDevice(dAtA, i, uint64(n49))
 		i--
 		dAtA[i] = 0x1a
 	}
 	if m.ValidFrom != nil {
-		n44, err44 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
-		if err44 != nil {
-			return 0, err44
+		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ValidFrom, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ValidFrom):])
+		if err50 != nil {
+			return 0, err50
 		}
-		i -= n44
-		i = encodeVarintEndDevice(dAtA, i, uint64(n44))
+		i -= n50
+		i = encodeVarintEndDevice(dAtA, i, uint64(n50))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5123,6 +5674,54 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MACTrace) > 0 {
+		for iNdEx := len(m.MACTrace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MACTrace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.MuteWindows) > 0 {
+		for iNdEx := len(m.MuteWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MuteWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEndDevice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintEndDevice(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.LifecycleState != 0 {
+		i = encodeVarintEndDevice(dAtA, i, uint64(m.LifecycleState))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
 	if len(m.ApplicationServerID) > 0 {
 		i -= len(m.ApplicationServerID)
 		copy(dAtA[i:], m.ApplicationServerID)
@@ -5320,12 +5919,12 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x90
 	}
 	if m.LastDevStatusReceivedAt != nil {
-		n50, err50 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
-		if err50 != nil {
-			return 0, err50
+		n56, err56 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.LastDevStatusReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.LastDevStatusReceivedAt):])
+		if err56 != nil {
+			return 0, err56
 		}
-		i -= n50
-		i = encodeVarintEndDevice(dAtA, i, uint64(n50))
+		i -= n56
+		i = encodeVarintEndDevice(dAtA, i, uint64(n56))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -5353,20 +5952,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xf0
 	}
 	if len(m.UsedDevNonces) > 0 {
-		dAtA52 := make([]byte, len(m.UsedDevNonces)*10)
-		var j51 int
+		dAtA58 := make([]byte, len(m.UsedDevNonces)*10)
+		var j57 int
 		for _, num := range m.UsedDevNonces {
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j57++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintEndDevice(dAtA, i, uint64(j51))
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintEndDevice(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -5641,20 +6240,20 @@ func (m *EndDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n60, err60 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
-	if err60 != nil {
-		return 0, err60
+	n66, err66 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UpdatedAt):])
+	if err66 != nil {
+		return 0, err66
 	}
-	i -= n60
-	i = encodeVarintEndDevice(dAtA, i, uint64(n60))
+	i -= n66
+	i = encodeVarintEndDevice(dAtA, i, uint64(n66))
 	i--
 	dAtA[i] = 0x1a
-	n61, err61 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err61 != nil {
-		return 0, err61
+	n67, err67 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err67 != nil {
+		return 0, err67
 	}
-	i -= n61
-	i = encodeVarintEndDevice(dAtA, i, uint64(n61))
+	i -= n67
+	i = encodeVarintEndDevice(dAtA, i, uint64(n67))
 	i--
 	dAtA[i] = 0x12
 	{
@@ -6294,6 +6893,18 @@ func NewPopulatedMACSettings(r randyEndDevice, easy bool) *MACSettings {
 	if r.Intn(5) != 0 {
 		this.DesiredADRAckDelayExponent = NewPopulatedADRAckDelayExponentValue(r, easy)
 	}
+	if r.Intn(5) != 0 {
+		this.ADRMinDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ADRMaxDataRateIndex = NewPopulatedDataRateIndexValue(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.ADRMaxTxPowerIndex = types.NewPopulatedUInt32Value(r, easy)
+	}
+	if r.Intn(5) != 0 {
+		this.FCntResetGrace = types.NewPopulatedUInt32Value(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6315,12 +6926,45 @@ func NewPopulatedMACState_JoinAccept(r randyEndDevice, easy bool) *MACState_Join
 	return this
 }
 
+func NewPopulatedMACTraceEntry(r randyEndDevice, easy bool) *MACTraceEntry {
+	this := &MACTraceEntry{}
+	v9 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.Time = *v9
+	this.Uplink = bool(r.Intn(2) == 0)
+	this.FCnt = r.Uint32()
+	this.ADR = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		v10 := r.Intn(5)
+		this.MACCommands = make([]*MACCommand, v10)
+		for i := 0; i < v10; i++ {
+			this.MACCommands[i] = NewPopulatedMACCommand(r, easy)
+		}
+	}
+	this.DataRateIndex = DataRateIndex([]int32{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}[r.Intn(16)])
+	this.Class = Class([]int32{0, 1, 2}[r.Intn(3)])
+	this.Priority = TxSchedulePriority([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	if r.Intn(5) != 0 {
+		v11 := r.Intn(5)
+		this.GatewayIDs = make([]GatewayIdentifiers, v11)
+		for i := 0; i < v11; i++ {
+			v12 := NewPopulatedGatewayIdentifiers(r, easy)
+			this.GatewayIDs[i] = *v12
+		}
+	}
+	if r.Intn(5) != 0 {
+		this.TransmitAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 	this := &EndDevices{}
 	if r.Intn(5) != 0 {
-		v9 := r.Intn(5)
-		this.EndDevices = make([]*EndDevice, v9)
-		for i := 0; i < v9; i++ {
+		v13 := r.Intn(5)
+		this.EndDevices = make([]*EndDevice, v13)
+		for i := 0; i < v13; i++ {
 			this.EndDevices[i] = NewPopulatedEndDevice(r, easy)
 		}
 	}
@@ -6331,8 +6975,8 @@ func NewPopulatedEndDevices(r randyEndDevice, easy bool) *EndDevices {
 
 func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndDeviceRequest {
 	this := &CreateEndDeviceRequest{}
-	v10 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v10
+	v14 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v14
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6340,10 +6984,10 @@ func NewPopulatedCreateEndDeviceRequest(r randyEndDevice, easy bool) *CreateEndD
 
 func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndDeviceRequest {
 	this := &UpdateEndDeviceRequest{}
-	v11 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v11
-	v12 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v12
+	v15 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v15
+	v16 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v16
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6351,10 +6995,10 @@ func NewPopulatedUpdateEndDeviceRequest(r randyEndDevice, easy bool) *UpdateEndD
 
 func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceRequest {
 	this := &GetEndDeviceRequest{}
-	v13 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v13
-	v14 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v14
+	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v17
+	v18 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v18
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6362,10 +7006,10 @@ func NewPopulatedGetEndDeviceRequest(r randyEndDevice, easy bool) *GetEndDeviceR
 
 func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bool) *GetEndDeviceIdentifiersForEUIsRequest {
 	this := &GetEndDeviceIdentifiersForEUIsRequest{}
-	v15 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.JoinEUI = *v15
-	v16 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
-	this.DevEUI = *v16
+	v19 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.JoinEUI = *v19
+	v20 := go_thethings_network_lorawan_stack_pkg_types.NewPopulatedEUI64(r)
+	this.DevEUI = *v20
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6373,10 +7017,10 @@ func NewPopulatedGetEndDeviceIdentifiersForEUIsRequest(r randyEndDevice, easy bo
 
 func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevicesRequest {
 	this := &ListEndDevicesRequest{}
-	v17 := NewPopulatedApplicationIdentifiers(r, easy)
-	this.ApplicationIdentifiers = *v17
-	v18 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v18
+	v21 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIdentifiers = *v21
+	v22 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v22
 	this.Order = randStringEndDevice(r)
 	this.Limit = r.Uint32()
 	this.Page = r.Uint32()
@@ -6387,10 +7031,10 @@ func NewPopulatedListEndDevicesRequest(r randyEndDevice, easy bool) *ListEndDevi
 
 func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceRequest {
 	this := &SetEndDeviceRequest{}
-	v19 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v19
-	v20 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v20
+	v23 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v23
+	v24 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v24
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -6398,10 +7042,10 @@ func NewPopulatedSetEndDeviceRequest(r randyEndDevice, easy bool) *SetEndDeviceR
 
 func NewPopulatedEndDeviceTemplate(r randyEndDevice, easy bool) *EndDeviceTemplate {
 	this := &EndDeviceTemplate{}
-	v21 := NewPopulatedEndDevice(r, easy)
-	this.EndDevice = *v21
-	v22 := types.NewPopulatedFieldMask(r, easy)
-	this.FieldMask = *v22
+	v25 := NewPopulatedEndDevice(r, easy)
+	this.EndDevice = *v25
+	v26 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v26
 	this.MappingKey = randStringEndDevice(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -6412,9 +7056,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 	this := &EndDeviceTemplateFormat{}
 	this.Name = randStringEndDevice(r)
 	this.Description = randStringEndDevice(r)
-	v23 := r.Intn(10)
-	this.FileExtensions = make([]string, v23)
-	for i := 0; i < v23; i++ {
+	v27 := r.Intn(10)
+	this.FileExtensions = make([]string, v27)
+	for i := 0; i < v27; i++ {
 		this.FileExtensions[i] = randStringEndDevice(r)
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -6425,9 +7069,9 @@ func NewPopulatedEndDeviceTemplateFormat(r randyEndDevice, easy bool) *EndDevice
 func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDeviceTemplateFormats {
 	this := &EndDeviceTemplateFormats{}
 	if r.Intn(5) != 0 {
-		v24 := r.Intn(10)
+		v28 := r.Intn(10)
 		this.Formats = make(map[string]*EndDeviceTemplateFormat)
-		for i := 0; i < v24; i++ {
+		for i := 0; i < v28; i++ {
 			this.Formats[randStringEndDevice(r)] = NewPopulatedEndDeviceTemplateFormat(r, easy)
 		}
 	}
@@ -6439,9 +7083,9 @@ func NewPopulatedEndDeviceTemplateFormats(r randyEndDevice, easy bool) *EndDevic
 func NewPopulatedConvertEndDeviceTemplateRequest(r randyEndDevice, easy bool) *ConvertEndDeviceTemplateRequest {
 	this := &ConvertEndDeviceTemplateRequest{}
 	this.FormatID = randStringEndDevice(r)
-	v25 := r.Intn(100)
-	this.Data = make([]byte, v25)
-	for i := 0; i < v25; i++ {
+	v29 := r.Intn(100)
+	this.Data = make([]byte, v29)
+	for i := 0; i < v29; i++ {
 		this.Data[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -6468,9 +7112,9 @@ func randUTF8RuneEndDevice(r randyEndDevice) rune {
 	return rune(ru + 61)
 }
 func randStringEndDevice(r randyEndDevice) string {
-	v26 := r.Intn(100)
-	tmps := make([]rune, v26)
-	for i := 0; i < v26; i++ {
+	v30 := r.Intn(100)
+	tmps := make([]rune, v30)
+	for i := 0; i < v30; i++ {
 		tmps[i] = randUTF8RuneEndDevice(r)
 	}
 	return string(tmps)
@@ -6492,11 +7136,11 @@ func randFieldEndDevice(dAtA []byte, r randyEndDevice, fieldNumber int, wire int
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
-		v27 := r.Int63()
+		v31 := r.Int63()
 		if r.Intn(2) == 0 {
-			v27 *= -1
+			v31 *= -1
 		}
-		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v27))
+		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(v31))
 	case 1:
 		dAtA = encodeVarintPopulateEndDevice(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -6880,6 +7524,22 @@ func (m *MACSettings) Size() (n int) {
 		l = m.DesiredADRAckDelayExponent.Size()
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
+	if m.ADRMinDataRateIndex != nil {
+		l = m.ADRMinDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRMaxDataRateIndex != nil {
+		l = m.ADRMaxDataRateIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.ADRMaxTxPowerIndex != nil {
+		l = m.ADRMaxTxPowerIndex.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if m.FCntResetGrace != nil {
+		l = m.FCntResetGrace.Size()
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
 	return n
 }
 
@@ -6956,6 +7616,51 @@ func (m *MACState_JoinAccept) Size() (n int) {
 	return n
 }
 
+func (m *MACTraceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEndDevice(uint64(l))
+	if m.Uplink {
+		n += 2
+	}
+	if m.FCnt != 0 {
+		n += 1 + sovEndDevice(uint64(m.FCnt))
+	}
+	if m.ADR {
+		n += 2
+	}
+	if len(m.MACCommands) > 0 {
+		for _, e := range m.MACCommands {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.DataRateIndex != 0 {
+		n += 1 + sovEndDevice(uint64(m.DataRateIndex))
+	}
+	if m.Class != 0 {
+		n += 1 + sovEndDevice(uint64(m.Class))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEndDevice(uint64(m.Priority))
+	}
+	if len(m.GatewayIDs) > 0 {
+		for _, e := range m.GatewayIDs {
+			l = e.Size()
+			n += 1 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if m.TransmitAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.TransmitAt)
+		n += 1 + l + sovEndDevice(uint64(l))
+	}
+	return n
+}
+
 func (m *EndDeviceAuthenticationCode) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7182,7 +7887,26 @@ func (m *EndDevice) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovEndDevice(uint64(l))
 	}
-	return n
+	if m.LifecycleState != 0 {
+		n += 2 + sovEndDevice(uint64(m.LifecycleState))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 2 + l + sovEndDevice(uint64(l))
+	}
+	if len(m.MuteWindows) > 0 {
+		for _, e := range m.MuteWindows {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	if len(m.MACTrace) > 0 {
+		for _, e := range m.MACTrace {
+			l = e.Size()
+			n += 2 + l + sovEndDevice(uint64(l))
+		}
+	}
+	return n
 }
 
 func (m *EndDevices) Size() (n int) {
@@ -7525,6 +8249,10 @@ func (this *MACSettings) String() string {
 		`DesiredMaxDutyCycle:` + strings.Replace(fmt.Sprintf("%v", this.DesiredMaxDutyCycle), "AggregatedDutyCycleValue", "AggregatedDutyCycleValue", 1) + `,`,
 		`DesiredADRAckLimitExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckLimitExponent), "ADRAckLimitExponentValue", "ADRAckLimitExponentValue", 1) + `,`,
 		`DesiredADRAckDelayExponent:` + strings.Replace(fmt.Sprintf("%v", this.DesiredADRAckDelayExponent), "ADRAckDelayExponentValue", "ADRAckDelayExponentValue", 1) + `,`,
+		`ADRMinDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.ADRMinDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`ADRMaxDataRateIndex:` + strings.Replace(fmt.Sprintf("%v", this.ADRMaxDataRateIndex), "DataRateIndexValue", "DataRateIndexValue", 1) + `,`,
+		`ADRMaxTxPowerIndex:` + strings.Replace(fmt.Sprintf("%v", this.ADRMaxTxPowerIndex), "UInt32Value", "types.UInt32Value", 1) + `,`,
+		`FCntResetGrace:` + strings.Replace(fmt.Sprintf("%v", this.FCntResetGrace), "UInt32Value", "types.UInt32Value", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7573,6 +8301,35 @@ func (this *MACState_JoinAccept) String() string {
 	}, "")
 	return s
 }
+func (this *MACTraceEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMACCommands := "[]*MACCommand{"
+	for _, f := range this.MACCommands {
+		repeatedStringForMACCommands += strings.Replace(fmt.Sprintf("%v", f), "MACCommand", "MACCommand", 1) + ","
+	}
+	repeatedStringForMACCommands += "}"
+	repeatedStringForGatewayIDs := "[]GatewayIdentifiers{"
+	for _, f := range this.GatewayIDs {
+		repeatedStringForGatewayIDs += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForGatewayIDs += "}"
+	s := strings.Join([]string{`&MACTraceEntry{`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Uplink:` + fmt.Sprintf("%v", this.Uplink) + `,`,
+		`FCnt:` + fmt.Sprintf("%v", this.FCnt) + `,`,
+		`ADR:` + fmt.Sprintf("%v", this.ADR) + `,`,
+		`MACCommands:` + repeatedStringForMACCommands + `,`,
+		`DataRateIndex:` + fmt.Sprintf("%v", this.DataRateIndex) + `,`,
+		`Class:` + fmt.Sprintf("%v", this.Class) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`GatewayIDs:` + repeatedStringForGatewayIDs + `,`,
+		`TransmitAt:` + strings.Replace(fmt.Sprintf("%v", this.TransmitAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EndDeviceAuthenticationCode) String() string {
 	if this == nil {
 		return "nil"
@@ -7609,6 +8366,16 @@ func (this *EndDevice) String() string {
 		repeatedStringForQueuedApplicationDownlinks += strings.Replace(fmt.Sprintf("%v", f), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
 	}
 	repeatedStringForQueuedApplicationDownlinks += "}"
+	repeatedStringForMuteWindows := "[]*MuteWindow{"
+	for _, f := range this.MuteWindows {
+		repeatedStringForMuteWindows += strings.Replace(fmt.Sprintf("%v", f), "MuteWindow", "MuteWindow", 1) + ","
+	}
+	repeatedStringForMuteWindows += "}"
+	repeatedStringForMACTrace := "[]*MACTraceEntry{"
+	for _, f := range this.MACTrace {
+		repeatedStringForMACTrace += strings.Replace(f.String(), "MACTraceEntry", "MACTraceEntry", 1) + ","
+	}
+	repeatedStringForMACTrace += "}"
 	keysForAttributes := make([]string, 0, len(this.Attributes))
 	for k := range this.Attributes {
 		keysForAttributes = append(keysForAttributes, k)
@@ -7679,6 +8446,10 @@ func (this *EndDevice) String() string {
 		`NetworkServerKEKLabel:` + fmt.Sprintf("%v", this.NetworkServerKEKLabel) + `,`,
 		`ApplicationServerKEKLabel:` + fmt.Sprintf("%v", this.ApplicationServerKEKLabel) + `,`,
 		`ApplicationServerID:` + fmt.Sprintf("%v", this.ApplicationServerID) + `,`,
+		`LifecycleState:` + fmt.Sprintf("%v", this.LifecycleState) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`MuteWindows:` + repeatedStringForMuteWindows + `,`,
+		`MACTrace:` + repeatedStringForMACTrace + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10552,6 +11323,150 @@ func (m *MACSettings) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMinDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRMinDataRateIndex == nil {
+				m.ADRMinDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.ADRMinDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMaxDataRateIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRMaxDataRateIndex == nil {
+				m.ADRMaxDataRateIndex = &DataRateIndexValue{}
+			}
+			if err := m.ADRMaxDataRateIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADRMaxTxPowerIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ADRMaxTxPowerIndex == nil {
+				m.ADRMaxTxPowerIndex = &types.UInt32Value{}
+			}
+			if err := m.ADRMaxTxPowerIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCntResetGrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FCntResetGrace == nil {
+				m.FCntResetGrace = &types.UInt32Value{}
+			}
+			if err := m.FCntResetGrace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
@@ -11156,7 +12071,7 @@ func (m *MACState_JoinAccept) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
+func (m *MACTraceEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11179,17 +12094,17 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: MACTraceEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MACTraceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11199,29 +12114,30 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEndDevice
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEndDevice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uplink", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEndDevice
@@ -11231,31 +12147,54 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEndDevice
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEndDevice
+			m.Uplink = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FCnt", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.FCnt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FCnt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.ValidFrom == nil {
-				m.ValidFrom = new(time.Time)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ADR", wireType)
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+			m.ADR = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MACCommands", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11282,33 +12221,315 @@ func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidTo == nil {
-				m.ValidTo = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+			m.MACCommands = append(m.MACCommands, &MACCommand{})
+			if err := m.MACCommands[len(m.MACCommands)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEndDevice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthEndDevice
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRateIndex", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthEndDevice
+			m.DataRateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRateIndex |= DataRateIndex(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			m.Class = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Class |= Class(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= TxSchedulePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GatewayIDs = append(m.GatewayIDs, GatewayIdentifiers{})
+			if err := m.GatewayIDs[len(m.GatewayIDs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransmitAt == nil {
+				m.TransmitAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.TransmitAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceAuthenticationCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEndDevice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceAuthenticationCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidFrom == nil {
+				m.ValidFrom = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidFrom, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidTo == nil {
+				m.ValidTo = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ValidTo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEndDevice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -13018,6 +14239,125 @@ func (m *EndDevice) Unmarshal(dAtA []byte) error {
 			}
 			m.ApplicationServerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleState", wireType)
+			}
+			m.LifecycleState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleState |= EndDeviceLifecycleState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MuteWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MuteWindows = append(m.MuteWindows, &MuteWindow{})
+			if err := m.MuteWindows[len(m.MuteWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MACTrace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEndDevice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEndDevice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MACTrace = append(m.MACTrace, &MACTraceEntry{})
+			if err := m.MACTrace[len(m.MACTrace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEndDevice(dAtA[iNdEx:])
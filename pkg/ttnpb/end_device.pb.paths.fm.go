@@ -128,10 +128,16 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_formatters",
 	"default_formatters.down_formatter",
 	"default_formatters.down_formatter_parameter",
+	"default_formatters.f_port_formatters",
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"default_mac_settings",
 	"default_mac_settings.adr_margin",
+	"default_mac_settings.adr_max_data_rate_index",
+	"default_mac_settings.adr_max_data_rate_index.value",
+	"default_mac_settings.adr_max_tx_power_index",
+	"default_mac_settings.adr_min_data_rate_index",
+	"default_mac_settings.adr_min_data_rate_index.value",
 	"default_mac_settings.class_b_timeout",
 	"default_mac_settings.class_c_timeout",
 	"default_mac_settings.desired_adr_ack_delay_exponent",
@@ -146,6 +152,7 @@ var EndDeviceVersionFieldPathsNested = []string{
 	"default_mac_settings.desired_rx2_data_rate_index",
 	"default_mac_settings.desired_rx2_data_rate_index.value",
 	"default_mac_settings.desired_rx2_frequency",
+	"default_mac_settings.f_cnt_reset_grace",
 	"default_mac_settings.factory_preset_frequencies",
 	"default_mac_settings.max_duty_cycle",
 	"default_mac_settings.max_duty_cycle.value",
@@ -199,6 +206,11 @@ var EndDeviceVersionFieldPathsTopLevel = []string{
 }
 var MACSettingsFieldPathsNested = []string{
 	"adr_margin",
+	"adr_max_data_rate_index",
+	"adr_max_data_rate_index.value",
+	"adr_max_tx_power_index",
+	"adr_min_data_rate_index",
+	"adr_min_data_rate_index.value",
 	"class_b_timeout",
 	"class_c_timeout",
 	"desired_adr_ack_delay_exponent",
@@ -213,6 +225,7 @@ var MACSettingsFieldPathsNested = []string{
 	"desired_rx2_data_rate_index",
 	"desired_rx2_data_rate_index.value",
 	"desired_rx2_frequency",
+	"f_cnt_reset_grace",
 	"factory_preset_frequencies",
 	"max_duty_cycle",
 	"max_duty_cycle.value",
@@ -236,6 +249,9 @@ var MACSettingsFieldPathsNested = []string{
 
 var MACSettingsFieldPathsTopLevel = []string{
 	"adr_margin",
+	"adr_max_data_rate_index",
+	"adr_max_tx_power_index",
+	"adr_min_data_rate_index",
 	"class_b_timeout",
 	"class_c_timeout",
 	"desired_adr_ack_delay_exponent",
@@ -245,6 +261,7 @@ var MACSettingsFieldPathsTopLevel = []string{
 	"desired_rx1_delay",
 	"desired_rx2_data_rate_index",
 	"desired_rx2_frequency",
+	"f_cnt_reset_grace",
 	"factory_preset_frequencies",
 	"max_duty_cycle",
 	"ping_slot_data_rate_index",
@@ -320,6 +337,7 @@ var MACStateFieldPathsNested = []string{
 	"pending_application_downlink.confirmed",
 	"pending_application_downlink.correlation_ids",
 	"pending_application_downlink.decoded_payload",
+	"pending_application_downlink.expires_at",
 	"pending_application_downlink.f_cnt",
 	"pending_application_downlink.f_port",
 	"pending_application_downlink.frm_payload",
@@ -484,6 +502,31 @@ var MACStateFieldPathsTopLevel = []string{
 	"queued_responses",
 	"rx_windows_available",
 }
+var MACTraceEntryFieldPathsNested = []string{
+	"adr",
+	"class",
+	"data_rate_index",
+	"f_cnt",
+	"gateway_ids",
+	"mac_commands",
+	"priority",
+	"time",
+	"transmit_at",
+	"uplink",
+}
+
+var MACTraceEntryFieldPathsTopLevel = []string{
+	"adr",
+	"class",
+	"data_rate_index",
+	"f_cnt",
+	"gateway_ids",
+	"mac_commands",
+	"priority",
+	"time",
+	"transmit_at",
+	"uplink",
+}
 var EndDeviceAuthenticationCodeFieldPathsNested = []string{
 	"valid_from",
 	"valid_to",
@@ -511,6 +554,7 @@ var EndDeviceFieldPathsNested = []string{
 	"formatters",
 	"formatters.down_formatter",
 	"formatters.down_formatter_parameter",
+	"formatters.f_port_formatters",
 	"formatters.up_formatter",
 	"formatters.up_formatter_parameter",
 	"frequency_plan_id",
@@ -527,11 +571,17 @@ var EndDeviceFieldPathsNested = []string{
 	"last_join_nonce",
 	"last_rj_count_0",
 	"last_rj_count_1",
+	"lifecycle_state",
 	"locations",
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
 	"mac_settings.adr_margin",
+	"mac_settings.adr_max_data_rate_index",
+	"mac_settings.adr_max_data_rate_index.value",
+	"mac_settings.adr_max_tx_power_index",
+	"mac_settings.adr_min_data_rate_index",
+	"mac_settings.adr_min_data_rate_index.value",
 	"mac_settings.class_b_timeout",
 	"mac_settings.class_c_timeout",
 	"mac_settings.desired_adr_ack_delay_exponent",
@@ -546,6 +596,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_settings.desired_rx2_data_rate_index",
 	"mac_settings.desired_rx2_data_rate_index.value",
 	"mac_settings.desired_rx2_frequency",
+	"mac_settings.f_cnt_reset_grace",
 	"mac_settings.factory_preset_frequencies",
 	"mac_settings.max_duty_cycle",
 	"mac_settings.max_duty_cycle.value",
@@ -625,6 +676,7 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.pending_application_downlink.confirmed",
 	"mac_state.pending_application_downlink.correlation_ids",
 	"mac_state.pending_application_downlink.decoded_payload",
+	"mac_state.pending_application_downlink.expires_at",
 	"mac_state.pending_application_downlink.f_cnt",
 	"mac_state.pending_application_downlink.f_port",
 	"mac_state.pending_application_downlink.frm_payload",
@@ -772,9 +824,11 @@ var EndDeviceFieldPathsNested = []string{
 	"mac_state.queued_join_accept.request.selected_mac_version",
 	"mac_state.queued_responses",
 	"mac_state.rx_windows_available",
+	"mac_trace",
 	"max_frequency",
 	"min_frequency",
 	"multicast",
+	"mute_windows",
 	"name",
 	"net_id",
 	"network_server_address",
@@ -839,6 +893,7 @@ var EndDeviceFieldPathsNested = []string{
 	"pending_mac_state.pending_application_downlink.confirmed",
 	"pending_mac_state.pending_application_downlink.correlation_ids",
 	"pending_mac_state.pending_application_downlink.decoded_payload",
+	"pending_mac_state.pending_application_downlink.expires_at",
 	"pending_mac_state.pending_application_downlink.f_cnt",
 	"pending_mac_state.pending_application_downlink.f_port",
 	"pending_mac_state.pending_application_downlink.frm_payload",
@@ -1058,6 +1113,7 @@ var EndDeviceFieldPathsNested = []string{
 	"supports_class_b",
 	"supports_class_c",
 	"supports_join",
+	"time_zone",
 	"updated_at",
 	"used_dev_nonces",
 	"version_ids",
@@ -1086,14 +1142,17 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"last_join_nonce",
 	"last_rj_count_0",
 	"last_rj_count_1",
+	"lifecycle_state",
 	"locations",
 	"lorawan_phy_version",
 	"lorawan_version",
 	"mac_settings",
 	"mac_state",
+	"mac_trace",
 	"max_frequency",
 	"min_frequency",
 	"multicast",
+	"mute_windows",
 	"name",
 	"net_id",
 	"network_server_address",
@@ -1114,6 +1173,7 @@ var EndDeviceFieldPathsTopLevel = []string{
 	"supports_class_b",
 	"supports_class_c",
 	"supports_join",
+	"time_zone",
 	"updated_at",
 	"used_dev_nonces",
 	"version_ids",
@@ -1142,6 +1202,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -1158,11 +1219,17 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.last_join_nonce",
 	"end_device.last_rj_count_0",
 	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
 	"end_device.locations",
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
 	"end_device.mac_settings.desired_adr_ack_delay_exponent",
@@ -1177,6 +1244,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_data_rate_index",
 	"end_device.mac_settings.desired_rx2_data_rate_index.value",
 	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -1256,6 +1324,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -1403,9 +1472,11 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
 	"end_device.multicast",
+	"end_device.mute_windows",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -1470,6 +1541,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -1689,6 +1761,7 @@ var CreateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
+	"end_device.time_zone",
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
@@ -1718,6 +1791,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -1734,11 +1808,17 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.last_join_nonce",
 	"end_device.last_rj_count_0",
 	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
 	"end_device.locations",
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
 	"end_device.mac_settings.desired_adr_ack_delay_exponent",
@@ -1753,6 +1833,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_data_rate_index",
 	"end_device.mac_settings.desired_rx2_data_rate_index.value",
 	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -1832,6 +1913,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -1979,9 +2061,11 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
 	"end_device.multicast",
+	"end_device.mute_windows",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -2046,6 +2130,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -2265,6 +2350,7 @@ var UpdateEndDeviceRequestFieldPathsNested = []string{
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
+	"end_device.time_zone",
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
@@ -2336,6 +2422,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -2352,11 +2439,17 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.last_join_nonce",
 	"end_device.last_rj_count_0",
 	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
 	"end_device.locations",
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
 	"end_device.mac_settings.desired_adr_ack_delay_exponent",
@@ -2371,6 +2464,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_data_rate_index",
 	"end_device.mac_settings.desired_rx2_data_rate_index.value",
 	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -2450,6 +2544,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -2597,9 +2692,11 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
 	"end_device.multicast",
+	"end_device.mute_windows",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -2664,6 +2761,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -2883,6 +2981,7 @@ var SetEndDeviceRequestFieldPathsNested = []string{
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
+	"end_device.time_zone",
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
@@ -2914,6 +3013,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -2930,11 +3030,17 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.last_join_nonce",
 	"end_device.last_rj_count_0",
 	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
 	"end_device.locations",
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
 	"end_device.mac_settings.desired_adr_ack_delay_exponent",
@@ -2949,6 +3055,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_data_rate_index",
 	"end_device.mac_settings.desired_rx2_data_rate_index.value",
 	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -3028,6 +3135,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -3175,9 +3283,11 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
 	"end_device.multicast",
+	"end_device.mute_windows",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -3242,6 +3352,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -3461,6 +3572,7 @@ var EndDeviceTemplateFieldPathsNested = []string{
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
+	"end_device.time_zone",
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
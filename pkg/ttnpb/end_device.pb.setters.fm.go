@@ -987,6 +987,66 @@ func (dst *MACSettings) SetFields(src *MACSettings, paths ...string) error {
 					dst.DesiredADRAckDelayExponent = nil
 				}
 			}
+		case "adr_min_data_rate_index":
+			if len(subs) > 0 {
+				newDst := dst.ADRMinDataRateIndex
+				if newDst == nil {
+					newDst = &DataRateIndexValue{}
+					dst.ADRMinDataRateIndex = newDst
+				}
+				var newSrc *DataRateIndexValue
+				if src != nil {
+					newSrc = src.ADRMinDataRateIndex
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADRMinDataRateIndex = src.ADRMinDataRateIndex
+				} else {
+					dst.ADRMinDataRateIndex = nil
+				}
+			}
+		case "adr_max_data_rate_index":
+			if len(subs) > 0 {
+				newDst := dst.ADRMaxDataRateIndex
+				if newDst == nil {
+					newDst = &DataRateIndexValue{}
+					dst.ADRMaxDataRateIndex = newDst
+				}
+				var newSrc *DataRateIndexValue
+				if src != nil {
+					newSrc = src.ADRMaxDataRateIndex
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ADRMaxDataRateIndex = src.ADRMaxDataRateIndex
+				} else {
+					dst.ADRMaxDataRateIndex = nil
+				}
+			}
+		case "adr_max_tx_power_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr_max_tx_power_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADRMaxTxPowerIndex = src.ADRMaxTxPowerIndex
+			} else {
+				dst.ADRMaxTxPowerIndex = nil
+			}
+		case "f_cnt_reset_grace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt_reset_grace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCntResetGrace = src.FCntResetGrace
+			} else {
+				dst.FCntResetGrace = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1182,6 +1242,114 @@ func (dst *MACState) SetFields(src *MACState, paths ...string) error {
 	return nil
 }
 
+func (dst *MACTraceEntry) SetFields(src *MACTraceEntry, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				var zero time.Time
+				dst.Time = zero
+			}
+		case "uplink":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Uplink = src.Uplink
+			} else {
+				var zero bool
+				dst.Uplink = zero
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "adr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'adr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ADR = src.ADR
+			} else {
+				var zero bool
+				dst.ADR = zero
+			}
+		case "mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MACCommands = src.MACCommands
+			} else {
+				dst.MACCommands = nil
+			}
+		case "data_rate_index":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data_rate_index' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DataRateIndex = src.DataRateIndex
+			} else {
+				var zero DataRateIndex
+				dst.DataRateIndex = zero
+			}
+		case "class":
+			if len(subs) > 0 {
+				return fmt.Errorf("'class' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Class = src.Class
+			} else {
+				var zero Class
+				dst.Class = zero
+			}
+		case "priority":
+			if len(subs) > 0 {
+				return fmt.Errorf("'priority' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Priority = src.Priority
+			} else {
+				var zero TxSchedulePriority
+				dst.Priority = zero
+			}
+		case "gateway_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'gateway_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GatewayIDs = src.GatewayIDs
+			} else {
+				dst.GatewayIDs = nil
+			}
+		case "transmit_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'transmit_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TransmitAt = src.TransmitAt
+			} else {
+				dst.TransmitAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EndDeviceAuthenticationCode) SetFields(src *EndDeviceAuthenticationCode, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -1810,7 +1978,45 @@ func (dst *EndDevice) SetFields(src *EndDevice, paths ...string) error {
 					dst.ClaimAuthenticationCode = nil
 				}
 			}
+		case "lifecycle_state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lifecycle_state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LifecycleState = src.LifecycleState
+			} else {
+				var zero EndDeviceLifecycleState
+				dst.LifecycleState = zero
+			}
 
+		case "time_zone":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time_zone' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TimeZone = src.TimeZone
+			} else {
+				var zero string
+				dst.TimeZone = zero
+			}
+		case "mute_windows":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mute_windows' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MuteWindows = src.MuteWindows
+			} else {
+				dst.MuteWindows = nil
+			}
+		case "mac_trace":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_trace' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MACTrace = src.MACTrace
+			} else {
+				dst.MACTrace = nil
+			}
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
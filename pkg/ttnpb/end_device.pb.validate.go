@@ -1201,6 +1201,55 @@ func (m *MACSettings) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "adr_min_data_rate_index":
+
+			if v, ok := interface{}(m.GetADRMinDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_min_data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "adr_max_data_rate_index":
+
+			if v, ok := interface{}(m.GetADRMaxDataRateIndex()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "adr_max_data_rate_index",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "adr_max_tx_power_index":
+
+			if wrapper := m.GetADRMaxTxPowerIndex(); wrapper != nil {
+
+				if wrapper.GetValue() > 15 {
+					return MACSettingsValidationError{
+						field:  "adr_max_tx_power_index",
+						reason: "value must be less than or equal to 15",
+					}
+				}
+
+			}
+
+		case "f_cnt_reset_grace":
+
+			if v, ok := interface{}(m.GetFCntResetGrace()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACSettingsValidationError{
+						field:  "f_cnt_reset_grace",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return MACSettingsValidationError{
 				field:  name,
@@ -1481,6 +1530,176 @@ var _ interface {
 	ErrorName() string
 } = MACStateValidationError{}
 
+// ValidateFields checks the field values on MACTraceEntry with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *MACTraceEntry) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACTraceEntryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(&m.Time).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACTraceEntryValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "uplink":
+			// no validation rules for Uplink
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "adr":
+			// no validation rules for ADR
+		case "mac_commands":
+
+			for idx, item := range m.GetMACCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACTraceEntryValidationError{
+							field:  fmt.Sprintf("mac_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "data_rate_index":
+
+			if _, ok := DataRateIndex_name[int32(m.GetDataRateIndex())]; !ok {
+				return MACTraceEntryValidationError{
+					field:  "data_rate_index",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "class":
+
+			if _, ok := Class_name[int32(m.GetClass())]; !ok {
+				return MACTraceEntryValidationError{
+					field:  "class",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "priority":
+
+			if _, ok := TxSchedulePriority_name[int32(m.GetPriority())]; !ok {
+				return MACTraceEntryValidationError{
+					field:  "priority",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "gateway_ids":
+
+			for idx, item := range m.GatewayIDs {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACTraceEntryValidationError{
+							field:  fmt.Sprintf("gateway_ids[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "transmit_at":
+
+			if v, ok := interface{}(m.GetTransmitAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return MACTraceEntryValidationError{
+						field:  "transmit_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return MACTraceEntryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACTraceEntryValidationError is the validation error returned by
+// MACTraceEntry.ValidateFields if the designated constraints aren't met.
+type MACTraceEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACTraceEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACTraceEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACTraceEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACTraceEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACTraceEntryValidationError) ErrorName() string { return "MACTraceEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MACTraceEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACTraceEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACTraceEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACTraceEntryValidationError{}
+
 // ValidateFields checks the field values on EndDeviceAuthenticationCode with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
@@ -2076,6 +2295,65 @@ func (m *EndDevice) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "lifecycle_state":
+
+			if _, ok := EndDeviceLifecycleState_name[int32(m.GetLifecycleState())]; !ok {
+				return EndDeviceValidationError{
+					field:  "lifecycle_state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "time_zone":
+
+			if utf8.RuneCountInString(m.GetTimeZone()) > 64 {
+				return EndDeviceValidationError{
+					field:  "time_zone",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		case "mute_windows":
+
+			if len(m.GetMuteWindows()) > 20 {
+				return EndDeviceValidationError{
+					field:  "mute_windows",
+					reason: "value must contain no more than 20 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetMuteWindows() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceValidationError{
+							field:  fmt.Sprintf("mute_windows[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "mac_trace":
+
+			for idx, item := range m.GetMACTrace() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceValidationError{
+							field:  fmt.Sprintf("mac_trace[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return EndDeviceValidationError{
 				field:  name,
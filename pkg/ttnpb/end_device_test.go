@@ -0,0 +1,71 @@
+// Copyright © 2020 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ttnpb_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/pkg/errors"
+	. "go.thethings.network/lorawan-stack/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/pkg/util/test"
+	"go.thethings.network/lorawan-stack/pkg/util/test/assertions/should"
+)
+
+func TestMACSettingsValidateContext(t *testing.T) {
+	for _, tc := range []struct {
+		Name        string
+		MACSettings *MACSettings
+		Valid       bool
+	}{
+		{
+			Name:        "empty",
+			MACSettings: &MACSettings{},
+			Valid:       true,
+		},
+		{
+			Name: "minimum data rate index only",
+			MACSettings: &MACSettings{
+				ADRMinDataRateIndex: &DataRateIndexValue{Value: DATA_RATE_5},
+			},
+			Valid: true,
+		},
+		{
+			Name: "equal data rate indexes",
+			MACSettings: &MACSettings{
+				ADRMinDataRateIndex: &DataRateIndexValue{Value: DATA_RATE_3},
+				ADRMaxDataRateIndex: &DataRateIndexValue{Value: DATA_RATE_3},
+			},
+			Valid: true,
+		},
+		{
+			Name: "minimum data rate index higher than maximum",
+			MACSettings: &MACSettings{
+				ADRMinDataRateIndex: &DataRateIndexValue{Value: DATA_RATE_4},
+				ADRMaxDataRateIndex: &DataRateIndexValue{Value: DATA_RATE_3},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			err := tc.MACSettings.ValidateContext(test.Context())
+			if tc.Valid {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
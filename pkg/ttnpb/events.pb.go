@@ -209,6 +209,97 @@ func (m *StreamEventsRequest) GetAfter() *time.Time {
 	return nil
 }
 
+type FindRelatedEventsRequest struct {
+	CorrelationID        string   `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindRelatedEventsRequest) Reset()      { *m = FindRelatedEventsRequest{} }
+func (*FindRelatedEventsRequest) ProtoMessage() {}
+func (*FindRelatedEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fd8551d68f51e44, []int{2}
+}
+func (m *FindRelatedEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindRelatedEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindRelatedEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindRelatedEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindRelatedEventsRequest.Merge(m, src)
+}
+func (m *FindRelatedEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindRelatedEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindRelatedEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindRelatedEventsRequest proto.InternalMessageInfo
+
+func (m *FindRelatedEventsRequest) GetCorrelationID() string {
+	if m != nil {
+		return m.CorrelationID
+	}
+	return ""
+}
+
+type FindRelatedEventsResponse struct {
+	// Events with the correlation ID, ordered by time.
+	Events               []*Event `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindRelatedEventsResponse) Reset()      { *m = FindRelatedEventsResponse{} }
+func (*FindRelatedEventsResponse) ProtoMessage() {}
+func (*FindRelatedEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4fd8551d68f51e44, []int{3}
+}
+func (m *FindRelatedEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindRelatedEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindRelatedEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FindRelatedEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindRelatedEventsResponse.Merge(m, src)
+}
+func (m *FindRelatedEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindRelatedEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindRelatedEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindRelatedEventsResponse proto.InternalMessageInfo
+
+func (m *FindRelatedEventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Event)(nil), "ttn.lorawan.v3.Event")
 	golang_proto.RegisterType((*Event)(nil), "ttn.lorawan.v3.Event")
@@ -216,6 +307,10 @@ func init() {
 	golang_proto.RegisterMapType((map[string][]byte)(nil), "ttn.lorawan.v3.Event.ContextEntry")
 	proto.RegisterType((*StreamEventsRequest)(nil), "ttn.lorawan.v3.StreamEventsRequest")
 	golang_proto.RegisterType((*StreamEventsRequest)(nil), "ttn.lorawan.v3.StreamEventsRequest")
+	proto.RegisterType((*FindRelatedEventsRequest)(nil), "ttn.lorawan.v3.FindRelatedEventsRequest")
+	golang_proto.RegisterType((*FindRelatedEventsRequest)(nil), "ttn.lorawan.v3.FindRelatedEventsRequest")
+	proto.RegisterType((*FindRelatedEventsResponse)(nil), "ttn.lorawan.v3.FindRelatedEventsResponse")
+	golang_proto.RegisterType((*FindRelatedEventsResponse)(nil), "ttn.lorawan.v3.FindRelatedEventsResponse")
 }
 
 func init() { proto.RegisterFile("lorawan-stack/api/events.proto", fileDescriptor_4fd8551d68f51e44) }
@@ -224,51 +319,55 @@ func init() {
 }
 
 var fileDescriptor_4fd8551d68f51e44 = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3d, 0x4c, 0x1b, 0x4d,
-	0x10, 0xdd, 0xf5, 0x1f, 0xb0, 0xf0, 0xf1, 0xa1, 0x0d, 0x41, 0x17, 0x2b, 0x5a, 0x3b, 0xa6, 0xb1,
-	0xa2, 0xf8, 0x1c, 0x81, 0x84, 0x22, 0x94, 0x22, 0x98, 0xa0, 0x88, 0xf6, 0x92, 0x8a, 0x26, 0x5a,
-	0xdb, 0xcb, 0x79, 0x65, 0x7b, 0xd7, 0xb9, 0x5b, 0x1b, 0xae, 0x43, 0xa9, 0x28, 0x51, 0xd2, 0xa4,
-	0x8c, 0x52, 0x44, 0x94, 0x28, 0x15, 0x25, 0x25, 0x25, 0x52, 0x1a, 0x2a, 0xc0, 0x77, 0x29, 0x28,
-	0x29, 0x11, 0x55, 0xe4, 0xbd, 0x73, 0x30, 0x36, 0x52, 0xa4, 0x74, 0x33, 0x9a, 0x37, 0x33, 0x6f,
-	0xde, 0xdb, 0x3b, 0x44, 0x1a, 0xd2, 0xa1, 0x5b, 0x54, 0x14, 0x5c, 0x45, 0x2b, 0xf5, 0x22, 0x6d,
-	0xf1, 0x22, 0xeb, 0x30, 0xa1, 0x5c, 0xb3, 0xe5, 0x48, 0x25, 0xf1, 0xb4, 0x52, 0xc2, 0x8c, 0x30,
-	0x66, 0x67, 0x31, 0xbd, 0x62, 0x73, 0x55, 0x6b, 0x97, 0xcd, 0x8a, 0x6c, 0x16, 0x99, 0xe8, 0x48,
-	0xaf, 0xe5, 0xc8, 0x6d, 0xaf, 0xa8, 0xc1, 0x95, 0x82, 0xcd, 0x44, 0xa1, 0x43, 0x1b, 0xbc, 0x4a,
-	0x15, 0x2b, 0x8e, 0x04, 0xe1, 0xc8, 0x74, 0x61, 0x60, 0x84, 0x2d, 0x6d, 0x19, 0x36, 0x97, 0xdb,
-	0x9b, 0x3a, 0xd3, 0x89, 0x8e, 0x22, 0xf8, 0x63, 0x5b, 0x4a, 0xbb, 0xc1, 0x34, 0x35, 0x2a, 0x84,
-	0x54, 0x54, 0x71, 0x29, 0x22, 0x7e, 0xe9, 0x47, 0x51, 0xf5, 0xcf, 0x0c, 0x2a, 0xbc, 0xa8, 0x94,
-	0x19, 0x2e, 0x29, 0xde, 0x64, 0xae, 0xa2, 0xcd, 0x56, 0x04, 0x98, 0x1f, 0xbd, 0x9d, 0x57, 0x99,
-	0x50, 0x7c, 0x93, 0x33, 0xa7, 0xbf, 0xe0, 0x1e, 0x81, 0x1c, 0x6e, 0xd7, 0xfa, 0x02, 0xe5, 0xce,
-	0xe3, 0x28, 0xb9, 0xd6, 0x53, 0x0c, 0x63, 0x94, 0x10, 0xb4, 0xc9, 0x0c, 0x98, 0x85, 0xf9, 0x09,
-	0x4b, 0xc7, 0xf8, 0x15, 0x4a, 0xf4, 0xb6, 0x1a, 0xb1, 0x2c, 0xcc, 0x4f, 0x2e, 0xa4, 0xcd, 0x90,
-	0x92, 0xd9, 0xa7, 0x64, 0xbe, 0xeb, 0x53, 0x2a, 0xcd, 0xdc, 0x94, 0x92, 0x3f, 0x60, 0x6c, 0x1c,
-	0x1e, 0x9f, 0x65, 0xc0, 0xde, 0x79, 0x06, 0x5a, 0xba, 0x13, 0xaf, 0xa2, 0xc9, 0x01, 0x52, 0x46,
-	0x3c, 0x1b, 0xcf, 0x4f, 0x2e, 0x3c, 0x31, 0xef, 0xda, 0x62, 0xae, 0x09, 0xc5, 0x95, 0xb7, 0x7e,
-	0x0b, 0xb4, 0x06, 0xbb, 0x70, 0x1e, 0x25, 0xaa, 0x54, 0x51, 0x23, 0xa1, 0x69, 0xcc, 0x8e, 0xd0,
-	0x58, 0x11, 0x9e, 0xa5, 0x11, 0xf8, 0x0d, 0xfa, 0xbf, 0x22, 0x1d, 0x87, 0x35, 0xb4, 0xca, 0xef,
-	0x79, 0xd5, 0x35, 0x92, 0xd9, 0x78, 0x7e, 0xa2, 0x44, 0x6e, 0x4a, 0x13, 0x9f, 0x60, 0x2a, 0x97,
-	0x70, 0x62, 0x46, 0xd5, 0x3f, 0xcb, 0x4c, 0xaf, 0xde, 0xc2, 0xd6, 0x5f, 0xbb, 0xd6, 0xf4, 0x40,
-	0xdb, 0x7a, 0xd5, 0xc5, 0x73, 0x28, 0x25, 0x1d, 0x6e, 0x73, 0x61, 0xa4, 0xb4, 0x1e, 0x51, 0x86,
-	0x5f, 0xa2, 0xb1, 0x8a, 0x14, 0x8a, 0x6d, 0x2b, 0x63, 0x4c, 0xdf, 0x92, 0x1b, 0xb9, 0xa5, 0xa7,
-	0xa6, 0xb9, 0x1a, 0x82, 0xd6, 0x84, 0x72, 0x3c, 0xab, 0xdf, 0x82, 0x97, 0x10, 0xea, 0x70, 0x97,
-	0x97, 0x79, 0x83, 0x2b, 0xcf, 0x18, 0xd7, 0xe7, 0xcc, 0x0d, 0x0f, 0xb0, 0xb4, 0x3f, 0xd6, 0x00,
-	0x32, 0xbd, 0x8c, 0xa6, 0x06, 0x07, 0xe2, 0x19, 0x14, 0xaf, 0x33, 0x2f, 0xb2, 0xaa, 0x17, 0xe2,
-	0x59, 0x94, 0xec, 0xd0, 0x46, 0x3b, 0xb4, 0x6a, 0xca, 0x0a, 0x93, 0xe5, 0xd8, 0x0b, 0x98, 0xfb,
-	0x0e, 0xd1, 0x83, 0xb7, 0xca, 0x61, 0xb4, 0xa9, 0x99, 0xb9, 0x16, 0xfb, 0xd0, 0x66, 0xae, 0x1a,
-	0x76, 0x06, 0xfe, 0x93, 0x33, 0x18, 0x25, 0x14, 0xe5, 0x0d, 0xbd, 0xf5, 0x3f, 0x4b, 0xc7, 0x78,
-	0x09, 0x25, 0xe9, 0xa6, 0x62, 0x8e, 0x11, 0xff, 0xeb, 0xab, 0x49, 0xe8, 0x97, 0x12, 0xc2, 0x17,
-	0xaa, 0x28, 0x15, 0x32, 0xc4, 0x1b, 0x28, 0x15, 0x32, 0xc6, 0xf3, 0xc3, 0x7c, 0xee, 0xb9, 0x24,
-	0xfd, 0xf0, 0x5e, 0x0b, 0x72, 0xf8, 0xe3, 0xcf, 0x5f, 0x9f, 0x63, 0x53, 0xb9, 0xb1, 0xe8, 0x97,
-	0xb0, 0x0c, 0x9f, 0x3e, 0x87, 0xa5, 0x6f, 0xf0, 0xb8, 0x4b, 0xe0, 0x49, 0x97, 0xc0, 0xd3, 0x2e,
-	0x01, 0x17, 0x5d, 0x02, 0x2e, 0xbb, 0x04, 0x5c, 0x75, 0x09, 0xb8, 0xee, 0x12, 0xb8, 0xe3, 0x13,
-	0xb8, 0xeb, 0x13, 0xb0, 0xef, 0x13, 0x78, 0xe0, 0x13, 0x70, 0xe8, 0x13, 0x70, 0xe4, 0x13, 0x70,
-	0xec, 0x13, 0x78, 0xe2, 0x13, 0x78, 0xea, 0x13, 0x70, 0xe1, 0x13, 0x78, 0xe9, 0x13, 0x70, 0xe5,
-	0x13, 0x78, 0xed, 0x13, 0xb0, 0x13, 0x10, 0xb0, 0x1b, 0x10, 0xb8, 0x17, 0x10, 0xf0, 0x25, 0x20,
-	0xf0, 0x6b, 0x40, 0xc0, 0x7e, 0x40, 0xc0, 0x41, 0x40, 0xe0, 0x61, 0x40, 0xe0, 0x51, 0x40, 0xe0,
-	0xc6, 0x33, 0x5b, 0x9a, 0xaa, 0xc6, 0x54, 0x8d, 0x0b, 0xdb, 0x35, 0x05, 0x53, 0x5b, 0xd2, 0xa9,
-	0x17, 0xef, 0x7e, 0x9c, 0xad, 0xba, 0x5d, 0x54, 0x4a, 0xb4, 0xca, 0xe5, 0x94, 0xd6, 0x6a, 0xf1,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x67, 0xe1, 0x7a, 0xdf, 0x04, 0x00, 0x00,
+	// 767 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9d, 0x54, 0x3d, 0x4c, 0x14, 0x41,
+	0x14, 0xbe, 0xb9, 0x9f, 0x05, 0x06, 0x38, 0x70, 0x44, 0x5c, 0x36, 0x66, 0x0f, 0x97, 0x06, 0x8d,
+	0xb7, 0x6b, 0x20, 0x31, 0x86, 0x58, 0xc8, 0x21, 0x1a, 0x2c, 0x57, 0x2b, 0x12, 0x63, 0xf6, 0xee,
+	0x86, 0xbd, 0xc9, 0xdd, 0xed, 0x9e, 0xbb, 0x73, 0x07, 0x17, 0x1b, 0x62, 0x45, 0x49, 0xb4, 0xb1,
+	0x34, 0x16, 0x86, 0x92, 0x58, 0x51, 0x52, 0x52, 0x92, 0xd8, 0x50, 0xf1, 0x6b, 0x41, 0x49, 0x49,
+	0xa8, 0x7c, 0x37, 0xbb, 0x27, 0xf7, 0x67, 0x34, 0x16, 0x2f, 0xef, 0xcd, 0xce, 0xf7, 0xde, 0xbc,
+	0xef, 0x7d, 0xb3, 0x83, 0xd5, 0x92, 0xeb, 0x59, 0xab, 0x96, 0x93, 0xf6, 0xb9, 0x95, 0x2b, 0x1a,
+	0x56, 0x85, 0x19, 0xb4, 0x46, 0x1d, 0xee, 0xeb, 0x15, 0xcf, 0xe5, 0x2e, 0x49, 0x72, 0xee, 0xe8,
+	0x21, 0x46, 0xaf, 0xcd, 0x2a, 0xf3, 0x36, 0xe3, 0x85, 0x6a, 0x56, 0xcf, 0xb9, 0x65, 0x83, 0x3a,
+	0x35, 0xb7, 0x0e, 0xb0, 0xb5, 0xba, 0x21, 0xc0, 0xb9, 0xb4, 0x4d, 0x9d, 0x74, 0xcd, 0x2a, 0xb1,
+	0xbc, 0xc5, 0xa9, 0xd1, 0x15, 0x04, 0x25, 0x95, 0x74, 0x4b, 0x09, 0xdb, 0xb5, 0xdd, 0x20, 0x39,
+	0x5b, 0x5d, 0x11, 0x2b, 0xb1, 0x10, 0x51, 0x08, 0xbf, 0x63, 0xbb, 0xae, 0x5d, 0xa2, 0xa2, 0x35,
+	0xcb, 0x71, 0x5c, 0x6e, 0x71, 0xe6, 0x3a, 0x61, 0x7f, 0xca, 0x44, 0xb8, 0xfb, 0xbb, 0x86, 0xe5,
+	0xd4, 0xc3, 0xad, 0x54, 0xe7, 0x16, 0x67, 0x65, 0x0a, 0x34, 0xcb, 0x95, 0x10, 0x30, 0xd5, 0xcd,
+	0x9d, 0xe5, 0x81, 0x3b, 0x5b, 0x61, 0xd4, 0x6b, 0x1e, 0xd0, 0x63, 0x40, 0x1e, 0xb3, 0x0b, 0xcd,
+	0x01, 0x69, 0x47, 0x31, 0x9c, 0x58, 0x6c, 0x4c, 0x8c, 0x10, 0x1c, 0x77, 0xac, 0x32, 0x95, 0xd1,
+	0x24, 0x9a, 0x1e, 0x30, 0x45, 0x4c, 0x9e, 0xe2, 0x78, 0xe3, 0x54, 0x39, 0x0a, 0xdf, 0x06, 0x67,
+	0x14, 0x3d, 0x68, 0x49, 0x6f, 0xb6, 0xa4, 0xbf, 0x6e, 0xb6, 0x94, 0x19, 0xbd, 0xca, 0x24, 0xbe,
+	0xa3, 0x68, 0x3f, 0xda, 0x3b, 0x4c, 0x45, 0x36, 0x8f, 0x52, 0xc8, 0x14, 0x99, 0x64, 0x01, 0x0f,
+	0xb6, 0x34, 0x25, 0xc7, 0x26, 0x63, 0x50, 0xe8, 0xae, 0xde, 0x2e, 0x8b, 0xbe, 0x08, 0x00, 0x5e,
+	0x5f, 0xba, 0x06, 0x9a, 0xad, 0x59, 0x64, 0x1a, 0xc7, 0x41, 0x00, 0x4b, 0x8e, 0x8b, 0x36, 0xc6,
+	0xba, 0xda, 0x98, 0x77, 0xea, 0xa6, 0x40, 0x90, 0x17, 0x78, 0x24, 0xe7, 0x7a, 0x1e, 0x2d, 0x89,
+	0x29, 0xbf, 0x65, 0x79, 0x5f, 0x4e, 0xc0, 0x91, 0x03, 0x19, 0xf5, 0x2a, 0x33, 0xf0, 0x11, 0x49,
+	0x5a, 0xdc, 0x8b, 0xca, 0xf9, 0xd3, 0xc3, 0x54, 0x72, 0xe1, 0x1a, 0xb6, 0xf4, 0xcc, 0x37, 0x93,
+	0x2d, 0x69, 0x4b, 0x79, 0x9f, 0x8c, 0x63, 0xc9, 0x85, 0x41, 0x31, 0x47, 0x96, 0xc4, 0x3c, 0xc2,
+	0x15, 0x79, 0x82, 0xfb, 0x72, 0xae, 0xc3, 0xe9, 0x1a, 0x97, 0xfb, 0x04, 0x17, 0xad, 0x8b, 0x4b,
+	0x63, 0x9a, 0xfa, 0x42, 0x00, 0x02, 0x62, 0x5e, 0xdd, 0x6c, 0xa6, 0x90, 0x47, 0x18, 0xd7, 0x98,
+	0xcf, 0xb2, 0xac, 0x04, 0x74, 0xe5, 0x7e, 0x41, 0x67, 0xbc, 0xb3, 0x80, 0x29, 0xf4, 0x31, 0x5b,
+	0x90, 0xca, 0x1c, 0x1e, 0x6a, 0x2d, 0x48, 0x46, 0x71, 0xac, 0x48, 0xeb, 0xa1, 0x54, 0x8d, 0x90,
+	0x8c, 0xe1, 0x04, 0xdc, 0xd3, 0x6a, 0x20, 0xd5, 0x90, 0x19, 0x2c, 0xe6, 0xa2, 0x8f, 0x91, 0xf6,
+	0x0d, 0xe1, 0x9b, 0xaf, 0xb8, 0x47, 0xad, 0xb2, 0xe8, 0xcc, 0x37, 0xe9, 0xbb, 0x2a, 0x88, 0xd6,
+	0xa9, 0x0c, 0xfa, 0x2f, 0x65, 0xe0, 0xd2, 0x70, 0x8b, 0x95, 0xc4, 0xa9, 0xc3, 0xa6, 0x88, 0x81,
+	0x64, 0xc2, 0x5a, 0xe1, 0xd4, 0x03, 0xb1, 0xff, 0x76, 0x6b, 0xe2, 0xe2, 0xa6, 0x04, 0x70, 0xed,
+	0x0d, 0x96, 0x9f, 0x33, 0x27, 0x6f, 0x36, 0x54, 0xa0, 0xf9, 0xf6, 0x66, 0xe7, 0x71, 0xb2, 0x5d,
+	0xd7, 0x80, 0x7b, 0x46, 0xb9, 0xca, 0x48, 0x5e, 0x7c, 0x14, 0x09, 0x4d, 0x87, 0xdb, 0x34, 0x35,
+	0x87, 0xdb, 0x24, 0xd5, 0x5e, 0xe2, 0x89, 0x1e, 0xe5, 0xfd, 0x0a, 0xfc, 0x8c, 0x94, 0xa4, 0xb1,
+	0x14, 0xbc, 0x1b, 0xe1, 0x1c, 0x6e, 0xf5, 0x54, 0xd5, 0x0c, 0x41, 0x33, 0x27, 0x08, 0x4b, 0x41,
+	0x05, 0xb2, 0x8c, 0xa5, 0x60, 0xba, 0x64, 0xaa, 0x33, 0xa7, 0xc7, 0xd4, 0x95, 0xde, 0x85, 0x35,
+	0xf2, 0xe1, 0xc7, 0xcf, 0x4f, 0xd1, 0x21, 0xad, 0x2f, 0x7c, 0xbe, 0xe6, 0xd0, 0xfd, 0x87, 0x88,
+	0xbc, 0xc7, 0x83, 0x2d, 0x2d, 0x93, 0xe9, 0xce, 0xdc, 0x3f, 0x8d, 0x4b, 0xb9, 0xf7, 0x0f, 0xc8,
+	0x80, 0xb9, 0x76, 0x5b, 0x9c, 0x7c, 0x83, 0x8c, 0x84, 0x27, 0x1b, 0x5e, 0x00, 0xcb, 0x7c, 0x45,
+	0x7b, 0x27, 0x2a, 0xda, 0x07, 0x3b, 0x38, 0x51, 0x23, 0xc7, 0x60, 0xe7, 0x60, 0x17, 0x60, 0x97,
+	0xf0, 0x6d, 0xfd, 0x54, 0x45, 0x1b, 0xa7, 0x6a, 0x64, 0x0b, 0xfc, 0x36, 0xf8, 0x1d, 0xb0, 0x5d,
+	0xb0, 0x3d, 0x58, 0xef, 0x83, 0x1d, 0x40, 0x7c, 0x0c, 0xfe, 0x1c, 0xfc, 0x05, 0xf8, 0x4b, 0xf0,
+	0xeb, 0x67, 0x6a, 0x64, 0xe3, 0x4c, 0x45, 0x9b, 0xe0, 0x3f, 0x83, 0xff, 0x02, 0x7e, 0x0b, 0x6c,
+	0x1b, 0xe2, 0x1d, 0xb0, 0x5d, 0xb0, 0xe5, 0x07, 0xf0, 0x72, 0xf2, 0x02, 0xe5, 0x05, 0xe6, 0xd8,
+	0xbe, 0xee, 0x50, 0xbe, 0xea, 0x7a, 0x45, 0xa3, 0xfd, 0x15, 0xab, 0x14, 0x6d, 0x03, 0x08, 0x56,
+	0xb2, 0x59, 0x49, 0x5c, 0xaa, 0xd9, 0x5f, 0xdc, 0xb4, 0x00, 0x40, 0x08, 0x06, 0x00, 0x00,
 }
 
 func (this *Event) Equal(that interface{}) bool {
@@ -370,6 +469,59 @@ func (this *StreamEventsRequest) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *FindRelatedEventsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FindRelatedEventsRequest)
+	if !ok {
+		that2, ok := that.(FindRelatedEventsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CorrelationID != that1.CorrelationID {
+		return false
+	}
+	return true
+}
+func (this *FindRelatedEventsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FindRelatedEventsResponse)
+	if !ok {
+		that2, ok := that.(FindRelatedEventsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Events) != len(that1.Events) {
+		return false
+	}
+	for i := range this.Events {
+		if !this.Events[i].Equal(that1.Events[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -386,6 +538,10 @@ type EventsClient interface {
 	// Stream live events, optionally with a tail of historical events (depending on server support and retention policy).
 	// Events may arrive out-of-order.
 	Stream(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (Events_StreamClient, error)
+	// Find the events related to a message by its correlation ID, across the components of the cluster.
+	// Only the events that are visible to the caller are returned.
+	// The availability of events depends on server support and retention policy.
+	FindRelated(ctx context.Context, in *FindRelatedEventsRequest, opts ...grpc.CallOption) (*FindRelatedEventsResponse, error)
 }
 
 type eventsClient struct {
@@ -428,11 +584,24 @@ func (x *eventsStreamClient) Recv() (*Event, error) {
 	return m, nil
 }
 
+func (c *eventsClient) FindRelated(ctx context.Context, in *FindRelatedEventsRequest, opts ...grpc.CallOption) (*FindRelatedEventsResponse, error) {
+	out := new(FindRelatedEventsResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Events/FindRelated", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EventsServer is the server API for Events service.
 type EventsServer interface {
 	// Stream live events, optionally with a tail of historical events (depending on server support and retention policy).
 	// Events may arrive out-of-order.
 	Stream(*StreamEventsRequest, Events_StreamServer) error
+	// Find the events related to a message by its correlation ID, across the components of the cluster.
+	// Only the events that are visible to the caller are returned.
+	// The availability of events depends on server support and retention policy.
+	FindRelated(context.Context, *FindRelatedEventsRequest) (*FindRelatedEventsResponse, error)
 }
 
 // UnimplementedEventsServer can be embedded to have forward compatible implementations.
@@ -442,6 +611,9 @@ type UnimplementedEventsServer struct {
 func (*UnimplementedEventsServer) Stream(req *StreamEventsRequest, srv Events_StreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
 }
+func (*UnimplementedEventsServer) FindRelated(ctx context.Context, req *FindRelatedEventsRequest) (*FindRelatedEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRelated not implemented")
+}
 
 func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
 	s.RegisterService(&_Events_serviceDesc, srv)
@@ -468,10 +640,33 @@ func (x *eventsStreamServer) Send(m *Event) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Events_FindRelated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindRelatedEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventsServer).FindRelated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Events/FindRelated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventsServer).FindRelated(ctx, req.(*FindRelatedEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Events_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ttn.lorawan.v3.Events",
 	HandlerType: (*EventsServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FindRelated",
+			Handler:    _Events_FindRelated_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "Stream",
@@ -647,6 +842,73 @@ func (m *StreamEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FindRelatedEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindRelatedEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindRelatedEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CorrelationID) > 0 {
+		i -= len(m.CorrelationID)
+		copy(dAtA[i:], m.CorrelationID)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CorrelationID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindRelatedEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindRelatedEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindRelatedEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -717,6 +979,28 @@ func NewPopulatedStreamEventsRequest(r randyEvents, easy bool) *StreamEventsRequ
 	return this
 }
 
+func NewPopulatedFindRelatedEventsRequest(r randyEvents, easy bool) *FindRelatedEventsRequest {
+	this := &FindRelatedEventsRequest{}
+	this.CorrelationID = randStringEvents(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFindRelatedEventsResponse(r randyEvents, easy bool) *FindRelatedEventsResponse {
+	this := &FindRelatedEventsResponse{}
+	if r.Intn(5) != 0 {
+		v8 := r.Intn(5)
+		this.Events = make([]*Event, v8)
+		for i := 0; i < v8; i++ {
+			this.Events[i] = NewPopulatedEvent(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyEvents interface {
 	Float32() float32
 	Float64() float64
@@ -862,6 +1146,34 @@ func (m *StreamEventsRequest) Size() (n int) {
 	return n
 }
 
+func (m *FindRelatedEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CorrelationID)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *FindRelatedEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -917,6 +1229,33 @@ func (this *StreamEventsRequest) String() string {
 	}, "")
 	return s
 }
+func (this *FindRelatedEventsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FindRelatedEventsRequest{`,
+		`CorrelationID:` + fmt.Sprintf("%v", this.CorrelationID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
+func (this *FindRelatedEventsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]*Event{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(fmt.Sprintf("%v", f), "Event", "Event", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&FindRelatedEventsResponse{`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func valueToStringEvents(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -1483,6 +1822,180 @@ func (m *StreamEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FindRelatedEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindRelatedEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindRelatedEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CorrelationID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CorrelationID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FindRelatedEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindRelatedEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindRelatedEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
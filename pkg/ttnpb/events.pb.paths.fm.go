@@ -35,3 +35,19 @@ var StreamEventsRequestFieldPathsTopLevel = []string{
 	"identifiers",
 	"tail",
 }
+
+var FindRelatedEventsRequestFieldPathsNested = []string{
+	"correlation_id",
+}
+
+var FindRelatedEventsRequestFieldPathsTopLevel = []string{
+	"correlation_id",
+}
+
+var FindRelatedEventsResponseFieldPathsNested = []string{
+	"events",
+}
+
+var FindRelatedEventsResponseFieldPathsTopLevel = []string{
+	"events",
+}
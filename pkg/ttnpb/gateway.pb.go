@@ -473,8 +473,11 @@ type Gateway struct {
 	// duty cycle only in controlled research and development environments.
 	EnforceDutyCycle       bool                   `protobuf:"varint,17,opt,name=enforce_duty_cycle,json=enforceDutyCycle,proto3" json:"enforce_duty_cycle,omitempty"`
 	DownlinkPathConstraint DownlinkPathConstraint `protobuf:"varint,18,opt,name=downlink_path_constraint,json=downlinkPathConstraint,proto3,enum=ttn.lorawan.v3.DownlinkPathConstraint" json:"downlink_path_constraint,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}               `json:"-"`
-	XXX_sizecache          int32                  `json:"-"`
+	// Suspended gateways are not allowed to connect to the Gateway Server.
+	// Only admins can update this field.
+	Suspended            bool     `protobuf:"varint,19,opt,name=suspended,proto3" json:"suspended,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Gateway) Reset()      { *m = Gateway{} }
@@ -621,6 +624,13 @@ func (m *Gateway) GetDownlinkPathConstraint() DownlinkPathConstraint {
 	return DOWNLINK_PATH_CONSTRAINT_NONE
 }
 
+func (m *Gateway) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
 type Gateways struct {
 	Gateways             []*Gateway `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -1684,160 +1694,158 @@ func init() {
 }
 
 var fileDescriptor_1df6bae1ac946b39 = []byte{
-	// 2447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xe7, 0x90, 0x92, 0x48, 0x0d, 0x25, 0x4a, 0x9e, 0xbf, 0xfe, 0xca, 0x9a, 0xb6, 0x97, 0x0a,
-	0xa3, 0x24, 0x92, 0x2b, 0x52, 0x2d, 0x93, 0x14, 0xad, 0xda, 0x44, 0x21, 0x29, 0x3b, 0x20, 0x6c,
-	0xd5, 0xee, 0xca, 0x6a, 0x00, 0x7f, 0x2d, 0x46, 0xbb, 0x43, 0x72, 0xab, 0xe5, 0x2e, 0xbb, 0x3b,
-	0x94, 0x45, 0xc7, 0x01, 0x82, 0x22, 0x40, 0x83, 0xa0, 0x68, 0x83, 0x9c, 0x82, 0xa2, 0x87, 0xa0,
-	0x40, 0x8b, 0xa0, 0xed, 0xc1, 0xe8, 0xa1, 0xf0, 0xa1, 0x87, 0x5c, 0x5a, 0xf8, 0x54, 0xf8, 0x54,
-	0x04, 0x2d, 0xa0, 0x44, 0xd4, 0xc5, 0xbd, 0x05, 0xed, 0x25, 0xd0, 0xa9, 0x98, 0xd9, 0xd9, 0xe5,
-	0x92, 0xb2, 0x14, 0xc9, 0x1f, 0x69, 0x6f, 0x33, 0x6f, 0x7e, 0xef, 0x73, 0x1e, 0xdf, 0xbe, 0x37,
-	0x84, 0x19, 0xd3, 0x76, 0xf0, 0x0d, 0x6c, 0xe5, 0x5c, 0x8a, 0xb5, 0xf5, 0x79, 0xdc, 0x34, 0xe6,
-	0x6b, 0x98, 0x92, 0x1b, 0xb8, 0x9d, 0x6f, 0x3a, 0x36, 0xb5, 0x51, 0x8a, 0x52, 0x2b, 0x2f, 0x40,
-	0xf9, 0x8d, 0x17, 0xd2, 0xc5, 0x9a, 0x41, 0xeb, 0xad, 0xb5, 0xbc, 0x66, 0x37, 0xe6, 0x89, 0xb5,
-	0x61, 0xb7, 0x9b, 0x8e, 0xbd, 0xd9, 0x9e, 0xe7, 0x60, 0x2d, 0x57, 0x23, 0x56, 0x6e, 0x03, 0x9b,
-	0x86, 0x8e, 0x29, 0x99, 0xdf, 0xb3, 0xf0, 0x44, 0xa6, 0x73, 0x21, 0x11, 0x35, 0xbb, 0x66, 0x7b,
-	0xcc, 0x6b, 0xad, 0x2a, 0xdf, 0xf1, 0x0d, 0x5f, 0x09, 0xb8, 0x5c, 0xb3, 0xed, 0x9a, 0x49, 0xba,
-	0x28, 0xbd, 0xe5, 0x60, 0x6a, 0xd8, 0x96, 0x38, 0x9f, 0xea, 0x3f, 0xaf, 0x1a, 0xc4, 0xd4, 0xd5,
-	0x06, 0x76, 0xd7, 0x05, 0xe2, 0x64, 0x3f, 0xc2, 0xa5, 0x4e, 0x4b, 0xa3, 0xe2, 0x34, 0xd3, 0x7f,
-	0x4a, 0x8d, 0x06, 0x71, 0x29, 0x6e, 0x34, 0x05, 0x60, 0x7a, 0x6f, 0x8c, 0x34, 0xdb, 0xa2, 0x58,
-	0xa3, 0xaa, 0x61, 0x55, 0x7d, 0x33, 0x4f, 0xed, 0x45, 0x11, 0xab, 0xd5, 0x70, 0xc5, 0xf1, 0x33,
-	0x7b, 0x8f, 0x0d, 0x9d, 0x58, 0xd4, 0xa8, 0x1a, 0xc4, 0xf1, 0x41, 0x53, 0x7b, 0x41, 0x0d, 0x42,
-	0xb1, 0x8e, 0x29, 0xf6, 0x83, 0xb1, 0x17, 0xe1, 0x18, 0xb5, 0x3a, 0x15, 0x12, 0xb2, 0xeb, 0x70,
-	0xe4, 0x35, 0xef, 0xfe, 0x4a, 0x0e, 0xb6, 0x74, 0x34, 0x09, 0xa3, 0x86, 0x2e, 0x81, 0x29, 0x30,
-	0x33, 0x5c, 0x1a, 0xea, 0x6c, 0x65, 0xa2, 0x95, 0x25, 0x25, 0x6a, 0xe8, 0x08, 0xc1, 0x01, 0x0b,
-	0x37, 0x88, 0x14, 0x65, 0x27, 0x0a, 0x5f, 0xa3, 0xe3, 0x30, 0xd6, 0x72, 0x4c, 0x29, 0xc6, 0xc1,
-	0xf1, 0xce, 0x56, 0x26, 0xb6, 0xaa, 0x9c, 0x57, 0x18, 0x0d, 0x4d, 0xc0, 0x41, 0xd3, 0xae, 0xd9,
-	0xae, 0x34, 0x30, 0x15, 0x9b, 0x19, 0x56, 0xbc, 0x4d, 0xf6, 0x36, 0x08, 0xb4, 0x2d, 0xdb, 0x3a,
-	0x31, 0xd1, 0x32, 0x4c, 0xac, 0x31, 0xb5, 0x6a, 0xa0, 0xb3, 0xb0, 0x5b, 0x9a, 0x76, 0xb2, 0xd2,
-	0x74, 0x41, 0xbe, 0x7e, 0x05, 0xe7, 0x6e, 0x7e, 0x3d, 0xf7, 0xed, 0x6b, 0x33, 0x8b, 0x0b, 0x57,
-	0x72, 0xd7, 0x16, 0xfd, 0xed, 0xec, 0x1b, 0x85, 0xb9, 0x37, 0xa7, 0x3b, 0x5b, 0x99, 0x38, 0xb7,
-	0xb8, 0xb2, 0xa4, 0xc4, 0xb9, 0x8c, 0x8a, 0x8e, 0x5e, 0xe6, 0xc6, 0x73, 0x13, 0x4b, 0xb9, 0xc3,
-	0x0b, 0xea, 0xf7, 0x31, 0xd6, 0xf5, 0x31, 0xfb, 0xf3, 0x28, 0x3c, 0x2e, 0x4c, 0xfe, 0x01, 0x71,
-	0x5c, 0xc3, 0xb6, 0x2a, 0xdd, 0x5b, 0x78, 0xdc, 0xf6, 0x2f, 0xc3, 0x44, 0x83, 0xc5, 0x45, 0x0d,
-	0xbc, 0x38, 0x8a, 0x38, 0x1e, 0x52, 0x26, 0x8e, 0xcb, 0xa8, 0xe8, 0x68, 0x16, 0x8e, 0xd7, 0xb1,
-	0xa3, 0xdf, 0xc0, 0x0e, 0x51, 0x37, 0x3c, 0xe3, 0x85, 0x6f, 0x63, 0x3e, 0x5d, 0xf8, 0xc4, 0xa0,
-	0x55, 0xc3, 0x69, 0xf4, 0x40, 0x07, 0x3c, 0xa8, 0x4f, 0x17, 0xd0, 0xec, 0xbf, 0xa2, 0xc1, 0x25,
-	0x2a, 0x58, 0x37, 0x6c, 0x34, 0x09, 0x87, 0x88, 0x85, 0xd7, 0x4c, 0xc2, 0x43, 0x90, 0x50, 0xc4,
-	0x0e, 0x9d, 0x80, 0xc3, 0x5a, 0xdd, 0x68, 0xaa, 0xb4, 0xdd, 0xf4, 0xf3, 0x26, 0xc1, 0x08, 0x97,
-	0xda, 0x4d, 0x82, 0x4e, 0xc2, 0xe1, 0xaa, 0x43, 0x7e, 0xd4, 0x22, 0x96, 0xd6, 0xe6, 0x46, 0x0d,
-	0x28, 0x5d, 0x02, 0x9a, 0x87, 0x49, 0xc7, 0x75, 0x0d, 0xd5, 0xae, 0x56, 0x5d, 0x42, 0xb9, 0x25,
-	0xd1, 0x52, 0xaa, 0xb3, 0x95, 0x81, 0xca, 0xca, 0x4a, 0xe5, 0x02, 0xa7, 0x2a, 0x90, 0x41, 0xbc,
-	0x35, 0x7a, 0x1d, 0x8e, 0xd3, 0x4d, 0x55, 0xb3, 0xad, 0xaa, 0x51, 0x13, 0xbf, 0x76, 0x69, 0x70,
-	0x0a, 0xcc, 0x24, 0x0b, 0x73, 0xf9, 0xde, 0x82, 0x94, 0x0f, 0xdb, 0x9e, 0xbf, 0xb4, 0x59, 0x0e,
-	0xf3, 0x28, 0x63, 0xb4, 0x97, 0x90, 0x7e, 0x1b, 0xc0, 0xb1, 0x3e, 0x10, 0x7a, 0x06, 0x8e, 0x36,
-	0x0c, 0x4b, 0xed, 0xda, 0x0f, 0xb8, 0xfd, 0x23, 0x0d, 0xc3, 0x3a, 0x1b, 0xb8, 0xc0, 0x40, 0x78,
-	0x33, 0x04, 0x8a, 0x0a, 0x10, 0xde, 0xec, 0x82, 0x9e, 0x87, 0x63, 0x96, 0x4d, 0xb5, 0xba, 0xda,
-	0x1f, 0x8b, 0x14, 0x27, 0x07, 0xc0, 0xec, 0xdf, 0x00, 0x4c, 0xf5, 0xa6, 0x21, 0x5a, 0x86, 0x31,
-	0x43, 0x77, 0xb9, 0xee, 0x64, 0x61, 0x76, 0x1f, 0x2f, 0xf7, 0xe6, 0x6c, 0x69, 0x7c, 0xb7, 0x34,
-	0xf8, 0x2e, 0x88, 0x8e, 0x83, 0xbb, 0x5b, 0x99, 0xc8, 0xbd, 0xad, 0x0c, 0x50, 0x98, 0x1c, 0x76,
-	0x8b, 0xcd, 0xba, 0x4d, 0x6d, 0x57, 0x8a, 0xf2, 0x9f, 0xac, 0xd8, 0xa1, 0x17, 0xe1, 0x90, 0xc3,
-	0x42, 0xe5, 0x4a, 0xb1, 0xa9, 0xd8, 0x4c, 0xb2, 0x70, 0xf2, 0xa0, 0x78, 0x2a, 0x02, 0x8b, 0x9e,
-	0x86, 0x23, 0x9a, 0x69, 0x6b, 0xeb, 0xaa, 0x6b, 0xb7, 0x1c, 0x8d, 0x48, 0xf1, 0x29, 0x30, 0x33,
-	0xaa, 0x24, 0x39, 0x6d, 0x85, 0x93, 0x16, 0x06, 0xee, 0x7c, 0x98, 0x89, 0x64, 0x6f, 0x43, 0x18,
-	0x17, 0x12, 0xd0, 0xd9, 0xb0, 0x47, 0xd9, 0x7d, 0xf4, 0x1c, 0xc2, 0x95, 0x32, 0x84, 0x9a, 0x43,
-	0x30, 0x25, 0xba, 0x8a, 0x29, 0x8f, 0x7b, 0xb2, 0x90, 0xce, 0x7b, 0x55, 0x3b, 0xef, 0x57, 0xed,
-	0xfc, 0x25, 0xbf, 0x6a, 0x97, 0x12, 0x8c, 0xfd, 0xbd, 0x4f, 0x33, 0x40, 0x19, 0x16, 0x7c, 0x45,
-	0xca, 0x84, 0xb4, 0x9a, 0xba, 0x2f, 0x24, 0x76, 0x14, 0x21, 0x82, 0xaf, 0x48, 0xd1, 0x09, 0x51,
-	0x51, 0x06, 0xbc, 0x12, 0xb9, 0x5b, 0x1a, 0x70, 0xa2, 0x52, 0x41, 0x94, 0xcf, 0xd3, 0x30, 0xa9,
-	0x13, 0x57, 0x73, 0x8c, 0x66, 0x90, 0xae, 0xc3, 0xa5, 0xc4, 0x6e, 0x69, 0xd0, 0x89, 0x49, 0xf7,
-	0xc6, 0x94, 0xf0, 0x21, 0x6a, 0x41, 0x88, 0x29, 0x75, 0x8c, 0xb5, 0x16, 0x25, 0xae, 0x34, 0xc4,
-	0x6f, 0xe2, 0xf9, 0x7d, 0x22, 0x94, 0x2f, 0x06, 0xc8, 0x33, 0x16, 0x75, 0xda, 0xa5, 0xb9, 0xdd,
-	0xd2, 0xec, 0x2f, 0xc0, 0x73, 0xd9, 0x43, 0x55, 0x12, 0x25, 0xa4, 0x08, 0xbd, 0x02, 0x47, 0xc2,
-	0x5f, 0x2e, 0x29, 0xce, 0x15, 0x9f, 0xe8, 0x57, 0x5c, 0xf6, 0x30, 0x15, 0xab, 0x6a, 0x2b, 0x49,
-	0xad, 0xbb, 0x41, 0x57, 0x61, 0x52, 0x54, 0x13, 0x95, 0xdd, 0x6c, 0xe2, 0xd1, 0x73, 0x15, 0x6e,
-	0xf8, 0x28, 0x17, 0xfd, 0x19, 0xc0, 0x49, 0xd1, 0x7c, 0xa8, 0x2e, 0x71, 0x36, 0x88, 0xa3, 0x62,
-	0x5d, 0x77, 0x88, 0xeb, 0x4a, 0xc3, 0x3c, 0x98, 0x3f, 0x03, 0xbb, 0xa5, 0x77, 0x81, 0xf3, 0x13,
-	0x50, 0x78, 0x1b, 0x5c, 0x9f, 0x59, 0x5c, 0x60, 0x0e, 0xe3, 0xdc, 0xcd, 0x62, 0xee, 0x32, 0xf3,
-	0xf7, 0x56, 0x68, 0xdd, 0x5d, 0x5e, 0xcd, 0x5d, 0x3b, 0x1d, 0x3a, 0x98, 0xbd, 0x9a, 0x9f, 0x3d,
-	0xcd, 0xf8, 0x8a, 0xb9, 0xcb, 0x22, 0x4e, 0xb7, 0x42, 0xeb, 0xee, 0x92, 0xf3, 0x75, 0x0f, 0x66,
-	0x67, 0x16, 0x17, 0x16, 0xae, 0xb0, 0xd5, 0x1b, 0xdf, 0x98, 0x7b, 0xe9, 0xcd, 0xd9, 0xc5, 0xe9,
-	0x5b, 0xd7, 0xa7, 0x95, 0x09, 0x61, 0xee, 0x0a, 0xb7, 0xb6, 0xe8, 0x19, 0x8b, 0x32, 0x30, 0x89,
-	0x5b, 0xd4, 0x56, 0xbd, 0xbc, 0x91, 0x20, 0xaf, 0xa2, 0x90, 0x91, 0x56, 0x39, 0x05, 0x3d, 0x0b,
-	0x53, 0xde, 0x99, 0xaa, 0xd5, 0xb1, 0x65, 0x11, 0x53, 0x4a, 0xf2, 0x72, 0x3a, 0xea, 0x51, 0xcb,
-	0x1e, 0x11, 0x9d, 0x85, 0xc7, 0x82, 0x3a, 0xa2, 0x36, 0x4d, 0xcc, 0x82, 0x2e, 0x8d, 0xf0, 0x48,
-	0xa4, 0xbd, 0xd4, 0x7b, 0xb5, 0xb3, 0x95, 0x19, 0x0b, 0xaa, 0xca, 0x45, 0x13, 0x5b, 0x95, 0x25,
-	0x65, 0xac, 0xda, 0x43, 0xd0, 0xd1, 0xab, 0x30, 0x81, 0x2d, 0x4a, 0x2c, 0x0b, 0xbb, 0xd2, 0x28,
-	0xbf, 0x71, 0x79, 0x9f, 0x2b, 0x2b, 0x7a, 0xb0, 0xd2, 0x00, 0xbb, 0x1f, 0x25, 0xe0, 0x62, 0xc5,
-	0xcf, 0xa5, 0x98, 0xb6, 0x5c, 0xb5, 0xd9, 0x5a, 0x33, 0x0d, 0x4d, 0x4a, 0x71, 0x9f, 0x46, 0x3c,
-	0xe2, 0x45, 0x4e, 0x63, 0xc5, 0xcf, 0xb4, 0x35, 0x5e, 0x52, 0x7d, 0xd8, 0x18, 0x87, 0xa5, 0x7c,
-	0xb2, 0x00, 0xbe, 0x08, 0x27, 0x5d, 0xad, 0x4e, 0xf4, 0x96, 0x49, 0x54, 0xdd, 0xbe, 0x61, 0x99,
-	0x86, 0xb5, 0xae, 0x9a, 0x2c, 0x54, 0xe3, 0x1c, 0x3f, 0xe1, 0x9f, 0x2e, 0x89, 0xc3, 0xf3, 0x2c,
-	0x68, 0x73, 0x10, 0x11, 0xab, 0x6a, 0x3b, 0x1a, 0x51, 0xf5, 0x16, 0x6d, 0xab, 0x5a, 0x5b, 0x33,
-	0x89, 0x74, 0x8c, 0x73, 0x8c, 0x8b, 0x93, 0xa5, 0x16, 0x6d, 0x97, 0x19, 0x1d, 0xfd, 0x10, 0x4a,
-	0x81, 0xe8, 0x26, 0xa6, 0x75, 0xf6, 0x2d, 0x71, 0xa9, 0x83, 0x0d, 0x8b, 0x4a, 0x68, 0x0a, 0xcc,
-	0xa4, 0x0a, 0xcf, 0xf5, 0xc7, 0xc0, 0xd7, 0x76, 0x11, 0xd3, 0x7a, 0x39, 0x40, 0xf3, 0x5f, 0xf0,
-	0x8f, 0x59, 0xce, 0x2a, 0x93, 0xfa, 0x03, 0x11, 0xe9, 0x97, 0xe1, 0x58, 0xdf, 0x4f, 0x14, 0x8d,
-	0xc3, 0xd8, 0x3a, 0xf1, 0x3e, 0x24, 0xc3, 0x0a, 0x5b, 0xb2, 0x0e, 0x6a, 0x03, 0x9b, 0x2d, 0xff,
-	0xcb, 0xe9, 0x6d, 0x16, 0xa2, 0xdf, 0x02, 0xd9, 0x45, 0x98, 0x10, 0xe1, 0x77, 0xd1, 0x0b, 0x30,
-	0x21, 0x52, 0x8a, 0xd5, 0x4d, 0x76, 0x55, 0x4f, 0xed, 0x57, 0x9f, 0x03, 0x60, 0xf6, 0x77, 0x00,
-	0x1e, 0x7b, 0x8d, 0x50, 0xff, 0x80, 0x5d, 0xbe, 0x4b, 0xd1, 0x2a, 0x4c, 0xfa, 0x3f, 0xa6, 0x47,
-	0xad, 0xc2, 0xb0, 0xe6, 0xa3, 0x5c, 0xb4, 0x08, 0x61, 0xb7, 0xbf, 0xde, 0xb7, 0x18, 0x9f, 0x65,
-	0x90, 0x65, 0xec, 0xae, 0x8b, 0x54, 0x1a, 0xae, 0xfa, 0x84, 0x6c, 0x1b, 0x66, 0xbb, 0xc6, 0x86,
-	0xf4, 0x9e, 0xb5, 0x9d, 0x33, 0xab, 0x15, 0xdf, 0xfa, 0x15, 0x18, 0x23, 0x2d, 0x83, 0x5b, 0x3d,
-	0x52, 0x2a, 0x32, 0x19, 0x7f, 0xdf, 0xca, 0x14, 0x6a, 0x76, 0x9e, 0xd6, 0x09, 0xad, 0x1b, 0x56,
-	0xcd, 0xcd, 0x5b, 0x84, 0xde, 0xb0, 0x9d, 0xf5, 0xf9, 0xde, 0x8e, 0xb8, 0xb9, 0x5e, 0x9b, 0x67,
-	0x1d, 0x8a, 0x9b, 0x3f, 0xb3, 0x5a, 0xf9, 0xe6, 0x8b, 0xac, 0x8b, 0x65, 0x62, 0x99, 0xb4, 0xec,
-	0xbf, 0x01, 0xfc, 0xbf, 0xf3, 0x86, 0xeb, 0x2b, 0x77, 0x7d, 0x65, 0xdf, 0x67, 0x65, 0xd1, 0x34,
-	0xf1, 0x9a, 0xed, 0x60, 0x6a, 0x3b, 0x22, 0x56, 0xb9, 0xfe, 0x58, 0x5d, 0x70, 0x6a, 0xd8, 0x32,
-	0x6e, 0xf2, 0x54, 0xbe, 0xe0, 0xac, 0xba, 0xc4, 0x09, 0x99, 0xaf, 0xf4, 0x88, 0x78, 0xe4, 0x30,
-	0xb1, 0x7c, 0xb1, 0x1d, 0x9d, 0x38, 0xa2, 0xc3, 0xf3, 0x36, 0x48, 0x86, 0x83, 0xa6, 0xd1, 0x30,
-	0xbc, 0x16, 0x6a, 0x94, 0xe7, 0xe6, 0xe9, 0x98, 0x74, 0x3f, 0xae, 0x78, 0x64, 0xd6, 0xf2, 0x36,
-	0x71, 0x8d, 0xf0, 0x8f, 0xcf, 0xa8, 0xc2, 0xd7, 0xd9, 0x3f, 0x01, 0x38, 0x51, 0xe6, 0xdf, 0xc1,
-	0xbe, 0x0c, 0x29, 0xc3, 0xb8, 0xb8, 0x58, 0xe1, 0xf1, 0x7e, 0xb9, 0xf6, 0x80, 0x94, 0xf0, 0x39,
-	0x91, 0xda, 0x17, 0xbb, 0xe8, 0x43, 0xc4, 0xae, 0x34, 0x12, 0x96, 0xdf, 0x1b, 0xc9, 0xec, 0x2f,
-	0x01, 0x9c, 0xf0, 0xea, 0xe6, 0x93, 0x30, 0xff, 0x91, 0xd3, 0xf9, 0x37, 0x00, 0x1e, 0x0f, 0xe5,
-	0x54, 0xf1, 0x62, 0xe5, 0x1c, 0xe9, 0x66, 0xd6, 0x13, 0xfa, 0x11, 0x06, 0x69, 0x10, 0x3d, 0x38,
-	0x0d, 0x62, 0xa1, 0x34, 0x78, 0x1f, 0xc0, 0xa7, 0xba, 0x3f, 0x3c, 0xcf, 0xce, 0x27, 0x6c, 0xe6,
-	0x14, 0x1c, 0x5a, 0x27, 0xed, 0xee, 0xf4, 0x33, 0xdc, 0xd9, 0xca, 0x0c, 0x9e, 0x23, 0xed, 0xca,
-	0x92, 0x32, 0xb8, 0x4e, 0xda, 0x15, 0x3d, 0xfb, 0x57, 0x00, 0xd3, 0x3d, 0xb9, 0xf9, 0x95, 0xd8,
-	0x75, 0x22, 0x3c, 0xfc, 0xf6, 0xb7, 0x71, 0xdf, 0x85, 0x43, 0xde, 0x44, 0xcd, 0x1b, 0xe4, 0x54,
-	0xe1, 0xff, 0xfb, 0xd5, 0x29, 0xec, 0xb4, 0x34, 0xba, 0x5b, 0x82, 0xef, 0x83, 0x78, 0x56, 0x7c,
-	0x1b, 0x04, 0x4f, 0xf6, 0x8f, 0x00, 0xa6, 0x7b, 0xb2, 0xf5, 0x2b, 0x71, 0xa8, 0x08, 0xe3, 0xb8,
-	0x69, 0xa8, 0xec, 0x93, 0xe3, 0xa5, 0xf0, 0x64, 0xbf, 0x48, 0xcf, 0x8c, 0x07, 0x88, 0x19, 0xc2,
-	0x4d, 0xe3, 0x1c, 0x69, 0x67, 0x7f, 0x0f, 0x60, 0x26, 0x94, 0xc7, 0xe5, 0xd0, 0x4f, 0xf0, 0x7f,
-	0x31, 0x9b, 0xff, 0x01, 0xe0, 0xa9, 0x6e, 0x36, 0x87, 0xad, 0x7d, 0xc2, 0xc6, 0x6a, 0x8f, 0xa3,
-	0xde, 0xed, 0x55, 0xd1, 0x5b, 0xf3, 0xfe, 0x02, 0xe0, 0xa9, 0x95, 0xff, 0x86, 0x77, 0xdf, 0x7b,
-	0xa0, 0x77, 0x27, 0xf7, 0x0e, 0x08, 0x5d, 0xcc, 0x81, 0xc5, 0xfb, 0xd7, 0xd1, 0x60, 0xce, 0x15,
-	0xbd, 0x25, 0xbb, 0xcd, 0x1a, 0x36, 0x2c, 0x6e, 0x72, 0x54, 0xe1, 0x6b, 0x54, 0x82, 0x09, 0xbf,
-	0x47, 0x14, 0x2a, 0xa5, 0x7e, 0x95, 0xe7, 0xc5, 0x79, 0x9f, 0xba, 0x80, 0x0f, 0xdd, 0xea, 0x19,
-	0xa9, 0xbc, 0xe1, 0x36, 0x7f, 0x70, 0x9f, 0xfb, 0xf8, 0x26, 0xab, 0x47, 0xed, 0x01, 0x7f, 0x3a,
-	0x08, 0x47, 0x85, 0x6d, 0x2b, 0xbc, 0xa7, 0x46, 0xaf, 0xc2, 0x01, 0x6a, 0x34, 0x88, 0xb8, 0xd9,
-	0x83, 0x26, 0x55, 0x76, 0xa3, 0x7f, 0x00, 0xd1, 0x04, 0x08, 0x26, 0x56, 0xce, 0x89, 0x8a, 0x70,
-	0x78, 0xcd, 0xb6, 0xa9, 0xca, 0xc5, 0x1c, 0x65, 0x6a, 0x4e, 0x30, 0x36, 0x76, 0x80, 0x5a, 0x30,
-	0x21, 0xe6, 0x33, 0x3f, 0xa2, 0x5f, 0xdb, 0x27, 0xa2, 0x9e, 0xd5, 0x79, 0x31, 0xf3, 0x3d, 0x54,
-	0x38, 0x03, 0x55, 0xe8, 0x0c, 0x3c, 0x26, 0x46, 0x0f, 0xd5, 0xbf, 0x5e, 0xef, 0xe5, 0xf1, 0x80,
-	0xbc, 0x50, 0xc6, 0x05, 0x8b, 0x4f, 0x70, 0xf9, 0xdb, 0x67, 0x53, 0x1a, 0x9c, 0x8a, 0x05, 0x6f,
-	0x9f, 0x17, 0x95, 0xa8, 0xd1, 0x44, 0x0e, 0x8c, 0x37, 0x08, 0x75, 0x0c, 0xcd, 0x9f, 0xbc, 0x4f,
-	0x1f, 0xec, 0xd4, 0xb2, 0x07, 0x7e, 0x18, 0x9f, 0x7c, 0x45, 0xac, 0xb1, 0xc7, 0xfa, 0x06, 0xb6,
-	0x34, 0xa2, 0x4b, 0x9a, 0xe8, 0x56, 0xfa, 0xef, 0x62, 0x85, 0xbf, 0x4a, 0x2b, 0x01, 0x30, 0xfd,
-	0x1d, 0x38, 0xda, 0x13, 0xd0, 0xa3, 0xa4, 0x54, 0x7a, 0x01, 0x8e, 0x84, 0x0d, 0xff, 0x32, 0xde,
-	0x68, 0x38, 0x1d, 0x3f, 0x1d, 0x82, 0x93, 0x41, 0xf1, 0xb1, 0x2c, 0xa2, 0xb1, 0x80, 0xb2, 0x68,
-	0xb8, 0xa8, 0xcc, 0x9f, 0x10, 0x18, 0xc9, 0x7b, 0x49, 0xf9, 0xf2, 0xfc, 0x1c, 0xe0, 0x49, 0x95,
-	0x0c, 0xb8, 0x8a, 0x14, 0xa5, 0x61, 0xc2, 0xfb, 0xc3, 0xc0, 0x36, 0xfd, 0x97, 0x44, 0x7f, 0x8f,
-	0x5e, 0x87, 0x4f, 0x99, 0xd8, 0xa5, 0xaa, 0x18, 0x38, 0x1d, 0xa2, 0x11, 0x63, 0xe3, 0xb0, 0xaf,
-	0x36, 0x9e, 0xae, 0x09, 0x26, 0xc0, 0xbb, 0x3c, 0x45, 0xb0, 0x17, 0x29, 0x7a, 0x05, 0x26, 0x43,
-	0x82, 0x79, 0x07, 0x9d, 0x2c, 0x9c, 0x3a, 0xf0, 0xea, 0x15, 0xd8, 0x95, 0x14, 0x18, 0xd6, 0x6a,
-	0xf2, 0xa9, 0x32, 0x6c, 0xd8, 0xe0, 0x51, 0x0c, 0x5b, 0xe5, 0xfc, 0x21, 0xc3, 0x9e, 0x86, 0x23,
-	0x42, 0xa6, 0x66, 0xb7, 0x2c, 0x2a, 0x0d, 0xf1, 0x27, 0xc3, 0xa4, 0x47, 0x2b, 0x33, 0x12, 0xba,
-	0x02, 0x8f, 0x73, 0xdd, 0xc1, 0x4c, 0x1b, 0xd6, 0x1e, 0x3f, 0xa4, 0xf6, 0x49, 0x26, 0xc2, 0x9f,
-	0x72, 0x43, 0xfa, 0x9f, 0x85, 0xa9, 0x40, 0xae, 0x67, 0x41, 0x82, 0x5b, 0x30, 0xea, 0x53, 0x3d,
-	0x1b, 0x54, 0x38, 0xee, 0xd8, 0x2d, 0x4b, 0x57, 0xa9, 0x63, 0x34, 0x79, 0x55, 0xf1, 0xde, 0x65,
-	0x92, 0x85, 0x97, 0xf6, 0x09, 0x62, 0x5f, 0xee, 0xe4, 0x15, 0xc6, 0x7e, 0xc9, 0x31, 0x9a, 0xdc,
-	0x32, 0x25, 0xe5, 0xf4, 0xec, 0xd3, 0xff, 0x04, 0x30, 0xd5, 0x0b, 0x41, 0x2f, 0xc3, 0x58, 0x43,
-	0x7c, 0x2b, 0x92, 0x85, 0xe3, 0x7b, 0x3c, 0x5c, 0x12, 0x4f, 0xb8, 0xbc, 0x06, 0xfe, 0xd6, 0xaf,
-	0x81, 0x1f, 0x30, 0x67, 0x19, 0x1f, 0x67, 0xc7, 0x9b, 0xa2, 0xf8, 0x1d, 0x91, 0x1d, 0x6f, 0xa2,
-	0x32, 0x1c, 0x6a, 0x10, 0xdd, 0xc0, 0x96, 0xc8, 0xbc, 0x23, 0x49, 0x10, 0xac, 0xec, 0x57, 0xe6,
-	0x05, 0x95, 0x8f, 0x6c, 0x8a, 0xb7, 0x29, 0xfd, 0x0a, 0xdc, 0xdd, 0x96, 0xc1, 0xbd, 0x6d, 0x19,
-	0x7c, 0xb2, 0x2d, 0x47, 0x3e, 0xdb, 0x96, 0x23, 0xf7, 0xb7, 0xe5, 0xc8, 0xe7, 0xdb, 0x72, 0xe4,
-	0x8b, 0x6d, 0x19, 0xbc, 0xd5, 0x91, 0xc1, 0x3b, 0x1d, 0x39, 0xf2, 0x51, 0x47, 0x06, 0xb7, 0x3b,
-	0x72, 0xe4, 0x4e, 0x47, 0x8e, 0x7c, 0xdc, 0x91, 0x23, 0x77, 0x3b, 0x32, 0xb8, 0xd7, 0x91, 0xc1,
-	0x27, 0x1d, 0x39, 0xf2, 0x59, 0x47, 0x06, 0xf7, 0x3b, 0x72, 0xe4, 0xf3, 0x8e, 0x0c, 0xbe, 0xe8,
-	0xc8, 0x91, 0xb7, 0x76, 0xe4, 0xc8, 0x3b, 0x3b, 0x32, 0x78, 0x6f, 0x47, 0x8e, 0x7c, 0xb0, 0x23,
-	0x83, 0x0f, 0x77, 0xe4, 0xc8, 0x47, 0x3b, 0x72, 0xe4, 0xf6, 0x8e, 0x0c, 0xee, 0xec, 0xc8, 0xe0,
-	0xe3, 0x1d, 0x19, 0x5c, 0x9e, 0x3b, 0xec, 0x04, 0x4d, 0xad, 0xe6, 0xda, 0xda, 0x10, 0xf7, 0xf3,
-	0x85, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x74, 0x8c, 0xcf, 0x38, 0x25, 0x1c, 0x00, 0x00,
+	// 2401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x19, 0x4b, 0x6c, 0x1b, 0xc7,
+	0x55, 0x4b, 0x4a, 0x22, 0x35, 0x94, 0x28, 0x79, 0xa3, 0xca, 0x2b, 0xda, 0xa6, 0x14, 0x46, 0xf9,
+	0x48, 0x15, 0xa9, 0x96, 0x76, 0x8a, 0x56, 0xad, 0xa3, 0x68, 0x69, 0x3b, 0x10, 0x6a, 0xd7, 0xea,
+	0xca, 0x6a, 0x80, 0xf8, 0xb3, 0x58, 0xed, 0x8e, 0xc8, 0xad, 0xc8, 0x5d, 0x76, 0x77, 0x56, 0x9f,
+	0xc4, 0x01, 0x82, 0x22, 0x40, 0x83, 0xa0, 0x68, 0x83, 0x9c, 0x82, 0xa2, 0x87, 0xa0, 0x40, 0x83,
+	0x20, 0xed, 0xc1, 0xe8, 0xa1, 0xf0, 0xa1, 0x87, 0x5c, 0x5a, 0xf8, 0x14, 0xf8, 0x54, 0x04, 0x2d,
+	0xe0, 0x24, 0xce, 0xc5, 0xbd, 0x05, 0xed, 0x25, 0xd0, 0xa9, 0x6f, 0x3e, 0xbb, 0x5c, 0x52, 0x96,
+	0x22, 0x59, 0x76, 0xda, 0xc3, 0x82, 0x33, 0x6f, 0xde, 0x7f, 0xde, 0xbc, 0x79, 0x6f, 0x88, 0xc6,
+	0xea, 0xae, 0x67, 0x6c, 0x18, 0x4e, 0xd1, 0x27, 0x86, 0xb9, 0x36, 0x63, 0x34, 0xed, 0x99, 0xaa,
+	0x41, 0xf0, 0x86, 0xb1, 0x55, 0x6a, 0x7a, 0x2e, 0x71, 0xe5, 0x2c, 0x21, 0x4e, 0x49, 0x20, 0x95,
+	0xd6, 0x4f, 0xe6, 0xe6, 0xab, 0x36, 0xa9, 0x05, 0x2b, 0x25, 0xd3, 0x6d, 0xcc, 0x60, 0x67, 0xdd,
+	0xdd, 0x02, 0xb4, 0xcd, 0xad, 0x19, 0x86, 0x6c, 0x16, 0xab, 0xd8, 0x29, 0xae, 0x1b, 0x75, 0xdb,
+	0x02, 0x1e, 0x33, 0x3b, 0x06, 0x9c, 0x65, 0xae, 0x18, 0x63, 0x51, 0x75, 0xab, 0x2e, 0x27, 0x5e,
+	0x09, 0x56, 0xd9, 0x8c, 0x4d, 0xd8, 0x48, 0xa0, 0xe7, 0xab, 0xae, 0x5b, 0xad, 0xe3, 0x16, 0x96,
+	0x15, 0x78, 0x06, 0xb1, 0x5d, 0x47, 0xac, 0x8f, 0x77, 0xae, 0xaf, 0xda, 0xb8, 0x6e, 0xe9, 0x0d,
+	0xc3, 0x5f, 0x13, 0x18, 0xc7, 0x3b, 0x31, 0x7c, 0xe2, 0x05, 0x26, 0x11, 0xab, 0x63, 0x9d, 0xab,
+	0xc4, 0x6e, 0x60, 0x70, 0x47, 0xa3, 0x29, 0x10, 0x26, 0x76, 0xfa, 0xc8, 0x74, 0x1d, 0x18, 0x13,
+	0xdd, 0x76, 0x56, 0x43, 0x35, 0x4f, 0xec, 0xc4, 0xc2, 0x4e, 0xd0, 0xf0, 0xc5, 0xf2, 0x13, 0x3b,
+	0x97, 0x6d, 0x0b, 0x3b, 0xc4, 0x06, 0x6d, 0xbd, 0x10, 0x69, 0x7c, 0x27, 0x52, 0x03, 0x13, 0x03,
+	0x7c, 0x67, 0x84, 0xce, 0xd8, 0x89, 0xe1, 0xd9, 0xd5, 0x1a, 0x11, 0x1c, 0x0a, 0x6b, 0xa8, 0xff,
+	0x05, 0xbe, 0x7f, 0xaa, 0x67, 0x38, 0x96, 0x3c, 0x82, 0x12, 0xb6, 0xa5, 0x48, 0xe3, 0xd2, 0x33,
+	0x7d, 0x6a, 0xef, 0xdd, 0x3b, 0x63, 0x89, 0x85, 0x33, 0x1a, 0x40, 0x64, 0x19, 0x75, 0x3b, 0x46,
+	0x03, 0x2b, 0x09, 0xba, 0xa2, 0xb1, 0xb1, 0x3c, 0x8a, 0x92, 0x81, 0x57, 0x57, 0x92, 0x0c, 0x39,
+	0x05, 0xc8, 0xc9, 0x65, 0xed, 0xbc, 0x46, 0x61, 0xf2, 0x30, 0xea, 0xa9, 0xc3, 0x8e, 0xf8, 0x4a,
+	0xf7, 0x78, 0x12, 0xf0, 0xf9, 0xa4, 0x70, 0x43, 0x8a, 0xa4, 0x5d, 0x70, 0x2d, 0x5c, 0x97, 0x2f,
+	0xa0, 0xf4, 0x0a, 0x15, 0xab, 0x47, 0x32, 0xcb, 0xdb, 0xea, 0x84, 0x57, 0x50, 0x26, 0xca, 0xf9,
+	0x6b, 0x97, 0x8d, 0xe2, 0xcb, 0xdf, 0x2a, 0x7e, 0xef, 0xea, 0x33, 0x73, 0xb3, 0x97, 0x8b, 0x57,
+	0xe7, 0xc2, 0xe9, 0xe4, 0x2b, 0xe5, 0xe9, 0x57, 0x27, 0x40, 0x5a, 0x8a, 0x69, 0x0c, 0xfa, 0xa5,
+	0x18, 0x8f, 0x05, 0x4b, 0x3e, 0xcd, 0x94, 0x67, 0x2a, 0xaa, 0xc5, 0xfd, 0x33, 0xea, 0xb4, 0x31,
+	0xd9, 0xb2, 0xb1, 0xf0, 0xeb, 0x04, 0x1a, 0x15, 0x2a, 0xff, 0x04, 0xfc, 0x0e, 0x51, 0xb4, 0xd0,
+	0xda, 0x85, 0x87, 0xad, 0x3f, 0xb0, 0x6b, 0x50, 0xbf, 0xe8, 0x91, 0x15, 0x07, 0x61, 0xc7, 0x5c,
+	0x4a, 0xd9, 0x31, 0x1e, 0xc0, 0x6e, 0x12, 0x0d, 0xd5, 0x0c, 0xcf, 0xda, 0x30, 0x3c, 0xac, 0xaf,
+	0x73, 0xe5, 0x85, 0x6d, 0x83, 0x21, 0x5c, 0xd8, 0x44, 0x51, 0x57, 0x6d, 0xaf, 0xd1, 0x86, 0xda,
+	0xcd, 0x51, 0x43, 0xb8, 0x40, 0x2d, 0xfc, 0x3b, 0x11, 0x6d, 0xa2, 0x66, 0x58, 0xb6, 0x0b, 0x21,
+	0xd3, 0x8b, 0x1d, 0x63, 0xa5, 0x8e, 0x99, 0x0b, 0xd2, 0x9a, 0x98, 0xc9, 0xc7, 0x50, 0x9f, 0x59,
+	0xb3, 0x9b, 0x3a, 0xd9, 0x6a, 0x86, 0x71, 0x93, 0xa6, 0x80, 0x4b, 0x30, 0x97, 0x8f, 0xa3, 0xbe,
+	0x55, 0x0f, 0xff, 0x2c, 0xc0, 0x8e, 0xb9, 0xc5, 0x94, 0xea, 0xd6, 0x5a, 0x00, 0x79, 0x06, 0x65,
+	0x3c, 0xdf, 0xb7, 0x75, 0x77, 0x75, 0xd5, 0xc7, 0x84, 0x69, 0x92, 0x50, 0xb3, 0x60, 0x24, 0xd2,
+	0x96, 0x96, 0x16, 0x2e, 0x32, 0xa8, 0x86, 0x28, 0x0a, 0x1f, 0xcb, 0x2f, 0xa2, 0x21, 0xb2, 0xa9,
+	0xc3, 0x29, 0x5b, 0xb5, 0xab, 0xe2, 0xb4, 0x2b, 0x3d, 0x40, 0x95, 0x29, 0x4f, 0x97, 0xda, 0x13,
+	0x52, 0x29, 0xae, 0x7b, 0xe9, 0xd2, 0x66, 0x25, 0x4e, 0xa3, 0x0d, 0x92, 0x76, 0x40, 0xee, 0x75,
+	0x09, 0x0d, 0x76, 0x20, 0xc9, 0x4f, 0xa0, 0x81, 0x86, 0xed, 0xe8, 0x2d, 0xfd, 0x25, 0xa6, 0x7f,
+	0x3f, 0x00, 0xcf, 0x45, 0x26, 0x50, 0x24, 0x63, 0x33, 0x86, 0x94, 0x10, 0x48, 0xc6, 0x66, 0x0b,
+	0xe9, 0x69, 0x34, 0xe8, 0xb8, 0xc4, 0xac, 0xe9, 0x9d, 0xbe, 0xc8, 0x32, 0x70, 0x84, 0x58, 0xf8,
+	0xbb, 0x84, 0xb2, 0xed, 0x61, 0x08, 0xc1, 0x92, 0xb4, 0x2d, 0x9f, 0xc9, 0xce, 0x94, 0x27, 0x77,
+	0xb1, 0x72, 0x67, 0xcc, 0xaa, 0x43, 0xdb, 0x6a, 0xcf, 0x9b, 0x52, 0x62, 0x48, 0xba, 0x75, 0x67,
+	0xac, 0xeb, 0xf6, 0x9d, 0x31, 0x49, 0xa3, 0x7c, 0xe8, 0x2e, 0x36, 0x6b, 0x90, 0x11, 0x7c, 0x50,
+	0x94, 0x1e, 0x59, 0x31, 0x93, 0x4f, 0xa1, 0x5e, 0x8f, 0xba, 0xca, 0x07, 0xcd, 0x92, 0x20, 0xe9,
+	0xf8, 0x5e, 0xfe, 0xd4, 0x04, 0xae, 0xfc, 0x38, 0xea, 0x37, 0xeb, 0xae, 0xb9, 0xa6, 0xfb, 0x6e,
+	0xe0, 0x99, 0x58, 0x49, 0x81, 0x96, 0x03, 0x5a, 0x86, 0xc1, 0x96, 0x18, 0x68, 0xb6, 0xfb, 0xe6,
+	0xbb, 0x63, 0x5d, 0x85, 0x8f, 0x10, 0x4a, 0x09, 0x0e, 0xf2, 0xb9, 0xb8, 0x45, 0x85, 0x5d, 0xe4,
+	0xec, 0xc3, 0x94, 0x0a, 0x42, 0xa6, 0x87, 0x01, 0xdd, 0xd2, 0x0d, 0xc2, 0xfc, 0x9e, 0x29, 0xe7,
+	0x4a, 0x3c, 0x6b, 0x97, 0xc2, 0xac, 0x5d, 0xba, 0x14, 0x66, 0x6d, 0x35, 0x4d, 0xc9, 0xdf, 0xfa,
+	0x04, 0xc8, 0xfb, 0x04, 0xdd, 0x3c, 0xa1, 0x4c, 0x82, 0xa6, 0x15, 0x32, 0x49, 0x1e, 0x84, 0x89,
+	0xa0, 0x03, 0x26, 0xc7, 0x44, 0x46, 0xe9, 0xe6, 0x29, 0x72, 0x5b, 0xed, 0xf6, 0x12, 0x4a, 0x59,
+	0xa4, 0xcf, 0x29, 0x94, 0xb1, 0xb0, 0x6f, 0x7a, 0x76, 0x33, 0x0a, 0xd7, 0x3e, 0x35, 0x0d, 0x26,
+	0x79, 0x49, 0xe5, 0xf6, 0xa0, 0x16, 0x5f, 0x94, 0x03, 0x84, 0x0c, 0x42, 0x3c, 0x7b, 0x25, 0x20,
+	0xd8, 0x57, 0x7a, 0xd9, 0x4e, 0x3c, 0xbd, 0x8b, 0x87, 0x4a, 0xf3, 0x11, 0xe6, 0x59, 0x87, 0x78,
+	0x5b, 0xea, 0xf4, 0xb6, 0x3a, 0xf9, 0x1b, 0xe9, 0xa9, 0xc2, 0xbe, 0x32, 0x89, 0x16, 0x13, 0x24,
+	0x3f, 0x07, 0xdb, 0x18, 0xbb, 0xb9, 0x60, 0x1b, 0xa9, 0xe0, 0x63, 0x9d, 0x82, 0x2b, 0x1c, 0x67,
+	0x01, 0x50, 0x60, 0x8f, 0x5b, 0x13, 0xf9, 0x0a, 0xca, 0x88, 0x6c, 0xa2, 0xd3, 0x9d, 0x4d, 0x1f,
+	0x3e, 0x56, 0xd1, 0x7a, 0x88, 0xe5, 0xcb, 0x7f, 0x95, 0xd0, 0x88, 0x28, 0x3e, 0x74, 0x1f, 0x7b,
+	0xb0, 0xa2, 0x1b, 0x96, 0xe5, 0x61, 0xdf, 0x57, 0xfa, 0x98, 0x33, 0x7f, 0x25, 0x6d, 0xab, 0x6f,
+	0x4a, 0xde, 0x2f, 0xa4, 0xf2, 0xeb, 0xd2, 0x35, 0xb0, 0x96, 0x1a, 0x0c, 0xc6, 0xce, 0x17, 0x5f,
+	0xa2, 0xf6, 0x5e, 0x8f, 0x8d, 0x5b, 0xc3, 0x2b, 0xc5, 0xab, 0x53, 0xb1, 0x85, 0xc9, 0x2b, 0xa5,
+	0xc9, 0x29, 0x4a, 0x07, 0x73, 0xe1, 0xa7, 0xeb, 0xb1, 0x71, 0x6b, 0xc8, 0xe8, 0x5a, 0x0b, 0x93,
+	0x40, 0x33, 0x7b, 0x99, 0x8e, 0x5e, 0xf9, 0xf6, 0xf4, 0xb3, 0xaf, 0x4e, 0xce, 0x4d, 0x5c, 0xbf,
+	0x36, 0xa1, 0x0d, 0x0b, 0x75, 0x97, 0x98, 0xb6, 0xf3, 0x5c, 0x59, 0x79, 0x0c, 0x65, 0x8c, 0x80,
+	0xb8, 0x3a, 0x8f, 0x1b, 0x05, 0xb1, 0x2c, 0x8a, 0x28, 0x68, 0x99, 0x41, 0xe4, 0x27, 0x51, 0x96,
+	0xaf, 0xe9, 0x66, 0xcd, 0x70, 0x1c, 0x5c, 0x57, 0x32, 0x2c, 0x9d, 0x0e, 0x70, 0x68, 0x85, 0x03,
+	0xe1, 0xfc, 0x1c, 0x89, 0xf2, 0x88, 0xde, 0xac, 0x1b, 0xd4, 0xe9, 0x4a, 0x3f, 0xf3, 0x44, 0x8e,
+	0x87, 0xde, 0xf3, 0x90, 0x42, 0x07, 0xa3, 0xac, 0xb2, 0x08, 0x28, 0x70, 0x5f, 0x0c, 0xae, 0xb6,
+	0x01, 0x2c, 0xf9, 0x79, 0x94, 0x36, 0x1c, 0x82, 0x1d, 0xc7, 0xf0, 0x95, 0x01, 0xb6, 0xe3, 0xf9,
+	0x5d, 0xb6, 0x6c, 0x9e, 0xa3, 0xa9, 0xdd, 0x74, 0x7f, 0xb4, 0x88, 0x8a, 0x26, 0x3f, 0x38, 0x15,
+	0x24, 0xf0, 0xf5, 0x66, 0xb0, 0x52, 0xb7, 0x4d, 0x25, 0xcb, 0x6c, 0xea, 0xe7, 0xc0, 0x45, 0x06,
+	0xa3, 0xc9, 0x0f, 0xd2, 0x01, 0x4b, 0xa9, 0x21, 0xda, 0x20, 0x43, 0xcb, 0x86, 0x60, 0x81, 0x78,
+	0x0a, 0x8d, 0xf8, 0x66, 0x0d, 0x5b, 0x41, 0x1d, 0xeb, 0x96, 0xbb, 0xe1, 0xd4, 0x6d, 0x67, 0x4d,
+	0xaf, 0x53, 0x57, 0x0d, 0x31, 0xfc, 0xe1, 0x70, 0xf5, 0x8c, 0x58, 0x3c, 0x4f, 0x9d, 0x36, 0x8d,
+	0x64, 0x0c, 0x31, 0x08, 0xa9, 0x46, 0xb7, 0x02, 0xb2, 0xa5, 0x9b, 0x5b, 0x26, 0x5c, 0x51, 0x47,
+	0x18, 0xc5, 0x90, 0x58, 0x39, 0x03, 0x0b, 0x15, 0x0a, 0x97, 0x7f, 0x8a, 0x94, 0x88, 0x75, 0xd3,
+	0x20, 0x35, 0x7a, 0x97, 0x40, 0xd5, 0x67, 0xd8, 0x0e, 0x51, 0x64, 0xa0, 0xc9, 0x96, 0x9f, 0xea,
+	0xf4, 0x41, 0x28, 0x6d, 0x11, 0xd0, 0x2b, 0x11, 0x36, 0x3b, 0xc1, 0x3f, 0xa7, 0x31, 0xab, 0x8d,
+	0x58, 0xf7, 0xc5, 0xa0, 0x77, 0x9f, 0x1f, 0xf8, 0x4d, 0xec, 0x58, 0xd8, 0x52, 0x1e, 0x63, 0x0a,
+	0xb5, 0x00, 0xb9, 0xd3, 0x68, 0xb0, 0xe3, 0x00, 0xcb, 0x43, 0x28, 0xb9, 0x86, 0xf9, 0x35, 0xd3,
+	0xa7, 0xd1, 0x21, 0xad, 0xaf, 0xa0, 0x48, 0x0e, 0xc2, 0x7b, 0x95, 0x4f, 0x66, 0x13, 0xdf, 0x95,
+	0x0a, 0x73, 0x28, 0x2d, 0x36, 0xc7, 0x97, 0x4f, 0xa2, 0xb4, 0x08, 0x38, 0x9a, 0x55, 0xe9, 0x46,
+	0x1e, 0xdd, 0x2d, 0x7b, 0x47, 0x88, 0x85, 0x3f, 0x48, 0xe8, 0xc8, 0x0b, 0x98, 0x84, 0x0b, 0x34,
+	0x34, 0x7c, 0x22, 0x2f, 0xa3, 0x4c, 0x78, 0xd4, 0x0e, 0x9b, 0xa3, 0x51, 0x35, 0xc4, 0xf2, 0xe5,
+	0x39, 0x84, 0x5a, 0xd5, 0xf7, 0xae, 0xa9, 0xfa, 0x1c, 0x45, 0xb9, 0x00, 0x18, 0x22, 0xd0, 0xfa,
+	0x56, 0x43, 0x40, 0x61, 0x0b, 0x15, 0x5a, 0xca, 0xc6, 0xe4, 0x9e, 0x73, 0xbd, 0xb3, 0xcb, 0x0b,
+	0xa1, 0xf6, 0x4b, 0x28, 0x89, 0x03, 0x9b, 0x69, 0xdd, 0xaf, 0xce, 0x53, 0x1e, 0xff, 0xb8, 0x33,
+	0x56, 0x86, 0x8e, 0x81, 0xd4, 0x30, 0xa9, 0xd9, 0x4e, 0xd5, 0x2f, 0x39, 0x98, 0x6c, 0xb8, 0xde,
+	0xda, 0x4c, 0x7b, 0xbd, 0xdc, 0x5c, 0xab, 0xce, 0xd0, 0xfa, 0xc5, 0x2f, 0x01, 0xb7, 0xef, 0x9c,
+	0xa2, 0x35, 0x2e, 0x65, 0x4b, 0xb9, 0x15, 0xfe, 0x23, 0xa1, 0xc7, 0xce, 0xdb, 0x7e, 0x28, 0xdc,
+	0x0f, 0x85, 0xfd, 0x98, 0x26, 0xcd, 0x7a, 0xdd, 0x58, 0x01, 0x4e, 0xc4, 0xf5, 0x84, 0xaf, 0x8a,
+	0x9d, 0xbe, 0xba, 0xe8, 0x55, 0x0d, 0xc7, 0x7e, 0x99, 0x05, 0xfa, 0x45, 0x6f, 0x19, 0x12, 0x58,
+	0x4c, 0x7d, 0xad, 0x8d, 0xc5, 0xa1, 0xdd, 0x44, 0xe3, 0xc5, 0xf5, 0x2c, 0xec, 0x89, 0xfa, 0x8f,
+	0x4f, 0xe4, 0x3c, 0x54, 0xe9, 0x76, 0xc3, 0xe6, 0x05, 0xd6, 0x00, 0x8b, 0xdc, 0xa9, 0xa4, 0x72,
+	0x2f, 0xa5, 0x71, 0x30, 0x2d, 0x88, 0x9b, 0x46, 0x15, 0xb3, 0xab, 0x69, 0x40, 0x63, 0xe3, 0xc2,
+	0x5f, 0x24, 0x34, 0x5c, 0x61, 0xb7, 0x64, 0x47, 0x84, 0x54, 0x50, 0x4a, 0x6c, 0xac, 0xb0, 0x78,
+	0xb7, 0x58, 0xbb, 0x4f, 0x48, 0x84, 0x94, 0xb2, 0xde, 0xe1, 0xbb, 0xc4, 0x03, 0xf8, 0x4e, 0xed,
+	0x8f, 0xf3, 0x6f, 0xf7, 0x64, 0xe1, 0xb7, 0xa0, 0x3e, 0xcf, 0xaa, 0x8f, 0x42, 0xfd, 0x43, 0x87,
+	0xf3, 0x7b, 0x12, 0x1a, 0x8d, 0xc5, 0xd4, 0xfc, 0xe2, 0xc2, 0x0f, 0x71, 0x2b, 0xb2, 0x1e, 0xd1,
+	0x21, 0x8c, 0xc2, 0x20, 0xb1, 0x77, 0x18, 0x24, 0x63, 0x61, 0xf0, 0xb6, 0x84, 0x8e, 0xb6, 0x0e,
+	0x1e, 0xd7, 0xf3, 0x11, 0xab, 0x39, 0x8e, 0x7a, 0x21, 0xf5, 0xb5, 0x7a, 0xa3, 0x3e, 0x38, 0x8d,
+	0x3d, 0x20, 0x16, 0xae, 0xb0, 0x1e, 0x58, 0x58, 0xb0, 0x0a, 0x1f, 0x49, 0x28, 0xd7, 0x16, 0x9b,
+	0x5f, 0x8b, 0x5e, 0xc7, 0xe2, 0xad, 0x71, 0x67, 0x91, 0xf7, 0x03, 0x28, 0x9f, 0x59, 0xbf, 0xcd,
+	0xca, 0xe7, 0x6c, 0xf9, 0x1b, 0x9d, 0xe2, 0x34, 0xba, 0xaa, 0x0e, 0x6c, 0xab, 0xe8, 0x6d, 0x29,
+	0x55, 0x10, 0x37, 0x87, 0xa0, 0x29, 0xfc, 0x19, 0x0c, 0x6a, 0x8b, 0xd6, 0xaf, 0xc5, 0xa0, 0x79,
+	0x94, 0x32, 0x9a, 0xb6, 0x4e, 0xaf, 0x1c, 0x1e, 0xc2, 0x23, 0x9d, 0x2c, 0xb9, 0x1a, 0xf7, 0x61,
+	0xd3, 0x0b, 0x84, 0xb0, 0x52, 0xf8, 0xa3, 0x84, 0xc6, 0x62, 0x71, 0x5c, 0x89, 0x1d, 0xc1, 0xff,
+	0xc7, 0x68, 0xfe, 0xa7, 0x84, 0x4e, 0xb4, 0xa2, 0x39, 0xae, 0xed, 0x23, 0x56, 0xd6, 0x7c, 0x18,
+	0xf9, 0x6e, 0xa7, 0x88, 0xf6, 0x9c, 0xf7, 0x37, 0xb0, 0x6e, 0xe9, 0x7f, 0x61, 0xdd, 0x8f, 0xee,
+	0x6b, 0xdd, 0xf1, 0x9d, 0xed, 0x43, 0x0b, 0x67, 0xcf, 0xe4, 0xfd, 0xfb, 0x44, 0xd4, 0x05, 0x8b,
+	0xca, 0x93, 0xee, 0x66, 0x15, 0x8a, 0x2a, 0xa6, 0x72, 0x42, 0x63, 0x63, 0x59, 0x45, 0xe9, 0xb0,
+	0x82, 0x14, 0x22, 0x95, 0x4e, 0x91, 0xe7, 0xc5, 0x7a, 0x87, 0xb8, 0x88, 0x4e, 0xbe, 0xde, 0xd6,
+	0x70, 0xf1, 0xd6, 0xb7, 0xb4, 0x77, 0x15, 0xfc, 0xf0, 0xfa, 0xae, 0xc3, 0xd6, 0x80, 0xbf, 0xec,
+	0x41, 0x03, 0x42, 0xb7, 0x25, 0x56, 0x71, 0x43, 0x49, 0xdf, 0x4d, 0x5f, 0x29, 0xc5, 0xce, 0xee,
+	0xd5, 0xc7, 0xd2, 0x1d, 0xfd, 0x93, 0x94, 0x48, 0x4b, 0x51, 0x3f, 0xcb, 0x28, 0x21, 0x29, 0xf4,
+	0xad, 0xb8, 0x2e, 0xd1, 0x19, 0x9b, 0x83, 0xf4, 0xd4, 0x69, 0x4a, 0x46, 0x17, 0xa0, 0x89, 0x4d,
+	0x8b, 0xee, 0x2d, 0xf4, 0xe8, 0x37, 0x77, 0xf1, 0x28, 0xd7, 0xba, 0x24, 0x3a, 0xc2, 0x07, 0x72,
+	0x67, 0x24, 0x4a, 0x3e, 0x8b, 0x8e, 0x88, 0xc6, 0x44, 0x0f, 0xb7, 0x97, 0xbf, 0x4b, 0xee, 0x11,
+	0x17, 0xda, 0x90, 0x20, 0x09, 0x01, 0x3e, 0x7b, 0x19, 0x6d, 0x42, 0x29, 0x94, 0x8c, 0x5e, 0x46,
+	0x17, 0x35, 0x80, 0xc8, 0x1e, 0x4a, 0x35, 0x30, 0xec, 0x95, 0x19, 0xf6, 0xe5, 0x53, 0x7b, 0x1b,
+	0x75, 0x81, 0x23, 0x3f, 0x88, 0x4d, 0xa1, 0x20, 0x5a, 0xd8, 0x1b, 0xd6, 0xba, 0xe1, 0x98, 0xd0,
+	0x40, 0x98, 0xa2, 0x5a, 0xe9, 0xdc, 0x8b, 0x25, 0xf6, 0x66, 0xad, 0x45, 0x88, 0xb9, 0xef, 0xa3,
+	0x81, 0x36, 0x87, 0x1e, 0x24, 0xa4, 0x72, 0xb3, 0xa8, 0x3f, 0xae, 0xf8, 0x57, 0xd1, 0x26, 0xe2,
+	0xe1, 0xf8, 0x49, 0x2f, 0x1a, 0x89, 0x92, 0x0f, 0x74, 0xaa, 0x26, 0x75, 0x28, 0xf5, 0x06, 0x7d,
+	0xaa, 0xa1, 0x0f, 0x0c, 0x14, 0xc4, 0xdf, 0x59, 0xbe, 0x3a, 0x3e, 0xbb, 0x59, 0x50, 0x65, 0x22,
+	0xaa, 0x79, 0x22, 0xe7, 0x50, 0x9a, 0xff, 0x9d, 0xe0, 0xd6, 0xc3, 0x77, 0xc6, 0x70, 0x2e, 0xbf,
+	0x88, 0x8e, 0xd6, 0x0d, 0x9f, 0xe8, 0xa2, 0x1d, 0xf5, 0xb0, 0x89, 0xed, 0xf5, 0xfd, 0xbe, 0xe9,
+	0x70, 0x59, 0xc3, 0x94, 0x01, 0xdf, 0x3c, 0x4d, 0x90, 0x83, 0xd0, 0xe7, 0x50, 0x26, 0xc6, 0x98,
+	0x55, 0xd0, 0x99, 0xf2, 0x89, 0x3d, 0xb7, 0x5e, 0x43, 0x2d, 0x4e, 0x91, 0x62, 0x41, 0x93, 0xf5,
+	0x9c, 0x71, 0xc5, 0x7a, 0x0e, 0xa2, 0xd8, 0x32, 0xa3, 0x8f, 0x29, 0xf6, 0x38, 0xea, 0x17, 0x3c,
+	0x4d, 0x37, 0x80, 0xee, 0xb5, 0x97, 0x3d, 0x28, 0x66, 0x38, 0xac, 0x42, 0x41, 0xf2, 0x65, 0x34,
+	0xca, 0x64, 0x47, 0x1d, 0x6f, 0x5c, 0x7a, 0x6a, 0x9f, 0xd2, 0x47, 0x28, 0x8b, 0xb0, 0x07, 0x8e,
+	0xc9, 0x7f, 0x12, 0x65, 0x23, 0xbe, 0x5c, 0x83, 0x34, 0xd3, 0x60, 0x20, 0x84, 0x72, 0x1d, 0x74,
+	0x34, 0xe4, 0xc1, 0xc0, 0xd2, 0x21, 0xa8, 0x9a, 0x2c, 0xab, 0xf0, 0x57, 0x9b, 0x4c, 0xf9, 0xd9,
+	0x5d, 0x9c, 0xd8, 0x11, 0x3b, 0x25, 0x8d, 0x92, 0x5f, 0x02, 0x6a, 0xa6, 0x99, 0x96, 0xf5, 0xda,
+	0xe6, 0xb9, 0x7f, 0x49, 0x28, 0xdb, 0x8e, 0x22, 0x9f, 0x46, 0xc9, 0x86, 0xb8, 0x2b, 0x32, 0xe5,
+	0xd1, 0x1d, 0x16, 0x9e, 0x11, 0x0f, 0xbc, 0x2c, 0x07, 0x7e, 0x10, 0xe6, 0xc0, 0x77, 0xa8, 0xb1,
+	0x94, 0x8e, 0x91, 0x1b, 0x9b, 0x22, 0xf9, 0x1d, 0x90, 0xdc, 0xd8, 0x84, 0x58, 0xef, 0x6d, 0x60,
+	0xcb, 0x36, 0x1c, 0x11, 0x79, 0x07, 0xe2, 0x20, 0x48, 0xe9, 0x29, 0xe3, 0x4e, 0x65, 0x2d, 0x9b,
+	0xc6, 0x27, 0xea, 0xef, 0xa4, 0x5b, 0x9f, 0xe5, 0xa5, 0xdb, 0xf0, 0x7d, 0xfc, 0x59, 0xbe, 0xeb,
+	0x53, 0xf8, 0xee, 0xc1, 0xf7, 0x05, 0x7c, 0x5f, 0x02, 0xec, 0xb5, 0xbb, 0x79, 0xe9, 0x8d, 0xbb,
+	0xf9, 0xae, 0xf7, 0xe1, 0xf7, 0x06, 0xfc, 0xde, 0x84, 0xef, 0x43, 0xf8, 0x6e, 0xc1, 0xfc, 0x36,
+	0x7c, 0x1f, 0xc3, 0xf8, 0x53, 0xf8, 0xbd, 0x07, 0xbf, 0x5f, 0xc0, 0xef, 0x97, 0xf0, 0xfb, 0xda,
+	0xe7, 0xf9, 0xae, 0x37, 0x3e, 0xcf, 0x4b, 0x6f, 0xc1, 0xef, 0x3b, 0xf0, 0xfb, 0x2e, 0xfc, 0xbe,
+	0x0f, 0xdf, 0x0d, 0x18, 0xdf, 0x84, 0xef, 0x43, 0xf8, 0x5e, 0x9a, 0xde, 0x6f, 0x07, 0x4d, 0x9c,
+	0xe6, 0xca, 0x4a, 0x2f, 0xb3, 0xf3, 0xe4, 0x7f, 0x01, 0x24, 0x23, 0x20, 0x53, 0x43, 0x1c, 0x00,
+	0x00,
 }
 
 func (this *GatewayBrand) Equal(that interface{}) bool {
@@ -2138,6 +2146,9 @@ func (this *Gateway) Equal(that interface{}) bool {
 	if this.DownlinkPathConstraint != that1.DownlinkPathConstraint {
 		return false
 	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
 	return true
 }
 func (this *Gateways) Equal(that interface{}) bool {
@@ -3037,6 +3048,18 @@ func (m *Gateway) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	if m.DownlinkPathConstraint != 0 {
 		i = encodeVarintGateway(dAtA, i, uint64(m.DownlinkPathConstraint))
 		i--
@@ -4237,6 +4260,7 @@ func NewPopulatedGateway(r randyGateway, easy bool) *Gateway {
 	this.ScheduleDownlinkLate = bool(r.Intn(2) == 0)
 	this.EnforceDutyCycle = bool(r.Intn(2) == 0)
 	this.DownlinkPathConstraint = DownlinkPathConstraint([]int32{0, 1, 2}[r.Intn(3)])
+	this.Suspended = bool(r.Intn(2) == 0)
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -4786,6 +4810,9 @@ func (m *Gateway) Size() (n int) {
 	if m.DownlinkPathConstraint != 0 {
 		n += 2 + sovGateway(uint64(m.DownlinkPathConstraint))
 	}
+	if m.Suspended {
+		n += 3
+	}
 	return n
 }
 
@@ -5248,6 +5275,7 @@ func (this *Gateway) String() string {
 		`ScheduleDownlinkLate:` + fmt.Sprintf("%v", this.ScheduleDownlinkLate) + `,`,
 		`EnforceDutyCycle:` + fmt.Sprintf("%v", this.EnforceDutyCycle) + `,`,
 		`DownlinkPathConstraint:` + fmt.Sprintf("%v", this.DownlinkPathConstraint) + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -7104,6 +7132,26 @@ func (m *Gateway) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGateway
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGateway(dAtA[iNdEx:])
@@ -92,6 +92,7 @@ var GatewayFieldPathsNested = []string{
 	"name",
 	"schedule_downlink_late",
 	"status_public",
+	"suspended",
 	"update_channel",
 	"updated_at",
 	"version_ids",
@@ -117,6 +118,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"name",
 	"schedule_downlink_late",
 	"status_public",
+	"suspended",
 	"update_channel",
 	"updated_at",
 	"version_ids",
@@ -193,6 +195,7 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.name",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.suspended",
 	"gateway.update_channel",
 	"gateway.updated_at",
 	"gateway.version_ids",
@@ -226,6 +229,7 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.name",
 	"gateway.schedule_downlink_late",
 	"gateway.status_public",
+	"gateway.suspended",
 	"gateway.update_channel",
 	"gateway.updated_at",
 	"gateway.version_ids",
@@ -477,6 +477,16 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 				var zero DownlinkPathConstraint
 				dst.DownlinkPathConstraint = zero
 			}
+		case "suspended":
+			if len(subs) > 0 {
+				return fmt.Errorf("'suspended' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Suspended = src.Suspended
+			} else {
+				var zero bool
+				dst.Suspended = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
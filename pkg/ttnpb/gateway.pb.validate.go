@@ -748,6 +748,8 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "suspended":
+			// no validation rules for Suspended
 		default:
 			return GatewayValidationError{
 				field:  name,
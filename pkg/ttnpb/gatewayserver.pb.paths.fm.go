@@ -98,6 +98,10 @@ var GatewayDownFieldPathsNested = []string{
 	"downlink_message.settings.scheduled.data_rate.modulation.lora",
 	"downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
 	"downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"downlink_message.settings.scheduled.data_rate_index",
 	"downlink_message.settings.scheduled.downlink",
 	"downlink_message.settings.scheduled.downlink.antenna_index",
@@ -97,6 +97,8 @@ func init() {
 	defineEnum(PayloadFormatter_FORMATTER_GRPC_SERVICE, "gRPC service")
 	defineEnum(PayloadFormatter_FORMATTER_JAVASCRIPT, "JavaScript")
 	defineEnum(PayloadFormatter_FORMATTER_CAYENNELPP, "Cayenne LPP")
+	defineEnum(PayloadFormatter_FORMATTER_CBOR, "CBOR")
+	defineEnum(PayloadFormatter_FORMATTER_SENML, "SenML")
 
 	defineEnum(RIGHT_USER_INFO, "view user information")
 	defineEnum(RIGHT_USER_SETTINGS_BASIC, "edit basic user settings")
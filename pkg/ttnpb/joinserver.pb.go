@@ -856,122 +856,120 @@ func init() {
 }
 
 var fileDescriptor_1b695d5f526759a7 = []byte{
-	// 1829 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x41, 0x6c, 0x1b, 0xc7,
-	0x15, 0xdd, 0x21, 0x45, 0x4a, 0x1a, 0x49, 0x94, 0x3c, 0x76, 0x13, 0x96, 0x76, 0x96, 0x0e, 0xa3,
-	0xb6, 0xae, 0x63, 0x91, 0x01, 0xd3, 0x06, 0xa9, 0x82, 0xda, 0x20, 0x45, 0x56, 0xa2, 0x65, 0xa9,
-	0xea, 0xb2, 0x69, 0x53, 0x25, 0x0a, 0xb3, 0x22, 0x47, 0xf4, 0x9a, 0xd4, 0xec, 0x76, 0x67, 0x44,
-	0x85, 0x49, 0x0d, 0x18, 0x3e, 0x04, 0x6e, 0xd1, 0x43, 0x81, 0x36, 0x40, 0x8f, 0x45, 0x7b, 0x68,
-	0x0e, 0x3d, 0x04, 0xbd, 0x34, 0xa7, 0x22, 0x87, 0x1e, 0xdc, 0x9b, 0x8b, 0x5e, 0x82, 0x1e, 0xd4,
-	0x68, 0xd9, 0x43, 0x8e, 0x39, 0x15, 0x81, 0x4e, 0xc5, 0xcc, 0x0e, 0xc9, 0xe5, 0x92, 0x92, 0x45,
-	0x45, 0x32, 0xd0, 0xdb, 0xcc, 0xce, 0xff, 0x6f, 0xfe, 0x7f, 0xff, 0xff, 0xd9, 0xff, 0x61, 0xa2,
-	0x6e, 0xda, 0xfa, 0xae, 0x4e, 0xe6, 0x28, 0xd3, 0xcb, 0xb5, 0x94, 0x6e, 0x19, 0xa9, 0x3b, 0xa6,
-	0x41, 0x28, 0xb6, 0x1b, 0xd8, 0x4e, 0x5a, 0xb6, 0xc9, 0x4c, 0x14, 0x61, 0x8c, 0x24, 0xa5, 0x5c,
-	0xb2, 0xf1, 0x62, 0x2c, 0x53, 0x35, 0xd8, 0xed, 0x9d, 0xcd, 0x64, 0xd9, 0xdc, 0x4e, 0x61, 0xd2,
-	0x30, 0x9b, 0x96, 0x6d, 0xbe, 0xdd, 0x4c, 0x09, 0xe1, 0xf2, 0x5c, 0x15, 0x93, 0xb9, 0x86, 0x5e,
-	0x37, 0x2a, 0x3a, 0xc3, 0xa9, 0xbe, 0x85, 0x0b, 0x19, 0x9b, 0xf3, 0x40, 0x54, 0xcd, 0xaa, 0xe9,
-	0x2a, 0x6f, 0xee, 0x6c, 0x89, 0x9d, 0xd8, 0x88, 0x95, 0x14, 0xbf, 0x54, 0x35, 0xcd, 0x6a, 0x1d,
-	0x0b, 0xf3, 0x74, 0x42, 0x4c, 0xa6, 0x33, 0xc3, 0x24, 0x54, 0x9e, 0x5e, 0x94, 0xa7, 0x1d, 0x0c,
-	0xbc, 0x6d, 0xb1, 0xa6, 0x4f, 0xb5, 0x73, 0x48, 0x99, 0xbd, 0x53, 0x66, 0xf2, 0x74, 0x80, 0xfb,
-	0x98, 0x54, 0x4a, 0x15, 0xdc, 0x30, 0xca, 0x6d, 0x5b, 0x9f, 0xeb, 0x97, 0x31, 0x2a, 0x98, 0x30,
-	0x63, 0xcb, 0xc0, 0x76, 0xdb, 0x86, 0x4b, 0x83, 0x79, 0x3c, 0xfc, 0xb4, 0x86, 0x9b, 0x6d, 0xdd,
-	0x78, 0xff, 0x69, 0x9b, 0x6d, 0x21, 0x90, 0xf8, 0x4d, 0x00, 0x9e, 0x2b, 0x62, 0x4a, 0x0d, 0x93,
-	0x2c, 0xe3, 0xa6, 0x86, 0x7f, 0xba, 0x83, 0x29, 0x43, 0xd7, 0x61, 0x84, 0xba, 0x1f, 0x4b, 0x35,
-	0xdc, 0x2c, 0x19, 0x95, 0x28, 0xb8, 0x0c, 0xae, 0x4c, 0x66, 0xa3, 0x07, 0xd9, 0xd0, 0x3b, 0xc1,
-	0xe8, 0xbd, 0x19, 0x67, 0x2f, 0x3e, 0xd9, 0x55, 0x2b, 0xe4, 0xb4, 0x49, 0xda, 0xdd, 0x55, 0xd0,
-	0x06, 0x1c, 0xad, 0xe0, 0x46, 0x09, 0xef, 0x18, 0xd1, 0x80, 0x50, 0xcc, 0x3d, 0xdc, 0x8b, 0x2b,
-	0xff, 0xda, 0x8b, 0xa7, 0xab, 0x66, 0x92, 0xdd, 0xc6, 0xec, 0xb6, 0x41, 0xaa, 0x34, 0x49, 0x30,
-	0xdb, 0x35, 0xed, 0x5a, 0xaa, 0xd7, 0x48, 0xab, 0x56, 0x4d, 0xb1, 0xa6, 0x85, 0x69, 0x32, 0xff,
-	0x6a, 0xe1, 0xa5, 0x6f, 0x39, 0x7b, 0xf1, 0x70, 0x0e, 0x37, 0xf2, 0xaf, 0x16, 0xb4, 0x70, 0x05,
-	0x37, 0xf2, 0x3b, 0x06, 0x7a, 0x0b, 0x8e, 0x71, 0x06, 0x04, 0x7e, 0x50, 0xe0, 0xe7, 0xbf, 0x14,
-	0xfe, 0xe8, 0x4d, 0xd3, 0x20, 0xfc, 0x82, 0x51, 0x0e, 0x9b, 0xdf, 0x31, 0x12, 0xf7, 0x03, 0x70,
-	0x66, 0x75, 0xb7, 0x56, 0x5c, 0xc6, 0x4d, 0xaa, 0x61, 0x6a, 0x99, 0x84, 0x62, 0xf4, 0x7d, 0x38,
-	0xbd, 0x55, 0x22, 0xbb, 0xb5, 0x12, 0x2d, 0x19, 0x84, 0x71, 0x66, 0x04, 0x2d, 0x13, 0xe9, 0x8b,
-	0xc9, 0xde, 0x34, 0x4e, 0x2e, 0xe3, 0x66, 0x9e, 0x34, 0x70, 0xdd, 0xb4, 0x70, 0x76, 0xf2, 0x20,
-	0x1b, 0xfa, 0x05, 0x08, 0xcc, 0x00, 0x6e, 0xa2, 0x36, 0xb1, 0xc5, 0x61, 0x0b, 0x84, 0x2d, 0xe3,
-	0x26, 0x07, 0xa4, 0x3e, 0xc0, 0xc0, 0xd0, 0x80, 0xd4, 0x03, 0x78, 0x0b, 0x4e, 0xb9, 0x70, 0x98,
-	0x94, 0x05, 0x5c, 0x70, 0x58, 0x38, 0x48, 0x76, 0x6b, 0xc5, 0x3c, 0x29, 0x2f, 0xe3, 0x66, 0xe2,
-	0x35, 0x38, 0x9d, 0xb1, 0xac, 0xa2, 0xc8, 0x0b, 0x49, 0x41, 0x1e, 0x8e, 0xeb, 0x96, 0x55, 0xa2,
-	0x27, 0x73, 0x7e, 0x54, 0x77, 0xe1, 0x12, 0xbf, 0x0c, 0xc2, 0x8b, 0x0b, 0x76, 0xd3, 0x62, 0x66,
-	0x11, 0xdb, 0xbc, 0x1e, 0xd6, 0xf4, 0x66, 0xdd, 0xd4, 0x2b, 0xed, 0xfc, 0x5b, 0x82, 0x41, 0xa3,
-	0x42, 0xe5, 0x05, 0xb3, 0xfe, 0x0b, 0xf2, 0xa4, 0x92, 0x13, 0x55, 0x54, 0xe8, 0xd6, 0x4a, 0x76,
-	0xc6, 0x7b, 0xd3, 0xa3, 0xbd, 0x38, 0xd0, 0x38, 0x04, 0x2a, 0xc1, 0x69, 0xa9, 0x59, 0x6a, 0x60,
-	0x9b, 0x67, 0xa8, 0xa0, 0x38, 0x92, 0x8e, 0xf9, 0x51, 0x57, 0x32, 0x0b, 0x3f, 0x72, 0x25, 0xb2,
-	0xb1, 0x83, 0x6c, 0xe8, 0x3e, 0xc7, 0x72, 0xf6, 0xe2, 0x91, 0x5b, 0xa6, 0xa6, 0xff, 0x38, 0xb3,
-	0x2a, 0xcf, 0xb4, 0x88, 0x54, 0x91, 0x7b, 0x14, 0x85, 0xa3, 0x96, 0x6b, 0xbc, 0x9b, 0x8a, 0x5a,
-	0x7b, 0x8b, 0x36, 0x61, 0xc4, 0xb2, 0xcd, 0x86, 0xc1, 0xc5, 0xb0, 0xcd, 0x8b, 0x68, 0xe4, 0x32,
-	0xb8, 0x32, 0x9e, 0x7d, 0xe5, 0x20, 0xfb, 0x0d, 0xfb, 0x6b, 0xd1, 0xd9, 0xf4, 0xb3, 0x6f, 0xbe,
-	0xae, 0xcf, 0xbd, 0xf3, 0xc2, 0xdc, 0x77, 0x36, 0xae, 0xdc, 0x98, 0x7f, 0x7d, 0x6e, 0xe3, 0x46,
-	0x7b, 0xfb, 0xcd, 0x77, 0xd3, 0xd7, 0xee, 0xce, 0xfe, 0xec, 0xcd, 0x59, 0x67, 0x2f, 0x3e, 0xb5,
-	0xd6, 0xc5, 0x28, 0xe4, 0xb4, 0x29, 0x0f, 0x64, 0xa1, 0x82, 0x72, 0xf0, 0x5c, 0xe7, 0x83, 0x41,
-	0xaa, 0xa5, 0x8a, 0xce, 0xf4, 0x68, 0x48, 0xd0, 0xf6, 0x74, 0xd2, 0x7d, 0x9e, 0x92, 0xed, 0xe7,
-	0x29, 0x59, 0x14, 0xcf, 0x93, 0x36, 0xe3, 0xd5, 0xc8, 0xe9, 0x4c, 0x4f, 0xbc, 0x0c, 0x2f, 0x0d,
-	0x8e, 0x86, 0x8c, 0xba, 0xc7, 0x47, 0xd0, 0xe3, 0x63, 0xe2, 0x4f, 0x01, 0x78, 0x81, 0x17, 0x4f,
-	0xa6, 0x5c, 0xc6, 0x16, 0x5b, 0x29, 0x2c, 0xb4, 0x23, 0xb8, 0x05, 0xa7, 0xa5, 0x4c, 0xc9, 0x76,
-	0x3f, 0xc9, 0x68, 0x3e, 0xef, 0xe7, 0xfd, 0x88, 0x3c, 0x18, 0x10, 0xd4, 0x88, 0xd5, 0x9b, 0x29,
-	0x6b, 0xf0, 0x9c, 0x78, 0x0a, 0xe4, 0x25, 0x25, 0x5e, 0xd8, 0x87, 0x45, 0x58, 0xc3, 0x5c, 0xf4,
-	0x87, 0x4d, 0x0b, 0x67, 0xc7, 0xda, 0x11, 0xd6, 0xa6, 0xf9, 0x37, 0x89, 0xc6, 0x8f, 0xd0, 0x3a,
-	0x1c, 0xe7, 0x6f, 0x17, 0x31, 0x49, 0x19, 0xcb, 0xd7, 0xe5, 0xbb, 0xf2, 0x75, 0xf9, 0xf6, 0x50,
-	0xaf, 0x4b, 0x0e, 0x37, 0x56, 0x39, 0x88, 0x36, 0x56, 0x91, 0xab, 0xc4, 0x7b, 0x21, 0x18, 0xcd,
-	0x61, 0xdb, 0x68, 0xe0, 0xee, 0xe3, 0x49, 0xff, 0x0f, 0x93, 0x7e, 0x03, 0x42, 0xc1, 0xba, 0x97,
-	0xa4, 0xeb, 0x92, 0xa4, 0x97, 0x86, 0x22, 0x89, 0x27, 0x8f, 0xcb, 0xd2, 0xf8, 0x9d, 0xf6, 0xb2,
-	0x37, 0x04, 0x23, 0xa7, 0x1a, 0x02, 0xb4, 0x0e, 0xc3, 0x04, 0x33, 0x5e, 0x8d, 0x21, 0x01, 0xbc,
-	0x70, 0xa2, 0x3f, 0xc7, 0x2a, 0x66, 0x85, 0x9c, 0xb3, 0x17, 0x0f, 0x89, 0x85, 0x16, 0x22, 0x98,
-	0x15, 0x06, 0x55, 0x7c, 0xf8, 0xc9, 0x54, 0xfc, 0xe8, 0xb0, 0x15, 0xff, 0x20, 0x00, 0xd1, 0x22,
-	0x66, 0x9a, 0x69, 0xb2, 0xb3, 0x49, 0xc1, 0x7e, 0x2a, 0x02, 0x4f, 0x86, 0x8a, 0xe0, 0xb0, 0x54,
-	0xfc, 0x7d, 0x0c, 0xc6, 0x3a, 0xd7, 0x74, 0x5c, 0xec, 0x50, 0xf2, 0x13, 0x38, 0xad, 0x5b, 0x56,
-	0xdd, 0x28, 0x8b, 0xbe, 0xb0, 0xd4, 0xa5, 0xe7, 0xeb, 0x7e, 0x7a, 0x32, 0x5d, 0x31, 0x2f, 0x41,
-	0x63, 0xdd, 0xb7, 0x4b, 0xf7, 0x4a, 0xf0, 0x32, 0x1d, 0xcc, 0xd1, 0xcb, 0x07, 0xd9, 0x59, 0x3b,
-	0x11, 0x9d, 0x4d, 0xab, 0x47, 0x73, 0xf4, 0x58, 0x82, 0x9e, 0x3f, 0x8c, 0xa0, 0xc9, 0x7e, 0x1e,
-	0xd0, 0x1a, 0x1c, 0xa9, 0x1b, 0x94, 0x89, 0x7a, 0x9b, 0x48, 0xcf, 0xfb, 0xbd, 0x3b, 0x9c, 0xa2,
-	0xa4, 0xc7, 0xdb, 0x5b, 0x06, 0x65, 0x4b, 0x8a, 0x26, 0x90, 0x50, 0x11, 0x86, 0x6c, 0x9d, 0x54,
-	0xb1, 0xfc, 0x21, 0xbd, 0x72, 0x32, 0x48, 0x8d, 0x43, 0x2c, 0x29, 0x9a, 0x8b, 0x85, 0x36, 0xe0,
-	0xf8, 0x96, 0x6d, 0x6e, 0xbb, 0xbe, 0x84, 0x05, 0xf0, 0xf5, 0x93, 0x01, 0x7f, 0xcf, 0x36, 0xb7,
-	0xb9, 0xe7, 0x4b, 0x8a, 0x36, 0xb6, 0x25, 0xd7, 0xb1, 0x7f, 0x00, 0x38, 0xed, 0xf3, 0x07, 0xbd,
-	0xe1, 0x69, 0x37, 0xdd, 0x3e, 0x38, 0x73, 0x7a, 0xad, 0x26, 0x7a, 0x0b, 0x46, 0xba, 0x73, 0x81,
-	0xc8, 0xaf, 0xc0, 0xe5, 0xe0, 0xb1, 0xcb, 0xef, 0x02, 0xcf, 0x2e, 0xde, 0x8d, 0x77, 0x4f, 0x73,
-	0x54, 0x9b, 0xc4, 0x5d, 0x59, 0x1a, 0xfb, 0x37, 0x80, 0x33, 0x7e, 0x42, 0xcf, 0xd8, 0xa9, 0x6d,
-	0x38, 0x45, 0x99, 0x6e, 0xb3, 0x52, 0xef, 0x18, 0x50, 0xf8, 0x52, 0x6d, 0xfa, 0x44, 0x91, 0x43,
-	0xca, 0x59, 0x60, 0x82, 0xb6, 0x37, 0x3b, 0x46, 0x8c, 0xc2, 0xf3, 0x03, 0x02, 0x7b, 0xb6, 0x3e,
-	0xce, 0x07, 0xa2, 0x20, 0x3b, 0x05, 0x27, 0xba, 0xc1, 0xa3, 0x89, 0x9f, 0x03, 0x38, 0x25, 0xe5,
-	0xd6, 0x6c, 0xbc, 0x65, 0xbc, 0xdd, 0x33, 0xaa, 0x80, 0xb3, 0x18, 0x55, 0xd0, 0x53, 0x30, 0x5c,
-	0xc7, 0xa4, 0xca, 0x6e, 0x0b, 0x8e, 0xa7, 0x34, 0xb9, 0x4b, 0x68, 0x70, 0xba, 0xc7, 0x14, 0x4c,
-	0xd1, 0x0d, 0x38, 0x66, 0xc9, 0x75, 0x14, 0x88, 0x24, 0x7b, 0xc6, 0x9f, 0x64, 0x3d, 0x2a, 0xd9,
-	0x11, 0xd1, 0xb6, 0x77, 0x94, 0xd2, 0x7f, 0x00, 0x70, 0x64, 0x95, 0xde, 0xa4, 0x68, 0x11, 0xc2,
-	0x25, 0x9d, 0x54, 0xea, 0x98, 0xcb, 0xa3, 0x8b, 0x83, 0x50, 0x64, 0xc5, 0xc5, 0x2e, 0x0d, 0x3e,
-	0x94, 0xad, 0xa5, 0x06, 0x27, 0x16, 0x31, 0x6b, 0x8f, 0x5a, 0xe8, 0x59, 0xbf, 0x70, 0xdf, 0x6c,
-	0x1a, 0xbb, 0xec, 0x17, 0xf1, 0xcf, 0x69, 0xe9, 0xd7, 0xe0, 0x48, 0x86, 0x1b, 0xb9, 0x06, 0xe1,
-	0x22, 0x66, 0x72, 0x84, 0x39, 0x0e, 0x74, 0x7c, 0xc0, 0x93, 0xee, 0x1d, 0x7f, 0xd2, 0xff, 0x1d,
-	0x81, 0x17, 0x56, 0xdd, 0x48, 0xf5, 0xb4, 0xad, 0xa8, 0x06, 0x23, 0x1e, 0x9f, 0x57, 0x0a, 0x0b,
-	0x68, 0x98, 0x3e, 0x37, 0x76, 0xed, 0x78, 0xc2, 0x92, 0xb3, 0xb2, 0x9b, 0x64, 0x9d, 0x9e, 0x1b,
-	0xcd, 0x0e, 0xa2, 0xd8, 0xdf, 0x92, 0x0f, 0x79, 0x09, 0x81, 0xe7, 0xf2, 0xa4, 0xcc, 0x25, 0xba,
-	0x60, 0x67, 0xe9, 0x94, 0x05, 0xcf, 0xcb, 0xfb, 0xdc, 0x36, 0xfd, 0xec, 0x6f, 0x7c, 0x03, 0x46,
-	0xdc, 0x5e, 0xbc, 0x93, 0x7d, 0x57, 0xfc, 0xfa, 0x87, 0xf5, 0xea, 0x8f, 0x4f, 0x42, 0x74, 0x0b,
-	0x8e, 0xbb, 0x89, 0xcd, 0x73, 0x2f, 0xe1, 0x17, 0xef, 0xef, 0xbd, 0x62, 0x47, 0xcd, 0xd1, 0xe9,
-	0xbf, 0x01, 0x18, 0xf5, 0xf4, 0x17, 0xbd, 0xc9, 0xb7, 0x0e, 0xa7, 0x5c, 0x43, 0xdb, 0xa9, 0x7e,
-	0x7c, 0x3f, 0x1e, 0x97, 0xf1, 0xd2, 0x8d, 0x8c, 0x65, 0x9d, 0x8a, 0x1b, 0xef, 0x87, 0xe1, 0xf9,
-	0x9b, 0xb4, 0xf3, 0xab, 0xd2, 0x70, 0xd5, 0xa0, 0xcc, 0x6e, 0xa2, 0x3f, 0x03, 0x18, 0x5c, 0xc4,
-	0x0c, 0x3d, 0x37, 0xe0, 0x02, 0x8f, 0xb4, 0x7b, 0xc3, 0x57, 0x0f, 0xfd, 0x31, 0x26, 0x6a, 0xf7,
-	0xff, 0xf9, 0x9f, 0x5f, 0x07, 0x30, 0x2a, 0xa7, 0xee, 0xd0, 0x94, 0xa7, 0xdb, 0xa2, 0xa9, 0x77,
-	0x7b, 0xff, 0xb1, 0x49, 0x5f, 0x4f, 0xe7, 0xdb, 0xdf, 0x4d, 0xc9, 0x17, 0xbd, 0x4f, 0xaf, 0xb3,
-	0xbc, 0x8b, 0xde, 0x0b, 0xc0, 0x60, 0x71, 0x90, 0xd1, 0xc5, 0xe1, 0x8c, 0xfe, 0x2b, 0x10, 0x56,
-	0xff, 0x05, 0xc4, 0x8e, 0x34, 0x3b, 0x79, 0x42, 0xb3, 0x93, 0xbd, 0x66, 0xcf, 0x83, 0xab, 0xeb,
-	0x2b, 0x89, 0xa5, 0xd3, 0xba, 0x69, 0x1e, 0x5c, 0x45, 0x7f, 0x04, 0x70, 0xbc, 0xd3, 0x72, 0xa1,
-	0xab, 0xc7, 0xef, 0xc6, 0x8e, 0x62, 0xe5, 0x07, 0x82, 0x94, 0xa5, 0xd8, 0x42, 0xbf, 0xa5, 0x8f,
-	0x33, 0xad, 0xd3, 0xda, 0xce, 0x75, 0x8d, 0x7c, 0x10, 0x00, 0x2f, 0x00, 0xf4, 0x3e, 0x80, 0xe1,
-	0x1c, 0xae, 0x63, 0x86, 0xd1, 0xb1, 0xda, 0xab, 0xd8, 0x53, 0x7d, 0x73, 0x44, 0x7e, 0xdb, 0x62,
-	0xcd, 0xc4, 0x8a, 0xb0, 0x6e, 0xf1, 0x6a, 0x7e, 0x78, 0xeb, 0x3a, 0x21, 0xea, 0xc6, 0x24, 0x6d,
-	0xc3, 0xc0, 0x4d, 0x8a, 0xea, 0x62, 0x26, 0xf3, 0xff, 0xb4, 0x0f, 0x31, 0xa1, 0xbf, 0x74, 0x7d,
-	0x8a, 0x89, 0x67, 0x84, 0x8d, 0x4f, 0xa3, 0xaf, 0x70, 0x1b, 0xdb, 0x4d, 0x48, 0xa9, 0xfd, 0x2f,
-	0xcf, 0xfe, 0x1e, 0x3c, 0xdc, 0x57, 0xc1, 0xa3, 0x7d, 0x15, 0x7c, 0xb2, 0xaf, 0x2a, 0x9f, 0xee,
-	0xab, 0xca, 0x67, 0xfb, 0xaa, 0xf2, 0xf9, 0xbe, 0xaa, 0x7c, 0xb1, 0xaf, 0x82, 0x7b, 0x8e, 0x0a,
-	0x1e, 0x38, 0xaa, 0xf2, 0x81, 0xa3, 0x82, 0x0f, 0x1d, 0x55, 0xf9, 0xc8, 0x51, 0x95, 0x8f, 0x1d,
-	0x55, 0x79, 0xe8, 0xa8, 0xe0, 0x91, 0xa3, 0x82, 0x4f, 0x1c, 0x55, 0xf9, 0xd4, 0x51, 0xc1, 0x67,
-	0x8e, 0xaa, 0x7c, 0xee, 0xa8, 0xe0, 0x0b, 0x47, 0x55, 0xee, 0xb5, 0x54, 0xe5, 0x41, 0x4b, 0x05,
-	0xbf, 0x6a, 0xa9, 0xca, 0x6f, 0x5b, 0x2a, 0xf8, 0x5d, 0x4b, 0x55, 0x3e, 0x68, 0xa9, 0xca, 0x87,
-	0x2d, 0x15, 0x7c, 0xd4, 0x52, 0xc1, 0xc7, 0x2d, 0x15, 0xac, 0x5f, 0x3b, 0x6e, 0xdb, 0xc3, 0x88,
-	0xb5, 0xb9, 0x19, 0x16, 0x4e, 0xbf, 0xf8, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x0e, 0xb9,
-	0xea, 0x4c, 0x18, 0x00, 0x00,
+	// 1800 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x58, 0x4d, 0x6c, 0x13, 0x47,
+	0x14, 0xce, 0xda, 0x8e, 0x93, 0x4c, 0x12, 0x3b, 0x59, 0x28, 0xb8, 0x4e, 0x48, 0xc0, 0xa4, 0x2d,
+	0x05, 0x6c, 0x23, 0xd3, 0x22, 0x1a, 0x54, 0x90, 0x1d, 0xbb, 0x24, 0x29, 0x49, 0xd3, 0x75, 0x7f,
+	0x68, 0x20, 0x98, 0x8d, 0x3d, 0x71, 0x36, 0x76, 0x76, 0xb7, 0xbb, 0x1b, 0x07, 0x43, 0x91, 0x10,
+	0x07, 0x94, 0x56, 0x3d, 0x54, 0x6a, 0x91, 0x7a, 0xac, 0xda, 0x43, 0x39, 0xf4, 0x80, 0x7a, 0x29,
+	0xa7, 0x8a, 0x43, 0x0f, 0xf4, 0x46, 0xd5, 0x0b, 0xea, 0x21, 0xe5, 0xa7, 0x07, 0x8e, 0x9c, 0x2a,
+	0xc4, 0xa9, 0x6f, 0x66, 0xc7, 0xf6, 0x7a, 0xed, 0xfc, 0x38, 0x24, 0x48, 0x3d, 0x8c, 0x66, 0x66,
+	0xe7, 0xcd, 0x37, 0xef, 0x7d, 0xf3, 0xde, 0xcc, 0x9b, 0x45, 0x81, 0xbc, 0xa2, 0x89, 0x8b, 0xa2,
+	0x1c, 0xd4, 0x0d, 0x31, 0x9d, 0x0b, 0x8b, 0xaa, 0x14, 0x9e, 0x53, 0x24, 0x59, 0xc7, 0x5a, 0x01,
+	0x6b, 0x21, 0x55, 0x53, 0x0c, 0x85, 0xf7, 0x18, 0x86, 0x1c, 0x62, 0x72, 0xa1, 0xc2, 0x61, 0x7f,
+	0x34, 0x2b, 0x19, 0xb3, 0x0b, 0xd3, 0xa1, 0xb4, 0x32, 0x1f, 0xc6, 0x72, 0x41, 0x29, 0x82, 0xd8,
+	0x85, 0x62, 0x98, 0x0a, 0xa7, 0x83, 0x59, 0x2c, 0x07, 0x0b, 0x62, 0x5e, 0xca, 0x88, 0x06, 0x0e,
+	0xd7, 0x34, 0x4c, 0x48, 0x7f, 0xd0, 0x02, 0x91, 0x55, 0xb2, 0x8a, 0x39, 0x79, 0x7a, 0x61, 0x86,
+	0xf6, 0x68, 0x87, 0xb6, 0x98, 0x78, 0x6f, 0x56, 0x51, 0xb2, 0x79, 0x4c, 0xd5, 0x13, 0x65, 0x59,
+	0x31, 0x44, 0x43, 0x52, 0x64, 0x9d, 0x8d, 0xf6, 0xb0, 0xd1, 0x32, 0x06, 0x9e, 0x57, 0x8d, 0xa2,
+	0x6d, 0x6a, 0x79, 0x50, 0x37, 0xb4, 0x85, 0xb4, 0xc1, 0x46, 0xeb, 0x98, 0x8f, 0xe5, 0x4c, 0x2a,
+	0x83, 0x0b, 0x52, 0xba, 0xa4, 0xeb, 0xde, 0x5a, 0x19, 0x29, 0x83, 0x65, 0x43, 0x9a, 0x91, 0xb0,
+	0x56, 0xd2, 0xa1, 0xb7, 0x3e, 0x8f, 0x2b, 0x8f, 0xe6, 0x70, 0xb1, 0x34, 0xb7, 0xbf, 0x76, 0xb4,
+	0xc4, 0x36, 0x15, 0x08, 0x7c, 0xe3, 0x40, 0xdd, 0x49, 0xac, 0xeb, 0x60, 0xf3, 0xbb, 0xb8, 0x28,
+	0xe0, 0x4f, 0x17, 0xb0, 0x6e, 0xf0, 0xc7, 0x91, 0x47, 0x37, 0x3f, 0xa6, 0x00, 0x2c, 0x25, 0x65,
+	0x7c, 0xdc, 0x6e, 0x6e, 0x5f, 0x47, 0xcc, 0xf7, 0x2c, 0xd6, 0x7c, 0xd1, 0xe9, 0xbb, 0xd2, 0xf5,
+	0x70, 0xb9, 0xbf, 0xa3, 0x32, 0x6d, 0x24, 0x2e, 0x74, 0xe8, 0x95, 0x5e, 0x86, 0x9f, 0x42, 0x2d,
+	0x60, 0x67, 0x0a, 0x2f, 0x48, 0x3e, 0x07, 0x9d, 0x18, 0xbf, 0xb3, 0xdc, 0xdf, 0xf4, 0xd7, 0x72,
+	0x7f, 0x04, 0x78, 0x37, 0x66, 0xb1, 0x31, 0x2b, 0xc9, 0x59, 0x3d, 0x24, 0x63, 0x63, 0x51, 0xd1,
+	0x72, 0xe1, 0x6a, 0x25, 0xd5, 0x5c, 0x36, 0x6c, 0x14, 0x55, 0xac, 0x87, 0x12, 0x1f, 0x8e, 0x1c,
+	0x79, 0x03, 0x96, 0x72, 0xc7, 0x71, 0x01, 0xda, 0x82, 0x1b, 0x40, 0x13, 0x0b, 0x12, 0x7f, 0x1e,
+	0xb5, 0x12, 0x06, 0x28, 0xbe, 0x93, 0xe2, 0x27, 0x9e, 0x0b, 0xbf, 0x65, 0x14, 0xd0, 0xc8, 0x02,
+	0x2d, 0x04, 0x16, 0x56, 0x08, 0x5c, 0x75, 0xa0, 0xae, 0xf1, 0xc5, 0x5c, 0x12, 0xcc, 0xd1, 0x05,
+	0xac, 0xab, 0xe0, 0x11, 0x98, 0x7f, 0x0f, 0x79, 0x67, 0x52, 0xf2, 0x62, 0x2e, 0xa5, 0xa7, 0x24,
+	0xd9, 0x20, 0xcc, 0x50, 0x5a, 0xda, 0x23, 0x3d, 0xa1, 0x6a, 0x37, 0x0e, 0xc1, 0xb4, 0x84, 0x5c,
+	0xc0, 0x79, 0x45, 0xc5, 0xb1, 0x0e, 0xe0, 0xec, 0x0b, 0xce, 0xd1, 0xc5, 0x11, 0x15, 0x85, 0xf6,
+	0x19, 0x02, 0x3b, 0x22, 0x1b, 0x20, 0x42, 0x00, 0x75, 0x1b, 0xa0, 0xa3, 0x61, 0x40, 0xdd, 0x02,
+	0x78, 0x0a, 0x75, 0x9a, 0x70, 0x58, 0x4e, 0x53, 0x38, 0x67, 0xa3, 0x70, 0x08, 0xe6, 0x27, 0x13,
+	0x72, 0x1a, 0x24, 0x02, 0xa7, 0x91, 0x37, 0xaa, 0xaa, 0x49, 0xea, 0x17, 0x8c, 0x82, 0x04, 0x6a,
+	0x13, 0x55, 0x15, 0x16, 0xd8, 0x90, 0xf1, 0x2d, 0xa2, 0x09, 0x17, 0xf8, 0xd2, 0x89, 0x7a, 0x86,
+	0xb4, 0xa2, 0x6a, 0x28, 0x49, 0x38, 0x0d, 0x20, 0x1e, 0x26, 0xc4, 0x62, 0x5e, 0x11, 0x33, 0x25,
+	0xff, 0x1b, 0x46, 0x4e, 0x29, 0xa3, 0xb3, 0x05, 0x06, 0xec, 0x0b, 0x24, 0xe4, 0x4c, 0x9c, 0x46,
+	0xd1, 0x48, 0x25, 0x56, 0x62, 0x5d, 0xd6, 0x95, 0xee, 0x2e, 0xf7, 0x73, 0x02, 0x81, 0xe0, 0x53,
+	0xc8, 0xcb, 0x66, 0xa6, 0xe0, 0xd4, 0x21, 0x1e, 0x4a, 0x29, 0xf6, 0x44, 0xfc, 0x76, 0xd4, 0xb1,
+	0xe8, 0xd0, 0x47, 0xa6, 0x44, 0xcc, 0x0f, 0x58, 0x57, 0x09, 0x16, 0xf8, 0x86, 0xe7, 0x94, 0x22,
+	0x88, 0x1f, 0x47, 0xc7, 0xd9, 0x98, 0xe0, 0x61, 0x53, 0x58, 0x9f, 0xf7, 0xa1, 0x16, 0xd5, 0x54,
+	0xde, 0x74, 0x45, 0xa1, 0xd4, 0xe5, 0xa7, 0x91, 0x07, 0x62, 0xac, 0x20, 0x11, 0x31, 0xac, 0x91,
+	0x20, 0x72, 0x81, 0x40, 0x5b, 0xec, 0xd8, 0xb3, 0xd8, 0x6b, 0xda, 0x2b, 0xbe, 0x81, 0xc8, 0x9e,
+	0x73, 0x67, 0xc4, 0xe0, 0xc5, 0x43, 0xc1, 0xb7, 0xa6, 0xf6, 0x9d, 0x18, 0x3c, 0x13, 0x9c, 0x3a,
+	0x51, 0xea, 0xbe, 0x7e, 0x29, 0x72, 0xf0, 0xf2, 0xc0, 0x67, 0xe7, 0x06, 0x60, 0xfd, 0xce, 0x89,
+	0x0a, 0x06, 0xc4, 0x59, 0xa7, 0x05, 0x12, 0x02, 0x2d, 0x8e, 0xba, 0xcb, 0x1f, 0xc0, 0xe5, 0x53,
+	0x70, 0x0e, 0x8a, 0xbe, 0x66, 0x4a, 0xdb, 0xce, 0x90, 0x79, 0x3c, 0x85, 0x4a, 0xc7, 0x53, 0x28,
+	0x49, 0x8f, 0x27, 0xa1, 0xcb, 0x3a, 0x23, 0x0e, 0x13, 0x02, 0x47, 0x51, 0x6f, 0xfd, 0xdd, 0x60,
+	0xbb, 0x6e, 0xb1, 0x91, 0xab, 0xb2, 0x31, 0xf0, 0x93, 0x03, 0x6d, 0x27, 0xc1, 0x13, 0x4d, 0xa7,
+	0xb1, 0x6a, 0x8c, 0x8d, 0x0c, 0x95, 0x76, 0x70, 0x06, 0x79, 0x99, 0x4c, 0x4a, 0x33, 0x3f, 0xb1,
+	0xdd, 0x3c, 0x60, 0xe7, 0x7d, 0x15, 0x3f, 0xa8, 0xb3, 0xa9, 0x1e, 0xb5, 0xda, 0x53, 0x26, 0x50,
+	0x37, 0x3d, 0x0a, 0xd8, 0x22, 0x29, 0x12, 0xd8, 0x2b, 0xed, 0xb0, 0x80, 0x89, 0xe8, 0x07, 0x20,
+	0x11, 0x6b, 0x2d, 0xed, 0xb0, 0xe0, 0x25, 0xdf, 0x18, 0x1a, 0x19, 0xe2, 0x27, 0x51, 0x1b, 0x39,
+	0xbb, 0x64, 0x45, 0x4e, 0x63, 0x76, 0xba, 0xbc, 0xcd, 0x4e, 0x97, 0x37, 0x1b, 0x3a, 0x5d, 0xc0,
+	0x47, 0xc7, 0x09, 0x88, 0xd0, 0x9a, 0x61, 0xad, 0xc0, 0xb5, 0x66, 0xe4, 0x8b, 0x63, 0x4d, 0x2a,
+	0xe0, 0xca, 0xe1, 0xa9, 0xff, 0x0f, 0x9d, 0x7e, 0x0a, 0x21, 0xca, 0xba, 0x95, 0xa4, 0xe3, 0x8c,
+	0xa4, 0x23, 0x0d, 0x91, 0x44, 0x9c, 0xc7, 0x64, 0xa9, 0x6d, 0xae, 0xd4, 0xac, 0xde, 0x02, 0xd7,
+	0xa6, 0x6e, 0x01, 0x60, 0xbb, 0x61, 0x16, 0x89, 0xc6, 0x66, 0x0a, 0x3c, 0xb4, 0xa1, 0x9b, 0x63,
+	0x1c, 0x1b, 0x23, 0x71, 0x20, 0xaa, 0x99, 0x36, 0x84, 0x66, 0x90, 0x1f, 0xa9, 0x17, 0xf1, 0xee,
+	0x17, 0x13, 0xf1, 0x2d, 0x8d, 0x46, 0xfc, 0x92, 0x03, 0xf1, 0x27, 0xb1, 0x21, 0x28, 0x8a, 0xb1,
+	0x35, 0x2e, 0x58, 0x4b, 0x85, 0xe3, 0xc5, 0x50, 0xe1, 0x6c, 0x94, 0x8a, 0xdf, 0x5b, 0x91, 0xbf,
+	0xbc, 0x4c, 0xd9, 0xc4, 0x32, 0x25, 0x9f, 0x20, 0x2f, 0xdc, 0x5a, 0x79, 0x29, 0x4d, 0xf3, 0xc2,
+	0x54, 0x85, 0x9e, 0x57, 0xed, 0xf4, 0x44, 0x2b, 0x62, 0x56, 0x82, 0x5a, 0x2b, 0x67, 0x97, 0x68,
+	0x95, 0x20, 0x61, 0x5a, 0x9f, 0xa3, 0xa3, 0xcf, 0x62, 0x03, 0x5a, 0x00, 0x38, 0xea, 0x5b, 0x9d,
+	0xa3, 0x35, 0x09, 0x3a, 0xb0, 0x12, 0x41, 0x1d, 0xb5, 0x3c, 0xc0, 0x49, 0xea, 0xca, 0x4b, 0x70,
+	0x4c, 0xbb, 0xa8, 0x75, 0x83, 0x76, 0xeb, 0x56, 0xa6, 0x28, 0x64, 0xb1, 0xf6, 0x14, 0x20, 0x0c,
+	0x37, 0x09, 0x14, 0x89, 0x4f, 0xa2, 0x66, 0x4d, 0x94, 0xb3, 0x98, 0x5d, 0x48, 0xc7, 0x36, 0x06,
+	0x29, 0x10, 0x08, 0xc0, 0x34, 0xb1, 0xe0, 0xe8, 0x69, 0x9b, 0xd1, 0x94, 0x79, 0xd3, 0x16, 0x37,
+	0x05, 0x3e, 0xbe, 0x31, 0xe0, 0x77, 0x00, 0x86, 0x58, 0x0e, 0xd8, 0xad, 0x33, 0xac, 0xed, 0xff,
+	0x83, 0x43, 0x5e, 0x9b, 0x3d, 0xfc, 0x59, 0x4b, 0xba, 0x69, 0xe6, 0xc1, 0xd1, 0xcd, 0x4b, 0x35,
+	0x21, 0x99, 0xf5, 0x54, 0xde, 0x05, 0xd4, 0xbf, 0x1c, 0xbb, 0x9d, 0xeb, 0x0e, 0xbf, 0xed, 0xc4,
+	0xbb, 0x48, 0x36, 0x5e, 0x19, 0x8d, 0xeb, 0x42, 0x07, 0xae, 0xc8, 0xea, 0xfe, 0xbf, 0x39, 0xd4,
+	0x65, 0x27, 0x74, 0x8b, 0x8d, 0x9a, 0x47, 0x9d, 0x20, 0xac, 0x19, 0xa9, 0xea, 0x67, 0xc0, 0xc8,
+	0x73, 0xa5, 0xe9, 0xed, 0x49, 0x02, 0xc9, 0xde, 0x02, 0xed, 0x7a, 0xa9, 0xb3, 0x20, 0xf9, 0x75,
+	0xb4, 0xad, 0xce, 0xc6, 0x6e, 0xad, 0x8d, 0x83, 0x0e, 0x1f, 0x17, 0xeb, 0x44, 0xed, 0x95, 0xcd,
+	0xd3, 0x03, 0x9f, 0x73, 0xa8, 0x93, 0xc9, 0x4d, 0x68, 0x78, 0x46, 0xba, 0x50, 0xf5, 0x54, 0xe1,
+	0xb6, 0xe2, 0xa9, 0xc2, 0xef, 0x40, 0xee, 0x3c, 0x96, 0xb3, 0xc6, 0x2c, 0xe5, 0xb8, 0x53, 0x60,
+	0xbd, 0x80, 0x80, 0xbc, 0x55, 0xaa, 0x60, 0x9d, 0x3f, 0x81, 0x5a, 0x55, 0xd6, 0x06, 0x65, 0x88,
+	0x93, 0xed, 0xb2, 0x3b, 0x59, 0xd5, 0x94, 0x98, 0x8b, 0xa6, 0xed, 0xe5, 0x49, 0x91, 0x1f, 0x38,
+	0xe4, 0x1a, 0xd7, 0x47, 0x75, 0xfe, 0x24, 0x42, 0xc3, 0xa2, 0x9c, 0xc9, 0x63, 0x22, 0xcf, 0xf7,
+	0xd4, 0x43, 0x61, 0x11, 0xe7, 0xef, 0xad, 0x3f, 0xc8, 0x52, 0x4b, 0x01, 0xb5, 0xc3, 0x3d, 0x54,
+	0x7a, 0x6a, 0xf1, 0x7b, 0xec, 0xc2, 0x35, 0x6f, 0x53, 0xff, 0x6e, 0xbb, 0x88, 0xfd, 0x9d, 0x16,
+	0x39, 0x8d, 0x5c, 0x51, 0xa2, 0xe4, 0x04, 0x42, 0x80, 0xcd, 0x9e, 0x30, 0xeb, 0x81, 0xee, 0xaf,
+	0x73, 0xa4, 0x5b, 0x9f, 0x3f, 0x91, 0xf3, 0xa8, 0x6b, 0x54, 0xaf, 0xe4, 0xbb, 0xc3, 0x8a, 0x92,
+	0x83, 0x37, 0x57, 0x57, 0x34, 0x33, 0xb7, 0xa0, 0x1b, 0x95, 0xef, 0xab, 0x13, 0xb2, 0xda, 0x60,
+	0xe4, 0x5f, 0x17, 0xda, 0x3e, 0x6e, 0xfa, 0x42, 0x55, 0x62, 0xcc, 0xe7, 0x90, 0xc7, 0x22, 0x07,
+	0x99, 0x36, 0xdf, 0x48, 0x26, 0xed, 0x3f, 0xb8, 0x3e, 0x61, 0xb6, 0x2b, 0x69, 0xd3, 0x8d, 0xcb,
+	0x59, 0x3d, 0x3f, 0x50, 0x4f, 0x67, 0x7b, 0xd2, 0xdf, 0xe0, 0x22, 0x32, 0xea, 0x86, 0x87, 0x26,
+	0x91, 0xb0, 0x30, 0xb7, 0x85, 0x46, 0xa9, 0x68, 0x1b, 0x5b, 0xcf, 0x7c, 0x08, 0x6c, 0xfd, 0x8a,
+	0x67, 0x91, 0xc7, 0xcc, 0xf6, 0xcb, 0xfe, 0xbd, 0xcf, 0x3e, 0x7f, 0xa5, 0xd7, 0xc0, 0xda, 0x6e,
+	0x0e, 0x8e, 0xd7, 0x66, 0x86, 0x0e, 0xf1, 0xee, 0x80, 0x5d, 0xbc, 0x36, 0xbb, 0xf3, 0xaf, 0xf6,
+	0x52, 0x8f, 0xfc, 0xc6, 0x21, 0x9f, 0x25, 0x83, 0xa9, 0x76, 0xbe, 0x49, 0xd4, 0x69, 0x2a, 0x5a,
+	0x0a, 0xa6, 0xf5, 0xdb, 0xb1, 0x56, 0x4c, 0x31, 0x33, 0xe0, 0xeb, 0xa6, 0x98, 0x71, 0xdd, 0x8d,
+	0xb6, 0x8d, 0xea, 0xe5, 0xcb, 0x50, 0xc0, 0x59, 0xb8, 0xc1, 0xb5, 0x22, 0xff, 0x33, 0x87, 0x9c,
+	0x80, 0xc5, 0xef, 0xad, 0xb3, 0x80, 0x45, 0xda, 0x5c, 0xe1, 0xe5, 0x15, 0xaf, 0xde, 0x40, 0xee,
+	0xea, 0x9f, 0xff, 0x7c, 0xed, 0x80, 0x08, 0x09, 0xcf, 0xe9, 0x61, 0x4b, 0x3e, 0xa7, 0x87, 0x2f,
+	0x55, 0xdf, 0xe2, 0x21, 0x5b, 0xd6, 0x68, 0xeb, 0x5f, 0x0e, 0xb3, 0x3b, 0xa3, 0x66, 0x5e, 0xb9,
+	0x79, 0x99, 0xbf, 0xe6, 0x40, 0xce, 0x64, 0x3d, 0xa5, 0x93, 0x8d, 0x29, 0xfd, 0x2b, 0x47, 0xb5,
+	0xfe, 0x85, 0xf3, 0xaf, 0xaa, 0x76, 0x68, 0x83, 0x6a, 0x87, 0xaa, 0xd5, 0x1e, 0xe4, 0xf6, 0x4f,
+	0x8e, 0x05, 0x86, 0x37, 0x6b, 0x25, 0x80, 0xe3, 0x7f, 0xe4, 0x50, 0x5b, 0x39, 0xa9, 0xe3, 0xf7,
+	0xaf, 0x3f, 0xdf, 0x5b, 0x8d, 0x95, 0xf7, 0x29, 0x29, 0xc3, 0xfe, 0xa1, 0x5a, 0x4d, 0xd7, 0x52,
+	0xad, 0x9c, 0x3c, 0x07, 0x2b, 0x4a, 0x2e, 0x39, 0xb8, 0x43, 0x1c, 0x7f, 0x9d, 0x43, 0xee, 0x38,
+	0xce, 0x63, 0x03, 0xf3, 0xeb, 0x4a, 0xe0, 0xfc, 0x3b, 0x6a, 0x5e, 0x2a, 0x09, 0xf2, 0x8b, 0x39,
+	0x30, 0x46, 0xb5, 0x3b, 0xb9, 0x3f, 0xd1, 0xb8, 0x76, 0xe5, 0x2d, 0xaa, 0xec, 0x49, 0x44, 0x43,
+	0x0e, 0xb8, 0x11, 0xf3, 0xf4, 0xd5, 0x67, 0x4f, 0x0b, 0x56, 0x50, 0xa1, 0x36, 0x74, 0x6d, 0x13,
+	0x03, 0xbb, 0xa8, 0x8e, 0x3b, 0xf9, 0x97, 0x88, 0x8e, 0xa5, 0x34, 0x27, 0x55, 0xca, 0x16, 0x62,
+	0xdf, 0x73, 0x77, 0x1e, 0xf4, 0x71, 0x77, 0xa1, 0xdc, 0x7b, 0xd0, 0xd7, 0x74, 0x1f, 0xca, 0x63,
+	0x28, 0x4f, 0xa0, 0x3c, 0x85, 0x6f, 0x57, 0x1e, 0xf6, 0x71, 0x4b, 0x0f, 0xfb, 0x9a, 0x6e, 0x40,
+	0x7d, 0x13, 0xea, 0x5b, 0x50, 0x6e, 0x43, 0xb9, 0x03, 0xfd, 0xbb, 0x50, 0xee, 0x41, 0xfb, 0x3e,
+	0xd4, 0x8f, 0xa1, 0x7e, 0x02, 0xf5, 0x53, 0xa8, 0xaf, 0x3c, 0xea, 0x6b, 0x5a, 0x7a, 0xd4, 0xc7,
+	0x7d, 0x05, 0xf5, 0xb7, 0x50, 0x7f, 0x07, 0xf5, 0x0d, 0x28, 0x37, 0xa1, 0x7d, 0x0b, 0xca, 0x6d,
+	0x28, 0x93, 0x07, 0xd7, 0x9b, 0x58, 0x19, 0xb2, 0x3a, 0x3d, 0xed, 0xa6, 0x46, 0x1f, 0xfe, 0x0f,
+	0x4c, 0xd6, 0xf4, 0x3c, 0xae, 0x18, 0x00, 0x00,
 }
 
 func (this *SessionKeyRequest) Equal(that interface{}) bool {
@@ -1671,6 +1669,82 @@ var _AsJs_serviceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/joinserver.proto",
 }
 
+// JsJoinAcceptHookClient is the client API for JsJoinAcceptHook service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type JsJoinAcceptHookClient interface {
+	// Adjust the join-accept parameters of the join-request.
+	// Only the downlink settings, Rx delay and CFList of the returned join-request are used.
+	AdjustJoinAccept(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinRequest, error)
+}
+
+type jsJoinAcceptHookClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewJsJoinAcceptHookClient(cc *grpc.ClientConn) JsJoinAcceptHookClient {
+	return &jsJoinAcceptHookClient{cc}
+}
+
+func (c *jsJoinAcceptHookClient) AdjustJoinAccept(ctx context.Context, in *JoinRequest, opts ...grpc.CallOption) (*JoinRequest, error) {
+	out := new(JoinRequest)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.JsJoinAcceptHook/AdjustJoinAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// JsJoinAcceptHookServer is the server API for JsJoinAcceptHook service.
+type JsJoinAcceptHookServer interface {
+	// Adjust the join-accept parameters of the join-request.
+	// Only the downlink settings, Rx delay and CFList of the returned join-request are used.
+	AdjustJoinAccept(context.Context, *JoinRequest) (*JoinRequest, error)
+}
+
+// UnimplementedJsJoinAcceptHookServer can be embedded to have forward compatible implementations.
+type UnimplementedJsJoinAcceptHookServer struct {
+}
+
+func (*UnimplementedJsJoinAcceptHookServer) AdjustJoinAccept(ctx context.Context, req *JoinRequest) (*JoinRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustJoinAccept not implemented")
+}
+
+func RegisterJsJoinAcceptHookServer(s *grpc.Server, srv JsJoinAcceptHookServer) {
+	s.RegisterService(&_JsJoinAcceptHook_serviceDesc, srv)
+}
+
+func _JsJoinAcceptHook_AdjustJoinAccept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsJoinAcceptHookServer).AdjustJoinAccept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.JsJoinAcceptHook/AdjustJoinAccept",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsJoinAcceptHookServer).AdjustJoinAccept(ctx, req.(*JoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _JsJoinAcceptHook_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.JsJoinAcceptHook",
+	HandlerType: (*JsJoinAcceptHookServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AdjustJoinAccept",
+			Handler:    _JsJoinAcceptHook_AdjustJoinAccept_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/joinserver.proto",
+}
+
 // NetworkCryptoServiceClient is the client API for NetworkCryptoService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
@@ -574,7 +574,7 @@ var RejoinCountExponent_value = map[string]int32{
 }
 
 func (RejoinCountExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{12}
+	return fileDescriptor_2084d1d5a227b67e, []int{13}
 }
 
 type RejoinTimeExponent int32
@@ -637,7 +637,7 @@ var RejoinTimeExponent_value = map[string]int32{
 }
 
 func (RejoinTimeExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{13}
+	return fileDescriptor_2084d1d5a227b67e, []int{14}
 }
 
 type RejoinPeriodExponent int32
@@ -676,7 +676,7 @@ var RejoinPeriodExponent_value = map[string]int32{
 }
 
 func (RejoinPeriodExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{14}
+	return fileDescriptor_2084d1d5a227b67e, []int{15}
 }
 
 type DeviceEIRP int32
@@ -739,7 +739,7 @@ var DeviceEIRP_value = map[string]int32{
 }
 
 func (DeviceEIRP) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{15}
+	return fileDescriptor_2084d1d5a227b67e, []int{16}
 }
 
 type ADRAckLimitExponent int32
@@ -802,7 +802,7 @@ var ADRAckLimitExponent_value = map[string]int32{
 }
 
 func (ADRAckLimitExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{16}
+	return fileDescriptor_2084d1d5a227b67e, []int{17}
 }
 
 type ADRAckDelayExponent int32
@@ -865,7 +865,7 @@ var ADRAckDelayExponent_value = map[string]int32{
 }
 
 func (ADRAckDelayExponent) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{17}
+	return fileDescriptor_2084d1d5a227b67e, []int{18}
 }
 
 type RxDelay int32
@@ -928,7 +928,7 @@ var RxDelay_value = map[string]int32{
 }
 
 func (RxDelay) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18}
+	return fileDescriptor_2084d1d5a227b67e, []int{19}
 }
 
 type Minor int32
@@ -991,7 +991,7 @@ var Minor_value = map[string]int32{
 }
 
 func (Minor) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{19}
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
 }
 
 type Message struct {
@@ -1759,10 +1759,73 @@ func (m *FSKDataRate) GetBitRate() uint32 {
 	return 0
 }
 
+type LRFHSSDataRate struct {
+	ModulationType uint32 `protobuf:"varint,1,opt,name=modulation_type,json=modulationType,proto3" json:"modulation_type,omitempty"`
+	// Operating Channel Width (Hz).
+	OperatingChannelWidth uint32   `protobuf:"varint,2,opt,name=operating_channel_width,json=operatingChannelWidth,proto3" json:"operating_channel_width,omitempty"`
+	CodingRate            string   `protobuf:"bytes,3,opt,name=coding_rate,json=codingRate,proto3" json:"coding_rate,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *LRFHSSDataRate) Reset()      { *m = LRFHSSDataRate{} }
+func (*LRFHSSDataRate) ProtoMessage() {}
+func (*LRFHSSDataRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2084d1d5a227b67e, []int{12}
+}
+func (m *LRFHSSDataRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LRFHSSDataRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LRFHSSDataRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LRFHSSDataRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LRFHSSDataRate.Merge(m, src)
+}
+func (m *LRFHSSDataRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LRFHSSDataRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LRFHSSDataRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LRFHSSDataRate proto.InternalMessageInfo
+
+func (m *LRFHSSDataRate) GetModulationType() uint32 {
+	if m != nil {
+		return m.ModulationType
+	}
+	return 0
+}
+
+func (m *LRFHSSDataRate) GetOperatingChannelWidth() uint32 {
+	if m != nil {
+		return m.OperatingChannelWidth
+	}
+	return 0
+}
+
+func (m *LRFHSSDataRate) GetCodingRate() string {
+	if m != nil {
+		return m.CodingRate
+	}
+	return ""
+}
+
 type DataRate struct {
 	// Types that are valid to be assigned to Modulation:
 	//	*DataRate_LoRa
 	//	*DataRate_FSK
+	//	*DataRate_LRFHSS
 	Modulation           isDataRate_Modulation `protobuf_oneof:"modulation"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1771,7 +1834,7 @@ type DataRate struct {
 func (m *DataRate) Reset()      { *m = DataRate{} }
 func (*DataRate) ProtoMessage() {}
 func (*DataRate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{12}
+	return fileDescriptor_2084d1d5a227b67e, []int{13}
 }
 func (m *DataRate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1813,9 +1876,13 @@ type DataRate_LoRa struct {
 type DataRate_FSK struct {
 	FSK *FSKDataRate `protobuf:"bytes,2,opt,name=fsk,proto3,oneof" json:"fsk,omitempty"`
 }
+type DataRate_LRFHSS struct {
+	LRFHSS *LRFHSSDataRate `protobuf:"bytes,3,opt,name=lrfhss,proto3,oneof" json:"lrfhss,omitempty"`
+}
 
-func (*DataRate_LoRa) isDataRate_Modulation() {}
-func (*DataRate_FSK) isDataRate_Modulation()  {}
+func (*DataRate_LoRa) isDataRate_Modulation()   {}
+func (*DataRate_FSK) isDataRate_Modulation()    {}
+func (*DataRate_LRFHSS) isDataRate_Modulation() {}
 
 func (m *DataRate) GetModulation() isDataRate_Modulation {
 	if m != nil {
@@ -1838,11 +1905,19 @@ func (m *DataRate) GetFSK() *FSKDataRate {
 	return nil
 }
 
+func (m *DataRate) GetLRFHSS() *LRFHSSDataRate {
+	if x, ok := m.GetModulation().(*DataRate_LRFHSS); ok {
+		return x.LRFHSS
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataRate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*DataRate_LoRa)(nil),
 		(*DataRate_FSK)(nil),
+		(*DataRate_LRFHSS)(nil),
 	}
 }
 
@@ -1875,7 +1950,7 @@ type TxSettings struct {
 func (m *TxSettings) Reset()      { *m = TxSettings{} }
 func (*TxSettings) ProtoMessage() {}
 func (*TxSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{13}
+	return fileDescriptor_2084d1d5a227b67e, []int{14}
 }
 func (m *TxSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1975,7 +2050,7 @@ type TxSettings_Downlink struct {
 func (m *TxSettings_Downlink) Reset()      { *m = TxSettings_Downlink{} }
 func (*TxSettings_Downlink) ProtoMessage() {}
 func (*TxSettings_Downlink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{13, 0}
+	return fileDescriptor_2084d1d5a227b67e, []int{14, 0}
 }
 func (m *TxSettings_Downlink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2035,7 +2110,7 @@ type GatewayAntennaIdentifiers struct {
 func (m *GatewayAntennaIdentifiers) Reset()      { *m = GatewayAntennaIdentifiers{} }
 func (*GatewayAntennaIdentifiers) ProtoMessage() {}
 func (*GatewayAntennaIdentifiers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{14}
+	return fileDescriptor_2084d1d5a227b67e, []int{15}
 }
 func (m *GatewayAntennaIdentifiers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2081,7 +2156,7 @@ type UplinkToken struct {
 func (m *UplinkToken) Reset()      { *m = UplinkToken{} }
 func (*UplinkToken) ProtoMessage() {}
 func (*UplinkToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{15}
+	return fileDescriptor_2084d1d5a227b67e, []int{16}
 }
 func (m *UplinkToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2132,7 +2207,7 @@ type DownlinkPath struct {
 func (m *DownlinkPath) Reset()      { *m = DownlinkPath{} }
 func (*DownlinkPath) ProtoMessage() {}
 func (*DownlinkPath) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{16}
+	return fileDescriptor_2084d1d5a227b67e, []int{17}
 }
 func (m *DownlinkPath) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2247,7 +2322,7 @@ type TxRequest struct {
 func (m *TxRequest) Reset()      { *m = TxRequest{} }
 func (*TxRequest) ProtoMessage() {}
 func (*TxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{17}
+	return fileDescriptor_2084d1d5a227b67e, []int{18}
 }
 func (m *TxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2388,7 +2463,7 @@ type MACCommand struct {
 func (m *MACCommand) Reset()      { *m = MACCommand{} }
 func (*MACCommand) ProtoMessage() {}
 func (*MACCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18}
+	return fileDescriptor_2084d1d5a227b67e, []int{19}
 }
 func (m *MACCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2827,7 +2902,7 @@ type MACCommand_ResetInd struct {
 func (m *MACCommand_ResetInd) Reset()      { *m = MACCommand_ResetInd{} }
 func (*MACCommand_ResetInd) ProtoMessage() {}
 func (*MACCommand_ResetInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 0}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 0}
 }
 func (m *MACCommand_ResetInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +2947,7 @@ type MACCommand_ResetConf struct {
 func (m *MACCommand_ResetConf) Reset()      { *m = MACCommand_ResetConf{} }
 func (*MACCommand_ResetConf) ProtoMessage() {}
 func (*MACCommand_ResetConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 1}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 1}
 }
 func (m *MACCommand_ResetConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2919,7 +2994,7 @@ type MACCommand_LinkCheckAns struct {
 func (m *MACCommand_LinkCheckAns) Reset()      { *m = MACCommand_LinkCheckAns{} }
 func (*MACCommand_LinkCheckAns) ProtoMessage() {}
 func (*MACCommand_LinkCheckAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 2}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 2}
 }
 func (m *MACCommand_LinkCheckAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2975,7 +3050,7 @@ type MACCommand_LinkADRReq struct {
 func (m *MACCommand_LinkADRReq) Reset()      { *m = MACCommand_LinkADRReq{} }
 func (*MACCommand_LinkADRReq) ProtoMessage() {}
 func (*MACCommand_LinkADRReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 3}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 3}
 }
 func (m *MACCommand_LinkADRReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3050,7 +3125,7 @@ type MACCommand_LinkADRAns struct {
 func (m *MACCommand_LinkADRAns) Reset()      { *m = MACCommand_LinkADRAns{} }
 func (*MACCommand_LinkADRAns) ProtoMessage() {}
 func (*MACCommand_LinkADRAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 4}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 4}
 }
 func (m *MACCommand_LinkADRAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3109,7 +3184,7 @@ type MACCommand_DutyCycleReq struct {
 func (m *MACCommand_DutyCycleReq) Reset()      { *m = MACCommand_DutyCycleReq{} }
 func (*MACCommand_DutyCycleReq) ProtoMessage() {}
 func (*MACCommand_DutyCycleReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 5}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 5}
 }
 func (m *MACCommand_DutyCycleReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3156,7 +3231,7 @@ type MACCommand_RxParamSetupReq struct {
 func (m *MACCommand_RxParamSetupReq) Reset()      { *m = MACCommand_RxParamSetupReq{} }
 func (*MACCommand_RxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 6}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 6}
 }
 func (m *MACCommand_RxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3217,7 +3292,7 @@ type MACCommand_RxParamSetupAns struct {
 func (m *MACCommand_RxParamSetupAns) Reset()      { *m = MACCommand_RxParamSetupAns{} }
 func (*MACCommand_RxParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RxParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 7}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 7}
 }
 func (m *MACCommand_RxParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3282,7 +3357,7 @@ type MACCommand_DevStatusAns struct {
 func (m *MACCommand_DevStatusAns) Reset()      { *m = MACCommand_DevStatusAns{} }
 func (*MACCommand_DevStatusAns) ProtoMessage() {}
 func (*MACCommand_DevStatusAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 8}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 8}
 }
 func (m *MACCommand_DevStatusAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3337,7 +3412,7 @@ type MACCommand_NewChannelReq struct {
 func (m *MACCommand_NewChannelReq) Reset()      { *m = MACCommand_NewChannelReq{} }
 func (*MACCommand_NewChannelReq) ProtoMessage() {}
 func (*MACCommand_NewChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 9}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 9}
 }
 func (m *MACCommand_NewChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3479,7 @@ type MACCommand_NewChannelAns struct {
 func (m *MACCommand_NewChannelAns) Reset()      { *m = MACCommand_NewChannelAns{} }
 func (*MACCommand_NewChannelAns) ProtoMessage() {}
 func (*MACCommand_NewChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 10}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 10}
 }
 func (m *MACCommand_NewChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3457,7 +3532,7 @@ type MACCommand_DLChannelReq struct {
 func (m *MACCommand_DLChannelReq) Reset()      { *m = MACCommand_DLChannelReq{} }
 func (*MACCommand_DLChannelReq) ProtoMessage() {}
 func (*MACCommand_DLChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 11}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 11}
 }
 func (m *MACCommand_DLChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3510,7 +3585,7 @@ type MACCommand_DLChannelAns struct {
 func (m *MACCommand_DLChannelAns) Reset()      { *m = MACCommand_DLChannelAns{} }
 func (*MACCommand_DLChannelAns) ProtoMessage() {}
 func (*MACCommand_DLChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 12}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 12}
 }
 func (m *MACCommand_DLChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3562,7 +3637,7 @@ type MACCommand_RxTimingSetupReq struct {
 func (m *MACCommand_RxTimingSetupReq) Reset()      { *m = MACCommand_RxTimingSetupReq{} }
 func (*MACCommand_RxTimingSetupReq) ProtoMessage() {}
 func (*MACCommand_RxTimingSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 13}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 13}
 }
 func (m *MACCommand_RxTimingSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3611,7 +3686,7 @@ type MACCommand_TxParamSetupReq struct {
 func (m *MACCommand_TxParamSetupReq) Reset()      { *m = MACCommand_TxParamSetupReq{} }
 func (*MACCommand_TxParamSetupReq) ProtoMessage() {}
 func (*MACCommand_TxParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 14}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 14}
 }
 func (m *MACCommand_TxParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3670,7 +3745,7 @@ type MACCommand_RekeyInd struct {
 func (m *MACCommand_RekeyInd) Reset()      { *m = MACCommand_RekeyInd{} }
 func (*MACCommand_RekeyInd) ProtoMessage() {}
 func (*MACCommand_RekeyInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 15}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 15}
 }
 func (m *MACCommand_RekeyInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3715,7 +3790,7 @@ type MACCommand_RekeyConf struct {
 func (m *MACCommand_RekeyConf) Reset()      { *m = MACCommand_RekeyConf{} }
 func (*MACCommand_RekeyConf) ProtoMessage() {}
 func (*MACCommand_RekeyConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 16}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 16}
 }
 func (m *MACCommand_RekeyConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3763,7 +3838,7 @@ type MACCommand_ADRParamSetupReq struct {
 func (m *MACCommand_ADRParamSetupReq) Reset()      { *m = MACCommand_ADRParamSetupReq{} }
 func (*MACCommand_ADRParamSetupReq) ProtoMessage() {}
 func (*MACCommand_ADRParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 17}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 17}
 }
 func (m *MACCommand_ADRParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3815,7 +3890,7 @@ type MACCommand_DeviceTimeAns struct {
 func (m *MACCommand_DeviceTimeAns) Reset()      { *m = MACCommand_DeviceTimeAns{} }
 func (*MACCommand_DeviceTimeAns) ProtoMessage() {}
 func (*MACCommand_DeviceTimeAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 18}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 18}
 }
 func (m *MACCommand_DeviceTimeAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3864,7 +3939,7 @@ type MACCommand_ForceRejoinReq struct {
 func (m *MACCommand_ForceRejoinReq) Reset()      { *m = MACCommand_ForceRejoinReq{} }
 func (*MACCommand_ForceRejoinReq) ProtoMessage() {}
 func (*MACCommand_ForceRejoinReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 19}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 19}
 }
 func (m *MACCommand_ForceRejoinReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3933,7 +4008,7 @@ type MACCommand_RejoinParamSetupReq struct {
 func (m *MACCommand_RejoinParamSetupReq) Reset()      { *m = MACCommand_RejoinParamSetupReq{} }
 func (*MACCommand_RejoinParamSetupReq) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 20}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 20}
 }
 func (m *MACCommand_RejoinParamSetupReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3985,7 +4060,7 @@ type MACCommand_RejoinParamSetupAns struct {
 func (m *MACCommand_RejoinParamSetupAns) Reset()      { *m = MACCommand_RejoinParamSetupAns{} }
 func (*MACCommand_RejoinParamSetupAns) ProtoMessage() {}
 func (*MACCommand_RejoinParamSetupAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 21}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 21}
 }
 func (m *MACCommand_RejoinParamSetupAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4030,7 +4105,7 @@ type MACCommand_PingSlotInfoReq struct {
 func (m *MACCommand_PingSlotInfoReq) Reset()      { *m = MACCommand_PingSlotInfoReq{} }
 func (*MACCommand_PingSlotInfoReq) ProtoMessage() {}
 func (*MACCommand_PingSlotInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 22}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 22}
 }
 func (m *MACCommand_PingSlotInfoReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,7 +4151,7 @@ type MACCommand_PingSlotChannelReq struct {
 func (m *MACCommand_PingSlotChannelReq) Reset()      { *m = MACCommand_PingSlotChannelReq{} }
 func (*MACCommand_PingSlotChannelReq) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 23}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 23}
 }
 func (m *MACCommand_PingSlotChannelReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4129,7 +4204,7 @@ type MACCommand_PingSlotChannelAns struct {
 func (m *MACCommand_PingSlotChannelAns) Reset()      { *m = MACCommand_PingSlotChannelAns{} }
 func (*MACCommand_PingSlotChannelAns) ProtoMessage() {}
 func (*MACCommand_PingSlotChannelAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 24}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 24}
 }
 func (m *MACCommand_PingSlotChannelAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4182,7 +4257,7 @@ type MACCommand_BeaconTimingAns struct {
 func (m *MACCommand_BeaconTimingAns) Reset()      { *m = MACCommand_BeaconTimingAns{} }
 func (*MACCommand_BeaconTimingAns) ProtoMessage() {}
 func (*MACCommand_BeaconTimingAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 25}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 25}
 }
 func (m *MACCommand_BeaconTimingAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4234,7 +4309,7 @@ type MACCommand_BeaconFreqReq struct {
 func (m *MACCommand_BeaconFreqReq) Reset()      { *m = MACCommand_BeaconFreqReq{} }
 func (*MACCommand_BeaconFreqReq) ProtoMessage() {}
 func (*MACCommand_BeaconFreqReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 26}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 26}
 }
 func (m *MACCommand_BeaconFreqReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4279,7 +4354,7 @@ type MACCommand_BeaconFreqAns struct {
 func (m *MACCommand_BeaconFreqAns) Reset()      { *m = MACCommand_BeaconFreqAns{} }
 func (*MACCommand_BeaconFreqAns) ProtoMessage() {}
 func (*MACCommand_BeaconFreqAns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 27}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 27}
 }
 func (m *MACCommand_BeaconFreqAns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4324,7 +4399,7 @@ type MACCommand_DeviceModeInd struct {
 func (m *MACCommand_DeviceModeInd) Reset()      { *m = MACCommand_DeviceModeInd{} }
 func (*MACCommand_DeviceModeInd) ProtoMessage() {}
 func (*MACCommand_DeviceModeInd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 28}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 28}
 }
 func (m *MACCommand_DeviceModeInd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4369,7 +4444,7 @@ type MACCommand_DeviceModeConf struct {
 func (m *MACCommand_DeviceModeConf) Reset()      { *m = MACCommand_DeviceModeConf{} }
 func (*MACCommand_DeviceModeConf) ProtoMessage() {}
 func (*MACCommand_DeviceModeConf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{18, 29}
+	return fileDescriptor_2084d1d5a227b67e, []int{19, 29}
 }
 func (m *MACCommand_DeviceModeConf) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4489,7 @@ type DataRateIndexValue struct {
 func (m *DataRateIndexValue) Reset()      { *m = DataRateIndexValue{} }
 func (*DataRateIndexValue) ProtoMessage() {}
 func (*DataRateIndexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{19}
+	return fileDescriptor_2084d1d5a227b67e, []int{20}
 }
 func (m *DataRateIndexValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4459,7 +4534,7 @@ type PingSlotPeriodValue struct {
 func (m *PingSlotPeriodValue) Reset()      { *m = PingSlotPeriodValue{} }
 func (*PingSlotPeriodValue) ProtoMessage() {}
 func (*PingSlotPeriodValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{20}
+	return fileDescriptor_2084d1d5a227b67e, []int{21}
 }
 func (m *PingSlotPeriodValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4504,7 +4579,7 @@ type AggregatedDutyCycleValue struct {
 func (m *AggregatedDutyCycleValue) Reset()      { *m = AggregatedDutyCycleValue{} }
 func (*AggregatedDutyCycleValue) ProtoMessage() {}
 func (*AggregatedDutyCycleValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{21}
+	return fileDescriptor_2084d1d5a227b67e, []int{22}
 }
 func (m *AggregatedDutyCycleValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4549,7 +4624,7 @@ type RxDelayValue struct {
 func (m *RxDelayValue) Reset()      { *m = RxDelayValue{} }
 func (*RxDelayValue) ProtoMessage() {}
 func (*RxDelayValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{22}
+	return fileDescriptor_2084d1d5a227b67e, []int{23}
 }
 func (m *RxDelayValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4594,7 +4669,7 @@ type ADRAckLimitExponentValue struct {
 func (m *ADRAckLimitExponentValue) Reset()      { *m = ADRAckLimitExponentValue{} }
 func (*ADRAckLimitExponentValue) ProtoMessage() {}
 func (*ADRAckLimitExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{23}
+	return fileDescriptor_2084d1d5a227b67e, []int{24}
 }
 func (m *ADRAckLimitExponentValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4639,7 +4714,7 @@ type ADRAckDelayExponentValue struct {
 func (m *ADRAckDelayExponentValue) Reset()      { *m = ADRAckDelayExponentValue{} }
 func (*ADRAckDelayExponentValue) ProtoMessage() {}
 func (*ADRAckDelayExponentValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2084d1d5a227b67e, []int{24}
+	return fileDescriptor_2084d1d5a227b67e, []int{25}
 }
 func (m *ADRAckDelayExponentValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4740,6 +4815,8 @@ func init() {
 	golang_proto.RegisterType((*LoRaDataRate)(nil), "ttn.lorawan.v3.LoRaDataRate")
 	proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
 	golang_proto.RegisterType((*FSKDataRate)(nil), "ttn.lorawan.v3.FSKDataRate")
+	proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
+	golang_proto.RegisterType((*LRFHSSDataRate)(nil), "ttn.lorawan.v3.LRFHSSDataRate")
 	proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
 	golang_proto.RegisterType((*DataRate)(nil), "ttn.lorawan.v3.DataRate")
 	proto.RegisterType((*TxSettings)(nil), "ttn.lorawan.v3.TxSettings")
@@ -4836,350 +4913,348 @@ func init() {
 }
 
 var fileDescriptor_2084d1d5a227b67e = []byte{
-	// 5485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4d, 0x70, 0x1b, 0xc9,
-	0x75, 0xc6, 0xe0, 0x87, 0x00, 0x1f, 0x00, 0x62, 0xd4, 0xd4, 0x4a, 0x14, 0xbc, 0x06, 0x65, 0xca,
-	0x29, 0xcb, 0x5c, 0x8b, 0x12, 0xc1, 0x1f, 0x71, 0xfd, 0xb3, 0x31, 0xfe, 0xb8, 0xc4, 0x8a, 0x7f,
-	0x1e, 0x90, 0xd2, 0x6a, 0x63, 0xd7, 0x64, 0x88, 0x19, 0x50, 0x58, 0x02, 0x03, 0x78, 0x30, 0xa4,
-	0x48, 0xe7, 0xe2, 0xb2, 0x2f, 0x9b, 0xa4, 0x52, 0xe5, 0x72, 0xc5, 0x95, 0xf8, 0x90, 0xb2, 0x2b,
-	0x71, 0x55, 0x5c, 0x95, 0x43, 0x9c, 0xe4, 0x10, 0x1f, 0x72, 0xf0, 0x21, 0x07, 0xa7, 0x2a, 0x87,
-	0xcd, 0xcd, 0x49, 0x55, 0x14, 0x8b, 0xaa, 0x54, 0xf9, 0xe8, 0xa3, 0x4b, 0x87, 0x6c, 0xea, 0x75,
-	0xf7, 0x60, 0xba, 0x67, 0x20, 0x91, 0xf4, 0xca, 0xba, 0x68, 0xfa, 0xeb, 0xee, 0xf7, 0x5e, 0xbf,
-	0xf7, 0xfa, 0xbd, 0x7e, 0xdd, 0x20, 0x4c, 0x77, 0x7a, 0x8e, 0xf1, 0xd8, 0xb0, 0x6f, 0x0d, 0x5c,
-	0xa3, 0x79, 0x70, 0xdb, 0xe8, 0xb7, 0x6f, 0x73, 0x64, 0xae, 0xef, 0xf4, 0xdc, 0x1e, 0x99, 0x70,
-	0x5d, 0x7b, 0xce, 0x83, 0x8e, 0x16, 0xf2, 0xa5, 0xfd, 0xb6, 0xfb, 0xe8, 0x70, 0x6f, 0xae, 0xd9,
-	0xeb, 0xde, 0xb6, 0xec, 0xa3, 0xde, 0x49, 0xdf, 0xe9, 0x1d, 0x9f, 0xdc, 0xa6, 0x83, 0x9b, 0xb7,
-	0xf6, 0x2d, 0xfb, 0xd6, 0x91, 0xd1, 0x69, 0x9b, 0x86, 0x6b, 0xdd, 0x0e, 0x7d, 0x30, 0x92, 0xf9,
-	0x5b, 0x02, 0x89, 0xfd, 0xde, 0x7e, 0x8f, 0x4d, 0xde, 0x3b, 0x6c, 0xd1, 0x16, 0x6d, 0xd0, 0x2f,
-	0x3e, 0xfc, 0xf5, 0xfd, 0x5e, 0x6f, 0xbf, 0x63, 0xf9, 0xa3, 0x06, 0xae, 0x73, 0xd8, 0x74, 0x79,
-	0xef, 0x74, 0xb0, 0xd7, 0x6d, 0x77, 0xad, 0x81, 0x6b, 0x74, 0xfb, 0x7c, 0xc0, 0x8d, 0xf0, 0x0a,
-	0xdb, 0xa6, 0x65, 0xbb, 0xed, 0x56, 0xdb, 0x72, 0x06, 0x6c, 0xd0, 0xcc, 0x93, 0x18, 0x24, 0x37,
-	0xac, 0xc1, 0xc0, 0xd8, 0xb7, 0xc8, 0x17, 0x20, 0xd1, 0xd5, 0x1f, 0x99, 0xce, 0x94, 0x72, 0x5d,
-	0xb9, 0x99, 0x2e, 0x5e, 0x9e, 0x93, 0x35, 0x30, 0xb7, 0xb1, 0x56, 0xd5, 0xca, 0xea, 0xf3, 0x72,
-	0xe2, 0x4f, 0x94, 0xa8, 0xaa, 0xfc, 0xfc, 0xc9, 0x74, 0xe4, 0xc3, 0x27, 0xd3, 0x8a, 0x16, 0xef,
-	0xae, 0x99, 0x0e, 0xb9, 0x0e, 0xb1, 0x6e, 0xbb, 0x39, 0x15, 0xbd, 0xae, 0xdc, 0xcc, 0x94, 0x27,
-	0x9e, 0x97, 0xe3, 0xdf, 0x88, 0x3e, 0x8a, 0x9f, 0x3e, 0x99, 0x8e, 0x6d, 0xd4, 0x2b, 0x1a, 0x76,
-	0x91, 0x0d, 0x48, 0x77, 0x8d, 0xa6, 0xde, 0x37, 0x4e, 0x3a, 0x3d, 0xc3, 0x9c, 0x8a, 0x51, 0x26,
-	0xf9, 0x10, 0x93, 0x52, 0x65, 0x9b, 0x8d, 0x28, 0x4f, 0x9c, 0x3e, 0x99, 0x06, 0xbf, 0xbd, 0x16,
-	0xd1, 0xa0, 0x6b, 0x34, 0x79, 0x8b, 0xdc, 0x87, 0xcb, 0xef, 0xf7, 0xda, 0xb6, 0xee, 0x58, 0x5f,
-	0x3f, 0xb4, 0x06, 0xee, 0x90, 0x6e, 0x9c, 0xd2, 0x9d, 0x09, 0xd2, 0x7d, 0xa7, 0xd7, 0xb6, 0x35,
-	0x36, 0xd4, 0xa7, 0x47, 0xde, 0x0f, 0xa1, 0xa4, 0x01, 0x93, 0x94, 0xae, 0xd1, 0x6c, 0x5a, 0x7d,
-	0x9f, 0x6c, 0x82, 0x92, 0xfd, 0xd4, 0x28, 0xb2, 0x25, 0x3a, 0xd2, 0xa7, 0x7a, 0xe9, 0xfd, 0x20,
-	0x48, 0xbe, 0x0a, 0x57, 0x1c, 0x6b, 0xa4, 0xb8, 0x63, 0x94, 0xee, 0xa7, 0x83, 0x74, 0x35, 0xeb,
-	0xfd, 0x51, 0x02, 0x5f, 0x76, 0x46, 0xe0, 0x9f, 0x8f, 0xff, 0xf4, 0x87, 0xd3, 0x91, 0xf2, 0x04,
-	0x24, 0x3d, 0x76, 0xb1, 0xdf, 0x94, 0x95, 0x77, 0xe2, 0xa9, 0xa4, 0x9a, 0x9a, 0x39, 0x84, 0x38,
-	0xda, 0x8d, 0x2c, 0xc3, 0x58, 0x57, 0x77, 0x4f, 0xfa, 0x16, 0xb5, 0xee, 0x44, 0xf1, 0xb5, 0x90,
-	0xe2, 0x77, 0x4e, 0xfa, 0x56, 0x39, 0xf5, 0xbc, 0x9c, 0xf8, 0x16, 0x9a, 0x57, 0x4b, 0x74, 0x11,
-	0x20, 0x4b, 0x90, 0xe8, 0x1a, 0xef, 0xf7, 0x1c, 0x6a, 0xd9, 0x51, 0xd3, 0xb0, 0x53, 0x9a, 0x86,
-	0xc0, 0xcc, 0x33, 0x05, 0x04, 0xd3, 0xa1, 0x6b, 0xb5, 0x5e, 0xe6, 0x5a, 0xab, 0x2f, 0x70, 0xad,
-	0x16, 0xba, 0xd6, 0x34, 0x8c, 0xb5, 0xf4, 0x7e, 0xcf, 0x71, 0xa9, 0x0c, 0x59, 0xca, 0x6c, 0x36,
-	0x36, 0xf5, 0x91, 0xa2, 0x25, 0x5a, 0xdb, 0x3d, 0xc7, 0x25, 0xb7, 0x21, 0xdd, 0x72, 0xba, 0x92,
-	0x67, 0x65, 0x98, 0xf7, 0xac, 0x6a, 0x1b, 0x5c, 0x04, 0x0d, 0x5a, 0x4e, 0xd7, 0x13, 0xe7, 0xcb,
-	0x90, 0x33, 0xad, 0x66, 0xcf, 0xb4, 0xcc, 0x80, 0xdb, 0x5c, 0x9d, 0x63, 0xbb, 0x6a, 0xce, 0xdb,
-	0x55, 0x73, 0x0d, 0xba, 0xe7, 0xb4, 0x09, 0x3e, 0x5e, 0x52, 0xf9, 0xcc, 0xff, 0x2a, 0x10, 0x47,
-	0xd1, 0xc9, 0x03, 0x48, 0x99, 0xd6, 0x91, 0x6e, 0x98, 0x7c, 0x89, 0x99, 0xf2, 0x17, 0x71, 0x11,
-	0xff, 0xf5, 0x64, 0x7a, 0x71, 0xbf, 0x37, 0xe7, 0x3e, 0xb2, 0xdc, 0x47, 0x6d, 0x7b, 0x7f, 0x30,
-	0x67, 0x5b, 0xee, 0xe3, 0x9e, 0x73, 0x70, 0x5b, 0xde, 0x9a, 0xfd, 0x83, 0xfd, 0xdb, 0x68, 0x9a,
-	0xc1, 0x5c, 0xd5, 0x3a, 0x2a, 0x99, 0xa6, 0xa3, 0x25, 0x4d, 0xf6, 0x41, 0xde, 0xc2, 0xb5, 0x37,
-	0x5d, 0xa7, 0x43, 0xd7, 0x9e, 0x0e, 0xeb, 0x7f, 0xb5, 0xe2, 0x3a, 0x9d, 0x11, 0xaa, 0x4b, 0xb4,
-	0xb0, 0x83, 0x14, 0x50, 0xf1, 0x4d, 0xdb, 0xa5, 0x4a, 0xc9, 0x96, 0xc7, 0x9f, 0x97, 0xc7, 0x66,
-	0xe3, 0x53, 0x1f, 0x7d, 0x14, 0xd3, 0xe2, 0xad, 0x8a, 0xed, 0x92, 0x02, 0xd2, 0xef, 0xf5, 0xdd,
-	0x01, 0x55, 0x40, 0xa6, 0x9c, 0xa4, 0x3b, 0x77, 0x2a, 0xa7, 0x25, 0x5a, 0x5b, 0x7d, 0x77, 0xc0,
-	0xd7, 0xf9, 0x7d, 0x05, 0x12, 0x94, 0x11, 0xb9, 0x06, 0x31, 0x83, 0xaf, 0x31, 0x55, 0x4e, 0xe2,
-	0xfe, 0x2e, 0x55, 0x35, 0x0d, 0x31, 0x72, 0x0b, 0xd2, 0x86, 0xe9, 0xe8, 0x46, 0xf3, 0x00, 0x9d,
-	0x9c, 0xca, 0x9b, 0x2a, 0x67, 0x4f, 0x9f, 0x4c, 0x8f, 0x97, 0xaa, 0x5a, 0xa9, 0x79, 0xa0, 0x59,
-	0x5f, 0xd7, 0xc6, 0x0d, 0xd3, 0x61, 0x9f, 0x44, 0x85, 0x98, 0xd1, 0x3c, 0xa0, 0x72, 0xa5, 0x34,
-	0xfc, 0x24, 0x9f, 0x80, 0xf1, 0x96, 0xde, 0xb7, 0x6c, 0xb3, 0x6d, 0xef, 0x53, 0x71, 0x52, 0x5a,
-	0xaa, 0xb5, 0xcd, 0xda, 0xe4, 0x2a, 0x24, 0x9b, 0x1d, 0x63, 0x30, 0xd0, 0xf7, 0xe8, 0x56, 0x4c,
-	0x69, 0x63, 0xb4, 0x59, 0x9e, 0xf9, 0xe7, 0x28, 0x90, 0xf0, 0xe6, 0x26, 0x7f, 0x08, 0x29, 0xba,
-	0xdf, 0xac, 0xc3, 0x36, 0xb7, 0x48, 0x8d, 0x5b, 0xa4, 0x78, 0x21, 0x8b, 0xd4, 0x76, 0xeb, 0xcb,
-	0x8b, 0xa7, 0x4f, 0xa6, 0x93, 0xc8, 0xa3, 0xb6, 0x5b, 0xd7, 0x92, 0x48, 0xb6, 0x76, 0xd8, 0x26,
-	0x5f, 0x03, 0xb4, 0x12, 0x65, 0xc0, 0xa2, 0x5e, 0xf5, 0x63, 0x31, 0x18, 0xab, 0x5a, 0x47, 0x48,
-	0x7f, 0xcc, 0xb4, 0x8e, 0x90, 0xfc, 0x7b, 0x30, 0x8e, 0xe4, 0xed, 0x9e, 0xdd, 0xb4, 0xb8, 0x4b,
-	0x7f, 0x89, 0x33, 0x58, 0xba, 0xa8, 0x4f, 0x6d, 0x22, 0x11, 0x0d, 0x5d, 0x94, 0x7e, 0x71, 0xab,
-	0xfe, 0x20, 0x06, 0x97, 0x47, 0xc5, 0x19, 0x52, 0x83, 0x34, 0x8f, 0x56, 0x42, 0xc0, 0xc8, 0x8f,
-	0x0e, 0x51, 0x81, 0xa8, 0x01, 0xce, 0x10, 0x25, 0xef, 0xc1, 0x98, 0x6d, 0xb9, 0x7a, 0xdb, 0xe4,
-	0xfa, 0xa9, 0xfc, 0x56, 0xfa, 0xd9, 0xb4, 0xdc, 0x7a, 0xf5, 0xf4, 0xc9, 0x74, 0x82, 0x7e, 0x68,
-	0x09, 0xdb, 0x72, 0xeb, 0xb2, 0x79, 0x63, 0xbf, 0x6b, 0xf3, 0xc6, 0x7f, 0x07, 0xe6, 0xfd, 0x24,
-	0x70, 0x55, 0xd1, 0xdd, 0x89, 0x2e, 0x9d, 0xd5, 0xc6, 0x19, 0x52, 0xb1, 0x5d, 0x6e, 0xa1, 0xef,
-	0xc5, 0xe1, 0x52, 0x28, 0xc3, 0x90, 0xd7, 0x61, 0xdc, 0xb2, 0x9b, 0xce, 0x49, 0xdf, 0xb5, 0x4c,
-	0xe6, 0xdb, 0x9a, 0x0f, 0x90, 0xaf, 0x01, 0x50, 0xb2, 0xcc, 0x71, 0x98, 0xe6, 0xdf, 0xe2, 0xa2,
-	0x2f, 0x5f, 0x48, 0x74, 0xe4, 0xcc, 0x3c, 0x67, 0xfc, 0x7d, 0xef, 0x53, 0x30, 0x6a, 0xec, 0x95,
-	0x1b, 0x55, 0x8c, 0xa2, 0xf1, 0x57, 0x19, 0x45, 0x37, 0x20, 0x6d, 0x76, 0xf4, 0x81, 0xe5, 0xba,
-	0x38, 0x9f, 0xe7, 0xf2, 0x90, 0x43, 0x57, 0xd7, 0x1b, 0x7c, 0xc4, 0x88, 0x78, 0x0a, 0x66, 0xc7,
-	0xeb, 0x25, 0x5f, 0x84, 0x94, 0x73, 0xac, 0x9b, 0x56, 0xc7, 0x38, 0xa1, 0xf9, 0x7b, 0xa2, 0x78,
-	0x35, 0xb4, 0x39, 0x8e, 0xab, 0xd8, 0x2d, 0xec, 0x8c, 0xa4, 0xc3, 0x20, 0xf2, 0x05, 0x48, 0x36,
-	0x5b, 0x7a, 0xa7, 0x3d, 0x70, 0xa7, 0x92, 0x54, 0x90, 0x2b, 0xc1, 0xc9, 0x95, 0xd5, 0xf5, 0xf6,
-	0xc0, 0x2d, 0x03, 0xba, 0x0d, 0xfb, 0xd6, 0xc6, 0x9a, 0x2d, 0xfc, 0x9f, 0xfb, 0xc5, 0xbf, 0x28,
-	0x00, 0xbe, 0xb4, 0xe4, 0xf3, 0x90, 0x75, 0x8e, 0xe7, 0x75, 0xd3, 0xd1, 0x7b, 0xad, 0xd6, 0xc0,
-	0x72, 0xa9, 0x53, 0x64, 0xcb, 0x57, 0x9e, 0x97, 0xe3, 0xb3, 0xd1, 0x29, 0x8c, 0xd2, 0x69, 0xed,
-	0x78, 0xbe, 0xaa, 0x6d, 0xd1, 0x5e, 0x2d, 0xed, 0x1c, 0xcf, 0x57, 0x1d, 0xd6, 0x20, 0x6f, 0xc3,
-	0x98, 0x73, 0x5c, 0xd4, 0x4d, 0x2f, 0xc1, 0x7f, 0x32, 0xa4, 0x15, 0xc3, 0x35, 0x34, 0xc3, 0xb5,
-	0xea, 0xb6, 0x69, 0x1d, 0x97, 0x2f, 0x79, 0xeb, 0x41, 0xe3, 0x69, 0xc7, 0xc5, 0xaa, 0xa6, 0x25,
-	0x9c, 0xe3, 0x62, 0xd5, 0x21, 0x37, 0x20, 0xd9, 0xeb, 0xbb, 0xba, 0x6d, 0xed, 0xb3, 0x98, 0xce,
-	0xc4, 0xdf, 0xea, 0xbb, 0x9b, 0xd6, 0xbe, 0x36, 0xd6, 0xa3, 0xff, 0x73, 0xf1, 0x1f, 0x03, 0x5f,
-	0x16, 0x59, 0x81, 0xf8, 0xcb, 0x42, 0x0c, 0x1b, 0x15, 0x08, 0x31, 0x74, 0x06, 0x21, 0x10, 0x6f,
-	0xb1, 0x34, 0x13, 0xbb, 0x99, 0xd5, 0xe8, 0x37, 0xb9, 0x06, 0xa9, 0xe6, 0x23, 0xbd, 0x6b, 0x0c,
-	0x0e, 0x06, 0x53, 0xb1, 0xeb, 0xb1, 0x9b, 0x29, 0x2d, 0xd9, 0x7c, 0xb4, 0x81, 0x4d, 0xce, 0xf8,
-	0x01, 0x64, 0xd6, 0x7b, 0x9a, 0xe1, 0x2d, 0x09, 0x77, 0xd2, 0x9e, 0x61, 0x9b, 0x8f, 0xdb, 0xa6,
-	0xfb, 0x88, 0x29, 0x4d, 0xf3, 0x01, 0xf2, 0x59, 0x50, 0x07, 0x7d, 0xc7, 0x32, 0x30, 0xff, 0xe8,
-	0x2d, 0xa3, 0xe9, 0xf2, 0x53, 0x50, 0x56, 0xcb, 0x0d, 0xf1, 0x55, 0x0a, 0xcf, 0xdc, 0x84, 0xf4,
-	0x6a, 0xe3, 0xde, 0x90, 0xee, 0x35, 0x48, 0xed, 0xb5, 0x5d, 0xdd, 0x31, 0x5c, 0x8b, 0x93, 0x4d,
-	0xee, 0xb5, 0x5d, 0xec, 0x9a, 0xf9, 0x9e, 0x02, 0xa9, 0xe1, 0xb8, 0x2f, 0x42, 0x1c, 0x57, 0xcb,
-	0x4f, 0x45, 0xaf, 0x07, 0x97, 0x2f, 0xca, 0x5a, 0x4e, 0x9d, 0x3e, 0x99, 0x8e, 0x23, 0xb2, 0x16,
-	0xd1, 0xe8, 0x2c, 0xb2, 0x02, 0xb1, 0xd6, 0xe0, 0x80, 0x1f, 0x0c, 0x3e, 0x11, 0x3a, 0x18, 0xf8,
-	0xf2, 0xb0, 0x44, 0xbd, 0xda, 0xb8, 0xb7, 0x16, 0xd1, 0x70, 0x4a, 0xf9, 0x12, 0x40, 0xb7, 0x67,
-	0x1e, 0x76, 0x0c, 0xb7, 0xdd, 0xb3, 0xe9, 0x69, 0x71, 0xe6, 0x6f, 0xe3, 0x00, 0x3b, 0xc7, 0x43,
-	0x97, 0xaa, 0xc0, 0xb8, 0x69, 0xb8, 0x86, 0xbf, 0x84, 0x74, 0x71, 0xea, 0x45, 0x9e, 0x51, 0xce,
-	0x88, 0xbb, 0x45, 0x4b, 0x99, 0xde, 0xf2, 0xb6, 0x20, 0x37, 0x24, 0xa2, 0xb7, 0xd1, 0x7f, 0xce,
-	0xe7, 0x64, 0xbe, 0xad, 0xb3, 0xa6, 0xd8, 0x41, 0xa6, 0x21, 0xdd, 0xec, 0x51, 0x73, 0x50, 0xb9,
-	0xd0, 0xcf, 0xc6, 0x35, 0x60, 0x90, 0x67, 0xd0, 0x16, 0x3d, 0x61, 0xdb, 0xcd, 0x13, 0x1a, 0x42,
-	0xe2, 0x9a, 0x0f, 0x90, 0xcf, 0x01, 0x58, 0xb6, 0xb1, 0xd7, 0xb1, 0xf4, 0xa6, 0xd3, 0x64, 0xc7,
-	0x08, 0x76, 0x40, 0xa9, 0x51, 0xb4, 0xa2, 0x55, 0x30, 0x90, 0xd2, 0x4f, 0xa7, 0x89, 0xb4, 0x86,
-	0x25, 0x15, 0xdd, 0xe6, 0x59, 0xcd, 0x07, 0xc8, 0x22, 0xc4, 0xb1, 0xc1, 0xb7, 0x70, 0x3e, 0x74,
-	0x6e, 0xdc, 0xf1, 0x46, 0x96, 0xe3, 0xdf, 0xf9, 0x1f, 0x3c, 0xca, 0xe2, 0x68, 0xf2, 0xfb, 0x90,
-	0x32, 0x7b, 0x8f, 0xed, 0x4e, 0xdb, 0x3e, 0x98, 0x4a, 0xd1, 0x99, 0x37, 0x82, 0xaa, 0xf0, 0x8d,
-	0x30, 0x57, 0xe5, 0x43, 0xb5, 0xe1, 0xa4, 0xfc, 0x1f, 0x41, 0xca, 0x43, 0xc9, 0x0d, 0xc8, 0x1a,
-	0xb6, 0x6b, 0xd9, 0xb6, 0xc1, 0x95, 0xcb, 0x5c, 0x2d, 0xc3, 0x41, 0xa6, 0xb2, 0x6b, 0x90, 0x72,
-	0x8f, 0xf5, 0x7e, 0xef, 0xb1, 0xc5, 0x9c, 0x37, 0xaa, 0x25, 0xdd, 0xe3, 0x6d, 0x6c, 0x92, 0xdb,
-	0x30, 0xd9, 0xb6, 0x8f, 0x2c, 0xc7, 0xd5, 0xfb, 0xbd, 0x8e, 0xe1, 0xb4, 0xbf, 0x41, 0xdd, 0x81,
-	0x9f, 0xc8, 0x08, 0xeb, 0xda, 0x16, 0x7a, 0xf8, 0x26, 0xfa, 0x0b, 0x05, 0xae, 0xbd, 0x6d, 0xb8,
-	0xd6, 0x63, 0xe3, 0xa4, 0xc4, 0x39, 0xf9, 0x65, 0x25, 0xd9, 0x85, 0xf4, 0x3e, 0xeb, 0xd4, 0xdb,
-	0xe6, 0x80, 0xbb, 0x4e, 0xa8, 0x1a, 0xe3, 0xf3, 0x85, 0x89, 0xa3, 0x42, 0xee, 0xbe, 0x37, 0x6a,
-	0x10, 0x5e, 0x6b, 0x34, 0xbc, 0xd6, 0x99, 0x6f, 0x40, 0x7a, 0xb7, 0x8f, 0xaa, 0xd9, 0xe9, 0x1d,
-	0x58, 0x36, 0xd9, 0x80, 0x98, 0x2f, 0xc2, 0x67, 0x5f, 0x20, 0x42, 0x78, 0x09, 0x23, 0x24, 0x41,
-	0x3a, 0xb2, 0x3f, 0x44, 0x03, 0xfe, 0x30, 0xf3, 0x6d, 0x05, 0x32, 0x9e, 0x65, 0xb6, 0x0d, 0xf7,
-	0x11, 0xb9, 0x01, 0x99, 0x43, 0x2a, 0x8c, 0xee, 0xa2, 0x34, 0x2c, 0x51, 0xaf, 0x45, 0xb4, 0xf4,
-	0xa1, 0x20, 0x62, 0x09, 0x12, 0xad, 0xf6, 0xb1, 0x65, 0xf2, 0x4d, 0x7c, 0x7e, 0x21, 0xd7, 0x22,
-	0x1a, 0x9b, 0x59, 0x4e, 0x43, 0xbc, 0x8f, 0xfc, 0xe8, 0x2e, 0xfe, 0x76, 0x02, 0xc6, 0x77, 0x8e,
-	0xf9, 0x71, 0x8e, 0xbc, 0x01, 0x09, 0x7a, 0x48, 0x7e, 0x51, 0xc9, 0x57, 0xc1, 0x4e, 0x8d, 0x8d,
-	0x21, 0x15, 0x98, 0xf0, 0xbc, 0x4c, 0x47, 0x82, 0x03, 0x1a, 0x5a, 0x47, 0x44, 0x25, 0x71, 0x95,
-	0x5a, 0xd6, 0x14, 0x5a, 0x03, 0xf2, 0x16, 0x8c, 0xd3, 0x4c, 0x44, 0x53, 0x63, 0xec, 0xbc, 0xa9,
-	0x31, 0x85, 0x09, 0x89, 0xe6, 0xc6, 0xfb, 0x30, 0x49, 0xe7, 0x07, 0xa2, 0x46, 0xfc, 0x62, 0x51,
-	0x43, 0x45, 0x7a, 0x52, 0xe0, 0xb8, 0xc1, 0x32, 0xa4, 0x1f, 0x1b, 0x12, 0x34, 0x36, 0x64, 0x9c,
-	0xe3, 0xf9, 0xd5, 0x61, 0x78, 0xa0, 0xcc, 0x8b, 0x21, 0xe6, 0x63, 0x17, 0x66, 0x5e, 0x1c, 0xc1,
-	0xbc, 0x28, 0x30, 0x4f, 0x7a, 0xcc, 0x8b, 0x3e, 0xf3, 0x35, 0x48, 0xf5, 0x9d, 0x76, 0xcf, 0x69,
-	0xbb, 0x27, 0x34, 0x32, 0x4c, 0x84, 0x37, 0xcd, 0xce, 0x71, 0xa3, 0xf9, 0xc8, 0x32, 0x0f, 0x3b,
-	0xd6, 0x36, 0x1f, 0x29, 0xea, 0xd0, 0x9b, 0x4d, 0x6a, 0x90, 0x35, 0xf6, 0x06, 0xbd, 0xce, 0xa1,
-	0x6b, 0xe9, 0x34, 0x44, 0x8d, 0x9f, 0x33, 0x44, 0x65, 0xbc, 0x69, 0xd8, 0x41, 0x16, 0x20, 0x65,
-	0x98, 0x47, 0x86, 0xdd, 0xb4, 0xcc, 0xa9, 0xe6, 0xcb, 0x8b, 0xe3, 0xe1, 0x40, 0x1e, 0x21, 0xfe,
-	0x78, 0x89, 0x16, 0xff, 0x95, 0x5e, 0xb7, 0x6b, 0xd8, 0x26, 0xa9, 0x43, 0xac, 0xd9, 0x36, 0xb9,
-	0x13, 0x7e, 0x7a, 0xc4, 0x85, 0x0f, 0x1f, 0xe8, 0xbb, 0x37, 0x1e, 0x33, 0x92, 0xdf, 0x52, 0xe2,
-	0xaa, 0x72, 0x3d, 0x82, 0xa9, 0xab, 0x52, 0xaf, 0x6a, 0x48, 0x83, 0x7c, 0x0a, 0xd2, 0x8e, 0xf1,
-	0x78, 0x58, 0xb4, 0x47, 0xf9, 0x9e, 0x02, 0xc7, 0x78, 0xec, 0x9d, 0x8e, 0xcb, 0x30, 0xee, 0x58,
-	0x03, 0x3c, 0xa2, 0xda, 0xde, 0x25, 0xd3, 0x8d, 0x17, 0xf3, 0x9c, 0xd3, 0x70, 0x6c, 0xdd, 0x36,
-	0xd7, 0x22, 0x5a, 0xca, 0xe1, 0xdf, 0xa4, 0x86, 0x87, 0x73, 0xa4, 0xd1, 0xec, 0xd9, 0x2d, 0x7e,
-	0x35, 0xf0, 0xe9, 0xb3, 0x88, 0x54, 0x7a, 0x76, 0x6b, 0x2d, 0xa2, 0x31, 0xee, 0xd8, 0x20, 0x5b,
-	0x30, 0x41, 0xb7, 0x53, 0xf3, 0x91, 0xd5, 0x3c, 0xd0, 0x0d, 0xdb, 0x3b, 0x79, 0x7e, 0xe6, 0x25,
-	0xa4, 0xd6, 0xdb, 0xf6, 0x41, 0x05, 0xc7, 0x97, 0x6c, 0xdc, 0xe4, 0x99, 0x8e, 0xd0, 0x26, 0x0f,
-	0x81, 0xb6, 0x75, 0xac, 0xb3, 0xf1, 0xf0, 0xc3, 0x2e, 0x8f, 0x7e, 0xef, 0x0c, 0x72, 0x58, 0xa1,
-	0x5b, 0x5f, 0x67, 0x17, 0x22, 0x7e, 0x1b, 0xd5, 0x86, 0xc4, 0x4a, 0xa6, 0x83, 0xe5, 0xb8, 0x48,
-	0x1a, 0x25, 0x4d, 0x9e, 0x97, 0x74, 0xc9, 0x1e, 0x48, 0xa4, 0x99, 0xdc, 0x1e, 0x69, 0x94, 0x7a,
-	0x0b, 0x26, 0xcc, 0x43, 0xf7, 0x44, 0x6f, 0x9e, 0x34, 0x3b, 0x16, 0x95, 0x3b, 0x75, 0xa6, 0x1a,
-	0xaa, 0x87, 0xee, 0x49, 0x05, 0xc7, 0x33, 0x49, 0x33, 0xa6, 0xd0, 0x26, 0x0f, 0x81, 0x38, 0xc7,
-	0x7a, 0xdf, 0x70, 0x8c, 0x2e, 0x1e, 0xea, 0x0f, 0xfb, 0x94, 0x28, 0x73, 0xf3, 0xd9, 0x97, 0x99,
-	0xe9, 0x78, 0x1b, 0xe7, 0x34, 0x70, 0x0a, 0xa3, 0x9b, 0x73, 0x64, 0x68, 0x04, 0x69, 0x54, 0x06,
-	0x5c, 0x88, 0x34, 0xd3, 0x80, 0x44, 0xda, 0x53, 0x83, 0x75, 0xa4, 0x0f, 0x5c, 0xc3, 0x3d, 0x1c,
-	0x50, 0xb2, 0xe9, 0xb3, 0xd5, 0x60, 0x1d, 0x35, 0xe8, 0x78, 0xee, 0x0d, 0xa6, 0xd0, 0x26, 0x1a,
-	0xe4, 0x6c, 0xeb, 0xb1, 0xde, 0x7c, 0x64, 0xd8, 0xb6, 0xd5, 0xa1, 0x3a, 0xc8, 0x50, 0x8a, 0x37,
-	0x5f, 0x42, 0x71, 0xd3, 0x7a, 0x5c, 0x61, 0x13, 0x98, 0x06, 0xb2, 0xb6, 0x08, 0x04, 0x69, 0xa2,
-	0x94, 0xd9, 0x0b, 0xd0, 0x64, 0x62, 0x0a, 0x34, 0x51, 0x4e, 0x03, 0x26, 0xcc, 0x8e, 0x24, 0xe6,
-	0xc4, 0xd9, 0x0b, 0x5f, 0xf7, 0x85, 0x2a, 0xab, 0xa7, 0x4f, 0xa6, 0x33, 0x22, 0x42, 0x55, 0xd1,
-	0x11, 0xc4, 0x96, 0x59, 0xa0, 0xd4, 0xb9, 0xf3, 0xb3, 0x40, 0x0f, 0x96, 0x59, 0x78, 0xda, 0xee,
-	0x08, 0xab, 0xf8, 0x2a, 0x66, 0x07, 0x0c, 0xa8, 0x78, 0xfc, 0xf4, 0xbd, 0x4e, 0xa5, 0x7c, 0xde,
-	0x78, 0xa9, 0x6b, 0xec, 0xd0, 0x49, 0x82, 0xdb, 0xa9, 0x4e, 0x00, 0x43, 0xbf, 0x73, 0xc3, 0x2e,
-	0x7d, 0xe9, 0x4c, 0xbf, 0xdb, 0x09, 0xbb, 0xb4, 0x1b, 0x70, 0x69, 0x1a, 0x10, 0x0f, 0xac, 0x13,
-	0x1a, 0x10, 0xc9, 0x39, 0x02, 0xe2, 0x81, 0x75, 0x32, 0x0c, 0x88, 0xec, 0x9b, 0x05, 0x44, 0xa4,
-	0x41, 0x03, 0xe2, 0xe4, 0x39, 0x02, 0xe2, 0x81, 0x75, 0xe2, 0x07, 0x44, 0xde, 0x20, 0x0e, 0x4c,
-	0x62, 0x7c, 0x09, 0x2e, 0xf3, 0xf2, 0x99, 0x3a, 0x2c, 0x55, 0x35, 0x69, 0x51, 0xe5, 0xcb, 0xa7,
-	0x4f, 0xa6, 0xd5, 0x20, 0x8a, 0x9a, 0x35, 0x4c, 0x47, 0x5e, 0xbe, 0x06, 0x39, 0xd3, 0x3a, 0x6a,
-	0x37, 0x59, 0x32, 0xa4, 0xbe, 0xf1, 0xda, 0x99, 0x1e, 0x5d, 0xa5, 0x33, 0x30, 0x0f, 0x72, 0x8f,
-	0x36, 0x45, 0x80, 0xec, 0x82, 0xda, 0xea, 0x39, 0x4d, 0x0c, 0x66, 0xde, 0xa5, 0xfe, 0xd4, 0x95,
-	0xd1, 0x27, 0x38, 0x81, 0xe8, 0x2a, 0x4e, 0x19, 0x5e, 0xb8, 0xad, 0x45, 0xb4, 0x89, 0x96, 0x84,
-	0x10, 0x6b, 0xf8, 0x4a, 0x10, 0xd4, 0xd0, 0x55, 0x4a, 0x7c, 0xee, 0xa5, 0x1a, 0xc7, 0x89, 0x41,
-	0x75, 0x4c, 0x3a, 0x61, 0xf8, 0x05, 0x6c, 0x50, 0x31, 0x53, 0x17, 0x66, 0xc3, 0xd4, 0x13, 0x62,
-	0xc3, 0x92, 0x15, 0xe9, 0xd3, 0xbd, 0xd2, 0xe9, 0x61, 0x32, 0x6e, 0xf5, 0xe8, 0x4a, 0xae, 0x9d,
-	0xe9, 0xd2, 0xdb, 0xb8, 0x2f, 0x3a, 0x3d, 0xb7, 0x6e, 0xb7, 0x7a, 0xdc, 0xa5, 0xfb, 0x32, 0x44,
-	0xf6, 0xe0, 0x35, 0x9f, 0xb4, 0x18, 0x58, 0xf2, 0x94, 0xfa, 0xad, 0x73, 0x50, 0x97, 0x82, 0x09,
-	0xe9, 0x87, 0xd0, 0xd1, 0x3c, 0x50, 0x49, 0x9f, 0xb8, 0x28, 0x0f, 0xa6, 0xa3, 0x20, 0x0f, 0x54,
-	0xd1, 0xbb, 0x70, 0x69, 0xcf, 0x32, 0x9a, 0x3d, 0xdb, 0x8b, 0x2b, 0x48, 0xff, 0xf5, 0x33, 0x35,
-	0x54, 0xa6, 0x73, 0x58, 0x04, 0xe1, 0xc9, 0x66, 0x4f, 0x86, 0xd0, 0xeb, 0x39, 0x65, 0x3c, 0x76,
-	0x52, 0xdd, 0x7c, 0xf2, 0x4c, 0xaf, 0x67, 0x74, 0xf1, 0x4c, 0xca, 0x73, 0xc3, 0x9e, 0x08, 0x04,
-	0x69, 0xa2, 0xac, 0x85, 0x0b, 0xd0, 0xe4, 0x3b, 0x69, 0x4f, 0x04, 0x84, 0xdd, 0xd9, 0xed, 0x99,
-	0xf4, 0xc4, 0x3d, 0x35, 0x7d, 0xce, 0xdd, 0xb9, 0xd1, 0x33, 0x2d, 0x16, 0xa7, 0xf8, 0xee, 0xe4,
-	0x00, 0xee, 0x4e, 0x91, 0x26, 0x0d, 0x59, 0xd7, 0xcf, 0xdc, 0x9d, 0x3e, 0x51, 0x1e, 0xb7, 0x26,
-	0x4c, 0x09, 0xc9, 0xbf, 0x0b, 0x29, 0xef, 0xb0, 0x48, 0x56, 0x21, 0xdb, 0x6d, 0xdb, 0x3d, 0x47,
-	0x3f, 0xb2, 0x9c, 0x01, 0x16, 0xcd, 0x2f, 0x7a, 0x54, 0xc3, 0x41, 0x65, 0xf0, 0x4e, 0xb3, 0x53,
-	0x8a, 0x96, 0xa1, 0xf3, 0xee, 0xb3, 0x69, 0xec, 0xbc, 0x9c, 0x7f, 0x08, 0xe3, 0xc3, 0x13, 0xe4,
-	0x2b, 0x26, 0x6d, 0x41, 0x46, 0x3c, 0x51, 0x92, 0xeb, 0x30, 0xd6, 0x35, 0x9c, 0xfd, 0xb6, 0xcd,
-	0xef, 0x08, 0xf9, 0x5b, 0xda, 0xff, 0x29, 0x1a, 0xc7, 0xc9, 0x2d, 0xc8, 0x7a, 0x05, 0x7c, 0xb3,
-	0x77, 0x68, 0x87, 0x1f, 0xdd, 0x32, 0xbc, 0xbb, 0x82, 0xbd, 0x9c, 0xcd, 0x8f, 0xa2, 0x20, 0x1c,
-	0x2d, 0x47, 0x5d, 0xfc, 0x28, 0x1f, 0xeb, 0xe2, 0xe7, 0x16, 0x4c, 0x78, 0xb7, 0x18, 0x62, 0xfd,
-	0x4f, 0x9f, 0xab, 0x66, 0xa3, 0x53, 0x39, 0x2d, 0xc3, 0x2f, 0x35, 0xd8, 0xf0, 0x37, 0x20, 0xe3,
-	0xed, 0xd8, 0xae, 0x31, 0x38, 0x60, 0x97, 0x81, 0x94, 0xfa, 0x77, 0x95, 0xa8, 0xaa, 0x6a, 0x69,
-	0xde, 0xbb, 0x61, 0x0c, 0x0e, 0xc8, 0x9b, 0x70, 0x59, 0x1c, 0x8c, 0xfe, 0xe2, 0x3a, 0xbd, 0x0e,
-	0xbb, 0x93, 0xf7, 0x38, 0x24, 0x35, 0x22, 0xcc, 0xa9, 0xb0, 0x21, 0x64, 0x06, 0x52, 0xf6, 0x9e,
-	0xee, 0x3a, 0xb8, 0x15, 0xc6, 0x64, 0x81, 0x92, 0xf6, 0xde, 0x0e, 0xe2, 0x4c, 0x41, 0xef, 0xc4,
-	0x53, 0x71, 0x35, 0x91, 0xff, 0xae, 0x02, 0xc2, 0x31, 0x99, 0xdc, 0x04, 0x55, 0xe2, 0x6c, 0x34,
-	0x0f, 0xd8, 0xcb, 0x9a, 0x36, 0x21, 0x30, 0x2b, 0x35, 0x0f, 0xc8, 0x2d, 0x98, 0x0c, 0x28, 0x94,
-	0x0e, 0xa6, 0x6f, 0x6c, 0x9a, 0x2a, 0xe9, 0x0a, 0x87, 0xbf, 0xc1, 0x4e, 0x13, 0xbe, 0xba, 0x74,
-	0xff, 0xa9, 0x2d, 0x27, 0x6a, 0xaa, 0xd4, 0x3c, 0xc8, 0x37, 0x21, 0x23, 0x9e, 0xb6, 0x49, 0x03,
-	0x26, 0xba, 0xc6, 0xb1, 0xee, 0x1f, 0xd9, 0xb9, 0xed, 0x42, 0x87, 0x86, 0xd2, 0xfe, 0xbe, 0x63,
-	0xa1, 0x33, 0x98, 0xc3, 0xf9, 0x82, 0x05, 0x33, 0x5d, 0xe3, 0x78, 0x88, 0xe7, 0xff, 0x53, 0x81,
-	0x5c, 0xe0, 0xf8, 0xfd, 0xa2, 0x7a, 0x5b, 0xf9, 0xb8, 0xf5, 0xf6, 0x0a, 0x5c, 0x96, 0x2f, 0x11,
-	0xf8, 0xad, 0x78, 0x54, 0x36, 0xe8, 0x25, 0xe1, 0x96, 0x80, 0x5f, 0x86, 0xcf, 0x05, 0x2b, 0x75,
-	0x54, 0x59, 0x9c, 0xbe, 0x9a, 0x16, 0xe3, 0x37, 0x7f, 0xf8, 0x67, 0x63, 0x72, 0xd1, 0xce, 0x9d,
-	0xff, 0xef, 0x02, 0x6b, 0x43, 0xd3, 0x2e, 0xc2, 0xd5, 0x11, 0x6b, 0x13, 0x2c, 0x3c, 0x19, 0x14,
-	0x1b, 0xed, 0xb6, 0x0c, 0x53, 0xa3, 0x24, 0x17, 0x6c, 0x7d, 0x39, 0x24, 0x34, 0xce, 0x9b, 0x85,
-	0x4b, 0x92, 0xdc, 0xa2, 0xb9, 0x45, 0x81, 0xd1, 0xdc, 0x26, 0x64, 0xc4, 0xaa, 0x82, 0xcc, 0x40,
-	0x72, 0xcf, 0x70, 0x5d, 0xcb, 0x39, 0x91, 0x43, 0xc2, 0x47, 0x8a, 0xe6, 0x75, 0x90, 0xd9, 0x61,
-	0xd4, 0x40, 0x29, 0x12, 0x65, 0xf2, 0xbc, 0x9c, 0xcb, 0x67, 0xa7, 0xa6, 0x6f, 0xfe, 0xf2, 0x23,
-	0xfe, 0x6f, 0x18, 0x3f, 0xb8, 0x4e, 0xfe, 0x2a, 0x0a, 0x59, 0xa9, 0xd4, 0xc0, 0xb8, 0xe2, 0x39,
-	0xbb, 0x70, 0x5b, 0x29, 0xc6, 0x15, 0xde, 0xcd, 0x8c, 0xf8, 0x19, 0xf1, 0x26, 0x37, 0x1a, 0x34,
-	0x83, 0x70, 0xa9, 0x7b, 0x1f, 0x26, 0xbb, 0x6d, 0x3b, 0xe4, 0x45, 0xb1, 0x0b, 0x7a, 0x51, 0xb7,
-	0x6d, 0xcb, 0x5e, 0x84, 0x74, 0x71, 0x1b, 0x7c, 0xcc, 0xab, 0x28, 0xdc, 0x05, 0x62, 0x1f, 0xd7,
-	0xcf, 0xbb, 0xa2, 0x7a, 0xd0, 0x0c, 0x37, 0x20, 0x2b, 0x9b, 0x8f, 0xb9, 0x49, 0xa6, 0x25, 0xd8,
-	0x8e, 0xcc, 0x40, 0xd6, 0x97, 0xc7, 0x77, 0x8a, 0xb4, 0x17, 0x00, 0xd0, 0xbe, 0x1d, 0x90, 0x4a,
-	0xa5, 0xdf, 0x95, 0xde, 0xf9, 0x3a, 0x74, 0x90, 0xaa, 0x26, 0xf4, 0x44, 0x89, 0x9b, 0xb0, 0x94,
-	0x9c, 0xc8, 0x07, 0x57, 0x13, 0x5a, 0x72, 0x34, 0xbc, 0xe4, 0xfc, 0x3d, 0x50, 0x83, 0x05, 0x14,
-	0xb9, 0x0b, 0x09, 0x76, 0xc3, 0xa8, 0x9c, 0xf7, 0x86, 0x91, 0x8d, 0xcf, 0xff, 0x9b, 0x02, 0xb9,
-	0x40, 0xc5, 0x44, 0xde, 0x63, 0xe1, 0xce, 0x6a, 0x3b, 0x7d, 0x29, 0x00, 0x85, 0x9f, 0x07, 0xe9,
-	0x71, 0xa0, 0x56, 0xd7, 0xb6, 0xcb, 0x53, 0xc2, 0x2b, 0x58, 0x66, 0xc3, 0x38, 0x46, 0x90, 0x2e,
-	0x8b, 0x46, 0xbd, 0x5a, 0xdb, 0xe9, 0x33, 0x65, 0xce, 0xc2, 0x25, 0x7e, 0x07, 0x6c, 0x3e, 0xb6,
-	0x3a, 0x1d, 0x76, 0x1d, 0xc7, 0x56, 0x99, 0x63, 0x1d, 0x55, 0xc4, 0xe9, 0x7d, 0xdb, 0x1c, 0x4c,
-	0x0e, 0xef, 0x5f, 0x85, 0xd1, 0x6c, 0x17, 0x5f, 0xf2, 0xba, 0x86, 0xe3, 0xf3, 0xdb, 0x78, 0x1c,
-	0xe1, 0xe5, 0x59, 0xf5, 0x42, 0x67, 0x06, 0x31, 0x46, 0x0b, 0x27, 0x86, 0xfc, 0x57, 0xf0, 0x18,
-	0xe2, 0x95, 0x6a, 0xaf, 0x86, 0xe4, 0x07, 0x51, 0x08, 0x55, 0x69, 0xe4, 0x04, 0xae, 0x78, 0x3f,
-	0x14, 0xe9, 0xb4, 0xbb, 0x6d, 0x57, 0xb7, 0x8e, 0xfb, 0x3d, 0xdb, 0xb2, 0xdd, 0x17, 0x26, 0x1a,
-	0xfa, 0xfb, 0x91, 0x75, 0x1c, 0x5b, 0xe3, 0x43, 0xcb, 0xd3, 0x82, 0x09, 0x26, 0x47, 0x0c, 0xd0,
-	0x26, 0xd9, 0x4f, 0x4d, 0x24, 0x50, 0x64, 0x4d, 0x3d, 0xc2, 0x67, 0x1d, 0x7d, 0x19, 0x6b, 0xea,
-	0x4e, 0x2f, 0x63, 0x2d, 0x0d, 0xf0, 0x58, 0x4b, 0x60, 0xfe, 0x2b, 0x90, 0x95, 0xaa, 0x4a, 0xf2,
-	0xe5, 0x73, 0xbf, 0x20, 0xa9, 0xcf, 0xcb, 0x89, 0x7f, 0x54, 0xa2, 0x29, 0xfa, 0x20, 0xe1, 0xbf,
-	0x26, 0xe5, 0xff, 0x21, 0x0a, 0x13, 0x72, 0x51, 0xf9, 0xaa, 0x7e, 0xba, 0xf1, 0xca, 0x5f, 0xee,
-	0x6e, 0x42, 0x1a, 0x77, 0x99, 0x63, 0xb9, 0x4e, 0xdb, 0x1a, 0xf0, 0x5f, 0x23, 0x0d, 0x53, 0x31,
-	0x74, 0x8d, 0x63, 0x8d, 0x75, 0x91, 0x07, 0x90, 0xeb, 0x5b, 0x4e, 0xbb, 0x67, 0xfa, 0xb6, 0x89,
-	0x8f, 0xbe, 0x39, 0xe6, 0xb5, 0x28, 0x1d, 0x3c, 0x34, 0x8e, 0x2f, 0xc1, 0x44, 0x5f, 0xea, 0xe1,
-	0x01, 0xeb, 0xdf, 0x15, 0x98, 0x1c, 0x51, 0x2b, 0x93, 0x3f, 0x00, 0x82, 0x02, 0xd2, 0x23, 0xef,
-	0x99, 0x0e, 0xc9, 0x08, 0xd0, 0x03, 0xf0, 0x08, 0xc6, 0x18, 0xf3, 0xa5, 0x3e, 0xac, 0xf3, 0x90,
-	0x38, 0xbd, 0x80, 0x08, 0x78, 0xdc, 0xcc, 0x0b, 0x6c, 0xd3, 0xee, 0x5a, 0x23, 0x48, 0xe7, 0xba,
-	0xc6, 0xb1, 0xd8, 0x95, 0x5f, 0x0b, 0xaf, 0x06, 0x7d, 0x6b, 0x1e, 0x5e, 0x0b, 0x31, 0x14, 0x42,
-	0x31, 0x09, 0x90, 0xc1, 0x40, 0xdb, 0x80, 0x5c, 0xa0, 0xf2, 0x26, 0x5f, 0x86, 0x31, 0xa6, 0x43,
-	0xae, 0x87, 0x42, 0x50, 0x56, 0x6f, 0x02, 0xb3, 0x81, 0x20, 0x27, 0x9f, 0x97, 0xff, 0x73, 0x05,
-	0x48, 0xb8, 0xe2, 0x96, 0x93, 0x8c, 0xf2, 0x92, 0xe4, 0xfe, 0xaa, 0xfd, 0x90, 0x3b, 0xc1, 0xa3,
-	0x90, 0x54, 0xe7, 0x4e, 0xc1, 0x17, 0x3b, 0x89, 0xe7, 0xf7, 0x21, 0x17, 0xa8, 0xd6, 0xc9, 0xb4,
-	0x98, 0xbd, 0xa4, 0x9f, 0xe4, 0x31, 0x3c, 0x9c, 0xb1, 0xa3, 0x2f, 0xcb, 0xd8, 0x7c, 0x49, 0x6f,
-	0x41, 0x56, 0x2a, 0xdf, 0xcf, 0xad, 0x63, 0x3e, 0x7f, 0x51, 0x9c, 0x7f, 0x5e, 0x6d, 0xe4, 0x57,
-	0xbd, 0xa0, 0xe6, 0xd5, 0xde, 0x4b, 0xe7, 0x79, 0x72, 0x14, 0x13, 0x33, 0x1d, 0x9d, 0x7f, 0x1b,
-	0x26, 0xe4, 0xfa, 0xfb, 0xb7, 0x24, 0xc4, 0x7f, 0x0a, 0x3b, 0x0e, 0x49, 0xfe, 0x44, 0x34, 0xd3,
-	0x00, 0x22, 0xb9, 0xc6, 0x7d, 0xa3, 0x73, 0x68, 0x91, 0x2f, 0x41, 0xe2, 0x08, 0x3f, 0x2e, 0x5a,
-	0x6c, 0xb0, 0x59, 0x33, 0xbb, 0x30, 0x29, 0xbb, 0x3e, 0xa3, 0xfa, 0x96, 0x4c, 0xf5, 0xfc, 0xdb,
-	0x85, 0x93, 0xd5, 0x61, 0x6a, 0x44, 0x4d, 0xc5, 0x68, 0x57, 0x64, 0xda, 0x17, 0x2c, 0xc6, 0x38,
-	0x83, 0xb7, 0x21, 0xc3, 0xcf, 0x46, 0x8c, 0xe8, 0x5d, 0x99, 0xe8, 0x79, 0x0e, 0x52, 0xbe, 0xa4,
-	0xe1, 0x9c, 0x7b, 0x4e, 0x49, 0x47, 0x64, 0xf3, 0x17, 0x33, 0x90, 0x92, 0xe8, 0x45, 0x18, 0xc8,
-	0x39, 0x3b, 0xc8, 0x60, 0xf6, 0x07, 0x0a, 0x24, 0xe8, 0x4f, 0x9e, 0x89, 0x0a, 0x99, 0x77, 0xb6,
-	0xea, 0x9b, 0xba, 0x56, 0xfb, 0xca, 0x6e, 0xad, 0xb1, 0xa3, 0x46, 0x48, 0x0e, 0xd2, 0x14, 0x29,
-	0x55, 0x2a, 0xb5, 0xed, 0x1d, 0x55, 0x21, 0x04, 0x26, 0x76, 0x37, 0x2b, 0x5b, 0x9b, 0xab, 0x75,
-	0x6d, 0xa3, 0x56, 0xd5, 0x77, 0xb7, 0xd5, 0x28, 0xb9, 0x0c, 0xaa, 0x88, 0x55, 0xb7, 0x1e, 0x6c,
-	0xaa, 0x31, 0x24, 0x26, 0x8d, 0x8b, 0xe3, 0xdc, 0xc0, 0xa8, 0x04, 0x62, 0x5a, 0x4d, 0x62, 0x3a,
-	0x86, 0x4c, 0xb7, 0xb5, 0xad, 0x6d, 0xad, 0x5e, 0xdb, 0x29, 0x69, 0x0f, 0xd5, 0xe4, 0xec, 0x55,
-	0x48, 0xd0, 0x1f, 0x57, 0x93, 0x09, 0x80, 0xf5, 0x2d, 0xad, 0xf4, 0xa0, 0xb4, 0xa9, 0x6b, 0xf3,
-	0x6a, 0x64, 0x76, 0x40, 0x5f, 0x57, 0xf9, 0x11, 0x0b, 0xe7, 0x6d, 0x94, 0x2a, 0xfa, 0xee, 0xe6,
-	0xbd, 0x4d, 0x24, 0x1e, 0x21, 0x19, 0x48, 0x21, 0x70, 0x7f, 0x5e, 0xbf, 0xa3, 0x2a, 0x38, 0xd9,
-	0x6b, 0xe9, 0xf3, 0x6a, 0x54, 0x6a, 0x17, 0xd5, 0x98, 0x30, 0x7a, 0x5e, 0x8d, 0x4b, 0xbd, 0x0b,
-	0x6a, 0x22, 0x9f, 0xfa, 0xe0, 0x6f, 0x0a, 0x91, 0x9f, 0xfc, 0xa8, 0x10, 0x99, 0xfd, 0x7b, 0x05,
-	0x60, 0x7b, 0xed, 0xa1, 0xc0, 0x75, 0x7b, 0xed, 0xa1, 0xcc, 0x15, 0x01, 0x9f, 0xab, 0xd7, 0xa2,
-	0x5c, 0x2f, 0x83, 0x3a, 0x6c, 0x17, 0x75, 0xad, 0x76, 0x5f, 0x2f, 0xa9, 0xb1, 0x11, 0x68, 0x99,
-	0x29, 0x8c, 0xa3, 0xf3, 0x7c, 0x64, 0x22, 0x84, 0x95, 0xd5, 0x31, 0x69, 0xf6, 0x02, 0x1f, 0x99,
-	0x14, 0x25, 0x8e, 0x42, 0x56, 0x2e, 0xe9, 0x72, 0x90, 0xae, 0x96, 0x76, 0x4a, 0xba, 0x56, 0xda,
-	0xa9, 0xe9, 0x77, 0x98, 0xa1, 0x7d, 0x60, 0x5e, 0x55, 0x64, 0xa0, 0xa8, 0x46, 0x65, 0x60, 0x41,
-	0x8d, 0xc9, 0xc0, 0xa2, 0x1a, 0x97, 0x81, 0x25, 0x35, 0x21, 0x03, 0xcb, 0xcc, 0xb2, 0x3e, 0x70,
-	0x57, 0x4d, 0xca, 0xc0, 0x8a, 0x9a, 0x92, 0x81, 0x37, 0xd5, 0x71, 0x74, 0x23, 0x41, 0xb0, 0x3b,
-	0x2a, 0x04, 0x90, 0x79, 0x35, 0x1d, 0x40, 0x8a, 0x6a, 0x26, 0x80, 0x2c, 0xa8, 0xd9, 0x00, 0xb2,
-	0xa8, 0x4e, 0x04, 0x90, 0x25, 0x35, 0x27, 0x68, 0xec, 0x0e, 0x80, 0x7f, 0x32, 0x24, 0x69, 0x48,
-	0x56, 0xb6, 0x36, 0x77, 0x6a, 0xef, 0xe2, 0x96, 0x48, 0x43, 0xb2, 0x51, 0x6b, 0x34, 0xea, 0x5b,
-	0x9b, 0xaa, 0x42, 0x52, 0x10, 0xbf, 0x57, 0x7b, 0xd8, 0x50, 0xa3, 0x38, 0xc3, 0xff, 0x8d, 0x1e,
-	0x2e, 0x63, 0x95, 0x3a, 0xf4, 0x66, 0xa5, 0x5e, 0x6b, 0xa8, 0x11, 0x72, 0x09, 0xb2, 0x95, 0xb5,
-	0xd2, 0xe6, 0x66, 0x6d, 0x5d, 0xdf, 0x28, 0x35, 0xee, 0x35, 0x54, 0x65, 0x76, 0x11, 0x12, 0x34,
-	0x74, 0x53, 0xf2, 0xeb, 0xa5, 0x46, 0x43, 0x2f, 0x31, 0xf2, 0xac, 0x51, 0x56, 0x15, 0xbf, 0x51,
-	0x51, 0xa3, 0xf9, 0x38, 0x4a, 0x37, 0xdb, 0x07, 0x12, 0xfe, 0xf5, 0x03, 0x01, 0x18, 0x5b, 0xdf,
-	0x7a, 0xc0, 0xf6, 0x6c, 0x12, 0x62, 0xeb, 0x5b, 0x0f, 0x54, 0x05, 0x17, 0x58, 0xae, 0xad, 0x6f,
-	0x3d, 0xd0, 0x37, 0xb7, 0xb4, 0x8d, 0xd2, 0xba, 0x1a, 0xc5, 0x61, 0xfc, 0x9b, 0xee, 0xcf, 0x52,
-	0x79, 0xeb, 0x7e, 0xcd, 0xeb, 0x8d, 0xe3, 0x62, 0xd6, 0xea, 0x6f, 0xaf, 0xa9, 0x09, 0xe4, 0x8b,
-	0x5f, 0x74, 0x3b, 0xce, 0xfe, 0x77, 0x0c, 0x2e, 0x8f, 0xfa, 0x69, 0x02, 0xc9, 0xc2, 0x78, 0xa5,
-	0x5e, 0xd5, 0xb5, 0xd5, 0x5d, 0xea, 0x42, 0x5e, 0xb3, 0xd6, 0xa8, 0xf1, 0x48, 0x81, 0xcd, 0xf5,
-	0xfa, 0xe6, 0x3d, 0xbd, 0xb2, 0x56, 0xab, 0xdc, 0x53, 0xa3, 0x34, 0x26, 0x78, 0x58, 0xa9, 0xaa,
-	0xa9, 0x31, 0x6f, 0x54, 0x75, 0x77, 0xe7, 0xa1, 0x5e, 0x79, 0x58, 0x59, 0xaf, 0xa9, 0x71, 0x72,
-	0x05, 0x08, 0x25, 0xf4, 0xae, 0xbe, 0x5d, 0xd2, 0x4a, 0x1b, 0x7a, 0xa3, 0xb6, 0xb3, 0xbb, 0xcd,
-	0x5c, 0x9f, 0x8e, 0xad, 0xdd, 0xd7, 0x1b, 0x3b, 0xa5, 0x9d, 0xdd, 0x86, 0x3a, 0x46, 0x26, 0x21,
-	0x87, 0xd8, 0x66, 0xed, 0x81, 0xce, 0xf5, 0xab, 0x26, 0xc9, 0x55, 0x98, 0xe4, 0x04, 0x76, 0xea,
-	0x1b, 0xf5, 0xcd, 0xb7, 0x39, 0x85, 0x94, 0x47, 0x79, 0x47, 0xa6, 0x3c, 0x3e, 0xa4, 0xbc, 0x3e,
-	0x24, 0x02, 0xfe, 0x72, 0xee, 0xd5, 0x1e, 0xaa, 0x69, 0x8f, 0x66, 0xa9, 0xaa, 0x49, 0x73, 0x33,
-	0x9e, 0x04, 0xd5, 0xda, 0xfd, 0x7a, 0xa5, 0x86, 0x0c, 0x6b, 0x6a, 0x16, 0x77, 0x24, 0x82, 0xab,
-	0x5b, 0x5a, 0xa5, 0xa6, 0xb3, 0x00, 0xa7, 0x4e, 0x90, 0x3c, 0x5c, 0x61, 0x24, 0x69, 0xc0, 0x13,
-	0xc9, 0xe4, 0x3c, 0xd1, 0xb6, 0xa9, 0xb8, 0xeb, 0x5b, 0x3b, 0x7a, 0x7d, 0x73, 0x75, 0x4b, 0x55,
-	0xc9, 0x35, 0x78, 0x4d, 0xc6, 0x3d, 0x09, 0x2f, 0x91, 0xd7, 0xe0, 0x12, 0x76, 0x95, 0x6b, 0xa5,
-	0xca, 0xd6, 0x26, 0x5f, 0xaa, 0x4a, 0x3c, 0x81, 0x38, 0x8c, 0x6e, 0xa8, 0x4e, 0x06, 0xa4, 0xdc,
-	0xd8, 0xaa, 0xd6, 0xd4, 0xeb, 0xdc, 0xa3, 0x7e, 0x11, 0x85, 0xc9, 0x11, 0x39, 0x93, 0xee, 0x8f,
-	0xa1, 0x59, 0xf4, 0x79, 0x35, 0x12, 0x40, 0x8a, 0xcc, 0xc5, 0x04, 0x64, 0x91, 0x99, 0x58, 0x40,
-	0x56, 0xd4, 0x18, 0xba, 0xbe, 0x48, 0x67, 0x59, 0x8d, 0x07, 0xa0, 0x85, 0xa2, 0x9a, 0x08, 0x40,
-	0xcb, 0x8b, 0xea, 0x18, 0x5a, 0x45, 0x9c, 0x58, 0x5c, 0x51, 0x93, 0x01, 0xac, 0xb8, 0xb4, 0xac,
-	0xa6, 0x02, 0xd8, 0xd2, 0x7c, 0x51, 0x1d, 0xc7, 0xf5, 0x8a, 0x73, 0xef, 0x14, 0x17, 0x55, 0x08,
-	0x80, 0xc5, 0x3b, 0x8b, 0x2b, 0x6a, 0x3a, 0x00, 0x2e, 0xde, 0x79, 0x73, 0x99, 0x19, 0x55, 0x5c,
-	0xc5, 0xfc, 0x9b, 0x45, 0x66, 0x54, 0x69, 0x21, 0x0b, 0x2b, 0x18, 0x46, 0x64, 0x74, 0xa1, 0x78,
-	0x77, 0x79, 0x45, 0xcd, 0x71, 0xd5, 0xfe, 0x93, 0x02, 0x13, 0xf2, 0x51, 0x07, 0xd7, 0x49, 0x6d,
-	0x59, 0xbb, 0x5f, 0xd3, 0x1e, 0xea, 0xf3, 0x3c, 0x36, 0x08, 0x50, 0xb1, 0xa1, 0x2a, 0x01, 0x68,
-	0xb1, 0xa1, 0x46, 0x03, 0xd0, 0x4a, 0x83, 0x6d, 0x1e, 0x91, 0xd6, 0x72, 0x83, 0xe7, 0x0c, 0x1f,
-	0x5b, 0x28, 0x36, 0x78, 0xce, 0xf0, 0xb1, 0xe5, 0x45, 0xbe, 0x71, 0xc4, 0xb9, 0xc5, 0x95, 0x86,
-	0x9a, 0xe4, 0x52, 0xff, 0x69, 0xcc, 0x2b, 0xa5, 0xe4, 0xda, 0x6d, 0x12, 0x72, 0xdc, 0x75, 0x2b,
-	0x5b, 0xbb, 0x9b, 0x3b, 0x68, 0xca, 0x48, 0x08, 0x5c, 0x40, 0xb7, 0x08, 0x82, 0xcb, 0x8b, 0x2c,
-	0xf3, 0xc9, 0xd3, 0x8b, 0x2b, 0x2c, 0xf3, 0x49, 0x28, 0x9a, 0x34, 0x1e, 0x42, 0xd1, 0xa8, 0x09,
-	0x74, 0x78, 0x99, 0x02, 0x9a, 0x75, 0x2c, 0x04, 0x53, 0xc3, 0x26, 0x43, 0x30, 0x35, 0x6d, 0x2a,
-	0x04, 0x53, 0xe3, 0x8e, 0xe3, 0xfe, 0x0b, 0x2c, 0x0e, 0xcd, 0x0b, 0x21, 0x9c, 0x19, 0x38, 0x1d,
-	0xc2, 0x97, 0x97, 0x96, 0x16, 0xd0, 0x73, 0xae, 0xc2, 0xa4, 0x4c, 0x67, 0x61, 0xfe, 0xce, 0x5d,
-	0xf4, 0x9e, 0x60, 0x47, 0x71, 0xb9, 0x38, 0xbf, 0x88, 0x0e, 0x14, 0xec, 0x58, 0x2a, 0x2e, 0x16,
-	0x57, 0x7c, 0x1f, 0xfa, 0x30, 0x0a, 0x24, 0x5c, 0x09, 0xa3, 0x3b, 0xf0, 0x59, 0x18, 0x72, 0x68,
-	0x00, 0x0e, 0x40, 0xf3, 0xcc, 0x8f, 0x44, 0xa8, 0xc8, 0xfc, 0x48, 0x84, 0x16, 0xd8, 0x0e, 0x15,
-	0xa1, 0x45, 0xb6, 0x43, 0x45, 0x68, 0x89, 0xed, 0x50, 0x11, 0xc2, 0x7c, 0x1e, 0x80, 0x30, 0xa3,
-	0x07, 0x20, 0xcc, 0xe9, 0x01, 0xe8, 0x4d, 0x16, 0x70, 0x25, 0x51, 0x31, 0xaf, 0x07, 0x31, 0xcc,
-	0xec, 0x41, 0x0c, 0x73, 0x7b, 0x10, 0xc3, 0xec, 0x1e, 0xc4, 0x50, 0xaf, 0x41, 0x6c, 0x69, 0xa8,
-	0xd2, 0x7f, 0x55, 0xbc, 0x3f, 0xf7, 0x91, 0xaf, 0x4c, 0x04, 0xbf, 0xdd, 0xae, 0x69, 0xf5, 0xad,
-	0x2a, 0x55, 0x6b, 0x08, 0x9c, 0x97, 0x3c, 0x9c, 0x83, 0xa8, 0xda, 0x10, 0x88, 0xca, 0x0d, 0x81,
-	0xa8, 0xde, 0x10, 0x88, 0x0a, 0x0e, 0x81, 0xcb, 0x6c, 0x9f, 0xca, 0xe0, 0xdd, 0xe1, 0x3e, 0xfd,
-	0x8f, 0x28, 0x80, 0x7f, 0x15, 0x4b, 0x23, 0x28, 0x0b, 0xef, 0xd8, 0xd4, 0x57, 0xd4, 0x08, 0x8d,
-	0x8c, 0x02, 0x34, 0x7f, 0x87, 0xe5, 0x65, 0x09, 0x43, 0xc1, 0x83, 0xd8, 0x02, 0x0b, 0x2e, 0x12,
-	0xb6, 0xc8, 0x82, 0x8b, 0x84, 0x2d, 0xb3, 0xe0, 0x22, 0x61, 0x2b, 0x3c, 0x72, 0x0b, 0x58, 0xf1,
-	0x0e, 0x8f, 0xdc, 0x22, 0x36, 0xcf, 0x23, 0xb7, 0x88, 0x2d, 0x32, 0xd7, 0x90, 0xb0, 0x65, 0xe6,
-	0x1a, 0x12, 0x76, 0x97, 0xb9, 0x86, 0x84, 0xbd, 0xc9, 0x5c, 0x43, 0xc4, 0x16, 0xee, 0x30, 0xd7,
-	0x90, 0xb0, 0x05, 0xe6, 0x1a, 0x12, 0xb6, 0x3c, 0x74, 0x8d, 0x0f, 0x62, 0x30, 0xea, 0x0e, 0x15,
-	0xcd, 0x80, 0xa9, 0xbf, 0x54, 0xb9, 0xa7, 0xaf, 0xd7, 0x37, 0xea, 0x3b, 0x34, 0x1f, 0x86, 0x40,
-	0x1e, 0xfb, 0x64, 0x70, 0x91, 0x79, 0x86, 0x0c, 0xf2, 0xd0, 0x17, 0xa0, 0xc9, 0x43, 0x9f, 0x8c,
-	0xd2, 0xf4, 0x18, 0x42, 0x97, 0x79, 0xe4, 0x0b, 0x50, 0x28, 0xf2, 0xc8, 0x17, 0x90, 0x6b, 0x89,
-	0x47, 0x3e, 0x19, 0x66, 0xa9, 0xf2, 0x0a, 0x90, 0x00, 0x11, 0x96, 0x2d, 0x43, 0x38, 0x4f, 0x98,
-	0x21, 0x9c, 0xe7, 0xcc, 0x10, 0xce, 0xd3, 0xe6, 0x55, 0xaa, 0x51, 0x69, 0x99, 0x2c, 0x73, 0x86,
-	0x3a, 0xe4, 0xe4, 0xe9, 0x9b, 0x42, 0x2a, 0x60, 0x45, 0x5d, 0x56, 0x6b, 0xeb, 0xa5, 0x87, 0x41,
-	0x53, 0x30, 0x30, 0x60, 0x0a, 0x06, 0x06, 0x4c, 0xc1, 0xc0, 0x80, 0x29, 0x38, 0xcd, 0x80, 0x29,
-	0x18, 0x1a, 0x34, 0x05, 0x43, 0x83, 0xa6, 0xe0, 0x14, 0x82, 0xa6, 0xe0, 0x72, 0x05, 0x4d, 0xc1,
-	0xe0, 0x90, 0x29, 0x38, 0x91, 0x90, 0x29, 0x38, 0x95, 0x90, 0x29, 0xf8, 0x02, 0x43, 0xa6, 0xe0,
-	0x6b, 0x0c, 0x99, 0xc2, 0x5b, 0x66, 0xc8, 0x14, 0xde, 0x4a, 0x45, 0x53, 0x7c, 0x3f, 0x0a, 0x49,
-	0x7e, 0x03, 0x82, 0x05, 0xad, 0xf6, 0x2e, 0x1f, 0x85, 0xe1, 0x51, 0x6c, 0xcf, 0xb3, 0x82, 0x77,
-	0xd8, 0x2e, 0xb2, 0x32, 0x7b, 0xd8, 0xc6, 0xb8, 0x22, 0xb6, 0x17, 0x59, 0xa1, 0x3d, 0x6c, 0x63,
-	0x14, 0x14, 0xdb, 0x18, 0x00, 0xc5, 0x36, 0x26, 0x18, 0xb1, 0x8d, 0xd9, 0x45, 0x6c, 0x63, 0x6a,
-	0xc9, 0x41, 0xda, 0x97, 0x07, 0xf3, 0x8a, 0x04, 0x60, 0x52, 0x91, 0x00, 0xcc, 0x28, 0x12, 0x80,
-	0xe9, 0x44, 0x02, 0x50, 0x3f, 0x12, 0x20, 0x97, 0x8a, 0x3f, 0x88, 0x42, 0x82, 0x3e, 0xfd, 0xd0,
-	0xfb, 0x87, 0xfa, 0xe6, 0x96, 0x36, 0xac, 0x88, 0xd2, 0x90, 0x64, 0x00, 0x2f, 0xa8, 0xfd, 0x5e,
-	0x5e, 0x50, 0xfb, 0x00, 0x2f, 0xa8, 0x7d, 0x80, 0x17, 0xd4, 0x3e, 0xc0, 0x0b, 0x6a, 0x1f, 0xe0,
-	0x05, 0xb5, 0x0f, 0xf0, 0x82, 0xda, 0x07, 0x78, 0x41, 0xed, 0x03, 0xbc, 0xa0, 0xf6, 0x01, 0xaf,
-	0xa0, 0x16, 0x10, 0x5e, 0x50, 0x0b, 0x08, 0x2f, 0xa8, 0x05, 0x84, 0x17, 0xd4, 0x02, 0xc2, 0x0b,
-	0x6a, 0x01, 0x19, 0xa6, 0xdb, 0xf2, 0x5f, 0x2b, 0x3f, 0x7f, 0x5a, 0x50, 0x3e, 0x7c, 0x5a, 0x50,
-	0x7e, 0xf1, 0xb4, 0x10, 0xf9, 0xe5, 0xd3, 0x42, 0xe4, 0x57, 0x4f, 0x0b, 0x91, 0x5f, 0x3f, 0x2d,
-	0x44, 0x7e, 0xf3, 0xb4, 0xa0, 0x7c, 0xf3, 0xb4, 0xa0, 0x7c, 0x70, 0x5a, 0x88, 0xfc, 0xf8, 0xb4,
-	0xa0, 0xfc, 0xe4, 0xb4, 0x10, 0xf9, 0xe9, 0x69, 0x21, 0xf2, 0xb3, 0xd3, 0x42, 0xe4, 0xe7, 0xa7,
-	0x05, 0xe5, 0xc3, 0xd3, 0x82, 0xf2, 0x8b, 0xd3, 0x42, 0xe4, 0x97, 0xa7, 0x05, 0xe5, 0x57, 0xa7,
-	0x85, 0xc8, 0xaf, 0x4f, 0x0b, 0xca, 0x6f, 0x4e, 0x0b, 0x91, 0x6f, 0x3e, 0x2b, 0x44, 0x3e, 0x78,
-	0x56, 0x50, 0xbe, 0xf3, 0xac, 0x10, 0xf9, 0xcb, 0x67, 0x05, 0xe5, 0x87, 0xcf, 0x0a, 0x91, 0x1f,
-	0x3f, 0x2b, 0x44, 0x7e, 0xf2, 0xac, 0xa0, 0xfc, 0xf4, 0x59, 0x41, 0xf9, 0xd9, 0xb3, 0x82, 0xf2,
-	0xde, 0xe7, 0xce, 0xfb, 0x97, 0x92, 0xae, 0xdd, 0xdf, 0xdb, 0x1b, 0xa3, 0xaf, 0x4d, 0x0b, 0xff,
-	0x1f, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x74, 0xd8, 0xc0, 0x0e, 0x43, 0x00, 0x00,
+	// 5452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb5, 0x5b, 0x4d, 0x6c, 0x23, 0x47,
+	0x76, 0x16, 0x29, 0x52, 0xa4, 0x4a, 0x24, 0x45, 0x95, 0x34, 0x33, 0x32, 0x6d, 0x8f, 0x66, 0x35,
+	0x0e, 0x3c, 0x2b, 0xef, 0x68, 0x46, 0x14, 0xa5, 0xd1, 0x6c, 0xbc, 0xce, 0xf2, 0x4f, 0x23, 0x7a,
+	0xf4, 0xb7, 0x4d, 0x6a, 0x7e, 0x9c, 0x0d, 0x3a, 0x2d, 0xb2, 0x29, 0x71, 0x44, 0x91, 0xdc, 0x66,
+	0x6b, 0x24, 0x39, 0x97, 0xc5, 0xee, 0xc5, 0x49, 0x10, 0x60, 0x61, 0x24, 0x48, 0xf6, 0x10, 0xd8,
+	0x48, 0x16, 0xc8, 0x02, 0x39, 0xc4, 0x49, 0x0e, 0xf1, 0x21, 0x87, 0x3d, 0xe4, 0xe0, 0x00, 0x39,
+	0x38, 0x37, 0x27, 0x41, 0x1c, 0xaf, 0x8d, 0x00, 0x7b, 0xdc, 0xa3, 0xe1, 0x43, 0x9c, 0xf7, 0xaa,
+	0xaa, 0xd9, 0x55, 0xdd, 0xd4, 0xdf, 0x7a, 0x2c, 0x80, 0x60, 0xd7, 0x57, 0x55, 0xaf, 0x5e, 0xbd,
+	0xf7, 0xea, 0xfd, 0x54, 0x53, 0x64, 0xaa, 0xd9, 0xb6, 0x8c, 0x43, 0xa3, 0x75, 0xb3, 0x6b, 0x1b,
+	0xd5, 0xbd, 0x5b, 0x46, 0xa7, 0x71, 0x4b, 0x20, 0xb3, 0x1d, 0xab, 0x6d, 0xb7, 0x69, 0xc2, 0xb6,
+	0x5b, 0xb3, 0x0e, 0xf4, 0x74, 0x3e, 0x95, 0xdd, 0x69, 0xd8, 0xbb, 0x07, 0xdb, 0xb3, 0xd5, 0xf6,
+	0xfe, 0x2d, 0xb3, 0xf5, 0xb4, 0x7d, 0x0c, 0xc3, 0x8e, 0x8e, 0x6f, 0xb1, 0xc1, 0xd5, 0x9b, 0x3b,
+	0x66, 0xeb, 0xe6, 0x53, 0xa3, 0xd9, 0xa8, 0x19, 0xb6, 0x79, 0xcb, 0xf7, 0xc0, 0x49, 0xa6, 0x6e,
+	0x4a, 0x24, 0x76, 0xda, 0x3b, 0x6d, 0x3e, 0x79, 0xfb, 0xa0, 0xce, 0x5a, 0xac, 0xc1, 0x9e, 0xc4,
+	0xf0, 0x17, 0x76, 0xda, 0xed, 0x9d, 0xa6, 0xe9, 0x8e, 0xea, 0xda, 0xd6, 0x41, 0xd5, 0x16, 0xbd,
+	0x53, 0xde, 0x5e, 0xbb, 0xb1, 0x6f, 0xc2, 0x66, 0xf6, 0x3b, 0x62, 0xc0, 0x75, 0xff, 0x0e, 0x1b,
+	0x35, 0xb3, 0x65, 0x37, 0xea, 0x0d, 0xd3, 0xea, 0xf2, 0x41, 0xd3, 0x1f, 0x0f, 0x92, 0xc8, 0x9a,
+	0xd9, 0xed, 0x1a, 0x3b, 0x26, 0xfd, 0x6d, 0x12, 0xde, 0xd7, 0x77, 0x6b, 0xd6, 0x64, 0xe0, 0x5a,
+	0xe0, 0xc6, 0x48, 0x7a, 0x62, 0x56, 0x95, 0xc0, 0xec, 0xda, 0x4a, 0x41, 0xcb, 0x25, 0xbf, 0xc8,
+	0x85, 0xff, 0x28, 0x10, 0x4c, 0x06, 0x3e, 0xf8, 0x78, 0x6a, 0xe0, 0xc3, 0x8f, 0xa7, 0x02, 0x5a,
+	0x68, 0x7f, 0xa5, 0x66, 0xd1, 0x6b, 0x64, 0x70, 0xbf, 0x51, 0x9d, 0x0c, 0xc2, 0xd4, 0x58, 0x2e,
+	0xf1, 0x45, 0x2e, 0xf4, 0x66, 0x70, 0x37, 0xf4, 0xe9, 0xc7, 0x53, 0x83, 0x6b, 0xa5, 0xbc, 0x86,
+	0x5d, 0x74, 0x8d, 0x8c, 0xec, 0x1b, 0x55, 0xbd, 0x63, 0x1c, 0x37, 0xdb, 0x46, 0x6d, 0x72, 0x90,
+	0x2d, 0x92, 0xf2, 0x2d, 0x92, 0xcd, 0x6f, 0xf2, 0x11, 0xb9, 0x04, 0x4c, 0x27, 0x6e, 0x7b, 0x65,
+	0x40, 0x23, 0x40, 0x40, 0xb4, 0xe8, 0x03, 0x32, 0xf1, 0xa4, 0xdd, 0x68, 0xe9, 0x96, 0xf9, 0x83,
+	0x03, 0xd8, 0x77, 0x8f, 0x6e, 0x88, 0xd1, 0x9d, 0xf6, 0xd2, 0x7d, 0x1d, 0xc6, 0x6a, 0x7c, 0xa8,
+	0x4b, 0x8f, 0x3e, 0xf1, 0xa1, 0xb4, 0x4c, 0xc6, 0x19, 0x5d, 0xa3, 0x5a, 0x35, 0x3b, 0x2e, 0xd9,
+	0x30, 0x23, 0xfb, 0x8d, 0x7e, 0x64, 0xb3, 0x6c, 0xa4, 0x4b, 0x75, 0xec, 0x89, 0x17, 0xa4, 0xdf,
+	0x27, 0x97, 0x2d, 0xb3, 0x2f, 0xbb, 0x43, 0x8c, 0xee, 0x4b, 0x5e, 0xba, 0x9a, 0xf9, 0xa4, 0x1f,
+	0xc3, 0x13, 0x56, 0x1f, 0xfc, 0xdb, 0xa1, 0xf7, 0xdf, 0x9d, 0x1a, 0xc8, 0x25, 0x48, 0xc4, 0x59,
+	0x6e, 0xf0, 0xf3, 0x5c, 0xe0, 0xf5, 0x50, 0x34, 0x92, 0x8c, 0x4e, 0x1f, 0x90, 0x10, 0xea, 0x8d,
+	0x2e, 0x92, 0xa1, 0x7d, 0xdd, 0x3e, 0xee, 0x98, 0x4c, 0xbb, 0x89, 0xf4, 0x25, 0x9f, 0xe0, 0x2b,
+	0xd0, 0x99, 0x8b, 0x82, 0x7a, 0x7f, 0x84, 0xea, 0xd5, 0xc2, 0xfb, 0x08, 0xd0, 0x05, 0x30, 0x0a,
+	0xe3, 0x49, 0xdb, 0x62, 0x9a, 0xed, 0x37, 0x0d, 0x3b, 0x95, 0x69, 0x08, 0x4c, 0x7f, 0x16, 0x20,
+	0x92, 0xea, 0xd0, 0xb4, 0xea, 0xa7, 0x99, 0xd6, 0xf2, 0x09, 0xa6, 0x55, 0x47, 0xd3, 0x9a, 0x22,
+	0x43, 0x75, 0xbd, 0xd3, 0xb6, 0x6c, 0xc6, 0x43, 0x9c, 0x2d, 0x36, 0x33, 0x38, 0xf9, 0x25, 0x2c,
+	0x56, 0xdf, 0x04, 0x98, 0xde, 0x22, 0x23, 0x75, 0x6b, 0x5f, 0xb1, 0xac, 0x18, 0xb7, 0x9e, 0x65,
+	0x6d, 0x4d, 0xb0, 0xa0, 0x11, 0x18, 0xe2, 0xb0, 0xf3, 0x5d, 0x32, 0x5a, 0x33, 0xab, 0xed, 0x9a,
+	0x59, 0xf3, 0x98, 0xcd, 0x95, 0x59, 0x7e, 0xaa, 0x66, 0x9d, 0x53, 0x35, 0x5b, 0x66, 0x67, 0x4e,
+	0x4b, 0x88, 0xf1, 0x8a, 0xc8, 0xa7, 0xff, 0x37, 0x40, 0x42, 0xc8, 0x3a, 0x7d, 0x48, 0xa2, 0x35,
+	0xf3, 0xa9, 0x6e, 0xd4, 0xc4, 0x16, 0x63, 0xb9, 0x57, 0x71, 0x13, 0xff, 0xf9, 0xf1, 0x54, 0x06,
+	0x8e, 0xb3, 0xbd, 0x6b, 0xda, 0xbb, 0x8d, 0xd6, 0x4e, 0x77, 0xb6, 0x65, 0xda, 0x87, 0x6d, 0x6b,
+	0xef, 0x96, 0x7a, 0x34, 0x3b, 0x7b, 0x3b, 0xb7, 0x50, 0x35, 0xdd, 0xd9, 0x82, 0xf9, 0x34, 0x0b,
+	0x34, 0xb4, 0x48, 0x8d, 0x3f, 0xd0, 0xd7, 0x70, 0xef, 0x55, 0xdb, 0x6a, 0xb2, 0xbd, 0x8f, 0xf8,
+	0xe5, 0xbf, 0x9c, 0x87, 0xce, 0x3e, 0xa2, 0x0b, 0xd7, 0xb1, 0x83, 0x5e, 0x45, 0xc1, 0x57, 0x5b,
+	0x36, 0x13, 0x4a, 0x3c, 0x37, 0xfc, 0x45, 0x6e, 0x68, 0x26, 0x34, 0xf9, 0xe5, 0x97, 0x83, 0x20,
+	0xdb, 0x7c, 0xcb, 0x86, 0x7e, 0xa0, 0xdf, 0xee, 0xd8, 0x5d, 0x26, 0x80, 0x58, 0x2e, 0xc2, 0x4e,
+	0xee, 0xe4, 0x28, 0xcc, 0xdf, 0x00, 0x54, 0xec, 0xf3, 0xa7, 0x01, 0x12, 0x66, 0x0b, 0xd1, 0xe7,
+	0xc8, 0xa0, 0x21, 0xf6, 0x18, 0xcd, 0x45, 0xf0, 0x7c, 0x67, 0x0b, 0x9a, 0x86, 0x18, 0xbd, 0x49,
+	0x46, 0xe0, 0x0b, 0xce, 0xcd, 0x1e, 0x1a, 0x39, 0xe3, 0x37, 0x9a, 0x8b, 0xc3, 0x90, 0x61, 0x18,
+	0x92, 0xad, 0xee, 0x81, 0xd1, 0x6a, 0xc3, 0x30, 0x82, 0x3f, 0xd2, 0x24, 0x50, 0xaa, 0xee, 0x31,
+	0xbe, 0xa2, 0x1a, 0x3e, 0xd2, 0xe7, 0xc9, 0x30, 0xe8, 0xd9, 0x6c, 0xd5, 0x40, 0x54, 0x8c, 0x9d,
+	0xa8, 0x16, 0xad, 0x6f, 0xf2, 0x36, 0xbd, 0x42, 0x22, 0xd5, 0xa6, 0xd1, 0xed, 0xea, 0xdb, 0xec,
+	0x28, 0x46, 0xb5, 0x21, 0xd6, 0xcc, 0x4d, 0xff, 0x53, 0x90, 0x50, 0xff, 0xe1, 0xa6, 0xbf, 0x4f,
+	0xa2, 0xec, 0xbc, 0x99, 0x07, 0x0d, 0xa1, 0x91, 0xa2, 0xd0, 0x48, 0xfa, 0x42, 0x1a, 0x29, 0x6e,
+	0x95, 0x16, 0x33, 0xb0, 0x89, 0x08, 0xae, 0x01, 0x0d, 0x2d, 0x82, 0x64, 0x8b, 0x07, 0x0d, 0xfa,
+	0x7b, 0x04, 0xb5, 0xc4, 0x16, 0xe0, 0x5e, 0xaf, 0xf0, 0x95, 0x16, 0x18, 0x02, 0xdd, 0x23, 0xfd,
+	0x21, 0x20, 0x8a, 0xe4, 0xdf, 0x20, 0xc3, 0x48, 0xbe, 0xd5, 0x6e, 0x55, 0x4d, 0x61, 0xd2, 0xdf,
+	0x11, 0x0b, 0x2c, 0x5c, 0xd4, 0xa6, 0xd6, 0x91, 0x88, 0x86, 0x26, 0xca, 0x9e, 0x84, 0x56, 0xdf,
+	0x19, 0x24, 0x13, 0xfd, 0xfc, 0x0c, 0x2d, 0x92, 0x11, 0xe1, 0xad, 0x24, 0x87, 0x91, 0xea, 0xef,
+	0xa2, 0x3c, 0x5e, 0x83, 0x58, 0x3d, 0x14, 0x76, 0x30, 0x04, 0xbc, 0xe9, 0x8d, 0x9a, 0x90, 0x4f,
+	0xfe, 0x37, 0x92, 0xcf, 0xba, 0x69, 0x97, 0x0a, 0x20, 0x9f, 0x30, 0x7b, 0xd0, 0xc2, 0x30, 0xbe,
+	0xa4, 0xaa, 0x77, 0xf0, 0xeb, 0x56, 0x6f, 0xe8, 0x6b, 0x50, 0xef, 0x8b, 0x44, 0x88, 0x8a, 0x9d,
+	0x4e, 0x34, 0xe9, 0xb8, 0x36, 0xcc, 0x11, 0x38, 0x97, 0x42, 0x43, 0x7f, 0x16, 0x22, 0x63, 0xbe,
+	0x08, 0x43, 0x5f, 0x20, 0xc3, 0x66, 0xab, 0x6a, 0x1d, 0x77, 0x6c, 0xb3, 0xc6, 0x6d, 0x5b, 0x73,
+	0x01, 0xe0, 0x9b, 0x30, 0xb2, 0xdc, 0x70, 0xb8, 0xe4, 0x5f, 0x13, 0xac, 0x2f, 0x5e, 0x88, 0x75,
+	0x5c, 0x99, 0x5b, 0xce, 0xf0, 0x13, 0xe7, 0x51, 0x52, 0xea, 0xe0, 0x33, 0x57, 0xaa, 0xec, 0x45,
+	0x43, 0xcf, 0xd2, 0x8b, 0x42, 0xea, 0x51, 0x6b, 0xea, 0x5d, 0xd3, 0xb6, 0x71, 0xbe, 0x88, 0xe5,
+	0x3e, 0x83, 0x2e, 0xac, 0x96, 0xc5, 0x88, 0x3e, 0xfe, 0x94, 0xd4, 0x9a, 0x4e, 0x2f, 0x7d, 0x95,
+	0x44, 0xad, 0x23, 0xbd, 0x66, 0x36, 0x8d, 0x63, 0x16, 0xbf, 0x13, 0x10, 0x37, 0xbc, 0x87, 0xe3,
+	0xa8, 0x80, 0xdd, 0xd2, 0xc9, 0x88, 0x58, 0x1c, 0x82, 0x58, 0x18, 0xa9, 0xd6, 0xf5, 0x66, 0xa3,
+	0x6b, 0x4f, 0x46, 0x18, 0x23, 0x97, 0xbd, 0x93, 0xf3, 0xcb, 0xab, 0xd0, 0x9b, 0x23, 0x68, 0x36,
+	0xfc, 0x19, 0xbc, 0x5d, 0x1d, 0xbf, 0x85, 0x5d, 0xfc, 0x33, 0x44, 0x57, 0x97, 0x5b, 0xfa, 0x6d,
+	0x12, 0xb7, 0x8e, 0xe6, 0x74, 0x70, 0xbe, 0xed, 0x7a, 0x1d, 0x76, 0xc9, 0x8c, 0x22, 0x9e, 0xbb,
+	0x0c, 0xbe, 0x7c, 0x26, 0x38, 0x89, 0x5e, 0x7a, 0x44, 0x3b, 0x9a, 0x2b, 0x68, 0x1b, 0xac, 0x57,
+	0x1b, 0x81, 0xc1, 0x05, 0x8b, 0x37, 0xe8, 0x3d, 0x32, 0x64, 0x1d, 0xa5, 0x61, 0xae, 0x08, 0xf0,
+	0x2f, 0xfa, 0xa4, 0x62, 0xd8, 0x86, 0x06, 0x39, 0x6c, 0xa9, 0x55, 0x33, 0x8f, 0x72, 0x63, 0xce,
+	0x7e, 0x50, 0x79, 0xda, 0x51, 0x1a, 0x9c, 0x7f, 0x18, 0xe6, 0x17, 0x2c, 0x7a, 0x9d, 0x44, 0x20,
+	0x8e, 0xe8, 0x2d, 0x73, 0x87, 0xfb, 0x74, 0xce, 0x3e, 0x04, 0x91, 0x75, 0x73, 0x47, 0x1b, 0x6a,
+	0xb3, 0x6f, 0xc1, 0xfe, 0x21, 0x11, 0xdb, 0xa2, 0x4b, 0x24, 0x74, 0x9a, 0x8b, 0xe1, 0xa3, 0x3c,
+	0x2e, 0x86, 0xcd, 0xa0, 0x94, 0x84, 0xea, 0x3c, 0xcc, 0x0c, 0xc2, 0xc9, 0x61, 0xcf, 0x10, 0x9c,
+	0xa2, 0xd5, 0x5d, 0x7d, 0xdf, 0xe8, 0xee, 0x75, 0x81, 0x87, 0x41, 0x08, 0x12, 0x91, 0xea, 0xee,
+	0x1a, 0x36, 0xc5, 0xc2, 0x0f, 0x49, 0x6c, 0xb5, 0xad, 0x19, 0xce, 0x96, 0xf0, 0x24, 0x6d, 0x1b,
+	0xad, 0xda, 0x61, 0xa3, 0x66, 0xef, 0x72, 0xa1, 0x69, 0x2e, 0x40, 0xbf, 0x49, 0x92, 0xdd, 0x8e,
+	0x65, 0x1a, 0x18, 0x7f, 0xf4, 0xba, 0x51, 0xb5, 0x45, 0x16, 0x14, 0xd7, 0x46, 0x7b, 0xf8, 0x32,
+	0x83, 0xa7, 0x6f, 0x90, 0x91, 0xe5, 0xf2, 0xfd, 0x1e, 0x5d, 0x60, 0x64, 0xbb, 0x61, 0xeb, 0x16,
+	0x3c, 0x0b, 0xb2, 0x11, 0x68, 0x63, 0xd7, 0xf4, 0xdb, 0x01, 0x92, 0x58, 0xd5, 0x96, 0x57, 0xca,
+	0xe5, 0xde, 0xe8, 0x97, 0xc9, 0xe8, 0x7e, 0xbb, 0x76, 0xd0, 0x34, 0xec, 0x46, 0x5b, 0x72, 0xb9,
+	0x71, 0x2d, 0xe1, 0xc2, 0xcc, 0xa1, 0x2e, 0x92, 0x2b, 0xed, 0x8e, 0x09, 0x54, 0x91, 0xa1, 0xea,
+	0xae, 0xd1, 0x6a, 0x99, 0x4d, 0x9d, 0x33, 0xcf, 0xf9, 0xba, 0xd4, 0xeb, 0xce, 0xf3, 0xde, 0x87,
+	0x6c, 0x23, 0x53, 0x64, 0x04, 0x92, 0x17, 0x9c, 0xc4, 0x38, 0x42, 0xf5, 0x0c, 0x6b, 0x84, 0x43,
+	0x8c, 0xa9, 0xff, 0x0a, 0x90, 0x68, 0x8f, 0x9d, 0x57, 0x49, 0x08, 0x55, 0x20, 0x52, 0xb5, 0x17,
+	0xbc, 0x3a, 0x91, 0x05, 0x98, 0x8b, 0x82, 0x8e, 0x43, 0x88, 0x40, 0x6e, 0xca, 0x66, 0x81, 0x46,
+	0x07, 0xeb, 0xdd, 0x3d, 0x91, 0xad, 0x3c, 0xef, 0xcb, 0x56, 0x5c, 0x21, 0xf1, 0xec, 0x01, 0x00,
+	0x98, 0x8a, 0x53, 0x68, 0x81, 0x0c, 0x35, 0xad, 0xfa, 0x6e, 0xb7, 0x2b, 0x4a, 0x83, 0xab, 0xbe,
+	0x95, 0x15, 0xb1, 0x71, 0xfb, 0xe2, 0x18, 0x90, 0x10, 0x73, 0x73, 0x63, 0x84, 0xb8, 0x52, 0x63,
+	0x89, 0xf0, 0xf4, 0xdf, 0x84, 0x08, 0xa9, 0x1c, 0xf5, 0x4e, 0x4b, 0x1e, 0x02, 0x2b, 0xd0, 0x70,
+	0xb5, 0x33, 0x92, 0x9e, 0x3c, 0xc9, 0xe8, 0x73, 0x31, 0xd9, 0x11, 0x40, 0x04, 0x75, 0x84, 0xb4,
+	0x01, 0x19, 0xa4, 0x43, 0x44, 0x6f, 0xe0, 0xd1, 0x38, 0xdf, 0xf9, 0x71, 0xcd, 0x38, 0x5e, 0x93,
+	0x3b, 0xce, 0xd4, 0x11, 0xda, 0x6a, 0x9d, 0x15, 0x0f, 0xad, 0xea, 0x31, 0xf3, 0x8e, 0x21, 0xcd,
+	0x05, 0xe8, 0xb7, 0x08, 0x31, 0x5b, 0xc6, 0x76, 0xd3, 0xd4, 0xab, 0x56, 0x95, 0x67, 0x48, 0x3c,
+	0xf7, 0x2a, 0x32, 0x34, 0xaf, 0xe5, 0x31, 0x46, 0xb0, 0x47, 0xab, 0x8a, 0xb4, 0x7a, 0xd5, 0x22,
+	0xf3, 0x60, 0x60, 0xf7, 0x3d, 0x80, 0x66, 0xe0, 0x50, 0x42, 0x43, 0x78, 0xa7, 0x94, 0x2f, 0x25,
+	0xae, 0x38, 0x23, 0x73, 0xa1, 0x9f, 0xfc, 0x0f, 0x66, 0xe9, 0x38, 0x9a, 0xfe, 0x0e, 0x38, 0xef,
+	0xf6, 0x61, 0xab, 0xd9, 0x68, 0xed, 0x4d, 0x46, 0xd9, 0xcc, 0xeb, 0x5e, 0x51, 0xb8, 0x4a, 0x98,
+	0x2d, 0x88, 0xa1, 0x5a, 0x6f, 0x52, 0xea, 0x0f, 0xc0, 0x06, 0xc5, 0x33, 0x38, 0x93, 0xb8, 0xd1,
+	0xb2, 0xcd, 0x56, 0xcb, 0x10, 0xc2, 0xe5, 0x07, 0x22, 0x26, 0x40, 0x2e, 0x32, 0x38, 0x65, 0xf6,
+	0x11, 0x14, 0x06, 0x87, 0x26, 0x3f, 0x97, 0x41, 0x2d, 0x62, 0x1f, 0x6d, 0x62, 0x13, 0x2a, 0x82,
+	0xf1, 0x46, 0xeb, 0xa9, 0x69, 0x41, 0x9d, 0xd5, 0x6e, 0x1a, 0x56, 0xe3, 0x4d, 0x66, 0x0e, 0x22,
+	0xd9, 0xa4, 0xbc, 0x6b, 0x53, 0xea, 0x11, 0xfe, 0xe1, 0xcf, 0x03, 0xe4, 0xb9, 0x7b, 0x20, 0xec,
+	0x43, 0xe3, 0x38, 0x2b, 0x56, 0x72, 0x2b, 0x66, 0xba, 0x45, 0x46, 0x76, 0x78, 0x27, 0x84, 0xbf,
+	0xae, 0x30, 0x1d, 0x5f, 0xa1, 0x29, 0xe6, 0x4b, 0x13, 0xfb, 0x45, 0x93, 0x1d, 0x67, 0x54, 0xd7,
+	0xbf, 0xd7, 0xa0, 0x7f, 0xaf, 0xd3, 0x6f, 0x92, 0x91, 0xad, 0x0e, 0x8a, 0xa6, 0xd2, 0xde, 0x33,
+	0x5b, 0x10, 0xd0, 0x06, 0x5d, 0x16, 0xbe, 0x79, 0x02, 0x0b, 0xfe, 0x2d, 0xf4, 0xe1, 0x04, 0xe9,
+	0xa8, 0xf6, 0x10, 0xf4, 0xd8, 0xc3, 0xf4, 0x8f, 0x03, 0x24, 0xe6, 0x68, 0x66, 0xd3, 0x00, 0x7f,
+	0x72, 0x9d, 0xc4, 0x0e, 0x18, 0x33, 0xba, 0x8d, 0xdc, 0xf0, 0x1c, 0x04, 0xce, 0xe0, 0xc8, 0x81,
+	0xc4, 0x62, 0x16, 0x2a, 0x8f, 0xc6, 0x91, 0x59, 0x13, 0xae, 0xe0, 0xfc, 0x4c, 0x02, 0x21, 0x3e,
+	0x33, 0x37, 0x42, 0x42, 0x1d, 0x5c, 0x8f, 0x9d, 0xe2, 0x1f, 0x87, 0xc9, 0x70, 0xe5, 0x48, 0x64,
+	0xaa, 0xf4, 0x15, 0x12, 0x66, 0xf9, 0xff, 0x49, 0xd5, 0x6c, 0x1e, 0x3b, 0x35, 0x3e, 0x06, 0x4e,
+	0x7c, 0xc2, 0xb1, 0x32, 0x1d, 0x09, 0x76, 0x59, 0xd4, 0xe8, 0xe3, 0xdb, 0xe4, 0x5d, 0xc2, 0x01,
+	0x95, 0x5a, 0x5d, 0xa8, 0xc4, 0x86, 0x59, 0x90, 0x65, 0x51, 0x7f, 0xf0, 0xbc, 0x51, 0x3f, 0x8a,
+	0xb1, 0x96, 0x85, 0xfd, 0x07, 0x64, 0x9c, 0xcd, 0xf7, 0x78, 0x8d, 0xd0, 0xc5, 0xbc, 0x46, 0x12,
+	0xe9, 0x29, 0x8e, 0xe3, 0x3a, 0x0f, 0xfe, 0xae, 0x6f, 0x08, 0x33, 0xdf, 0x10, 0x03, 0x70, 0xb9,
+	0xe7, 0x1e, 0xd8, 0xe2, 0x69, 0xdf, 0xe2, 0x43, 0x17, 0x5e, 0x3c, 0xdd, 0x67, 0xf1, 0xb4, 0xb4,
+	0x78, 0xc4, 0x59, 0x3c, 0xed, 0x2e, 0xbe, 0x42, 0xa2, 0x1d, 0xab, 0xd1, 0xb6, 0x1a, 0xf6, 0x31,
+	0xf3, 0x0c, 0x09, 0xff, 0xa1, 0x01, 0xcf, 0x50, 0xdd, 0x35, 0xc1, 0x6d, 0x9b, 0x9b, 0x62, 0xa4,
+	0x2c, 0x43, 0x67, 0x36, 0x14, 0x26, 0x71, 0x63, 0xbb, 0xdb, 0x6e, 0x1e, 0xc0, 0x0e, 0x98, 0x8b,
+	0x1a, 0x3e, 0xa7, 0x8b, 0x8a, 0x39, 0xd3, 0xb0, 0x83, 0xce, 0x93, 0xa8, 0x51, 0x7b, 0x6a, 0x40,
+	0x3a, 0x5b, 0x9b, 0xac, 0x9e, 0x5e, 0xf7, 0xf7, 0x06, 0x0a, 0x0f, 0xf1, 0x87, 0x0b, 0xec, 0x5e,
+	0x23, 0xdf, 0xde, 0xdf, 0x87, 0x3c, 0x81, 0x96, 0xc8, 0x60, 0xb5, 0x51, 0x13, 0x46, 0xf8, 0x52,
+	0x9f, 0xbb, 0x2c, 0x31, 0xd0, 0x35, 0x6f, 0xcc, 0xa0, 0x22, 0x3f, 0x0a, 0x84, 0x92, 0x81, 0x6b,
+	0x03, 0x18, 0x00, 0xf3, 0x90, 0xfe, 0x22, 0x0d, 0xfa, 0x0d, 0x28, 0xba, 0x8c, 0xc3, 0xde, 0x7d,
+	0x44, 0x50, 0x9c, 0x29, 0x02, 0xa0, 0x93, 0xf8, 0xe7, 0xc0, 0x04, 0xcd, 0x2e, 0x66, 0xdf, 0x2d,
+	0xe7, 0xfe, 0xec, 0xfa, 0xc9, 0x6b, 0x42, 0x81, 0x06, 0x63, 0x41, 0x4f, 0x40, 0x25, 0x6a, 0x89,
+	0x67, 0x10, 0x21, 0xe1, 0x34, 0xaa, 0xed, 0x56, 0x5d, 0xdc, 0x7a, 0xbc, 0x74, 0x16, 0x91, 0x3c,
+	0x8c, 0x05, 0x2a, 0x7c, 0x75, 0x6c, 0x40, 0xfc, 0x4b, 0xb0, 0xe3, 0x04, 0x5a, 0x83, 0x7a, 0xdf,
+	0x68, 0x39, 0x49, 0xf5, 0xcb, 0xa7, 0x90, 0x5a, 0x85, 0x09, 0x79, 0x1c, 0x9f, 0x6d, 0xe1, 0x21,
+	0x8f, 0x35, 0xa5, 0x36, 0x7d, 0x4c, 0x58, 0x5b, 0xc7, 0x2b, 0x04, 0xcc, 0xeb, 0xf8, 0xbd, 0xd8,
+	0x6f, 0x9d, 0x41, 0x0e, 0x2f, 0x1f, 0xcc, 0x1f, 0xf0, 0xbb, 0x1e, 0xb7, 0x8d, 0x62, 0x43, 0x62,
+	0x59, 0xa8, 0x01, 0x20, 0x2d, 0x94, 0x49, 0x23, 0xa7, 0x91, 0xf3, 0x92, 0x06, 0xbe, 0x14, 0xd2,
+	0x9c, 0x6f, 0x87, 0x34, 0x72, 0x0d, 0x62, 0xa8, 0x1d, 0xd8, 0xc7, 0x7a, 0xf5, 0xb8, 0x0a, 0xa1,
+	0x17, 0xf9, 0x8e, 0x9e, 0x29, 0x86, 0x02, 0x4c, 0xc8, 0xe3, 0x78, 0xce, 0x69, 0xac, 0x26, 0xb5,
+	0x81, 0x57, 0x0a, 0xa5, 0x45, 0xc7, 0xb0, 0x8c, 0x7d, 0xac, 0x57, 0x0e, 0x3a, 0x8c, 0x28, 0x37,
+	0xf3, 0x99, 0xd3, 0xd4, 0x74, 0xb4, 0x89, 0x73, 0xca, 0x38, 0x85, 0xd3, 0x1d, 0xb5, 0x54, 0xa8,
+	0x0f, 0x69, 0x14, 0x06, 0xb9, 0x10, 0x69, 0x2e, 0x01, 0x85, 0xb4, 0x23, 0x06, 0x28, 0xdc, 0xe0,
+	0xc4, 0xd9, 0x07, 0x5d, 0x46, 0x76, 0xe4, 0x6c, 0x31, 0x98, 0x4f, 0xcb, 0x6c, 0xbc, 0xb0, 0x86,
+	0x9a, 0xd4, 0xa6, 0x1a, 0x19, 0x6d, 0x99, 0x87, 0xbd, 0x1c, 0x17, 0x65, 0x10, 0x63, 0x14, 0x6f,
+	0x9c, 0x42, 0x71, 0xdd, 0x3c, 0x14, 0x69, 0x2f, 0x97, 0x40, 0xbc, 0x25, 0x03, 0x5e, 0x9a, 0xc8,
+	0x65, 0xfc, 0x02, 0x34, 0x39, 0x9b, 0x12, 0x4d, 0xe4, 0xd3, 0x80, 0x8d, 0x37, 0x15, 0x36, 0x13,
+	0x67, 0x6f, 0x7c, 0xd5, 0x65, 0x2a, 0x97, 0x04, 0xf3, 0x8a, 0xc9, 0x08, 0x13, 0x45, 0x53, 0x62,
+	0x5b, 0x5d, 0x02, 0xb9, 0x1e, 0x3d, 0xff, 0x12, 0x68, 0xc1, 0xea, 0x12, 0x8e, 0xb4, 0x9b, 0xd2,
+	0x2e, 0xbe, 0x8f, 0xd1, 0x01, 0x1d, 0x2a, 0xa6, 0x9f, 0xae, 0xd5, 0x25, 0xd9, 0x3a, 0xaf, 0x9c,
+	0x6a, 0x1a, 0x15, 0x36, 0x49, 0x32, 0x3b, 0x88, 0x11, 0x2a, 0x86, 0x76, 0x67, 0xfb, 0x4d, 0x7a,
+	0xec, 0x4c, 0xbb, 0xab, 0xf8, 0x4d, 0xda, 0xf6, 0x98, 0x34, 0x73, 0x88, 0x7b, 0xe6, 0x31, 0x73,
+	0x88, 0xf4, 0x1c, 0x0e, 0x11, 0xc6, 0xf6, 0x1c, 0x22, 0x7f, 0xe6, 0x0e, 0x11, 0x69, 0x30, 0x87,
+	0x38, 0x7e, 0x0e, 0x87, 0x08, 0x83, 0x5d, 0x87, 0x28, 0x1a, 0xd4, 0x22, 0xe3, 0xe8, 0x5f, 0xbc,
+	0xdb, 0x9c, 0x38, 0x53, 0x86, 0xe0, 0x57, 0x94, 0x4d, 0xe5, 0x26, 0x40, 0x5f, 0x49, 0x2f, 0x8a,
+	0x92, 0x05, 0xfa, 0xea, 0xf6, 0x35, 0xbc, 0xc6, 0x7e, 0xda, 0xa8, 0xf2, 0x60, 0xc8, 0x6c, 0xe3,
+	0xd2, 0x99, 0x16, 0x5d, 0x60, 0x33, 0x30, 0x0e, 0x0a, 0x8b, 0xae, 0xc9, 0x00, 0x24, 0xb9, 0xc9,
+	0x7a, 0xdb, 0xaa, 0xa2, 0x33, 0x73, 0xde, 0x57, 0x4c, 0x5e, 0xee, 0x9f, 0xc1, 0x49, 0x44, 0x97,
+	0x71, 0x4a, 0xef, 0x2e, 0x11, 0xa8, 0x26, 0xea, 0x0a, 0x42, 0xcd, 0xde, 0x0b, 0x10, 0xaf, 0x84,
+	0xae, 0x30, 0xe2, 0xb3, 0xa7, 0x4a, 0x1c, 0x27, 0x7a, 0xc5, 0x31, 0x6e, 0xf9, 0xe1, 0x13, 0x96,
+	0x41, 0xc1, 0x4c, 0x5e, 0x78, 0x19, 0x2e, 0x1e, 0xdf, 0x32, 0x3c, 0x58, 0xd1, 0x0e, 0x3b, 0x2b,
+	0xcd, 0x36, 0x06, 0xe3, 0x7a, 0x9b, 0xed, 0xe4, 0xb9, 0x33, 0x4d, 0x7a, 0x13, 0xcf, 0x05, 0xcc,
+	0x29, 0xc1, 0x14, 0x61, 0xd2, 0x1d, 0x15, 0xa2, 0xdb, 0xe4, 0x92, 0x4b, 0x5a, 0x76, 0x2c, 0x29,
+	0x46, 0xfd, 0xe6, 0x39, 0xa8, 0x2b, 0xce, 0x84, 0x76, 0x7c, 0x68, 0xff, 0x35, 0x50, 0x48, 0xcf,
+	0x5f, 0x74, 0x0d, 0x2e, 0x23, 0xef, 0x1a, 0x28, 0xa2, 0x47, 0x64, 0x6c, 0xdb, 0x34, 0xaa, 0x78,
+	0xa1, 0xc1, 0xfd, 0x0a, 0xd2, 0x7f, 0xe1, 0x4c, 0x09, 0xe5, 0xd8, 0x1c, 0xee, 0x41, 0x44, 0xb0,
+	0xd9, 0x56, 0x21, 0xb4, 0x7a, 0x41, 0x19, 0xd3, 0x4e, 0x26, 0x9b, 0x17, 0xcf, 0xb4, 0x7a, 0x4e,
+	0x17, 0x73, 0x52, 0x11, 0x1b, 0xb6, 0x65, 0xc0, 0x4b, 0x13, 0x79, 0xbd, 0x7a, 0x01, 0x9a, 0xe2,
+	0x24, 0x6d, 0xcb, 0x80, 0x74, 0x3a, 0xf7, 0xdb, 0x35, 0x96, 0x71, 0x4f, 0x4e, 0x9d, 0xf3, 0x74,
+	0xae, 0xc1, 0x04, 0xee, 0xa7, 0xc4, 0xe9, 0x14, 0x00, 0x9e, 0x4e, 0x99, 0x26, 0x73, 0x59, 0xd7,
+	0xce, 0x3c, 0x9d, 0x2e, 0x51, 0xe1, 0xb7, 0x12, 0x35, 0x05, 0x49, 0x3d, 0x22, 0x51, 0x27, 0x59,
+	0xa4, 0xcb, 0x24, 0x0e, 0x92, 0x6e, 0x5b, 0x3a, 0xd4, 0xc8, 0x5d, 0x2c, 0x9a, 0x4f, 0x7a, 0x5f,
+	0x88, 0x83, 0x72, 0xc4, 0xc9, 0x66, 0x27, 0x21, 0xc9, 0x66, 0xf3, 0x1e, 0xf0, 0x69, 0x3c, 0x5f,
+	0x4e, 0x3d, 0x26, 0xc3, 0xbd, 0x0c, 0xf2, 0x19, 0x93, 0x36, 0x49, 0x4c, 0xce, 0x28, 0xe9, 0x35,
+	0x32, 0xb4, 0x6f, 0x58, 0x3b, 0x8d, 0x96, 0xb8, 0xfe, 0x14, 0xaf, 0x09, 0xff, 0x2f, 0xa0, 0x09,
+	0x9c, 0xde, 0x24, 0x71, 0xa7, 0x80, 0xaf, 0xb6, 0x0f, 0x5a, 0xfe, 0xf7, 0x89, 0x31, 0xd1, 0x9d,
+	0xc7, 0x5e, 0xb1, 0xcc, 0xcf, 0x82, 0x44, 0x4a, 0x2d, 0xfb, 0x5d, 0xfc, 0x04, 0xbe, 0xd2, 0xc5,
+	0xcf, 0x4d, 0x92, 0x70, 0x6e, 0x31, 0xe4, 0xfa, 0x9f, 0xbd, 0x89, 0x9b, 0xc1, 0x37, 0x71, 0x31,
+	0x71, 0xa9, 0xc1, 0x87, 0xbf, 0x42, 0x62, 0xce, 0x89, 0xc5, 0x8b, 0x4e, 0x7e, 0xcf, 0xc9, 0xa8,
+	0xbf, 0x0d, 0xd4, 0x93, 0xda, 0x88, 0xe8, 0xc5, 0x6b, 0x4f, 0x7a, 0x97, 0x4c, 0xc8, 0x83, 0xd1,
+	0x5e, 0x6c, 0xab, 0xdd, 0xe4, 0xaf, 0x1b, 0x9c, 0x15, 0x22, 0x1a, 0x95, 0xe6, 0xe4, 0xf9, 0x10,
+	0x3a, 0x4d, 0xa2, 0xad, 0x6d, 0xdd, 0xb6, 0xf0, 0x28, 0x0c, 0xa9, 0x0c, 0x45, 0x5a, 0xdb, 0x15,
+	0xc4, 0xb9, 0x80, 0x5e, 0x0f, 0x45, 0x43, 0xc9, 0x70, 0xea, 0xed, 0x00, 0x91, 0xd2, 0x64, 0x7a,
+	0x83, 0x24, 0x95, 0x95, 0xf1, 0x55, 0x1f, 0x7b, 0x69, 0xa8, 0x25, 0xa4, 0xc5, 0xb2, 0xd5, 0x3d,
+	0xd8, 0xff, 0xb8, 0x47, 0xa0, 0x6c, 0x30, 0x7b, 0x7d, 0xa8, 0x25, 0x15, 0x59, 0xe1, 0xf0, 0x57,
+	0x78, 0x36, 0xe1, 0x8a, 0x4b, 0x77, 0xdf, 0x22, 0x8e, 0xca, 0x92, 0x82, 0xc1, 0xa9, 0x2a, 0x89,
+	0xc9, 0xd9, 0x36, 0x2d, 0x93, 0xc4, 0xbe, 0x71, 0xa4, 0xbb, 0x29, 0xbb, 0xd0, 0x9d, 0x2f, 0x69,
+	0xc8, 0xee, 0xec, 0x58, 0x26, 0x1a, 0x43, 0xad, 0x37, 0x5f, 0xd2, 0x60, 0x0c, 0x88, 0xf4, 0xf0,
+	0xd4, 0x7f, 0x04, 0xc8, 0xa8, 0x27, 0xfd, 0x3e, 0xa9, 0xde, 0x0e, 0x7c, 0xd5, 0x7a, 0x7b, 0x89,
+	0x4c, 0xa8, 0x97, 0x08, 0xe2, 0xc2, 0x3f, 0xa8, 0x2a, 0x74, 0x4c, 0xba, 0x25, 0x10, 0xf7, 0xfc,
+	0xb3, 0xde, 0x4a, 0x1d, 0x45, 0x16, 0x62, 0x2f, 0x84, 0xd3, 0xa1, 0x1b, 0xef, 0xfe, 0xc9, 0x90,
+	0x5a, 0xb4, 0x0b, 0xe3, 0xff, 0x5b, 0xcf, 0xde, 0x50, 0xb5, 0x19, 0x72, 0xa5, 0xcf, 0xde, 0x24,
+	0x0d, 0x8f, 0x7b, 0xd9, 0x46, 0xbd, 0x2d, 0x92, 0xc9, 0x7e, 0x9c, 0x4b, 0xba, 0x9e, 0xf0, 0x31,
+	0x8d, 0xf3, 0x66, 0xc8, 0x98, 0xc2, 0xb7, 0xac, 0x6e, 0x99, 0x61, 0x54, 0x77, 0x0d, 0xd4, 0x2d,
+	0x57, 0x11, 0xd3, 0x24, 0xb2, 0x6d, 0xd8, 0xb6, 0x69, 0x1d, 0xab, 0x2e, 0x01, 0x4e, 0xba, 0xd3,
+	0x01, 0xf4, 0x1d, 0xaf, 0x81, 0x5c, 0x84, 0x73, 0xf4, 0x8b, 0xdc, 0x68, 0x2a, 0x3e, 0x39, 0x75,
+	0xe3, 0x93, 0x2f, 0xc5, 0x5f, 0xcf, 0x7f, 0x08, 0x99, 0xfc, 0x65, 0x90, 0xc4, 0x95, 0x52, 0x03,
+	0xfd, 0x8a, 0x63, 0xec, 0xd2, 0x6d, 0xa5, 0xec, 0x57, 0x44, 0x37, 0x57, 0xe2, 0xcb, 0xf2, 0x4d,
+	0x6e, 0xd0, 0xab, 0x06, 0xe9, 0x52, 0x17, 0xac, 0x08, 0xfc, 0x9e, 0xcf, 0x8a, 0x06, 0x2f, 0x68,
+	0x45, 0x40, 0x43, 0xb5, 0x22, 0xa4, 0x8b, 0xc7, 0xe0, 0x2b, 0x5e, 0x45, 0xe1, 0x29, 0x90, 0xfb,
+	0x84, 0x7c, 0x1e, 0xc9, 0xe2, 0x41, 0x35, 0x5c, 0x27, 0x71, 0x55, 0x7d, 0xdc, 0x4c, 0x62, 0x75,
+	0x49, 0x77, 0xa0, 0xab, 0xb8, 0xcb, 0x8f, 0x6b, 0x14, 0x23, 0x8e, 0x03, 0x40, 0xfd, 0x36, 0x89,
+	0x52, 0x2a, 0x7d, 0x5d, 0x72, 0x17, 0xfb, 0xd0, 0x89, 0x52, 0x35, 0xa1, 0x25, 0x2a, 0xab, 0x49,
+	0x5b, 0x19, 0x95, 0xd7, 0xc1, 0xdd, 0xf8, 0xb6, 0x1c, 0xf4, 0x6f, 0x39, 0x75, 0x9f, 0x24, 0xbd,
+	0x05, 0x14, 0xbd, 0x43, 0xc2, 0xfc, 0x86, 0x31, 0x70, 0xde, 0x1b, 0x46, 0x3e, 0x3e, 0xf5, 0xaf,
+	0x70, 0x52, 0x3d, 0x15, 0x13, 0x7d, 0x83, 0xbb, 0x3b, 0xb3, 0x61, 0x75, 0x14, 0x07, 0xe4, 0x7f,
+	0xf3, 0xc9, 0xd2, 0x81, 0x62, 0x49, 0xdb, 0xcc, 0x4d, 0x4a, 0x2f, 0xf8, 0x62, 0x6b, 0xc6, 0x11,
+	0x82, 0x6c, 0x5b, 0xcc, 0xeb, 0x15, 0x81, 0x14, 0x17, 0x26, 0x48, 0x43, 0xdc, 0x01, 0xd7, 0x0e,
+	0xcd, 0x66, 0x93, 0x5f, 0xc7, 0xf1, 0x5d, 0x8e, 0xf2, 0x8e, 0x02, 0xe2, 0xec, 0xbe, 0x6d, 0x16,
+	0x5c, 0xbc, 0x73, 0xff, 0x2a, 0x8d, 0xe6, 0xa7, 0x78, 0xcc, 0xe9, 0xea, 0x8d, 0x4f, 0x6d, 0x62,
+	0x3a, 0x22, 0xca, 0xb3, 0xc2, 0x85, 0x72, 0x06, 0xd9, 0x47, 0x4b, 0x19, 0x43, 0xea, 0x7b, 0x98,
+	0x86, 0x38, 0xa5, 0xda, 0xb3, 0x21, 0xf9, 0x56, 0x90, 0xf8, 0xaa, 0x34, 0x7a, 0x4c, 0x2e, 0x3b,
+	0xbf, 0x81, 0x69, 0x82, 0x62, 0x6d, 0xdd, 0x3c, 0xea, 0xb4, 0x5b, 0x66, 0xcb, 0x3e, 0x31, 0xd0,
+	0xb0, 0x9f, 0xc6, 0xac, 0xe2, 0xd8, 0xa2, 0x18, 0x9a, 0x9b, 0x92, 0x54, 0x30, 0xde, 0x67, 0x80,
+	0x36, 0xce, 0x7f, 0x45, 0xa3, 0x80, 0xf2, 0xd2, 0xcc, 0x22, 0xdc, 0xa5, 0x83, 0xa7, 0x2d, 0xcd,
+	0xcc, 0xe9, 0xb4, 0xa5, 0x95, 0x01, 0xce, 0xd2, 0x0a, 0x08, 0xd2, 0x8d, 0x2b, 0x55, 0x25, 0xfd,
+	0xee, 0xb9, 0xdf, 0x20, 0xe1, 0x0b, 0x89, 0x7f, 0x08, 0x04, 0xa3, 0xec, 0x85, 0x84, 0xfb, 0x36,
+	0x29, 0xf5, 0xf7, 0x41, 0x92, 0x50, 0x8b, 0xca, 0x67, 0xf5, 0xab, 0x94, 0x67, 0xfe, 0xe6, 0xee,
+	0x06, 0xfe, 0xae, 0xf1, 0x08, 0xea, 0x10, 0xdb, 0x6a, 0x98, 0x5d, 0xf1, 0x43, 0xab, 0x5e, 0x28,
+	0x26, 0xd0, 0xa7, 0xf1, 0x2e, 0xfa, 0x90, 0x8c, 0x76, 0x4c, 0xab, 0xd1, 0xae, 0xb9, 0xba, 0x09,
+	0xf5, 0xbf, 0x39, 0x16, 0xb5, 0x28, 0x1b, 0xdc, 0x53, 0x8e, 0xcb, 0x41, 0xa2, 0xa3, 0xf4, 0x08,
+	0x87, 0xf5, 0x6f, 0x01, 0x32, 0xde, 0xa7, 0x56, 0xa6, 0xbf, 0x4b, 0x28, 0x32, 0xc8, 0x52, 0xde,
+	0x33, 0x0d, 0x92, 0x13, 0x60, 0x09, 0x70, 0x9f, 0x85, 0xd1, 0xe7, 0x2b, 0x7d, 0x58, 0xe7, 0x21,
+	0x71, 0x76, 0x01, 0xe1, 0xb1, 0xb8, 0xe9, 0x13, 0x74, 0x03, 0x43, 0xfb, 0x90, 0x1e, 0x05, 0x32,
+	0x72, 0x57, 0x6a, 0xc5, 0xbf, 0x1b, 0xb4, 0xad, 0x39, 0x72, 0xc9, 0xb7, 0xa0, 0xe4, 0x8a, 0xa9,
+	0x87, 0x0c, 0x3a, 0xda, 0x32, 0x19, 0xf5, 0x54, 0xde, 0x60, 0xa1, 0x43, 0x5c, 0x86, 0x42, 0x0e,
+	0xbe, 0x97, 0xcd, 0xce, 0x04, 0xae, 0x03, 0x89, 0x4f, 0x31, 0x2f, 0xf5, 0xa7, 0x01, 0x42, 0xfd,
+	0x15, 0xb7, 0x1a, 0x64, 0x02, 0xa7, 0x04, 0xf7, 0x67, 0x6d, 0x87, 0xc2, 0x08, 0x76, 0x7d, 0x5c,
+	0x9d, 0x3b, 0x04, 0x5f, 0x2c, 0x13, 0x4f, 0xed, 0x90, 0x51, 0x4f, 0xb5, 0x4e, 0xa7, 0xe4, 0xe8,
+	0xa5, 0xfc, 0xda, 0x90, 0xe3, 0xfe, 0x88, 0x1d, 0x3c, 0x2d, 0x62, 0x8b, 0x2d, 0xbd, 0x46, 0xe2,
+	0x4a, 0xf9, 0x7e, 0x6e, 0x19, 0x8b, 0xf9, 0x19, 0x79, 0xfe, 0x79, 0xa5, 0x91, 0x5a, 0x76, 0x9c,
+	0x9a, 0x53, 0x7b, 0x2f, 0x9c, 0xe7, 0x95, 0xa3, 0x1c, 0x98, 0xd9, 0xe8, 0xd4, 0x3d, 0x92, 0x50,
+	0xeb, 0xef, 0xdf, 0x90, 0x90, 0xf8, 0x95, 0xef, 0x30, 0x89, 0x88, 0x57, 0x44, 0xd3, 0x65, 0x42,
+	0x15, 0xd3, 0x78, 0x60, 0x34, 0x0f, 0x4c, 0xfa, 0x1d, 0x12, 0x7e, 0x8a, 0x0f, 0x17, 0x2d, 0x36,
+	0xf8, 0xac, 0xe9, 0x2d, 0x32, 0xae, 0x9a, 0x3e, 0xa7, 0xfa, 0x9a, 0x4a, 0xf5, 0xfc, 0xc7, 0x45,
+	0x90, 0xd5, 0xc9, 0x64, 0x9f, 0x9a, 0x8a, 0xd3, 0xce, 0xab, 0xb4, 0x2f, 0x58, 0x8c, 0x89, 0x05,
+	0xee, 0x91, 0x98, 0xc8, 0x8d, 0x38, 0xd1, 0x3b, 0x2a, 0xd1, 0xf3, 0x24, 0x52, 0x2e, 0xa7, 0xfe,
+	0x98, 0x7b, 0x4e, 0x4e, 0xfb, 0x44, 0xf3, 0x93, 0x17, 0x50, 0x82, 0xe8, 0x45, 0x16, 0x50, 0x63,
+	0xb6, 0x77, 0x81, 0x99, 0x77, 0x02, 0x24, 0xcc, 0x7e, 0xcd, 0x4d, 0x93, 0x24, 0xf6, 0xfa, 0x46,
+	0x69, 0x5d, 0xd7, 0x8a, 0xdf, 0xdb, 0x2a, 0x96, 0x2b, 0xc9, 0x01, 0x3a, 0x4a, 0x46, 0x18, 0x92,
+	0xcd, 0xe7, 0x8b, 0x9b, 0x95, 0x64, 0x80, 0x52, 0x92, 0xd8, 0x5a, 0xcf, 0x6f, 0xac, 0x2f, 0x97,
+	0xb4, 0xb5, 0x62, 0x41, 0xdf, 0xda, 0x4c, 0x06, 0xe9, 0x04, 0x49, 0xca, 0x58, 0x61, 0xe3, 0xe1,
+	0x7a, 0x72, 0x10, 0x89, 0x29, 0xe3, 0x42, 0x38, 0xd7, 0x33, 0x2a, 0x8c, 0x98, 0x56, 0x54, 0x16,
+	0x1d, 0xc2, 0x45, 0x37, 0xb5, 0x8d, 0x4d, 0xad, 0x54, 0xac, 0x64, 0xb5, 0xc7, 0xc9, 0xc8, 0xcc,
+	0x15, 0x60, 0x10, 0x7f, 0x26, 0x4e, 0x13, 0x84, 0xac, 0x6e, 0x68, 0xd9, 0x87, 0x59, 0x18, 0x3e,
+	0x97, 0x1c, 0x98, 0xe9, 0xb2, 0xb7, 0xab, 0x22, 0xc5, 0xc2, 0x79, 0xd0, 0xd2, 0xb7, 0xd6, 0xef,
+	0xaf, 0x23, 0xf1, 0x01, 0x1a, 0x23, 0x51, 0x04, 0x1e, 0xcc, 0xe9, 0xb7, 0x81, 0xf5, 0x04, 0x1b,
+	0xcc, 0x5a, 0xfa, 0x1c, 0xb0, 0x2d, 0xb7, 0xd3, 0xc0, 0xb0, 0x3b, 0x7a, 0x0e, 0x98, 0x95, 0x7b,
+	0xe7, 0x93, 0xe1, 0x54, 0xf4, 0xad, 0xbf, 0xbe, 0x3a, 0xf0, 0xde, 0xcf, 0xae, 0x0e, 0xcc, 0xfc,
+	0x5d, 0x80, 0x90, 0xcd, 0x95, 0xc7, 0xd2, 0xaa, 0xd0, 0x52, 0x57, 0x45, 0xc0, 0x5d, 0xd5, 0x69,
+	0xb1, 0x55, 0x41, 0x58, 0xbd, 0x76, 0x1a, 0x36, 0xfd, 0x40, 0xcf, 0xc2, 0xda, 0x7e, 0x34, 0xc7,
+	0x05, 0x26, 0xd0, 0x39, 0x31, 0x32, 0xec, 0xc3, 0x72, 0x20, 0x30, 0x79, 0xf6, 0xbc, 0x18, 0x19,
+	0x91, 0x39, 0x86, 0x12, 0x54, 0x2d, 0xe9, 0x80, 0xe9, 0x42, 0xb6, 0x92, 0xd5, 0xb5, 0x6c, 0xa5,
+	0x08, 0x6c, 0x0e, 0xa8, 0xc0, 0x1c, 0xf0, 0xad, 0x00, 0x69, 0x60, 0x5c, 0x01, 0xe6, 0x81, 0x67,
+	0x05, 0xc8, 0x00, 0xbb, 0x0a, 0xb0, 0x00, 0xbc, 0x2a, 0xc0, 0x22, 0xd7, 0xac, 0x0b, 0xdc, 0x49,
+	0x46, 0x54, 0x60, 0x29, 0x19, 0x55, 0x81, 0xbb, 0xc9, 0x61, 0x34, 0x23, 0x89, 0xb1, 0xdb, 0x49,
+	0xe2, 0x41, 0xe6, 0x92, 0x23, 0x1e, 0x24, 0x9d, 0x8c, 0x79, 0x90, 0xf9, 0x64, 0xdc, 0x83, 0x64,
+	0x92, 0x09, 0x0f, 0xb2, 0x90, 0x1c, 0x95, 0x24, 0x76, 0x9b, 0x10, 0x37, 0x33, 0xa4, 0x23, 0x24,
+	0x02, 0x86, 0x5b, 0x29, 0x3e, 0xc2, 0x23, 0x01, 0x8d, 0x72, 0xb1, 0x5c, 0x2e, 0x6d, 0xac, 0x83,
+	0x94, 0xa2, 0x24, 0x74, 0xbf, 0xf8, 0xb8, 0x9c, 0x0c, 0xe2, 0x0c, 0xf7, 0xe7, 0x87, 0xb8, 0x8d,
+	0x65, 0x66, 0xd0, 0xeb, 0xf9, 0x52, 0xb1, 0x0c, 0xb3, 0xc6, 0x48, 0x3c, 0xbf, 0x92, 0x5d, 0x5f,
+	0x2f, 0xae, 0xea, 0x6b, 0xd9, 0xf2, 0xfd, 0x72, 0x32, 0x30, 0x93, 0x21, 0x61, 0xe6, 0xba, 0x19,
+	0xf9, 0xd5, 0x6c, 0xb9, 0x0c, 0x5a, 0x1b, 0x70, 0x1b, 0x39, 0x20, 0xdf, 0x6b, 0xe4, 0x93, 0xc1,
+	0x54, 0x08, 0xb9, 0x9b, 0xe9, 0x10, 0xea, 0xff, 0xf5, 0x03, 0x25, 0x64, 0x68, 0x75, 0xe3, 0x21,
+	0x3f, 0xb3, 0x11, 0x32, 0x08, 0xcf, 0x30, 0x1b, 0x36, 0x98, 0x2b, 0xc2, 0xa3, 0xbe, 0xbe, 0xa1,
+	0xad, 0x65, 0x57, 0x41, 0x87, 0x30, 0x4c, 0x3c, 0xb3, 0xf3, 0x99, 0xcd, 0x6d, 0x3c, 0x28, 0x3a,
+	0xbd, 0x21, 0xdc, 0xcc, 0x4a, 0xe9, 0xde, 0x0a, 0x28, 0x0e, 0xd6, 0xc5, 0x27, 0x76, 0x1c, 0x67,
+	0xfe, 0x7b, 0x90, 0x4c, 0xf4, 0xfb, 0x69, 0x02, 0x8d, 0x93, 0xe1, 0x7c, 0xa9, 0xa0, 0x6b, 0xcb,
+	0x5b, 0xcc, 0x84, 0x9c, 0x66, 0xb1, 0x5c, 0x14, 0x9e, 0x02, 0x9b, 0xab, 0xa5, 0xf5, 0xfb, 0x7a,
+	0x7e, 0xa5, 0x98, 0xbf, 0x0f, 0xeb, 0xa3, 0x4f, 0x70, 0x30, 0xf0, 0x4d, 0xc0, 0x85, 0x18, 0x55,
+	0xd8, 0xaa, 0x3c, 0xd6, 0xf3, 0x8f, 0xf3, 0xab, 0x45, 0xe0, 0xe3, 0x32, 0xa1, 0x8c, 0xd0, 0x23,
+	0x7d, 0x33, 0xab, 0x65, 0xd7, 0x74, 0xa0, 0x07, 0xfe, 0x23, 0xdc, 0x1b, 0x0b, 0xf6, 0x5d, 0xae,
+	0x64, 0x2b, 0x5b, 0x65, 0xb0, 0xa8, 0x71, 0x32, 0x8a, 0xd8, 0x7a, 0xf1, 0xa1, 0x2e, 0xe4, 0x0b,
+	0x56, 0x75, 0x85, 0x8c, 0x0b, 0x02, 0x95, 0xd2, 0x5a, 0x69, 0xfd, 0x9e, 0xa0, 0x10, 0x75, 0x28,
+	0x57, 0x54, 0xca, 0xc3, 0x3d, 0xca, 0xab, 0x3d, 0x22, 0xc4, 0xdd, 0x0e, 0x28, 0x18, 0x6c, 0x4c,
+	0xd0, 0x04, 0xae, 0x95, 0xb9, 0x31, 0x87, 0x03, 0xe0, 0xaa, 0x94, 0x2f, 0xe2, 0x82, 0x45, 0xb0,
+	0x36, 0x38, 0x91, 0x08, 0x2e, 0x6f, 0x68, 0x80, 0x71, 0x07, 0x07, 0x16, 0x97, 0x22, 0x97, 0x39,
+	0x49, 0xe6, 0xf0, 0x64, 0x32, 0xa3, 0x0e, 0x6b, 0x9b, 0x8c, 0xdd, 0xd5, 0x8d, 0x8a, 0x5e, 0x5a,
+	0x5f, 0xde, 0x48, 0x26, 0xe9, 0x73, 0xe4, 0x92, 0x8a, 0x3b, 0x1c, 0x8e, 0xd1, 0x4b, 0x64, 0x0c,
+	0xbb, 0x72, 0xc5, 0x2c, 0x58, 0xa7, 0xd8, 0x6a, 0x92, 0x3a, 0x0c, 0x09, 0x18, 0xcd, 0x30, 0x39,
+	0xee, 0xe1, 0x72, 0x6d, 0xa3, 0x50, 0x4c, 0x5e, 0x13, 0x16, 0xf5, 0x51, 0x90, 0x8c, 0xf7, 0x89,
+	0x99, 0xec, 0x7c, 0xf4, 0xd4, 0x02, 0x3e, 0x61, 0xc0, 0x83, 0xa4, 0xb9, 0x89, 0x49, 0x48, 0x86,
+	0xab, 0x58, 0x42, 0x96, 0x40, 0xc5, 0x60, 0xfa, 0x32, 0x9d, 0x45, 0xd0, 0xb0, 0x0a, 0xcd, 0xa7,
+	0x41, 0xb9, 0x2a, 0xb4, 0x98, 0x01, 0xdd, 0x82, 0x56, 0xe4, 0x89, 0xe9, 0x25, 0x50, 0xad, 0x8a,
+	0xa5, 0x17, 0x16, 0x41, 0xab, 0x2a, 0xb6, 0x00, 0x0e, 0x60, 0x18, 0xf7, 0x2b, 0xcf, 0xbd, 0x9d,
+	0xce, 0x80, 0x4a, 0x55, 0x30, 0x7d, 0x3b, 0xb3, 0x04, 0x8a, 0x55, 0xc1, 0xcc, 0xed, 0xbb, 0x8b,
+	0x5c, 0xa9, 0xf2, 0x2e, 0xe6, 0xee, 0xa6, 0xb9, 0x52, 0x95, 0x8d, 0xcc, 0x2f, 0xa1, 0x1b, 0x51,
+	0xd1, 0xf9, 0xf4, 0x9d, 0xc5, 0x25, 0x70, 0x25, 0x5c, 0xb4, 0xff, 0x18, 0x00, 0x6f, 0xad, 0xa4,
+	0x3a, 0xb8, 0x4f, 0xa6, 0xcb, 0xe2, 0x83, 0xa2, 0xf6, 0x58, 0x9f, 0x13, 0xbe, 0x41, 0x82, 0xd2,
+	0xe0, 0x1b, 0x3c, 0x50, 0x06, 0x1c, 0x8c, 0x07, 0x5a, 0x2a, 0xf3, 0xc3, 0x23, 0xd3, 0x5a, 0x2c,
+	0x8b, 0x98, 0xe1, 0x62, 0xf3, 0x40, 0x2d, 0xec, 0xc1, 0x16, 0x33, 0xe2, 0xe0, 0xc8, 0x73, 0xd3,
+	0x40, 0x30, 0x22, 0xb8, 0xfe, 0xe3, 0x41, 0xa7, 0x94, 0x52, 0x6b, 0x37, 0x98, 0x22, 0x4c, 0x37,
+	0xbf, 0xb1, 0xb5, 0x5e, 0x41, 0x55, 0x0e, 0xf8, 0xc0, 0x79, 0x34, 0x0b, 0x2f, 0xb8, 0x98, 0xe1,
+	0x91, 0x4f, 0x9d, 0x9e, 0x5e, 0xe2, 0x91, 0x4f, 0x41, 0x51, 0xa5, 0x21, 0x1f, 0x8a, 0x4a, 0x0d,
+	0xa3, 0xc1, 0xab, 0x14, 0x50, 0xad, 0x43, 0x3e, 0x98, 0x29, 0x36, 0xe2, 0x83, 0x99, 0x6a, 0xa3,
+	0x3e, 0x98, 0x29, 0x77, 0x18, 0xcf, 0x9f, 0x67, 0x73, 0xa8, 0x5e, 0xe2, 0xc3, 0xb9, 0x82, 0x47,
+	0x7c, 0xf8, 0xe2, 0xc2, 0xc2, 0x3c, 0x5a, 0x0e, 0xf8, 0x09, 0x95, 0xce, 0xfc, 0xdc, 0xed, 0x3b,
+	0x68, 0x3d, 0xde, 0x8e, 0xf4, 0x62, 0x7a, 0x2e, 0x83, 0x06, 0xe4, 0xed, 0x58, 0x48, 0x67, 0xd2,
+	0x4b, 0xae, 0x0d, 0x7d, 0x18, 0x84, 0x95, 0x7c, 0x95, 0x30, 0x9a, 0x83, 0x98, 0x85, 0x2e, 0x87,
+	0x39, 0x60, 0x0f, 0x34, 0xc7, 0xed, 0x48, 0x86, 0xd2, 0xdc, 0x8e, 0x64, 0x68, 0x9e, 0x9f, 0x50,
+	0x19, 0xca, 0xf0, 0x13, 0x2a, 0x43, 0x0b, 0xfc, 0x84, 0xca, 0x10, 0xc6, 0x73, 0x0f, 0x84, 0x11,
+	0xdd, 0x03, 0x61, 0x4c, 0xf7, 0x40, 0x77, 0xb9, 0xc3, 0x55, 0x58, 0xc5, 0xb8, 0xee, 0xc5, 0x30,
+	0xb2, 0x7b, 0x31, 0x8c, 0xed, 0x5e, 0x0c, 0xa3, 0xbb, 0x17, 0x43, 0xb9, 0x7a, 0xb1, 0x85, 0x9e,
+	0x48, 0xff, 0x25, 0xe0, 0xfc, 0x27, 0x93, 0x7a, 0x65, 0x22, 0xd9, 0xed, 0x66, 0x51, 0x2b, 0x6d,
+	0x14, 0x98, 0x58, 0x7d, 0xe0, 0x9c, 0x62, 0xe1, 0x02, 0x44, 0xd1, 0xfa, 0x40, 0x14, 0xae, 0x0f,
+	0x44, 0xf1, 0xfa, 0x40, 0x14, 0xb0, 0x0f, 0x5c, 0xe4, 0xe7, 0x54, 0x05, 0xef, 0xf4, 0xce, 0xe9,
+	0xbf, 0x07, 0x09, 0x71, 0xaf, 0x62, 0x99, 0x07, 0xe5, 0xee, 0x1d, 0x9b, 0x20, 0xf9, 0x01, 0xe6,
+	0x19, 0x25, 0x68, 0xee, 0x36, 0x8f, 0xcb, 0x0a, 0x86, 0x8c, 0x7b, 0xb1, 0x79, 0xee, 0x5c, 0x14,
+	0x2c, 0xc3, 0x9d, 0x8b, 0x82, 0x2d, 0x72, 0xe7, 0xa2, 0x60, 0x4b, 0xc2, 0x73, 0x4b, 0x58, 0xfa,
+	0xb6, 0xf0, 0xdc, 0x32, 0x36, 0x27, 0x3c, 0xb7, 0x8c, 0x65, 0xb8, 0x69, 0x28, 0xd8, 0x22, 0x37,
+	0x0d, 0x05, 0xbb, 0xc3, 0x4d, 0x43, 0xc1, 0xee, 0x72, 0xd3, 0x90, 0xb1, 0xf9, 0xdb, 0xdc, 0x34,
+	0x14, 0x6c, 0x9e, 0x9b, 0x86, 0x82, 0x2d, 0xf6, 0x4c, 0xe3, 0x2d, 0xf0, 0x7d, 0x7d, 0xca, 0x32,
+	0x54, 0x03, 0x86, 0xfe, 0x6c, 0xfe, 0x3e, 0x64, 0x2f, 0x6b, 0xa5, 0x0a, 0x8b, 0x87, 0x3e, 0x50,
+	0xf8, 0x3e, 0x15, 0xcc, 0x70, 0xcb, 0x50, 0x41, 0xe1, 0xfa, 0x3c, 0x34, 0x85, 0xeb, 0x53, 0x51,
+	0x16, 0x1e, 0x7d, 0xe8, 0xa2, 0xf0, 0x7c, 0x1e, 0x0a, 0x69, 0xe1, 0xf9, 0x3c, 0x7c, 0x2d, 0x08,
+	0xcf, 0xa7, 0xc2, 0x3c, 0x54, 0x82, 0x27, 0xf3, 0x10, 0xe1, 0xd1, 0xd2, 0x87, 0x8b, 0x80, 0xe9,
+	0xc3, 0x45, 0xcc, 0xf4, 0xe1, 0x22, 0x6c, 0x5e, 0x61, 0x12, 0x55, 0xb6, 0xc9, 0x23, 0xa7, 0xaf,
+	0x43, 0x0d, 0x9e, 0xae, 0x2a, 0x94, 0x02, 0x56, 0x96, 0x65, 0xa1, 0xb8, 0x9a, 0x7d, 0xec, 0x55,
+	0x05, 0x07, 0x3d, 0xaa, 0xe0, 0xa0, 0x47, 0x15, 0x1c, 0xf4, 0xa8, 0x42, 0xd0, 0xf4, 0xa8, 0x82,
+	0xa3, 0x5e, 0x55, 0x70, 0xd4, 0xab, 0x0a, 0x41, 0xc1, 0xab, 0x0a, 0xc1, 0x97, 0x57, 0x15, 0x1c,
+	0xf6, 0xa9, 0x42, 0x10, 0xf1, 0xa9, 0x42, 0x50, 0xf1, 0xa9, 0x42, 0x6c, 0xd0, 0xa7, 0x0a, 0xb1,
+	0x47, 0x9f, 0x2a, 0x9c, 0x6d, 0xfa, 0x54, 0xe1, 0xec, 0x54, 0x56, 0xc5, 0x4f, 0x83, 0x24, 0x22,
+	0x6e, 0x40, 0xb0, 0xa0, 0x85, 0xc4, 0x9a, 0x8f, 0x42, 0xf7, 0x28, 0xb7, 0xe7, 0x78, 0xc1, 0xdb,
+	0x6b, 0xa7, 0x79, 0x99, 0xdd, 0x6b, 0xa3, 0x5f, 0x91, 0xdb, 0x19, 0x5e, 0x68, 0xf7, 0xda, 0xe8,
+	0x05, 0xe5, 0x36, 0x3a, 0x40, 0xb9, 0x8d, 0x01, 0x46, 0x6e, 0x63, 0x74, 0x91, 0xdb, 0x18, 0x5a,
+	0xa0, 0xf4, 0x72, 0xf9, 0xc1, 0xb8, 0xa2, 0x00, 0x18, 0x54, 0x14, 0x00, 0x23, 0x8a, 0x02, 0x60,
+	0x38, 0x51, 0x00, 0x94, 0x8f, 0x02, 0xa8, 0xa5, 0xe2, 0x3b, 0x41, 0x12, 0x66, 0xaf, 0x7e, 0xd8,
+	0xfd, 0x43, 0x09, 0xaa, 0xa9, 0x5e, 0x45, 0x04, 0x65, 0x14, 0x07, 0x44, 0x41, 0xed, 0xf6, 0x8a,
+	0x82, 0xda, 0x05, 0x44, 0x41, 0xed, 0x02, 0xa2, 0xa0, 0x76, 0x01, 0x51, 0x50, 0xbb, 0x80, 0x28,
+	0xa8, 0x5d, 0x40, 0x14, 0xd4, 0x2e, 0x20, 0x0a, 0x6a, 0x17, 0x10, 0x05, 0xb5, 0x0b, 0x38, 0x05,
+	0xb5, 0x84, 0x88, 0x82, 0x5a, 0x42, 0x44, 0x41, 0x2d, 0x21, 0xa2, 0xa0, 0x96, 0x10, 0x51, 0x50,
+	0x4b, 0x48, 0x2f, 0xdc, 0xe6, 0xfe, 0x2a, 0xf0, 0xc1, 0x2f, 0xaf, 0x06, 0x3e, 0x84, 0xcf, 0x47,
+	0xbf, 0xbc, 0x3a, 0xf0, 0x09, 0x7c, 0x7e, 0x05, 0x9f, 0x5f, 0xc3, 0xe7, 0x73, 0xc0, 0x7e, 0xf8,
+	0xe9, 0xd5, 0xc0, 0x5b, 0x9f, 0x5e, 0x1d, 0xf8, 0x39, 0x7c, 0xbf, 0x07, 0xdf, 0xef, 0xc3, 0xe7,
+	0x17, 0xf0, 0xf9, 0x00, 0xda, 0x1f, 0xc2, 0xe7, 0x23, 0x78, 0xfe, 0x04, 0xbe, 0x7f, 0x05, 0xdf,
+	0xbf, 0x86, 0xef, 0xcf, 0xe1, 0xfb, 0x87, 0x9f, 0x5d, 0x1d, 0x78, 0xeb, 0xb3, 0xab, 0x81, 0x9f,
+	0xc0, 0xf7, 0x5f, 0xc0, 0xf7, 0xbb, 0xf0, 0xfd, 0x73, 0xf8, 0xbc, 0x07, 0xcf, 0xef, 0xc3, 0xe7,
+	0x17, 0xf0, 0x79, 0xe3, 0x5b, 0xe7, 0xfd, 0x27, 0x50, 0xbb, 0xd5, 0xd9, 0xde, 0x1e, 0x62, 0x6f,
+	0x9b, 0xe6, 0xff, 0x1f, 0xf3, 0x14, 0x0b, 0x58, 0xe9, 0x43, 0x00, 0x00,
 }
 
 func (x MType) String() string {
@@ -5784,6 +5859,36 @@ func (this *FSKDataRate) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *LRFHSSDataRate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LRFHSSDataRate)
+	if !ok {
+		that2, ok := that.(LRFHSSDataRate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModulationType != that1.ModulationType {
+		return false
+	}
+	if this.OperatingChannelWidth != that1.OperatingChannelWidth {
+		return false
+	}
+	if this.CodingRate != that1.CodingRate {
+		return false
+	}
+	return true
+}
 func (this *DataRate) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -5862,6 +5967,30 @@ func (this *DataRate_FSK) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DataRate_LRFHSS) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataRate_LRFHSS)
+	if !ok {
+		that2, ok := that.(DataRate_LRFHSS)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.LRFHSS.Equal(that1.LRFHSS) {
+		return false
+	}
+	return true
+}
 func (this *TxSettings) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -8580,6 +8709,46 @@ func (m *FSKDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LRFHSSDataRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LRFHSSDataRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LRFHSSDataRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodingRate) > 0 {
+		i -= len(m.CodingRate)
+		copy(dAtA[i:], m.CodingRate)
+		i = encodeVarintLorawan(dAtA, i, uint64(len(m.CodingRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OperatingChannelWidth != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.OperatingChannelWidth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ModulationType != 0 {
+		i = encodeVarintLorawan(dAtA, i, uint64(m.ModulationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DataRate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8654,6 +8823,27 @@ func (m *DataRate_FSK) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DataRate_LRFHSS) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRate_LRFHSS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LRFHSS != nil {
+		{
+			size, err := m.LRFHSS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLorawan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TxSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11031,14 +11221,26 @@ func NewPopulatedFSKDataRate(r randyLorawan, easy bool) *FSKDataRate {
 	return this
 }
 
+func NewPopulatedLRFHSSDataRate(r randyLorawan, easy bool) *LRFHSSDataRate {
+	this := &LRFHSSDataRate{}
+	this.ModulationType = uint32(r.Uint32())
+	this.OperatingChannelWidth = uint32(r.Uint32())
+	this.CodingRate = randStringLorawan(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedDataRate(r randyLorawan, easy bool) *DataRate {
 	this := &DataRate{}
-	oneofNumber_Modulation := []int32{1, 2}[r.Intn(2)]
+	oneofNumber_Modulation := []int32{1, 2, 3}[r.Intn(3)]
 	switch oneofNumber_Modulation {
 	case 1:
 		this.Modulation = NewPopulatedDataRate_LoRa(r, easy)
 	case 2:
 		this.Modulation = NewPopulatedDataRate_FSK(r, easy)
+	case 3:
+		this.Modulation = NewPopulatedDataRate_LRFHSS(r, easy)
 	}
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -11055,6 +11257,11 @@ func NewPopulatedDataRate_FSK(r randyLorawan, easy bool) *DataRate_FSK {
 	this.FSK = NewPopulatedFSKDataRate(r, easy)
 	return this
 }
+func NewPopulatedDataRate_LRFHSS(r randyLorawan, easy bool) *DataRate_LRFHSS {
+	this := &DataRate_LRFHSS{}
+	this.LRFHSS = NewPopulatedLRFHSSDataRate(r, easy)
+	return this
+}
 func NewPopulatedTxSettings_Downlink(r randyLorawan, easy bool) *TxSettings_Downlink {
 	this := &TxSettings_Downlink{}
 	this.AntennaIndex = r.Uint32()
@@ -11670,6 +11877,25 @@ func (m *FSKDataRate) Size() (n int) {
 	return n
 }
 
+func (m *LRFHSSDataRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ModulationType != 0 {
+		n += 1 + sovLorawan(uint64(m.ModulationType))
+	}
+	if m.OperatingChannelWidth != 0 {
+		n += 1 + sovLorawan(uint64(m.OperatingChannelWidth))
+	}
+	l = len(m.CodingRate)
+	if l > 0 {
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
+
 func (m *DataRate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11706,6 +11932,18 @@ func (m *DataRate_FSK) Size() (n int) {
 	}
 	return n
 }
+func (m *DataRate_LRFHSS) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LRFHSS != nil {
+		l = m.LRFHSS.Size()
+		n += 1 + l + sovLorawan(uint64(l))
+	}
+	return n
+}
 func (m *TxSettings) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12965,6 +13203,19 @@ func (this *FSKDataRate) String() string {
 	}, "")
 	return s
 }
+func (this *LRFHSSDataRate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LRFHSSDataRate{`,
+		`ModulationType:` + fmt.Sprintf("%v", this.ModulationType) + `,`,
+		`OperatingChannelWidth:` + fmt.Sprintf("%v", this.OperatingChannelWidth) + `,`,
+		`CodingRate:` + fmt.Sprintf("%v", this.CodingRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+
 func (this *DataRate) String() string {
 	if this == nil {
 		return "nil"
@@ -12995,6 +13246,16 @@ func (this *DataRate_FSK) String() string {
 	}, "")
 	return s
 }
+func (this *DataRate_LRFHSS) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataRate_LRFHSS{`,
+		`LRFHSS:` + strings.Replace(fmt.Sprintf("%v", this.LRFHSS), "LRFHSSDataRate", "LRFHSSDataRate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TxSettings) String() string {
 	if this == nil {
 		return "nil"
@@ -15776,6 +16037,130 @@ func (m *FSKDataRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LRFHSSDataRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLorawan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LRFHSSDataRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LRFHSSDataRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModulationType", wireType)
+			}
+			m.ModulationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModulationType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatingChannelWidth", wireType)
+			}
+			m.OperatingChannelWidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperatingChannelWidth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodingRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLorawan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
 func (m *DataRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -15875,6 +16260,41 @@ func (m *DataRate) Unmarshal(dAtA []byte) error {
 			}
 			m.Modulation = &DataRate_FSK{v}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LRFHSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLorawan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLorawan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &LRFHSSDataRate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Modulation = &DataRate_LRFHSS{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLorawan(dAtA[iNdEx:])
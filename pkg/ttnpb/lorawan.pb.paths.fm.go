@@ -206,6 +206,17 @@ var FSKDataRateFieldPathsNested = []string{
 var FSKDataRateFieldPathsTopLevel = []string{
 	"bit_rate",
 }
+var LRFHSSDataRateFieldPathsNested = []string{
+	"coding_rate",
+	"modulation_type",
+	"operating_channel_width",
+}
+
+var LRFHSSDataRateFieldPathsTopLevel = []string{
+	"coding_rate",
+	"modulation_type",
+	"operating_channel_width",
+}
 var DataRateFieldPathsNested = []string{
 	"modulation",
 	"modulation.fsk",
@@ -213,6 +224,10 @@ var DataRateFieldPathsNested = []string{
 	"modulation.lora",
 	"modulation.lora.bandwidth",
 	"modulation.lora.spreading_factor",
+	"modulation.lrfhss",
+	"modulation.lrfhss.coding_rate",
+	"modulation.lrfhss.modulation_type",
+	"modulation.lrfhss.operating_channel_width",
 }
 
 var DataRateFieldPathsTopLevel = []string{
@@ -227,6 +242,10 @@ var TxSettingsFieldPathsNested = []string{
 	"data_rate.modulation.lora",
 	"data_rate.modulation.lora.bandwidth",
 	"data_rate.modulation.lora.spreading_factor",
+	"data_rate.modulation.lrfhss",
+	"data_rate.modulation.lrfhss.coding_rate",
+	"data_rate.modulation.lrfhss.modulation_type",
+	"data_rate.modulation.lrfhss.operating_channel_width",
 	"data_rate_index",
 	"downlink",
 	"downlink.antenna_index",
@@ -704,6 +704,47 @@ func (dst *FSKDataRate) SetFields(src *FSKDataRate, paths ...string) error {
 	return nil
 }
 
+func (dst *LRFHSSDataRate) SetFields(src *LRFHSSDataRate, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "modulation_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'modulation_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ModulationType = src.ModulationType
+			} else {
+				var zero uint32
+				dst.ModulationType = zero
+			}
+		case "operating_channel_width":
+			if len(subs) > 0 {
+				return fmt.Errorf("'operating_channel_width' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OperatingChannelWidth = src.OperatingChannelWidth
+			} else {
+				var zero uint32
+				dst.OperatingChannelWidth = zero
+			}
+		case "coding_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'coding_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CodingRate = src.CodingRate
+			} else {
+				var zero string
+				dst.CodingRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *DataRate) SetFields(src *DataRate, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -772,6 +813,31 @@ func (dst *DataRate) SetFields(src *DataRate, paths ...string) error {
 						}
 					}
 
+				case "lrfhss":
+					if _, ok := dst.Modulation.(*DataRate_LRFHSS); !ok {
+						dst.Modulation = &DataRate_LRFHSS{}
+					}
+					if len(oneofSubs) > 0 {
+						newDst := dst.Modulation.(*DataRate_LRFHSS).LRFHSS
+						if newDst == nil {
+							newDst = &LRFHSSDataRate{}
+							dst.Modulation.(*DataRate_LRFHSS).LRFHSS = newDst
+						}
+						var newSrc *LRFHSSDataRate
+						if src != nil {
+							newSrc = src.GetLRFHSS()
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if src != nil {
+							dst.Modulation.(*DataRate_LRFHSS).LRFHSS = src.GetLRFHSS()
+						} else {
+							dst.Modulation.(*DataRate_LRFHSS).LRFHSS = nil
+						}
+					}
+
 				default:
 					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
 				}
@@ -32,6 +32,10 @@ var ProcessUplinkMessageRequestFieldPathsNested = []string{
 	"message.settings.data_rate.modulation.lora",
 	"message.settings.data_rate.modulation.lora.bandwidth",
 	"message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.settings.data_rate.modulation.lrfhss",
+	"message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"message.settings.data_rate_index",
 	"message.settings.downlink",
 	"message.settings.downlink.antenna_index",
@@ -70,6 +74,7 @@ var ProcessDownlinkMessageRequestFieldPathsNested = []string{
 	"message.confirmed",
 	"message.correlation_ids",
 	"message.decoded_payload",
+	"message.expires_at",
 	"message.f_cnt",
 	"message.f_port",
 	"message.frm_payload",
@@ -49,6 +49,10 @@ const (
 	PayloadFormatter_FORMATTER_JAVASCRIPT PayloadFormatter = 3
 	// CayenneLPP payload formatter.
 	PayloadFormatter_FORMATTER_CAYENNELPP PayloadFormatter = 4
+	// CBOR payload formatter. The payload is a CBOR data item.
+	PayloadFormatter_FORMATTER_CBOR PayloadFormatter = 5
+	// SenML payload formatter. The payload is a list of SenML records in CBOR representation.
+	PayloadFormatter_FORMATTER_SENML PayloadFormatter = 6
 )
 
 var PayloadFormatter_name = map[int32]string{
@@ -57,6 +61,8 @@ var PayloadFormatter_name = map[int32]string{
 	2: "FORMATTER_GRPC_SERVICE",
 	3: "FORMATTER_JAVASCRIPT",
 	4: "FORMATTER_CAYENNELPP",
+	5: "FORMATTER_CBOR",
+	6: "FORMATTER_SENML",
 }
 
 var PayloadFormatter_value = map[string]int32{
@@ -65,6 +71,8 @@ var PayloadFormatter_value = map[string]int32{
 	"FORMATTER_GRPC_SERVICE": 2,
 	"FORMATTER_JAVASCRIPT":   3,
 	"FORMATTER_CAYENNELPP":   4,
+	"FORMATTER_CBOR":         5,
+	"FORMATTER_SENML":        6,
 }
 
 func (PayloadFormatter) EnumDescriptor() ([]byte, []int) {
@@ -113,6 +121,42 @@ func (TxAcknowledgment_Result) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_bbc6bff5780bdc9d, []int{2, 0}
 }
 
+type DecodedPayloadCondition_Operator int32
+
+const (
+	DecodedPayloadCondition_EQUAL                 DecodedPayloadCondition_Operator = 0
+	DecodedPayloadCondition_NOT_EQUAL             DecodedPayloadCondition_Operator = 1
+	DecodedPayloadCondition_GREATER_THAN          DecodedPayloadCondition_Operator = 2
+	DecodedPayloadCondition_GREATER_THAN_OR_EQUAL DecodedPayloadCondition_Operator = 3
+	DecodedPayloadCondition_LESS_THAN             DecodedPayloadCondition_Operator = 4
+	DecodedPayloadCondition_LESS_THAN_OR_EQUAL    DecodedPayloadCondition_Operator = 5
+	DecodedPayloadCondition_EXISTS                DecodedPayloadCondition_Operator = 6
+)
+
+var DecodedPayloadCondition_Operator_name = map[int32]string{
+	0: "EQUAL",
+	1: "NOT_EQUAL",
+	2: "GREATER_THAN",
+	3: "GREATER_THAN_OR_EQUAL",
+	4: "LESS_THAN",
+	5: "LESS_THAN_OR_EQUAL",
+	6: "EXISTS",
+}
+
+var DecodedPayloadCondition_Operator_value = map[string]int32{
+	"EQUAL":                 0,
+	"NOT_EQUAL":             1,
+	"GREATER_THAN":          2,
+	"GREATER_THAN_OR_EQUAL": 3,
+	"LESS_THAN":             4,
+	"LESS_THAN_OR_EQUAL":    5,
+	"EXISTS":                6,
+}
+
+func (DecodedPayloadCondition_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{13, 0}
+}
+
 // Uplink message from the end device to the network
 type UplinkMessage struct {
 	RawPayload []byte        `protobuf:"bytes,1,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
@@ -394,9 +438,16 @@ type ApplicationUplink struct {
 	RxMetadata     []*RxMetadata `protobuf:"bytes,6,rep,name=rx_metadata,json=rxMetadata,proto3" json:"rx_metadata,omitempty"`
 	Settings       TxSettings    `protobuf:"bytes,7,opt,name=settings,proto3" json:"settings"`
 	// Server time when the Network Server received the message.
-	ReceivedAt           time.Time `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3,stdtime" json:"received_at"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+	ReceivedAt time.Time `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3,stdtime" json:"received_at"`
+	// Indicates whether the end device requested the message to be acknowledged.
+	Confirmed bool `protobuf:"varint,9,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// Measurements of the decoded payload in the normalized payload schema.
+	// The measurements are validated by the Application Server.
+	NormalizedPayload []*types.Struct `protobuf:"bytes,10,rep,name=normalized_payload,json=normalizedPayload,proto3" json:"normalized_payload,omitempty"`
+	// Warnings of the validation of the normalized payload.
+	NormalizedPayloadWarnings []string `protobuf:"bytes,11,rep,name=normalized_payload_warnings,json=normalizedPayloadWarnings,proto3" json:"normalized_payload_warnings,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
 func (m *ApplicationUplink) Reset()      { *m = ApplicationUplink{} }
@@ -487,6 +538,27 @@ func (m *ApplicationUplink) GetReceivedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *ApplicationUplink) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *ApplicationUplink) GetNormalizedPayload() []*types.Struct {
+	if m != nil {
+		return m.NormalizedPayload
+	}
+	return nil
+}
+
+func (m *ApplicationUplink) GetNormalizedPayloadWarnings() []string {
+	if m != nil {
+		return m.NormalizedPayloadWarnings
+	}
+	return nil
+}
+
 type ApplicationLocation struct {
 	Service              string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
 	Location             `protobuf:"bytes,2,opt,name=location,proto3,embedded=location" json:"location"`
@@ -637,10 +709,14 @@ type ApplicationDownlink struct {
 	// If not set, this downlink message may be transmitted in class A, B and C.
 	ClassBC *ApplicationDownlink_ClassBC `protobuf:"bytes,7,opt,name=class_b_c,json=classBC,proto3" json:"class_b_c,omitempty"`
 	// Priority for scheduling the downlink message.
-	Priority             TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
-	CorrelationIDs       []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Priority       TxSchedulePriority `protobuf:"varint,8,opt,name=priority,proto3,enum=ttn.lorawan.v3.TxSchedulePriority" json:"priority,omitempty"`
+	CorrelationIDs []string           `protobuf:"bytes,9,rep,name=correlation_ids,json=correlationIds,proto3" json:"correlation_ids,omitempty"`
+	// Time after which the Network Server drops the downlink message if it has not been transmitted yet.
+	// The expired downlink message is reported as failed.
+	// If null, the downlink message does not expire.
+	ExpiresAt            *time.Time `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *ApplicationDownlink) Reset()      { *m = ApplicationDownlink{} }
@@ -738,6 +814,13 @@ func (m *ApplicationDownlink) GetCorrelationIDs() []string {
 	return nil
 }
 
+func (m *ApplicationDownlink) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type ApplicationDownlink_ClassBC struct {
 	// Possible gateway identifiers and antenna index to use for this downlink message.
 	// The Network Server selects one of these gateways for downlink, based on connectivity, signal quality, channel utilization and an available slot.
@@ -1139,6 +1222,186 @@ func (*ApplicationUp) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ApplicationUpFilter filters upstream messages, for example to route them to specific integrations.
+// Messages pass the filter if they match all conditions.
+type ApplicationUpFilter struct {
+	// FPort ranges of the messages that pass the filter. If empty, messages on all FPorts pass the filter.
+	// Only messages with an FPort, i.e. uplink messages and downlink messages, are filtered.
+	FPortRanges []*FPortRange `protobuf:"bytes,1,rep,name=f_port_ranges,json=fPortRanges,proto3" json:"f_port_ranges,omitempty"`
+	// Conditions on the decoded payload of uplink messages.
+	// Uplink messages without decoded payload do not pass the filter if there are conditions.
+	// Other messages are not filtered.
+	DecodedPayloadConditions []*DecodedPayloadCondition `protobuf:"bytes,2,rep,name=decoded_payload_conditions,json=decodedPayloadConditions,proto3" json:"decoded_payload_conditions,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{}                   `json:"-"`
+	XXX_sizecache            int32                      `json:"-"`
+}
+
+func (m *ApplicationUpFilter) Reset()      { *m = ApplicationUpFilter{} }
+func (*ApplicationUpFilter) ProtoMessage() {}
+func (*ApplicationUpFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+}
+func (m *ApplicationUpFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationUpFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationUpFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationUpFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationUpFilter.Merge(m, src)
+}
+func (m *ApplicationUpFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationUpFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationUpFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationUpFilter proto.InternalMessageInfo
+
+func (m *ApplicationUpFilter) GetFPortRanges() []*FPortRange {
+	if m != nil {
+		return m.FPortRanges
+	}
+	return nil
+}
+
+func (m *ApplicationUpFilter) GetDecodedPayloadConditions() []*DecodedPayloadCondition {
+	if m != nil {
+		return m.DecodedPayloadConditions
+	}
+	return nil
+}
+
+// FPortRange is an inclusive range of FPorts.
+type FPortRange struct {
+	Min                  uint32   `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  uint32   `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FPortRange) Reset()      { *m = FPortRange{} }
+func (*FPortRange) ProtoMessage() {}
+func (*FPortRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+}
+func (m *FPortRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FPortRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FPortRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FPortRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FPortRange.Merge(m, src)
+}
+func (m *FPortRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *FPortRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FPortRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FPortRange proto.InternalMessageInfo
+
+func (m *FPortRange) GetMin() uint32 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *FPortRange) GetMax() uint32 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+// DecodedPayloadCondition is a condition on a field of the decoded payload.
+type DecodedPayloadCondition struct {
+	// Path of the field in the decoded payload. Nested fields are separated by dots.
+	Field    string                           `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Operator DecodedPayloadCondition_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=ttn.lorawan.v3.DecodedPayloadCondition_Operator" json:"operator,omitempty"`
+	// Value to compare the field with. The value is parsed as the type of the field:
+	// numbers are compared numerically, booleans and strings are compared for equality.
+	// The value is ignored for the EXISTS operator.
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecodedPayloadCondition) Reset()      { *m = DecodedPayloadCondition{} }
+func (*DecodedPayloadCondition) ProtoMessage() {}
+func (*DecodedPayloadCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{13}
+}
+func (m *DecodedPayloadCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DecodedPayloadCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DecodedPayloadCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DecodedPayloadCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecodedPayloadCondition.Merge(m, src)
+}
+func (m *DecodedPayloadCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DecodedPayloadCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecodedPayloadCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecodedPayloadCondition proto.InternalMessageInfo
+
+func (m *DecodedPayloadCondition) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *DecodedPayloadCondition) GetOperator() DecodedPayloadCondition_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return DecodedPayloadCondition_EQUAL
+}
+
+func (m *DecodedPayloadCondition) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type MessagePayloadFormatters struct {
 	// Payload formatter for uplink messages, must be set together with its parameter.
 	UpFormatter PayloadFormatter `protobuf:"varint,1,opt,name=up_formatter,json=upFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"up_formatter,omitempty"`
@@ -1147,15 +1410,18 @@ type MessagePayloadFormatters struct {
 	// Payload formatter for downlink messages, must be set together with its parameter.
 	DownFormatter PayloadFormatter `protobuf:"varint,3,opt,name=down_formatter,json=downFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"down_formatter,omitempty"`
 	// Parameter for the down_formatter, must be set together.
-	DownFormatterParameter string   `protobuf:"bytes,4,opt,name=down_formatter_parameter,json=downFormatterParameter,proto3" json:"down_formatter_parameter,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	DownFormatterParameter string `protobuf:"bytes,4,opt,name=down_formatter_parameter,json=downFormatterParameter,proto3" json:"down_formatter_parameter,omitempty"`
+	// Payload formatters by FPort range. The payload formatters of the first FPort range that contains the
+	// FPort of the message are used instead of the up_formatter and down_formatter.
+	FPortFormatters      []*FPortPayloadFormatters `protobuf:"bytes,5,rep,name=f_port_formatters,json=fPortFormatters,proto3" json:"f_port_formatters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *MessagePayloadFormatters) Reset()      { *m = MessagePayloadFormatters{} }
 func (*MessagePayloadFormatters) ProtoMessage() {}
 func (*MessagePayloadFormatters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{11}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{14}
 }
 func (m *MessagePayloadFormatters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,6 +1478,96 @@ func (m *MessagePayloadFormatters) GetDownFormatterParameter() string {
 	return ""
 }
 
+func (m *MessagePayloadFormatters) GetFPortFormatters() []*FPortPayloadFormatters {
+	if m != nil {
+		return m.FPortFormatters
+	}
+	return nil
+}
+
+// FPortPayloadFormatters are the payload formatters for the messages on a range of FPorts.
+type FPortPayloadFormatters struct {
+	// FPort range of the messages.
+	FPortRange FPortRange `protobuf:"bytes,1,opt,name=f_port_range,json=fPortRange,proto3" json:"f_port_range"`
+	// Payload formatter for uplink messages on the FPort range.
+	UpFormatter PayloadFormatter `protobuf:"varint,2,opt,name=up_formatter,json=upFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"up_formatter,omitempty"`
+	// Parameter for the up_formatter.
+	UpFormatterParameter string `protobuf:"bytes,3,opt,name=up_formatter_parameter,json=upFormatterParameter,proto3" json:"up_formatter_parameter,omitempty"`
+	// Payload formatter for downlink messages on the FPort range.
+	DownFormatter PayloadFormatter `protobuf:"varint,4,opt,name=down_formatter,json=downFormatter,proto3,enum=ttn.lorawan.v3.PayloadFormatter" json:"down_formatter,omitempty"`
+	// Parameter for the down_formatter.
+	DownFormatterParameter string   `protobuf:"bytes,5,opt,name=down_formatter_parameter,json=downFormatterParameter,proto3" json:"down_formatter_parameter,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *FPortPayloadFormatters) Reset()      { *m = FPortPayloadFormatters{} }
+func (*FPortPayloadFormatters) ProtoMessage() {}
+func (*FPortPayloadFormatters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bbc6bff5780bdc9d, []int{15}
+}
+func (m *FPortPayloadFormatters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FPortPayloadFormatters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FPortPayloadFormatters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FPortPayloadFormatters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FPortPayloadFormatters.Merge(m, src)
+}
+func (m *FPortPayloadFormatters) XXX_Size() int {
+	return m.Size()
+}
+func (m *FPortPayloadFormatters) XXX_DiscardUnknown() {
+	xxx_messageInfo_FPortPayloadFormatters.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FPortPayloadFormatters proto.InternalMessageInfo
+
+func (m *FPortPayloadFormatters) GetFPortRange() FPortRange {
+	if m != nil {
+		return m.FPortRange
+	}
+	return FPortRange{}
+}
+
+func (m *FPortPayloadFormatters) GetUpFormatter() PayloadFormatter {
+	if m != nil {
+		return m.UpFormatter
+	}
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *FPortPayloadFormatters) GetUpFormatterParameter() string {
+	if m != nil {
+		return m.UpFormatterParameter
+	}
+	return ""
+}
+
+func (m *FPortPayloadFormatters) GetDownFormatter() PayloadFormatter {
+	if m != nil {
+		return m.DownFormatter
+	}
+	return PayloadFormatter_FORMATTER_NONE
+}
+
+func (m *FPortPayloadFormatters) GetDownFormatterParameter() string {
+	if m != nil {
+		return m.DownFormatterParameter
+	}
+	return ""
+}
+
 type DownlinkQueueRequest struct {
 	EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3,embedded=end_device_ids" json:"end_device_ids"`
 	Downlinks            []*ApplicationDownlink `protobuf:"bytes,2,rep,name=downlinks,proto3" json:"downlinks,omitempty"`
@@ -1222,7 +1578,7 @@ type DownlinkQueueRequest struct {
 func (m *DownlinkQueueRequest) Reset()      { *m = DownlinkQueueRequest{} }
 func (*DownlinkQueueRequest) ProtoMessage() {}
 func (*DownlinkQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bbc6bff5780bdc9d, []int{12}
+	return fileDescriptor_bbc6bff5780bdc9d, []int{16}
 }
 func (m *DownlinkQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,6 +1619,8 @@ func init() {
 	golang_proto.RegisterEnum("ttn.lorawan.v3.PayloadFormatter", PayloadFormatter_name, PayloadFormatter_value)
 	proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
 	golang_proto.RegisterEnum("ttn.lorawan.v3.TxAcknowledgment_Result", TxAcknowledgment_Result_name, TxAcknowledgment_Result_value)
+	proto.RegisterEnum("ttn.lorawan.v3.DecodedPayloadCondition_Operator", DecodedPayloadCondition_Operator_name, DecodedPayloadCondition_Operator_value)
+	golang_proto.RegisterEnum("ttn.lorawan.v3.DecodedPayloadCondition_Operator", DecodedPayloadCondition_Operator_name, DecodedPayloadCondition_Operator_value)
 	proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
 	golang_proto.RegisterType((*UplinkMessage)(nil), "ttn.lorawan.v3.UplinkMessage")
 	proto.RegisterType((*DownlinkMessage)(nil), "ttn.lorawan.v3.DownlinkMessage")
@@ -1289,8 +1647,16 @@ func init() {
 	golang_proto.RegisterType((*ApplicationInvalidatedDownlinks)(nil), "ttn.lorawan.v3.ApplicationInvalidatedDownlinks")
 	proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
 	golang_proto.RegisterType((*ApplicationUp)(nil), "ttn.lorawan.v3.ApplicationUp")
+	proto.RegisterType((*ApplicationUpFilter)(nil), "ttn.lorawan.v3.ApplicationUpFilter")
+	golang_proto.RegisterType((*ApplicationUpFilter)(nil), "ttn.lorawan.v3.ApplicationUpFilter")
+	proto.RegisterType((*FPortRange)(nil), "ttn.lorawan.v3.FPortRange")
+	golang_proto.RegisterType((*FPortRange)(nil), "ttn.lorawan.v3.FPortRange")
+	proto.RegisterType((*DecodedPayloadCondition)(nil), "ttn.lorawan.v3.DecodedPayloadCondition")
+	golang_proto.RegisterType((*DecodedPayloadCondition)(nil), "ttn.lorawan.v3.DecodedPayloadCondition")
 	proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
 	golang_proto.RegisterType((*MessagePayloadFormatters)(nil), "ttn.lorawan.v3.MessagePayloadFormatters")
+	proto.RegisterType((*FPortPayloadFormatters)(nil), "ttn.lorawan.v3.FPortPayloadFormatters")
+	golang_proto.RegisterType((*FPortPayloadFormatters)(nil), "ttn.lorawan.v3.FPortPayloadFormatters")
 	proto.RegisterType((*DownlinkQueueRequest)(nil), "ttn.lorawan.v3.DownlinkQueueRequest")
 	golang_proto.RegisterType((*DownlinkQueueRequest)(nil), "ttn.lorawan.v3.DownlinkQueueRequest")
 }
@@ -1301,136 +1667,161 @@ func init() {
 }
 
 var fileDescriptor_bbc6bff5780bdc9d = []byte{
-	// 2055 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4f, 0x6c, 0xdb, 0xd6,
-	0x19, 0xe7, 0x93, 0x2c, 0x4b, 0x7a, 0x96, 0x64, 0xf6, 0xd5, 0xed, 0x58, 0x2f, 0xa3, 0x3c, 0x35,
-	0x5d, 0x9d, 0x2c, 0x96, 0x37, 0x67, 0xc3, 0xb2, 0x00, 0x5b, 0x2b, 0xca, 0x74, 0xac, 0xd8, 0x91,
-	0x94, 0x27, 0xa5, 0x4d, 0xd6, 0x75, 0x04, 0x4d, 0x3e, 0x29, 0xac, 0x65, 0x92, 0x25, 0x9f, 0x6c,
-	0xab, 0xc3, 0x80, 0x6c, 0xa7, 0x62, 0xa7, 0xa0, 0xc0, 0xfe, 0x60, 0xc0, 0x86, 0x62, 0xa7, 0x1e,
-	0x06, 0x2c, 0xc7, 0x60, 0xa7, 0xde, 0x96, 0x63, 0x2e, 0x03, 0x8a, 0x1d, 0xb2, 0x58, 0xbe, 0xf4,
-	0xd8, 0x63, 0xe0, 0x4b, 0x07, 0x3e, 0x92, 0x12, 0x25, 0x6b, 0xa9, 0xe3, 0x6c, 0xa7, 0x9d, 0x2c,
-	0xbe, 0xf7, 0xfb, 0x7e, 0xef, 0x7b, 0xdf, 0xff, 0x67, 0xb8, 0xd0, 0xb1, 0x1c, 0x75, 0x4f, 0x35,
-	0x97, 0x5c, 0xaa, 0x6a, 0xdb, 0xcb, 0xaa, 0x6d, 0x2c, 0xef, 0x10, 0xd7, 0x55, 0xdb, 0xc4, 0x2d,
-	0xda, 0x8e, 0x45, 0x2d, 0x94, 0xa3, 0xd4, 0x2c, 0x06, 0xa8, 0xe2, 0xee, 0xc5, 0xf9, 0x52, 0xdb,
-	0xa0, 0xb7, 0xbb, 0x5b, 0x45, 0xcd, 0xda, 0x59, 0x26, 0xe6, 0xae, 0xd5, 0xb3, 0x1d, 0x6b, 0xbf,
-	0xb7, 0xcc, 0xc0, 0xda, 0x52, 0x9b, 0x98, 0x4b, 0xbb, 0x6a, 0xc7, 0xd0, 0x55, 0x4a, 0x96, 0x8f,
-	0xfd, 0xf0, 0x29, 0xe7, 0x97, 0x22, 0x14, 0x6d, 0xab, 0x6d, 0xf9, 0xc2, 0x5b, 0xdd, 0x16, 0xfb,
-	0x62, 0x1f, 0xec, 0x57, 0x00, 0x3f, 0xd3, 0xb6, 0xac, 0x76, 0x87, 0x0c, 0x51, 0x2e, 0x75, 0xba,
-	0x1a, 0x0d, 0x76, 0xf3, 0xe3, 0xbb, 0xd4, 0xd8, 0x21, 0x2e, 0x55, 0x77, 0xec, 0x00, 0xf0, 0x8d,
-	0xe3, 0x57, 0x24, 0x8e, 0x63, 0x39, 0xc1, 0xf6, 0xab, 0xc7, 0xb7, 0x0d, 0x9d, 0x98, 0xd4, 0x68,
-	0x19, 0xc4, 0x71, 0x43, 0x15, 0x8e, 0x83, 0xb6, 0x49, 0x2f, 0xdc, 0xcd, 0x1f, 0xdf, 0x0d, 0x0d,
-	0xe6, 0x03, 0x26, 0x5a, 0x99, 0xaa, 0xba, 0x4a, 0x55, 0x1f, 0x51, 0x78, 0x10, 0x87, 0xd9, 0x1b,
-	0x76, 0xc7, 0x30, 0xb7, 0xaf, 0xf9, 0xe6, 0x47, 0x79, 0x38, 0xe3, 0xa8, 0x7b, 0x8a, 0xad, 0xf6,
-	0x3a, 0x96, 0xaa, 0x0b, 0x60, 0x01, 0x2c, 0x66, 0x30, 0x74, 0xd4, 0xbd, 0xba, 0xbf, 0x82, 0xbe,
-	0x0b, 0x93, 0xe1, 0x66, 0x6c, 0x01, 0x2c, 0xce, 0xac, 0x7c, 0xad, 0x38, 0xea, 0xaa, 0x62, 0x40,
-	0x85, 0x43, 0x1c, 0x5a, 0x85, 0x29, 0x97, 0x50, 0x6a, 0x98, 0x6d, 0x57, 0x98, 0x62, 0x32, 0xf3,
-	0xe3, 0x32, 0xcd, 0xfd, 0x46, 0x80, 0x90, 0x32, 0x47, 0x52, 0xe2, 0xd7, 0x20, 0xc6, 0x83, 0x07,
-	0x8f, 0xf2, 0x1c, 0x1e, 0x48, 0x22, 0x19, 0xce, 0x38, 0xfb, 0x4a, 0x78, 0x01, 0x21, 0xb1, 0x10,
-	0x9f, 0x44, 0x84, 0xf7, 0xaf, 0x05, 0x08, 0x29, 0x75, 0x24, 0x25, 0x3e, 0x02, 0xb1, 0x14, 0xc0,
-	0xd0, 0x19, 0xac, 0x32, 0x1a, 0xa2, 0x11, 0x63, 0x97, 0xe8, 0x8a, 0x4a, 0x85, 0xe9, 0x40, 0x1f,
-	0xdf, 0x9d, 0xc5, 0xd0, 0x9d, 0xc5, 0x66, 0xe8, 0x4e, 0x29, 0xe5, 0xe9, 0x71, 0xf7, 0x5f, 0x79,
-	0x8f, 0x26, 0x10, 0x2c, 0x51, 0x74, 0x05, 0xce, 0x6a, 0x96, 0xe3, 0x90, 0x8e, 0x4a, 0x0d, 0xcb,
-	0x54, 0x0c, 0xdd, 0x15, 0x92, 0x0b, 0xf1, 0xc5, 0xb4, 0x24, 0x1e, 0x49, 0xe9, 0x8f, 0xc0, 0x74,
-	0x61, 0xca, 0x89, 0x09, 0x7a, 0xff, 0x51, 0x3e, 0x57, 0x1e, 0xc2, 0x2a, 0xab, 0x2e, 0xce, 0x45,
-	0xc4, 0x2a, 0xba, 0x8b, 0x2e, 0xc3, 0x39, 0x9d, 0xec, 0x1a, 0x1a, 0x51, 0xb4, 0xdb, 0xaa, 0x69,
-	0x92, 0x8e, 0x62, 0x98, 0x3a, 0xd9, 0x17, 0xd2, 0x0b, 0x60, 0x31, 0xcb, 0xee, 0x70, 0x3e, 0x2e,
-	0x7c, 0x09, 0x30, 0xf2, 0x51, 0x65, 0x1f, 0x54, 0xf1, 0x30, 0x97, 0xa7, 0xee, 0x7f, 0x9c, 0xe7,
-	0xae, 0x4e, 0xa5, 0x52, 0x7c, 0xba, 0xf0, 0xdb, 0x38, 0x9c, 0x5d, 0xb5, 0xf6, 0xcc, 0xff, 0xb5,
-	0x33, 0x7f, 0x0a, 0x73, 0xc4, 0xd4, 0x95, 0x40, 0x67, 0xef, 0xde, 0x71, 0x26, 0x79, 0x76, 0x5c,
-	0x52, 0x36, 0xf5, 0x55, 0x06, 0xaa, 0x0c, 0xe3, 0x5a, 0xe2, 0xfb, 0x8f, 0xf2, 0x99, 0xe1, 0xce,
-	0xaa, 0x8b, 0x33, 0x64, 0x88, 0x73, 0xd1, 0xf7, 0x61, 0xd2, 0x21, 0xef, 0x77, 0x89, 0x4b, 0x83,
-	0x48, 0x79, 0xe5, 0x78, 0xa4, 0x60, 0x1f, 0xb0, 0xce, 0xe1, 0x10, 0x8b, 0x2e, 0xc3, 0xb4, 0xab,
-	0xdd, 0x26, 0x7a, 0xb7, 0x43, 0x74, 0x21, 0xf1, 0x55, 0x21, 0xb6, 0xce, 0xe1, 0x21, 0x7c, 0x92,
-	0x27, 0xa7, 0x4f, 0xe3, 0x49, 0xdf, 0x1b, 0xd2, 0xec, 0x30, 0xd8, 0x51, 0xfc, 0x89, 0x04, 0x0a,
-	0x7f, 0x8f, 0x41, 0xbe, 0xb9, 0x5f, 0xd2, 0xb6, 0x4d, 0x6b, 0xaf, 0x43, 0xf4, 0xf6, 0x0e, 0x31,
-	0x27, 0x86, 0x0f, 0x38, 0x55, 0xf8, 0x54, 0xe0, 0xb4, 0x43, 0xdc, 0x6e, 0x87, 0x32, 0x07, 0xe6,
-	0x56, 0x5e, 0x3f, 0x7e, 0xed, 0xd1, 0xa3, 0x8b, 0x98, 0xc1, 0x59, 0x64, 0xfd, 0xca, 0x4b, 0x33,
-	0x1c, 0x10, 0x14, 0xfe, 0x04, 0xe0, 0xb4, 0xbf, 0x89, 0x66, 0x60, 0xb2, 0x71, 0xa3, 0x5c, 0x96,
-	0x1b, 0x0d, 0x9e, 0x43, 0x2f, 0xc0, 0xec, 0x8d, 0xea, 0x46, 0xb5, 0xf6, 0x76, 0x55, 0x91, 0x31,
-	0xae, 0x61, 0x1e, 0xa0, 0x0c, 0x4c, 0x35, 0x6b, 0x35, 0x65, 0xb3, 0xd4, 0x94, 0xf9, 0x18, 0xca,
-	0xc2, 0xb4, 0xf7, 0x25, 0x97, 0xf0, 0xe6, 0x2d, 0x3e, 0x8e, 0xe6, 0x20, 0x5f, 0xae, 0x6d, 0x6e,
-	0x56, 0x1a, 0x95, 0x5a, 0x55, 0xa9, 0x97, 0xca, 0x1b, 0x72, 0x93, 0x9f, 0x1a, 0x5d, 0x95, 0xe4,
-	0x52, 0xb9, 0x56, 0xe5, 0x13, 0xde, 0x41, 0xcd, 0x9b, 0xca, 0x1a, 0x96, 0xaf, 0xf3, 0xd3, 0x8c,
-	0xf5, 0xa6, 0x52, 0xaf, 0xbd, 0x2d, 0x63, 0x3e, 0x89, 0x78, 0x98, 0xb9, 0x52, 0x6f, 0x28, 0x37,
-	0xaa, 0x9b, 0xb5, 0xf2, 0x86, 0xbc, 0xca, 0xa7, 0x0a, 0xff, 0x88, 0xc3, 0x17, 0x4a, 0xb6, 0xdd,
-	0x31, 0x34, 0x76, 0x7d, 0xbf, 0x70, 0xa1, 0x1f, 0xc3, 0x9c, 0x4b, 0x5c, 0xd7, 0x33, 0xe3, 0x36,
-	0xe9, 0x29, 0x46, 0x10, 0xe7, 0x92, 0x70, 0x24, 0x25, 0x3e, 0x88, 0x0b, 0x77, 0x58, 0xc8, 0x35,
-	0x7c, 0xc4, 0x06, 0xe9, 0x55, 0x56, 0x71, 0xc6, 0x1d, 0x7e, 0xe9, 0xe8, 0x2c, 0x9c, 0x6e, 0x29,
-	0xb6, 0xe5, 0xf8, 0x16, 0xcc, 0x4a, 0xd9, 0x23, 0x09, 0x9e, 0x4f, 0x09, 0x5f, 0x82, 0x45, 0x70,
-	0xe9, 0x31, 0xc0, 0x89, 0x56, 0xdd, 0x72, 0x28, 0x7a, 0x11, 0x26, 0x5a, 0x8a, 0x66, 0x52, 0x16,
-	0xed, 0x59, 0x3c, 0xd5, 0x2a, 0x9b, 0x14, 0x2d, 0xc3, 0x99, 0x96, 0xb3, 0x33, 0xc8, 0xaf, 0x29,
-	0x76, 0x6e, 0xae, 0xff, 0x28, 0x0f, 0xd7, 0xf0, 0xb5, 0x20, 0xc7, 0x30, 0x6c, 0x39, 0x3b, 0x61,
-	0xbe, 0xbd, 0x09, 0x67, 0x75, 0xa2, 0x59, 0x3a, 0xd1, 0x07, 0x42, 0x89, 0x20, 0xef, 0xc6, 0x0b,
-	0x50, 0x83, 0x75, 0x1b, 0x9c, 0x0b, 0xf0, 0x21, 0xc3, 0x58, 0x15, 0x9c, 0x3e, 0x65, 0x15, 0x8c,
-	0x96, 0xe4, 0xe4, 0x73, 0x95, 0xe4, 0x48, 0x2d, 0x4d, 0x9d, 0xae, 0x96, 0x16, 0xfe, 0x16, 0x83,
-	0x2f, 0x46, 0xfc, 0xba, 0x69, 0xf9, 0x7f, 0x91, 0x00, 0x93, 0x2e, 0x71, 0xbc, 0xd2, 0xc0, 0x5c,
-	0x9a, 0xc6, 0xe1, 0x27, 0x5a, 0x83, 0xa9, 0x4e, 0x80, 0x0a, 0x0a, 0x97, 0x30, 0xae, 0x7e, 0xc8,
-	0x22, 0xf1, 0x51, 0xe5, 0x1f, 0x3e, 0xca, 0x03, 0x3c, 0x90, 0x45, 0xbf, 0x04, 0x10, 0xaa, 0x94,
-	0x3a, 0xc6, 0x56, 0x97, 0x12, 0xaf, 0x92, 0x79, 0xd6, 0xbc, 0x38, 0x4e, 0x35, 0x41, 0xb7, 0x62,
-	0x69, 0x20, 0x25, 0x9b, 0xd4, 0xe9, 0x49, 0x17, 0x8e, 0xa4, 0x73, 0x7f, 0x00, 0xdf, 0x2a, 0x9c,
-	0x75, 0x0a, 0xc2, 0xd9, 0x15, 0xf1, 0x67, 0xef, 0xa8, 0x4b, 0x1f, 0x7c, 0x67, 0xe9, 0x87, 0xef,
-	0x2e, 0xbe, 0x71, 0xf9, 0x9d, 0xa5, 0x77, 0xdf, 0x08, 0x3f, 0xcf, 0xfd, 0x7c, 0xe5, 0xc2, 0x2f,
-	0xce, 0xe2, 0xc8, 0xa1, 0xf3, 0x3f, 0x82, 0xb3, 0x63, 0x64, 0x88, 0x87, 0xf1, 0x6d, 0xd2, 0x0b,
-	0x2e, 0xed, 0xfd, 0x44, 0x73, 0x30, 0xb1, 0xab, 0x76, 0xba, 0x84, 0xdd, 0x36, 0x8d, 0xfd, 0x8f,
-	0xcb, 0xb1, 0x4b, 0xa0, 0xf0, 0xcf, 0x18, 0x7c, 0x29, 0xa2, 0xe0, 0x55, 0xcb, 0x30, 0x4b, 0x9a,
-	0x46, 0x6c, 0xfa, 0xdc, 0x89, 0xf1, 0x03, 0x98, 0x56, 0x6d, 0x5b, 0x71, 0x3d, 0xe9, 0xc0, 0xca,
-	0x5f, 0x1f, 0x37, 0xcd, 0x06, 0xe9, 0xc9, 0xe6, 0x2e, 0xe9, 0x58, 0x36, 0xc1, 0x49, 0xd5, 0xb6,
-	0x1b, 0x1b, 0xa4, 0x87, 0x6e, 0xc2, 0x97, 0x0c, 0x33, 0x1c, 0xbe, 0x74, 0x45, 0x0f, 0xba, 0x52,
-	0x68, 0xdf, 0x57, 0x9f, 0x62, 0xdf, 0xb0, 0x83, 0xe1, 0xb9, 0x08, 0x43, 0xb8, 0xe8, 0xa2, 0xd7,
-	0xe1, 0xac, 0x4d, 0x4c, 0xdd, 0x30, 0xdb, 0x4a, 0xa0, 0x2a, 0x4b, 0xba, 0x14, 0xce, 0x05, 0xcb,
-	0xc1, 0x75, 0xfe, 0x5b, 0x91, 0xf9, 0xc7, 0xc4, 0x48, 0x64, 0x86, 0x8a, 0xfc, 0x9f, 0xd5, 0x9c,
-	0x33, 0x30, 0xad, 0x59, 0x66, 0xcb, 0x70, 0x76, 0x88, 0xce, 0x06, 0xa6, 0x14, 0x1e, 0x2e, 0xa0,
-	0x2b, 0x30, 0xad, 0x75, 0x54, 0xd7, 0x55, 0xb6, 0x14, 0x2d, 0xa8, 0x25, 0xdf, 0x3e, 0x81, 0x87,
-	0x8b, 0x65, 0x4f, 0x48, 0x2a, 0xe3, 0xa4, 0xe6, 0xff, 0x40, 0xeb, 0x30, 0x65, 0x3b, 0x86, 0xe5,
-	0x18, 0xb4, 0xc7, 0x1c, 0x96, 0x5b, 0x29, 0x4c, 0xa8, 0x49, 0x41, 0xdf, 0xae, 0x07, 0xc8, 0x48,
-	0x1f, 0x1b, 0x48, 0x4f, 0xea, 0xae, 0xe9, 0xd3, 0x74, 0xd7, 0xf9, 0xdf, 0x01, 0x98, 0x0c, 0xf4,
-	0x44, 0x32, 0x4c, 0xb5, 0x55, 0x4a, 0xf6, 0xd4, 0x9e, 0x3f, 0xea, 0xcd, 0xac, 0x9c, 0x1b, 0x57,
-	0xef, 0x8a, 0xbf, 0x5f, 0x32, 0x29, 0x31, 0x4d, 0x35, 0x32, 0xf7, 0xe0, 0x81, 0x28, 0x92, 0x61,
-	0x56, 0xdd, 0x72, 0xad, 0x4e, 0x97, 0x12, 0xc5, 0x7b, 0x33, 0x9c, 0x20, 0x36, 0xa7, 0x58, 0x5c,
-	0x66, 0x42, 0x31, 0x6f, 0xc3, 0x1f, 0x36, 0x0a, 0xb7, 0xe0, 0xdc, 0x04, 0xd3, 0xba, 0xa8, 0x04,
-	0xd3, 0xc3, 0xac, 0x03, 0x27, 0xcf, 0xba, 0xa1, 0x54, 0xe1, 0x1e, 0x80, 0xaf, 0x4c, 0x80, 0xac,
-	0xa9, 0x86, 0x37, 0x34, 0x5d, 0x87, 0xa9, 0x10, 0xca, 0x42, 0xff, 0x64, 0xfc, 0x93, 0x6a, 0x71,
-	0x48, 0x83, 0xde, 0x84, 0x09, 0xf6, 0x40, 0x0a, 0x4a, 0xcd, 0x99, 0x63, 0xf3, 0xa4, 0xb7, 0xb9,
-	0x4a, 0xa8, 0x6a, 0x74, 0xc6, 0x3b, 0x92, 0x2f, 0x58, 0xf8, 0x0d, 0x80, 0xf9, 0xc8, 0xa9, 0x95,
-	0x49, 0x15, 0x64, 0xe3, 0x74, 0x96, 0x89, 0xb4, 0xd1, 0xa1, 0x3c, 0x7a, 0x0d, 0xce, 0x76, 0x54,
-	0x97, 0x2a, 0x2c, 0x4b, 0x59, 0x9d, 0xf3, 0xf3, 0x19, 0x67, 0xbc, 0xe5, 0xb5, 0xb2, 0x49, 0x3d,
-	0xf9, 0xc2, 0x61, 0x12, 0x66, 0x47, 0xe6, 0x96, 0x09, 0x43, 0x34, 0x78, 0x96, 0x21, 0xfa, 0x98,
-	0x15, 0x47, 0x87, 0xe8, 0x09, 0xe1, 0x1f, 0x3b, 0xd5, 0x70, 0x59, 0x1a, 0xad, 0xa2, 0x99, 0x13,
-	0x46, 0x6a, 0xf4, 0x9d, 0x74, 0x15, 0xe6, 0xba, 0x6c, 0x4e, 0x53, 0x82, 0x07, 0x7e, 0xf0, 0x5c,
-	0xf8, 0xe6, 0x53, 0x8c, 0xee, 0x0f, 0x76, 0xeb, 0x1c, 0xce, 0x76, 0x47, 0xde, 0xa6, 0xeb, 0x70,
-	0xe6, 0x3d, 0xcb, 0x30, 0x15, 0x95, 0xf5, 0xb7, 0xe0, 0x81, 0xf0, 0xda, 0x53, 0x88, 0x86, 0xcd,
-	0x70, 0x9d, 0xc3, 0xf0, 0xbd, 0x61, 0x6b, 0x5c, 0x87, 0x99, 0xd0, 0x8b, 0x8a, 0xaa, 0x6d, 0x07,
-	0x05, 0xf1, 0x24, 0x81, 0xb0, 0xce, 0xe1, 0x99, 0x50, 0xb4, 0xa4, 0x6d, 0xa3, 0xab, 0x30, 0x3b,
-	0x60, 0x32, 0x3d, 0xaa, 0xe9, 0x67, 0xa1, 0x1a, 0x68, 0x51, 0x55, 0xc7, 0xb8, 0x5c, 0x62, 0xd2,
-	0xa0, 0x9a, 0x3e, 0x2b, 0x57, 0xc3, 0x7b, 0x60, 0x34, 0xe1, 0xec, 0x80, 0xab, 0xc5, 0x72, 0x36,
-	0x28, 0x34, 0xe7, 0x4e, 0xc0, 0xe6, 0x27, 0xf9, 0x3a, 0x87, 0x73, 0xfa, 0x68, 0xda, 0x57, 0x23,
-	0xac, 0xef, 0x77, 0x49, 0x97, 0xe8, 0xec, 0x9d, 0x7a, 0x62, 0x1d, 0x07, 0x7c, 0xd7, 0x99, 0x30,
-	0xb2, 0xe0, 0xfc, 0x28, 0x9f, 0x12, 0x69, 0xfb, 0x02, 0x64, 0xd4, 0xcb, 0x4f, 0xa1, 0x9e, 0x94,
-	0xe2, 0xeb, 0x1c, 0x16, 0x46, 0x8e, 0x89, 0x80, 0xbc, 0x0b, 0x84, 0xc3, 0x9f, 0xe2, 0x5a, 0x9d,
-	0x5d, 0xa2, 0x0b, 0x33, 0x5f, 0x79, 0x81, 0x70, 0xe8, 0xf3, 0x2e, 0x10, 0x4a, 0x37, 0x98, 0xb0,
-	0x94, 0x86, 0xb1, 0xae, 0xed, 0xbf, 0xf3, 0xfe, 0x12, 0x83, 0x42, 0x10, 0xa9, 0x41, 0xe3, 0x5c,
-	0xb3, 0x9c, 0x1d, 0x95, 0x52, 0xe2, 0xb8, 0xe8, 0x1a, 0xcc, 0x74, 0x6d, 0xa5, 0x15, 0x2e, 0xb0,
-	0x74, 0xcf, 0xad, 0x2c, 0x8c, 0x1f, 0x3a, 0x2e, 0x18, 0xe9, 0x6e, 0x33, 0x5d, 0x7b, 0xb0, 0x8c,
-	0xbe, 0x07, 0x5f, 0x8e, 0xd2, 0x29, 0xb6, 0xea, 0xa8, 0x3b, 0xc4, 0x23, 0xf6, 0xe7, 0xc3, 0xb9,
-	0x08, 0xb8, 0x1e, 0xee, 0xa1, 0xeb, 0x90, 0xd9, 0x3f, 0xa2, 0x46, 0xfc, 0x99, 0xd5, 0x60, 0x11,
-	0x3a, 0x54, 0xe4, 0x12, 0x14, 0x46, 0x29, 0x23, 0xaa, 0x4c, 0x31, 0x55, 0x5e, 0x1e, 0x11, 0x18,
-	0x28, 0x53, 0xf8, 0x2b, 0x80, 0x73, 0xab, 0x51, 0x37, 0x05, 0xcf, 0x7a, 0xd4, 0x7c, 0xae, 0xda,
-	0x98, 0xfa, 0x0f, 0x35, 0x71, 0xa4, 0x23, 0xc6, 0x4e, 0xd3, 0x11, 0xcf, 0xdf, 0x05, 0x90, 0x1f,
-	0xb7, 0x0c, 0x42, 0x30, 0xb7, 0x56, 0xc3, 0xd7, 0x4a, 0xcd, 0xa6, 0x8c, 0x95, 0x6a, 0xad, 0x2a,
-	0xf3, 0x1c, 0x12, 0xe0, 0xdc, 0x70, 0x0d, 0xcb, 0xf5, 0x5a, 0xa3, 0xd2, 0xac, 0xe1, 0x5b, 0x3c,
-	0x40, 0xf3, 0xf0, 0xe5, 0xe1, 0xce, 0x15, 0x5c, 0x2f, 0x2b, 0x0d, 0x19, 0xbf, 0x55, 0x29, 0x7b,
-	0xaf, 0xe8, 0x11, 0xa9, 0xab, 0xa5, 0xb7, 0x4a, 0x8d, 0x32, 0xae, 0xd4, 0x9b, 0x7c, 0x7c, 0x74,
-	0xa7, 0x5c, 0xba, 0x25, 0x57, 0xab, 0xf2, 0x66, 0xbd, 0xce, 0x4f, 0x49, 0x7f, 0x06, 0x0f, 0x0e,
-	0x44, 0xf0, 0xf0, 0x40, 0x04, 0x9f, 0x1d, 0x88, 0xdc, 0xe3, 0x03, 0x91, 0xfb, 0xfc, 0x40, 0xe4,
-	0xbe, 0x38, 0x10, 0xb9, 0x27, 0x07, 0x22, 0xb8, 0xd3, 0x17, 0xc1, 0x87, 0x7d, 0x91, 0xfb, 0xa4,
-	0x2f, 0x82, 0x7b, 0x7d, 0x91, 0xbb, 0xdf, 0x17, 0xb9, 0x4f, 0xfb, 0x22, 0xf7, 0xa0, 0x2f, 0x82,
-	0x87, 0x7d, 0x11, 0x7c, 0xd6, 0x17, 0xb9, 0xc7, 0x7d, 0x11, 0x7c, 0xde, 0x17, 0xb9, 0x2f, 0xfa,
-	0x22, 0x78, 0xd2, 0x17, 0xb9, 0x3b, 0x87, 0x22, 0xf7, 0xe1, 0xa1, 0x08, 0xee, 0x1e, 0x8a, 0xdc,
-	0xef, 0x0f, 0x45, 0xf0, 0xf1, 0xa1, 0xc8, 0x7d, 0x72, 0x28, 0x72, 0xf7, 0x0e, 0x45, 0x70, 0xff,
-	0x50, 0x04, 0x9f, 0x1e, 0x8a, 0xe0, 0x27, 0x17, 0xda, 0x56, 0x91, 0xde, 0x26, 0xf4, 0xb6, 0xf7,
-	0x0a, 0x2c, 0x9a, 0x84, 0xee, 0x59, 0xce, 0xf6, 0xf2, 0xe8, 0x7f, 0x1b, 0xed, 0xed, 0xf6, 0x32,
-	0xa5, 0xa6, 0xbd, 0xb5, 0x35, 0xcd, 0x1a, 0xc5, 0xc5, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x0f,
-	0x91, 0xa2, 0x57, 0xf5, 0x15, 0x00, 0x00,
+	// 2464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0xe3, 0xc6,
+	0x15, 0x26, 0xf5, 0x67, 0xe9, 0x49, 0x96, 0xb8, 0x13, 0x67, 0xa3, 0x75, 0x52, 0xca, 0x55, 0x36,
+	0xc9, 0x6e, 0x1a, 0xcb, 0xa9, 0xd3, 0xa2, 0xe9, 0x02, 0x4d, 0x22, 0xca, 0xf4, 0x5a, 0x6b, 0xaf,
+	0xa4, 0x1d, 0x69, 0x93, 0xdd, 0xa6, 0x29, 0x41, 0x8b, 0x23, 0x2d, 0x63, 0x99, 0x64, 0x48, 0xca,
+	0xb6, 0x52, 0x14, 0xd8, 0x16, 0x3d, 0x04, 0x3d, 0x05, 0x01, 0xfa, 0x83, 0x02, 0x2d, 0x82, 0x9e,
+	0x72, 0x6b, 0x8e, 0x69, 0x4f, 0xb9, 0x75, 0x2f, 0x05, 0x72, 0x0c, 0x7a, 0x70, 0x63, 0xf9, 0x92,
+	0x63, 0x8e, 0x81, 0x5b, 0x20, 0x05, 0xc9, 0xa1, 0x48, 0xfd, 0x64, 0xe3, 0x75, 0x9a, 0x53, 0x4f,
+	0xd6, 0xcc, 0xfb, 0xde, 0x9b, 0x79, 0xbf, 0xf3, 0x1e, 0x0d, 0x4b, 0x3d, 0xdd, 0x94, 0xf7, 0x65,
+	0x6d, 0xd9, 0xb2, 0xe5, 0xf6, 0xce, 0x8a, 0x6c, 0xa8, 0x2b, 0xbb, 0xc4, 0xb2, 0xe4, 0x2e, 0xb1,
+	0x4a, 0x86, 0xa9, 0xdb, 0x3a, 0xca, 0xda, 0xb6, 0x56, 0xa2, 0xa8, 0xd2, 0xde, 0x73, 0x8b, 0xe5,
+	0xae, 0x6a, 0xdf, 0xe9, 0x6f, 0x97, 0xda, 0xfa, 0xee, 0x0a, 0xd1, 0xf6, 0xf4, 0x81, 0x61, 0xea,
+	0x07, 0x83, 0x15, 0x17, 0xdc, 0x5e, 0xee, 0x12, 0x6d, 0x79, 0x4f, 0xee, 0xa9, 0x8a, 0x6c, 0x93,
+	0x95, 0xa9, 0x1f, 0x9e, 0xc8, 0xc5, 0xe5, 0x90, 0x88, 0xae, 0xde, 0xd5, 0x3d, 0xe6, 0xed, 0x7e,
+	0xc7, 0x5d, 0xb9, 0x0b, 0xf7, 0x17, 0x85, 0x3f, 0xd6, 0xd5, 0xf5, 0x6e, 0x8f, 0x04, 0x28, 0xcb,
+	0x36, 0xfb, 0x6d, 0x9b, 0x52, 0x0b, 0x93, 0x54, 0x5b, 0xdd, 0x25, 0x96, 0x2d, 0xef, 0x1a, 0x14,
+	0xf0, 0xad, 0x69, 0x15, 0x89, 0x69, 0xea, 0x26, 0x25, 0x3f, 0x3e, 0x4d, 0x56, 0x15, 0xa2, 0xd9,
+	0x6a, 0x47, 0x25, 0xa6, 0xe5, 0x5f, 0x61, 0x1a, 0xb4, 0x43, 0x06, 0x3e, 0xb5, 0x30, 0x4d, 0xf5,
+	0x0d, 0xe6, 0x01, 0x66, 0x5a, 0xd9, 0x96, 0x15, 0xd9, 0x96, 0x3d, 0x44, 0xf1, 0x5e, 0x14, 0xe6,
+	0x6f, 0x1a, 0x3d, 0x55, 0xdb, 0xb9, 0xee, 0x99, 0x1f, 0x15, 0x20, 0x6d, 0xca, 0xfb, 0x92, 0x21,
+	0x0f, 0x7a, 0xba, 0xac, 0xe4, 0xd9, 0x25, 0xf6, 0x52, 0x06, 0x83, 0x29, 0xef, 0x37, 0xbc, 0x1d,
+	0xf4, 0x5d, 0x98, 0xf3, 0x89, 0x91, 0x25, 0xf6, 0x52, 0x7a, 0xf5, 0x91, 0xd2, 0xb8, 0xab, 0x4a,
+	0x54, 0x14, 0xf6, 0x71, 0x68, 0x0d, 0x92, 0x16, 0xb1, 0x6d, 0x55, 0xeb, 0x5a, 0xf9, 0x98, 0xcb,
+	0xb3, 0x38, 0xc9, 0xd3, 0x3a, 0x68, 0x52, 0x84, 0x90, 0x39, 0x11, 0xe2, 0xbf, 0x66, 0x23, 0x1c,
+	0x7b, 0xef, 0xb0, 0xc0, 0xe0, 0x11, 0x27, 0x12, 0x21, 0x6d, 0x1e, 0x48, 0xbe, 0x02, 0xf9, 0xf8,
+	0x52, 0x74, 0x96, 0x20, 0x7c, 0x70, 0x9d, 0x22, 0x84, 0xe4, 0x89, 0x10, 0x7f, 0x87, 0x8d, 0x24,
+	0x59, 0x0c, 0xe6, 0x68, 0xd7, 0x15, 0x43, 0xda, 0x44, 0xdd, 0x23, 0x8a, 0x24, 0xdb, 0xf9, 0x04,
+	0xbd, 0x8f, 0xe7, 0xce, 0x92, 0xef, 0xce, 0x52, 0xcb, 0x77, 0xa7, 0x90, 0x74, 0xee, 0xf1, 0xf6,
+	0xbf, 0x0a, 0x8e, 0x18, 0xca, 0x58, 0xb6, 0xd1, 0x55, 0xc8, 0xb5, 0x75, 0xd3, 0x24, 0x3d, 0xd9,
+	0x56, 0x75, 0x4d, 0x52, 0x15, 0x2b, 0x3f, 0xb7, 0x14, 0xbd, 0x94, 0x12, 0xf8, 0x13, 0x21, 0xf5,
+	0x0e, 0x9b, 0x28, 0xc6, 0xcc, 0x48, 0x5e, 0x19, 0x1e, 0x16, 0xb2, 0x95, 0x00, 0x56, 0x5d, 0xb3,
+	0x70, 0x36, 0xc4, 0x56, 0x55, 0x2c, 0x74, 0x05, 0x16, 0x14, 0xb2, 0xa7, 0xb6, 0x89, 0xd4, 0xbe,
+	0x23, 0x6b, 0x1a, 0xe9, 0x49, 0xaa, 0xa6, 0x90, 0x83, 0x7c, 0x6a, 0x89, 0xbd, 0x34, 0xef, 0xea,
+	0xf0, 0x74, 0x34, 0xff, 0x05, 0x8b, 0x91, 0x87, 0xaa, 0x78, 0xa0, 0xaa, 0x83, 0xb9, 0x12, 0xfb,
+	0xe0, 0xdd, 0x02, 0x73, 0x2d, 0x96, 0x4c, 0x72, 0xa9, 0xe2, 0x6f, 0xa3, 0x90, 0x5b, 0xd3, 0xf7,
+	0xb5, 0x6f, 0xda, 0x99, 0x3f, 0x81, 0x2c, 0xd1, 0x14, 0x89, 0xde, 0xd9, 0xd1, 0x3b, 0xea, 0x72,
+	0x5e, 0x9c, 0xe4, 0x14, 0x35, 0x65, 0xcd, 0x05, 0x55, 0x83, 0xb8, 0x16, 0xb8, 0xe1, 0x61, 0x21,
+	0x13, 0x50, 0xd6, 0x2c, 0x9c, 0x21, 0x01, 0xce, 0x42, 0xdf, 0x87, 0x39, 0x93, 0xbc, 0xd1, 0x27,
+	0x96, 0x4d, 0x23, 0xe5, 0xc2, 0x74, 0xa4, 0x60, 0x0f, 0xb0, 0xc1, 0x60, 0x1f, 0x8b, 0xae, 0x40,
+	0xca, 0x6a, 0xdf, 0x21, 0x4a, 0xbf, 0x47, 0x94, 0x7c, 0xfc, 0xab, 0x42, 0x6c, 0x83, 0xc1, 0x01,
+	0x7c, 0x96, 0x27, 0x13, 0x67, 0xf1, 0xa4, 0xe7, 0x0d, 0x21, 0x17, 0x04, 0x3b, 0x8a, 0x7e, 0x2e,
+	0xb0, 0xc5, 0xbf, 0x47, 0x80, 0x6b, 0x1d, 0x94, 0xdb, 0x3b, 0x9a, 0xbe, 0xdf, 0x23, 0x4a, 0x77,
+	0x97, 0x68, 0x33, 0xc3, 0x87, 0x3d, 0x53, 0xf8, 0x54, 0x21, 0x61, 0x12, 0xab, 0xdf, 0xb3, 0x5d,
+	0x07, 0x66, 0x57, 0x9f, 0x9a, 0x56, 0x7b, 0xfc, 0xe8, 0x12, 0x76, 0xe1, 0x6e, 0x64, 0xfd, 0xd2,
+	0x49, 0x33, 0x4c, 0x05, 0x14, 0xff, 0xc4, 0x42, 0xc2, 0x23, 0xa2, 0x34, 0xcc, 0x35, 0x6f, 0x56,
+	0x2a, 0x62, 0xb3, 0xc9, 0x31, 0xe8, 0x1c, 0xcc, 0xdf, 0xac, 0x6d, 0xd6, 0xea, 0xaf, 0xd4, 0x24,
+	0x11, 0xe3, 0x3a, 0xe6, 0x58, 0x94, 0x81, 0x64, 0xab, 0x5e, 0x97, 0xb6, 0xca, 0x2d, 0x91, 0x8b,
+	0xa0, 0x79, 0x48, 0x39, 0x2b, 0xb1, 0x8c, 0xb7, 0x6e, 0x73, 0x51, 0xb4, 0x00, 0x5c, 0xa5, 0xbe,
+	0xb5, 0x55, 0x6d, 0x56, 0xeb, 0x35, 0xa9, 0x51, 0xae, 0x6c, 0x8a, 0x2d, 0x2e, 0x36, 0xbe, 0x2b,
+	0x88, 0xe5, 0x4a, 0xbd, 0xc6, 0xc5, 0x9d, 0x83, 0x5a, 0xb7, 0xa4, 0x75, 0x2c, 0xde, 0xe0, 0x12,
+	0xae, 0xd4, 0x5b, 0x52, 0xa3, 0xfe, 0x8a, 0x88, 0xb9, 0x39, 0xc4, 0x41, 0xe6, 0x6a, 0xa3, 0x29,
+	0xdd, 0xac, 0x6d, 0xd5, 0x2b, 0x9b, 0xe2, 0x1a, 0x97, 0x2c, 0xfe, 0x3b, 0x06, 0xe7, 0xca, 0x86,
+	0xd1, 0x53, 0xdb, 0xae, 0xfa, 0x5e, 0xe1, 0x42, 0x2f, 0x40, 0xd6, 0x22, 0x96, 0xe5, 0x98, 0x71,
+	0x87, 0x0c, 0x24, 0x95, 0xc6, 0xb9, 0x90, 0x3f, 0x11, 0xe2, 0x6f, 0x46, 0xf3, 0x77, 0xdd, 0x90,
+	0x6b, 0x7a, 0x88, 0x4d, 0x32, 0xa8, 0xae, 0xe1, 0x8c, 0x15, 0xac, 0x14, 0x74, 0x11, 0x12, 0x1d,
+	0xc9, 0xd0, 0x4d, 0xcf, 0x82, 0xf3, 0xc2, 0xfc, 0x89, 0x00, 0x4f, 0x27, 0xf3, 0x5f, 0xb0, 0x97,
+	0xd8, 0xe7, 0x3f, 0x61, 0x71, 0xbc, 0xd3, 0xd0, 0x4d, 0x1b, 0x3d, 0x04, 0xf1, 0x8e, 0xd4, 0xd6,
+	0x6c, 0x37, 0xda, 0xe7, 0x71, 0xac, 0x53, 0xd1, 0x6c, 0xb4, 0x02, 0xe9, 0x8e, 0xb9, 0x3b, 0xca,
+	0xaf, 0x98, 0x7b, 0x6e, 0x76, 0x78, 0x58, 0x80, 0x75, 0x7c, 0x9d, 0xe6, 0x18, 0x86, 0x8e, 0xb9,
+	0xeb, 0xe7, 0xdb, 0x4b, 0x90, 0x53, 0x48, 0x5b, 0x57, 0x88, 0x32, 0x62, 0x8a, 0xd3, 0xbc, 0x9b,
+	0x2c, 0x40, 0x4d, 0xf7, 0xb5, 0xc1, 0x59, 0x8a, 0xf7, 0x25, 0x4c, 0x54, 0xc1, 0xc4, 0x19, 0xab,
+	0x60, 0xb8, 0x24, 0xcf, 0x7d, 0xad, 0x92, 0x1c, 0xaa, 0xa5, 0xc9, 0x33, 0xd6, 0xd2, 0xc7, 0x20,
+	0xd5, 0xd6, 0xb5, 0x8e, 0x6a, 0xee, 0x12, 0xc5, 0xad, 0x7b, 0x49, 0x1c, 0x6c, 0xa0, 0x75, 0x40,
+	0x9a, 0x6e, 0xee, 0xca, 0x3d, 0xf5, 0xcd, 0x90, 0xd9, 0xc0, 0x55, 0xfc, 0x4b, 0xcd, 0x76, 0x2e,
+	0x60, 0xf1, 0x2d, 0xf7, 0x02, 0x3c, 0x3a, 0x2d, 0x47, 0xda, 0x97, 0x4d, 0xcd, 0xb5, 0x42, 0xda,
+	0x49, 0x3f, 0x7c, 0x61, 0x8a, 0xef, 0x15, 0x0a, 0x28, 0xfe, 0x2d, 0x02, 0x0f, 0x85, 0xa2, 0x6f,
+	0x4b, 0xf7, 0xfe, 0xa2, 0x3c, 0xcc, 0x59, 0xc4, 0x74, 0x0a, 0x98, 0x1b, 0x78, 0x29, 0xec, 0x2f,
+	0xd1, 0x3a, 0x24, 0x7b, 0x14, 0x45, 0xcb, 0x6b, 0x7e, 0xd2, 0xc8, 0xbe, 0x14, 0x81, 0x0b, 0x9b,
+	0xf8, 0xa3, 0xc3, 0x02, 0x8b, 0x47, 0xbc, 0xe8, 0x17, 0x2c, 0x80, 0x6c, 0xdb, 0xa6, 0xba, 0xdd,
+	0xb7, 0x89, 0x53, 0x6f, 0x1d, 0xd5, 0x9f, 0x9b, 0x14, 0x35, 0xe3, 0x6e, 0xa5, 0xf2, 0x88, 0x4b,
+	0xd4, 0x6c, 0x73, 0x20, 0x3c, 0x73, 0x22, 0x5c, 0xfe, 0x03, 0xfb, 0x64, 0xf1, 0xa2, 0x59, 0xcc,
+	0x5f, 0x5c, 0xe5, 0x7f, 0xfa, 0xaa, 0xbc, 0xfc, 0xe6, 0xb3, 0xcb, 0x3f, 0x7c, 0xed, 0xd2, 0x8b,
+	0x57, 0x5e, 0x5d, 0x7e, 0xed, 0x45, 0x7f, 0x79, 0xf9, 0x67, 0xab, 0xcf, 0xfc, 0xfc, 0x22, 0x0e,
+	0x1d, 0xba, 0xf8, 0x23, 0xc8, 0x4d, 0x08, 0x43, 0x1c, 0x44, 0x77, 0xc8, 0x80, 0x2a, 0xed, 0xfc,
+	0x44, 0x0b, 0x10, 0xdf, 0x93, 0x7b, 0x7d, 0xe2, 0x6a, 0x9b, 0xc2, 0xde, 0xe2, 0x4a, 0xe4, 0x79,
+	0xb6, 0xf8, 0xcf, 0x08, 0x3c, 0x1c, 0xba, 0xe0, 0x35, 0x5d, 0xd5, 0xca, 0xed, 0x36, 0x31, 0xec,
+	0xaf, 0x9d, 0xbe, 0x3f, 0x80, 0x94, 0x6c, 0x18, 0x92, 0xe5, 0x70, 0x53, 0x2b, 0x3f, 0x3a, 0x69,
+	0x9a, 0x4d, 0x32, 0x10, 0xb5, 0x3d, 0xd2, 0xd3, 0x0d, 0x82, 0xe7, 0x64, 0xc3, 0x68, 0x6e, 0x92,
+	0x01, 0xba, 0x05, 0x0f, 0xab, 0x9a, 0xdf, 0x22, 0x2a, 0x92, 0x42, 0xdf, 0x4e, 0xdf, 0xbe, 0x8f,
+	0xdf, 0xc7, 0xbe, 0xfe, 0x3b, 0x8b, 0x17, 0x42, 0x12, 0xfc, 0x4d, 0x0b, 0x3d, 0x05, 0x39, 0x83,
+	0x68, 0x8a, 0xaa, 0x75, 0x25, 0x7a, 0x55, 0xb7, 0x34, 0x24, 0x71, 0x96, 0x6e, 0x53, 0x75, 0xfe,
+	0x47, 0xf9, 0x53, 0x3c, 0x8c, 0x8f, 0x45, 0xa6, 0x7f, 0x91, 0xff, 0xb3, 0xca, 0x38, 0x56, 0x45,
+	0x12, 0x93, 0x55, 0xe4, 0x2a, 0xa4, 0xda, 0x3d, 0xd9, 0xb2, 0xa4, 0x6d, 0xa9, 0x4d, 0x2b, 0xde,
+	0x77, 0x4e, 0xe1, 0xe1, 0x52, 0xc5, 0x61, 0x12, 0x2a, 0x78, 0xae, 0xed, 0xfd, 0x40, 0x1b, 0x90,
+	0x34, 0x4c, 0x55, 0x37, 0x55, 0x7b, 0xe0, 0x3a, 0x2c, 0xbb, 0x5a, 0x9c, 0x51, 0x39, 0x69, 0x77,
+	0xd1, 0xa0, 0xc8, 0xd0, 0x6b, 0x3b, 0xe2, 0x9e, 0xd5, 0x03, 0xa4, 0xce, 0xd4, 0x03, 0xbc, 0x08,
+	0x40, 0x0e, 0x0c, 0xd5, 0x24, 0x96, 0x13, 0x45, 0xf0, 0x95, 0x51, 0x14, 0x73, 0x23, 0x28, 0x45,
+	0x79, 0xca, 0xf6, 0xe2, 0xef, 0x58, 0x98, 0xa3, 0x8a, 0x22, 0x11, 0x92, 0x5d, 0xd9, 0x26, 0xfb,
+	0xf2, 0xc0, 0xeb, 0x68, 0xd3, 0xab, 0x97, 0x27, 0xf5, 0xbb, 0xea, 0xd1, 0xcb, 0x9a, 0x4d, 0x34,
+	0x4d, 0x0e, 0xb5, 0x77, 0x78, 0xc4, 0x8a, 0x44, 0x98, 0x97, 0xb7, 0x2d, 0xbd, 0xd7, 0xb7, 0x89,
+	0xe4, 0x8c, 0x46, 0xa7, 0x08, 0x6e, 0xef, 0x5a, 0x19, 0x9f, 0xcd, 0x21, 0x78, 0x3d, 0x55, 0xf1,
+	0x36, 0x2c, 0xcc, 0xf0, 0x8d, 0x85, 0xca, 0x90, 0x0a, 0xd2, 0x96, 0x3d, 0x7d, 0xda, 0x06, 0x5c,
+	0xc5, 0xf7, 0x59, 0xb8, 0x30, 0x03, 0xb2, 0x2e, 0xab, 0x4e, 0x6f, 0x78, 0x03, 0x92, 0x3e, 0xd4,
+	0xcd, 0x9d, 0xd3, 0xc9, 0x9f, 0x55, 0xcc, 0x7d, 0x31, 0xe8, 0x25, 0x88, 0xbb, 0x73, 0x20, 0xad,
+	0x55, 0x8f, 0x4d, 0xb5, 0xcd, 0x0e, 0x71, 0x8d, 0xd8, 0xb2, 0xda, 0x9b, 0x7c, 0x78, 0x3d, 0xc6,
+	0xe2, 0x6f, 0x58, 0x28, 0x84, 0x4e, 0xad, 0xce, 0x2a, 0x41, 0x9b, 0x67, 0xb3, 0x4c, 0xa8, 0x5b,
+	0x08, 0xf8, 0xd1, 0x13, 0x90, 0xeb, 0xc9, 0x96, 0x2d, 0xb9, 0x69, 0xee, 0x16, 0x4a, 0xaf, 0x20,
+	0xe0, 0x8c, 0xb3, 0xbd, 0x5e, 0xd1, 0x6c, 0x87, 0xbf, 0x78, 0x3c, 0x07, 0xf3, 0x63, 0xed, 0xd9,
+	0x8c, 0x59, 0x81, 0x7d, 0x90, 0x59, 0x61, 0xca, 0x8a, 0xe3, 0xb3, 0xc2, 0x8c, 0xfc, 0x89, 0x9c,
+	0x29, 0x7f, 0xca, 0xe3, 0x65, 0x38, 0x73, 0xca, 0x48, 0x0d, 0xb7, 0x30, 0xd7, 0x20, 0xdb, 0x77,
+	0xdb, 0x51, 0x89, 0x7e, 0xc7, 0xa0, 0x53, 0xd1, 0xb7, 0xef, 0x63, 0x74, 0xaf, 0x7f, 0xdd, 0x60,
+	0xf0, 0x7c, 0x7f, 0x6c, 0x04, 0xdf, 0x80, 0xf4, 0xeb, 0xba, 0xaa, 0x49, 0xb2, 0xfb, 0x40, 0xd2,
+	0x39, 0xe8, 0x89, 0xfb, 0x08, 0x0a, 0x5e, 0xd3, 0x0d, 0x06, 0xc3, 0xeb, 0xc1, 0xdb, 0xba, 0x01,
+	0x19, 0xdf, 0x8b, 0x92, 0xdc, 0xde, 0xa1, 0x15, 0xf5, 0x34, 0x81, 0xb0, 0xc1, 0xe0, 0xb4, 0xcf,
+	0x5a, 0x6e, 0xef, 0xa0, 0x6b, 0x30, 0x3f, 0x92, 0xa4, 0x39, 0xa2, 0x12, 0x0f, 0x22, 0x6a, 0x74,
+	0x8b, 0x9a, 0x3c, 0x21, 0xcb, 0x22, 0x9a, 0x4d, 0xcb, 0xf1, 0x83, 0xca, 0x6a, 0x3a, 0x73, 0x54,
+	0x0b, 0x72, 0x23, 0x59, 0x1d, 0x37, 0x67, 0x69, 0xa1, 0xb9, 0x7c, 0x0a, 0x69, 0x5e, 0x92, 0x6f,
+	0x30, 0x38, 0xab, 0x8c, 0xa7, 0x7d, 0x2d, 0x24, 0xf5, 0x8d, 0x3e, 0xe9, 0xd3, 0xb6, 0xf4, 0xd4,
+	0x77, 0x1c, 0xc9, 0xbb, 0xe1, 0x32, 0x23, 0x1d, 0x16, 0xc7, 0xe5, 0x49, 0xa1, 0xbe, 0x81, 0x16,
+	0xec, 0x95, 0xfb, 0x88, 0x9e, 0x95, 0xe2, 0x1b, 0x0c, 0xce, 0x8f, 0x1d, 0x13, 0x02, 0x39, 0x0a,
+	0xf8, 0xdd, 0xa3, 0x64, 0xe9, 0xbd, 0x3d, 0xa2, 0xe4, 0xd3, 0x5f, 0xa9, 0x80, 0xdf, 0x35, 0x3a,
+	0x0a, 0xf8, 0xdc, 0x4d, 0x97, 0x59, 0x48, 0x41, 0xa4, 0x6f, 0x78, 0xe3, 0xec, 0x3f, 0xd8, 0xb1,
+	0x66, 0xe3, 0xa6, 0xb1, 0xae, 0xf6, 0x6c, 0x62, 0xa2, 0x3a, 0xcc, 0x7b, 0xcd, 0x82, 0x64, 0xca,
+	0x5a, 0x97, 0xf8, 0x55, 0x67, 0x6a, 0xac, 0x58, 0x77, 0x9a, 0x06, 0xec, 0x40, 0x84, 0xdc, 0xf0,
+	0xb0, 0x90, 0x0e, 0xd6, 0x16, 0x4e, 0x77, 0x82, 0x05, 0x22, 0xb0, 0x38, 0xd1, 0x11, 0x48, 0x6d,
+	0x5d, 0x53, 0x54, 0xe7, 0x54, 0x2f, 0xd3, 0xd3, 0xd3, 0xd3, 0xee, 0xda, 0x58, 0x4f, 0x50, 0xf1,
+	0xf1, 0x38, 0xaf, 0xcc, 0x26, 0x58, 0xc5, 0x35, 0x80, 0xe0, 0x0a, 0x68, 0x11, 0xa2, 0xbb, 0xaa,
+	0xe6, 0x96, 0xa9, 0xf0, 0xc7, 0x17, 0x67, 0xd3, 0xa5, 0xc9, 0x07, 0xb4, 0x17, 0x0a, 0xd3, 0xe4,
+	0x03, 0x67, 0x38, 0x78, 0xe4, 0x4b, 0xce, 0x46, 0x4b, 0x10, 0xef, 0xa8, 0xa4, 0xe7, 0x75, 0x5f,
+	0x29, 0x01, 0x4e, 0x84, 0x39, 0x33, 0xce, 0xb1, 0xf9, 0xbb, 0x11, 0xec, 0x11, 0xd0, 0xcb, 0x90,
+	0xd4, 0x0d, 0x62, 0xca, 0x36, 0x7d, 0x16, 0xb2, 0xab, 0xcf, 0x9e, 0x52, 0xb1, 0x52, 0x9d, 0xf2,
+	0x85, 0x3b, 0x0c, 0x5f, 0x16, 0xe2, 0xfd, 0x7e, 0x3c, 0xea, 0x9e, 0xec, 0x40, 0xcc, 0xa8, 0x7b,
+	0xae, 0xbb, 0x5d, 0xfc, 0x15, 0x0b, 0x49, 0x5f, 0x00, 0x4a, 0x41, 0x5c, 0xbc, 0x71, 0xb3, 0xbc,
+	0xc5, 0x31, 0xce, 0x40, 0x5f, 0xab, 0xb7, 0x24, 0x6f, 0xc9, 0xba, 0x93, 0x38, 0x16, 0xcb, 0x2d,
+	0x11, 0x4b, 0xad, 0x8d, 0x72, 0x8d, 0x8b, 0xa0, 0x0b, 0xf0, 0x70, 0x78, 0x47, 0xaa, 0x63, 0x0a,
+	0x8e, 0x3a, 0xbc, 0x5b, 0x62, 0xb3, 0xe9, 0x21, 0x63, 0xe8, 0x3c, 0xa0, 0xd1, 0x32, 0x80, 0xc5,
+	0x11, 0x40, 0x42, 0xbc, 0x55, 0x6d, 0xb6, 0x9a, 0x5c, 0xa2, 0xf8, 0xc7, 0x28, 0xe4, 0x69, 0xf1,
+	0xa3, 0xfa, 0xad, 0x3b, 0x53, 0x98, 0x6d, 0x13, 0xd3, 0x42, 0xd7, 0x21, 0xd3, 0x37, 0xa4, 0x8e,
+	0xbf, 0xe1, 0x1a, 0x31, 0xbb, 0xba, 0x34, 0x69, 0x9f, 0x49, 0xc6, 0x90, 0x3d, 0xd2, 0x7d, 0x63,
+	0xb4, 0x8d, 0xbe, 0x07, 0xe7, 0xc3, 0xe2, 0x24, 0x43, 0x36, 0xe5, 0x5d, 0xe2, 0x08, 0xf6, 0x66,
+	0x96, 0x85, 0x10, 0xb8, 0xe1, 0xd3, 0xd0, 0x0d, 0x70, 0x53, 0x3a, 0x74, 0x8d, 0xe8, 0x03, 0x5f,
+	0xc3, 0x2d, 0x7a, 0xc1, 0x45, 0x9e, 0x87, 0xfc, 0xb8, 0xc8, 0xd0, 0x55, 0x62, 0xee, 0x55, 0xce,
+	0x8f, 0x31, 0x04, 0x97, 0xd1, 0xe1, 0x1c, 0xcd, 0xb4, 0x11, 0xaf, 0x45, 0x3f, 0x87, 0x3e, 0x39,
+	0x33, 0xdb, 0xa6, 0x8c, 0x2a, 0x3c, 0xea, 0x3d, 0xf3, 0xdc, 0xd2, 0xf0, 0xb0, 0x90, 0x73, 0x31,
+	0x01, 0x11, 0xe7, 0x3a, 0xe3, 0x1b, 0xc5, 0xff, 0x44, 0xe0, 0xfc, 0x6c, 0x41, 0xa8, 0x01, 0x99,
+	0x70, 0xd6, 0xd3, 0xf7, 0xfd, 0x7e, 0x49, 0x8f, 0x9c, 0xd7, 0xdc, 0x1d, 0x04, 0x46, 0x7b, 0x18,
+	0x82, 0xbc, 0x9f, 0xf2, 0x77, 0xe4, 0x9b, 0xf2, 0x77, 0xf4, 0x81, 0xfc, 0x1d, 0xfb, 0x26, 0xfd,
+	0x1d, 0xbf, 0x9f, 0xbf, 0x8b, 0x7f, 0x61, 0x61, 0x61, 0x2d, 0x5c, 0xe9, 0xe9, 0x07, 0x50, 0xd4,
+	0xfa, 0x5a, 0xed, 0x55, 0xf2, 0x4b, 0xda, 0xaa, 0xb1, 0xa6, 0x3a, 0x72, 0x96, 0xa6, 0xfa, 0xe9,
+	0xbf, 0xb2, 0xc0, 0x4d, 0x5a, 0x06, 0x21, 0xc8, 0xae, 0xd7, 0xf1, 0xf5, 0x72, 0xcb, 0xa9, 0x1a,
+	0xb5, 0x7a, 0x4d, 0xe4, 0x18, 0x94, 0x87, 0x85, 0x60, 0x0f, 0x8b, 0x8d, 0x7a, 0xb3, 0xda, 0xaa,
+	0xe3, 0xdb, 0x1c, 0x8b, 0x16, 0xe1, 0x7c, 0x40, 0xb9, 0x8a, 0x1b, 0x15, 0xa9, 0x29, 0xe2, 0x97,
+	0xab, 0x15, 0x91, 0x8b, 0x8c, 0x73, 0x5d, 0x2b, 0xbf, 0x5c, 0x6e, 0x56, 0x70, 0xb5, 0xd1, 0xe2,
+	0xa2, 0xe3, 0x94, 0x4a, 0xf9, 0xb6, 0x58, 0xab, 0x89, 0x5b, 0x8d, 0x06, 0x17, 0x1b, 0x3f, 0xbd,
+	0x22, 0xd4, 0x31, 0x17, 0x47, 0x0f, 0x41, 0x2e, 0xd8, 0x6b, 0x8a, 0xb5, 0xeb, 0x5b, 0x5c, 0x42,
+	0xf8, 0x33, 0x7b, 0xef, 0x88, 0x67, 0x3f, 0x3a, 0xe2, 0xd9, 0x8f, 0x8f, 0x78, 0xe6, 0x93, 0x23,
+	0x9e, 0xf9, 0xf4, 0x88, 0x67, 0x3e, 0x3b, 0xe2, 0x99, 0xcf, 0x8f, 0x78, 0xf6, 0xee, 0x90, 0x67,
+	0xdf, 0x1a, 0xf2, 0xcc, 0x7b, 0x43, 0x9e, 0x7d, 0x7f, 0xc8, 0x33, 0x1f, 0x0c, 0x79, 0xe6, 0xc3,
+	0x21, 0xcf, 0xdc, 0x1b, 0xf2, 0xec, 0x47, 0x43, 0x9e, 0xfd, 0x78, 0xc8, 0x33, 0x9f, 0x0c, 0x79,
+	0xf6, 0xd3, 0x21, 0xcf, 0x7c, 0x36, 0xe4, 0xd9, 0xcf, 0x87, 0x3c, 0x73, 0xf7, 0x98, 0x67, 0xde,
+	0x3a, 0xe6, 0xd9, 0xb7, 0x8f, 0x79, 0xe6, 0xf7, 0xc7, 0x3c, 0xfb, 0xee, 0x31, 0xcf, 0xbc, 0x77,
+	0xcc, 0x33, 0xef, 0x1f, 0xf3, 0xec, 0x07, 0xc7, 0x3c, 0xfb, 0xe1, 0x31, 0xcf, 0xfe, 0xf8, 0x99,
+	0xae, 0x5e, 0xb2, 0xef, 0x10, 0xfb, 0x8e, 0xaa, 0x75, 0xad, 0x92, 0x46, 0xec, 0x7d, 0xdd, 0xdc,
+	0x59, 0x19, 0xff, 0x07, 0x8e, 0xb1, 0xd3, 0x5d, 0xb1, 0x6d, 0xcd, 0xd8, 0xde, 0x4e, 0xb8, 0x4d,
+	0xe9, 0x73, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xd2, 0x57, 0xcf, 0x48, 0x1b, 0x00, 0x00,
 }
 
 func (x PayloadFormatter) String() string {
@@ -1447,6 +1838,13 @@ func (x TxAcknowledgment_Result) String() string {
 	}
 	return strconv.Itoa(int(x))
 }
+func (x DecodedPayloadCondition_Operator) String() string {
+	s, ok := DecodedPayloadCondition_Operator_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (this *UplinkMessage) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1674,6 +2072,25 @@ func (this *ApplicationUplink) Equal(that interface{}) bool {
 	if !this.ReceivedAt.Equal(that1.ReceivedAt) {
 		return false
 	}
+	if this.Confirmed != that1.Confirmed {
+		return false
+	}
+	if len(this.NormalizedPayload) != len(that1.NormalizedPayload) {
+		return false
+	}
+	for i := range this.NormalizedPayload {
+		if !this.NormalizedPayload[i].Equal(that1.NormalizedPayload[i]) {
+			return false
+		}
+	}
+	if len(this.NormalizedPayloadWarnings) != len(that1.NormalizedPayloadWarnings) {
+		return false
+	}
+	for i := range this.NormalizedPayloadWarnings {
+		if this.NormalizedPayloadWarnings[i] != that1.NormalizedPayloadWarnings[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *ApplicationLocation) Equal(that interface{}) bool {
@@ -1803,6 +2220,13 @@ func (this *ApplicationDownlink) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.ExpiresAt == nil {
+		if this.ExpiresAt != nil {
+			return false
+		}
+	} else if !this.ExpiresAt.Equal(*that1.ExpiresAt) {
+		return false
+	}
 	return true
 }
 func (this *ApplicationDownlink_ClassBC) Equal(that interface{}) bool {
@@ -2193,14 +2617,14 @@ func (this *ApplicationUp_LocationSolved) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *MessagePayloadFormatters) Equal(that interface{}) bool {
+func (this *ApplicationUpFilter) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*MessagePayloadFormatters)
+	that1, ok := that.(*ApplicationUpFilter)
 	if !ok {
-		that2, ok := that.(MessagePayloadFormatters)
+		that2, ok := that.(ApplicationUpFilter)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2212,28 +2636,32 @@ func (this *MessagePayloadFormatters) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.UpFormatter != that1.UpFormatter {
+	if len(this.FPortRanges) != len(that1.FPortRanges) {
 		return false
 	}
-	if this.UpFormatterParameter != that1.UpFormatterParameter {
-		return false
+	for i := range this.FPortRanges {
+		if !this.FPortRanges[i].Equal(that1.FPortRanges[i]) {
+			return false
+		}
 	}
-	if this.DownFormatter != that1.DownFormatter {
+	if len(this.DecodedPayloadConditions) != len(that1.DecodedPayloadConditions) {
 		return false
 	}
-	if this.DownFormatterParameter != that1.DownFormatterParameter {
-		return false
+	for i := range this.DecodedPayloadConditions {
+		if !this.DecodedPayloadConditions[i].Equal(that1.DecodedPayloadConditions[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *DownlinkQueueRequest) Equal(that interface{}) bool {
+func (this *FPortRange) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DownlinkQueueRequest)
+	that1, ok := that.(*FPortRange)
 	if !ok {
-		that2, ok := that.(DownlinkQueueRequest)
+		that2, ok := that.(FPortRange)
 		if ok {
 			that1 = &that2
 		} else {
@@ -2245,28 +2673,162 @@ func (this *DownlinkQueueRequest) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+	if this.Min != that1.Min {
 		return false
 	}
-	if len(this.Downlinks) != len(that1.Downlinks) {
+	if this.Max != that1.Max {
 		return false
 	}
-	for i := range this.Downlinks {
-		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
-			return false
-		}
-	}
 	return true
 }
-func (m *UplinkMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *DecodedPayloadCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
+
+	that1, ok := that.(*DecodedPayloadCondition)
+	if !ok {
+		that2, ok := that.(DecodedPayloadCondition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *MessagePayloadFormatters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MessagePayloadFormatters)
+	if !ok {
+		that2, ok := that.(MessagePayloadFormatters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.UpFormatter != that1.UpFormatter {
+		return false
+	}
+	if this.UpFormatterParameter != that1.UpFormatterParameter {
+		return false
+	}
+	if this.DownFormatter != that1.DownFormatter {
+		return false
+	}
+	if this.DownFormatterParameter != that1.DownFormatterParameter {
+		return false
+	}
+	if len(this.FPortFormatters) != len(that1.FPortFormatters) {
+		return false
+	}
+	for i := range this.FPortFormatters {
+		if !this.FPortFormatters[i].Equal(that1.FPortFormatters[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *FPortPayloadFormatters) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FPortPayloadFormatters)
+	if !ok {
+		that2, ok := that.(FPortPayloadFormatters)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.FPortRange.Equal(&that1.FPortRange) {
+		return false
+	}
+	if this.UpFormatter != that1.UpFormatter {
+		return false
+	}
+	if this.UpFormatterParameter != that1.UpFormatterParameter {
+		return false
+	}
+	if this.DownFormatter != that1.DownFormatter {
+		return false
+	}
+	if this.DownFormatterParameter != that1.DownFormatterParameter {
+		return false
+	}
+	return true
+}
+func (this *DownlinkQueueRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DownlinkQueueRequest)
+	if !ok {
+		that2, ok := that.(DownlinkQueueRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIdentifiers.Equal(&that1.EndDeviceIdentifiers) {
+		return false
+	}
+	if len(this.Downlinks) != len(that1.Downlinks) {
+		return false
+	}
+	for i := range this.Downlinks {
+		if !this.Downlinks[i].Equal(that1.Downlinks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *UplinkMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
 
 func (m *UplinkMessage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
@@ -2517,6 +3079,39 @@ func (m *ApplicationUplink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for iNdEx := len(m.NormalizedPayloadWarnings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NormalizedPayloadWarnings[iNdEx])
+			copy(dAtA[i:], m.NormalizedPayloadWarnings[iNdEx])
+			i = encodeVarintMessages(dAtA, i, uint64(len(m.NormalizedPayloadWarnings[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.NormalizedPayload) > 0 {
+		for iNdEx := len(m.NormalizedPayload) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NormalizedPayload[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Confirmed {
+		i--
+		if m.Confirmed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt):])
 	if err8 != nil {
 		return 0, err8
@@ -2741,6 +3336,16 @@ func (m *ApplicationDownlink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintMessages(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.CorrelationIDs) > 0 {
 		for iNdEx := len(m.CorrelationIDs) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.CorrelationIDs[iNdEx])
@@ -2837,12 +3442,12 @@ func (m *ApplicationDownlink_ClassBC) MarshalToSizedBuffer(dAtA []byte) (int, er
 	var l int
 	_ = l
 	if m.AbsoluteTime != nil {
-		n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
-		if err16 != nil {
-			return 0, err16
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.AbsoluteTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.AbsoluteTime):])
+		if err17 != nil {
+			return 0, err17
 		}
-		i -= n16
-		i = encodeVarintMessages(dAtA, i, uint64(n16))
+		i -= n17
+		i = encodeVarintMessages(dAtA, i, uint64(n17))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -3006,12 +3611,12 @@ func (m *ApplicationUp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.ReceivedAt != nil {
-		n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
-		if err19 != nil {
-			return 0, err19
+		n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.ReceivedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.ReceivedAt):])
+		if err20 != nil {
+			return 0, err20
 		}
-		i -= n19
-		i = encodeVarintMessages(dAtA, i, uint64(n19))
+		i -= n20
+		i = encodeVarintMessages(dAtA, i, uint64(n20))
 		i--
 		dAtA[i] = 0x62
 	}
@@ -3235,7 +3840,7 @@ func (m *ApplicationUp_LocationSolved) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
-func (m *MessagePayloadFormatters) Marshal() (dAtA []byte, err error) {
+func (m *ApplicationUpFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3245,44 +3850,123 @@ func (m *MessagePayloadFormatters) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessagePayloadFormatters) MarshalTo(dAtA []byte) (int, error) {
+func (m *ApplicationUpFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessagePayloadFormatters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ApplicationUpFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DownFormatterParameter) > 0 {
-		i -= len(m.DownFormatterParameter)
-		copy(dAtA[i:], m.DownFormatterParameter)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.DownFormatterParameter)))
+	if len(m.DecodedPayloadConditions) > 0 {
+		for iNdEx := len(m.DecodedPayloadConditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DecodedPayloadConditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FPortRanges) > 0 {
+		for iNdEx := len(m.FPortRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FPortRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FPortRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FPortRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FPortRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Max != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Max))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x10
 	}
-	if m.DownFormatter != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.DownFormatter))
+	if m.Min != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Min))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x8
 	}
-	if len(m.UpFormatterParameter) > 0 {
-		i -= len(m.UpFormatterParameter)
-		copy(dAtA[i:], m.UpFormatterParameter)
-		i = encodeVarintMessages(dAtA, i, uint64(len(m.UpFormatterParameter)))
+	return len(dAtA) - i, nil
+}
+
+func (m *DecodedPayloadCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DecodedPayloadCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DecodedPayloadCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Value)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.UpFormatter != 0 {
-		i = encodeVarintMessages(dAtA, i, uint64(m.UpFormatter))
+	if m.Operator != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.Operator))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DownlinkQueueRequest) Marshal() (dAtA []byte, err error) {
+func (m *MessagePayloadFormatters) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3292,20 +3976,138 @@ func (m *DownlinkQueueRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DownlinkQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessagePayloadFormatters) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DownlinkQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MessagePayloadFormatters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Downlinks) > 0 {
-		for iNdEx := len(m.Downlinks) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.FPortFormatters) > 0 {
+		for iNdEx := len(m.FPortFormatters) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Downlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.FPortFormatters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessages(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DownFormatterParameter) > 0 {
+		i -= len(m.DownFormatterParameter)
+		copy(dAtA[i:], m.DownFormatterParameter)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DownFormatterParameter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DownFormatter != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DownFormatter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.UpFormatterParameter) > 0 {
+		i -= len(m.UpFormatterParameter)
+		copy(dAtA[i:], m.UpFormatterParameter)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.UpFormatterParameter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpFormatter != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.UpFormatter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FPortPayloadFormatters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FPortPayloadFormatters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FPortPayloadFormatters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DownFormatterParameter) > 0 {
+		i -= len(m.DownFormatterParameter)
+		copy(dAtA[i:], m.DownFormatterParameter)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.DownFormatterParameter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DownFormatter != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.DownFormatter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UpFormatterParameter) > 0 {
+		i -= len(m.UpFormatterParameter)
+		copy(dAtA[i:], m.UpFormatterParameter)
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.UpFormatterParameter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.UpFormatter != 0 {
+		i = encodeVarintMessages(dAtA, i, uint64(m.UpFormatter))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FPortRange.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMessages(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DownlinkQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DownlinkQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DownlinkQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Downlinks) > 0 {
+		for iNdEx := len(m.Downlinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Downlinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3381,6 +4183,19 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 	this.Settings = *v5
 	v6 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
 	this.ReceivedAt = *v6
+	this.Confirmed = bool(r.Intn(2) == 0)
+	if r.Intn(5) != 0 {
+		v7 := r.Intn(5)
+		this.NormalizedPayload = make([]*types.Struct, v7)
+		for i := 0; i < v7; i++ {
+			this.NormalizedPayload[i] = types.NewPopulatedStruct(r, easy)
+		}
+	}
+	v8 := r.Intn(10)
+	this.NormalizedPayloadWarnings = make([]string, v8)
+	for i := 0; i < v8; i++ {
+		this.NormalizedPayloadWarnings[i] = randStringMessages(r)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3389,12 +4204,12 @@ func NewPopulatedApplicationUplink(r randyMessages, easy bool) *ApplicationUplin
 func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLocation {
 	this := &ApplicationLocation{}
 	this.Service = randStringMessages(r)
-	v7 := NewPopulatedLocation(r, easy)
-	this.Location = *v7
+	v9 := NewPopulatedLocation(r, easy)
+	this.Location = *v9
 	if r.Intn(5) != 0 {
-		v8 := r.Intn(10)
+		v10 := r.Intn(10)
 		this.Attributes = make(map[string]string)
-		for i := 0; i < v8; i++ {
+		for i := 0; i < v10; i++ {
 			this.Attributes[randStringMessages(r)] = randStringMessages(r)
 		}
 	}
@@ -3405,24 +4220,24 @@ func NewPopulatedApplicationLocation(r randyMessages, easy bool) *ApplicationLoc
 
 func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJoinAccept {
 	this := &ApplicationJoinAccept{}
-	v9 := r.Intn(100)
-	this.SessionKeyID = make([]byte, v9)
-	for i := 0; i < v9; i++ {
+	v11 := r.Intn(100)
+	this.SessionKeyID = make([]byte, v11)
+	for i := 0; i < v11; i++ {
 		this.SessionKeyID[i] = byte(r.Intn(256))
 	}
 	if r.Intn(5) != 0 {
 		this.AppSKey = NewPopulatedKeyEnvelope(r, easy)
 	}
 	if r.Intn(5) != 0 {
-		v10 := r.Intn(5)
-		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v10)
-		for i := 0; i < v10; i++ {
+		v12 := r.Intn(5)
+		this.InvalidatedDownlinks = make([]*ApplicationDownlink, v12)
+		for i := 0; i < v12; i++ {
 			this.InvalidatedDownlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
 	this.PendingSession = bool(r.Intn(2) == 0)
-	v11 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
-	this.ReceivedAt = *v11
+	v13 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.ReceivedAt = *v13
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3431,9 +4246,9 @@ func NewPopulatedApplicationJoinAccept(r randyMessages, easy bool) *ApplicationJ
 func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *ApplicationDownlink_ClassBC {
 	this := &ApplicationDownlink_ClassBC{}
 	if r.Intn(5) != 0 {
-		v12 := r.Intn(5)
-		this.Gateways = make([]*GatewayAntennaIdentifiers, v12)
-		for i := 0; i < v12; i++ {
+		v14 := r.Intn(5)
+		this.Gateways = make([]*GatewayAntennaIdentifiers, v14)
+		for i := 0; i < v14; i++ {
 			this.Gateways[i] = NewPopulatedGatewayAntennaIdentifiers(r, easy)
 		}
 	}
@@ -3448,9 +4263,9 @@ func NewPopulatedApplicationDownlink_ClassBC(r randyMessages, easy bool) *Applic
 func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDownlinks {
 	this := &ApplicationDownlinks{}
 	if r.Intn(5) != 0 {
-		v13 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v13)
-		for i := 0; i < v13; i++ {
+		v15 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v15)
+		for i := 0; i < v15; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3461,10 +4276,10 @@ func NewPopulatedApplicationDownlinks(r randyMessages, easy bool) *ApplicationDo
 
 func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *ApplicationDownlinkFailed {
 	this := &ApplicationDownlinkFailed{}
-	v14 := NewPopulatedApplicationDownlink(r, easy)
-	this.ApplicationDownlink = *v14
-	v15 := NewPopulatedErrorDetails(r, easy)
-	this.Error = *v15
+	v16 := NewPopulatedApplicationDownlink(r, easy)
+	this.ApplicationDownlink = *v16
+	v17 := NewPopulatedErrorDetails(r, easy)
+	this.Error = *v17
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -3473,9 +4288,9 @@ func NewPopulatedApplicationDownlinkFailed(r randyMessages, easy bool) *Applicat
 func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *ApplicationInvalidatedDownlinks {
 	this := &ApplicationInvalidatedDownlinks{}
 	if r.Intn(5) != 0 {
-		v16 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v16)
-		for i := 0; i < v16; i++ {
+		v18 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v18)
+		for i := 0; i < v18; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3487,11 +4302,11 @@ func NewPopulatedApplicationInvalidatedDownlinks(r randyMessages, easy bool) *Ap
 
 func NewPopulatedApplicationUp(r randyMessages, easy bool) *ApplicationUp {
 	this := &ApplicationUp{}
-	v17 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v17
-	v18 := r.Intn(10)
-	this.CorrelationIDs = make([]string, v18)
-	for i := 0; i < v18; i++ {
+	v19 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v19
+	v20 := r.Intn(10)
+	this.CorrelationIDs = make([]string, v20)
+	for i := 0; i < v20; i++ {
 		this.CorrelationIDs[i] = randStringMessages(r)
 	}
 	oneofNumber_Up := []int32{3, 4, 5, 6, 7, 8, 9, 10, 11}[r.Intn(9)]
@@ -3568,11 +4383,71 @@ func NewPopulatedApplicationUp_LocationSolved(r randyMessages, easy bool) *Appli
 	this.LocationSolved = NewPopulatedApplicationLocation(r, easy)
 	return this
 }
+func NewPopulatedApplicationUpFilter(r randyMessages, easy bool) *ApplicationUpFilter {
+	this := &ApplicationUpFilter{}
+	if r.Intn(5) != 0 {
+		v21 := r.Intn(5)
+		this.FPortRanges = make([]*FPortRange, v21)
+		for i := 0; i < v21; i++ {
+			this.FPortRanges[i] = NewPopulatedFPortRange(r, easy)
+		}
+	}
+	if r.Intn(5) != 0 {
+		v22 := r.Intn(5)
+		this.DecodedPayloadConditions = make([]*DecodedPayloadCondition, v22)
+		for i := 0; i < v22; i++ {
+			this.DecodedPayloadConditions[i] = NewPopulatedDecodedPayloadCondition(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFPortRange(r randyMessages, easy bool) *FPortRange {
+	this := &FPortRange{}
+	this.Min = r.Uint32()
+	this.Max = r.Uint32()
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDecodedPayloadCondition(r randyMessages, easy bool) *DecodedPayloadCondition {
+	this := &DecodedPayloadCondition{}
+	this.Field = randStringMessages(r)
+	this.Operator = DecodedPayloadCondition_Operator([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.Value = randStringMessages(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePayloadFormatters {
 	this := &MessagePayloadFormatters{}
-	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.UpFormatterParameter = randStringMessages(r)
+	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
+	this.DownFormatterParameter = randStringMessages(r)
+	if r.Intn(5) != 0 {
+		v23 := r.Intn(5)
+		this.FPortFormatters = make([]*FPortPayloadFormatters, v23)
+		for i := 0; i < v23; i++ {
+			this.FPortFormatters[i] = NewPopulatedFPortPayloadFormatters(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedFPortPayloadFormatters(r randyMessages, easy bool) *FPortPayloadFormatters {
+	this := &FPortPayloadFormatters{}
+	v24 := NewPopulatedFPortRange(r, easy)
+	this.FPortRange = *v24
+	this.UpFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
 	this.UpFormatterParameter = randStringMessages(r)
-	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4}[r.Intn(5)])
+	this.DownFormatter = PayloadFormatter([]int32{0, 1, 2, 3, 4, 5, 6}[r.Intn(7)])
 	this.DownFormatterParameter = randStringMessages(r)
 	if !easy && r.Intn(10) != 0 {
 	}
@@ -3581,12 +4456,12 @@ func NewPopulatedMessagePayloadFormatters(r randyMessages, easy bool) *MessagePa
 
 func NewPopulatedDownlinkQueueRequest(r randyMessages, easy bool) *DownlinkQueueRequest {
 	this := &DownlinkQueueRequest{}
-	v19 := NewPopulatedEndDeviceIdentifiers(r, easy)
-	this.EndDeviceIdentifiers = *v19
+	v25 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIdentifiers = *v25
 	if r.Intn(5) != 0 {
-		v20 := r.Intn(5)
-		this.Downlinks = make([]*ApplicationDownlink, v20)
-		for i := 0; i < v20; i++ {
+		v26 := r.Intn(5)
+		this.Downlinks = make([]*ApplicationDownlink, v26)
+		for i := 0; i < v26; i++ {
 			this.Downlinks[i] = NewPopulatedApplicationDownlink(r, easy)
 		}
 	}
@@ -3614,9 +4489,9 @@ func randUTF8RuneMessages(r randyMessages) rune {
 	return rune(ru + 61)
 }
 func randStringMessages(r randyMessages) string {
-	v21 := r.Intn(100)
-	tmps := make([]rune, v21)
-	for i := 0; i < v21; i++ {
+	v27 := r.Intn(100)
+	tmps := make([]rune, v27)
+	for i := 0; i < v27; i++ {
 		tmps[i] = randUTF8RuneMessages(r)
 	}
 	return string(tmps)
@@ -3638,11 +4513,11 @@ func randFieldMessages(dAtA []byte, r randyMessages, fieldNumber int, wire int)
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
-		v22 := r.Int63()
+		v28 := r.Int63()
 		if r.Intn(2) == 0 {
-			v22 *= -1
+			v28 *= -1
 		}
-		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v22))
+		dAtA = encodeVarintPopulateMessages(dAtA, uint64(v28))
 	case 1:
 		dAtA = encodeVarintPopulateMessages(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -3809,6 +4684,21 @@ func (m *ApplicationUplink) Size() (n int) {
 	n += 1 + l + sovMessages(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ReceivedAt)
 	n += 1 + l + sovMessages(uint64(l))
+	if m.Confirmed {
+		n += 2
+	}
+	if len(m.NormalizedPayload) > 0 {
+		for _, e := range m.NormalizedPayload {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.NormalizedPayloadWarnings) > 0 {
+		for _, s := range m.NormalizedPayloadWarnings {
+			l = len(s)
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3903,6 +4793,10 @@ func (m *ApplicationDownlink) Size() (n int) {
 			n += 1 + l + sovMessages(uint64(l))
 		}
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4103,6 +4997,62 @@ func (m *ApplicationUp_LocationSolved) Size() (n int) {
 	}
 	return n
 }
+func (m *ApplicationUpFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FPortRanges) > 0 {
+		for _, e := range m.FPortRanges {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	if len(m.DecodedPayloadConditions) > 0 {
+		for _, e := range m.DecodedPayloadConditions {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FPortRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Min != 0 {
+		n += 1 + sovMessages(uint64(m.Min))
+	}
+	if m.Max != 0 {
+		n += 1 + sovMessages(uint64(m.Max))
+	}
+	return n
+}
+
+func (m *DecodedPayloadCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovMessages(uint64(m.Operator))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	return n
+}
+
 func (m *MessagePayloadFormatters) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4123,6 +5073,37 @@ func (m *MessagePayloadFormatters) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	if len(m.FPortFormatters) > 0 {
+		for _, e := range m.FPortFormatters {
+			l = e.Size()
+			n += 1 + l + sovMessages(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FPortPayloadFormatters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FPortRange.Size()
+	n += 1 + l + sovMessages(uint64(l))
+	if m.UpFormatter != 0 {
+		n += 1 + sovMessages(uint64(m.UpFormatter))
+	}
+	l = len(m.UpFormatterParameter)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.DownFormatter != 0 {
+		n += 1 + sovMessages(uint64(m.DownFormatter))
+	}
+	l = len(m.DownFormatterParameter)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	return n
 }
 
@@ -4224,6 +5205,11 @@ func (this *ApplicationUplink) String() string {
 		repeatedStringForRxMetadata += strings.Replace(fmt.Sprintf("%v", f), "RxMetadata", "RxMetadata", 1) + ","
 	}
 	repeatedStringForRxMetadata += "}"
+	repeatedStringForNormalizedPayload := "[]*Struct{"
+	for _, f := range this.NormalizedPayload {
+		repeatedStringForNormalizedPayload += strings.Replace(fmt.Sprintf("%v", f), "Struct", "types.Struct", 1) + ","
+	}
+	repeatedStringForNormalizedPayload += "}"
 	s := strings.Join([]string{`&ApplicationUplink{`,
 		`SessionKeyID:` + fmt.Sprintf("%v", this.SessionKeyID) + `,`,
 		`FPort:` + fmt.Sprintf("%v", this.FPort) + `,`,
@@ -4233,6 +5219,9 @@ func (this *ApplicationUplink) String() string {
 		`RxMetadata:` + repeatedStringForRxMetadata + `,`,
 		`Settings:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Settings), "TxSettings", "TxSettings", 1), `&`, ``, 1) + `,`,
 		`ReceivedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReceivedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Confirmed:` + fmt.Sprintf("%v", this.Confirmed) + `,`,
+		`NormalizedPayload:` + repeatedStringForNormalizedPayload + `,`,
+		`NormalizedPayloadWarnings:` + fmt.Sprintf("%v", this.NormalizedPayloadWarnings) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4292,6 +5281,7 @@ func (this *ApplicationDownlink) String() string {
 		`ClassBC:` + strings.Replace(fmt.Sprintf("%v", this.ClassBC), "ApplicationDownlink_ClassBC", "ApplicationDownlink_ClassBC", 1) + `,`,
 		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
 		`CorrelationIDs:` + fmt.Sprintf("%v", this.CorrelationIDs) + `,`,
+		`ExpiresAt:` + strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Timestamp", "types.Timestamp", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4457,28 +5447,92 @@ func (this *ApplicationUp_LocationSolved) String() string {
 	}, "")
 	return s
 }
-func (this *MessagePayloadFormatters) String() string {
+func (this *ApplicationUpFilter) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&MessagePayloadFormatters{`,
-		`UpFormatter:` + fmt.Sprintf("%v", this.UpFormatter) + `,`,
-		`UpFormatterParameter:` + fmt.Sprintf("%v", this.UpFormatterParameter) + `,`,
-		`DownFormatter:` + fmt.Sprintf("%v", this.DownFormatter) + `,`,
-		`DownFormatterParameter:` + fmt.Sprintf("%v", this.DownFormatterParameter) + `,`,
+	repeatedStringForFPortRanges := "[]*FPortRange{"
+	for _, f := range this.FPortRanges {
+		repeatedStringForFPortRanges += strings.Replace(f.String(), "FPortRange", "FPortRange", 1) + ","
+	}
+	repeatedStringForFPortRanges += "}"
+	repeatedStringForDecodedPayloadConditions := "[]*DecodedPayloadCondition{"
+	for _, f := range this.DecodedPayloadConditions {
+		repeatedStringForDecodedPayloadConditions += strings.Replace(f.String(), "DecodedPayloadCondition", "DecodedPayloadCondition", 1) + ","
+	}
+	repeatedStringForDecodedPayloadConditions += "}"
+	s := strings.Join([]string{`&ApplicationUpFilter{`,
+		`FPortRanges:` + repeatedStringForFPortRanges + `,`,
+		`DecodedPayloadConditions:` + repeatedStringForDecodedPayloadConditions + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *DownlinkQueueRequest) String() string {
+func (this *FPortRange) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForDownlinks := "[]*ApplicationDownlink{"
-	for _, f := range this.Downlinks {
-		repeatedStringForDownlinks += strings.Replace(f.String(), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
-	}
-	repeatedStringForDownlinks += "}"
+	s := strings.Join([]string{`&FPortRange{`,
+		`Min:` + fmt.Sprintf("%v", this.Min) + `,`,
+		`Max:` + fmt.Sprintf("%v", this.Max) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DecodedPayloadCondition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DecodedPayloadCondition{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessagePayloadFormatters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFPortFormatters := "[]*FPortPayloadFormatters{"
+	for _, f := range this.FPortFormatters {
+		repeatedStringForFPortFormatters += strings.Replace(f.String(), "FPortPayloadFormatters", "FPortPayloadFormatters", 1) + ","
+	}
+	repeatedStringForFPortFormatters += "}"
+	s := strings.Join([]string{`&MessagePayloadFormatters{`,
+		`UpFormatter:` + fmt.Sprintf("%v", this.UpFormatter) + `,`,
+		`UpFormatterParameter:` + fmt.Sprintf("%v", this.UpFormatterParameter) + `,`,
+		`DownFormatter:` + fmt.Sprintf("%v", this.DownFormatter) + `,`,
+		`DownFormatterParameter:` + fmt.Sprintf("%v", this.DownFormatterParameter) + `,`,
+		`FPortFormatters:` + repeatedStringForFPortFormatters + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FPortPayloadFormatters) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FPortPayloadFormatters{`,
+		`FPortRange:` + strings.Replace(strings.Replace(this.FPortRange.String(), "FPortRange", "FPortRange", 1), `&`, ``, 1) + `,`,
+		`UpFormatter:` + fmt.Sprintf("%v", this.UpFormatter) + `,`,
+		`UpFormatterParameter:` + fmt.Sprintf("%v", this.UpFormatterParameter) + `,`,
+		`DownFormatter:` + fmt.Sprintf("%v", this.DownFormatter) + `,`,
+		`DownFormatterParameter:` + fmt.Sprintf("%v", this.DownFormatterParameter) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DownlinkQueueRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDownlinks := "[]*ApplicationDownlink{"
+	for _, f := range this.Downlinks {
+		repeatedStringForDownlinks += strings.Replace(f.String(), "ApplicationDownlink", "ApplicationDownlink", 1) + ","
+	}
+	repeatedStringForDownlinks += "}"
 	s := strings.Join([]string{`&DownlinkQueueRequest{`,
 		`EndDeviceIdentifiers:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIdentifiers), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
 		`Downlinks:` + repeatedStringForDownlinks + `,`,
@@ -5404,6 +6458,92 @@ func (m *ApplicationUplink) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Confirmed = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedPayload = append(m.NormalizedPayload, &types.Struct{})
+			if err := m.NormalizedPayload[len(m.NormalizedPayload)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedPayloadWarnings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedPayloadWarnings = append(m.NormalizedPayloadWarnings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -6161,6 +7301,42 @@ func (m *ApplicationDownlink) Unmarshal(dAtA []byte) error {
 			}
 			m.CorrelationIDs = append(m.CorrelationIDs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
@@ -7089,7 +8265,7 @@ func (m *ApplicationUp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
+func (m *ApplicationUpFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7112,17 +8288,17 @@ func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessagePayloadFormatters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApplicationUpFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessagePayloadFormatters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApplicationUpFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatter", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPortRanges", wireType)
 			}
-			m.UpFormatter = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -7132,16 +8308,31 @@ func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpFormatter |= PayloadFormatter(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FPortRanges = append(m.FPortRanges, &FPortRange{})
+			if err := m.FPortRanges[len(m.FPortRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatterParameter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecodedPayloadConditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -7151,29 +8342,84 @@ func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMessages
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessages
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpFormatterParameter = string(dAtA[iNdEx:postIndex])
+			m.DecodedPayloadConditions = append(m.DecodedPayloadConditions, &DecodedPayloadCondition{})
+			if err := m.DecodedPayloadConditions[len(m.DecodedPayloadConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FPortRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FPortRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FPortRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownFormatter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			m.DownFormatter = 0
+			m.Min = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -7183,12 +8429,512 @@ func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownFormatter |= PayloadFormatter(b&0x7F) << shift
+				m.Min |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			m.Max = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Max |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedPayloadCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedPayloadCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedPayloadCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= DecodedPayloadCondition_Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessagePayloadFormatters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessagePayloadFormatters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessagePayloadFormatters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatter", wireType)
+			}
+			m.UpFormatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpFormatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatterParameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpFormatterParameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownFormatter", wireType)
+			}
+			m.DownFormatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownFormatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownFormatterParameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DownFormatterParameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPortFormatters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FPortFormatters = append(m.FPortFormatters, &FPortPayloadFormatters{})
+			if err := m.FPortFormatters[len(m.FPortFormatters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FPortPayloadFormatters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FPortPayloadFormatters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FPortPayloadFormatters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FPortRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FPortRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatter", wireType)
+			}
+			m.UpFormatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpFormatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpFormatterParameter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpFormatterParameter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownFormatter", wireType)
+			}
+			m.DownFormatter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownFormatter |= PayloadFormatter(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DownFormatterParameter", wireType)
 			}
@@ -61,6 +61,10 @@ var UplinkMessageFieldPathsNested = []string{
 	"settings.data_rate.modulation.lora",
 	"settings.data_rate.modulation.lora.bandwidth",
 	"settings.data_rate.modulation.lora.spreading_factor",
+	"settings.data_rate.modulation.lrfhss",
+	"settings.data_rate.modulation.lrfhss.coding_rate",
+	"settings.data_rate.modulation.lrfhss.modulation_type",
+	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.data_rate_index",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
@@ -156,6 +160,10 @@ var DownlinkMessageFieldPathsNested = []string{
 	"settings.scheduled.data_rate.modulation.lora",
 	"settings.scheduled.data_rate.modulation.lora.bandwidth",
 	"settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"settings.scheduled.data_rate.modulation.lrfhss",
+	"settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.scheduled.data_rate_index",
 	"settings.scheduled.downlink",
 	"settings.scheduled.downlink.antenna_index",
@@ -184,10 +192,13 @@ var TxAcknowledgmentFieldPathsTopLevel = []string{
 	"result",
 }
 var ApplicationUplinkFieldPathsNested = []string{
+	"confirmed",
 	"decoded_payload",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
+	"normalized_payload",
+	"normalized_payload_warnings",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -200,6 +211,10 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"settings.data_rate.modulation.lora",
 	"settings.data_rate.modulation.lora.bandwidth",
 	"settings.data_rate.modulation.lora.spreading_factor",
+	"settings.data_rate.modulation.lrfhss",
+	"settings.data_rate.modulation.lrfhss.coding_rate",
+	"settings.data_rate.modulation.lrfhss.modulation_type",
+	"settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"settings.data_rate_index",
 	"settings.downlink",
 	"settings.downlink.antenna_index",
@@ -212,10 +227,13 @@ var ApplicationUplinkFieldPathsNested = []string{
 }
 
 var ApplicationUplinkFieldPathsTopLevel = []string{
+	"confirmed",
 	"decoded_payload",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
+	"normalized_payload",
+	"normalized_payload_warnings",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -262,6 +280,7 @@ var ApplicationDownlinkFieldPathsNested = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -274,6 +293,7 @@ var ApplicationDownlinkFieldPathsTopLevel = []string{
 	"confirmed",
 	"correlation_ids",
 	"decoded_payload",
+	"expires_at",
 	"f_cnt",
 	"f_port",
 	"frm_payload",
@@ -295,6 +315,7 @@ var ApplicationDownlinkFailedFieldPathsNested = []string{
 	"downlink.confirmed",
 	"downlink.correlation_ids",
 	"downlink.decoded_payload",
+	"downlink.expires_at",
 	"downlink.f_cnt",
 	"downlink.f_port",
 	"downlink.frm_payload",
@@ -347,6 +368,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_ack.confirmed",
 	"up.downlink_ack.correlation_ids",
 	"up.downlink_ack.decoded_payload",
+	"up.downlink_ack.expires_at",
 	"up.downlink_ack.f_cnt",
 	"up.downlink_ack.f_port",
 	"up.downlink_ack.frm_payload",
@@ -360,6 +382,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_failed.downlink.confirmed",
 	"up.downlink_failed.downlink.correlation_ids",
 	"up.downlink_failed.downlink.decoded_payload",
+	"up.downlink_failed.downlink.expires_at",
 	"up.downlink_failed.downlink.f_cnt",
 	"up.downlink_failed.downlink.f_port",
 	"up.downlink_failed.downlink.frm_payload",
@@ -386,6 +409,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_nack.confirmed",
 	"up.downlink_nack.correlation_ids",
 	"up.downlink_nack.decoded_payload",
+	"up.downlink_nack.expires_at",
 	"up.downlink_nack.f_cnt",
 	"up.downlink_nack.f_port",
 	"up.downlink_nack.frm_payload",
@@ -401,6 +425,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_queued.confirmed",
 	"up.downlink_queued.correlation_ids",
 	"up.downlink_queued.decoded_payload",
+	"up.downlink_queued.expires_at",
 	"up.downlink_queued.f_cnt",
 	"up.downlink_queued.f_port",
 	"up.downlink_queued.frm_payload",
@@ -413,6 +438,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.downlink_sent.confirmed",
 	"up.downlink_sent.correlation_ids",
 	"up.downlink_sent.decoded_payload",
+	"up.downlink_sent.expires_at",
 	"up.downlink_sent.f_cnt",
 	"up.downlink_sent.f_port",
 	"up.downlink_sent.frm_payload",
@@ -437,6 +463,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.location_solved.location.source",
 	"up.location_solved.service",
 	"up.uplink_message",
+	"up.uplink_message.confirmed",
 	"up.uplink_message.decoded_payload",
 	"up.uplink_message.f_cnt",
 	"up.uplink_message.f_port",
@@ -453,6 +480,10 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.settings.data_rate.modulation.lora",
 	"up.uplink_message.settings.data_rate.modulation.lora.bandwidth",
 	"up.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"up.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
 	"up.uplink_message.settings.data_rate_index",
 	"up.uplink_message.settings.downlink",
 	"up.uplink_message.settings.downlink.antenna_index",
@@ -470,9 +501,42 @@ var ApplicationUpFieldPathsTopLevel = []string{
 	"received_at",
 	"up",
 }
+var ApplicationUpFilterFieldPathsNested = []string{
+	"decoded_payload_conditions",
+	"f_port_ranges",
+}
+
+var ApplicationUpFilterFieldPathsTopLevel = []string{
+	"decoded_payload_conditions",
+	"f_port_ranges",
+}
+
+var FPortRangeFieldPathsNested = []string{
+	"max",
+	"min",
+}
+
+var FPortRangeFieldPathsTopLevel = []string{
+	"max",
+	"min",
+}
+
+var DecodedPayloadConditionFieldPathsNested = []string{
+	"field",
+	"operator",
+	"value",
+}
+
+var DecodedPayloadConditionFieldPathsTopLevel = []string{
+	"field",
+	"operator",
+	"value",
+}
+
 var MessagePayloadFormattersFieldPathsNested = []string{
 	"down_formatter",
 	"down_formatter_parameter",
+	"f_port_formatters",
 	"up_formatter",
 	"up_formatter_parameter",
 }
@@ -480,9 +544,28 @@ var MessagePayloadFormattersFieldPathsNested = []string{
 var MessagePayloadFormattersFieldPathsTopLevel = []string{
 	"down_formatter",
 	"down_formatter_parameter",
+	"f_port_formatters",
 	"up_formatter",
 	"up_formatter_parameter",
 }
+var FPortPayloadFormattersFieldPathsNested = []string{
+	"down_formatter",
+	"down_formatter_parameter",
+	"f_port_range",
+	"f_port_range.max",
+	"f_port_range.min",
+	"up_formatter",
+	"up_formatter_parameter",
+}
+
+var FPortPayloadFormattersFieldPathsTopLevel = []string{
+	"down_formatter",
+	"down_formatter_parameter",
+	"f_port_range",
+	"up_formatter",
+	"up_formatter_parameter",
+}
+
 var DownlinkQueueRequestFieldPathsNested = []string{
 	"downlinks",
 	"end_device_ids",
@@ -361,7 +361,35 @@ func (dst *ApplicationUplink) SetFields(src *ApplicationUplink, paths ...string)
 				var zero time.Time
 				dst.ReceivedAt = zero
 			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
 
+		case "normalized_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'normalized_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NormalizedPayload = src.NormalizedPayload
+			} else {
+				dst.NormalizedPayload = nil
+			}
+		case "normalized_payload_warnings":
+			if len(subs) > 0 {
+				return fmt.Errorf("'normalized_payload_warnings' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NormalizedPayloadWarnings = src.NormalizedPayloadWarnings
+			} else {
+				dst.NormalizedPayloadWarnings = nil
+			}
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -587,6 +615,15 @@ func (dst *ApplicationDownlink) SetFields(src *ApplicationDownlink, paths ...str
 			} else {
 				dst.CorrelationIDs = nil
 			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -976,6 +1013,107 @@ func (dst *ApplicationUp) SetFields(src *ApplicationUp, paths ...string) error {
 	return nil
 }
 
+func (dst *ApplicationUpFilter) SetFields(src *ApplicationUpFilter, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "f_port_ranges":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port_ranges' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPortRanges = src.FPortRanges
+			} else {
+				dst.FPortRanges = nil
+			}
+		case "decoded_payload_conditions":
+			if len(subs) > 0 {
+				return fmt.Errorf("'decoded_payload_conditions' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DecodedPayloadConditions = src.DecodedPayloadConditions
+			} else {
+				dst.DecodedPayloadConditions = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FPortRange) SetFields(src *FPortRange, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "min":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Min = src.Min
+			} else {
+				var zero uint32
+				dst.Min = zero
+			}
+		case "max":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Max = src.Max
+			} else {
+				var zero uint32
+				dst.Max = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodedPayloadCondition) SetFields(src *DecodedPayloadCondition, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "field":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Field = src.Field
+			} else {
+				var zero string
+				dst.Field = zero
+			}
+		case "operator":
+			if len(subs) > 0 {
+				return fmt.Errorf("'operator' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Operator = src.Operator
+			} else {
+				var zero DecodedPayloadCondition_Operator
+				dst.Operator = zero
+			}
+		case "value":
+			if len(subs) > 0 {
+				return fmt.Errorf("'value' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Value = src.Value
+			} else {
+				var zero string
+				dst.Value = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MessagePayloadFormatters) SetFields(src *MessagePayloadFormatters, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
 		switch name {
@@ -1020,6 +1158,84 @@ func (dst *MessagePayloadFormatters) SetFields(src *MessagePayloadFormatters, pa
 				dst.DownFormatterParameter = zero
 			}
 
+		case "f_port_formatters":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port_formatters' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPortFormatters = src.FPortFormatters
+			} else {
+				dst.FPortFormatters = nil
+			}
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *FPortPayloadFormatters) SetFields(src *FPortPayloadFormatters, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "f_port_range":
+			if len(subs) > 0 {
+				newDst := &dst.FPortRange
+				var newSrc *FPortRange
+				if src != nil {
+					newSrc = &src.FPortRange
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.FPortRange = src.FPortRange
+				} else {
+					var zero FPortRange
+					dst.FPortRange = zero
+				}
+			}
+		case "up_formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'up_formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpFormatter = src.UpFormatter
+			} else {
+				var zero PayloadFormatter
+				dst.UpFormatter = zero
+			}
+		case "up_formatter_parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'up_formatter_parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpFormatterParameter = src.UpFormatterParameter
+			} else {
+				var zero string
+				dst.UpFormatterParameter = zero
+			}
+		case "down_formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'down_formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownFormatter = src.DownFormatter
+			} else {
+				var zero PayloadFormatter
+				dst.DownFormatter = zero
+			}
+		case "down_formatter_parameter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'down_formatter_parameter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownFormatterParameter = src.DownFormatterParameter
+			} else {
+				var zero string
+				dst.DownFormatterParameter = zero
+			}
+
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
 		}
@@ -573,6 +573,27 @@ func (m *ApplicationUplink) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "confirmed":
+			// no validation rules for Confirmed
+		case "normalized_payload":
+
+			for idx, item := range m.GetNormalizedPayload() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationUplinkValidationError{
+							field:  fmt.Sprintf("normalized_payload[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "normalized_payload_warnings":
+			// no validation rules for NormalizedPayloadWarnings
 		default:
 			return ApplicationUplinkValidationError{
 				field:  name,
@@ -988,6 +1009,18 @@ func (m *ApplicationDownlink) ValidateFields(paths ...string) error {
 
 			}
 
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationDownlinkValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationDownlinkValidationError{
 				field:  name,
@@ -1648,6 +1681,329 @@ var _ interface {
 	ErrorName() string
 } = ApplicationUpValidationError{}
 
+// ValidateFields checks the field values on ApplicationUpFilter with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *ApplicationUpFilter) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUpFilterFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_port_ranges":
+
+			for idx, item := range m.GetFPortRanges() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationUpFilterValidationError{
+							field:  fmt.Sprintf("f_port_ranges[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "decoded_payload_conditions":
+
+			for idx, item := range m.GetDecodedPayloadConditions() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationUpFilterValidationError{
+							field:  fmt.Sprintf("decoded_payload_conditions[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationUpFilterValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUpFilterValidationError is the validation error returned by
+// ApplicationUpFilter.ValidateFields if the designated constraints aren't met.
+type ApplicationUpFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUpFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUpFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUpFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUpFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUpFilterValidationError) ErrorName() string {
+	return "ApplicationUpFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUpFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUpFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUpFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUpFilterValidationError{}
+
+// ValidateFields checks the field values on FPortRange with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FPortRange) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FPortRangeFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min":
+
+			if m.GetMin() > 255 {
+				return FPortRangeValidationError{
+					field:  "min",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		case "max":
+
+			if m.GetMax() > 255 {
+				return FPortRangeValidationError{
+					field:  "max",
+					reason: "value must be less than or equal to 255",
+				}
+			}
+
+		default:
+			return FPortRangeValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FPortRangeValidationError is the validation error returned by
+// FPortRange.ValidateFields if the designated constraints aren't met.
+type FPortRangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FPortRangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FPortRangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FPortRangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FPortRangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FPortRangeValidationError) ErrorName() string {
+	return "FPortRangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FPortRangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFPortRange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FPortRangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FPortRangeValidationError{}
+
+// ValidateFields checks the field values on DecodedPayloadCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodedPayloadCondition) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodedPayloadConditionFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "field":
+
+			if l := utf8.RuneCountInString(m.GetField()); l < 1 || l > 256 {
+				return DecodedPayloadConditionValidationError{
+					field:  "field",
+					reason: "value length must be between 1 and 256 runes, inclusive",
+				}
+			}
+
+		case "operator":
+
+			if _, ok := DecodedPayloadCondition_Operator_name[int32(m.GetOperator())]; !ok {
+				return DecodedPayloadConditionValidationError{
+					field:  "operator",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "value":
+
+			if utf8.RuneCountInString(m.GetValue()) > 256 {
+				return DecodedPayloadConditionValidationError{
+					field:  "value",
+					reason: "value length must be at most 256 runes",
+				}
+			}
+
+		default:
+			return DecodedPayloadConditionValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodedPayloadConditionValidationError is the validation error returned by
+// DecodedPayloadCondition.ValidateFields if the designated constraints aren't
+// met.
+type DecodedPayloadConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodedPayloadConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodedPayloadConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodedPayloadConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodedPayloadConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodedPayloadConditionValidationError) ErrorName() string {
+	return "DecodedPayloadConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodedPayloadConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodedPayloadCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodedPayloadConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodedPayloadConditionValidationError{}
+
 // ValidateFields checks the field values on MessagePayloadFormatters with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1685,6 +2041,30 @@ func (m *MessagePayloadFormatters) ValidateFields(paths ...string) error {
 
 		case "down_formatter_parameter":
 			// no validation rules for DownFormatterParameter
+		case "f_port_formatters":
+
+			if len(m.GetFPortFormatters()) > 32 {
+				return MessagePayloadFormattersValidationError{
+					field:  "f_port_formatters",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetFPortFormatters() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MessagePayloadFormattersValidationError{
+							field:  fmt.Sprintf("f_port_formatters[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
 		default:
 			return MessagePayloadFormattersValidationError{
 				field:  name,
@@ -1752,6 +2132,122 @@ var _ interface {
 	ErrorName() string
 } = MessagePayloadFormattersValidationError{}
 
+// ValidateFields checks the field values on FPortPayloadFormatters with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FPortPayloadFormatters) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = FPortPayloadFormattersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "f_port_range":
+
+			if v, ok := interface{}(&m.FPortRange).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return FPortPayloadFormattersValidationError{
+						field:  "f_port_range",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "up_formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetUpFormatter())]; !ok {
+				return FPortPayloadFormattersValidationError{
+					field:  "up_formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "up_formatter_parameter":
+			// no validation rules for UpFormatterParameter
+		case "down_formatter":
+
+			if _, ok := PayloadFormatter_name[int32(m.GetDownFormatter())]; !ok {
+				return FPortPayloadFormattersValidationError{
+					field:  "down_formatter",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "down_formatter_parameter":
+			// no validation rules for DownFormatterParameter
+		default:
+			return FPortPayloadFormattersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// FPortPayloadFormattersValidationError is the validation error returned by
+// FPortPayloadFormatters.ValidateFields if the designated constraints aren't
+// met.
+type FPortPayloadFormattersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FPortPayloadFormattersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FPortPayloadFormattersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FPortPayloadFormattersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FPortPayloadFormattersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FPortPayloadFormattersValidationError) ErrorName() string {
+	return "FPortPayloadFormattersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FPortPayloadFormattersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFPortPayloadFormatters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FPortPayloadFormattersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FPortPayloadFormattersValidationError{}
+
 // ValidateFields checks the field values on DownlinkQueueRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -121,6 +121,10 @@ type RxMetadata struct {
 	UplinkToken []byte `protobuf:"bytes,15,opt,name=uplink_token,json=uplinkToken,proto3" json:"uplink_token,omitempty"`
 	// Index of the gateway channel that received the message.
 	ChannelIndex uint32 `protobuf:"varint,17,opt,name=channel_index,json=channelIndex,proto3" json:"channel_index,omitempty"`
+	// Frequency plan ID of the gateway that received the message; injected by the Gateway Server.
+	FrequencyPlanID string `protobuf:"bytes,18,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	// Median round-trip time of the gateway connection; injected by the Gateway Server.
+	RoundTripTime *time.Duration `protobuf:"bytes,19,opt,name=round_trip_time,json=roundTripTime,proto3,stdduration" json:"round_trip_time,omitempty"`
 	// Advanced metadata fields
 	// - can be used for advanced information or experimental features that are not yet formally defined in the API
 	// - field names are written in snake_case
@@ -273,6 +277,20 @@ func (m *RxMetadata) GetChannelIndex() uint32 {
 	return 0
 }
 
+func (m *RxMetadata) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
+	}
+	return ""
+}
+
+func (m *RxMetadata) GetRoundTripTime() *time.Duration {
+	if m != nil {
+		return m.RoundTripTime
+	}
+	return nil
+}
+
 func (m *RxMetadata) GetAdvanced() *types.Struct {
 	if m != nil {
 		return m.Advanced
@@ -377,77 +395,82 @@ func init() {
 }
 
 var fileDescriptor_e1123b3e8fd87092 = []byte{
-	// 1112 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0x31, 0x6c, 0xdb, 0x46,
-	0x14, 0x86, 0x79, 0x96, 0xec, 0xc8, 0x27, 0x5b, 0x56, 0xaf, 0x4d, 0x42, 0xcb, 0xee, 0x51, 0x4d,
-	0xd0, 0x42, 0x09, 0x6a, 0x09, 0x70, 0x52, 0xa0, 0xe8, 0x14, 0xd3, 0x76, 0x0c, 0x21, 0x8e, 0x94,
-	0x9e, 0x94, 0x04, 0xed, 0x42, 0x9c, 0xc9, 0x13, 0xc5, 0x4a, 0x3e, 0xaa, 0xe4, 0xc9, 0x8e, 0xb6,
-	0xa0, 0x53, 0x50, 0x74, 0x48, 0xb7, 0x8e, 0x41, 0xbb, 0x64, 0xcc, 0x98, 0xd1, 0xa3, 0x47, 0x8f,
-	0x99, 0xd4, 0x88, 0x5a, 0x32, 0x7a, 0x0c, 0xbc, 0xb4, 0xe0, 0x89, 0x92, 0x2d, 0x29, 0xf6, 0xc4,
-	0xfb, 0xdf, 0xf7, 0xff, 0x67, 0xbe, 0xbb, 0x47, 0xc1, 0x6c, 0xd3, 0xf5, 0xe8, 0x21, 0xe5, 0x6b,
-	0xbe, 0xa0, 0x66, 0xa3, 0x40, 0x5b, 0x4e, 0x61, 0x9f, 0x09, 0x6a, 0x51, 0x41, 0xf3, 0x2d, 0xcf,
-	0x15, 0x2e, 0x4a, 0x09, 0xc1, 0xf3, 0x11, 0x95, 0x3f, 0xb8, 0x93, 0xd9, 0xb0, 0x1d, 0x51, 0x6f,
-	0xef, 0xe5, 0x4d, 0x77, 0xbf, 0xc0, 0xf8, 0x81, 0xdb, 0x69, 0x79, 0xee, 0xb3, 0x4e, 0x41, 0xc2,
-	0xe6, 0x9a, 0xcd, 0xf8, 0xda, 0x01, 0x6d, 0x3a, 0x16, 0x15, 0xac, 0x30, 0xf5, 0x30, 0x88, 0xcc,
-	0xac, 0x5d, 0x88, 0xb0, 0x5d, 0xdb, 0x1d, 0x98, 0xf7, 0xda, 0x35, 0xb9, 0x92, 0x0b, 0xf9, 0x14,
-	0xe1, 0xab, 0xb6, 0xeb, 0xda, 0x4d, 0x76, 0x4e, 0xf9, 0xc2, 0x6b, 0x9b, 0x22, 0xaa, 0x6a, 0x93,
-	0x55, 0xe1, 0xec, 0x33, 0x5f, 0xd0, 0xfd, 0x56, 0x04, 0xe0, 0x49, 0xe0, 0xd0, 0xa3, 0xad, 0x16,
-	0xf3, 0xfc, 0xa8, 0xfe, 0xe5, 0x74, 0x0b, 0x18, 0x6f, 0xef, 0x0f, 0xcb, 0x37, 0xa7, 0xcb, 0x8e,
-	0xc5, 0xb8, 0x70, 0x6a, 0xce, 0x28, 0xe3, 0xc6, 0x1f, 0x09, 0x08, 0xc9, 0xb3, 0x87, 0x51, 0xe7,
-	0xd0, 0x63, 0x98, 0xb4, 0xa9, 0x60, 0x87, 0xb4, 0x63, 0x38, 0x96, 0xaf, 0x82, 0x2c, 0xc8, 0x25,
-	0xd7, 0x6f, 0xe4, 0xc7, 0x3b, 0x99, 0xdf, 0x19, 0x20, 0xc5, 0xf3, 0x34, 0x3d, 0x7d, 0xa6, 0xcf,
-	0xfe, 0x0e, 0x66, 0xd2, 0xe0, 0xb8, 0xab, 0x29, 0x27, 0x5d, 0x0d, 0x10, 0x68, 0x0f, 0x29, 0x1f,
-	0xdd, 0x84, 0x8b, 0x94, 0x0b, 0xc6, 0x39, 0x35, 0x1c, 0x6e, 0xb1, 0x67, 0xea, 0x4c, 0x16, 0xe4,
-	0x16, 0xc9, 0x42, 0x24, 0x16, 0x43, 0x0d, 0xdd, 0x85, 0xf1, 0xb0, 0x03, 0x6a, 0x4c, 0x6e, 0x9a,
-	0xc9, 0x0f, 0xde, 0x3e, 0x3f, 0x7c, 0xfb, 0x7c, 0x75, 0xd8, 0x1e, 0x3d, 0xfe, 0xf2, 0x5f, 0x0d,
-	0x10, 0x49, 0xa3, 0x55, 0x38, 0x3f, 0xea, 0x9b, 0x1a, 0x97, 0xb1, 0xe7, 0x02, 0xfa, 0x1a, 0xa6,
-	0x6a, 0x0e, 0x67, 0xc6, 0x39, 0x32, 0x9b, 0x05, 0xb9, 0x38, 0x59, 0x0c, 0xd5, 0x51, 0x20, 0xfa,
-	0x1e, 0xaa, 0x8c, 0x9b, 0x5e, 0xa7, 0x25, 0x98, 0x65, 0x4c, 0x18, 0xe6, 0xb2, 0x20, 0xb7, 0x40,
-	0xae, 0x8d, 0xea, 0xf7, 0xc7, 0x9c, 0x0c, 0x6a, 0x97, 0x39, 0x8d, 0x06, 0x0b, 0xbb, 0xa8, 0x5e,
-	0xc9, 0x82, 0xdc, 0xbc, 0xae, 0x05, 0x5d, 0x6d, 0x65, 0xfb, 0x93, 0x21, 0x0f, 0x58, 0xa7, 0xb8,
-	0x45, 0x56, 0xd8, 0xa5, 0x45, 0x0b, 0xad, 0xc2, 0xb8, 0xe7, 0xfb, 0x8e, 0x9a, 0xc8, 0x82, 0xdc,
-	0x8c, 0x9e, 0x08, 0xba, 0x5a, 0x9c, 0x54, 0x2a, 0x45, 0x22, 0x55, 0xb4, 0x0b, 0x93, 0xbe, 0x63,
-	0x73, 0xda, 0x34, 0x24, 0x94, 0x96, 0x0d, 0x5c, 0x99, 0x6a, 0xe0, 0xfd, 0xa6, 0x4b, 0xc5, 0x13,
-	0xda, 0x6c, 0x33, 0x3d, 0x15, 0x74, 0x35, 0x58, 0x91, 0x1e, 0x99, 0x03, 0x07, 0x7e, 0x12, 0xa6,
-	0xad, 0xc3, 0x05, 0xb3, 0x4e, 0x39, 0x67, 0x51, 0xdc, 0xbc, 0xdc, 0x73, 0x29, 0xe8, 0x6a, 0xc9,
-	0xcd, 0x81, 0x2e, 0x2d, 0xc9, 0x08, 0x92, 0x9e, 0x1f, 0xe1, 0xf5, 0x90, 0x35, 0x7c, 0x41, 0xb9,
-	0x45, 0x3d, 0xcb, 0xb0, 0xd8, 0x81, 0x43, 0x85, 0xe3, 0x72, 0x15, 0x4a, 0xfb, 0x72, 0xd0, 0xd5,
-	0xae, 0x86, 0xbe, 0x4a, 0x44, 0x6c, 0x0d, 0x01, 0x72, 0x35, 0x74, 0x4e, 0xc9, 0x68, 0x19, 0xc6,
-	0x7c, 0xee, 0xa9, 0x49, 0x69, 0xbf, 0x12, 0x74, 0xb5, 0x58, 0xa5, 0x44, 0x48, 0xa8, 0xa1, 0x5b,
-	0x30, 0x5d, 0xf3, 0xd8, 0xaf, 0x6d, 0xc6, 0xcd, 0x8e, 0xe1, 0xd6, 0x6a, 0x3e, 0x13, 0xea, 0x42,
-	0x16, 0xe4, 0x62, 0x64, 0x69, 0xa4, 0x97, 0xa5, 0x8c, 0xee, 0xc2, 0x44, 0xd3, 0x35, 0x07, 0xff,
-	0xc9, 0xa2, 0xec, 0x8b, 0x3a, 0x79, 0x9b, 0x77, 0xa3, 0x3a, 0x19, 0x91, 0xe8, 0x17, 0xa8, 0x5a,
-	0xee, 0x21, 0x6f, 0x3a, 0xbc, 0x61, 0xb4, 0xa8, 0xa8, 0x1b, 0xa6, 0xcb, 0x7d, 0xe1, 0x51, 0x87,
-	0x0b, 0x35, 0x95, 0x05, 0xb9, 0xd4, 0xfa, 0x37, 0x93, 0x29, 0x5b, 0x11, 0xff, 0x88, 0x8a, 0xfa,
-	0xe6, 0x88, 0xd6, 0x13, 0x67, 0xfa, 0xec, 0x6f, 0xe1, 0x5c, 0x90, 0x6b, 0xd6, 0x27, 0x09, 0xf4,
-	0x15, 0x5c, 0x68, 0xb7, 0xe4, 0x4e, 0xc2, 0x6d, 0x30, 0xae, 0x2e, 0xc9, 0xfb, 0x96, 0x1c, 0x68,
-	0xd5, 0x50, 0x42, 0x6b, 0x70, 0x71, 0x78, 0x22, 0x83, 0xf1, 0xf9, 0x2c, 0xbc, 0xe7, 0x32, 0xfb,
-	0x76, 0x4c, 0xfd, 0x0f, 0x90, 0xe1, 0x81, 0x0d, 0x06, 0xe9, 0x0e, 0x4c, 0x50, 0xeb, 0x80, 0x72,
-	0x93, 0x59, 0xaa, 0x29, 0xdf, 0xf9, 0xfa, 0xd4, 0x5d, 0xa8, 0xc8, 0x2f, 0x11, 0x19, 0x81, 0x3f,
-	0xc4, 0xdf, 0xbe, 0xd2, 0x94, 0x1b, 0xa7, 0x00, 0x26, 0x86, 0xfd, 0x08, 0x73, 0x9a, 0x54, 0x38,
-	0xa2, 0x6d, 0x31, 0xf9, 0x25, 0x00, 0xfa, 0xf5, 0x33, 0xfd, 0x0b, 0x84, 0x96, 0x95, 0xf0, 0xef,
-	0xf9, 0x93, 0x7b, 0xb7, 0xa2, 0x87, 0x23, 0x32, 0x02, 0xd1, 0x77, 0x70, 0xbe, 0xe9, 0x72, 0x7b,
-	0xe0, 0x9a, 0x99, 0x76, 0xd5, 0x86, 0xae, 0xda, 0x11, 0x39, 0x27, 0x51, 0x06, 0x26, 0x68, 0x33,
-	0xda, 0x2b, 0xfc, 0x00, 0xcc, 0x92, 0xd1, 0x5a, 0xd6, 0x4c, 0xb3, 0xed, 0x51, 0xb3, 0x23, 0x27,
-	0x3c, 0xac, 0x45, 0x6b, 0x74, 0x0f, 0xce, 0xf9, 0x6e, 0xdb, 0x33, 0x99, 0x1c, 0xec, 0xd4, 0x3a,
-	0xbe, 0xec, 0x74, 0x2b, 0x92, 0xba, 0x70, 0x1e, 0x91, 0xef, 0xf6, 0x9f, 0x33, 0x30, 0x35, 0x0e,
-	0x21, 0x04, 0x53, 0x95, 0xf2, 0x63, 0xb2, 0xb9, 0x6d, 0x3c, 0x2e, 0x3d, 0x28, 0x95, 0x9f, 0x96,
-	0xd2, 0x0a, 0x4a, 0x41, 0x18, 0x69, 0x3b, 0x8f, 0x2a, 0x69, 0x80, 0x3e, 0x87, 0x4b, 0xd1, 0x9a,
-	0x6c, 0xef, 0x14, 0x2b, 0x55, 0xf2, 0x53, 0x3a, 0x86, 0x96, 0xe1, 0xd5, 0x48, 0x2c, 0x3e, 0x32,
-	0x76, 0xb6, 0xcb, 0xbb, 0xe5, 0xcd, 0x8d, 0x6a, 0xb1, 0x5c, 0x4a, 0xc7, 0x51, 0x16, 0xae, 0x46,
-	0xa5, 0xa7, 0xc5, 0xfb, 0x45, 0x23, 0x1c, 0x85, 0x31, 0x62, 0x16, 0x61, 0x98, 0x89, 0x08, 0xbd,
-	0x3a, 0x5d, 0x9f, 0xbb, 0x90, 0xb0, 0x5b, 0x26, 0x1b, 0xd3, 0xc4, 0x95, 0x49, 0xa2, 0xba, 0x55,
-	0xde, 0x18, 0x23, 0x12, 0x48, 0x83, 0x2b, 0x11, 0xb1, 0x59, 0x7e, 0xa8, 0x17, 0x4b, 0xdb, 0x5b,
-	0x63, 0xc0, 0x7c, 0x26, 0xfe, 0xe2, 0x1f, 0xac, 0xe8, 0x7f, 0x83, 0xe3, 0x1e, 0x06, 0x27, 0x3d,
-	0x0c, 0xde, 0xf5, 0xb0, 0xf2, 0xbe, 0x87, 0x95, 0x0f, 0x3d, 0xac, 0x9c, 0xf6, 0xb0, 0xf2, 0xb1,
-	0x87, 0xc1, 0xf3, 0x00, 0x83, 0x17, 0x01, 0x56, 0x5e, 0x07, 0x18, 0xbc, 0x09, 0xb0, 0xf2, 0x36,
-	0xc0, 0xca, 0x51, 0x80, 0x95, 0xe3, 0x00, 0x83, 0x93, 0x00, 0x83, 0x77, 0x01, 0x56, 0xde, 0x07,
-	0x18, 0x7c, 0x08, 0xb0, 0x72, 0x1a, 0x60, 0xf0, 0x31, 0xc0, 0xca, 0xf3, 0x3e, 0x56, 0x5e, 0xf4,
-	0x31, 0x78, 0xd9, 0xc7, 0xca, 0x5f, 0x7d, 0x0c, 0x5e, 0xf5, 0xb1, 0xf2, 0xba, 0x8f, 0x95, 0x37,
-	0x7d, 0x0c, 0xde, 0xf6, 0x31, 0x38, 0xea, 0x63, 0xf0, 0xf3, 0xb7, 0xb6, 0x9b, 0x17, 0x75, 0x26,
-	0xea, 0x0e, 0xb7, 0xfd, 0x3c, 0x67, 0xe2, 0xd0, 0xf5, 0x1a, 0x85, 0xf1, 0x9f, 0xb1, 0x56, 0xc3,
-	0x2e, 0x08, 0xc1, 0x5b, 0x7b, 0x7b, 0x73, 0xf2, 0x32, 0xdf, 0xf9, 0x3f, 0x00, 0x00, 0xff, 0xff,
-	0xba, 0x03, 0x63, 0xc5, 0x0a, 0x08, 0x00, 0x00,
+	// 1194 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0x31, 0x6c, 0xdb, 0xc6,
+	0x17, 0xc6, 0x79, 0xb6, 0xec, 0xc8, 0x27, 0x5b, 0x56, 0x2e, 0xff, 0x24, 0xb4, 0xec, 0xff, 0x51,
+	0x4d, 0xd0, 0x42, 0x09, 0x6a, 0x09, 0x70, 0x52, 0xa0, 0xe8, 0x14, 0xd3, 0xb2, 0x0d, 0x21, 0x8e,
+	0xe5, 0x9e, 0x94, 0x04, 0xed, 0x42, 0x9c, 0xc9, 0x13, 0xcd, 0x9a, 0x3e, 0xb2, 0xe4, 0xc9, 0x8e,
+	0xb6, 0xa0, 0x53, 0xd0, 0x29, 0xdd, 0xb2, 0x14, 0x08, 0xda, 0x25, 0x63, 0xc6, 0x8c, 0x1e, 0x33,
+	0x66, 0xcc, 0xa4, 0x46, 0xd4, 0x92, 0x31, 0x63, 0xe0, 0xa5, 0x05, 0x4f, 0x94, 0x6c, 0x59, 0xb1,
+	0x27, 0xde, 0xf7, 0x7e, 0xdf, 0x77, 0xe6, 0xe3, 0xbb, 0x13, 0x2c, 0xb8, 0x5e, 0x40, 0x8f, 0x28,
+	0x5f, 0x0e, 0x05, 0x35, 0xf7, 0xcb, 0xd4, 0x77, 0xca, 0x07, 0x4c, 0x50, 0x8b, 0x0a, 0x5a, 0xf2,
+	0x03, 0x4f, 0x78, 0x28, 0x2b, 0x04, 0x2f, 0x25, 0x54, 0xe9, 0xf0, 0x4e, 0x7e, 0xd5, 0x76, 0xc4,
+	0x5e, 0x6b, 0xb7, 0x64, 0x7a, 0x07, 0x65, 0xc6, 0x0f, 0xbd, 0xb6, 0x1f, 0x78, 0x4f, 0xda, 0x65,
+	0x09, 0x9b, 0xcb, 0x36, 0xe3, 0xcb, 0x87, 0xd4, 0x75, 0x2c, 0x2a, 0x58, 0x79, 0xec, 0xa1, 0x1f,
+	0x99, 0x5f, 0x3e, 0x13, 0x61, 0x7b, 0xb6, 0xd7, 0x37, 0xef, 0xb6, 0x9a, 0x72, 0x25, 0x17, 0xf2,
+	0x29, 0xc1, 0xb1, 0xed, 0x79, 0xb6, 0xcb, 0x4e, 0x29, 0xab, 0x15, 0x50, 0xe1, 0x78, 0x3c, 0xa9,
+	0x2f, 0x9d, 0xaf, 0x87, 0x22, 0x68, 0x99, 0x22, 0xa9, 0x6a, 0xe7, 0xab, 0xc2, 0x39, 0x60, 0xa1,
+	0xa0, 0x07, 0xfe, 0x45, 0xf1, 0x47, 0x01, 0xf5, 0x7d, 0x16, 0x84, 0x49, 0xfd, 0xff, 0xe3, 0x2d,
+	0x62, 0xbc, 0x75, 0x30, 0x28, 0xdf, 0x1c, 0x2f, 0x3b, 0x16, 0xe3, 0xc2, 0x69, 0x3a, 0xc3, 0x8c,
+	0x1b, 0x7f, 0xce, 0x40, 0x48, 0x9e, 0x3c, 0x48, 0x3a, 0x8b, 0x1e, 0xc2, 0x8c, 0x4d, 0x05, 0x3b,
+	0xa2, 0x6d, 0xc3, 0xb1, 0x42, 0x15, 0x14, 0x40, 0x31, 0xb3, 0x72, 0xa3, 0x34, 0xda, 0xe9, 0xd2,
+	0x66, 0x1f, 0xa9, 0x9e, 0xa6, 0xe9, 0xb9, 0x13, 0x7d, 0xea, 0x77, 0x30, 0x91, 0x03, 0x6f, 0x3b,
+	0x9a, 0xf2, 0xae, 0xa3, 0x01, 0x02, 0xed, 0x01, 0x15, 0xa2, 0x9b, 0x70, 0x8e, 0x72, 0xc1, 0x38,
+	0xa7, 0x86, 0xc3, 0x2d, 0xf6, 0x44, 0x9d, 0x28, 0x80, 0xe2, 0x1c, 0x99, 0x4d, 0xc4, 0x6a, 0xac,
+	0xa1, 0xbb, 0x30, 0x15, 0x77, 0x40, 0x9d, 0x94, 0x9b, 0xe6, 0x4b, 0xfd, 0xb7, 0x2f, 0x0d, 0xde,
+	0xbe, 0xd4, 0x18, 0xb4, 0x47, 0x4f, 0x3d, 0xff, 0x47, 0x03, 0x44, 0xd2, 0x68, 0x09, 0xce, 0x0c,
+	0xfb, 0xa6, 0xa6, 0x64, 0xec, 0xa9, 0x80, 0xbe, 0x86, 0xd9, 0xa6, 0xc3, 0x99, 0x71, 0x8a, 0x4c,
+	0x15, 0x40, 0x31, 0x45, 0xe6, 0x62, 0x75, 0x18, 0x88, 0xbe, 0x87, 0x2a, 0xe3, 0x66, 0xd0, 0xf6,
+	0x05, 0xb3, 0x8c, 0x73, 0x86, 0xe9, 0x02, 0x28, 0xce, 0x92, 0x6b, 0xc3, 0xfa, 0xc6, 0x88, 0x93,
+	0x41, 0xed, 0x22, 0xa7, 0xb1, 0xcf, 0xe2, 0x2e, 0xaa, 0x97, 0x0a, 0xa0, 0x38, 0xa3, 0x6b, 0x51,
+	0x47, 0x5b, 0x5c, 0xff, 0x62, 0xc8, 0x7d, 0xd6, 0xae, 0x56, 0xc8, 0x22, 0xbb, 0xb0, 0x68, 0xa1,
+	0x25, 0x98, 0x0a, 0xc2, 0xd0, 0x51, 0xd3, 0x05, 0x50, 0x9c, 0xd0, 0xd3, 0x51, 0x47, 0x4b, 0x91,
+	0x7a, 0xbd, 0x4a, 0xa4, 0x8a, 0xb6, 0x60, 0x26, 0x74, 0x6c, 0x4e, 0x5d, 0x43, 0x42, 0x39, 0xd9,
+	0xc0, 0xc5, 0xb1, 0x06, 0x6e, 0xb8, 0x1e, 0x15, 0x8f, 0xa8, 0xdb, 0x62, 0x7a, 0x36, 0xea, 0x68,
+	0xb0, 0x2e, 0x3d, 0x32, 0x07, 0xf6, 0xfd, 0x24, 0x4e, 0x5b, 0x81, 0xb3, 0xe6, 0x1e, 0xe5, 0x9c,
+	0x25, 0x71, 0x33, 0x72, 0xcf, 0xf9, 0xa8, 0xa3, 0x65, 0xd6, 0xfa, 0xba, 0xb4, 0x64, 0x12, 0x48,
+	0x7a, 0x7e, 0x84, 0xd7, 0x63, 0xd6, 0x08, 0x05, 0xe5, 0x16, 0x0d, 0x2c, 0xc3, 0x62, 0x87, 0x8e,
+	0x3c, 0x0a, 0x2a, 0x94, 0xf6, 0x85, 0xa8, 0xa3, 0x5d, 0x8d, 0x7d, 0xf5, 0x84, 0xa8, 0x0c, 0x00,
+	0x72, 0x35, 0x76, 0x8e, 0xc9, 0x68, 0x01, 0x4e, 0x86, 0x3c, 0x50, 0x33, 0xd2, 0x7e, 0x29, 0xea,
+	0x68, 0x93, 0xf5, 0x6d, 0x42, 0x62, 0x0d, 0xdd, 0x82, 0xb9, 0x66, 0xc0, 0x7e, 0x6d, 0x31, 0x6e,
+	0xb6, 0x0d, 0xaf, 0xd9, 0x0c, 0x99, 0x50, 0x67, 0x0b, 0xa0, 0x38, 0x49, 0xe6, 0x87, 0x7a, 0x4d,
+	0xca, 0xe8, 0x2e, 0x4c, 0xbb, 0x9e, 0xd9, 0xff, 0x4f, 0xe6, 0x64, 0x5f, 0xd4, 0xf3, 0xd3, 0xbc,
+	0x95, 0xd4, 0xc9, 0x90, 0x44, 0xbf, 0x40, 0xd5, 0xf2, 0x8e, 0xb8, 0xeb, 0xf0, 0x7d, 0xc3, 0xa7,
+	0x62, 0xcf, 0x30, 0x3d, 0x1e, 0x8a, 0x80, 0x3a, 0x5c, 0xa8, 0xd9, 0x02, 0x28, 0x66, 0x57, 0xbe,
+	0x39, 0x9f, 0x52, 0x49, 0xf8, 0x1d, 0x2a, 0xf6, 0xd6, 0x86, 0xb4, 0x9e, 0x3e, 0xd1, 0xa7, 0x7e,
+	0x8b, 0xcf, 0x05, 0xb9, 0x66, 0x7d, 0x91, 0x40, 0x5f, 0xc1, 0xd9, 0x96, 0x2f, 0x77, 0x12, 0xde,
+	0x3e, 0xe3, 0xea, 0xbc, 0x9c, 0xb7, 0x4c, 0x5f, 0x6b, 0xc4, 0x12, 0x5a, 0x86, 0x73, 0x83, 0x2f,
+	0xd2, 0x3f, 0x3e, 0x97, 0xe3, 0x39, 0x97, 0xd9, 0xb7, 0x27, 0xd5, 0x7f, 0x01, 0x19, 0x7c, 0xb0,
+	0xfe, 0x41, 0xda, 0x80, 0x97, 0x4f, 0xdb, 0xe3, 0xbb, 0x94, 0xc7, 0x53, 0x88, 0xe4, 0x14, 0xe6,
+	0x4f, 0xf4, 0x54, 0x30, 0xa1, 0xde, 0x8b, 0x3a, 0xda, 0xfc, 0xc6, 0x80, 0xd9, 0x71, 0x29, 0xaf,
+	0x56, 0xce, 0xf4, 0x4e, 0x0a, 0x16, 0xda, 0x84, 0xf3, 0x81, 0xd7, 0xe2, 0x96, 0x21, 0x02, 0xc7,
+	0x97, 0x73, 0xad, 0x5e, 0x91, 0x2d, 0x5c, 0x18, 0x1b, 0xad, 0x4a, 0x72, 0xf1, 0xe9, 0xa9, 0x17,
+	0xf1, 0xd1, 0x9c, 0x93, 0xbe, 0x46, 0xe0, 0xf8, 0xf1, 0x1c, 0xa3, 0x3b, 0x30, 0x4d, 0xad, 0x43,
+	0xca, 0x4d, 0x66, 0xa9, 0xa6, 0x4c, 0xb8, 0x3e, 0x96, 0x50, 0x97, 0x57, 0x23, 0x19, 0x82, 0x3f,
+	0xa4, 0xde, 0xbc, 0xd4, 0x94, 0x1b, 0x9f, 0x00, 0x4c, 0x0f, 0x3e, 0x50, 0x9c, 0xe3, 0x52, 0xe1,
+	0x88, 0x96, 0xc5, 0xe4, 0xd5, 0x04, 0xf4, 0xeb, 0x27, 0xfa, 0xff, 0x10, 0x5a, 0x50, 0xe2, 0xbf,
+	0xa7, 0x8f, 0xee, 0xdd, 0x4a, 0x1e, 0x8e, 0xc9, 0x10, 0x44, 0xdf, 0xc1, 0x19, 0xd7, 0xe3, 0x76,
+	0xdf, 0x35, 0x31, 0xee, 0x6a, 0x0e, 0x5c, 0xcd, 0x63, 0x72, 0x4a, 0xa2, 0x3c, 0x4c, 0x53, 0x37,
+	0xd9, 0x2b, 0xbe, 0x91, 0xa6, 0xc8, 0x70, 0x2d, 0x6b, 0xa6, 0xd9, 0x0a, 0xa8, 0xd9, 0x96, 0x57,
+	0x4e, 0x5c, 0x4b, 0xd6, 0xe8, 0x1e, 0x9c, 0x0e, 0xbd, 0x56, 0x60, 0x32, 0x79, 0xd3, 0x64, 0x57,
+	0xf0, 0x45, 0xe3, 0x56, 0x97, 0xd4, 0x99, 0x01, 0x49, 0x7c, 0xb7, 0xff, 0x98, 0x80, 0xd9, 0x51,
+	0x08, 0x21, 0x98, 0xad, 0xd7, 0x1e, 0x92, 0xb5, 0x75, 0xe3, 0xe1, 0xf6, 0xfd, 0xed, 0xda, 0xe3,
+	0xed, 0x9c, 0x82, 0xb2, 0x10, 0x26, 0xda, 0xe6, 0x4e, 0x3d, 0x07, 0xd0, 0x15, 0x38, 0x9f, 0xac,
+	0xc9, 0xfa, 0x66, 0xb5, 0xde, 0x20, 0x3f, 0xe5, 0x26, 0xd1, 0x02, 0xbc, 0x9a, 0x88, 0xd5, 0x1d,
+	0x63, 0x73, 0xbd, 0xb6, 0x55, 0x5b, 0x5b, 0x6d, 0x54, 0x6b, 0xdb, 0xb9, 0x14, 0x2a, 0xc0, 0xa5,
+	0xa4, 0xf4, 0xb8, 0xba, 0x51, 0x35, 0xe2, 0xb3, 0x39, 0x42, 0x4c, 0x21, 0x0c, 0xf3, 0x09, 0xa1,
+	0x37, 0xc6, 0xeb, 0xd3, 0x67, 0x12, 0xb6, 0x6a, 0x64, 0x75, 0x9c, 0xb8, 0x74, 0x9e, 0x68, 0x54,
+	0x6a, 0xab, 0x23, 0x44, 0x1a, 0x69, 0x70, 0x31, 0x21, 0xd6, 0x6a, 0x0f, 0xf4, 0xea, 0xf6, 0x7a,
+	0x65, 0x04, 0x98, 0xc9, 0xa7, 0x9e, 0xfd, 0x8d, 0x15, 0xfd, 0x2f, 0xf0, 0xb6, 0x8b, 0xc1, 0xbb,
+	0x2e, 0x06, 0xef, 0xbb, 0x58, 0xf9, 0xd0, 0xc5, 0xca, 0xc7, 0x2e, 0x56, 0x3e, 0x75, 0xb1, 0xf2,
+	0xb9, 0x8b, 0xc1, 0xd3, 0x08, 0x83, 0x67, 0x11, 0x56, 0x5e, 0x45, 0x18, 0xbc, 0x8e, 0xb0, 0xf2,
+	0x26, 0xc2, 0xca, 0x71, 0x84, 0x95, 0xb7, 0x11, 0x06, 0xef, 0x22, 0x0c, 0xde, 0x47, 0x58, 0xf9,
+	0x10, 0x61, 0xf0, 0x31, 0xc2, 0xca, 0xa7, 0x08, 0x83, 0xcf, 0x11, 0x56, 0x9e, 0xf6, 0xb0, 0xf2,
+	0xac, 0x87, 0xc1, 0xf3, 0x1e, 0x56, 0x5e, 0xf4, 0x30, 0x78, 0xd9, 0xc3, 0xca, 0xab, 0x1e, 0x56,
+	0x5e, 0xf7, 0x30, 0x78, 0xd3, 0xc3, 0xe0, 0xb8, 0x87, 0xc1, 0xcf, 0xdf, 0xda, 0x5e, 0x49, 0xec,
+	0x31, 0xb1, 0xe7, 0x70, 0x3b, 0x2c, 0x71, 0x26, 0x8e, 0xbc, 0x60, 0xbf, 0x3c, 0xfa, 0xbb, 0xea,
+	0xef, 0xdb, 0x65, 0x21, 0xb8, 0xbf, 0xbb, 0x3b, 0x2d, 0x87, 0xf9, 0xce, 0x7f, 0x01, 0x00, 0x00,
+	0xff, 0xff, 0xdc, 0x8d, 0x88, 0xdf, 0xbb, 0x08, 0x00, 0x00,
 }
 
 func (x LocationSource) String() string {
@@ -531,6 +554,18 @@ func (this *RxMetadata) Equal(that interface{}) bool {
 	if this.ChannelIndex != that1.ChannelIndex {
 		return false
 	}
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if this.RoundTripTime != nil && that1.RoundTripTime != nil {
+		if *this.RoundTripTime != *that1.RoundTripTime {
+			return false
+		}
+	} else if this.RoundTripTime != nil {
+		return false
+	} else if that1.RoundTripTime != nil {
+		return false
+	}
 	if !this.Advanced.Equal(that1.Advanced) {
 		return false
 	}
@@ -606,6 +641,27 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x9a
 	}
+	if m.RoundTripTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.RoundTripTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RoundTripTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMetadata(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
 	if m.ChannelIndex != 0 {
 		i = encodeVarintMetadata(dAtA, i, uint64(m.ChannelIndex))
 		i--
@@ -705,12 +761,12 @@ func (m *RxMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.Time != nil {
-		n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
-		if err4 != nil {
-			return 0, err4
+		n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n4
-		i = encodeVarintMetadata(dAtA, i, uint64(n4))
+		i -= n5
+		i = encodeVarintMetadata(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -951,6 +1007,14 @@ func (m *RxMetadata) Size() (n int) {
 	if m.ChannelIndex != 0 {
 		n += 2 + sovMetadata(uint64(m.ChannelIndex))
 	}
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 2 + l + sovMetadata(uint64(l))
+	}
+	if m.RoundTripTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.RoundTripTime)
+		n += 2 + l + sovMetadata(uint64(l))
+	}
 	if m.Advanced != nil {
 		l = m.Advanced.Size()
 		n += 2 + l + sovMetadata(uint64(l))
@@ -1010,6 +1074,8 @@ func (this *RxMetadata) String() string {
 		`UplinkToken:` + fmt.Sprintf("%v", this.UplinkToken) + `,`,
 		`SignalRSSI:` + strings.Replace(fmt.Sprintf("%v", this.SignalRSSI), "FloatValue", "types.FloatValue", 1) + `,`,
 		`ChannelIndex:` + fmt.Sprintf("%v", this.ChannelIndex) + `,`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`RoundTripTime:` + strings.Replace(fmt.Sprintf("%v", this.RoundTripTime), "Duration", "types.Duration", 1) + `,`,
 		`Advanced:` + strings.Replace(fmt.Sprintf("%v", this.Advanced), "Struct", "types.Struct", 1) + `,`,
 		`}`,
 	}, "")
@@ -1465,6 +1531,74 @@ func (m *RxMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundTripTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RoundTripTime == nil {
+				m.RoundTripTime = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.RoundTripTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Advanced", wireType)
@@ -12,6 +12,7 @@ var RxMetadataFieldPathsNested = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
@@ -21,6 +22,7 @@ var RxMetadataFieldPathsNested = []string{
 	"location.latitude",
 	"location.longitude",
 	"location.source",
+	"round_trip_time",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -40,8 +42,10 @@ var RxMetadataFieldPathsTopLevel = []string{
 	"encrypted_fine_timestamp_key_id",
 	"fine_timestamp",
 	"frequency_offset",
+	"frequency_plan_id",
 	"gateway_ids",
 	"location",
+	"round_trip_time",
 	"rssi",
 	"rssi_standard_deviation",
 	"signal_rssi",
@@ -192,6 +192,25 @@ func (dst *RxMetadata) SetFields(src *RxMetadata, paths ...string) error {
 				var zero uint32
 				dst.ChannelIndex = zero
 			}
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "round_trip_time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'round_trip_time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RoundTripTime = src.RoundTripTime
+			} else {
+				dst.RoundTripTime = nil
+			}
 		case "advanced":
 			if len(subs) > 0 {
 				return fmt.Errorf("'advanced' has no subfields, but %s were specified", subs)
@@ -11,9 +11,12 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+	time "time"
 
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	types "github.com/gogo/protobuf/types"
 	golang_proto "github.com/golang/protobuf/proto"
 	go_thethings_network_lorawan_stack_pkg_types "go.thethings.network/lorawan-stack/pkg/types"
@@ -28,6 +31,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -73,673 +77,2946 @@ func (m *GenerateDevAddrResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenerateDevAddrResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
-	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+// A violation of the regional parameters in the MAC state of an end device.
+type RegionalParametersViolation struct {
+	// Path of the MAC state field that violates the regional parameters.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Description of the violation.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Hint to remediate the violation.
+	Remediation          string   `protobuf:"bytes,3,opt,name=remediation,proto3" json:"remediation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
-}
-func init() {
-	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+func (m *RegionalParametersViolation) Reset()      { *m = RegionalParametersViolation{} }
+func (*RegionalParametersViolation) ProtoMessage() {}
+func (*RegionalParametersViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{1}
 }
-
-var fileDescriptor_c77e7504ad1081b8 = []byte{
-	// 714 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x4d, 0x48, 0x1b, 0x4f,
-	0x18, 0xc6, 0x67, 0xa2, 0xf8, 0xff, 0x33, 0x88, 0xd2, 0xa9, 0xf4, 0x23, 0x6d, 0xa7, 0x12, 0x2d,
-	0x15, 0xa9, 0xb3, 0x25, 0xf6, 0x50, 0xbc, 0x29, 0x09, 0xb1, 0x60, 0xa4, 0x6a, 0xbd, 0x78, 0x09,
-	0x9b, 0xec, 0xeb, 0x66, 0x49, 0x9c, 0xdd, 0xee, 0x4c, 0x22, 0x52, 0x04, 0xe9, 0xa1, 0x78, 0x2c,
-	0x94, 0x42, 0x8f, 0xa5, 0x27, 0x8f, 0xd2, 0x4b, 0x3d, 0x15, 0x8f, 0x1e, 0x85, 0x5e, 0xa4, 0x07,
-	0x31, 0xbb, 0x3d, 0x08, 0xbd, 0x78, 0xf4, 0x58, 0xb2, 0x49, 0x8c, 0xc9, 0x1a, 0xb1, 0x1f, 0xb7,
-	0x99, 0x9d, 0xe7, 0x7d, 0xe6, 0x37, 0x0f, 0xef, 0xbe, 0xe4, 0x41, 0xd1, 0x76, 0xf5, 0x55, 0x5d,
-	0x8c, 0x49, 0xa5, 0xe7, 0x0a, 0x9a, 0xee, 0x58, 0x9a, 0x00, 0xb5, 0x6a, 0xbb, 0x05, 0x09, 0x6e,
-	0x19, 0x5c, 0xee, 0xb8, 0xb6, 0xb2, 0x69, 0x9f, 0x52, 0x82, 0xd7, 0xa5, 0xbc, 0x3c, 0x1e, 0x1d,
-	0x33, 0x2d, 0x95, 0x2f, 0x65, 0x79, 0xce, 0x5e, 0xd1, 0x4c, 0xdb, 0xb4, 0xb5, 0x40, 0x96, 0x2d,
-	0x2d, 0x07, 0xbb, 0x60, 0x13, 0xac, 0x6a, 0xe5, 0xd1, 0xbb, 0xa6, 0x6d, 0x9b, 0x45, 0x08, 0xec,
-	0x75, 0x21, 0x6c, 0xa5, 0x2b, 0xcb, 0x16, 0xb2, 0x7e, 0x7a, 0xa7, 0x7e, 0x7a, 0xe6, 0x01, 0x2b,
-	0x8e, 0x5a, 0xab, 0x1f, 0xc6, 0xc2, 0x80, 0x20, 0x8c, 0x8c, 0x01, 0x65, 0x2b, 0x07, 0x75, 0xcd,
-	0x50, 0x58, 0x63, 0x19, 0x20, 0x94, 0xb5, 0x6c, 0x81, 0xdb, 0xb8, 0x65, 0x30, 0x2c, 0x5a, 0x01,
-	0x29, 0x75, 0x13, 0xea, 0x8a, 0x98, 0x20, 0x37, 0x53, 0x20, 0xc0, 0xd5, 0x15, 0x24, 0xa0, 0x3c,
-	0x69, 0x18, 0xee, 0x3c, 0x48, 0xc7, 0x16, 0x12, 0xe8, 0x02, 0xf9, 0xdf, 0x80, 0x72, 0x46, 0x37,
-	0x0c, 0xf7, 0x16, 0x1e, 0xc4, 0x23, 0xbd, 0x53, 0x4f, 0xbf, 0x1f, 0xde, 0x7f, 0x62, 0xda, 0x5c,
-	0xe5, 0x41, 0xe5, 0x2d, 0x61, 0x4a, 0x5e, 0xcf, 0x4d, 0x6b, 0xbd, 0xc7, 0x29, 0x98, 0x9a, 0x5a,
-	0x73, 0x40, 0xf2, 0x86, 0xe7, 0x7f, 0x46, 0x6d, 0x11, 0x4f, 0x93, 0xee, 0x94, 0x9c, 0x95, 0x34,
-	0x49, 0x7a, 0xa7, 0x75, 0x61, 0x14, 0x61, 0xd1, 0x29, 0x5a, 0xa2, 0x40, 0xef, 0xf1, 0xd6, 0xb4,
-	0x79, 0xed, 0x7b, 0xba, 0x46, 0x1b, 0xbd, 0xc1, 0x6b, 0x79, 0xf1, 0x46, 0x5e, 0x3c, 0x59, 0xcd,
-	0x2b, 0x7e, 0x18, 0x21, 0xdd, 0x93, 0x55, 0xbf, 0x19, 0xd2, 0x3f, 0x63, 0x89, 0xc2, 0xa4, 0xe3,
-	0x14, 0xad, 0x5c, 0x90, 0x34, 0xed, 0x50, 0x13, 0x0d, 0x5d, 0x75, 0xae, 0x68, 0xd1, 0x19, 0xc1,
-	0x8f, 0x31, 0x7d, 0x41, 0x06, 0x12, 0xf6, 0xaa, 0xa8, 0x12, 0xcc, 0x95, 0xa0, 0x04, 0xf3, 0xe0,
-	0x14, 0xf5, 0x1c, 0xd0, 0xe1, 0xf6, 0xd2, 0x36, 0xd5, 0xcb, 0x12, 0x48, 0xd5, 0x09, 0x96, 0xce,
-	0x91, 0x6b, 0x2d, 0xfa, 0xe7, 0x25, 0x99, 0xff, 0x4b, 0xcb, 0x4c, 0x9b, 0xe5, 0x8c, 0x25, 0x55,
-	0xd8, 0x32, 0x29, 0x8c, 0x44, 0xd0, 0x3b, 0xcf, 0x9a, 0x1d, 0x12, 0x1d, 0xbe, 0x24, 0x86, 0x86,
-	0xa7, 0x8c, 0xff, 0xec, 0x26, 0xd7, 0x67, 0xe5, 0x99, 0xc1, 0x3c, 0x98, 0x96, 0x54, 0xee, 0x1a,
-	0xfd, 0x8c, 0x49, 0x57, 0x0a, 0x14, 0x1d, 0x6a, 0x77, 0x49, 0x81, 0x3a, 0xa7, 0xae, 0xd1, 0xdf,
-	0xee, 0x08, 0x14, 0x2b, 0xbc, 0xfe, 0xf6, 0xe3, 0x5d, 0x04, 0x68, 0x4e, 0x13, 0x52, 0xd3, 0x9b,
-	0x04, 0x52, 0x7b, 0xd5, 0x6c, 0xf9, 0x8c, 0x65, 0x48, 0x7e, 0xee, 0xf0, 0x82, 0xfd, 0xba, 0x56,
-	0x93, 0x86, 0xeb, 0xce, 0x96, 0xeb, 0xf4, 0x4d, 0x84, 0x74, 0x2d, 0x5c, 0x04, 0xbd, 0xf0, 0x7b,
-	0xd0, 0x5f, 0x71, 0x40, 0xfd, 0x05, 0x47, 0x2f, 0xc5, 0xe6, 0x7f, 0x88, 0xcd, 0x5b, 0xb1, 0x27,
-	0xf0, 0xe8, 0x52, 0x3a, 0x36, 0xfd, 0xaf, 0x6e, 0x9a, 0xc0, 0xa3, 0xf4, 0x3d, 0x26, 0x3d, 0x09,
-	0x28, 0x82, 0x82, 0x2b, 0x36, 0x4b, 0x87, 0xfe, 0x8b, 0xa5, 0x83, 0x20, 0x52, 0xa3, 0xc9, 0x30,
-	0xdd, 0x95, 0x1f, 0xde, 0x7c, 0x69, 0x5c, 0x90, 0xc8, 0xac, 0xa4, 0x79, 0xd2, 0xdf, 0x36, 0x93,
-	0x3a, 0xfe, 0xcb, 0x0f, 0xc3, 0xed, 0x77, 0xe1, 0x30, 0x8b, 0x0d, 0x04, 0xa0, 0x7d, 0xb4, 0xb7,
-	0x0a, 0xda, 0x18, 0x6b, 0x53, 0x9f, 0xf0, 0x5e, 0x85, 0xe1, 0xfd, 0x0a, 0xc3, 0x07, 0x15, 0x86,
-	0x8e, 0x2a, 0x0c, 0x1d, 0x57, 0x18, 0x3a, 0xa9, 0x30, 0x74, 0x5a, 0x61, 0x78, 0xc3, 0x63, 0x78,
-	0xd3, 0x63, 0x68, 0xcb, 0x63, 0x78, 0xdb, 0x63, 0x68, 0xc7, 0x63, 0x68, 0xd7, 0x63, 0x68, 0xcf,
-	0x63, 0x78, 0xdf, 0x63, 0xf8, 0xc0, 0x63, 0xe8, 0xc8, 0x63, 0xf8, 0xd8, 0x63, 0xe8, 0xc4, 0x63,
-	0xf8, 0xd4, 0x63, 0x68, 0xc3, 0x67, 0x68, 0xd3, 0x67, 0xf8, 0xad, 0xcf, 0xd0, 0x07, 0x9f, 0xe1,
-	0x8f, 0x3e, 0x43, 0x5b, 0x3e, 0x43, 0xdb, 0x3e, 0xc3, 0x3b, 0x3e, 0xc3, 0xbb, 0x3e, 0xc3, 0x4b,
-	0x8f, 0xae, 0x3a, 0x43, 0x95, 0x70, 0xb2, 0xd9, 0x9e, 0xe0, 0xcd, 0xe3, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x99, 0xbd, 0xad, 0xb8, 0xb7, 0x06, 0x00, 0x00,
+func (m *RegionalParametersViolation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*GenerateDevAddrResponse)
-	if !ok {
-		that2, ok := that.(GenerateDevAddrResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if that1.DevAddr == nil {
-		if this.DevAddr != nil {
-			return false
+func (m *RegionalParametersViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionalParametersViolation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-	} else if !this.DevAddr.Equal(*that1.DevAddr) {
-		return false
+		return b[:n], nil
 	}
-	return true
+}
+func (m *RegionalParametersViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionalParametersViolation.Merge(m, src)
+}
+func (m *RegionalParametersViolation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegionalParametersViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionalParametersViolation.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_RegionalParametersViolation proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *RegionalParametersViolation) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
 
-// GsNsClient is the client API for GsNs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GsNsClient interface {
-	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+func (m *RegionalParametersViolation) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
 
-type gsNsClient struct {
-	cc *grpc.ClientConn
+func (m *RegionalParametersViolation) GetRemediation() string {
+	if m != nil {
+		return m.Remediation
+	}
+	return ""
 }
 
-func NewGsNsClient(cc *grpc.ClientConn) GsNsClient {
-	return &gsNsClient{cc}
+// The report of the compliance of the current MAC state of an end device with the regional parameters of its band.
+type RegionalParametersComplianceReport struct {
+	FrequencyPlanID   string     `protobuf:"bytes,1,opt,name=frequency_plan_id,json=frequencyPlanId,proto3" json:"frequency_plan_id,omitempty"`
+	BandID            string     `protobuf:"bytes,2,opt,name=band_id,json=bandId,proto3" json:"band_id,omitempty"`
+	LoRaWANPHYVersion PHYVersion `protobuf:"varint,3,opt,name=lorawan_phy_version,json=lorawanPhyVersion,proto3,enum=ttn.lorawan.v3.PHYVersion" json:"lorawan_phy_version,omitempty"`
+	// Violations of the regional parameters. Empty if the MAC state is compliant.
+	Violations           []*RegionalParametersViolation `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/HandleUplink", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RegionalParametersComplianceReport) Reset()      { *m = RegionalParametersComplianceReport{} }
+func (*RegionalParametersComplianceReport) ProtoMessage() {}
+func (*RegionalParametersComplianceReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{2}
+}
+func (m *RegionalParametersComplianceReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegionalParametersComplianceReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegionalParametersComplianceReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// GsNsServer is the server API for GsNs service.
-type GsNsServer interface {
-	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+func (m *RegionalParametersComplianceReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegionalParametersComplianceReport.Merge(m, src)
 }
-
-// UnimplementedGsNsServer can be embedded to have forward compatible implementations.
-type UnimplementedGsNsServer struct {
+func (m *RegionalParametersComplianceReport) XXX_Size() int {
+	return m.Size()
 }
-
-func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
+func (m *RegionalParametersComplianceReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegionalParametersComplianceReport.DiscardUnknown(m)
 }
 
-func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
-	s.RegisterService(&_GsNs_serviceDesc, srv)
-}
+var xxx_messageInfo_RegionalParametersComplianceReport proto.InternalMessageInfo
 
-func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UplinkMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GsNsServer).HandleUplink(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.GsNs/HandleUplink",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GsNsServer).HandleUplink(ctx, req.(*UplinkMessage))
+func (m *RegionalParametersComplianceReport) GetFrequencyPlanID() string {
+	if m != nil {
+		return m.FrequencyPlanID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _GsNs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.GsNs",
-	HandlerType: (*GsNsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "HandleUplink",
-			Handler:    _GsNs_HandleUplink_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+func (m *RegionalParametersComplianceReport) GetBandID() string {
+	if m != nil {
+		return m.BandID
+	}
+	return ""
 }
 
-// AsNsClient is the client API for AsNs service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AsNsClient interface {
-	LinkApplication(ctx context.Context, opts ...grpc.CallOption) (AsNs_LinkApplicationClient, error)
-	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
-	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+func (m *RegionalParametersComplianceReport) GetLoRaWANPHYVersion() PHYVersion {
+	if m != nil {
+		return m.LoRaWANPHYVersion
+	}
+	return PHY_UNKNOWN
 }
 
-type asNsClient struct {
-	cc *grpc.ClientConn
+func (m *RegionalParametersComplianceReport) GetViolations() []*RegionalParametersViolation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
 }
 
-func NewAsNsClient(cc *grpc.ClientConn) AsNsClient {
-	return &asNsClient{cc}
+// The MAC layer trace of an end device.
+type MACTrace struct {
+	// The trace entries, sorted by time.
+	Entries              []*MACTraceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *asNsClient) LinkApplication(ctx context.Context, opts ...grpc.CallOption) (AsNs_LinkApplicationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_AsNs_serviceDesc.Streams[0], "/ttn.lorawan.v3.AsNs/LinkApplication", opts...)
-	if err != nil {
-		return nil, err
+func (m *MACTrace) Reset()      { *m = MACTrace{} }
+func (*MACTrace) ProtoMessage() {}
+func (*MACTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{3}
+}
+func (m *MACTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MACTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MACTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	x := &asNsLinkApplicationClient{stream}
-	return x, nil
 }
-
-type AsNs_LinkApplicationClient interface {
-	Send(*types.Empty) error
-	Recv() (*ApplicationUp, error)
-	grpc.ClientStream
+func (m *MACTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MACTrace.Merge(m, src)
+}
+func (m *MACTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MACTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MACTrace.DiscardUnknown(m)
 }
 
-type asNsLinkApplicationClient struct {
-	grpc.ClientStream
+var xxx_messageInfo_MACTrace proto.InternalMessageInfo
+
+func (m *MACTrace) GetEntries() []*MACTraceEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
 }
 
-func (x *asNsLinkApplicationClient) Send(m *types.Empty) error {
-	return x.ClientStream.SendMsg(m)
+type SetEndDeviceLifecycleStatesRequest struct {
+	ApplicationIDs       ApplicationIdentifiers  `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	DeviceIDs            []string                `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	LifecycleState       EndDeviceLifecycleState `protobuf:"varint,3,opt,name=lifecycle_state,json=lifecycleState,proto3,enum=ttn.lorawan.v3.EndDeviceLifecycleState" json:"lifecycle_state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (x *asNsLinkApplicationClient) Recv() (*ApplicationUp, error) {
-	m := new(ApplicationUp)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SetEndDeviceLifecycleStatesRequest) Reset()      { *m = SetEndDeviceLifecycleStatesRequest{} }
+func (*SetEndDeviceLifecycleStatesRequest) ProtoMessage() {}
+func (*SetEndDeviceLifecycleStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{4}
+}
+func (m *SetEndDeviceLifecycleStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetEndDeviceLifecycleStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetEndDeviceLifecycleStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *SetEndDeviceLifecycleStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEndDeviceLifecycleStatesRequest.Merge(m, src)
+}
+func (m *SetEndDeviceLifecycleStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetEndDeviceLifecycleStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEndDeviceLifecycleStatesRequest.DiscardUnknown(m)
 }
 
-func (c *asNsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SetEndDeviceLifecycleStatesRequest proto.InternalMessageInfo
+
+func (m *SetEndDeviceLifecycleStatesRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	return out, nil
+	return ApplicationIdentifiers{}
 }
 
-func (c *asNsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueuePush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetEndDeviceLifecycleStatesRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *asNsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
-	out := new(ApplicationDownlinks)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SetEndDeviceLifecycleStatesRequest) GetLifecycleState() EndDeviceLifecycleState {
+	if m != nil {
+		return m.LifecycleState
 	}
-	return out, nil
+	return EndDeviceLifecycleState_LIFECYCLE_ACTIVE
 }
 
-// AsNsServer is the server API for AsNs service.
-type AsNsServer interface {
-	LinkApplication(AsNs_LinkApplicationServer) error
-	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
-	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+type GetEndDeviceBulkRequest struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// The devices of the application to get.
+	DeviceIDs            []string        `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	FieldMask            types.FieldMask `protobuf:"bytes,3,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-// UnimplementedAsNsServer can be embedded to have forward compatible implementations.
-type UnimplementedAsNsServer struct {
+func (m *GetEndDeviceBulkRequest) Reset()      { *m = GetEndDeviceBulkRequest{} }
+func (*GetEndDeviceBulkRequest) ProtoMessage() {}
+func (*GetEndDeviceBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{5}
 }
-
-func (*UnimplementedAsNsServer) LinkApplication(srv AsNs_LinkApplicationServer) error {
-	return status.Errorf(codes.Unimplemented, "method LinkApplication not implemented")
+func (m *GetEndDeviceBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedAsNsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+func (m *GetEndDeviceBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEndDeviceBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedAsNsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+func (m *GetEndDeviceBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEndDeviceBulkRequest.Merge(m, src)
 }
-func (*UnimplementedAsNsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+func (m *GetEndDeviceBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEndDeviceBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEndDeviceBulkRequest.DiscardUnknown(m)
 }
 
-func RegisterAsNsServer(s *grpc.Server, srv AsNsServer) {
-	s.RegisterService(&_AsNs_serviceDesc, srv)
+var xxx_messageInfo_GetEndDeviceBulkRequest proto.InternalMessageInfo
+
+func (m *GetEndDeviceBulkRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return ApplicationIdentifiers{}
 }
 
-func _AsNs_LinkApplication_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(AsNsServer).LinkApplication(&asNsLinkApplicationServer{stream})
+func (m *GetEndDeviceBulkRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
+	}
+	return nil
 }
 
-type AsNs_LinkApplicationServer interface {
-	Send(*ApplicationUp) error
-	Recv() (*types.Empty, error)
-	grpc.ServerStream
+func (m *GetEndDeviceBulkRequest) GetFieldMask() types.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return types.FieldMask{}
 }
 
-type asNsLinkApplicationServer struct {
-	grpc.ServerStream
+type SetEndDeviceBulkRequest struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// The devices to create or update. The devices must belong to the application.
+	EndDevices           []*SetEndDeviceRequest `protobuf:"bytes,2,rep,name=end_devices,json=endDevices,proto3" json:"end_devices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (x *asNsLinkApplicationServer) Send(m *ApplicationUp) error {
-	return x.ServerStream.SendMsg(m)
+func (m *SetEndDeviceBulkRequest) Reset()      { *m = SetEndDeviceBulkRequest{} }
+func (*SetEndDeviceBulkRequest) ProtoMessage() {}
+func (*SetEndDeviceBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{6}
 }
-
-func (x *asNsLinkApplicationServer) Recv() (*types.Empty, error) {
-	m := new(types.Empty)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *SetEndDeviceBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetEndDeviceBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetEndDeviceBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return m, nil
+}
+func (m *SetEndDeviceBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEndDeviceBulkRequest.Merge(m, src)
+}
+func (m *SetEndDeviceBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetEndDeviceBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEndDeviceBulkRequest.DiscardUnknown(m)
 }
 
-func _AsNs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueueReplace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace",
+var xxx_messageInfo_SetEndDeviceBulkRequest proto.InternalMessageInfo
+
+func (m *SetEndDeviceBulkRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+	return ApplicationIdentifiers{}
+}
+
+func (m *SetEndDeviceBulkRequest) GetEndDevices() []*SetEndDeviceRequest {
+	if m != nil {
+		return m.EndDevices
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _AsNs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DownlinkQueueRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type DeleteEndDeviceBulkRequest struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// The devices of the application to delete.
+	DeviceIDs            []string `protobuf:"bytes,2,rep,name=device_ids,json=deviceIds,proto3" json:"device_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteEndDeviceBulkRequest) Reset()      { *m = DeleteEndDeviceBulkRequest{} }
+func (*DeleteEndDeviceBulkRequest) ProtoMessage() {}
+func (*DeleteEndDeviceBulkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{7}
+}
+func (m *DeleteEndDeviceBulkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteEndDeviceBulkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteEndDeviceBulkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueuePush(ctx, in)
+}
+func (m *DeleteEndDeviceBulkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteEndDeviceBulkRequest.Merge(m, src)
+}
+func (m *DeleteEndDeviceBulkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteEndDeviceBulkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteEndDeviceBulkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteEndDeviceBulkRequest proto.InternalMessageInfo
+
+func (m *DeleteEndDeviceBulkRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueuePush",
+	return ApplicationIdentifiers{}
+}
+
+func (m *DeleteEndDeviceBulkRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+	return nil
+}
+
+// The result of a bulk operation for an end device.
+type EndDeviceBulkResult struct {
+	EndDeviceIDs EndDeviceIdentifiers `protobuf:"bytes,1,opt,name=end_device_ids,json=endDeviceIds,proto3" json:"end_device_ids"`
+	// The end device. This is only set for bulk get and set.
+	EndDevice *EndDevice `protobuf:"bytes,2,opt,name=end_device,json=endDevice,proto3" json:"end_device,omitempty"`
+	// The error if the operation failed for this end device.
+	Error                *ErrorDetails `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *EndDeviceBulkResult) Reset()      { *m = EndDeviceBulkResult{} }
+func (*EndDeviceBulkResult) ProtoMessage() {}
+func (*EndDeviceBulkResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{8}
+}
+func (m *EndDeviceBulkResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceBulkResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceBulkResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EndDeviceBulkResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceBulkResult.Merge(m, src)
+}
+func (m *EndDeviceBulkResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceBulkResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceBulkResult.DiscardUnknown(m)
 }
 
-func _AsNs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_EndDeviceBulkResult proto.InternalMessageInfo
+
+func (m *EndDeviceBulkResult) GetEndDeviceIDs() EndDeviceIdentifiers {
+	if m != nil {
+		return m.EndDeviceIDs
 	}
-	if interceptor == nil {
-		return srv.(AsNsServer).DownlinkQueueList(ctx, in)
+	return EndDeviceIdentifiers{}
+}
+
+func (m *EndDeviceBulkResult) GetEndDevice() *EndDevice {
+	if m != nil {
+		return m.EndDevice
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueList",
+	return nil
+}
+
+func (m *EndDeviceBulkResult) GetError() *ErrorDetails {
+	if m != nil {
+		return m.Error
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AsNsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
+	return nil
+}
+
+type EndDeviceBulkResults struct {
+	// The results, in the order of the requested end devices.
+	Results              []*EndDeviceBulkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *EndDeviceBulkResults) Reset()      { *m = EndDeviceBulkResults{} }
+func (*EndDeviceBulkResults) ProtoMessage() {}
+func (*EndDeviceBulkResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{9}
+}
+func (m *EndDeviceBulkResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EndDeviceBulkResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EndDeviceBulkResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EndDeviceBulkResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndDeviceBulkResults.Merge(m, src)
+}
+func (m *EndDeviceBulkResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *EndDeviceBulkResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndDeviceBulkResults.DiscardUnknown(m)
 }
 
-var _AsNs_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.AsNs",
-	HandlerType: (*AsNsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "DownlinkQueueReplace",
-			Handler:    _AsNs_DownlinkQueueReplace_Handler,
-		},
-		{
-			MethodName: "DownlinkQueuePush",
-			Handler:    _AsNs_DownlinkQueuePush_Handler,
-		},
-		{
-			MethodName: "DownlinkQueueList",
-			Handler:    _AsNs_DownlinkQueueList_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "LinkApplication",
-			Handler:       _AsNs_LinkApplication_Handler,
-			ServerStreams: true,
-			ClientStreams: true,
-		},
-	},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+var xxx_messageInfo_EndDeviceBulkResults proto.InternalMessageInfo
+
+func (m *EndDeviceBulkResults) GetResults() []*EndDeviceBulkResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-// NsEndDeviceRegistryClient is the client API for NsEndDeviceRegistry service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsEndDeviceRegistryClient interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+// The emergency broadcast mode of an application in the Network Server.
+type EmergencyBroadcast struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// Reason of the emergency broadcast, for the audit trail.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The admin that started the emergency broadcast.
+	StartedBy            *OrganizationOrUserIdentifiers `protobuf:"bytes,3,opt,name=started_by,json=startedBy,proto3" json:"started_by,omitempty"`
+	StartedAt            time.Time                      `protobuf:"bytes,4,opt,name=started_at,json=startedAt,proto3,stdtime" json:"started_at"`
+	EndsAt               time.Time                      `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-type nsEndDeviceRegistryClient struct {
-	cc *grpc.ClientConn
+func (m *EmergencyBroadcast) Reset()      { *m = EmergencyBroadcast{} }
+func (*EmergencyBroadcast) ProtoMessage() {}
+func (*EmergencyBroadcast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{10}
+}
+func (m *EmergencyBroadcast) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmergencyBroadcast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmergencyBroadcast.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmergencyBroadcast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmergencyBroadcast.Merge(m, src)
+}
+func (m *EmergencyBroadcast) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmergencyBroadcast) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmergencyBroadcast.DiscardUnknown(m)
 }
 
-func NewNsEndDeviceRegistryClient(cc *grpc.ClientConn) NsEndDeviceRegistryClient {
-	return &nsEndDeviceRegistryClient{cc}
+var xxx_messageInfo_EmergencyBroadcast proto.InternalMessageInfo
+
+func (m *EmergencyBroadcast) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return ApplicationIdentifiers{}
 }
 
-func (c *nsEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EmergencyBroadcast) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *nsEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
-	out := new(EndDevice)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EmergencyBroadcast) GetStartedBy() *OrganizationOrUserIdentifiers {
+	if m != nil {
+		return m.StartedBy
 	}
-	return out, nil
+	return nil
 }
 
-func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EmergencyBroadcast) GetStartedAt() time.Time {
+	if m != nil {
+		return m.StartedAt
 	}
-	return out, nil
+	return time.Time{}
 }
 
-// NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
-type NsEndDeviceRegistryServer interface {
-	// Get returns the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
-	// Set creates or updates the device.
-	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
-	// Delete deletes the device that matches the given identifiers.
-	// If there are multiple matches, an error will be returned.
-	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+func (m *EmergencyBroadcast) GetEndsAt() time.Time {
+	if m != nil {
+		return m.EndsAt
+	}
+	return time.Time{}
 }
 
-// UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
-type UnimplementedNsEndDeviceRegistryServer struct {
+type StartEmergencyBroadcastRequest struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// Reason of the emergency broadcast, for the audit trail.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Time at which the emergency broadcast ends. If not set, the emergency broadcast ends after one hour.
+	// The emergency broadcast lasts at most 24 hours.
+	EndsAt               *time.Time `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3,stdtime" json:"ends_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (*UnimplementedNsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (m *StartEmergencyBroadcastRequest) Reset()      { *m = StartEmergencyBroadcastRequest{} }
+func (*StartEmergencyBroadcastRequest) ProtoMessage() {}
+func (*StartEmergencyBroadcastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{11}
 }
-func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (m *StartEmergencyBroadcastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (m *StartEmergencyBroadcastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartEmergencyBroadcastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartEmergencyBroadcastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartEmergencyBroadcastRequest.Merge(m, src)
+}
+func (m *StartEmergencyBroadcastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartEmergencyBroadcastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartEmergencyBroadcastRequest.DiscardUnknown(m)
 }
 
-func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
-	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
+var xxx_messageInfo_StartEmergencyBroadcastRequest proto.InternalMessageInfo
+
+func (m *StartEmergencyBroadcastRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
+	}
+	return ApplicationIdentifiers{}
 }
 
-func _NsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *StartEmergencyBroadcastRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _NsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetEndDeviceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Set(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Set",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
+func (m *StartEmergencyBroadcastRequest) GetEndsAt() *time.Time {
+	if m != nil {
+		return m.EndsAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EndDeviceIdentifiers)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(NsEndDeviceRegistryServer).Delete(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
-	}
-	return interceptor(ctx, in, info, handler)
+type StopEmergencyBroadcastRequest struct {
+	ApplicationIDs ApplicationIdentifiers `protobuf:"bytes,1,opt,name=application_ids,json=applicationIds,proto3" json:"application_ids"`
+	// Reason of stopping the emergency broadcast, for the audit trail.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
-	HandlerType: (*NsEndDeviceRegistryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _NsEndDeviceRegistry_Get_Handler,
-		},
-		{
-			MethodName: "Set",
-			Handler:    _NsEndDeviceRegistry_Set_Handler,
-		},
-		{
-			MethodName: "Delete",
-			Handler:    _NsEndDeviceRegistry_Delete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+func (m *StopEmergencyBroadcastRequest) Reset()      { *m = StopEmergencyBroadcastRequest{} }
+func (*StopEmergencyBroadcastRequest) ProtoMessage() {}
+func (*StopEmergencyBroadcastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c77e7504ad1081b8, []int{12}
 }
-
-// NsClient is the client API for Ns service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NsClient interface {
-	// GenerateDevAddr requests a device address assignment from the Network Server.
-	GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error)
+func (m *StopEmergencyBroadcastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type nsClient struct {
-	cc *grpc.ClientConn
+func (m *StopEmergencyBroadcastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StopEmergencyBroadcastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewNsClient(cc *grpc.ClientConn) NsClient {
-	return &nsClient{cc}
+func (m *StopEmergencyBroadcastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopEmergencyBroadcastRequest.Merge(m, src)
+}
+func (m *StopEmergencyBroadcastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StopEmergencyBroadcastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopEmergencyBroadcastRequest.DiscardUnknown(m)
 }
 
-func (c *nsClient) GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error) {
-	out := new(GenerateDevAddrResponse)
-	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/GenerateDevAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StopEmergencyBroadcastRequest proto.InternalMessageInfo
+
+func (m *StopEmergencyBroadcastRequest) GetApplicationIDs() ApplicationIdentifiers {
+	if m != nil {
+		return m.ApplicationIDs
 	}
-	return out, nil
+	return ApplicationIdentifiers{}
 }
 
-// NsServer is the server API for Ns service.
-type NsServer interface {
-	// GenerateDevAddr requests a device address assignment from the Network Server.
-	GenerateDevAddr(context.Context, *types.Empty) (*GenerateDevAddrResponse, error)
+func (m *StopEmergencyBroadcastRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// UnimplementedNsServer can be embedded to have forward compatible implementations.
-type UnimplementedNsServer struct {
+func init() {
+	proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	golang_proto.RegisterType((*GenerateDevAddrResponse)(nil), "ttn.lorawan.v3.GenerateDevAddrResponse")
+	proto.RegisterType((*RegionalParametersViolation)(nil), "ttn.lorawan.v3.RegionalParametersViolation")
+	golang_proto.RegisterType((*RegionalParametersViolation)(nil), "ttn.lorawan.v3.RegionalParametersViolation")
+	proto.RegisterType((*RegionalParametersComplianceReport)(nil), "ttn.lorawan.v3.RegionalParametersComplianceReport")
+	golang_proto.RegisterType((*RegionalParametersComplianceReport)(nil), "ttn.lorawan.v3.RegionalParametersComplianceReport")
+	proto.RegisterType((*MACTrace)(nil), "ttn.lorawan.v3.MACTrace")
+	golang_proto.RegisterType((*MACTrace)(nil), "ttn.lorawan.v3.MACTrace")
+	proto.RegisterType((*SetEndDeviceLifecycleStatesRequest)(nil), "ttn.lorawan.v3.SetEndDeviceLifecycleStatesRequest")
+	golang_proto.RegisterType((*SetEndDeviceLifecycleStatesRequest)(nil), "ttn.lorawan.v3.SetEndDeviceLifecycleStatesRequest")
+	proto.RegisterType((*GetEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.GetEndDeviceBulkRequest")
+	golang_proto.RegisterType((*GetEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.GetEndDeviceBulkRequest")
+	proto.RegisterType((*SetEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.SetEndDeviceBulkRequest")
+	golang_proto.RegisterType((*SetEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.SetEndDeviceBulkRequest")
+	proto.RegisterType((*DeleteEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.DeleteEndDeviceBulkRequest")
+	golang_proto.RegisterType((*DeleteEndDeviceBulkRequest)(nil), "ttn.lorawan.v3.DeleteEndDeviceBulkRequest")
+	proto.RegisterType((*EndDeviceBulkResult)(nil), "ttn.lorawan.v3.EndDeviceBulkResult")
+	golang_proto.RegisterType((*EndDeviceBulkResult)(nil), "ttn.lorawan.v3.EndDeviceBulkResult")
+	proto.RegisterType((*EndDeviceBulkResults)(nil), "ttn.lorawan.v3.EndDeviceBulkResults")
+	golang_proto.RegisterType((*EndDeviceBulkResults)(nil), "ttn.lorawan.v3.EndDeviceBulkResults")
+	proto.RegisterType((*EmergencyBroadcast)(nil), "ttn.lorawan.v3.EmergencyBroadcast")
+	golang_proto.RegisterType((*EmergencyBroadcast)(nil), "ttn.lorawan.v3.EmergencyBroadcast")
+	proto.RegisterType((*StartEmergencyBroadcastRequest)(nil), "ttn.lorawan.v3.StartEmergencyBroadcastRequest")
+	golang_proto.RegisterType((*StartEmergencyBroadcastRequest)(nil), "ttn.lorawan.v3.StartEmergencyBroadcastRequest")
+	proto.RegisterType((*StopEmergencyBroadcastRequest)(nil), "ttn.lorawan.v3.StopEmergencyBroadcastRequest")
+	golang_proto.RegisterType((*StopEmergencyBroadcastRequest)(nil), "ttn.lorawan.v3.StopEmergencyBroadcastRequest")
 }
 
-func (*UnimplementedNsServer) GenerateDevAddr(ctx context.Context, req *types.Empty) (*GenerateDevAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+func init() {
+	proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
+}
+func init() {
+	golang_proto.RegisterFile("lorawan-stack/api/networkserver.proto", fileDescriptor_c77e7504ad1081b8)
 }
 
-func RegisterNsServer(s *grpc.Server, srv NsServer) {
-	s.RegisterService(&_Ns_serviceDesc, srv)
+var fileDescriptor_c77e7504ad1081b8 = []byte{
+	// 1765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5f, 0x6c, 0x1c, 0x47,
+	0x19, 0xbf, 0x39, 0xff, 0x1f, 0x1b, 0x3b, 0x19, 0x9b, 0xc4, 0x5c, 0x9b, 0x3d, 0x6b, 0x13, 0x68,
+	0x64, 0xf0, 0x1d, 0xba, 0x22, 0x28, 0x91, 0x50, 0xe5, 0xcd, 0xb9, 0x4e, 0x8a, 0x9d, 0x38, 0x7b,
+	0x71, 0x0b, 0x79, 0x39, 0xc6, 0xb7, 0x9f, 0xcf, 0xab, 0xdb, 0xdb, 0x5d, 0x66, 0xe6, 0x2e, 0x1c,
+	0xa8, 0x52, 0x55, 0x24, 0x54, 0xf1, 0x54, 0x81, 0x90, 0x78, 0x44, 0x48, 0x40, 0x79, 0x8b, 0xfa,
+	0x00, 0x15, 0x48, 0xd0, 0x17, 0xa4, 0xf0, 0x16, 0x89, 0x97, 0x0a, 0x21, 0x53, 0xef, 0x81, 0x28,
+	0x6f, 0x79, 0xac, 0xf2, 0x84, 0x76, 0x76, 0xd7, 0xb7, 0xb7, 0x7b, 0xe7, 0x5e, 0xe3, 0x48, 0xa4,
+	0x6f, 0xbb, 0x33, 0xdf, 0xf7, 0x9b, 0xdf, 0xf7, 0x9b, 0x99, 0x6f, 0xbe, 0x19, 0xfc, 0x79, 0xcb,
+	0x61, 0xf4, 0x2e, 0xb5, 0xd7, 0xb8, 0xa0, 0xb5, 0x46, 0x91, 0xba, 0x66, 0xd1, 0x06, 0x71, 0xd7,
+	0x61, 0x0d, 0x0e, 0xac, 0x0d, 0xac, 0xe0, 0x32, 0x47, 0x38, 0x64, 0x5e, 0x08, 0xbb, 0x10, 0x9a,
+	0x16, 0xda, 0xcf, 0xe7, 0xd6, 0xeb, 0xa6, 0x38, 0x68, 0xed, 0x15, 0x6a, 0x4e, 0xb3, 0x08, 0x76,
+	0xdb, 0xe9, 0xb8, 0xcc, 0xf9, 0x5e, 0xa7, 0x28, 0x8d, 0x6b, 0x6b, 0x75, 0xb0, 0xd7, 0xda, 0xd4,
+	0x32, 0x0d, 0x2a, 0xa0, 0x98, 0xfa, 0x08, 0x20, 0x73, 0x6b, 0x31, 0x88, 0xba, 0x53, 0x77, 0x02,
+	0xe7, 0xbd, 0xd6, 0xbe, 0xfc, 0x93, 0x3f, 0xf2, 0x2b, 0x34, 0x7f, 0xb6, 0xee, 0x38, 0x75, 0x0b,
+	0x24, 0x43, 0x6a, 0xdb, 0x8e, 0xa0, 0xc2, 0x74, 0x6c, 0x1e, 0xf6, 0x3e, 0x13, 0xf6, 0x1e, 0x63,
+	0x40, 0xd3, 0x15, 0x9d, 0xb0, 0x73, 0x25, 0xd9, 0xb9, 0x6f, 0x82, 0x65, 0x54, 0x9b, 0x94, 0x37,
+	0x42, 0x8b, 0x7c, 0xd2, 0x42, 0x98, 0x4d, 0xe0, 0x82, 0x36, 0xdd, 0xd0, 0x40, 0x4d, 0xcb, 0x04,
+	0xb6, 0x51, 0x35, 0xa0, 0x6d, 0xd6, 0xa2, 0x80, 0x2e, 0x0c, 0xb0, 0x61, 0xcc, 0x09, 0x25, 0xcc,
+	0x5d, 0x4c, 0x77, 0x9b, 0x06, 0xd8, 0xc2, 0xdc, 0x37, 0x81, 0x45, 0x71, 0xe4, 0xd3, 0x46, 0x91,
+	0xea, 0x61, 0x2c, 0x69, 0x83, 0x26, 0x70, 0x4e, 0xeb, 0x10, 0x42, 0xa8, 0x36, 0x3e, 0xbf, 0x09,
+	0x36, 0x30, 0x2a, 0xa0, 0x0c, 0xed, 0x75, 0xc3, 0x60, 0x3a, 0x70, 0xd7, 0xb1, 0x39, 0x90, 0x0a,
+	0x9e, 0x36, 0xa0, 0x5d, 0xa5, 0x86, 0xc1, 0x96, 0xd1, 0x0a, 0xba, 0x3c, 0xa7, 0xbd, 0xf0, 0xf7,
+	0xc3, 0xfc, 0x57, 0xea, 0x4e, 0x41, 0x1c, 0x80, 0x38, 0x30, 0xed, 0x3a, 0x2f, 0x84, 0xb3, 0x5f,
+	0xec, 0x1f, 0xc7, 0x6d, 0xd4, 0x8b, 0xa2, 0xe3, 0x02, 0x2f, 0x44, 0x98, 0x53, 0x46, 0xf0, 0xa1,
+	0xde, 0xc5, 0xcf, 0xe8, 0x50, 0x37, 0x1d, 0x9b, 0x5a, 0x3b, 0x94, 0xd1, 0x26, 0x08, 0x60, 0xfc,
+	0x15, 0xd3, 0xb1, 0xe4, 0x04, 0x91, 0x25, 0x3c, 0x21, 0xe5, 0x96, 0x03, 0xce, 0xe8, 0xc1, 0x0f,
+	0x59, 0xc1, 0xb3, 0x06, 0xf0, 0x1a, 0x33, 0x5d, 0xdf, 0x68, 0x39, 0x2b, 0xfb, 0xe2, 0x4d, 0xbe,
+	0x05, 0x83, 0x26, 0x18, 0xa6, 0x84, 0x59, 0x1e, 0x0b, 0x2c, 0x62, 0x4d, 0xea, 0x1f, 0xb2, 0x58,
+	0x4d, 0x8f, 0x7c, 0xd5, 0x69, 0xba, 0x96, 0x49, 0xed, 0x1a, 0xe8, 0xe0, 0x3a, 0x4c, 0x90, 0x17,
+	0xf1, 0xd9, 0x7d, 0x06, 0xdf, 0x6d, 0x81, 0x5d, 0xeb, 0x54, 0x5d, 0x8b, 0xda, 0x55, 0x33, 0x24,
+	0xa3, 0x2d, 0x7a, 0x87, 0xf9, 0x85, 0x97, 0xa2, 0xce, 0x1d, 0x8b, 0xda, 0xd7, 0xcb, 0xfa, 0xc2,
+	0x7e, 0x5f, 0x83, 0x41, 0x2e, 0xe2, 0xa9, 0x3d, 0x6a, 0x1b, 0xbe, 0x9b, 0xe4, 0xa9, 0x61, 0xef,
+	0x30, 0x3f, 0xa9, 0x51, 0xdb, 0xb8, 0x5e, 0xd6, 0x27, 0xfd, 0xae, 0xeb, 0x06, 0xa1, 0x78, 0x31,
+	0x54, 0xac, 0xea, 0x1e, 0x74, 0xaa, 0x6d, 0x60, 0x3c, 0xa2, 0x3d, 0x5f, 0xca, 0x15, 0xfa, 0xb7,
+	0x4f, 0x61, 0xe7, 0xda, 0xb7, 0x5f, 0x09, 0x2c, 0xb4, 0xcf, 0x7a, 0x87, 0xf9, 0xb3, 0x5b, 0x8e,
+	0x4e, 0x5f, 0x5d, 0xbf, 0xd1, 0x6b, 0xd6, 0xcf, 0x86, 0xd6, 0x3b, 0x07, 0x9d, 0xb0, 0x89, 0x7c,
+	0x13, 0xe3, 0x76, 0x24, 0x2b, 0x5f, 0x1e, 0x5f, 0x19, 0xbb, 0x3c, 0x5b, 0xfa, 0x62, 0x12, 0xf9,
+	0x84, 0xa9, 0xd0, 0x63, 0xee, 0xea, 0x55, 0x3c, 0xbd, 0xbd, 0x7e, 0xf5, 0x36, 0xa3, 0x35, 0x20,
+	0x5f, 0xc3, 0x53, 0x60, 0x0b, 0x66, 0x02, 0x5f, 0x46, 0x12, 0xf5, 0x42, 0x12, 0x35, 0x32, 0xdd,
+	0xb0, 0x05, 0xeb, 0xe8, 0x91, 0xb5, 0xfa, 0xdb, 0x2c, 0x56, 0x2b, 0x20, 0x36, 0x6c, 0xa3, 0x2c,
+	0x37, 0xc2, 0x96, 0xb9, 0x0f, 0xb5, 0x4e, 0xcd, 0x82, 0x8a, 0xa0, 0x02, 0xb8, 0xee, 0xeb, 0xc8,
+	0x05, 0x71, 0xf0, 0x02, 0x75, 0x5d, 0xcb, 0xac, 0xc9, 0xb1, 0xab, 0xa6, 0xc1, 0xa5, 0xfe, 0xb3,
+	0xa5, 0x2f, 0x24, 0xc7, 0x59, 0xef, 0x99, 0x5d, 0xef, 0xed, 0x0d, 0x4d, 0x79, 0xa4, 0x4d, 0xfc,
+	0x18, 0x65, 0xcf, 0xa0, 0xfb, 0x87, 0xf9, 0x8c, 0x77, 0x98, 0x9f, 0x8f, 0xdb, 0x95, 0xb9, 0x3e,
+	0x4f, 0xe3, 0x7e, 0x9c, 0x7c, 0x15, 0xe3, 0x60, 0x67, 0xca, 0xb1, 0xb2, 0x2b, 0x63, 0x97, 0x67,
+	0xb4, 0xf3, 0x8f, 0xb4, 0xa9, 0x9f, 0xa0, 0xf1, 0x69, 0x74, 0xc6, 0xf0, 0x0e, 0xf3, 0x33, 0x01,
+	0x61, 0xdf, 0x79, 0x26, 0x30, 0xf5, 0xfd, 0xee, 0xe0, 0x05, 0x2b, 0x0a, 0xa1, 0xca, 0xfd, 0x18,
+	0xc2, 0x09, 0x7c, 0x2e, 0x49, 0x74, 0x48, 0xc8, 0xda, 0xf4, 0x23, 0x6d, 0xe2, 0x0d, 0x9f, 0xa9,
+	0x3e, 0x6f, 0xf5, 0xf5, 0xa8, 0x3f, 0xcc, 0xfa, 0xfb, 0xb2, 0xa7, 0x95, 0xd6, 0xb2, 0x1a, 0x9f,
+	0x3a, 0x81, 0x5e, 0xc4, 0xb8, 0x97, 0x3b, 0xa5, 0x36, 0xb3, 0xa5, 0x5c, 0x21, 0x48, 0x9e, 0x85,
+	0x28, 0x79, 0x16, 0x5e, 0xf2, 0x4d, 0xb6, 0x29, 0x6f, 0x68, 0xe3, 0x3e, 0x1f, 0x7d, 0x66, 0x3f,
+	0x6a, 0x50, 0xff, 0x81, 0xf0, 0xf9, 0xca, 0xd3, 0xa2, 0xc2, 0x0e, 0x9e, 0xed, 0x25, 0xf1, 0x40,
+	0x86, 0xd9, 0xd2, 0xc5, 0xe4, 0x60, 0x71, 0xba, 0x21, 0x55, 0x0d, 0xf7, 0xb4, 0xd2, 0x31, 0x44,
+	0xbd, 0x5c, 0xfd, 0x0b, 0xc2, 0xb9, 0x32, 0x58, 0x20, 0xe0, 0x53, 0x3d, 0xcf, 0xea, 0xbf, 0x11,
+	0x5e, 0x4c, 0x44, 0xc0, 0x5b, 0x96, 0x20, 0xdf, 0xc1, 0xf3, 0x3d, 0xc5, 0x62, 0xfc, 0x2f, 0x0d,
+	0xdd, 0x1f, 0x71, 0xf6, 0x4b, 0x21, 0xeb, 0xb9, 0x5e, 0x6f, 0x99, 0xeb, 0x73, 0xd0, 0xb3, 0xe5,
+	0xe4, 0x05, 0x8c, 0x7b, 0x23, 0xc8, 0x7c, 0x3b, 0x5b, 0xfa, 0xdc, 0x50, 0x74, 0x7d, 0xe6, 0xd8,
+	0x99, 0x94, 0xf0, 0x84, 0x3c, 0x6e, 0xc3, 0x65, 0xf9, 0x6c, 0xca, 0xc9, 0xef, 0x2c, 0x83, 0xa0,
+	0xa6, 0xc5, 0xf5, 0xc0, 0x54, 0xdd, 0xc5, 0x4b, 0x03, 0xc2, 0xe4, 0xe4, 0x1b, 0x78, 0x8a, 0x05,
+	0x9f, 0x61, 0x46, 0xbc, 0x38, 0x94, 0x42, 0xcf, 0x4d, 0x8f, 0x7c, 0xd4, 0xff, 0x64, 0x31, 0xd9,
+	0x68, 0x02, 0xab, 0xfb, 0xa7, 0x88, 0xc6, 0x1c, 0x6a, 0xd4, 0x28, 0x17, 0xa4, 0x7e, 0xda, 0xe9,
+	0x3f, 0x37, 0xe2, 0xb4, 0x9f, 0xc3, 0x93, 0x0c, 0x28, 0x3f, 0x3e, 0x58, 0xc3, 0x3f, 0xb2, 0x85,
+	0x31, 0x17, 0x94, 0x09, 0x30, 0xaa, 0x7b, 0x9d, 0x50, 0xa7, 0xb5, 0xe4, 0xd8, 0x37, 0x59, 0x9d,
+	0xda, 0xe6, 0xf7, 0x25, 0xd8, 0x4d, 0xb6, 0xcb, 0x81, 0xc5, 0x28, 0xe8, 0x33, 0x21, 0x80, 0xd6,
+	0x21, 0x57, 0x7b, 0x68, 0x54, 0x2c, 0x8f, 0x0f, 0x49, 0x06, 0xb7, 0xa3, 0x4a, 0x4a, 0x9b, 0xf6,
+	0xd9, 0xbf, 0xf5, 0xcf, 0x3c, 0x3a, 0x06, 0x59, 0x17, 0xbe, 0xd2, 0x60, 0x1b, 0xdc, 0x47, 0x98,
+	0xf8, 0x04, 0x08, 0x93, 0xbe, 0xd3, 0xba, 0x50, 0x1f, 0x22, 0xac, 0x54, 0x7c, 0xb0, 0xb4, 0xdc,
+	0xff, 0xb7, 0x4d, 0xa7, 0xf6, 0xab, 0x2f, 0x93, 0x05, 0x9b, 0x38, 0x83, 0x96, 0x1f, 0x2c, 0x1c,
+	0xcf, 0xc4, 0xd7, 0x7b, 0x61, 0x8f, 0x7d, 0x6c, 0xd8, 0xe3, 0x7d, 0x21, 0xbf, 0x83, 0xf0, 0x85,
+	0x8a, 0x70, 0xdc, 0xa7, 0x28, 0xe2, 0x95, 0x44, 0xc4, 0xfe, 0x29, 0xc8, 0xc6, 0x62, 0xf1, 0x96,
+	0xb6, 0xf1, 0xf8, 0x26, 0xbf, 0xc1, 0xc9, 0x06, 0x9e, 0xbb, 0x46, 0x6d, 0xc3, 0x82, 0x5d, 0xd7,
+	0x32, 0xed, 0x06, 0x49, 0x55, 0x1a, 0x41, 0xfb, 0x76, 0x50, 0xd2, 0xe6, 0xce, 0xa5, 0x54, 0xd9,
+	0xf0, 0xeb, 0xfa, 0xd2, 0x61, 0x16, 0x8f, 0xaf, 0xfb, 0x78, 0x5b, 0x78, 0x61, 0xcb, 0xb4, 0x1b,
+	0x31, 0x7e, 0x64, 0x88, 0x4f, 0xee, 0xc2, 0x09, 0xc1, 0xef, 0xba, 0x97, 0xd1, 0x97, 0x11, 0xb9,
+	0x8d, 0x97, 0xca, 0xce, 0x5d, 0xdb, 0x67, 0x70, 0xab, 0x05, 0x2d, 0xbf, 0x82, 0xb4, 0xfc, 0x02,
+	0x29, 0x95, 0xde, 0x12, 0x56, 0x52, 0xf6, 0x61, 0x64, 0xc9, 0x2d, 0x7c, 0xb6, 0xcf, 0x7e, 0xa7,
+	0xc5, 0x0f, 0x4e, 0x09, 0x59, 0x4d, 0x40, 0x6e, 0x99, 0x5c, 0x90, 0x91, 0x92, 0x70, 0xee, 0xd2,
+	0x09, 0x32, 0x44, 0x98, 0xbc, 0xf4, 0xab, 0xcf, 0xe0, 0xc5, 0x1b, 0x3c, 0x76, 0xee, 0xd5, 0x4d,
+	0x2e, 0x58, 0x87, 0xbc, 0x83, 0xf0, 0xd8, 0x26, 0x08, 0x92, 0xca, 0x87, 0x9b, 0xe9, 0x53, 0x32,
+	0x37, 0x3c, 0x6f, 0xab, 0x8d, 0x37, 0xfe, 0xf6, 0xaf, 0x9f, 0x66, 0x81, 0xd4, 0x8a, 0x36, 0x2f,
+	0xc6, 0x56, 0x13, 0x2f, 0xfe, 0xa0, 0xff, 0x80, 0x29, 0x24, 0x56, 0x72, 0xe2, 0xff, 0xb5, 0x62,
+	0x78, 0x7a, 0xa7, 0xfc, 0x8e, 0x3f, 0x5f, 0x23, 0x3f, 0xca, 0xe2, 0xb1, 0xca, 0x20, 0xd2, 0x95,
+	0x4f, 0x46, 0xfa, 0x4f, 0x48, 0xb2, 0xfe, 0x3d, 0xca, 0x9d, 0x48, 0xbb, 0xf0, 0x98, 0xb4, 0x0b,
+	0xfd, 0xb4, 0xaf, 0xa0, 0xd5, 0x3b, 0xdb, 0xea, 0xb5, 0x27, 0x35, 0xd2, 0x15, 0xb4, 0x4a, 0x7e,
+	0x86, 0xf0, 0x64, 0x50, 0x9e, 0x8c, 0xb8, 0x58, 0x86, 0xac, 0x3f, 0x75, 0x5b, 0x0a, 0xb1, 0xb9,
+	0xba, 0x91, 0x66, 0x37, 0x72, 0xe0, 0xb1, 0x09, 0xfa, 0x2b, 0xc2, 0x8b, 0x9b, 0x20, 0x52, 0x57,
+	0xb7, 0xd1, 0x48, 0x96, 0x3e, 0xfe, 0x0e, 0x94, 0x44, 0x56, 0xbf, 0x25, 0x03, 0xd0, 0xc9, 0xce,
+	0x13, 0x09, 0xa0, 0x58, 0x3b, 0xc6, 0x27, 0x7f, 0x44, 0x98, 0x54, 0x40, 0x24, 0xae, 0x42, 0xa4,
+	0x74, 0xd2, 0xda, 0x1b, 0x7c, 0x6f, 0xca, 0xe5, 0x86, 0x86, 0xcf, 0xd5, 0x5d, 0x19, 0xc0, 0x4d,
+	0xf5, 0xe5, 0xc7, 0x0f, 0x20, 0x71, 0xd5, 0x91, 0x2b, 0xe4, 0x37, 0x08, 0x4f, 0x6d, 0x82, 0xf0,
+	0x8b, 0x1a, 0xf2, 0xdc, 0x49, 0x7b, 0x3c, 0x56, 0xd6, 0xe6, 0x2e, 0x8d, 0x50, 0x1c, 0xf1, 0x68,
+	0xcd, 0xa8, 0xda, 0xe3, 0x33, 0xde, 0x6b, 0x59, 0x8d, 0x62, 0x1d, 0x44, 0xc4, 0xb4, 0x32, 0x8c,
+	0x69, 0xe5, 0xa9, 0x60, 0xca, 0x03, 0xa6, 0xf7, 0x10, 0xc6, 0xc1, 0xae, 0x93, 0x64, 0x57, 0x53,
+	0x99, 0x7f, 0xe8, 0x85, 0x61, 0x44, 0xbe, 0x3b, 0x92, 0xef, 0xcb, 0xea, 0xc6, 0x29, 0xf9, 0x1a,
+	0x92, 0x88, 0x4f, 0xf9, 0xd7, 0x08, 0xcf, 0x6e, 0x82, 0x38, 0x7e, 0x21, 0x18, 0x6d, 0x23, 0x2e,
+	0x0f, 0x7b, 0x36, 0x50, 0x5f, 0x95, 0x0c, 0x6f, 0x91, 0x9b, 0x4f, 0x66, 0xbb, 0x35, 0x69, 0xad,
+	0x2a, 0x7c, 0xe0, 0xd2, 0x7f, 0xc7, 0x70, 0xf6, 0x06, 0x27, 0x07, 0x78, 0x21, 0xf1, 0xe6, 0x35,
+	0xb4, 0x0c, 0x18, 0xb0, 0xaa, 0x07, 0x3e, 0x96, 0xa9, 0x4b, 0x92, 0xf3, 0x3c, 0x99, 0xf3, 0x39,
+	0x47, 0xcf, 0x66, 0xe4, 0xcf, 0xfe, 0x05, 0x76, 0x70, 0xc1, 0x49, 0x0a, 0xa9, 0x65, 0x78, 0x62,
+	0x65, 0x9a, 0x53, 0x53, 0xaa, 0xa6, 0x4c, 0x4f, 0x33, 0xb7, 0x10, 0xa1, 0x55, 0xf7, 0x22, 0x38,
+	0x7f, 0x6e, 0x7f, 0x87, 0xf0, 0xb9, 0xc1, 0xf5, 0x23, 0x59, 0x4b, 0x07, 0x70, 0x42, 0x9d, 0x39,
+	0xf4, 0x74, 0x38, 0x45, 0x6e, 0x1a, 0xc0, 0xb9, 0xc8, 0x85, 0xe3, 0x5e, 0x41, 0xab, 0xda, 0x2f,
+	0xd1, 0xfd, 0x23, 0x05, 0x3d, 0x38, 0x52, 0xd0, 0xfb, 0x47, 0x4a, 0xe6, 0x83, 0x23, 0x25, 0xf3,
+	0xe1, 0x91, 0x92, 0x79, 0x78, 0xa4, 0x64, 0x3e, 0x3a, 0x52, 0xd0, 0xeb, 0x9e, 0x82, 0xde, 0xf4,
+	0x94, 0xcc, 0xdb, 0x9e, 0x82, 0xee, 0x79, 0x4a, 0xe6, 0x5d, 0x4f, 0xc9, 0xbc, 0xe7, 0x29, 0x99,
+	0xfb, 0x9e, 0x82, 0x1e, 0x78, 0x0a, 0x7a, 0xdf, 0x53, 0x32, 0x1f, 0x78, 0x0a, 0xfa, 0xd0, 0x53,
+	0x32, 0x0f, 0x3d, 0x05, 0x7d, 0xe4, 0x29, 0x99, 0xd7, 0xbb, 0x4a, 0xe6, 0xcd, 0xae, 0x82, 0xde,
+	0xea, 0x2a, 0x99, 0x9f, 0x77, 0x15, 0xf4, 0x8b, 0xae, 0x92, 0x79, 0xbb, 0xab, 0x64, 0xee, 0x75,
+	0x15, 0xf4, 0x6e, 0x57, 0x41, 0xef, 0x75, 0x15, 0x74, 0xe7, 0x4b, 0xa3, 0x3e, 0x8f, 0x0a, 0xdb,
+	0xdd, 0xdb, 0x9b, 0x94, 0x5a, 0x3c, 0xff, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0x06, 0x40,
+	0x3c, 0x58, 0x17, 0x00, 0x00,
 }
 
-func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (this *GenerateDevAddrResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if interceptor == nil {
-		return srv.(NsServer).GenerateDevAddr(ctx, in)
+
+	that1, ok := that.(*GenerateDevAddrResponse)
+	if !ok {
+		that2, ok := that.(GenerateDevAddrResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ttn.lorawan.v3.Ns/GenerateDevAddr",
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(NsServer).GenerateDevAddr(ctx, req.(*types.Empty))
+	if that1.DevAddr == nil {
+		if this.DevAddr != nil {
+			return false
+		}
+	} else if !this.DevAddr.Equal(*that1.DevAddr) {
+		return false
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Ns_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ttn.lorawan.v3.Ns",
-	HandlerType: (*NsServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenerateDevAddr",
-			Handler:    _Ns_GenerateDevAddr_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lorawan-stack/api/networkserver.proto",
+	return true
 }
-
-func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *RegionalParametersViolation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DevAddr != nil {
-		{
-			size := m.DevAddr.Size()
-			i -= size
-			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	that1, ok := that.(*RegionalParametersViolation)
+	if !ok {
+		that2, ok := that.(RegionalParametersViolation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Remediation != that1.Remediation {
+		return false
+	}
+	return true
 }
+func (this *RegionalParametersComplianceReport) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNetworkserver(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*RegionalParametersComplianceReport)
+	if !ok {
+		that2, ok := that.(RegionalParametersComplianceReport)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *GenerateDevAddrResponse {
-	this := &GenerateDevAddrResponse{}
-	this.DevAddr = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
-	if !easy && r.Intn(10) != 0 {
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return this
+	if this.FrequencyPlanID != that1.FrequencyPlanID {
+		return false
+	}
+	if this.BandID != that1.BandID {
+		return false
+	}
+	if this.LoRaWANPHYVersion != that1.LoRaWANPHYVersion {
+		return false
+	}
+	if len(this.Violations) != len(that1.Violations) {
+		return false
+	}
+	for i := range this.Violations {
+		if !this.Violations[i].Equal(that1.Violations[i]) {
+			return false
+		}
+	}
+	return true
 }
+func (this *MACTrace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-type randyNetworkserver interface {
-	Float32() float32
+	that1, ok := that.(*MACTrace)
+	if !ok {
+		that2, ok := that.(MACTrace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetEndDeviceLifecycleStatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetEndDeviceLifecycleStatesRequest)
+	if !ok {
+		that2, ok := that.(SetEndDeviceLifecycleStatesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if len(this.DeviceIDs) != len(that1.DeviceIDs) {
+		return false
+	}
+	for i := range this.DeviceIDs {
+		if this.DeviceIDs[i] != that1.DeviceIDs[i] {
+			return false
+		}
+	}
+	if this.LifecycleState != that1.LifecycleState {
+		return false
+	}
+	return true
+}
+func (this *GetEndDeviceBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetEndDeviceBulkRequest)
+	if !ok {
+		that2, ok := that.(GetEndDeviceBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if len(this.DeviceIDs) != len(that1.DeviceIDs) {
+		return false
+	}
+	for i := range this.DeviceIDs {
+		if this.DeviceIDs[i] != that1.DeviceIDs[i] {
+			return false
+		}
+	}
+	if !this.FieldMask.Equal(&that1.FieldMask) {
+		return false
+	}
+	return true
+}
+func (this *SetEndDeviceBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetEndDeviceBulkRequest)
+	if !ok {
+		that2, ok := that.(SetEndDeviceBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if len(this.EndDevices) != len(that1.EndDevices) {
+		return false
+	}
+	for i := range this.EndDevices {
+		if !this.EndDevices[i].Equal(that1.EndDevices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DeleteEndDeviceBulkRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteEndDeviceBulkRequest)
+	if !ok {
+		that2, ok := that.(DeleteEndDeviceBulkRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if len(this.DeviceIDs) != len(that1.DeviceIDs) {
+		return false
+	}
+	for i := range this.DeviceIDs {
+		if this.DeviceIDs[i] != that1.DeviceIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EndDeviceBulkResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceBulkResult)
+	if !ok {
+		that2, ok := that.(EndDeviceBulkResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.EndDeviceIDs.Equal(&that1.EndDeviceIDs) {
+		return false
+	}
+	if !this.EndDevice.Equal(that1.EndDevice) {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *EndDeviceBulkResults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EndDeviceBulkResults)
+	if !ok {
+		that2, ok := that.(EndDeviceBulkResults)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if !this.Results[i].Equal(that1.Results[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EmergencyBroadcast) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmergencyBroadcast)
+	if !ok {
+		that2, ok := that.(EmergencyBroadcast)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if !this.StartedBy.Equal(that1.StartedBy) {
+		return false
+	}
+	if !this.StartedAt.Equal(that1.StartedAt) {
+		return false
+	}
+	if !this.EndsAt.Equal(that1.EndsAt) {
+		return false
+	}
+	return true
+}
+func (this *StartEmergencyBroadcastRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartEmergencyBroadcastRequest)
+	if !ok {
+		that2, ok := that.(StartEmergencyBroadcastRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if that1.EndsAt == nil {
+		if this.EndsAt != nil {
+			return false
+		}
+	} else if !this.EndsAt.Equal(*that1.EndsAt) {
+		return false
+	}
+	return true
+}
+func (this *StopEmergencyBroadcastRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StopEmergencyBroadcastRequest)
+	if !ok {
+		that2, ok := that.(StopEmergencyBroadcastRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.ApplicationIDs.Equal(&that1.ApplicationIDs) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GsNsClient is the client API for GsNs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GsNsClient interface {
+	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type gsNsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGsNsClient(cc *grpc.ClientConn) GsNsClient {
+	return &gsNsClient{cc}
+}
+
+func (c *gsNsClient) HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.GsNs/HandleUplink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GsNsServer is the server API for GsNs service.
+type GsNsServer interface {
+	HandleUplink(context.Context, *UplinkMessage) (*types.Empty, error)
+}
+
+// UnimplementedGsNsServer can be embedded to have forward compatible implementations.
+type UnimplementedGsNsServer struct {
+}
+
+func (*UnimplementedGsNsServer) HandleUplink(ctx context.Context, req *UplinkMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleUplink not implemented")
+}
+
+func RegisterGsNsServer(s *grpc.Server, srv GsNsServer) {
+	s.RegisterService(&_GsNs_serviceDesc, srv)
+}
+
+func _GsNs_HandleUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UplinkMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).HandleUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.GsNs/HandleUplink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).HandleUplink(ctx, req.(*UplinkMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GsNs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GsNs",
+	HandlerType: (*GsNsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HandleUplink",
+			Handler:    _GsNs_HandleUplink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// AsNsClient is the client API for AsNs service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AsNsClient interface {
+	LinkApplication(ctx context.Context, opts ...grpc.CallOption) (AsNs_LinkApplicationClient, error)
+	DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error)
+	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+}
+
+type asNsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAsNsClient(cc *grpc.ClientConn) AsNsClient {
+	return &asNsClient{cc}
+}
+
+func (c *asNsClient) LinkApplication(ctx context.Context, opts ...grpc.CallOption) (AsNs_LinkApplicationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AsNs_serviceDesc.Streams[0], "/ttn.lorawan.v3.AsNs/LinkApplication", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &asNsLinkApplicationClient{stream}
+	return x, nil
+}
+
+type AsNs_LinkApplicationClient interface {
+	Send(*types.Empty) error
+	Recv() (*ApplicationUp, error)
+	grpc.ClientStream
+}
+
+type asNsLinkApplicationClient struct {
+	grpc.ClientStream
+}
+
+func (x *asNsLinkApplicationClient) Send(m *types.Empty) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *asNsLinkApplicationClient) Recv() (*ApplicationUp, error) {
+	m := new(ApplicationUp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *asNsClient) DownlinkQueueReplace(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asNsClient) DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueuePush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asNsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error) {
+	out := new(ApplicationDownlinks)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.AsNs/DownlinkQueueList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AsNsServer is the server API for AsNs service.
+type AsNsServer interface {
+	LinkApplication(AsNs_LinkApplicationServer) error
+	DownlinkQueueReplace(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*types.Empty, error)
+	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+}
+
+// UnimplementedAsNsServer can be embedded to have forward compatible implementations.
+type UnimplementedAsNsServer struct {
+}
+
+func (*UnimplementedAsNsServer) LinkApplication(srv AsNs_LinkApplicationServer) error {
+	return status.Errorf(codes.Unimplemented, "method LinkApplication not implemented")
+}
+func (*UnimplementedAsNsServer) DownlinkQueueReplace(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueReplace not implemented")
+}
+func (*UnimplementedAsNsServer) DownlinkQueuePush(ctx context.Context, req *DownlinkQueueRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueuePush not implemented")
+}
+func (*UnimplementedAsNsServer) DownlinkQueueList(ctx context.Context, req *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
+}
+
+func RegisterAsNsServer(s *grpc.Server, srv AsNsServer) {
+	s.RegisterService(&_AsNs_serviceDesc, srv)
+}
+
+func _AsNs_LinkApplication_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AsNsServer).LinkApplication(&asNsLinkApplicationServer{stream})
+}
+
+type AsNs_LinkApplicationServer interface {
+	Send(*ApplicationUp) error
+	Recv() (*types.Empty, error)
+	grpc.ServerStream
+}
+
+type asNsLinkApplicationServer struct {
+	grpc.ServerStream
+}
+
+func (x *asNsLinkApplicationServer) Send(m *ApplicationUp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *asNsLinkApplicationServer) Recv() (*types.Empty, error) {
+	m := new(types.Empty)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _AsNs_DownlinkQueueReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueueReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueueReplace(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsNs_DownlinkQueuePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownlinkQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueuePush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueuePush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueuePush(ctx, req.(*DownlinkQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AsNs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).DownlinkQueueList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.AsNs/DownlinkQueueList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).DownlinkQueueList(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AsNs_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.AsNs",
+	HandlerType: (*AsNsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DownlinkQueueReplace",
+			Handler:    _AsNs_DownlinkQueueReplace_Handler,
+		},
+		{
+			MethodName: "DownlinkQueuePush",
+			Handler:    _AsNs_DownlinkQueuePush_Handler,
+		},
+		{
+			MethodName: "DownlinkQueueList",
+			Handler:    _AsNs_DownlinkQueueList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LinkApplication",
+			Handler:       _AsNs_LinkApplication_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// NsEndDeviceRegistryClient is the client API for NsEndDeviceRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsEndDeviceRegistryClient interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error)
+	// GetComplianceReport audits the current MAC state of the device against the regional parameters of its band.
+	// The report contains the violations, such as channels, data rates and Rx2 settings that are not allowed in the band,
+	// with hints to remediate them. This is useful when devices are moved between regions.
+	GetComplianceReport(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*RegionalParametersComplianceReport, error)
+	// SetLifecycleStates sets the lifecycle state of the given devices of the application.
+	// The transitions of all devices are validated before any device is updated.
+	SetLifecycleStates(ctx context.Context, in *SetEndDeviceLifecycleStatesRequest, opts ...grpc.CallOption) (*EndDevices, error)
+	// GetBulk returns the devices of the application that match the given device IDs.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	GetBulk(ctx context.Context, in *GetEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error)
+	// SetBulk creates or updates the devices of the application.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	SetBulk(ctx context.Context, in *SetEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error)
+	// DeleteBulk deletes the devices of the application that match the given device IDs.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	DeleteBulk(ctx context.Context, in *DeleteEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error)
+	// GetMACTrace returns the MAC layer trace of the recent data messages of the device: the MAC commands that the
+	// Network Server parsed from uplink messages and generated for downlink messages, and how it scheduled the downlink
+	// messages. The trace is only stored if the Network Server is configured to store it.
+	GetMACTrace(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MACTrace, error)
+}
+
+type nsEndDeviceRegistryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsEndDeviceRegistryClient(cc *grpc.ClientConn) NsEndDeviceRegistryClient {
+	return &nsEndDeviceRegistryClient{cc}
+}
+
+func (c *nsEndDeviceRegistryClient) Get(ctx context.Context, in *GetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) Set(ctx context.Context, in *SetEndDeviceRequest, opts ...grpc.CallOption) (*EndDevice, error) {
+	out := new(EndDevice)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) Delete(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetComplianceReport(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*RegionalParametersComplianceReport, error) {
+	out := new(RegionalParametersComplianceReport)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetComplianceReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) SetLifecycleStates(ctx context.Context, in *SetEndDeviceLifecycleStatesRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/SetLifecycleStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetBulk(ctx context.Context, in *GetEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error) {
+	out := new(EndDeviceBulkResults)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) SetBulk(ctx context.Context, in *SetEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error) {
+	out := new(EndDeviceBulkResults)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/SetBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) DeleteBulk(ctx context.Context, in *DeleteEndDeviceBulkRequest, opts ...grpc.CallOption) (*EndDeviceBulkResults, error) {
+	out := new(EndDeviceBulkResults)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/DeleteBulk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsEndDeviceRegistryClient) GetMACTrace(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*MACTrace, error) {
+	out := new(MACTrace)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.NsEndDeviceRegistry/GetMACTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsEndDeviceRegistryServer is the server API for NsEndDeviceRegistry service.
+type NsEndDeviceRegistryServer interface {
+	// Get returns the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Get(context.Context, *GetEndDeviceRequest) (*EndDevice, error)
+	// Set creates or updates the device.
+	Set(context.Context, *SetEndDeviceRequest) (*EndDevice, error)
+	// Delete deletes the device that matches the given identifiers.
+	// If there are multiple matches, an error will be returned.
+	Delete(context.Context, *EndDeviceIdentifiers) (*types.Empty, error)
+	// GetComplianceReport audits the current MAC state of the device against the regional parameters of its band.
+	// The report contains the violations, such as channels, data rates and Rx2 settings that are not allowed in the band,
+	// with hints to remediate them. This is useful when devices are moved between regions.
+	GetComplianceReport(context.Context, *EndDeviceIdentifiers) (*RegionalParametersComplianceReport, error)
+	// SetLifecycleStates sets the lifecycle state of the given devices of the application.
+	// The transitions of all devices are validated before any device is updated.
+	SetLifecycleStates(context.Context, *SetEndDeviceLifecycleStatesRequest) (*EndDevices, error)
+	// GetBulk returns the devices of the application that match the given device IDs.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	GetBulk(context.Context, *GetEndDeviceBulkRequest) (*EndDeviceBulkResults, error)
+	// SetBulk creates or updates the devices of the application.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	SetBulk(context.Context, *SetEndDeviceBulkRequest) (*EndDeviceBulkResults, error)
+	// DeleteBulk deletes the devices of the application that match the given device IDs.
+	// The result of each device is reported separately; a failure for one device does not affect the others.
+	DeleteBulk(context.Context, *DeleteEndDeviceBulkRequest) (*EndDeviceBulkResults, error)
+	// GetMACTrace returns the MAC layer trace of the recent data messages of the device: the MAC commands that the
+	// Network Server parsed from uplink messages and generated for downlink messages, and how it scheduled the downlink
+	// messages. The trace is only stored if the Network Server is configured to store it.
+	GetMACTrace(context.Context, *EndDeviceIdentifiers) (*MACTrace, error)
+}
+
+// UnimplementedNsEndDeviceRegistryServer can be embedded to have forward compatible implementations.
+type UnimplementedNsEndDeviceRegistryServer struct {
+}
+
+func (*UnimplementedNsEndDeviceRegistryServer) Get(ctx context.Context, req *GetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) Set(ctx context.Context, req *SetEndDeviceRequest) (*EndDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) Delete(ctx context.Context, req *EndDeviceIdentifiers) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetComplianceReport(ctx context.Context, req *EndDeviceIdentifiers) (*RegionalParametersComplianceReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComplianceReport not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) SetLifecycleStates(ctx context.Context, req *SetEndDeviceLifecycleStatesRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLifecycleStates not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetBulk(ctx context.Context, req *GetEndDeviceBulkRequest) (*EndDeviceBulkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBulk not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) SetBulk(ctx context.Context, req *SetEndDeviceBulkRequest) (*EndDeviceBulkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBulk not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) DeleteBulk(ctx context.Context, req *DeleteEndDeviceBulkRequest) (*EndDeviceBulkResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBulk not implemented")
+}
+func (*UnimplementedNsEndDeviceRegistryServer) GetMACTrace(ctx context.Context, req *EndDeviceIdentifiers) (*MACTrace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMACTrace not implemented")
+}
+
+func RegisterNsEndDeviceRegistryServer(s *grpc.Server, srv NsEndDeviceRegistryServer) {
+	s.RegisterService(&_NsEndDeviceRegistry_serviceDesc, srv)
+}
+
+func _NsEndDeviceRegistry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Get(ctx, req.(*GetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Set(ctx, req.(*SetEndDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).Delete(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetComplianceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetComplianceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetComplianceReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetComplianceReport(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_SetLifecycleStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceLifecycleStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).SetLifecycleStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/SetLifecycleStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).SetLifecycleStates(ctx, req.(*SetEndDeviceLifecycleStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEndDeviceBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetBulk(ctx, req.(*GetEndDeviceBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_SetBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEndDeviceBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).SetBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/SetBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).SetBulk(ctx, req.(*SetEndDeviceBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_DeleteBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEndDeviceBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).DeleteBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/DeleteBulk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).DeleteBulk(ctx, req.(*DeleteEndDeviceBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsEndDeviceRegistry_GetMACTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EndDeviceIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceRegistryServer).GetMACTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.NsEndDeviceRegistry/GetMACTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceRegistryServer).GetMACTrace(ctx, req.(*EndDeviceIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NsEndDeviceRegistry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsEndDeviceRegistry",
+	HandlerType: (*NsEndDeviceRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _NsEndDeviceRegistry_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _NsEndDeviceRegistry_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NsEndDeviceRegistry_Delete_Handler,
+		},
+		{
+			MethodName: "GetComplianceReport",
+			Handler:    _NsEndDeviceRegistry_GetComplianceReport_Handler,
+		},
+		{
+			MethodName: "SetLifecycleStates",
+			Handler:    _NsEndDeviceRegistry_SetLifecycleStates_Handler,
+		},
+		{
+			MethodName: "GetBulk",
+			Handler:    _NsEndDeviceRegistry_GetBulk_Handler,
+		},
+		{
+			MethodName: "SetBulk",
+			Handler:    _NsEndDeviceRegistry_SetBulk_Handler,
+		},
+		{
+			MethodName: "DeleteBulk",
+			Handler:    _NsEndDeviceRegistry_DeleteBulk_Handler,
+		},
+		{
+			MethodName: "GetMACTrace",
+			Handler:    _NsEndDeviceRegistry_GetMACTrace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+// NsClient is the client API for Ns service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NsClient interface {
+	// GenerateDevAddr requests a device address assignment from the Network Server.
+	GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error)
+	// StartEmergencyBroadcast starts the emergency broadcast mode of the application.
+	// While the mode is active, application downlink messages with the highest priority pre-empt the other downlink
+	// messages of the end devices of the application. Only admins can start the emergency broadcast mode.
+	StartEmergencyBroadcast(ctx context.Context, in *StartEmergencyBroadcastRequest, opts ...grpc.CallOption) (*EmergencyBroadcast, error)
+	// StopEmergencyBroadcast stops the emergency broadcast mode of the application.
+	// Only admins can stop the emergency broadcast mode.
+	StopEmergencyBroadcast(ctx context.Context, in *StopEmergencyBroadcastRequest, opts ...grpc.CallOption) (*types.Empty, error)
+}
+
+type nsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNsClient(cc *grpc.ClientConn) NsClient {
+	return &nsClient{cc}
+}
+
+func (c *nsClient) GenerateDevAddr(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*GenerateDevAddrResponse, error) {
+	out := new(GenerateDevAddrResponse)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/GenerateDevAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) StartEmergencyBroadcast(ctx context.Context, in *StartEmergencyBroadcastRequest, opts ...grpc.CallOption) (*EmergencyBroadcast, error) {
+	out := new(EmergencyBroadcast)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/StartEmergencyBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsClient) StopEmergencyBroadcast(ctx context.Context, in *StopEmergencyBroadcastRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/ttn.lorawan.v3.Ns/StopEmergencyBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsServer is the server API for Ns service.
+type NsServer interface {
+	// GenerateDevAddr requests a device address assignment from the Network Server.
+	GenerateDevAddr(context.Context, *types.Empty) (*GenerateDevAddrResponse, error)
+	// StartEmergencyBroadcast starts the emergency broadcast mode of the application.
+	// While the mode is active, application downlink messages with the highest priority pre-empt the other downlink
+	// messages of the end devices of the application. Only admins can start the emergency broadcast mode.
+	StartEmergencyBroadcast(context.Context, *StartEmergencyBroadcastRequest) (*EmergencyBroadcast, error)
+	// StopEmergencyBroadcast stops the emergency broadcast mode of the application.
+	// Only admins can stop the emergency broadcast mode.
+	StopEmergencyBroadcast(context.Context, *StopEmergencyBroadcastRequest) (*types.Empty, error)
+}
+
+// UnimplementedNsServer can be embedded to have forward compatible implementations.
+type UnimplementedNsServer struct {
+}
+
+func (*UnimplementedNsServer) GenerateDevAddr(ctx context.Context, req *types.Empty) (*GenerateDevAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDevAddr not implemented")
+}
+func (*UnimplementedNsServer) StartEmergencyBroadcast(ctx context.Context, req *StartEmergencyBroadcastRequest) (*EmergencyBroadcast, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEmergencyBroadcast not implemented")
+}
+func (*UnimplementedNsServer) StopEmergencyBroadcast(ctx context.Context, req *StopEmergencyBroadcastRequest) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopEmergencyBroadcast not implemented")
+}
+
+func RegisterNsServer(s *grpc.Server, srv NsServer) {
+	s.RegisterService(&_Ns_serviceDesc, srv)
+}
+
+func _Ns_GenerateDevAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).GenerateDevAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Ns/GenerateDevAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).GenerateDevAddr(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_StartEmergencyBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEmergencyBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).StartEmergencyBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Ns/StartEmergencyBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).StartEmergencyBroadcast(ctx, req.(*StartEmergencyBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ns_StopEmergencyBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopEmergencyBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).StopEmergencyBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ttn.lorawan.v3.Ns/StopEmergencyBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).StopEmergencyBroadcast(ctx, req.(*StopEmergencyBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Ns_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.Ns",
+	HandlerType: (*NsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateDevAddr",
+			Handler:    _Ns_GenerateDevAddr_Handler,
+		},
+		{
+			MethodName: "StartEmergencyBroadcast",
+			Handler:    _Ns_StartEmergencyBroadcast_Handler,
+		},
+		{
+			MethodName: "StopEmergencyBroadcast",
+			Handler:    _Ns_StopEmergencyBroadcast_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
+func (m *GenerateDevAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenerateDevAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenerateDevAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		{
+			size := m.DevAddr.Size()
+			i -= size
+			if _, err := m.DevAddr.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionalParametersViolation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionalParametersViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionalParametersViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remediation) > 0 {
+		i -= len(m.Remediation)
+		copy(dAtA[i:], m.Remediation)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Remediation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegionalParametersComplianceReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegionalParametersComplianceReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegionalParametersComplianceReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Violations) > 0 {
+		for iNdEx := len(m.Violations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Violations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.LoRaWANPHYVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BandID) > 0 {
+		i -= len(m.BandID)
+		copy(dAtA[i:], m.BandID)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.BandID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FrequencyPlanID) > 0 {
+		i -= len(m.FrequencyPlanID)
+		copy(dAtA[i:], m.FrequencyPlanID)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.FrequencyPlanID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MACTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MACTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MACTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetEndDeviceLifecycleStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetEndDeviceLifecycleStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetEndDeviceLifecycleStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LifecycleState != 0 {
+		i = encodeVarintNetworkserver(dAtA, i, uint64(m.LifecycleState))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeviceIDs) > 0 {
+		for iNdEx := len(m.DeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceIDs[iNdEx])
+			copy(dAtA[i:], m.DeviceIDs[iNdEx])
+			i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.DeviceIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEndDeviceBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEndDeviceBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEndDeviceBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FieldMask.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DeviceIDs) > 0 {
+		for iNdEx := len(m.DeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceIDs[iNdEx])
+			copy(dAtA[i:], m.DeviceIDs[iNdEx])
+			i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.DeviceIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetEndDeviceBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetEndDeviceBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetEndDeviceBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EndDevices) > 0 {
+		for iNdEx := len(m.EndDevices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EndDevices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteEndDeviceBulkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteEndDeviceBulkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteEndDeviceBulkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeviceIDs) > 0 {
+		for iNdEx := len(m.DeviceIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeviceIDs[iNdEx])
+			copy(dAtA[i:], m.DeviceIDs[iNdEx])
+			i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.DeviceIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceBulkResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceBulkResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceBulkResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDevice != nil {
+		{
+			size, err := m.EndDevice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.EndDeviceIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EndDeviceBulkResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EndDeviceBulkResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EndDeviceBulkResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmergencyBroadcast) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmergencyBroadcast) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmergencyBroadcast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintNetworkserver(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if m.StartedBy != nil {
+		{
+			size, err := m.StartedBy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StartEmergencyBroadcastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartEmergencyBroadcastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartEmergencyBroadcastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndsAt != nil {
+		n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndsAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndsAt):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintNetworkserver(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StopEmergencyBroadcastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StopEmergencyBroadcastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StopEmergencyBroadcastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintNetworkserver(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.ApplicationIDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintNetworkserver(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNetworkserver(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNetworkserver(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func NewPopulatedGenerateDevAddrResponse(r randyNetworkserver, easy bool) *GenerateDevAddrResponse {
+	this := &GenerateDevAddrResponse{}
+	this.DevAddr = go_thethings_network_lorawan_stack_pkg_types.NewPopulatedDevAddr(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRegionalParametersViolation(r randyNetworkserver, easy bool) *RegionalParametersViolation {
+	this := &RegionalParametersViolation{}
+	this.Field = randStringNetworkserver(r)
+	this.Description = randStringNetworkserver(r)
+	this.Remediation = randStringNetworkserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedRegionalParametersComplianceReport(r randyNetworkserver, easy bool) *RegionalParametersComplianceReport {
+	this := &RegionalParametersComplianceReport{}
+	this.FrequencyPlanID = randStringNetworkserver(r)
+	this.BandID = randStringNetworkserver(r)
+	this.LoRaWANPHYVersion = PHYVersion([]int32{0, 1, 2, 3, 4, 5, 6, 7}[r.Intn(8)])
+	if r.Intn(5) != 0 {
+		v1 := r.Intn(5)
+		this.Violations = make([]*RegionalParametersViolation, v1)
+		for i := 0; i < v1; i++ {
+			this.Violations[i] = NewPopulatedRegionalParametersViolation(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedMACTrace(r randyNetworkserver, easy bool) *MACTrace {
+	this := &MACTrace{}
+	if r.Intn(5) != 0 {
+		v2 := r.Intn(5)
+		this.Entries = make([]*MACTraceEntry, v2)
+		for i := 0; i < v2; i++ {
+			this.Entries[i] = NewPopulatedMACTraceEntry(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetEndDeviceLifecycleStatesRequest(r randyNetworkserver, easy bool) *SetEndDeviceLifecycleStatesRequest {
+	this := &SetEndDeviceLifecycleStatesRequest{}
+	v3 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v3
+	v4 := r.Intn(10)
+	this.DeviceIDs = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.DeviceIDs[i] = randStringNetworkserver(r)
+	}
+	this.LifecycleState = EndDeviceLifecycleState([]int32{0, 1, 2, 3}[r.Intn(4)])
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedGetEndDeviceBulkRequest(r randyNetworkserver, easy bool) *GetEndDeviceBulkRequest {
+	this := &GetEndDeviceBulkRequest{}
+	v5 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v5
+	v6 := r.Intn(10)
+	this.DeviceIDs = make([]string, v6)
+	for i := 0; i < v6; i++ {
+		this.DeviceIDs[i] = randStringNetworkserver(r)
+	}
+	v7 := types.NewPopulatedFieldMask(r, easy)
+	this.FieldMask = *v7
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedSetEndDeviceBulkRequest(r randyNetworkserver, easy bool) *SetEndDeviceBulkRequest {
+	this := &SetEndDeviceBulkRequest{}
+	v8 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v8
+	if r.Intn(5) != 0 {
+		v9 := r.Intn(5)
+		this.EndDevices = make([]*SetEndDeviceRequest, v9)
+		for i := 0; i < v9; i++ {
+			this.EndDevices[i] = NewPopulatedSetEndDeviceRequest(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedDeleteEndDeviceBulkRequest(r randyNetworkserver, easy bool) *DeleteEndDeviceBulkRequest {
+	this := &DeleteEndDeviceBulkRequest{}
+	v10 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v10
+	v11 := r.Intn(10)
+	this.DeviceIDs = make([]string, v11)
+	for i := 0; i < v11; i++ {
+		this.DeviceIDs[i] = randStringNetworkserver(r)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceBulkResult(r randyNetworkserver, easy bool) *EndDeviceBulkResult {
+	this := &EndDeviceBulkResult{}
+	v12 := NewPopulatedEndDeviceIdentifiers(r, easy)
+	this.EndDeviceIDs = *v12
+	if r.Intn(5) != 0 {
+		this.EndDevice = NewPopulatedEndDevice(r, easy)
+	}
+	if r.Intn(5) == 0 {
+		this.Error = NewPopulatedErrorDetails(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEndDeviceBulkResults(r randyNetworkserver, easy bool) *EndDeviceBulkResults {
+	this := &EndDeviceBulkResults{}
+	if r.Intn(5) == 0 {
+		v13 := r.Intn(5)
+		this.Results = make([]*EndDeviceBulkResult, v13)
+		for i := 0; i < v13; i++ {
+			this.Results[i] = NewPopulatedEndDeviceBulkResult(r, easy)
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedEmergencyBroadcast(r randyNetworkserver, easy bool) *EmergencyBroadcast {
+	this := &EmergencyBroadcast{}
+	v14 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v14
+	this.Reason = randStringNetworkserver(r)
+	if r.Intn(5) != 0 {
+		this.StartedBy = NewPopulatedOrganizationOrUserIdentifiers(r, easy)
+	}
+	v15 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.StartedAt = *v15
+	v16 := github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	this.EndsAt = *v16
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStartEmergencyBroadcastRequest(r randyNetworkserver, easy bool) *StartEmergencyBroadcastRequest {
+	this := &StartEmergencyBroadcastRequest{}
+	v17 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v17
+	this.Reason = randStringNetworkserver(r)
+	if r.Intn(5) != 0 {
+		this.EndsAt = github_com_gogo_protobuf_types.NewPopulatedStdTime(r, easy)
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedStopEmergencyBroadcastRequest(r randyNetworkserver, easy bool) *StopEmergencyBroadcastRequest {
+	this := &StopEmergencyBroadcastRequest{}
+	v18 := NewPopulatedApplicationIdentifiers(r, easy)
+	this.ApplicationIDs = *v18
+	this.Reason = randStringNetworkserver(r)
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyNetworkserver interface {
+	Float32() float32
 	Float64() float64
 	Int63() int64
 	Int31() int32
@@ -747,107 +3024,2132 @@ type randyNetworkserver interface {
 	Intn(n int) int
 }
 
-func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
-	ru := r.Intn(62)
-	if ru < 10 {
-		return rune(ru + 48)
-	} else if ru < 36 {
-		return rune(ru + 55)
+func randUTF8RuneNetworkserver(r randyNetworkserver) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringNetworkserver(r randyNetworkserver) string {
+	v19 := r.Intn(100)
+	tmps := make([]rune, v19)
+	for i := 0; i < v19; i++ {
+		tmps[i] = randUTF8RuneNetworkserver(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedNetworkserver(r randyNetworkserver, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldNetworkserver(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		v20 := r.Int63()
+		if r.Intn(2) == 0 {
+			v20 *= -1
+		}
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v20))
+	case 1:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateNetworkserver(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(v&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *GenerateDevAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DevAddr != nil {
+		l = m.DevAddr.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RegionalParametersViolation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	l = len(m.Remediation)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *RegionalParametersComplianceReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FrequencyPlanID)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	l = len(m.BandID)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.LoRaWANPHYVersion != 0 {
+		n += 1 + sovNetworkserver(uint64(m.LoRaWANPHYVersion))
+	}
+	if len(m.Violations) > 0 {
+		for _, e := range m.Violations {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MACTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SetEndDeviceLifecycleStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if len(m.DeviceIDs) > 0 {
+		for _, s := range m.DeviceIDs {
+			l = len(s)
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	if m.LifecycleState != 0 {
+		n += 1 + sovNetworkserver(uint64(m.LifecycleState))
+	}
+	return n
+}
+
+func (m *GetEndDeviceBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if len(m.DeviceIDs) > 0 {
+		for _, s := range m.DeviceIDs {
+			l = len(s)
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	l = m.FieldMask.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *SetEndDeviceBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if len(m.EndDevices) > 0 {
+		for _, e := range m.EndDevices {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DeleteEndDeviceBulkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if len(m.DeviceIDs) > 0 {
+		for _, s := range m.DeviceIDs {
+			l = len(s)
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EndDeviceBulkResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.EndDeviceIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	if m.EndDevice != nil {
+		l = m.EndDevice.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *EndDeviceBulkResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovNetworkserver(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmergencyBroadcast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.StartedBy != nil {
+		l = m.StartedBy.Size()
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartedAt)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndsAt)
+	n += 1 + l + sovNetworkserver(uint64(l))
+	return n
+}
+
+func (m *StartEmergencyBroadcastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	if m.EndsAt != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndsAt)
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func (m *StopEmergencyBroadcastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ApplicationIDs.Size()
+	n += 1 + l + sovNetworkserver(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovNetworkserver(uint64(l))
+	}
+	return n
+}
+
+func sovNetworkserver(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNetworkserver(x uint64) (n int) {
+	return sovNetworkserver((x << 1) ^ uint64((int64(x) >> 63)))
+}
+func (this *GenerateDevAddrResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GenerateDevAddrResponse{`,
+		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegionalParametersViolation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegionalParametersViolation{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Description:` + fmt.Sprintf("%v", this.Description) + `,`,
+		`Remediation:` + fmt.Sprintf("%v", this.Remediation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegionalParametersComplianceReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForViolations := "[]*RegionalParametersViolation{"
+	for _, f := range this.Violations {
+		repeatedStringForViolations += strings.Replace(f.String(), "RegionalParametersViolation", "RegionalParametersViolation", 1) + ","
+	}
+	repeatedStringForViolations += "}"
+	s := strings.Join([]string{`&RegionalParametersComplianceReport{`,
+		`FrequencyPlanID:` + fmt.Sprintf("%v", this.FrequencyPlanID) + `,`,
+		`BandID:` + fmt.Sprintf("%v", this.BandID) + `,`,
+		`LoRaWANPHYVersion:` + fmt.Sprintf("%v", this.LoRaWANPHYVersion) + `,`,
+		`Violations:` + repeatedStringForViolations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MACTrace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*MACTraceEntry{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(fmt.Sprintf("%v", f), "MACTraceEntry", "MACTraceEntry", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&MACTrace{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetEndDeviceLifecycleStatesRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetEndDeviceLifecycleStatesRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceIDs:` + fmt.Sprintf("%v", this.DeviceIDs) + `,`,
+		`LifecycleState:` + fmt.Sprintf("%v", this.LifecycleState) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetEndDeviceBulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetEndDeviceBulkRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceIDs:` + fmt.Sprintf("%v", this.DeviceIDs) + `,`,
+		`FieldMask:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.FieldMask), "FieldMask", "types.FieldMask", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetEndDeviceBulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEndDevices := "[]*SetEndDeviceRequest{"
+	for _, f := range this.EndDevices {
+		repeatedStringForEndDevices += strings.Replace(fmt.Sprintf("%v", f), "SetEndDeviceRequest", "SetEndDeviceRequest", 1) + ","
+	}
+	repeatedStringForEndDevices += "}"
+	s := strings.Join([]string{`&SetEndDeviceBulkRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`EndDevices:` + repeatedStringForEndDevices + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteEndDeviceBulkRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteEndDeviceBulkRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`DeviceIDs:` + fmt.Sprintf("%v", this.DeviceIDs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceBulkResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EndDeviceBulkResult{`,
+		`EndDeviceIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndDeviceIDs), "EndDeviceIdentifiers", "EndDeviceIdentifiers", 1), `&`, ``, 1) + `,`,
+		`EndDevice:` + strings.Replace(fmt.Sprintf("%v", this.EndDevice), "EndDevice", "EndDevice", 1) + `,`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "ErrorDetails", "ErrorDetails", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EndDeviceBulkResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResults := "[]*EndDeviceBulkResult{"
+	for _, f := range this.Results {
+		repeatedStringForResults += strings.Replace(f.String(), "EndDeviceBulkResult", "EndDeviceBulkResult", 1) + ","
+	}
+	repeatedStringForResults += "}"
+	s := strings.Join([]string{`&EndDeviceBulkResults{`,
+		`Results:` + repeatedStringForResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EmergencyBroadcast) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EmergencyBroadcast{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`StartedBy:` + strings.Replace(fmt.Sprintf("%v", this.StartedBy), "OrganizationOrUserIdentifiers", "OrganizationOrUserIdentifiers", 1) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`EndsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndsAt), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartEmergencyBroadcastRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartEmergencyBroadcastRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`EndsAt:` + strings.Replace(fmt.Sprintf("%v", this.EndsAt), "Timestamp", "types.Timestamp", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StopEmergencyBroadcastRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StopEmergencyBroadcastRequest{`,
+		`ApplicationIDs:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ApplicationIDs), "ApplicationIdentifiers", "ApplicationIdentifiers", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringNetworkserver(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenerateDevAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenerateDevAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v go_thethings_network_lorawan_stack_pkg_types.DevAddr
+			m.DevAddr = &v
+			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionalParametersViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionalParametersViolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionalParametersViolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remediation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remediation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegionalParametersComplianceReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegionalParametersComplianceReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegionalParametersComplianceReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrequencyPlanID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrequencyPlanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BandID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BandID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoRaWANPHYVersion", wireType)
+			}
+			m.LoRaWANPHYVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LoRaWANPHYVersion |= PHYVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Violations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Violations = append(m.Violations, &RegionalParametersViolation{})
+			if err := m.Violations[len(m.Violations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MACTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MACTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MACTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &MACTraceEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetEndDeviceLifecycleStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetEndDeviceLifecycleStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetEndDeviceLifecycleStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceIDs = append(m.DeviceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifecycleState", wireType)
+			}
+			m.LifecycleState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LifecycleState |= EndDeviceLifecycleState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEndDeviceBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEndDeviceBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEndDeviceBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceIDs = append(m.DeviceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FieldMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetEndDeviceBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetEndDeviceBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetEndDeviceBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDevices = append(m.EndDevices, &SetEndDeviceRequest{})
+			if err := m.EndDevices[len(m.EndDevices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteEndDeviceBulkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteEndDeviceBulkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteEndDeviceBulkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceIDs = append(m.DeviceIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceBulkResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceBulkResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceBulkResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDeviceIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndDeviceIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDevice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDevice == nil {
+				m.EndDevice = &EndDevice{}
+			}
+			if err := m.EndDevice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &ErrorDetails{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EndDeviceBulkResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EndDeviceBulkResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EndDeviceBulkResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &EndDeviceBulkResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmergencyBroadcast) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmergencyBroadcast: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmergencyBroadcast: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedBy == nil {
+				m.StartedBy = &OrganizationOrUserIdentifiers{}
+			}
+			if err := m.StartedBy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return rune(ru + 61)
-}
-func randStringNetworkserver(r randyNetworkserver) string {
-	v1 := r.Intn(100)
-	tmps := make([]rune, v1)
-	for i := 0; i < v1; i++ {
-		tmps[i] = randUTF8RuneNetworkserver(r)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return string(tmps)
+	return nil
 }
-func randUnrecognizedNetworkserver(r randyNetworkserver, maxFieldNumber int) (dAtA []byte) {
-	l := r.Intn(5)
-	for i := 0; i < l; i++ {
-		wire := r.Intn(4)
-		if wire == 3 {
-			wire = 5
+func (m *StartEmergencyBroadcastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNetworkserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		fieldNumber := maxFieldNumber + r.Intn(100)
-		dAtA = randFieldNetworkserver(dAtA, r, fieldNumber, wire)
-	}
-	return dAtA
-}
-func randFieldNetworkserver(dAtA []byte, r randyNetworkserver, fieldNumber int, wire int) []byte {
-	key := uint32(fieldNumber)<<3 | uint32(wire)
-	switch wire {
-	case 0:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		v2 := r.Int63()
-		if r.Intn(2) == 0 {
-			v2 *= -1
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartEmergencyBroadcastRequest: wiretype end group for non-group")
 		}
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(v2))
-	case 1:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	case 2:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		ll := r.Intn(100)
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(ll))
-		for j := 0; j < ll; j++ {
-			dAtA = append(dAtA, byte(r.Intn(256)))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartEmergencyBroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndsAt == nil {
+				m.EndsAt = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndsAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNetworkserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-	default:
-		dAtA = encodeVarintPopulateNetworkserver(dAtA, uint64(key))
-		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
-	}
-	return dAtA
-}
-func encodeVarintPopulateNetworkserver(dAtA []byte, v uint64) []byte {
-	for v >= 1<<7 {
-		dAtA = append(dAtA, uint8(v&0x7f|0x80))
-		v >>= 7
-	}
-	dAtA = append(dAtA, uint8(v))
-	return dAtA
-}
-func (m *GenerateDevAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DevAddr != nil {
-		l = m.DevAddr.Size()
-		n += 1 + l + sovNetworkserver(uint64(l))
 	}
-	return n
-}
 
-func sovNetworkserver(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNetworkserver(x uint64) (n int) {
-	return sovNetworkserver((x << 1) ^ uint64((int64(x) >> 63)))
-}
-func (this *GenerateDevAddrResponse) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&GenerateDevAddrResponse{`,
-		`DevAddr:` + fmt.Sprintf("%v", this.DevAddr) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringNetworkserver(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *StopEmergencyBroadcastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -870,17 +5172,17 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenerateDevAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StopEmergencyBroadcastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenerateDevAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StopEmergencyBroadcastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplicationIDs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNetworkserver
@@ -890,27 +5192,57 @@ func (m *GenerateDevAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNetworkserver
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNetworkserver
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v go_thethings_network_lorawan_stack_pkg_types.DevAddr
-			m.DevAddr = &v
-			if err := m.DevAddr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApplicationIDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNetworkserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNetworkserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNetworkserver(dAtA[iNdEx:])
@@ -9,3 +9,747 @@ var GenerateDevAddrResponseFieldPathsNested = []string{
 var GenerateDevAddrResponseFieldPathsTopLevel = []string{
 	"dev_addr",
 }
+
+var RegionalParametersViolationFieldPathsNested = []string{
+	"description",
+	"field",
+	"remediation",
+}
+
+var RegionalParametersViolationFieldPathsTopLevel = []string{
+	"description",
+	"field",
+	"remediation",
+}
+
+var RegionalParametersComplianceReportFieldPathsNested = []string{
+	"band_id",
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"violations",
+}
+
+var RegionalParametersComplianceReportFieldPathsTopLevel = []string{
+	"band_id",
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"violations",
+}
+
+var MACTraceFieldPathsNested = []string{
+	"entries",
+}
+
+var MACTraceFieldPathsTopLevel = []string{
+	"entries",
+}
+
+var SetEndDeviceLifecycleStatesRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+	"lifecycle_state",
+}
+
+var SetEndDeviceLifecycleStatesRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+	"lifecycle_state",
+}
+
+var GetEndDeviceBulkRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+	"field_mask",
+}
+
+var GetEndDeviceBulkRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+	"field_mask",
+}
+
+var SetEndDeviceBulkRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"end_devices",
+}
+
+var SetEndDeviceBulkRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"end_devices",
+}
+
+var DeleteEndDeviceBulkRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"device_ids",
+}
+
+var DeleteEndDeviceBulkRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"device_ids",
+}
+
+var EndDeviceBulkResultFieldPathsNested = []string{
+	"end_device",
+	"end_device.application_server_address",
+	"end_device.application_server_id",
+	"end_device.application_server_kek_label",
+	"end_device.attributes",
+	"end_device.battery_percentage",
+	"end_device.claim_authentication_code",
+	"end_device.claim_authentication_code.valid_from",
+	"end_device.claim_authentication_code.valid_to",
+	"end_device.claim_authentication_code.value",
+	"end_device.created_at",
+	"end_device.description",
+	"end_device.downlink_margin",
+	"end_device.formatters",
+	"end_device.formatters.down_formatter",
+	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
+	"end_device.formatters.up_formatter",
+	"end_device.formatters.up_formatter_parameter",
+	"end_device.frequency_plan_id",
+	"end_device.ids",
+	"end_device.ids.application_ids",
+	"end_device.ids.application_ids.application_id",
+	"end_device.ids.dev_addr",
+	"end_device.ids.dev_eui",
+	"end_device.ids.device_id",
+	"end_device.ids.join_eui",
+	"end_device.join_server_address",
+	"end_device.last_dev_nonce",
+	"end_device.last_dev_status_received_at",
+	"end_device.last_join_nonce",
+	"end_device.last_rj_count_0",
+	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
+	"end_device.locations",
+	"end_device.lorawan_phy_version",
+	"end_device.lorawan_version",
+	"end_device.mac_settings",
+	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
+	"end_device.mac_settings.class_b_timeout",
+	"end_device.mac_settings.class_c_timeout",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent",
+	"end_device.mac_settings.desired_adr_ack_delay_exponent.value",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent",
+	"end_device.mac_settings.desired_adr_ack_limit_exponent.value",
+	"end_device.mac_settings.desired_max_duty_cycle",
+	"end_device.mac_settings.desired_max_duty_cycle.value",
+	"end_device.mac_settings.desired_rx1_data_rate_offset",
+	"end_device.mac_settings.desired_rx1_delay",
+	"end_device.mac_settings.desired_rx1_delay.value",
+	"end_device.mac_settings.desired_rx2_data_rate_index",
+	"end_device.mac_settings.desired_rx2_data_rate_index.value",
+	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
+	"end_device.mac_settings.factory_preset_frequencies",
+	"end_device.mac_settings.max_duty_cycle",
+	"end_device.mac_settings.max_duty_cycle.value",
+	"end_device.mac_settings.ping_slot_data_rate_index",
+	"end_device.mac_settings.ping_slot_data_rate_index.value",
+	"end_device.mac_settings.ping_slot_frequency",
+	"end_device.mac_settings.ping_slot_periodicity",
+	"end_device.mac_settings.ping_slot_periodicity.value",
+	"end_device.mac_settings.resets_f_cnt",
+	"end_device.mac_settings.rx1_data_rate_offset",
+	"end_device.mac_settings.rx1_delay",
+	"end_device.mac_settings.rx1_delay.value",
+	"end_device.mac_settings.rx2_data_rate_index",
+	"end_device.mac_settings.rx2_data_rate_index.value",
+	"end_device.mac_settings.rx2_frequency",
+	"end_device.mac_settings.status_count_periodicity",
+	"end_device.mac_settings.status_time_periodicity",
+	"end_device.mac_settings.supports_32_bit_f_cnt",
+	"end_device.mac_settings.use_adr",
+	"end_device.mac_state",
+	"end_device.mac_state.current_parameters",
+	"end_device.mac_state.current_parameters.adr_ack_delay",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.current_parameters.adr_ack_limit",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.current_parameters.adr_data_rate_index",
+	"end_device.mac_state.current_parameters.adr_nb_trans",
+	"end_device.mac_state.current_parameters.adr_tx_power_index",
+	"end_device.mac_state.current_parameters.beacon_frequency",
+	"end_device.mac_state.current_parameters.channels",
+	"end_device.mac_state.current_parameters.downlink_dwell_time",
+	"end_device.mac_state.current_parameters.max_duty_cycle",
+	"end_device.mac_state.current_parameters.max_eirp",
+	"end_device.mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.current_parameters.ping_slot_frequency",
+	"end_device.mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.current_parameters.rx1_delay",
+	"end_device.mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.mac_state.current_parameters.rx2_frequency",
+	"end_device.mac_state.current_parameters.uplink_dwell_time",
+	"end_device.mac_state.desired_parameters",
+	"end_device.mac_state.desired_parameters.adr_ack_delay",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_ack_limit",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.mac_state.desired_parameters.adr_nb_trans",
+	"end_device.mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.mac_state.desired_parameters.beacon_frequency",
+	"end_device.mac_state.desired_parameters.channels",
+	"end_device.mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.mac_state.desired_parameters.max_duty_cycle",
+	"end_device.mac_state.desired_parameters.max_eirp",
+	"end_device.mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.mac_state.desired_parameters.rx1_delay",
+	"end_device.mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.mac_state.desired_parameters.rx2_frequency",
+	"end_device.mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.mac_state.device_class",
+	"end_device.mac_state.last_confirmed_downlink_at",
+	"end_device.mac_state.last_dev_status_f_cnt_up",
+	"end_device.mac_state.lorawan_version",
+	"end_device.mac_state.pending_application_downlink",
+	"end_device.mac_state.pending_application_downlink.class_b_c",
+	"end_device.mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.mac_state.pending_application_downlink.confirmed",
+	"end_device.mac_state.pending_application_downlink.correlation_ids",
+	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
+	"end_device.mac_state.pending_application_downlink.f_cnt",
+	"end_device.mac_state.pending_application_downlink.f_port",
+	"end_device.mac_state.pending_application_downlink.frm_payload",
+	"end_device.mac_state.pending_application_downlink.priority",
+	"end_device.mac_state.pending_application_downlink.session_key_id",
+	"end_device.mac_state.pending_join_request",
+	"end_device.mac_state.pending_join_request.cf_list",
+	"end_device.mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.mac_state.pending_join_request.cf_list.freq",
+	"end_device.mac_state.pending_join_request.cf_list.type",
+	"end_device.mac_state.pending_join_request.correlation_ids",
+	"end_device.mac_state.pending_join_request.dev_addr",
+	"end_device.mac_state.pending_join_request.downlink_settings",
+	"end_device.mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.net_id",
+	"end_device.mac_state.pending_join_request.payload",
+	"end_device.mac_state.pending_join_request.payload.Payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.net_id",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.mac_state.pending_join_request.payload.Payload.join_request_payload.join_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.f_port",
+	"end_device.mac_state.pending_join_request.payload.Payload.mac_payload.frm_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.mac_state.pending_join_request.payload.m_hdr",
+	"end_device.mac_state.pending_join_request.payload.m_hdr.m_type",
+	"end_device.mac_state.pending_join_request.payload.m_hdr.major",
+	"end_device.mac_state.pending_join_request.payload.mic",
+	"end_device.mac_state.pending_join_request.raw_payload",
+	"end_device.mac_state.pending_join_request.rx_delay",
+	"end_device.mac_state.pending_join_request.selected_mac_version",
+	"end_device.mac_state.pending_requests",
+	"end_device.mac_state.ping_slot_periodicity",
+	"end_device.mac_state.queued_join_accept",
+	"end_device.mac_state.queued_join_accept.keys",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.mac_state.queued_join_accept.payload",
+	"end_device.mac_state.queued_join_accept.request",
+	"end_device.mac_state.queued_join_accept.request.cf_list",
+	"end_device.mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.mac_state.queued_join_accept.request.correlation_ids",
+	"end_device.mac_state.queued_join_accept.request.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.join_request_payload.join_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_port",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.mac_payload.frm_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr.m_type",
+	"end_device.mac_state.queued_join_accept.request.payload.m_hdr.major",
+	"end_device.mac_state.queued_join_accept.request.payload.mic",
+	"end_device.mac_state.queued_join_accept.request.raw_payload",
+	"end_device.mac_state.queued_join_accept.request.rx_delay",
+	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.mac_state.queued_responses",
+	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
+	"end_device.max_frequency",
+	"end_device.min_frequency",
+	"end_device.multicast",
+	"end_device.mute_windows",
+	"end_device.name",
+	"end_device.net_id",
+	"end_device.network_server_address",
+	"end_device.network_server_kek_label",
+	"end_device.pending_mac_state",
+	"end_device.pending_mac_state.current_parameters",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.current_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.current_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.current_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.current_parameters.beacon_frequency",
+	"end_device.pending_mac_state.current_parameters.channels",
+	"end_device.pending_mac_state.current_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.current_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.current_parameters.max_eirp",
+	"end_device.pending_mac_state.current_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.current_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.current_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.current_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.current_parameters.rx1_delay",
+	"end_device.pending_mac_state.current_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.current_parameters.rx2_frequency",
+	"end_device.pending_mac_state.current_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_delay_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent",
+	"end_device.pending_mac_state.desired_parameters.adr_ack_limit_exponent.value",
+	"end_device.pending_mac_state.desired_parameters.adr_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.adr_nb_trans",
+	"end_device.pending_mac_state.desired_parameters.adr_tx_power_index",
+	"end_device.pending_mac_state.desired_parameters.beacon_frequency",
+	"end_device.pending_mac_state.desired_parameters.channels",
+	"end_device.pending_mac_state.desired_parameters.downlink_dwell_time",
+	"end_device.pending_mac_state.desired_parameters.max_duty_cycle",
+	"end_device.pending_mac_state.desired_parameters.max_eirp",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.ping_slot_frequency",
+	"end_device.pending_mac_state.desired_parameters.rejoin_count_periodicity",
+	"end_device.pending_mac_state.desired_parameters.rejoin_time_periodicity",
+	"end_device.pending_mac_state.desired_parameters.rx1_data_rate_offset",
+	"end_device.pending_mac_state.desired_parameters.rx1_delay",
+	"end_device.pending_mac_state.desired_parameters.rx2_data_rate_index",
+	"end_device.pending_mac_state.desired_parameters.rx2_frequency",
+	"end_device.pending_mac_state.desired_parameters.uplink_dwell_time",
+	"end_device.pending_mac_state.device_class",
+	"end_device.pending_mac_state.last_confirmed_downlink_at",
+	"end_device.pending_mac_state.last_dev_status_f_cnt_up",
+	"end_device.pending_mac_state.lorawan_version",
+	"end_device.pending_mac_state.pending_application_downlink",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.absolute_time",
+	"end_device.pending_mac_state.pending_application_downlink.class_b_c.gateways",
+	"end_device.pending_mac_state.pending_application_downlink.confirmed",
+	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
+	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
+	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
+	"end_device.pending_mac_state.pending_application_downlink.f_port",
+	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
+	"end_device.pending_mac_state.pending_application_downlink.priority",
+	"end_device.pending_mac_state.pending_application_downlink.session_key_id",
+	"end_device.pending_mac_state.pending_join_request",
+	"end_device.pending_mac_state.pending_join_request.cf_list",
+	"end_device.pending_mac_state.pending_join_request.cf_list.ch_masks",
+	"end_device.pending_mac_state.pending_join_request.cf_list.freq",
+	"end_device.pending_mac_state.pending_join_request.cf_list.type",
+	"end_device.pending_mac_state.pending_join_request.correlation_ids",
+	"end_device.pending_mac_state.pending_join_request.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.pending_join_request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.join_request_payload.join_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.f_port",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.mac_payload.frm_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.pending_mac_state.pending_join_request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr.m_type",
+	"end_device.pending_mac_state.pending_join_request.payload.m_hdr.major",
+	"end_device.pending_mac_state.pending_join_request.payload.mic",
+	"end_device.pending_mac_state.pending_join_request.raw_payload",
+	"end_device.pending_mac_state.pending_join_request.rx_delay",
+	"end_device.pending_mac_state.pending_join_request.selected_mac_version",
+	"end_device.pending_mac_state.pending_requests",
+	"end_device.pending_mac_state.ping_slot_periodicity",
+	"end_device.pending_mac_state.queued_join_accept",
+	"end_device.pending_mac_state.queued_join_accept.keys",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.app_s_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.f_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.nwk_s_enc_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_mac_state.queued_join_accept.keys.s_nwk_s_int_key.key",
+	"end_device.pending_mac_state.queued_join_accept.keys.session_key_id",
+	"end_device.pending_mac_state.queued_join_accept.payload",
+	"end_device.pending_mac_state.queued_join_accept.request",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.ch_masks",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.freq",
+	"end_device.pending_mac_state.queued_join_accept.request.cf_list.type",
+	"end_device.pending_mac_state.queued_join_accept.request.correlation_ids",
+	"end_device.pending_mac_state.queued_join_accept.request.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.opt_neg",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.queued_join_accept.request.downlink_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.freq",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.cf_list.type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.encrypted",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.join_nonce",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_accept_payload.rx_delay",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.dev_nonce",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.join_request_payload.join_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.decoded_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_hdr.f_opts",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.f_port",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.mac_payload.frm_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.dev_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.join_eui",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.net_id",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.Payload.rejoin_request_payload.rejoin_type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr.m_type",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.m_hdr.major",
+	"end_device.pending_mac_state.queued_join_accept.request.payload.mic",
+	"end_device.pending_mac_state.queued_join_accept.request.raw_payload",
+	"end_device.pending_mac_state.queued_join_accept.request.rx_delay",
+	"end_device.pending_mac_state.queued_join_accept.request.selected_mac_version",
+	"end_device.pending_mac_state.queued_responses",
+	"end_device.pending_mac_state.rx_windows_available",
+	"end_device.pending_session",
+	"end_device.pending_session.dev_addr",
+	"end_device.pending_session.keys",
+	"end_device.pending_session.keys.app_s_key",
+	"end_device.pending_session.keys.app_s_key.encrypted_key",
+	"end_device.pending_session.keys.app_s_key.kek_label",
+	"end_device.pending_session.keys.app_s_key.key",
+	"end_device.pending_session.keys.f_nwk_s_int_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.f_nwk_s_int_key.key",
+	"end_device.pending_session.keys.nwk_s_enc_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.pending_session.keys.nwk_s_enc_key.kek_label",
+	"end_device.pending_session.keys.nwk_s_enc_key.key",
+	"end_device.pending_session.keys.s_nwk_s_int_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.pending_session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.pending_session.keys.s_nwk_s_int_key.key",
+	"end_device.pending_session.keys.session_key_id",
+	"end_device.pending_session.last_a_f_cnt_down",
+	"end_device.pending_session.last_conf_f_cnt_down",
+	"end_device.pending_session.last_f_cnt_up",
+	"end_device.pending_session.last_n_f_cnt_down",
+	"end_device.pending_session.started_at",
+	"end_device.power_state",
+	"end_device.provisioner_id",
+	"end_device.provisioning_data",
+	"end_device.queued_application_downlinks",
+	"end_device.recent_adr_uplinks",
+	"end_device.recent_downlinks",
+	"end_device.recent_uplinks",
+	"end_device.resets_join_nonces",
+	"end_device.root_keys",
+	"end_device.root_keys.app_key",
+	"end_device.root_keys.app_key.encrypted_key",
+	"end_device.root_keys.app_key.kek_label",
+	"end_device.root_keys.app_key.key",
+	"end_device.root_keys.nwk_key",
+	"end_device.root_keys.nwk_key.encrypted_key",
+	"end_device.root_keys.nwk_key.kek_label",
+	"end_device.root_keys.nwk_key.key",
+	"end_device.root_keys.root_key_id",
+	"end_device.service_profile_id",
+	"end_device.session",
+	"end_device.session.dev_addr",
+	"end_device.session.keys",
+	"end_device.session.keys.app_s_key",
+	"end_device.session.keys.app_s_key.encrypted_key",
+	"end_device.session.keys.app_s_key.kek_label",
+	"end_device.session.keys.app_s_key.key",
+	"end_device.session.keys.f_nwk_s_int_key",
+	"end_device.session.keys.f_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.f_nwk_s_int_key.kek_label",
+	"end_device.session.keys.f_nwk_s_int_key.key",
+	"end_device.session.keys.nwk_s_enc_key",
+	"end_device.session.keys.nwk_s_enc_key.encrypted_key",
+	"end_device.session.keys.nwk_s_enc_key.kek_label",
+	"end_device.session.keys.nwk_s_enc_key.key",
+	"end_device.session.keys.s_nwk_s_int_key",
+	"end_device.session.keys.s_nwk_s_int_key.encrypted_key",
+	"end_device.session.keys.s_nwk_s_int_key.kek_label",
+	"end_device.session.keys.s_nwk_s_int_key.key",
+	"end_device.session.keys.session_key_id",
+	"end_device.session.last_a_f_cnt_down",
+	"end_device.session.last_conf_f_cnt_down",
+	"end_device.session.last_f_cnt_up",
+	"end_device.session.last_n_f_cnt_down",
+	"end_device.session.started_at",
+	"end_device.supports_class_b",
+	"end_device.supports_class_c",
+	"end_device.supports_join",
+	"end_device.time_zone",
+	"end_device.updated_at",
+	"end_device.used_dev_nonces",
+	"end_device.version_ids",
+	"end_device.version_ids.brand_id",
+	"end_device.version_ids.firmware_version",
+	"end_device.version_ids.hardware_version",
+	"end_device.version_ids.model_id",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+}
+
+var EndDeviceBulkResultFieldPathsTopLevel = []string{
+	"end_device",
+	"end_device_ids",
+	"error",
+}
+
+var EndDeviceBulkResultsFieldPathsNested = []string{
+	"results",
+}
+
+var EndDeviceBulkResultsFieldPathsTopLevel = []string{
+	"results",
+}
+
+var EmergencyBroadcastFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"ends_at",
+	"reason",
+	"started_at",
+	"started_by",
+}
+
+var EmergencyBroadcastFieldPathsTopLevel = []string{
+	"application_ids",
+	"ends_at",
+	"reason",
+	"started_at",
+	"started_by",
+}
+
+var StartEmergencyBroadcastRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"ends_at",
+	"reason",
+}
+
+var StartEmergencyBroadcastRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"ends_at",
+	"reason",
+}
+
+var StopEmergencyBroadcastRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"reason",
+}
+
+var StopEmergencyBroadcastRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"reason",
+}
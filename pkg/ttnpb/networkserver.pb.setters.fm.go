@@ -2,7 +2,12 @@
 
 package ttnpb
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	time "time"
+
+	types "github.com/gogo/protobuf/types"
+)
 
 func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, paths ...string) error {
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -23,3 +28,540 @@ func (dst *GenerateDevAddrResponse) SetFields(src *GenerateDevAddrResponse, path
 	}
 	return nil
 }
+
+func (dst *RegionalParametersViolation) SetFields(src *RegionalParametersViolation, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "field":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Field = src.Field
+			} else {
+				var zero string
+				dst.Field = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "remediation":
+			if len(subs) > 0 {
+				return fmt.Errorf("'remediation' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Remediation = src.Remediation
+			} else {
+				var zero string
+				dst.Remediation = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RegionalParametersComplianceReport) SetFields(src *RegionalParametersComplianceReport, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "frequency_plan_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frequency_plan_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrequencyPlanID = src.FrequencyPlanID
+			} else {
+				var zero string
+				dst.FrequencyPlanID = zero
+			}
+		case "band_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'band_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.BandID = src.BandID
+			} else {
+				var zero string
+				dst.BandID = zero
+			}
+		case "lorawan_phy_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_phy_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LoRaWANPHYVersion = src.LoRaWANPHYVersion
+			} else {
+				var zero PHYVersion
+				dst.LoRaWANPHYVersion = zero
+			}
+		case "violations":
+			if len(subs) > 0 {
+				return fmt.Errorf("'violations' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Violations = src.Violations
+			} else {
+				dst.Violations = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *MACTrace) SetFields(src *MACTrace, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "entries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'entries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Entries = src.Entries
+			} else {
+				dst.Entries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetEndDeviceLifecycleStatesRequest) SetFields(src *SetEndDeviceLifecycleStatesRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIDs = src.DeviceIDs
+			} else {
+				dst.DeviceIDs = nil
+			}
+		case "lifecycle_state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lifecycle_state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LifecycleState = src.LifecycleState
+			} else {
+				var zero EndDeviceLifecycleState
+				dst.LifecycleState = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetEndDeviceBulkRequest) SetFields(src *GetEndDeviceBulkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIDs = src.DeviceIDs
+			} else {
+				dst.DeviceIDs = nil
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				var zero types.FieldMask
+				dst.FieldMask = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetEndDeviceBulkRequest) SetFields(src *SetEndDeviceBulkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "end_devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'end_devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndDevices = src.EndDevices
+			} else {
+				dst.EndDevices = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteEndDeviceBulkRequest) SetFields(src *DeleteEndDeviceBulkRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIDs = src.DeviceIDs
+			} else {
+				dst.DeviceIDs = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceBulkResult) SetFields(src *EndDeviceBulkResult, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				newDst := &dst.EndDeviceIDs
+				var newSrc *EndDeviceIdentifiers
+				if src != nil {
+					newSrc = &src.EndDeviceIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIDs = src.EndDeviceIDs
+				} else {
+					var zero EndDeviceIdentifiers
+					dst.EndDeviceIDs = zero
+				}
+			}
+		case "end_device":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDevice
+				if (src == nil || src.EndDevice == nil) && dst.EndDevice == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDevice
+				}
+				if dst.EndDevice != nil {
+					newDst = dst.EndDevice
+				} else {
+					newDst = &EndDevice{}
+					dst.EndDevice = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDevice = src.EndDevice
+				} else {
+					dst.EndDevice = nil
+				}
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EndDeviceBulkResults) SetFields(src *EndDeviceBulkResults, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EmergencyBroadcast) SetFields(src *EmergencyBroadcast, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+		case "started_by":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_by' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedBy = src.StartedBy
+			} else {
+				dst.StartedBy = nil
+			}
+		case "started_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'started_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StartedAt = src.StartedAt
+			} else {
+				var zero time.Time
+				dst.StartedAt = zero
+			}
+		case "ends_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ends_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndsAt = src.EndsAt
+			} else {
+				var zero time.Time
+				dst.EndsAt = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StartEmergencyBroadcastRequest) SetFields(src *StartEmergencyBroadcastRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+		case "ends_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'ends_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EndsAt = src.EndsAt
+			} else {
+				dst.EndsAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *StopEmergencyBroadcastRequest) SetFields(src *StopEmergencyBroadcastRequest, paths ...string) error {
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				newDst := &dst.ApplicationIDs
+				var newSrc *ApplicationIdentifiers
+				if src != nil {
+					newSrc = &src.ApplicationIDs
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIDs = src.ApplicationIDs
+				} else {
+					var zero ApplicationIdentifiers
+					dst.ApplicationIDs = zero
+				}
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -117,3 +117,1301 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GenerateDevAddrResponseValidationError{}
+
+// ValidateFields checks the field values on RegionalParametersViolation with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RegionalParametersViolation) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RegionalParametersViolationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "field":
+			// no validation rules for Field
+		case "description":
+			// no validation rules for Description
+		case "remediation":
+			// no validation rules for Remediation
+		default:
+			return RegionalParametersViolationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RegionalParametersViolationValidationError is the validation error returned
+// by RegionalParametersViolation.ValidateFields if the designated constraints
+// aren't met.
+type RegionalParametersViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegionalParametersViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegionalParametersViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegionalParametersViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegionalParametersViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegionalParametersViolationValidationError) ErrorName() string {
+	return "RegionalParametersViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegionalParametersViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegionalParametersViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegionalParametersViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegionalParametersViolationValidationError{}
+
+// ValidateFields checks the field values on RegionalParametersComplianceReport
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *RegionalParametersComplianceReport) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RegionalParametersComplianceReportFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "frequency_plan_id":
+			// no validation rules for FrequencyPlanID
+		case "band_id":
+			// no validation rules for BandID
+		case "lorawan_phy_version":
+			// no validation rules for LoRaWANPHYVersion
+		case "violations":
+
+			for idx, item := range m.GetViolations() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RegionalParametersComplianceReportValidationError{
+							field:  fmt.Sprintf("violations[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RegionalParametersComplianceReportValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RegionalParametersComplianceReportValidationError is the validation error
+// returned by RegionalParametersComplianceReport.ValidateFields if the
+// designated constraints aren't met.
+type RegionalParametersComplianceReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegionalParametersComplianceReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegionalParametersComplianceReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegionalParametersComplianceReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegionalParametersComplianceReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegionalParametersComplianceReportValidationError) ErrorName() string {
+	return "RegionalParametersComplianceReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegionalParametersComplianceReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegionalParametersComplianceReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegionalParametersComplianceReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegionalParametersComplianceReportValidationError{}
+
+// ValidateFields checks the field values on MACTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *MACTrace) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = MACTraceFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entries":
+
+			for idx, item := range m.GetEntries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return MACTraceValidationError{
+							field:  fmt.Sprintf("entries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return MACTraceValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// MACTraceValidationError is the validation error returned by
+// MACTrace.ValidateFields if the designated constraints aren't met.
+type MACTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MACTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MACTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MACTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MACTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MACTraceValidationError) ErrorName() string { return "MACTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MACTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMACTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MACTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MACTraceValidationError{}
+
+// ValidateFields checks the field values on SetEndDeviceLifecycleStatesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetEndDeviceLifecycleStatesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetEndDeviceLifecycleStatesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceLifecycleStatesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if l := len(m.GetDeviceIDs()); l < 1 || l > 100 {
+				return SetEndDeviceLifecycleStatesRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+		case "lifecycle_state":
+
+			if _, ok := EndDeviceLifecycleState_name[int32(m.GetLifecycleState())]; !ok {
+				return SetEndDeviceLifecycleStatesRequestValidationError{
+					field:  "lifecycle_state",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return SetEndDeviceLifecycleStatesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetEndDeviceLifecycleStatesRequestValidationError is the validation error
+// returned by SetEndDeviceLifecycleStatesRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetEndDeviceLifecycleStatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEndDeviceLifecycleStatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEndDeviceLifecycleStatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEndDeviceLifecycleStatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEndDeviceLifecycleStatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEndDeviceLifecycleStatesRequestValidationError) ErrorName() string {
+	return "SetEndDeviceLifecycleStatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEndDeviceLifecycleStatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEndDeviceLifecycleStatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEndDeviceLifecycleStatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEndDeviceLifecycleStatesRequestValidationError{}
+
+// ValidateFields checks the field values on GetEndDeviceBulkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetEndDeviceBulkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetEndDeviceBulkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceBulkRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if l := len(m.GetDeviceIDs()); l < 1 || l > 100 {
+				return GetEndDeviceBulkRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(&m.FieldMask).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetEndDeviceBulkRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetEndDeviceBulkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetEndDeviceBulkRequestValidationError is the validation error returned by
+// GetEndDeviceBulkRequest.ValidateFields if the designated constraints aren't met.
+type GetEndDeviceBulkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEndDeviceBulkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEndDeviceBulkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEndDeviceBulkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEndDeviceBulkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEndDeviceBulkRequestValidationError) ErrorName() string {
+	return "GetEndDeviceBulkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEndDeviceBulkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEndDeviceBulkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEndDeviceBulkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEndDeviceBulkRequestValidationError{}
+
+// ValidateFields checks the field values on SetEndDeviceBulkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetEndDeviceBulkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetEndDeviceBulkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetEndDeviceBulkRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_devices":
+
+			if l := len(m.GetEndDevices()); l < 1 || l > 100 {
+				return SetEndDeviceBulkRequestValidationError{
+					field:  "end_devices",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+			for idx, item := range m.GetEndDevices() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return SetEndDeviceBulkRequestValidationError{
+							field:  fmt.Sprintf("end_devices[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return SetEndDeviceBulkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetEndDeviceBulkRequestValidationError is the validation error returned by
+// SetEndDeviceBulkRequest.ValidateFields if the designated constraints aren't met.
+type SetEndDeviceBulkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetEndDeviceBulkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetEndDeviceBulkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetEndDeviceBulkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetEndDeviceBulkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetEndDeviceBulkRequestValidationError) ErrorName() string {
+	return "SetEndDeviceBulkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetEndDeviceBulkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetEndDeviceBulkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetEndDeviceBulkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetEndDeviceBulkRequestValidationError{}
+
+// ValidateFields checks the field values on DeleteEndDeviceBulkRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteEndDeviceBulkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteEndDeviceBulkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteEndDeviceBulkRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if l := len(m.GetDeviceIDs()); l < 1 || l > 100 {
+				return DeleteEndDeviceBulkRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain between 1 and 100 items, inclusive",
+				}
+			}
+
+		default:
+			return DeleteEndDeviceBulkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteEndDeviceBulkRequestValidationError is the validation error returned by
+// DeleteEndDeviceBulkRequest.ValidateFields if the designated constraints aren't met.
+type DeleteEndDeviceBulkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteEndDeviceBulkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteEndDeviceBulkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteEndDeviceBulkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteEndDeviceBulkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteEndDeviceBulkRequestValidationError) ErrorName() string {
+	return "DeleteEndDeviceBulkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteEndDeviceBulkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteEndDeviceBulkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteEndDeviceBulkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteEndDeviceBulkRequestValidationError{}
+
+// ValidateFields checks the field values on EndDeviceBulkResult with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *EndDeviceBulkResult) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceBulkResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if v, ok := interface{}(&m.EndDeviceIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceBulkResultValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device":
+
+			if v, ok := interface{}(m.GetEndDevice()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceBulkResultValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EndDeviceBulkResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EndDeviceBulkResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceBulkResultValidationError is the validation error returned by
+// EndDeviceBulkResult.ValidateFields if the designated constraints aren't met.
+type EndDeviceBulkResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceBulkResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceBulkResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceBulkResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceBulkResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceBulkResultValidationError) ErrorName() string {
+	return "EndDeviceBulkResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceBulkResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceBulkResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceBulkResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceBulkResultValidationError{}
+
+// ValidateFields checks the field values on EndDeviceBulkResults with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EndDeviceBulkResults) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EndDeviceBulkResultsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return EndDeviceBulkResultsValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return EndDeviceBulkResultsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EndDeviceBulkResultsValidationError is the validation error returned by
+// EndDeviceBulkResults.ValidateFields if the designated constraints aren't met.
+type EndDeviceBulkResultsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndDeviceBulkResultsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndDeviceBulkResultsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndDeviceBulkResultsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndDeviceBulkResultsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndDeviceBulkResultsValidationError) ErrorName() string {
+	return "EndDeviceBulkResultsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EndDeviceBulkResultsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndDeviceBulkResults.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndDeviceBulkResultsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndDeviceBulkResultsValidationError{}
+
+// ValidateFields checks the field values on EmergencyBroadcast with the rules
+// defined in the proto definition for this message. If any rules are violated,
+// an error is returned.
+func (m *EmergencyBroadcast) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EmergencyBroadcastFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EmergencyBroadcastValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reason":
+			// no validation rules for Reason
+		case "started_by":
+
+			if v, ok := interface{}(m.GetStartedBy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EmergencyBroadcastValidationError{
+						field:  "started_by",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "started_at":
+
+			if v, ok := interface{}(&m.StartedAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EmergencyBroadcastValidationError{
+						field:  "started_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "ends_at":
+
+			if v, ok := interface{}(&m.EndsAt).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return EmergencyBroadcastValidationError{
+						field:  "ends_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return EmergencyBroadcastValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EmergencyBroadcastValidationError is the validation error returned by
+// EmergencyBroadcast.ValidateFields if the designated constraints aren't met.
+type EmergencyBroadcastValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmergencyBroadcastValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmergencyBroadcastValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmergencyBroadcastValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmergencyBroadcastValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmergencyBroadcastValidationError) ErrorName() string {
+	return "EmergencyBroadcastValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmergencyBroadcastValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmergencyBroadcast.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmergencyBroadcastValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmergencyBroadcastValidationError{}
+
+// ValidateFields checks the field values on StartEmergencyBroadcastRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *StartEmergencyBroadcastRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StartEmergencyBroadcastRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartEmergencyBroadcastRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reason":
+
+			if l := utf8.RuneCountInString(m.GetReason()); l < 1 || l > 2000 {
+				return StartEmergencyBroadcastRequestValidationError{
+					field:  "reason",
+					reason: "value length must be between 1 and 2000 runes, inclusive",
+				}
+			}
+
+		case "ends_at":
+
+			if v, ok := interface{}(m.GetEndsAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StartEmergencyBroadcastRequestValidationError{
+						field:  "ends_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return StartEmergencyBroadcastRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StartEmergencyBroadcastRequestValidationError is the validation error
+// returned by StartEmergencyBroadcastRequest.ValidateFields if the designated
+// constraints aren't met.
+type StartEmergencyBroadcastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartEmergencyBroadcastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartEmergencyBroadcastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartEmergencyBroadcastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartEmergencyBroadcastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartEmergencyBroadcastRequestValidationError) ErrorName() string {
+	return "StartEmergencyBroadcastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartEmergencyBroadcastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartEmergencyBroadcastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartEmergencyBroadcastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartEmergencyBroadcastRequestValidationError{}
+
+// ValidateFields checks the field values on StopEmergencyBroadcastRequest with
+// the rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *StopEmergencyBroadcastRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = StopEmergencyBroadcastRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if v, ok := interface{}(&m.ApplicationIDs).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return StopEmergencyBroadcastRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reason":
+
+			if utf8.RuneCountInString(m.GetReason()) > 2000 {
+				return StopEmergencyBroadcastRequestValidationError{
+					field:  "reason",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		default:
+			return StopEmergencyBroadcastRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// StopEmergencyBroadcastRequestValidationError is the validation error
+// returned by StopEmergencyBroadcastRequest.ValidateFields if the designated
+// constraints aren't met.
+type StopEmergencyBroadcastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StopEmergencyBroadcastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StopEmergencyBroadcastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StopEmergencyBroadcastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StopEmergencyBroadcastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StopEmergencyBroadcastRequestValidationError) ErrorName() string {
+	return "StopEmergencyBroadcastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StopEmergencyBroadcastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStopEmergencyBroadcastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StopEmergencyBroadcastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StopEmergencyBroadcastRequestValidationError{}
@@ -44,6 +44,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.formatters",
 	"end_device.formatters.down_formatter",
 	"end_device.formatters.down_formatter_parameter",
+	"end_device.formatters.f_port_formatters",
 	"end_device.formatters.up_formatter",
 	"end_device.formatters.up_formatter_parameter",
 	"end_device.frequency_plan_id",
@@ -60,11 +61,17 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.last_join_nonce",
 	"end_device.last_rj_count_0",
 	"end_device.last_rj_count_1",
+	"end_device.lifecycle_state",
 	"end_device.locations",
 	"end_device.lorawan_phy_version",
 	"end_device.lorawan_version",
 	"end_device.mac_settings",
 	"end_device.mac_settings.adr_margin",
+	"end_device.mac_settings.adr_max_data_rate_index",
+	"end_device.mac_settings.adr_max_data_rate_index.value",
+	"end_device.mac_settings.adr_max_tx_power_index",
+	"end_device.mac_settings.adr_min_data_rate_index",
+	"end_device.mac_settings.adr_min_data_rate_index.value",
 	"end_device.mac_settings.class_b_timeout",
 	"end_device.mac_settings.class_c_timeout",
 	"end_device.mac_settings.desired_adr_ack_delay_exponent",
@@ -79,6 +86,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_settings.desired_rx2_data_rate_index",
 	"end_device.mac_settings.desired_rx2_data_rate_index.value",
 	"end_device.mac_settings.desired_rx2_frequency",
+	"end_device.mac_settings.f_cnt_reset_grace",
 	"end_device.mac_settings.factory_preset_frequencies",
 	"end_device.mac_settings.max_duty_cycle",
 	"end_device.mac_settings.max_duty_cycle.value",
@@ -158,6 +166,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.pending_application_downlink.confirmed",
 	"end_device.mac_state.pending_application_downlink.correlation_ids",
 	"end_device.mac_state.pending_application_downlink.decoded_payload",
+	"end_device.mac_state.pending_application_downlink.expires_at",
 	"end_device.mac_state.pending_application_downlink.f_cnt",
 	"end_device.mac_state.pending_application_downlink.f_port",
 	"end_device.mac_state.pending_application_downlink.frm_payload",
@@ -305,9 +314,11 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.mac_state.queued_join_accept.request.selected_mac_version",
 	"end_device.mac_state.queued_responses",
 	"end_device.mac_state.rx_windows_available",
+	"end_device.mac_trace",
 	"end_device.max_frequency",
 	"end_device.min_frequency",
 	"end_device.multicast",
+	"end_device.mute_windows",
 	"end_device.name",
 	"end_device.net_id",
 	"end_device.network_server_address",
@@ -372,6 +383,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.pending_mac_state.pending_application_downlink.confirmed",
 	"end_device.pending_mac_state.pending_application_downlink.correlation_ids",
 	"end_device.pending_mac_state.pending_application_downlink.decoded_payload",
+	"end_device.pending_mac_state.pending_application_downlink.expires_at",
 	"end_device.pending_mac_state.pending_application_downlink.f_cnt",
 	"end_device.pending_mac_state.pending_application_downlink.f_port",
 	"end_device.pending_mac_state.pending_application_downlink.frm_payload",
@@ -591,6 +603,7 @@ var GenerateEndDeviceQRCodeRequestFieldPathsNested = []string{
 	"end_device.supports_class_b",
 	"end_device.supports_class_c",
 	"end_device.supports_join",
+	"end_device.time_zone",
 	"end_device.updated_at",
 	"end_device.used_dev_nonces",
 	"end_device.version_ids",
@@ -28,9 +28,10 @@ import (
 )
 
 const (
-	delta = 0.001 // For GPS comparisons
-	lora  = "LORA"
-	fsk   = "FSK"
+	delta  = 0.001 // For GPS comparisons
+	lora   = "LORA"
+	fsk    = "FSK"
+	lrfhss = "LR-FHSS"
 
 	// eirpDelta is the delta between EIRP and ERP.
 	eirpDelta = 2.15
@@ -199,6 +200,11 @@ func convertUplink(rx RxPacket, md UpstreamMetadata) (ttnpb.UplinkMessage, error
 	if lora := up.Settings.DataRate.GetLoRa(); lora != nil {
 		up.Settings.CodingRate = rx.CodR
 	}
+	if lrfhss := up.Settings.DataRate.GetLRFHSS(); lrfhss != nil {
+		// The coding rate is part of the LR-FHSS data rate.
+		lrfhss.CodingRate = rx.CodR
+		up.Settings.CodingRate = rx.CodR
+	}
 
 	return up, nil
 }
@@ -282,6 +288,9 @@ func FromGatewayUp(up *ttnpb.GatewayUp) (rxs []*RxPacket, stat *Stat, ack *TxPac
 			codr = msg.Settings.CodingRate
 		case *ttnpb.DataRate_FSK:
 			modulation = fsk
+		case *ttnpb.DataRate_LRFHSS:
+			modulation = lrfhss
+			codr = msg.Settings.CodingRate
 		}
 		rxs = append(rxs, &RxPacket{
 			Freq: float64(msg.Settings.Frequency) / 1000000,
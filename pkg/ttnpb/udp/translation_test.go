@@ -211,6 +211,34 @@ func TestToGatewayUpRaw(t *testing.T) {
 	a.So(len(msg.RawPayload), should.Equal, base64.StdEncoding.DecodedLen(len("Wqish6GVYpKy6o9WFHingeTJ1oh+ABc8iALBvwz44yxZP+BKDocaC5VQT5Y6dDdUaBILVjRMz0Ynzow1U/Kkts9AoZh3Ja3DX+DyY27exB+BKpSx2rXJ2vs9svm/EKYIsPF0RG1E+7lBYaD9")))
 }
 
+func TestToGatewayUpRawLRFHSS(t *testing.T) {
+	a := assertions.New(t)
+
+	raw := []byte(`{"rxpk":[{"tmst":368384825,"chan":0,"rfch":0,"freq":868.100000,"stat":1,"modu":"LR-FHSS","datr":"M0CW137","codr":"2/3","lsnr":-11,"rssi":-107,"size":4,"data":"AQIDBA=="}]}`)
+	var rxData udp.Data
+	err := json.Unmarshal(raw, &rxData)
+	a.So(err, should.BeNil)
+
+	upstream, err := udp.ToGatewayUp(rxData, udp.UpstreamMetadata{ID: ids})
+	a.So(err, should.BeNil)
+
+	a.So(len(upstream.UplinkMessages), should.Equal, 1)
+	msg := upstream.UplinkMessages[0]
+	a.So(msg.Settings.DataRate.GetLRFHSS(), should.Resemble, &ttnpb.LRFHSSDataRate{
+		ModulationType:        0,
+		OperatingChannelWidth: 137000,
+		CodingRate:            "2/3",
+	})
+	a.So(msg.Settings.CodingRate, should.Equal, "2/3")
+	a.So(msg.RawPayload, should.Resemble, []byte{0x1, 0x2, 0x3, 0x4})
+
+	rxs, _, _ := udp.FromGatewayUp(upstream)
+	a.So(len(rxs), should.Equal, 1)
+	a.So(rxs[0].Modu, should.Equal, "LR-FHSS")
+	a.So(rxs[0].DatR.String(), should.Equal, "M0CW137")
+	a.So(rxs[0].CodR, should.Equal, "2/3")
+}
+
 func TestToGatewayUpRawMultiAntenna(t *testing.T) {
 	a := assertions.New(t)
 
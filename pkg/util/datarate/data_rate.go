@@ -23,14 +23,15 @@ import (
 	"go.thethings.network/lorawan-stack/pkg/ttnpb"
 )
 
-// DR encodes a LoRa data rate or an FSK data rate, and implements marshalling and unmarshalling between JSON.
+// DR encodes a LoRa data rate, an FSK data rate or an LR-FHSS data rate, and implements marshalling and unmarshalling
+// between JSON.
 type DR struct {
 	ttnpb.DataRate
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 func (dr DR) MarshalJSON() ([]byte, error) {
-	if dr.GetLoRa() != nil {
+	if dr.GetLoRa() != nil || dr.GetLRFHSS() != nil {
 		return []byte(strconv.Quote(dr.String())), nil
 	}
 	if dr.GetFSK() != nil {
@@ -42,7 +43,11 @@ func (dr DR) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON implements the json.Unmarshaler interface.
 func (dr *DR) UnmarshalJSON(data []byte) error {
 	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
-		datarate, err := ParseLoRa(string(data[1 : len(data)-1]))
+		parse := ParseLoRa
+		if data[1] == 'M' {
+			parse = ParseLRFHSS
+		}
+		datarate, err := parse(string(data[1 : len(data)-1]))
 		if err != nil {
 			return err
 		}
@@ -66,9 +71,10 @@ func (dr *DR) UnmarshalJSON(data []byte) error {
 }
 
 var (
-	errDataRate = errors.DefineInvalidArgument("data_rate", "invalid data rate")
-	sfRegexp    = regexp.MustCompile(`^SF([1-9]|10|11|12)BW`)
-	bwRegexp    = regexp.MustCompile(`BW(\d+(?:\.\d+)?)$`)
+	errDataRate  = errors.DefineInvalidArgument("data_rate", "invalid data rate")
+	sfRegexp     = regexp.MustCompile(`^SF([1-9]|10|11|12)BW`)
+	bwRegexp     = regexp.MustCompile(`BW(\d+(?:\.\d+)?)$`)
+	lrFHSSRegexp = regexp.MustCompile(`^M(\d+)CW(\d+)$`)
 )
 
 // String implements the Stringer interface.
@@ -79,6 +85,9 @@ func (dr DR) String() string {
 	if fsk := dr.GetFSK(); fsk != nil {
 		return fmt.Sprintf("%d", fsk.BitRate)
 	}
+	if lrfhss := dr.GetLRFHSS(); lrfhss != nil {
+		return fmt.Sprintf("M%dCW%d", lrfhss.ModulationType, lrfhss.OperatingChannelWidth/1000)
+	}
 	return ""
 }
 
@@ -111,3 +120,30 @@ func ParseLoRa(dr string) (DR, error) {
 		},
 	}, nil
 }
+
+// ParseLRFHSS converts a string of format "MxCWxxx" to a LRFHSSDataRate, where M is the modulation type and CW is the
+// operating channel width in kHz. The coding rate is not part of the string.
+func ParseLRFHSS(dr string) (DR, error) {
+	matches := lrFHSSRegexp.FindStringSubmatch(dr)
+	if len(matches) != 3 {
+		return DR{}, errDataRate
+	}
+	modulationType, err := strconv.ParseUint(matches[1], 10, 32)
+	if err != nil {
+		return DR{}, errDataRate
+	}
+	ocw, err := strconv.ParseUint(matches[2], 10, 32)
+	if err != nil {
+		return DR{}, errDataRate
+	}
+	return DR{
+		DataRate: ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_LRFHSS{
+				LRFHSS: &ttnpb.LRFHSSDataRate{
+					ModulationType:        uint32(modulationType),
+					OperatingChannelWidth: uint32(ocw * 1000),
+				},
+			},
+		},
+	}, nil
+}
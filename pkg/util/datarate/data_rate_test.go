@@ -29,6 +29,7 @@ func TestDataRate(t *testing.T) {
 	table := map[string]datarate.DR{
 		`"SF7BW125"`: {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 7, Bandwidth: 125000}}}},
 		`50000`:      {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}},
+		`"M0CW137"`:  {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 137000}}}},
 	}
 
 	for s, dr := range table {
@@ -75,14 +76,38 @@ func TestInvalidLoRaDataRateParsing(t *testing.T) {
 	}
 }
 
+func TestLRFHSSDataRateParsing(t *testing.T) {
+	a := assertions.New(t)
+
+	table := map[string]datarate.DR{
+		"M0CW137":  {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 137000}}}},
+		"M0CW1523": {DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 1523000}}}},
+	}
+	for dr, expected := range table {
+		actual, err := datarate.ParseLRFHSS(dr)
+		a.So(err, should.BeNil)
+		a.So(actual, should.Resemble, expected)
+	}
+
+	for _, dr := range []string{
+		"CW137",
+		"M0CW",
+		"SF7BW125",
+	} {
+		_, err := datarate.ParseLRFHSS(dr)
+		a.So(err, should.NotBeNil)
+	}
+}
+
 func TestStringer(t *testing.T) {
 	a := assertions.New(t)
 
 	table := map[datarate.DR]string{
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 6, Bandwidth: 125000}}}}: "SF6BW125",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 9, Bandwidth: 500000}}}}: "SF9BW500",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 5, Bandwidth: 31250}}}}:  "SF5BW31.25",
-		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}}:                           "50000",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 6, Bandwidth: 125000}}}}:                  "SF6BW125",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 9, Bandwidth: 500000}}}}:                  "SF9BW500",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LoRa{LoRa: &ttnpb.LoRaDataRate{SpreadingFactor: 5, Bandwidth: 31250}}}}:                   "SF5BW31.25",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_FSK{FSK: &ttnpb.FSKDataRate{BitRate: 50000}}}}:                                            "50000",
+		{DataRate: ttnpb.DataRate{Modulation: &ttnpb.DataRate_LRFHSS{LRFHSS: &ttnpb.LRFHSSDataRate{ModulationType: 0, OperatingChannelWidth: 336000}}}}: "M0CW336",
 	}
 
 	for dr, expected := range table {
@@ -23,14 +23,16 @@ import (
 
 // MockApplicationAccessServer is a mock ttnpb.ApplicationAccessServer used for testing.
 type MockApplicationAccessServer struct {
-	CreateAPIKeyFunc      func(context.Context, *ttnpb.CreateApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
-	GetAPIKeyFunc         func(context.Context, *ttnpb.GetApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
-	ListAPIKeysFunc       func(context.Context, *ttnpb.ListApplicationAPIKeysRequest) (*ttnpb.APIKeys, error)
-	ListCollaboratorsFunc func(context.Context, *ttnpb.ListApplicationCollaboratorsRequest) (*ttnpb.Collaborators, error)
-	ListRightsFunc        func(context.Context, *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error)
-	GetCollaboratorFunc   func(context.Context, *ttnpb.GetApplicationCollaboratorRequest) (*ttnpb.GetCollaboratorResponse, error)
-	SetCollaboratorFunc   func(context.Context, *ttnpb.SetApplicationCollaboratorRequest) (*pbtypes.Empty, error)
-	UpdateAPIKeyFunc      func(context.Context, *ttnpb.UpdateApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
+	CreateAPIKeyFunc        func(context.Context, *ttnpb.CreateApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
+	CreateAPIKeyBulkFunc    func(context.Context, *ttnpb.CreateApplicationAPIKeyBulkRequest) (*ttnpb.ApplicationBulkResults, error)
+	GetAPIKeyFunc           func(context.Context, *ttnpb.GetApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
+	ListAPIKeysFunc         func(context.Context, *ttnpb.ListApplicationAPIKeysRequest) (*ttnpb.APIKeys, error)
+	ListCollaboratorsFunc   func(context.Context, *ttnpb.ListApplicationCollaboratorsRequest) (*ttnpb.Collaborators, error)
+	ListRightsFunc          func(context.Context, *ttnpb.ApplicationIdentifiers) (*ttnpb.Rights, error)
+	GetCollaboratorFunc     func(context.Context, *ttnpb.GetApplicationCollaboratorRequest) (*ttnpb.GetCollaboratorResponse, error)
+	SetCollaboratorFunc     func(context.Context, *ttnpb.SetApplicationCollaboratorRequest) (*pbtypes.Empty, error)
+	SetCollaboratorBulkFunc func(context.Context, *ttnpb.SetApplicationCollaboratorBulkRequest) (*ttnpb.ApplicationBulkResults, error)
+	UpdateAPIKeyFunc        func(context.Context, *ttnpb.UpdateApplicationAPIKeyRequest) (*ttnpb.APIKey, error)
 }
 
 // ListRights calls ListRightsFunc if set and panics otherwise.
@@ -49,6 +51,14 @@ func (m MockApplicationAccessServer) CreateAPIKey(ctx context.Context, req *ttnp
 	return m.CreateAPIKeyFunc(ctx, req)
 }
 
+// CreateAPIKeyBulk calls CreateAPIKeyBulkFunc if set and panics otherwise.
+func (m MockApplicationAccessServer) CreateAPIKeyBulk(ctx context.Context, req *ttnpb.CreateApplicationAPIKeyBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	if m.CreateAPIKeyBulkFunc == nil {
+		panic("CreateAPIKeyBulk called, but not set")
+	}
+	return m.CreateAPIKeyBulkFunc(ctx, req)
+}
+
 // ListAPIKeys calls ListAPIKeysFunc if set and panics otherwise.
 func (m MockApplicationAccessServer) ListAPIKeys(ctx context.Context, req *ttnpb.ListApplicationAPIKeysRequest) (*ttnpb.APIKeys, error) {
 	if m.ListAPIKeysFunc == nil {
@@ -89,6 +99,14 @@ func (m MockApplicationAccessServer) SetCollaborator(ctx context.Context, req *t
 	return m.SetCollaboratorFunc(ctx, req)
 }
 
+// SetCollaboratorBulk calls SetCollaboratorBulkFunc if set and panics otherwise.
+func (m MockApplicationAccessServer) SetCollaboratorBulk(ctx context.Context, req *ttnpb.SetApplicationCollaboratorBulkRequest) (*ttnpb.ApplicationBulkResults, error) {
+	if m.SetCollaboratorBulkFunc == nil {
+		panic("SetCollaboratorBulk called, but not set")
+	}
+	return m.SetCollaboratorBulkFunc(ctx, req)
+}
+
 // ListCollaborators calls ListCollaboratorsFunc if set and panics otherwise.
 func (m MockApplicationAccessServer) ListCollaborators(ctx context.Context, req *ttnpb.ListApplicationCollaboratorsRequest) (*ttnpb.Collaborators, error) {
 	if m.ListCollaboratorsFunc == nil {
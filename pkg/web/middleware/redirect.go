@@ -18,16 +18,29 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"strings"
 
 	echo "github.com/labstack/echo/v4"
 )
 
+// joinHostPort joins the host and the port like net.JoinHostPort. If the port is empty, only the host is returned,
+// with brackets if it is an IPv6 address.
+func joinHostPort(host, port string) string {
+	if port == "" {
+		if strings.Contains(host, ":") {
+			return "[" + host + "]"
+		}
+		return host
+	}
+	return net.JoinHostPort(host, port)
+}
+
 // RedirectToHost redirects to the target host if not already used.
 // The port of the request is preserved.
 func RedirectToHost(target string) echo.MiddlewareFunc {
 	targetHost, _, err := net.SplitHostPort(target)
 	if err != nil {
-		targetHost = target
+		targetHost = strings.Trim(target, "[]")
 	}
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
@@ -37,7 +50,7 @@ func RedirectToHost(target string) echo.MiddlewareFunc {
 			}
 			host, port, err := net.SplitHostPort(requestHost)
 			if err != nil {
-				host = requestHost
+				host = strings.Trim(requestHost, "[]")
 			}
 			if host != targetHost {
 				url := *c.Request().URL
@@ -47,10 +60,7 @@ func RedirectToHost(target string) echo.MiddlewareFunc {
 				} else if c.IsTLS() {
 					url.Scheme = "https"
 				}
-				url.Host = targetHost
-				if port != "" {
-					url.Host = net.JoinHostPort(targetHost, port)
-				}
+				url.Host = joinHostPort(targetHost, port)
 				return c.Redirect(http.StatusFound, url.String())
 			}
 			return next(c)
@@ -69,16 +79,16 @@ func RedirectToHTTPS(fromToPorts map[int]int) echo.MiddlewareFunc {
 				}
 				host, port, err := net.SplitHostPort(requestHost)
 				if err != nil {
-					host = requestHost
+					host = strings.Trim(requestHost, "[]")
 					port = "80"
 				}
 				if port, err := strconv.Atoi(port); err == nil {
 					if to, ok := fromToPorts[port]; ok {
 						url := *c.Request().URL
 						url.Scheme = "https"
-						url.Host = host
+						url.Host = joinHostPort(host, "")
 						if to != 443 {
-							url.Host = net.JoinHostPort(host, strconv.Itoa(to))
+							url.Host = joinHostPort(host, strconv.Itoa(to))
 						}
 						return c.Redirect(http.StatusPermanentRedirect, url.String())
 					}
@@ -79,6 +79,29 @@ func TestRedirectToHost(t *testing.T) {
 		a.So(rec.Code, should.Equal, http.StatusFound)
 		a.So(rec.Header().Get("Location"), should.Equal, "https://example.com/")
 	}
+
+	{
+		redirectHandler := RedirectToHost("[2001:db8::1]")(handler)
+
+		req := httptest.NewRequest("GET", "http://[2001:db8::1]/", nil)
+		rec := httptest.NewRecorder()
+
+		c := e.NewContext(req, rec)
+		err := redirectHandler(c)
+
+		a.So(err, should.BeNil)
+		a.So(rec.Code, should.Equal, http.StatusOK)
+
+		req = httptest.NewRequest("GET", "http://example.com/", nil)
+		rec = httptest.NewRecorder()
+
+		c = e.NewContext(req, rec)
+		err = redirectHandler(c)
+
+		a.So(err, should.BeNil)
+		a.So(rec.Code, should.Equal, http.StatusFound)
+		a.So(rec.Header().Get("Location"), should.Equal, "http://[2001:db8::1]/")
+	}
 }
 
 func TestRedirectToHTTPS(t *testing.T) {
@@ -151,4 +174,32 @@ func TestRedirectToHTTPS(t *testing.T) {
 		a.So(rec.Code, should.Equal, http.StatusPermanentRedirect)
 		a.So(rec.Header().Get("Location"), should.Equal, "https://example.com:8885/")
 	}
+
+	for _, tc := range []struct {
+		URL      string
+		Location string
+	}{
+		{
+			URL:      "http://[2001:db8::1]/",
+			Location: "https://[2001:db8::1]/",
+		},
+		{
+			URL:      "http://[2001:db8::1]:80/",
+			Location: "https://[2001:db8::1]/",
+		},
+		{
+			URL:      "http://[2001:db8::1]:1885/",
+			Location: "https://[2001:db8::1]:8885/",
+		},
+	} {
+		req := httptest.NewRequest("GET", tc.URL, nil)
+		rec := httptest.NewRecorder()
+
+		c := e.NewContext(req, rec)
+		err := redirectHandler(c)
+
+		a.So(err, should.BeNil)
+		a.So(rec.Code, should.Equal, http.StatusPermanentRedirect)
+		a.So(rec.Header().Get("Location"), should.Equal, tc.Location)
+	}
 }
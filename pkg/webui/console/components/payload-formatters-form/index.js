@@ -183,6 +183,8 @@ class PayloadFormattersForm extends React.Component {
             <Radio label="Javascript" value={TYPES.JAVASCRIPT} />
             <Radio label={m.grpc} value={TYPES.GRPC} />
             <Radio label="CayenneLPP" value={TYPES.CAYENNELPP} />
+            <Radio label="CBOR" value={TYPES.CBOR} />
+            <Radio label="SenML" value={TYPES.SENML} />
             <Radio label={m.repository} value={TYPES.REPOSITORY} />
           </Form.Field>
           {this.formatter}
@@ -17,5 +17,7 @@ export default Object.freeze({
   JAVASCRIPT: 'FORMATTER_JAVASCRIPT',
   GRPC: 'FORMATTER_GRPC_SERVICE',
   CAYENNELPP: 'FORMATTER_CAYENNELPP',
+  CBOR: 'FORMATTER_CBOR',
+  SENML: 'FORMATTER_SENML',
   REPOSITORY: 'FORMATTER_REPOSITORY',
 })
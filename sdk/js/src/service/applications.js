@@ -164,6 +164,26 @@ class Applications {
     return Marshaler.unwrapRights(result)
   }
 
+  // Bulk Access Management
+
+  async createApiKeyBulk(applicationIds, key) {
+    const result = await this._api.ApplicationAccess.CreateAPIKeyBulk(undefined, {
+      application_ids: applicationIds.map(id => ({ application_id: id })),
+      ...key,
+    })
+
+    return Marshaler.payloadListResponse('results', result)
+  }
+
+  async setCollaboratorBulk(applicationIds, collaborator) {
+    const result = await this._api.ApplicationAccess.SetCollaboratorBulk(undefined, {
+      application_ids: applicationIds.map(id => ({ application_id: id })),
+      collaborator,
+    })
+
+    return Marshaler.payloadListResponse('results', result)
+  }
+
   async getMqttConnectionInfo(applicationId) {
     const response = await this._api.AppAs.GetMQTTConnectionInfo({
       routeParams: { application_id: applicationId },
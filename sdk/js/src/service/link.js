@@ -59,6 +59,14 @@ class Link {
 
     return Marshaler.payloadSingleResponse(result)
   }
+
+  async getTrafficStats(appId) {
+    const result = await this._api.GetTrafficStats({
+      routeParams: { application_id: appId },
+    })
+
+    return Marshaler.payloadSingleResponse(result)
+  }
 }
 
 export default Link
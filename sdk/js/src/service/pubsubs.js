@@ -133,6 +133,39 @@ class PubSub {
     return Marshaler.payloadSingleResponse(result)
   }
 
+  async getStatusById(appId, pubsubId) {
+    const result = await this._api.GetPubSubStatus({
+      routeParams: {
+        'application_ids.application_id': appId,
+        pub_sub_id: pubsubId,
+      },
+    })
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
+  async pauseById(appId, pubsubId) {
+    const result = await this._api.Pause({
+      routeParams: {
+        'application_ids.application_id': appId,
+        pub_sub_id: pubsubId,
+      },
+    })
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
+  async resumeById(appId, pubsubId) {
+    const result = await this._api.Resume({
+      routeParams: {
+        'application_ids.application_id': appId,
+        pub_sub_id: pubsubId,
+      },
+    })
+
+    return Marshaler.payloadSingleResponse(result)
+  }
+
   async getFormats() {
     const result = await this._api.GetFormats()
 